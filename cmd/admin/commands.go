@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+// createAdmin creates a user and grants it the ADMIN role
+// The password can come from ADMIN_PASSWORD so it doesn't end up in shell history.
+func createAdmin(ctx context.Context, env *environment, args []string) error {
+	fs := newFlagSet("create-admin")
+	email := fs.String("email", "", "email address of the new admin")
+	username := fs.String("username", "", "username of the new admin")
+	password := fs.String("password", os.Getenv("ADMIN_PASSWORD"), "password, at least 8 characters (default $ADMIN_PASSWORD)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, map[string]string{"email": *email, "username": *username, "password": *password}); err != nil {
+		return err
+	}
+	if len(*password) < 8 {
+		return fmt.Errorf("password must be at least 8 characters")
+	}
+
+	created, err := env.deps.UserService.CreateUser(ctx, *email, *username, *password)
+	if err != nil {
+		return err
+	}
+	admin, err := env.deps.UserService.GrantRole(ctx, created.Email, role.RoleAdmin.String())
+	if err != nil {
+		return fmt.Errorf("user %s was created but granting ADMIN failed: %w", created.Email, err)
+	}
+
+	fmt.Fprintf(env.out, "Created admin %s (%s) with roles %s\n", admin.Email, admin.ID, roleNames(admin))
+	return nil
+}
+
+// grantRole adds a role to a user
+func grantRole(ctx context.Context, env *environment, args []string) error {
+	return changeRole(ctx, env, "grant-role", args, env.deps.UserService.GrantRole)
+}
+
+// revokeRole removes a role from a user
+func revokeRole(ctx context.Context, env *environment, args []string) error {
+	return changeRole(ctx, env, "revoke-role", args, env.deps.UserService.RevokeRole)
+}
+
+// changeRole parses the flags shared by grant-role and revoke-role and applies the change
+func changeRole(ctx context.Context, env *environment, name string, args []string,
+	apply func(ctx context.Context, email, roleName string) (*user.User, error)) error {
+	fs := newFlagSet(name)
+	email := fs.String("email", "", "email address of the user")
+	roleName := fs.String("role", "", "role name: USER, ORGANIZER or ADMIN")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, map[string]string{"email": *email, "role": *roleName}); err != nil {
+		return err
+	}
+
+	updated, err := apply(ctx, *email, *roleName)
+	if err != nil {
+		return err
+	}
+
+	effect := "takes effect at the next login"
+	if env.deps.RoleVersions != nil {
+		effect = "signed-in sessions pick it up on their next request"
+	}
+	fmt.Fprintf(env.out, "%s now has roles %s (%s)\n", updated.Email, roleNames(updated), effect)
+	return nil
+}
+
+// cancelEvent cancels an event on behalf of its organizer
+func cancelEvent(ctx context.Context, env *environment, args []string) error {
+	fs := newFlagSet("cancel-event")
+	id := fs.String("id", "", "ID of the event to cancel")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, map[string]string{"id": *id}); err != nil {
+		return err
+	}
+	eventID, err := uuid.Parse(*id)
+	if err != nil {
+		return fmt.Errorf("invalid event ID: %w", err)
+	}
+
+	ev, err := env.deps.EventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if err := env.deps.EventService.CancelEvent(ctx, ev.ID, ev.OrganizerID); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(env.out, "Cancelled %q (%s); ticket holders are being notified\n", ev.Title, ev.ID)
+	return nil
+}
+
+// expireOrders fails orders left pending too long, and group shares whose hold ended, and releases their tickets
+func expireOrders(ctx context.Context, env *environment, args []string) error {
+	fs := newFlagSet("expire-orders")
+	olderThan := fs.Duration("older-than", 30*time.Minute, "expire pending orders created longer ago than this")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	expired, err := env.deps.OrderService.ExpirePendingOrders(ctx, *olderThan)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(env.out, "Expired %d pending orders older than %s\n", expired, *olderThan)
+	return nil
+}
+
+// reconcileTickets reports events whose ticket counter disagrees with their orders
+// Counters are only reset with -fix; without it the command is a dry run.
+func reconcileTickets(ctx context.Context, env *environment, args []string) error {
+	fs := newFlagSet("reconcile-tickets")
+	fix := fs.Bool("fix", false, "reset drifted counters to what the orders imply")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	result, err := env.deps.OrderService.ReconcileTickets(ctx, !*fix)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range result.Discrepancies {
+		status := ""
+		switch {
+		case d.Corrected:
+			status = " (corrected)"
+		case d.IsOversold():
+			status = " (oversold, left alone)"
+		case *fix:
+			status = " (changed meanwhile, left alone)"
+		}
+		fmt.Fprintf(env.out, "%s: %d available, orders imply %d (total %d, reserved %d)%s\n",
+			d.EventID, d.AvailableTickets, d.ExpectedAvailable(), d.TotalTickets, d.ReservedTickets, status)
+	}
+	fmt.Fprintf(env.out, "Found %d discrepancies, corrected %d\n", len(result.Discrepancies), result.Corrected)
+	return nil
+}
+
+// archiveEvents moves events that took place more than -months ago, with their orders, to the archive tables
+func archiveEvents(ctx context.Context, env *environment, args []string) error {
+	fs := newFlagSet("archive-events")
+	months := fs.Int("months", env.cfg.Archive.RetentionMonths, "archive events that took place more than this many months ago")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *months <= 0 {
+		return fmt.Errorf("-months must be positive")
+	}
+
+	archived, err := env.deps.OrderService.ArchiveEvents(ctx, time.Now().AddDate(0, -*months, 0))
+	if archived != nil {
+		fmt.Fprintf(env.out, "Archived %d events with %d orders\n", archived.Events, archived.Orders)
+	}
+	return err
+}
+
+// backfillSnapshots recomputes the daily analytics snapshots of a period
+// Days that already have a snapshot are replaced, so it also repairs days computed from incomplete data.
+func backfillSnapshots(ctx context.Context, env *environment, args []string) error {
+	fs := newFlagSet("backfill-snapshots")
+	fromFlag := fs.String("from", "", "first day to snapshot, YYYY-MM-DD")
+	toFlag := fs.String("to", time.Now().UTC().Format(time.DateOnly), "day after the last one to snapshot, YYYY-MM-DD")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, map[string]string{"from": *fromFlag, "to": *toFlag}); err != nil {
+		return err
+	}
+	from, err := time.Parse(time.DateOnly, *fromFlag)
+	if err != nil {
+		return fmt.Errorf("invalid -from: %w", err)
+	}
+	to, err := time.Parse(time.DateOnly, *toFlag)
+	if err != nil {
+		return fmt.Errorf("invalid -to: %w", err)
+	}
+
+	taken, err := env.deps.AnalyticsService.Backfill(ctx, from, to)
+	if err != nil {
+		return fmt.Errorf("backfill stopped after %d days: %w", taken, err)
+	}
+
+	fmt.Fprintf(env.out, "Snapshotted %d days from %s\n", taken, from.Format(time.DateOnly))
+	return nil
+}
+
+// flushEventCache removes every cached event
+// Other API instances keep their in-process entries until local_cache_ttl passes.
+func flushEventCache(ctx context.Context, env *environment, args []string) error {
+	if err := newFlagSet("flush-event-cache").Parse(args); err != nil {
+		return err
+	}
+	if env.deps.EventCache == nil {
+		fmt.Fprintln(env.out, "Event caching is disabled; nothing to flush")
+		return nil
+	}
+
+	if err := env.deps.EventCache.InvalidateEventCaches(ctx); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(env.out, "Flushed event caches")
+	return nil
+}
+
+// printConfig prints every configuration key with its effective value
+func printConfig(ctx context.Context, env *environment, args []string) error {
+	if err := newFlagSet("print-config").Parse(args); err != nil {
+		return err
+	}
+	writeConfig(env.out, "", reflect.ValueOf(*env.cfg))
+	return nil
+}
+
+// writeConfig prints the fields of a config section as "section.key = value" lines,
+// using the same keys as config.yaml
+func writeConfig(w io.Writer, prefix string, section reflect.Value) {
+	sectionType := section.Type()
+	for i := 0; i < section.NumField(); i++ {
+		key := sectionType.Field(i).Tag.Get("mapstructure")
+		if key == "" {
+			continue
+		}
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		field := section.Field(i)
+		if field.Kind() == reflect.Struct {
+			writeConfig(w, key, field)
+			continue
+		}
+		fmt.Fprintf(w, "%s = %v\n", key, redact(key, field.Interface()))
+	}
+}
+
+// redact hides secrets in config values
+func redact(key string, value interface{}) interface{} {
+	s, ok := value.(string)
+	if !ok || s == "" {
+		return value
+	}
+	for _, secret := range []string{"password", "token", "secret"} {
+		if strings.HasSuffix(key, secret) {
+			return "[REDACTED]"
+		}
+	}
+	if strings.HasSuffix(key, "url") {
+		if u, err := url.Parse(s); err == nil && u.User != nil {
+			return u.Redacted()
+		}
+	}
+	return value
+}
+
+// roleNames lists the roles of a user
+func roleNames(u *user.User) string {
+	return strings.Join(u.RoleNames(), ", ")
+}
@@ -0,0 +1,155 @@
+// Command admin runs operational tasks against the application's database and caches
+// without writing SQL. Each subcommand goes through the same services as the API,
+// so business rules, cache invalidation and notifications still apply.
+//
+// Usage:
+//
+//	admin <command> [flags]
+//
+// Run "admin help" for the list of commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"enterprise-crud/internal/app"
+	"enterprise-crud/internal/config"
+)
+
+// command is one admin subcommand
+type command struct {
+	summary string
+	// needsDeps is false for commands that only read configuration, so they work without a database
+	needsDeps bool
+	run       func(ctx context.Context, env *environment, args []string) error
+}
+
+// environment is what a command runs with
+type environment struct {
+	cfg  *config.Config
+	deps *app.Dependencies // nil unless the command needs it
+	out  io.Writer
+}
+
+// commands lists every subcommand by name
+var commands = map[string]command{
+	"create-admin":       {summary: "Create a user with the ADMIN role", needsDeps: true, run: createAdmin},
+	"grant-role":         {summary: "Grant a role to a user", needsDeps: true, run: grantRole},
+	"revoke-role":        {summary: "Revoke a role from a user", needsDeps: true, run: revokeRole},
+	"cancel-event":       {summary: "Cancel an event and notify its ticket holders", needsDeps: true, run: cancelEvent},
+	"expire-orders":      {summary: "Fail stale pending orders and release their tickets", needsDeps: true, run: expireOrders},
+	"reconcile-tickets":  {summary: "Compare event ticket counters with orders, optionally correcting drift", needsDeps: true, run: reconcileTickets},
+	"archive-events":     {summary: "Move long-past events and their orders to the archive tables", needsDeps: true, run: archiveEvents},
+	"backfill-snapshots": {summary: "Recompute the daily analytics snapshots of a period", needsDeps: true, run: backfillSnapshots},
+	"flush-event-cache":  {summary: "Remove every cached event from Redis and the local cache", needsDeps: true, run: flushEventCache},
+	"print-config":       {summary: "Print the effective configuration with secrets redacted", run: printConfig},
+}
+
+// commandTimeout bounds a single command so a hung database doesn't block the terminal forever
+const commandTimeout = 2 * time.Minute
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 || os.Args[1] == "help" || os.Args[1] == "-h" || os.Args[1] == "--help" {
+		usage(os.Stdout)
+		return
+	}
+
+	name := os.Args[1]
+	cmd, ok := commands[name]
+	if !ok {
+		usage(os.Stderr)
+		log.Fatalf("unknown command %q", name)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	env := &environment{cfg: cfg, out: os.Stdout}
+
+	if cmd.needsDeps {
+		deps, err := app.NewDependencies(cfg)
+		if err != nil {
+			log.Fatalf("Failed to initialize dependencies: %v", err)
+		}
+		defer closeDependencies(deps)
+		env.deps = deps
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	if err := cmd.run(ctx, env, os.Args[2:]); err != nil {
+		// log.Fatal would skip the deferred cleanup
+		log.Printf("%s: %v", name, err)
+		cancel()
+		if env.deps != nil {
+			closeDependencies(env.deps)
+		}
+		os.Exit(1)
+	}
+}
+
+// usage prints the available commands
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: admin <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-18s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `Run "admin <command> -h" for the flags of a command.`)
+}
+
+// closeDependencies flushes pending cache writes and closes connections
+func closeDependencies(deps *app.Dependencies) {
+	if deps.CachePopulator != nil {
+		deps.CachePopulator.Close()
+	}
+	if deps.RedisClient != nil {
+		deps.RedisClient.Close()
+	}
+	if deps.DBConn != nil {
+		deps.DBConn.Close()
+	}
+}
+
+// newFlagSet creates the flag set of a command
+// Parsing errors are returned rather than exiting so connections are closed cleanly.
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("admin "+name, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	return fs
+}
+
+// required reports the first flag that was left empty
+func required(fs *flag.FlagSet, values map[string]string) error {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if values[name] == "" {
+			fs.Usage()
+			return errors.New("-" + name + " is required")
+		}
+	}
+	return nil
+}
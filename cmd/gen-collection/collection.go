@@ -0,0 +1,343 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// postmanSchema identifies the Postman collection format written
+const postmanSchema = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+// maxExampleDepth stops example bodies from nesting forever on recursive definitions
+const maxExampleDepth = 6
+
+// swaggerDoc is the part of a Swagger 2.0 document the collection is built from
+type swaggerDoc struct {
+	Info struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"info"`
+	Paths       map[string]map[string]*swaggerOperation `json:"paths"`
+	Definitions map[string]*swaggerSchema               `json:"definitions"`
+}
+
+type swaggerOperation struct {
+	Summary     string                `json:"summary"`
+	Description string                `json:"description"`
+	Tags        []string              `json:"tags"`
+	Parameters  []swaggerParameter    `json:"parameters"`
+	Security    []map[string][]string `json:"security"`
+}
+
+type swaggerParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description"`
+	Required    bool           `json:"required"`
+	Type        string         `json:"type"`
+	Schema      *swaggerSchema `json:"schema"`
+}
+
+type swaggerSchema struct {
+	Ref                  string                    `json:"$ref"`
+	Type                 string                    `json:"type"`
+	Properties           map[string]*swaggerSchema `json:"properties"`
+	Items                *swaggerSchema            `json:"items"`
+	AllOf                []*swaggerSchema          `json:"allOf"`
+	AdditionalProperties interface{}               `json:"additionalProperties"` // A schema, or true for free-form objects
+	Example              interface{}               `json:"example"`
+}
+
+// Postman collection v2.1 types
+type collection struct {
+	Info     collectionInfo `json:"info"`
+	Auth     *auth          `json:"auth,omitempty"`
+	Variable []variable     `json:"variable"`
+	Item     []folder       `json:"item"`
+}
+
+type collectionInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Schema      string `json:"schema"`
+}
+
+type auth struct {
+	Type   string     `json:"type"`
+	Bearer []variable `json:"bearer,omitempty"`
+}
+
+type variable struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	Disabled    bool   `json:"disabled,omitempty"`
+}
+
+type folder struct {
+	Name string `json:"name"`
+	Item []item `json:"item"`
+}
+
+type item struct {
+	Name    string  `json:"name"`
+	Event   []event `json:"event,omitempty"`
+	Request request `json:"request"`
+}
+
+type event struct {
+	Listen string `json:"listen"`
+	Script script `json:"script"`
+}
+
+type script struct {
+	Type string   `json:"type"`
+	Exec []string `json:"exec"`
+}
+
+type request struct {
+	Method      string     `json:"method"`
+	Description string     `json:"description,omitempty"`
+	Auth        *auth      `json:"auth,omitempty"` // Nil inherits the collection's bearer token
+	Header      []variable `json:"header"`
+	Body        *body      `json:"body,omitempty"`
+	URL         requestURL `json:"url"`
+}
+
+type body struct {
+	Mode    string      `json:"mode"`
+	Raw     string      `json:"raw"`
+	Options bodyOptions `json:"options"`
+}
+
+type bodyOptions struct {
+	Raw struct {
+		Language string `json:"language"`
+	} `json:"raw"`
+}
+
+type requestURL struct {
+	Raw      string     `json:"raw"`
+	Host     []string   `json:"host"`
+	Path     []string   `json:"path"`
+	Query    []variable `json:"query,omitempty"`
+	Variable []variable `json:"variable,omitempty"`
+}
+
+// loginPath is the request whose response sets the collection's token
+const loginPath = "/api/v1/auth/login"
+
+// skippedRoutes are served by the API but make no sense as collection requests
+var skippedRoutes = map[string]bool{
+	"/swagger/*any": true,
+	"/metrics":      true,
+}
+
+// pathParam matches gin path parameters such as :id
+var pathParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// buildCollection turns the registered routes into a collection, documenting them from doc where it covers them
+// It also returns the routes doc doesn't describe, formatted as "METHOD /path".
+func buildCollection(routes gin.RoutesInfo, doc *swaggerDoc, baseURL string) (*collection, []string) {
+	folders := make(map[string][]item)
+	var undocumented []string
+
+	sorted := append(gin.RoutesInfo(nil), routes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+		return sorted[i].Method < sorted[j].Method
+	})
+
+	for _, route := range sorted {
+		if skippedRoutes[route.Path] || route.Method == "HEAD" {
+			continue
+		}
+		op := doc.operation(route.Method, route.Path)
+		if op == nil {
+			undocumented = append(undocumented, route.Method+" "+route.Path)
+		}
+		name := folderName(route.Path, op)
+		folders[name] = append(folders[name], doc.requestItem(route, op))
+	}
+
+	names := make([]string, 0, len(folders))
+	for name := range folders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	title := doc.Info.Title
+	if title == "" {
+		title = "Enterprise CRUD API"
+	}
+	c := &collection{
+		Info: collectionInfo{Name: title, Description: doc.Info.Description, Schema: postmanSchema},
+		Auth: &auth{Type: "bearer", Bearer: []variable{{Key: "token", Value: "{{token}}", Type: "string"}}},
+		Variable: []variable{
+			{Key: "baseUrl", Value: strings.TrimRight(baseURL, "/")},
+			{Key: "token", Value: "", Description: "Set by the login request"},
+		},
+	}
+	for _, name := range names {
+		c.Item = append(c.Item, folder{Name: name, Item: folders[name]})
+	}
+	return c, undocumented
+}
+
+// operation looks up the Swagger operation of a gin route, nil when it isn't documented
+func (d *swaggerDoc) operation(method, path string) *swaggerOperation {
+	ops := d.Paths[pathParam.ReplaceAllString(path, "{$1}")]
+	if ops == nil {
+		return nil
+	}
+	return ops[strings.ToLower(method)]
+}
+
+// folderName groups a route by its Swagger tag, or by the resource below /api/v1
+func folderName(path string, op *swaggerOperation) string {
+	if op != nil && len(op.Tags) > 0 {
+		return op.Tags[0]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 2 && segments[0] == "api" {
+		if segments[2] == "admin" && len(segments) > 3 {
+			return "admin"
+		}
+		return segments[2]
+	}
+	return "system"
+}
+
+// requestItem builds the collection request of a route
+func (d *swaggerDoc) requestItem(route gin.RouteInfo, op *swaggerOperation) item {
+	segments := strings.Split(strings.Trim(route.Path, "/"), "/")
+	u := requestURL{
+		Raw:  "{{baseUrl}}" + route.Path,
+		Host: []string{"{{baseUrl}}"},
+		Path: segments,
+	}
+	for _, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			u.Variable = append(u.Variable, variable{Key: strings.TrimPrefix(segment, ":")})
+		}
+	}
+
+	req := request{
+		Method: route.Method,
+		Header: []variable{{Key: "Accept", Value: "application/json"}},
+		URL:    u,
+	}
+	it := item{Name: route.Method + " " + route.Path}
+
+	if op == nil {
+		req.Description = "Not in docs/swagger.json yet; regenerate it with swag init to document this route."
+	} else {
+		if op.Summary != "" {
+			it.Name = op.Summary
+		}
+		req.Description = op.Description
+		if len(op.Security) == 0 {
+			req.Auth = &auth{Type: "noauth"}
+		}
+		for _, param := range op.Parameters {
+			switch param.In {
+			case "query":
+				// Optional filters are listed but disabled so the request works as it is
+				req.URL.Query = append(req.URL.Query, variable{Key: param.Name, Description: param.Description, Disabled: !param.Required})
+			case "path":
+				for i := range req.URL.Variable {
+					if req.URL.Variable[i].Key == param.Name {
+						req.URL.Variable[i].Description = param.Description
+					}
+				}
+			case "header":
+				req.Header = append(req.Header, variable{Key: param.Name, Description: param.Description, Disabled: !param.Required})
+			case "body":
+				raw, _ := json.MarshalIndent(d.example(param.Schema, 0), "", "  ")
+				req.Header = append(req.Header, variable{Key: "Content-Type", Value: "application/json"})
+				req.Body = &body{Mode: "raw", Raw: string(raw)}
+				req.Body.Options.Raw.Language = "json"
+			}
+		}
+		if len(req.URL.Query) > 0 {
+			var pairs []string
+			for _, q := range req.URL.Query {
+				if !q.Disabled {
+					pairs = append(pairs, q.Key+"=")
+				}
+			}
+			if len(pairs) > 0 {
+				req.URL.Raw += "?" + strings.Join(pairs, "&")
+			}
+		}
+	}
+
+	if route.Path == loginPath {
+		it.Event = []event{{Listen: "test", Script: script{Type: "text/javascript", Exec: []string{
+			"if (pm.response.code === 200) {",
+			"    pm.collectionVariables.set(\"token\", pm.response.json().token);",
+			"}",
+		}}}}
+	}
+	it.Request = req
+	return it
+}
+
+// example builds an example value of schema from the examples in the Swagger definitions
+func (d *swaggerDoc) example(schema *swaggerSchema, depth int) interface{} {
+	if schema == nil || depth > maxExampleDepth {
+		return nil
+	}
+	if schema.Ref != "" {
+		return d.example(d.Definitions[strings.TrimPrefix(schema.Ref, "#/definitions/")], depth+1)
+	}
+	if len(schema.AllOf) > 0 {
+		merged := map[string]interface{}{}
+		for _, part := range schema.AllOf {
+			if fields, ok := d.example(part, depth+1).(map[string]interface{}); ok {
+				for k, v := range fields {
+					merged[k] = v
+				}
+			}
+		}
+		return merged
+	}
+	if schema.Example != nil {
+		return schema.Example
+	}
+
+	switch schema.Type {
+	case "object", "":
+		fields := map[string]interface{}{}
+		for name, prop := range schema.Properties {
+			fields[name] = d.example(prop, depth+1)
+		}
+		return fields
+	case "array":
+		return []interface{}{d.example(schema.Items, depth+1)}
+	case "string":
+		return ""
+	case "integer", "number":
+		return 0
+	case "boolean":
+		return false
+	}
+	return nil
+}
+
+// parseSwagger reads a Swagger 2.0 document
+func parseSwagger(raw []byte) (*swaggerDoc, error) {
+	var doc swaggerDoc
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parsing swagger: %w", err)
+	}
+	return &doc, nil
+}
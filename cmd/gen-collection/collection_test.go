@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSwagger = `{
+  "info": {"title": "Test API"},
+  "paths": {
+    "/api/v1/auth/login": {"post": {"summary": "User login", "tags": ["auth"],
+      "parameters": [{"in": "body", "name": "credentials", "schema": {"$ref": "#/definitions/user.LoginRequest"}}]}},
+    "/api/v1/events/{id}": {"get": {"summary": "Get event", "tags": ["events"],
+      "parameters": [{"in": "path", "name": "id", "description": "Event ID", "required": true}]}},
+    "/api/v1/orders": {"post": {"summary": "Create order", "tags": ["orders"], "security": [{"BearerAuth": []}],
+      "parameters": [{"in": "query", "name": "dry_run", "description": "Validate only"}]}}
+  },
+  "definitions": {
+    "user.LoginRequest": {"type": "object", "properties": {
+      "email": {"type": "string", "example": "user@example.com"},
+      "password": {"type": "string"},
+      "roles": {"type": "array", "items": {"type": "string", "example": "USER"}}
+    }}
+  }
+}`
+
+func TestBuildCollection(t *testing.T) {
+	doc, err := parseSwagger([]byte(testSwagger))
+	require.NoError(t, err)
+	routes := gin.RoutesInfo{
+		{Method: "POST", Path: "/api/v1/orders"},
+		{Method: "GET", Path: "/api/v1/events/:id"},
+		{Method: "POST", Path: "/api/v1/auth/login"},
+		{Method: "DELETE", Path: "/api/v1/venues/:id"},
+		{Method: "GET", Path: "/swagger/*any"},
+		{Method: "GET", Path: "/health"},
+	}
+
+	coll, undocumented := buildCollection(routes, doc, "http://localhost:8080/")
+
+	assert.Equal(t, []string{"DELETE /api/v1/venues/:id", "GET /health"}, undocumented)
+	assert.Equal(t, "http://localhost:8080", coll.Variable[0].Value)
+	assert.Equal(t, "bearer", coll.Auth.Type)
+
+	folders := make(map[string][]item)
+	for _, f := range coll.Item {
+		folders[f.Name] = f.Item
+	}
+	require.Len(t, folders, 5, "auth, events, orders, venues and system")
+
+	login := folders["auth"][0]
+	assert.Equal(t, "User login", login.Name)
+	assert.Equal(t, "noauth", login.Request.Auth.Type)
+	require.NotNil(t, login.Request.Body)
+	var loginBody map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(login.Request.Body.Raw), &loginBody))
+	assert.Equal(t, map[string]interface{}{"email": "user@example.com", "password": "", "roles": []interface{}{"USER"}}, loginBody)
+	require.Len(t, login.Event, 1, "login stores the token")
+
+	getEvent := folders["events"][0].Request
+	assert.Equal(t, "{{baseUrl}}/api/v1/events/:id", getEvent.URL.Raw)
+	assert.Equal(t, []variable{{Key: "id", Description: "Event ID"}}, getEvent.URL.Variable)
+
+	createOrder := folders["orders"][0].Request
+	assert.Nil(t, createOrder.Auth, "inherits the collection's bearer token")
+	assert.Equal(t, []variable{{Key: "dry_run", Description: "Validate only", Disabled: true}}, createOrder.URL.Query)
+
+	deleteVenue := folders["venues"][0]
+	assert.Equal(t, "DELETE /api/v1/venues/:id", deleteVenue.Name)
+	assert.Nil(t, deleteVenue.Request.Auth)
+	assert.NotEmpty(t, deleteVenue.Request.Description)
+
+	assert.Equal(t, "GET /health", folders["system"][0].Name)
+}
+
+// TestCollectionUpToDate runs the -check mode against the committed collection
+func TestCollectionUpToDate(t *testing.T) {
+	out, _, _, err := render("../../docs/swagger.json", "http://localhost:8080")
+	require.NoError(t, err)
+	current, err := os.ReadFile("../../docs/postman_collection.json")
+	require.NoError(t, err)
+
+	assert.True(t, bytes.Equal(current, out), "docs/postman_collection.json is out of date; run go run ./cmd/gen-collection")
+}
@@ -15,82 +15,107 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/auth/login": {
-            "post": {
-                "description": "Authenticate user with email and password, returns JWT token",
-                "consumes": [
-                    "application/json"
+        "/api/v1/admin/analytics/daily": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Get tickets sold, revenue, active events and new users per UTC day (requires ADMIN role)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "User login",
+                "summary": "Get platform trends",
                 "parameters": [
                     {
-                        "description": "User login credentials",
-                        "name": "credentials",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user.LoginRequest"
-                        }
+                        "type": "string",
+                        "description": "Start of the period (RFC 3339 or YYYY-MM-DD, inclusive), defaults to 30 days ago",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period (RFC 3339 or YYYY-MM-DD, exclusive), defaults to now",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.LoginResponse"
+                            "$ref": "#/definitions/analytics.DailySnapshotsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request data",
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
+                            "$ref": "#/definitions/analytics.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Invalid credentials",
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
+                            "$ref": "#/definitions/analytics.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/analytics.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
+                            "$ref": "#/definitions/analytics.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/events": {
+        "/api/v1/admin/ip-rules": {
             "get": {
-                "description": "Get list of all events",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "List the address ranges allowed or denied access to admin routes (requires ADMIN role). Ranges from configuration are not listed.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "admin"
                 ],
-                "summary": "Get all events",
+                "summary": "List admin IP rules",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/event.EventListResponse"
+                            "$ref": "#/definitions/ipaccess.RuleListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
                         }
                     }
                 }
@@ -101,7 +126,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new event (requires ORGANIZER or ADMIN role)",
+                "description": "Allow or deny a CIDR range or single address access to admin routes (requires ADMIN role).\nDeny rules win; once any allow rule exists only matching addresses get through. Changes that would block your own address are rejected.",
                 "consumes": [
                     "application/json"
                 ],
@@ -109,17 +134,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "admin"
                 ],
-                "summary": "Create a new event",
+                "summary": "Add admin IP rule",
                 "parameters": [
                     {
-                        "description": "Event data",
-                        "name": "event",
+                        "description": "Rule",
+                        "name": "rule",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/event.CreateEventRequest"
+                            "$ref": "#/definitions/ipaccess.CreateRuleRequest"
                         }
                     }
                 ],
@@ -127,220 +152,254 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/event.EventResponse"
+                            "$ref": "#/definitions/ipaccess.RuleResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/events/my-events": {
-            "get": {
+        "/api/v1/admin/ip-rules/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get events created by the current organizer",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Remove an admin IP rule (requires ADMIN role). Changes that would block your own address are rejected.",
                 "tags": [
-                    "events"
+                    "admin"
+                ],
+                "summary": "Delete admin IP rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get my events",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/event.EventListResponse"
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/ipaccess.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/events/{id}": {
+        "/api/v1/admin/jobs": {
             "get": {
-                "description": "Get event details by ID",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "List background jobs with the given status, most recently updated first (requires ADMIN role)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "admin"
                 ],
-                "summary": "Get event by ID",
+                "summary": "List jobs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": "FAILED",
+                        "description": "Job status (PENDING, RUNNING, SUCCEEDED, FAILED)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum number of jobs (1-500)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/event.EventResponse"
+                            "$ref": "#/definitions/job.JobListResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/v1/admin/jobs/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing event (only by organizer)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get background job details including the last error (requires ADMIN role)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "admin"
                 ],
-                "summary": "Update event",
+                "summary": "Get job by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID",
+                        "description": "Job ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Event data",
-                        "name": "event",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/event.UpdateEventRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/event.EventResponse"
+                            "$ref": "#/definitions/job.JobResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/admin/jobs/{id}/retry": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an event (only by organizer, only if no tickets sold)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Move a failed (dead-lettered) job back to the queue with a fresh set of attempts (requires ADMIN role)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "admin"
                 ],
-                "summary": "Delete event",
+                "summary": "Retry failed job",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID",
+                        "description": "Job ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -350,117 +409,91 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/event.SuccessResponse"
+                            "$ref": "#/definitions/job.JobResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/job.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/events/{id}/cancel": {
-            "patch": {
+        "/api/v1/admin/maintenance": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cancel an event (only by organizer)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Report maintenance mode, what put it in force, the admin switch and the scheduled window (requires ADMIN role).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
-                ],
-                "summary": "Cancel event",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Event ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "admin"
                 ],
+                "summary": "Get maintenance mode",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/event.SuccessResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/maintenance.AdminStatusResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/event.ErrorResponse"
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/orders": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new order (requires USER role)",
+                "description": "Turn maintenance mode on or off for every instance (requires ADMIN role). Maintenance forced on in configuration stays on.",
                 "consumes": [
                     "application/json"
                 ],
@@ -468,62 +501,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "admin"
                 ],
-                "summary": "Create a new order",
+                "summary": "Switch maintenance mode",
                 "parameters": [
                     {
-                        "description": "Order data",
-                        "name": "order",
+                        "description": "Switch",
+                        "name": "mode",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/order.CreateOrderRequest"
+                            "$ref": "#/definitions/maintenance.SetModeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/order.OrderResponse"
+                            "$ref": "#/definitions/maintenance.AdminStatusResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/order.ErrorResponse"
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/order.ErrorResponse"
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/order.ErrorResponse"
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/order.ErrorResponse"
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/orders/my-orders": {
-            "get": {
+        "/api/v1/admin/maintenance/window": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all orders for the current user",
+                "description": "Schedule a period during which writes answer 503, replacing the window scheduled before (requires ADMIN role). It is listed by GET /api/v1/maintenance until it ends.",
                 "consumes": [
                     "application/json"
                 ],
@@ -531,69 +564,129 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "admin"
+                ],
+                "summary": "Schedule maintenance window",
+                "parameters": [
+                    {
+                        "description": "Window",
+                        "name": "window",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.ScheduleWindowRequest"
+                        }
+                    }
                 ],
-                "summary": "Get my orders",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/order.OrderListResponse"
+                            "$ref": "#/definitions/maintenance.AdminStatusResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/order.ErrorResponse"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/order.ErrorResponse"
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the scheduled maintenance window, ending it early if it is in progress (requires ADMIN role).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Cancel maintenance window",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.AdminStatusResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/orders/{id}": {
+        "/api/v1/admin/orders/review": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get order details by ID (user can only see their own orders)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List orders held for manual review, oldest first, with the reasons they were held (requires ADMIN role)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "admin"
                 ],
-                "summary": "Get order by ID",
+                "summary": "List orders awaiting review",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Maximum number of orders (default 100, max 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/order.OrderResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/order.ErrorResponse"
+                            "$ref": "#/definitions/order.ReviewQueueResponse"
                         }
                     },
                     "401": {
@@ -608,12 +701,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/order.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/order.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -623,9 +710,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/users": {
+        "/api/v1/admin/orders/{id}/review": {
             "post": {
-                "description": "Create a new user with email, username and password",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approve a held order, releasing it as PENDING, or reject it, failing it and returning its tickets (requires ADMIN role)",
                 "consumes": [
                     "application/json"
                 ],
@@ -633,167 +725,173 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Create a new user",
+                "summary": "Decide on an order awaiting review",
                 "parameters": [
                     {
-                        "description": "User creation request",
-                        "name": "user",
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision",
+                        "name": "decision",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/user.CreateUserRequest"
+                            "$ref": "#/definitions/order.ReviewOrderRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "User created successfully",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.UserResponse"
+                            "$ref": "#/definitions/order.ReviewOrderResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request data",
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
                         }
                     },
                     "409": {
-                        "description": "User already exists",
+                        "description": "Conflict",
                         "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
+                            "$ref": "#/definitions/order.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
+                            "$ref": "#/definitions/order.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/users/profile": {
+        "/api/v1/admin/plans": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the profile of the currently authenticated user with their roles",
+                "description": "Get all subscription plans and their limits (requires ADMIN role)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Get current user profile",
+                "summary": "Get all plans",
                 "responses": {
                     "200": {
-                        "description": "User profile retrieved successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.UserResponse"
+                            "$ref": "#/definitions/plan.PlanListResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
+                            "$ref": "#/definitions/plan.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/plan.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
+                            "$ref": "#/definitions/plan.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/users/{email}": {
+        "/api/v1/admin/policies": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get user details by email address",
+                "description": "List every published version of a policy, newest first (requires ADMIN role)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Get user by email",
+                "summary": "List policy versions",
                 "parameters": [
                     {
+                        "enum": [
+                            "TERMS",
+                            "PRIVACY"
+                        ],
                         "type": "string",
-                        "description": "User email",
-                        "name": "email",
-                        "in": "path",
+                        "description": "Policy type",
+                        "name": "type",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User found",
-                        "schema": {
-                            "$ref": "#/definitions/user.UserResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
+                            "$ref": "#/definitions/consent.VersionListResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - insufficient permissions",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
+                            "$ref": "#/definitions/consent.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
+                            "$ref": "#/definitions/consent.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/user.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/venues": {
-            "get": {
-                "description": "Get list of all venues",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "venues"
-                ],
-                "summary": "Get all venues",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/venue.VenueListResponse"
+                            "$ref": "#/definitions/consent.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/consent.ErrorResponse"
                         }
                     }
                 }
@@ -804,7 +902,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new venue (requires ORGANIZER or ADMIN role)",
+                "description": "Publish a new terms of service or privacy policy version (requires ADMIN role).\nUsers are asked to accept it on their next login; purchases are blocked until the current terms are accepted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -812,17 +910,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "venues"
+                    "admin"
                 ],
-                "summary": "Create a new venue",
+                "summary": "Publish policy version",
                 "parameters": [
                     {
-                        "description": "Venue data",
-                        "name": "venue",
+                        "description": "Policy version",
+                        "name": "version",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/venue.CreateVenueRequest"
+                            "$ref": "#/definitions/consent.PublishVersionRequest"
                         }
                     }
                 ],
@@ -830,39 +928,50 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/venue.VenueResponse"
+                            "$ref": "#/definitions/consent.VersionResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/consent.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/consent.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/consent.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/consent.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/consent.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/venues/{id}": {
-            "get": {
-                "description": "Get venue details by ID",
+        "/api/v1/admin/users/{id}/ban": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Block a user permanently (requires ADMIN role). They get 403 with the reason at login and on every authenticated request.",
                 "consumes": [
                     "application/json"
                 ],
@@ -870,52 +979,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "venues"
+                    "admin"
                 ],
-                "summary": "Get venue by ID",
+                "summary": "Ban user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Venue ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.AccountStatusRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/venue.VenueResponse"
+                            "$ref": "#/definitions/user.AccountStatusResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid request or missing reason",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/user.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/user.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/user.ErrorResponse"
                         }
-                    }
-                }
-            },
-            "put": {
-                "security": [
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/users/{id}/plan": {
+            "put": {
+                "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing venue (requires ORGANIZER or ADMIN role)",
+                "description": "Assign a subscription plan to a user (requires ADMIN role)",
                 "consumes": [
                     "application/json"
                 ],
@@ -923,24 +1055,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "venues"
+                    "admin"
                 ],
-                "summary": "Update venue",
+                "summary": "Assign plan to user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Venue ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Venue data",
-                        "name": "venue",
+                        "description": "Plan to assign",
+                        "name": "plan",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/venue.UpdateVenueRequest"
+                            "$ref": "#/definitions/plan.AssignPlanRequest"
                         }
                     }
                 ],
@@ -948,48 +1080,50 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/venue.VenueResponse"
+                            "$ref": "#/definitions/plan.UserPlanResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/plan.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/plan.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/plan.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/plan.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/plan.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/admin/users/{id}/reactivate": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a venue (requires ADMIN role)",
+                "description": "Lift a suspension or ban (requires ADMIN role). The reason is optional.",
                 "consumes": [
                     "application/json"
                 ],
@@ -997,267 +1131,9679 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "venues"
+                    "admin"
                 ],
-                "summary": "Delete venue",
+                "summary": "Reactivate user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Venue ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Reason",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/user.AccountStatusRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/venue.SuccessResponse"
+                            "$ref": "#/definitions/user.AccountStatusResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid request",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/user.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/user.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/user.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "User not found",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/user.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/venue.ErrorResponse"
+                            "$ref": "#/definitions/user.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "event.CreateEventRequest": {
-            "type": "object",
-            "required": [
-                "event_date",
-                "ticket_price",
-                "title",
-                "total_tickets",
-                "venue_id"
-            ],
-            "properties": {
-                "description": {
-                    "type": "string",
-                    "example": "An amazing summer concert with live music"
-                },
-                "event_date": {
-                    "type": "string",
-                    "example": "2024-08-15T20:00:00Z"
-                },
-                "ticket_price": {
-                    "type": "number",
-                    "minimum": 0,
-                    "example": 50
-                },
-                "title": {
-                    "type": "string",
-                    "example": "Summer Concert"
-                },
-                "total_tickets": {
-                    "type": "integer",
-                    "minimum": 1,
-                    "example": 100
-                },
-                "venue_id": {
-                    "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
-                }
-            }
         },
-        "event.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "validation_error"
-                },
-                "message": {
-                    "type": "string",
-                    "example": "Invalid input data"
+        "/api/v1/admin/users/{id}/status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a user's account status with every change and its reason, newest first (requires ADMIN role)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get account status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.AccountStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "event.EventListResponse": {
-            "type": "object",
-            "properties": {
-                "count": {
-                    "type": "integer"
-                },
-                "events": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/event.EventResponse"
+        "/api/v1/admin/users/{id}/suspend": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Block a user until reactivated (requires ADMIN role). They get 403 with the reason at login and on every authenticated request.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Suspend user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.AccountStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.AccountStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "event.EventResponse": {
-            "type": "object",
-            "properties": {
-                "available_tickets": {
-                    "type": "integer",
-                    "example": 75
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "An amazing summer concert with live music"
-                },
-                "event_date": {
-                    "type": "string",
-                    "example": "2024-08-15T20:00:00Z"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
-                },
+        "/api/v1/analytics/sales": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the current organizer's completed orders and event page views per UTC day, read from the daily snapshots. Today is only included once its snapshot is taken.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "Get sales trend",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start of the period (RFC 3339 or YYYY-MM-DD, inclusive), defaults to 30 days ago",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period (RFC 3339 or YYYY-MM-DD, exclusive), defaults to now",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/analytics.SalesTrendResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/analytics.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/analytics.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/analytics.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/analytics.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/login": {
+            "post": {
+                "description": "Authenticate user with email and password, returns JWT token.\npending_policies lists policy versions published since the user last accepted them; log in with accept_terms to accept them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "User login credentials",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful",
+                        "schema": {
+                            "$ref": "#/definitions/user.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Account suspended or banned",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/dev/emails": {
+            "get": {
+                "description": "List the email templates and the locales they can be rendered in (development only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "development"
+                ],
+                "summary": "List email templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/emailpreview.TemplatesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/dev/emails/{name}": {
+            "get": {
+                "description": "Render an email template with made-up data, as HTML or plain text (development only). The subject is returned in the X-Email-Subject header, MIME-encoded when it isn't ASCII.",
+                "produces": [
+                    "text/html",
+                    "text/plain"
+                ],
+                "tags": [
+                    "development"
+                ],
+                "summary": "Preview an email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale, e.g. de or de-AT (default: email.default_locale)",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "html or text (default: html)",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rendered email",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/emailpreview.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/emailpreview.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/emailpreview.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/event-transfers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the events other organizers offered to the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "List my pending event transfers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.TransferListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/event-transfers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a transfer with its history (by either organizer or an admin)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Get an event transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.TransferDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/event-transfers/{id}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Take over an event another organizer offered to the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Accept an event transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/event-transfers/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Withdraw a pending transfer (only by the organizer handing the event over or an admin)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Cancel an event transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/event-transfers/{id}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Decline an event another organizer offered to the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Decline an event transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events": {
+            "get": {
+                "description": "List active upcoming events, soonest first.\nOrganizers and admins may pass status and include_past; organizers then only see their own events.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event status (ACTIVE, CANCELLED, COMPLETED); organizers and admins only",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include events that already took place; organizers and admins only",
+                        "name": "include_past",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Moderation status (APPROVED, FLAGGED); organizers and admins only",
+                        "name": "moderation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only events whose tickets are on sale",
+                        "name": "on_sale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Non-public listing requested without a valid token",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Non-public listing requested by a user who is neither organizer nor admin",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new event (requires ORGANIZER or ADMIN role)\nA request identical to one the same user sent moments earlier answers 409 DUPLICATE_REQUEST.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Create a new event",
+                "parameters": [
+                    {
+                        "description": "Event data",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.CreateEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/feed.ics": {
+            "get": {
+                "description": "Subscribe to upcoming active events in a calendar app, optionally filtered by venue or organizer",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Upcoming events calendar feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only events at this venue",
+                        "name": "venue_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events by this organizer",
+                        "name": "organizer_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "iCalendar document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/feed.rss": {
+            "get": {
+                "description": "Follow upcoming active events in a feed reader, optionally filtered by venue or organizer",
+                "produces": [
+                    "application/rss+xml"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Upcoming events RSS feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only events at this venue",
+                        "name": "venue_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events by this organizer",
+                        "name": "organizer_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "RSS 2.0 document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/my-events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a page of the current organizer's events, soonest first, optionally filtered by status and date.\nFollow links.next, or pass meta.next_cursor as cursor, for the next page.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get my events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event status (ACTIVE, CANCELLED, COMPLETED)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events at or after this time (RFC 3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events before this time (RFC 3339 or YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Deprecated, use cursor: number of events to skip; ignored with cursor",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/recommended": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get upcoming events at venues and by organizers the current user bought tickets for before, best match first.\nEvents the user already ordered and sold out events are left out; when too few events match, the soonest upcoming events fill the list.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get recommended events",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of events (default 10, at most 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.RecommendedEventsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/slug/{slug}": {
+            "get": {
+                "description": "Get event details by slug. Slugs an event had before its title changed redirect to its current slug.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get event by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventResponse"
+                        }
+                    },
+                    "301": {
+                        "description": "Moved to the event's current slug"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/trending": {
+            "get": {
+                "description": "List active upcoming events by their recent page views and placed orders, highest score first.\nEach view and order counts half as much after every trending.half_life.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get trending events",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of events (default 10, at most 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.TrendingEventsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}": {
+            "get": {
+                "description": "Get event details by ID. Events flagged by moderation are only shown to their organizer and admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get event by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing event (only by its organizer or a co-organizer)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Update event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Event data",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.UpdateEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an event (only by organizer, only if no tickets sold)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Delete event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/access-codes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the access codes of an event with their usage (organizer, co-organizer or admin)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "access-codes"
+                ],
+                "summary": "List access codes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.AccessCodeListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a single-use or multi-use access code to an event; orders for events requiring a code must redeem one (organizer, co-organizer or admin)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "access-codes"
+                ],
+                "summary": "Create an access code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Access code",
+                        "name": "access_code",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.CreateAccessCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.AccessCodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/access-codes/{codeId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove an access code; orders already placed with it are kept (organizer, co-organizer or admin)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "access-codes"
+                ],
+                "summary": "Delete an access code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Access code ID",
+                        "name": "codeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/access-codes/{codeId}/redemptions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the orders placed with an access code, oldest first (organizer, co-organizer or admin)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "access-codes"
+                ],
+                "summary": "List access code redemptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Access code ID",
+                        "name": "codeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.RedemptionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/accesscode.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/attendees/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the completed orders of an event as CSV, with buyer, notes and one column per attendee question (organizer, admin or staff)",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "Export event attendees",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/cancel": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancel an event (only by its organizer or a co-organizer)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Cancel event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/check-ins": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark a completed order as checked in (by the organizer, a co-organizer or CHECK_IN staff)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "Check in a ticket holder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Order to check in",
+                        "name": "check_in",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order.CheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/forecast": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Project when an event sells out by fitting a line through its tickets sold per hour (event organizer or ADMIN only).\nNo sellout is projected with fewer than three hours of sales or when the event comes first. Forecasts are recomputed from cached sales figures, refreshed every reports.forecast_cache_ttl.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get sellout forecast",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/report.ForecastResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/inventory-adjustments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the inventory adjustments of an event, newest first (only for its organizer, a co-organizer or an admin)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List event inventory adjustments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.InventoryAdjustmentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add tickets to an event, or remove unsold ones with a negative delta (e.g. a production hold), and record why (only by its organizer, a co-organizer or an admin). The new total must fit the venue and the organizer's plan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Adjust event inventory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tickets added or removed, and why",
+                        "name": "adjustment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.InventoryAdjustmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/event.InventoryAdjustmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/moderation/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Publish an event whose title or description moderation flagged (requires ADMIN role). Flagged events are listed at GET /api/v1/events?moderation=FLAGGED.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Approve flagged event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/share": {
+            "get": {
+                "description": "Get Open Graph style metadata and a short link for sharing an event",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get event share metadata",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/share.ShareResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/share.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/share.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/share.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/short-url": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the short URL of an event and how often it was followed (by the organizer or assigned staff).\nEvents published before short URLs existed get one on first request.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get event short URL statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/shorturl.ShortURLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shorturl.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shorturl.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/shorturl.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shorturl.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shorturl.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/staff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the staff and pending invitations of an event (only by organizer)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "List event staff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/staff.StaffListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Invite a user by email to check in attendees (CHECK_IN), view statistics (VIEWER) or help run the event (CO_ORGANIZER) (only by organizer)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "Invite event staff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Invitation",
+                        "name": "invitation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/staff.InviteStaffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/staff.StaffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/staff/{userId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke a user's staff role or pending invitation on an event (only by organizer)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "Remove event staff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/staff.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/transfer": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Offer an event to another organizer by email; the event changes hands once they accept (only by organizer or admin)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Transfer an event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New organizer",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transfer.TransferEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{id}/transfers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the transfers of an event, newest first (only by organizer or admin)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "List event transfers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.TransferListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/maintenance": {
+            "get": {
+                "description": "Report whether maintenance mode is in force, and the current or upcoming maintenance window. While it is, writes answer 503 with Retry-After; reads keep working.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Get maintenance status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.StatusResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/messages/unread": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Count the messages the current user hasn't read, in total and per order thread",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Count unread order messages",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.UnreadCountsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/meta/error-codes": {
+            "get": {
+                "description": "List every error code the event, order, user and venue endpoints can answer with in the error (or code) field,\nwith the HTTP status it usually comes with and what it means. Codes are stable; messages are not, so program against codes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meta"
+                ],
+                "summary": "List error codes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/meta.ErrorCodeListResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new order (requires USER role). Answers must match the event's attendee questions.\nAnswers 403 CONSENT_REQUIRED until the user has accepted the current terms of service.\nRisky checkouts answer 403 CAPTCHA_REQUIRED; repeat them with the CAPTCHA token in the X-Captcha-Token header.\nOrders before an event's sale_start answer 400 SALES_NOT_STARTED, from its sale_end on 400 SALES_ENDED.\nEvents that require an access code answer 403 ACCESS_CODE_REQUIRED without one and 403 ACCESS_CODE_INVALID for unknown or used-up codes.\nAge-restricted events answer 403 DATE_OF_BIRTH_REQUIRED until the buyer adds a date of birth to their profile and 403 AGE_RESTRICTED for buyers under the minimum age.\nOrders flagged by the fraud checks are created with status REVIEW until an admin decides, or refused with 403 ORDER_REJECTED.\nA user may only have a few checkouts running at once; a request finding no free slot within a short wait answers 429 TOO_MANY_CONCURRENT_REQUESTS with Retry-After.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Create a new order",
+                "parameters": [
+                    {
+                        "description": "Order data",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order.CreateOrderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "CAPTCHA token, when challenged",
+                        "name": "X-Captcha-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/order.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/gifts/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Claim the tickets of a gift with the token from its claim link; the order moves to the current user's account and both sides are notified.\nUnknown tokens and tokens replaced by a resent link answer 404 GIFT_NOT_FOUND, gifts claimed before 409 GIFT_ALREADY_CLAIMED,\nand buyers claiming their own gift 400 CANNOT_CLAIM_OWN_GIFT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Claim a gift",
+                "parameters": [
+                    {
+                        "description": "Claim token",
+                        "name": "claim",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order.ClaimGiftRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/groups": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reserve tickets for several members at once (requires USER role). Each share becomes a pending order with its own payment link,\nreturned only in this response for the leader to pass on; shares not paid by hold_until are released back to the event.\nThe checks of creating an order apply to the reservation as a whole, with the same error codes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Reserve tickets for a group",
+                "parameters": [
+                    {
+                        "description": "Group reservation",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order.CreateGroupRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "CAPTCHA token, when challenged",
+                        "name": "X-Captcha-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/order.GroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/groups/shares/take": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Take a share of a group reservation with the token from its payment link; the pending order moves to the current user,\nwho pays it before hold_until to get the tickets. Each link works once.\nUnknown or used links answer 404 GROUP_SHARE_NOT_FOUND, shares another member took or paid 409 GROUP_SHARE_TAKEN,\nand shares whose hold ended 410 GROUP_HOLD_EXPIRED.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Take a group share",
+                "parameters": [
+                    {
+                        "description": "Payment link token",
+                        "name": "share",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order.TakeGroupShareRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/groups/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a group reservation with the status of each share and who holds it (leader only). Payment links are not shown again.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get a group reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.GroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/my-orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all orders for the current user, newest first, including read-only orders of archived events",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get my orders",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.OrderListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get order details by ID (user can only see their own orders)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get order by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}/gift/resend": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Email the recipient of a paid, unclaimed gift a new claim link; earlier links stop working (buyer only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Resend a gift claim link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/order.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}/invoice": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the invoice of a completed order as print-ready HTML (own orders only, unless admin).\nThe first request assigns a sequential invoice number and queues generation, answering 202 with Retry-After; poll until 200.",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get order invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/invoice.PendingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/invoice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/invoice.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/invoice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/invoice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/invoice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/invoice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}/messages": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the messages between the buyer and the event organizer of an order, oldest first. Messages to the current user are marked read; the response still shows them unread.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Get order messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ThreadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Write to the other side of an order: the buyer to the event organizer, or the organizer to the buyer. The recipient is notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Post an order message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Message",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/messaging.PostMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/messaging.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}/refund-requests": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ask the organizer for a refund (REFUND) or contest the charge (DISPUTE) on a completed order.\nRequests can be opened until refunds.request_window after the event, or any time if it was cancelled.\nRefunds follow the event's refund_policy: NONE fails with 409 REFUNDS_NOT_OFFERED and past its deadline with 409 REFUND_DEADLINE_PASSED.\nAn order has at most one request that wasn't denied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "refunds"
+                ],
+                "summary": "Request a refund",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Kind and reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/refund.OpenRefundRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/refund.RefundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}/status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the status of an order and when it last changed (user can only see their own orders).\nResponses carry an ETag; send it back in If-None-Match to get 304 Not Modified while the order is unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Poll order status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the last response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.OrderStatusResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "Order unchanged"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/order.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/organizers/me/branding": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the brand colors and logo shown on the current organizer's event pages and invoices (requires ORGANIZER or ADMIN role).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "branding"
+                ],
+                "summary": "Get my branding",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/branding.BrandingResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the current organizer's brand colors (requires ORGANIZER or ADMIN role). Colors are #rrggbb or #rgb; an empty color restores the default. Invoices already issued keep the colors they were issued with.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "branding"
+                ],
+                "summary": "Set brand colors",
+                "parameters": [
+                    {
+                        "description": "Brand colors",
+                        "name": "colors",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/branding.SetColorsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/branding.BrandingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/organizers/me/branding/logo": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the current organizer's logo with the PNG, JPEG or GIF image in the request body (requires ORGANIZER or ADMIN role). Logos are at most 512 KiB and between 32 and 1024 pixels wide and high.",
+                "consumes": [
+                    "image/png",
+                    "image/jpeg",
+                    "image/gif"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "branding"
+                ],
+                "summary": "Upload logo",
+                "parameters": [
+                    {
+                        "description": "Logo image",
+                        "name": "logo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/branding.BrandingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the current organizer's logo (requires ORGANIZER or ADMIN role). Invoices already issued keep the logo they were issued with.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "branding"
+                ],
+                "summary": "Delete logo",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/branding.BrandingResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/organizers/{id}/branding/logo": {
+            "get": {
+                "description": "Serve an organizer's logo image. Link to it with the logo_url of event and branding responses, which changes with every upload.",
+                "produces": [
+                    "image/png",
+                    "image/jpeg",
+                    "image/gif"
+                ],
+                "tags": [
+                    "branding"
+                ],
+                "summary": "Get organizer logo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organizer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Logo",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/branding.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/organizers/{id}/events": {
+            "get": {
+                "description": "Get a page of an organizer's active events that have not taken place yet, soonest first. No authentication is needed;\nunknown organizers have no events. Follow links.next, or pass meta.next_cursor as cursor, for the next page.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List an organizer's upcoming events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organizer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/policies": {
+            "get": {
+                "description": "Get the current terms of service and privacy policy versions, e.g. to show them at registration",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "consents"
+                ],
+                "summary": "Get current policies",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/consent.VersionListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/consent.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/refund-requests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the refund requests and disputes on the current organizer's events, oldest first (admins see every event)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "refunds"
+                ],
+                "summary": "List refund requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request status (OPEN, APPROVED, REFUNDED, REFUND_FAILED, DENIED)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only requests on this event",
+                        "name": "event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of requests to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/refund.RefundListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/refund-requests/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a refund request or dispute with every change made to it (buyer, event organizer or admin)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "refunds"
+                ],
+                "summary": "Get a refund request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Refund request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/refund.RefundDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/refund-requests/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approve a refund request or dispute with a reason and refund the buyer through the payment provider (only by organizer).\nThe amount defaults to the refundable amount the event's refund policy allowed when the request was opened, and only admins may refund more.\nCancelled events must be refunded in full. Approving a REFUND_FAILED request retries the refund.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "refunds"
+                ],
+                "summary": "Approve a refund request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Refund request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Amount and reason",
+                        "name": "decision",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/refund.ApproveRefundRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/refund.RefundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/refund-requests/{id}/deny": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deny an open refund request or dispute with a reason (only by organizer). Requests on cancelled events can't be denied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "refunds"
+                ],
+                "summary": "Deny a refund request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Refund request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason",
+                        "name": "decision",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/refund.DenyRefundRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/refund.RefundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/refund.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reports/sales": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get completed order totals per event for the current organizer, the same data sent in report emails",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get sales summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start of the period (RFC 3339 or YYYY-MM-DD, inclusive), defaults to 7 days ago",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period (RFC 3339 or YYYY-MM-DD, exclusive), defaults to now",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/report.SalesSummaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reports/schedule": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the sales summary email schedule of the current organizer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get report schedule",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/report.ScheduleResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Enable or disable DAILY or WEEKLY sales summary emails for the current organizer",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Update report schedule",
+                "parameters": [
+                    {
+                        "description": "Report schedule",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/report.UpdateScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/report.ScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/report.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/staff/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the staff invitations the current user has not accepted yet",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "List my staff invitations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/staff.StaffListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/staff/invitations/{id}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Accept a staff invitation, granting its role on the event",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "staff"
+                ],
+                "summary": "Accept a staff invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invitation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/staff.StaffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/staff.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users": {
+            "post": {
+                "description": "Create a new user with email, username and password.\nOnce policies are published, accept_terms must be set to accept the current versions.\nA gift_token from a gift claim link moves the gift to the new account; a gift that can't be claimed doesn't fail the registration.\nRisky registrations answer 403 CAPTCHA_REQUIRED; repeat them with the CAPTCHA token in the X-Captcha-Token header.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User creation request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.CreateUserRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "CAPTCHA token, when challenged",
+                        "name": "X-Captcha-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/user.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or terms not accepted",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "CAPTCHA required or failed",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email or username already taken",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/check-username": {
+            "get": {
+                "description": "Report whether a username can still be registered, for validating sign-up forms as the user types",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Check username availability",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username to check (at least 3 characters)",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Availability of the username",
+                        "schema": {
+                            "$ref": "#/definitions/user.UsernameAvailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing or too short username",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/devices": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Register an FCM (ANDROID) or APNs (IOS) device token so the current user receives push notifications on it; registering a token again moves it to the current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Register device",
+                "parameters": [
+                    {
+                        "description": "Device",
+                        "name": "device",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notification.RegisterDeviceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/notification.DeviceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/devices/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop sending push notifications to a device of the current user, e.g. on logout",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Unregister device",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Device ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Device unregistered"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Schedule the current user's account for deletion after a grace period, during which it can be cancelled.\nDeletion erases the profile, notes, answers, notifications, devices and preferences; orders are kept anonymized for accounting.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete account",
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/account.DeletionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/consents": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the policy versions the current user accepted and the current versions still to accept",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "consents"
+                ],
+                "summary": "Get my consents",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/consent.ConsentsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/consent.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/consent.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Accept policy versions, e.g. the pending versions listed by GET /api/v1/users/me/consents",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "consents"
+                ],
+                "summary": "Accept policies",
+                "parameters": [
+                    {
+                        "description": "Versions to accept",
+                        "name": "consents",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/consent.AcceptRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/consent.ConsentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/consent.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/consent.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/consent.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/consent.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/deletion/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancel a scheduled account deletion during its grace period",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Cancel account deletion",
+                "responses": {
+                    "204": {
+                        "description": "Deletion cancelled"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/export": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queue a ZIP archive of everything stored about the current user: profile, orders, tickets, notifications, devices, staff roles and organized events.\nPoll the returned download URL until it answers 200. Repeated requests within an hour return the pending export.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Export personal data",
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/account.ExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/exports/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download a personal data export as a ZIP archive. Answers 202 with Retry-After while the export is being built.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Download personal data export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ZIP archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/account.ExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/account.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List a page of the current user's in-app notifications, newest first, with the unread count",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "List notifications",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only unread notifications",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Page size (1-100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notification.NotificationListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/notifications/preferences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get whether each notification type is delivered by email, in-app and push for the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Get notification preferences",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notification.PreferencesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Choose email, in-app and push delivery per notification type (ORDER_CONFIRMED, EVENT_CHANGED, WAITLIST_PROMOTED, ORDER_MESSAGE, GIFT); types and channels left out are unchanged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Update notification preferences",
+                "parameters": [
+                    {
+                        "description": "Notification preferences",
+                        "name": "preferences",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notification.UpdatePreferencesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notification.PreferencesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/notifications/read-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark every unread notification of the current user as read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark all notifications read",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notification.MarkAllReadResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/notifications/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark one of the current user's notifications as read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark notification read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Notification marked as read"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/phone": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the verified phone number that receives critical alerts by SMS; empty when none is set",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Get phone number",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notification.PhoneResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Text a six-digit code to a phone number in international format; the number is saved once the code is confirmed. A new code can be requested once a minute.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Add phone number",
+                "parameters": [
+                    {
+                        "description": "Phone number",
+                        "name": "phone",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notification.StartPhoneVerificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/notification.PhoneVerificationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the current user's phone number, which stops SMS alerts",
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Remove phone number",
+                "responses": {
+                    "204": {
+                        "description": "Phone number removed"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/phone/verify": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Confirm the pending phone number with the code texted to it. After five wrong codes a new one must be requested.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Verify phone number",
+                "parameters": [
+                    {
+                        "description": "Verification code",
+                        "name": "code",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notification.VerifyPhoneRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notification.PhoneResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/notification.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the profile of the currently authenticated user with their roles",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get current user profile",
+                "responses": {
+                    "200": {
+                        "description": "User profile retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/user.UserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/profile/date-of-birth": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the date of birth on the current user's profile, null when not given",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get date of birth",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.DateOfBirthResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the date of birth on the current user's profile, or remove it with null.\nIt is optional, but needed to order tickets for events with a minimum age.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Set date of birth",
+                "parameters": [
+                    {
+                        "description": "Date of birth",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.DateOfBirthRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.DateOfBirthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or date of birth",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the endpoints the current user subscribed to their orders' status changes, oldest first. Secrets are not included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.SubscriptionListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Have order.completed and order.refunded events of the current user's own orders POSTed as JSON to an https endpoint of their system; leave out events to receive both. The response carries the secret deliveries are signed with, which is not shown again. Every delivery has the headers X-Webhook-Id (the same on retries, to drop duplicates), X-Webhook-Event, X-Webhook-Timestamp (Unix seconds) and X-Webhook-Signature: \"sha256=\" and the hex HMAC-SHA256, keyed with the secret, of the timestamp, a dot and the raw body. To verify a delivery, compute that HMAC over the body exactly as received, compare it to the signature in constant time and refuse timestamps more than 5 minutes from your clock. Answer 2xx to acknowledge; timeouts, 408, 429 and 5xx are retried with backoff, other answers drop the delivery.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Subscribe a webhook",
+                "parameters": [
+                    {
+                        "description": "Endpoint and events",
+                        "name": "subscription",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhook.CreateSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.CreatedSubscriptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/webhooks/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop delivering to an endpoint of the current user; deliveries still being retried are dropped",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Webhook deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{email}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get user details by email address",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User email",
+                        "name": "email",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User found",
+                        "schema": {
+                            "$ref": "#/definitions/user.UserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/user.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/venues": {
+            "get": {
+                "description": "Get list of all venues",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Get all venues",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/venue.VenueListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new venue (requires ORGANIZER or ADMIN role)\nA request identical to one the same user sent moments earlier answers 409 DUPLICATE_REQUEST.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Create a new venue",
+                "parameters": [
+                    {
+                        "description": "Venue data",
+                        "name": "venue",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/venue.CreateVenueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/venue.VenueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/venues/{id}": {
+            "get": {
+                "description": "Get venue details by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Get venue by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/venue.VenueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing venue (requires the venue owner or ADMIN role)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Update venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Venue data",
+                        "name": "venue",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/venue.UpdateVenueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/venue.VenueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a venue (requires the venue owner or ADMIN role)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Delete venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/venue.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/venues/{id}/transfer": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Hand a venue to another active organizer or admin (requires the venue owner or ADMIN role)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Transfer venue ownership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New owner",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/venue.TransferVenueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/venue.VenueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/venue.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/debug/dumps": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Write profiles to diagnostics.dump_dir on the instance that serves the request, so they outlive it being restarted (requires ADMIN role on the API port). Goroutine dumps are text with every stack; the others are pprof files. Without a body goroutines and heap are captured.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diagnostics"
+                ],
+                "summary": "Capture profile dumps",
+                "parameters": [
+                    {
+                        "description": "Profiles to capture",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/diagnostics.CaptureRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/diagnostics.CaptureResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/diagnostics.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/diagnostics.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/diagnostics.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/diagnostics.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/debug/pprof/{profile}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "pprof index and profiles, e.g. /debug/pprof/heap, /debug/pprof/goroutine?debug=2 or /debug/pprof/profile?seconds=10 for ` + "`" + `go tool pprof` + "`" + ` (requires ADMIN role on the API port). CPU profiles and traces can't outlast server.write_timeout there; serve diagnostics on diagnostics.addr for longer ones.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "diagnostics"
+                ],
+                "summary": "Runtime profiles",
+                "responses": {
+                    "200": {
+                        "description": "Profile",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/diagnostics.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/diagnostics.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/e/{code}": {
+            "get": {
+                "description": "Redirect to the page of the event the short code belongs to",
+                "tags": [
+                    "events"
+                ],
+                "summary": "Follow an event short URL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Short code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the event page"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shorturl.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shorturl.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/s/{code}": {
+            "get": {
+                "description": "Redirect to the event page a short link was created for",
+                "tags": [
+                    "events"
+                ],
+                "summary": "Follow a short link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Short link code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the event page"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/share.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/share.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sitemap.xml": {
+            "get": {
+                "description": "Sitemap listing the pages of upcoming active events",
+                "produces": [
+                    "application/xml"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Sitemap",
+                "responses": {
+                    "200": {
+                        "description": "Sitemap document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/event.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "accesscode.AccessCodeListResponse": {
+            "type": "object",
+            "properties": {
+                "access_codes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/accesscode.AccessCodeResponse"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "accesscode.AccessCodeResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "VIP-PRESALE"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "max_uses": {
+                    "description": "0 for no limit",
+                    "type": "integer",
+                    "example": 1
+                },
+                "remaining_uses": {
+                    "description": "Omitted for codes without a limit",
+                    "type": "integer",
+                    "example": 1
+                },
+                "uses": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "accesscode.CreateAccessCodeRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Omit to generate one",
+                    "type": "string",
+                    "maxLength": 32,
+                    "example": "VIP-PRESALE"
+                },
+                "max_uses": {
+                    "description": "1 for a single-use code, 0 for no limit",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                }
+            }
+        },
+        "accesscode.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "accesscode.RedemptionListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "redemptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/accesscode.RedemptionResponse"
+                    }
+                }
+            }
+        },
+        "accesscode.RedemptionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "accesscode.SuccessResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "account.DeletionResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Your account will be deleted on the scheduled date unless you cancel the request"
+                },
+                "scheduled_for": {
+                    "type": "string"
+                }
+            }
+        },
+        "account.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "account.ExportResponse": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "download_url": {
+                    "type": "string",
+                    "example": "/api/v1/users/me/exports/6f1c2b8e-2f44-4c8e-9b53-0d3f6c1e7a10"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "PENDING"
+                }
+            }
+        },
+        "analytics.DailySnapshotResponse": {
+            "type": "object",
+            "properties": {
+                "active_events": {
+                    "type": "integer"
+                },
+                "day": {
+                    "type": "string",
+                    "example": "2026-10-15"
+                },
+                "new_users": {
+                    "type": "integer"
+                },
+                "orders": {
+                    "type": "integer"
+                },
+                "revenue": {
+                    "type": "number"
+                },
+                "taken_at": {
+                    "type": "string"
+                },
+                "tickets_sold": {
+                    "type": "integer"
+                }
+            }
+        },
+        "analytics.DailySnapshotsResponse": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "description": "Oldest first; days without a snapshot are missing",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/analytics.DailySnapshotResponse"
+                    }
+                }
+            }
+        },
+        "analytics.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "analytics.SalesDayResponse": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "type": "string",
+                    "example": "2026-10-15"
+                },
+                "orders": {
+                    "type": "integer"
+                },
+                "revenue": {
+                    "type": "number"
+                },
+                "tickets_sold": {
+                    "type": "integer"
+                },
+                "unique_visitors": {
+                    "description": "Estimated per event and added up over the organizer's events",
+                    "type": "integer"
+                },
+                "views": {
+                    "type": "integer"
+                }
+            }
+        },
+        "analytics.SalesTrendResponse": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "description": "Oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/analytics.SalesDayResponse"
+                    }
+                },
+                "total_orders": {
+                    "type": "integer"
+                },
+                "total_revenue": {
+                    "type": "number"
+                },
+                "total_tickets": {
+                    "type": "integer"
+                },
+                "total_views": {
+                    "type": "integer"
+                }
+            }
+        },
+        "branding.BrandingResponse": {
+            "type": "object",
+            "properties": {
+                "accent_color": {
+                    "description": "Empty for the default",
+                    "type": "string",
+                    "example": "#ff8800"
+                },
+                "logo_height": {
+                    "description": "Pixels",
+                    "type": "integer",
+                    "example": 80
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://tickets.example.com/api/v1/organizers/550e8400-e29b-41d4-a716-446655440000/branding/logo?v=20261017T093000.000000000Z"
+                },
+                "logo_width": {
+                    "description": "Pixels",
+                    "type": "integer",
+                    "example": 240
+                },
+                "organizer_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "primary_color": {
+                    "description": "Empty for the default",
+                    "type": "string",
+                    "example": "#1a2b3c"
+                },
+                "updated_at": {
+                    "description": "Omitted until branding is first set",
+                    "type": "string",
+                    "example": "2026-10-17T09:30:00Z"
+                }
+            }
+        },
+        "branding.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "branding.SetColorsRequest": {
+            "type": "object",
+            "properties": {
+                "accent_color": {
+                    "description": "#rrggbb or #rgb; empty restores the default",
+                    "type": "string",
+                    "maxLength": 7,
+                    "example": "#ff8800"
+                },
+                "primary_color": {
+                    "description": "#rrggbb or #rgb; empty restores the default",
+                    "type": "string",
+                    "maxLength": 7,
+                    "example": "#1a2b3c"
+                }
+            }
+        },
+        "common.ListLinks": {
+            "type": "object",
+            "properties": {
+                "next": {
+                    "description": "Omitted on the last page",
+                    "type": "string",
+                    "example": "/api/v1/events/my-events?cursor=eyJvIjoyMH0\u0026limit=20"
+                },
+                "self": {
+                    "type": "string",
+                    "example": "/api/v1/events/my-events?limit=20"
+                }
+            }
+        },
+        "common.ListMeta": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Items on this page",
+                    "type": "integer",
+                    "example": 20
+                },
+                "next_cursor": {
+                    "description": "Omitted on the last page",
+                    "type": "string",
+                    "example": "eyJvIjoyMH0"
+                },
+                "total": {
+                    "description": "Items matching the filters, on lists that count them",
+                    "type": "integer",
+                    "example": 120
+                },
+                "warnings": {
+                    "description": "Deprecated features the request used, with their removal date",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "consent.AcceptRequest": {
+            "type": "object",
+            "required": [
+                "version_ids"
+            ],
+            "properties": {
+                "version_ids": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "consent.AcceptanceResponse": {
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "TERMS"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/legal/terms-2026-05"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "2026-05"
+                }
+            }
+        },
+        "consent.ConsentsResponse": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/consent.AcceptanceResponse"
+                    }
+                },
+                "pending": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/consent.VersionResponse"
+                    }
+                }
+            }
+        },
+        "consent.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "consent.PublishVersionRequest": {
+            "type": "object",
+            "required": [
+                "type",
+                "url",
+                "version"
+            ],
+            "properties": {
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "TERMS",
+                        "PRIVACY"
+                    ],
+                    "example": "TERMS"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://example.com/legal/terms-2026-05"
+                },
+                "version": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "2026-05"
+                }
+            }
+        },
+        "consent.VersionListResponse": {
+            "type": "object",
+            "properties": {
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/consent.VersionResponse"
+                    }
+                }
+            }
+        },
+        "consent.VersionResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "TERMS"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/legal/terms-2026-05"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "2026-05"
+                }
+            }
+        },
+        "diagnostics.CaptureRequest": {
+            "type": "object",
+            "properties": {
+                "profiles": {
+                    "description": "Empty captures goroutines and heap",
+                    "type": "array",
+                    "maxItems": 6,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "goroutine",
+                        "heap"
+                    ]
+                }
+            }
+        },
+        "diagnostics.CaptureResponse": {
+            "type": "object",
+            "properties": {
+                "dumps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/diagnostics.DumpResponse"
+                    }
+                },
+                "host": {
+                    "description": "Instance holding the files",
+                    "type": "string",
+                    "example": "api-7f9c6d-2x4kq"
+                }
+            }
+        },
+        "diagnostics.DumpResponse": {
+            "type": "object",
+            "properties": {
+                "bytes": {
+                    "type": "integer",
+                    "example": 48213
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/tmp/enterprise-crud-dumps/goroutine-20261017T093000.000Z-1234567890.txt"
+                },
+                "profile": {
+                    "type": "string",
+                    "example": "goroutine"
+                }
+            }
+        },
+        "diagnostics.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "emailpreview.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "emailpreview.TemplatesResponse": {
+            "type": "object",
+            "properties": {
+                "locales": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "de",
+                        "en"
+                    ]
+                },
+                "templates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "event_update",
+                        "gift_claim",
+                        "notification",
+                        "order_confirmation",
+                        "password_reset",
+                        "sales_summary"
+                    ]
+                }
+            }
+        },
+        "enterprise-crud_internal_dto_event.PriceTier": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "Omit to start when the event is created",
+                    "type": "string",
+                    "example": "2024-06-01T10:00:00Z"
+                },
+                "name": {
+                    "description": "Defaults to \"Tier n\"",
+                    "type": "string",
+                    "example": "Early bird"
+                },
+                "price": {
+                    "description": "Price per ticket while the tier is active",
+                    "type": "number",
+                    "example": 35
+                },
+                "to": {
+                    "description": "Exclusive; omit to run until the event",
+                    "type": "string",
+                    "example": "2024-07-01T00:00:00Z"
+                }
+            }
+        },
+        "enterprise-crud_internal_dto_event.RefundPolicy": {
+            "type": "object",
+            "properties": {
+                "days_before": {
+                    "description": "Full refunds until this many days before the event; DAYS_BEFORE only",
+                    "type": "integer",
+                    "example": 7
+                },
+                "tiers": {
+                    "description": "SLIDING only",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/enterprise-crud_internal_dto_event.RefundTier"
+                    }
+                },
+                "type": {
+                    "description": "FLEXIBLE (default), NONE, DAYS_BEFORE or SLIDING",
+                    "type": "string",
+                    "example": "SLIDING"
+                }
+            }
+        },
+        "enterprise-crud_internal_dto_event.RefundTier": {
+            "type": "object",
+            "properties": {
+                "days_before": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 30
+                },
+                "percent": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1,
+                    "example": 50
+                }
+            }
+        },
+        "event.AttendeeQuestion": {
+            "type": "object",
+            "required": [
+                "key",
+                "label",
+                "type"
+            ],
+            "properties": {
+                "key": {
+                    "type": "string",
+                    "example": "tshirt_size"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "T-shirt size"
+                },
+                "max_length": {
+                    "description": "Longest text answer (default: 200)",
+                    "type": "integer",
+                    "example": 200
+                },
+                "options": {
+                    "description": "Allowed answers of choice questions",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "S",
+                        "M",
+                        "L",
+                        "XL"
+                    ]
+                },
+                "required": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "text",
+                        "number",
+                        "choice",
+                        "boolean"
+                    ],
+                    "example": "choice"
+                }
+            }
+        },
+        "event.CreateEventRequest": {
+            "type": "object",
+            "required": [
+                "event_date",
+                "ticket_price",
+                "title",
+                "total_tickets",
+                "venue_id"
+            ],
+            "properties": {
+                "access_code_required": {
+                    "description": "Only buyers with one of the event's access codes may order",
+                    "type": "boolean",
+                    "example": false
+                },
+                "attendee_questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.AttendeeQuestion"
+                    }
+                },
+                "content_warnings": {
+                    "description": "Shown to buyers before they order",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "strobe lights",
+                        "loud music"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "example": "An amazing summer concert with live music"
+                },
+                "event_date": {
+                    "type": "string",
+                    "example": "2024-08-15T20:00:00Z"
+                },
+                "layout": {
+                    "description": "Venue layout to hold the event in; tickets must fit its capacity",
+                    "type": "string",
+                    "example": "Seated"
+                },
+                "minimum_age": {
+                    "description": "Age buyers must have reached; 0 for no limit",
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 0,
+                    "example": 18
+                },
+                "price_schedule": {
+                    "description": "Tiers may not overlap; ticket_price applies outside them",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/enterprise-crud_internal_dto_event.PriceTier"
+                    }
+                },
+                "refund_policy": {
+                    "description": "Omit for a FLEXIBLE policy",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enterprise-crud_internal_dto_event.RefundPolicy"
+                        }
+                    ]
+                },
+                "sale_end": {
+                    "description": "Omit to sell until the event is over",
+                    "type": "string",
+                    "example": "2024-08-15T18:00:00Z"
+                },
+                "sale_start": {
+                    "description": "Omit to sell right away",
+                    "type": "string",
+                    "example": "2024-06-01T10:00:00Z"
+                },
+                "ticket_price": {
+                    "type": "number",
+                    "example": 50
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Summer Concert"
+                },
+                "total_tickets": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "venue_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "event.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Error code, listed by GET /api/v1/meta/error-codes",
+                    "type": "string",
+                    "example": "EVENT_NOT_FOUND"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "event with ID 9b2d4c1e-5f6a-4b7c-8d9e-0f1a2b3c4d5e not found"
+                }
+            }
+        },
+        "event.EventListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.EventResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/common.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/common.ListMeta"
+                }
+            }
+        },
+        "event.EventResponse": {
+            "type": "object",
+            "properties": {
+                "access_code_required": {
+                    "description": "Orders need one of the event's access codes",
+                    "type": "boolean",
+                    "example": false
+                },
+                "attendee_questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.AttendeeQuestion"
+                    }
+                },
+                "available_tickets": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "branding": {
+                    "description": "Omitted when the organizer has no branding",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/event.OrganizerBranding"
+                        }
+                    ]
+                },
+                "content_warnings": {
+                    "description": "Empty when there are none",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "strobe lights",
+                        "loud music"
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "current_price": {
+                    "description": "Price per ticket of an order placed now",
+                    "type": "number",
+                    "example": 35
+                },
+                "current_tier": {
+                    "description": "Omitted when no tier is active",
+                    "type": "string",
+                    "example": "Early bird"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "An amazing summer concert with live music"
+                },
+                "event_date": {
+                    "type": "string",
+                    "example": "2024-08-15T20:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "layout": {
+                    "description": "Omitted for events using the whole venue",
+                    "type": "string",
+                    "example": "Seated"
+                },
+                "minimum_age": {
+                    "description": "Age buyers must have reached; 0 when there is no limit",
+                    "type": "integer",
+                    "example": 18
+                },
+                "moderation_reason": {
+                    "description": "Why the event was flagged",
+                    "type": "string",
+                    "example": "contains review term \"rave\""
+                },
+                "moderation_status": {
+                    "description": "FLAGGED events are hidden from public listings until an admin approves them",
+                    "type": "string",
+                    "example": "APPROVED"
+                },
+                "on_sale": {
+                    "description": "Whether the sale window is open, refreshed periodically",
+                    "type": "boolean",
+                    "example": true
+                },
+                "organizer_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "price_schedule": {
+                    "description": "Empty when the event has a single price",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/enterprise-crud_internal_dto_event.PriceTier"
+                    }
+                },
+                "refund_policy": {
+                    "$ref": "#/definitions/enterprise-crud_internal_dto_event.RefundPolicy"
+                },
+                "sale_end": {
+                    "description": "Omitted when sales run until the event",
+                    "type": "string",
+                    "example": "2024-08-15T18:00:00Z"
+                },
+                "sale_start": {
+                    "description": "Omitted when sales opened with the event",
+                    "type": "string",
+                    "example": "2024-06-01T10:00:00Z"
+                },
+                "short_url": {
+                    "description": "Omitted when the event has no short URL",
+                    "type": "string",
+                    "example": "https://tickets.example.com/e/aZ3kP9q"
+                },
+                "slug": {
+                    "description": "Changes with the title; old slugs redirect to the current one",
+                    "type": "string",
+                    "example": "summer-concert-k3x9qa"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ACTIVE"
+                },
+                "ticket_price": {
+                    "description": "Price outside every tier of the price schedule",
+                    "type": "number",
+                    "example": 50
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Summer Concert"
+                },
+                "total_tickets": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "venue_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "event.InventoryAdjustmentListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.InventoryAdjustmentResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/common.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/common.ListMeta"
+                }
+            }
+        },
+        "event.InventoryAdjustmentRequest": {
+            "type": "object",
+            "required": [
+                "delta",
+                "reason"
+            ],
+            "properties": {
+                "delta": {
+                    "description": "Tickets added, or removed when negative; only unsold tickets can be removed",
+                    "type": "integer",
+                    "example": -20
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Production hold for camera platform"
+                }
+            }
+        },
+        "event.InventoryAdjustmentResponse": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "description": "Omitted once the user who made it is deleted",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "available_tickets": {
+                    "description": "Unsold tickets of the event after the adjustment",
+                    "type": "integer",
+                    "example": 55
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "delta": {
+                    "type": "integer",
+                    "example": -20
+                },
+                "event_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Production hold for camera platform"
+                },
+                "total_tickets": {
+                    "description": "Total tickets of the event after the adjustment",
+                    "type": "integer",
+                    "example": 130
+                }
+            }
+        },
+        "event.OrganizerBranding": {
+            "type": "object",
+            "properties": {
+                "accent_color": {
+                    "type": "string",
+                    "example": "#ff8800"
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://tickets.example.com/api/v1/organizers/550e8400-e29b-41d4-a716-446655440000/branding/logo?v=20261017T093000.000000000Z"
+                },
+                "primary_color": {
+                    "type": "string",
+                    "example": "#1a2b3c"
+                }
+            }
+        },
+        "event.RecommendedEventResponse": {
+            "type": "object",
+            "properties": {
+                "access_code_required": {
+                    "description": "Orders need one of the event's access codes",
+                    "type": "boolean",
+                    "example": false
+                },
+                "attendee_questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.AttendeeQuestion"
+                    }
+                },
+                "available_tickets": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "branding": {
+                    "description": "Omitted when the organizer has no branding",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/event.OrganizerBranding"
+                        }
+                    ]
+                },
+                "content_warnings": {
+                    "description": "Empty when there are none",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "strobe lights",
+                        "loud music"
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "current_price": {
+                    "description": "Price per ticket of an order placed now",
+                    "type": "number",
+                    "example": 35
+                },
+                "current_tier": {
+                    "description": "Omitted when no tier is active",
+                    "type": "string",
+                    "example": "Early bird"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "An amazing summer concert with live music"
+                },
+                "event_date": {
+                    "type": "string",
+                    "example": "2024-08-15T20:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "layout": {
+                    "description": "Omitted for events using the whole venue",
+                    "type": "string",
+                    "example": "Seated"
+                },
+                "minimum_age": {
+                    "description": "Age buyers must have reached; 0 when there is no limit",
+                    "type": "integer",
+                    "example": 18
+                },
+                "moderation_reason": {
+                    "description": "Why the event was flagged",
+                    "type": "string",
+                    "example": "contains review term \"rave\""
+                },
+                "moderation_status": {
+                    "description": "FLAGGED events are hidden from public listings until an admin approves them",
+                    "type": "string",
+                    "example": "APPROVED"
+                },
+                "on_sale": {
+                    "description": "Whether the sale window is open, refreshed periodically",
+                    "type": "boolean",
+                    "example": true
+                },
+                "organizer_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "price_schedule": {
+                    "description": "Empty when the event has a single price",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/enterprise-crud_internal_dto_event.PriceTier"
+                    }
+                },
+                "reasons": {
+                    "description": "SAME_VENUE, SAME_ORGANIZER, SELLING_FAST or UPCOMING",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "SAME_VENUE",
+                        "SELLING_FAST"
+                    ]
+                },
+                "refund_policy": {
+                    "$ref": "#/definitions/enterprise-crud_internal_dto_event.RefundPolicy"
+                },
+                "sale_end": {
+                    "description": "Omitted when sales run until the event",
+                    "type": "string",
+                    "example": "2024-08-15T18:00:00Z"
+                },
+                "sale_start": {
+                    "description": "Omitted when sales opened with the event",
+                    "type": "string",
+                    "example": "2024-06-01T10:00:00Z"
+                },
+                "score": {
+                    "description": "Higher is a better match; 0 for events picked only because they are soon",
+                    "type": "number",
+                    "example": 7
+                },
+                "short_url": {
+                    "description": "Omitted when the event has no short URL",
+                    "type": "string",
+                    "example": "https://tickets.example.com/e/aZ3kP9q"
+                },
+                "slug": {
+                    "description": "Changes with the title; old slugs redirect to the current one",
+                    "type": "string",
+                    "example": "summer-concert-k3x9qa"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ACTIVE"
+                },
+                "ticket_price": {
+                    "description": "Price outside every tier of the price schedule",
+                    "type": "number",
+                    "example": 50
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Summer Concert"
+                },
+                "total_tickets": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "venue_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "event.RecommendedEventsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.RecommendedEventResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/common.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/common.ListMeta"
+                }
+            }
+        },
+        "event.SuccessResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Event created successfully"
+                }
+            }
+        },
+        "event.TrendingEventResponse": {
+            "type": "object",
+            "properties": {
+                "access_code_required": {
+                    "description": "Orders need one of the event's access codes",
+                    "type": "boolean",
+                    "example": false
+                },
+                "attendee_questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.AttendeeQuestion"
+                    }
+                },
+                "available_tickets": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "branding": {
+                    "description": "Omitted when the organizer has no branding",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/event.OrganizerBranding"
+                        }
+                    ]
+                },
+                "content_warnings": {
+                    "description": "Empty when there are none",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "strobe lights",
+                        "loud music"
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "current_price": {
+                    "description": "Price per ticket of an order placed now",
+                    "type": "number",
+                    "example": 35
+                },
+                "current_tier": {
+                    "description": "Omitted when no tier is active",
+                    "type": "string",
+                    "example": "Early bird"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "An amazing summer concert with live music"
+                },
+                "event_date": {
+                    "type": "string",
+                    "example": "2024-08-15T20:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "layout": {
+                    "description": "Omitted for events using the whole venue",
+                    "type": "string",
+                    "example": "Seated"
+                },
+                "minimum_age": {
+                    "description": "Age buyers must have reached; 0 when there is no limit",
+                    "type": "integer",
+                    "example": 18
+                },
+                "moderation_reason": {
+                    "description": "Why the event was flagged",
+                    "type": "string",
+                    "example": "contains review term \"rave\""
+                },
+                "moderation_status": {
+                    "description": "FLAGGED events are hidden from public listings until an admin approves them",
+                    "type": "string",
+                    "example": "APPROVED"
+                },
+                "on_sale": {
+                    "description": "Whether the sale window is open, refreshed periodically",
+                    "type": "boolean",
+                    "example": true
+                },
                 "organizer_id": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "price_schedule": {
+                    "description": "Empty when the event has a single price",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/enterprise-crud_internal_dto_event.PriceTier"
+                    }
+                },
+                "purchases": {
+                    "description": "Orders placed, decayed like the score",
+                    "type": "number",
+                    "example": 1.2
+                },
+                "refund_policy": {
+                    "$ref": "#/definitions/enterprise-crud_internal_dto_event.RefundPolicy"
+                },
+                "sale_end": {
+                    "description": "Omitted when sales run until the event",
+                    "type": "string",
+                    "example": "2024-08-15T18:00:00Z"
+                },
+                "sale_start": {
+                    "description": "Omitted when sales opened with the event",
+                    "type": "string",
+                    "example": "2024-06-01T10:00:00Z"
+                },
+                "score": {
+                    "description": "Weighted views and purchases, halving every half-life",
+                    "type": "number",
+                    "example": 42.5
+                },
+                "short_url": {
+                    "description": "Omitted when the event has no short URL",
+                    "type": "string",
+                    "example": "https://tickets.example.com/e/aZ3kP9q"
+                },
+                "slug": {
+                    "description": "Changes with the title; old slugs redirect to the current one",
+                    "type": "string",
+                    "example": "summer-concert-k3x9qa"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ACTIVE"
+                },
+                "ticket_price": {
+                    "description": "Price outside every tier of the price schedule",
+                    "type": "number",
+                    "example": 50
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Summer Concert"
+                },
+                "total_tickets": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "venue_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "views": {
+                    "description": "Page views, decayed like the score",
+                    "type": "number",
+                    "example": 30.2
+                }
+            }
+        },
+        "event.TrendingEventsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.TrendingEventResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/common.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/common.ListMeta"
+                }
+            }
+        },
+        "event.UpdateEventRequest": {
+            "type": "object",
+            "required": [
+                "event_date",
+                "ticket_price",
+                "title",
+                "total_tickets",
+                "venue_id"
+            ],
+            "properties": {
+                "access_code_required": {
+                    "description": "Omit to keep the current setting",
+                    "type": "boolean",
+                    "example": true
+                },
+                "attendee_questions": {
+                    "description": "Omit to keep the current questions",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.AttendeeQuestion"
+                    }
+                },
+                "content_warnings": {
+                    "description": "Omit to keep the current warnings",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "strobe lights",
+                        "loud music"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "example": "An amazing summer concert with live music - Updated"
+                },
+                "event_date": {
+                    "type": "string",
+                    "example": "2024-08-15T20:00:00Z"
+                },
+                "layout": {
+                    "description": "Omit to keep the current layout, \"\" for the whole venue",
+                    "type": "string",
+                    "example": "Seated"
+                },
+                "minimum_age": {
+                    "description": "Omit to keep the current limit, 0 to remove it",
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 0,
+                    "example": 18
+                },
+                "price_schedule": {
+                    "description": "Omit to keep the current tiers, [] to remove them",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/enterprise-crud_internal_dto_event.PriceTier"
+                    }
+                },
+                "refund_policy": {
+                    "description": "Omit to keep the current policy; applies to requests opened afterwards",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enterprise-crud_internal_dto_event.RefundPolicy"
+                        }
+                    ]
+                },
+                "sale_end": {
+                    "description": "Omit to keep the current end",
+                    "type": "string",
+                    "example": "2024-08-15T18:00:00Z"
+                },
+                "sale_start": {
+                    "description": "Omit to keep the current start",
+                    "type": "string",
+                    "example": "2024-06-01T10:00:00Z"
+                },
+                "ticket_price": {
+                    "type": "number",
+                    "example": 60
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Summer Concert - Updated"
+                },
+                "total_tickets": {
+                    "type": "integer",
+                    "example": 150
+                },
+                "venue_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "invoice.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "invoice.PendingResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Invoice is being generated, retry shortly"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "PENDING"
+                }
+            }
+        },
+        "ipaccess.CreateRuleRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "cidr"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "ALLOW",
+                        "DENY"
+                    ],
+                    "example": "ALLOW"
+                },
+                "cidr": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "203.0.113.0/24"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Office network"
+                }
+            }
+        },
+        "ipaccess.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "ipaccess.RuleListResponse": {
+            "type": "object",
+            "properties": {
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ipaccess.RuleResponse"
+                    }
+                }
+            }
+        },
+        "ipaccess.RuleResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "ALLOW"
+                },
+                "cidr": {
+                    "type": "string",
+                    "example": "203.0.113.0/24"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Office network"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "job.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "job.JobListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/job.JobResponse"
+                    }
+                }
+            }
+        },
+        "job.JobResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "max_attempts": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "run_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "maintenance.AdminStatusResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "ends_at": {
+                    "description": "Only while a window is active",
+                    "type": "string",
+                    "example": "2026-11-01T03:00:00Z"
+                },
+                "message": {
+                    "description": "Only while active",
+                    "type": "string",
+                    "example": "We are upgrading the database and will be back shortly."
+                },
+                "source": {
+                    "description": "config, switch or window; only while active",
+                    "type": "string",
+                    "example": "switch"
+                },
+                "switch_enabled": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                },
+                "window": {
+                    "description": "The current or upcoming window",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/maintenance.WindowResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "maintenance.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "maintenance.ScheduleWindowRequest": {
+            "type": "object",
+            "required": [
+                "ends_at",
+                "starts_at"
+            ],
+            "properties": {
+                "ends_at": {
+                    "type": "string",
+                    "example": "2026-11-01T03:00:00Z"
+                },
+                "message": {
+                    "description": "Empty uses the configured message",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Scheduled database upgrade"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2026-11-01T02:00:00Z"
+                }
+            }
+        },
+        "maintenance.SetModeRequest": {
+            "type": "object",
+            "required": [
+                "enabled"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "message": {
+                    "description": "Empty uses the configured message",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "We are upgrading the database and will be back shortly."
+                }
+            }
+        },
+        "maintenance.StatusResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "ends_at": {
+                    "description": "Only while a window is active",
+                    "type": "string",
+                    "example": "2026-11-01T03:00:00Z"
+                },
+                "message": {
+                    "description": "Only while active",
+                    "type": "string",
+                    "example": "We are upgrading the database and will be back shortly."
+                },
+                "window": {
+                    "description": "The current or upcoming window",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/maintenance.WindowResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "maintenance.WindowResponse": {
+            "type": "object",
+            "properties": {
+                "ends_at": {
+                    "type": "string",
+                    "example": "2026-11-01T03:00:00Z"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Scheduled database upgrade"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2026-11-01T02:00:00Z"
+                }
+            }
+        },
+        "messaging.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "messaging.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "recipient_id": {
+                    "type": "string"
+                },
+                "sender_id": {
+                    "type": "string"
+                },
+                "sender_role": {
+                    "type": "string",
+                    "example": "BUYER"
+                }
+            }
+        },
+        "messaging.PostMessageRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "Is there step-free access to the venue?"
+                }
+            }
+        },
+        "messaging.ThreadResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "messages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/messaging.MessageResponse"
+                    }
+                }
+            }
+        },
+        "messaging.UnreadCountsResponse": {
+            "type": "object",
+            "properties": {
+                "threads": {
+                    "description": "Most recent first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/messaging.UnreadThreadResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "messaging.UnreadThreadResponse": {
+            "type": "object",
+            "properties": {
+                "order_id": {
+                    "type": "string"
+                },
+                "unread": {
+                    "type": "integer"
+                }
+            }
+        },
+        "meta.ErrorCodeListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/meta.ErrorCodeResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/common.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/common.ListMeta"
+                }
+            }
+        },
+        "meta.ErrorCodeResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "EVENT_NOT_FOUND"
+                },
+                "description": {
+                    "description": "What the code means; the error message has the details",
+                    "type": "string",
+                    "example": "event not found"
+                },
+                "domain": {
+                    "description": "event, order, user or venue",
+                    "type": "string",
+                    "example": "event"
+                },
+                "http_status": {
+                    "description": "Status the API usually answers the code with",
+                    "type": "integer",
+                    "example": 404
+                },
+                "kind": {
+                    "description": "Class of failure, e.g. INVALID, FORBIDDEN or CONFLICT",
+                    "type": "string",
+                    "example": "NOT_FOUND"
+                }
+            }
+        },
+        "notification.DeviceResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string",
+                    "example": "IOS"
+                }
+            }
+        },
+        "notification.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "notification.MarkAllReadResponse": {
+            "type": "object",
+            "properties": {
+                "marked": {
+                    "type": "integer"
+                }
+            }
+        },
+        "notification.NotificationListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/notification.NotificationResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/common.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/common.ListMeta"
+                },
+                "unread_count": {
+                    "description": "Across all pages",
+                    "type": "integer"
+                }
+            }
+        },
+        "notification.NotificationResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "read": {
+                    "type": "boolean"
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "ORDER_CONFIRMED"
+                }
+            }
+        },
+        "notification.PhoneResponse": {
+            "type": "object",
+            "properties": {
+                "phone": {
+                    "type": "string",
+                    "example": "+14155550123"
+                },
+                "verified_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "notification.PhoneVerificationResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+14155550123"
+                }
+            }
+        },
+        "notification.PreferenceRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "email": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "in_app": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "push": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "type": {
+                    "type": "string",
+                    "example": "EVENT_CHANGED"
+                }
+            }
+        },
+        "notification.PreferenceResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "boolean"
+                },
+                "in_app": {
+                    "type": "boolean"
+                },
+                "push": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "EVENT_CHANGED"
+                }
+            }
+        },
+        "notification.PreferencesResponse": {
+            "type": "object",
+            "properties": {
+                "preferences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/notification.PreferenceResponse"
+                    }
+                }
+            }
+        },
+        "notification.RegisterDeviceRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "token"
+            ],
+            "properties": {
+                "platform": {
+                    "type": "string",
+                    "example": "IOS"
+                },
+                "token": {
+                    "type": "string",
+                    "maxLength": 512
+                }
+            }
+        },
+        "notification.StartPhoneVerificationRequest": {
+            "type": "object",
+            "required": [
+                "phone"
+            ],
+            "properties": {
+                "phone": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "example": "+14155550123"
+                }
+            }
+        },
+        "notification.UpdatePreferencesRequest": {
+            "type": "object",
+            "required": [
+                "preferences"
+            ],
+            "properties": {
+                "preferences": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/notification.PreferenceRequest"
+                    }
+                }
+            }
+        },
+        "notification.VerifyPhoneRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "123456"
+                }
+            }
+        },
+        "order.CheckInRequest": {
+            "type": "object",
+            "required": [
+                "order_id"
+            ],
+            "properties": {
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "order.ClaimGiftRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "order.CreateGroupRequest": {
+            "type": "object",
+            "required": [
+                "event_id",
+                "shares"
+            ],
+            "properties": {
+                "access_code": {
+                    "type": "string",
+                    "example": "VIP-PRESALE"
+                },
+                "answers": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "shares": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/order.GroupShareRequest"
+                    }
+                }
+            }
+        },
+        "order.CreateOrderRequest": {
+            "type": "object",
+            "required": [
+                "event_id",
+                "quantity"
+            ],
+            "properties": {
+                "access_code": {
+                    "description": "Needed for events that require an access code",
+                    "type": "string",
+                    "example": "VIP-PRESALE"
+                },
+                "answers": {
+                    "description": "Answers to the event's attendee questions by key",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "gift_recipient": {
+                    "description": "Buys the tickets for someone else: once paid, a claim link is emailed to this address",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "friend@example.com"
+                },
+                "notes": {
+                    "description": "Optional note to the organizer",
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "order.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Error code, listed by GET /api/v1/meta/error-codes",
+                    "type": "string",
+                    "example": "INSUFFICIENT_TICKETS"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Insufficient tickets: requested 4, available 2"
+                }
+            }
+        },
+        "order.GiftResponse": {
+            "type": "object",
+            "properties": {
+                "claimed_at": {
+                    "type": "string"
+                },
+                "gifted_by": {
+                    "type": "string"
+                },
+                "recipient": {
+                    "type": "string",
+                    "example": "friend@example.com"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "CLAIMED"
+                }
+            }
+        },
+        "order.GroupResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "hold_until": {
+                    "description": "Shares not paid by then are released",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "shares": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.GroupShareResponse"
+                    }
+                },
+                "total_amount": {
+                    "type": "number"
+                }
+            }
+        },
+        "order.GroupShareRequest": {
+            "type": "object",
+            "required": [
+                "quantity"
+            ],
+            "properties": {
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "order.GroupShareResponse": {
+            "type": "object",
+            "properties": {
+                "holder_id": {
+                    "description": "The leader until the share is taken",
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "payment_url": {
+                    "description": "Only returned when the group is created",
+                    "type": "string",
+                    "example": "https://tickets.example.com/groups/pay?token=3q2-7w"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "PENDING"
+                },
+                "taken": {
+                    "description": "A member took the share with its payment link",
+                    "type": "boolean"
+                },
+                "total_amount": {
+                    "type": "number"
+                }
+            }
+        },
+        "order.OrderListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.OrderResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/common.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/common.ListMeta"
+                }
+            }
+        },
+        "order.OrderResponse": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "archived": {
+                    "description": "The event is archived; the order is read-only history",
+                    "type": "boolean"
+                },
+                "checked_in_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "gift": {
+                    "description": "Set on orders bought for someone else",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/order.GiftResponse"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "Set on the shares of a group reservation; unpaid shares are released at hold_until",
+                    "type": "string"
+                },
+                "hold_until": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invoice_number": {
+                    "description": "Set once the invoice was requested",
+                    "type": "string",
+                    "example": "INV-000042"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total_amount": {
+                    "type": "number"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "order.OrderStatusResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "COMPLETED"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "order.ReviewOrderRequest": {
+            "type": "object",
+            "required": [
+                "approve"
+            ],
+            "properties": {
+                "approve": {
+                    "description": "true releases the order as PENDING, false fails it and returns its tickets",
+                    "type": "boolean"
+                }
+            }
+        },
+        "order.ReviewOrderResponse": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "archived": {
+                    "description": "The event is archived; the order is read-only history",
+                    "type": "boolean"
+                },
+                "checked_in_at": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "gift": {
+                    "description": "Set on orders bought for someone else",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/order.GiftResponse"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "Set on the shares of a group reservation; unpaid shares are released at hold_until",
+                    "type": "string"
+                },
+                "hold_until": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invoice_number": {
+                    "description": "Set once the invoice was requested",
+                    "type": "string",
+                    "example": "INV-000042"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "risk_reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "risk_score": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total_amount": {
+                    "type": "number"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "order.ReviewQueueResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.ReviewOrderResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/common.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/common.ListMeta"
+                }
+            }
+        },
+        "order.SuccessResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "order.TakeGroupShareRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "plan.AssignPlanRequest": {
+            "type": "object",
+            "required": [
+                "plan"
+            ],
+            "properties": {
+                "plan": {
+                    "type": "string",
+                    "example": "PRO"
+                }
+            }
+        },
+        "plan.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "plan.PlanListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "plans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/plan.PlanResponse"
+                    }
+                }
+            }
+        },
+        "plan.PlanResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "max_active_events": {
+                    "description": "0 means unlimited",
+                    "type": "integer"
+                },
+                "max_tickets_per_event": {
+                    "description": "0 means unlimited",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "plan.UserPlanResponse": {
+            "type": "object",
+            "properties": {
+                "plan": {
+                    "$ref": "#/definitions/plan.PlanResponse"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "refund.ApproveRefundRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Defaults to the refundable amount",
+                    "type": "number",
+                    "example": 49.99
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Approved as a goodwill gesture"
+                }
+            }
+        },
+        "refund.AuditEntryResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "APPROVED"
+                },
+                "actor_id": {
+                    "description": "Omitted for the payment provider's outcome",
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                }
+            }
+        },
+        "refund.DenyRefundRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Refunds close 30 days before the event"
+                }
+            }
+        },
+        "refund.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "refund.OpenRefundRequest": {
+            "type": "object",
+            "required": [
+                "kind",
+                "reason"
+            ],
+            "properties": {
+                "kind": {
+                    "description": "REFUND or DISPUTE",
+                    "type": "string",
+                    "example": "REFUND"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "I can no longer attend"
+                }
+            }
+        },
+        "refund.RefundDetailResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Refunded amount, 0 until approved",
+                    "type": "number",
+                    "example": 99.98
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "decided_at": {
+                    "type": "string"
+                },
+                "decided_by": {
+                    "type": "string"
+                },
+                "decision": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_title": {
+                    "type": "string"
+                },
+                "history": {
+                    "description": "Oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/refund.AuditEntryResponse"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "REFUND"
+                },
+                "order_amount": {
+                    "type": "number",
+                    "example": 99.98
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "provider_reference": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "refundable_amount": {
+                    "description": "Most the event's refund policy allows",
+                    "type": "number",
+                    "example": 49.99
+                },
+                "status": {
+                    "type": "string",
+                    "example": "OPEN"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "refund.RefundListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/refund.RefundResponse"
+                    }
+                }
+            }
+        },
+        "refund.RefundResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Refunded amount, 0 until approved",
+                    "type": "number",
+                    "example": 99.98
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "decided_at": {
+                    "type": "string"
+                },
+                "decided_by": {
+                    "type": "string"
+                },
+                "decision": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_title": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "REFUND"
+                },
+                "order_amount": {
+                    "type": "number",
+                    "example": 99.98
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "provider_reference": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "refundable_amount": {
+                    "description": "Most the event's refund policy allows",
+                    "type": "number",
+                    "example": 49.99
+                },
+                "status": {
+                    "type": "string",
+                    "example": "OPEN"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "report.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "report.EventSalesResponse": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string"
                 },
-                "status": {
-                    "type": "string",
-                    "example": "ACTIVE"
+                "orders": {
+                    "type": "integer"
                 },
-                "ticket_price": {
+                "revenue": {
+                    "type": "number"
+                },
+                "tickets_sold": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "report.ForecastResponse": {
+            "type": "object",
+            "properties": {
+                "event_date": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "fit": {
+                    "description": "R² of the fit; low values mean sales are irregular",
                     "type": "number",
-                    "example": 50
+                    "example": 0.93
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "projected_sold": {
+                    "description": "Tickets projected to be sold by the event date",
+                    "type": "integer",
+                    "example": 1800
+                },
+                "sellout_at": {
+                    "description": "Projected sellout; omitted when sold out, too few sales or not before the event",
+                    "type": "string"
+                },
+                "sold_out": {
+                    "type": "boolean"
+                },
+                "tickets_per_day": {
+                    "description": "Sales rate fitted over the sales so far",
+                    "type": "number",
+                    "example": 42.5
+                },
+                "tickets_sold": {
+                    "type": "integer"
                 },
                 "title": {
-                    "type": "string",
-                    "example": "Summer Concert"
+                    "type": "string"
+                },
+                "total_tickets": {
+                    "type": "integer"
+                }
+            }
+        },
+        "report.SalesSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/report.EventSalesResponse"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "total_orders": {
+                    "type": "integer"
+                },
+                "total_revenue": {
+                    "type": "number"
                 },
                 "total_tickets": {
+                    "type": "integer"
+                }
+            }
+        },
+        "report.ScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "last_sent_at": {
+                    "type": "string"
+                },
+                "next_run_at": {
+                    "description": "Only set while enabled",
+                    "type": "string"
+                }
+            }
+        },
+        "report.UpdateScheduleRequest": {
+            "type": "object",
+            "required": [
+                "enabled",
+                "frequency"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "frequency": {
+                    "type": "string",
+                    "example": "WEEKLY"
+                }
+            }
+        },
+        "share.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "share.ShareResponse": {
+            "type": "object",
+            "properties": {
+                "canonical_url": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "description": "Omitted when no share image is configured",
+                    "type": "string"
+                },
+                "short_url": {
+                    "description": "Omitted when short links are unavailable",
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "venue_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "shorturl.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "shorturl.ShortURLResponse": {
+            "type": "object",
+            "properties": {
+                "clicks": {
                     "type": "integer",
-                    "example": 100
+                    "example": 42
                 },
-                "updated_at": {
+                "code": {
                     "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                    "example": "aZ3kP9q"
                 },
-                "venue_id": {
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "short_url": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "https://tickets.example.com/e/aZ3kP9q"
                 }
             }
         },
-        "event.SuccessResponse": {
+        "staff.ErrorResponse": {
             "type": "object",
             "properties": {
+                "error": {
+                    "type": "string"
+                },
                 "message": {
-                    "type": "string",
-                    "example": "Event created successfully"
+                    "type": "string"
                 }
             }
         },
-        "event.UpdateEventRequest": {
+        "staff.InviteStaffRequest": {
             "type": "object",
             "required": [
-                "event_date",
-                "ticket_price",
-                "title",
-                "total_tickets",
-                "venue_id"
+                "email",
+                "role"
             ],
             "properties": {
-                "description": {
+                "email": {
                     "type": "string",
-                    "example": "An amazing summer concert with live music - Updated"
+                    "example": "door@example.com"
                 },
-                "event_date": {
+                "role": {
                     "type": "string",
-                    "example": "2024-08-15T20:00:00Z"
+                    "example": "CHECK_IN"
+                }
+            }
+        },
+        "staff.StaffListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
                 },
-                "ticket_price": {
-                    "type": "number",
-                    "minimum": 0,
-                    "example": 60
+                "staff": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/staff.StaffResponse"
+                    }
+                }
+            }
+        },
+        "staff.StaffResponse": {
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "type": "string"
                 },
-                "title": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invited_by": {
+                    "type": "string"
+                },
+                "role": {
                     "type": "string",
-                    "example": "Summer Concert - Updated"
+                    "example": "CHECK_IN"
                 },
-                "total_tickets": {
-                    "type": "integer",
-                    "minimum": 1,
-                    "example": 150
+                "status": {
+                    "type": "string",
+                    "example": "PENDING"
                 },
-                "venue_id": {
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "staff.SuccessResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "transfer.AuditEntryResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "ACCEPTED"
+                },
+                "actor_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
                 }
             }
         },
-        "order.CreateOrderRequest": {
+        "transfer.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "transfer.TransferDetailResponse": {
             "type": "object",
-            "required": [
-                "event_id",
-                "quantity"
-            ],
             "properties": {
+                "created_at": {
+                    "type": "string"
+                },
                 "event_id": {
                     "type": "string"
                 },
-                "quantity": {
-                    "type": "integer",
-                    "minimum": 1
+                "event_title": {
+                    "type": "string"
+                },
+                "from_organizer_id": {
+                    "type": "string"
+                },
+                "history": {
+                    "description": "Oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transfer.AuditEntryResponse"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "requested_by": {
+                    "type": "string"
+                },
+                "responded_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "PENDING"
+                },
+                "to_organizer_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "order.ErrorResponse": {
+        "transfer.TransferEventRequest": {
             "type": "object",
+            "required": [
+                "email"
+            ],
             "properties": {
-                "error": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
+                "email": {
+                    "type": "string",
+                    "example": "new.organizer@example.com"
                 }
             }
         },
-        "order.OrderListResponse": {
+        "transfer.TransferListResponse": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer"
                 },
-                "orders": {
+                "transfers": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/order.OrderResponse"
+                        "$ref": "#/definitions/transfer.TransferResponse"
                     }
                 }
             }
         },
-        "order.OrderResponse": {
+        "transfer.TransferResponse": {
             "type": "object",
             "properties": {
                 "created_at": {
@@ -1266,17 +10812,68 @@ const docTemplate = `{
                 "event_id": {
                     "type": "string"
                 },
+                "event_title": {
+                    "type": "string"
+                },
+                "from_organizer_id": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
-                "quantity": {
-                    "type": "integer"
+                "requested_by": {
+                    "type": "string"
+                },
+                "responded_at": {
+                    "type": "string"
                 },
                 "status": {
+                    "type": "string",
+                    "example": "PENDING"
+                },
+                "to_organizer_id": {
                     "type": "string"
                 },
-                "total_amount": {
-                    "type": "number"
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "user.AccountStatusRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "description": "Required to suspend or ban; shown to the user",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Chargebacks on several orders"
+                }
+            }
+        },
+        "user.AccountStatusResponse": {
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "history": {
+                    "description": "Newest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user.StatusChangeResponse"
+                    }
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Chargebacks on several orders"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "SUSPENDED"
                 },
                 "user_id": {
                     "type": "string"
@@ -1291,11 +10888,21 @@ const docTemplate = `{
                 "username"
             ],
             "properties": {
+                "accept_terms": {
+                    "description": "AcceptTerms accepts the current terms of service and privacy policy (see GET /api/v1/policies)\nRequired once policies have been published",
+                    "type": "boolean",
+                    "example": true
+                },
                 "email": {
                     "description": "User's email address - must be unique",
                     "type": "string",
                     "example": "user@example.com"
                 },
+                "gift_token": {
+                    "description": "GiftToken claims the gift whose claim link led to the registration",
+                    "type": "string",
+                    "maxLength": 100
+                },
                 "password": {
                     "description": "Password - must be at least 8 characters",
                     "type": "string",
@@ -1310,9 +10917,34 @@ const docTemplate = `{
                 }
             }
         },
+        "user.DateOfBirthRequest": {
+            "type": "object",
+            "properties": {
+                "date_of_birth": {
+                    "description": "YYYY-MM-DD; null removes it",
+                    "type": "string",
+                    "example": "1990-05-17"
+                }
+            }
+        },
+        "user.DateOfBirthResponse": {
+            "type": "object",
+            "properties": {
+                "date_of_birth": {
+                    "description": "YYYY-MM-DD; null when not given",
+                    "type": "string",
+                    "example": "1990-05-17"
+                }
+            }
+        },
         "user.ErrorResponse": {
             "type": "object",
             "properties": {
+                "code": {
+                    "description": "Domain error code, when the error has one",
+                    "type": "string",
+                    "example": "USERNAME_EXISTS"
+                },
                 "error": {
                     "description": "Error message",
                     "type": "string",
@@ -1332,6 +10964,11 @@ const docTemplate = `{
                 "password"
             ],
             "properties": {
+                "accept_terms": {
+                    "description": "AcceptTerms accepts the policy versions published since the user last accepted them",
+                    "type": "boolean",
+                    "example": false
+                },
                 "email": {
                     "description": "User's email address",
                     "type": "string",
@@ -1352,6 +10989,13 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1735689600
                 },
+                "pending_policies": {
+                    "description": "PendingPolicies lists current policy versions the user has not accepted yet\nClients should show them and log in again with accept_terms, or accept them through the consents API",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user.PolicyResponse"
+                    }
+                },
                 "token": {
                     "description": "JWT access token",
                     "type": "string",
@@ -1367,6 +11011,45 @@ const docTemplate = `{
                 }
             }
         },
+        "user.PolicyResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "TERMS"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/legal/terms-2026-05"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "2026-05"
+                }
+            }
+        },
+        "user.StatusChangeResponse": {
+            "type": "object",
+            "properties": {
+                "changed_by": {
+                    "description": "Admin who made the change; omitted for automatic suspensions",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "SUSPENDED"
+                }
+            }
+        },
         "user.UserResponse": {
             "type": "object",
             "properties": {
@@ -1398,6 +11081,19 @@ const docTemplate = `{
                 }
             }
         },
+        "user.UsernameAvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john_doe"
+                }
+            }
+        },
         "venue.CreateVenueRequest": {
             "type": "object",
             "required": [
@@ -1411,12 +11107,18 @@ const docTemplate = `{
                     "minLength": 1
                 },
                 "capacity": {
-                    "type": "integer",
-                    "minimum": 1
+                    "type": "integer"
                 },
                 "description": {
                     "type": "string"
                 },
+                "layouts": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "$ref": "#/definitions/venue.VenueLayout"
+                    }
+                },
                 "name": {
                     "type": "string",
                     "maxLength": 255,
@@ -1428,10 +11130,13 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "error": {
-                    "type": "string"
+                    "description": "Error code, listed by GET /api/v1/meta/error-codes",
+                    "type": "string",
+                    "example": "VENUE_NOT_FOUND"
                 },
                 "message": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "venue with ID 9b2d4c1e-5f6a-4b7c-8d9e-0f1a2b3c4d5e not found"
                 }
             }
         },
@@ -1443,6 +11148,19 @@ const docTemplate = `{
                 }
             }
         },
+        "venue.TransferVenueRequest": {
+            "type": "object",
+            "required": [
+                "owner_id"
+            ],
+            "properties": {
+                "owner_id": {
+                    "description": "An active ORGANIZER or ADMIN",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
         "venue.UpdateVenueRequest": {
             "type": "object",
             "required": [
@@ -1456,12 +11174,19 @@ const docTemplate = `{
                     "minLength": 1
                 },
                 "capacity": {
-                    "type": "integer",
-                    "minimum": 1
+                    "type": "integer"
                 },
                 "description": {
                     "type": "string"
                 },
+                "layouts": {
+                    "description": "Omit to keep the current layouts",
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "$ref": "#/definitions/venue.VenueLayout"
+                    }
+                },
                 "name": {
                     "type": "string",
                     "maxLength": 255,
@@ -1469,17 +11194,39 @@ const docTemplate = `{
                 }
             }
         },
-        "venue.VenueListResponse": {
+        "venue.VenueLayout": {
             "type": "object",
+            "required": [
+                "capacity",
+                "name"
+            ],
             "properties": {
-                "count": {
-                    "type": "integer"
+                "capacity": {
+                    "description": "At most the venue's capacity",
+                    "type": "integer",
+                    "example": 800
                 },
-                "venues": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Seated"
+                }
+            }
+        },
+        "venue.VenueListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/venue.VenueResponse"
                     }
+                },
+                "links": {
+                    "$ref": "#/definitions/common.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/common.ListMeta"
                 }
             }
         },
@@ -1501,13 +11248,127 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "layouts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/venue.VenueLayout"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
+                "owner_id": {
+                    "description": "Null for venues only admins manage",
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
+        },
+        "webhook.CreateSubscriptionRequest": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "description": "Every event when left out",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order.completed",
+                        "order.refunded"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://erp.example.com/hooks/tickets"
+                }
+            }
+        },
+        "webhook.CreatedSubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order.completed",
+                        "order.refunded"
+                    ]
+                },
+                "id": {
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "Only returned now; store it to verify signatures",
+                    "type": "string",
+                    "example": "whsec_5f2b8c..."
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://erp.example.com/hooks/tickets"
+                }
+            }
+        },
+        "webhook.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "webhook.SubscriptionListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "subscriptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/webhook.SubscriptionResponse"
+                    }
+                }
+            }
+        },
+        "webhook.SubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order.completed",
+                        "order.refunded"
+                    ]
+                },
+                "id": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://erp.example.com/hooks/tickets"
+                }
+            }
         }
     },
     "securityDefinitions": {
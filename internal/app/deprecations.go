@@ -0,0 +1,22 @@
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"enterprise-crud/internal/deprecation"
+)
+
+// Deprecations lists the parts of the API being phased out
+// An entry stays until its sunset has passed and the deprecated part has been removed with it;
+// TestDeprecations_KeptUntilSunset fails if the part goes first.
+var Deprecations = []deprecation.Deprecation{
+	{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/events/my-events",
+		Param:       "offset",
+		Since:       time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+		Sunset:      time.Date(2027, 4, 17, 0, 0, 0, 0, time.UTC),
+		Replacement: "cursor with the next_cursor of the previous page",
+	},
+}
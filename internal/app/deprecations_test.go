@@ -0,0 +1,40 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/deprecation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeprecations_Valid fails on incomplete or contradictory deprecations
+func TestDeprecations_Valid(t *testing.T) {
+	for _, d := range Deprecations {
+		assert.NoError(t, d.Validate())
+	}
+}
+
+// TestDeprecations_KeptUntilSunset fails when a deprecated endpoint or response field is removed before its sunset
+// Once the sunset has passed, remove the deprecated part together with its entry.
+func TestDeprecations_KeptUntilSunset(t *testing.T) {
+	served := make(map[string]bool)
+	for _, env := range []string{"production", "development"} {
+		for _, route := range Routes(&config.Config{App: config.AppConfig{Environment: env}, Diagnostics: config.DiagnosticsConfig{Enabled: true}}) {
+			served[route.Method+" "+route.Path] = true
+		}
+	}
+
+	now := time.Now()
+	for _, d := range Deprecations {
+		if now.After(d.Sunset) {
+			continue
+		}
+		assert.True(t, served[d.Method+" "+d.Path], "%s was removed before its sunset on %s", d.Subject(), d.Sunset.Format(time.DateOnly))
+		if d.Field != "" {
+			assert.True(t, deprecation.HasField(d.Response, d.Field), "%s was removed before its sunset on %s", d.Subject(), d.Sunset.Format(time.DateOnly))
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/fixtures"
+	"enterprise-crud/internal/golden"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+// TestErrorEnvelopes_MatchGoldenFiles records the status and body of common error responses
+// They come from the mock app, so middleware and handlers produce them exactly as the API does.
+func TestErrorEnvelopes_MatchGoldenFiles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	application, err := NewMockApp(&config.Config{})
+	require.NoError(t, err)
+	router := application.SetupRouter()
+
+	login := func(email string) string {
+		w := serveMock(t, router, http.MethodPost, "/api/v1/auth/login", "", map[string]string{
+			"email": email, "password": fixtures.Password,
+		})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var response struct {
+			Token string `json:"token"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response.Token
+	}
+	userToken := login(fixtures.UserEmail)
+	organizerToken := login(fixtures.OrganizerEmail)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		body   interface{}
+	}{
+		{name: "missing_token", method: http.MethodGet, path: "/api/v1/orders/my-orders"},
+		{name: "invalid_token", method: http.MethodGet, path: "/api/v1/orders/my-orders", token: "not-a-token"},
+		{name: "missing_role", method: http.MethodPost, path: "/api/v1/events", token: userToken, body: map[string]string{}},
+		{name: "invalid_id", method: http.MethodGet, path: "/api/v1/events/not-a-uuid"},
+		{name: "invalid_body", method: http.MethodPost, path: "/api/v1/events", token: organizerToken, body: map[string]string{}},
+		{name: "event_not_found", method: http.MethodGet, path: "/api/v1/events/00000000-0000-4000-8000-000000000099"},
+		{name: "not_order_owner", method: http.MethodGet, path: "/api/v1/orders/" + fixtures.SoldOutOrderID.String(), token: userToken},
+		{name: "invalid_credentials", method: http.MethodPost, path: "/api/v1/auth/login", body: map[string]string{
+			"email": fixtures.UserEmail, "password": "wrong-password",
+		}},
+		{name: "sold_out", method: http.MethodPost, path: "/api/v1/orders", token: userToken, body: map[string]interface{}{
+			"event_id": fixtures.SoldOutID, "quantity": 1,
+		}},
+		{name: "not_mocked", method: http.MethodGet, path: "/api/v1/admin/jobs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveMock(t, router, tt.method, tt.path, tt.token, tt.body)
+
+			golden.AssertJSON(t, "errors/"+tt.name, map[string]interface{}{
+				"status": w.Code,
+				"body":   json.RawMessage(w.Body.Bytes()),
+			})
+		})
+	}
+}
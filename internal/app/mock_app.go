@@ -0,0 +1,163 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/plan"
+	"enterprise-crud/internal/domain/recommendation"
+	"enterprise-crud/internal/domain/share"
+	"enterprise-crud/internal/domain/trending"
+	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/domain/venue"
+	"enterprise-crud/internal/fixtures"
+	"enterprise-crud/internal/infrastructure/email"
+	"enterprise-crud/internal/infrastructure/memory"
+	httpHandlers "enterprise-crud/internal/presentation/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// mockRootRoutes are the routes outside /api/v1 served in mock mode
+var mockRootRoutes = []string{"/health", "/ready", "/version", "/metrics", "/swagger/*any", "/sitemap.xml", "/s/:code",
+	"/debug/pprof/*profile", "/debug/pprof/symbol", "/debug/vars", "/debug/dumps"}
+
+// NewMockApp creates the application on in-memory repositories seeded from the fixtures package
+// Users, plans, venues, events and orders work as usual, and share pages, feeds, recommendations and trending events are built from them;
+// maintenance mode can be switched as usual, the error code catalogue is served and email previews are served in development;
+// every other API route answers 501 Not Implemented. Nothing connects to Postgres or Redis and
+// nothing survives a restart.
+func NewMockApp(cfg *config.Config) (*WireApp, error) {
+	store := memory.NewStore(fixtures.Default(time.Now()))
+	txDB, err := memory.NewTxDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mock transactions: %w", err)
+	}
+
+	// Services
+	venueRepo := memory.NewVenueRepository(store)
+	userService := user.NewUserService(memory.NewUserRepository(store), memory.NewRoleRepository(store))
+	venueService := venue.NewVenueService(venueRepo, VenueOwnerCheck(userService))
+	planService := plan.NewService(memory.NewPlanRepository(store))
+	eventService := event.NewService(memory.NewEventRepository(store), venueRepo, planService, nil)
+	eventBus := eventbus.New()
+	orderOptions := []order.ServiceOption{order.WithAgeGate(userService)}
+	if cfg.Orders.GroupHold > 0 {
+		orderOptions = append(orderOptions, order.WithGroupReservations(cfg.Orders.GroupHold))
+	}
+	orderService := order.NewOrderService(memory.NewOrderRepository(store), txDB, eventBus, orderOptions...)
+	shareService := share.NewService(eventService, venueService, nil, share.Config{
+		PublicURL: cfg.App.PublicURL,
+		ImageURL:  cfg.Share.ImageURL,
+		LinkTTL:   cfg.Share.LinkTTL,
+	})
+	recommendationService := recommendation.NewService(orderService, eventService)
+	trendingService := trending.NewService(memory.NewTrendingRepository(), eventService, trending.Config{
+		HalfLife:       cfg.Trending.HalfLife,
+		ViewWeight:     cfg.Trending.ViewWeight,
+		PurchaseWeight: cfg.Trending.PurchaseWeight,
+	})
+	trending.Subscribe(eventBus, trendingService)
+	maintenanceService := NewMaintenanceService(memory.NewMaintenanceRepository(), &cfg.Maintenance)
+
+	jwtService := newJWTService()
+	jwtService.CheckAccountsWith(userService)
+
+	// Handlers with services behind them
+	userHandler := httpHandlers.NewUserHandler(userService, nil, jwtService)
+	eventHandler := httpHandlers.NewEventHandler(eventService, nil, jwtService)
+	eventHandler.CountViewsWith(trendingService)
+	orderHandler := httpHandlers.NewOrderHandler(orderService, jwtService)
+	orderHandler.LinkGroupSharesTo(cfg.App.PublicURL)
+	venueHandler := httpHandlers.NewVenueHandler(venueService, jwtService)
+	planHandler := httpHandlers.NewPlanHandler(planService, jwtService)
+	feedHandler := httpHandlers.NewFeedHandler(eventService, venueService, &cfg.App, &cfg.Feeds)
+	shareHandler := httpHandlers.NewShareHandler(shareService, jwtService)
+	recommendationHandler := httpHandlers.NewRecommendationHandler(recommendationService, jwtService)
+	trendingHandler := httpHandlers.NewTrendingHandler(trendingService)
+	maintenanceHandler := httpHandlers.NewMaintenanceHandler(maintenanceService, jwtService)
+	emailRenderer, err := email.NewRenderer(cfg.Email.DefaultLocale)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load email templates: %w", err)
+	}
+	emailPreviewHandler := httpHandlers.NewEmailPreviewHandler(emailRenderer)
+	metaHandler := httpHandlers.NewMetaHandler()
+
+	// The routes of those handlers are the ones served; registering them on a scratch engine lists them
+	scratch := gin.New()
+	v1 := scratch.Group("/api/v1")
+	userHandler.RegisterRoutes(v1)
+	userHandler.RegisterAuthRoutes(v1)
+	eventHandler.RegisterRoutes(v1)
+	orderHandler.RegisterRoutes(v1)
+	venueHandler.RegisterRoutes(v1)
+	planHandler.RegisterRoutes(v1)
+	feedHandler.RegisterRoutes(v1)
+	shareHandler.RegisterRoutes(v1)
+	recommendationHandler.RegisterRoutes(v1)
+	trendingHandler.RegisterRoutes(v1)
+	maintenanceHandler.RegisterRoutes(v1)
+	emailPreviewHandler.RegisterRoutes(v1)
+	metaHandler.RegisterRoutes(v1)
+	served := make(map[string]bool)
+	for _, route := range scratch.Routes() {
+		served[route.Method+" "+route.Path] = true
+	}
+
+	// The other handlers have no services and only register their routes
+	application := NewWireApp(cfg, nil, nil,
+		userHandler,
+		eventHandler,
+		orderHandler,
+		venueHandler,
+		planHandler,
+		httpHandlers.NewJobHandler(nil, jwtService),
+		httpHandlers.NewReportHandler(nil, jwtService),
+		feedHandler,
+		shareHandler,
+		httpHandlers.NewShortURLHandler(nil, nil, jwtService),
+		httpHandlers.NewStaffHandler(nil, nil, jwtService),
+		httpHandlers.NewNotificationHandler(nil, jwtService),
+		httpHandlers.NewInvoiceHandler(nil, jwtService),
+		httpHandlers.NewAccountHandler(nil, jwtService),
+		httpHandlers.NewConsentHandler(nil, jwtService),
+		httpHandlers.NewIPAccessHandler(nil, jwtService),
+		httpHandlers.NewAnalyticsHandler(nil, jwtService),
+		recommendationHandler,
+		trendingHandler,
+		httpHandlers.NewRefundHandler(nil, jwtService),
+		httpHandlers.NewMessageHandler(nil, jwtService),
+		httpHandlers.NewWebhookHandler(nil, jwtService),
+		httpHandlers.NewTransferHandler(nil, jwtService),
+		httpHandlers.NewAccessCodeHandler(nil, jwtService),
+		maintenanceHandler,
+		httpHandlers.NewDiagnosticsHandler(cfg.Diagnostics.DumpDir, jwtService),
+		emailPreviewHandler,
+		httpHandlers.NewBrandingHandler(nil, jwtService),
+		metaHandler,
+	)
+	application.Use(rejectUnmocked(served), NewMaintenanceGuard(maintenanceService, &cfg.Maintenance))
+	return application, nil
+}
+
+// rejectUnmocked answers 501 on routes whose handler has no service in mock mode
+// Unknown paths fall through to the router's 404.
+func rejectUnmocked(served map[string]bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path := c.FullPath()
+		if path == "" || served[c.Request.Method+" "+path] || slices.Contains(mockRootRoutes, path) {
+			c.Next()
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusNotImplemented, gin.H{
+			"error":   "Not implemented in mock mode",
+			"message": "Only users, plans, venues, events, orders, share pages, feeds, recommendations, trending events, maintenance mode, email previews and the error code catalogue are served by the mock server",
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/fixtures"
+	"enterprise-crud/internal/presentation/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveMock(t *testing.T, router *gin.Engine, method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	var reader bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&reader).Encode(body))
+	}
+	req := httptest.NewRequest(method, path, &reader)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.RequestIDHeader, "test-request") // Error bodies carry it, so golden files need it fixed
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestMockApp_ServesFixturesFromMemory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	application, err := NewMockApp(&config.Config{})
+	require.NoError(t, err)
+	router := application.SetupRouter()
+
+	w := serveMock(t, router, http.MethodPost, "/api/v1/auth/login", "", map[string]string{
+		"email": fixtures.UserEmail, "password": fixtures.Password,
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var login struct {
+		Token string `json:"token"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &login))
+
+	w = serveMock(t, router, http.MethodPost, "/api/v1/orders", login.Token, map[string]interface{}{
+		"event_id": fixtures.ConcertID, "quantity": 2,
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = serveMock(t, router, http.MethodGet, "/api/v1/events/"+fixtures.ConcertID.String(), "", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var concert struct {
+		AvailableTickets int `json:"available_tickets"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &concert))
+	assert.Equal(t, 494, concert.AvailableTickets, "496 seeded, 2 ordered")
+
+	w = serveMock(t, router, http.MethodGet, "/api/v1/orders/my-orders", login.Token, nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestMockApp_RejectsRoutesWithoutServices(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	application, err := NewMockApp(&config.Config{})
+	require.NoError(t, err)
+	router := application.SetupRouter()
+
+	w := serveMock(t, router, http.MethodGet, "/api/v1/admin/jobs", "", nil)
+	assert.Equal(t, http.StatusNotImplemented, w.Code)
+
+	w = serveMock(t, router, http.MethodGet, "/api/v1/no-such-route", "", nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = serveMock(t, router, http.MethodGet, "/health", "", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// loginMock logs a fixture user in and returns their token
+func loginMock(t *testing.T, router *gin.Engine, email string) string {
+	w := serveMock(t, router, http.MethodPost, "/api/v1/auth/login", "", map[string]string{
+		"email": email, "password": fixtures.Password,
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var login struct {
+		Token string `json:"token"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &login))
+	return login.Token
+}
+
+func TestMockApp_VenueOwnership(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	application, err := NewMockApp(&config.Config{})
+	require.NoError(t, err)
+	router := application.SetupRouter()
+	organizer := loginMock(t, router, fixtures.OrganizerEmail)
+	admin := loginMock(t, router, fixtures.AdminEmail)
+	club := "/api/v1/venues/" + fixtures.ClubID.String()
+	update := map[string]interface{}{"name": "Riverside Club", "address": "42 River Street, Springfield", "capacity": 60}
+
+	w := serveMock(t, router, http.MethodPut, club, organizer, update)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), `"owner_id":"`+fixtures.OrganizerID.String()+`"`)
+
+	w = serveMock(t, router, http.MethodPost, "/api/v1/venues", admin, map[string]interface{}{
+		"name": "Admin Hall", "address": "1 Admin Road", "capacity": 100,
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+
+	w = serveMock(t, router, http.MethodPut, "/api/v1/venues/"+created.ID, organizer, update)
+	assert.Equal(t, http.StatusForbidden, w.Code, "organizers can't edit other owners' venues")
+	assert.Contains(t, w.Body.String(), "NOT_VENUE_OWNER")
+
+	w = serveMock(t, router, http.MethodPost, club+"/transfer", organizer, map[string]interface{}{"owner_id": fixtures.UserID})
+	assert.Equal(t, http.StatusBadRequest, w.Code, "plain users can't own venues")
+	assert.Contains(t, w.Body.String(), "INVALID_NEW_OWNER")
+
+	w = serveMock(t, router, http.MethodPost, club+"/transfer", organizer, map[string]interface{}{"owner_id": fixtures.AdminID})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = serveMock(t, router, http.MethodDelete, club, organizer, nil)
+	assert.Equal(t, http.StatusForbidden, w.Code, "the previous owner lost access")
+
+	w = serveMock(t, router, http.MethodPut, club, admin, update)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+}
+
+func TestMockApp_MaintenanceMode(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	application, err := NewMockApp(&config.Config{Maintenance: config.MaintenanceConfig{Message: "Back soon", RetryAfter: time.Minute}})
+	require.NoError(t, err)
+	router := application.SetupRouter()
+	user := loginMock(t, router, fixtures.UserEmail)
+	admin := loginMock(t, router, fixtures.AdminEmail)
+	order := map[string]interface{}{"event_id": fixtures.ConcertID, "quantity": 1}
+
+	w := serveMock(t, router, http.MethodPut, "/api/v1/admin/maintenance", user, map[string]interface{}{"enabled": true})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = serveMock(t, router, http.MethodPut, "/api/v1/admin/maintenance", admin, map[string]interface{}{"enabled": true})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = serveMock(t, router, http.MethodPost, "/api/v1/orders", user, order)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "60", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), `"message":"Back soon"`)
+
+	w = serveMock(t, router, http.MethodGet, "/api/v1/events/"+fixtures.ConcertID.String(), "", nil)
+	assert.Equal(t, http.StatusOK, w.Code, "reads stay available")
+
+	w = serveMock(t, router, http.MethodGet, "/api/v1/maintenance", "", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"active":true`)
+
+	loginMock(t, router, fixtures.AdminEmail) // Logging in stays open
+
+	w = serveMock(t, router, http.MethodPut, "/api/v1/admin/maintenance", admin, map[string]interface{}{"enabled": false})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = serveMock(t, router, http.MethodPost, "/api/v1/orders", user, order)
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+}
@@ -0,0 +1,119 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// publicRoutes are the routes served without a token; every other route must challenge for one
+// A new public route has to be added here, so no route is left open by accident.
+var publicRoutes = map[string]bool{
+	"GET /health":       true,
+	"GET /ready":        true,
+	"GET /version":      true,
+	"GET /metrics":      true,
+	"GET /sitemap.xml":  true,
+	"GET /s/:code":      true,
+	"GET /e/:code":      true,
+	"GET /swagger/*any": true,
+
+	"POST /api/v1/users":               true,
+	"POST /api/v1/auth/login":          true,
+	"GET /api/v1/users/check-username": true,
+	"GET /api/v1/policies":             true,
+	"GET /api/v1/maintenance":          true,
+	"GET /api/v1/meta/error-codes":     true,
+
+	"GET /api/v1/events":                       true,
+	"GET /api/v1/events/:id":                   true,
+	"GET /api/v1/events/slug/:slug":            true,
+	"GET /api/v1/events/:id/share":             true,
+	"GET /api/v1/events/trending":              true,
+	"GET /api/v1/events/feed.ics":              true,
+	"GET /api/v1/events/feed.rss":              true,
+	"GET /api/v1/organizers/:id/events":        true,
+	"GET /api/v1/organizers/:id/branding/logo": true,
+	"GET /api/v1/venues":                       true,
+	"GET /api/v1/venues/:id":                   true,
+}
+
+// routeParam matches the parameters of a route path
+var routeParam = regexp.MustCompile(`[:*][^/]+`)
+
+// routeAuthRouter serves every route of the API without services behind the handlers
+func routeAuthRouter(t *testing.T) (*gin.Engine, *auth.JWTService, gin.RoutesInfo) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{}
+	cfg.Diagnostics.Enabled = true
+	jwtService := auth.NewJWTService("test-secret", cfg.App.Name, time.Hour)
+	router := routesOnlyApp(cfg, jwtService).SetupRouter()
+	return router, jwtService, router.Routes()
+}
+
+// serveRoute sends a request to a route, with a UUID for every path parameter
+func serveRoute(router *gin.Engine, route gin.RouteInfo, authorization string) *httptest.ResponseRecorder {
+	path := routeParam.ReplaceAllString(route.Path, uuid.NewString())
+	req := httptest.NewRequest(route.Method, path, strings.NewReader("{}"))
+	req.Header.Set("Content-Type", "application/json")
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestProtectedRoutes_ChallengeForToken(t *testing.T) {
+	router, _, routes := routeAuthRouter(t)
+
+	for _, route := range routes {
+		name := route.Method + " " + route.Path
+		if publicRoutes[name] {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			w := serveRoute(router, route, "")
+			assert.Equal(t, http.StatusUnauthorized, w.Code, "requests without a token are unauthenticated")
+			assert.Equal(t, `Bearer realm="enterprise-crud"`, w.Header().Get("WWW-Authenticate"))
+			assert.Contains(t, w.Body.String(), auth.CodeAuthenticationRequired)
+
+			w = serveRoute(router, route, "Bearer not-a-token")
+			assert.Equal(t, http.StatusUnauthorized, w.Code, "requests with a bad token are unauthenticated")
+			assert.Contains(t, w.Header().Get("WWW-Authenticate"), `error="invalid_token"`)
+			assert.Contains(t, w.Body.String(), auth.CodeInvalidToken)
+		})
+	}
+}
+
+func TestAdminRoutes_ForbidOtherRoles(t *testing.T) {
+	router, jwtService, routes := routeAuthRouter(t)
+	token, err := jwtService.GenerateToken(uuid.New(), "organizer@example.com", "organizer", []string{"USER", "ORGANIZER"}, 0)
+	require.NoError(t, err)
+
+	checked := 0
+	for _, route := range routes {
+		if !strings.HasPrefix(route.Path, "/api/v1/admin/") && !strings.HasPrefix(route.Path, "/debug/") {
+			continue
+		}
+		checked++
+		t.Run(route.Method+" "+route.Path, func(t *testing.T) {
+			w := serveRoute(router, route, "Bearer "+token)
+			assert.Equal(t, http.StatusForbidden, w.Code, "signed-in users without the role are forbidden, not unauthenticated")
+			assert.Empty(t, w.Header().Get("WWW-Authenticate"))
+			assert.Contains(t, w.Body.String(), auth.CodeInsufficientRole)
+		})
+	}
+	assert.NotZero(t, checked)
+}
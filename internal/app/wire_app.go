@@ -2,8 +2,10 @@ package app
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
@@ -13,31 +15,121 @@ import (
 
 	_ "enterprise-crud/docs"
 	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/deprecation"
+	"enterprise-crud/internal/domain/accesscode"
+	"enterprise-crud/internal/domain/account"
+	"enterprise-crud/internal/domain/analytics"
+	"enterprise-crud/internal/domain/branding"
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/consent"
 	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/invoice"
+	"enterprise-crud/internal/domain/ipaccess"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/maintenance"
+	"enterprise-crud/internal/domain/messaging"
+	"enterprise-crud/internal/domain/notification"
 	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/plan"
+	"enterprise-crud/internal/domain/recommendation"
+	"enterprise-crud/internal/domain/refund"
+	"enterprise-crud/internal/domain/report"
 	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/domain/share"
+	"enterprise-crud/internal/domain/shorturl"
+	"enterprise-crud/internal/domain/staff"
+	"enterprise-crud/internal/domain/transfer"
+	"enterprise-crud/internal/domain/trending"
 	"enterprise-crud/internal/domain/user"
 	"enterprise-crud/internal/domain/venue"
+	"enterprise-crud/internal/domain/webhook"
+	"enterprise-crud/internal/infrastructure/accounts"
+	"enterprise-crud/internal/infrastructure/antibot"
+	"enterprise-crud/internal/infrastructure/auditexport"
 	"enterprise-crud/internal/infrastructure/auth"
 	"enterprise-crud/internal/infrastructure/cache"
+	"enterprise-crud/internal/infrastructure/cdn"
+	"enterprise-crud/internal/infrastructure/chaos"
+	"enterprise-crud/internal/infrastructure/concurrency"
 	"enterprise-crud/internal/infrastructure/database"
+	"enterprise-crud/internal/infrastructure/dedupe"
+	"enterprise-crud/internal/infrastructure/diagnostics"
+	"enterprise-crud/internal/infrastructure/email"
+	"enterprise-crud/internal/infrastructure/events"
+	"enterprise-crud/internal/infrastructure/invoices"
+	"enterprise-crud/internal/infrastructure/jobs"
+	"enterprise-crud/internal/infrastructure/memory"
+	"enterprise-crud/internal/infrastructure/metrics"
+	"enterprise-crud/internal/infrastructure/moderation"
+	"enterprise-crud/internal/infrastructure/notifications"
+	"enterprise-crud/internal/infrastructure/orders"
+	"enterprise-crud/internal/infrastructure/payments"
+	"enterprise-crud/internal/infrastructure/push"
+	"enterprise-crud/internal/infrastructure/reports"
+	"enterprise-crud/internal/infrastructure/resilience"
+	"enterprise-crud/internal/infrastructure/sms"
+	"enterprise-crud/internal/infrastructure/snapshots"
+	"enterprise-crud/internal/infrastructure/storage"
+	"enterprise-crud/internal/infrastructure/trends"
+	"enterprise-crud/internal/infrastructure/webhooks"
+	"enterprise-crud/internal/lifecycle"
 	httpHandlers "enterprise-crud/internal/presentation/http"
+	"enterprise-crud/internal/presentation/middleware"
+	"enterprise-crud/internal/version"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
 // WireApp represents the application with Wire-injected dependencies
 type WireApp struct {
-	config       *config.Config
-	server       *http.Server
-	dbConn       *database.Connection
-	redisClient  *cache.RedisClient
-	userHandler  *httpHandlers.UserHandler
-	eventHandler *httpHandlers.EventHandler
-	orderHandler *httpHandlers.OrderHandler
-	venueHandler *httpHandlers.VenueHandler
+	config                *config.Config
+	server                *http.Server
+	dbConn                *database.Connection
+	healthMonitor         *database.HealthMonitor
+	redisClient           *cache.RedisClient
+	shutdownHooks         []func()
+	lifecycle             *lifecycle.Registry
+	userHandler           *httpHandlers.UserHandler
+	eventHandler          *httpHandlers.EventHandler
+	orderHandler          *httpHandlers.OrderHandler
+	venueHandler          *httpHandlers.VenueHandler
+	planHandler           *httpHandlers.PlanHandler
+	jobHandler            *httpHandlers.JobHandler
+	reportHandler         *httpHandlers.ReportHandler
+	feedHandler           *httpHandlers.FeedHandler
+	shareHandler          *httpHandlers.ShareHandler
+	shortURLHandler       *httpHandlers.ShortURLHandler
+	staffHandler          *httpHandlers.StaffHandler
+	notificationHandler   *httpHandlers.NotificationHandler
+	invoiceHandler        *httpHandlers.InvoiceHandler
+	accountHandler        *httpHandlers.AccountHandler
+	consentHandler        *httpHandlers.ConsentHandler
+	ipAccessHandler       *httpHandlers.IPAccessHandler
+	analyticsHandler      *httpHandlers.AnalyticsHandler
+	recommendationHandler *httpHandlers.RecommendationHandler
+	trendingHandler       *httpHandlers.TrendingHandler
+	refundHandler         *httpHandlers.RefundHandler
+	messageHandler        *httpHandlers.MessageHandler
+	webhookHandler        *httpHandlers.WebhookHandler
+	transferHandler       *httpHandlers.TransferHandler
+	accessCodeHandler     *httpHandlers.AccessCodeHandler
+	maintenanceHandler    *httpHandlers.MaintenanceHandler
+	diagnosticsHandler    *httpHandlers.DiagnosticsHandler
+	emailPreviewHandler   *httpHandlers.EmailPreviewHandler
+	brandingHandler       *httpHandlers.BrandingHandler
+	metaHandler           *httpHandlers.MetaHandler
+	routerMiddleware      []gin.HandlerFunc
+	background            []BackgroundService
+}
+
+// BackgroundService is a long-running component started and stopped with the server
+type BackgroundService interface {
+	Start()
+	Stop()
 }
 
 // NewWireApp creates a new application with injected dependencies
@@ -49,30 +141,101 @@ func NewWireApp(
 	eventHandler *httpHandlers.EventHandler,
 	orderHandler *httpHandlers.OrderHandler,
 	venueHandler *httpHandlers.VenueHandler,
+	planHandler *httpHandlers.PlanHandler,
+	jobHandler *httpHandlers.JobHandler,
+	reportHandler *httpHandlers.ReportHandler,
+	feedHandler *httpHandlers.FeedHandler,
+	shareHandler *httpHandlers.ShareHandler,
+	shortURLHandler *httpHandlers.ShortURLHandler,
+	staffHandler *httpHandlers.StaffHandler,
+	notificationHandler *httpHandlers.NotificationHandler,
+	invoiceHandler *httpHandlers.InvoiceHandler,
+	accountHandler *httpHandlers.AccountHandler,
+	consentHandler *httpHandlers.ConsentHandler,
+	ipAccessHandler *httpHandlers.IPAccessHandler,
+	analyticsHandler *httpHandlers.AnalyticsHandler,
+	recommendationHandler *httpHandlers.RecommendationHandler,
+	trendingHandler *httpHandlers.TrendingHandler,
+	refundHandler *httpHandlers.RefundHandler,
+	messageHandler *httpHandlers.MessageHandler,
+	webhookHandler *httpHandlers.WebhookHandler,
+	transferHandler *httpHandlers.TransferHandler,
+	accessCodeHandler *httpHandlers.AccessCodeHandler,
+	maintenanceHandler *httpHandlers.MaintenanceHandler,
+	diagnosticsHandler *httpHandlers.DiagnosticsHandler,
+	emailPreviewHandler *httpHandlers.EmailPreviewHandler,
+	brandingHandler *httpHandlers.BrandingHandler,
+	metaHandler *httpHandlers.MetaHandler,
 ) *WireApp {
 	return &WireApp{
-		config:       cfg,
-		dbConn:       dbConn,
-		redisClient:  redisClient,
-		userHandler:  userHandler,
-		eventHandler: eventHandler,
-		orderHandler: orderHandler,
-		venueHandler: venueHandler,
+		config:                cfg,
+		dbConn:                dbConn,
+		redisClient:           redisClient,
+		userHandler:           userHandler,
+		eventHandler:          eventHandler,
+		orderHandler:          orderHandler,
+		venueHandler:          venueHandler,
+		planHandler:           planHandler,
+		jobHandler:            jobHandler,
+		reportHandler:         reportHandler,
+		feedHandler:           feedHandler,
+		shareHandler:          shareHandler,
+		shortURLHandler:       shortURLHandler,
+		staffHandler:          staffHandler,
+		notificationHandler:   notificationHandler,
+		invoiceHandler:        invoiceHandler,
+		accountHandler:        accountHandler,
+		consentHandler:        consentHandler,
+		ipAccessHandler:       ipAccessHandler,
+		analyticsHandler:      analyticsHandler,
+		recommendationHandler: recommendationHandler,
+		trendingHandler:       trendingHandler,
+		refundHandler:         refundHandler,
+		messageHandler:        messageHandler,
+		webhookHandler:        webhookHandler,
+		transferHandler:       transferHandler,
+		accessCodeHandler:     accessCodeHandler,
+		maintenanceHandler:    maintenanceHandler,
+		diagnosticsHandler:    diagnosticsHandler,
+		emailPreviewHandler:   emailPreviewHandler,
+		brandingHandler:       brandingHandler,
+		metaHandler:           metaHandler,
 	}
 }
 
+// RunInBackground registers a service started after the HTTP server and stopped
+// once it has shut down, before shutdown hooks run
+func (a *WireApp) RunInBackground(svc BackgroundService) {
+	a.background = append(a.background, svc)
+}
+
+// Use registers middleware run on every request after the security headers are set
+// It must be called before SetupRouter.
+func (a *WireApp) Use(handlers ...gin.HandlerFunc) {
+	a.routerMiddleware = append(a.routerMiddleware, handlers...)
+}
+
+// Track makes shutdown wait for the goroutines of registry after background services stop
+// and before shutdown hooks run, so they finish their writes while Redis and the database are still open
+func (a *WireApp) Track(registry *lifecycle.Registry) {
+	a.lifecycle = registry
+}
+
+// OnShutdown registers a function run after the HTTP server stops and before
+// the database and Redis connections are closed
+func (a *WireApp) OnShutdown(fn func()) {
+	a.shutdownHooks = append(a.shutdownHooks, fn)
+}
+
 // Run starts the application with graceful shutdown
 func (a *WireApp) Run() error {
-	// Configure database connection pool
-	sqlDB, err := a.dbConn.DB.DB()
-	if err != nil {
-		return fmt.Errorf("failed to get sql.DB from GORM: %w", err)
+	// The mock server runs without a database
+	if a.dbConn != nil {
+		if err := a.startDatabase(); err != nil {
+			return err
+		}
 	}
 
-	sqlDB.SetMaxOpenConns(a.config.Database.MaxOpenConns)
-	sqlDB.SetMaxIdleConns(a.config.Database.MaxIdleConns)
-	sqlDB.SetConnMaxLifetime(a.config.Database.ConnMaxLifetime)
-
 	// Setup HTTP server
 	router := a.SetupRouter()
 
@@ -86,16 +249,54 @@ func (a *WireApp) Run() error {
 
 	// Start server in a goroutine
 	go func() {
-		log.Printf("Server starting on port %s", a.config.Server.Port)
+		build := version.Get()
+		log.Printf("Server starting on port %s (version %s, commit %s, built %s, %s)",
+			a.config.Server.Port, build.Version, build.ShortCommit(), build.BuildTime, build.GoVersion)
 		if err := a.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Fatalf("Server failed to start: %v", err)
 		}
 	}()
 
+	for _, svc := range a.background {
+		svc.Start()
+	}
+
 	// Wait for interrupt signal to gracefully shutdown
 	return a.waitForShutdown()
 }
 
+// startDatabase configures the connection pool and starts monitoring the database's health
+func (a *WireApp) startDatabase() error {
+	// Configure database connection pool
+	sqlDB, err := a.dbConn.DB.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get sql.DB from GORM: %w", err)
+	}
+
+	sqlDB.SetMaxOpenConns(a.config.Database.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(a.config.Database.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(a.config.Database.ConnMaxLifetime)
+
+	if err := metrics.RegisterDBStats(sqlDB, "postgres"); err != nil {
+		log.Printf("Warning: Failed to register database metrics: %v", err)
+	}
+
+	// Monitor database health so /ready reflects outages and the pool reconnects
+	if a.config.Database.HealthCheckInterval > 0 {
+		a.healthMonitor, err = database.NewHealthMonitor(
+			a.dbConn,
+			a.config.Database.HealthCheckInterval,
+			a.config.Database.HealthCheckTimeout,
+			a.config.Database.MaxIdleConns,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create database health monitor: %w", err)
+		}
+		a.healthMonitor.Start()
+	}
+	return nil
+}
+
 // SetupRouter creates and configures the HTTP router
 func (a *WireApp) SetupRouter() *gin.Engine {
 	if a.config.App.Environment == "production" {
@@ -103,8 +304,30 @@ func (a *WireApp) SetupRouter() *gin.Engine {
 	}
 
 	router := gin.New()
-	router.Use(gin.Logger())
+	// Without trusted proxies X-Forwarded-For is ignored, so clients cannot spoof their address
+	if err := router.SetTrustedProxies(a.config.Security.TrustedProxies); err != nil {
+		log.Printf("Warning: Invalid trusted proxies, trusting none: %v", err)
+		_ = router.SetTrustedProxies(nil)
+	}
+	// Tag access logs with the build so a log search can tell deployments apart
+	build := version.Get()
+	router.Use(middleware.RequestLogger(middleware.RequestLoggerConfig{
+		Logger:   slog.New(slog.NewJSONHandler(os.Stdout, nil)).With("version", build.Version, "commit", build.ShortCommit()),
+		LogBody:  a.config.App.LogRequestBodies && a.config.App.Environment == "development",
+		SkipPath: []string{"/health", "/ready", "/metrics", "/version"},
+	}))
 	router.Use(gin.Recovery())
+	if a.config.Server.ProblemDetails {
+		router.Use(middleware.ProblemDetails(a.config.Server.ProblemTypeBase))
+	}
+	if a.config.Security.HeadersEnabled {
+		router.Use(middleware.SecurityHeaders(&a.config.Security))
+	}
+	if a.config.CacheControl.Enabled {
+		router.Use(middleware.CacheControl(&a.config.CacheControl))
+	}
+	router.Use(middleware.Deprecations(deprecation.NewRegistry(Deprecations...)))
+	router.Use(a.routerMiddleware...)
 
 	// Health check endpoint
 	// @Summary Health check endpoint
@@ -118,10 +341,61 @@ func (a *WireApp) SetupRouter() *gin.Engine {
 			"status":      "healthy",
 			"service":     a.config.App.Name,
 			"version":     a.config.App.Version,
+			"commit":      build.Commit,
+			"build_time":  build.BuildTime,
 			"environment": a.config.App.Environment,
+			"breakers":    resilience.States(),
 		})
 	})
 
+	// Build information endpoint
+	// @Summary Build information
+	// @Description Report the version, git commit, build time and Go version of the running binary
+	// @Tags health
+	// @Produce json
+	// @Success 200 {object} version.Info "Build information"
+	// @Router /version [get]
+	router.GET("/version", func(c *gin.Context) {
+		c.JSON(http.StatusOK, build)
+	})
+
+	// Readiness check endpoint
+	// @Summary Readiness check endpoint
+	// @Description Check if the service can serve traffic (database reachable, connection pool not exhausted)
+	// @Tags health
+	// @Produce json
+	// @Success 200 {object} map[string]interface{} "Service is ready"
+	// @Failure 503 {object} map[string]interface{} "Service is not ready"
+	// @Router /ready [get]
+	router.GET("/ready", func(c *gin.Context) {
+		if a.healthMonitor != nil {
+			if ready, reason := a.healthMonitor.Status(); !ready {
+				c.JSON(http.StatusServiceUnavailable, gin.H{
+					"status": "not ready",
+					"reason": reason,
+				})
+				return
+			}
+		}
+		c.JSON(http.StatusOK, gin.H{"status": "ready"})
+	})
+
+	// Prometheus metrics
+	metrics.SetBuildInfo(build.Version, build.Commit, build.BuildTime, build.GoVersion)
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+
+	// Sitemap of public event pages
+	router.GET("/sitemap.xml", a.feedHandler.Sitemap)
+
+	// Short links to shared events
+	router.GET("/s/:code", a.shareHandler.Redirect)
+	router.GET("/e/:code", a.shortURLHandler.Redirect)
+
+	// Runtime diagnostics for admins, unless they are served on their own address
+	if a.config.Diagnostics.Enabled && a.config.Diagnostics.Addr == "" {
+		a.diagnosticsHandler.RegisterRoutes(&router.RouterGroup)
+	}
+
 	// Swagger documentation
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -133,11 +407,193 @@ func (a *WireApp) SetupRouter() *gin.Engine {
 		a.eventHandler.RegisterRoutes(v1)
 		a.orderHandler.RegisterRoutes(v1)
 		a.venueHandler.RegisterRoutes(v1)
+		a.planHandler.RegisterRoutes(v1)
+		a.jobHandler.RegisterRoutes(v1)
+		a.reportHandler.RegisterRoutes(v1)
+		a.feedHandler.RegisterRoutes(v1)
+		a.shareHandler.RegisterRoutes(v1)
+		a.shortURLHandler.RegisterRoutes(v1)
+		a.staffHandler.RegisterRoutes(v1)
+		a.notificationHandler.RegisterRoutes(v1)
+		a.invoiceHandler.RegisterRoutes(v1)
+		a.accountHandler.RegisterRoutes(v1)
+		a.consentHandler.RegisterRoutes(v1)
+		a.ipAccessHandler.RegisterRoutes(v1)
+		a.analyticsHandler.RegisterRoutes(v1)
+		a.recommendationHandler.RegisterRoutes(v1)
+		a.trendingHandler.RegisterRoutes(v1)
+		a.refundHandler.RegisterRoutes(v1)
+		a.messageHandler.RegisterRoutes(v1)
+		a.webhookHandler.RegisterRoutes(v1)
+		a.transferHandler.RegisterRoutes(v1)
+		a.accessCodeHandler.RegisterRoutes(v1)
+		a.brandingHandler.RegisterRoutes(v1)
+		a.maintenanceHandler.RegisterRoutes(v1)
+		a.metaHandler.RegisterRoutes(v1)
+
+		// Email previews with sample data, unauthenticated and so never outside development
+		if a.config.App.Environment == "development" {
+			a.emailPreviewHandler.RegisterRoutes(v1)
+		}
 	}
 
 	return router
 }
 
+// newJWTService creates the JWT service from JWT_SECRET, JWT_ISSUER and JWT_EXPIRATION_HOURS
+func newJWTService() *auth.JWTService {
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		jwtSecret = "default-secret-key-change-in-production"
+	}
+
+	jwtIssuer := os.Getenv("JWT_ISSUER")
+	if jwtIssuer == "" {
+		jwtIssuer = "enterprise-crud-api"
+	}
+
+	jwtExpirationHours := 720 // 30 days default
+	if envHours := os.Getenv("JWT_EXPIRATION_HOURS"); envHours != "" {
+		if hours, err := strconv.Atoi(envHours); err == nil {
+			jwtExpirationHours = hours
+		}
+	}
+
+	return auth.NewJWTService(jwtSecret, jwtIssuer, time.Duration(jwtExpirationHours)*time.Hour)
+}
+
+// VenueOwnerCheck lets the venue service vet new venue owners against the user service
+func VenueOwnerCheck(users user.Service) venue.OwnerCheck {
+	return func(ctx context.Context, userID uuid.UUID) (bool, error) {
+		u, err := users.GetUserByID(ctx, userID)
+		if err != nil {
+			if user.GetUserErrorCode(err) == user.ErrUserNotFound.Code {
+				return false, nil
+			}
+			return false, err
+		}
+		return u.IsActive() && (u.HasRole(role.RoleOrganizer) || u.HasRole(role.RoleAdmin)), nil
+	}
+}
+
+// InvoiceBranding lets invoices embed the colors and logo of the event's organizer
+func InvoiceBranding(brandings branding.Service) invoice.BrandingLookup {
+	return func(ctx context.Context, organizerID uuid.UUID) (*invoice.Branding, error) {
+		b, err := brandings.GetBranding(ctx, organizerID)
+		if err != nil {
+			return nil, err
+		}
+		if b.IsEmpty() {
+			return nil, nil
+		}
+
+		result := &invoice.Branding{PrimaryColor: b.PrimaryColor, AccentColor: b.AccentColor}
+		if b.HasLogo() {
+			logo, err := brandings.GetLogo(ctx, organizerID)
+			if err != nil && !branding.IsLogoNotFoundError(err) {
+				return nil, err
+			}
+			if logo != nil {
+				result.LogoContentType = logo.ContentType
+				result.Logo = logo.Body
+			}
+		}
+		return result, nil
+	}
+}
+
+// NewPasswordHasher creates the hasher for new user passwords from configuration
+func NewPasswordHasher(cfg *config.PasswordsConfig) (user.PasswordHasher, error) {
+	switch cfg.Algorithm {
+	case user.AlgorithmBcrypt:
+		hasher, err := user.NewBcryptHasher(cfg.BcryptCost)
+		if err != nil {
+			return nil, err
+		}
+		return hasher, nil
+	case user.AlgorithmArgon2id:
+		hasher, err := user.NewArgon2idHasher(cfg.Argon2Time, cfg.Argon2MemoryKiB, cfg.Argon2Threads)
+		if err != nil {
+			return nil, err
+		}
+		return hasher, nil
+	default:
+		return nil, fmt.Errorf("unknown password algorithm %q, want %q or %q", cfg.Algorithm, user.AlgorithmBcrypt, user.AlgorithmArgon2id)
+	}
+}
+
+// NewMaintenanceService creates the maintenance service from configuration
+func NewMaintenanceService(repo maintenance.Repository, cfg *config.MaintenanceConfig, opts ...maintenance.ServiceOption) maintenance.Service {
+	return maintenance.NewService(repo, maintenance.Settings{
+		Enabled:  cfg.Enabled,
+		Message:  cfg.Message,
+		CacheTTL: cfg.CacheTTL,
+	}, opts...)
+}
+
+// NewMaintenanceGuard creates the middleware rejecting writes during maintenance
+// Logging in, the maintenance switch and diagnostics stay open, so admins can always turn
+// maintenance off and troubleshoot what it was turned on for.
+func NewMaintenanceGuard(maintenanceService maintenance.Service, cfg *config.MaintenanceConfig) gin.HandlerFunc {
+	return middleware.Maintenance(maintenanceService, cfg.RetryAfter, "/api/v1/auth/login", "/api/v1/admin/maintenance", "/debug")
+}
+
+// NewDiagnosticsServer creates the server for diagnostics.addr, or returns nil when diagnostics
+// are disabled or served on the API port
+func NewDiagnosticsServer(cfg *config.DiagnosticsConfig, handler *httpHandlers.DiagnosticsHandler) *diagnostics.Server {
+	if !cfg.Enabled || cfg.Addr == "" {
+		return nil
+	}
+	router := gin.New()
+	router.Use(gin.Recovery())
+	handler.RegisterInternalRoutes(&router.RouterGroup)
+	return diagnostics.NewServer(cfg.Addr, router)
+}
+
+// Routes lists the routes SetupRouter registers without connecting to anything
+// The handlers have no services behind them and must never be called; it is meant for tooling
+// such as cmd/gen-collection.
+func Routes(cfg *config.Config) gin.RoutesInfo {
+	jwtService := auth.NewJWTService("routes-only", cfg.App.Name, time.Hour) // Never signs or checks a token here
+	return routesOnlyApp(cfg, jwtService).SetupRouter().Routes()
+}
+
+// routesOnlyApp creates the application with every handler but no services behind them
+// Only requests refused before they reach a handler, e.g. by authentication, can be served.
+func routesOnlyApp(cfg *config.Config, jwtService *auth.JWTService) *WireApp {
+	return NewWireApp(cfg, nil, nil,
+		httpHandlers.NewUserHandler(nil, nil, jwtService),
+		httpHandlers.NewEventHandler(nil, nil, jwtService),
+		httpHandlers.NewOrderHandler(nil, jwtService),
+		httpHandlers.NewVenueHandler(nil, jwtService),
+		httpHandlers.NewPlanHandler(nil, jwtService),
+		httpHandlers.NewJobHandler(nil, jwtService),
+		httpHandlers.NewReportHandler(nil, jwtService),
+		httpHandlers.NewFeedHandler(nil, nil, &cfg.App, &cfg.Feeds),
+		httpHandlers.NewShareHandler(nil, jwtService),
+		httpHandlers.NewShortURLHandler(nil, nil, jwtService),
+		httpHandlers.NewStaffHandler(nil, nil, jwtService),
+		httpHandlers.NewNotificationHandler(nil, jwtService),
+		httpHandlers.NewInvoiceHandler(nil, jwtService),
+		httpHandlers.NewAccountHandler(nil, jwtService),
+		httpHandlers.NewConsentHandler(nil, jwtService),
+		httpHandlers.NewIPAccessHandler(nil, jwtService),
+		httpHandlers.NewAnalyticsHandler(nil, jwtService),
+		httpHandlers.NewRecommendationHandler(nil, jwtService),
+		httpHandlers.NewTrendingHandler(nil),
+		httpHandlers.NewRefundHandler(nil, jwtService),
+		httpHandlers.NewMessageHandler(nil, jwtService),
+		httpHandlers.NewWebhookHandler(nil, jwtService),
+		httpHandlers.NewTransferHandler(nil, jwtService),
+		httpHandlers.NewAccessCodeHandler(nil, jwtService),
+		httpHandlers.NewMaintenanceHandler(nil, jwtService),
+		httpHandlers.NewDiagnosticsHandler(cfg.Diagnostics.DumpDir, jwtService),
+		httpHandlers.NewEmailPreviewHandler(nil),
+		httpHandlers.NewBrandingHandler(nil, jwtService),
+		httpHandlers.NewMetaHandler(),
+	)
+}
+
 func (a *WireApp) waitForShutdown() error {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -146,7 +602,11 @@ func (a *WireApp) waitForShutdown() error {
 	log.Println("Shutting down server...")
 
 	// Create a context with timeout for graceful shutdown
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	timeout := a.config.Server.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	// Shutdown the server gracefully
@@ -154,6 +614,26 @@ func (a *WireApp) waitForShutdown() error {
 		return fmt.Errorf("server forced to shutdown: %w", err)
 	}
 
+	for _, svc := range a.background {
+		svc.Stop()
+	}
+
+	// Let background writes finish within what is left of the timeout
+	if a.lifecycle != nil {
+		if err := a.lifecycle.Shutdown(ctx); err != nil {
+			log.Printf("Warning: %v", err)
+		}
+	}
+
+	for _, hook := range a.shutdownHooks {
+		hook()
+	}
+
+	// Stop health checks before closing the connection they use
+	if a.healthMonitor != nil {
+		a.healthMonitor.Stop()
+	}
+
 	// Close database connection
 	if a.dbConn != nil {
 		a.dbConn.Close()
@@ -170,31 +650,123 @@ func (a *WireApp) waitForShutdown() error {
 
 // Dependencies injection interface
 type Dependencies struct {
-	Config       *config.Config
-	DBConn       *database.Connection
-	RedisClient  *cache.RedisClient
-	UserRepo     user.Repository
-	RoleRepo     role.Repository
-	EventRepo    event.Repository // Now can be cached or direct
-	UserService  user.Service
-	EventService event.Service
-	OrderService order.Service
-	VenueService venue.Service
-	JWTService   *auth.JWTService
-	UserHandler  *httpHandlers.UserHandler
-	EventHandler *httpHandlers.EventHandler
-	OrderHandler *httpHandlers.OrderHandler
-	VenueHandler *httpHandlers.VenueHandler
+	Config                *config.Config
+	DBConn                *database.Connection
+	RedisClient           *cache.RedisClient
+	Lifecycle             *lifecycle.Registry      // Goroutines shutdown waits for
+	CachePopulator        *cache.Populator         // nil when caching is disabled
+	EventCache            *cache.EventCacheService // nil when caching is disabled
+	UserRepo              user.Repository
+	RoleRepo              role.Repository
+	PlanRepo              plan.Repository
+	JobRepo               job.Repository
+	ReportRepo            report.Repository
+	ShortURLRepo          shorturl.Repository
+	StaffRepo             staff.Repository
+	NotificationRepo      notification.Repository
+	InvoiceRepo           invoice.Repository
+	AccountRepo           account.Repository
+	ConsentRepo           consent.Repository
+	IPAccessRepo          ipaccess.Repository
+	RefundRepo            refund.Repository
+	MessageRepo           messaging.Repository
+	WebhookRepo           webhook.Repository
+	TransferRepo          transfer.Repository
+	AccessCodeRepo        accesscode.Repository
+	BrandingRepo          branding.Repository
+	EventRepo             event.Repository // Now can be cached or direct
+	UserService           user.Service
+	EventService          event.Service
+	OrderService          order.Service
+	VenueService          venue.Service
+	PlanService           plan.Service
+	JobService            job.Service
+	JobRunner             *jobs.Runner // Handlers are registered on it by features that enqueue jobs
+	ReportService         report.Service
+	ShareService          share.Service
+	ShortURLService       shorturl.Service
+	StaffService          staff.Service
+	NotificationService   notification.Service
+	InvoiceService        invoice.Service
+	AccountService        account.Service
+	ConsentService        consent.Service
+	IPAccessService       ipaccess.Service
+	AnalyticsService      analytics.Service
+	RecommendationService recommendation.Service
+	TrendingService       trending.Service
+	RefundService         refund.Service
+	MessagingService      messaging.Service
+	WebhookService        webhook.Service
+	TransferService       transfer.Service
+	AccessCodeService     accesscode.Service
+	BrandingService       branding.Service
+	MaintenanceService    maintenance.Service
+	AdminIPFilter         gin.HandlerFunc
+	MaintenanceGuard      gin.HandlerFunc     // Answers writes with 503 during maintenance
+	DiagnosticsServer     *diagnostics.Server // nil unless diagnostics are served on diagnostics.addr
+	EventBus              *eventbus.Bus
+	ReportScheduler       *reports.Scheduler              // nil when reports.schedule_interval is 0
+	DeletionScheduler     *accounts.DeletionScheduler     // nil when accounts.deletion_check_interval is 0
+	TicketReconciler      *orders.ReconciliationScheduler // nil when reconciliation.interval is 0
+	EventArchiver         *orders.ArchiveScheduler        // nil when archive.interval or archive.retention_months is 0
+	OrderPartitions       *database.PartitionMaintainer   // nil when database.partition_check_interval is 0
+	SnapshotScheduler     *snapshots.Scheduler            // nil when analytics.snapshot_interval is 0
+	TrendingDecay         *trends.DecayScheduler          // nil when trending.decay_interval is 0
+	OnSaleScheduler       *events.OnSaleScheduler         // nil when events.on_sale_interval is 0
+	CDNPurges             *cdn.Queue                      // nil when cdn.enabled is false
+	AuditExporter         *auditexport.Exporter           // nil when observability.audit_export.enabled is false
+	RoleVersions          user.RoleVersions               // nil without Redis, when role changes take effect at the next login
+	JWTService            *auth.JWTService
+	UserHandler           *httpHandlers.UserHandler
+	EventHandler          *httpHandlers.EventHandler
+	OrderHandler          *httpHandlers.OrderHandler
+	VenueHandler          *httpHandlers.VenueHandler
+	PlanHandler           *httpHandlers.PlanHandler
+	JobHandler            *httpHandlers.JobHandler
+	ReportHandler         *httpHandlers.ReportHandler
+	FeedHandler           *httpHandlers.FeedHandler
+	ShareHandler          *httpHandlers.ShareHandler
+	ShortURLHandler       *httpHandlers.ShortURLHandler
+	StaffHandler          *httpHandlers.StaffHandler
+	NotificationHandler   *httpHandlers.NotificationHandler
+	InvoiceHandler        *httpHandlers.InvoiceHandler
+	AccountHandler        *httpHandlers.AccountHandler
+	ConsentHandler        *httpHandlers.ConsentHandler
+	IPAccessHandler       *httpHandlers.IPAccessHandler
+	AnalyticsHandler      *httpHandlers.AnalyticsHandler
+	RecommendationHandler *httpHandlers.RecommendationHandler
+	TrendingHandler       *httpHandlers.TrendingHandler
+	RefundHandler         *httpHandlers.RefundHandler
+	MessageHandler        *httpHandlers.MessageHandler
+	WebhookHandler        *httpHandlers.WebhookHandler
+	TransferHandler       *httpHandlers.TransferHandler
+	AccessCodeHandler     *httpHandlers.AccessCodeHandler
+	MaintenanceHandler    *httpHandlers.MaintenanceHandler
+	DiagnosticsHandler    *httpHandlers.DiagnosticsHandler
+	EmailPreviewHandler   *httpHandlers.EmailPreviewHandler
+	BrandingHandler       *httpHandlers.BrandingHandler
+	MetaHandler           *httpHandlers.MetaHandler
 }
 
 // NewDependencies creates all application dependencies manually (alternative to Wire)
 func NewDependencies(cfg *config.Config) (*Dependencies, error) {
 	// Database connection
-	dbConn, err := database.NewConnection()
+	dbConn, err := database.NewConnection(&cfg.Database)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	// Log slow queries with their parameters redacted; query plans are only captured in development
+	queryLogger := database.NewQueryLogger(database.QueryLoggerConfig{
+		SlowThreshold: cfg.Database.SlowQueryThreshold,
+		SampleRate:    cfg.Database.QuerySampleRate,
+		Explain:       cfg.Database.ExplainSlowQueries && cfg.App.Environment == "development",
+		LogLevel:      dbConn.LogLevel,
+	})
+	if err := dbConn.DB.Use(queryLogger); err != nil {
+		return nil, fmt.Errorf("failed to register query logger: %w", err)
+	}
+
 	// Redis connection
 	redisClient, err := cache.NewRedisClient(&cfg.Redis)
 	if err != nil {
@@ -207,70 +779,553 @@ func NewDependencies(cfg *config.Config) (*Dependencies, error) {
 	userRepo := database.NewUserRepository(dbConn.DB)
 	roleRepo := database.NewRoleRepository(dbConn.DB)
 	venueRepo := database.NewVenueRepository(dbConn.DB)
+	baseEventRepo := database.NewEventRepository(dbConn.DB)
+	orderRepo := database.NewOrderRepository(dbConn.DB)
+	planRepo := database.NewPlanRepository(dbConn.DB)
+	jobRepo := database.NewJobRepository(dbConn.DB)
+	reportRepo := database.NewReportRepository(dbConn.DB)
+	shortURLRepo := database.NewShortURLRepository(dbConn.DB)
+	staffRepo := database.NewStaffRepository(dbConn.DB)
+	notificationRepo := database.NewNotificationRepository(dbConn.DB)
+	invoiceRepo := database.NewInvoiceRepository(dbConn.DB)
+	accountRepo := database.NewAccountRepository(dbConn.DB)
+	consentRepo := database.NewConsentRepository(dbConn.DB)
+	ipAccessRepo := database.NewIPAccessRepository(dbConn.DB)
+	analyticsRepo := database.NewAnalyticsRepository(dbConn.DB)
+	refundRepo := database.NewRefundRepository(dbConn.DB)
+	messageRepo := database.NewMessageRepository(dbConn.DB)
+	webhookRepo := database.NewWebhookRepository(dbConn.DB)
+	transferRepo := database.NewTransferRepository(dbConn.DB)
+	accessCodeRepo := database.NewAccessCodeRepository(dbConn.DB)
+	brandingRepo := database.NewBrandingRepository(dbConn.DB)
+	auditRepo := database.NewAuditRepository(dbConn.DB)
+
+	// Injected faults sit below the resilience decorators so retries and breakers see them
+	if cfg.Chaos.Enabled && cfg.App.Environment != "production" {
+		faults := chaos.NewInjector(chaos.SettingsFromConfig(&cfg.Chaos))
+		userRepo = chaos.NewUserRepository(userRepo, faults)
+		roleRepo = chaos.NewRoleRepository(roleRepo, faults)
+		venueRepo = chaos.NewVenueRepository(venueRepo, faults)
+		baseEventRepo = chaos.NewEventRepository(baseEventRepo, faults)
+		orderRepo = chaos.NewOrderRepository(orderRepo, faults)
+		planRepo = chaos.NewPlanRepository(planRepo, faults)
+		if redisClient != nil {
+			redisClient.GetClient().AddHook(chaos.NewRedisHook(faults))
+		}
+		log.Printf("Chaos enabled: %s latency (+%s jitter), %.1f%% of database and Redis calls fail",
+			cfg.Chaos.Latency, cfg.Chaos.LatencyJitter, cfg.Chaos.ErrorPercent)
+	} else if cfg.Chaos.Enabled {
+		log.Println("Warning: chaos.enabled is ignored in production")
+	}
+
+	// Export circuit breaker transitions as metrics
+	resilience.OnStateChange(metrics.ObserveBreakerStateChange)
+
+	// Circuit breaker and retry handling around database calls
+	if cfg.Resilience.Enabled {
+		dbExecutor := resilience.NewExecutor(resilience.SettingsFromConfig("postgres", &cfg.Resilience))
+		userRepo = resilience.NewUserRepository(userRepo, dbExecutor)
+		roleRepo = resilience.NewRoleRepository(roleRepo, dbExecutor)
+		venueRepo = resilience.NewVenueRepository(venueRepo, dbExecutor)
+		baseEventRepo = resilience.NewEventRepository(baseEventRepo, dbExecutor)
+		orderRepo = resilience.NewOrderRepository(orderRepo, dbExecutor)
+		planRepo = resilience.NewPlanRepository(planRepo, dbExecutor)
+		reportRepo = resilience.NewReportRepository(reportRepo, dbExecutor)
+		shortURLRepo = resilience.NewShortURLRepository(shortURLRepo, dbExecutor)
+		staffRepo = resilience.NewStaffRepository(staffRepo, dbExecutor)
+		notificationRepo = resilience.NewNotificationRepository(notificationRepo, dbExecutor)
+		invoiceRepo = resilience.NewInvoiceRepository(invoiceRepo, dbExecutor)
+		accountRepo = resilience.NewAccountRepository(accountRepo, dbExecutor)
+		consentRepo = resilience.NewConsentRepository(consentRepo, dbExecutor)
+		ipAccessRepo = resilience.NewIPAccessRepository(ipAccessRepo, dbExecutor)
+		analyticsRepo = resilience.NewAnalyticsRepository(analyticsRepo, dbExecutor)
+		refundRepo = resilience.NewRefundRepository(refundRepo, dbExecutor)
+		messageRepo = resilience.NewMessageRepository(messageRepo, dbExecutor)
+		webhookRepo = resilience.NewWebhookRepository(webhookRepo, dbExecutor)
+		transferRepo = resilience.NewTransferRepository(transferRepo, dbExecutor)
+		accessCodeRepo = resilience.NewAccessCodeRepository(accessCodeRepo, dbExecutor)
+		brandingRepo = resilience.NewBrandingRepository(brandingRepo, dbExecutor)
+		auditRepo = resilience.NewAuditRepository(auditRepo, dbExecutor)
+	}
+
+	// Background goroutines run on one registry so shutdown can wait for them
+	registry := lifecycle.New(context.Background())
 
 	// Event repository with optional caching
 	var eventRepo event.Repository
-	baseEventRepo := database.NewEventRepository(dbConn.DB)
+	var cachedEventRepo *cache.CachedEventRepository
+	var eventCache *cache.EventCacheService
+	var cachePopulator *cache.Populator
 	if redisClient != nil {
 		// Use cached repository
-		eventCache := cache.NewEventCacheService(redisClient)
-		eventRepo = cache.NewCachedEventRepository(baseEventRepo, eventCache)
+		eventCache = cache.NewEventCacheService(redisClient)
+		cachePopulator = cache.NewPopulator(registry, cfg.Redis.PopulateWorkers, cfg.Redis.PopulateQueueSize, cfg.Redis.PopulateTimeout)
+		cachedEventRepo = cache.NewCachedEventRepository(baseEventRepo, eventCache, cachePopulator)
+		eventRepo = cachedEventRepo
 		log.Println("Event caching enabled")
+	} else if cfg.Redis.LocalCacheSize > 0 {
+		// Fall back to the in-process cache only
+		eventCache = cache.NewLocalEventCacheService(&cfg.Redis)
+		cachePopulator = cache.NewPopulator(registry, cfg.Redis.PopulateWorkers, cfg.Redis.PopulateQueueSize, cfg.Redis.PopulateTimeout)
+		cachedEventRepo = cache.NewCachedEventRepository(baseEventRepo, eventCache, cachePopulator)
+		eventRepo = cachedEventRepo
+		log.Println("Event caching enabled (in-process only)")
 	} else {
 		// Use direct database repository
 		eventRepo = baseEventRepo
 		log.Println("Event caching disabled")
 	}
 
-	orderRepo := database.NewOrderRepository(dbConn.DB)
+	// Purges go out from the event cache invalidations, so they need event caching
+	var cdnPurges *cdn.Queue
+	if cfg.CDN.Enabled && eventCache != nil {
+		cdnPurges = cdn.NewQueue(cdn.NewPurger(&cfg.CDN), &cfg.CDN)
+		eventCache.PurgeEdgeWith(cdnPurges)
+	} else if cfg.CDN.Enabled {
+		log.Println("Warning: CDN purging needs event caching, which is disabled; cached responses expire with their max-age")
+	}
+
+	// Short URL lookups are cached in Redis when it is available
+	if redisClient != nil {
+		shortURLRepo = cache.NewCachedShortURLRepository(shortURLRepo, redisClient)
+	}
+
+	// Sales series behind sellout forecasts are cached in Redis when it is available
+	if redisClient != nil && cfg.Reports.ForecastCacheTTL > 0 {
+		reportRepo = cache.NewCachedReportRepository(reportRepo, redisClient, cfg.Reports.ForecastCacheTTL)
+	}
+
+	// Domain events raised by services are delivered to subscribers in-process
+	eventBus := eventbus.New()
+
+	// Services tell the time and create record IDs through these, so tests can substitute fakes
+	var systemClock clock.Clock = clock.System{}
+	var ids clock.IDGenerator = clock.RandomIDs{}
+
+	passwordHasher, err := NewPasswordHasher(&cfg.Passwords)
+	if err != nil {
+		return nil, fmt.Errorf("invalid password hashing settings: %w", err)
+	}
+
+	// Role changes reach issued tokens through versions shared in Redis, which the admin CLI bumps too;
+	// without Redis they take effect at the next login
+	userOptions := []user.ServiceOption{user.WithClock(systemClock), user.WithIDGenerator(ids), user.WithPasswordHasher(passwordHasher)}
+	var roleVersions user.RoleVersions
+	if redisClient != nil {
+		roleVersions = cache.NewRoleVersionStore(redisClient)
+		userOptions = append(userOptions, user.WithRoleVersions(roleVersions))
+	} else {
+		log.Println("Role versions disabled: Redis unavailable, role changes take effect at the next login")
+	}
 
 	// Services
-	userService := user.NewUserService(userRepo, roleRepo)
-	venueService := venue.NewVenueService(venueRepo)
-	eventService := event.NewService(eventRepo, venueRepo)
-	orderService := order.NewOrderService(orderRepo, dbConn.DB)
+	userService := user.NewUserService(userRepo, roleRepo, userOptions...)
+	venueService := venue.NewVenueService(venueRepo, VenueOwnerCheck(userService), venue.WithClock(systemClock), venue.WithIDGenerator(ids))
+	planService := plan.NewService(planRepo)
+	jobService := job.NewService(jobRepo, job.WithClock(systemClock), job.WithIDGenerator(ids))
+	jobRunner := jobs.NewRunner(jobRepo, cfg.Jobs)
+	eventOptions := []event.ServiceOption{event.WithCoOrganizers(staffRepo), event.WithClock(systemClock)}
+	if cfg.Moderation.Enabled {
+		moderator, err := moderation.New(&cfg.Moderation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure moderation: %w", err)
+		}
+		eventOptions = append(eventOptions, event.WithModerator(moderator))
+	}
+	eventService := event.NewService(eventRepo, venueRepo, planService, eventBus, eventOptions...)
+	orderOptions := []order.ServiceOption{
+		order.WithClock(systemClock), order.WithIDGenerator(ids),
+		order.WithAccessCodes(accessCodeRepo), order.WithAgeGate(userService), order.WithGifts(jobService),
+	}
+	if cfg.Fraud.Enabled {
+		scorer := order.NewRuleScorer(orderRepo, order.RiskRules{
+			MaxEventOrders: cfg.Fraud.MaxEventOrders,
+			MaxRapidOrders: cfg.Fraud.MaxRapidOrders,
+			RapidWindow:    cfg.Fraud.RapidWindow,
+			CheckCountry:   cfg.Fraud.CheckCountry,
+		})
+		orderOptions = append(orderOptions, order.WithRiskScorer(scorer, order.RiskThresholds{
+			Review: cfg.Fraud.ReviewScore,
+			Reject: cfg.Fraud.RejectScore,
+		}))
+	}
+	if !cfg.Orders.ConditionalTicketUpdate {
+		orderOptions = append(orderOptions, order.WithLegacyTicketUpdate())
+	}
+	if cfg.Orders.GroupHold > 0 {
+		orderOptions = append(orderOptions, order.WithGroupReservations(cfg.Orders.GroupHold))
+	}
+	orderService := order.NewOrderService(orderRepo, dbConn.DB, eventBus, orderOptions...)
+	reportService := report.NewService(reportRepo, jobService, report.WithClock(systemClock), report.WithIDGenerator(ids))
+	shortURLService := shorturl.NewService(shortURLRepo, cfg.App.PublicURL)
+	staffService := staff.NewService(staffRepo, eventService, staff.WithClock(systemClock))
+	// No payment integration yet: approved refunds are logged for manual payout
+	refundService := refund.NewService(refundRepo, orderService, eventService, payments.NewLogProvider(), refund.Policy{
+		RequestWindow: cfg.Refunds.RequestWindow,
+	}, refund.WithClock(systemClock), refund.WithPublisher(eventBus))
+	messagingService := messaging.NewService(messageRepo, orderService, eventService, eventBus, messaging.WithClock(systemClock), messaging.WithIDGenerator(ids))
+	// Transfers write the event's organizer directly, so both organizers' cached listings are dropped
+	// through the cached repository, which keeps reads racing the transfer from caching the old organizer
+	var transferCaches transfer.CacheInvalidator
+	if cachedEventRepo != nil {
+		transferCaches = cachedEventRepo
+	}
+	transferService := transfer.NewService(transferRepo, eventService, transferCaches, transfer.WithClock(systemClock), transfer.WithIDGenerator(ids))
+	accessCodeService := accesscode.NewService(accessCodeRepo, eventService, accesscode.WithIDGenerator(ids))
+	notificationService := notification.NewService(notificationRepo, jobService, notification.WithClock(systemClock), notification.WithIDGenerator(ids))
+	notification.Subscribe(eventBus, notificationService, eventService)
+	if cfg.SMS.CancellationAlerts {
+		notification.SubscribeSMSAlerts(eventBus, notificationService, eventService, systemClock)
+	}
+	if cfg.Fraud.Enabled && cfg.Fraud.SuspendScore > 0 {
+		user.SubscribeFraudSuspensions(eventBus, userService, cfg.Fraud.SuspendScore)
+	}
+	webhookService := webhook.NewService(webhookRepo, jobService, webhook.Settings{
+		MaxPerUser:   cfg.Webhooks.MaxPerUser,
+		AllowHTTP:    cfg.Webhooks.AllowHTTP,
+		AllowPrivate: cfg.Webhooks.AllowPrivate,
+	}, webhook.WithClock(systemClock), webhook.WithIDGenerator(ids))
+	webhook.Subscribe(eventBus, webhookService)
 
-	// JWT Service
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		jwtSecret = "default-secret-key-change-in-production"
+	// Short links need Redis; share metadata is served without them otherwise
+	var shareLinks share.LinkRepository
+	if redisClient != nil {
+		shareLinks = cache.NewShareLinkStore(redisClient)
 	}
+	shareService := share.NewService(eventService, venueService, shareLinks, share.Config{
+		PublicURL: cfg.App.PublicURL,
+		ImageURL:  cfg.Share.ImageURL,
+		LinkTTL:   cfg.Share.LinkTTL,
+	}, share.WithClock(systemClock))
 
-	jwtIssuer := os.Getenv("JWT_ISSUER")
-	if jwtIssuer == "" {
-		jwtIssuer = "enterprise-crud-api"
+	// Scheduled report, notification and gift claim emails are rendered and sent by job workers
+	emailRenderer, err := email.NewRenderer(cfg.Email.DefaultLocale)
+	if err != nil {
+		return nil, err
 	}
+	emailSender := email.NewSender(&cfg.Email)
+	jobRunner.Register(report.JobTypeSalesSummary, reports.SalesSummaryHandler(reportService, emailRenderer, emailSender))
+	jobRunner.Register(notification.JobTypeEmail, notifications.EmailHandler(notificationService, emailRenderer, emailSender))
+	jobRunner.Register(order.JobTypeGiftClaim, notifications.GiftClaimHandler(orderService, eventService, userService, emailRenderer, emailSender, cfg.App.PublicURL))
 
-	jwtExpirationHours := 720 // 30 days default
-	if envHours := os.Getenv("JWT_EXPIRATION_HOURS"); envHours != "" {
-		if hours, err := strconv.Atoi(envHours); err == nil {
-			jwtExpirationHours = hours
+	// Push notifications are sent per device by job workers, which retry provider outages
+	pushProvider, err := push.NewProvider(&cfg.Push)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure push notifications: %w", err)
+	}
+	jobRunner.Register(notification.JobTypePush, notifications.PushHandler(notificationService, pushProvider))
+	jobRunner.Register(notification.JobTypeSMS, notifications.SMSHandler(sms.NewSender(&cfg.SMS)))
+
+	// Order status webhooks are posted to buyers' endpoints by job workers, which retry endpoints that are down
+	jobRunner.Register(webhook.JobTypeDeliver, webhooks.DeliveryHandler(webhookService, webhooks.NewClient(cfg.Webhooks.Timeout, cfg.Webhooks.AllowPrivate)))
+
+	// Invoices and data exports are built by job workers into storage shared with the API instances
+	objectStore, err := storage.NewFileStore(cfg.Storage.Dir)
+	if err != nil {
+		return nil, err
+	}
+	// Organizer logos live in the same storage and are embedded in the invoices of their events
+	brandingService := branding.NewService(brandingRepo, objectStore, cfg.App.PublicURL, branding.WithClock(systemClock))
+	invoiceService := invoice.NewService(invoiceRepo, objectStore, jobService, invoice.Settings{
+		SellerName:    cfg.Invoices.SellerName,
+		SellerAddress: cfg.Invoices.SellerAddress,
+		TaxLabel:      cfg.Invoices.TaxLabel,
+		TaxRate:       cfg.Invoices.TaxRate,
+		Currency:      cfg.Invoices.Currency,
+	}, invoice.WithBranding(InvoiceBranding(brandingService)), invoice.WithClock(systemClock))
+	invoiceRenderer, err := invoices.NewRenderer()
+	if err != nil {
+		return nil, err
+	}
+	jobRunner.Register(invoice.JobTypeGenerate, invoices.GenerateHandler(invoiceService, invoiceRenderer))
+
+	accountService := account.NewService(accountRepo, objectStore, jobService, cfg.Accounts.DeletionGracePeriod, account.WithClock(systemClock))
+	jobRunner.Register(account.JobTypeExport, accounts.ExportHandler(accountService))
+
+	var reportScheduler *reports.Scheduler
+	if cfg.Reports.ScheduleInterval > 0 {
+		reportScheduler = reports.NewScheduler(reportService, cfg.Reports.ScheduleInterval)
+	}
+
+	// Unique visitors are counted in Redis HyperLogLogs; without Redis no views are counted
+	analyticsOptions := []analytics.Option{analytics.WithClock(systemClock)}
+	if redisClient != nil {
+		viewSalt := []byte(cfg.Analytics.ViewSalt)
+		if len(viewSalt) == 0 {
+			viewSalt = make([]byte, 32)
+			if _, err := rand.Read(viewSalt); err != nil {
+				return nil, fmt.Errorf("failed to generate view salt: %w", err)
+			}
+		}
+		analyticsOptions = append(analyticsOptions, analytics.WithViewCounter(cache.NewViewCounter(redisClient), viewSalt))
+	}
+	analyticsService := analytics.NewService(analyticsRepo, analyticsOptions...)
+	var snapshotScheduler *snapshots.Scheduler
+	if cfg.Analytics.SnapshotInterval > 0 {
+		snapshotScheduler = snapshots.NewScheduler(analyticsService, cfg.Analytics.SnapshotInterval)
+	}
+
+	consentService := consent.NewService(consentRepo, consent.WithClock(systemClock))
+
+	recommendationService := recommendation.NewService(orderService, eventService)
+
+	// Trending scores are shared through Redis when available, otherwise kept by this instance
+	trendingRepo := memory.NewTrendingRepository()
+	if redisClient != nil {
+		trendingRepo = cache.NewTrendingStore(redisClient)
+	}
+	trendingService := trending.NewService(trendingRepo, eventService, trending.Config{
+		HalfLife:       cfg.Trending.HalfLife,
+		ViewWeight:     cfg.Trending.ViewWeight,
+		PurchaseWeight: cfg.Trending.PurchaseWeight,
+	}, trending.WithClock(systemClock))
+	trending.Subscribe(eventBus, trendingService)
+	var trendingDecay *trends.DecayScheduler
+	if cfg.Trending.DecayInterval > 0 {
+		trendingDecay = trends.NewDecayScheduler(trendingService, cfg.Trending.DecayInterval)
+	}
+
+	var auditExporter *auditexport.Exporter
+	if cfg.Observability.AuditExport.Enabled {
+		auditSink, err := auditexport.NewSink(&cfg.Observability.AuditExport)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure audit export: %w", err)
+		}
+		auditExporter = auditexport.NewExporter(auditRepo, auditSink, &cfg.Observability.AuditExport)
+	}
+
+	ipAccessService, err := ipaccess.NewService(ipAccessRepo, ipaccess.Settings{
+		Allow:    cfg.Security.AdminAllow,
+		Deny:     cfg.Security.AdminDeny,
+		CacheTTL: cfg.Security.AdminRulesTTL,
+	}, ipaccess.WithClock(systemClock))
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin IP rules: %w", err)
+	}
+	restrictedPaths := []string{"/api/v1/admin"}
+	if cfg.Security.RestrictSwagger && cfg.App.Environment == "production" {
+		restrictedPaths = append(restrictedPaths, "/swagger")
+	}
+	adminIPFilter := middleware.RestrictIPs(ipAccessService, restrictedPaths...)
+
+	// Maintenance mode is shared through Redis when available, otherwise switched per instance
+	maintenanceRepo := memory.NewMaintenanceRepository()
+	if redisClient != nil {
+		maintenanceRepo = cache.NewMaintenanceStore(redisClient)
+	}
+	maintenanceService := NewMaintenanceService(maintenanceRepo, &cfg.Maintenance, maintenance.WithClock(systemClock))
+	maintenanceGuard := NewMaintenanceGuard(maintenanceService, &cfg.Maintenance)
+
+	// Anti-bot challenges on registration and checkout; velocity is shared through Redis when available
+	var botGuard *antibot.Guard
+	if cfg.AntiBot.Enabled {
+		var counter antibot.Counter = antibot.NewMemoryCounter()
+		if redisClient != nil {
+			counter = antibot.NewRedisCounter(redisClient)
+		}
+		botGuard, err = antibot.NewGuard(&cfg.AntiBot, counter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure anti-bot protection: %w", err)
 		}
 	}
 
-	jwtService := auth.NewJWTService(jwtSecret, jwtIssuer, time.Duration(jwtExpirationHours)*time.Hour)
+	// Double-submits of event and venue creation are caught across instances through Redis when available
+	var duplicateStore dedupe.Store = dedupe.NewMemoryStore()
+	if redisClient != nil {
+		duplicateStore = dedupe.NewRedisStore(redisClient)
+	}
+
+	// Concurrent checkouts are limited across instances through Redis when available, otherwise per instance
+	var checkoutSlots concurrency.Store = concurrency.NewMemoryStore()
+	if redisClient != nil {
+		checkoutSlots = concurrency.NewRedisStore(redisClient)
+	}
+	checkoutLimiter := concurrency.NewLimiter(checkoutSlots, concurrency.Limits{
+		PerUser: cfg.Orders.MaxConcurrentPerUser,
+		Total:   cfg.Orders.MaxConcurrent,
+	}, cfg.Orders.ConcurrencyWait)
+
+	var deletionScheduler *accounts.DeletionScheduler
+	if cfg.Accounts.DeletionCheckInterval > 0 {
+		deletionScheduler = accounts.NewDeletionScheduler(accountService, cfg.Accounts.DeletionCheckInterval)
+	}
+
+	var ticketReconciler *orders.ReconciliationScheduler
+	if cfg.Reconciliation.Interval > 0 {
+		ticketReconciler = orders.NewReconciliationScheduler(orderService, cfg.Reconciliation.Interval, cfg.Reconciliation.AutoCorrect)
+	}
+
+	var eventArchiver *orders.ArchiveScheduler
+	if cfg.Archive.Interval > 0 && cfg.Archive.RetentionMonths > 0 {
+		eventArchiver = orders.NewArchiveScheduler(orderService, cfg.Archive.Interval, cfg.Archive.RetentionMonths)
+	}
+
+	var onSaleScheduler *events.OnSaleScheduler
+	if cfg.Events.OnSaleInterval > 0 {
+		onSaleScheduler = events.NewOnSaleScheduler(eventService, cfg.Events.OnSaleInterval)
+	}
+
+	var orderPartitions *database.PartitionMaintainer
+	if cfg.Database.PartitionCheckInterval > 0 {
+		orderPartitions = database.NewPartitionMaintainer(dbConn.DB, cfg.Database.PartitionCheckInterval, cfg.Database.PartitionMonthsAhead)
+	}
+
+	// JWT Service
+	jwtService := newJWTService()
+	// Suspended and banned users lose access immediately rather than when their token expires
+	jwtService.CheckAccountsWith(userService)
+	// Tokens issued before a role change get the user's current roles on their next request
+	if roleVersions != nil {
+		jwtService.CheckRolesWith(userService)
+	}
 
 	// Handlers
-	userHandler := httpHandlers.NewUserHandler(userService, jwtService)
-	eventHandler := httpHandlers.NewEventHandler(eventService, jwtService)
+	userHandler := httpHandlers.NewUserHandler(userService, consentService, jwtService, httpHandlers.WithClock(systemClock))
+	userHandler.ClaimGiftsWith(orderService)
+	if botGuard != nil {
+		userHandler.RequireBeforeRegistration(middleware.RequireHumanCheck(botGuard, "register"))
+	}
+	eventHandler := httpHandlers.NewEventHandler(eventService, shortURLService, jwtService, httpHandlers.WithClock(systemClock))
+	eventHandler.CountViewsWith(trendingService)
+	eventHandler.TrackVisitorsWith(analyticsService)
+	eventHandler.BrandWith(brandingService)
+	eventHandler.RequireBeforeCreate(middleware.RejectDuplicates(duplicateStore, cfg.Duplicates.EventCreateWindow))
 	orderHandler := httpHandlers.NewOrderHandler(orderService, jwtService)
+	orderHandler.CacheStatusFor(cfg.Orders.StatusMaxAge)
+	orderHandler.LinkGroupSharesTo(cfg.App.PublicURL)
+	orderHandler.RequireBeforePurchase(middleware.RequireTermsAccepted(consentService))
+	if cfg.Fraud.Enabled {
+		orderHandler.ReadCountryFrom(cfg.Fraud.CountryHeader)
+	}
+	if botGuard != nil {
+		orderHandler.RequireBeforePurchase(middleware.RequireHumanCheck(botGuard, "checkout"))
+	}
+	// Checked last, so requests turned away by the checks above never wait for a slot
+	orderHandler.RequireBeforePurchase(middleware.LimitConcurrency(checkoutLimiter))
 	venueHandler := httpHandlers.NewVenueHandler(venueService, jwtService)
+	venueHandler.RequireBeforeCreate(middleware.RejectDuplicates(duplicateStore, cfg.Duplicates.VenueCreateWindow))
+	planHandler := httpHandlers.NewPlanHandler(planService, jwtService)
+	jobHandler := httpHandlers.NewJobHandler(jobService, jwtService)
+	reportHandler := httpHandlers.NewReportHandler(reportService, jwtService, httpHandlers.WithClock(systemClock))
+	feedHandler := httpHandlers.NewFeedHandler(eventService, venueService, &cfg.App, &cfg.Feeds, httpHandlers.WithClock(systemClock))
+	shareHandler := httpHandlers.NewShareHandler(shareService, jwtService)
+	shortURLHandler := httpHandlers.NewShortURLHandler(shortURLService, staffService, jwtService)
+	staffHandler := httpHandlers.NewStaffHandler(staffService, orderService, jwtService)
+	notificationHandler := httpHandlers.NewNotificationHandler(notificationService, jwtService)
+	invoiceHandler := httpHandlers.NewInvoiceHandler(invoiceService, jwtService)
+	accountHandler := httpHandlers.NewAccountHandler(accountService, jwtService)
+	consentHandler := httpHandlers.NewConsentHandler(consentService, jwtService)
+	ipAccessHandler := httpHandlers.NewIPAccessHandler(ipAccessService, jwtService)
+	analyticsHandler := httpHandlers.NewAnalyticsHandler(analyticsService, jwtService, httpHandlers.WithClock(systemClock))
+	recommendationHandler := httpHandlers.NewRecommendationHandler(recommendationService, jwtService, httpHandlers.WithClock(systemClock))
+	trendingHandler := httpHandlers.NewTrendingHandler(trendingService, httpHandlers.WithClock(systemClock))
+	refundHandler := httpHandlers.NewRefundHandler(refundService, jwtService)
+	messageHandler := httpHandlers.NewMessageHandler(messagingService, jwtService)
+	webhookHandler := httpHandlers.NewWebhookHandler(webhookService, jwtService)
+	transferHandler := httpHandlers.NewTransferHandler(transferService, jwtService)
+	accessCodeHandler := httpHandlers.NewAccessCodeHandler(accessCodeService, jwtService)
+	brandingHandler := httpHandlers.NewBrandingHandler(brandingService, jwtService)
+	maintenanceHandler := httpHandlers.NewMaintenanceHandler(maintenanceService, jwtService)
+	diagnosticsHandler := httpHandlers.NewDiagnosticsHandler(cfg.Diagnostics.DumpDir, jwtService, httpHandlers.WithClock(systemClock))
+	emailPreviewHandler := httpHandlers.NewEmailPreviewHandler(emailRenderer, httpHandlers.WithClock(systemClock))
+	metaHandler := httpHandlers.NewMetaHandler()
 
 	return &Dependencies{
-		Config:       cfg,
-		DBConn:       dbConn,
-		RedisClient:  redisClient,
-		UserRepo:     userRepo,
-		RoleRepo:     roleRepo,
-		EventRepo:    eventRepo,
-		UserService:  userService,
-		EventService: eventService,
-		OrderService: orderService,
-		VenueService: venueService,
-		JWTService:   jwtService,
-		UserHandler:  userHandler,
-		EventHandler: eventHandler,
-		OrderHandler: orderHandler,
-		VenueHandler: venueHandler,
+		Config:                cfg,
+		DBConn:                dbConn,
+		RedisClient:           redisClient,
+		Lifecycle:             registry,
+		CachePopulator:        cachePopulator,
+		EventCache:            eventCache,
+		UserRepo:              userRepo,
+		RoleRepo:              roleRepo,
+		PlanRepo:              planRepo,
+		JobRepo:               jobRepo,
+		ReportRepo:            reportRepo,
+		ShortURLRepo:          shortURLRepo,
+		StaffRepo:             staffRepo,
+		NotificationRepo:      notificationRepo,
+		InvoiceRepo:           invoiceRepo,
+		AccountRepo:           accountRepo,
+		ConsentRepo:           consentRepo,
+		IPAccessRepo:          ipAccessRepo,
+		RefundRepo:            refundRepo,
+		MessageRepo:           messageRepo,
+		WebhookRepo:           webhookRepo,
+		TransferRepo:          transferRepo,
+		AccessCodeRepo:        accessCodeRepo,
+		BrandingRepo:          brandingRepo,
+		EventRepo:             eventRepo,
+		RoleVersions:          roleVersions,
+		UserService:           userService,
+		EventService:          eventService,
+		OrderService:          orderService,
+		VenueService:          venueService,
+		PlanService:           planService,
+		JobService:            jobService,
+		JobRunner:             jobRunner,
+		ReportService:         reportService,
+		ShareService:          shareService,
+		ShortURLService:       shortURLService,
+		StaffService:          staffService,
+		NotificationService:   notificationService,
+		InvoiceService:        invoiceService,
+		AccountService:        accountService,
+		ConsentService:        consentService,
+		IPAccessService:       ipAccessService,
+		AnalyticsService:      analyticsService,
+		RecommendationService: recommendationService,
+		TrendingService:       trendingService,
+		RefundService:         refundService,
+		MessagingService:      messagingService,
+		WebhookService:        webhookService,
+		TransferService:       transferService,
+		AccessCodeService:     accessCodeService,
+		BrandingService:       brandingService,
+		MaintenanceService:    maintenanceService,
+		AdminIPFilter:         adminIPFilter,
+		MaintenanceGuard:      maintenanceGuard,
+		DiagnosticsServer:     NewDiagnosticsServer(&cfg.Diagnostics, diagnosticsHandler),
+		EventBus:              eventBus,
+		ReportScheduler:       reportScheduler,
+		DeletionScheduler:     deletionScheduler,
+		TicketReconciler:      ticketReconciler,
+		EventArchiver:         eventArchiver,
+		OrderPartitions:       orderPartitions,
+		SnapshotScheduler:     snapshotScheduler,
+		TrendingDecay:         trendingDecay,
+		OnSaleScheduler:       onSaleScheduler,
+		CDNPurges:             cdnPurges,
+		AuditExporter:         auditExporter,
+		JWTService:            jwtService,
+		UserHandler:           userHandler,
+		EventHandler:          eventHandler,
+		OrderHandler:          orderHandler,
+		VenueHandler:          venueHandler,
+		PlanHandler:           planHandler,
+		JobHandler:            jobHandler,
+		ReportHandler:         reportHandler,
+		FeedHandler:           feedHandler,
+		ShareHandler:          shareHandler,
+		ShortURLHandler:       shortURLHandler,
+		StaffHandler:          staffHandler,
+		NotificationHandler:   notificationHandler,
+		InvoiceHandler:        invoiceHandler,
+		AccountHandler:        accountHandler,
+		ConsentHandler:        consentHandler,
+		IPAccessHandler:       ipAccessHandler,
+		AnalyticsHandler:      analyticsHandler,
+		RecommendationHandler: recommendationHandler,
+		TrendingHandler:       trendingHandler,
+		RefundHandler:         refundHandler,
+		MessageHandler:        messageHandler,
+		WebhookHandler:        webhookHandler,
+		TransferHandler:       transferHandler,
+		AccessCodeHandler:     accessCodeHandler,
+		MaintenanceHandler:    maintenanceHandler,
+		DiagnosticsHandler:    diagnosticsHandler,
+		EmailPreviewHandler:   emailPreviewHandler,
+		BrandingHandler:       brandingHandler,
+		MetaHandler:           metaHandler,
 	}, nil
 }
@@ -2,23 +2,40 @@ package app
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"runtime"
 	"testing"
 	"time"
 
 	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/account"
+	"enterprise-crud/internal/domain/analytics"
+	"enterprise-crud/internal/domain/consent"
 	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/invoice"
+	"enterprise-crud/internal/domain/ipaccess"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/notification"
 	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/plan"
+	"enterprise-crud/internal/domain/report"
+	"enterprise-crud/internal/domain/share"
+	"enterprise-crud/internal/domain/shorturl"
+	"enterprise-crud/internal/domain/staff"
 	"enterprise-crud/internal/domain/user"
 	"enterprise-crud/internal/domain/venue"
 	"enterprise-crud/internal/infrastructure/auth"
+	"enterprise-crud/internal/infrastructure/email"
 	httpHandlers "enterprise-crud/internal/presentation/http"
+	"enterprise-crud/internal/version"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockUserService is a mock implementation of user.Service interface
@@ -36,11 +53,82 @@ func (m *MockUserService) GetUserByEmail(ctx context.Context, email string) (*us
 	return args.Get(0).(*user.User), args.Error(1)
 }
 
+func (m *MockUserService) IsUsernameAvailable(ctx context.Context, username string) (bool, error) {
+	args := m.Called(ctx, username)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserService) GetUserByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) SetAccountStatus(ctx context.Context, userID uuid.UUID, status, reason string, actorID *uuid.UUID) (*user.User, error) {
+	args := m.Called(ctx, userID, status, reason, actorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) CheckAccountStatus(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserService) GetStatusHistory(ctx context.Context, userID uuid.UUID) ([]*user.StatusChange, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*user.StatusChange), args.Error(1)
+}
+
+func (m *MockUserService) SetDateOfBirth(ctx context.Context, userID uuid.UUID, dateOfBirth *time.Time) (*user.User, error) {
+	args := m.Called(ctx, userID, dateOfBirth)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) DateOfBirth(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
 func (m *MockUserService) AuthenticateUser(ctx context.Context, email, password string) (*user.User, error) {
 	args := m.Called(ctx, email, password)
 	return args.Get(0).(*user.User), args.Error(1)
 }
 
+func (m *MockUserService) GrantRole(ctx context.Context, email, roleName string) (*user.User, error) {
+	args := m.Called(ctx, email, roleName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) RevokeRole(ctx context.Context, email, roleName string) (*user.User, error) {
+	args := m.Called(ctx, email, roleName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) RolesVersion(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // MockEventService is a mock implementation of event.Service interface
 type MockEventService struct {
 	mock.Mock
@@ -56,13 +144,23 @@ func (m *MockEventService) GetEventByID(ctx context.Context, id uuid.UUID) (*eve
 	return args.Get(0).(*event.Event), args.Error(1)
 }
 
-func (m *MockEventService) GetAllEvents(ctx context.Context) ([]*event.Event, error) {
-	args := m.Called(ctx)
+func (m *MockEventService) GetEventBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	args := m.Called(ctx, slug)
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetAllEvents(ctx context.Context, filter event.ListFilter, viewer event.Viewer) ([]*event.Event, error) {
+	args := m.Called(ctx, filter, viewer)
 	return args.Get(0).([]*event.Event), args.Error(1)
 }
 
-func (m *MockEventService) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID) ([]*event.Event, error) {
-	args := m.Called(ctx, organizerID)
+func (m *MockEventService) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, filter event.OrganizerFilter) ([]*event.Event, int, error) {
+	args := m.Called(ctx, organizerID, filter)
+	return args.Get(0).([]*event.Event), args.Int(1), args.Error(2)
+}
+
+func (m *MockEventService) GetUpcomingEvents(ctx context.Context, filter event.UpcomingFilter) ([]*event.Event, error) {
+	args := m.Called(ctx, filter)
 	return args.Get(0).([]*event.Event), args.Error(1)
 }
 
@@ -76,6 +174,40 @@ func (m *MockEventService) CancelEvent(ctx context.Context, eventID uuid.UUID, o
 	return args.Error(0)
 }
 
+func (m *MockEventService) ApproveModeration(ctx context.Context, eventID uuid.UUID, adminID uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, eventID, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) CanManage(ctx context.Context, e *event.Event, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, e, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockEventService) RefreshOnSale(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockEventService) AdjustInventory(ctx context.Context, eventID uuid.UUID, viewer event.Viewer, delta int, reason string) (*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer, delta, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID, viewer event.Viewer) ([]*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*event.InventoryAdjustment), args.Error(1)
+}
+
 func (m *MockEventService) DeleteEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
 	args := m.Called(ctx, eventID, organizerID)
 	return args.Error(0)
@@ -86,8 +218,8 @@ type MockOrderService struct {
 	mock.Mock
 }
 
-func (m *MockOrderService) CreateOrder(ctx context.Context, userID uuid.UUID, eventID uuid.UUID, quantity int) (*order.Order, error) {
-	args := m.Called(ctx, userID, eventID, quantity)
+func (m *MockOrderService) CreateOrder(ctx context.Context, userID uuid.UUID, eventID uuid.UUID, quantity int, details order.Details) (*order.Order, error) {
+	args := m.Called(ctx, userID, eventID, quantity, details)
 	return args.Get(0).(*order.Order), args.Error(1)
 }
 
@@ -96,6 +228,14 @@ func (m *MockOrderService) GetOrderByID(ctx context.Context, id uuid.UUID) (*ord
 	return args.Get(0).(*order.Order), args.Error(1)
 }
 
+func (m *MockOrderService) GetOrderStatus(ctx context.Context, id uuid.UUID) (*order.StatusSnapshot, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.StatusSnapshot), args.Error(1)
+}
+
 func (m *MockOrderService) GetOrdersByUserID(ctx context.Context, userID uuid.UUID) ([]*order.Order, error) {
 	args := m.Called(ctx, userID)
 	return args.Get(0).([]*order.Order), args.Error(1)
@@ -106,7 +246,15 @@ func (m *MockOrderService) GetOrdersByEventID(ctx context.Context, eventID uuid.
 	return args.Get(0).([]*order.Order), args.Error(1)
 }
 
-func (m *MockOrderService) UpdateOrderStatus(ctx context.Context, id uuid.UUID, status string) error {
+func (m *MockOrderService) GetAttendees(ctx context.Context, eventID uuid.UUID) ([]*order.Attendee, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Attendee), args.Error(1)
+}
+
+func (m *MockOrderService) UpdateOrderStatus(ctx context.Context, id uuid.UUID, status order.Status) error {
 	args := m.Called(ctx, id, status)
 	return args.Error(0)
 }
@@ -116,6 +264,82 @@ func (m *MockOrderService) DeleteOrder(ctx context.Context, id uuid.UUID) error
 	return args.Error(0)
 }
 
+func (m *MockOrderService) CheckIn(ctx context.Context, eventID uuid.UUID, orderID uuid.UUID) (*order.Order, error) {
+	args := m.Called(ctx, eventID, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockOrderService) ExpirePendingOrders(ctx context.Context, olderThan time.Duration) (int64, error) {
+	args := m.Called(ctx, olderThan)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockOrderService) ListOrdersForReview(ctx context.Context, limit int) ([]*order.Order, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
+func (m *MockOrderService) ReviewOrder(ctx context.Context, id uuid.UUID, approve bool) (*order.Order, error) {
+	args := m.Called(ctx, id, approve)
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockOrderService) ReconcileTickets(ctx context.Context, dryRun bool) (*order.Reconciliation, error) {
+	args := m.Called(ctx, dryRun)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Reconciliation), args.Error(1)
+}
+
+func (m *MockOrderService) ArchiveEvents(ctx context.Context, cutoff time.Time) (*order.Archived, error) {
+	args := m.Called(ctx, cutoff)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Archived), args.Error(1)
+}
+
+func (m *MockOrderService) ResendGift(ctx context.Context, userID, orderID uuid.UUID) error {
+	args := m.Called(ctx, userID, orderID)
+	return args.Error(0)
+}
+
+func (m *MockOrderService) ClaimGift(ctx context.Context, userID uuid.UUID, token string) (*order.Order, error) {
+	args := m.Called(ctx, userID, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockOrderService) CreateGroup(ctx context.Context, leaderID, eventID uuid.UUID, shares []int, details order.Details) (*order.GroupReservation, error) {
+	args := m.Called(ctx, leaderID, eventID, shares, details)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.GroupReservation), args.Error(1)
+}
+
+func (m *MockOrderService) GetGroup(ctx context.Context, leaderID, groupID uuid.UUID) (*order.Group, error) {
+	args := m.Called(ctx, leaderID, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Group), args.Error(1)
+}
+
+func (m *MockOrderService) TakeGroupShare(ctx context.Context, userID uuid.UUID, token string) (*order.Order, error) {
+	args := m.Called(ctx, userID, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
 // MockVenueService is a mock implementation of venue.Service interface
 type MockVenueService struct {
 	mock.Mock
@@ -136,16 +360,492 @@ func (m *MockVenueService) GetAllVenues(ctx context.Context) ([]*venue.Venue, er
 	return args.Get(0).([]*venue.Venue), args.Error(1)
 }
 
-func (m *MockVenueService) UpdateVenue(ctx context.Context, venue *venue.Venue) error {
-	args := m.Called(ctx, venue)
+func (m *MockVenueService) UpdateVenue(ctx context.Context, actor venue.Actor, venue *venue.Venue) error {
+	args := m.Called(ctx, actor, venue)
+	return args.Error(0)
+}
+
+func (m *MockVenueService) DeleteVenue(ctx context.Context, actor venue.Actor, id uuid.UUID) error {
+	args := m.Called(ctx, actor, id)
 	return args.Error(0)
 }
 
-func (m *MockVenueService) DeleteVenue(ctx context.Context, id uuid.UUID) error {
+func (m *MockVenueService) TransferOwnership(ctx context.Context, actor venue.Actor, id, newOwnerID uuid.UUID) (*venue.Venue, error) {
+	args := m.Called(ctx, actor, id, newOwnerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*venue.Venue), args.Error(1)
+}
+
+// MockPlanService is a mock implementation of plan.Service
+type MockPlanService struct {
+	mock.Mock
+}
+
+func (m *MockPlanService) GetAllPlans(ctx context.Context) ([]*plan.Plan, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*plan.Plan), args.Error(1)
+}
+
+func (m *MockPlanService) GetUserPlan(ctx context.Context, userID uuid.UUID) (*plan.Plan, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(*plan.Plan), args.Error(1)
+}
+
+func (m *MockPlanService) AssignPlan(ctx context.Context, userID uuid.UUID, planName string) (*plan.Plan, error) {
+	args := m.Called(ctx, userID, planName)
+	return args.Get(0).(*plan.Plan), args.Error(1)
+}
+
+// MockJobService is a mock implementation of job.Service
+type MockJobService struct {
+	mock.Mock
+}
+
+func (m *MockJobService) Enqueue(ctx context.Context, jobType string, payload interface{}, opts ...job.EnqueueOption) (*job.Job, error) {
+	args := m.Called(ctx, jobType, payload)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) GetJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
 	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) ListJobs(ctx context.Context, status string, limit int) ([]*job.Job, error) {
+	args := m.Called(ctx, status, limit)
+	return args.Get(0).([]*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) RetryJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+// MockReportService is a mock implementation of report.Service
+type MockReportService struct {
+	mock.Mock
+}
+
+func (m *MockReportService) GetSchedule(ctx context.Context, userID uuid.UUID) (*report.Schedule, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(*report.Schedule), args.Error(1)
+}
+
+func (m *MockReportService) UpdateSchedule(ctx context.Context, userID uuid.UUID, frequency string, enabled bool) (*report.Schedule, error) {
+	args := m.Called(ctx, userID, frequency, enabled)
+	return args.Get(0).(*report.Schedule), args.Error(1)
+}
+
+func (m *MockReportService) GetSalesSummary(ctx context.Context, organizerID uuid.UUID, from, to time.Time) (*report.SalesSummary, error) {
+	args := m.Called(ctx, organizerID, from, to)
+	return args.Get(0).(*report.SalesSummary), args.Error(1)
+}
+
+func (m *MockReportService) GetForecast(ctx context.Context, eventID uuid.UUID) (*report.Forecast, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).(*report.Forecast), args.Error(1)
+}
+
+func (m *MockReportService) EnqueueDueReports(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockReportService) PrepareSalesReport(ctx context.Context, payload report.SalesSummaryPayload) (*report.SalesReport, error) {
+	args := m.Called(ctx, payload)
+	return args.Get(0).(*report.SalesReport), args.Error(1)
+}
+
+func (m *MockReportService) MarkReportSent(ctx context.Context, userID uuid.UUID, sentAt time.Time) error {
+	args := m.Called(ctx, userID, sentAt)
 	return args.Error(0)
 }
 
+// MockShortURLService is a mock implementation of shorturl.Service
+type MockShortURLService struct {
+	mock.Mock
+}
+
+func (m *MockShortURLService) CreateForEvent(ctx context.Context, eventID uuid.UUID) (*shorturl.ShortURL, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).(*shorturl.ShortURL), args.Error(1)
+}
+
+func (m *MockShortURLService) GetForEvent(ctx context.Context, eventID uuid.UUID) (*shorturl.ShortURL, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).(*shorturl.ShortURL), args.Error(1)
+}
+
+func (m *MockShortURLService) GetURLs(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	args := m.Called(ctx, eventIDs)
+	return args.Get(0).(map[uuid.UUID]string), args.Error(1)
+}
+
+func (m *MockShortURLService) Resolve(ctx context.Context, code string) (*shorturl.ShortURL, error) {
+	args := m.Called(ctx, code)
+	return args.Get(0).(*shorturl.ShortURL), args.Error(1)
+}
+
+func (m *MockShortURLService) URL(code string) string {
+	args := m.Called(code)
+	return args.String(0)
+}
+
+func (m *MockShortURLService) TargetURL(shortURL *shorturl.ShortURL) string {
+	args := m.Called(shortURL)
+	return args.String(0)
+}
+
+// MockStaffService is a mock implementation of staff.Service
+type MockStaffService struct {
+	mock.Mock
+}
+
+func (m *MockStaffService) Invite(ctx context.Context, actor staff.Actor, eventID uuid.UUID, email, role string) (*staff.Assignment, error) {
+	args := m.Called(ctx, actor, eventID, email, role)
+	return args.Get(0).(*staff.Assignment), args.Error(1)
+}
+
+func (m *MockStaffService) ListStaff(ctx context.Context, actor staff.Actor, eventID uuid.UUID) ([]*staff.Assignment, error) {
+	args := m.Called(ctx, actor, eventID)
+	return args.Get(0).([]*staff.Assignment), args.Error(1)
+}
+
+func (m *MockStaffService) RemoveStaff(ctx context.Context, actor staff.Actor, eventID, userID uuid.UUID) error {
+	args := m.Called(ctx, actor, eventID, userID)
+	return args.Error(0)
+}
+
+func (m *MockStaffService) ListInvitations(ctx context.Context, userID uuid.UUID) ([]*staff.Assignment, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*staff.Assignment), args.Error(1)
+}
+
+func (m *MockStaffService) AcceptInvitation(ctx context.Context, userID, invitationID uuid.UUID) (*staff.Assignment, error) {
+	args := m.Called(ctx, userID, invitationID)
+	return args.Get(0).(*staff.Assignment), args.Error(1)
+}
+
+func (m *MockStaffService) Authorize(ctx context.Context, actor staff.Actor, eventID uuid.UUID, permission staff.Permission) (*event.Event, error) {
+	args := m.Called(ctx, actor, eventID, permission)
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+// MockNotificationService is a mock implementation of notification.Service interface
+type MockNotificationService struct {
+	mock.Mock
+}
+
+func (m *MockNotificationService) List(ctx context.Context, userID uuid.UUID, filter notification.ListFilter) ([]*notification.Notification, int64, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*notification.Notification), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockNotificationService) MarkRead(ctx context.Context, userID, id uuid.UUID) error {
+	args := m.Called(ctx, userID, id)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationService) GetPreferences(ctx context.Context, userID uuid.UUID) ([]*notification.Preference, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notification.Preference), args.Error(1)
+}
+
+func (m *MockNotificationService) UpdatePreferences(ctx context.Context, userID uuid.UUID, updates []notification.PreferenceUpdate) ([]*notification.Preference, error) {
+	args := m.Called(ctx, userID, updates)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notification.Preference), args.Error(1)
+}
+
+func (m *MockNotificationService) Notify(ctx context.Context, userID uuid.UUID, msg notification.Message) error {
+	args := m.Called(ctx, userID, msg)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) NotifyTicketHolders(ctx context.Context, eventID uuid.UUID, msg notification.Message) error {
+	args := m.Called(ctx, eventID, msg)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) PrepareEmail(ctx context.Context, payload notification.EmailPayload) (*notification.Email, error) {
+	args := m.Called(ctx, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notification.Email), args.Error(1)
+}
+
+func (m *MockNotificationService) RegisterDevice(ctx context.Context, userID uuid.UUID, platform, token string) (*notification.Device, error) {
+	args := m.Called(ctx, userID, platform, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notification.Device), args.Error(1)
+}
+
+func (m *MockNotificationService) UnregisterDevice(ctx context.Context, userID, deviceID uuid.UUID) error {
+	args := m.Called(ctx, userID, deviceID)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) RemoveDeviceToken(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) GetPhone(ctx context.Context, userID uuid.UUID) (*notification.PhoneContact, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notification.PhoneContact), args.Error(1)
+}
+
+func (m *MockNotificationService) StartPhoneVerification(ctx context.Context, userID uuid.UUID, phone string) (*notification.PhoneVerification, error) {
+	args := m.Called(ctx, userID, phone)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notification.PhoneVerification), args.Error(1)
+}
+
+func (m *MockNotificationService) VerifyPhone(ctx context.Context, userID uuid.UUID, code string) (*notification.PhoneContact, error) {
+	args := m.Called(ctx, userID, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notification.PhoneContact), args.Error(1)
+}
+
+func (m *MockNotificationService) RemovePhone(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) AlertTicketHoldersBySMS(ctx context.Context, eventID uuid.UUID, body string) error {
+	args := m.Called(ctx, eventID, body)
+	return args.Error(0)
+}
+
+// MockInvoiceService is a mock implementation of invoice.Service interface
+type MockInvoiceService struct {
+	mock.Mock
+}
+
+func (m *MockInvoiceService) GetInvoice(ctx context.Context, requesterID uuid.UUID, isAdmin bool, orderID uuid.UUID) (*invoice.Document, error) {
+	args := m.Called(ctx, requesterID, isAdmin, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*invoice.Document), args.Error(1)
+}
+
+func (m *MockInvoiceService) PrepareInvoice(ctx context.Context, orderID uuid.UUID) (*invoice.Invoice, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*invoice.Invoice), args.Error(1)
+}
+
+func (m *MockInvoiceService) SaveDocument(ctx context.Context, orderID uuid.UUID, body []byte) error {
+	args := m.Called(ctx, orderID, body)
+	return args.Error(0)
+}
+
+// MockAccountService is a mock implementation of account.Service interface
+type MockAccountService struct {
+	mock.Mock
+}
+
+func (m *MockAccountService) RequestExport(ctx context.Context, userID uuid.UUID) (*account.Export, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Export), args.Error(1)
+}
+
+func (m *MockAccountService) GetExport(ctx context.Context, userID, exportID uuid.UUID) (*account.Export, []byte, error) {
+	args := m.Called(ctx, userID, exportID)
+	export, _ := args.Get(0).(*account.Export)
+	archive, _ := args.Get(1).([]byte)
+	return export, archive, args.Error(2)
+}
+
+func (m *MockAccountService) PrepareExport(ctx context.Context, exportID uuid.UUID) (*account.Export, *account.PersonalData, error) {
+	args := m.Called(ctx, exportID)
+	export, _ := args.Get(0).(*account.Export)
+	data, _ := args.Get(1).(*account.PersonalData)
+	return export, data, args.Error(2)
+}
+
+func (m *MockAccountService) SaveExport(ctx context.Context, export *account.Export, archive []byte) error {
+	args := m.Called(ctx, export, archive)
+	return args.Error(0)
+}
+
+func (m *MockAccountService) FailExport(ctx context.Context, exportID uuid.UUID) error {
+	args := m.Called(ctx, exportID)
+	return args.Error(0)
+}
+
+func (m *MockAccountService) RequestDeletion(ctx context.Context, userID uuid.UUID) (time.Time, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockAccountService) CancelDeletion(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockAccountService) ProcessDueDeletions(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+// MockConsentService is a mock implementation of consent.Service interface
+type MockConsentService struct {
+	mock.Mock
+}
+
+func (m *MockConsentService) PublishVersion(ctx context.Context, policyType, version, documentURL string) (*consent.Version, error) {
+	args := m.Called(ctx, policyType, version, documentURL)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*consent.Version), args.Error(1)
+}
+
+func (m *MockConsentService) ListVersions(ctx context.Context, policyType string) ([]*consent.Version, error) {
+	args := m.Called(ctx, policyType)
+	versions, _ := args.Get(0).([]*consent.Version)
+	return versions, args.Error(1)
+}
+
+func (m *MockConsentService) GetLatestVersions(ctx context.Context) ([]*consent.Version, error) {
+	args := m.Called(ctx)
+	versions, _ := args.Get(0).([]*consent.Version)
+	return versions, args.Error(1)
+}
+
+func (m *MockConsentService) GetPendingVersions(ctx context.Context, userID uuid.UUID) ([]*consent.Version, error) {
+	args := m.Called(ctx, userID)
+	versions, _ := args.Get(0).([]*consent.Version)
+	return versions, args.Error(1)
+}
+
+func (m *MockConsentService) ListAcceptances(ctx context.Context, userID uuid.UUID) ([]*consent.Acceptance, error) {
+	args := m.Called(ctx, userID)
+	acceptances, _ := args.Get(0).([]*consent.Acceptance)
+	return acceptances, args.Error(1)
+}
+
+func (m *MockConsentService) Accept(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID) ([]*consent.Version, error) {
+	args := m.Called(ctx, userID, versionIDs)
+	versions, _ := args.Get(0).([]*consent.Version)
+	return versions, args.Error(1)
+}
+
+func (m *MockConsentService) AcceptPending(ctx context.Context, userID uuid.UUID) ([]*consent.Version, error) {
+	args := m.Called(ctx, userID)
+	versions, _ := args.Get(0).([]*consent.Version)
+	return versions, args.Error(1)
+}
+
+func (m *MockConsentService) CheckTermsAccepted(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// MockIPAccessService is a mock implementation of ipaccess.Service interface
+type MockIPAccessService struct {
+	mock.Mock
+}
+
+func (m *MockIPAccessService) ListRules(ctx context.Context) ([]*ipaccess.Rule, error) {
+	args := m.Called(ctx)
+	rules, _ := args.Get(0).([]*ipaccess.Rule)
+	return rules, args.Error(1)
+}
+
+func (m *MockIPAccessService) AddRule(ctx context.Context, cidr, action, description string, createdBy uuid.UUID, callerIP string) (*ipaccess.Rule, error) {
+	args := m.Called(ctx, cidr, action, description, createdBy, callerIP)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ipaccess.Rule), args.Error(1)
+}
+
+func (m *MockIPAccessService) DeleteRule(ctx context.Context, id uuid.UUID, callerIP string) error {
+	args := m.Called(ctx, id, callerIP)
+	return args.Error(0)
+}
+
+func (m *MockIPAccessService) IsAllowed(ctx context.Context, ip string) (bool, error) {
+	args := m.Called(ctx, ip)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockAnalyticsService is a mock implementation of analytics.Service interface
+type MockAnalyticsService struct {
+	mock.Mock
+}
+
+func (m *MockAnalyticsService) TakeSnapshot(ctx context.Context, t time.Time) (*analytics.DailySnapshot, error) {
+	args := m.Called(ctx, t)
+	snapshot, _ := args.Get(0).(*analytics.DailySnapshot)
+	return snapshot, args.Error(1)
+}
+
+func (m *MockAnalyticsService) TakeDueSnapshots(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockAnalyticsService) Backfill(ctx context.Context, from, to time.Time) (int, error) {
+	args := m.Called(ctx, from, to)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetDailySnapshots(ctx context.Context, from, to time.Time) ([]*analytics.DailySnapshot, error) {
+	args := m.Called(ctx, from, to)
+	snapshots, _ := args.Get(0).([]*analytics.DailySnapshot)
+	return snapshots, args.Error(1)
+}
+
+func (m *MockAnalyticsService) RecordView(ctx context.Context, eventID uuid.UUID, visitor string) error {
+	args := m.Called(ctx, eventID, visitor)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsService) MoveViews(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockAnalyticsService) GetOrganizerSales(ctx context.Context, organizerID uuid.UUID, from, to time.Time) ([]*analytics.SalesDay, error) {
+	args := m.Called(ctx, organizerID, from, to)
+	days, _ := args.Get(0).([]*analytics.SalesDay)
+	return days, args.Error(1)
+}
+
 func setupTestWireApp() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 
@@ -163,16 +863,75 @@ func setupTestWireApp() *gin.Engine {
 	mockOrderService := new(MockOrderService)
 	jwtService := auth.NewJWTService("test-secret-key", "test-issuer", time.Hour)
 
-	userHandler := httpHandlers.NewUserHandler(mockUserService, jwtService)
-	eventHandler := httpHandlers.NewEventHandler(mockEventService, jwtService)
+	userHandler := httpHandlers.NewUserHandler(mockUserService, nil, jwtService)
+	eventHandler := httpHandlers.NewEventHandler(mockEventService, nil, jwtService)
 	orderHandler := httpHandlers.NewOrderHandler(mockOrderService, jwtService)
 
 	// Create mock venue service and handler
 	mockVenueService := new(MockVenueService)
 	venueHandler := httpHandlers.NewVenueHandler(mockVenueService, jwtService)
 
+	// Create mock plan service and handler
+	mockPlanService := new(MockPlanService)
+	planHandler := httpHandlers.NewPlanHandler(mockPlanService, jwtService)
+
+	// Create mock job service and handler
+	mockJobService := new(MockJobService)
+	jobHandler := httpHandlers.NewJobHandler(mockJobService, jwtService)
+
+	// Create mock report service and handler
+	mockReportService := new(MockReportService)
+	reportHandler := httpHandlers.NewReportHandler(mockReportService, jwtService)
+
+	// Create feed handler on the mock event and venue services
+	feedHandler := httpHandlers.NewFeedHandler(mockEventService, mockVenueService, &cfg.App, &cfg.Feeds)
+
+	// Create share handler without a short link store
+	shareHandler := httpHandlers.NewShareHandler(share.NewService(mockEventService, mockVenueService, nil, share.Config{PublicURL: cfg.App.PublicURL}), jwtService)
+
+	// Create mock staff service and handler
+	mockStaffService := new(MockStaffService)
+	staffHandler := httpHandlers.NewStaffHandler(mockStaffService, mockOrderService, jwtService)
+
+	// Create mock short URL service and handler
+	mockShortURLService := new(MockShortURLService)
+	shortURLHandler := httpHandlers.NewShortURLHandler(mockShortURLService, mockStaffService, jwtService)
+
+	// Create mock notification service and handler
+	mockNotificationService := new(MockNotificationService)
+	notificationHandler := httpHandlers.NewNotificationHandler(mockNotificationService, jwtService)
+
+	// Create mock invoice service and handler
+	invoiceHandler := httpHandlers.NewInvoiceHandler(new(MockInvoiceService), jwtService)
+
+	// Create mock account service and handler
+	accountHandler := httpHandlers.NewAccountHandler(new(MockAccountService), jwtService)
+
+	// Create mock consent service and handler
+	consentHandler := httpHandlers.NewConsentHandler(new(MockConsentService), jwtService)
+
+	// Create mock IP access service and handler
+	ipAccessHandler := httpHandlers.NewIPAccessHandler(new(MockIPAccessService), jwtService)
+	analyticsHandler := httpHandlers.NewAnalyticsHandler(new(MockAnalyticsService), jwtService)
+	recommendationHandler := httpHandlers.NewRecommendationHandler(nil, jwtService)
+	trendingHandler := httpHandlers.NewTrendingHandler(nil)
+	refundHandler := httpHandlers.NewRefundHandler(nil, jwtService)
+	messageHandler := httpHandlers.NewMessageHandler(nil, jwtService)
+	webhookHandler := httpHandlers.NewWebhookHandler(nil, jwtService)
+	transferHandler := httpHandlers.NewTransferHandler(nil, jwtService)
+	accessCodeHandler := httpHandlers.NewAccessCodeHandler(nil, jwtService)
+	maintenanceHandler := httpHandlers.NewMaintenanceHandler(nil, jwtService)
+	diagnosticsHandler := httpHandlers.NewDiagnosticsHandler(cfg.Diagnostics.DumpDir, jwtService)
+	emailRenderer, err := email.NewRenderer("")
+	if err != nil {
+		panic(err)
+	}
+	emailPreviewHandler := httpHandlers.NewEmailPreviewHandler(emailRenderer)
+	brandingHandler := httpHandlers.NewBrandingHandler(nil, jwtService)
+	metaHandler := httpHandlers.NewMetaHandler()
+
 	// Create a test app instance
-	app := NewWireApp(cfg, nil, nil, userHandler, eventHandler, orderHandler, venueHandler)
+	app := NewWireApp(cfg, nil, nil, userHandler, eventHandler, orderHandler, venueHandler, planHandler, jobHandler, reportHandler, feedHandler, shareHandler, shortURLHandler, staffHandler, notificationHandler, invoiceHandler, accountHandler, consentHandler, ipAccessHandler, analyticsHandler, recommendationHandler, trendingHandler, refundHandler, messageHandler, webhookHandler, transferHandler, accessCodeHandler, maintenanceHandler, diagnosticsHandler, emailPreviewHandler, brandingHandler, metaHandler)
 
 	return app.SetupRouter()
 }
@@ -192,6 +951,21 @@ func TestWireApp_HealthCheck(t *testing.T) {
 	assert.Contains(t, w.Body.String(), `"environment":"test"`)
 }
 
+func TestWireApp_Version(t *testing.T) {
+	router := setupTestWireApp()
+
+	req, _ := http.NewRequest(http.MethodGet, "/version", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var info version.Info
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &info))
+	assert.Equal(t, version.Get(), info)
+	assert.Equal(t, runtime.Version(), info.GoVersion)
+}
+
 func TestWireApp_SwaggerEndpoint(t *testing.T) {
 	router := setupTestWireApp()
 
@@ -270,3 +1044,75 @@ func TestWireApp_RouterSetup(t *testing.T) {
 	assert.True(t, foundSwaggerRoute, "Swagger route should be registered")
 	assert.True(t, foundUserRoute, "User routes should be registered")
 }
+
+func TestRoutes_ListsRegisteredRoutesWithoutServices(t *testing.T) {
+	routes := Routes(&config.Config{App: config.AppConfig{Name: "test-app"}})
+
+	registered := make(map[string]bool)
+	for _, route := range routes {
+		registered[route.Method+" "+route.Path] = true
+	}
+	assert.True(t, registered["POST /api/v1/auth/login"])
+	assert.True(t, registered["POST /api/v1/orders"])
+	assert.True(t, registered["GET /api/v1/events/:id"])
+	assert.Equal(t, len(setupTestWireApp().Routes()), len(routes))
+}
+
+func TestRoutes_Diagnostics(t *testing.T) {
+	registered := func(cfg *config.Config) map[string]bool {
+		paths := make(map[string]bool)
+		for _, route := range Routes(cfg) {
+			paths[route.Method+" "+route.Path] = true
+		}
+		return paths
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		routes := registered(&config.Config{})
+		assert.False(t, routes["GET /debug/vars"])
+	})
+
+	t.Run("on the API port", func(t *testing.T) {
+		routes := registered(&config.Config{Diagnostics: config.DiagnosticsConfig{Enabled: true}})
+		assert.True(t, routes["GET /debug/pprof/*profile"])
+		assert.True(t, routes["GET /debug/vars"])
+		assert.True(t, routes["POST /debug/dumps"])
+	})
+
+	t.Run("on their own address", func(t *testing.T) {
+		cfg := &config.DiagnosticsConfig{Enabled: true, Addr: "127.0.0.1:0"}
+		routes := registered(&config.Config{Diagnostics: *cfg})
+		assert.False(t, routes["GET /debug/vars"])
+		assert.NotNil(t, NewDiagnosticsServer(cfg, httpHandlers.NewDiagnosticsHandler(t.TempDir(), nil)))
+	})
+}
+
+func TestRoutes_EmailPreviews(t *testing.T) {
+	previews := func(environment string) bool {
+		for _, route := range Routes(&config.Config{App: config.AppConfig{Environment: environment}}) {
+			if route.Path == "/api/v1/dev/emails/:name" {
+				return true
+			}
+		}
+		return false
+	}
+
+	assert.True(t, previews("development"))
+	assert.False(t, previews("staging"))
+	assert.False(t, previews("production"))
+}
+
+func TestNewPasswordHasher(t *testing.T) {
+	hasher, err := NewPasswordHasher(&config.PasswordsConfig{Algorithm: "argon2id", Argon2Time: 1, Argon2MemoryKiB: 64, Argon2Threads: 1})
+	require.NoError(t, err)
+	assert.Equal(t, user.AlgorithmArgon2id, hasher.Algorithm())
+
+	hasher, err = NewPasswordHasher(&config.PasswordsConfig{Algorithm: "bcrypt", BcryptCost: 4})
+	require.NoError(t, err)
+	assert.Equal(t, user.AlgorithmBcrypt, hasher.Algorithm())
+
+	_, err = NewPasswordHasher(&config.PasswordsConfig{Algorithm: "bcrypt", BcryptCost: 40})
+	assert.Error(t, err)
+	_, err = NewPasswordHasher(&config.PasswordsConfig{Algorithm: "scrypt"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,157 @@
+// Package deprecation describes the parts of the API being phased out, so clients are
+// told through Deprecation and Sunset headers and list warnings well before they go.
+package deprecation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Deprecation announces that an endpoint, one of its query parameters or a field of its response is going away
+type Deprecation struct {
+	Method string // HTTP method of the endpoint
+	Path   string // Route as registered, e.g. /api/v1/events/:id
+
+	// Param or Field narrow the deprecation to part of the endpoint; leave both empty for the whole endpoint
+	Param    string      // Query parameter
+	Field    string      // JSON field of the response, dotted for nested fields, e.g. meta.total
+	Response interface{} // Response DTO holding Field, so tests can check it stays until the sunset
+
+	Since       time.Time // When the deprecation was announced
+	Sunset      time.Time // From when it may be removed
+	Replacement string    // What to use instead, if anything
+}
+
+// Subject names what is deprecated
+func (d Deprecation) Subject() string {
+	endpoint := d.Method + " " + d.Path
+	switch {
+	case d.Param != "":
+		return fmt.Sprintf("the %s parameter of %s", d.Param, endpoint)
+	case d.Field != "":
+		return fmt.Sprintf("the %s field of %s", d.Field, endpoint)
+	default:
+		return endpoint
+	}
+}
+
+// Warning returns the message shown to clients that use the deprecated part
+func (d Deprecation) Warning() string {
+	message := fmt.Sprintf("%s is deprecated and will be removed after %s", d.Subject(), d.Sunset.Format(time.DateOnly))
+	if d.Replacement != "" {
+		message += "; use " + d.Replacement + " instead"
+	}
+	return message
+}
+
+// Validate checks that the deprecation is complete and consistent
+func (d Deprecation) Validate() error {
+	switch {
+	case d.Method == "" || !strings.HasPrefix(d.Path, "/"):
+		return fmt.Errorf("deprecation needs a method and a route path")
+	case d.Param != "" && d.Field != "":
+		return fmt.Errorf("%s: set only one of Param and Field", d.Subject())
+	case d.Field != "" && d.Response == nil:
+		return fmt.Errorf("%s: a deprecated field needs the Response it belongs to", d.Subject())
+	case d.Since.IsZero() || !d.Sunset.After(d.Since):
+		return fmt.Errorf("%s: the sunset must come after the deprecation", d.Subject())
+	}
+	return nil
+}
+
+// Registry looks up the deprecations of a request
+type Registry struct {
+	byRoute map[string][]Deprecation
+}
+
+// NewRegistry creates a registry of deprecations
+func NewRegistry(deprecations ...Deprecation) *Registry {
+	r := &Registry{byRoute: make(map[string][]Deprecation)}
+	for _, d := range deprecations {
+		key := d.Method + " " + d.Path
+		r.byRoute[key] = append(r.byRoute[key], d)
+	}
+	return r
+}
+
+// Applicable returns the deprecations a request to a route touches, earliest sunset first
+// Deprecated parameters only count when the request sends them; the endpoint and fields of its
+// response always do.
+func (r *Registry) Applicable(method, route string, params map[string][]string) []Deprecation {
+	var applicable []Deprecation
+	for _, d := range r.byRoute[method+" "+route] {
+		if d.Param != "" {
+			if _, sent := params[d.Param]; !sent {
+				continue
+			}
+		}
+		applicable = append(applicable, d)
+	}
+	sort.SliceStable(applicable, func(i, j int) bool { return applicable[i].Sunset.Before(applicable[j].Sunset) })
+	return applicable
+}
+
+// HasField reports whether response still has the dotted JSON field
+// Fields of embedded structs count as the JSON encoder promotes them.
+func HasField(response interface{}, field string) bool {
+	typ := reflect.TypeOf(response)
+	for _, name := range strings.Split(field, ".") {
+		for typ != nil && (typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice) {
+			typ = typ.Elem()
+		}
+		if typ == nil || typ.Kind() != reflect.Struct {
+			return false
+		}
+		next, ok := jsonField(typ, name)
+		if !ok {
+			return false
+		}
+		typ = next
+	}
+	return true
+}
+
+// jsonField returns the type of the struct field encoded under name
+func jsonField(typ reflect.Type, name string) (reflect.Type, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if tag == "-" || !f.IsExported() && !f.Anonymous {
+			continue
+		}
+		if f.Anonymous && tag == "" {
+			embedded := f.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if found, ok := jsonField(embedded, name); ok {
+					return found, true
+				}
+				continue
+			}
+		}
+		if tag == name || tag == "" && f.Name == name {
+			return f.Type, true
+		}
+	}
+	return nil, false
+}
+
+// warningsKey is the context key of a request's deprecation warnings
+type warningsKey struct{}
+
+// WithWarnings returns a copy of ctx carrying the warnings of the deprecations a request touches
+func WithWarnings(ctx context.Context, warnings []string) context.Context {
+	return context.WithValue(ctx, warningsKey{}, warnings)
+}
+
+// Warnings returns the deprecation warnings carried by ctx, or nil
+func Warnings(ctx context.Context) []string {
+	warnings, _ := ctx.Value(warningsKey{}).([]string)
+	return warnings
+}
@@ -0,0 +1,85 @@
+package deprecation
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	since  = time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	sunset = time.Date(2027, 4, 17, 0, 0, 0, 0, time.UTC)
+)
+
+type page struct {
+	Meta  meta `json:"meta"`
+	inner `json:"-"`
+}
+
+type meta struct {
+	Total *int `json:"total,omitempty"`
+}
+
+type inner struct {
+	Hidden string `json:"hidden"`
+}
+
+type wrapped struct {
+	page
+	Items []item `json:"items"`
+}
+
+type item struct {
+	Name string `json:"name"`
+}
+
+func TestDeprecation_Warning(t *testing.T) {
+	d := Deprecation{Method: "GET", Path: "/api/v1/events/my-events", Param: "offset", Since: since, Sunset: sunset, Replacement: "cursor"}
+
+	assert.Equal(t, "the offset parameter of GET /api/v1/events/my-events is deprecated and will be removed after 2027-04-17; use cursor instead", d.Warning())
+	assert.NoError(t, d.Validate())
+}
+
+func TestDeprecation_Validate(t *testing.T) {
+	tests := map[string]Deprecation{
+		"no method":           {Path: "/api/v1/events", Since: since, Sunset: sunset},
+		"param and field":     {Method: "GET", Path: "/api/v1/events", Param: "a", Field: "b", Response: page{}, Since: since, Sunset: sunset},
+		"field sans response": {Method: "GET", Path: "/api/v1/events", Field: "meta.total", Since: since, Sunset: sunset},
+		"sunset before since": {Method: "GET", Path: "/api/v1/events", Since: sunset, Sunset: since},
+	}
+	for name, d := range tests {
+		assert.Error(t, d.Validate(), name)
+	}
+}
+
+func TestRegistry_Applicable(t *testing.T) {
+	endpoint := Deprecation{Method: "GET", Path: "/api/v1/old", Since: since, Sunset: sunset.AddDate(1, 0, 0)}
+	param := Deprecation{Method: "GET", Path: "/api/v1/events/my-events", Param: "offset", Since: since, Sunset: sunset}
+	field := Deprecation{Method: "GET", Path: "/api/v1/events/my-events", Field: "meta.total", Response: page{}, Since: since, Sunset: sunset.AddDate(0, 1, 0)}
+	registry := NewRegistry(endpoint, param, field)
+
+	assert.Equal(t, []Deprecation{endpoint}, registry.Applicable("GET", "/api/v1/old", nil))
+	assert.Empty(t, registry.Applicable("POST", "/api/v1/old", nil))
+	assert.Equal(t, []Deprecation{field}, registry.Applicable("GET", "/api/v1/events/my-events", url.Values{"limit": {"10"}}))
+	assert.Equal(t, []Deprecation{param, field}, registry.Applicable("GET", "/api/v1/events/my-events", url.Values{"offset": {"10"}}))
+}
+
+func TestHasField(t *testing.T) {
+	assert.True(t, HasField(page{}, "meta"))
+	assert.True(t, HasField(page{}, "meta.total"))
+	assert.True(t, HasField(&wrapped{}, "meta.total"))
+	assert.True(t, HasField(wrapped{}, "items.name"))
+	assert.False(t, HasField(page{}, "meta.count"))
+	assert.False(t, HasField(page{}, "hidden"))
+	assert.False(t, HasField(page{}, "meta.total.value"))
+}
+
+func TestWarnings(t *testing.T) {
+	assert.Nil(t, Warnings(context.Background()))
+
+	ctx := WithWarnings(context.Background(), []string{"going away"})
+	assert.Equal(t, []string{"going away"}, Warnings(ctx))
+}
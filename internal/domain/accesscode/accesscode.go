@@ -0,0 +1,65 @@
+package accesscode
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Code length limits and the alphabet of generated codes
+const (
+	MinCodeLength       = 4
+	MaxCodeLength       = 32
+	GeneratedCodeLength = 8
+
+	// generatedAlphabet leaves out characters that are easily confused: 0/O and 1/I/L
+	generatedAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+)
+
+// AccessCode lets buyers order tickets for an event that requires a code
+// Each order redeeming the code counts as one use, whatever its ticket quantity.
+type AccessCode struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	EventID   uuid.UUID `gorm:"not null;type:uuid" json:"event_id"`
+	Code      string    `gorm:"not null;size:32" json:"code"`       // Stored in upper case; buyers may enter it in any case
+	MaxUses   int       `gorm:"not null;default:0" json:"max_uses"` // 1 for single-use codes, 0 for no limit
+	Uses      int       `gorm:"not null;default:0" json:"uses"`
+	CreatedBy uuid.UUID `gorm:"not null;type:uuid" json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (AccessCode) TableName() string {
+	return "event_access_codes"
+}
+
+// IsUsedUp checks if the code has no uses left
+func (c *AccessCode) IsUsedUp() bool {
+	return c.MaxUses > 0 && c.Uses >= c.MaxUses
+}
+
+// Redemption records an order placed with an access code
+type Redemption struct {
+	ID           uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	AccessCodeID uuid.UUID `gorm:"not null;type:uuid" json:"access_code_id"`
+	EventID      uuid.UUID `gorm:"not null;type:uuid" json:"event_id"`
+	OrderID      uuid.UUID `gorm:"not null;type:uuid" json:"order_id"`
+	UserID       uuid.UUID `gorm:"not null;type:uuid" json:"user_id"`
+	Quantity     int       `gorm:"not null" json:"quantity"`
+	CreatedAt    time.Time `json:"created_at"`
+
+	// Email of the buyer, filled in by listing queries
+	Email string `gorm:"->;-:migration" json:"email,omitempty"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Redemption) TableName() string {
+	return "event_access_code_redemptions"
+}
+
+// Actor identifies the user managing access codes
+type Actor struct {
+	UserID  uuid.UUID
+	IsAdmin bool // Admins may manage the codes of any event
+}
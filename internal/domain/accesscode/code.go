@@ -0,0 +1,39 @@
+package accesscode
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+// NewCode returns a random access code
+func NewCode() (string, error) {
+	max := big.NewInt(int64(len(generatedAlphabet)))
+	code := make([]byte, GeneratedCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = generatedAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// NormalizeCode trims an access code and converts it to upper case, the form codes are stored in
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// ValidCode checks that a normalized code has an allowed length and only letters, digits and dashes
+func ValidCode(code string) bool {
+	if len(code) < MinCodeLength || len(code) > MaxCodeLength {
+		return false
+	}
+	for _, c := range code {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package accesscode
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AccessCodeError represents domain-specific access code errors
+type AccessCodeError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *AccessCodeError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *AccessCodeError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined access code domain errors
+var (
+	ErrInvalidCode               = &AccessCodeError{Code: "INVALID_ACCESS_CODE", Message: fmt.Sprintf("access code must be %d to %d letters, digits or dashes", MinCodeLength, MaxCodeLength)}
+	ErrInvalidMaxUses            = &AccessCodeError{Code: "INVALID_MAX_USES", Message: "max uses must not be negative"}
+	ErrDuplicateCode             = &AccessCodeError{Code: "DUPLICATE_ACCESS_CODE", Message: "the event already has this access code"}
+	ErrNotOrganizer              = &AccessCodeError{Code: "NOT_EVENT_ORGANIZER", Message: "only the event's organizers can manage its access codes"}
+	ErrAccessCodeNotFound        = &AccessCodeError{Code: "ACCESS_CODE_NOT_FOUND", Message: "access code not found"}
+	ErrAccessCodeSaveFailed      = &AccessCodeError{Code: "ACCESS_CODE_SAVE_FAILED", Message: "failed to save access code"}
+	ErrAccessCodeRetrievalFailed = &AccessCodeError{Code: "ACCESS_CODE_RETRIEVAL_FAILED", Message: "failed to retrieve access codes"}
+)
+
+// NewAccessCodeError creates a new AccessCodeError with a cause
+func NewAccessCodeError(baseError *AccessCodeError, cause error) *AccessCodeError {
+	return &AccessCodeError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetAccessCodeErrorCode extracts the error code from an AccessCodeError
+func GetAccessCodeErrorCode(err error) string {
+	var codeErr *AccessCodeError
+	if errors.As(err, &codeErr) {
+		return codeErr.Code
+	}
+	return ""
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetAccessCodeErrorCode(err) {
+	case "INVALID_ACCESS_CODE", "INVALID_MAX_USES":
+		return true
+	}
+	return false
+}
+
+// IsNotFoundError checks if an error reports a missing access code
+func IsNotFoundError(err error) bool {
+	return GetAccessCodeErrorCode(err) == "ACCESS_CODE_NOT_FOUND"
+}
+
+// IsForbiddenError checks if an error denies the actor the event's codes
+func IsForbiddenError(err error) bool {
+	return GetAccessCodeErrorCode(err) == "NOT_EVENT_ORGANIZER"
+}
+
+// IsConflictError checks if an error is caused by an existing code
+func IsConflictError(err error) bool {
+	return GetAccessCodeErrorCode(err) == "DUPLICATE_ACCESS_CODE"
+}
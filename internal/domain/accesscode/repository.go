@@ -0,0 +1,32 @@
+package accesscode
+
+import (
+	"context"
+
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for access code data access
+// It also redeems codes for order creation, inside the order's transaction.
+type Repository interface {
+	order.AccessCodeRedeemer
+
+	// Create stores a new access code
+	// Returns ErrDuplicateCode when the event already has the code
+	Create(ctx context.Context, code *AccessCode) error
+
+	// GetByID retrieves an access code by its ID
+	// Returns ErrAccessCodeNotFound when there is none
+	GetByID(ctx context.Context, id uuid.UUID) (*AccessCode, error)
+
+	// ListByEvent retrieves the access codes of an event, oldest first
+	ListByEvent(ctx context.Context, eventID uuid.UUID) ([]*AccessCode, error)
+
+	// Delete removes an access code together with its redemptions
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListRedemptions retrieves the redemptions of an access code with buyer emails, oldest first
+	ListRedemptions(ctx context.Context, codeID uuid.UUID) ([]*Redemption, error)
+}
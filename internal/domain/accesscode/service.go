@@ -0,0 +1,166 @@
+package accesscode
+
+import (
+	"context"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// maxCodeAttempts bounds how often a generated code is redrawn after colliding with an existing one
+const maxCodeAttempts = 3
+
+// Service defines the business logic interface for event access codes
+// The event's organizer, its co-organizers and admins manage its codes.
+type Service interface {
+	// Create adds an access code to an event; an empty code is generated
+	Create(ctx context.Context, actor Actor, eventID uuid.UUID, code string, maxUses int) (*AccessCode, error)
+
+	// List retrieves the access codes of an event
+	List(ctx context.Context, actor Actor, eventID uuid.UUID) ([]*AccessCode, error)
+
+	// Delete removes an access code from an event
+	Delete(ctx context.Context, actor Actor, eventID, codeID uuid.UUID) error
+
+	// ListRedemptions retrieves the orders placed with an access code
+	ListRedemptions(ctx context.Context, actor Actor, eventID, codeID uuid.UUID) ([]*Redemption, error)
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo         Repository
+	eventService event.Service
+	ids          clock.IDGenerator // Creates the IDs of new access codes
+}
+
+// ServiceOption configures optional access code Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithIDGenerator creates the IDs of new access codes with ids instead of at random
+func WithIDGenerator(ids clock.IDGenerator) ServiceOption {
+	return func(s *serviceImpl) {
+		s.ids = ids
+	}
+}
+
+// NewService creates a new access code service instance
+func NewService(repo Repository, eventService event.Service, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repo:         repo,
+		eventService: eventService,
+		ids:          clock.RandomIDs{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Create adds an access code to an event
+// Codes are stored in upper case. Without a code one is generated, and redrawn if it collides.
+func (s *serviceImpl) Create(ctx context.Context, actor Actor, eventID uuid.UUID, code string, maxUses int) (*AccessCode, error) {
+	code = NormalizeCode(code)
+	generate := code == ""
+	if !generate && !ValidCode(code) {
+		return nil, ErrInvalidCode
+	}
+	if maxUses < 0 {
+		return nil, ErrInvalidMaxUses
+	}
+
+	if _, err := s.managedEvent(ctx, actor, eventID); err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		if generate {
+			var err error
+			if code, err = NewCode(); err != nil {
+				return nil, NewAccessCodeError(ErrAccessCodeSaveFailed, err)
+			}
+		}
+
+		accessCode := &AccessCode{
+			ID:        s.ids.NewID(),
+			EventID:   eventID,
+			Code:      code,
+			MaxUses:   maxUses,
+			CreatedBy: actor.UserID,
+		}
+		err := s.repo.Create(ctx, accessCode)
+		if err == nil {
+			logging.From(ctx).Info("Access code created", "access_code_id", accessCode.ID, "event_id", eventID, "max_uses", maxUses, "created_by", actor.UserID)
+			return accessCode, nil
+		}
+		if !generate || !IsConflictError(err) || attempt+1 >= maxCodeAttempts {
+			return nil, err
+		}
+	}
+}
+
+// List retrieves the access codes of an event, oldest first
+func (s *serviceImpl) List(ctx context.Context, actor Actor, eventID uuid.UUID) ([]*AccessCode, error) {
+	if _, err := s.managedEvent(ctx, actor, eventID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByEvent(ctx, eventID)
+}
+
+// Delete removes an access code from an event
+// Orders already placed with it are kept; its redemption history goes with it.
+func (s *serviceImpl) Delete(ctx context.Context, actor Actor, eventID, codeID uuid.UUID) error {
+	if _, err := s.eventCode(ctx, actor, eventID, codeID); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, codeID); err != nil {
+		return err
+	}
+	logging.From(ctx).Info("Access code deleted", "access_code_id", codeID, "event_id", eventID, "deleted_by", actor.UserID)
+	return nil
+}
+
+// ListRedemptions retrieves the orders placed with an access code, oldest first
+func (s *serviceImpl) ListRedemptions(ctx context.Context, actor Actor, eventID, codeID uuid.UUID) ([]*Redemption, error) {
+	if _, err := s.eventCode(ctx, actor, eventID, codeID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListRedemptions(ctx, codeID)
+}
+
+// managedEvent retrieves an event the actor may manage the access codes of
+func (s *serviceImpl) managedEvent(ctx context.Context, actor Actor, eventID uuid.UUID) (*event.Event, error) {
+	e, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if actor.IsAdmin {
+		return e, nil
+	}
+	allowed, err := s.eventService.CanManage(ctx, e, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, ErrNotOrganizer
+	}
+	return e, nil
+}
+
+// eventCode retrieves an access code of an event the actor manages
+// Codes of other events are reported as not found.
+func (s *serviceImpl) eventCode(ctx context.Context, actor Actor, eventID, codeID uuid.UUID) (*AccessCode, error) {
+	if _, err := s.managedEvent(ctx, actor, eventID); err != nil {
+		return nil, err
+	}
+	accessCode, err := s.repo.GetByID(ctx, codeID)
+	if err != nil {
+		return nil, err
+	}
+	if accessCode.EventID != eventID {
+		return nil, ErrAccessCodeNotFound
+	}
+	return accessCode, nil
+}
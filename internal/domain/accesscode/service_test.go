@@ -0,0 +1,261 @@
+package accesscode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// MockEventService is a mock implementation of event.Service interface
+type MockEventService struct {
+	mock.Mock
+}
+
+func (m *MockEventService) CreateEvent(ctx context.Context, e *event.Event) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockEventService) GetEventByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetEventBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetAllEvents(ctx context.Context, filter event.ListFilter, viewer event.Viewer) ([]*event.Event, error) {
+	args := m.Called(ctx, filter, viewer)
+	return args.Get(0).([]*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, filter event.OrganizerFilter) ([]*event.Event, int, error) {
+	args := m.Called(ctx, organizerID, filter)
+	return args.Get(0).([]*event.Event), args.Int(1), args.Error(2)
+}
+
+func (m *MockEventService) GetUpcomingEvents(ctx context.Context, filter event.UpcomingFilter) ([]*event.Event, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) UpdateEvent(ctx context.Context, e *event.Event) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockEventService) CancelEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
+	args := m.Called(ctx, eventID, organizerID)
+	return args.Error(0)
+}
+
+func (m *MockEventService) ApproveModeration(ctx context.Context, eventID uuid.UUID, adminID uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, eventID, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) CanManage(ctx context.Context, e *event.Event, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, e, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockEventService) RefreshOnSale(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockEventService) AdjustInventory(ctx context.Context, eventID uuid.UUID, viewer event.Viewer, delta int, reason string) (*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer, delta, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID, viewer event.Viewer) ([]*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) DeleteEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
+	args := m.Called(ctx, eventID, organizerID)
+	return args.Error(0)
+}
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, code *AccessCode) error {
+	args := m.Called(ctx, code)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (*AccessCode, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AccessCode), args.Error(1)
+}
+
+func (m *MockRepository) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]*AccessCode, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).([]*AccessCode), args.Error(1)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListRedemptions(ctx context.Context, codeID uuid.UUID) ([]*Redemption, error) {
+	args := m.Called(ctx, codeID)
+	return args.Get(0).([]*Redemption), args.Error(1)
+}
+
+func (m *MockRepository) RedeemWithTx(ctx context.Context, tx *gorm.DB, redemption order.AccessCodeRedemption) (bool, error) {
+	args := m.Called(ctx, tx, redemption)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestValidCode(t *testing.T) {
+	assert.True(t, ValidCode("VIP-2024"))
+	assert.True(t, ValidCode(NormalizeCode(" presale ")))
+	assert.False(t, ValidCode("ABC"), "too short")
+	assert.False(t, ValidCode("VIP 2024"), "space")
+	assert.False(t, ValidCode("vip"), "not normalized")
+
+	generated, err := NewCode()
+	require.NoError(t, err)
+	assert.Len(t, generated, GeneratedCodeLength)
+	assert.True(t, ValidCode(generated))
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+	organizerID := uuid.New()
+	eventID := uuid.New()
+	e := &event.Event{ID: eventID, OrganizerID: organizerID}
+
+	t.Run("invalid code", func(t *testing.T) {
+		service := NewService(new(MockRepository), new(MockEventService))
+
+		_, err := service.Create(ctx, Actor{UserID: organizerID}, eventID, "a b", 1)
+		assert.Equal(t, ErrInvalidCode, err)
+	})
+
+	t.Run("negative max uses", func(t *testing.T) {
+		service := NewService(new(MockRepository), new(MockEventService))
+
+		_, err := service.Create(ctx, Actor{UserID: organizerID}, eventID, "", -1)
+		assert.Equal(t, ErrInvalidMaxUses, err)
+	})
+
+	t.Run("only the event's organizers", func(t *testing.T) {
+		strangerID := uuid.New()
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(e, nil)
+		eventService.On("CanManage", ctx, e, strangerID).Return(false, nil)
+		service := NewService(new(MockRepository), eventService)
+
+		_, err := service.Create(ctx, Actor{UserID: strangerID}, eventID, "VIP-2024", 1)
+		assert.Equal(t, ErrNotOrganizer, err)
+	})
+
+	t.Run("stores the code in upper case", func(t *testing.T) {
+		repo := new(MockRepository)
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(e, nil)
+		eventService.On("CanManage", ctx, e, organizerID).Return(true, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*accesscode.AccessCode")).Return(nil)
+		service := NewService(repo, eventService)
+
+		code, err := service.Create(ctx, Actor{UserID: organizerID}, eventID, " vip-2024 ", 1)
+		require.NoError(t, err)
+		assert.Equal(t, "VIP-2024", code.Code)
+		assert.Equal(t, 1, code.MaxUses)
+		assert.Equal(t, organizerID, code.CreatedBy)
+	})
+
+	t.Run("redraws a generated code that collides", func(t *testing.T) {
+		repo := new(MockRepository)
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(e, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*accesscode.AccessCode")).Return(ErrDuplicateCode).Once()
+		repo.On("Create", ctx, mock.AnythingOfType("*accesscode.AccessCode")).Return(nil).Once()
+		service := NewService(repo, eventService)
+
+		code, err := service.Create(ctx, Actor{UserID: uuid.New(), IsAdmin: true}, eventID, "", 0)
+		require.NoError(t, err)
+		assert.True(t, ValidCode(code.Code))
+		repo.AssertNumberOfCalls(t, "Create", 2)
+	})
+
+	t.Run("chosen code that exists is a conflict", func(t *testing.T) {
+		repo := new(MockRepository)
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(e, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*accesscode.AccessCode")).Return(ErrDuplicateCode)
+		service := NewService(repo, eventService)
+
+		_, err := service.Create(ctx, Actor{UserID: uuid.New(), IsAdmin: true}, eventID, "VIP-2024", 0)
+		assert.Equal(t, ErrDuplicateCode, err)
+		repo.AssertNumberOfCalls(t, "Create", 1)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+	codeID := uuid.New()
+	admin := Actor{UserID: uuid.New(), IsAdmin: true}
+
+	t.Run("codes of other events are not found", func(t *testing.T) {
+		repo := new(MockRepository)
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(&event.Event{ID: eventID}, nil)
+		repo.On("GetByID", ctx, codeID).Return(&AccessCode{ID: codeID, EventID: uuid.New()}, nil)
+		service := NewService(repo, eventService)
+
+		err := service.Delete(ctx, admin, eventID, codeID)
+		assert.Equal(t, ErrAccessCodeNotFound, err)
+		repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("deletes the event's code", func(t *testing.T) {
+		repo := new(MockRepository)
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(&event.Event{ID: eventID}, nil)
+		repo.On("GetByID", ctx, codeID).Return(&AccessCode{ID: codeID, EventID: eventID}, nil)
+		repo.On("Delete", ctx, codeID).Return(nil)
+		service := NewService(repo, eventService)
+
+		require.NoError(t, service.Delete(ctx, admin, eventID, codeID))
+		repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,258 @@
+package account
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobTypeExport is the job type that builds a personal data export
+const JobTypeExport = "account.export"
+
+// Export statuses
+const (
+	ExportPending = "PENDING" // Queued or being built
+	ExportReady   = "READY"   // Archive stored and downloadable
+	ExportFailed  = "FAILED"  // Could not be built; the user may request a new one
+)
+
+// ExportContentType is the media type of export archives
+const ExportContentType = "application/zip"
+
+// Export is a user's request for a copy of their personal data
+type Export struct {
+	ID          uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	UserID      uuid.UUID  `gorm:"not null;type:uuid;index" json:"user_id"`
+	Status      string     `gorm:"size:20;not null;default:'PENDING'" json:"status"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Export) TableName() string {
+	return "data_exports"
+}
+
+// StorageKey is where the archive of an export is stored
+func (e *Export) StorageKey() string {
+	return ExportStorageKey(e.UserID, e.ID)
+}
+
+// ExportStorageKey is where the archive of a user's export is stored
+func ExportStorageKey(userID, exportID uuid.UUID) string {
+	return "exports/" + userID.String() + "/" + exportID.String() + ".zip"
+}
+
+// ExportPayload is the job payload for JobTypeExport
+type ExportPayload struct {
+	ExportID uuid.UUID `json:"export_id"`
+}
+
+// PersonalData is everything stored about a user, grouped as in the export archive
+type PersonalData struct {
+	ExportedAt              time.Time             `json:"exported_at"`
+	Profile                 Profile               `json:"profile"`
+	Orders                  []OrderRecord         `json:"orders"`
+	Tickets                 []TicketRecord        `json:"tickets"`
+	Notifications           []NotificationRecord  `json:"notifications"`
+	NotificationPreferences []PreferenceRecord    `json:"notification_preferences"`
+	Devices                 []DeviceRecord        `json:"devices"`
+	StaffAssignments        []StaffRecord         `json:"staff_assignments"`
+	OrganizedEvents         []EventRecord         `json:"organized_events"`
+	Consents                []ConsentRecord       `json:"consents"`
+	Webhooks                []WebhookRecord       `json:"webhooks"`
+	Messages                []MessageRecord       `json:"messages"`
+	RefundRequests          []RefundRequestRecord `json:"refund_requests"`
+	RefundAuditLog          []RefundAuditRecord   `json:"refund_audit_log"`
+	StatusChanges           []StatusChangeRecord  `json:"status_changes"`
+	EventTransfers          []TransferRecord      `json:"event_transfers"`
+	TransferAuditLog        []TransferAuditRecord `json:"event_transfer_audit_log"`
+	AccessCodeRedemptions   []RedemptionRecord    `json:"access_code_redemptions"`
+	OrderGroups             []OrderGroupRecord    `json:"order_groups"`
+	ReportSchedule          *ReportScheduleRecord `json:"report_schedule,omitempty"`
+}
+
+// Profile is the user's account record
+type Profile struct {
+	ID                  uuid.UUID  `json:"id"`
+	Email               string     `json:"email"`
+	Username            string     `json:"username"`
+	Roles               []string   `json:"roles" gorm:"-"`
+	Phone               *string    `json:"phone,omitempty"`
+	PhoneVerifiedAt     *time.Time `json:"phone_verified_at,omitempty"`
+	DateOfBirth         *time.Time `json:"date_of_birth,omitempty"`
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+// OrderRecord is an order placed by the user
+type OrderRecord struct {
+	ID            uuid.UUID              `json:"id"`
+	EventID       uuid.UUID              `json:"event_id"`
+	EventTitle    string                 `json:"event_title"`
+	Quantity      int                    `json:"quantity"`
+	TotalAmount   float64                `json:"total_amount"`
+	Status        string                 `json:"status"`
+	Notes         string                 `json:"notes,omitempty"`
+	Answers       map[string]interface{} `json:"answers,omitempty" gorm:"serializer:json"`
+	InvoiceNumber *int64                 `json:"invoice_number,omitempty"`
+	GiftRecipient string                 `json:"gift_recipient,omitempty"` // Email the user bought the tickets for
+	GiftedBy      *uuid.UUID             `json:"gifted_by,omitempty"`      // Buyer of a gift the user claimed
+	GiftClaimedAt *time.Time             `json:"gift_claimed_at,omitempty"`
+	CheckedInAt   *time.Time             `json:"checked_in_at,omitempty"`
+	CreatedAt     time.Time              `json:"created_at"`
+}
+
+// TicketRecord is a ticket issued to the user
+type TicketRecord struct {
+	ID       uuid.UUID `json:"id"`
+	OrderID  uuid.UUID `json:"order_id"`
+	EventID  uuid.UUID `json:"event_id"`
+	SeatInfo string    `json:"seat_info,omitempty"`
+}
+
+// NotificationRecord is a notification sent to the user
+type NotificationRecord struct {
+	ID        uuid.UUID  `json:"id"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body,omitempty"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// PreferenceRecord is the user's channel choice for a notification type
+type PreferenceRecord struct {
+	Type  string `json:"type"`
+	Email bool   `json:"email"`
+	InApp bool   `json:"in_app"`
+	Push  bool   `json:"push"`
+}
+
+// DeviceRecord is a device registered for push notifications
+// Tokens are omitted: they identify the app installation, not the user.
+type DeviceRecord struct {
+	Platform  string    `json:"platform"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// StaffRecord is the user's role on another organizer's event
+type StaffRecord struct {
+	EventID    uuid.UUID  `json:"event_id"`
+	Role       string     `json:"role"`
+	Status     string     `json:"status"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// EventRecord is an event the user organizes
+type EventRecord struct {
+	ID        uuid.UUID `json:"id"`
+	Title     string    `json:"title"`
+	EventDate time.Time `json:"event_date"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ConsentRecord is a policy version the user accepted
+type ConsentRecord struct {
+	Type       string    `json:"type"`
+	Version    string    `json:"version"`
+	URL        string    `json:"url"`
+	AcceptedAt time.Time `json:"accepted_at"`
+}
+
+// WebhookRecord is an endpoint the user subscribed to their orders' status changes
+// Secrets are omitted: they only sign deliveries and were shown when the subscription was made.
+type WebhookRecord struct {
+	URL       string    `json:"url"`
+	Events    []string  `json:"events" gorm:"serializer:json"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MessageRecord is a message the user sent or received in an order's thread
+type MessageRecord struct {
+	OrderID    uuid.UUID  `json:"order_id"`
+	SenderRole string     `json:"sender_role"`
+	Sent       bool       `json:"sent"` // The user wrote it rather than received it
+	Body       string     `json:"body"`
+	ReadAt     *time.Time `json:"read_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// RefundRequestRecord is a refund or dispute the user opened on one of their orders
+type RefundRequestRecord struct {
+	ID        uuid.UUID  `json:"id"`
+	OrderID   uuid.UUID  `json:"order_id"`
+	EventID   uuid.UUID  `json:"event_id"`
+	Kind      string     `json:"kind"`
+	Status    string     `json:"status"`
+	Reason    string     `json:"reason"`
+	Amount    float64    `json:"amount"`
+	Decision  string     `json:"decision,omitempty"`
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// RefundAuditRecord is a change of one of the user's refund requests, or a change the user made as organizer
+type RefundAuditRecord struct {
+	RequestID uuid.UUID `json:"request_id"`
+	Action    string    `json:"action"`
+	Acted     bool      `json:"acted"` // The user made the change
+	Amount    float64   `json:"amount"`
+	Note      string    `json:"note,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// StatusChangeRecord is a suspension, ban or reactivation of the user's account
+// Who made the change is omitted: it names an administrator.
+type StatusChangeRecord struct {
+	Status    string    `json:"status"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TransferRecord is an event ownership transfer the user offered, received or requested
+type TransferRecord struct {
+	ID              uuid.UUID  `json:"id"`
+	EventID         uuid.UUID  `json:"event_id"`
+	FromOrganizerID uuid.UUID  `json:"from_organizer_id"`
+	ToOrganizerID   uuid.UUID  `json:"to_organizer_id"`
+	Status          string     `json:"status"`
+	RespondedAt     *time.Time `json:"responded_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// TransferAuditRecord is a change of one of the user's transfers, or a change the user made
+type TransferAuditRecord struct {
+	TransferID uuid.UUID `json:"transfer_id"`
+	EventID    uuid.UUID `json:"event_id"`
+	Action     string    `json:"action"`
+	Acted      bool      `json:"acted"` // The user made the change
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// RedemptionRecord is an order the user placed with an access code
+type RedemptionRecord struct {
+	EventID   uuid.UUID `json:"event_id"`
+	OrderID   uuid.UUID `json:"order_id"`
+	Code      string    `json:"code"`
+	Quantity  int       `json:"quantity"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OrderGroupRecord is a group reservation the user leads
+type OrderGroupRecord struct {
+	ID        uuid.UUID `json:"id"`
+	EventID   uuid.UUID `json:"event_id"`
+	HoldUntil time.Time `json:"hold_until"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ReportScheduleRecord is the user's sales report email settings
+type ReportScheduleRecord struct {
+	Frequency  string     `json:"frequency"`
+	Enabled    bool       `json:"enabled"`
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+}
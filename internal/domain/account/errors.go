@@ -0,0 +1,74 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AccountError represents domain-specific account errors
+type AccountError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *AccountError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *AccountError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined account domain errors
+var (
+	ErrUserNotFound           = &AccountError{Code: "USER_NOT_FOUND", Message: "user not found"}
+	ErrExportNotFound         = &AccountError{Code: "EXPORT_NOT_FOUND", Message: "data export not found"}
+	ErrExportFailed           = &AccountError{Code: "EXPORT_FAILED", Message: "data export could not be built, please request a new one"}
+	ErrNoDeletionScheduled    = &AccountError{Code: "NO_DELETION_SCHEDULED", Message: "account deletion is not scheduled"}
+	ErrAccountRetrievalFailed = &AccountError{Code: "ACCOUNT_RETRIEVAL_FAILED", Message: "failed to retrieve account data"}
+	ErrAccountUpdateFailed    = &AccountError{Code: "ACCOUNT_UPDATE_FAILED", Message: "failed to update account"}
+	ErrExportStorageFailed    = &AccountError{Code: "EXPORT_STORAGE_FAILED", Message: "failed to access stored data export"}
+	ErrExportSchedulingFailed = &AccountError{Code: "EXPORT_SCHEDULING_FAILED", Message: "failed to schedule data export"}
+)
+
+// NewAccountError creates a new AccountError with a cause
+func NewAccountError(baseError *AccountError, cause error) *AccountError {
+	return &AccountError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetAccountErrorCode extracts the error code from an AccountError
+func GetAccountErrorCode(err error) string {
+	var accountErr *AccountError
+	if errors.As(err, &accountErr) {
+		return accountErr.Code
+	}
+	return ""
+}
+
+// IsUserNotFoundError checks if an error is a "user not found" error
+func IsUserNotFoundError(err error) bool {
+	return GetAccountErrorCode(err) == "USER_NOT_FOUND"
+}
+
+// IsExportNotFoundError checks if an error is an "export not found" error
+func IsExportNotFoundError(err error) bool {
+	return GetAccountErrorCode(err) == "EXPORT_NOT_FOUND"
+}
+
+// IsExportFailedError checks if an error reports a failed export
+func IsExportFailedError(err error) bool {
+	return GetAccountErrorCode(err) == "EXPORT_FAILED"
+}
+
+// IsNoDeletionScheduledError checks if an error is because no deletion was pending
+func IsNoDeletionScheduledError(err error) bool {
+	return GetAccountErrorCode(err) == "NO_DELETION_SCHEDULED"
+}
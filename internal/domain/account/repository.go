@@ -0,0 +1,57 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for account data access
+type Repository interface {
+	// CreateExport stores a new export request
+	CreateExport(ctx context.Context, export *Export) error
+
+	// GetExport retrieves an export by its ID
+	GetExport(ctx context.Context, id uuid.UUID) (*Export, error)
+
+	// GetPendingExport retrieves the user's most recent pending export created after since, or nil
+	GetPendingExport(ctx context.Context, userID uuid.UUID, since time.Time) (*Export, error)
+
+	// CompleteExport sets the final status of an export
+	CompleteExport(ctx context.Context, id uuid.UUID, status string, completedAt time.Time) error
+
+	// GetPersonalData collects everything stored about a user
+	GetPersonalData(ctx context.Context, userID uuid.UUID) (*PersonalData, error)
+
+	// ScheduleDeletion schedules the user's account for deletion at the given time
+	// An existing schedule is kept; the effective deletion time is returned.
+	ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) (time.Time, error)
+
+	// CancelDeletion clears a scheduled deletion, reporting false when none was scheduled
+	CancelDeletion(ctx context.Context, userID uuid.UUID) (bool, error)
+
+	// GetDueDeletions retrieves users whose scheduled deletion is at or before now
+	GetDueDeletions(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
+
+	// DeleteAccount erases a user due for deletion at now in one transaction
+	// The user row is kept as an anonymous tombstone so orders stay valid for accounting:
+	// its email, username, password, phone and date of birth are overwritten; order notes, answers,
+	// countries and gift recipients, refund reasons and the bodies of messages the user sent are
+	// cleared; group reservations the user leads are dissolved, and every other personal record is
+	// deleted. It returns the storage keys of the user's export archives and organizer logo so they
+	// can be removed, and false if the deletion was cancelled or already done.
+	DeleteAccount(ctx context.Context, userID uuid.UUID, now time.Time) ([]string, bool, error)
+}
+
+// Store keeps export archives, e.g. in object storage shared with organizer logos
+type Store interface {
+	// Get returns the object stored under key, or nil if there is none
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put stores data under key, replacing any previous object
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Delete removes the object stored under key; missing objects are not an error
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,214 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// pendingExportWindow is how long a pending export is reused for repeated requests
+// Older pending exports are assumed stuck and a new one is queued.
+const pendingExportWindow = time.Hour
+
+// dueBatchSize limits how many accounts are deleted per ProcessDueDeletions call
+const dueBatchSize = 100
+
+// Service defines the business logic interface for personal data exports and account deletion
+type Service interface {
+	// RequestExport queues an export of the user's personal data
+	// A pending export requested within the last hour is returned instead of queueing another.
+	RequestExport(ctx context.Context, userID uuid.UUID) (*Export, error)
+
+	// GetExport returns one of the user's exports and, once it is ready, its archive
+	GetExport(ctx context.Context, userID, exportID uuid.UUID) (*Export, []byte, error)
+
+	// PrepareExport gathers the personal data for a queued export
+	// It returns nil data when the export no longer needs building.
+	PrepareExport(ctx context.Context, exportID uuid.UUID) (*Export, *PersonalData, error)
+
+	// SaveExport stores a built archive and marks the export ready
+	SaveExport(ctx context.Context, export *Export, archive []byte) error
+
+	// FailExport marks an export that could not be built as failed
+	FailExport(ctx context.Context, exportID uuid.UUID) error
+
+	// RequestDeletion schedules the user's account for deletion after the grace period
+	// Repeated requests keep the original date, which is returned.
+	RequestDeletion(ctx context.Context, userID uuid.UUID) (time.Time, error)
+
+	// CancelDeletion cancels a scheduled deletion during the grace period
+	CancelDeletion(ctx context.Context, userID uuid.UUID) error
+
+	// ProcessDueDeletions deletes every account whose grace period ended before now
+	ProcessDueDeletions(ctx context.Context, now time.Time) (int, error)
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo        Repository
+	store       Store
+	jobService  job.Service
+	gracePeriod time.Duration
+	clock       clock.Clock // Tells the time exports and deletions are scheduled from
+}
+
+// ServiceOption configures optional account Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock schedules exports and deletions by c instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// NewService creates a new account service instance
+func NewService(repo Repository, store Store, jobService job.Service, gracePeriod time.Duration, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repo:        repo,
+		store:       store,
+		jobService:  jobService,
+		gracePeriod: gracePeriod,
+		clock:       clock.System{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// RequestExport queues an export of the user's personal data
+func (s *serviceImpl) RequestExport(ctx context.Context, userID uuid.UUID) (*Export, error) {
+	pending, err := s.repo.GetPendingExport(ctx, userID, s.clock.Now().Add(-pendingExportWindow))
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		return pending, nil
+	}
+
+	export := &Export{UserID: userID, Status: ExportPending}
+	if err := s.repo.CreateExport(ctx, export); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.jobService.Enqueue(ctx, JobTypeExport, ExportPayload{ExportID: export.ID}); err != nil {
+		if failErr := s.repo.CompleteExport(ctx, export.ID, ExportFailed, s.clock.Now()); failErr != nil {
+			logging.From(ctx).Warn("Failed to mark export as failed", "export_id", export.ID, "error", failErr)
+		}
+		return nil, NewAccountError(ErrExportSchedulingFailed, err)
+	}
+	return export, nil
+}
+
+// GetExport returns one of the user's exports and its archive once ready
+func (s *serviceImpl) GetExport(ctx context.Context, userID, exportID uuid.UUID) (*Export, []byte, error) {
+	export, err := s.repo.GetExport(ctx, exportID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Other users' exports are reported as missing so their IDs cannot be probed
+	if export.UserID != userID {
+		return nil, nil, ErrExportNotFound
+	}
+
+	switch export.Status {
+	case ExportFailed:
+		return nil, nil, ErrExportFailed
+	case ExportPending:
+		return export, nil, nil
+	}
+
+	archive, err := s.store.Get(ctx, export.StorageKey())
+	if err != nil {
+		return nil, nil, NewAccountError(ErrExportStorageFailed, err)
+	}
+	if archive == nil {
+		return nil, nil, ErrExportNotFound
+	}
+	return export, archive, nil
+}
+
+// PrepareExport gathers the personal data for a queued export
+func (s *serviceImpl) PrepareExport(ctx context.Context, exportID uuid.UUID) (*Export, *PersonalData, error) {
+	export, err := s.repo.GetExport(ctx, exportID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if export.Status != ExportPending {
+		return export, nil, nil
+	}
+
+	data, err := s.repo.GetPersonalData(ctx, export.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	data.ExportedAt = s.clock.Now().UTC()
+	return export, data, nil
+}
+
+// SaveExport stores a built archive and marks the export ready
+func (s *serviceImpl) SaveExport(ctx context.Context, export *Export, archive []byte) error {
+	if err := s.store.Put(ctx, export.StorageKey(), archive); err != nil {
+		return NewAccountError(ErrExportStorageFailed, err)
+	}
+	return s.repo.CompleteExport(ctx, export.ID, ExportReady, s.clock.Now())
+}
+
+// FailExport marks an export that could not be built as failed
+func (s *serviceImpl) FailExport(ctx context.Context, exportID uuid.UUID) error {
+	return s.repo.CompleteExport(ctx, exportID, ExportFailed, s.clock.Now())
+}
+
+// RequestDeletion schedules the user's account for deletion after the grace period
+func (s *serviceImpl) RequestDeletion(ctx context.Context, userID uuid.UUID) (time.Time, error) {
+	return s.repo.ScheduleDeletion(ctx, userID, s.clock.Now().Add(s.gracePeriod))
+}
+
+// CancelDeletion cancels a scheduled deletion
+func (s *serviceImpl) CancelDeletion(ctx context.Context, userID uuid.UUID) error {
+	cancelled, err := s.repo.CancelDeletion(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return ErrNoDeletionScheduled
+	}
+	return nil
+}
+
+// ProcessDueDeletions deletes every account whose grace period ended before now
+// Each account is deleted in its own transaction, so a failure only postpones that account.
+func (s *serviceImpl) ProcessDueDeletions(ctx context.Context, now time.Time) (int, error) {
+	userIDs, err := s.repo.GetDueDeletions(ctx, now, dueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, userID := range userIDs {
+		storageKeys, ok, err := s.repo.DeleteAccount(ctx, userID, now)
+		if err != nil {
+			logging.From(ctx).Warn("Failed to delete account", "user_id", userID, "error", err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		deleted++
+
+		// Archives and logos are unreachable once their rows are gone; removing them is best effort
+		for _, key := range storageKeys {
+			if err := s.store.Delete(ctx, key); err != nil {
+				logging.From(ctx).Warn("Failed to delete stored file", "key", key, "user_id", userID, "error", err)
+			}
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,338 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/job"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) CreateExport(ctx context.Context, export *Export) error {
+	args := m.Called(ctx, export)
+	if export.ID == uuid.Nil {
+		export.ID = uuid.New()
+	}
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetExport(ctx context.Context, id uuid.UUID) (*Export, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Export), args.Error(1)
+}
+
+func (m *MockRepository) GetPendingExport(ctx context.Context, userID uuid.UUID, since time.Time) (*Export, error) {
+	args := m.Called(ctx, userID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Export), args.Error(1)
+}
+
+func (m *MockRepository) CompleteExport(ctx context.Context, id uuid.UUID, status string, completedAt time.Time) error {
+	args := m.Called(ctx, id, status, completedAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetPersonalData(ctx context.Context, userID uuid.UUID) (*PersonalData, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PersonalData), args.Error(1)
+}
+
+func (m *MockRepository) ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) (time.Time, error) {
+	args := m.Called(ctx, userID, at)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockRepository) CancelDeletion(ctx context.Context, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) GetDueDeletions(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) DeleteAccount(ctx context.Context, userID uuid.UUID, now time.Time) ([]string, bool, error) {
+	args := m.Called(ctx, userID, now)
+	keys, _ := args.Get(0).([]string)
+	return keys, args.Bool(1), args.Error(2)
+}
+
+// memoryStore is an in-memory Store
+type memoryStore map[string][]byte
+
+func (s memoryStore) Get(ctx context.Context, key string) ([]byte, error) {
+	return s[key], nil
+}
+
+func (s memoryStore) Put(ctx context.Context, key string, data []byte) error {
+	s[key] = data
+	return nil
+}
+
+func (s memoryStore) Delete(ctx context.Context, key string) error {
+	delete(s, key)
+	return nil
+}
+
+// MockJobService is a mock implementation of job.Service interface
+type MockJobService struct {
+	mock.Mock
+}
+
+func (m *MockJobService) Enqueue(ctx context.Context, jobType string, payload interface{}, opts ...job.EnqueueOption) (*job.Job, error) {
+	args := m.Called(ctx, jobType, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) GetJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) ListJobs(ctx context.Context, status string, limit int) ([]*job.Job, error) {
+	args := m.Called(ctx, status, limit)
+	return args.Get(0).([]*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) RetryJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func TestAccountService_RequestExport(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("queues a new export", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobs := new(MockJobService)
+		service := NewService(repo, memoryStore{}, jobs, time.Hour)
+
+		repo.On("GetPendingExport", mock.Anything, userID, mock.AnythingOfType("time.Time")).Return(nil, nil)
+		repo.On("CreateExport", mock.Anything, mock.AnythingOfType("*account.Export")).Return(nil)
+		jobs.On("Enqueue", mock.Anything, JobTypeExport, mock.AnythingOfType("account.ExportPayload")).Return(&job.Job{}, nil)
+
+		export, err := service.RequestExport(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, export.UserID)
+		assert.Equal(t, ExportPending, export.Status)
+		jobs.AssertCalled(t, "Enqueue", mock.Anything, JobTypeExport, ExportPayload{ExportID: export.ID})
+	})
+
+	t.Run("reuses a recent pending export", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobs := new(MockJobService)
+		service := NewService(repo, memoryStore{}, jobs, time.Hour)
+		pending := &Export{ID: uuid.New(), UserID: userID, Status: ExportPending}
+
+		repo.On("GetPendingExport", mock.Anything, userID, mock.AnythingOfType("time.Time")).Return(pending, nil)
+
+		export, err := service.RequestExport(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Same(t, pending, export)
+		repo.AssertNotCalled(t, "CreateExport", mock.Anything, mock.Anything)
+		jobs.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("marks the export failed when it cannot be queued", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobs := new(MockJobService)
+		service := NewService(repo, memoryStore{}, jobs, time.Hour)
+
+		repo.On("GetPendingExport", mock.Anything, userID, mock.AnythingOfType("time.Time")).Return(nil, nil)
+		repo.On("CreateExport", mock.Anything, mock.AnythingOfType("*account.Export")).Return(nil)
+		repo.On("CompleteExport", mock.Anything, mock.AnythingOfType("uuid.UUID"), ExportFailed, mock.AnythingOfType("time.Time")).Return(nil)
+		jobs.On("Enqueue", mock.Anything, JobTypeExport, mock.Anything).Return(nil, errors.New("queue down"))
+
+		export, err := service.RequestExport(context.Background(), userID)
+
+		assert.Nil(t, export)
+		assert.Equal(t, "EXPORT_SCHEDULING_FAILED", GetAccountErrorCode(err))
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestAccountService_GetExport(t *testing.T) {
+	userID := uuid.New()
+	exportID := uuid.New()
+
+	tests := []struct {
+		name        string
+		export      *Export
+		stored      []byte
+		wantArchive []byte
+		wantErr     error
+	}{
+		{
+			name:        "ready export returns the archive",
+			export:      &Export{ID: exportID, UserID: userID, Status: ExportReady},
+			stored:      []byte("zip"),
+			wantArchive: []byte("zip"),
+		},
+		{
+			name:   "pending export has no archive yet",
+			export: &Export{ID: exportID, UserID: userID, Status: ExportPending},
+		},
+		{
+			name:    "failed export",
+			export:  &Export{ID: exportID, UserID: userID, Status: ExportFailed},
+			wantErr: ErrExportFailed,
+		},
+		{
+			name:    "another user's export is not found",
+			export:  &Export{ID: exportID, UserID: uuid.New(), Status: ExportReady},
+			stored:  []byte("zip"),
+			wantErr: ErrExportNotFound,
+		},
+		{
+			name:    "ready export without an archive is not found",
+			export:  &Export{ID: exportID, UserID: userID, Status: ExportReady},
+			wantErr: ErrExportNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			store := memoryStore{}
+			if tt.stored != nil {
+				store[tt.export.StorageKey()] = tt.stored
+			}
+			service := NewService(repo, store, new(MockJobService), time.Hour)
+
+			repo.On("GetExport", mock.Anything, exportID).Return(tt.export, nil)
+
+			export, archive, err := service.GetExport(context.Background(), userID, exportID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, export)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.export, export)
+			assert.Equal(t, tt.wantArchive, archive)
+		})
+	}
+}
+
+func TestAccountService_PrepareAndSaveExport(t *testing.T) {
+	userID := uuid.New()
+	export := &Export{ID: uuid.New(), UserID: userID, Status: ExportPending}
+	repo := new(MockRepository)
+	store := memoryStore{}
+	service := NewService(repo, store, new(MockJobService), time.Hour)
+
+	repo.On("GetExport", mock.Anything, export.ID).Return(export, nil)
+	repo.On("GetPersonalData", mock.Anything, userID).Return(&PersonalData{Profile: Profile{ID: userID}}, nil)
+	repo.On("CompleteExport", mock.Anything, export.ID, ExportReady, mock.AnythingOfType("time.Time")).Return(nil)
+
+	got, data, err := service.PrepareExport(context.Background(), export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, export, got)
+	assert.Equal(t, userID, data.Profile.ID)
+	assert.False(t, data.ExportedAt.IsZero())
+
+	require.NoError(t, service.SaveExport(context.Background(), export, []byte("zip")))
+	assert.Equal(t, []byte("zip"), store[export.StorageKey()])
+	repo.AssertExpectations(t)
+}
+
+func TestAccountService_PrepareExport_AlreadyCompleted(t *testing.T) {
+	export := &Export{ID: uuid.New(), UserID: uuid.New(), Status: ExportReady}
+	repo := new(MockRepository)
+	service := NewService(repo, memoryStore{}, new(MockJobService), time.Hour)
+
+	repo.On("GetExport", mock.Anything, export.ID).Return(export, nil)
+
+	_, data, err := service.PrepareExport(context.Background(), export.ID)
+
+	require.NoError(t, err)
+	assert.Nil(t, data)
+	repo.AssertNotCalled(t, "GetPersonalData", mock.Anything, mock.Anything)
+}
+
+func TestAccountService_RequestDeletion(t *testing.T) {
+	userID := uuid.New()
+	repo := new(MockRepository)
+	service := NewService(repo, memoryStore{}, new(MockJobService), 30*24*time.Hour)
+	scheduled := time.Now().Add(30 * 24 * time.Hour)
+
+	repo.On("ScheduleDeletion", mock.Anything, userID, mock.MatchedBy(func(at time.Time) bool {
+		return at.Sub(time.Now()) > 29*24*time.Hour
+	})).Return(scheduled, nil)
+
+	at, err := service.RequestDeletion(context.Background(), userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduled, at)
+}
+
+func TestAccountService_CancelDeletion(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("cancels a scheduled deletion", func(t *testing.T) {
+		repo := new(MockRepository)
+		service := NewService(repo, memoryStore{}, new(MockJobService), time.Hour)
+		repo.On("CancelDeletion", mock.Anything, userID).Return(true, nil)
+
+		assert.NoError(t, service.CancelDeletion(context.Background(), userID))
+	})
+
+	t.Run("nothing scheduled", func(t *testing.T) {
+		repo := new(MockRepository)
+		service := NewService(repo, memoryStore{}, new(MockJobService), time.Hour)
+		repo.On("CancelDeletion", mock.Anything, userID).Return(false, nil)
+
+		assert.True(t, IsNoDeletionScheduledError(service.CancelDeletion(context.Background(), userID)))
+	})
+}
+
+func TestAccountService_ProcessDueDeletions(t *testing.T) {
+	now := time.Date(2026, 7, 1, 3, 0, 0, 0, time.UTC)
+	deletedUser := uuid.New()
+	cancelledUser := uuid.New()
+	failingUser := uuid.New()
+	exportID := uuid.New()
+
+	repo := new(MockRepository)
+	logoKey := "branding/" + deletedUser.String() + "/logo-20260601T120000.000000000Z"
+	store := memoryStore{ExportStorageKey(deletedUser, exportID): []byte("zip"), logoKey: []byte("png")}
+	service := NewService(repo, store, new(MockJobService), time.Hour)
+
+	repo.On("GetDueDeletions", mock.Anything, now, dueBatchSize).Return([]uuid.UUID{failingUser, deletedUser, cancelledUser}, nil)
+	repo.On("DeleteAccount", mock.Anything, failingUser, now).Return(nil, false, errors.New("deadlock"))
+	repo.On("DeleteAccount", mock.Anything, deletedUser, now).Return([]string{ExportStorageKey(deletedUser, exportID), logoKey}, true, nil)
+	repo.On("DeleteAccount", mock.Anything, cancelledUser, now).Return(nil, false, nil)
+
+	deleted, err := service.ProcessDueDeletions(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	assert.Empty(t, store, "export archives and logos of deleted accounts are removed")
+	repo.AssertExpectations(t)
+}
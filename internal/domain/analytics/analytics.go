@@ -0,0 +1,72 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DailySnapshot holds the platform-wide figures of one UTC day
+// Sales count the orders placed that day that were completed when the snapshot was taken.
+type DailySnapshot struct {
+	Day          time.Time `gorm:"primaryKey;type:date" json:"day"`
+	Orders       int       `gorm:"not null;default:0" json:"orders"`
+	TicketsSold  int       `gorm:"not null;default:0" json:"tickets_sold"`
+	Revenue      float64   `gorm:"type:decimal(12,2);not null;default:0" json:"revenue"`
+	ActiveEvents int       `gorm:"not null;default:0" json:"active_events"` // Events still upcoming at the end of the day and not cancelled
+	NewUsers     int       `gorm:"not null;default:0" json:"new_users"`     // Users registered that day
+	TakenAt      time.Time `gorm:"not null" json:"taken_at"`                // When the figures were computed
+}
+
+// TableName tells GORM what table to use for this model
+func (DailySnapshot) TableName() string {
+	return "daily_snapshots"
+}
+
+// EventDailySales holds the sales of one event on one UTC day
+// OrganizerID is copied from the event so organizer trends never join the events table.
+type EventDailySales struct {
+	Day         time.Time `gorm:"primaryKey;type:date" json:"day"`
+	EventID     uuid.UUID `gorm:"primaryKey;type:uuid" json:"event_id"`
+	OrganizerID uuid.UUID `gorm:"not null;type:uuid" json:"organizer_id"`
+	Orders      int       `gorm:"not null;default:0" json:"orders"`
+	TicketsSold int       `gorm:"not null;default:0" json:"tickets_sold"`
+	Revenue     float64   `gorm:"type:decimal(12,2);not null;default:0" json:"revenue"`
+}
+
+// TableName tells GORM what table to use for this model
+func (EventDailySales) TableName() string {
+	return "event_daily_sales"
+}
+
+// EventDailyViews holds the page views of one event on one UTC day
+// Unique visitors are estimated by the view counter; no visitor identity is stored.
+type EventDailyViews struct {
+	Day            time.Time `gorm:"primaryKey;type:date" json:"day"`
+	EventID        uuid.UUID `gorm:"primaryKey;type:uuid" json:"event_id"`
+	OrganizerID    uuid.UUID `gorm:"not null;type:uuid" json:"organizer_id"`
+	Views          int       `gorm:"not null;default:0" json:"views"`
+	UniqueVisitors int       `gorm:"not null;default:0" json:"unique_visitors"`
+}
+
+// TableName tells GORM what table to use for this model
+func (EventDailyViews) TableName() string {
+	return "event_daily_views"
+}
+
+// SalesDay sums an organizer's event sales and page views on one UTC day
+// UniqueVisitors adds up the visitors of each event, so someone viewing two events counts twice.
+type SalesDay struct {
+	Day            time.Time
+	Orders         int
+	TicketsSold    int
+	Revenue        float64
+	Views          int
+	UniqueVisitors int
+}
+
+// DayStart returns midnight UTC of the day containing t
+func DayStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
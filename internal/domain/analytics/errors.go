@@ -0,0 +1,59 @@
+package analytics
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AnalyticsError represents domain-specific analytics errors
+type AnalyticsError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *AnalyticsError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *AnalyticsError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined analytics domain errors
+var (
+	ErrInvalidPeriod   = &AnalyticsError{Code: "INVALID_PERIOD", Message: fmt.Sprintf("period must end after it starts and span at most %d days", MaxTrendDays)}
+	ErrInvalidBackfill = &AnalyticsError{Code: "INVALID_BACKFILL", Message: "backfill must start before it ends and before today"}
+	ErrSnapshotFailed  = &AnalyticsError{Code: "SNAPSHOT_FAILED", Message: "failed to take snapshot"}
+	ErrRetrievalFailed = &AnalyticsError{Code: "SNAPSHOT_RETRIEVAL_FAILED", Message: "failed to retrieve snapshots"}
+)
+
+// NewAnalyticsError creates a new AnalyticsError with a cause
+func NewAnalyticsError(baseError *AnalyticsError, cause error) *AnalyticsError {
+	return &AnalyticsError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetAnalyticsErrorCode extracts the error code from an AnalyticsError
+func GetAnalyticsErrorCode(err error) string {
+	var analyticsErr *AnalyticsError
+	if errors.As(err, &analyticsErr) {
+		return analyticsErr.Code
+	}
+	return ""
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetAnalyticsErrorCode(err) {
+	case "INVALID_PERIOD", "INVALID_BACKFILL":
+		return true
+	}
+	return false
+}
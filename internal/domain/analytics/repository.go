@@ -0,0 +1,31 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for analytics data access
+type Repository interface {
+	// ComputeDay aggregates the live tables for the UTC day starting at day
+	ComputeDay(ctx context.Context, day time.Time) (*DailySnapshot, []*EventDailySales, error)
+
+	// SaveDay stores the snapshot of a day, replacing any earlier snapshot and event sales of that day
+	SaveDay(ctx context.Context, snapshot *DailySnapshot, sales []*EventDailySales) error
+
+	// LatestDay returns the most recent day with a snapshot, or nil if none was taken yet
+	LatestDay(ctx context.Context) (*time.Time, error)
+
+	// GetSnapshots retrieves the snapshots of days in [from, to), oldest first
+	GetSnapshots(ctx context.Context, from, to time.Time) ([]*DailySnapshot, error)
+
+	// SaveViews stores the page views of events on a day, replacing any earlier counts of those events
+	// Counts of events that no longer exist are dropped.
+	SaveViews(ctx context.Context, day time.Time, views []*ViewCount) error
+
+	// GetOrganizerSales sums an organizer's event sales and page views per day in [from, to), oldest first
+	// Days without sales or views are omitted.
+	GetOrganizerSales(ctx context.Context, organizerID uuid.UUID, from, to time.Time) ([]*SalesDay, error)
+}
@@ -0,0 +1,211 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"enterprise-crud/internal/domain/clock"
+
+	"github.com/google/uuid"
+)
+
+// MaxTrendDays bounds the period of a single trend query
+const MaxTrendDays = 366
+
+// maxCatchUpDays bounds how many missed days TakeDueSnapshots fills in; older gaps need a backfill
+const maxCatchUpDays = 31
+
+// oneDay is the length of a snapshot period
+const oneDay = 24 * time.Hour
+
+// Service defines the business logic interface for analytics snapshots
+type Service interface {
+	// TakeSnapshot computes and stores the snapshot of the UTC day containing t
+	TakeSnapshot(ctx context.Context, t time.Time) (*DailySnapshot, error)
+
+	// TakeDueSnapshots snapshots every day that ended since the latest snapshot
+	TakeDueSnapshots(ctx context.Context, now time.Time) (int, error)
+
+	// Backfill snapshots every UTC day in [from, to) that has ended, replacing existing snapshots
+	Backfill(ctx context.Context, from, to time.Time) (int, error)
+
+	// GetDailySnapshots retrieves the platform snapshots of days in [from, to)
+	GetDailySnapshots(ctx context.Context, from, to time.Time) ([]*DailySnapshot, error)
+
+	// GetOrganizerSales retrieves an organizer's daily sales and page views in [from, to) from the snapshots
+	GetOrganizerSales(ctx context.Context, organizerID uuid.UUID, from, to time.Time) ([]*SalesDay, error)
+
+	// RecordView counts a view of an event's page by visitor, e.g. a user ID or IP address
+	RecordView(ctx context.Context, eventID uuid.UUID, visitor string) error
+
+	// MoveViews stores the view counts of days that have ended in the database and clears them from the counter
+	MoveViews(ctx context.Context, now time.Time) (int, error)
+}
+
+// Option configures optional Service behaviour
+type Option func(*serviceImpl)
+
+// WithViewCounter counts event page views with counter
+// Visitors are hashed with salt before they reach the counter.
+func WithViewCounter(counter ViewCounter, salt []byte) Option {
+	return func(s *serviceImpl) {
+		s.views = counter
+		s.viewSalt = salt
+	}
+}
+
+// WithClock tells today apart from past days by c instead of the system clock
+func WithClock(c clock.Clock) Option {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo     Repository
+	views    ViewCounter // Nil counts no views
+	viewSalt []byte
+	clock    clock.Clock // Tells which UTC day is today
+}
+
+// NewService creates a new analytics service instance
+func NewService(repo Repository, opts ...Option) Service {
+	s := &serviceImpl{repo: repo, clock: clock.System{}}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// TakeSnapshot computes and stores the snapshot of the UTC day containing t
+func (s *serviceImpl) TakeSnapshot(ctx context.Context, t time.Time) (*DailySnapshot, error) {
+	snapshot, sales, err := s.repo.ComputeDay(ctx, DayStart(t))
+	if err != nil {
+		return nil, err
+	}
+	snapshot.TakenAt = s.clock.Now()
+	if err := s.repo.SaveDay(ctx, snapshot, sales); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// TakeDueSnapshots snapshots every day that ended since the latest snapshot
+// Without any snapshot only yesterday is taken, and at most maxCatchUpDays missed days are filled in.
+// Several instances may run it at once; a day snapshotted twice simply holds the later figures.
+func (s *serviceImpl) TakeDueSnapshots(ctx context.Context, now time.Time) (int, error) {
+	today := DayStart(now)
+	start := today.Add(-oneDay)
+
+	latest, err := s.repo.LatestDay(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if latest != nil {
+		start = DayStart(*latest).Add(oneDay)
+	}
+	if oldest := today.AddDate(0, 0, -maxCatchUpDays); start.Before(oldest) {
+		start = oldest
+	}
+
+	taken := 0
+	for d := start; d.Before(today); d = d.Add(oneDay) {
+		if _, err := s.TakeSnapshot(ctx, d); err != nil {
+			return taken, err
+		}
+		taken++
+	}
+	return taken, nil
+}
+
+// Backfill snapshots every UTC day in [from, to) that has ended
+func (s *serviceImpl) Backfill(ctx context.Context, from, to time.Time) (int, error) {
+	from = DayStart(from)
+	to = DayStart(to)
+	if today := DayStart(s.clock.Now()); to.After(today) {
+		to = today
+	}
+	if !from.Before(to) {
+		return 0, ErrInvalidBackfill
+	}
+
+	taken := 0
+	for d := from; d.Before(to); d = d.Add(oneDay) {
+		if _, err := s.TakeSnapshot(ctx, d); err != nil {
+			return taken, err
+		}
+		taken++
+	}
+	return taken, nil
+}
+
+// GetDailySnapshots retrieves the platform snapshots of days in [from, to)
+func (s *serviceImpl) GetDailySnapshots(ctx context.Context, from, to time.Time) ([]*DailySnapshot, error) {
+	from, to, err := trendPeriod(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetSnapshots(ctx, from, to)
+}
+
+// GetOrganizerSales retrieves an organizer's daily sales in [from, to)
+func (s *serviceImpl) GetOrganizerSales(ctx context.Context, organizerID uuid.UUID, from, to time.Time) ([]*SalesDay, error) {
+	from, to, err := trendPeriod(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetOrganizerSales(ctx, organizerID, from, to)
+}
+
+// RecordView counts a view of an event's page by visitor
+func (s *serviceImpl) RecordView(ctx context.Context, eventID uuid.UUID, visitor string) error {
+	if s.views == nil {
+		return nil
+	}
+	day := DayStart(s.clock.Now())
+	return s.views.Record(ctx, day, eventID, visitorHash(s.viewSalt, day, visitor))
+}
+
+// MoveViews stores the view counts of the last MaxViewCatchUpDays days that have ended
+// A day is cleared from the counter only once its counts are stored, and storing replaces
+// earlier counts, so a day moved twice by racing instances keeps the same figures.
+func (s *serviceImpl) MoveViews(ctx context.Context, now time.Time) (int, error) {
+	if s.views == nil {
+		return 0, nil
+	}
+
+	today := DayStart(now)
+	moved := 0
+	for d := today.AddDate(0, 0, -MaxViewCatchUpDays); d.Before(today); d = d.Add(oneDay) {
+		counts, err := s.views.Counts(ctx, d)
+		if err != nil {
+			return moved, err
+		}
+		if len(counts) == 0 {
+			continue
+		}
+		if err := s.repo.SaveViews(ctx, d, counts); err != nil {
+			return moved, err
+		}
+		if err := s.views.Clear(ctx, d); err != nil {
+			return moved, err
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+// trendPeriod rounds a trend query to whole UTC days, counting a partial last day in full
+func trendPeriod(from, to time.Time) (time.Time, time.Time, error) {
+	from = DayStart(from)
+	if end := DayStart(to); end.Before(to) {
+		to = end.Add(oneDay)
+	} else {
+		to = end
+	}
+	if !from.Before(to) || to.Sub(from) > MaxTrendDays*oneDay {
+		return time.Time{}, time.Time{}, ErrInvalidPeriod
+	}
+	return from, to, nil
+}
@@ -0,0 +1,300 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ComputeDay(ctx context.Context, day time.Time) (*DailySnapshot, []*EventDailySales, error) {
+	args := m.Called(ctx, day)
+	snapshot, _ := args.Get(0).(*DailySnapshot)
+	sales, _ := args.Get(1).([]*EventDailySales)
+	return snapshot, sales, args.Error(2)
+}
+
+func (m *MockRepository) SaveDay(ctx context.Context, snapshot *DailySnapshot, sales []*EventDailySales) error {
+	args := m.Called(ctx, snapshot, sales)
+	return args.Error(0)
+}
+
+func (m *MockRepository) SaveViews(ctx context.Context, day time.Time, views []*ViewCount) error {
+	args := m.Called(ctx, day, views)
+	return args.Error(0)
+}
+
+func (m *MockRepository) LatestDay(ctx context.Context) (*time.Time, error) {
+	args := m.Called(ctx)
+	latest, _ := args.Get(0).(*time.Time)
+	return latest, args.Error(1)
+}
+
+func (m *MockRepository) GetSnapshots(ctx context.Context, from, to time.Time) ([]*DailySnapshot, error) {
+	args := m.Called(ctx, from, to)
+	snapshots, _ := args.Get(0).([]*DailySnapshot)
+	return snapshots, args.Error(1)
+}
+
+func (m *MockRepository) GetOrganizerSales(ctx context.Context, organizerID uuid.UUID, from, to time.Time) ([]*SalesDay, error) {
+	args := m.Called(ctx, organizerID, from, to)
+	days, _ := args.Get(0).([]*SalesDay)
+	return days, args.Error(1)
+}
+
+// expectSnapshot lets the repository compute and save an empty snapshot of day
+func expectSnapshot(repo *MockRepository, ctx context.Context, day time.Time) {
+	repo.On("ComputeDay", ctx, day).Return(&DailySnapshot{Day: day}, []*EventDailySales(nil), nil).Once()
+	repo.On("SaveDay", ctx, mock.MatchedBy(func(s *DailySnapshot) bool { return s.Day.Equal(day) }), mock.Anything).Return(nil).Once()
+}
+
+func TestDayStart(t *testing.T) {
+	berlin := time.FixedZone("CEST", 2*60*60)
+	got := DayStart(time.Date(2026, 10, 16, 1, 30, 0, 0, berlin))
+	assert.Equal(t, time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), got)
+}
+
+func TestAnalyticsService_TakeSnapshot(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	sales := []*EventDailySales{{Day: day, EventID: uuid.New(), Orders: 2, TicketsSold: 3, Revenue: 90}}
+
+	repo := new(MockRepository)
+	repo.On("ComputeDay", ctx, day).Return(&DailySnapshot{Day: day, Orders: 2, TicketsSold: 3, Revenue: 90}, sales, nil)
+	repo.On("SaveDay", ctx, mock.AnythingOfType("*analytics.DailySnapshot"), sales).Return(nil)
+	service := NewService(repo)
+
+	snapshot, err := service.TakeSnapshot(ctx, day.Add(15*time.Hour))
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, snapshot.TicketsSold)
+	assert.False(t, snapshot.TakenAt.IsZero())
+	repo.AssertExpectations(t)
+}
+
+func TestAnalyticsService_TakeDueSnapshots(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 0, 30, 0, 0, time.UTC)
+	today := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+
+	t.Run("first run takes yesterday only", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("LatestDay", ctx).Return(nil, nil)
+		expectSnapshot(repo, ctx, today.AddDate(0, 0, -1))
+		service := NewService(repo)
+
+		taken, err := service.TakeDueSnapshots(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, taken)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("catches up missed days", func(t *testing.T) {
+		latest := today.AddDate(0, 0, -3)
+		repo := new(MockRepository)
+		repo.On("LatestDay", ctx).Return(&latest, nil)
+		expectSnapshot(repo, ctx, today.AddDate(0, 0, -2))
+		expectSnapshot(repo, ctx, today.AddDate(0, 0, -1))
+		service := NewService(repo)
+
+		taken, err := service.TakeDueSnapshots(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, taken)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("nothing due once yesterday is taken", func(t *testing.T) {
+		latest := today.AddDate(0, 0, -1)
+		repo := new(MockRepository)
+		repo.On("LatestDay", ctx).Return(&latest, nil)
+		service := NewService(repo)
+
+		taken, err := service.TakeDueSnapshots(ctx, now)
+
+		require.NoError(t, err)
+		assert.Zero(t, taken)
+		repo.AssertNotCalled(t, "ComputeDay", mock.Anything, mock.Anything)
+	})
+
+	t.Run("long gaps are left to a backfill", func(t *testing.T) {
+		latest := today.AddDate(-1, 0, 0)
+		repo := new(MockRepository)
+		repo.On("LatestDay", ctx).Return(&latest, nil)
+		repo.On("ComputeDay", ctx, mock.Anything).Return(&DailySnapshot{}, []*EventDailySales(nil), nil)
+		repo.On("SaveDay", ctx, mock.Anything, mock.Anything).Return(nil)
+		service := NewService(repo)
+
+		taken, err := service.TakeDueSnapshots(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, maxCatchUpDays, taken)
+	})
+}
+
+func TestAnalyticsService_Backfill(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	t.Run("snapshots every day in the period", func(t *testing.T) {
+		repo := new(MockRepository)
+		expectSnapshot(repo, ctx, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		expectSnapshot(repo, ctx, time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC))
+		service := NewService(repo)
+
+		taken, err := service.Backfill(ctx, from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, taken)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("empty period", func(t *testing.T) {
+		service := NewService(new(MockRepository))
+
+		_, err := service.Backfill(ctx, to, from)
+
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("stops at the first failure", func(t *testing.T) {
+		repo := new(MockRepository)
+		expectSnapshot(repo, ctx, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		repo.On("ComputeDay", ctx, time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)).Return(nil, nil, errors.New("connection refused"))
+		service := NewService(repo)
+
+		taken, err := service.Backfill(ctx, from, to)
+
+		assert.Error(t, err)
+		assert.Equal(t, 1, taken)
+	})
+}
+
+func TestAnalyticsService_GetDailySnapshots(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rounds the period to whole days", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetSnapshots", ctx, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)).
+			Return([]*DailySnapshot{}, nil)
+		service := NewService(repo)
+
+		_, err := service.GetDailySnapshots(ctx,
+			time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC),
+			time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC))
+
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects periods that are too long", func(t *testing.T) {
+		service := NewService(new(MockRepository))
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		_, err := service.GetDailySnapshots(ctx, from, from.AddDate(0, 0, MaxTrendDays+1))
+
+		assert.True(t, IsValidationError(err))
+	})
+}
+
+// fakeViewCounter keeps view counts per day and the visitors they were recorded for
+type fakeViewCounter struct {
+	visitors map[time.Time][]string
+	counts   map[time.Time][]*ViewCount
+	cleared  []time.Time
+}
+
+func (c *fakeViewCounter) Record(ctx context.Context, day time.Time, eventID uuid.UUID, visitor string) error {
+	c.visitors[day] = append(c.visitors[day], visitor)
+	return nil
+}
+
+func (c *fakeViewCounter) Counts(ctx context.Context, day time.Time) ([]*ViewCount, error) {
+	return c.counts[day], nil
+}
+
+func (c *fakeViewCounter) Clear(ctx context.Context, day time.Time) error {
+	c.cleared = append(c.cleared, day)
+	return nil
+}
+
+func TestAnalyticsService_RecordView(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+
+	t.Run("hashes visitors", func(t *testing.T) {
+		counter := &fakeViewCounter{visitors: make(map[time.Time][]string)}
+		service := NewService(new(MockRepository), WithViewCounter(counter, []byte("salt")))
+
+		require.NoError(t, service.RecordView(ctx, eventID, "ip:203.0.113.7"))
+		require.NoError(t, service.RecordView(ctx, eventID, "ip:203.0.113.7"))
+		require.NoError(t, service.RecordView(ctx, eventID, "user:42"))
+
+		visitors := counter.visitors[DayStart(time.Now())]
+		require.Len(t, visitors, 3)
+		assert.Equal(t, visitors[0], visitors[1])
+		assert.NotEqual(t, visitors[0], visitors[2])
+		assert.NotContains(t, visitors[0], "203.0.113.7")
+	})
+
+	t.Run("hashes differ per day and salt", func(t *testing.T) {
+		day := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+
+		assert.NotEqual(t, visitorHash([]byte("salt"), day, "user:42"), visitorHash([]byte("salt"), day.Add(oneDay), "user:42"))
+		assert.NotEqual(t, visitorHash([]byte("salt"), day, "user:42"), visitorHash([]byte("pepper"), day, "user:42"))
+	})
+
+	t.Run("without a counter", func(t *testing.T) {
+		assert.NoError(t, NewService(new(MockRepository)).RecordView(ctx, eventID, "user:42"))
+	})
+}
+
+func TestAnalyticsService_MoveViews(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 15, 3, 0, 0, 0, time.UTC)
+	yesterday := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	counts := []*ViewCount{{EventID: uuid.New(), Views: 12, UniqueVisitors: 9}}
+
+	t.Run("moves finished days only", func(t *testing.T) {
+		counter := &fakeViewCounter{counts: map[time.Time][]*ViewCount{
+			yesterday:     counts,
+			DayStart(now): {{EventID: uuid.New(), Views: 1, UniqueVisitors: 1}},
+			yesterday.AddDate(0, 0, -MaxViewCatchUpDays-1): counts,
+		}}
+		repo := new(MockRepository)
+		repo.On("SaveViews", ctx, yesterday, counts).Return(nil).Once()
+		service := NewService(repo, WithViewCounter(counter, []byte("salt")))
+
+		moved, err := service.MoveViews(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, moved)
+		assert.Equal(t, []time.Time{yesterday}, counter.cleared)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("keeps counts that failed to save", func(t *testing.T) {
+		counter := &fakeViewCounter{counts: map[time.Time][]*ViewCount{yesterday: counts}}
+		repo := new(MockRepository)
+		repo.On("SaveViews", ctx, yesterday, counts).Return(errors.New("connection refused"))
+		service := NewService(repo, WithViewCounter(counter, []byte("salt")))
+
+		moved, err := service.MoveViews(ctx, now)
+
+		assert.Error(t, err)
+		assert.Zero(t, moved)
+		assert.Empty(t, counter.cleared)
+	})
+}
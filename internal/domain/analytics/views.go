@@ -0,0 +1,45 @@
+package analytics
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxViewCatchUpDays bounds how many finished days of views MoveViews looks for
+// View counters must keep a day's counts at least this long.
+const MaxViewCatchUpDays = 7
+
+// ViewCount is an event's page views on one UTC day
+type ViewCount struct {
+	EventID        uuid.UUID
+	Views          int
+	UniqueVisitors int
+}
+
+// ViewCounter counts event page views per UTC day until they are moved to the database
+// Visitors arrive already hashed; counters may estimate the number of distinct ones.
+type ViewCounter interface {
+	// Record counts a view of an event on day by visitor
+	Record(ctx context.Context, day time.Time, eventID uuid.UUID, visitor string) error
+
+	// Counts returns the views of every event viewed on day
+	Counts(ctx context.Context, day time.Time) ([]*ViewCount, error)
+
+	// Clear forgets the counts of day
+	Clear(ctx context.Context, day time.Time) error
+}
+
+// visitorHash pseudonymises a visitor for one day
+// The day is part of the hash so a visitor's hashes cannot be linked across days.
+func visitorHash(salt []byte, day time.Time, visitor string) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(day.Format(time.DateOnly)))
+	mac.Write([]byte{0})
+	mac.Write([]byte(visitor))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
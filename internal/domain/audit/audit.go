@@ -0,0 +1,53 @@
+// Package audit reads the audit trails other domains keep, so they can be shipped to systems
+// outside the service. Refunds, event transfers and inventory adjustments each log who changed
+// what in their own table; this package presents their rows as one kind of Entry.
+package audit
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sources of audit entries
+const (
+	SourceRefund    = "refund"    // refund_audit_log: every change of a refund request or dispute
+	SourceTransfer  = "transfer"  // event_transfer_audit_log: every change of an event transfer
+	SourceInventory = "inventory" // event_inventory_adjustments: tickets added to or removed from events
+)
+
+// Sources lists every audit trail, in the order they are exported
+var Sources = []string{SourceRefund, SourceTransfer, SourceInventory}
+
+// ErrUnknownSource is returned for a source not in Sources
+var ErrUnknownSource = errors.New("unknown audit source")
+
+// Entry is one row of an audit trail
+type Entry struct {
+	ID        uuid.UUID      `json:"id"`                 // ID of the row; replays of an entry carry the same ID, so sinks can drop duplicates
+	Source    string         `json:"source"`             // Audit trail the entry comes from
+	Action    string         `json:"action"`             // What was done, e.g. APPROVED or ACCEPTED
+	ActorID   *uuid.UUID     `json:"actor_id,omitempty"` // nil for the payment provider, or once the user who acted is deleted
+	SubjectID uuid.UUID      `json:"subject_id"`         // Refund request, transfer or event the entry is about
+	EventID   *uuid.UUID     `json:"event_id,omitempty"` // Event the subject belongs to
+	Details   map[string]any `json:"details,omitempty"`  // Columns particular to the source
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// Cursor is the position of the last entry of a source a sink acknowledged
+// Entries are ordered by creation time, then ID for entries created in the same instant.
+type Cursor struct {
+	CreatedAt time.Time
+	EntryID   uuid.UUID
+}
+
+// IsZero checks if nothing was exported yet
+func (c Cursor) IsZero() bool {
+	return c.CreatedAt.IsZero() && c.EntryID == uuid.Nil
+}
+
+// CursorOf returns the cursor positioned on entry
+func CursorOf(entry *Entry) Cursor {
+	return Cursor{CreatedAt: entry.CreatedAt, EntryID: entry.ID}
+}
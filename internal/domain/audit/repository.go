@@ -0,0 +1,19 @@
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+// Repository reads audit trails and remembers how far each sink has exported them
+type Repository interface {
+	// List returns up to limit entries of source after the cursor and created before until,
+	// oldest first
+	List(ctx context.Context, source string, after Cursor, until time.Time, limit int) ([]*Entry, error)
+
+	// GetCursor returns the last entry of source acknowledged by sink, or a zero cursor
+	GetCursor(ctx context.Context, sink, source string) (Cursor, error)
+
+	// SaveCursor records the last entry of source acknowledged by sink
+	SaveCursor(ctx context.Context, sink, source string, cursor Cursor) error
+}
@@ -0,0 +1,115 @@
+package branding
+
+import (
+	"bytes"
+	"image"
+	_ "image/gif"  // Register GIF logos with image.DecodeConfig
+	_ "image/jpeg" // Register JPEG logos with image.DecodeConfig
+	_ "image/png"  // Register PNG logos with image.DecodeConfig
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Logo limits
+const (
+	MaxLogoBytes     = 512 << 10 // 512 KiB
+	MinLogoDimension = 32        // Pixels, on either side
+	MaxLogoDimension = 1024      // Pixels, on either side
+)
+
+// logoContentTypes maps the image formats accepted for logos to their media types
+var logoContentTypes = map[string]string{
+	"png":  "image/png",
+	"jpeg": "image/jpeg",
+	"gif":  "image/gif",
+}
+
+// colorPattern matches #rgb and #rrggbb colors
+var colorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Branding is how an organizer's events and invoices are styled
+type Branding struct {
+	OrganizerID     uuid.UUID `gorm:"primaryKey;type:uuid" json:"organizer_id"`
+	PrimaryColor    string    `gorm:"size:7;not null;default:''" json:"primary_color"` // #rrggbb, empty for the default
+	AccentColor     string    `gorm:"size:7;not null;default:''" json:"accent_color"`  // #rrggbb, empty for the default
+	LogoKey         string    `gorm:"size:255;not null;default:''" json:"-"`           // Storage key of the logo, empty without one
+	LogoContentType string    `gorm:"size:32;not null;default:''" json:"logo_content_type"`
+	LogoWidth       int       `gorm:"not null;default:0" json:"logo_width"`
+	LogoHeight      int       `gorm:"not null;default:0" json:"logo_height"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Branding) TableName() string {
+	return "organizer_brandings"
+}
+
+// HasLogo reports whether a logo was uploaded
+func (b *Branding) HasLogo() bool {
+	return b.LogoKey != ""
+}
+
+// IsEmpty reports whether nothing is set, so the default styling applies
+func (b *Branding) IsEmpty() bool {
+	return b.PrimaryColor == "" && b.AccentColor == "" && !b.HasLogo()
+}
+
+// Colors are the brand colors of an organizer
+type Colors struct {
+	Primary string
+	Accent  string
+}
+
+// Logo is an uploaded logo image
+type Logo struct {
+	ContentType string
+	Body        []byte
+	UpdatedAt   time.Time
+}
+
+// LogoKey is where a logo uploaded at a time is stored
+// Every upload gets its own key, so a replaced logo is never served with the old content type.
+func LogoKey(organizerID uuid.UUID, uploadedAt time.Time) string {
+	return "branding/" + organizerID.String() + "/logo-" + uploadedAt.UTC().Format("20060102T150405.000000000Z")
+}
+
+// NormalizeColor checks a #rgb or #rrggbb color and returns it as lowercase #rrggbb
+// An empty color is returned as is, meaning the default.
+func NormalizeColor(color string) (string, bool) {
+	color = strings.TrimSpace(color)
+	if color == "" {
+		return "", true
+	}
+	if !colorPattern.MatchString(color) {
+		return "", false
+	}
+	color = strings.ToLower(color)
+	if len(color) == 4 {
+		color = "#" + strings.Repeat(color[1:2], 2) + strings.Repeat(color[2:3], 2) + strings.Repeat(color[3:4], 2)
+	}
+	return color, true
+}
+
+// inspectLogo checks an uploaded logo's format and dimensions from its header
+func inspectLogo(data []byte) (contentType string, width, height int, err error) {
+	if len(data) > MaxLogoBytes {
+		return "", 0, 0, ErrLogoTooLarge
+	}
+	config, format, decodeErr := image.DecodeConfig(bytes.NewReader(data))
+	if decodeErr != nil {
+		return "", 0, 0, ErrUnsupportedLogo
+	}
+	contentType, ok := logoContentTypes[format]
+	if !ok {
+		return "", 0, 0, ErrUnsupportedLogo
+	}
+	if config.Width < MinLogoDimension || config.Height < MinLogoDimension ||
+		config.Width > MaxLogoDimension || config.Height > MaxLogoDimension {
+		return "", 0, 0, ErrLogoDimensions
+	}
+	return contentType, config.Width, config.Height, nil
+}
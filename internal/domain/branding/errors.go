@@ -0,0 +1,73 @@
+package branding
+
+import (
+	"errors"
+	"fmt"
+)
+
+// BrandingError represents domain-specific branding errors
+type BrandingError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *BrandingError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *BrandingError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined branding domain errors
+var (
+	ErrInvalidColor            = &BrandingError{Code: "INVALID_COLOR", Message: "colors must be hex colors like #1a2b3c or #abc"}
+	ErrUnsupportedLogo         = &BrandingError{Code: "UNSUPPORTED_LOGO", Message: "logos must be PNG, JPEG or GIF images"}
+	ErrLogoTooLarge            = &BrandingError{Code: "LOGO_TOO_LARGE", Message: fmt.Sprintf("logos must be at most %d bytes", MaxLogoBytes)}
+	ErrLogoDimensions          = &BrandingError{Code: "INVALID_LOGO_DIMENSIONS", Message: fmt.Sprintf("logos must be between %d and %d pixels wide and high", MinLogoDimension, MaxLogoDimension)}
+	ErrLogoNotFound            = &BrandingError{Code: "LOGO_NOT_FOUND", Message: "the organizer has no logo"}
+	ErrBrandingRetrievalFailed = &BrandingError{Code: "BRANDING_RETRIEVAL_FAILED", Message: "failed to retrieve branding"}
+	ErrBrandingSaveFailed      = &BrandingError{Code: "BRANDING_SAVE_FAILED", Message: "failed to save branding"}
+	ErrLogoStorageFailed       = &BrandingError{Code: "LOGO_STORAGE_FAILED", Message: "failed to access stored logo"}
+)
+
+// NewBrandingError creates a new BrandingError with a cause
+func NewBrandingError(baseError *BrandingError, cause error) *BrandingError {
+	return &BrandingError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetBrandingErrorCode extracts the error code from a BrandingError
+func GetBrandingErrorCode(err error) string {
+	var brandingErr *BrandingError
+	if errors.As(err, &brandingErr) {
+		return brandingErr.Code
+	}
+	return ""
+}
+
+// IsValidationError checks if an error is caused by an invalid color or logo
+func IsValidationError(err error) bool {
+	switch GetBrandingErrorCode(err) {
+	case "INVALID_COLOR", "UNSUPPORTED_LOGO", "INVALID_LOGO_DIMENSIONS":
+		return true
+	}
+	return false
+}
+
+// IsLogoTooLargeError checks if an error is because a logo exceeds MaxLogoBytes
+func IsLogoTooLargeError(err error) bool {
+	return GetBrandingErrorCode(err) == "LOGO_TOO_LARGE"
+}
+
+// IsLogoNotFoundError checks if an error is because there is no logo
+func IsLogoNotFoundError(err error) bool {
+	return GetBrandingErrorCode(err) == "LOGO_NOT_FOUND"
+}
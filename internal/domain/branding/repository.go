@@ -0,0 +1,32 @@
+package branding
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for branding data access
+type Repository interface {
+	// Get retrieves an organizer's branding, or nil if none was ever set
+	Get(ctx context.Context, organizerID uuid.UUID) (*Branding, error)
+
+	// GetMany retrieves the branding of several organizers, keyed by organizer ID
+	// Organizers without branding are left out.
+	GetMany(ctx context.Context, organizerIDs []uuid.UUID) (map[uuid.UUID]*Branding, error)
+
+	// Save creates or replaces an organizer's branding
+	Save(ctx context.Context, b *Branding) error
+}
+
+// Store keeps uploaded logos, e.g. in object storage
+type Store interface {
+	// Get returns the object stored under key, or nil if there is none
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put stores data under key, replacing any previous object
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Delete removes the object stored under key; missing objects are not an error
+	Delete(ctx context.Context, key string) error
+}
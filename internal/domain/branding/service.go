@@ -0,0 +1,204 @@
+package branding
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for organizer branding
+type Service interface {
+	// GetBranding returns an organizer's branding; organizers who never set one get an empty Branding
+	GetBranding(ctx context.Context, organizerID uuid.UUID) (*Branding, error)
+
+	// GetBrandings returns the branding of several organizers, keyed by organizer ID
+	// Organizers without branding are left out.
+	GetBrandings(ctx context.Context, organizerIDs []uuid.UUID) (map[uuid.UUID]*Branding, error)
+
+	// SetColors replaces an organizer's brand colors; empty colors restore the default
+	SetColors(ctx context.Context, organizerID uuid.UUID, colors Colors) (*Branding, error)
+
+	// UploadLogo replaces an organizer's logo with a PNG, JPEG or GIF image
+	UploadLogo(ctx context.Context, organizerID uuid.UUID, data []byte) (*Branding, error)
+
+	// DeleteLogo removes an organizer's logo
+	DeleteLogo(ctx context.Context, organizerID uuid.UUID) (*Branding, error)
+
+	// GetLogo returns an organizer's logo image, or ErrLogoNotFound
+	GetLogo(ctx context.Context, organizerID uuid.UUID) (*Logo, error)
+
+	// LogoURL returns the public URL of a branding's logo, or "" without one
+	// The URL changes with every upload, so it can be cached for long.
+	LogoURL(b *Branding) string
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo      Repository
+	store     Store
+	publicURL string
+	clock     clock.Clock // Tells the time logo keys are derived from
+}
+
+// ServiceOption configures optional branding Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock derives logo keys from the time c tells instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// NewService creates a new branding service serving logos below publicURL
+func NewService(repo Repository, store Store, publicURL string, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repo:      repo,
+		store:     store,
+		publicURL: strings.TrimRight(publicURL, "/"),
+		clock:     clock.System{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// GetBranding returns an organizer's branding
+func (s *serviceImpl) GetBranding(ctx context.Context, organizerID uuid.UUID) (*Branding, error) {
+	b, err := s.repo.Get(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return &Branding{OrganizerID: organizerID}, nil
+	}
+	return b, nil
+}
+
+// GetBrandings returns the branding of several organizers
+func (s *serviceImpl) GetBrandings(ctx context.Context, organizerIDs []uuid.UUID) (map[uuid.UUID]*Branding, error) {
+	if len(organizerIDs) == 0 {
+		return map[uuid.UUID]*Branding{}, nil
+	}
+	return s.repo.GetMany(ctx, organizerIDs)
+}
+
+// SetColors replaces an organizer's brand colors
+func (s *serviceImpl) SetColors(ctx context.Context, organizerID uuid.UUID, colors Colors) (*Branding, error) {
+	primary, ok := NormalizeColor(colors.Primary)
+	if !ok {
+		return nil, ErrInvalidColor
+	}
+	accent, ok := NormalizeColor(colors.Accent)
+	if !ok {
+		return nil, ErrInvalidColor
+	}
+
+	b, err := s.GetBranding(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	b.PrimaryColor = primary
+	b.AccentColor = accent
+	if err := s.repo.Save(ctx, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UploadLogo stores a new logo and then points the branding at it
+// The replaced logo is deleted afterwards; failing to delete it only leaves an orphaned object.
+func (s *serviceImpl) UploadLogo(ctx context.Context, organizerID uuid.UUID, data []byte) (*Branding, error) {
+	contentType, width, height, err := inspectLogo(data)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.GetBranding(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := LogoKey(organizerID, s.clock.Now())
+	if err := s.store.Put(ctx, key, data); err != nil {
+		return nil, NewBrandingError(ErrLogoStorageFailed, err)
+	}
+	previousKey := b.LogoKey
+	b.LogoKey = key
+	b.LogoContentType = contentType
+	b.LogoWidth = width
+	b.LogoHeight = height
+	if err := s.repo.Save(ctx, b); err != nil {
+		s.deleteLogo(ctx, key)
+		return nil, err
+	}
+
+	if previousKey != "" {
+		s.deleteLogo(ctx, previousKey)
+	}
+	return b, nil
+}
+
+// DeleteLogo removes an organizer's logo
+func (s *serviceImpl) DeleteLogo(ctx context.Context, organizerID uuid.UUID) (*Branding, error) {
+	b, err := s.GetBranding(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	if !b.HasLogo() {
+		return nil, ErrLogoNotFound
+	}
+
+	key := b.LogoKey
+	b.LogoKey = ""
+	b.LogoContentType = ""
+	b.LogoWidth = 0
+	b.LogoHeight = 0
+	if err := s.repo.Save(ctx, b); err != nil {
+		return nil, err
+	}
+	s.deleteLogo(ctx, key)
+	return b, nil
+}
+
+// GetLogo returns an organizer's logo image
+func (s *serviceImpl) GetLogo(ctx context.Context, organizerID uuid.UUID) (*Logo, error) {
+	b, err := s.repo.Get(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil || !b.HasLogo() {
+		return nil, ErrLogoNotFound
+	}
+
+	body, err := s.store.Get(ctx, b.LogoKey)
+	if err != nil {
+		return nil, NewBrandingError(ErrLogoStorageFailed, err)
+	}
+	if body == nil {
+		return nil, ErrLogoNotFound
+	}
+	return &Logo{ContentType: b.LogoContentType, Body: body, UpdatedAt: b.UpdatedAt}, nil
+}
+
+// LogoURL returns the public URL of a branding's logo, versioned by the upload it serves
+func (s *serviceImpl) LogoURL(b *Branding) string {
+	if b == nil || !b.HasLogo() {
+		return ""
+	}
+	version := strings.TrimPrefix(path.Base(b.LogoKey), "logo-")
+	return s.publicURL + "/api/v1/organizers/" + b.OrganizerID.String() + "/branding/logo?v=" + version
+}
+
+// deleteLogo removes a stored logo that is no longer referenced, logging failures
+func (s *serviceImpl) deleteLogo(ctx context.Context, key string) {
+	if err := s.store.Delete(ctx, key); err != nil {
+		logging.From(ctx).Warn("Failed to delete replaced logo", "key", key, "error", err)
+	}
+}
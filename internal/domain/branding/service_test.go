@@ -0,0 +1,180 @@
+package branding
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRepository keeps brandings in a map
+type stubRepository struct {
+	brandings map[uuid.UUID]Branding
+}
+
+func newStubRepository() *stubRepository {
+	return &stubRepository{brandings: make(map[uuid.UUID]Branding)}
+}
+
+func (r *stubRepository) Get(ctx context.Context, organizerID uuid.UUID) (*Branding, error) {
+	b, ok := r.brandings[organizerID]
+	if !ok {
+		return nil, nil
+	}
+	return &b, nil
+}
+
+func (r *stubRepository) GetMany(ctx context.Context, organizerIDs []uuid.UUID) (map[uuid.UUID]*Branding, error) {
+	result := make(map[uuid.UUID]*Branding)
+	for _, id := range organizerIDs {
+		if b, ok := r.brandings[id]; ok {
+			result[id] = &b
+		}
+	}
+	return result, nil
+}
+
+func (r *stubRepository) Save(ctx context.Context, b *Branding) error {
+	r.brandings[b.OrganizerID] = *b
+	return nil
+}
+
+// memoryStore keeps objects in a map
+type memoryStore map[string][]byte
+
+func (s memoryStore) Get(ctx context.Context, key string) ([]byte, error) { return s[key], nil }
+
+func (s memoryStore) Put(ctx context.Context, key string, data []byte) error {
+	s[key] = data
+	return nil
+}
+
+func (s memoryStore) Delete(ctx context.Context, key string) error {
+	delete(s, key)
+	return nil
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestNormalizeColor(t *testing.T) {
+	tests := []struct {
+		color    string
+		expected string
+		ok       bool
+	}{
+		{color: "", expected: "", ok: true},
+		{color: "#1A2B3C", expected: "#1a2b3c", ok: true},
+		{color: " #abc ", expected: "#aabbcc", ok: true},
+		{color: "1a2b3c"},
+		{color: "#12345"},
+		{color: "red"},
+		{color: "#1a2b3c;background:url(x)"},
+	}
+
+	for _, tt := range tests {
+		color, ok := NormalizeColor(tt.color)
+		assert.Equal(t, tt.ok, ok, tt.color)
+		assert.Equal(t, tt.expected, color, tt.color)
+	}
+}
+
+func TestService_SetColors(t *testing.T) {
+	ctx := context.Background()
+	organizerID := uuid.New()
+
+	t.Run("invalid color", func(t *testing.T) {
+		_, err := NewService(newStubRepository(), memoryStore{}, "").SetColors(ctx, organizerID, Colors{Primary: "blue"})
+		assert.Equal(t, ErrInvalidColor, err)
+	})
+
+	t.Run("keeps the logo", func(t *testing.T) {
+		repo := newStubRepository()
+		repo.brandings[organizerID] = Branding{OrganizerID: organizerID, LogoKey: "branding/logo", LogoContentType: "image/png"}
+
+		b, err := NewService(repo, memoryStore{}, "").SetColors(ctx, organizerID, Colors{Primary: "#ABC", Accent: ""})
+		require.NoError(t, err)
+		assert.Equal(t, "#aabbcc", b.PrimaryColor)
+		assert.Empty(t, b.AccentColor)
+		assert.NotEmpty(t, repo.brandings[organizerID].LogoKey)
+	})
+}
+
+func TestService_UploadLogo(t *testing.T) {
+	ctx := context.Background()
+	organizerID := uuid.New()
+
+	tests := []struct {
+		name        string
+		data        []byte
+		expectedErr error
+	}{
+		{name: "not an image", data: []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"/>"), expectedErr: ErrUnsupportedLogo},
+		{name: "too small", data: testPNG(t, 16, 64), expectedErr: ErrLogoDimensions},
+		{name: "too large", data: testPNG(t, 2048, 64), expectedErr: ErrLogoDimensions},
+		{name: "too many bytes", data: append(testPNG(t, 64, 64), make([]byte, MaxLogoBytes)...), expectedErr: ErrLogoTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memoryStore{}
+			_, err := NewService(newStubRepository(), store, "").UploadLogo(ctx, organizerID, tt.data)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Empty(t, store)
+		})
+	}
+
+	t.Run("replaces the previous logo", func(t *testing.T) {
+		repo := newStubRepository()
+		store := memoryStore{}
+		service := NewService(repo, store, "https://tickets.example.com/")
+
+		first, err := service.UploadLogo(ctx, organizerID, testPNG(t, 64, 64))
+		require.NoError(t, err)
+		firstKey := first.LogoKey
+		second, err := service.UploadLogo(ctx, organizerID, testPNG(t, 200, 100))
+		require.NoError(t, err)
+
+		assert.NotEqual(t, firstKey, second.LogoKey)
+		assert.Len(t, store, 1, "the replaced logo is deleted")
+		assert.Equal(t, "image/png", second.LogoContentType)
+		assert.Equal(t, 200, second.LogoWidth)
+		assert.Equal(t, 100, second.LogoHeight)
+		assert.True(t, strings.HasPrefix(service.LogoURL(second), "https://tickets.example.com/api/v1/organizers/"+organizerID.String()+"/branding/logo?v="))
+		assert.NotEqual(t, service.LogoURL(first), service.LogoURL(second))
+
+		logo, err := service.GetLogo(ctx, organizerID)
+		require.NoError(t, err)
+		assert.Equal(t, store[second.LogoKey], logo.Body)
+	})
+}
+
+func TestService_DeleteLogo(t *testing.T) {
+	ctx := context.Background()
+	organizerID := uuid.New()
+	repo := newStubRepository()
+	store := memoryStore{}
+	service := NewService(repo, store, "")
+
+	_, err := service.DeleteLogo(ctx, organizerID)
+	assert.Equal(t, ErrLogoNotFound, err)
+
+	_, err = service.UploadLogo(ctx, organizerID, testPNG(t, 64, 64))
+	require.NoError(t, err)
+	b, err := service.DeleteLogo(ctx, organizerID)
+	require.NoError(t, err)
+
+	assert.False(t, b.HasLogo())
+	assert.Empty(t, store)
+	assert.Empty(t, service.LogoURL(b))
+	_, err = service.GetLogo(ctx, organizerID)
+	assert.Equal(t, ErrLogoNotFound, err)
+}
@@ -0,0 +1,88 @@
+// Package clock supplies services with the current time and the IDs of new records. Services
+// take them as dependencies instead of calling time.Now and uuid.New, so tests can fix the
+// instant time-based rules are checked at and predict the IDs they create.
+package clock
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Clock tells the current time
+type Clock interface {
+	Now() time.Time
+}
+
+// IDGenerator creates the IDs of new records
+type IDGenerator interface {
+	NewID() uuid.UUID
+}
+
+// System is the Clock of the machine the service runs on
+type System struct{}
+
+// Now returns the current local time
+func (System) Now() time.Time {
+	return time.Now()
+}
+
+// RandomIDs generates random (version 4) UUIDs
+type RandomIDs struct{}
+
+// NewID returns a new random UUID
+func (RandomIDs) NewID() uuid.UUID {
+	return uuid.New()
+}
+
+// Fake is a Clock that stands still until it is set or advanced, for tests
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFake creates a fake clock showing now
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the time the clock shows
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Set moves the clock to now
+func (f *Fake) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = now
+}
+
+// Advance moves the clock forward by d
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// SequentialIDs generates the UUIDs 00000000-0000-0000-0000-000000000001, …002 and so on, for tests
+type SequentialIDs struct {
+	mu   sync.Mutex
+	next uint64
+}
+
+// NewID returns the next UUID of the sequence
+func (s *SequentialIDs) NewID() uuid.UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.next++
+
+	var id uuid.UUID
+	for i := 0; i < 8; i++ {
+		id[15-i] = byte(s.next >> (8 * i))
+	}
+	return id
+}
@@ -0,0 +1,30 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	fake := NewFake(start)
+	assert.Equal(t, start, fake.Now())
+	assert.Equal(t, start, fake.Now(), "the clock stands still")
+
+	fake.Advance(time.Hour)
+	assert.Equal(t, start.Add(time.Hour), fake.Now())
+
+	fake.Set(start)
+	assert.Equal(t, start, fake.Now())
+}
+
+func TestSequentialIDs(t *testing.T) {
+	var ids SequentialIDs
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", ids.NewID().String())
+	assert.Equal(t, "00000000-0000-0000-0000-000000000002", ids.NewID().String())
+
+	ids.next = 255
+	assert.Equal(t, "00000000-0000-0000-0000-000000000100", ids.NewID().String())
+}
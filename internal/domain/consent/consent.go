@@ -0,0 +1,60 @@
+package consent
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Policy types users accept
+const (
+	TypeTerms   = "TERMS"   // Terms of service; purchases are blocked until the latest version is accepted
+	TypePrivacy = "PRIVACY" // Privacy policy
+)
+
+// Types lists every policy type, in the order they are reported
+var Types = []string{TypeTerms, TypePrivacy}
+
+// IsValidType checks if a policy type is known
+func IsValidType(policyType string) bool {
+	for _, t := range Types {
+		if t == policyType {
+			return true
+		}
+	}
+	return false
+}
+
+// Version is a published version of a policy document
+// The newest published version of each type is the one users must accept.
+type Version struct {
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	Type        string    `gorm:"size:20;not null" json:"type"`
+	Version     string    `gorm:"size:50;not null" json:"version"` // Label shown to users, e.g. "2026-05"
+	URL         string    `gorm:"size:500;not null" json:"url"`    // Where the document can be read
+	PublishedAt time.Time `gorm:"not null" json:"published_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Version) TableName() string {
+	return "policy_versions"
+}
+
+// Consent records that a user accepted a policy version
+type Consent struct {
+	UserID     uuid.UUID `gorm:"primaryKey;type:uuid" json:"user_id"`
+	VersionID  uuid.UUID `gorm:"primaryKey;type:uuid" json:"version_id"`
+	AcceptedAt time.Time `gorm:"not null" json:"accepted_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Consent) TableName() string {
+	return "user_consents"
+}
+
+// Acceptance is a policy version together with when the user accepted it
+type Acceptance struct {
+	Version
+	AcceptedAt time.Time
+}
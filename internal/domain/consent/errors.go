@@ -0,0 +1,77 @@
+package consent
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ConsentError represents domain-specific consent errors
+type ConsentError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *ConsentError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *ConsentError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined consent domain errors
+var (
+	ErrInvalidType            = &ConsentError{Code: "INVALID_POLICY_TYPE", Message: "policy type must be TERMS or PRIVACY"}
+	ErrInvalidVersion         = &ConsentError{Code: "INVALID_POLICY_VERSION", Message: "version label and document URL are required"}
+	ErrVersionExists          = &ConsentError{Code: "POLICY_VERSION_EXISTS", Message: "this policy version has already been published"}
+	ErrVersionNotFound        = &ConsentError{Code: "POLICY_VERSION_NOT_FOUND", Message: "policy version not found"}
+	ErrConsentRequired        = &ConsentError{Code: "CONSENT_REQUIRED", Message: "the latest terms of service must be accepted"}
+	ErrConsentRetrievalFailed = &ConsentError{Code: "CONSENT_RETRIEVAL_FAILED", Message: "failed to retrieve consents"}
+	ErrConsentSaveFailed      = &ConsentError{Code: "CONSENT_SAVE_FAILED", Message: "failed to save consent"}
+)
+
+// NewConsentError creates a new ConsentError with a cause
+func NewConsentError(baseError *ConsentError, cause error) *ConsentError {
+	return &ConsentError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetConsentErrorCode extracts the error code from a ConsentError
+func GetConsentErrorCode(err error) string {
+	var consentErr *ConsentError
+	if errors.As(err, &consentErr) {
+		return consentErr.Code
+	}
+	return ""
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetConsentErrorCode(err) {
+	case "INVALID_POLICY_TYPE", "INVALID_POLICY_VERSION":
+		return true
+	}
+	return false
+}
+
+// IsVersionExistsError checks if an error is a "version already published" error
+func IsVersionExistsError(err error) bool {
+	return GetConsentErrorCode(err) == "POLICY_VERSION_EXISTS"
+}
+
+// IsVersionNotFoundError checks if an error is a "version not found" error
+func IsVersionNotFoundError(err error) bool {
+	return GetConsentErrorCode(err) == "POLICY_VERSION_NOT_FOUND"
+}
+
+// IsConsentRequiredError checks if an error is because the latest terms were not accepted
+func IsConsentRequiredError(err error) bool {
+	return GetConsentErrorCode(err) == "CONSENT_REQUIRED"
+}
@@ -0,0 +1,32 @@
+package consent
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for policy version and consent data access
+type Repository interface {
+	// CreateVersion stores a newly published policy version
+	CreateVersion(ctx context.Context, version *Version) error
+
+	// GetVersions retrieves policy versions by their IDs; unknown IDs are skipped
+	GetVersions(ctx context.Context, ids []uuid.UUID) ([]*Version, error)
+
+	// ListVersions retrieves every version of a policy type, newest first
+	ListVersions(ctx context.Context, policyType string) ([]*Version, error)
+
+	// GetLatestVersions retrieves the newest published version of each policy type
+	GetLatestVersions(ctx context.Context) ([]*Version, error)
+
+	// GetPendingVersions retrieves the latest versions the user has not accepted
+	GetPendingVersions(ctx context.Context, userID uuid.UUID) ([]*Version, error)
+
+	// ListAcceptances retrieves the versions the user accepted, most recent first
+	ListAcceptances(ctx context.Context, userID uuid.UUID) ([]*Acceptance, error)
+
+	// RecordConsents records that the user accepted the versions; repeated acceptances are ignored
+	RecordConsents(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID, acceptedAt time.Time) error
+}
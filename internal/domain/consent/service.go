@@ -0,0 +1,173 @@
+package consent
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"enterprise-crud/internal/domain/clock"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for policy versions and user consents
+type Service interface {
+	// PublishVersion publishes a new version of a policy, which users must then accept
+	PublishVersion(ctx context.Context, policyType, version, documentURL string) (*Version, error)
+
+	// ListVersions lists every published version of a policy type, newest first
+	ListVersions(ctx context.Context, policyType string) ([]*Version, error)
+
+	// GetLatestVersions returns the current version of each policy type
+	GetLatestVersions(ctx context.Context) ([]*Version, error)
+
+	// GetPendingVersions returns the current versions the user has not accepted
+	GetPendingVersions(ctx context.Context, userID uuid.UUID) ([]*Version, error)
+
+	// ListAcceptances lists the versions the user accepted, most recent first
+	ListAcceptances(ctx context.Context, userID uuid.UUID) ([]*Acceptance, error)
+
+	// Accept records that the user accepted the given versions
+	Accept(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID) ([]*Version, error)
+
+	// AcceptPending records that the user accepted every current version they had not accepted
+	AcceptPending(ctx context.Context, userID uuid.UUID) ([]*Version, error)
+
+	// CheckTermsAccepted returns ErrConsentRequired unless the user accepted the current terms of service
+	CheckTermsAccepted(ctx context.Context, userID uuid.UUID) error
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo  Repository
+	clock clock.Clock // Stamps published documents and recorded consents
+}
+
+// ServiceOption configures optional consent Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock stamps documents and consents with the time c tells instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// NewService creates a new consent service instance
+func NewService(repo Repository, opts ...ServiceOption) Service {
+	s := &serviceImpl{repo: repo, clock: clock.System{}}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// PublishVersion publishes a new version of a policy
+func (s *serviceImpl) PublishVersion(ctx context.Context, policyType, version, documentURL string) (*Version, error) {
+	if !IsValidType(policyType) {
+		return nil, ErrInvalidType
+	}
+	version = strings.TrimSpace(version)
+	documentURL = strings.TrimSpace(documentURL)
+	if version == "" || len(version) > 50 || !isHTTPURL(documentURL) {
+		return nil, ErrInvalidVersion
+	}
+
+	v := &Version{
+		Type:        policyType,
+		Version:     version,
+		URL:         documentURL,
+		PublishedAt: s.clock.Now(),
+	}
+	if err := s.repo.CreateVersion(ctx, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListVersions lists every published version of a policy type
+func (s *serviceImpl) ListVersions(ctx context.Context, policyType string) ([]*Version, error) {
+	if !IsValidType(policyType) {
+		return nil, ErrInvalidType
+	}
+	return s.repo.ListVersions(ctx, policyType)
+}
+
+// GetLatestVersions returns the current version of each policy type
+func (s *serviceImpl) GetLatestVersions(ctx context.Context) ([]*Version, error) {
+	return s.repo.GetLatestVersions(ctx)
+}
+
+// GetPendingVersions returns the current versions the user has not accepted
+func (s *serviceImpl) GetPendingVersions(ctx context.Context, userID uuid.UUID) ([]*Version, error) {
+	return s.repo.GetPendingVersions(ctx, userID)
+}
+
+// ListAcceptances lists the versions the user accepted
+func (s *serviceImpl) ListAcceptances(ctx context.Context, userID uuid.UUID) ([]*Acceptance, error) {
+	return s.repo.ListAcceptances(ctx, userID)
+}
+
+// Accept records that the user accepted the given versions
+// Older versions may be accepted too; only the current ones lift the purchase gate.
+func (s *serviceImpl) Accept(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID) ([]*Version, error) {
+	versions, err := s.repo.GetVersions(ctx, versionIDs)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[uuid.UUID]bool, len(versions))
+	for _, v := range versions {
+		found[v.ID] = true
+	}
+	for _, id := range versionIDs {
+		if !found[id] {
+			return nil, ErrVersionNotFound
+		}
+	}
+
+	if err := s.repo.RecordConsents(ctx, userID, versionIDs, s.clock.Now()); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// AcceptPending records that the user accepted every current version they had not accepted
+func (s *serviceImpl) AcceptPending(ctx context.Context, userID uuid.UUID) ([]*Version, error) {
+	pending, err := s.repo.GetPendingVersions(ctx, userID)
+	if err != nil || len(pending) == 0 {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(pending))
+	for i, v := range pending {
+		ids[i] = v.ID
+	}
+	if err := s.repo.RecordConsents(ctx, userID, ids, s.clock.Now()); err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+// CheckTermsAccepted returns ErrConsentRequired unless the user accepted the current terms
+// Nothing is required before terms of service have been published.
+func (s *serviceImpl) CheckTermsAccepted(ctx context.Context, userID uuid.UUID) error {
+	pending, err := s.repo.GetPendingVersions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, v := range pending {
+		if v.Type == TypeTerms {
+			return ErrConsentRequired
+		}
+	}
+	return nil
+}
+
+// isHTTPURL checks that a document URL is an absolute http(s) URL
+func isHTTPURL(raw string) bool {
+	if len(raw) > 500 {
+		return false
+	}
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,169 @@
+package consent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) CreateVersion(ctx context.Context, version *Version) error {
+	args := m.Called(ctx, version)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetVersions(ctx context.Context, ids []uuid.UUID) ([]*Version, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]*Version), args.Error(1)
+}
+
+func (m *MockRepository) ListVersions(ctx context.Context, policyType string) ([]*Version, error) {
+	args := m.Called(ctx, policyType)
+	return args.Get(0).([]*Version), args.Error(1)
+}
+
+func (m *MockRepository) GetLatestVersions(ctx context.Context) ([]*Version, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*Version), args.Error(1)
+}
+
+func (m *MockRepository) GetPendingVersions(ctx context.Context, userID uuid.UUID) ([]*Version, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*Version), args.Error(1)
+}
+
+func (m *MockRepository) ListAcceptances(ctx context.Context, userID uuid.UUID) ([]*Acceptance, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*Acceptance), args.Error(1)
+}
+
+func (m *MockRepository) RecordConsents(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID, acceptedAt time.Time) error {
+	args := m.Called(ctx, userID, versionIDs, acceptedAt)
+	return args.Error(0)
+}
+
+func TestConsentService_PublishVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		policyType  string
+		version     string
+		documentURL string
+		wantErr     error
+	}{
+		{name: "valid", policyType: TypeTerms, version: " 2026-05 ", documentURL: "https://example.com/terms"},
+		{name: "unknown type", policyType: "COOKIES", version: "1", documentURL: "https://example.com/cookies", wantErr: ErrInvalidType},
+		{name: "missing version", policyType: TypePrivacy, version: "  ", documentURL: "https://example.com/privacy", wantErr: ErrInvalidVersion},
+		{name: "relative URL", policyType: TypePrivacy, version: "1", documentURL: "/privacy", wantErr: ErrInvalidVersion},
+		{name: "non-http URL", policyType: TypePrivacy, version: "1", documentURL: "javascript:alert(1)", wantErr: ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			repo.On("CreateVersion", mock.Anything, mock.AnythingOfType("*consent.Version")).Return(nil)
+			service := NewService(repo)
+
+			v, err := service.PublishVersion(context.Background(), tt.policyType, tt.version, tt.documentURL)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				repo.AssertNotCalled(t, "CreateVersion", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "2026-05", v.Version)
+			assert.False(t, v.PublishedAt.IsZero())
+		})
+	}
+}
+
+func TestConsentService_Accept(t *testing.T) {
+	userID := uuid.New()
+	known := &Version{ID: uuid.New(), Type: TypeTerms, Version: "1"}
+
+	t.Run("records known versions", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetVersions", mock.Anything, []uuid.UUID{known.ID}).Return([]*Version{known}, nil)
+		repo.On("RecordConsents", mock.Anything, userID, []uuid.UUID{known.ID}, mock.AnythingOfType("time.Time")).Return(nil)
+
+		accepted, err := NewService(repo).Accept(context.Background(), userID, []uuid.UUID{known.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, []*Version{known}, accepted)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects unknown versions", func(t *testing.T) {
+		unknown := uuid.New()
+		repo := new(MockRepository)
+		repo.On("GetVersions", mock.Anything, []uuid.UUID{known.ID, unknown}).Return([]*Version{known}, nil)
+
+		_, err := NewService(repo).Accept(context.Background(), userID, []uuid.UUID{known.ID, unknown})
+
+		assert.True(t, IsVersionNotFoundError(err))
+		repo.AssertNotCalled(t, "RecordConsents", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestConsentService_AcceptPending(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("records every pending version", func(t *testing.T) {
+		terms := &Version{ID: uuid.New(), Type: TypeTerms}
+		privacy := &Version{ID: uuid.New(), Type: TypePrivacy}
+		repo := new(MockRepository)
+		repo.On("GetPendingVersions", mock.Anything, userID).Return([]*Version{terms, privacy}, nil)
+		repo.On("RecordConsents", mock.Anything, userID, []uuid.UUID{terms.ID, privacy.ID}, mock.AnythingOfType("time.Time")).Return(nil)
+
+		accepted, err := NewService(repo).AcceptPending(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Len(t, accepted, 2)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("nothing pending", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetPendingVersions", mock.Anything, userID).Return([]*Version{}, nil)
+
+		accepted, err := NewService(repo).AcceptPending(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Empty(t, accepted)
+		repo.AssertNotCalled(t, "RecordConsents", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestConsentService_CheckTermsAccepted(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name    string
+		pending []*Version
+		wantErr bool
+	}{
+		{name: "nothing pending", pending: []*Version{}},
+		{name: "only privacy policy pending", pending: []*Version{{Type: TypePrivacy}}},
+		{name: "terms pending", pending: []*Version{{Type: TypePrivacy}, {Type: TypeTerms}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			repo.On("GetPendingVersions", mock.Anything, userID).Return(tt.pending, nil)
+
+			err := NewService(repo).CheckTermsAccepted(context.Background(), userID)
+
+			assert.Equal(t, tt.wantErr, IsConsentRequiredError(err))
+		})
+	}
+}
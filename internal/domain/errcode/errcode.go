@@ -0,0 +1,24 @@
+// Package errcode describes the error codes domain packages report, for the catalogue clients program against
+package errcode
+
+// Kind is the class of failure an error code reports
+// The API answers every code of a kind with the same HTTP status.
+type Kind string
+
+// Kinds of failure
+const (
+	Invalid         Kind = "INVALID"         // The request breaks a rule of the domain
+	Unauthenticated Kind = "UNAUTHENTICATED" // The caller could not be identified
+	Forbidden       Kind = "FORBIDDEN"       // The caller may not do this
+	NotFound        Kind = "NOT_FOUND"       // The resource does not exist
+	Conflict        Kind = "CONFLICT"        // The resource is not in a state that allows this
+	Gone            Kind = "GONE"            // The resource existed but has expired
+	Internal        Kind = "INTERNAL"        // The server failed; retrying may help
+)
+
+// Code describes an error code a domain package reports
+type Code struct {
+	Code        string
+	Kind        Kind
+	Description string
+}
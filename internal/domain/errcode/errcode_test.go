@@ -0,0 +1,79 @@
+package errcode_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+
+	"enterprise-crud/internal/domain/errcode"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/domain/venue"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// catalogues lists the error catalogue of every package the API publishes, by the file declaring its errors
+var catalogues = map[string][]errcode.Code{
+	"../event/errors.go": event.ErrorCodes(),
+	"../order/errors.go": order.ErrorCodes(),
+	"../user/errors.go":  user.ErrorCodes(),
+	"../venue/errors.go": venue.ErrorCodes(),
+}
+
+// TestErrorCodes_Complete fails when an error code is added to a package without describing it in its catalogue
+func TestErrorCodes_Complete(t *testing.T) {
+	for file, codes := range catalogues {
+		t.Run(file, func(t *testing.T) {
+			described := make(map[string]bool)
+			for _, code := range codes {
+				assert.False(t, described[code.Code], "%s is described twice", code.Code)
+				assert.NotEmpty(t, code.Kind, code.Code)
+				assert.NotEmpty(t, code.Description, code.Code)
+				described[code.Code] = true
+			}
+
+			for _, code := range declaredCodes(t, file) {
+				assert.True(t, described[code], "%s is missing from the catalogue", code)
+			}
+		})
+	}
+}
+
+// declaredCodes returns the string literals a file assigns to Code fields or declares as constants
+func declaredCodes(t *testing.T, file string) []string {
+	parsed, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.SkipObjectResolution)
+	require.NoError(t, err)
+
+	var codes []string
+	literal := func(expr ast.Expr) {
+		if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			value, err := strconv.Unquote(lit.Value)
+			require.NoError(t, err)
+			codes = append(codes, value)
+		}
+	}
+	ast.Inspect(parsed, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.KeyValueExpr:
+			if key, ok := node.Key.(*ast.Ident); ok && key.Name == "Code" {
+				literal(node.Value)
+			}
+		case *ast.GenDecl:
+			if node.Tok == token.CONST {
+				for _, spec := range node.Specs {
+					for _, value := range spec.(*ast.ValueSpec).Values {
+						literal(value)
+					}
+				}
+			}
+		}
+		return true
+	})
+	require.NotEmpty(t, codes)
+	return codes
+}
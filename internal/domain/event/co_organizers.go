@@ -0,0 +1,32 @@
+package event
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CoOrganizers tells whether users help organize events
+// Co-organizers may update and cancel an event like its organizer, but only the organizer
+// may delete it or hand it over.
+type CoOrganizers interface {
+	IsCoOrganizer(ctx context.Context, eventID, userID uuid.UUID) (bool, error)
+}
+
+// WithCoOrganizers lets the accepted co-organizers of an event manage it
+func WithCoOrganizers(coOrganizers CoOrganizers) ServiceOption {
+	return func(s *serviceImpl) {
+		s.coOrganizers = coOrganizers
+	}
+}
+
+// CanManage reports whether the user is the event's organizer or one of its co-organizers
+func (s *serviceImpl) CanManage(ctx context.Context, event *Event, userID uuid.UUID) (bool, error) {
+	if event.OrganizerID == userID {
+		return true, nil
+	}
+	if s.coOrganizers == nil {
+		return false, nil
+	}
+	return s.coOrganizers.IsCoOrganizer(ctx, event.ID, userID)
+}
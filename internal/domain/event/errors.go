@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 
+	"enterprise-crud/internal/domain/errcode"
+	"enterprise-crud/internal/domain/venue"
+
 	"github.com/google/uuid"
 )
 
@@ -44,6 +47,19 @@ var (
 	ErrCannotUpdateCompleted   = &EventError{Code: "CANNOT_UPDATE_COMPLETED", Message: "cannot update completed event"}
 	ErrCannotDeleteWithTickets = &EventError{Code: "CANNOT_DELETE_WITH_TICKETS", Message: "cannot delete event with sold tickets"}
 	ErrInvalidTicketReduction  = &EventError{Code: "INVALID_TICKET_REDUCTION", Message: "cannot reduce total tickets below sold tickets"}
+	ErrQuotaExceeded           = &EventError{Code: "QUOTA_EXCEEDED", Message: "plan limit exceeded"}
+	ErrQuotaCheckFailed        = &EventError{Code: "QUOTA_CHECK_FAILED", Message: "failed to check plan limits"}
+	ErrInvalidTicketPrice      = &EventError{Code: "INVALID_TICKET_PRICE", Message: "invalid ticket price"}
+	ErrInvalidTotalTickets     = &EventError{Code: "INVALID_TOTAL_TICKETS", Message: "invalid total tickets"}
+	ErrInvalidStatus           = &EventError{Code: "INVALID_STATUS", Message: "status must be ACTIVE, CANCELLED or COMPLETED"}
+	ErrInvalidStatusFilter     = &EventError{Code: "INVALID_STATUS_FILTER", Message: "status must be ACTIVE, CANCELLED or COMPLETED"}
+	ErrInvalidDateRange        = &EventError{Code: "INVALID_DATE_RANGE", Message: "from must be before to"}
+	ErrListingNotAllowed       = &EventError{Code: "LISTING_NOT_ALLOWED", Message: "only organizers and admins can list cancelled, completed, past or flagged events"}
+	ErrInvalidModeration       = &EventError{Code: "INVALID_MODERATION_FILTER", Message: "moderation must be APPROVED or FLAGGED"}
+	ErrContentRejected         = &EventError{Code: "CONTENT_REJECTED", Message: "event title or description was rejected by moderation"}
+	ErrNotFlagged              = &EventError{Code: "EVENT_NOT_FLAGGED", Message: "event is not awaiting moderation review"}
+	ErrInvalidSaleWindow       = &EventError{Code: "INVALID_SALE_WINDOW", Message: "sale_end must be after sale_start"}
+	ErrSlugTaken               = &EventError{Code: "SLUG_TAKEN", Message: "event slug is already taken"}
 )
 
 // NewEventError creates a new EventError with a cause
@@ -63,6 +79,14 @@ func NewEventNotFoundError(eventID uuid.UUID) *EventError {
 	}
 }
 
+// NewEventSlugNotFoundError creates a specific error for a slug no event has or had
+func NewEventSlugNotFoundError(slug string) *EventError {
+	return &EventError{
+		Code:    "EVENT_NOT_FOUND",
+		Message: fmt.Sprintf("event with slug %q not found", slug),
+	}
+}
+
 // NewVenueNotFoundError creates a specific error for venue not found
 func NewVenueNotFoundError(venueID uuid.UUID) *EventError {
 	return &EventError{
@@ -79,6 +103,22 @@ func NewTicketsExceedCapacityError(totalTickets, venueCapacity int) *EventError
 	}
 }
 
+// NewTicketsExceedLayoutError creates a specific error for ticket capacity validation against a layout
+func NewTicketsExceedLayoutError(totalTickets int, layout venue.Layout) *EventError {
+	return &EventError{
+		Code:    "TICKETS_EXCEED_CAPACITY",
+		Message: fmt.Sprintf("total tickets (%d) cannot exceed the capacity of layout %q (%d)", totalTickets, layout.Name, layout.Capacity),
+	}
+}
+
+// NewUnknownLayoutError creates a specific error for a layout the venue doesn't have
+func NewUnknownLayoutError(layout string) *EventError {
+	return &EventError{
+		Code:    "UNKNOWN_LAYOUT",
+		Message: fmt.Sprintf("venue has no layout named %q", layout),
+	}
+}
+
 // NewInvalidTicketReductionError creates a specific error for invalid ticket reduction
 func NewInvalidTicketReductionError(requestedTotal, soldTickets int) *EventError {
 	return &EventError{
@@ -95,12 +135,86 @@ func NewUnauthorizedAccessError(action string) *EventError {
 	}
 }
 
+// NewQuotaExceededError creates a specific error for plan limit violations
+func NewQuotaExceededError(message string) *EventError {
+	return &EventError{
+		Code:    "QUOTA_EXCEEDED",
+		Message: message,
+	}
+}
+
+// NewInvalidQuestionsError creates a specific error for a malformed attendee form
+func NewInvalidQuestionsError(message string) *EventError {
+	return &EventError{
+		Code:    "INVALID_QUESTIONS",
+		Message: "invalid attendee questions: " + message,
+	}
+}
+
+// NewInvalidRestrictionsError creates a specific error for an out-of-range age limit or content warnings
+func NewInvalidRestrictionsError(message string) *EventError {
+	return &EventError{
+		Code:    "INVALID_RESTRICTIONS",
+		Message: "invalid restrictions: " + message,
+	}
+}
+
+// NewInvalidPriceScheduleError creates a specific error for overlapping or malformed price tiers
+func NewInvalidPriceScheduleError(message string) *EventError {
+	return &EventError{
+		Code:    "INVALID_PRICE_SCHEDULE",
+		Message: "invalid price schedule: " + message,
+	}
+}
+
+// NewInvalidRefundPolicyError creates a specific error for a malformed refund policy
+func NewInvalidRefundPolicyError(message string) *EventError {
+	return &EventError{
+		Code:    "INVALID_REFUND_POLICY",
+		Message: "invalid refund policy: " + message,
+	}
+}
+
+// NewInvalidInventoryAdjustmentError creates a specific error for an adjustment without a delta or reason
+func NewInvalidInventoryAdjustmentError(message string) *EventError {
+	return &EventError{
+		Code:    "INVALID_INVENTORY_ADJUSTMENT",
+		Message: "invalid inventory adjustment: " + message,
+	}
+}
+
+// NewInvalidAnswersError creates a specific error for answers not matching an attendee form
+func NewInvalidAnswersError(message string) *EventError {
+	return &EventError{
+		Code:    "INVALID_ANSWERS",
+		Message: message,
+	}
+}
+
+// NewContentRejectedError creates a specific error for text a moderator rejected
+func NewContentRejectedError(reason string) *EventError {
+	message := ErrContentRejected.Message
+	if reason != "" {
+		message += ": " + reason
+	}
+	return &EventError{
+		Code:    "CONTENT_REJECTED",
+		Message: message,
+	}
+}
+
 // IsEventNotFoundError checks if an error is a "not found" error
 func IsEventNotFoundError(err error) bool {
 	var eventErr *EventError
 	return errors.As(err, &eventErr) && eventErr.Code == "EVENT_NOT_FOUND"
 }
 
+// IsSlugTakenError checks if an error reports a slug already used by another event
+func IsSlugTakenError(err error) bool {
+	var eventErr *EventError
+	return errors.As(err, &eventErr) && eventErr.Code == "SLUG_TAKEN"
+}
+
 // IsVenueNotFoundError checks if an error is a "venue not found" error
 func IsVenueNotFoundError(err error) bool {
 	var eventErr *EventError
@@ -113,6 +227,18 @@ func IsUnauthorizedError(err error) bool {
 	return errors.As(err, &eventErr) && eventErr.Code == "UNAUTHORIZED_ACCESS"
 }
 
+// IsQuotaExceededError checks if an error is a plan limit violation
+func IsQuotaExceededError(err error) bool {
+	var eventErr *EventError
+	return errors.As(err, &eventErr) && eventErr.Code == "QUOTA_EXCEEDED"
+}
+
+// IsListingNotAllowedError checks if an error is a refused non-public listing
+func IsListingNotAllowedError(err error) bool {
+	var eventErr *EventError
+	return errors.As(err, &eventErr) && eventErr.Code == "LISTING_NOT_ALLOWED"
+}
+
 // GetEventErrorCode extracts the error code from an EventError
 func GetEventErrorCode(err error) string {
 	var eventErr *EventError
@@ -131,7 +257,10 @@ func IsValidationError(err error) bool {
 
 	validationCodes := []string{
 		"EVENT_DATE_INVALID",
+		"INVALID_TICKET_PRICE",
+		"INVALID_TOTAL_TICKETS",
 		"TICKETS_EXCEED_CAPACITY",
+		"UNKNOWN_LAYOUT",
 		"INVALID_TICKET_REDUCTION",
 		"CANNOT_UPDATE_CANCELLED",
 		"CANNOT_UPDATE_COMPLETED",
@@ -139,6 +268,17 @@ func IsValidationError(err error) bool {
 		"CANNOT_DELETE_WITH_TICKETS",
 		"EVENT_ALREADY_CANCELLED",
 		"EVENT_ALREADY_COMPLETED",
+		"INVALID_QUESTIONS",
+		"INVALID_STATUS_FILTER",
+		"INVALID_DATE_RANGE",
+		"INVALID_MODERATION_FILTER",
+		"CONTENT_REJECTED",
+		"EVENT_NOT_FLAGGED",
+		"INVALID_SALE_WINDOW",
+		"INVALID_RESTRICTIONS",
+		"INVALID_PRICE_SCHEDULE",
+		"INVALID_REFUND_POLICY",
+		"INVALID_INVENTORY_ADJUSTMENT",
 	}
 
 	for _, code := range validationCodes {
@@ -148,3 +288,46 @@ func IsValidationError(err error) bool {
 	}
 	return false
 }
+
+// ErrorCodes describes every code event errors carry, for the API's error catalogue
+func ErrorCodes() []errcode.Code {
+	return []errcode.Code{
+		{Code: ErrEventNotFound.Code, Kind: errcode.NotFound, Description: ErrEventNotFound.Message},
+		{Code: ErrEventAlreadyExists.Code, Kind: errcode.Conflict, Description: ErrEventAlreadyExists.Message},
+		{Code: ErrEventCreationFailed.Code, Kind: errcode.Internal, Description: ErrEventCreationFailed.Message},
+		{Code: ErrEventUpdateFailed.Code, Kind: errcode.Internal, Description: ErrEventUpdateFailed.Message},
+		{Code: ErrEventDeletionFailed.Code, Kind: errcode.Internal, Description: ErrEventDeletionFailed.Message},
+		{Code: ErrEventRetrievalFailed.Code, Kind: errcode.Internal, Description: ErrEventRetrievalFailed.Message},
+		{Code: ErrVenueNotFound.Code, Kind: errcode.NotFound, Description: ErrVenueNotFound.Message},
+		{Code: ErrEventDateInPast.Code, Kind: errcode.Invalid, Description: ErrEventDateInPast.Message},
+		{Code: ErrTicketsExceedCapacity.Code, Kind: errcode.Invalid, Description: ErrTicketsExceedCapacity.Message + " or of the chosen layout"},
+		{Code: "UNKNOWN_LAYOUT", Kind: errcode.Invalid, Description: "the venue has no layout of that name"},
+		{Code: ErrUnauthorizedAccess.Code, Kind: errcode.Forbidden, Description: ErrUnauthorizedAccess.Message},
+		{Code: ErrEventAlreadyCancelled.Code, Kind: errcode.Invalid, Description: ErrEventAlreadyCancelled.Message},
+		{Code: ErrEventAlreadyCompleted.Code, Kind: errcode.Invalid, Description: ErrEventAlreadyCompleted.Message},
+		{Code: ErrCannotCancelCompleted.Code, Kind: errcode.Invalid, Description: ErrCannotCancelCompleted.Message},
+		{Code: ErrCannotUpdateCancelled.Code, Kind: errcode.Invalid, Description: ErrCannotUpdateCancelled.Message},
+		{Code: ErrCannotUpdateCompleted.Code, Kind: errcode.Invalid, Description: ErrCannotUpdateCompleted.Message},
+		{Code: ErrCannotDeleteWithTickets.Code, Kind: errcode.Invalid, Description: ErrCannotDeleteWithTickets.Message},
+		{Code: ErrInvalidTicketReduction.Code, Kind: errcode.Invalid, Description: ErrInvalidTicketReduction.Message},
+		{Code: ErrQuotaExceeded.Code, Kind: errcode.Forbidden, Description: "the organizer's plan limit on events or tickets is exceeded"},
+		{Code: ErrQuotaCheckFailed.Code, Kind: errcode.Internal, Description: ErrQuotaCheckFailed.Message},
+		{Code: ErrInvalidTicketPrice.Code, Kind: errcode.Invalid, Description: ErrInvalidTicketPrice.Message},
+		{Code: ErrInvalidTotalTickets.Code, Kind: errcode.Invalid, Description: ErrInvalidTotalTickets.Message},
+		{Code: ErrInvalidStatus.Code, Kind: errcode.Invalid, Description: ErrInvalidStatus.Message},
+		{Code: ErrInvalidStatusFilter.Code, Kind: errcode.Invalid, Description: ErrInvalidStatusFilter.Message},
+		{Code: ErrInvalidDateRange.Code, Kind: errcode.Invalid, Description: ErrInvalidDateRange.Message},
+		{Code: ErrListingNotAllowed.Code, Kind: errcode.Forbidden, Description: ErrListingNotAllowed.Message},
+		{Code: ErrInvalidModeration.Code, Kind: errcode.Invalid, Description: ErrInvalidModeration.Message},
+		{Code: ErrContentRejected.Code, Kind: errcode.Invalid, Description: ErrContentRejected.Message},
+		{Code: ErrNotFlagged.Code, Kind: errcode.Invalid, Description: ErrNotFlagged.Message},
+		{Code: ErrInvalidSaleWindow.Code, Kind: errcode.Invalid, Description: ErrInvalidSaleWindow.Message},
+		{Code: ErrSlugTaken.Code, Kind: errcode.Conflict, Description: ErrSlugTaken.Message},
+		{Code: "INVALID_QUESTIONS", Kind: errcode.Invalid, Description: "the attendee form is malformed"},
+		{Code: "INVALID_ANSWERS", Kind: errcode.Invalid, Description: "the answers do not match the event's attendee form"},
+		{Code: "INVALID_RESTRICTIONS", Kind: errcode.Invalid, Description: "the age limit or content warnings are out of range"},
+		{Code: "INVALID_PRICE_SCHEDULE", Kind: errcode.Invalid, Description: "the price tiers overlap or are malformed"},
+		{Code: "INVALID_REFUND_POLICY", Kind: errcode.Invalid, Description: "the refund policy is malformed"},
+		{Code: "INVALID_INVENTORY_ADJUSTMENT", Kind: errcode.Invalid, Description: "the inventory adjustment has no delta or no reason"},
+	}
+}
@@ -3,6 +3,8 @@ package event
 import (
 	"time"
 
+	"enterprise-crud/internal/domain/values"
+
 	"github.com/google/uuid"
 )
 
@@ -14,12 +16,18 @@ type Event struct {
 	// VenueID is the ID of the venue where the event takes place
 	VenueID uuid.UUID `gorm:"not null;type:uuid" json:"venue_id" binding:"required"`
 
+	// Layout names the venue's capacity configuration the event uses; empty uses the whole venue
+	Layout string `gorm:"not null;size:50;default:''" json:"layout"`
+
 	// OrganizerID is the ID of the user who organized the event
 	OrganizerID uuid.UUID `gorm:"not null;type:uuid" json:"organizer_id"`
 
 	// Title is the event title
 	Title string `gorm:"not null;size:255" json:"title" binding:"required"`
 
+	// Slug identifies the event in readable URLs; it follows title changes and old slugs keep resolving to the event
+	Slug string `gorm:"not null;size:100;uniqueIndex:events_slug_key" json:"slug"`
+
 	// Description provides additional information about the event
 	Description string `gorm:"type:text" json:"description"`
 
@@ -27,29 +35,129 @@ type Event struct {
 	EventDate time.Time `gorm:"not null" json:"event_date" binding:"required"`
 
 	// TicketPrice is the price per ticket
-	TicketPrice float64 `gorm:"not null;type:decimal(10,2);check:ticket_price >= 0" json:"ticket_price" binding:"required,min=0"`
+	TicketPrice float64 `gorm:"not null;type:decimal(10,2);check:ticket_price >= 0" json:"ticket_price" binding:"required,money"`
 
 	// AvailableTickets is the number of tickets still available
 	AvailableTickets int `gorm:"not null;check:available_tickets >= 0" json:"available_tickets"`
 
+	// PriceSchedule holds time-based prices such as early-bird tiers; outside every tier TicketPrice applies
+	PriceSchedule PriceSchedule `gorm:"type:jsonb;not null;default:'[]'" json:"price_schedule"`
+
+	// RefundPolicy limits the refunds buyers may ask for; enforced by the refund service
+	RefundPolicy RefundPolicy `gorm:"type:jsonb;not null;default:'{\"type\": \"FLEXIBLE\"}'" json:"refund_policy"`
+
 	// TotalTickets is the total number of tickets for the event
-	TotalTickets int `gorm:"not null;check:total_tickets > 0" json:"total_tickets" binding:"required,min=1"`
+	TotalTickets int `gorm:"not null;check:total_tickets > 0" json:"total_tickets" binding:"required,capacity"`
+
+	// AccessCodeRequired limits ticket sales to buyers holding one of the event's access codes
+	AccessCodeRequired bool `gorm:"not null;default:false" json:"access_code_required"`
+
+	// SaleStart is when ticket sales open; nil sells from creation
+	SaleStart *time.Time `json:"sale_start"`
+
+	// SaleEnd is when ticket sales close; nil sells until the event is over or sold out
+	SaleEnd *time.Time `json:"sale_end"`
+
+	// OnSale caches whether now is inside the sale window; the on-sale scheduler keeps it current for listings
+	OnSale bool `gorm:"not null" json:"on_sale"`
+
+	// MinimumAge is the age buyers must have reached to order tickets; 0 means no age limit
+	MinimumAge int `gorm:"not null;default:0;check:minimum_age >= 0" json:"minimum_age"`
+
+	// ContentWarnings tell buyers what to expect, e.g. "strobe lighting"
+	ContentWarnings ContentWarnings `gorm:"type:jsonb;not null;default:'[]'" json:"content_warnings"`
+
+	// AttendeeQuestions are custom questions ticket buyers answer when ordering
+	AttendeeQuestions Questions `gorm:"type:jsonb;not null;default:'[]'" json:"attendee_questions"`
 
 	// Status indicates the current state of the event
-	Status string `gorm:"default:'ACTIVE';size:20;check:status IN ('ACTIVE', 'CANCELLED', 'COMPLETED')" json:"status"`
+	Status Status `gorm:"not null;default:'ACTIVE';size:20;check:status IN ('ACTIVE', 'CANCELLED', 'COMPLETED')" json:"status"`
+
+	// ModerationStatus tells whether the title and description passed moderation or await an admin
+	ModerationStatus string `gorm:"default:'APPROVED';size:20;check:moderation_status IN ('APPROVED', 'FLAGGED')" json:"moderation_status"`
+
+	// ModerationReason explains why the event was flagged
+	ModerationReason string `gorm:"size:255" json:"moderation_reason,omitempty"`
 
 	// Timestamps track when the event was created and last updated
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// Event status constants
+// Sale window states returned by Event.SaleState
 const (
-	StatusActive    = "ACTIVE"
-	StatusCancelled = "CANCELLED"
-	StatusCompleted = "COMPLETED"
+	SaleNotStarted = "NOT_STARTED"
+	SaleOpen       = "OPEN"
+	SaleEnded      = "ENDED"
 )
 
+// SaleStateAt places now relative to a sale window whose bounds may be missing
+func SaleStateAt(start, end *time.Time, now time.Time) string {
+	if start != nil && now.Before(*start) {
+		return SaleNotStarted
+	}
+	if end != nil && !now.Before(*end) {
+		return SaleEnded
+	}
+	return SaleOpen
+}
+
+// UpcomingFilter narrows the events returned by Service.GetUpcomingEvents
+type UpcomingFilter struct {
+	VenueID     *uuid.UUID // Only events at this venue
+	OrganizerID *uuid.UUID // Only events by this organizer
+	Limit       int        // Maximum number of events, 0 means no limit
+}
+
+// ListFilter selects the events returned by Service.GetAllEvents
+// The zero value is the public listing: active events that have not taken place yet.
+type ListFilter struct {
+	Status      Status // Only events with this status; empty means ACTIVE
+	IncludePast bool   // Also return events that already took place
+	Moderation  string // Only events with this moderation status; empty means any, except in the public listing
+	OnSale      bool   // Only events whose tickets are on sale
+}
+
+// IsPublic reports whether the filter asks for nothing beyond the public listing
+// The public listing leaves out flagged events.
+func (f ListFilter) IsPublic() bool {
+	return (f.Status == "" || f.Status == StatusActive) && !f.IncludePast && f.Moderation == ""
+}
+
+// OrganizerFilter narrows and pages the events returned by Service.GetEventsByOrganizer
+type OrganizerFilter struct {
+	Status Status     // Only events with this status; empty means any
+	From   *time.Time // Only events taking place at or after this time
+	To     *time.Time // Only events taking place before this time
+	Limit  int        // Page size; 0 means DefaultPageSize, capped at MaxPageSize
+	Offset int        // Number of matching events to skip
+
+	// PublicOnly limits the events to what anyone may see on the organizer's page: active events
+	// that have not taken place yet and are not held by moderation
+	PublicOnly bool
+}
+
+// Page sizes for organizer event listings
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 100
+)
+
+// PageSize returns the number of events a page holds under this filter
+func (f OrganizerFilter) PageSize() int {
+	if f.Limit <= 0 {
+		return DefaultPageSize
+	}
+	return min(f.Limit, MaxPageSize)
+}
+
+// Viewer identifies who is listing events, which decides what a ListFilter may reveal
+type Viewer struct {
+	UserID    uuid.UUID // uuid.Nil for anonymous visitors
+	Organizer bool      // Non-public listings are limited to the viewer's own events
+	Admin     bool      // Non-public listings cover every organizer
+}
+
 // TableName tells GORM what table to use for this model
 func (Event) TableName() string {
 	return "events"
@@ -70,11 +178,59 @@ func (e *Event) IsCompleted() bool {
 	return e.Status == StatusCompleted
 }
 
+// IsFlagged checks if the event is held for moderation review
+func (e *Event) IsFlagged() bool {
+	return e.ModerationStatus == ModerationFlagged
+}
+
+// moderationStatus returns the event's moderation status, treating events from before moderation as approved
+func (e *Event) moderationStatus() string {
+	if e.ModerationStatus == "" {
+		return ModerationApproved
+	}
+	return e.ModerationStatus
+}
+
+// Validate checks the ticket price and number of tickets against the shared value rules
+// The price is rounded to cents as the database would store it.
+func (e *Event) Validate() error {
+	price, err := values.NewMoney(e.TicketPrice)
+	if err != nil {
+		return NewEventError(ErrInvalidTicketPrice, err)
+	}
+	if _, err := values.NewCapacity(e.TotalTickets); err != nil {
+		return NewEventError(ErrInvalidTotalTickets, err)
+	}
+	e.TicketPrice = price.Float64()
+	return nil
+}
+
+// IsAgeRestricted checks if buyers must be of a minimum age
+func (e *Event) IsAgeRestricted() bool {
+	return e.MinimumAge > 0
+}
+
 // HasAvailableTickets checks if there are tickets available
 func (e *Event) HasAvailableTickets() bool {
 	return e.AvailableTickets > 0
 }
 
+// SaleState tells whether tickets are on sale at the given time under the sale window
+// It returns SaleNotStarted before SaleStart, SaleEnded from SaleEnd on, and SaleOpen otherwise.
+func (e *Event) SaleState(now time.Time) string {
+	return SaleStateAt(e.SaleStart, e.SaleEnd, now)
+}
+
+// CurrentPrice returns the price per ticket of orders placed at the given time under the price schedule
+func (e *Event) CurrentPrice(now time.Time) float64 {
+	return e.PriceSchedule.PriceAt(e.TicketPrice, now)
+}
+
+// IsOnSale checks if the sale window is open at the given time
+func (e *Event) IsOnSale(now time.Time) bool {
+	return e.SaleState(now) == SaleOpen
+}
+
 // CanSellTickets checks if tickets can be sold for this event
 func (e *Event) CanSellTickets() bool {
 	return e.IsActive() && e.HasAvailableTickets()
@@ -0,0 +1,57 @@
+package event
+
+import "github.com/google/uuid"
+
+// Event bus topics published by the event domain
+const (
+	TopicEventChanged     = "event.changed"
+	TopicWaitlistPromoted = "event.waitlist_promoted"
+)
+
+// Fields reported in EventChanged.Changes
+const (
+	ChangeTitle = "title"
+	ChangeDate  = "event_date"
+	ChangeVenue = "venue"
+)
+
+// EventChanged is published when an event is cancelled, or when a change
+// that matters to ticket holders (title, date or venue) is saved
+type EventChanged struct {
+	EventID   uuid.UUID
+	Title     string
+	Cancelled bool
+	Changes   []string // Change* constants, empty for cancellations
+}
+
+// Topic implements eventbus.Event
+func (EventChanged) Topic() string {
+	return TopicEventChanged
+}
+
+// WaitlistPromoted is published when a waitlisted user is offered tickets for an event
+type WaitlistPromoted struct {
+	EventID  uuid.UUID
+	UserID   uuid.UUID
+	Quantity int
+}
+
+// Topic implements eventbus.Event
+func (WaitlistPromoted) Topic() string {
+	return TopicWaitlistPromoted
+}
+
+// changedFields lists the fields ticket holders care about that differ between two versions of an event
+func changedFields(before, after *Event) []string {
+	var changes []string
+	if before.Title != after.Title {
+		changes = append(changes, ChangeTitle)
+	}
+	if !before.EventDate.Equal(after.EventDate) {
+		changes = append(changes, ChangeDate)
+	}
+	if before.VenueID != after.VenueID {
+		changes = append(changes, ChangeVenue)
+	}
+	return changes
+}
@@ -0,0 +1,120 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"enterprise-crud/internal/domain/values"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// MaxAdjustmentReasonLength bounds the reason given for an inventory adjustment in characters
+const MaxAdjustmentReasonLength = 500
+
+// InventoryAdjustment records tickets added to or removed from an event outside a full event update,
+// e.g. seats held back for production
+type InventoryAdjustment struct {
+	ID      uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	EventID uuid.UUID  `gorm:"not null;type:uuid" json:"event_id"`
+	ActorID *uuid.UUID `gorm:"type:uuid" json:"actor_id,omitempty"` // nil once the user who made it is deleted
+	Delta   int        `gorm:"not null" json:"delta"`               // Tickets added, or removed when negative
+	Reason  string     `gorm:"type:text;not null" json:"reason"`
+
+	// Ticket counts of the event once the adjustment was applied
+	TotalTickets     int `gorm:"not null" json:"total_tickets"`
+	AvailableTickets int `gorm:"not null" json:"available_tickets"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (InventoryAdjustment) TableName() string {
+	return "event_inventory_adjustments"
+}
+
+// AdjustInventory adds delta tickets to an event, or removes them when negative, and records why
+// Only unsold tickets can be removed, and the new total must fit the venue and the organizer's plan.
+// The counts are changed by the repository in one statement, so tickets sold meanwhile are never
+// taken back.
+func (s *serviceImpl) AdjustInventory(ctx context.Context, eventID uuid.UUID, viewer Viewer, delta int, reason string) (*InventoryAdjustment, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > MaxAdjustmentReasonLength {
+		return nil, NewInvalidInventoryAdjustmentError(fmt.Sprintf("a reason of at most %d characters is required", MaxAdjustmentReasonLength))
+	}
+	if delta == 0 {
+		return nil, NewInvalidInventoryAdjustmentError("delta must not be zero")
+	}
+
+	existing, err := s.managedEvent(ctx, eventID, viewer, "adjust tickets of this event")
+	if err != nil {
+		return nil, err
+	}
+	if existing.IsCancelled() {
+		return nil, ErrCannotUpdateCancelled
+	}
+	if existing.IsCompleted() {
+		return nil, ErrCannotUpdateCompleted
+	}
+
+	adjusted := *existing
+	adjusted.TotalTickets += delta
+	if delta < 0 && -delta > existing.AvailableTickets {
+		return nil, NewInvalidTicketReductionError(adjusted.TotalTickets, existing.TotalTickets-existing.AvailableTickets)
+	}
+	if _, err := values.NewCapacity(adjusted.TotalTickets); err != nil {
+		return nil, NewEventError(ErrInvalidTotalTickets, err)
+	}
+	if delta > 0 {
+		venue, err := s.venueRepo.GetByID(ctx, adjusted.VenueID)
+		if err != nil {
+			return nil, NewVenueNotFoundError(adjusted.VenueID)
+		}
+		if err := fitVenue(&adjusted, venue); err != nil {
+			return nil, err
+		}
+		if err := s.checkQuota(ctx, &adjusted, false); err != nil {
+			return nil, err
+		}
+	}
+
+	adjustment := &InventoryAdjustment{EventID: eventID, ActorID: &viewer.UserID, Delta: delta, Reason: reason}
+	if err := s.eventRepo.AdjustInventory(ctx, adjustment); err != nil {
+		return nil, err // Repository already returns custom error
+	}
+
+	logging.From(ctx).Info("Event inventory adjusted", "event_id", eventID, "user_id", viewer.UserID, "delta", delta,
+		"total_tickets", adjustment.TotalTickets, "available_tickets", adjustment.AvailableTickets)
+	return adjustment, nil
+}
+
+// ListInventoryAdjustments returns the inventory adjustments of an event the viewer manages, newest first
+func (s *serviceImpl) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID, viewer Viewer) ([]*InventoryAdjustment, error) {
+	if _, err := s.managedEvent(ctx, eventID, viewer, "view ticket adjustments of this event"); err != nil {
+		return nil, err
+	}
+	return s.eventRepo.ListInventoryAdjustments(ctx, eventID)
+}
+
+// managedEvent retrieves an event the viewer may manage: as an admin, its organizer or a co-organizer
+func (s *serviceImpl) managedEvent(ctx context.Context, eventID uuid.UUID, viewer Viewer, action string) (*Event, error) {
+	e, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err // Repository already returns custom error
+	}
+	if viewer.Admin {
+		return e, nil
+	}
+	canManage, err := s.CanManage(ctx, e, viewer.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
+		return nil, NewUnauthorizedAccessError(action)
+	}
+	return e, nil
+}
@@ -0,0 +1,122 @@
+package event
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/venue"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventService_AdjustInventory(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+	organizerID := uuid.New()
+
+	newEvent := func() *Event {
+		return &Event{
+			ID:               eventID,
+			VenueID:          uuid.New(),
+			OrganizerID:      organizerID,
+			EventDate:        time.Now().Add(24 * time.Hour),
+			Status:           StatusActive,
+			TotalTickets:     100,
+			AvailableTickets: 40, // 60 tickets sold
+		}
+	}
+	organizer := Viewer{UserID: organizerID}
+
+	t.Run("holds back unsold tickets", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", ctx, eventID).Return(newEvent(), nil)
+		eventRepo.On("AdjustInventory", ctx, mock.AnythingOfType("*event.InventoryAdjustment")).Return(nil)
+		venueRepo := new(MockVenueRepository)
+
+		adjustment, err := NewService(eventRepo, venueRepo, nil, nil).AdjustInventory(ctx, eventID, organizer, -25, "  Production hold  ")
+
+		require.NoError(t, err)
+		assert.Equal(t, -25, adjustment.Delta)
+		assert.Equal(t, "Production hold", adjustment.Reason)
+		assert.Equal(t, &organizerID, adjustment.ActorID)
+		venueRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("adds tickets that fit the venue", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", ctx, eventID).Return(newEvent(), nil)
+		eventRepo.On("AdjustInventory", ctx, mock.AnythingOfType("*event.InventoryAdjustment")).Return(nil)
+		venueRepo := new(MockVenueRepository)
+		venueRepo.On("GetByID", ctx, mock.AnythingOfType("uuid.UUID")).Return(&venue.Venue{Capacity: 150}, nil)
+
+		_, err := NewService(eventRepo, venueRepo, nil, nil).AdjustInventory(ctx, eventID, organizer, 50, "Opened the balcony")
+
+		require.NoError(t, err)
+		eventRepo.AssertExpectations(t)
+	})
+
+	t.Run("refuses tickets beyond the venue", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", ctx, eventID).Return(newEvent(), nil)
+		venueRepo := new(MockVenueRepository)
+		venueRepo.On("GetByID", ctx, mock.AnythingOfType("uuid.UUID")).Return(&venue.Venue{Capacity: 120}, nil)
+
+		_, err := NewService(eventRepo, venueRepo, nil, nil).AdjustInventory(ctx, eventID, organizer, 50, "Opened the balcony")
+
+		assert.True(t, IsValidationError(err))
+		eventRepo.AssertNotCalled(t, "AdjustInventory", mock.Anything, mock.Anything)
+	})
+
+	t.Run("can't remove sold tickets", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", ctx, eventID).Return(newEvent(), nil)
+
+		_, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).AdjustInventory(ctx, eventID, organizer, -41, "Production hold")
+
+		assert.True(t, IsValidationError(err))
+		assert.Equal(t, "INVALID_TICKET_REDUCTION", GetEventErrorCode(err))
+		eventRepo.AssertNotCalled(t, "AdjustInventory", mock.Anything, mock.Anything)
+	})
+
+	t.Run("needs a reason and a delta", func(t *testing.T) {
+		service := NewService(new(MockEventRepository), new(MockVenueRepository), nil, nil)
+
+		_, err := service.AdjustInventory(ctx, eventID, organizer, 5, "   ")
+		assert.True(t, IsValidationError(err))
+
+		_, err = service.AdjustInventory(ctx, eventID, organizer, 5, strings.Repeat("x", MaxAdjustmentReasonLength+1))
+		assert.True(t, IsValidationError(err))
+
+		_, err = service.AdjustInventory(ctx, eventID, organizer, 0, "Production hold")
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("only managers and admins adjust", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", ctx, eventID).Return(newEvent(), nil)
+		eventRepo.On("AdjustInventory", ctx, mock.AnythingOfType("*event.InventoryAdjustment")).Return(nil)
+		service := NewService(eventRepo, new(MockVenueRepository), nil, nil)
+
+		_, err := service.AdjustInventory(ctx, eventID, Viewer{UserID: uuid.New()}, -5, "Production hold")
+		assert.True(t, IsUnauthorizedError(err))
+
+		_, err = service.AdjustInventory(ctx, eventID, Viewer{UserID: uuid.New(), Admin: true}, -5, "Production hold")
+		assert.NoError(t, err)
+	})
+
+	t.Run("cancelled events are left alone", func(t *testing.T) {
+		cancelled := newEvent()
+		cancelled.Status = StatusCancelled
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", ctx, eventID).Return(cancelled, nil)
+
+		_, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).AdjustInventory(ctx, eventID, organizer, -5, "Production hold")
+
+		assert.Equal(t, ErrCannotUpdateCancelled, err)
+	})
+}
@@ -0,0 +1,39 @@
+package event
+
+import "context"
+
+// Moderation statuses of an event
+// Flagged events are kept out of public listings until an admin approves them.
+const (
+	ModerationApproved = "APPROVED"
+	ModerationFlagged  = "FLAGGED"
+)
+
+// Actions a Moderator takes on an event's text
+const (
+	ModerationAllow  = "ALLOW"
+	ModerationFlag   = "FLAG"
+	ModerationReject = "REJECT"
+)
+
+// ModerationVerdict is a moderator's decision on an event's title and description
+type ModerationVerdict struct {
+	Action string // ALLOW, FLAG or REJECT
+	Reason string // Shown to the organizer and to admins reviewing flagged events
+}
+
+// Moderator checks event titles and descriptions before they are published
+// It returns an error only when it could not decide; the event is then held for review.
+type Moderator interface {
+	Moderate(ctx context.Context, title, description string) (ModerationVerdict, error)
+}
+
+// ServiceOption configures optional event Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithModerator checks the text of every new event and of every edit to it
+func WithModerator(moderator Moderator) ServiceOption {
+	return func(s *serviceImpl) {
+		s.moderator = moderator
+	}
+}
@@ -0,0 +1,128 @@
+package event
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"enterprise-crud/internal/domain/values"
+)
+
+// Limits on price schedules
+const (
+	MaxPriceTiers    = 10
+	maxTierNameRunes = 50
+)
+
+// PriceTier sets the ticket price for orders placed between From and To, e.g. an early-bird price
+type PriceTier struct {
+	Name  string     `json:"name"`  // Shown to buyers, e.g. "Early bird"
+	Price float64    `json:"price"` // Price per ticket while the tier is active
+	From  *time.Time `json:"from"`  // Start of the tier; nil for since the event was created
+	To    *time.Time `json:"to"`    // End of the tier, exclusive; nil for until the event
+}
+
+// ActiveAt reports whether orders placed at now get the tier's price
+func (t PriceTier) ActiveAt(now time.Time) bool {
+	return SaleStateAt(t.From, t.To, now) == SaleOpen
+}
+
+// PriceSchedule is the time-based pricing of an event, stored as JSONB
+// Tiers are kept ordered by start and never overlap; outside every tier the event's TicketPrice applies.
+type PriceSchedule []PriceTier
+
+// Value implements driver.Valuer so the schedule is stored as JSON
+func (s PriceSchedule) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner for schedules stored as JSON
+func (s *PriceSchedule) Scan(value interface{}) error {
+	return scanJSON(value, s)
+}
+
+// TierAt returns the tier active at now, if any
+func (s PriceSchedule) TierAt(now time.Time) (PriceTier, bool) {
+	for _, tier := range s {
+		if tier.ActiveAt(now) {
+			return tier, true
+		}
+	}
+	return PriceTier{}, false
+}
+
+// PriceAt returns the price per ticket of orders placed at now: the active tier's, or base outside every tier
+func (s PriceSchedule) PriceAt(base float64, now time.Time) float64 {
+	if tier, ok := s.TierAt(now); ok {
+		return tier.Price
+	}
+	return base
+}
+
+// Normalize orders the tiers by start, trims their names, names unnamed ones and rounds prices to cents
+// Prices that don't round to a valid amount are kept for Validate to reject.
+func (s PriceSchedule) Normalize() PriceSchedule {
+	normalized := slices.Clone(s)
+	slices.SortStableFunc(normalized, func(a, b PriceTier) int {
+		switch {
+		case a.From == nil && b.From == nil:
+			return 0
+		case a.From == nil:
+			return -1
+		case b.From == nil:
+			return 1
+		}
+		return a.From.Compare(*b.From)
+	})
+	for i := range normalized {
+		tier := &normalized[i]
+		tier.Name = strings.TrimSpace(tier.Name)
+		if tier.Name == "" {
+			tier.Name = fmt.Sprintf("Tier %d", i+1)
+		}
+		if price, err := values.NewMoney(tier.Price); err == nil {
+			tier.Price = price.Float64()
+		}
+	}
+	return normalized
+}
+
+// Validate checks a normalized schedule before it is saved
+// Every tier needs a valid price and a non-empty window, and tiers may not overlap.
+func (s PriceSchedule) Validate() error {
+	if len(s) > MaxPriceTiers {
+		return NewInvalidPriceScheduleError(fmt.Sprintf("at most %d tiers are allowed", MaxPriceTiers))
+	}
+	for i, tier := range s {
+		if utf8.RuneCountInString(tier.Name) > maxTierNameRunes {
+			return NewInvalidPriceScheduleError(fmt.Sprintf("%s: name must be at most %d characters", tier.Name, maxTierNameRunes))
+		}
+		if _, err := values.NewMoney(tier.Price); err != nil {
+			return NewInvalidPriceScheduleError(fmt.Sprintf("%s: price %s", tier.Name, values.ErrInvalidMoney.Rule))
+		}
+		if tier.From == nil && tier.To == nil {
+			return NewInvalidPriceScheduleError(fmt.Sprintf("%s: from or to is required", tier.Name))
+		}
+		if tier.From != nil && tier.To != nil && !tier.To.After(*tier.From) {
+			return NewInvalidPriceScheduleError(fmt.Sprintf("%s: to must be after from", tier.Name))
+		}
+		if i > 0 {
+			previous := s[i-1]
+			if previous.To == nil || tier.From == nil || tier.From.Before(*previous.To) {
+				return NewInvalidPriceScheduleError(fmt.Sprintf("%s overlaps %s", tier.Name, previous.Name))
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceSchedule_Validate(t *testing.T) {
+	june := time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC)
+	july := june.AddDate(0, 1, 0)
+	august := july.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name     string
+		schedule PriceSchedule
+		wantErr  bool
+	}{
+		{name: "no tiers", schedule: nil},
+		{name: "early bird then regular", schedule: PriceSchedule{{Price: 30, To: &june}, {Price: 40, From: &june, To: &july}}},
+		{name: "tiers with a gap", schedule: PriceSchedule{{Price: 30, From: &june, To: &july}, {Price: 60, From: &august}}},
+		{name: "free tier", schedule: PriceSchedule{{Price: 0, To: &june}}},
+		{name: "negative price", schedule: PriceSchedule{{Price: -1, To: &june}}, wantErr: true},
+		{name: "no window", schedule: PriceSchedule{{Price: 30}}, wantErr: true},
+		{name: "empty window", schedule: PriceSchedule{{Price: 30, From: &july, To: &june}}, wantErr: true},
+		{name: "overlapping tiers", schedule: PriceSchedule{{Price: 30, To: &july}, {Price: 40, From: &june, To: &august}}, wantErr: true},
+		{name: "two open starts", schedule: PriceSchedule{{Price: 30, To: &june}, {Price: 40, To: &july}}, wantErr: true},
+		{name: "too many tiers", schedule: make(PriceSchedule, MaxPriceTiers+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Normalize().Validate()
+			if tt.wantErr {
+				assert.True(t, IsValidationError(err))
+				assert.Equal(t, "INVALID_PRICE_SCHEDULE", GetEventErrorCode(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPriceSchedule_Normalize(t *testing.T) {
+	june := time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC)
+	july := june.AddDate(0, 1, 0)
+
+	normalized := PriceSchedule{
+		{Name: " Regular ", Price: 40.004, From: &july},
+		{Price: 30, From: &june, To: &july},
+	}.Normalize()
+
+	require.Len(t, normalized, 2)
+	assert.Equal(t, PriceTier{Name: "Tier 1", Price: 30, From: &june, To: &july}, normalized[0])
+	assert.Equal(t, PriceTier{Name: "Regular", Price: 40, From: &july}, normalized[1])
+}
+
+func TestEvent_CurrentPrice(t *testing.T) {
+	june := time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC)
+	july := june.AddDate(0, 1, 0)
+	e := &Event{TicketPrice: 50, PriceSchedule: PriceSchedule{
+		{Name: "Early bird", Price: 30, To: &june},
+		{Name: "Advance", Price: 40, From: &june, To: &july},
+	}}
+
+	assert.Equal(t, 30.0, e.CurrentPrice(june.Add(-time.Second)))
+	assert.Equal(t, 40.0, e.CurrentPrice(june))
+	assert.Equal(t, 50.0, e.CurrentPrice(july))
+}
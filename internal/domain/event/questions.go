@@ -0,0 +1,227 @@
+package event
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// Question types supported in attendee forms
+const (
+	QuestionText    = "text"    // Free text, up to MaxLength characters
+	QuestionNumber  = "number"  // Any JSON number
+	QuestionChoice  = "choice"  // One of Options
+	QuestionBoolean = "boolean" // true or false
+)
+
+// Limits on attendee forms
+const (
+	MaxQuestions          = 20
+	MaxQuestionOptions    = 50
+	DefaultAnswerLength   = 200
+	MaxAnswerLength       = 2000
+	maxQuestionLabelRunes = 200
+)
+
+// questionKeyPattern keeps keys usable as export column names
+var questionKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,39}$`)
+
+// Question is a custom question organizers ask ticket buyers, e.g. t-shirt size or dietary needs
+type Question struct {
+	Key       string   `json:"key"`                  // Identifies the answer, e.g. "tshirt_size"
+	Label     string   `json:"label"`                // Shown to buyers
+	Type      string   `json:"type"`                 // One of the Question* types
+	Required  bool     `json:"required"`             // Orders without an answer are rejected
+	Options   []string `json:"options,omitempty"`    // Allowed answers of choice questions
+	MaxLength int      `json:"max_length,omitempty"` // Longest text answer, 0 means DefaultAnswerLength
+}
+
+// Questions is the attendee form of an event, stored as JSONB
+type Questions []Question
+
+// Value implements driver.Valuer so questions are stored as JSON
+func (q Questions) Value() (driver.Value, error) {
+	if q == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(q)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner for questions stored as JSON
+func (q *Questions) Scan(value interface{}) error {
+	return scanJSON(value, q)
+}
+
+// Validate checks the form is well-formed before it is saved
+func (q Questions) Validate() error {
+	if len(q) > MaxQuestions {
+		return NewInvalidQuestionsError(fmt.Sprintf("at most %d questions are allowed", MaxQuestions))
+	}
+
+	seen := make(map[string]bool, len(q))
+	for _, question := range q {
+		if !questionKeyPattern.MatchString(question.Key) {
+			return NewInvalidQuestionsError(fmt.Sprintf(
+				"key %q must start with a lowercase letter and contain only lowercase letters, digits and underscores (max 40)", question.Key))
+		}
+		if seen[question.Key] {
+			return NewInvalidQuestionsError(fmt.Sprintf("key %q is used twice", question.Key))
+		}
+		seen[question.Key] = true
+
+		label := strings.TrimSpace(question.Label)
+		if label == "" || len([]rune(label)) > maxQuestionLabelRunes {
+			return NewInvalidQuestionsError(fmt.Sprintf("%s: label is required and at most %d characters", question.Key, maxQuestionLabelRunes))
+		}
+
+		switch question.Type {
+		case QuestionText:
+			if question.MaxLength < 0 || question.MaxLength > MaxAnswerLength {
+				return NewInvalidQuestionsError(fmt.Sprintf("%s: max_length must be between 0 and %d", question.Key, MaxAnswerLength))
+			}
+		case QuestionChoice:
+			if len(question.Options) == 0 || len(question.Options) > MaxQuestionOptions {
+				return NewInvalidQuestionsError(fmt.Sprintf("%s: choice questions need 1 to %d options", question.Key, MaxQuestionOptions))
+			}
+		case QuestionNumber, QuestionBoolean:
+		default:
+			return NewInvalidQuestionsError(fmt.Sprintf("%s: unknown type %q", question.Key, question.Type))
+		}
+	}
+	return nil
+}
+
+// Answers holds a buyer's answers to an attendee form by question key, stored as JSONB
+type Answers map[string]interface{}
+
+// Value implements driver.Valuer so answers are stored as JSON
+func (a Answers) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner for answers stored as JSON
+func (a *Answers) Scan(value interface{}) error {
+	return scanJSON(value, a)
+}
+
+// ValidateAnswers checks answers against the form and returns them normalized
+// Unknown keys are rejected, text is trimmed and blank optional answers are dropped.
+func (q Questions) ValidateAnswers(answers map[string]interface{}) (Answers, error) {
+	byKey := make(map[string]Question, len(q))
+	for _, question := range q {
+		byKey[question.Key] = question
+	}
+	for key := range answers {
+		if _, ok := byKey[key]; !ok {
+			return nil, NewInvalidAnswersError(fmt.Sprintf("%s is not a question of this event", key))
+		}
+	}
+
+	valid := make(Answers, len(answers))
+	for _, question := range q {
+		value, err := question.validateAnswer(answers[question.Key])
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			valid[question.Key] = value
+		}
+	}
+	if len(valid) == 0 {
+		return nil, nil
+	}
+	return valid, nil
+}
+
+// validateAnswer checks a single answer, returning nil when it was left blank
+func (q Question) validateAnswer(value interface{}) (interface{}, error) {
+	if s, ok := value.(string); ok && q.Type != QuestionText {
+		if strings.TrimSpace(s) == "" {
+			value = nil
+		}
+	}
+
+	if value == nil {
+		if q.Required {
+			return nil, NewInvalidAnswersError(q.Key + " is required")
+		}
+		return nil, nil
+	}
+
+	switch q.Type {
+	case QuestionText:
+		s, ok := value.(string)
+		if !ok {
+			return nil, NewInvalidAnswersError(q.Key + " must be text")
+		}
+		s = strings.TrimSpace(s)
+		if s == "" {
+			if q.Required {
+				return nil, NewInvalidAnswersError(q.Key + " is required")
+			}
+			return nil, nil
+		}
+		limit := q.MaxLength
+		if limit == 0 {
+			limit = DefaultAnswerLength
+		}
+		if len([]rune(s)) > limit {
+			return nil, NewInvalidAnswersError(fmt.Sprintf("%s must be at most %d characters", q.Key, limit))
+		}
+		return s, nil
+	case QuestionNumber:
+		n, ok := value.(float64)
+		if !ok || math.IsNaN(n) || math.IsInf(n, 0) {
+			return nil, NewInvalidAnswersError(q.Key + " must be a number")
+		}
+		return n, nil
+	case QuestionChoice:
+		s, ok := value.(string)
+		if ok {
+			for _, option := range q.Options {
+				if s == option {
+					return s, nil
+				}
+			}
+		}
+		return nil, NewInvalidAnswersError(fmt.Sprintf("%s must be one of: %s", q.Key, strings.Join(q.Options, ", ")))
+	case QuestionBoolean:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, NewInvalidAnswersError(q.Key + " must be true or false")
+		}
+		return b, nil
+	}
+	return nil, NewInvalidAnswersError(fmt.Sprintf("%s has unknown type %q", q.Key, q.Type))
+}
+
+// scanJSON decodes a JSON column into dest
+func scanJSON(value interface{}, dest interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported JSON column type")
+	}
+	return json.Unmarshal(data, dest)
+}
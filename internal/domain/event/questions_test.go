@@ -0,0 +1,111 @@
+package event
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuestions_Validate(t *testing.T) {
+	valid := Question{Key: "tshirt_size", Label: "T-shirt size", Type: QuestionChoice, Options: []string{"S", "M", "L"}}
+
+	tests := []struct {
+		name      string
+		questions Questions
+		wantErr   bool
+	}{
+		{name: "empty form", questions: nil},
+		{name: "valid form", questions: Questions{valid, {Key: "dietary_needs", Label: "Dietary needs", Type: QuestionText, MaxLength: 500}}},
+		{name: "invalid key", questions: Questions{{Key: "T-Shirt", Label: "T-shirt", Type: QuestionText}}, wantErr: true},
+		{name: "duplicate key", questions: Questions{valid, valid}, wantErr: true},
+		{name: "missing label", questions: Questions{{Key: "age", Label: " ", Type: QuestionNumber}}, wantErr: true},
+		{name: "choice without options", questions: Questions{{Key: "size", Label: "Size", Type: QuestionChoice}}, wantErr: true},
+		{name: "text too long", questions: Questions{{Key: "bio", Label: "Bio", Type: QuestionText, MaxLength: MaxAnswerLength + 1}}, wantErr: true},
+		{name: "unknown type", questions: Questions{{Key: "file", Label: "File", Type: "file"}}, wantErr: true},
+		{name: "too many questions", questions: make(Questions, MaxQuestions+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.questions.Validate()
+			if tt.wantErr {
+				assert.True(t, IsValidationError(err))
+				assert.Equal(t, "INVALID_QUESTIONS", GetEventErrorCode(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestQuestions_ValidateAnswers(t *testing.T) {
+	questions := Questions{
+		{Key: "tshirt_size", Label: "T-shirt size", Type: QuestionChoice, Required: true, Options: []string{"S", "M", "L"}},
+		{Key: "dietary_needs", Label: "Dietary needs", Type: QuestionText, MaxLength: 10},
+		{Key: "age", Label: "Age", Type: QuestionNumber},
+		{Key: "newsletter", Label: "Newsletter", Type: QuestionBoolean},
+	}
+
+	tests := []struct {
+		name    string
+		answers map[string]interface{}
+		want    Answers
+		wantErr string
+	}{
+		{
+			name:    "valid answers are normalized",
+			answers: map[string]interface{}{"tshirt_size": "M", "dietary_needs": "  vegan ", "age": float64(30), "newsletter": false},
+			want:    Answers{"tshirt_size": "M", "dietary_needs": "vegan", "age": float64(30), "newsletter": false},
+		},
+		{
+			name:    "blank optional answers are dropped",
+			answers: map[string]interface{}{"tshirt_size": "S", "dietary_needs": " ", "age": ""},
+			want:    Answers{"tshirt_size": "S"},
+		},
+		{name: "missing required answer", answers: nil, wantErr: "tshirt_size is required"},
+		{name: "unknown key", answers: map[string]interface{}{"tshirt_size": "S", "shoe_size": "42"}, wantErr: "shoe_size is not a question"},
+		{name: "option not offered", answers: map[string]interface{}{"tshirt_size": "XXL"}, wantErr: "must be one of: S, M, L"},
+		{name: "text too long", answers: map[string]interface{}{"tshirt_size": "S", "dietary_needs": strings.Repeat("a", 11)}, wantErr: "at most 10 characters"},
+		{name: "wrong number type", answers: map[string]interface{}{"tshirt_size": "S", "age": "thirty"}, wantErr: "age must be a number"},
+		{name: "wrong boolean type", answers: map[string]interface{}{"tshirt_size": "S", "newsletter": "yes"}, wantErr: "newsletter must be true or false"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := questions.ValidateAnswers(tt.answers)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, "INVALID_ANSWERS", GetEventErrorCode(err))
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQuestions_ValidateAnswers_NoQuestions(t *testing.T) {
+	answers, err := Questions(nil).ValidateAnswers(nil)
+	require.NoError(t, err)
+	assert.Nil(t, answers)
+
+	_, err = Questions(nil).ValidateAnswers(map[string]interface{}{"anything": "x"})
+	assert.Error(t, err)
+}
+
+func TestQuestions_ValueScan(t *testing.T) {
+	questions := Questions{{Key: "size", Label: "Size", Type: QuestionChoice, Options: []string{"S"}}}
+	value, err := questions.Value()
+	require.NoError(t, err)
+
+	var scanned Questions
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, questions, scanned)
+
+	empty, err := Questions(nil).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "[]", empty)
+}
@@ -0,0 +1,132 @@
+package event
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Refund policy types
+const (
+	RefundPolicyFlexible   = "FLEXIBLE"    // Organizers decide each request within the platform policy
+	RefundPolicyNone       = "NONE"        // Buyers can't ask for refunds, only dispute charges
+	RefundPolicyDaysBefore = "DAYS_BEFORE" // Full refunds until DaysBefore days before the event
+	RefundPolicySliding    = "SLIDING"     // The share refunded shrinks as the event approaches
+)
+
+// Limits on refund policies
+const (
+	MaxRefundTiers = 10
+	MaxRefundDays  = 365
+)
+
+// RefundTier refunds Percent of the order total to buyers asking at least DaysBefore days before the event
+type RefundTier struct {
+	DaysBefore int `json:"days_before"`
+	Percent    int `json:"percent"` // 1 to 100
+}
+
+// RefundPolicy is the refund policy an organizer sets for an event, stored as JSONB
+// It is enforced when buyers ask for a refund; disputes and refunds of cancelled events are exempt.
+type RefundPolicy struct {
+	Type       string       `json:"type"`
+	DaysBefore int          `json:"days_before,omitempty"` // Deadline of DAYS_BEFORE policies; 0 means until the event starts
+	Tiers      []RefundTier `json:"tiers,omitempty"`       // Tiers of SLIDING policies, furthest from the event first
+}
+
+// Value implements driver.Valuer so the policy is stored as JSON
+func (p RefundPolicy) Value() (driver.Value, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner for policies stored as JSON
+func (p *RefundPolicy) Scan(value interface{}) error {
+	return scanJSON(value, p)
+}
+
+// RefundPercentAt returns the share of the order total, in percent, a buyer asking at now may get back
+// Flexible policies leave the amount to the organizer, so they allow up to the whole order.
+func (p RefundPolicy) RefundPercentAt(eventDate, now time.Time) int {
+	switch p.Type {
+	case RefundPolicyNone:
+		return 0
+	case RefundPolicyDaysBefore:
+		if now.After(eventDate.AddDate(0, 0, -p.DaysBefore)) {
+			return 0
+		}
+		return 100
+	case RefundPolicySliding:
+		for _, tier := range p.Tiers {
+			if !now.After(eventDate.AddDate(0, 0, -tier.DaysBefore)) {
+				return tier.Percent
+			}
+		}
+		return 0
+	}
+	return 100
+}
+
+// Normalize upper-cases the type, defaults it to FLEXIBLE and orders tiers furthest from the event first
+func (p RefundPolicy) Normalize() RefundPolicy {
+	p.Type = strings.ToUpper(strings.TrimSpace(p.Type))
+	if p.Type == "" {
+		p.Type = RefundPolicyFlexible
+	}
+	p.Tiers = slices.Clone(p.Tiers)
+	slices.SortStableFunc(p.Tiers, func(a, b RefundTier) int {
+		return b.DaysBefore - a.DaysBefore
+	})
+	return p
+}
+
+// Validate checks a normalized policy before it is saved
+// Sliding tiers need distinct days, and refunds may not grow as the event approaches.
+func (p RefundPolicy) Validate() error {
+	switch p.Type {
+	case RefundPolicyFlexible, RefundPolicyNone:
+		if p.DaysBefore != 0 || len(p.Tiers) > 0 {
+			return NewInvalidRefundPolicyError(fmt.Sprintf("%s policies take no days_before or tiers", p.Type))
+		}
+	case RefundPolicyDaysBefore:
+		if p.DaysBefore < 0 || p.DaysBefore > MaxRefundDays {
+			return NewInvalidRefundPolicyError(fmt.Sprintf("days_before must be between 0 and %d", MaxRefundDays))
+		}
+		if len(p.Tiers) > 0 {
+			return NewInvalidRefundPolicyError("DAYS_BEFORE policies take no tiers")
+		}
+	case RefundPolicySliding:
+		if p.DaysBefore != 0 {
+			return NewInvalidRefundPolicyError("SLIDING policies set days_before on their tiers")
+		}
+		if len(p.Tiers) == 0 || len(p.Tiers) > MaxRefundTiers {
+			return NewInvalidRefundPolicyError(fmt.Sprintf("SLIDING policies need 1 to %d tiers", MaxRefundTiers))
+		}
+		for i, tier := range p.Tiers {
+			if tier.DaysBefore < 0 || tier.DaysBefore > MaxRefundDays {
+				return NewInvalidRefundPolicyError(fmt.Sprintf("days_before must be between 0 and %d", MaxRefundDays))
+			}
+			if tier.Percent < 1 || tier.Percent > 100 {
+				return NewInvalidRefundPolicyError("percent must be between 1 and 100")
+			}
+			if i > 0 {
+				previous := p.Tiers[i-1]
+				if tier.DaysBefore == previous.DaysBefore {
+					return NewInvalidRefundPolicyError(fmt.Sprintf("two tiers start %d days before the event", tier.DaysBefore))
+				}
+				if tier.Percent > previous.Percent {
+					return NewInvalidRefundPolicyError(fmt.Sprintf("the tier %d days before refunds more than the tier %d days before", tier.DaysBefore, previous.DaysBefore))
+				}
+			}
+		}
+	default:
+		return NewInvalidRefundPolicyError("type must be FLEXIBLE, NONE, DAYS_BEFORE or SLIDING")
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefundPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  RefundPolicy
+		wantErr bool
+	}{
+		{name: "unset means flexible", policy: RefundPolicy{}},
+		{name: "no refunds", policy: RefundPolicy{Type: "none"}},
+		{name: "until a week before", policy: RefundPolicy{Type: RefundPolicyDaysBefore, DaysBefore: 7}},
+		{name: "until the event starts", policy: RefundPolicy{Type: RefundPolicyDaysBefore}},
+		{name: "sliding", policy: RefundPolicy{Type: RefundPolicySliding, Tiers: []RefundTier{{DaysBefore: 7, Percent: 50}, {DaysBefore: 30, Percent: 100}}}},
+		{name: "unknown type", policy: RefundPolicy{Type: "SOMETIMES"}, wantErr: true},
+		{name: "days on a flexible policy", policy: RefundPolicy{DaysBefore: 7}, wantErr: true},
+		{name: "deadline too far out", policy: RefundPolicy{Type: RefundPolicyDaysBefore, DaysBefore: MaxRefundDays + 1}, wantErr: true},
+		{name: "sliding without tiers", policy: RefundPolicy{Type: RefundPolicySliding}, wantErr: true},
+		{name: "percent out of range", policy: RefundPolicy{Type: RefundPolicySliding, Tiers: []RefundTier{{DaysBefore: 7, Percent: 120}}}, wantErr: true},
+		{name: "repeated days", policy: RefundPolicy{Type: RefundPolicySliding, Tiers: []RefundTier{{DaysBefore: 7, Percent: 50}, {DaysBefore: 7, Percent: 25}}}, wantErr: true},
+		{name: "refunds grow closer to the event", policy: RefundPolicy{Type: RefundPolicySliding, Tiers: []RefundTier{{DaysBefore: 30, Percent: 50}, {DaysBefore: 7, Percent: 100}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Normalize().Validate()
+			if tt.wantErr {
+				assert.True(t, IsValidationError(err))
+				assert.Equal(t, "INVALID_REFUND_POLICY", GetEventErrorCode(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRefundPolicy_RefundPercentAt(t *testing.T) {
+	eventDate := time.Date(2027, 6, 30, 20, 0, 0, 0, time.UTC)
+	sliding := RefundPolicy{Type: RefundPolicySliding, Tiers: []RefundTier{{DaysBefore: 7, Percent: 50}, {DaysBefore: 30, Percent: 100}}}.Normalize()
+
+	tests := []struct {
+		name   string
+		policy RefundPolicy
+		at     time.Time
+		want   int
+	}{
+		{name: "flexible", policy: RefundPolicy{}, at: eventDate.Add(-time.Hour), want: 100},
+		{name: "no refunds", policy: RefundPolicy{Type: RefundPolicyNone}, at: eventDate.AddDate(0, -3, 0), want: 0},
+		{name: "before the deadline", policy: RefundPolicy{Type: RefundPolicyDaysBefore, DaysBefore: 7}, at: eventDate.AddDate(0, 0, -7), want: 100},
+		{name: "after the deadline", policy: RefundPolicy{Type: RefundPolicyDaysBefore, DaysBefore: 7}, at: eventDate.AddDate(0, 0, -7).Add(time.Second), want: 0},
+		{name: "sliding far out", policy: sliding, at: eventDate.AddDate(0, 0, -45), want: 100},
+		{name: "sliding closer", policy: sliding, at: eventDate.AddDate(0, 0, -10), want: 50},
+		{name: "sliding too late", policy: sliding, at: eventDate.AddDate(0, 0, -3), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.RefundPercentAt(eventDate, tt.at))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package event
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -9,11 +10,16 @@ import (
 // Repository defines the interface for event data operations
 type Repository interface {
 	// Create creates a new event
+	// It returns ErrSlugTaken when another event has or had the event's slug.
 	Create(ctx context.Context, event *Event) error
 
 	// GetByID retrieves an event by its ID
 	GetByID(ctx context.Context, id uuid.UUID) (*Event, error)
 
+	// GetBySlug retrieves the event that has or once had slug
+	// Compare the event's Slug with slug to tell a current slug from an old one.
+	GetBySlug(ctx context.Context, slug string) (*Event, error)
+
 	// GetAll retrieves all events
 	GetAll(ctx context.Context) ([]*Event, error)
 
@@ -24,8 +30,21 @@ type Repository interface {
 	GetByVenue(ctx context.Context, venueID uuid.UUID) ([]*Event, error)
 
 	// Update updates an existing event
+	// A new slug is recorded with the old ones, and ErrSlugTaken returned when another event has or had it.
 	Update(ctx context.Context, event *Event) error
 
 	// Delete deletes an event by its ID
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// RefreshOnSale sets OnSale from each event's sale window at now
+	// It returns the events whose flag changed, with at least their ID, VenueID and OrganizerID.
+	RefreshOnSale(ctx context.Context, now time.Time) ([]*Event, error)
+
+	// AdjustInventory applies adjustment.Delta to the event's total and available tickets and records the
+	// adjustment with the resulting counts, all at once
+	// It returns ErrInvalidTicketReduction when fewer than -Delta tickets are unsold, as when they sold since the event was read.
+	AdjustInventory(ctx context.Context, adjustment *InventoryAdjustment) error
+
+	// ListInventoryAdjustments retrieves the inventory adjustments of an event, newest first
+	ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID) ([]*InventoryAdjustment, error)
 }
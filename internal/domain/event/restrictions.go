@@ -0,0 +1,69 @@
+package event
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits on event restrictions
+const (
+	MaxMinimumAge          = 99
+	MaxContentWarnings     = 10
+	maxContentWarningRunes = 50
+)
+
+// ContentWarnings tell buyers what an event contains, e.g. "strobe lighting" or "explicit language"
+// They are stored as JSONB.
+type ContentWarnings []string
+
+// Value implements driver.Valuer so warnings are stored as JSON
+func (w ContentWarnings) Value() (driver.Value, error) {
+	if w == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner for warnings stored as JSON
+func (w *ContentWarnings) Scan(value interface{}) error {
+	return scanJSON(value, w)
+}
+
+// Normalize trims the warnings and drops empty ones and repeats, ignoring case
+func (w ContentWarnings) Normalize() ContentWarnings {
+	normalized := make(ContentWarnings, 0, len(w))
+	seen := make(map[string]bool, len(w))
+	for _, warning := range w {
+		warning = strings.TrimSpace(warning)
+		key := strings.ToLower(warning)
+		if warning == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, warning)
+	}
+	return normalized
+}
+
+// validateRestrictions checks the minimum age and content warnings of an event
+func validateRestrictions(minimumAge int, warnings ContentWarnings) error {
+	if minimumAge < 0 || minimumAge > MaxMinimumAge {
+		return NewInvalidRestrictionsError(fmt.Sprintf("minimum age must be between 0 and %d", MaxMinimumAge))
+	}
+	if len(warnings) > MaxContentWarnings {
+		return NewInvalidRestrictionsError(fmt.Sprintf("at most %d content warnings are allowed", MaxContentWarnings))
+	}
+	for _, warning := range warnings {
+		if utf8.RuneCountInString(warning) > maxContentWarningRunes {
+			return NewInvalidRestrictionsError(fmt.Sprintf("content warnings must be at most %d characters", maxContentWarningRunes))
+		}
+	}
+	return nil
+}
@@ -2,7 +2,14 @@ package event
 
 import (
 	"context"
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/plan"
 	"enterprise-crud/internal/domain/venue"
+	"enterprise-crud/internal/logging"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,11 +23,20 @@ type Service interface {
 	// GetEventByID retrieves an event by its ID
 	GetEventByID(ctx context.Context, id uuid.UUID) (*Event, error)
 
-	// GetAllEvents retrieves all events
-	GetAllEvents(ctx context.Context) ([]*Event, error)
+	// GetEventBySlug retrieves the event that has or once had slug; its Slug is the current one
+	GetEventBySlug(ctx context.Context, slug string) (*Event, error)
 
-	// GetEventsByOrganizer retrieves events by organizer ID
-	GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID) ([]*Event, error)
+	// GetAllEvents lists events visible to the viewer, soonest first
+	// Anyone may list active upcoming events; other statuses and past events are for organizers and admins
+	GetAllEvents(ctx context.Context, filter ListFilter, viewer Viewer) ([]*Event, error)
+
+	// GetEventsByOrganizer retrieves a page of an organizer's events, soonest first, with the number of events matching the filter
+	// With filter.PublicOnly only the events shown on the organizer's public page are considered.
+	GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, filter OrganizerFilter) ([]*Event, int, error)
+
+	// GetUpcomingEvents retrieves active events that have not started yet, soonest first
+	// Events flagged by moderation are left out.
+	GetUpcomingEvents(ctx context.Context, filter UpcomingFilter) ([]*Event, error)
 
 	// UpdateEvent updates an existing event
 	UpdateEvent(ctx context.Context, event *Event) error
@@ -30,19 +46,56 @@ type Service interface {
 
 	// DeleteEvent deletes an event (only if no tickets sold)
 	DeleteEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error
+
+	// ApproveModeration publishes a flagged event, overriding the moderator
+	ApproveModeration(ctx context.Context, eventID uuid.UUID, adminID uuid.UUID) (*Event, error)
+
+	// CanManage reports whether the user may update and cancel the event: its organizer or a co-organizer
+	CanManage(ctx context.Context, event *Event, userID uuid.UUID) (bool, error)
+
+	// RefreshOnSale updates the on-sale flag of events whose sale window opened or closed by now
+	// It returns how many events changed.
+	RefreshOnSale(ctx context.Context, now time.Time) (int, error)
+
+	// AdjustInventory adds delta tickets to an event, or removes unsold ones when negative, recording the reason
+	// Admins may adjust any event, others only events they manage.
+	AdjustInventory(ctx context.Context, eventID uuid.UUID, viewer Viewer, delta int, reason string) (*InventoryAdjustment, error)
+
+	// ListInventoryAdjustments returns the inventory adjustments of an event, newest first
+	ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID, viewer Viewer) ([]*InventoryAdjustment, error)
 }
 
 // serviceImpl implements the Service interface
 type serviceImpl struct {
-	eventRepo Repository
-	venueRepo venue.Repository
+	eventRepo   Repository
+	venueRepo   venue.Repository
+	planService plan.Service       // Enforces organizer plan limits; nil disables quotas
+	publisher   eventbus.Publisher // Receives EventChanged; nil publishes nothing
+	moderator   Moderator          // Checks event text; nil approves everything
+	clock       clock.Clock        // Tells the time event dates and sale windows are checked against
+
+	coOrganizers CoOrganizers // Lets co-organizers manage events; nil leaves that to organizers
 }
 
 // NewService creates a new event service instance
-func NewService(eventRepo Repository, venueRepo venue.Repository) Service {
-	return &serviceImpl{
-		eventRepo: eventRepo,
-		venueRepo: venueRepo,
+func NewService(eventRepo Repository, venueRepo venue.Repository, planService plan.Service, publisher eventbus.Publisher, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		eventRepo:   eventRepo,
+		venueRepo:   venueRepo,
+		planService: planService,
+		publisher:   publisher,
+		clock:       clock.System{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// WithClock checks event dates and sale windows against c instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
 	}
 }
 
@@ -53,16 +106,36 @@ func (s *serviceImpl) CreateEvent(ctx context.Context, event *Event) error {
 		return err
 	}
 
+	// Enforce the organizer's plan limits
+	if err := s.checkQuota(ctx, event, true); err != nil {
+		return err
+	}
+
+	// Reject or flag objectionable text
+	if err := s.moderate(ctx, event, nil); err != nil {
+		return err
+	}
+
 	// Set default values
 	event.Status = StatusActive
 	event.AvailableTickets = event.TotalTickets
+	event.OnSale = event.IsOnSale(s.clock.Now())
 
-	// Create the event
-	if err := s.eventRepo.Create(ctx, event); err != nil {
-		return err // Repository already returns custom error
-	}
+	// Create the event under a fresh slug
+	return withNewSlug(event, func() error {
+		return s.eventRepo.Create(ctx, event) // Repository already returns custom error
+	})
+}
 
-	return nil
+// withNewSlug gives the event a new slug from its title and saves it, trying other slugs while they are taken
+func withNewSlug(event *Event, save func() error) error {
+	for attempt := 1; ; attempt++ {
+		event.Slug = NewSlug(event.Title)
+		err := save()
+		if !IsSlugTakenError(err) || attempt == maxSlugAttempts {
+			return err
+		}
+	}
 }
 
 // GetEventByID retrieves an event by its ID
@@ -74,22 +147,144 @@ func (s *serviceImpl) GetEventByID(ctx context.Context, id uuid.UUID) (*Event, e
 	return event, nil
 }
 
-// GetAllEvents retrieves all events
-func (s *serviceImpl) GetAllEvents(ctx context.Context) ([]*Event, error) {
-	events, err := s.eventRepo.GetAll(ctx)
+// GetEventBySlug retrieves the event that has or once had slug
+func (s *serviceImpl) GetEventBySlug(ctx context.Context, slug string) (*Event, error) {
+	event, err := s.eventRepo.GetBySlug(ctx, strings.ToLower(slug))
+	if err != nil {
+		return nil, err // Repository already returns custom error
+	}
+	return event, nil
+}
+
+// GetAllEvents lists events visible to the viewer
+// Organizers asking for more than the public listing only see their own events; admins see everyone's.
+func (s *serviceImpl) GetAllEvents(ctx context.Context, filter ListFilter, viewer Viewer) ([]*Event, error) {
+	status := filter.Status
+	if status == "" {
+		status = StatusActive
+	}
+	if !status.Valid() {
+		return nil, ErrInvalidStatusFilter
+	}
+	switch filter.Moderation {
+	case "", ModerationApproved, ModerationFlagged:
+	default:
+		return nil, ErrInvalidModeration
+	}
+
+	var events []*Event
+	var err error
+	switch {
+	case filter.IsPublic() || viewer.Admin:
+		events, err = s.eventRepo.GetAll(ctx)
+	case viewer.Organizer && viewer.UserID != uuid.Nil:
+		events, err = s.eventRepo.GetByOrganizer(ctx, viewer.UserID)
+	default:
+		return nil, ErrListingNotAllowed
+	}
 	if err != nil {
 		return nil, err // Repository already returns custom error
 	}
-	return events, nil
+
+	now := s.clock.Now()
+	visible := make([]*Event, 0, len(events))
+	for _, e := range events {
+		if e.Status != status {
+			continue
+		}
+		if !filter.IncludePast && !e.EventDate.After(now) {
+			continue
+		}
+		if filter.IsPublic() && e.IsFlagged() {
+			continue
+		}
+		if filter.Moderation != "" && e.moderationStatus() != filter.Moderation {
+			continue
+		}
+		if filter.OnSale && !e.OnSale {
+			continue
+		}
+		visible = append(visible, e)
+	}
+	return visible, nil
 }
 
-// GetEventsByOrganizer retrieves events by organizer ID
-func (s *serviceImpl) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID) ([]*Event, error) {
+// GetEventsByOrganizer retrieves a page of an organizer's events
+func (s *serviceImpl) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, filter OrganizerFilter) ([]*Event, int, error) {
+	if filter.Status != "" && !filter.Status.Valid() {
+		return nil, 0, ErrInvalidStatusFilter
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, 0, ErrInvalidDateRange
+	}
+	offset := max(filter.Offset, 0)
+
 	events, err := s.eventRepo.GetByOrganizer(ctx, organizerID)
+	if err != nil {
+		return nil, 0, err // Repository already returns custom error
+	}
+
+	now := s.clock.Now()
+	matching := make([]*Event, 0, len(events))
+	for _, e := range events {
+		if filter.PublicOnly && (!e.IsActive() || !e.EventDate.After(now) || e.IsFlagged()) {
+			continue
+		}
+		if filter.Status != "" && e.Status != filter.Status {
+			continue
+		}
+		if filter.From != nil && e.EventDate.Before(*filter.From) {
+			continue
+		}
+		if filter.To != nil && !e.EventDate.Before(*filter.To) {
+			continue
+		}
+		matching = append(matching, e)
+	}
+
+	total := len(matching)
+	if offset >= total {
+		return []*Event{}, total, nil
+	}
+	end := min(offset+filter.PageSize(), total)
+	return matching[offset:end], total, nil
+}
+
+// GetUpcomingEvents retrieves active events that have not started yet
+func (s *serviceImpl) GetUpcomingEvents(ctx context.Context, filter UpcomingFilter) ([]*Event, error) {
+	var events []*Event
+	var err error
+	switch {
+	case filter.VenueID != nil:
+		events, err = s.eventRepo.GetByVenue(ctx, *filter.VenueID)
+	case filter.OrganizerID != nil:
+		events, err = s.eventRepo.GetByOrganizer(ctx, *filter.OrganizerID)
+	default:
+		events, err = s.eventRepo.GetAll(ctx)
+	}
 	if err != nil {
 		return nil, err // Repository already returns custom error
 	}
-	return events, nil
+
+	now := s.clock.Now()
+	upcoming := make([]*Event, 0, len(events))
+	for _, e := range events {
+		if !e.IsActive() || !e.EventDate.After(now) || e.IsFlagged() {
+			continue
+		}
+		if filter.OrganizerID != nil && e.OrganizerID != *filter.OrganizerID {
+			continue
+		}
+		upcoming = append(upcoming, e)
+	}
+
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		return upcoming[i].EventDate.Before(upcoming[j].EventDate)
+	})
+	if filter.Limit > 0 && len(upcoming) > filter.Limit {
+		upcoming = upcoming[:filter.Limit]
+	}
+	return upcoming, nil
 }
 
 // UpdateEvent updates an existing event
@@ -110,11 +305,39 @@ func (s *serviceImpl) UpdateEvent(ctx context.Context, event *Event) error {
 		return err
 	}
 
-	// Update the event
-	if err := s.eventRepo.Update(ctx, event); err != nil {
+	// Raising ticket counts must stay within the organizer's plan
+	if err := s.checkQuota(ctx, event, false); err != nil {
+		return err
+	}
+
+	// Edited text is moderated again
+	if err := s.moderate(ctx, event, existingEvent); err != nil {
+		return err
+	}
+
+	// Work out what ticket holders need to hear about before the update is applied
+	changes := changedFields(existingEvent, event)
+
+	// A new sale window shows in listings right away rather than at the next refresh
+	event.OnSale = event.IsOnSale(s.clock.Now())
+
+	// A new title gets a new slug; the old one keeps resolving to the event
+	if event.Title != existingEvent.Title || existingEvent.Slug == "" {
+		err = withNewSlug(event, func() error {
+			return s.eventRepo.Update(ctx, event)
+		})
+	} else {
+		event.Slug = existingEvent.Slug
+		err = s.eventRepo.Update(ctx, event)
+	}
+	if err != nil {
 		return err // Repository already returns custom error
 	}
 
+	if len(changes) > 0 {
+		s.publish(ctx, EventChanged{EventID: event.ID, Title: event.Title, Changes: changes})
+	}
+
 	return nil
 }
 
@@ -125,8 +348,12 @@ func (s *serviceImpl) CancelEvent(ctx context.Context, eventID uuid.UUID, organi
 		return err // Repository already returns custom error
 	}
 
-	// Check if user is the organizer
-	if event.OrganizerID != organizerID {
+	// Check if user is the organizer or a co-organizer
+	canManage, err := s.CanManage(ctx, event, organizerID)
+	if err != nil {
+		return err
+	}
+	if !canManage {
 		return NewUnauthorizedAccessError("cancel this event")
 	}
 
@@ -145,9 +372,80 @@ func (s *serviceImpl) CancelEvent(ctx context.Context, eventID uuid.UUID, organi
 		return err // Repository already returns custom error
 	}
 
+	s.publish(ctx, EventChanged{EventID: event.ID, Title: event.Title, Cancelled: true})
+
+	return nil
+}
+
+// ApproveModeration publishes a flagged event
+// The approval holds until the organizer edits the title or description again.
+func (s *serviceImpl) ApproveModeration(ctx context.Context, eventID uuid.UUID, adminID uuid.UUID) (*Event, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err // Repository already returns custom error
+	}
+	if !event.IsFlagged() {
+		return nil, ErrNotFlagged
+	}
+
+	reason := event.ModerationReason
+	event.ModerationStatus = ModerationApproved
+	event.ModerationReason = ""
+	if err := s.eventRepo.Update(ctx, event); err != nil {
+		return nil, err // Repository already returns custom error
+	}
+
+	logging.From(ctx).Info("Flagged event approved", "event_id", event.ID, "admin_id", adminID, "reason", reason)
+	return event, nil
+}
+
+// moderate sets the moderation status of a new or edited event, rejecting objectionable text
+// Edits that keep the title and description keep the existing status. A moderator that
+// can't decide holds the event for review rather than blocking the organizer.
+func (s *serviceImpl) moderate(ctx context.Context, event, existing *Event) error {
+	if existing != nil && existing.Title == event.Title && existing.Description == event.Description {
+		event.ModerationStatus = existing.ModerationStatus
+		event.ModerationReason = existing.ModerationReason
+		return nil
+	}
+
+	event.ModerationStatus = ModerationApproved
+	event.ModerationReason = ""
+	if s.moderator == nil {
+		return nil
+	}
+
+	verdict, err := s.moderator.Moderate(ctx, event.Title, event.Description)
+	if err != nil {
+		logging.From(ctx).Warn("Failed to moderate event", "event_id", event.ID, "error", err)
+		verdict = ModerationVerdict{Action: ModerationFlag, Reason: "moderation unavailable"}
+	}
+
+	switch verdict.Action {
+	case ModerationReject:
+		return NewContentRejectedError(verdict.Reason)
+	case ModerationFlag:
+		event.ModerationStatus = ModerationFlagged
+		event.ModerationReason = truncate(verdict.Reason, 255)
+	}
 	return nil
 }
 
+// truncate shortens s to at most n runes
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
+
+// publish hands a domain event to the event bus, if one is configured
+func (s *serviceImpl) publish(ctx context.Context, e eventbus.Event) {
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, e)
+	}
+}
+
 // DeleteEvent deletes an event (only if no tickets sold)
 func (s *serviceImpl) DeleteEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -173,8 +471,21 @@ func (s *serviceImpl) DeleteEvent(ctx context.Context, eventID uuid.UUID, organi
 	return nil
 }
 
+// RefreshOnSale updates the on-sale flag of events whose sale window opened or closed by now
+func (s *serviceImpl) RefreshOnSale(ctx context.Context, now time.Time) (int, error) {
+	changed, err := s.eventRepo.RefreshOnSale(ctx, now)
+	if err != nil {
+		return 0, err // Repository already returns custom error
+	}
+	return len(changed), nil
+}
+
 // validateEvent validates event data
 func (s *serviceImpl) validateEvent(ctx context.Context, event *Event) error {
+	if err := event.Validate(); err != nil {
+		return err
+	}
+
 	// Check if venue exists and get venue details
 	venue, err := s.venueRepo.GetByID(ctx, event.VenueID)
 	if err != nil {
@@ -183,14 +494,92 @@ func (s *serviceImpl) validateEvent(ctx context.Context, event *Event) error {
 	}
 
 	// Check if event date is in the future
-	if event.EventDate.Before(time.Now()) {
+	if event.EventDate.Before(s.clock.Now()) {
 		return ErrEventDateInPast
 	}
 
-	// Check if total tickets doesn't exceed venue capacity
-	if event.TotalTickets > venue.Capacity {
+	if err := fitVenue(event, venue); err != nil {
+		return err
+	}
+
+	event.ContentWarnings = event.ContentWarnings.Normalize()
+	if err := validateRestrictions(event.MinimumAge, event.ContentWarnings); err != nil {
+		return err
+	}
+
+	// An empty sale window would never sell
+	if event.SaleStart != nil && event.SaleEnd != nil && !event.SaleEnd.After(*event.SaleStart) {
+		return ErrInvalidSaleWindow
+	}
+
+	event.PriceSchedule = event.PriceSchedule.Normalize()
+	if err := event.PriceSchedule.Validate(); err != nil {
+		return err
+	}
+
+	event.RefundPolicy = event.RefundPolicy.Normalize()
+	if err := event.RefundPolicy.Validate(); err != nil {
+		return err
+	}
+
+	return event.AttendeeQuestions.Validate()
+}
+
+// fitVenue checks the event's total tickets fit its layout, or the whole venue without one
+// The layout name is set to the venue's spelling of it.
+func fitVenue(event *Event, venue *venue.Venue) error {
+	if event.Layout != "" {
+		layout, ok := venue.Layouts.Find(event.Layout)
+		if !ok {
+			return NewUnknownLayoutError(event.Layout)
+		}
+		event.Layout = layout.Name
+		if event.TotalTickets > layout.Capacity {
+			return NewTicketsExceedLayoutError(event.TotalTickets, layout)
+		}
+	} else if event.TotalTickets > venue.Capacity {
 		return NewTicketsExceedCapacityError(event.TotalTickets, venue.Capacity)
 	}
+	return nil
+}
+
+// checkQuota enforces the organizer's plan limits
+// The active event limit only applies to new events; the ticket limit applies to every change
+func (s *serviceImpl) checkQuota(ctx context.Context, event *Event, creating bool) error {
+	if s.planService == nil {
+		return nil
+	}
+
+	organizerPlan, err := s.planService.GetUserPlan(ctx, event.OrganizerID)
+	if err != nil {
+		return NewEventError(ErrQuotaCheckFailed, err)
+	}
+
+	if !organizerPlan.AllowsTickets(event.TotalTickets) {
+		return NewQuotaExceededError(fmt.Sprintf(
+			"%s plan allows at most %d tickets per event", organizerPlan.Name, organizerPlan.MaxTicketsPerEvent))
+	}
+
+	if !creating {
+		return nil
+	}
+
+	events, err := s.eventRepo.GetByOrganizer(ctx, event.OrganizerID)
+	if err != nil {
+		return err // Repository already returns custom error
+	}
+
+	activeEvents := 0
+	for _, e := range events {
+		if e.IsActive() {
+			activeEvents++
+		}
+	}
+
+	if !organizerPlan.AllowsActiveEvents(activeEvents) {
+		return NewQuotaExceededError(fmt.Sprintf(
+			"%s plan allows at most %d active events", organizerPlan.Name, organizerPlan.MaxActiveEvents))
+	}
 
 	return nil
 }
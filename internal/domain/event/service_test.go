@@ -2,14 +2,19 @@ package event
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/plan"
 	"enterprise-crud/internal/domain/venue"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockEventRepository is a mock implementation of Repository interface
@@ -30,6 +35,14 @@ func (m *MockEventRepository) GetByID(ctx context.Context, id uuid.UUID) (*Event
 	return args.Get(0).(*Event), args.Error(1)
 }
 
+func (m *MockEventRepository) GetBySlug(ctx context.Context, slug string) (*Event, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Event), args.Error(1)
+}
+
 func (m *MockEventRepository) GetAll(ctx context.Context) ([]*Event, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -64,6 +77,24 @@ func (m *MockEventRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return args.Error(0)
 }
 
+func (m *MockEventRepository) RefreshOnSale(ctx context.Context, now time.Time) ([]*Event, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).([]*Event), args.Error(1)
+}
+
+func (m *MockEventRepository) AdjustInventory(ctx context.Context, adjustment *InventoryAdjustment) error {
+	args := m.Called(ctx, adjustment)
+	return args.Error(0)
+}
+
+func (m *MockEventRepository) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID) ([]*InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*InventoryAdjustment), args.Error(1)
+}
+
 // MockVenueRepository is a mock implementation of venue.Repository interface
 type MockVenueRepository struct {
 	mock.Mock
@@ -100,6 +131,35 @@ func (m *MockVenueRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return args.Error(0)
 }
 
+// MockPlanService is a mock implementation of plan.Service interface
+type MockPlanService struct {
+	mock.Mock
+}
+
+func (m *MockPlanService) GetAllPlans(ctx context.Context) ([]*plan.Plan, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*plan.Plan), args.Error(1)
+}
+
+func (m *MockPlanService) GetUserPlan(ctx context.Context, userID uuid.UUID) (*plan.Plan, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*plan.Plan), args.Error(1)
+}
+
+func (m *MockPlanService) AssignPlan(ctx context.Context, userID uuid.UUID, planName string) (*plan.Plan, error) {
+	args := m.Called(ctx, userID, planName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*plan.Plan), args.Error(1)
+}
+
 func TestEventService_CreateEvent(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -198,6 +258,37 @@ func TestEventService_CreateEvent(t *testing.T) {
 				return IsValidationError(err)
 			},
 		},
+		{
+			name: "negative ticket price",
+			event: &Event{
+				VenueID:      uuid.New(),
+				OrganizerID:  uuid.New(),
+				Title:        "Test Event",
+				EventDate:    time.Now().Add(24 * time.Hour),
+				TicketPrice:  -5,
+				TotalTickets: 100,
+			},
+			setupMocks:  func(eventRepo *MockEventRepository, venueRepo *MockVenueRepository) {},
+			expectError: true,
+			errorCheck: func(err error) bool {
+				return IsValidationError(err) && GetEventErrorCode(err) == ErrInvalidTicketPrice.Code
+			},
+		},
+		{
+			name: "no tickets",
+			event: &Event{
+				VenueID:     uuid.New(),
+				OrganizerID: uuid.New(),
+				Title:       "Test Event",
+				EventDate:   time.Now().Add(24 * time.Hour),
+				TicketPrice: 50.0,
+			},
+			setupMocks:  func(eventRepo *MockEventRepository, venueRepo *MockVenueRepository) {},
+			expectError: true,
+			errorCheck: func(err error) bool {
+				return IsValidationError(err) && GetEventErrorCode(err) == ErrInvalidTotalTickets.Code
+			},
+		},
 		{
 			name: "repository create error",
 			event: &Event{
@@ -232,7 +323,7 @@ func TestEventService_CreateEvent(t *testing.T) {
 
 			tt.setupMocks(eventRepo, venueRepo)
 
-			service := NewService(eventRepo, venueRepo)
+			service := NewService(eventRepo, venueRepo, nil, nil)
 			err := service.CreateEvent(context.Background(), tt.event)
 
 			if tt.expectError {
@@ -291,7 +382,7 @@ func TestEventService_GetEventByID(t *testing.T) {
 
 			tt.setupMocks(eventRepo, venueRepo)
 
-			service := NewService(eventRepo, venueRepo)
+			service := NewService(eventRepo, venueRepo, nil, nil)
 			event, err := service.GetEventByID(context.Background(), tt.eventID)
 
 			if tt.expectError {
@@ -394,7 +485,7 @@ func TestEventService_CancelEvent(t *testing.T) {
 
 			tt.setupMocks(eventRepo, venueRepo)
 
-			service := NewService(eventRepo, venueRepo)
+			service := NewService(eventRepo, venueRepo, nil, nil)
 			err := service.CancelEvent(context.Background(), tt.eventID, tt.organizerID)
 
 			if tt.expectError {
@@ -493,7 +584,7 @@ func TestEventService_UpdateEvent(t *testing.T) {
 
 			tt.setupMocks(eventRepo, venueRepo)
 
-			service := NewService(eventRepo, venueRepo)
+			service := NewService(eventRepo, venueRepo, nil, nil)
 			err := service.UpdateEvent(context.Background(), tt.event)
 
 			if tt.expectError {
@@ -511,6 +602,84 @@ func TestEventService_UpdateEvent(t *testing.T) {
 	}
 }
 
+// recordingPublisher records published domain events
+type recordingPublisher struct {
+	events []eventbus.Event
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, e eventbus.Event) {
+	p.events = append(p.events, e)
+}
+
+func TestEventService_PublishesEventChanged(t *testing.T) {
+	organizerID := uuid.New()
+	eventID := uuid.New()
+	venueID := uuid.New()
+	eventDate := time.Now().Add(24 * time.Hour)
+
+	existing := func() *Event {
+		return &Event{
+			ID:               eventID,
+			VenueID:          venueID,
+			OrganizerID:      organizerID,
+			Title:            "Jazz Night",
+			Description:      "Live jazz",
+			EventDate:        eventDate,
+			TicketPrice:      25,
+			TotalTickets:     100,
+			AvailableTickets: 50,
+			Status:           StatusActive,
+		}
+	}
+
+	t.Run("rescheduling notifies subscribers", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		venueRepo := new(MockVenueRepository)
+		eventRepo.On("GetByID", mock.Anything, eventID).Return(existing(), nil)
+		venueRepo.On("GetByID", mock.Anything, venueID).Return(&venue.Venue{ID: venueID, Capacity: 200}, nil)
+		eventRepo.On("Update", mock.Anything, mock.AnythingOfType("*event.Event")).Return(nil)
+		publisher := &recordingPublisher{}
+
+		updated := existing()
+		updated.EventDate = eventDate.Add(2 * time.Hour)
+		err := NewService(eventRepo, venueRepo, nil, publisher).UpdateEvent(context.Background(), updated)
+
+		require.NoError(t, err)
+		require.Len(t, publisher.events, 1)
+		assert.Equal(t, EventChanged{EventID: eventID, Title: "Jazz Night", Changes: []string{ChangeDate}}, publisher.events[0])
+	})
+
+	t.Run("changes ticket holders don't need are not published", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		venueRepo := new(MockVenueRepository)
+		eventRepo.On("GetByID", mock.Anything, eventID).Return(existing(), nil)
+		venueRepo.On("GetByID", mock.Anything, venueID).Return(&venue.Venue{ID: venueID, Capacity: 200}, nil)
+		eventRepo.On("Update", mock.Anything, mock.AnythingOfType("*event.Event")).Return(nil)
+		publisher := &recordingPublisher{}
+
+		updated := existing()
+		updated.Description = "Live jazz and food"
+		updated.TicketPrice = 30
+		err := NewService(eventRepo, venueRepo, nil, publisher).UpdateEvent(context.Background(), updated)
+
+		require.NoError(t, err)
+		assert.Empty(t, publisher.events)
+	})
+
+	t.Run("cancellation notifies subscribers", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", mock.Anything, eventID).Return(existing(), nil)
+		eventRepo.On("Update", mock.Anything, mock.AnythingOfType("*event.Event")).Return(nil)
+		publisher := &recordingPublisher{}
+
+		err := NewService(eventRepo, new(MockVenueRepository), nil, publisher).CancelEvent(context.Background(), eventID, organizerID)
+
+		require.NoError(t, err)
+		require.Len(t, publisher.events, 1)
+		assert.Equal(t, EventChanged{EventID: eventID, Title: "Jazz Night", Cancelled: true}, publisher.events[0])
+	})
+}
+
 func TestEventService_DeleteEvent(t *testing.T) {
 	organizerID := uuid.New()
 	eventID := uuid.New()
@@ -579,7 +748,7 @@ func TestEventService_DeleteEvent(t *testing.T) {
 
 			tt.setupMocks(eventRepo, venueRepo)
 
-			service := NewService(eventRepo, venueRepo)
+			service := NewService(eventRepo, venueRepo, nil, nil)
 			err := service.DeleteEvent(context.Background(), tt.eventID, tt.organizerID)
 
 			if tt.expectError {
@@ -596,3 +765,718 @@ func TestEventService_DeleteEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestEventService_CreateEvent_PlanQuota(t *testing.T) {
+	freePlan := &plan.Plan{Name: plan.PlanFree, MaxActiveEvents: 2, MaxTicketsPerEvent: 100}
+
+	tests := []struct {
+		name           string
+		totalTickets   int
+		existingEvents []*Event
+		expectQuota    bool
+	}{
+		{
+			name:           "within limits",
+			totalTickets:   100,
+			existingEvents: []*Event{{Status: StatusActive}, {Status: StatusCancelled}},
+		},
+		{
+			name:         "too many tickets",
+			totalTickets: 101,
+			expectQuota:  true,
+		},
+		{
+			name:           "too many active events",
+			totalTickets:   50,
+			existingEvents: []*Event{{Status: StatusActive}, {Status: StatusActive}},
+			expectQuota:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := new(MockEventRepository)
+			venueRepo := new(MockVenueRepository)
+			planService := new(MockPlanService)
+
+			evt := &Event{
+				VenueID:      uuid.New(),
+				OrganizerID:  uuid.New(),
+				Title:        "Test Event",
+				EventDate:    time.Now().Add(24 * time.Hour),
+				TotalTickets: tt.totalTickets,
+			}
+
+			venueRepo.On("GetByID", mock.Anything, evt.VenueID).Return(&venue.Venue{Capacity: 1000}, nil)
+			planService.On("GetUserPlan", mock.Anything, evt.OrganizerID).Return(freePlan, nil)
+			eventRepo.On("GetByOrganizer", mock.Anything, evt.OrganizerID).Return(tt.existingEvents, nil)
+			eventRepo.On("Create", mock.Anything, evt).Return(nil)
+
+			service := NewService(eventRepo, venueRepo, planService, nil)
+			err := service.CreateEvent(context.Background(), evt)
+
+			if tt.expectQuota {
+				assert.True(t, IsQuotaExceededError(err), "expected QUOTA_EXCEEDED, got %v", err)
+				eventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEventService_GetUpcomingEvents(t *testing.T) {
+	now := time.Now()
+	organizerID := uuid.New()
+	venueID := uuid.New()
+
+	later := &Event{ID: uuid.New(), OrganizerID: organizerID, VenueID: venueID, Status: StatusActive, EventDate: now.Add(48 * time.Hour)}
+	sooner := &Event{ID: uuid.New(), OrganizerID: organizerID, VenueID: venueID, Status: StatusActive, EventDate: now.Add(24 * time.Hour)}
+	past := &Event{ID: uuid.New(), OrganizerID: organizerID, VenueID: venueID, Status: StatusActive, EventDate: now.Add(-time.Hour)}
+	cancelled := &Event{ID: uuid.New(), OrganizerID: organizerID, VenueID: venueID, Status: StatusCancelled, EventDate: now.Add(time.Hour)}
+	otherOrganizer := &Event{ID: uuid.New(), OrganizerID: uuid.New(), VenueID: venueID, Status: StatusActive, EventDate: now.Add(time.Hour)}
+
+	t.Run("filters and sorts all events", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetAll", mock.Anything).Return([]*Event{later, past, cancelled, sooner}, nil)
+
+		events, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).GetUpcomingEvents(context.Background(), UpcomingFilter{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*Event{sooner, later}, events)
+	})
+
+	t.Run("combines venue and organizer filters", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByVenue", mock.Anything, venueID).Return([]*Event{otherOrganizer, later, sooner}, nil)
+
+		events, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).GetUpcomingEvents(context.Background(), UpcomingFilter{
+			VenueID:     &venueID,
+			OrganizerID: &organizerID,
+			Limit:       1,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*Event{sooner}, events)
+		eventRepo.AssertNotCalled(t, "GetByOrganizer", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEventService_GetAllEvents(t *testing.T) {
+	now := time.Now()
+	organizerID := uuid.New()
+
+	upcoming := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusActive, EventDate: now.Add(24 * time.Hour)}
+	past := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusActive, EventDate: now.Add(-time.Hour)}
+	cancelled := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusCancelled, EventDate: now.Add(time.Hour)}
+	otherCancelled := &Event{ID: uuid.New(), OrganizerID: uuid.New(), Status: StatusCancelled, EventDate: now.Add(time.Hour)}
+
+	t.Run("public listing hides cancelled and past events", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetAll", mock.Anything).Return([]*Event{past, upcoming, cancelled, otherCancelled}, nil)
+
+		events, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).GetAllEvents(context.Background(), ListFilter{}, Viewer{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*Event{upcoming}, events)
+	})
+
+	t.Run("anonymous visitors cannot ask for more", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+
+		_, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).GetAllEvents(context.Background(), ListFilter{IncludePast: true}, Viewer{})
+
+		assert.True(t, IsListingNotAllowedError(err))
+		eventRepo.AssertNotCalled(t, "GetAll", mock.Anything)
+	})
+
+	t.Run("organizers only see their own cancelled events", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByOrganizer", mock.Anything, organizerID).Return([]*Event{past, upcoming, cancelled}, nil)
+
+		events, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).GetAllEvents(context.Background(),
+			ListFilter{Status: StatusCancelled}, Viewer{UserID: organizerID, Organizer: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*Event{cancelled}, events)
+	})
+
+	t.Run("admins see past events of every organizer", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetAll", mock.Anything).Return([]*Event{past, upcoming, cancelled, otherCancelled}, nil)
+
+		events, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).GetAllEvents(context.Background(),
+			ListFilter{Status: StatusCancelled, IncludePast: true}, Viewer{UserID: uuid.New(), Admin: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*Event{cancelled, otherCancelled}, events)
+	})
+
+	t.Run("unknown status is rejected", func(t *testing.T) {
+		_, err := NewService(new(MockEventRepository), new(MockVenueRepository), nil, nil).GetAllEvents(context.Background(),
+			ListFilter{Status: "DRAFT"}, Viewer{UserID: uuid.New(), Admin: true})
+
+		assert.Equal(t, ErrInvalidStatusFilter, err)
+	})
+}
+
+func TestEventService_GetEventsByOrganizer(t *testing.T) {
+	now := time.Now()
+	organizerID := uuid.New()
+
+	first := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusActive, EventDate: now.Add(24 * time.Hour)}
+	second := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusActive, EventDate: now.Add(48 * time.Hour)}
+	third := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusActive, EventDate: now.Add(72 * time.Hour)}
+	cancelled := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusCancelled, EventDate: now.Add(36 * time.Hour)}
+	all := []*Event{first, cancelled, second, third}
+
+	t.Run("filters by status and pages the result", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByOrganizer", mock.Anything, organizerID).Return(all, nil)
+
+		events, total, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).GetEventsByOrganizer(context.Background(), organizerID,
+			OrganizerFilter{Status: StatusActive, Limit: 2, Offset: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, total)
+		assert.Equal(t, []*Event{second, third}, events)
+	})
+
+	t.Run("filters by date range", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByOrganizer", mock.Anything, organizerID).Return(all, nil)
+		from, to := now.Add(30*time.Hour), now.Add(72*time.Hour)
+
+		events, total, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).GetEventsByOrganizer(context.Background(), organizerID,
+			OrganizerFilter{From: &from, To: &to})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []*Event{cancelled, second}, events)
+	})
+
+	t.Run("offset past the end returns an empty page", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByOrganizer", mock.Anything, organizerID).Return(all, nil)
+
+		events, total, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).GetEventsByOrganizer(context.Background(), organizerID,
+			OrganizerFilter{Offset: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, total)
+		assert.Empty(t, events)
+	})
+
+	t.Run("public only leaves out past and flagged events", func(t *testing.T) {
+		past := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusActive, EventDate: now.Add(-time.Hour)}
+		flagged := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusActive, ModerationStatus: ModerationFlagged, EventDate: now.Add(30 * time.Hour)}
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByOrganizer", mock.Anything, organizerID).Return([]*Event{past, first, flagged, cancelled, second}, nil)
+
+		events, total, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).GetEventsByOrganizer(context.Background(), organizerID,
+			OrganizerFilter{PublicOnly: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []*Event{first, second}, events)
+	})
+
+	t.Run("rejects an inverted date range", func(t *testing.T) {
+		from, to := now.Add(48*time.Hour), now
+
+		_, _, err := NewService(new(MockEventRepository), new(MockVenueRepository), nil, nil).GetEventsByOrganizer(context.Background(), organizerID,
+			OrganizerFilter{From: &from, To: &to})
+
+		assert.Equal(t, ErrInvalidDateRange, err)
+	})
+}
+
+// stubModerator returns a fixed verdict and records the text it was asked about
+type stubModerator struct {
+	verdict ModerationVerdict
+	err     error
+	titles  []string
+}
+
+func (m *stubModerator) Moderate(ctx context.Context, title, description string) (ModerationVerdict, error) {
+	m.titles = append(m.titles, title)
+	return m.verdict, m.err
+}
+
+func TestEventService_Moderation(t *testing.T) {
+	ctx := context.Background()
+	newEvent := func() *Event {
+		return &Event{
+			VenueID:      uuid.New(),
+			OrganizerID:  uuid.New(),
+			Title:        "Test Event",
+			EventDate:    time.Now().Add(24 * time.Hour),
+			TotalTickets: 100,
+		}
+	}
+	newRepos := func() (*MockEventRepository, *MockVenueRepository) {
+		eventRepo := new(MockEventRepository)
+		venueRepo := new(MockVenueRepository)
+		venueRepo.On("GetByID", mock.Anything, mock.Anything).Return(&venue.Venue{Capacity: 200}, nil)
+		return eventRepo, venueRepo
+	}
+
+	t.Run("rejected text is not created", func(t *testing.T) {
+		eventRepo, venueRepo := newRepos()
+		moderator := &stubModerator{verdict: ModerationVerdict{Action: ModerationReject, Reason: "contains blocked term"}}
+
+		err := NewService(eventRepo, venueRepo, nil, nil, WithModerator(moderator)).CreateEvent(ctx, newEvent())
+
+		assert.Equal(t, "CONTENT_REJECTED", GetEventErrorCode(err))
+		assert.Contains(t, err.Error(), "contains blocked term")
+		eventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("flagged and undecided text is held for review", func(t *testing.T) {
+		for _, moderator := range []*stubModerator{
+			{verdict: ModerationVerdict{Action: ModerationFlag, Reason: "possible spam"}},
+			{err: assert.AnError},
+		} {
+			eventRepo, venueRepo := newRepos()
+			eventRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+			e := newEvent()
+
+			require.NoError(t, NewService(eventRepo, venueRepo, nil, nil, WithModerator(moderator)).CreateEvent(ctx, e))
+			assert.True(t, e.IsFlagged())
+			assert.NotEmpty(t, e.ModerationReason)
+		}
+	})
+
+	t.Run("edits keep the status unless the text changes", func(t *testing.T) {
+		existing := newEvent()
+		existing.ID = uuid.New()
+		existing.Status = StatusActive
+		existing.ModerationStatus = ModerationFlagged
+		existing.ModerationReason = "possible spam"
+		eventRepo, venueRepo := newRepos()
+		eventRepo.On("GetByID", mock.Anything, existing.ID).Return(existing, nil)
+		eventRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+		moderator := &stubModerator{verdict: ModerationVerdict{Action: ModerationAllow}}
+		service := NewService(eventRepo, venueRepo, nil, nil, WithModerator(moderator))
+
+		priceChange := *existing
+		priceChange.TicketPrice = 20
+		require.NoError(t, service.UpdateEvent(ctx, &priceChange))
+		assert.True(t, priceChange.IsFlagged())
+		assert.Empty(t, moderator.titles)
+
+		retitled := *existing
+		retitled.Title = "Renamed Event"
+		require.NoError(t, service.UpdateEvent(ctx, &retitled))
+		assert.Equal(t, ModerationApproved, retitled.ModerationStatus)
+		assert.Empty(t, retitled.ModerationReason)
+		assert.Equal(t, []string{"Renamed Event"}, moderator.titles)
+	})
+
+	t.Run("admins approve flagged events", func(t *testing.T) {
+		flagged := newEvent()
+		flagged.ID = uuid.New()
+		flagged.ModerationStatus = ModerationFlagged
+		eventRepo, venueRepo := newRepos()
+		eventRepo.On("GetByID", mock.Anything, flagged.ID).Return(flagged, nil)
+		eventRepo.On("Update", mock.Anything, flagged).Return(nil).Once()
+		service := NewService(eventRepo, venueRepo, nil, nil)
+
+		approved, err := service.ApproveModeration(ctx, flagged.ID, uuid.New())
+		require.NoError(t, err)
+		assert.Equal(t, ModerationApproved, approved.ModerationStatus)
+
+		_, err = service.ApproveModeration(ctx, flagged.ID, uuid.New())
+		assert.Equal(t, ErrNotFlagged, err)
+	})
+
+	t.Run("flagged events stay out of public listings", func(t *testing.T) {
+		organizerID := uuid.New()
+		approved := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusActive, EventDate: time.Now().Add(time.Hour)}
+		flagged := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusActive, EventDate: time.Now().Add(time.Hour), ModerationStatus: ModerationFlagged}
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetAll", mock.Anything).Return([]*Event{approved, flagged}, nil)
+		service := NewService(eventRepo, new(MockVenueRepository), nil, nil)
+
+		events, err := service.GetAllEvents(ctx, ListFilter{}, Viewer{})
+		require.NoError(t, err)
+		assert.Equal(t, []*Event{approved}, events)
+
+		events, err = service.GetUpcomingEvents(ctx, UpcomingFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, []*Event{approved}, events)
+
+		_, err = service.GetAllEvents(ctx, ListFilter{Moderation: ModerationFlagged}, Viewer{})
+		assert.True(t, IsListingNotAllowedError(err))
+
+		events, err = service.GetAllEvents(ctx, ListFilter{Moderation: ModerationFlagged}, Viewer{UserID: uuid.New(), Admin: true})
+		require.NoError(t, err)
+		assert.Equal(t, []*Event{flagged}, events)
+	})
+}
+
+func TestEventService_Layouts(t *testing.T) {
+	ctx := context.Background()
+	arena := &venue.Venue{
+		Capacity: 500,
+		Layouts:  venue.Layouts{{Name: "Standing", Capacity: 500}, {Name: "Seated", Capacity: 300}},
+	}
+	newEvent := func(layout string, tickets int) *Event {
+		return &Event{
+			VenueID:      uuid.New(),
+			OrganizerID:  uuid.New(),
+			Title:        "Test Event",
+			EventDate:    time.Now().Add(24 * time.Hour),
+			TotalTickets: tickets,
+			Layout:       layout,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		event        *Event
+		expectedCode string
+		layout       string
+	}{
+		{name: "whole venue", event: newEvent("", 500)},
+		{name: "fits layout", event: newEvent("seated", 300), layout: "Seated"},
+		{name: "exceeds layout", event: newEvent("Seated", 301), expectedCode: "TICKETS_EXCEED_CAPACITY"},
+		{name: "unknown layout", event: newEvent("Theatre", 100), expectedCode: "UNKNOWN_LAYOUT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := new(MockEventRepository)
+			venueRepo := new(MockVenueRepository)
+			venueRepo.On("GetByID", mock.Anything, mock.Anything).Return(arena, nil)
+			eventRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+			err := NewService(eventRepo, venueRepo, nil, nil).CreateEvent(ctx, tt.event)
+
+			if tt.expectedCode != "" {
+				assert.Equal(t, tt.expectedCode, GetEventErrorCode(err))
+				assert.True(t, IsValidationError(err))
+				eventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.layout, tt.event.Layout, "layout names are stored as the venue spells them")
+		})
+	}
+}
+
+func TestEventService_SaleWindow(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	hourAgo, inAnHour := now.Add(-time.Hour), now.Add(time.Hour)
+	newEvent := func(start, end *time.Time) *Event {
+		return &Event{
+			VenueID:      uuid.New(),
+			OrganizerID:  uuid.New(),
+			Title:        "Test Event",
+			EventDate:    now.Add(24 * time.Hour),
+			TotalTickets: 100,
+			SaleStart:    start,
+			SaleEnd:      end,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		event        *Event
+		expectedCode string
+		onSale       bool
+	}{
+		{name: "no window", event: newEvent(nil, nil), onSale: true},
+		{name: "open window", event: newEvent(&hourAgo, &inAnHour), onSale: true},
+		{name: "not started", event: newEvent(&inAnHour, nil), onSale: false},
+		{name: "end before start", event: newEvent(&inAnHour, &hourAgo), expectedCode: "INVALID_SALE_WINDOW"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := new(MockEventRepository)
+			venueRepo := new(MockVenueRepository)
+			venueRepo.On("GetByID", mock.Anything, mock.Anything).Return(&venue.Venue{Capacity: 500}, nil)
+			eventRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+			err := NewService(eventRepo, venueRepo, nil, nil).CreateEvent(ctx, tt.event)
+
+			if tt.expectedCode != "" {
+				assert.Equal(t, tt.expectedCode, GetEventErrorCode(err))
+				assert.True(t, IsValidationError(err))
+				eventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.onSale, tt.event.OnSale)
+		})
+	}
+
+	t.Run("listings can keep to events on sale", func(t *testing.T) {
+		onSale := &Event{ID: uuid.New(), Status: StatusActive, EventDate: now.Add(24 * time.Hour), OnSale: true}
+		notYet := &Event{ID: uuid.New(), Status: StatusActive, EventDate: now.Add(24 * time.Hour), SaleStart: &inAnHour}
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetAll", mock.Anything).Return([]*Event{onSale, notYet}, nil)
+
+		events, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).GetAllEvents(ctx, ListFilter{OnSale: true}, Viewer{})
+
+		require.NoError(t, err)
+		assert.Equal(t, []*Event{onSale}, events)
+	})
+
+	t.Run("refresh counts the events that changed", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("RefreshOnSale", ctx, now).Return([]*Event{{ID: uuid.New()}, {ID: uuid.New()}}, nil)
+
+		changed, err := NewService(eventRepo, new(MockVenueRepository), nil, nil).RefreshOnSale(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, changed)
+	})
+}
+
+func TestEventService_Clock(t *testing.T) {
+	ctx := context.Background()
+	// Years ahead of the wall clock, so only the service clock can tell these dates are past
+	start := time.Date(2031, 5, 1, 12, 0, 0, 0, time.UTC)
+	newService := func(now *clock.Fake) (*MockEventRepository, Service) {
+		eventRepo := new(MockEventRepository)
+		venueRepo := new(MockVenueRepository)
+		venueRepo.On("GetByID", mock.Anything, mock.Anything).Return(&venue.Venue{Capacity: 500}, nil)
+		eventRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+		return eventRepo, NewService(eventRepo, venueRepo, nil, nil, WithClock(now))
+	}
+	newEvent := func(eventDate time.Time) *Event {
+		return &Event{VenueID: uuid.New(), OrganizerID: uuid.New(), Title: "Test Event", EventDate: eventDate, TotalTickets: 100}
+	}
+
+	t.Run("rejects event dates before the clock", func(t *testing.T) {
+		eventRepo, service := newService(clock.NewFake(start))
+
+		err := service.CreateEvent(ctx, newEvent(start.Add(-time.Minute)))
+
+		assert.Equal(t, "EVENT_DATE_INVALID", GetEventErrorCode(err))
+		eventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		assert.NoError(t, service.CreateEvent(ctx, newEvent(start.Add(time.Minute))))
+	})
+
+	t.Run("puts events on sale by the clock", func(t *testing.T) {
+		saleStart, saleEnd := start.Add(time.Hour), start.Add(2*time.Hour)
+		tests := []struct {
+			name   string
+			at     time.Time
+			onSale bool
+		}{
+			{name: "before the window", at: start, onSale: false},
+			{name: "at the start", at: saleStart, onSale: true},
+			{name: "inside the window", at: saleStart.Add(30 * time.Minute), onSale: true},
+			{name: "at the end", at: saleEnd, onSale: false},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, service := newService(clock.NewFake(tt.at))
+				e := newEvent(start.Add(24 * time.Hour))
+				e.SaleStart, e.SaleEnd = &saleStart, &saleEnd
+
+				require.NoError(t, service.CreateEvent(ctx, e))
+
+				assert.Equal(t, tt.onSale, e.OnSale)
+			})
+		}
+	})
+
+	t.Run("drops events from upcoming listings once they start", func(t *testing.T) {
+		now := clock.NewFake(start)
+		e := &Event{ID: uuid.New(), Status: StatusActive, EventDate: start.Add(time.Hour)}
+		eventRepo, service := newService(now)
+		eventRepo.On("GetAll", mock.Anything).Return([]*Event{e}, nil)
+
+		upcoming, err := service.GetUpcomingEvents(ctx, UpcomingFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, []*Event{e}, upcoming)
+
+		now.Advance(time.Hour)
+		upcoming, err = service.GetUpcomingEvents(ctx, UpcomingFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, upcoming)
+	})
+}
+
+// stubCoOrganizers lists the co-organizers of every event
+type stubCoOrganizers map[uuid.UUID]bool
+
+func (s stubCoOrganizers) IsCoOrganizer(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	return s[userID], nil
+}
+
+func TestEventService_CoOrganizers(t *testing.T) {
+	ctx := context.Background()
+	organizerID := uuid.New()
+	coOrganizerID := uuid.New()
+	e := &Event{ID: uuid.New(), OrganizerID: organizerID, Status: StatusActive}
+	coOrganizers := stubCoOrganizers{coOrganizerID: true}
+
+	service := NewService(new(MockEventRepository), new(MockVenueRepository), nil, nil, WithCoOrganizers(coOrganizers))
+	for userID, want := range map[uuid.UUID]bool{organizerID: true, coOrganizerID: true, uuid.New(): false} {
+		canManage, err := service.CanManage(ctx, e, userID)
+		require.NoError(t, err)
+		assert.Equal(t, want, canManage)
+	}
+
+	t.Run("co-organizers cancel but don't delete", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", mock.Anything, e.ID).Return(e, nil)
+		eventRepo.On("Update", mock.Anything, e).Return(nil)
+		service := NewService(eventRepo, new(MockVenueRepository), nil, nil, WithCoOrganizers(coOrganizers))
+
+		assert.True(t, IsUnauthorizedError(service.DeleteEvent(ctx, e.ID, coOrganizerID)))
+		require.NoError(t, service.CancelEvent(ctx, e.ID, coOrganizerID))
+		assert.True(t, e.IsCancelled())
+		eventRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("without co-organizers only the organizer manages", func(t *testing.T) {
+		canManage, err := NewService(new(MockEventRepository), new(MockVenueRepository), nil, nil).CanManage(ctx, e, coOrganizerID)
+		require.NoError(t, err)
+		assert.False(t, canManage)
+	})
+}
+
+func TestEventService_Restrictions(t *testing.T) {
+	ctx := context.Background()
+	newEvent := func(minimumAge int, warnings ...string) *Event {
+		return &Event{
+			VenueID:         uuid.New(),
+			OrganizerID:     uuid.New(),
+			Title:           "Test Event",
+			EventDate:       time.Now().Add(24 * time.Hour),
+			TotalTickets:    100,
+			MinimumAge:      minimumAge,
+			ContentWarnings: warnings,
+		}
+	}
+
+	tests := []struct {
+		name             string
+		event            *Event
+		expectedWarnings ContentWarnings
+		expectInvalid    bool
+	}{
+		{name: "no restrictions", event: newEvent(0), expectedWarnings: ContentWarnings{}},
+		{
+			name:             "warnings are trimmed and deduplicated",
+			event:            newEvent(18, " Strobe lights ", "strobe lights", "", "Loud music"),
+			expectedWarnings: ContentWarnings{"Strobe lights", "Loud music"},
+		},
+		{name: "negative age", event: newEvent(-1), expectInvalid: true},
+		{name: "age over the limit", event: newEvent(MaxMinimumAge + 1), expectInvalid: true},
+		{name: "warning too long", event: newEvent(0, strings.Repeat("a", maxContentWarningRunes+1)), expectInvalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := new(MockEventRepository)
+			venueRepo := new(MockVenueRepository)
+			venueRepo.On("GetByID", mock.Anything, mock.Anything).Return(&venue.Venue{Capacity: 500}, nil)
+			eventRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+			err := NewService(eventRepo, venueRepo, nil, nil).CreateEvent(ctx, tt.event)
+
+			if tt.expectInvalid {
+				assert.Equal(t, "INVALID_RESTRICTIONS", GetEventErrorCode(err))
+				assert.True(t, IsValidationError(err))
+				eventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedWarnings, tt.event.ContentWarnings)
+		})
+	}
+}
+
+func TestEventService_Slugs(t *testing.T) {
+	ctx := context.Background()
+	newService := func(eventRepo *MockEventRepository) Service {
+		venueRepo := new(MockVenueRepository)
+		venueRepo.On("GetByID", mock.Anything, mock.Anything).Return(&venue.Venue{Capacity: 500}, nil)
+		return NewService(eventRepo, venueRepo, nil, nil)
+	}
+	newEvent := func(title string) *Event {
+		return &Event{
+			ID:           uuid.New(),
+			VenueID:      uuid.New(),
+			OrganizerID:  uuid.New(),
+			Title:        title,
+			EventDate:    time.Now().Add(24 * time.Hour),
+			TotalTickets: 100,
+			Status:       StatusActive,
+		}
+	}
+
+	t.Run("create retries taken slugs", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		var tried []string
+		eventRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			tried = append(tried, args.Get(1).(*Event).Slug)
+		}).Return(ErrSlugTaken).Once()
+		eventRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Once()
+
+		e := newEvent("Summer Concert")
+		require.NoError(t, newService(eventRepo).CreateEvent(ctx, e))
+
+		assert.Regexp(t, `^summer-concert-[a-z2-7]{6}$`, e.Slug)
+		require.Len(t, tried, 1)
+		assert.NotEqual(t, tried[0], e.Slug)
+	})
+
+	t.Run("create gives up after repeated collisions", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("Create", mock.Anything, mock.Anything).Return(ErrSlugTaken)
+
+		err := newService(eventRepo).CreateEvent(ctx, newEvent("Summer Concert"))
+
+		assert.True(t, IsSlugTakenError(err))
+		eventRepo.AssertNumberOfCalls(t, "Create", maxSlugAttempts)
+	})
+
+	t.Run("update keeps the slug while the title is unchanged", func(t *testing.T) {
+		existing := newEvent("Summer Concert")
+		existing.Slug = "summer-concert-abcdef"
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", mock.Anything, existing.ID).Return(existing, nil)
+		eventRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+		updated := *existing
+		updated.Slug = ""
+		updated.Description = "Now with fireworks"
+		require.NoError(t, newService(eventRepo).UpdateEvent(ctx, &updated))
+
+		assert.Equal(t, "summer-concert-abcdef", updated.Slug)
+	})
+
+	t.Run("update gives a new title a new slug", func(t *testing.T) {
+		existing := newEvent("Summer Concert")
+		existing.Slug = "summer-concert-abcdef"
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", mock.Anything, existing.ID).Return(existing, nil)
+		eventRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+		updated := *existing
+		updated.Title = "Winter Concert"
+		require.NoError(t, newService(eventRepo).UpdateEvent(ctx, &updated))
+
+		assert.Regexp(t, `^winter-concert-[a-z2-7]{6}$`, updated.Slug)
+	})
+
+	t.Run("lookups ignore case", func(t *testing.T) {
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetBySlug", mock.Anything, "summer-concert-abcdef").Return(newEvent("Summer Concert"), nil)
+
+		_, err := newService(eventRepo).GetEventBySlug(ctx, "Summer-Concert-ABCDEF")
+
+		require.NoError(t, err)
+		eventRepo.AssertExpectations(t)
+	})
+}
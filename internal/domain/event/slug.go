@@ -0,0 +1,83 @@
+package event
+
+import (
+	"crypto/rand"
+	"strings"
+)
+
+// Slug limits
+const (
+	maxSlugBaseLength = 80 // Longest title part of a slug, before the suffix
+	slugSuffixLength  = 6
+	maxSlugAttempts   = 5 // Slugs generated for an event before giving up on collisions
+)
+
+// slugAlphabet is what slug suffixes are made of: lowercase base32, 32 characters so every byte maps evenly
+const slugAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+
+// Slugify turns a title into the readable part of a slug: lowercase ASCII letters and digits joined by dashes
+// Accented Latin letters lose their accents; anything else separates words. Titles with nothing left become "event".
+func Slugify(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		r = unaccent(r)
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+		if b.Len() >= maxSlugBaseLength {
+			break
+		}
+	}
+	slug := strings.TrimSuffix(b.String(), "-")
+	if slug == "" {
+		return "event"
+	}
+	return slug
+}
+
+// NewSlug creates a slug for an event with the given title: its slugified title and a random suffix
+// The suffix keeps events with the same title apart; collisions are still possible and must be retried.
+func NewSlug(title string) string {
+	suffix := make([]byte, slugSuffixLength)
+	if _, err := rand.Read(suffix); err != nil {
+		panic(err) // crypto/rand never fails on supported platforms
+	}
+	for i, b := range suffix {
+		suffix[i] = slugAlphabet[b&31]
+	}
+	return Slugify(title) + "-" + string(suffix)
+}
+
+// unaccent maps accented Latin letters to their base letter
+func unaccent(r rune) rune {
+	switch {
+	case strings.ContainsRune("àáâãäåā", r):
+		return 'a'
+	case strings.ContainsRune("çćč", r):
+		return 'c'
+	case strings.ContainsRune("èéêëēė", r):
+		return 'e'
+	case strings.ContainsRune("ìíîïī", r):
+		return 'i'
+	case strings.ContainsRune("ñń", r):
+		return 'n'
+	case strings.ContainsRune("òóôõöøō", r):
+		return 'o'
+	case strings.ContainsRune("ùúûüū", r):
+		return 'u'
+	case strings.ContainsRune("ýÿ", r):
+		return 'y'
+	case strings.ContainsRune("šś", r):
+		return 's'
+	case strings.ContainsRune("žźż", r):
+		return 'z'
+	}
+	return r
+}
@@ -0,0 +1,38 @@
+package event
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		title    string
+		expected string
+	}{
+		{title: "Summer Concert", expected: "summer-concert"},
+		{title: "  Rock & Roll -- Live!  ", expected: "rock-roll-live"},
+		{title: "Café Münchën 2025", expected: "cafe-munchen-2025"},
+		{title: "東京", expected: "event"},
+		{title: "", expected: "event"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Slugify(tt.title))
+		})
+	}
+
+	t.Run("long titles are cut", func(t *testing.T) {
+		assert.Len(t, Slugify(strings.Repeat("a", 200)), maxSlugBaseLength)
+	})
+}
+
+func TestNewSlug(t *testing.T) {
+	slug := NewSlug("Summer Concert")
+	assert.Regexp(t, regexp.MustCompile(`^summer-concert-[a-z2-7]{6}$`), slug)
+	assert.NotEqual(t, slug, NewSlug("Summer Concert"))
+}
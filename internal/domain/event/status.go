@@ -0,0 +1,62 @@
+package event
+
+import "slices"
+
+// Status is the lifecycle state of an event
+// Events start ACTIVE and end either CANCELLED by their organizer or COMPLETED once they took place.
+type Status string
+
+// Event status constants
+const (
+	StatusActive    Status = "ACTIVE"
+	StatusCancelled Status = "CANCELLED"
+	StatusCompleted Status = "COMPLETED"
+)
+
+// Statuses lists every event status, in lifecycle order
+var Statuses = []Status{StatusActive, StatusCancelled, StatusCompleted}
+
+// eventTransitions lists the statuses each status may move to
+var eventTransitions = map[Status][]Status{
+	StatusActive: {StatusCancelled, StatusCompleted},
+}
+
+// Valid reports whether s is a known event status
+func (s Status) Valid() bool {
+	return slices.Contains(Statuses, s)
+}
+
+// Transitions returns the statuses an event in status s may move to; none for final statuses
+func (s Status) Transitions() []Status {
+	return slices.Clone(eventTransitions[s])
+}
+
+// CanTransitionTo reports whether an event in status s may be moved to next
+// Setting the status it already has is allowed and changes nothing.
+func (s Status) CanTransitionTo(next Status) bool {
+	return next.Valid() && (s == next || slices.Contains(eventTransitions[s], next))
+}
+
+// ParseStatus returns the event status named by value
+func ParseStatus(value string) (Status, error) {
+	status := Status(value)
+	if !status.Valid() {
+		return "", ErrInvalidStatus
+	}
+	return status, nil
+}
+
+// UnmarshalText decodes a status from JSON and query strings, rejecting unknown ones
+// The empty string decodes to the zero value, meaning no status was given.
+func (s *Status) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*s = ""
+		return nil
+	}
+	status, err := ParseStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
@@ -0,0 +1,37 @@
+package event
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus_Transitions(t *testing.T) {
+	assert.Equal(t, []Status{StatusCancelled, StatusCompleted}, StatusActive.Transitions())
+	assert.Empty(t, StatusCancelled.Transitions())
+	assert.Empty(t, StatusCompleted.Transitions())
+
+	assert.True(t, StatusActive.CanTransitionTo(StatusCancelled))
+	assert.True(t, StatusCancelled.CanTransitionTo(StatusCancelled))
+	assert.False(t, StatusCancelled.CanTransitionTo(StatusActive))
+	assert.False(t, StatusCompleted.CanTransitionTo(StatusCancelled))
+	assert.False(t, StatusActive.CanTransitionTo("ARCHIVED"))
+}
+
+func TestStatus_UnmarshalJSON(t *testing.T) {
+	var e Event
+	require.NoError(t, json.Unmarshal([]byte(`{"status":"CANCELLED"}`), &e))
+	assert.Equal(t, StatusCancelled, e.Status)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"status":""}`), &e))
+	assert.Equal(t, Status(""), e.Status)
+
+	err := json.Unmarshal([]byte(`{"status":"cancelled"}`), &e)
+	assert.ErrorIs(t, err, ErrInvalidStatus)
+
+	encoded, err := json.Marshal(Event{Status: StatusActive})
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"status":"ACTIVE"`)
+}
@@ -0,0 +1,56 @@
+// Package eventbus dispatches domain events to in-process subscribers
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	"enterprise-crud/internal/logging"
+)
+
+// Event is something that happened in one domain that other domains may react to
+type Event interface {
+	// Topic identifies the kind of event handlers subscribe to
+	Topic() string
+}
+
+// Handler reacts to a published event
+type Handler func(ctx context.Context, e Event) error
+
+// Publisher publishes domain events
+type Publisher interface {
+	Publish(ctx context.Context, e Event)
+}
+
+// Bus delivers each event synchronously to the handlers subscribed to its topic
+// Handler errors are logged and never reach the publisher: the change that
+// raised the event has already been committed by then.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// New creates an empty event bus
+func New() *Bus {
+	return &Bus{handlers: make(map[string][]Handler)}
+}
+
+// Subscribe registers a handler for every event published on topic
+func (b *Bus) Subscribe(topic string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[topic] = append(b.handlers[topic], handler)
+}
+
+// Publish runs the handlers subscribed to the event's topic in registration order
+func (b *Bus) Publish(ctx context.Context, e Event) {
+	b.mu.RLock()
+	handlers := b.handlers[e.Topic()]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, e); err != nil {
+			logging.From(ctx).Warn("Event handler failed", "topic", e.Topic(), "error", err)
+		}
+	}
+}
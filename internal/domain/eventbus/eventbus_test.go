@@ -0,0 +1,44 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testEvent struct {
+	topic string
+}
+
+func (e testEvent) Topic() string { return e.topic }
+
+func TestBus_Publish(t *testing.T) {
+	bus := New()
+
+	var calls []string
+	bus.Subscribe("a", func(ctx context.Context, e Event) error {
+		calls = append(calls, "first")
+		return errors.New("boom")
+	})
+	bus.Subscribe("a", func(ctx context.Context, e Event) error {
+		calls = append(calls, "second")
+		return nil
+	})
+	bus.Subscribe("b", func(ctx context.Context, e Event) error {
+		calls = append(calls, "other topic")
+		return nil
+	})
+
+	bus.Publish(context.Background(), testEvent{topic: "a"})
+
+	// A failing handler does not stop later ones
+	assert.Equal(t, []string{"first", "second"}, calls)
+}
+
+func TestBus_PublishWithoutSubscribers(t *testing.T) {
+	assert.NotPanics(t, func() {
+		New().Publish(context.Background(), testEvent{topic: "nobody"})
+	})
+}
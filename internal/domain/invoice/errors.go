@@ -0,0 +1,77 @@
+package invoice
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// InvoiceError represents domain-specific invoice errors
+type InvoiceError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *InvoiceError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *InvoiceError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined invoice domain errors
+var (
+	ErrForbidden               = &InvoiceError{Code: "FORBIDDEN", Message: "you can only view invoices of your own orders"}
+	ErrOrderNotCompleted       = &InvoiceError{Code: "ORDER_NOT_COMPLETED", Message: "invoices are only issued for completed orders"}
+	ErrInvoiceRetrievalFailed  = &InvoiceError{Code: "INVOICE_RETRIEVAL_FAILED", Message: "failed to retrieve invoice data"}
+	ErrInvoiceNumberFailed     = &InvoiceError{Code: "INVOICE_NUMBER_FAILED", Message: "failed to assign invoice number"}
+	ErrInvoiceStorageFailed    = &InvoiceError{Code: "INVOICE_STORAGE_FAILED", Message: "failed to access stored invoice"}
+	ErrInvoiceSchedulingFailed = &InvoiceError{Code: "INVOICE_SCHEDULING_FAILED", Message: "failed to schedule invoice generation"}
+)
+
+// NewInvoiceError creates a new InvoiceError with a cause
+func NewInvoiceError(baseError *InvoiceError, cause error) *InvoiceError {
+	return &InvoiceError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// NewOrderNotFoundError creates a specific error for an unknown order
+func NewOrderNotFoundError(id uuid.UUID) *InvoiceError {
+	return &InvoiceError{
+		Code:    "ORDER_NOT_FOUND",
+		Message: fmt.Sprintf("order with ID %s not found", id),
+	}
+}
+
+// GetInvoiceErrorCode extracts the error code from an InvoiceError
+func GetInvoiceErrorCode(err error) string {
+	var invoiceErr *InvoiceError
+	if errors.As(err, &invoiceErr) {
+		return invoiceErr.Code
+	}
+	return ""
+}
+
+// IsOrderNotFoundError checks if an error is an "order not found" error
+func IsOrderNotFoundError(err error) bool {
+	return GetInvoiceErrorCode(err) == "ORDER_NOT_FOUND"
+}
+
+// IsForbiddenError checks if an error denies access to an invoice
+func IsForbiddenError(err error) bool {
+	return GetInvoiceErrorCode(err) == "FORBIDDEN"
+}
+
+// IsOrderNotCompletedError checks if an error is because the order can't be invoiced yet
+func IsOrderNotCompletedError(err error) bool {
+	return GetInvoiceErrorCode(err) == "ORDER_NOT_COMPLETED"
+}
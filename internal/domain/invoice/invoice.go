@@ -0,0 +1,145 @@
+package invoice
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+)
+
+// JobTypeGenerate is the job type that renders an order's invoice and stores it
+const JobTypeGenerate = "invoice.generate"
+
+// ContentType is the media type of stored invoice documents
+// Invoices are print-ready HTML; browsers save them as PDF from the print dialog.
+const ContentType = "text/html; charset=utf-8"
+
+// GeneratePayload is the job payload for JobTypeGenerate
+type GeneratePayload struct {
+	OrderID uuid.UUID `json:"order_id"`
+}
+
+// Settings describe the seller and how taxes are shown on invoices
+type Settings struct {
+	SellerName    string
+	SellerAddress string
+	TaxLabel      string  // e.g. "VAT"
+	TaxRate       float64 // Fraction included in ticket prices, e.g. 0.2 for 20%
+	Currency      string  // ISO 4217 code printed next to amounts
+}
+
+// Source is the order data an invoice is built from
+type Source struct {
+	OrderID         uuid.UUID
+	UserID          uuid.UUID
+	Status          order.Status
+	Quantity        int
+	TotalAmount     float64
+	OrderedAt       time.Time
+	InvoiceNumber   *int64
+	InvoiceIssuedAt *time.Time
+	BuyerName       string
+	BuyerEmail      string
+	EventTitle      string
+	EventDate       time.Time
+	VenueName       string
+	OrganizerID     uuid.UUID
+}
+
+// Line is an itemized charge on an invoice
+// Amounts include tax, as ticket prices do.
+type Line struct {
+	Description string
+	Quantity    int
+	UnitPrice   float64
+	Amount      float64
+}
+
+// Invoice is everything needed to render an order's invoice
+type Invoice struct {
+	Number     string
+	IssuedAt   time.Time
+	OrderID    uuid.UUID
+	OrderedAt  time.Time
+	BuyerName  string
+	BuyerEmail string
+	Seller     Settings
+	Lines      []Line
+	Subtotal   float64 // Total without tax
+	TaxAmount  float64
+	Total      float64
+	Branding   *Branding // The organizer's branding; nil for the default styling
+}
+
+// Branding is how an organizer styles the invoices of their events
+// The logo is embedded in the document, which is stored once and must not depend on later uploads.
+type Branding struct {
+	PrimaryColor    string // #rrggbb, empty for the default
+	AccentColor     string // #rrggbb, empty for the default
+	LogoContentType string
+	Logo            []byte // Empty without a logo
+}
+
+// Document is a rendered invoice
+type Document struct {
+	Number      string
+	ContentType string
+	Body        []byte
+}
+
+// FormatNumber renders a sequential invoice number, e.g. INV-000042
+func FormatNumber(n int64) string {
+	return fmt.Sprintf("INV-%06d", n)
+}
+
+// StorageKey is where the document of an order's invoice is stored
+func StorageKey(orderID uuid.UUID) string {
+	return "invoices/" + orderID.String() + ".html"
+}
+
+// Build itemizes an order with an assigned invoice number
+// The order total is tax-inclusive, so tax is extracted from it rather than added.
+func Build(src *Source, settings Settings) *Invoice {
+	unitPrice := 0.0
+	if src.Quantity > 0 {
+		unitPrice = roundCents(src.TotalAmount / float64(src.Quantity))
+	}
+
+	description := "Ticket: " + src.EventTitle + ", " + src.EventDate.UTC().Format("2 Jan 2006 15:04 MST")
+	if src.VenueName != "" {
+		description += ", " + src.VenueName
+	}
+
+	subtotal := roundCents(src.TotalAmount / (1 + settings.TaxRate))
+	inv := &Invoice{
+		OrderID:    src.OrderID,
+		OrderedAt:  src.OrderedAt,
+		BuyerName:  src.BuyerName,
+		BuyerEmail: src.BuyerEmail,
+		Seller:     settings,
+		Lines: []Line{{
+			Description: description,
+			Quantity:    src.Quantity,
+			UnitPrice:   unitPrice,
+			Amount:      src.TotalAmount,
+		}},
+		Subtotal:  subtotal,
+		TaxAmount: roundCents(src.TotalAmount - subtotal),
+		Total:     src.TotalAmount,
+	}
+	if src.InvoiceNumber != nil {
+		inv.Number = FormatNumber(*src.InvoiceNumber)
+	}
+	if src.InvoiceIssuedAt != nil {
+		inv.IssuedAt = *src.InvoiceIssuedAt
+	}
+	return inv
+}
+
+// roundCents rounds an amount to two decimals
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,27 @@
+package invoice
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for invoice data access
+type Repository interface {
+	// GetSource retrieves an order with its buyer and event
+	GetSource(ctx context.Context, orderID uuid.UUID) (*Source, error)
+
+	// AssignNumber gives an order the next invoice number unless it already has one
+	// It reports false when the order was numbered before.
+	AssignNumber(ctx context.Context, orderID uuid.UUID, issuedAt time.Time) (bool, error)
+}
+
+// Store keeps rendered invoices, e.g. in object storage
+type Store interface {
+	// Get returns the object stored under key, or nil if there is none
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put stores data under key, replacing any previous object
+	Put(ctx context.Context, key string, data []byte) error
+}
@@ -0,0 +1,144 @@
+package invoice
+
+import (
+	"context"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for order invoices
+type Service interface {
+	// GetInvoice returns the invoice of an order the requester bought, or of any order for admins
+	// It returns nil while the invoice is being generated; the first request for a
+	// completed order queues generation.
+	GetInvoice(ctx context.Context, requesterID uuid.UUID, isAdmin bool, orderID uuid.UUID) (*Document, error)
+
+	// PrepareInvoice gathers the data for a queued invoice, numbering the order if needed
+	PrepareInvoice(ctx context.Context, orderID uuid.UUID) (*Invoice, error)
+
+	// SaveDocument stores a rendered invoice so later requests are served from storage
+	SaveDocument(ctx context.Context, orderID uuid.UUID, body []byte) error
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo       Repository
+	store      Store
+	jobService job.Service
+	settings   Settings
+	branding   BrandingLookup // Styles invoices for their organizer; nil uses the default styling
+	clock      clock.Clock    // Tells the time invoice numbers are assigned at
+}
+
+// BrandingLookup returns an organizer's branding, or nil when they have none
+type BrandingLookup func(ctx context.Context, organizerID uuid.UUID) (*Branding, error)
+
+// ServiceOption configures optional invoice service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithBranding styles invoices with the branding of the event's organizer
+func WithBranding(lookup BrandingLookup) ServiceOption {
+	return func(s *serviceImpl) {
+		s.branding = lookup
+	}
+}
+
+// WithClock assigns invoice numbers at the time c tells instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// NewService creates a new invoice service instance
+func NewService(repo Repository, store Store, jobService job.Service, settings Settings, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repo:       repo,
+		store:      store,
+		jobService: jobService,
+		settings:   settings,
+		clock:      clock.System{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// GetInvoice returns the stored invoice of an order, queueing its generation on first request
+func (s *serviceImpl) GetInvoice(ctx context.Context, requesterID uuid.UUID, isAdmin bool, orderID uuid.UUID) (*Document, error) {
+	src, err := s.repo.GetSource(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if src.UserID != requesterID && !isAdmin {
+		return nil, ErrForbidden
+	}
+	if src.Status != order.StatusCompleted {
+		return nil, ErrOrderNotCompleted
+	}
+
+	if src.InvoiceNumber != nil {
+		body, err := s.store.Get(ctx, StorageKey(orderID))
+		if err != nil {
+			return nil, NewInvoiceError(ErrInvoiceStorageFailed, err)
+		}
+		if body != nil {
+			return &Document{Number: FormatNumber(*src.InvoiceNumber), ContentType: ContentType, Body: body}, nil
+		}
+		// Numbered but not stored yet: generation is queued or running
+		return nil, nil
+	}
+
+	// Queue before numbering, so a failed enqueue leaves the order to be retried by the
+	// next request. Concurrent first requests may queue twice; generation is idempotent.
+	if _, err := s.jobService.Enqueue(ctx, JobTypeGenerate, GeneratePayload{OrderID: orderID}); err != nil {
+		return nil, NewInvoiceError(ErrInvoiceSchedulingFailed, err)
+	}
+	if _, err := s.repo.AssignNumber(ctx, orderID, s.clock.Now()); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// PrepareInvoice gathers the data for a queued invoice
+func (s *serviceImpl) PrepareInvoice(ctx context.Context, orderID uuid.UUID) (*Invoice, error) {
+	src, err := s.repo.GetSource(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if src.Status != order.StatusCompleted {
+		return nil, ErrOrderNotCompleted
+	}
+
+	if src.InvoiceNumber == nil {
+		// The request that queued the job failed to number the order
+		if _, err := s.repo.AssignNumber(ctx, orderID, s.clock.Now()); err != nil {
+			return nil, err
+		}
+		if src, err = s.repo.GetSource(ctx, orderID); err != nil {
+			return nil, err
+		}
+	}
+
+	inv := Build(src, s.settings)
+	if s.branding != nil {
+		// Invoices are stored once, so a failed lookup is retried rather than rendered unbranded
+		if inv.Branding, err = s.branding(ctx, src.OrganizerID); err != nil {
+			return nil, NewInvoiceError(ErrInvoiceRetrievalFailed, err)
+		}
+	}
+	return inv, nil
+}
+
+// SaveDocument stores a rendered invoice
+func (s *serviceImpl) SaveDocument(ctx context.Context, orderID uuid.UUID, body []byte) error {
+	if err := s.store.Put(ctx, StorageKey(orderID), body); err != nil {
+		return NewInvoiceError(ErrInvoiceStorageFailed, err)
+	}
+	return nil
+}
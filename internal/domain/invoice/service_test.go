@@ -0,0 +1,239 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetSource(ctx context.Context, orderID uuid.UUID) (*Source, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Source), args.Error(1)
+}
+
+func (m *MockRepository) AssignNumber(ctx context.Context, orderID uuid.UUID, issuedAt time.Time) (bool, error) {
+	args := m.Called(ctx, orderID, issuedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+// memoryStore is an in-memory Store
+type memoryStore map[string][]byte
+
+func (s memoryStore) Get(ctx context.Context, key string) ([]byte, error) {
+	return s[key], nil
+}
+
+func (s memoryStore) Put(ctx context.Context, key string, data []byte) error {
+	s[key] = data
+	return nil
+}
+
+// MockJobService is a mock implementation of job.Service interface
+type MockJobService struct {
+	mock.Mock
+}
+
+func (m *MockJobService) Enqueue(ctx context.Context, jobType string, payload interface{}, opts ...job.EnqueueOption) (*job.Job, error) {
+	args := m.Called(ctx, jobType, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) GetJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) ListJobs(ctx context.Context, status string, limit int) ([]*job.Job, error) {
+	args := m.Called(ctx, status, limit)
+	return args.Get(0).([]*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) RetryJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func completedSource(orderID, userID uuid.UUID, number *int64) *Source {
+	return &Source{
+		OrderID:     orderID,
+		UserID:      userID,
+		Status:      order.StatusCompleted,
+		Quantity:    2,
+		TotalAmount: 120,
+		OrderedAt:   time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC),
+		BuyerName:   "buyer",
+		BuyerEmail:  "buyer@example.com",
+		EventTitle:  "Go Conference",
+		EventDate:   time.Date(2026, 6, 1, 18, 0, 0, 0, time.UTC),
+		VenueName:   "Main Hall",
+
+		InvoiceNumber: number,
+	}
+}
+
+func TestBuild(t *testing.T) {
+	number := int64(42)
+	issuedAt := time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC)
+	src := completedSource(uuid.New(), uuid.New(), &number)
+	src.InvoiceIssuedAt = &issuedAt
+
+	inv := Build(src, Settings{TaxLabel: "VAT", TaxRate: 0.2, Currency: "EUR"})
+
+	assert.Equal(t, "INV-000042", inv.Number)
+	assert.Equal(t, issuedAt, inv.IssuedAt)
+	require.Len(t, inv.Lines, 1)
+	assert.Equal(t, 2, inv.Lines[0].Quantity)
+	assert.Equal(t, 60.0, inv.Lines[0].UnitPrice)
+	assert.Contains(t, inv.Lines[0].Description, "Go Conference")
+	assert.Contains(t, inv.Lines[0].Description, "Main Hall")
+	assert.Equal(t, 100.0, inv.Subtotal)
+	assert.Equal(t, 20.0, inv.TaxAmount)
+	assert.Equal(t, 120.0, inv.Total)
+}
+
+func TestInvoiceService_GetInvoice(t *testing.T) {
+	orderID := uuid.New()
+	buyerID := uuid.New()
+	number := int64(7)
+
+	t.Run("first request queues generation and numbers the order", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobs := new(MockJobService)
+		repo.On("GetSource", mock.Anything, orderID).Return(completedSource(orderID, buyerID, nil), nil)
+		jobs.On("Enqueue", mock.Anything, JobTypeGenerate, GeneratePayload{OrderID: orderID}).Return(&job.Job{ID: uuid.New()}, nil)
+		repo.On("AssignNumber", mock.Anything, orderID, mock.AnythingOfType("time.Time")).Return(true, nil)
+
+		doc, err := NewService(repo, memoryStore{}, jobs, Settings{}).GetInvoice(context.Background(), buyerID, false, orderID)
+
+		assert.NoError(t, err)
+		assert.Nil(t, doc)
+		repo.AssertExpectations(t)
+		jobs.AssertExpectations(t)
+	})
+
+	t.Run("failed enqueue leaves the order unnumbered", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobs := new(MockJobService)
+		repo.On("GetSource", mock.Anything, orderID).Return(completedSource(orderID, buyerID, nil), nil)
+		jobs.On("Enqueue", mock.Anything, JobTypeGenerate, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := NewService(repo, memoryStore{}, jobs, Settings{}).GetInvoice(context.Background(), buyerID, false, orderID)
+
+		assert.Equal(t, "INVOICE_SCHEDULING_FAILED", GetInvoiceErrorCode(err))
+		repo.AssertNotCalled(t, "AssignNumber", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("pending while numbered but not stored", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetSource", mock.Anything, orderID).Return(completedSource(orderID, buyerID, &number), nil)
+
+		doc, err := NewService(repo, memoryStore{}, nil, Settings{}).GetInvoice(context.Background(), buyerID, false, orderID)
+
+		assert.NoError(t, err)
+		assert.Nil(t, doc)
+	})
+
+	t.Run("stored invoice is returned", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetSource", mock.Anything, orderID).Return(completedSource(orderID, buyerID, &number), nil)
+		store := memoryStore{StorageKey(orderID): []byte("<html>")}
+
+		doc, err := NewService(repo, store, nil, Settings{}).GetInvoice(context.Background(), uuid.New(), true, orderID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "INV-000007", doc.Number)
+		assert.Equal(t, ContentType, doc.ContentType)
+		assert.Equal(t, "<html>", string(doc.Body))
+	})
+
+	t.Run("other users are forbidden", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetSource", mock.Anything, orderID).Return(completedSource(orderID, buyerID, &number), nil)
+
+		_, err := NewService(repo, memoryStore{}, nil, Settings{}).GetInvoice(context.Background(), uuid.New(), false, orderID)
+
+		assert.True(t, IsForbiddenError(err))
+	})
+
+	t.Run("pending orders have no invoice", func(t *testing.T) {
+		repo := new(MockRepository)
+		src := completedSource(orderID, buyerID, nil)
+		src.Status = order.StatusPending
+		repo.On("GetSource", mock.Anything, orderID).Return(src, nil)
+
+		_, err := NewService(repo, memoryStore{}, nil, Settings{}).GetInvoice(context.Background(), buyerID, false, orderID)
+
+		assert.True(t, IsOrderNotCompletedError(err))
+	})
+}
+
+func TestInvoiceService_PrepareInvoice_NumbersUnnumberedOrder(t *testing.T) {
+	orderID := uuid.New()
+	number := int64(3)
+	repo := new(MockRepository)
+	repo.On("GetSource", mock.Anything, orderID).Return(completedSource(orderID, uuid.New(), nil), nil).Once()
+	repo.On("AssignNumber", mock.Anything, orderID, mock.AnythingOfType("time.Time")).Return(true, nil)
+	repo.On("GetSource", mock.Anything, orderID).Return(completedSource(orderID, uuid.New(), &number), nil).Once()
+
+	inv, err := NewService(repo, memoryStore{}, nil, Settings{}).PrepareInvoice(context.Background(), orderID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "INV-000003", inv.Number)
+	repo.AssertExpectations(t)
+}
+
+func TestInvoiceService_PrepareInvoice_Branding(t *testing.T) {
+	orderID := uuid.New()
+	number := int64(3)
+	src := completedSource(orderID, uuid.New(), &number)
+	src.OrganizerID = uuid.New()
+	brand := &Branding{PrimaryColor: "#1a2b3c"}
+
+	t.Run("styles the invoice for the organizer", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetSource", mock.Anything, orderID).Return(src, nil)
+		var lookedUp uuid.UUID
+		lookup := func(ctx context.Context, organizerID uuid.UUID) (*Branding, error) {
+			lookedUp = organizerID
+			return brand, nil
+		}
+
+		inv, err := NewService(repo, memoryStore{}, nil, Settings{}, WithBranding(lookup)).PrepareInvoice(context.Background(), orderID)
+
+		require.NoError(t, err)
+		assert.Equal(t, src.OrganizerID, lookedUp)
+		assert.Equal(t, brand, inv.Branding)
+	})
+
+	t.Run("failed lookups are retried rather than rendered unbranded", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetSource", mock.Anything, orderID).Return(src, nil)
+		lookup := func(ctx context.Context, organizerID uuid.UUID) (*Branding, error) {
+			return nil, errors.New("connection refused")
+		}
+
+		_, err := NewService(repo, memoryStore{}, nil, Settings{}, WithBranding(lookup)).PrepareInvoice(context.Background(), orderID)
+
+		assert.Equal(t, ErrInvoiceRetrievalFailed.Code, GetInvoiceErrorCode(err))
+	})
+}
@@ -0,0 +1,78 @@
+package ipaccess
+
+import (
+	"errors"
+	"fmt"
+)
+
+// IPAccessError represents domain-specific admin IP rule errors
+type IPAccessError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *IPAccessError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *IPAccessError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined IP access domain errors
+var (
+	ErrInvalidCIDR         = &IPAccessError{Code: "INVALID_CIDR", Message: "address must be a CIDR range or a single IP address"}
+	ErrInvalidAction       = &IPAccessError{Code: "INVALID_RULE_ACTION", Message: "action must be ALLOW or DENY"}
+	ErrRuleExists          = &IPAccessError{Code: "IP_RULE_EXISTS", Message: "a rule for this range already exists"}
+	ErrRuleNotFound        = &IPAccessError{Code: "IP_RULE_NOT_FOUND", Message: "IP rule not found"}
+	ErrSelfLockout         = &IPAccessError{Code: "IP_RULE_LOCKOUT", Message: "this change would block your own address from admin routes"}
+	ErrRuleRetrievalFailed = &IPAccessError{Code: "IP_RULE_RETRIEVAL_FAILED", Message: "failed to retrieve IP rules"}
+	ErrRuleSaveFailed      = &IPAccessError{Code: "IP_RULE_SAVE_FAILED", Message: "failed to save IP rule"}
+	ErrRuleDeleteFailed    = &IPAccessError{Code: "IP_RULE_DELETE_FAILED", Message: "failed to delete IP rule"}
+)
+
+// NewIPAccessError creates a new IPAccessError with a cause
+func NewIPAccessError(baseError *IPAccessError, cause error) *IPAccessError {
+	return &IPAccessError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetIPAccessErrorCode extracts the error code from an IPAccessError
+func GetIPAccessErrorCode(err error) string {
+	var accessErr *IPAccessError
+	if errors.As(err, &accessErr) {
+		return accessErr.Code
+	}
+	return ""
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetIPAccessErrorCode(err) {
+	case "INVALID_CIDR", "INVALID_RULE_ACTION":
+		return true
+	}
+	return false
+}
+
+// IsRuleExistsError checks if an error is a "rule already exists" error
+func IsRuleExistsError(err error) bool {
+	return GetIPAccessErrorCode(err) == "IP_RULE_EXISTS"
+}
+
+// IsRuleNotFoundError checks if an error is a "rule not found" error
+func IsRuleNotFoundError(err error) bool {
+	return GetIPAccessErrorCode(err) == "IP_RULE_NOT_FOUND"
+}
+
+// IsSelfLockoutError checks if an error is because a change would block the caller
+func IsSelfLockoutError(err error) bool {
+	return GetIPAccessErrorCode(err) == "IP_RULE_LOCKOUT"
+}
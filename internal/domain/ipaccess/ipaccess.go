@@ -0,0 +1,126 @@
+package ipaccess
+
+import (
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Rule actions
+const (
+	ActionAllow = "ALLOW" // Once any allow rule exists, only matching addresses are let through
+	ActionDeny  = "DENY"  // Matching addresses are rejected, even if an allow rule also matches
+)
+
+// IsValidAction checks if a rule action is known
+func IsValidAction(action string) bool {
+	return action == ActionAllow || action == ActionDeny
+}
+
+// Rule is an address range allowed or denied access to admin routes, managed through the API
+// Rules from configuration apply as well but are not stored.
+type Rule struct {
+	ID          uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	CIDR        string     `gorm:"column:cidr;size:50;not null" json:"cidr"` // Canonical form, e.g. "10.0.0.0/8" or "203.0.113.7/32"
+	Action      string     `gorm:"size:10;not null" json:"action"`
+	Description string     `gorm:"size:255" json:"description,omitempty"`
+	CreatedBy   *uuid.UUID `gorm:"type:uuid" json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Rule) TableName() string {
+	return "admin_ip_rules"
+}
+
+// ParseCIDR parses a CIDR range or a single address, which becomes a range of one
+// The result is masked, so "10.1.2.3/8" and "10.0.0.0/8" are the same range.
+func ParseCIDR(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// Policy decides which addresses may reach admin routes
+// Deny rules win over allow rules; without allow rules every other address is allowed.
+type Policy struct {
+	allow []netip.Prefix
+	deny  []netip.Prefix
+}
+
+// NewPolicy builds a policy from CIDR ranges or single addresses
+func NewPolicy(allow, deny []string) (*Policy, error) {
+	p := &Policy{}
+	for _, s := range allow {
+		prefix, err := ParseCIDR(s)
+		if err != nil {
+			return nil, NewIPAccessError(ErrInvalidCIDR, err)
+		}
+		p.allow = append(p.allow, prefix)
+	}
+	for _, s := range deny {
+		prefix, err := ParseCIDR(s)
+		if err != nil {
+			return nil, NewIPAccessError(ErrInvalidCIDR, err)
+		}
+		p.deny = append(p.deny, prefix)
+	}
+	return p, nil
+}
+
+// withRules returns a copy of the policy extended with stored rules
+// Stored rules were validated when they were added, so unparsable ones are skipped.
+func (p *Policy) withRules(rules []*Rule) *Policy {
+	combined := &Policy{
+		allow: append([]netip.Prefix(nil), p.allow...),
+		deny:  append([]netip.Prefix(nil), p.deny...),
+	}
+	for _, rule := range rules {
+		prefix, err := ParseCIDR(rule.CIDR)
+		if err != nil {
+			continue
+		}
+		if rule.Action == ActionDeny {
+			combined.deny = append(combined.deny, prefix)
+		} else {
+			combined.allow = append(combined.allow, prefix)
+		}
+	}
+	return combined
+}
+
+// Allows reports whether the address may reach admin routes; unparsable addresses never may
+func (p *Policy) Allows(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, prefix := range p.deny {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	if len(p.allow) == 0 {
+		return true
+	}
+	for _, prefix := range p.allow {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
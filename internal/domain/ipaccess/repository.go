@@ -0,0 +1,19 @@
+package ipaccess
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for admin IP rule data access
+type Repository interface {
+	// CreateRule stores a new rule; a rule for the same range returns ErrRuleExists
+	CreateRule(ctx context.Context, rule *Rule) error
+
+	// ListRules retrieves every stored rule, oldest first
+	ListRules(ctx context.Context) ([]*Rule, error)
+
+	// DeleteRule removes a rule; an unknown ID returns ErrRuleNotFound
+	DeleteRule(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,179 @@
+package ipaccess
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for admin IP rules
+type Service interface {
+	// ListRules lists the rules managed through the API, oldest first
+	ListRules(ctx context.Context) ([]*Rule, error)
+
+	// AddRule stores a rule; it fails with ErrSelfLockout if the caller's address would be blocked
+	AddRule(ctx context.Context, cidr, action, description string, createdBy uuid.UUID, callerIP string) (*Rule, error)
+
+	// DeleteRule removes a rule; it fails with ErrSelfLockout if the caller's address would be blocked
+	DeleteRule(ctx context.Context, id uuid.UUID, callerIP string) error
+
+	// IsAllowed reports whether the address may reach admin routes
+	IsAllowed(ctx context.Context, ip string) (bool, error)
+}
+
+// Settings are the rules from configuration, which always apply and cannot be removed through the API
+type Settings struct {
+	Allow    []string      // CIDR ranges or addresses allowed; empty allows all not denied
+	Deny     []string      // CIDR ranges or addresses denied
+	CacheTTL time.Duration // How long stored rules are reused before reloading, so other instances pick up changes
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo     Repository
+	base     *Policy
+	cacheTTL time.Duration
+	clock    clock.Clock // Tells when cached rules expire
+
+	mu       sync.Mutex
+	cached   *Policy
+	loadedAt time.Time
+}
+
+// ServiceOption configures optional IP access Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock expires cached rules by c instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// NewService creates a new IP access service instance
+// It fails if the configured ranges cannot be parsed, so typos do not silently open admin routes.
+func NewService(repo Repository, settings Settings, opts ...ServiceOption) (Service, error) {
+	base, err := NewPolicy(settings.Allow, settings.Deny)
+	if err != nil {
+		return nil, err
+	}
+	s := &serviceImpl{repo: repo, base: base, cacheTTL: settings.CacheTTL, clock: clock.System{}}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// ListRules lists the stored rules
+func (s *serviceImpl) ListRules(ctx context.Context) ([]*Rule, error) {
+	return s.repo.ListRules(ctx)
+}
+
+// AddRule validates and stores a rule
+func (s *serviceImpl) AddRule(ctx context.Context, cidr, action, description string, createdBy uuid.UUID, callerIP string) (*Rule, error) {
+	prefix, err := ParseCIDR(cidr)
+	if err != nil {
+		return nil, NewIPAccessError(ErrInvalidCIDR, err)
+	}
+	action = strings.ToUpper(strings.TrimSpace(action))
+	if !IsValidAction(action) {
+		return nil, ErrInvalidAction
+	}
+
+	rule := &Rule{
+		CIDR:        prefix.String(),
+		Action:      action,
+		Description: strings.TrimSpace(description),
+		CreatedBy:   &createdBy,
+	}
+
+	rules, err := s.repo.ListRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !s.base.withRules(append(rules, rule)).Allows(callerIP) {
+		return nil, ErrSelfLockout
+	}
+
+	if err := s.repo.CreateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	s.invalidate()
+	return rule, nil
+}
+
+// DeleteRule removes a stored rule
+func (s *serviceImpl) DeleteRule(ctx context.Context, id uuid.UUID, callerIP string) error {
+	rules, err := s.repo.ListRules(ctx)
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]*Rule, 0, len(rules))
+	found := false
+	for _, rule := range rules {
+		if rule.ID == id {
+			found = true
+			continue
+		}
+		remaining = append(remaining, rule)
+	}
+	if !found {
+		return ErrRuleNotFound
+	}
+	if !s.base.withRules(remaining).Allows(callerIP) {
+		return ErrSelfLockout
+	}
+
+	if err := s.repo.DeleteRule(ctx, id); err != nil {
+		return err
+	}
+	s.invalidate()
+	return nil
+}
+
+// IsAllowed checks the address against configured and stored rules
+func (s *serviceImpl) IsAllowed(ctx context.Context, ip string) (bool, error) {
+	policy, err := s.policy(ctx)
+	if err != nil {
+		return false, err
+	}
+	return policy.Allows(ip), nil
+}
+
+// policy returns the combined policy, reloading stored rules once the cache expires
+// If reloading fails the previous rules are kept, since admins cannot fix rules they are locked out of.
+func (s *serviceImpl) policy(ctx context.Context) (*Policy, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != nil && s.clock.Now().Sub(s.loadedAt) < s.cacheTTL {
+		return s.cached, nil
+	}
+
+	rules, err := s.repo.ListRules(ctx)
+	if err != nil {
+		if s.cached != nil {
+			logging.From(ctx).Warn("Failed to reload admin IP rules, keeping previous rules", "error", err)
+			return s.cached, nil
+		}
+		return nil, err
+	}
+
+	s.cached = s.base.withRules(rules)
+	s.loadedAt = s.clock.Now()
+	return s.cached, nil
+}
+
+// invalidate makes the next check reload stored rules
+func (s *serviceImpl) invalidate() {
+	s.mu.Lock()
+	s.loadedAt = time.Time{}
+	s.mu.Unlock()
+}
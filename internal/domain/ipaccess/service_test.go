@@ -0,0 +1,226 @@
+package ipaccess
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) CreateRule(ctx context.Context, rule *Rule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListRules(ctx context.Context) ([]*Rule, error) {
+	args := m.Called(ctx)
+	rules, _ := args.Get(0).([]*Rule)
+	return rules, args.Error(1)
+}
+
+func (m *MockRepository) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestParseCIDR(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		valid    bool
+	}{
+		{input: "10.0.0.0/8", expected: "10.0.0.0/8", valid: true},
+		{input: "10.1.2.3/8", expected: "10.0.0.0/8", valid: true},
+		{input: " 203.0.113.7 ", expected: "203.0.113.7/32", valid: true},
+		{input: "2001:db8::1", expected: "2001:db8::1/128", valid: true},
+		{input: "10.0.0.0/33"},
+		{input: "example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			prefix, err := ParseCIDR(tt.input)
+			if !tt.valid {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, prefix.String())
+		})
+	}
+}
+
+func TestPolicy_Allows(t *testing.T) {
+	t.Run("no rules allow everyone", func(t *testing.T) {
+		policy, err := NewPolicy(nil, nil)
+		require.NoError(t, err)
+		assert.True(t, policy.Allows("198.51.100.1"))
+	})
+
+	t.Run("deny wins over allow", func(t *testing.T) {
+		policy, err := NewPolicy([]string{"10.0.0.0/8"}, []string{"10.0.0.13"})
+		require.NoError(t, err)
+		assert.True(t, policy.Allows("10.1.2.3"))
+		assert.False(t, policy.Allows("10.0.0.13"))
+		assert.False(t, policy.Allows("198.51.100.1"))
+	})
+
+	t.Run("IPv4-mapped IPv6 addresses match IPv4 ranges", func(t *testing.T) {
+		policy, err := NewPolicy([]string{"10.0.0.0/8"}, nil)
+		require.NoError(t, err)
+		assert.True(t, policy.Allows("::ffff:10.1.2.3"))
+	})
+
+	t.Run("unparsable addresses are refused", func(t *testing.T) {
+		policy, err := NewPolicy(nil, nil)
+		require.NoError(t, err)
+		assert.False(t, policy.Allows("not-an-ip"))
+	})
+
+	t.Run("invalid configuration is rejected", func(t *testing.T) {
+		_, err := NewPolicy([]string{"10.0.0.0/40"}, nil)
+		assert.True(t, IsValidationError(err))
+	})
+}
+
+func TestIPAccessService_AddRule(t *testing.T) {
+	adminID := uuid.New()
+
+	t.Run("stores canonical range", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListRules", mock.Anything).Return([]*Rule{}, nil)
+		repo.On("CreateRule", mock.Anything, mock.MatchedBy(func(r *Rule) bool {
+			return r.CIDR == "10.0.0.0/8" && r.Action == ActionAllow && *r.CreatedBy == adminID
+		})).Return(nil)
+		service, err := NewService(repo, Settings{})
+		require.NoError(t, err)
+
+		rule, err := service.AddRule(context.Background(), "10.1.2.3/8", "allow", " VPN ", adminID, "10.9.9.9")
+
+		require.NoError(t, err)
+		assert.Equal(t, "VPN", rule.Description)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("allow rule excluding the caller is refused", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListRules", mock.Anything).Return([]*Rule{}, nil)
+		service, err := NewService(repo, Settings{})
+		require.NoError(t, err)
+
+		_, err = service.AddRule(context.Background(), "10.0.0.0/8", ActionAllow, "", adminID, "198.51.100.1")
+
+		assert.True(t, IsSelfLockoutError(err))
+		repo.AssertNotCalled(t, "CreateRule", mock.Anything, mock.Anything)
+	})
+
+	t.Run("deny rule covering the caller is refused", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListRules", mock.Anything).Return([]*Rule{}, nil)
+		service, err := NewService(repo, Settings{})
+		require.NoError(t, err)
+
+		_, err = service.AddRule(context.Background(), "198.51.100.0/24", ActionDeny, "", adminID, "198.51.100.1")
+
+		assert.True(t, IsSelfLockoutError(err))
+	})
+
+	t.Run("invalid action", func(t *testing.T) {
+		service, err := NewService(new(MockRepository), Settings{})
+		require.NoError(t, err)
+
+		_, err = service.AddRule(context.Background(), "10.0.0.0/8", "BLOCK", "", adminID, "10.0.0.1")
+
+		assert.True(t, IsValidationError(err))
+	})
+}
+
+func TestIPAccessService_DeleteRule(t *testing.T) {
+	office := &Rule{ID: uuid.New(), CIDR: "198.51.100.0/24", Action: ActionAllow}
+	vpn := &Rule{ID: uuid.New(), CIDR: "10.0.0.0/8", Action: ActionAllow}
+
+	t.Run("removing the caller's only allow rule is refused", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListRules", mock.Anything).Return([]*Rule{office, vpn}, nil)
+		service, err := NewService(repo, Settings{})
+		require.NoError(t, err)
+
+		err = service.DeleteRule(context.Background(), office.ID, "198.51.100.1")
+
+		assert.True(t, IsSelfLockoutError(err))
+		repo.AssertNotCalled(t, "DeleteRule", mock.Anything, mock.Anything)
+	})
+
+	t.Run("deletes other rules", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListRules", mock.Anything).Return([]*Rule{office, vpn}, nil)
+		repo.On("DeleteRule", mock.Anything, vpn.ID).Return(nil)
+		service, err := NewService(repo, Settings{})
+		require.NoError(t, err)
+
+		assert.NoError(t, service.DeleteRule(context.Background(), vpn.ID, "198.51.100.1"))
+	})
+
+	t.Run("unknown rule", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListRules", mock.Anything).Return([]*Rule{office}, nil)
+		service, err := NewService(repo, Settings{})
+		require.NoError(t, err)
+
+		assert.True(t, IsRuleNotFoundError(service.DeleteRule(context.Background(), uuid.New(), "198.51.100.1")))
+	})
+}
+
+func TestIPAccessService_IsAllowed(t *testing.T) {
+	t.Run("combines configured and stored rules", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListRules", mock.Anything).Return([]*Rule{{CIDR: "10.0.0.13/32", Action: ActionDeny}}, nil).Once()
+		service, err := NewService(repo, Settings{Allow: []string{"10.0.0.0/8"}, CacheTTL: time.Minute})
+		require.NoError(t, err)
+
+		allowed, err := service.IsAllowed(context.Background(), "10.1.2.3")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = service.IsAllowed(context.Background(), "10.0.0.13")
+		require.NoError(t, err)
+		assert.False(t, allowed, "stored rules are cached between checks")
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("keeps previous rules when reloading fails", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListRules", mock.Anything).Return([]*Rule{{CIDR: "10.0.0.0/8", Action: ActionAllow}}, nil).Once()
+		repo.On("ListRules", mock.Anything).Return(nil, errors.New("db down"))
+		service, err := NewService(repo, Settings{})
+		require.NoError(t, err)
+
+		_, err = service.IsAllowed(context.Background(), "10.1.2.3")
+		require.NoError(t, err)
+		allowed, err := service.IsAllowed(context.Background(), "198.51.100.1")
+
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("fails without any loaded rules", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListRules", mock.Anything).Return(nil, errors.New("db down"))
+		service, err := NewService(repo, Settings{})
+		require.NoError(t, err)
+
+		_, err = service.IsAllowed(context.Background(), "10.1.2.3")
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,82 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// JobError represents domain-specific job errors
+type JobError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *JobError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *JobError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined job domain errors
+var (
+	ErrJobNotFound        = &JobError{Code: "JOB_NOT_FOUND", Message: "job not found"}
+	ErrJobCreationFailed  = &JobError{Code: "JOB_CREATION_FAILED", Message: "failed to enqueue job"}
+	ErrJobUpdateFailed    = &JobError{Code: "JOB_UPDATE_FAILED", Message: "failed to update job"}
+	ErrJobRetrievalFailed = &JobError{Code: "JOB_RETRIEVAL_FAILED", Message: "failed to retrieve job"}
+	ErrJobNotFailed       = &JobError{Code: "JOB_NOT_FAILED", Message: "only failed jobs can be retried"}
+	ErrInvalidJobType     = &JobError{Code: "INVALID_JOB_TYPE", Message: "job type is required"}
+	ErrInvalidJobPayload  = &JobError{Code: "INVALID_JOB_PAYLOAD", Message: "job payload must be JSON encodable"}
+	ErrInvalidJobStatus   = &JobError{Code: "INVALID_JOB_STATUS", Message: "unknown job status"}
+)
+
+// ErrPermanent marks a handler error that must not be retried
+// Handlers wrap it, e.g. fmt.Errorf("%w: event deleted", job.ErrPermanent)
+var ErrPermanent = errors.New("permanent job failure")
+
+// NewJobError creates a new JobError with a cause
+func NewJobError(baseError *JobError, cause error) *JobError {
+	return &JobError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// NewJobNotFoundError creates a specific error for job not found
+func NewJobNotFoundError(id uuid.UUID) *JobError {
+	return &JobError{
+		Code:    "JOB_NOT_FOUND",
+		Message: fmt.Sprintf("job with ID %s not found", id),
+	}
+}
+
+// GetJobErrorCode extracts the error code from a JobError
+func GetJobErrorCode(err error) string {
+	var jobErr *JobError
+	if errors.As(err, &jobErr) {
+		return jobErr.Code
+	}
+	return ""
+}
+
+// IsJobNotFoundError checks if an error is a "not found" error
+func IsJobNotFoundError(err error) bool {
+	return GetJobErrorCode(err) == "JOB_NOT_FOUND"
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetJobErrorCode(err) {
+	case "JOB_NOT_FAILED", "INVALID_JOB_TYPE", "INVALID_JOB_PAYLOAD", "INVALID_JOB_STATUS":
+		return true
+	}
+	return false
+}
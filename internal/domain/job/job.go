@@ -0,0 +1,49 @@
+package job
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Job is a unit of background work persisted in the jobs table
+// Jobs are claimed by workers, retried with backoff on failure and moved to
+// FAILED (the dead-letter state) once they run out of attempts.
+type Job struct {
+	ID          uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	Type        string          `gorm:"not null;size:100;index" json:"type"`                    // Selects the handler that runs the job
+	Payload     json.RawMessage `gorm:"type:jsonb;not null;default:'{}'" json:"payload"`        // Handler-specific arguments
+	Status      string          `gorm:"not null;size:20;default:'PENDING';index" json:"status"` // One of the Status* constants
+	Attempts    int             `gorm:"not null;default:0" json:"attempts"`                     // Times the job has been claimed
+	MaxAttempts int             `gorm:"not null;default:5" json:"max_attempts"`                 // Attempts before the job is dead-lettered
+	RunAt       time.Time       `gorm:"not null;index" json:"run_at"`                           // Earliest time the job may run
+	LockedAt    *time.Time      `json:"locked_at,omitempty"`                                    // When a worker claimed the job
+	LastError   string          `gorm:"type:text" json:"last_error,omitempty"`                  // Error from the most recent failed attempt
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Job statuses
+const (
+	StatusPending   = "PENDING"   // Waiting for RunAt and a free worker
+	StatusRunning   = "RUNNING"   // Claimed by a worker
+	StatusSucceeded = "SUCCEEDED" // Completed successfully
+	StatusFailed    = "FAILED"    // Dead-lettered after exhausting attempts or a permanent error
+)
+
+// TableName tells GORM what table to use for this model
+func (Job) TableName() string {
+	return "jobs"
+}
+
+// IsFailed checks if the job has been dead-lettered
+func (j *Job) IsFailed() bool {
+	return j.Status == StatusFailed
+}
+
+// HasAttemptsLeft checks if the job may be retried after a failed attempt
+func (j *Job) HasAttemptsLeft() bool {
+	return j.Attempts < j.MaxAttempts
+}
@@ -0,0 +1,40 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for job queue operations
+type Repository interface {
+	// Create enqueues a new job
+	Create(ctx context.Context, job *Job) error
+
+	// GetByID retrieves a job by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Job, error)
+
+	// GetByStatus retrieves the most recently updated jobs with the given status
+	GetByStatus(ctx context.Context, status string, limit int) ([]*Job, error)
+
+	// ClaimNext atomically marks the next due pending job as running and returns it
+	// Returns nil when no job is due
+	ClaimNext(ctx context.Context) (*Job, error)
+
+	// MarkSucceeded records a successful run
+	MarkSucceeded(ctx context.Context, id uuid.UUID) error
+
+	// MarkRetry puts a job back in the queue to run again at runAt
+	MarkRetry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error
+
+	// MarkFailed dead-letters a job
+	MarkFailed(ctx context.Context, id uuid.UUID, lastError string) error
+
+	// Requeue moves a failed job back to pending with a fresh set of attempts
+	Requeue(ctx context.Context, id uuid.UUID) error
+
+	// ReleaseStale returns running jobs locked before cutoff to the queue
+	// Used to recover jobs whose worker died mid-run
+	ReleaseStale(ctx context.Context, cutoff time.Time) (int64, error)
+}
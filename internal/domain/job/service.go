@@ -0,0 +1,155 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"enterprise-crud/internal/domain/clock"
+
+	"github.com/google/uuid"
+)
+
+// DefaultMaxAttempts is used when a job is enqueued without WithMaxAttempts
+const DefaultMaxAttempts = 5
+
+// Service defines the business logic interface for background jobs
+type Service interface {
+	// Enqueue schedules a job of the given type; payload is JSON encoded
+	Enqueue(ctx context.Context, jobType string, payload interface{}, opts ...EnqueueOption) (*Job, error)
+
+	// GetJob retrieves a job by its ID
+	GetJob(ctx context.Context, id uuid.UUID) (*Job, error)
+
+	// ListJobs retrieves jobs with the given status, most recently updated first
+	ListJobs(ctx context.Context, status string, limit int) ([]*Job, error)
+
+	// RetryJob puts a failed job back in the queue
+	RetryJob(ctx context.Context, id uuid.UUID) (*Job, error)
+}
+
+// EnqueueOption customises a job at enqueue time
+type EnqueueOption func(*Job)
+
+// WithDelay delays the first run of a job
+func WithDelay(delay time.Duration) EnqueueOption {
+	return func(j *Job) {
+		j.RunAt = j.RunAt.Add(delay)
+	}
+}
+
+// WithRunAt schedules the first run of a job at a specific time
+func WithRunAt(runAt time.Time) EnqueueOption {
+	return func(j *Job) {
+		j.RunAt = runAt
+	}
+}
+
+// WithMaxAttempts overrides how many times a job is attempted before it is dead-lettered
+func WithMaxAttempts(maxAttempts int) EnqueueOption {
+	return func(j *Job) {
+		j.MaxAttempts = maxAttempts
+	}
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo  Repository
+	clock clock.Clock       // Tells the time jobs become due
+	ids   clock.IDGenerator // Creates the IDs of new jobs
+}
+
+// ServiceOption configures optional job Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock schedules jobs by c instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// WithIDGenerator creates the IDs of new jobs with ids instead of at random
+func WithIDGenerator(ids clock.IDGenerator) ServiceOption {
+	return func(s *serviceImpl) {
+		s.ids = ids
+	}
+}
+
+// NewService creates a new job service instance
+func NewService(repo Repository, opts ...ServiceOption) Service {
+	s := &serviceImpl{repo: repo, clock: clock.System{}, ids: clock.RandomIDs{}}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Enqueue schedules a job of the given type
+func (s *serviceImpl) Enqueue(ctx context.Context, jobType string, payload interface{}, opts ...EnqueueOption) (*Job, error) {
+	if jobType == "" {
+		return nil, ErrInvalidJobType
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, NewJobError(ErrInvalidJobPayload, err)
+	}
+
+	j := &Job{
+		ID:          s.ids.NewID(),
+		Type:        jobType,
+		Payload:     data,
+		Status:      StatusPending,
+		MaxAttempts: DefaultMaxAttempts,
+		RunAt:       s.clock.Now(),
+	}
+	for _, opt := range opts {
+		opt(j)
+	}
+	if j.MaxAttempts < 1 {
+		j.MaxAttempts = 1
+	}
+
+	if err := s.repo.Create(ctx, j); err != nil {
+		return nil, err // Repository already returns custom error
+	}
+	return j, nil
+}
+
+// GetJob retrieves a job by its ID
+func (s *serviceImpl) GetJob(ctx context.Context, id uuid.UUID) (*Job, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// ListJobs retrieves jobs with the given status
+func (s *serviceImpl) ListJobs(ctx context.Context, status string, limit int) ([]*Job, error) {
+	switch status {
+	case StatusPending, StatusRunning, StatusSucceeded, StatusFailed:
+	default:
+		return nil, ErrInvalidJobStatus
+	}
+
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	return s.repo.GetByStatus(ctx, status, limit)
+}
+
+// RetryJob puts a failed job back in the queue
+func (s *serviceImpl) RetryJob(ctx context.Context, id uuid.UUID) (*Job, error) {
+	j, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !j.IsFailed() {
+		return nil, ErrJobNotFailed
+	}
+
+	if err := s.repo.Requeue(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
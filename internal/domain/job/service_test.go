@@ -0,0 +1,156 @@
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/clock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, j *Job) error {
+	args := m.Called(ctx, j)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (*Job, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Job), args.Error(1)
+}
+
+func (m *MockRepository) GetByStatus(ctx context.Context, status string, limit int) ([]*Job, error) {
+	args := m.Called(ctx, status, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Job), args.Error(1)
+}
+
+func (m *MockRepository) ClaimNext(ctx context.Context) (*Job, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Job), args.Error(1)
+}
+
+func (m *MockRepository) MarkSucceeded(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkRetry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error {
+	args := m.Called(ctx, id, runAt, lastError)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkFailed(ctx context.Context, id uuid.UUID, lastError string) error {
+	args := m.Called(ctx, id, lastError)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Requeue(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ReleaseStale(ctx context.Context, cutoff time.Time) (int64, error) {
+	args := m.Called(ctx, cutoff)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestJobService_Enqueue(t *testing.T) {
+	repo := new(MockRepository)
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil)
+
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	service := NewService(repo, WithClock(clock.NewFake(now)), WithIDGenerator(&clock.SequentialIDs{}))
+	j, err := service.Enqueue(context.Background(), "send_email", map[string]string{"to": "a@example.com"},
+		WithDelay(time.Hour), WithMaxAttempts(3))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", j.ID.String())
+	assert.Equal(t, "send_email", j.Type)
+	assert.Equal(t, StatusPending, j.Status)
+	assert.Equal(t, 3, j.MaxAttempts)
+	assert.JSONEq(t, `{"to":"a@example.com"}`, string(j.Payload))
+	assert.Equal(t, now.Add(time.Hour), j.RunAt)
+	repo.AssertExpectations(t)
+}
+
+func TestJobService_Enqueue_Defaults(t *testing.T) {
+	repo := new(MockRepository)
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil)
+
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	j, err := NewService(repo, WithClock(clock.NewFake(now))).Enqueue(context.Background(), "send_email", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultMaxAttempts, j.MaxAttempts)
+	assert.Equal(t, now, j.RunAt)
+}
+
+func TestJobService_Enqueue_InvalidInput(t *testing.T) {
+	repo := new(MockRepository)
+	service := NewService(repo)
+
+	_, err := service.Enqueue(context.Background(), "", nil)
+	assert.Equal(t, ErrInvalidJobType, err)
+
+	_, err = service.Enqueue(context.Background(), "send_email", make(chan int))
+	assert.True(t, IsValidationError(err))
+
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestJobService_ListJobs(t *testing.T) {
+	repo := new(MockRepository)
+	repo.On("GetByStatus", mock.Anything, StatusFailed, 100).Return([]*Job{}, nil)
+	service := NewService(repo)
+
+	_, err := service.ListJobs(context.Background(), StatusFailed, 0)
+	assert.NoError(t, err)
+
+	_, err = service.ListJobs(context.Background(), "BOGUS", 10)
+	assert.Equal(t, ErrInvalidJobStatus, err)
+	repo.AssertExpectations(t)
+}
+
+func TestJobService_RetryJob(t *testing.T) {
+	id := uuid.New()
+
+	t.Run("requeues failed job", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetByID", mock.Anything, id).Return(&Job{ID: id, Status: StatusFailed}, nil).Once()
+		repo.On("Requeue", mock.Anything, id).Return(nil)
+		repo.On("GetByID", mock.Anything, id).Return(&Job{ID: id, Status: StatusPending}, nil).Once()
+
+		j, err := NewService(repo).RetryJob(context.Background(), id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, StatusPending, j.Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects job that has not failed", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetByID", mock.Anything, id).Return(&Job{ID: id, Status: StatusRunning}, nil)
+
+		_, err := NewService(repo).RetryJob(context.Background(), id)
+
+		assert.Equal(t, ErrJobNotFailed, err)
+		repo.AssertNotCalled(t, "Requeue", mock.Anything, mock.Anything)
+	})
+}
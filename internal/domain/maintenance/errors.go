@@ -0,0 +1,65 @@
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaintenanceError represents domain-specific maintenance mode errors
+type MaintenanceError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *MaintenanceError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *MaintenanceError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined maintenance domain errors
+var (
+	ErrInvalidWindow        = &MaintenanceError{Code: "INVALID_MAINTENANCE_WINDOW", Message: "ends_at must be after starts_at and in the future"}
+	ErrMessageTooLong       = &MaintenanceError{Code: "MAINTENANCE_MESSAGE_TOO_LONG", Message: fmt.Sprintf("message must be at most %d characters", MaxMessageLength)}
+	ErrNoWindow             = &MaintenanceError{Code: "MAINTENANCE_WINDOW_NOT_FOUND", Message: "no maintenance window is scheduled"}
+	ErrStateRetrievalFailed = &MaintenanceError{Code: "MAINTENANCE_RETRIEVAL_FAILED", Message: "failed to retrieve maintenance mode"}
+	ErrStateSaveFailed      = &MaintenanceError{Code: "MAINTENANCE_SAVE_FAILED", Message: "failed to save maintenance mode"}
+)
+
+// NewMaintenanceError creates a new MaintenanceError with a cause
+func NewMaintenanceError(baseError *MaintenanceError, cause error) *MaintenanceError {
+	return &MaintenanceError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetMaintenanceErrorCode extracts the error code from a MaintenanceError
+func GetMaintenanceErrorCode(err error) string {
+	var maintenanceErr *MaintenanceError
+	if errors.As(err, &maintenanceErr) {
+		return maintenanceErr.Code
+	}
+	return ""
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetMaintenanceErrorCode(err) {
+	case "INVALID_MAINTENANCE_WINDOW", "MAINTENANCE_MESSAGE_TOO_LONG":
+		return true
+	}
+	return false
+}
+
+// IsNoWindowError checks if an error is because no window is scheduled
+func IsNoWindowError(err error) bool {
+	return GetMaintenanceErrorCode(err) == "MAINTENANCE_WINDOW_NOT_FOUND"
+}
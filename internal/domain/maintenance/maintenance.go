@@ -0,0 +1,48 @@
+package maintenance
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sources of maintenance mode, reported so admins can tell why writes are rejected
+const (
+	SourceConfig = "config" // maintenance.enabled in configuration
+	SourceSwitch = "switch" // the admin switch
+	SourceWindow = "window" // a scheduled window
+)
+
+// MaxMessageLength is the longest message shown to clients, in characters
+const MaxMessageLength = 500
+
+// Window is a scheduled period of maintenance
+type Window struct {
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+	Message  string    `json:"message,omitempty"`
+}
+
+// Contains reports whether now falls within the window; the end is exclusive
+func (w *Window) Contains(now time.Time) bool {
+	return !now.Before(w.StartsAt) && now.Before(w.EndsAt)
+}
+
+// State is the admin switch and the scheduled window, shared by every instance
+type State struct {
+	Enabled   bool       `json:"enabled"`
+	Message   string     `json:"message,omitempty"`
+	Window    *Window    `json:"window,omitempty"`
+	UpdatedBy *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// Status is whether maintenance mode is in force at a moment, and why
+type Status struct {
+	Active  bool
+	Source  string     // One of the Source constants; empty when inactive
+	Message string     // Shown to clients whose writes are rejected
+	EndsAt  *time.Time // End of the active window; nil when maintenance has no known end
+	Window  *Window    // The active or upcoming window, if any
+	State   State
+}
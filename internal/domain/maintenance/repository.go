@@ -0,0 +1,13 @@
+package maintenance
+
+import "context"
+
+// Repository defines the contract for storing the maintenance state
+// Every instance must see the same state, so production stores it in Redis.
+type Repository interface {
+	// Get retrieves the state; a state never saved is returned as the zero State
+	Get(ctx context.Context) (*State, error)
+
+	// Save replaces the state
+	Save(ctx context.Context, state *State) error
+}
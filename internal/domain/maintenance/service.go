@@ -0,0 +1,203 @@
+package maintenance
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for maintenance mode
+type Service interface {
+	// Status reports whether maintenance mode is in force now
+	Status(ctx context.Context) (*Status, error)
+
+	// SetEnabled turns the admin switch on or off; an empty message uses the configured one
+	SetEnabled(ctx context.Context, enabled bool, message string, adminID uuid.UUID) (*Status, error)
+
+	// ScheduleWindow schedules a maintenance window, replacing the one scheduled before
+	ScheduleWindow(ctx context.Context, window Window, adminID uuid.UUID) (*Status, error)
+
+	// CancelWindow removes the scheduled window; it fails with ErrNoWindow if none is scheduled
+	CancelWindow(ctx context.Context, adminID uuid.UUID) (*Status, error)
+}
+
+// Settings are the maintenance settings from configuration
+type Settings struct {
+	Enabled  bool          // Force maintenance mode on whatever the switch says
+	Message  string        // Shown when the switch or window has no message of its own
+	CacheTTL time.Duration // How long the stored state is reused before reloading, so other instances pick up changes
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo     Repository
+	settings Settings
+	clock    clock.Clock // Tells the time windows and the cache are checked against
+
+	mu       sync.Mutex
+	cached   *State
+	loadedAt time.Time
+}
+
+// ServiceOption configures optional maintenance Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock checks windows and the cache against c instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// NewService creates a new maintenance service instance
+func NewService(repo Repository, settings Settings, opts ...ServiceOption) Service {
+	s := &serviceImpl{repo: repo, settings: settings, clock: clock.System{}}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Status works out the maintenance mode in force now from configuration and the stored state
+func (s *serviceImpl) Status(ctx context.Context) (*Status, error) {
+	state, err := s.state(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.statusAt(state, s.clock.Now()), nil
+}
+
+// SetEnabled turns the admin switch on or off
+func (s *serviceImpl) SetEnabled(ctx context.Context, enabled bool, message string, adminID uuid.UUID) (*Status, error) {
+	message, err := cleanMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	return s.update(ctx, adminID, func(state *State) error {
+		state.Enabled = enabled
+		state.Message = message
+		return nil
+	})
+}
+
+// ScheduleWindow validates and stores a maintenance window
+func (s *serviceImpl) ScheduleWindow(ctx context.Context, window Window, adminID uuid.UUID) (*Status, error) {
+	if !window.EndsAt.After(window.StartsAt) || !window.EndsAt.After(s.clock.Now()) {
+		return nil, ErrInvalidWindow
+	}
+	message, err := cleanMessage(window.Message)
+	if err != nil {
+		return nil, err
+	}
+	window = Window{StartsAt: window.StartsAt.UTC(), EndsAt: window.EndsAt.UTC(), Message: message}
+
+	return s.update(ctx, adminID, func(state *State) error {
+		state.Window = &window
+		return nil
+	})
+}
+
+// CancelWindow removes the scheduled window
+// A window that already ended counts as none, since it no longer affects anything.
+func (s *serviceImpl) CancelWindow(ctx context.Context, adminID uuid.UUID) (*Status, error) {
+	return s.update(ctx, adminID, func(state *State) error {
+		if state.Window == nil || !state.Window.EndsAt.After(s.clock.Now()) {
+			return ErrNoWindow
+		}
+		state.Window = nil
+		return nil
+	})
+}
+
+// update applies change to the stored state and saves it
+// The state is read from the store rather than the cache so changes made by other instances are kept.
+func (s *serviceImpl) update(ctx context.Context, adminID uuid.UUID, change func(*State) error) (*Status, error) {
+	state, err := s.repo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := change(state); err != nil {
+		return nil, err
+	}
+	state.UpdatedBy = &adminID
+	state.UpdatedAt = s.clock.Now().UTC()
+	if err := s.repo.Save(ctx, state); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.cached, s.loadedAt = state, s.clock.Now()
+	s.mu.Unlock()
+
+	logging.From(ctx).Info("Maintenance mode changed", "admin_id", adminID, "enabled", state.Enabled, "window", state.Window != nil)
+	return s.statusAt(state, s.clock.Now()), nil
+}
+
+// state returns the stored state, reloading it once the cache expires
+// If reloading fails the previous state is kept, so a Redis outage does not flip maintenance mode.
+func (s *serviceImpl) state(ctx context.Context) (*State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != nil && s.clock.Now().Sub(s.loadedAt) < s.settings.CacheTTL {
+		return s.cached, nil
+	}
+
+	state, err := s.repo.Get(ctx)
+	if err != nil {
+		if s.cached != nil {
+			logging.From(ctx).Warn("Failed to reload maintenance mode, keeping previous state", "error", err)
+			return s.cached, nil
+		}
+		return nil, err
+	}
+
+	s.cached = state
+	s.loadedAt = s.clock.Now()
+	return s.cached, nil
+}
+
+// statusAt works out the maintenance mode in force at now
+// Configuration wins over the switch, and the switch over a window; windows that ended are ignored.
+func (s *serviceImpl) statusAt(state *State, now time.Time) *Status {
+	status := &Status{State: *state}
+	if state.Window != nil && now.Before(state.Window.EndsAt) {
+		window := *state.Window
+		status.Window = &window
+	}
+
+	switch {
+	case s.settings.Enabled:
+		status.Active, status.Source, status.Message = true, SourceConfig, s.settings.Message
+	case state.Enabled:
+		status.Active, status.Source, status.Message = true, SourceSwitch, s.messageOr(state.Message)
+	case status.Window != nil && status.Window.Contains(now):
+		status.Active, status.Source, status.Message = true, SourceWindow, s.messageOr(status.Window.Message)
+		status.EndsAt = &status.Window.EndsAt
+	}
+	return status
+}
+
+// messageOr returns message, or the configured message if it is empty
+func (s *serviceImpl) messageOr(message string) string {
+	if message == "" {
+		return s.settings.Message
+	}
+	return message
+}
+
+// cleanMessage trims a message shown to clients and checks its length
+func cleanMessage(message string) (string, error) {
+	message = strings.TrimSpace(message)
+	if utf8.RuneCountInString(message) > MaxMessageLength {
+		return "", ErrMessageTooLong
+	}
+	return message, nil
+}
@@ -0,0 +1,193 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/clock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRepository keeps the state in a field and fails while err is set
+type stubRepository struct {
+	state State
+	err   error
+	gets  int
+}
+
+func (r *stubRepository) Get(ctx context.Context) (*State, error) {
+	r.gets++
+	if r.err != nil {
+		return nil, r.err
+	}
+	state := r.state
+	return &state, nil
+}
+
+func (r *stubRepository) Save(ctx context.Context, state *State) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.state = *state
+	return nil
+}
+
+func TestService_Status(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	current := &Window{StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour), Message: "Upgrading"}
+	upcoming := &Window{StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}
+	ended := &Window{StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour)}
+
+	tests := []struct {
+		name            string
+		settings        Settings
+		state           State
+		expectActive    bool
+		expectSource    string
+		expectMessage   string
+		expectWindow    bool
+		expectEndsAtSet bool
+	}{
+		{name: "off", state: State{}},
+		{name: "forced by config", settings: Settings{Enabled: true, Message: "Deploying"}, expectActive: true, expectSource: SourceConfig, expectMessage: "Deploying"},
+		{name: "switch uses configured message", settings: Settings{Message: "Default"}, state: State{Enabled: true}, expectActive: true, expectSource: SourceSwitch, expectMessage: "Default"},
+		{name: "switch message", settings: Settings{Message: "Default"}, state: State{Enabled: true, Message: "Back at noon"}, expectActive: true, expectSource: SourceSwitch, expectMessage: "Back at noon"},
+		{name: "current window", state: State{Window: current}, expectActive: true, expectSource: SourceWindow, expectMessage: "Upgrading", expectWindow: true, expectEndsAtSet: true},
+		{name: "upcoming window is listed", state: State{Window: upcoming}, expectWindow: true},
+		{name: "ended window is ignored", state: State{Window: ended}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService(&stubRepository{state: tt.state}, tt.settings)
+
+			status, err := service.Status(ctx)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectActive, status.Active)
+			assert.Equal(t, tt.expectSource, status.Source)
+			assert.Equal(t, tt.expectMessage, status.Message)
+			assert.Equal(t, tt.expectWindow, status.Window != nil)
+			assert.Equal(t, tt.expectEndsAtSet, status.EndsAt != nil)
+		})
+	}
+}
+
+func TestService_WindowEndsOnTime(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewFake(time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC))
+	repo := &stubRepository{state: State{Window: &Window{StartsAt: clk.Now(), EndsAt: clk.Now().Add(time.Hour)}}}
+	service := NewService(repo, Settings{CacheTTL: time.Minute}, WithClock(clk))
+
+	status, err := service.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, status.Active)
+
+	clk.Advance(59 * time.Minute)
+	status, err = service.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, status.Active)
+
+	clk.Advance(time.Minute)
+	status, err = service.Status(ctx)
+	require.NoError(t, err)
+	assert.False(t, status.Active, "the window ends at its end time")
+}
+
+func TestService_SetEnabled(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	repo := &stubRepository{}
+	service := NewService(repo, Settings{CacheTTL: time.Hour})
+
+	status, err := service.SetEnabled(ctx, true, "  Back soon  ", adminID)
+	require.NoError(t, err)
+	assert.True(t, status.Active)
+	assert.Equal(t, "Back soon", repo.state.Message)
+	assert.Equal(t, &adminID, repo.state.UpdatedBy)
+
+	// The change is seen right away by this instance despite the cache
+	status, err = service.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, status.Active)
+
+	_, err = service.SetEnabled(ctx, true, strings.Repeat("a", MaxMessageLength+1), adminID)
+	assert.Equal(t, ErrMessageTooLong, err)
+	assert.True(t, IsValidationError(err))
+}
+
+func TestService_ScheduleWindow(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		window      Window
+		expectedErr error
+	}{
+		{name: "upcoming", window: Window{StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}},
+		{name: "already started", window: Window{StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)}},
+		{name: "ends before it starts", window: Window{StartsAt: now.Add(2 * time.Hour), EndsAt: now.Add(time.Hour)}, expectedErr: ErrInvalidWindow},
+		{name: "already ended", window: Window{StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour)}, expectedErr: ErrInvalidWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubRepository{}
+			status, err := NewService(repo, Settings{}).ScheduleWindow(ctx, tt.window, adminID)
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				assert.Nil(t, repo.state.Window)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, status.Window)
+			assert.Equal(t, tt.window.EndsAt.UTC(), repo.state.Window.EndsAt)
+		})
+	}
+}
+
+func TestService_CancelWindow(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	now := time.Now()
+
+	repo := &stubRepository{state: State{Window: &Window{StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)}}}
+	service := NewService(repo, Settings{})
+
+	status, err := service.CancelWindow(ctx, adminID)
+	require.NoError(t, err)
+	assert.False(t, status.Active)
+	assert.Nil(t, repo.state.Window)
+
+	_, err = service.CancelWindow(ctx, adminID)
+	assert.True(t, IsNoWindowError(err))
+}
+
+func TestService_KeepsStateWhenReloadFails(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubRepository{state: State{Enabled: true}}
+	service := NewService(repo, Settings{}) // No cache: every status reloads
+
+	status, err := service.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, status.Active)
+
+	repo.err = errors.New("redis down")
+	status, err = service.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, status.Active)
+	assert.Equal(t, 2, repo.gets)
+
+	_, err = NewService(repo, Settings{}).Status(ctx)
+	assert.Error(t, err, "nothing to fall back on")
+}
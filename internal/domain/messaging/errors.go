@@ -0,0 +1,59 @@
+package messaging
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MessagingError represents domain-specific order messaging errors
+type MessagingError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *MessagingError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *MessagingError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined messaging domain errors
+var (
+	ErrInvalidBody            = &MessagingError{Code: "INVALID_MESSAGE", Message: fmt.Sprintf("message must not be empty or longer than %d characters", MaxBodyLength)}
+	ErrOwnOrder               = &MessagingError{Code: "OWN_ORDER", Message: "you are both buyer and organizer of this order"}
+	ErrMessageSaveFailed      = &MessagingError{Code: "MESSAGE_SAVE_FAILED", Message: "failed to save message"}
+	ErrMessageRetrievalFailed = &MessagingError{Code: "MESSAGE_RETRIEVAL_FAILED", Message: "failed to retrieve messages"}
+)
+
+// NewMessagingError creates a new MessagingError with a cause
+func NewMessagingError(baseError *MessagingError, cause error) *MessagingError {
+	return &MessagingError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetMessagingErrorCode extracts the error code from a MessagingError
+func GetMessagingErrorCode(err error) string {
+	var messagingErr *MessagingError
+	if errors.As(err, &messagingErr) {
+		return messagingErr.Code
+	}
+	return ""
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetMessagingErrorCode(err) {
+	case "INVALID_MESSAGE", "OWN_ORDER":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package messaging
+
+import "github.com/google/uuid"
+
+// Event bus topics of messaging events
+const (
+	TopicMessagePosted = "message.posted"
+)
+
+// MessagePosted is published when a message has been added to an order thread
+type MessagePosted struct {
+	MessageID   uuid.UUID
+	OrderID     uuid.UUID
+	EventID     uuid.UUID
+	SenderID    uuid.UUID
+	RecipientID uuid.UUID
+	SenderRole  string
+	Body        string
+}
+
+// Topic implements eventbus.Event
+func (MessagePosted) Topic() string {
+	return TopicMessagePosted
+}
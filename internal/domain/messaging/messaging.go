@@ -0,0 +1,47 @@
+package messaging
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sender roles in an order thread
+const (
+	RoleBuyer     = "BUYER"     // The user who placed the order
+	RoleOrganizer = "ORGANIZER" // The organizer of the order's event
+)
+
+// MaxBodyLength bounds message bodies in characters
+const MaxBodyLength = 2000
+
+// Message is one message in the thread attached to an order
+// Every thread has two participants, the buyer and the event organizer, so each message
+// has a single recipient whose reading it marks read.
+type Message struct {
+	ID          uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	OrderID     uuid.UUID  `gorm:"not null;type:uuid" json:"order_id"`
+	EventID     uuid.UUID  `gorm:"not null;type:uuid" json:"event_id"`
+	SenderID    uuid.UUID  `gorm:"not null;type:uuid" json:"sender_id"`
+	RecipientID uuid.UUID  `gorm:"not null;type:uuid" json:"recipient_id"`
+	SenderRole  string     `gorm:"not null;size:20" json:"sender_role"`
+	Body        string     `gorm:"type:text;not null" json:"body"`
+	ReadAt      *time.Time `json:"read_at,omitempty"` // When the recipient first opened the thread after it was sent
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Message) TableName() string {
+	return "order_messages"
+}
+
+// IsRead checks if the recipient has read the message
+func (m *Message) IsRead() bool {
+	return m.ReadAt != nil
+}
+
+// ThreadUnread is the number of messages a user hasn't read in one order thread
+type ThreadUnread struct {
+	OrderID uuid.UUID
+	Unread  int
+}
@@ -0,0 +1,24 @@
+package messaging
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for order message data access
+type Repository interface {
+	// Create stores a new message
+	Create(ctx context.Context, m *Message) error
+
+	// ListByOrder retrieves the messages of an order's thread, oldest first
+	ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*Message, error)
+
+	// MarkRead marks the unread messages to recipientID in an order's thread as read
+	// and returns how many changed
+	MarkRead(ctx context.Context, orderID, recipientID uuid.UUID, at time.Time) (int64, error)
+
+	// CountUnread counts the messages a user hasn't read, per thread with any
+	CountUnread(ctx context.Context, recipientID uuid.UUID) ([]*ThreadUnread, error)
+}
@@ -0,0 +1,171 @@
+package messaging
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for order message threads
+type Service interface {
+	// Post adds a message from the order's buyer or event organizer to the order's thread
+	Post(ctx context.Context, userID, orderID uuid.UUID, body string) (*Message, error)
+
+	// ListThread retrieves an order's thread for one of its participants and marks
+	// the messages to them as read
+	ListThread(ctx context.Context, userID, orderID uuid.UUID) ([]*Message, error)
+
+	// UnreadCounts counts the messages a user hasn't read, per thread with any
+	UnreadCounts(ctx context.Context, userID uuid.UUID) ([]*ThreadUnread, error)
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo         Repository
+	orderService order.Service
+	eventService event.Service
+	events       eventbus.Publisher
+	clock        clock.Clock       // Stamps sent and read messages
+	ids          clock.IDGenerator // Creates the IDs of new messages
+}
+
+// ServiceOption configures optional messaging Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock stamps messages with the time c tells instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// WithIDGenerator creates the IDs of new messages with ids instead of at random
+func WithIDGenerator(ids clock.IDGenerator) ServiceOption {
+	return func(s *serviceImpl) {
+		s.ids = ids
+	}
+}
+
+// NewService creates a new messaging service instance
+// Posted messages are published to events, which may be nil.
+func NewService(repo Repository, orderService order.Service, eventService event.Service, events eventbus.Publisher, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repo:         repo,
+		orderService: orderService,
+		eventService: eventService,
+		events:       events,
+		clock:        clock.System{},
+		ids:          clock.RandomIDs{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Post adds a message to an order's thread
+// Archived orders can still be read but take no new messages.
+func (s *serviceImpl) Post(ctx context.Context, userID, orderID uuid.UUID, body string) (*Message, error) {
+	body = strings.TrimSpace(body)
+	if body == "" || utf8.RuneCountInString(body) > MaxBodyLength {
+		return nil, ErrInvalidBody
+	}
+
+	o, p, err := s.participant(ctx, userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if o.ArchivedAt != nil {
+		return nil, order.NewOrderArchivedError(orderID)
+	}
+
+	m := &Message{
+		ID:          s.ids.NewID(),
+		OrderID:     o.ID,
+		EventID:     o.EventID,
+		SenderID:    userID,
+		RecipientID: p.recipientID,
+		SenderRole:  p.role,
+		Body:        body,
+		CreatedAt:   s.clock.Now(),
+	}
+	if err := s.repo.Create(ctx, m); err != nil {
+		return nil, err
+	}
+
+	if s.events != nil {
+		s.events.Publish(ctx, MessagePosted{
+			MessageID:   m.ID,
+			OrderID:     m.OrderID,
+			EventID:     m.EventID,
+			SenderID:    m.SenderID,
+			RecipientID: m.RecipientID,
+			SenderRole:  m.SenderRole,
+			Body:        m.Body,
+		})
+	}
+	return m, nil
+}
+
+// ListThread retrieves an order's thread and marks the messages to the user as read
+// The returned messages show them unread, so clients can highlight what is new.
+func (s *serviceImpl) ListThread(ctx context.Context, userID, orderID uuid.UUID) ([]*Message, error) {
+	if _, _, err := s.participant(ctx, userID, orderID); err != nil {
+		return nil, err
+	}
+
+	messages, err := s.repo.ListByOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range messages {
+		if m.RecipientID == userID && !m.IsRead() {
+			if _, err := s.repo.MarkRead(ctx, orderID, userID, s.clock.Now()); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return messages, nil
+}
+
+// UnreadCounts counts the messages a user hasn't read, per thread with any
+func (s *serviceImpl) UnreadCounts(ctx context.Context, userID uuid.UUID) ([]*ThreadUnread, error) {
+	return s.repo.CountUnread(ctx, userID)
+}
+
+// threadParticipant is a user's side of an order thread
+type threadParticipant struct {
+	role        string
+	recipientID uuid.UUID // The other participant
+}
+
+// participant retrieves an order whose thread the user takes part in
+// Orders the user is neither buyer nor organizer of are reported as not found.
+func (s *serviceImpl) participant(ctx context.Context, userID, orderID uuid.UUID) (*order.Order, threadParticipant, error) {
+	o, err := s.orderService.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, threadParticipant{}, err
+	}
+	e, err := s.eventService.GetEventByID(ctx, o.EventID)
+	if err != nil {
+		return nil, threadParticipant{}, err
+	}
+
+	switch {
+	case o.UserID == e.OrganizerID && userID == o.UserID:
+		return nil, threadParticipant{}, ErrOwnOrder
+	case userID == o.UserID:
+		return o, threadParticipant{role: RoleBuyer, recipientID: e.OrganizerID}, nil
+	case userID == e.OrganizerID:
+		return o, threadParticipant{role: RoleOrganizer, recipientID: o.UserID}, nil
+	}
+	return nil, threadParticipant{}, order.NewOrderNotFoundError(orderID)
+}
@@ -0,0 +1,171 @@
+package messaging_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/messaging"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/fixtures"
+	"enterprise-crud/internal/infrastructure/memory"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepository keeps messages in memory
+type fakeRepository struct {
+	mu       sync.Mutex
+	messages []messaging.Message
+}
+
+func (r *fakeRepository) Create(ctx context.Context, m *messaging.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = append(r.messages, *m)
+	return nil
+}
+
+func (r *fakeRepository) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*messaging.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var messages []*messaging.Message
+	for _, m := range r.messages {
+		if m.OrderID == orderID {
+			messages = append(messages, &m)
+		}
+	}
+	return messages, nil
+}
+
+func (r *fakeRepository) MarkRead(ctx context.Context, orderID, recipientID uuid.UUID, at time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var marked int64
+	for i, m := range r.messages {
+		if m.OrderID == orderID && m.RecipientID == recipientID && !m.IsRead() {
+			r.messages[i].ReadAt = &at
+			marked++
+		}
+	}
+	return marked, nil
+}
+
+func (r *fakeRepository) CountUnread(ctx context.Context, recipientID uuid.UUID) ([]*messaging.ThreadUnread, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	counts := make(map[uuid.UUID]*messaging.ThreadUnread)
+	var threads []*messaging.ThreadUnread
+	for _, m := range r.messages {
+		if m.RecipientID != recipientID || m.IsRead() {
+			continue
+		}
+		if counts[m.OrderID] == nil {
+			counts[m.OrderID] = &messaging.ThreadUnread{OrderID: m.OrderID}
+			threads = append(threads, counts[m.OrderID])
+		}
+		counts[m.OrderID].Unread++
+	}
+	return threads, nil
+}
+
+// newService creates a messaging service over fresh fixture orders and events
+func newService(repo messaging.Repository, events eventbus.Publisher) messaging.Service {
+	store := memory.NewStore(fixtures.Default(time.Now()))
+	eventService := event.NewService(memory.NewEventRepository(store), memory.NewVenueRepository(store), nil, nil)
+	orderService := order.NewOrderService(memory.NewOrderRepository(store), nil, nil)
+	return messaging.NewService(repo, orderService, eventService, events)
+}
+
+func TestPost(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("buyer writes to the organizer", func(t *testing.T) {
+		bus := eventbus.New()
+		var posted []messaging.MessagePosted
+		bus.Subscribe(messaging.TopicMessagePosted, func(ctx context.Context, e eventbus.Event) error {
+			posted = append(posted, e.(messaging.MessagePosted))
+			return nil
+		})
+		service := newService(&fakeRepository{}, bus)
+
+		m, err := service.Post(ctx, fixtures.UserID, fixtures.ConcertOrderID, "  Is there parking?  ")
+		require.NoError(t, err)
+		assert.Equal(t, "Is there parking?", m.Body)
+		assert.Equal(t, messaging.RoleBuyer, m.SenderRole)
+		assert.Equal(t, fixtures.OrganizerID, m.RecipientID)
+		assert.Equal(t, fixtures.ConcertID, m.EventID)
+
+		require.Len(t, posted, 1)
+		assert.Equal(t, m.ID, posted[0].MessageID)
+		assert.Equal(t, fixtures.OrganizerID, posted[0].RecipientID)
+	})
+
+	t.Run("organizer replies to the buyer", func(t *testing.T) {
+		service := newService(&fakeRepository{}, nil)
+
+		m, err := service.Post(ctx, fixtures.OrganizerID, fixtures.ConcertOrderID, "Yes, on level 2")
+		require.NoError(t, err)
+		assert.Equal(t, messaging.RoleOrganizer, m.SenderRole)
+		assert.Equal(t, fixtures.UserID, m.RecipientID)
+	})
+
+	t.Run("outsiders don't see the order", func(t *testing.T) {
+		service := newService(&fakeRepository{}, nil)
+
+		_, err := service.Post(ctx, fixtures.AdminID, fixtures.ConcertOrderID, "Hello")
+		assert.True(t, order.IsOrderNotFoundError(err))
+	})
+
+	t.Run("rejects empty and overlong bodies", func(t *testing.T) {
+		service := newService(&fakeRepository{}, nil)
+
+		for _, body := range []string{"   ", strings.Repeat("a", messaging.MaxBodyLength+1)} {
+			_, err := service.Post(ctx, fixtures.UserID, fixtures.ConcertOrderID, body)
+			assert.True(t, messaging.IsValidationError(err))
+		}
+	})
+}
+
+func TestListThreadAndUnreadCounts(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepository{}
+	service := newService(repo, nil)
+
+	_, err := service.Post(ctx, fixtures.UserID, fixtures.ConcertOrderID, "First")
+	require.NoError(t, err)
+	_, err = service.Post(ctx, fixtures.UserID, fixtures.ConcertOrderID, "Second")
+	require.NoError(t, err)
+
+	counts, err := service.UnreadCounts(ctx, fixtures.OrganizerID)
+	require.NoError(t, err)
+	require.Len(t, counts, 1)
+	assert.Equal(t, fixtures.ConcertOrderID, counts[0].OrderID)
+	assert.Equal(t, 2, counts[0].Unread)
+
+	// The buyer reading their own messages leaves them unread for the organizer
+	_, err = service.ListThread(ctx, fixtures.UserID, fixtures.ConcertOrderID)
+	require.NoError(t, err)
+	counts, err = service.UnreadCounts(ctx, fixtures.OrganizerID)
+	require.NoError(t, err)
+	require.Len(t, counts, 1)
+
+	messages, err := service.ListThread(ctx, fixtures.OrganizerID, fixtures.ConcertOrderID)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "First", messages[0].Body)
+	assert.False(t, messages[0].IsRead(), "the listing shows what was new")
+
+	counts, err = service.UnreadCounts(ctx, fixtures.OrganizerID)
+	require.NoError(t, err)
+	assert.Empty(t, counts)
+
+	_, err = service.ListThread(ctx, fixtures.AdminID, fixtures.ConcertOrderID)
+	assert.True(t, order.IsOrderNotFoundError(err))
+}
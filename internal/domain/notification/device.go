@@ -0,0 +1,34 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Device platforms, which also select the push provider
+const (
+	PlatformAndroid = "ANDROID" // Delivered through Firebase Cloud Messaging
+	PlatformIOS     = "IOS"     // Delivered through the Apple Push Notification service
+)
+
+// Device is a mobile app installation that receives push notifications
+// A token belongs to one installation, so registering it again moves it to the new user.
+type Device struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	UserID    uuid.UUID `gorm:"not null;type:uuid;index" json:"user_id"`
+	Platform  string    `gorm:"not null;size:10" json:"platform"`
+	Token     string    `gorm:"not null;size:512;uniqueIndex" json:"token"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Device) TableName() string {
+	return "device_tokens"
+}
+
+// IsValidPlatform checks if push notifications can be delivered to a platform
+func IsValidPlatform(platform string) bool {
+	return platform == PlatformAndroid || platform == PlatformIOS
+}
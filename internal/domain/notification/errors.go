@@ -0,0 +1,104 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// NotificationError represents domain-specific notification errors
+type NotificationError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *NotificationError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *NotificationError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined notification domain errors
+var (
+	ErrNotificationNotFound   = &NotificationError{Code: "NOTIFICATION_NOT_FOUND", Message: "notification not found"}
+	ErrDeviceNotFound         = &NotificationError{Code: "DEVICE_NOT_FOUND", Message: "device not found"}
+	ErrInvalidPlatform        = &NotificationError{Code: "INVALID_PLATFORM", Message: "platform must be ANDROID or IOS"}
+	ErrInvalidDeviceToken     = &NotificationError{Code: "INVALID_DEVICE_TOKEN", Message: "device token must not be empty"}
+	ErrInvalidPhone           = &NotificationError{Code: "INVALID_PHONE", Message: "phone number must be in international format, e.g. +14155550123"}
+	ErrVerificationNotFound   = &NotificationError{Code: "VERIFICATION_NOT_FOUND", Message: "no phone verification is pending"}
+	ErrVerificationExpired    = &NotificationError{Code: "VERIFICATION_EXPIRED", Message: "verification code has expired, request a new one"}
+	ErrInvalidCode            = &NotificationError{Code: "INVALID_VERIFICATION_CODE", Message: "verification code is incorrect"}
+	ErrTooManyAttempts        = &NotificationError{Code: "TOO_MANY_ATTEMPTS", Message: "too many incorrect codes, request a new one"}
+	ErrVerificationTooSoon    = &NotificationError{Code: "VERIFICATION_TOO_SOON", Message: "a code was sent recently, wait a minute before requesting another"}
+	ErrUserNotFound           = &NotificationError{Code: "USER_NOT_FOUND", Message: "user not found"}
+	ErrInvalidType            = &NotificationError{Code: "INVALID_NOTIFICATION_TYPE", Message: "notification type must be ORDER_CONFIRMED, EVENT_CHANGED, WAITLIST_PROMOTED, ORDER_MESSAGE or GIFT"}
+	ErrNotificationSaveFailed = &NotificationError{Code: "NOTIFICATION_SAVE_FAILED", Message: "failed to save notification"}
+	ErrRetrievalFailed        = &NotificationError{Code: "NOTIFICATION_RETRIEVAL_FAILED", Message: "failed to retrieve notifications"}
+	ErrDeliveryFailed         = &NotificationError{Code: "NOTIFICATION_DELIVERY_FAILED", Message: "failed to queue notification email"}
+)
+
+// NewNotificationError creates a new NotificationError with a cause
+func NewNotificationError(baseError *NotificationError, cause error) *NotificationError {
+	return &NotificationError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// NewUserNotFoundError creates a specific error for an unknown notification recipient
+func NewUserNotFoundError(id uuid.UUID) *NotificationError {
+	return &NotificationError{
+		Code:    "USER_NOT_FOUND",
+		Message: fmt.Sprintf("user with ID %s not found", id),
+	}
+}
+
+// GetNotificationErrorCode extracts the error code from a NotificationError
+func GetNotificationErrorCode(err error) string {
+	var notificationErr *NotificationError
+	if errors.As(err, &notificationErr) {
+		return notificationErr.Code
+	}
+	return ""
+}
+
+// IsNotFoundError checks if an error is a "notification not found", "device not found" or "verification not found" error
+func IsNotFoundError(err error) bool {
+	switch GetNotificationErrorCode(err) {
+	case "NOTIFICATION_NOT_FOUND", "DEVICE_NOT_FOUND", "VERIFICATION_NOT_FOUND":
+		return true
+	}
+	return false
+}
+
+// IsUserNotFoundError checks if an error is a "user not found" error
+func IsUserNotFoundError(err error) bool {
+	return GetNotificationErrorCode(err) == "USER_NOT_FOUND"
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetNotificationErrorCode(err) {
+	case "INVALID_NOTIFICATION_TYPE", "INVALID_PLATFORM", "INVALID_DEVICE_TOKEN", "INVALID_PHONE",
+		"INVALID_VERIFICATION_CODE", "VERIFICATION_EXPIRED":
+		return true
+	}
+	return false
+}
+
+// IsRateLimitError checks if an error asks the user to wait before trying again
+func IsRateLimitError(err error) bool {
+	switch GetNotificationErrorCode(err) {
+	case "TOO_MANY_ATTEMPTS", "VERIFICATION_TOO_SOON":
+		return true
+	}
+	return false
+}
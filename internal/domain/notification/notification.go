@@ -0,0 +1,185 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Job types that deliver notifications outside the app
+const (
+	JobTypeEmail = "notification.email" // Emails a notification to its recipient
+	JobTypePush  = "notification.push"  // Pushes a notification to one registered device
+	JobTypeSMS   = "notification.sms"   // Texts a verification code or critical alert to a phone number
+)
+
+// Notification types users can set preferences for
+const (
+	TypeOrderConfirmed   = "ORDER_CONFIRMED"   // An order was completed
+	TypeEventChanged     = "EVENT_CHANGED"     // An event the user has tickets for was rescheduled, moved or cancelled
+	TypeWaitlistPromoted = "WAITLIST_PROMOTED" // The user was offered tickets from an event's waitlist
+	TypeOrderMessage     = "ORDER_MESSAGE"     // The buyer or organizer wrote in an order's message thread
+	TypeGift             = "GIFT"              // A gift the user bought or received was claimed
+)
+
+// Types lists every notification type, in the order preferences are reported
+var Types = []string{TypeOrderConfirmed, TypeEventChanged, TypeWaitlistPromoted, TypeOrderMessage, TypeGift}
+
+// Notification is an in-app message shown in a user's notification center
+type Notification struct {
+	ID        uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	UserID    uuid.UUID  `gorm:"not null;type:uuid;index" json:"user_id"`
+	Type      string     `gorm:"not null;size:30" json:"type"`
+	Title     string     `gorm:"not null;size:255" json:"title"`
+	Body      string     `gorm:"type:text" json:"body"`
+	EventID   *uuid.UUID `gorm:"type:uuid" json:"event_id,omitempty"` // Event the notification is about, if any
+	OrderID   *uuid.UUID `gorm:"type:uuid" json:"order_id,omitempty"` // Order the notification is about, if any
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// IsRead checks if the user has read the notification
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// ListFilter narrows and pages the notifications returned by Service.List
+type ListFilter struct {
+	UnreadOnly bool // Only notifications not read yet
+	Limit      int  // Page size; outside 1-100 means DefaultPageSize
+	Offset     int  // Number of matching notifications to skip
+}
+
+// DefaultPageSize is the page size of notification listings without a valid limit
+const DefaultPageSize = 50
+
+// PageSize returns the number of notifications a page holds under this filter
+func (f ListFilter) PageSize() int {
+	if f.Limit <= 0 || f.Limit > 100 {
+		return DefaultPageSize
+	}
+	return f.Limit
+}
+
+// Preference selects the channels a user receives one type of notification on
+type Preference struct {
+	UserID    uuid.UUID `gorm:"primaryKey;type:uuid" json:"user_id"`
+	Type      string    `gorm:"primaryKey;size:30" json:"type"`
+	Email     bool      `gorm:"not null" json:"email"`
+	InApp     bool      `gorm:"not null" json:"in_app"`
+	Push      bool      `gorm:"not null" json:"push"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Preference) TableName() string {
+	return "notification_preferences"
+}
+
+// DefaultPreference is used for types a user has not configured: every channel is on
+func DefaultPreference(userID uuid.UUID, notificationType string) *Preference {
+	return &Preference{
+		UserID: userID,
+		Type:   notificationType,
+		Email:  true,
+		InApp:  true,
+		Push:   true,
+	}
+}
+
+// PreferenceUpdate changes the channels of one notification type; nil fields keep their setting
+type PreferenceUpdate struct {
+	Type  string
+	Email *bool
+	InApp *bool
+	Push  *bool
+}
+
+// apply copies the fields set in the update onto a preference
+func (u PreferenceUpdate) apply(p *Preference) {
+	if u.Email != nil {
+		p.Email = *u.Email
+	}
+	if u.InApp != nil {
+		p.InApp = *u.InApp
+	}
+	if u.Push != nil {
+		p.Push = *u.Push
+	}
+}
+
+// IsValidType checks if a notification type is known
+func IsValidType(notificationType string) bool {
+	for _, t := range Types {
+		if t == notificationType {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys of Message.Data, from which emails of the type are laid out
+// Title and Body remain the fallback, e.g. for jobs queued before the data was added.
+const (
+	DataEventTitle = "event_title" // Current title of the event
+	DataEventDate  = "event_date"  // Start of the event, RFC 3339
+	DataQuantity   = "quantity"    // Tickets in the order
+	DataTotal      = "total"       // Amount paid, two decimals
+	DataCancelled  = "cancelled"   // "true" when the event was cancelled
+	DataChanges    = "changes"     // Comma-separated event.Change values
+)
+
+// Message is a notification to deliver to one user on the channels they chose
+type Message struct {
+	Type    string
+	Title   string
+	Body    string
+	EventID *uuid.UUID
+	OrderID *uuid.UUID
+	Data    map[string]string // Details for the email of the type, keyed by the Data* constants
+}
+
+// Recipient identifies who a notification email is sent to
+type Recipient struct {
+	UserID   uuid.UUID
+	Email    string
+	Username string
+}
+
+// EmailPayload is the job payload for JobTypeEmail
+type EmailPayload struct {
+	UserID  uuid.UUID         `json:"user_id"`
+	Type    string            `json:"type"`
+	Title   string            `json:"title"`
+	Body    string            `json:"body"`
+	EventID *uuid.UUID        `json:"event_id,omitempty"`
+	OrderID *uuid.UUID        `json:"order_id,omitempty"`
+	Data    map[string]string `json:"data,omitempty"`
+}
+
+// PushPayload is the job payload for JobTypePush
+type PushPayload struct {
+	UserID   uuid.UUID         `json:"user_id"`
+	Platform string            `json:"platform"`
+	Token    string            `json:"token"`
+	Type     string            `json:"type"`
+	Title    string            `json:"title"`
+	Body     string            `json:"body"`
+	Data     map[string]string `json:"data,omitempty"` // Delivered to the app alongside the alert
+}
+
+// Email is everything needed to render a notification email
+type Email struct {
+	Recipient *Recipient
+	Type      string
+	Title     string
+	Body      string
+	EventID   *uuid.UUID
+	OrderID   *uuid.UUID
+	Data      map[string]string
+}
@@ -0,0 +1,83 @@
+package notification
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// VerificationCodeTTL is how long a phone verification code can be used
+	VerificationCodeTTL = 10 * time.Minute
+
+	// MaxVerificationAttempts is how many wrong codes are accepted before a new code is needed
+	MaxVerificationAttempts = 5
+
+	// VerificationResendInterval is the minimum wait before another code is sent, which
+	// keeps the endpoint from being used to flood a number with messages
+	VerificationResendInterval = time.Minute
+
+	// SMSAlertWindow is how close to its start an event must be for its cancellation to be sent by SMS
+	SMSAlertWindow = 24 * time.Hour
+)
+
+// e164 matches phone numbers in E.164 format, e.g. +14155550123
+var e164 = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// PhoneVerification is a pending confirmation that a user controls a phone number
+// Only a hash of the code is stored.
+type PhoneVerification struct {
+	UserID    uuid.UUID `gorm:"primaryKey;type:uuid" json:"user_id"`
+	Phone     string    `gorm:"not null;size:20" json:"phone"`
+	CodeHash  string    `gorm:"not null;size:64" json:"-"`
+	Attempts  int       `gorm:"not null;default:0" json:"attempts"`
+	ExpiresAt time.Time `gorm:"not null" json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (PhoneVerification) TableName() string {
+	return "phone_verifications"
+}
+
+// IsExpired checks if the code can no longer be used
+func (v *PhoneVerification) IsExpired(now time.Time) bool {
+	return !now.Before(v.ExpiresAt)
+}
+
+// PhoneContact is a verified phone number of a user
+type PhoneContact struct {
+	UserID     uuid.UUID
+	Phone      string
+	VerifiedAt *time.Time
+}
+
+// SMSPayload is the job payload for JobTypeSMS
+type SMSPayload struct {
+	UserID uuid.UUID `json:"user_id"`
+	Phone  string    `json:"phone"`
+	Body   string    `json:"body"`
+}
+
+// NormalizePhone strips common separators and checks the result is an E.164 number
+func NormalizePhone(phone string) (string, bool) {
+	normalized := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, phone)
+	return normalized, e164.MatchString(normalized)
+}
+
+// hashVerificationCode hashes a code for storage
+// Codes are short-lived and attempts are limited, so a fast hash is enough.
+func hashVerificationCode(userID uuid.UUID, code string) string {
+	sum := sha256.Sum256([]byte(userID.String() + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
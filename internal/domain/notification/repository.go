@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for notification data access
+type Repository interface {
+	// Create stores a new notification
+	Create(ctx context.Context, n *Notification) error
+
+	// ListByUser retrieves a page of a user's notifications, newest first
+	ListByUser(ctx context.Context, userID uuid.UUID, filter ListFilter) ([]*Notification, error)
+
+	// CountUnread counts a user's unread notifications
+	CountUnread(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	// MarkRead marks one of a user's notifications as read
+	// It returns ErrNotificationNotFound if the user has no such notification
+	MarkRead(ctx context.Context, userID, id uuid.UUID, at time.Time) error
+
+	// MarkAllRead marks all of a user's unread notifications as read and returns how many changed
+	MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int64, error)
+
+	// GetPreferences retrieves the preferences a user has stored; unconfigured types are absent
+	GetPreferences(ctx context.Context, userID uuid.UUID) ([]*Preference, error)
+
+	// SavePreferences creates or updates preferences
+	SavePreferences(ctx context.Context, prefs []*Preference) error
+
+	// SaveDevice registers a device token, moving it to device.UserID if it was registered before
+	SaveDevice(ctx context.Context, device *Device) error
+
+	// GetDevices retrieves the devices registered by a user
+	GetDevices(ctx context.Context, userID uuid.UUID) ([]*Device, error)
+
+	// DeleteDevice removes one of a user's devices
+	// It returns ErrDeviceNotFound if the user has no such device
+	DeleteDevice(ctx context.Context, userID, id uuid.UUID) error
+
+	// DeleteDeviceToken removes a device token regardless of its owner
+	DeleteDeviceToken(ctx context.Context, token string) error
+
+	// SavePhoneVerification creates or replaces a user's pending phone verification
+	SavePhoneVerification(ctx context.Context, v *PhoneVerification) error
+
+	// GetPhoneVerification retrieves a user's pending phone verification
+	// It returns ErrVerificationNotFound if there is none
+	GetPhoneVerification(ctx context.Context, userID uuid.UUID) (*PhoneVerification, error)
+
+	// IncrementVerificationAttempts records a wrong code for a user's pending verification
+	IncrementVerificationAttempts(ctx context.Context, userID uuid.UUID) error
+
+	// DeletePhoneVerification removes a user's pending phone verification
+	DeletePhoneVerification(ctx context.Context, userID uuid.UUID) error
+
+	// GetPhone retrieves a user's verified phone number
+	// Phone is empty if the user has none
+	GetPhone(ctx context.Context, userID uuid.UUID) (*PhoneContact, error)
+
+	// SetPhone stores a user's verified phone number; an empty phone removes it
+	SetPhone(ctx context.Context, userID uuid.UUID, phone string, verifiedAt *time.Time) error
+
+	// GetTicketHolderPhones retrieves the verified phone numbers of the users with completed orders for an event
+	GetTicketHolderPhones(ctx context.Context, eventID uuid.UUID) ([]PhoneContact, error)
+
+	// GetTicketHolderIDs retrieves the users with completed orders for an event
+	GetTicketHolderIDs(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error)
+
+	// GetRecipient retrieves the email details of a user
+	GetRecipient(ctx context.Context, userID uuid.UUID) (*Recipient, error)
+}
@@ -0,0 +1,477 @@
+package notification
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/job"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for user notifications
+type Service interface {
+	// List retrieves a page of a user's notifications, newest first, along with their unread count
+	List(ctx context.Context, userID uuid.UUID, filter ListFilter) ([]*Notification, int64, error)
+
+	// MarkRead marks one of a user's notifications as read
+	MarkRead(ctx context.Context, userID, id uuid.UUID) error
+
+	// MarkAllRead marks all of a user's notifications as read and returns how many changed
+	MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	// GetPreferences retrieves a user's preference for every notification type
+	GetPreferences(ctx context.Context, userID uuid.UUID) ([]*Preference, error)
+
+	// UpdatePreferences applies changes to the given types and returns the full set
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, updates []PreferenceUpdate) ([]*Preference, error)
+
+	// Notify delivers a message to a user on the channels they chose for its type
+	Notify(ctx context.Context, userID uuid.UUID, msg Message) error
+
+	// NotifyTicketHolders delivers a message to every user with a completed order for an event
+	NotifyTicketHolders(ctx context.Context, eventID uuid.UUID, msg Message) error
+
+	// PrepareEmail gathers the data for a queued notification email
+	// It returns nil if the user turned off email for the type after the job was queued
+	PrepareEmail(ctx context.Context, payload EmailPayload) (*Email, error)
+
+	// RegisterDevice registers a device to receive the user's push notifications
+	RegisterDevice(ctx context.Context, userID uuid.UUID, platform, token string) (*Device, error)
+
+	// UnregisterDevice stops push notifications to one of the user's devices
+	UnregisterDevice(ctx context.Context, userID, deviceID uuid.UUID) error
+
+	// RemoveDeviceToken forgets a token the push provider reported as no longer valid
+	RemoveDeviceToken(ctx context.Context, token string) error
+
+	// GetPhone retrieves the user's verified phone number
+	GetPhone(ctx context.Context, userID uuid.UUID) (*PhoneContact, error)
+
+	// StartPhoneVerification texts a verification code to a phone number the user wants to add
+	StartPhoneVerification(ctx context.Context, userID uuid.UUID, phone string) (*PhoneVerification, error)
+
+	// VerifyPhone checks a verification code and stores the phone number on the user's profile
+	VerifyPhone(ctx context.Context, userID uuid.UUID, code string) (*PhoneContact, error)
+
+	// RemovePhone removes the user's phone number, which stops SMS alerts
+	RemovePhone(ctx context.Context, userID uuid.UUID) error
+
+	// AlertTicketHoldersBySMS texts a critical alert to every ticket holder of an event with a verified phone
+	AlertTicketHoldersBySMS(ctx context.Context, eventID uuid.UUID, body string) error
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo       Repository
+	jobService job.Service
+	clock      clock.Clock       // Stamps notifications and devices and expires phone verifications
+	ids        clock.IDGenerator // Creates the IDs of new notifications and devices
+}
+
+// ServiceOption configures optional notification Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock reads the time from c instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// WithIDGenerator creates the IDs of new notifications and devices with ids instead of at random
+func WithIDGenerator(ids clock.IDGenerator) ServiceOption {
+	return func(s *serviceImpl) {
+		s.ids = ids
+	}
+}
+
+// NewService creates a new notification service instance
+func NewService(repo Repository, jobService job.Service, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repo:       repo,
+		jobService: jobService,
+		clock:      clock.System{},
+		ids:        clock.RandomIDs{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// List retrieves a user's notifications along with their unread count
+func (s *serviceImpl) List(ctx context.Context, userID uuid.UUID, filter ListFilter) ([]*Notification, int64, error) {
+	notifications, err := s.repo.ListByUser(ctx, userID, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	unread, err := s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return notifications, unread, nil
+}
+
+// MarkRead marks one of a user's notifications as read
+func (s *serviceImpl) MarkRead(ctx context.Context, userID, id uuid.UUID) error {
+	return s.repo.MarkRead(ctx, userID, id, s.clock.Now())
+}
+
+// MarkAllRead marks all of a user's notifications as read
+func (s *serviceImpl) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return s.repo.MarkAllRead(ctx, userID, s.clock.Now())
+}
+
+// GetPreferences retrieves a user's preference for every notification type
+// Types the user never configured are reported with their defaults.
+func (s *serviceImpl) GetPreferences(ctx context.Context, userID uuid.UUID) ([]*Preference, error) {
+	stored, err := s.repo.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[string]*Preference, len(stored))
+	for _, p := range stored {
+		byType[p.Type] = p
+	}
+
+	prefs := make([]*Preference, len(Types))
+	for i, t := range Types {
+		if p, ok := byType[t]; ok {
+			prefs[i] = p
+		} else {
+			prefs[i] = DefaultPreference(userID, t)
+		}
+	}
+	return prefs, nil
+}
+
+// UpdatePreferences applies changes to the given types and returns the full set
+func (s *serviceImpl) UpdatePreferences(ctx context.Context, userID uuid.UUID, updates []PreferenceUpdate) ([]*Preference, error) {
+	current, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	byType := make(map[string]*Preference, len(current))
+	for _, p := range current {
+		byType[p.Type] = p
+	}
+
+	now := s.clock.Now()
+	changed := make(map[string]*Preference, len(updates))
+	for _, u := range updates {
+		p, ok := byType[strings.ToUpper(u.Type)]
+		if !ok {
+			return nil, ErrInvalidType
+		}
+		u.apply(p)
+		p.UpdatedAt = now
+		changed[p.Type] = p
+	}
+
+	if len(changed) > 0 {
+		prefs := make([]*Preference, 0, len(changed))
+		for _, t := range Types {
+			if p, ok := changed[t]; ok {
+				prefs = append(prefs, p)
+			}
+		}
+		if err := s.repo.SavePreferences(ctx, prefs); err != nil {
+			return nil, err // Repository already returns custom error
+		}
+	}
+	return current, nil
+}
+
+// Notify delivers a message to a user on the channels they chose for its type
+// The email is sent by a job worker so a slow mail server never holds up the caller.
+func (s *serviceImpl) Notify(ctx context.Context, userID uuid.UUID, msg Message) error {
+	pref, err := s.preference(ctx, userID, msg.Type)
+	if err != nil {
+		return err
+	}
+
+	if pref.InApp {
+		n := &Notification{
+			ID:        s.ids.NewID(),
+			UserID:    userID,
+			Type:      msg.Type,
+			Title:     msg.Title,
+			Body:      msg.Body,
+			EventID:   msg.EventID,
+			OrderID:   msg.OrderID,
+			CreatedAt: s.clock.Now(),
+		}
+		if err := s.repo.Create(ctx, n); err != nil {
+			return err // Repository already returns custom error
+		}
+	}
+
+	if pref.Email {
+		payload := EmailPayload{
+			UserID:  userID,
+			Type:    msg.Type,
+			Title:   msg.Title,
+			Body:    msg.Body,
+			EventID: msg.EventID,
+			OrderID: msg.OrderID,
+			Data:    msg.Data,
+		}
+		if _, err := s.jobService.Enqueue(ctx, JobTypeEmail, payload); err != nil {
+			return NewNotificationError(ErrDeliveryFailed, err)
+		}
+	}
+
+	if pref.Push {
+		if err := s.enqueuePushes(ctx, userID, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enqueuePushes queues one push job per registered device, so a failing device
+// is retried on its own without repeating pushes that were already delivered
+func (s *serviceImpl) enqueuePushes(ctx context.Context, userID uuid.UUID, msg Message) error {
+	devices, err := s.repo.GetDevices(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]string{"type": msg.Type}
+	if msg.EventID != nil {
+		data["event_id"] = msg.EventID.String()
+	}
+	if msg.OrderID != nil {
+		data["order_id"] = msg.OrderID.String()
+	}
+
+	for _, device := range devices {
+		payload := PushPayload{
+			UserID:   userID,
+			Platform: device.Platform,
+			Token:    device.Token,
+			Type:     msg.Type,
+			Title:    msg.Title,
+			Body:     msg.Body,
+			Data:     data,
+		}
+		if _, err := s.jobService.Enqueue(ctx, JobTypePush, payload); err != nil {
+			return NewNotificationError(ErrDeliveryFailed, err)
+		}
+	}
+	return nil
+}
+
+// NotifyTicketHolders delivers a message to every user with a completed order for an event
+// Every holder is attempted; the last failure, if any, is returned.
+func (s *serviceImpl) NotifyTicketHolders(ctx context.Context, eventID uuid.UUID, msg Message) error {
+	userIDs, err := s.repo.GetTicketHolderIDs(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for _, userID := range userIDs {
+		if err := s.Notify(ctx, userID, msg); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// PrepareEmail gathers the data for a queued notification email
+func (s *serviceImpl) PrepareEmail(ctx context.Context, payload EmailPayload) (*Email, error) {
+	pref, err := s.preference(ctx, payload.UserID, payload.Type)
+	if err != nil {
+		return nil, err
+	}
+	if !pref.Email {
+		return nil, nil
+	}
+
+	recipient, err := s.repo.GetRecipient(ctx, payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Email{
+		Recipient: recipient,
+		Type:      payload.Type,
+		Title:     payload.Title,
+		Body:      payload.Body,
+		EventID:   payload.EventID,
+		OrderID:   payload.OrderID,
+		Data:      payload.Data,
+	}, nil
+}
+
+// RegisterDevice registers a device to receive the user's push notifications
+func (s *serviceImpl) RegisterDevice(ctx context.Context, userID uuid.UUID, platform, token string) (*Device, error) {
+	platform = strings.ToUpper(strings.TrimSpace(platform))
+	if !IsValidPlatform(platform) {
+		return nil, ErrInvalidPlatform
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, ErrInvalidDeviceToken
+	}
+
+	now := s.clock.Now()
+	device := &Device{
+		ID:        s.ids.NewID(),
+		UserID:    userID,
+		Platform:  platform,
+		Token:     token,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.SaveDevice(ctx, device); err != nil {
+		return nil, err // Repository already returns custom error
+	}
+	return device, nil
+}
+
+// UnregisterDevice stops push notifications to one of the user's devices
+func (s *serviceImpl) UnregisterDevice(ctx context.Context, userID, deviceID uuid.UUID) error {
+	return s.repo.DeleteDevice(ctx, userID, deviceID)
+}
+
+// RemoveDeviceToken forgets a token the push provider reported as no longer valid
+func (s *serviceImpl) RemoveDeviceToken(ctx context.Context, token string) error {
+	return s.repo.DeleteDeviceToken(ctx, token)
+}
+
+// GetPhone retrieves the user's verified phone number
+func (s *serviceImpl) GetPhone(ctx context.Context, userID uuid.UUID) (*PhoneContact, error) {
+	return s.repo.GetPhone(ctx, userID)
+}
+
+// StartPhoneVerification texts a verification code to a phone number the user wants to add
+// A new request replaces any pending code, but not within VerificationResendInterval of the last one.
+func (s *serviceImpl) StartPhoneVerification(ctx context.Context, userID uuid.UUID, phone string) (*PhoneVerification, error) {
+	phone, ok := NormalizePhone(phone)
+	if !ok {
+		return nil, ErrInvalidPhone
+	}
+
+	now := s.clock.Now()
+	pending, err := s.repo.GetPhoneVerification(ctx, userID)
+	if err != nil && !errors.Is(err, ErrVerificationNotFound) {
+		return nil, err
+	}
+	if pending != nil && now.Sub(pending.CreatedAt) < VerificationResendInterval {
+		return nil, ErrVerificationTooSoon
+	}
+
+	code, err := newVerificationCode()
+	if err != nil {
+		return nil, NewNotificationError(ErrDeliveryFailed, err)
+	}
+	verification := &PhoneVerification{
+		UserID:    userID,
+		Phone:     phone,
+		CodeHash:  hashVerificationCode(userID, code),
+		ExpiresAt: now.Add(VerificationCodeTTL),
+		CreatedAt: now,
+	}
+	if err := s.repo.SavePhoneVerification(ctx, verification); err != nil {
+		return nil, err // Repository already returns custom error
+	}
+
+	payload := SMSPayload{
+		UserID: userID,
+		Phone:  phone,
+		Body:   fmt.Sprintf("Your verification code is %s. It expires in %d minutes.", code, int(VerificationCodeTTL.Minutes())),
+	}
+	if _, err := s.jobService.Enqueue(ctx, JobTypeSMS, payload); err != nil {
+		return nil, NewNotificationError(ErrDeliveryFailed, err)
+	}
+	return verification, nil
+}
+
+// VerifyPhone checks a verification code and stores the phone number on the user's profile
+func (s *serviceImpl) VerifyPhone(ctx context.Context, userID uuid.UUID, code string) (*PhoneContact, error) {
+	verification, err := s.repo.GetPhoneVerification(ctx, userID)
+	if err != nil {
+		return nil, err // Repository already returns custom error
+	}
+
+	now := s.clock.Now()
+	if verification.IsExpired(now) {
+		return nil, ErrVerificationExpired
+	}
+	if verification.Attempts >= MaxVerificationAttempts {
+		return nil, ErrTooManyAttempts
+	}
+
+	expected := hashVerificationCode(userID, strings.TrimSpace(code))
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(verification.CodeHash)) != 1 {
+		if err := s.repo.IncrementVerificationAttempts(ctx, userID); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidCode
+	}
+
+	if err := s.repo.SetPhone(ctx, userID, verification.Phone, &now); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeletePhoneVerification(ctx, userID); err != nil {
+		return nil, err
+	}
+	return &PhoneContact{UserID: userID, Phone: verification.Phone, VerifiedAt: &now}, nil
+}
+
+// RemovePhone removes the user's phone number, which stops SMS alerts
+func (s *serviceImpl) RemovePhone(ctx context.Context, userID uuid.UUID) error {
+	return s.repo.SetPhone(ctx, userID, "", nil)
+}
+
+// AlertTicketHoldersBySMS texts a critical alert to every ticket holder of an event with a verified phone
+// SMS is reserved for alerts that can't wait, so it doesn't depend on notification preferences;
+// users opt in by verifying a phone number.
+func (s *serviceImpl) AlertTicketHoldersBySMS(ctx context.Context, eventID uuid.UUID, body string) error {
+	contacts, err := s.repo.GetTicketHolderPhones(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for _, contact := range contacts {
+		payload := SMSPayload{UserID: contact.UserID, Phone: contact.Phone, Body: body}
+		if _, err := s.jobService.Enqueue(ctx, JobTypeSMS, payload); err != nil {
+			lastErr = NewNotificationError(ErrDeliveryFailed, err)
+		}
+	}
+	return lastErr
+}
+
+// newVerificationCode generates a random six-digit code
+func newVerificationCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// preference retrieves a user's preference for one notification type
+func (s *serviceImpl) preference(ctx context.Context, userID uuid.UUID, notificationType string) (*Preference, error) {
+	prefs, err := s.repo.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range prefs {
+		if p.Type == notificationType {
+			return p, nil
+		}
+	}
+	return DefaultPreference(userID, notificationType), nil
+}
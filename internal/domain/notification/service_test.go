@@ -0,0 +1,712 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/messaging"
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, n *Notification) error {
+	args := m.Called(ctx, n)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListByUser(ctx context.Context, userID uuid.UUID, filter ListFilter) ([]*Notification, error) {
+	args := m.Called(ctx, userID, filter)
+	return args.Get(0).([]*Notification), args.Error(1)
+}
+
+func (m *MockRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) MarkRead(ctx context.Context, userID, id uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, userID, id, at)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int64, error) {
+	args := m.Called(ctx, userID, at)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) GetPreferences(ctx context.Context, userID uuid.UUID) ([]*Preference, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*Preference), args.Error(1)
+}
+
+func (m *MockRepository) SavePreferences(ctx context.Context, prefs []*Preference) error {
+	args := m.Called(ctx, prefs)
+	return args.Error(0)
+}
+
+func (m *MockRepository) SaveDevice(ctx context.Context, device *Device) error {
+	args := m.Called(ctx, device)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetDevices(ctx context.Context, userID uuid.UUID) ([]*Device, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*Device), args.Error(1)
+}
+
+func (m *MockRepository) DeleteDevice(ctx context.Context, userID, id uuid.UUID) error {
+	args := m.Called(ctx, userID, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteDeviceToken(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockRepository) SavePhoneVerification(ctx context.Context, v *PhoneVerification) error {
+	args := m.Called(ctx, v)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetPhoneVerification(ctx context.Context, userID uuid.UUID) (*PhoneVerification, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PhoneVerification), args.Error(1)
+}
+
+func (m *MockRepository) IncrementVerificationAttempts(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeletePhoneVerification(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetPhone(ctx context.Context, userID uuid.UUID) (*PhoneContact, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PhoneContact), args.Error(1)
+}
+
+func (m *MockRepository) SetPhone(ctx context.Context, userID uuid.UUID, phone string, verifiedAt *time.Time) error {
+	args := m.Called(ctx, userID, phone, verifiedAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetTicketHolderPhones(ctx context.Context, eventID uuid.UUID) ([]PhoneContact, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).([]PhoneContact), args.Error(1)
+}
+
+func (m *MockRepository) GetTicketHolderIDs(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) GetRecipient(ctx context.Context, userID uuid.UUID) (*Recipient, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Recipient), args.Error(1)
+}
+
+// MockJobService is a mock implementation of job.Service interface
+type MockJobService struct {
+	mock.Mock
+}
+
+func (m *MockJobService) Enqueue(ctx context.Context, jobType string, payload interface{}, opts ...job.EnqueueOption) (*job.Job, error) {
+	args := m.Called(ctx, jobType, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) GetJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) ListJobs(ctx context.Context, status string, limit int) ([]*job.Job, error) {
+	args := m.Called(ctx, status, limit)
+	return args.Get(0).([]*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) RetryJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+// MockEventService is a mock implementation of event.Service interface
+type MockEventService struct {
+	mock.Mock
+}
+
+func (m *MockEventService) CreateEvent(ctx context.Context, e *event.Event) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockEventService) GetEventByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetEventBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetAllEvents(ctx context.Context, filter event.ListFilter, viewer event.Viewer) ([]*event.Event, error) {
+	args := m.Called(ctx, filter, viewer)
+	return args.Get(0).([]*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, filter event.OrganizerFilter) ([]*event.Event, int, error) {
+	args := m.Called(ctx, organizerID, filter)
+	return args.Get(0).([]*event.Event), args.Int(1), args.Error(2)
+}
+
+func (m *MockEventService) GetUpcomingEvents(ctx context.Context, filter event.UpcomingFilter) ([]*event.Event, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) UpdateEvent(ctx context.Context, e *event.Event) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockEventService) CancelEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
+	args := m.Called(ctx, eventID, organizerID)
+	return args.Error(0)
+}
+
+func (m *MockEventService) ApproveModeration(ctx context.Context, eventID uuid.UUID, adminID uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, eventID, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) CanManage(ctx context.Context, e *event.Event, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, e, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockEventService) RefreshOnSale(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockEventService) AdjustInventory(ctx context.Context, eventID uuid.UUID, viewer event.Viewer, delta int, reason string) (*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer, delta, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID, viewer event.Viewer) ([]*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) DeleteEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
+	args := m.Called(ctx, eventID, organizerID)
+	return args.Error(0)
+}
+
+func TestService_GetPreferences(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	repo := new(MockRepository)
+	repo.On("GetPreferences", ctx, userID).Return([]*Preference{
+		{UserID: userID, Type: TypeEventChanged, Email: false, InApp: true},
+	}, nil)
+
+	prefs, err := NewService(repo, new(MockJobService)).GetPreferences(ctx, userID)
+
+	require.NoError(t, err)
+	require.Len(t, prefs, len(Types))
+	assert.Equal(t, DefaultPreference(userID, TypeOrderConfirmed), prefs[0])
+	assert.Equal(t, TypeEventChanged, prefs[1].Type)
+	assert.False(t, prefs[1].Email)
+	assert.True(t, prefs[1].InApp)
+	assert.Equal(t, DefaultPreference(userID, TypeWaitlistPromoted), prefs[2])
+	assert.Equal(t, DefaultPreference(userID, TypeOrderMessage), prefs[3])
+}
+
+func TestService_UpdatePreferences(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("invalid type", func(t *testing.T) {
+		repo := new(MockRepository)
+
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{}, nil)
+
+		_, err := NewService(repo, new(MockJobService)).UpdatePreferences(ctx, userID, []PreferenceUpdate{{Type: "NEWSLETTER"}})
+
+		assert.Equal(t, ErrInvalidType, err)
+		repo.AssertNotCalled(t, "SavePreferences", mock.Anything, mock.Anything)
+	})
+
+	t.Run("stores preferences for the user", func(t *testing.T) {
+		off := false
+		repo := new(MockRepository)
+		repo.On("SavePreferences", ctx, mock.MatchedBy(func(prefs []*Preference) bool {
+			return len(prefs) == 1 && prefs[0].UserID == userID && prefs[0].Type == TypeOrderConfirmed &&
+				!prefs[0].Email && prefs[0].InApp && prefs[0].Push
+		})).Return(nil)
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{}, nil)
+
+		prefs, err := NewService(repo, new(MockJobService)).UpdatePreferences(ctx, userID, []PreferenceUpdate{
+			{Type: "order_confirmed", Email: &off},
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, prefs, len(Types))
+		assert.False(t, prefs[0].Email)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("omitted channels keep their stored setting", func(t *testing.T) {
+		on := true
+		repo := new(MockRepository)
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{
+			{UserID: userID, Type: TypeEventChanged, Email: false, InApp: true, Push: false},
+		}, nil)
+		repo.On("SavePreferences", ctx, mock.MatchedBy(func(prefs []*Preference) bool {
+			return len(prefs) == 1 && prefs[0].Type == TypeEventChanged && !prefs[0].Email && prefs[0].InApp && prefs[0].Push
+		})).Return(nil)
+
+		_, err := NewService(repo, new(MockJobService)).UpdatePreferences(ctx, userID, []PreferenceUpdate{
+			{Type: TypeEventChanged, Push: &on},
+		})
+
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestService_Notify(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	msg := Message{Type: TypeOrderConfirmed, Title: "Order confirmed: Jazz Night", Body: "Your order is confirmed."}
+
+	t.Run("defaults deliver in-app, by email and by push", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobService := new(MockJobService)
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{}, nil)
+		repo.On("GetDevices", ctx, userID).Return([]*Device{}, nil)
+		repo.On("Create", ctx, mock.MatchedBy(func(n *Notification) bool {
+			return n.UserID == userID && n.Type == TypeOrderConfirmed && n.Title == msg.Title && !n.IsRead()
+		})).Return(nil)
+		jobService.On("Enqueue", ctx, JobTypeEmail, EmailPayload{UserID: userID, Type: msg.Type, Title: msg.Title, Body: msg.Body}).Return(&job.Job{}, nil)
+
+		err := NewService(repo, jobService).Notify(ctx, userID, msg)
+
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+		jobService.AssertExpectations(t)
+	})
+
+	t.Run("email turned off", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobService := new(MockJobService)
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{{UserID: userID, Type: TypeOrderConfirmed, InApp: true}}, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*notification.Notification")).Return(nil)
+
+		err := NewService(repo, jobService).Notify(ctx, userID, msg)
+
+		require.NoError(t, err)
+		jobService.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("in-app turned off", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobService := new(MockJobService)
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{{UserID: userID, Type: TypeOrderConfirmed, Email: true}}, nil)
+		jobService.On("Enqueue", ctx, JobTypeEmail, mock.Anything).Return(&job.Job{}, nil)
+
+		err := NewService(repo, jobService).Notify(ctx, userID, msg)
+
+		require.NoError(t, err)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("push queues a job per device", func(t *testing.T) {
+		eventID := uuid.New()
+		pushMsg := Message{Type: TypeEventChanged, Title: "Jazz Night has changed", Body: "It now takes place later.", EventID: &eventID}
+		repo := new(MockRepository)
+		jobService := new(MockJobService)
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{{UserID: userID, Type: TypeEventChanged, Push: true}}, nil)
+		repo.On("GetDevices", ctx, userID).Return([]*Device{
+			{UserID: userID, Platform: PlatformAndroid, Token: "fcm-token"},
+			{UserID: userID, Platform: PlatformIOS, Token: "apns-token"},
+		}, nil)
+		data := map[string]string{"type": TypeEventChanged, "event_id": eventID.String()}
+		for _, d := range []struct{ platform, token string }{{PlatformAndroid, "fcm-token"}, {PlatformIOS, "apns-token"}} {
+			jobService.On("Enqueue", ctx, JobTypePush, PushPayload{
+				UserID: userID, Platform: d.platform, Token: d.token,
+				Type: pushMsg.Type, Title: pushMsg.Title, Body: pushMsg.Body, Data: data,
+			}).Return(&job.Job{}, nil).Once()
+		}
+
+		err := NewService(repo, jobService).Notify(ctx, userID, pushMsg)
+
+		require.NoError(t, err)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		jobService.AssertExpectations(t)
+	})
+
+	t.Run("queue failure", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobService := new(MockJobService)
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{{UserID: userID, Type: TypeOrderConfirmed, Email: true}}, nil)
+		jobService.On("Enqueue", ctx, JobTypeEmail, mock.Anything).Return(nil, errors.New("db down"))
+
+		err := NewService(repo, jobService).Notify(ctx, userID, msg)
+
+		assert.Equal(t, ErrDeliveryFailed.Code, GetNotificationErrorCode(err))
+	})
+}
+
+func TestService_RegisterDevice(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("invalid platform", func(t *testing.T) {
+		repo := new(MockRepository)
+
+		_, err := NewService(repo, new(MockJobService)).RegisterDevice(ctx, userID, "WINDOWS", "token")
+
+		assert.Equal(t, ErrInvalidPlatform, err)
+		repo.AssertNotCalled(t, "SaveDevice", mock.Anything, mock.Anything)
+	})
+
+	t.Run("blank token", func(t *testing.T) {
+		_, err := NewService(new(MockRepository), new(MockJobService)).RegisterDevice(ctx, userID, PlatformIOS, "  ")
+
+		assert.Equal(t, ErrInvalidDeviceToken, err)
+	})
+
+	t.Run("saves the device", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("SaveDevice", ctx, mock.MatchedBy(func(d *Device) bool {
+			return d.UserID == userID && d.Platform == PlatformAndroid && d.Token == "fcm-token"
+		})).Return(nil)
+
+		device, err := NewService(repo, new(MockJobService)).RegisterDevice(ctx, userID, "android", " fcm-token ")
+
+		require.NoError(t, err)
+		assert.Equal(t, PlatformAndroid, device.Platform)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestService_PhoneVerification(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("invalid phone", func(t *testing.T) {
+		_, err := NewService(new(MockRepository), new(MockJobService)).StartPhoneVerification(ctx, userID, "555-0123")
+
+		assert.Equal(t, ErrInvalidPhone, err)
+	})
+
+	t.Run("codes are not resent within a minute", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetPhoneVerification", ctx, userID).Return(&PhoneVerification{UserID: userID, CreatedAt: time.Now().Add(-10 * time.Second)}, nil)
+
+		_, err := NewService(repo, new(MockJobService)).StartPhoneVerification(ctx, userID, "+14155550123")
+
+		assert.Equal(t, ErrVerificationTooSoon, err)
+		repo.AssertNotCalled(t, "SavePhoneVerification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("texted code verifies the phone", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobService := new(MockJobService)
+		var stored *PhoneVerification
+		var code string
+		repo.On("GetPhoneVerification", ctx, userID).Return(nil, ErrVerificationNotFound).Once()
+		repo.On("SavePhoneVerification", ctx, mock.AnythingOfType("*notification.PhoneVerification")).
+			Run(func(args mock.Arguments) { stored = args.Get(1).(*PhoneVerification) }).Return(nil)
+		jobService.On("Enqueue", ctx, JobTypeSMS, mock.MatchedBy(func(p SMSPayload) bool {
+			code = strings.Fields(p.Body)[4][:6]
+			return p.UserID == userID && p.Phone == "+14155550123"
+		})).Return(&job.Job{}, nil)
+		service := NewService(repo, jobService)
+
+		verification, err := service.StartPhoneVerification(ctx, userID, "+1 (415) 555-0123")
+		require.NoError(t, err)
+		assert.Equal(t, "+14155550123", verification.Phone)
+		assert.NotContains(t, stored.CodeHash, code)
+
+		repo.On("GetPhoneVerification", ctx, userID).Return(stored, nil)
+		repo.On("SetPhone", ctx, userID, "+14155550123", mock.AnythingOfType("*time.Time")).Return(nil)
+		repo.On("DeletePhoneVerification", ctx, userID).Return(nil)
+
+		contact, err := service.VerifyPhone(ctx, userID, code)
+
+		require.NoError(t, err)
+		assert.Equal(t, "+14155550123", contact.Phone)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("wrong code counts an attempt", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetPhoneVerification", ctx, userID).Return(&PhoneVerification{
+			UserID: userID, Phone: "+14155550123", CodeHash: hashVerificationCode(userID, "123456"), ExpiresAt: time.Now().Add(time.Minute),
+		}, nil)
+		repo.On("IncrementVerificationAttempts", ctx, userID).Return(nil)
+
+		_, err := NewService(repo, new(MockJobService)).VerifyPhone(ctx, userID, "654321")
+
+		assert.Equal(t, ErrInvalidCode, err)
+		repo.AssertNotCalled(t, "SetPhone", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("too many attempts", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetPhoneVerification", ctx, userID).Return(&PhoneVerification{
+			UserID: userID, CodeHash: hashVerificationCode(userID, "123456"), Attempts: MaxVerificationAttempts, ExpiresAt: time.Now().Add(time.Minute),
+		}, nil)
+
+		_, err := NewService(repo, new(MockJobService)).VerifyPhone(ctx, userID, "123456")
+
+		assert.Equal(t, ErrTooManyAttempts, err)
+	})
+
+	t.Run("expired code", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetPhoneVerification", ctx, userID).Return(&PhoneVerification{
+			UserID: userID, CodeHash: hashVerificationCode(userID, "123456"), ExpiresAt: time.Now().Add(-time.Second),
+		}, nil)
+
+		_, err := NewService(repo, new(MockJobService)).VerifyPhone(ctx, userID, "123456")
+
+		assert.Equal(t, ErrVerificationExpired, err)
+	})
+}
+
+func TestService_PrepareEmail(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	eventID := uuid.New()
+	payload := EmailPayload{
+		UserID: userID, Type: TypeEventChanged, Title: "Jazz Night has changed", Body: "It now takes place later.",
+		EventID: &eventID, Data: map[string]string{DataEventTitle: "Jazz Night", DataChanges: "event_date"},
+	}
+
+	t.Run("opted out after queueing", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{{UserID: userID, Type: TypeEventChanged, InApp: true}}, nil)
+
+		mail, err := NewService(repo, new(MockJobService)).PrepareEmail(ctx, payload)
+
+		require.NoError(t, err)
+		assert.Nil(t, mail)
+		repo.AssertNotCalled(t, "GetRecipient", mock.Anything, mock.Anything)
+	})
+
+	t.Run("builds the email", func(t *testing.T) {
+		repo := new(MockRepository)
+		recipient := &Recipient{UserID: userID, Email: "fan@example.com", Username: "fan"}
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{}, nil)
+		repo.On("GetRecipient", ctx, userID).Return(recipient, nil)
+
+		mail, err := NewService(repo, new(MockJobService)).PrepareEmail(ctx, payload)
+
+		require.NoError(t, err)
+		assert.Equal(t, &Email{
+			Recipient: recipient, Type: TypeEventChanged, Title: payload.Title, Body: payload.Body,
+			EventID: &eventID, Data: payload.Data,
+		}, mail)
+	})
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	orderID := uuid.New()
+	eventID := uuid.New()
+	ev := &event.Event{ID: eventID, Title: "Jazz Night", EventDate: time.Date(2026, 11, 20, 20, 0, 0, 0, time.UTC)}
+
+	t.Run("order confirmations notify the buyer", func(t *testing.T) {
+		bus := eventbus.New()
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(ev, nil)
+		repo := new(MockRepository)
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{{UserID: userID, Type: TypeOrderConfirmed, InApp: true}}, nil)
+		repo.On("Create", ctx, mock.MatchedBy(func(n *Notification) bool {
+			return n.UserID == userID && n.Type == TypeOrderConfirmed && n.Title == "Order confirmed: Jazz Night" &&
+				strings.Contains(n.Body, "2 tickets for Jazz Night") && *n.OrderID == orderID
+		})).Return(nil)
+		Subscribe(bus, NewService(repo, new(MockJobService)), eventService)
+
+		bus.Publish(ctx, order.OrderConfirmed{OrderID: orderID, UserID: userID, EventID: eventID, Quantity: 2, TotalAmount: 50})
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("cancellations notify every ticket holder", func(t *testing.T) {
+		otherUserID := uuid.New()
+		bus := eventbus.New()
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(ev, nil)
+		repo := new(MockRepository)
+		repo.On("GetTicketHolderIDs", ctx, eventID).Return([]uuid.UUID{userID, otherUserID}, nil)
+		repo.On("GetPreferences", ctx, mock.Anything).Return([]*Preference{{Type: TypeEventChanged, InApp: true}}, nil)
+		repo.On("Create", ctx, mock.MatchedBy(func(n *Notification) bool {
+			return n.Type == TypeEventChanged && n.Title == "Jazz Night has been cancelled"
+		})).Return(nil).Twice()
+		Subscribe(bus, NewService(repo, new(MockJobService)), eventService)
+
+		bus.Publish(ctx, event.EventChanged{EventID: eventID, Title: "Jazz Night", Cancelled: true})
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("order messages notify the recipient", func(t *testing.T) {
+		organizerID := uuid.New()
+		bus := eventbus.New()
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(ev, nil)
+		repo := new(MockRepository)
+		repo.On("GetPreferences", ctx, organizerID).Return([]*Preference{{UserID: organizerID, Type: TypeOrderMessage, InApp: true}}, nil)
+		repo.On("Create", ctx, mock.MatchedBy(func(n *Notification) bool {
+			return n.UserID == organizerID && n.Type == TypeOrderMessage && n.Title == "New message about Jazz Night" &&
+				strings.HasPrefix(n.Body, "The buyer wrote about order ") && strings.HasSuffix(n.Body, ": Is there parking?") &&
+				*n.OrderID == orderID
+		})).Return(nil)
+		Subscribe(bus, NewService(repo, new(MockJobService)), eventService)
+
+		bus.Publish(ctx, messaging.MessagePosted{
+			MessageID: uuid.New(), OrderID: orderID, EventID: eventID, SenderID: userID, RecipientID: organizerID,
+			SenderRole: messaging.RoleBuyer, Body: "Is there parking?",
+		})
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("claimed gifts notify the recipient and the buyer", func(t *testing.T) {
+		recipientID := uuid.New()
+		bus := eventbus.New()
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(ev, nil)
+		repo := new(MockRepository)
+		repo.On("GetPreferences", ctx, recipientID).Return([]*Preference{{UserID: recipientID, Type: TypeGift, InApp: true}}, nil)
+		repo.On("GetPreferences", ctx, userID).Return([]*Preference{{UserID: userID, Type: TypeGift, InApp: true}}, nil)
+		repo.On("Create", ctx, mock.MatchedBy(func(n *Notification) bool {
+			return n.UserID == recipientID && n.Type == TypeGift && n.Title == "Gift claimed: Jazz Night" &&
+				strings.HasPrefix(n.Body, "2 tickets for Jazz Night") && *n.OrderID == orderID
+		})).Return(nil).Once()
+		repo.On("Create", ctx, mock.MatchedBy(func(n *Notification) bool {
+			return n.UserID == userID && n.Type == TypeGift && n.Title == "Your gift was claimed: Jazz Night" && *n.OrderID == orderID
+		})).Return(nil).Once()
+		Subscribe(bus, NewService(repo, new(MockJobService)), eventService)
+
+		bus.Publish(ctx, order.GiftClaimed{OrderID: orderID, EventID: eventID, PurchaserID: userID, RecipientID: recipientID, Quantity: 2})
+
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestEventChangedMessage(t *testing.T) {
+	ev := &event.Event{ID: uuid.New(), Title: "Jazz Night", EventDate: time.Date(2026, 11, 20, 20, 0, 0, 0, time.UTC)}
+
+	msg := eventChangedMessage(event.EventChanged{EventID: ev.ID, Title: ev.Title, Changes: []string{event.ChangeDate, event.ChangeVenue}}, ev)
+
+	assert.Equal(t, "Jazz Night has changed", msg.Title)
+	assert.Contains(t, msg.Body, "It now takes place on Fri Nov 20, 2026 20:00 UTC.")
+	assert.Contains(t, msg.Body, "It has moved to a different venue.")
+	assert.Equal(t, map[string]string{
+		DataEventTitle: "Jazz Night", DataEventDate: "2026-11-20T20:00:00Z", DataChanges: "event_date,venue",
+	}, msg.Data)
+}
+
+func TestSubscribeSMSAlerts(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+	userID := uuid.New()
+	clk := clock.NewFake(time.Date(2026, 11, 20, 17, 0, 0, 0, time.UTC))
+
+	t.Run("same-day cancellations are texted", func(t *testing.T) {
+		bus := eventbus.New()
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(&event.Event{ID: eventID, Title: "Jazz Night", EventDate: clk.Now().Add(3 * time.Hour)}, nil)
+		repo := new(MockRepository)
+		jobService := new(MockJobService)
+		repo.On("GetTicketHolderPhones", ctx, eventID).Return([]PhoneContact{{UserID: userID, Phone: "+14155550123"}}, nil)
+		jobService.On("Enqueue", ctx, JobTypeSMS, mock.MatchedBy(func(p SMSPayload) bool {
+			return p.UserID == userID && p.Phone == "+14155550123" && strings.HasPrefix(p.Body, "Jazz Night on ")
+		})).Return(&job.Job{}, nil)
+		SubscribeSMSAlerts(bus, NewService(repo, jobService), eventService, clk)
+
+		bus.Publish(ctx, event.EventChanged{EventID: eventID, Title: "Jazz Night", Cancelled: true})
+
+		jobService.AssertExpectations(t)
+	})
+
+	t.Run("later cancellations and other changes are not texted", func(t *testing.T) {
+		bus := eventbus.New()
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(&event.Event{ID: eventID, Title: "Jazz Night", EventDate: clk.Now().Add(72 * time.Hour)}, nil)
+		repo := new(MockRepository)
+		SubscribeSMSAlerts(bus, NewService(repo, new(MockJobService)), eventService, clk)
+
+		bus.Publish(ctx, event.EventChanged{EventID: eventID, Title: "Jazz Night", Cancelled: true})
+		bus.Publish(ctx, event.EventChanged{EventID: eventID, Title: "Jazz Night", Changes: []string{event.ChangeDate}})
+
+		repo.AssertNotCalled(t, "GetTicketHolderPhones", mock.Anything, mock.Anything)
+		eventService.AssertNumberOfCalls(t, "GetEventByID", 1)
+	})
+}
+
+func TestNormalizePhone(t *testing.T) {
+	phone, ok := NormalizePhone("+44 20 7946-0958")
+	assert.True(t, ok)
+	assert.Equal(t, "+442079460958", phone)
+
+	_, ok = NormalizePhone("020 7946 0958")
+	assert.False(t, ok)
+}
@@ -0,0 +1,224 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/messaging"
+	"enterprise-crud/internal/domain/order"
+)
+
+// dateFormat is how event dates appear in notifications
+const dateFormat = "Mon Jan 2, 2006 15:04 MST"
+
+// Subscribe registers the handlers that turn domain events into notifications
+func Subscribe(bus *eventbus.Bus, service Service, eventService event.Service) {
+	bus.Subscribe(order.TopicOrderConfirmed, func(ctx context.Context, e eventbus.Event) error {
+		confirmed := e.(order.OrderConfirmed)
+		ev, err := eventService.GetEventByID(ctx, confirmed.EventID)
+		if err != nil {
+			return err
+		}
+		return service.Notify(ctx, confirmed.UserID, orderConfirmedMessage(confirmed, ev))
+	})
+
+	bus.Subscribe(event.TopicEventChanged, func(ctx context.Context, e eventbus.Event) error {
+		changed := e.(event.EventChanged)
+		ev, err := eventService.GetEventByID(ctx, changed.EventID)
+		if err != nil {
+			return err
+		}
+		return service.NotifyTicketHolders(ctx, changed.EventID, eventChangedMessage(changed, ev))
+	})
+
+	bus.Subscribe(event.TopicWaitlistPromoted, func(ctx context.Context, e eventbus.Event) error {
+		promoted := e.(event.WaitlistPromoted)
+		ev, err := eventService.GetEventByID(ctx, promoted.EventID)
+		if err != nil {
+			return err
+		}
+		return service.Notify(ctx, promoted.UserID, waitlistPromotedMessage(promoted, ev))
+	})
+
+	bus.Subscribe(messaging.TopicMessagePosted, func(ctx context.Context, e eventbus.Event) error {
+		posted := e.(messaging.MessagePosted)
+		ev, err := eventService.GetEventByID(ctx, posted.EventID)
+		if err != nil {
+			return err
+		}
+		return service.Notify(ctx, posted.RecipientID, orderMessageMessage(posted, ev))
+	})
+
+	bus.Subscribe(order.TopicGiftClaimed, func(ctx context.Context, e eventbus.Event) error {
+		claimed := e.(order.GiftClaimed)
+		ev, err := eventService.GetEventByID(ctx, claimed.EventID)
+		if err != nil {
+			return err
+		}
+		received, sent := giftClaimedMessages(claimed, ev)
+		if err := service.Notify(ctx, claimed.RecipientID, received); err != nil {
+			return err
+		}
+		return service.Notify(ctx, claimed.PurchaserID, sent)
+	})
+}
+
+// SubscribeSMSAlerts registers the handler that texts ticket holders when an event is
+// cancelled within SMSAlertWindow of its start by clk, when an email may not be read in time
+func SubscribeSMSAlerts(bus *eventbus.Bus, service Service, eventService event.Service, clk clock.Clock) {
+	bus.Subscribe(event.TopicEventChanged, func(ctx context.Context, e eventbus.Event) error {
+		changed := e.(event.EventChanged)
+		if !changed.Cancelled {
+			return nil
+		}
+		ev, err := eventService.GetEventByID(ctx, changed.EventID)
+		if err != nil {
+			return err
+		}
+		if !startsSoon(ev, clk.Now()) {
+			return nil
+		}
+		return service.AlertTicketHoldersBySMS(ctx, changed.EventID, cancellationSMS(ev))
+	})
+}
+
+// startsSoon checks if an event starts within SMSAlertWindow
+func startsSoon(ev *event.Event, now time.Time) bool {
+	return ev.EventDate.After(now) && ev.EventDate.Sub(now) <= SMSAlertWindow
+}
+
+// cancellationSMS builds the text sent to ticket holders of a cancelled event
+func cancellationSMS(ev *event.Event) string {
+	return fmt.Sprintf("%s on %s has been cancelled by the organizer. Check your email for details.",
+		ev.Title, ev.EventDate.Format(dateFormat))
+}
+
+// orderConfirmedMessage builds the notification for a completed order
+func orderConfirmedMessage(confirmed order.OrderConfirmed, ev *event.Event) Message {
+	return Message{
+		Type:  TypeOrderConfirmed,
+		Title: "Order confirmed: " + ev.Title,
+		Body: fmt.Sprintf("Your order of %s for %s on %s is confirmed. Total paid: %.2f.",
+			tickets(confirmed.Quantity), ev.Title, ev.EventDate.Format(dateFormat), confirmed.TotalAmount),
+		EventID: &confirmed.EventID,
+		OrderID: &confirmed.OrderID,
+		Data: map[string]string{
+			DataEventTitle: ev.Title,
+			DataEventDate:  ev.EventDate.Format(time.RFC3339),
+			DataQuantity:   strconv.Itoa(confirmed.Quantity),
+			DataTotal:      strconv.FormatFloat(confirmed.TotalAmount, 'f', 2, 64),
+		},
+	}
+}
+
+// eventChangedMessage builds the notification sent to ticket holders when an event changes
+func eventChangedMessage(changed event.EventChanged, ev *event.Event) Message {
+	msg := Message{
+		Type:    TypeEventChanged,
+		EventID: &changed.EventID,
+		Data: map[string]string{
+			DataEventTitle: ev.Title,
+			DataEventDate:  ev.EventDate.Format(time.RFC3339),
+		},
+	}
+	if changed.Cancelled {
+		msg.Data[DataCancelled] = "true"
+		msg.Title = ev.Title + " has been cancelled"
+		msg.Body = fmt.Sprintf("The organizer has cancelled %s, which was scheduled for %s.", ev.Title, ev.EventDate.Format(dateFormat))
+		return msg
+	}
+
+	var details []string
+	for _, change := range changed.Changes {
+		switch change {
+		case event.ChangeTitle:
+			details = append(details, "It is now called "+ev.Title+".")
+		case event.ChangeDate:
+			details = append(details, "It now takes place on "+ev.EventDate.Format(dateFormat)+".")
+		case event.ChangeVenue:
+			details = append(details, "It has moved to a different venue.")
+		}
+	}
+	msg.Data[DataChanges] = strings.Join(changed.Changes, ",")
+	msg.Title = ev.Title + " has changed"
+	msg.Body = "An event you have tickets for has been updated. " + strings.Join(details, " ")
+	return msg
+}
+
+// waitlistPromotedMessage builds the notification for a user offered tickets from a waitlist
+func waitlistPromotedMessage(promoted event.WaitlistPromoted, ev *event.Event) Message {
+	return Message{
+		Type:  TypeWaitlistPromoted,
+		Title: "Tickets available: " + ev.Title,
+		Body: fmt.Sprintf("Good news: %s for %s on %s became available and are being held for you.",
+			tickets(promoted.Quantity), ev.Title, ev.EventDate.Format(dateFormat)),
+		EventID: &promoted.EventID,
+	}
+}
+
+// messagePreviewLength bounds how much of an order message its notification quotes, in characters
+const messagePreviewLength = 200
+
+// orderMessageMessage builds the notification for the recipient of an order message
+func orderMessageMessage(posted messaging.MessagePosted, ev *event.Event) Message {
+	sender := "The buyer"
+	if posted.SenderRole == messaging.RoleOrganizer {
+		sender = "The organizer"
+	}
+
+	preview := []rune(posted.Body)
+	body := string(preview)
+	if len(preview) > messagePreviewLength {
+		body = string(preview[:messagePreviewLength]) + "…"
+	}
+
+	return Message{
+		Type:    TypeOrderMessage,
+		Title:   "New message about " + ev.Title,
+		Body:    fmt.Sprintf("%s wrote about order %s: %s", sender, posted.OrderID, body),
+		EventID: &posted.EventID,
+		OrderID: &posted.OrderID,
+	}
+}
+
+// giftClaimedMessages builds the notifications for the recipient of a claimed gift and for its buyer
+func giftClaimedMessages(claimed order.GiftClaimed, ev *event.Event) (received, sent Message) {
+	data := map[string]string{
+		DataEventTitle: ev.Title,
+		DataEventDate:  ev.EventDate.Format(time.RFC3339),
+		DataQuantity:   strconv.Itoa(claimed.Quantity),
+	}
+	received = Message{
+		Type:  TypeGift,
+		Title: "Gift claimed: " + ev.Title,
+		Body: fmt.Sprintf("%s for %s on %s are now in your account.",
+			tickets(claimed.Quantity), ev.Title, ev.EventDate.Format(dateFormat)),
+		EventID: &claimed.EventID,
+		OrderID: &claimed.OrderID,
+		Data:    data,
+	}
+	sent = Message{
+		Type:    TypeGift,
+		Title:   "Your gift was claimed: " + ev.Title,
+		Body:    fmt.Sprintf("Your gift of %s for %s has been claimed by its recipient.", tickets(claimed.Quantity), ev.Title),
+		EventID: &claimed.EventID,
+		OrderID: &claimed.OrderID,
+		Data:    maps.Clone(data),
+	}
+	return received, sent
+}
+
+// tickets formats a ticket count
+func tickets(quantity int) string {
+	if quantity == 1 {
+		return "1 ticket"
+	}
+	return fmt.Sprintf("%d tickets", quantity)
+}
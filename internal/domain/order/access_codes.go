@@ -0,0 +1,58 @@
+package order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AccessCodeRedemption is the use of an event access code by an order about to be placed
+type AccessCodeRedemption struct {
+	EventID  uuid.UUID
+	OrderID  uuid.UUID
+	UserID   uuid.UUID
+	Code     string
+	Quantity int
+}
+
+// AccessCodeRedeemer redeems the access codes of events that require one
+// It is called inside the order transaction, so an order that fails later gives the use back.
+type AccessCodeRedeemer interface {
+	// RedeemWithTx records a use of the code, reporting false when it is unknown or used up
+	RedeemWithTx(ctx context.Context, tx *gorm.DB, redemption AccessCodeRedemption) (bool, error)
+}
+
+// WithAccessCodes redeems access codes for orders of events that require one
+// Without it such events refuse every order, as no code can be checked.
+func WithAccessCodes(redeemer AccessCodeRedeemer) ServiceOption {
+	return func(s *OrderService) {
+		s.accessCodes = redeemer
+	}
+}
+
+// redeemAccessCode redeems the buyer's access code when the event requires one
+// CreateOrder has already refused orders without a code.
+func (s *OrderService) redeemAccessCode(ctx context.Context, tx *gorm.DB, eventInfo *EventInfo, o *Order, code string) error {
+	if !eventInfo.AccessCodeRequired {
+		return nil
+	}
+	if s.accessCodes == nil {
+		return NewAccessCodeInvalidError()
+	}
+
+	redeemed, err := s.accessCodes.RedeemWithTx(ctx, tx, AccessCodeRedemption{
+		EventID:  eventInfo.ID,
+		OrderID:  o.ID,
+		UserID:   o.UserID,
+		Code:     code,
+		Quantity: o.Quantity,
+	})
+	if err != nil {
+		return NewOrderCreationError(err)
+	}
+	if !redeemed {
+		return NewAccessCodeInvalidError()
+	}
+	return nil
+}
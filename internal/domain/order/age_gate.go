@@ -0,0 +1,53 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BirthDates looks up the dates of birth buyers gave on their profiles
+type BirthDates interface {
+	// DateOfBirth returns the user's date of birth, nil when they haven't given one
+	DateOfBirth(ctx context.Context, userID uuid.UUID) (*time.Time, error)
+}
+
+// WithAgeGate checks buyers' ages against the minimum age of age-restricted events
+// Without it such events refuse every order, as no age can be checked.
+func WithAgeGate(birthDates BirthDates) ServiceOption {
+	return func(s *OrderService) {
+		s.birthDates = birthDates
+	}
+}
+
+// checkAge refuses buyers younger than the event's minimum age, or without a date of birth
+func (s *OrderService) checkAge(ctx context.Context, eventInfo *EventInfo, userID uuid.UUID, now time.Time) error {
+	if eventInfo.MinimumAge <= 0 {
+		return nil
+	}
+	if s.birthDates == nil {
+		return NewDateOfBirthRequiredError(eventInfo.ID, eventInfo.MinimumAge)
+	}
+
+	dateOfBirth, err := s.birthDates.DateOfBirth(ctx, userID)
+	if err != nil {
+		return NewOrderCreationError(err)
+	}
+	if dateOfBirth == nil {
+		return NewDateOfBirthRequiredError(eventInfo.ID, eventInfo.MinimumAge)
+	}
+	if ageOn(*dateOfBirth, now.UTC()) < eventInfo.MinimumAge {
+		return NewAgeRestrictedError(eventInfo.ID, eventInfo.MinimumAge)
+	}
+	return nil
+}
+
+// ageOn returns how many full years old someone born on dateOfBirth is on day
+func ageOn(dateOfBirth, day time.Time) int {
+	age := day.Year() - dateOfBirth.Year()
+	if day.Month() < dateOfBirth.Month() || (day.Month() == dateOfBirth.Month() && day.Day() < dateOfBirth.Day()) {
+		age--
+	}
+	return age
+}
@@ -0,0 +1,16 @@
+package order
+
+// archiveBatchSize bounds how many events a single archiving transaction moves
+const archiveBatchSize = 100
+
+// Archived counts what was moved to the archive tables
+type Archived struct {
+	Events int
+	Orders int
+}
+
+// add accumulates the counts of another batch
+func (a *Archived) add(batch *Archived) {
+	a.Events += batch.Events
+	a.Orders += batch.Orders
+}
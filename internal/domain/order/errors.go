@@ -2,6 +2,11 @@ package order
 
 import (
 	"fmt"
+	"time"
+
+	"enterprise-crud/internal/domain/errcode"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/values"
 
 	"github.com/google/uuid"
 )
@@ -34,6 +39,27 @@ const (
 	ValidationErrorCode          = "VALIDATION_ERROR"
 	OrderCreationErrorCode       = "ORDER_CREATION_ERROR"
 	UnauthorizedErrorCode        = "UNAUTHORIZED"
+	OrderNotCompletedErrorCode   = "ORDER_NOT_COMPLETED"
+	AlreadyCheckedInErrorCode    = "ALREADY_CHECKED_IN"
+	InvalidAnswersErrorCode      = "INVALID_ANSWERS"
+	OrderRejectedErrorCode       = "ORDER_REJECTED"
+	OrderNotInReviewErrorCode    = "ORDER_NOT_IN_REVIEW"
+	OrderArchivedErrorCode       = "ORDER_ARCHIVED"
+	AccessCodeRequiredErrorCode  = "ACCESS_CODE_REQUIRED"
+	AccessCodeInvalidErrorCode   = "ACCESS_CODE_INVALID"
+	SalesNotStartedErrorCode     = "SALES_NOT_STARTED"
+	SalesEndedErrorCode          = "SALES_ENDED"
+	AgeRestrictedErrorCode       = "AGE_RESTRICTED"
+	DateOfBirthRequiredErrorCode = "DATE_OF_BIRTH_REQUIRED"
+	InvalidTransitionErrorCode   = "INVALID_STATUS_TRANSITION"
+	GiftNotFoundErrorCode        = "GIFT_NOT_FOUND"
+	GiftAlreadyClaimedErrorCode  = "GIFT_ALREADY_CLAIMED"
+	OwnGiftErrorCode             = "CANNOT_CLAIM_OWN_GIFT"
+	NotAGiftErrorCode            = "NOT_A_GIFT"
+	GroupNotFoundErrorCode       = "GROUP_NOT_FOUND"
+	GroupShareNotFoundErrorCode  = "GROUP_SHARE_NOT_FOUND"
+	GroupShareTakenErrorCode     = "GROUP_SHARE_TAKEN"
+	GroupHoldExpiredErrorCode    = "GROUP_HOLD_EXPIRED"
 )
 
 // NewOrderNotFoundError creates a new order not found error
@@ -64,12 +90,12 @@ func NewInsufficientTicketsError(requested, available int) *OrderError {
 func NewInvalidQuantityError(quantity int) *OrderError {
 	return &OrderError{
 		Code:    InvalidQuantityErrorCode,
-		Message: fmt.Sprintf("Invalid quantity: %d. Quantity must be greater than 0", quantity),
+		Message: fmt.Sprintf("Invalid quantity: %d. Quantity %s", quantity, values.ErrInvalidQuantity.Rule),
 	}
 }
 
 // NewEventNotActiveError creates a new event not active error
-func NewEventNotActiveError(eventID uuid.UUID, status string) *OrderError {
+func NewEventNotActiveError(eventID uuid.UUID, status event.Status) *OrderError {
 	return &OrderError{
 		Code:    EventNotActiveErrorCode,
 		Message: fmt.Sprintf("Event %s is not active (status: %s)", eventID, status),
@@ -93,6 +119,178 @@ func NewOrderCreationError(err error) *OrderError {
 	}
 }
 
+// NewOrderNotCompletedError creates an error for checking in an order that was not paid
+func NewOrderNotCompletedError(id uuid.UUID, status Status) *OrderError {
+	return &OrderError{
+		Code:    OrderNotCompletedErrorCode,
+		Message: fmt.Sprintf("Order %s is not completed (status: %s)", id, status),
+	}
+}
+
+// NewAlreadyCheckedInError creates an error for checking in an order twice
+func NewAlreadyCheckedInError(id uuid.UUID) *OrderError {
+	return &OrderError{
+		Code:    AlreadyCheckedInErrorCode,
+		Message: fmt.Sprintf("Order %s is already checked in", id),
+	}
+}
+
+// NewInvalidAnswersError creates an error for answers not matching the event's attendee questions
+func NewInvalidAnswersError(err error) *OrderError {
+	return &OrderError{
+		Code:    InvalidAnswersErrorCode,
+		Message: "Invalid answers",
+		Err:     err,
+	}
+}
+
+// NewOrderRejectedError creates an error for an order refused by the risk checks
+// The message is deliberately vague so it doesn't teach fraudsters which rule they tripped.
+func NewOrderRejectedError() *OrderError {
+	return &OrderError{
+		Code:    OrderRejectedErrorCode,
+		Message: "Order could not be accepted",
+	}
+}
+
+// NewOrderNotInReviewError creates an error for deciding on an order that isn't held for review
+func NewOrderNotInReviewError(id uuid.UUID, status Status) *OrderError {
+	return &OrderError{
+		Code:    OrderNotInReviewErrorCode,
+		Message: fmt.Sprintf("Order %s is not awaiting review (status: %s)", id, status),
+	}
+}
+
+// NewOrderArchivedError creates an error for changing an order that was archived with its event
+func NewOrderArchivedError(id uuid.UUID) *OrderError {
+	return &OrderError{
+		Code:    OrderArchivedErrorCode,
+		Message: fmt.Sprintf("Order %s is archived and can no longer be changed", id),
+	}
+}
+
+// NewAccessCodeRequiredError creates an error for ordering without a code for an event that requires one
+func NewAccessCodeRequiredError(eventID uuid.UUID) *OrderError {
+	return &OrderError{
+		Code:    AccessCodeRequiredErrorCode,
+		Message: fmt.Sprintf("Event %s requires an access code", eventID),
+	}
+}
+
+// NewAccessCodeInvalidError creates an error for an access code that is unknown or used up
+// Both cases share one message so codes can't be probed.
+func NewAccessCodeInvalidError() *OrderError {
+	return &OrderError{
+		Code:    AccessCodeInvalidErrorCode,
+		Message: "Access code is invalid or has been used up",
+	}
+}
+
+// NewSalesNotStartedError creates an error for ordering before the event's sale window opens
+func NewSalesNotStartedError(eventID uuid.UUID, saleStart time.Time) *OrderError {
+	return &OrderError{
+		Code:    SalesNotStartedErrorCode,
+		Message: fmt.Sprintf("Ticket sales for event %s start at %s", eventID, saleStart.UTC().Format(time.RFC3339)),
+	}
+}
+
+// NewSalesEndedError creates an error for ordering after the event's sale window closed
+func NewSalesEndedError(eventID uuid.UUID, saleEnd time.Time) *OrderError {
+	return &OrderError{
+		Code:    SalesEndedErrorCode,
+		Message: fmt.Sprintf("Ticket sales for event %s ended at %s", eventID, saleEnd.UTC().Format(time.RFC3339)),
+	}
+}
+
+// NewAgeRestrictedError creates an error for a buyer younger than the event's minimum age
+func NewAgeRestrictedError(eventID uuid.UUID, minimumAge int) *OrderError {
+	return &OrderError{
+		Code:    AgeRestrictedErrorCode,
+		Message: fmt.Sprintf("Event %s is restricted to buyers aged %d or over", eventID, minimumAge),
+	}
+}
+
+// NewDateOfBirthRequiredError creates an error for a buyer without a date of birth ordering for an age-restricted event
+func NewDateOfBirthRequiredError(eventID uuid.UUID, minimumAge int) *OrderError {
+	return &OrderError{
+		Code:    DateOfBirthRequiredErrorCode,
+		Message: fmt.Sprintf("Event %s is restricted to buyers aged %d or over; add your date of birth to your profile to order", eventID, minimumAge),
+	}
+}
+
+// NewInvalidTransitionError creates an error for moving an order to a status its current one doesn't lead to
+func NewInvalidTransitionError(id uuid.UUID, from, to Status) *OrderError {
+	return &OrderError{
+		Code:    InvalidTransitionErrorCode,
+		Message: fmt.Sprintf("Order %s cannot move from %s to %s", id, from, to),
+	}
+}
+
+// NewGiftNotFoundError creates an error for a claim token that matches no gift
+// Tokens replaced by a newer claim link are reported the same way.
+func NewGiftNotFoundError() *OrderError {
+	return &OrderError{
+		Code:    GiftNotFoundErrorCode,
+		Message: "Gift claim link is invalid or has been replaced by a newer one",
+	}
+}
+
+// NewGiftAlreadyClaimedError creates an error for claiming or resending a gift whose tickets were already claimed
+func NewGiftAlreadyClaimedError(id uuid.UUID) *OrderError {
+	return &OrderError{
+		Code:    GiftAlreadyClaimedErrorCode,
+		Message: fmt.Sprintf("Gift %s has already been claimed", id),
+	}
+}
+
+// NewOwnGiftError creates an error for a buyer claiming the gift they bought
+func NewOwnGiftError(id uuid.UUID) *OrderError {
+	return &OrderError{
+		Code:    OwnGiftErrorCode,
+		Message: fmt.Sprintf("Order %s is a gift you bought; it can only be claimed by its recipient", id),
+	}
+}
+
+// NewNotAGiftError creates an error for gift actions on an order bought for the buyer themselves
+func NewNotAGiftError(id uuid.UUID) *OrderError {
+	return &OrderError{
+		Code:    NotAGiftErrorCode,
+		Message: fmt.Sprintf("Order %s is not a gift", id),
+	}
+}
+
+// NewGroupNotFoundError creates an error for a group reservation that doesn't exist or isn't the caller's
+func NewGroupNotFoundError(id uuid.UUID) *OrderError {
+	return &OrderError{
+		Code:    GroupNotFoundErrorCode,
+		Message: fmt.Sprintf("Group reservation %s not found", id),
+	}
+}
+
+// NewGroupShareNotFoundError creates an error for a payment link token that matches no group share
+func NewGroupShareNotFoundError() *OrderError {
+	return &OrderError{
+		Code:    GroupShareNotFoundErrorCode,
+		Message: "Group payment link is invalid",
+	}
+}
+
+// NewGroupShareTakenError creates an error for a group share another member took or paid first
+func NewGroupShareTakenError(id uuid.UUID) *OrderError {
+	return &OrderError{
+		Code:    GroupShareTakenErrorCode,
+		Message: fmt.Sprintf("Group share %s has already been taken", id),
+	}
+}
+
+// NewGroupHoldExpiredError creates an error for a group share whose hold ended before it was paid
+func NewGroupHoldExpiredError(id uuid.UUID, holdUntil time.Time) *OrderError {
+	return &OrderError{
+		Code:    GroupHoldExpiredErrorCode,
+		Message: fmt.Sprintf("The hold on group share %s ended at %s; its tickets were released", id, holdUntil.Format(time.RFC3339)),
+	}
+}
+
 // Error type checking functions
 func IsOrderNotFoundError(err error) bool {
 	if orderErr, ok := err.(*OrderError); ok {
@@ -143,6 +341,153 @@ func IsOrderCreationError(err error) bool {
 	return false
 }
 
+func IsOrderNotCompletedError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == OrderNotCompletedErrorCode
+	}
+	return false
+}
+
+func IsAlreadyCheckedInError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == AlreadyCheckedInErrorCode
+	}
+	return false
+}
+
+func IsInvalidAnswersError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == InvalidAnswersErrorCode
+	}
+	return false
+}
+
+func IsOrderRejectedError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == OrderRejectedErrorCode
+	}
+	return false
+}
+
+func IsOrderNotInReviewError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == OrderNotInReviewErrorCode
+	}
+	return false
+}
+
+func IsOrderArchivedError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == OrderArchivedErrorCode
+	}
+	return false
+}
+
+func IsAccessCodeRequiredError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == AccessCodeRequiredErrorCode
+	}
+	return false
+}
+
+func IsAccessCodeInvalidError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == AccessCodeInvalidErrorCode
+	}
+	return false
+}
+
+func IsSalesNotStartedError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == SalesNotStartedErrorCode
+	}
+	return false
+}
+
+func IsSalesEndedError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == SalesEndedErrorCode
+	}
+	return false
+}
+
+func IsAgeRestrictedError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == AgeRestrictedErrorCode
+	}
+	return false
+}
+
+func IsDateOfBirthRequiredError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == DateOfBirthRequiredErrorCode
+	}
+	return false
+}
+
+func IsInvalidTransitionError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == InvalidTransitionErrorCode
+	}
+	return false
+}
+
+func IsGiftNotFoundError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == GiftNotFoundErrorCode
+	}
+	return false
+}
+
+func IsGiftAlreadyClaimedError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == GiftAlreadyClaimedErrorCode
+	}
+	return false
+}
+
+func IsOwnGiftError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == OwnGiftErrorCode
+	}
+	return false
+}
+
+func IsNotAGiftError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == NotAGiftErrorCode
+	}
+	return false
+}
+
+func IsGroupNotFoundError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == GroupNotFoundErrorCode
+	}
+	return false
+}
+
+func IsGroupShareNotFoundError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == GroupShareNotFoundErrorCode
+	}
+	return false
+}
+
+func IsGroupShareTakenError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == GroupShareTakenErrorCode
+	}
+	return false
+}
+
+func IsGroupHoldExpiredError(err error) bool {
+	if orderErr, ok := err.(*OrderError); ok {
+		return orderErr.Code == GroupHoldExpiredErrorCode
+	}
+	return false
+}
+
 // GetOrderErrorCode extracts the error code from an order error
 func GetOrderErrorCode(err error) string {
 	if orderErr, ok := err.(*OrderError); ok {
@@ -150,3 +495,38 @@ func GetOrderErrorCode(err error) string {
 	}
 	return "UNKNOWN_ERROR"
 }
+
+// ErrorCodes describes every code order errors carry, for the API's error catalogue
+func ErrorCodes() []errcode.Code {
+	return []errcode.Code{
+		{Code: OrderNotFoundErrorCode, Kind: errcode.NotFound, Description: "the order does not exist"},
+		{Code: EventNotFoundErrorCode, Kind: errcode.NotFound, Description: "the event ordered from does not exist"},
+		{Code: InsufficientTicketsErrorCode, Kind: errcode.Invalid, Description: "fewer tickets are left than requested"},
+		{Code: InvalidQuantityErrorCode, Kind: errcode.Invalid, Description: "the quantity is out of range"},
+		{Code: EventNotActiveErrorCode, Kind: errcode.Invalid, Description: "the event is cancelled or completed"},
+		{Code: ValidationErrorCode, Kind: errcode.Invalid, Description: "the request is malformed"},
+		{Code: OrderCreationErrorCode, Kind: errcode.Internal, Description: "the order could not be saved"},
+		{Code: UnauthorizedErrorCode, Kind: errcode.Forbidden, Description: "the order belongs to someone else"},
+		{Code: OrderNotCompletedErrorCode, Kind: errcode.Conflict, Description: "the order has not been paid for"},
+		{Code: AlreadyCheckedInErrorCode, Kind: errcode.Conflict, Description: "the order has already been checked in"},
+		{Code: InvalidAnswersErrorCode, Kind: errcode.Invalid, Description: "the answers do not match the event's attendee form"},
+		{Code: OrderRejectedErrorCode, Kind: errcode.Forbidden, Description: "the fraud checks refused the order"},
+		{Code: OrderNotInReviewErrorCode, Kind: errcode.Conflict, Description: "the order is not awaiting review"},
+		{Code: OrderArchivedErrorCode, Kind: errcode.Conflict, Description: "the order is archived and can no longer be changed"},
+		{Code: AccessCodeRequiredErrorCode, Kind: errcode.Forbidden, Description: "the event requires an access code"},
+		{Code: AccessCodeInvalidErrorCode, Kind: errcode.Forbidden, Description: "the access code is invalid or has been used up"},
+		{Code: SalesNotStartedErrorCode, Kind: errcode.Invalid, Description: "ticket sales for the event have not started"},
+		{Code: SalesEndedErrorCode, Kind: errcode.Invalid, Description: "ticket sales for the event have ended"},
+		{Code: AgeRestrictedErrorCode, Kind: errcode.Forbidden, Description: "the buyer is younger than the event's age limit"},
+		{Code: DateOfBirthRequiredErrorCode, Kind: errcode.Forbidden, Description: "the event has an age limit and the buyer has no date of birth on their profile"},
+		{Code: InvalidTransitionErrorCode, Kind: errcode.Invalid, Description: "the order cannot move to the requested status"},
+		{Code: GiftNotFoundErrorCode, Kind: errcode.NotFound, Description: "the gift claim link is invalid or has been replaced by a newer one"},
+		{Code: GiftAlreadyClaimedErrorCode, Kind: errcode.Conflict, Description: "the gift has already been claimed"},
+		{Code: OwnGiftErrorCode, Kind: errcode.Invalid, Description: "a gift can only be claimed by its recipient, not its buyer"},
+		{Code: NotAGiftErrorCode, Kind: errcode.Invalid, Description: "the order is not a gift"},
+		{Code: GroupNotFoundErrorCode, Kind: errcode.NotFound, Description: "the group reservation does not exist"},
+		{Code: GroupShareNotFoundErrorCode, Kind: errcode.NotFound, Description: "the group payment link is invalid"},
+		{Code: GroupShareTakenErrorCode, Kind: errcode.Conflict, Description: "the group share has already been taken"},
+		{Code: GroupHoldExpiredErrorCode, Kind: errcode.Gone, Description: "the hold on the group share has ended and its tickets were released"},
+	}
+}
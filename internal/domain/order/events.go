@@ -0,0 +1,69 @@
+package order
+
+import "github.com/google/uuid"
+
+// Event bus topics of order events
+const (
+	TopicOrderPlaced    = "order.placed"
+	TopicOrderConfirmed = "order.confirmed"
+	TopicOrderFlagged   = "order.flagged"
+	TopicGiftClaimed    = "order.gift_claimed"
+)
+
+// OrderPlaced is published when an order has been created, pending payment or held for review
+type OrderPlaced struct {
+	OrderID  uuid.UUID
+	UserID   uuid.UUID
+	EventID  uuid.UUID
+	Quantity int
+	Status   Status
+}
+
+// Topic implements eventbus.Event
+func (OrderPlaced) Topic() string {
+	return TopicOrderPlaced
+}
+
+// OrderConfirmed is published when an order moves to COMPLETED
+type OrderConfirmed struct {
+	OrderID     uuid.UUID
+	UserID      uuid.UUID
+	EventID     uuid.UUID
+	Quantity    int
+	TotalAmount float64
+}
+
+// Topic implements eventbus.Event
+func (OrderConfirmed) Topic() string {
+	return TopicOrderConfirmed
+}
+
+// OrderFlagged is published when the risk checks hold an order for review or reject it
+// OrderID is nil for rejected orders, which are never stored.
+type OrderFlagged struct {
+	OrderID  uuid.UUID
+	UserID   uuid.UUID
+	EventID  uuid.UUID
+	Score    int
+	Reasons  []string
+	Rejected bool
+}
+
+// Topic implements eventbus.Event
+func (OrderFlagged) Topic() string {
+	return TopicOrderFlagged
+}
+
+// GiftClaimed is published when the recipient of a gift claims its tickets
+type GiftClaimed struct {
+	OrderID     uuid.UUID
+	EventID     uuid.UUID
+	PurchaserID uuid.UUID
+	RecipientID uuid.UUID
+	Quantity    int
+}
+
+// Topic implements eventbus.Event
+func (GiftClaimed) Topic() string {
+	return TopicGiftClaimed
+}
@@ -0,0 +1,182 @@
+package order
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"net/mail"
+	"strings"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// JobTypeGiftClaim emails the recipient of a paid gift the link to claim its tickets
+const JobTypeGiftClaim = "order.gift_claim"
+
+// GiftClaimPayload is the job payload for JobTypeGiftClaim
+// The token is only ever stored hashed; a newer link for the same gift makes the job a no-op.
+type GiftClaimPayload struct {
+	OrderID uuid.UUID `json:"order_id"`
+	Token   string    `json:"token"`
+}
+
+// linkTokenBytes is the entropy of gift claim and group payment link tokens
+const linkTokenBytes = 32
+
+// WithGifts lets buyers order tickets for someone else, queueing claim emails on jobs
+// Without it orders with a gift recipient are refused, as no claim link could be sent.
+func WithGifts(jobs job.Service) ServiceOption {
+	return func(s *OrderService) {
+		s.giftJobs = jobs
+	}
+}
+
+// IsGift checks if the order was bought for someone else
+func (o *Order) IsGift() bool {
+	return o.GiftRecipient != ""
+}
+
+// IsGiftClaimed checks if the recipient of a gift has taken over its tickets
+func (o *Order) IsGiftClaimed() bool {
+	return o.GiftClaimedAt != nil
+}
+
+// IsVisibleTo checks if a user may see the order: its holder, or the buyer of a claimed gift
+func (o *Order) IsVisibleTo(userID uuid.UUID) bool {
+	return o.UserID == userID || (o.GiftedBy != nil && *o.GiftedBy == userID)
+}
+
+// GiftTokenMatches checks token against the claim token last sent for the gift
+func (o *Order) GiftTokenMatches(token string) bool {
+	return o.GiftTokenHash != "" && subtle.ConstantTimeCompare([]byte(o.GiftTokenHash), []byte(hashLinkToken(token))) == 1
+}
+
+// normalizeGiftRecipient lowercases and checks the email a gift is for; empty means no gift
+func normalizeGiftRecipient(recipient string) (string, error) {
+	recipient = strings.ToLower(strings.TrimSpace(recipient))
+	if recipient == "" {
+		return "", nil
+	}
+	address, err := mail.ParseAddress(recipient)
+	if err != nil || address.Address != recipient || len(recipient) > 255 {
+		return "", NewValidationError("Gift recipient must be an email address")
+	}
+	return recipient, nil
+}
+
+// newLinkToken returns a random link token
+func newLinkToken() (string, error) {
+	buf := make([]byte, linkTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashLinkToken returns the form link tokens are stored and looked up in
+func hashLinkToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// sendGiftClaim gives a paid gift a new claim token and queues the email carrying it
+// Any link sent before stops working.
+func (s *OrderService) sendGiftClaim(ctx context.Context, o *Order) error {
+	if s.giftJobs == nil {
+		return NewValidationError("Gifts are not available")
+	}
+	token, err := newLinkToken()
+	if err != nil {
+		return err
+	}
+	set, err := s.repository.SetGiftToken(ctx, o.ID, hashLinkToken(token))
+	if err != nil {
+		return err
+	}
+	if !set {
+		return NewGiftAlreadyClaimedError(o.ID)
+	}
+	if _, err := s.giftJobs.Enqueue(ctx, JobTypeGiftClaim, GiftClaimPayload{OrderID: o.ID, Token: token}); err != nil {
+		return err
+	}
+	logging.From(ctx).Info("Gift claim link sent", "order_id", o.ID, "user_id", o.UserID)
+	return nil
+}
+
+// ResendGift sends the recipient of a paid, unclaimed gift a new claim link
+func (s *OrderService) ResendGift(ctx context.Context, userID, orderID uuid.UUID) error {
+	o, err := s.repository.GetByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if o.UserID != userID {
+		return NewOrderNotFoundError(orderID)
+	}
+	if !o.IsGift() {
+		return NewNotAGiftError(orderID)
+	}
+	if o.IsGiftClaimed() {
+		return NewGiftAlreadyClaimedError(orderID)
+	}
+	if o.IsArchived() {
+		return NewOrderArchivedError(orderID)
+	}
+	if !o.IsCompleted() {
+		return NewOrderNotCompletedError(orderID, o.Status)
+	}
+	return s.sendGiftClaim(ctx, o)
+}
+
+// ClaimGift hands the tickets of the gift a claim token belongs to over to the user
+// The buyer keeps seeing the order in their history through GiftedBy.
+func (s *OrderService) ClaimGift(ctx context.Context, userID uuid.UUID, token string) (*Order, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, NewGiftNotFoundError()
+	}
+	o, err := s.repository.GetByGiftToken(ctx, hashLinkToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if o.IsGiftClaimed() {
+		return nil, NewGiftAlreadyClaimedError(o.ID)
+	}
+	if o.UserID == userID {
+		return nil, NewOwnGiftError(o.ID)
+	}
+	if o.IsArchived() {
+		return nil, NewOrderArchivedError(o.ID)
+	}
+
+	now := s.clock.Now()
+	claimed, err := s.repository.ClaimGift(ctx, o.ID, o.UserID, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, NewGiftAlreadyClaimedError(o.ID)
+	}
+
+	purchaserID := o.UserID
+	o.GiftedBy = &purchaserID
+	o.UserID = userID
+	o.GiftClaimedAt = &now
+	logging.From(ctx).Info("Gift claimed", "order_id", o.ID, "purchaser_id", purchaserID, "recipient_id", userID)
+
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, GiftClaimed{
+			OrderID:     o.ID,
+			EventID:     o.EventID,
+			PurchaserID: purchaserID,
+			RecipientID: userID,
+			Quantity:    o.Quantity,
+		})
+	}
+	return o, nil
+}
@@ -0,0 +1,218 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockJobService is a mock implementation of job.Service
+type MockJobService struct {
+	mock.Mock
+}
+
+func (m *MockJobService) Enqueue(ctx context.Context, jobType string, payload interface{}, opts ...job.EnqueueOption) (*job.Job, error) {
+	args := m.Called(ctx, jobType, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) GetJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) ListJobs(ctx context.Context, status string, limit int) ([]*job.Job, error) {
+	args := m.Called(ctx, status, limit)
+	return args.Get(0).([]*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) RetryJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func TestOrderService_CreateOrder_GiftRecipient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalid email is rejected", func(t *testing.T) {
+		service := order.NewOrderService(new(MockOrderRepository), nil, nil, order.WithGifts(new(MockJobService)))
+
+		_, err := service.CreateOrder(ctx, uuid.New(), uuid.New(), 1, order.Details{GiftRecipient: "not an email"})
+
+		assert.True(t, order.IsValidationError(err))
+	})
+
+	t.Run("gifts are refused without a job service", func(t *testing.T) {
+		service := order.NewOrderService(new(MockOrderRepository), nil, nil)
+
+		_, err := service.CreateOrder(ctx, uuid.New(), uuid.New(), 1, order.Details{GiftRecipient: "friend@example.com"})
+
+		assert.True(t, order.IsValidationError(err))
+	})
+
+	t.Run("recipient is stored lowercased", func(t *testing.T) {
+		eventID := uuid.New()
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("ReserveTicketsWithTx", ctx, mock.Anything, eventID, 1).
+			Return(&order.EventInfo{ID: eventID, TicketPrice: 25, AvailableTickets: 9}, nil)
+		mockRepo.On("CreateWithTx", ctx, mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil)
+		service := order.NewOrderService(mockRepo, newTxDB(t), nil, order.WithGifts(new(MockJobService)))
+
+		created, err := service.CreateOrder(ctx, uuid.New(), eventID, 1, order.Details{GiftRecipient: " Friend@Example.com "})
+
+		require.NoError(t, err)
+		assert.Equal(t, "friend@example.com", created.GiftRecipient)
+		assert.True(t, created.IsGift())
+	})
+}
+
+func TestOrderService_UpdateOrderStatus_SendsGiftClaim(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.New()
+	gift := &order.Order{ID: orderID, UserID: uuid.New(), Quantity: 2, Status: order.StatusPending, GiftRecipient: "friend@example.com"}
+
+	mockRepo := new(MockOrderRepository)
+	mockRepo.On("GetByID", ctx, orderID).Return(gift, nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*order.Order")).Return(nil)
+	var tokenHash string
+	mockRepo.On("SetGiftToken", ctx, orderID, mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { tokenHash = args.String(2) }).Return(true, nil)
+	jobs := new(MockJobService)
+	var payload order.GiftClaimPayload
+	jobs.On("Enqueue", ctx, order.JobTypeGiftClaim, mock.AnythingOfType("order.GiftClaimPayload")).
+		Run(func(args mock.Arguments) { payload = args.Get(2).(order.GiftClaimPayload) }).Return(&job.Job{}, nil)
+
+	err := order.NewOrderService(mockRepo, nil, nil, order.WithGifts(jobs)).UpdateOrderStatus(ctx, orderID, order.StatusCompleted)
+
+	require.NoError(t, err)
+	assert.Equal(t, orderID, payload.OrderID)
+	assert.NotEmpty(t, payload.Token)
+	assert.NotEqual(t, payload.Token, tokenHash, "only the hash of the token is stored")
+	assert.True(t, (&order.Order{GiftTokenHash: tokenHash}).GiftTokenMatches(payload.Token))
+}
+
+func TestOrderService_ResendGift(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.New()
+	buyerID := uuid.New()
+
+	newGift := func() *order.Order {
+		return &order.Order{ID: orderID, UserID: buyerID, Status: order.StatusCompleted, GiftRecipient: "friend@example.com"}
+	}
+
+	t.Run("buyer gets a new link sent", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByID", ctx, orderID).Return(newGift(), nil)
+		mockRepo.On("SetGiftToken", ctx, orderID, mock.AnythingOfType("string")).Return(true, nil)
+		jobs := new(MockJobService)
+		jobs.On("Enqueue", ctx, order.JobTypeGiftClaim, mock.AnythingOfType("order.GiftClaimPayload")).Return(&job.Job{}, nil)
+
+		err := order.NewOrderService(mockRepo, nil, nil, order.WithGifts(jobs)).ResendGift(ctx, buyerID, orderID)
+
+		require.NoError(t, err)
+		jobs.AssertExpectations(t)
+	})
+
+	t.Run("others can't resend", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByID", ctx, orderID).Return(newGift(), nil)
+
+		err := order.NewOrderService(mockRepo, nil, nil, order.WithGifts(new(MockJobService))).ResendGift(ctx, uuid.New(), orderID)
+
+		assert.True(t, order.IsOrderNotFoundError(err))
+	})
+
+	t.Run("claimed gifts are not resent", func(t *testing.T) {
+		claimed := newGift()
+		claimedAt := time.Now()
+		claimed.GiftClaimedAt = &claimedAt
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByID", ctx, orderID).Return(claimed, nil)
+
+		err := order.NewOrderService(mockRepo, nil, nil, order.WithGifts(new(MockJobService))).ResendGift(ctx, buyerID, orderID)
+
+		assert.True(t, order.IsGiftAlreadyClaimedError(err))
+	})
+
+	t.Run("unpaid gifts are not sent", func(t *testing.T) {
+		pending := newGift()
+		pending.Status = order.StatusPending
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByID", ctx, orderID).Return(pending, nil)
+
+		err := order.NewOrderService(mockRepo, nil, nil, order.WithGifts(new(MockJobService))).ResendGift(ctx, buyerID, orderID)
+
+		assert.True(t, order.IsOrderNotCompletedError(err))
+	})
+}
+
+func TestOrderService_ClaimGift(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.New()
+	eventID := uuid.New()
+	buyerID := uuid.New()
+	recipientID := uuid.New()
+
+	newGift := func() *order.Order {
+		return &order.Order{ID: orderID, UserID: buyerID, EventID: eventID, Quantity: 2, Status: order.StatusCompleted, GiftRecipient: "friend@example.com"}
+	}
+
+	t.Run("recipient takes over the order", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByGiftToken", ctx, mock.AnythingOfType("string")).Return(newGift(), nil)
+		mockRepo.On("ClaimGift", ctx, orderID, buyerID, recipientID, mock.AnythingOfType("time.Time")).Return(true, nil)
+		publisher := &recordingPublisher{}
+
+		claimed, err := order.NewOrderService(mockRepo, nil, publisher).ClaimGift(ctx, recipientID, "token")
+
+		require.NoError(t, err)
+		assert.Equal(t, recipientID, claimed.UserID)
+		assert.Equal(t, &buyerID, claimed.GiftedBy)
+		assert.True(t, claimed.IsGiftClaimed())
+		assert.True(t, claimed.IsVisibleTo(buyerID))
+		require.Len(t, publisher.events, 1)
+		assert.Equal(t, order.GiftClaimed{OrderID: orderID, EventID: eventID, PurchaserID: buyerID, RecipientID: recipientID, Quantity: 2}, publisher.events[0])
+	})
+
+	t.Run("buyer can't claim their own gift", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByGiftToken", ctx, mock.AnythingOfType("string")).Return(newGift(), nil)
+
+		_, err := order.NewOrderService(mockRepo, nil, nil).ClaimGift(ctx, buyerID, "token")
+
+		assert.True(t, order.IsOwnGiftError(err))
+		mockRepo.AssertNotCalled(t, "ClaimGift", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("concurrent claim loses", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByGiftToken", ctx, mock.AnythingOfType("string")).Return(newGift(), nil)
+		mockRepo.On("ClaimGift", ctx, orderID, buyerID, recipientID, mock.AnythingOfType("time.Time")).Return(false, nil)
+		publisher := &recordingPublisher{}
+
+		_, err := order.NewOrderService(mockRepo, nil, publisher).ClaimGift(ctx, recipientID, "token")
+
+		assert.True(t, order.IsGiftAlreadyClaimedError(err))
+		assert.Empty(t, publisher.events)
+	})
+
+	t.Run("empty token is not looked up", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+
+		_, err := order.NewOrderService(mockRepo, nil, nil).ClaimGift(ctx, recipientID, " ")
+
+		assert.True(t, order.IsGiftNotFoundError(err))
+		mockRepo.AssertNotCalled(t, "GetByGiftToken", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,172 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/domain/values"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// MaxGroupShares bounds how many members one group reservation holds tickets for
+const MaxGroupShares = 20
+
+// Group is a reservation a leader made for several members of a group
+// Each member's share is a pending order, held until HoldUntil; shares left unpaid then are released.
+type Group struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid" json:"id"`
+	EventID   uuid.UUID `gorm:"not null;type:uuid" json:"event_id"`
+	LeaderID  uuid.UUID `gorm:"not null;type:uuid" json:"leader_id"`
+	HoldUntil time.Time `gorm:"not null" json:"hold_until"`
+	CreatedAt time.Time `json:"created_at"`
+
+	Shares []*Order `gorm:"-" json:"shares,omitempty"` // Filled in when the group is read with its orders
+}
+
+// TableName tells GORM what table to use for this model
+func (Group) TableName() string {
+	return "order_groups"
+}
+
+// GroupReservation is a newly created group together with the payment link token of each share
+// Tokens are only stored hashed, so this is the only time they can be handed out.
+type GroupReservation struct {
+	Group  *Group
+	Tokens []string // In the order of Group.Shares
+}
+
+// WithGroupReservations lets buyers hold tickets for a group, each share held for hold before it is released
+// Without it group reservations are refused.
+func WithGroupReservations(hold time.Duration) ServiceOption {
+	return func(s *OrderService) {
+		s.groupHold = hold
+	}
+}
+
+// IsGroupShare checks if the order is one member's share of a group reservation
+func (o *Order) IsGroupShare() bool {
+	return o.GroupID != nil
+}
+
+// IsHoldExpired checks if the order was held until a deadline that has passed at now
+func (o *Order) IsHoldExpired(now time.Time) bool {
+	return o.HoldUntil != nil && !now.Before(*o.HoldUntil)
+}
+
+// CreateGroup reserves the tickets of all shares at once and creates a pending order per share for the leader
+// The checks of CreateOrder apply to the reservation as a whole. Members take their share with its
+// payment link; shares not paid when the hold ends are expired like any pending order.
+func (s *OrderService) CreateGroup(ctx context.Context, leaderID, eventID uuid.UUID, shares []int, details Details) (*GroupReservation, error) {
+	if s.groupHold <= 0 {
+		return nil, NewValidationError("Group reservations are not available")
+	}
+	if len(shares) < 2 || len(shares) > MaxGroupShares {
+		return nil, NewValidationError(fmt.Sprintf("A group reservation needs between 2 and %d shares", MaxGroupShares))
+	}
+	if strings.TrimSpace(details.GiftRecipient) != "" {
+		return nil, NewValidationError("Group shares can't be bought as gifts")
+	}
+
+	quantities := make([]values.Quantity, len(shares))
+	total := 0
+	for i, n := range shares {
+		quantity, err := values.NewQuantity(n)
+		if err != nil {
+			return nil, NewInvalidQuantityError(n)
+		}
+		quantities[i] = quantity
+		total += n
+	}
+	if _, err := values.NewQuantity(total); err != nil {
+		return nil, NewInvalidQuantityError(total)
+	}
+
+	tokens := make([]string, len(shares))
+	hashes := make([]string, len(shares))
+	for i := range shares {
+		token, err := newLinkToken()
+		if err != nil {
+			return nil, err
+		}
+		tokens[i] = token
+		hashes[i] = hashLinkToken(token)
+	}
+
+	group := &Group{ID: s.ids.NewID(), EventID: eventID, LeaderID: leaderID}
+	created, err := s.placeOrders(ctx, placement{
+		userID:      leaderID,
+		eventID:     eventID,
+		shares:      quantities,
+		details:     details,
+		group:       group,
+		shareHashes: hashes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	group.Shares = created
+	logging.From(ctx).Info("Group reservation created", "group_id", group.ID, "event_id", eventID, "user_id", leaderID, "shares", len(shares), "hold_until", group.HoldUntil)
+
+	return &GroupReservation{Group: group, Tokens: tokens}, nil
+}
+
+// GetGroup returns a group reservation with its shares; groups of other leaders are reported as not found
+func (s *OrderService) GetGroup(ctx context.Context, leaderID, groupID uuid.UUID) (*Group, error) {
+	group, err := s.repository.GetGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.LeaderID != leaderID {
+		return nil, NewGroupNotFoundError(groupID)
+	}
+	group.Shares, err = s.repository.GetByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// TakeGroupShare moves the group share a payment link token belongs to from the leader to the user
+// The share stays pending, to be paid by its new holder before the hold ends; each link works once.
+// A leader opening one of their own links keeps the share.
+func (s *OrderService) TakeGroupShare(ctx context.Context, userID uuid.UUID, token string) (*Order, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, NewGroupShareNotFoundError()
+	}
+	hash := hashLinkToken(token)
+	share, err := s.repository.GetByShareToken(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	switch {
+	case share.IsFailed() || share.IsHoldExpired(now):
+		return nil, NewGroupHoldExpiredError(share.ID, *share.HoldUntil)
+	case share.IsCompleted():
+		return nil, NewGroupShareTakenError(share.ID)
+	case share.IsInReview():
+		return nil, NewValidationError(fmt.Sprintf("Group share %s is awaiting review", share.ID))
+	case share.UserID == userID:
+		return share, nil
+	}
+
+	taken, err := s.repository.TakeShare(ctx, share.ID, hash, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !taken {
+		return nil, NewGroupShareTakenError(share.ID)
+	}
+
+	leaderID := share.UserID
+	share.UserID = userID
+	share.ShareTokenHash = ""
+	logging.From(ctx).Info("Group share taken", "order_id", share.ID, "group_id", share.GroupID, "leader_id", leaderID, "user_id", userID)
+	return share, nil
+}
@@ -0,0 +1,153 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderService_CreateGroup(t *testing.T) {
+	ctx := context.Background()
+	leaderID := uuid.New()
+	eventID := uuid.New()
+
+	t.Run("holds every share for the leader after taking all tickets at once", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("ReserveTicketsWithTx", ctx, mock.Anything, eventID, 5).
+			Return(&order.EventInfo{ID: eventID, TicketPrice: 20, AvailableTickets: 95}, nil)
+		mockRepo.On("CreateGroupWithTx", ctx, mock.Anything, mock.AnythingOfType("*order.Group")).Return(nil)
+		var placed []*order.Order
+		mockRepo.On("CreateWithTx", ctx, mock.Anything, mock.AnythingOfType("*order.Order")).
+			Run(func(args mock.Arguments) { placed = append(placed, args.Get(2).(*order.Order)) }).Return(nil)
+		publisher := &recordingPublisher{}
+		service := order.NewOrderService(mockRepo, newTxDB(t), publisher, order.WithGroupReservations(48*time.Hour))
+
+		before := time.Now()
+		reservation, err := service.CreateGroup(ctx, leaderID, eventID, []int{2, 3}, order.Details{})
+
+		require.NoError(t, err)
+		group := reservation.Group
+		assert.Equal(t, leaderID, group.LeaderID)
+		assert.WithinDuration(t, before.Add(48*time.Hour), group.HoldUntil, time.Minute)
+		require.Len(t, group.Shares, 2)
+		require.Len(t, reservation.Tokens, 2)
+		assert.Equal(t, placed, group.Shares)
+		for i, share := range group.Shares {
+			assert.Equal(t, leaderID, share.UserID)
+			assert.Equal(t, order.StatusPending, share.Status)
+			assert.Equal(t, &group.ID, share.GroupID)
+			assert.Equal(t, group.HoldUntil, *share.HoldUntil)
+			assert.NotEmpty(t, share.ShareTokenHash)
+			assert.NotEqual(t, reservation.Tokens[i], share.ShareTokenHash, "only the hash of the token is stored")
+		}
+		assert.Equal(t, 40.0, group.Shares[0].TotalAmount)
+		assert.Equal(t, 60.0, group.Shares[1].TotalAmount)
+		assert.Len(t, publisher.events, 2, "each share is placed as an order")
+		mockRepo.AssertNumberOfCalls(t, "ReserveTicketsWithTx", 1)
+	})
+
+	t.Run("refused without a hold window", func(t *testing.T) {
+		service := order.NewOrderService(new(MockOrderRepository), nil, nil)
+
+		_, err := service.CreateGroup(ctx, leaderID, eventID, []int{1, 1}, order.Details{})
+
+		assert.True(t, order.IsValidationError(err))
+	})
+
+	t.Run("needs at least two shares", func(t *testing.T) {
+		service := order.NewOrderService(new(MockOrderRepository), nil, nil, order.WithGroupReservations(time.Hour))
+
+		_, err := service.CreateGroup(ctx, leaderID, eventID, []int{4}, order.Details{})
+
+		assert.True(t, order.IsValidationError(err))
+	})
+
+	t.Run("total is bounded like one order", func(t *testing.T) {
+		service := order.NewOrderService(new(MockOrderRepository), nil, nil, order.WithGroupReservations(time.Hour))
+
+		_, err := service.CreateGroup(ctx, leaderID, eventID, []int{60, 60}, order.Details{})
+
+		assert.True(t, order.IsInvalidQuantityError(err))
+	})
+}
+
+func TestOrderService_TakeGroupShare(t *testing.T) {
+	ctx := context.Background()
+	shareID := uuid.New()
+	groupID := uuid.New()
+	leaderID := uuid.New()
+	memberID := uuid.New()
+
+	newShare := func(holdUntil time.Time) *order.Order {
+		return &order.Order{ID: shareID, UserID: leaderID, Quantity: 2, Status: order.StatusPending, GroupID: &groupID, HoldUntil: &holdUntil, ShareTokenHash: "hash"}
+	}
+
+	t.Run("member takes the share", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByShareToken", ctx, mock.AnythingOfType("string")).Return(newShare(time.Now().Add(time.Hour)), nil)
+		mockRepo.On("TakeShare", ctx, shareID, mock.AnythingOfType("string"), memberID, mock.AnythingOfType("time.Time")).Return(true, nil)
+
+		taken, err := order.NewOrderService(mockRepo, nil, nil).TakeGroupShare(ctx, memberID, "token")
+
+		require.NoError(t, err)
+		assert.Equal(t, memberID, taken.UserID)
+		assert.Empty(t, taken.ShareTokenHash)
+		assert.True(t, taken.IsPending())
+	})
+
+	t.Run("expired holds are refused", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByShareToken", ctx, mock.AnythingOfType("string")).Return(newShare(time.Now().Add(-time.Minute)), nil)
+
+		_, err := order.NewOrderService(mockRepo, nil, nil).TakeGroupShare(ctx, memberID, "token")
+
+		assert.True(t, order.IsGroupHoldExpiredError(err))
+		mockRepo.AssertNotCalled(t, "TakeShare", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("concurrent take loses", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByShareToken", ctx, mock.AnythingOfType("string")).Return(newShare(time.Now().Add(time.Hour)), nil)
+		mockRepo.On("TakeShare", ctx, shareID, mock.AnythingOfType("string"), memberID, mock.AnythingOfType("time.Time")).Return(false, nil)
+
+		_, err := order.NewOrderService(mockRepo, nil, nil).TakeGroupShare(ctx, memberID, "token")
+
+		assert.True(t, order.IsGroupShareTakenError(err))
+	})
+
+	t.Run("leader keeps their own share", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByShareToken", ctx, mock.AnythingOfType("string")).Return(newShare(time.Now().Add(time.Hour)), nil)
+
+		share, err := order.NewOrderService(mockRepo, nil, nil).TakeGroupShare(ctx, leaderID, "token")
+
+		require.NoError(t, err)
+		assert.Equal(t, leaderID, share.UserID)
+		mockRepo.AssertNotCalled(t, "TakeShare", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestOrderService_GetGroup(t *testing.T) {
+	ctx := context.Background()
+	groupID := uuid.New()
+	leaderID := uuid.New()
+
+	mockRepo := new(MockOrderRepository)
+	mockRepo.On("GetGroup", ctx, groupID).Return(&order.Group{ID: groupID, LeaderID: leaderID}, nil)
+	mockRepo.On("GetByGroupID", ctx, groupID).Return([]*order.Order{{ID: uuid.New()}, {ID: uuid.New()}}, nil)
+	service := order.NewOrderService(mockRepo, nil, nil)
+
+	group, err := service.GetGroup(ctx, leaderID, groupID)
+	require.NoError(t, err)
+	assert.Len(t, group.Shares, 2)
+
+	_, err = service.GetGroup(ctx, uuid.New(), groupID)
+	assert.True(t, order.IsGroupNotFoundError(err), "other users' groups are not revealed")
+}
@@ -3,26 +3,84 @@ package order
 import (
 	"time"
 
+	"enterprise-crud/internal/domain/event"
+
 	"github.com/google/uuid"
 )
 
 // Order represents a ticket purchase order
 type Order struct {
-	ID          uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
-	UserID      uuid.UUID `gorm:"not null;type:uuid" json:"user_id"`
-	EventID     uuid.UUID `gorm:"not null;type:uuid" json:"event_id"`
-	Quantity    int       `gorm:"not null" json:"quantity"`
-	TotalAmount float64   `gorm:"type:decimal(10,2);not null" json:"total_amount"`
-	Status      string    `gorm:"size:20;not null;default:'PENDING'" json:"status"`
-	CreatedAt   time.Time `json:"created_at"`
-}
-
-// Order status constants
-const (
-	StatusPending   = "PENDING"
-	StatusCompleted = "COMPLETED"
-	StatusFailed    = "FAILED"
-)
+	ID          uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	UserID      uuid.UUID  `gorm:"not null;type:uuid" json:"user_id"`
+	EventID     uuid.UUID  `gorm:"not null;type:uuid" json:"event_id"`
+	Quantity    int        `gorm:"not null" json:"quantity"`
+	TotalAmount float64    `gorm:"type:decimal(10,2);not null" json:"total_amount"`
+	Status      Status     `gorm:"size:20;not null;default:'PENDING';check:status IN ('PENDING', 'COMPLETED', 'FAILED', 'REVIEW')" json:"status"`
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"` // Set when the ticket holder is admitted at the event
+
+	Notes   string        `gorm:"type:text" json:"notes,omitempty"`    // Free-form note from the buyer to the organizer
+	Answers event.Answers `gorm:"type:jsonb" json:"answers,omitempty"` // Answers to the event's attendee questions
+
+	// Set by the risk scorer when the order was placed; never shown to the buyer
+	RiskScore   int      `gorm:"not null;default:0" json:"-"`
+	RiskReasons []string `gorm:"type:jsonb;serializer:json" json:"-"`
+	Country     string   `gorm:"size:2" json:"-"` // Buyer's country as reported by the edge proxy, if any
+
+	// Set once when the invoice is first requested; read-only here so saving an order never clears them
+	InvoiceNumber   *int64     `gorm:"->" json:"invoice_number,omitempty"`
+	InvoiceIssuedAt *time.Time `gorm:"->" json:"invoice_issued_at,omitempty"`
+
+	// Set on orders bought for someone else. Claiming the gift moves the order to the recipient's
+	// account and records the buyer in GiftedBy; the claim token is only stored hashed.
+	GiftRecipient string     `gorm:"size:255;not null;default:''" json:"gift_recipient,omitempty"`
+	GiftTokenHash string     `gorm:"size:64;not null;default:''" json:"-"`
+	GiftedBy      *uuid.UUID `gorm:"type:uuid" json:"gifted_by,omitempty"`
+	GiftClaimedAt *time.Time `json:"gift_claimed_at,omitempty"`
+
+	// Set on the shares of a group reservation. A share belongs to the group's leader until a member
+	// takes it with its payment link, and is released unless paid by HoldUntil; the link token is only stored hashed.
+	GroupID        *uuid.UUID `gorm:"type:uuid" json:"group_id,omitempty"`
+	HoldUntil      *time.Time `json:"hold_until,omitempty"`
+	ShareTokenHash string     `gorm:"size:64;not null;default:''" json:"-"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"` // Moves with every change, so status polling can tell when to refetch
+
+	// Set on orders read from the archive; they are read-only history
+	ArchivedAt *time.Time `gorm:"->" json:"archived_at,omitempty"`
+}
+
+// StatusSnapshot is what clients polling an order need: its status and when it last changed
+type StatusSnapshot struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID // Buyer, for the access check
+	Status    Status
+	UpdatedAt time.Time
+}
+
+// MaxNotesLength bounds order notes in characters
+const MaxNotesLength = 1000
+
+// Details is what a buyer provides with an order besides the ticket quantity
+type Details struct {
+	Notes   string                 // Optional note to the organizer
+	Answers map[string]interface{} // Answers to the event's attendee questions by key
+
+	AccessCode string // Needed for events that require an access code
+
+	GiftRecipient string // Email of the person the tickets are for; empty when buying for oneself
+
+	// Request metadata for fraud checks, filled in by the HTTP layer
+	ClientIP string
+	Country  string // ISO 3166-1 alpha-2 code; empty when unknown
+}
+
+// Attendee is a completed order together with its buyer, as listed in the attendee export
+type Attendee struct {
+	Order
+	Email    string
+	Username string
+}
 
 // TableName tells GORM what table to use for this model
 func (Order) TableName() string {
@@ -39,6 +97,21 @@ func (o *Order) IsCompleted() bool {
 	return o.Status == StatusCompleted
 }
 
+// IsCheckedIn checks if the ticket holder has been admitted
+func (o *Order) IsCheckedIn() bool {
+	return o.CheckedInAt != nil
+}
+
+// IsInReview checks if the order is held for manual review
+func (o *Order) IsInReview() bool {
+	return o.Status == StatusReview
+}
+
+// IsArchived checks if the order was moved to the archive with its event
+func (o *Order) IsArchived() bool {
+	return o.ArchivedAt != nil
+}
+
 // IsFailed checks if the order has failed
 func (o *Order) IsFailed() bool {
 	return o.Status == StatusFailed
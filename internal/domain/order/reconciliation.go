@@ -0,0 +1,40 @@
+package order
+
+import "github.com/google/uuid"
+
+// TicketCount compares an event's available ticket counter with the tickets its orders hold
+type TicketCount struct {
+	EventID          uuid.UUID
+	TotalTickets     int
+	AvailableTickets int // Counter stored on the event
+	ReservedTickets  int // Tickets held by pending, in-review and completed orders
+}
+
+// ExpectedAvailable is the counter value implied by the event's orders
+func (c *TicketCount) ExpectedAvailable() int {
+	return c.TotalTickets - c.ReservedTickets
+}
+
+// Drift is how many tickets the counter shows beyond what the orders imply; negative means too few
+func (c *TicketCount) Drift() int {
+	return c.AvailableTickets - c.ExpectedAvailable()
+}
+
+// IsOversold reports whether orders hold more tickets than the event has
+// An oversold counter can't be corrected because it would have to go below zero.
+func (c *TicketCount) IsOversold() bool {
+	return c.ExpectedAvailable() < 0
+}
+
+// Discrepancy is an event whose ticket counter has drifted from its orders
+type Discrepancy struct {
+	TicketCount
+	Corrected bool // The counter was reset to ExpectedAvailable
+}
+
+// Reconciliation is the outcome of comparing ticket counters with orders
+type Reconciliation struct {
+	DryRun        bool // Discrepancies were only reported
+	Discrepancies []Discrepancy
+	Corrected     int // Counters reset during this run
+}
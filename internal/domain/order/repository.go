@@ -2,6 +2,9 @@ package order
 
 import (
 	"context"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -11,23 +14,113 @@ import (
 type Repository interface {
 	Create(ctx context.Context, order *Order) error
 	GetByID(ctx context.Context, id uuid.UUID) (*Order, error)
-	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*Order, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*Order, error) // Includes gifts the user bought that were claimed by others
 	Update(ctx context.Context, order *Order) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetByEventID(ctx context.Context, eventID uuid.UUID) ([]*Order, error)
 
+	// GetStatus reads only the status and last change of an order, including archived ones
+	GetStatus(ctx context.Context, id uuid.UUID) (*StatusSnapshot, error)
+
+	// GetAttendees returns the completed orders of an event with their buyers, oldest first
+	GetAttendees(ctx context.Context, eventID uuid.UUID) ([]*Attendee, error)
+
+	// MarkCheckedIn records a check-in unless the order was already checked in
+	// It reports false when the order was checked in before
+	MarkCheckedIn(ctx context.Context, id uuid.UUID, at time.Time) (bool, error)
+
+	// ExpirePending marks pending orders created before cutoff as failed and returns their tickets to their events
+	// Group shares are expired when their hold ends before now instead, however old they are.
+	// It reports how many orders were expired
+	ExpirePending(ctx context.Context, cutoff, now time.Time) (int64, error)
+
+	// GetPurchaseHistory summarises a user's earlier orders for risk scoring
+	// RecentOrders counts orders placed at or after since.
+	GetPurchaseHistory(ctx context.Context, userID, eventID uuid.UUID, since time.Time) (*PurchaseHistory, error)
+
+	// ListByStatus returns up to limit orders with the given status, oldest first
+	ListByStatus(ctx context.Context, status Status, limit int) ([]*Order, error)
+
+	// ResolveReview moves an order out of REVIEW to status, returning its tickets when status is FAILED
+	// It reports false when the order was no longer in review
+	ResolveReview(ctx context.Context, id uuid.UUID, status Status) (bool, error)
+
+	// FindTicketDrift returns the events whose available tickets differ from their total minus reserved tickets
+	FindTicketDrift(ctx context.Context) ([]*TicketCount, error)
+
+	// CorrectAvailableTickets recomputes an event's available tickets from its orders if the counter still reads observed
+	// It reports false when the counter changed in the meantime
+	CorrectAvailableTickets(ctx context.Context, eventID uuid.UUID, observed int) (bool, error)
+
+	// ArchiveEvents moves up to limit events that took place before cutoff, with their orders, to the archive tables
+	// Events with pending or in-review orders are left in place.
+	ArchiveEvents(ctx context.Context, cutoff time.Time, limit int) (*Archived, error)
+
+	// SetGiftToken stores the hash of a new claim token on a gift, replacing the previous one
+	// It reports false when the gift was already claimed
+	SetGiftToken(ctx context.Context, id uuid.UUID, tokenHash string) (bool, error)
+
+	// GetByGiftToken returns the gift whose current claim token hashes to tokenHash
+	GetByGiftToken(ctx context.Context, tokenHash string) (*Order, error)
+
+	// ClaimGift moves an unclaimed gift from its buyer to the recipient, recording the buyer in GiftedBy
+	// It reports false when the gift was claimed or changed hands in the meantime
+	ClaimGift(ctx context.Context, id, purchaserID, recipientID uuid.UUID, at time.Time) (bool, error)
+
+	// GetGroup returns a group reservation without its shares
+	GetGroup(ctx context.Context, id uuid.UUID) (*Group, error)
+
+	// GetByGroupID returns the shares of a group reservation, oldest first
+	GetByGroupID(ctx context.Context, groupID uuid.UUID) ([]*Order, error)
+
+	// GetByShareToken returns the group share whose payment link token hashes to tokenHash
+	GetByShareToken(ctx context.Context, tokenHash string) (*Order, error)
+
+	// TakeShare moves a pending group share whose hold lasts beyond now to userID, using up its payment link
+	// It reports false when the share was taken, paid or released in the meantime
+	TakeShare(ctx context.Context, id uuid.UUID, tokenHash string, userID uuid.UUID, now time.Time) (bool, error)
+
 	// Transaction methods
 	CreateWithTx(ctx context.Context, tx *gorm.DB, order *Order) error
-	GetEventWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID) (*EventInfo, error)
+	CreateGroupWithTx(ctx context.Context, tx *gorm.DB, group *Group) error
+	LockEventWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID) (*EventInfo, error) // Locks the event row FOR UPDATE
+
+	// ReserveTicketsWithTx takes quantity tickets of an active event and returns it with the new ticket count
+	// It returns nil without an error when the event is missing, not active, held by moderation or short of tickets.
+	ReserveTicketsWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID, quantity int) (*EventInfo, error)
 	UpdateEventTicketsWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID, newAvailableTickets int) error
 }
 
 // EventInfo represents event information needed for order processing
 type EventInfo struct {
 	ID               uuid.UUID
-	Title            string
 	TicketPrice      float64
+	PriceSchedule    event.PriceSchedule // Time-based prices replacing TicketPrice while a tier is active
 	AvailableTickets int
-	TotalTickets     int
-	Status           string
+	Status           event.Status
+	Flagged          bool            // Held by moderation, so not on sale until an admin approves it
+	Questions        event.Questions // Attendee questions buyers must answer
+
+	AccessCodeRequired bool // Buyers must redeem one of the event's access codes
+
+	SaleStart *time.Time // Orders are refused before this time; nil sells from creation
+	SaleEnd   *time.Time // Orders are refused from this time on; nil sells until the event is over
+
+	MinimumAge int // Age buyers must have reached; 0 means no age limit
+}
+
+// priceAt returns the price per ticket of orders placed at now
+func (e *EventInfo) priceAt(now time.Time) float64 {
+	return e.PriceSchedule.PriceAt(e.TicketPrice, now)
+}
+
+// checkSaleWindow refuses orders outside the event's sale window
+func (e *EventInfo) checkSaleWindow(now time.Time) error {
+	switch event.SaleStateAt(e.SaleStart, e.SaleEnd, now) {
+	case event.SaleNotStarted:
+		return NewSalesNotStartedError(e.ID, *e.SaleStart)
+	case event.SaleEnded:
+		return NewSalesEndedError(e.ID, *e.SaleEnd)
+	}
+	return nil
 }
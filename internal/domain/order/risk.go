@@ -0,0 +1,123 @@
+package order
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RiskInput describes an order about to be placed, as seen by a RiskScorer
+type RiskInput struct {
+	UserID      uuid.UUID
+	EventID     uuid.UUID
+	Quantity    int
+	TotalAmount float64
+	ClientIP    string
+	Country     string    // Empty when the edge proxy didn't report one
+	PlacedAt    time.Time // When the order is placed; rules looking back count from here
+}
+
+// RiskAssessment is a scorer's verdict on an order
+type RiskAssessment struct {
+	Score   int
+	Reasons []string // Short machine-readable tags explaining the score
+}
+
+// RiskScorer rates how likely an order is to be fraudulent
+// It is called inside the order transaction, so it should answer quickly.
+type RiskScorer interface {
+	Score(ctx context.Context, input RiskInput) (RiskAssessment, error)
+}
+
+// RiskThresholds decide what happens to a scored order
+// A zero threshold disables that outcome.
+type RiskThresholds struct {
+	Review int // Orders scoring at least this are held with status REVIEW
+	Reject int // Orders scoring at least this are refused outright
+}
+
+// ServiceOption configures optional OrderService behaviour
+type ServiceOption func(*OrderService)
+
+// WithRiskScorer scores every new order and holds or rejects it per thresholds
+func WithRiskScorer(scorer RiskScorer, thresholds RiskThresholds) ServiceOption {
+	return func(s *OrderService) {
+		s.scorer = scorer
+		s.thresholds = thresholds
+	}
+}
+
+// Risk reasons reported by the rule scorer
+const (
+	ReasonRepeatPurchases = "repeat_event_purchases"
+	ReasonRapidPurchases  = "rapid_purchases"
+	ReasonCountryMismatch = "country_mismatch"
+)
+
+// Points each rule adds to the score when it fires
+const (
+	repeatPurchasePoints  = 40
+	rapidPurchasePoints   = 50
+	countryMismatchPoints = 30
+)
+
+// PurchaseHistory summarises a buyer's earlier orders for the rule scorer
+type PurchaseHistory struct {
+	EventOrders  int      // Orders for the same event that didn't fail
+	RecentOrders int      // Orders for any event placed since the window start
+	Countries    []string // Distinct countries earlier orders were placed from
+}
+
+// RiskRules configure the rule scorer; a zero limit disables its rule
+type RiskRules struct {
+	MaxEventOrders int           // Earlier orders for one event before buying again looks like scalping
+	MaxRapidOrders int           // Orders within RapidWindow before buying looks automated
+	RapidWindow    time.Duration // How far back rapid-fire purchases are counted
+	CheckCountry   bool          // Flag orders from a country the buyer never ordered from before
+}
+
+// RuleScorer is a RiskScorer built from simple purchase-history rules
+type RuleScorer struct {
+	repository Repository
+	rules      RiskRules
+}
+
+// NewRuleScorer creates a rule scorer reading history from repository
+func NewRuleScorer(repository Repository, rules RiskRules) *RuleScorer {
+	return &RuleScorer{repository: repository, rules: rules}
+}
+
+// Score adds up the points of every rule the order trips
+func (r *RuleScorer) Score(ctx context.Context, input RiskInput) (RiskAssessment, error) {
+	history, err := r.repository.GetPurchaseHistory(ctx, input.UserID, input.EventID, input.PlacedAt.Add(-r.rules.RapidWindow))
+	if err != nil {
+		return RiskAssessment{}, err
+	}
+
+	var assessment RiskAssessment
+	if r.rules.MaxEventOrders > 0 && history.EventOrders >= r.rules.MaxEventOrders {
+		assessment.Score += repeatPurchasePoints
+		assessment.Reasons = append(assessment.Reasons, ReasonRepeatPurchases)
+	}
+	if r.rules.MaxRapidOrders > 0 && r.rules.RapidWindow > 0 && history.RecentOrders >= r.rules.MaxRapidOrders {
+		assessment.Score += rapidPurchasePoints
+		assessment.Reasons = append(assessment.Reasons, ReasonRapidPurchases)
+	}
+	if r.rules.CheckCountry && input.Country != "" && len(history.Countries) > 0 && !containsFold(history.Countries, input.Country) {
+		assessment.Score += countryMismatchPoints
+		assessment.Reasons = append(assessment.Reasons, ReasonCountryMismatch)
+	}
+	return assessment, nil
+}
+
+// containsFold reports whether values holds s, ignoring case
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
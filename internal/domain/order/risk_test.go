@@ -0,0 +1,71 @@
+package order_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleScorer_Score(t *testing.T) {
+	ctx := context.Background()
+	rules := order.RiskRules{
+		MaxEventOrders: 3,
+		MaxRapidOrders: 5,
+		RapidWindow:    10 * time.Minute,
+		CheckCountry:   true,
+	}
+	input := order.RiskInput{UserID: uuid.New(), EventID: uuid.New(), Quantity: 1, Country: "de", PlacedAt: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)}
+
+	score := func(t *testing.T, rules order.RiskRules, history *order.PurchaseHistory) order.RiskAssessment {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetPurchaseHistory", ctx, input.UserID, input.EventID, input.PlacedAt.Add(-rules.RapidWindow)).Return(history, nil)
+
+		assessment, err := order.NewRuleScorer(mockRepo, rules).Score(ctx, input)
+
+		require.NoError(t, err)
+		return assessment
+	}
+
+	t.Run("clean history scores nothing", func(t *testing.T) {
+		assessment := score(t, rules, &order.PurchaseHistory{EventOrders: 1, RecentOrders: 1, Countries: []string{"DE"}})
+
+		assert.Zero(t, assessment.Score)
+		assert.Empty(t, assessment.Reasons)
+	})
+
+	t.Run("first order skips the country check", func(t *testing.T) {
+		assessment := score(t, rules, &order.PurchaseHistory{})
+
+		assert.Zero(t, assessment.Score)
+	})
+
+	t.Run("every tripped rule adds up", func(t *testing.T) {
+		assessment := score(t, rules, &order.PurchaseHistory{EventOrders: 3, RecentOrders: 5, Countries: []string{"US"}})
+
+		assert.Equal(t, 120, assessment.Score)
+		assert.Equal(t, []string{order.ReasonRepeatPurchases, order.ReasonRapidPurchases, order.ReasonCountryMismatch}, assessment.Reasons)
+	})
+
+	t.Run("zero limits disable rules", func(t *testing.T) {
+		assessment := score(t, order.RiskRules{}, &order.PurchaseHistory{EventOrders: 10, RecentOrders: 10, Countries: []string{"US"}})
+
+		assert.Zero(t, assessment.Score)
+	})
+
+	t.Run("history errors are returned", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetPurchaseHistory", ctx, input.UserID, input.EventID, mock.AnythingOfType("time.Time")).Return(nil, errors.New("db down"))
+
+		_, err := order.NewRuleScorer(mockRepo, rules).Score(ctx, input)
+
+		assert.Error(t, err)
+	})
+}
@@ -2,7 +2,17 @@ package order
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/values"
+	"enterprise-crud/internal/logging"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -10,90 +20,355 @@ import (
 
 // Service defines the contract for order business logic
 type Service interface {
-	CreateOrder(ctx context.Context, userID uuid.UUID, eventID uuid.UUID, quantity int) (*Order, error)
+	CreateOrder(ctx context.Context, userID uuid.UUID, eventID uuid.UUID, quantity int, details Details) (*Order, error)
 	GetOrderByID(ctx context.Context, id uuid.UUID) (*Order, error)
+	GetOrderStatus(ctx context.Context, id uuid.UUID) (*StatusSnapshot, error)
 	GetOrdersByUserID(ctx context.Context, userID uuid.UUID) ([]*Order, error)
 	GetOrdersByEventID(ctx context.Context, eventID uuid.UUID) ([]*Order, error)
-	UpdateOrderStatus(ctx context.Context, id uuid.UUID, status string) error
+	GetAttendees(ctx context.Context, eventID uuid.UUID) ([]*Attendee, error)
+	UpdateOrderStatus(ctx context.Context, id uuid.UUID, status Status) error
 	DeleteOrder(ctx context.Context, id uuid.UUID) error
+	CheckIn(ctx context.Context, eventID uuid.UUID, orderID uuid.UUID) (*Order, error)
+	ExpirePendingOrders(ctx context.Context, olderThan time.Duration) (int64, error)
+
+	// Manual review queue for orders held by the risk checks
+	ListOrdersForReview(ctx context.Context, limit int) ([]*Order, error)
+	ReviewOrder(ctx context.Context, id uuid.UUID, approve bool) (*Order, error)
+
+	// ReconcileTickets compares event ticket counters with orders, correcting drifted counters unless dryRun is set
+	ReconcileTickets(ctx context.Context, dryRun bool) (*Reconciliation, error)
+
+	// ArchiveEvents moves events that took place before cutoff, and their orders, to the archive tables
+	ArchiveEvents(ctx context.Context, cutoff time.Time) (*Archived, error)
+
+	// Gifts: the buyer may resend the claim link, and its recipient claims the tickets with the token from it
+	ResendGift(ctx context.Context, userID, orderID uuid.UUID) error
+	ClaimGift(ctx context.Context, userID uuid.UUID, token string) (*Order, error)
+
+	// Group reservations: the leader holds tickets for several members, each of whom takes and pays their share through its payment link
+	CreateGroup(ctx context.Context, leaderID, eventID uuid.UUID, shares []int, details Details) (*GroupReservation, error)
+	GetGroup(ctx context.Context, leaderID, groupID uuid.UUID) (*Group, error)
+	TakeGroupShare(ctx context.Context, userID uuid.UUID, token string) (*Order, error)
 }
 
+// MaxReviewPageSize bounds how many held orders are listed at once
+const MaxReviewPageSize = 100
+
 // OrderService implements the order service interface
 type OrderService struct {
 	repository Repository
 	db         *gorm.DB
+	publisher  eventbus.Publisher // Receives OrderPlaced, OrderConfirmed, OrderFlagged and GiftClaimed; nil publishes nothing
+	clock      clock.Clock        // Tells the time sale windows, ages and holds are checked against
+	ids        clock.IDGenerator  // Creates the IDs of new orders and groups
+	scorer     RiskScorer         // Rates new orders; nil accepts every order
+	thresholds RiskThresholds
+
+	accessCodes AccessCodeRedeemer // Redeems codes for events that require one; nil refuses their orders
+	birthDates  BirthDates         // Looks up buyers' ages for age-restricted events; nil refuses their orders
+	giftJobs    job.Service        // Queues gift claim emails; nil refuses gift orders
+	groupHold   time.Duration      // How long group shares are held for their members; 0 refuses group reservations
+
+	legacyTicketUpdate bool // Lock the event and write back its new ticket count instead of one conditional UPDATE
 }
 
 // NewOrderService creates a new instance of order service
-func NewOrderService(repository Repository, db *gorm.DB) Service {
-	return &OrderService{
+func NewOrderService(repository Repository, db *gorm.DB, publisher eventbus.Publisher, opts ...ServiceOption) Service {
+	s := &OrderService{
 		repository: repository,
 		db:         db,
+		publisher:  publisher,
+		clock:      clock.System{},
+		ids:        clock.RandomIDs{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// WithClock checks sale windows, ages and holds against c instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *OrderService) {
+		s.clock = c
+	}
+}
+
+// WithIDGenerator creates the IDs of new orders and groups with ids instead of at random
+func WithIDGenerator(ids clock.IDGenerator) ServiceOption {
+	return func(s *OrderService) {
+		s.ids = ids
+	}
+}
+
+// WithLegacyTicketUpdate takes tickets by locking the event, checking its count and writing back the new one
+// It is the path orders used before the conditional UPDATE and is kept as a fallback during its rollout.
+func WithLegacyTicketUpdate() ServiceOption {
+	return func(s *OrderService) {
+		s.legacyTicketUpdate = true
 	}
 }
 
 // CreateOrder creates a new order with transaction support
-func (s *OrderService) CreateOrder(ctx context.Context, userID uuid.UUID, eventID uuid.UUID, quantity int) (*Order, error) {
+// Answers are checked against the event's attendee questions inside the transaction.
+// With a risk scorer configured, risky orders are held for review or rejected.
+// Events that require an access code only sell to buyers redeeming a valid one.
+// Events with a sale window only sell while it is open, and age-restricted events only to buyers old enough.
+// Tickets are priced at the event's price tier active when the order is placed.
+func (s *OrderService) CreateOrder(ctx context.Context, userID uuid.UUID, eventID uuid.UUID, quantity int, details Details) (*Order, error) {
 	// Validate input
-	if quantity <= 0 {
+	tickets, err := values.NewQuantity(quantity)
+	if err != nil {
 		return nil, NewInvalidQuantityError(quantity)
 	}
 
-	var createdOrder *Order
-	var err error
+	giftRecipient, err := normalizeGiftRecipient(details.GiftRecipient)
+	if err != nil {
+		return nil, err
+	}
+	if giftRecipient != "" && s.giftJobs == nil {
+		return nil, NewValidationError("Gifts are not available")
+	}
+
+	created, err := s.placeOrders(ctx, placement{
+		userID:        userID,
+		eventID:       eventID,
+		shares:        []values.Quantity{tickets},
+		details:       details,
+		giftRecipient: giftRecipient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created[0], nil
+}
+
+// placement describes the orders placeOrders creates for one buyer and event
+type placement struct {
+	userID  uuid.UUID
+	eventID uuid.UUID
+	shares  []values.Quantity // One order is placed per share
+	details Details
+
+	giftRecipient string // Normalized gift recipient of a single order
+	group         *Group // Group the shares are held for; nil for a plain order
+	shareHashes   []string
+}
+
+// placeOrders takes the tickets of all shares at once and creates their orders in one transaction
+// The sale window, age, answers, access code and risk checks apply to the placement as a whole.
+func (s *OrderService) placeOrders(ctx context.Context, p placement) ([]*Order, error) {
+	notes := strings.TrimSpace(p.details.Notes)
+	if utf8.RuneCountInString(notes) > MaxNotesLength {
+		return nil, NewValidationError(fmt.Sprintf("Notes must be at most %d characters", MaxNotesLength))
+	}
+
+	quantity := 0
+	for _, share := range p.shares {
+		quantity += int(share)
+	}
+
+	var createdOrders []*Order
+	var flagged *OrderFlagged
 
 	// Execute within transaction to ensure atomicity
-	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Get event information within transaction
-		eventInfo, err := s.repository.GetEventWithTx(ctx, tx, eventID)
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Take the tickets first: the event row stays locked until the transaction ends
+		eventInfo, err := s.takeTickets(ctx, tx, p.eventID, quantity)
 		if err != nil {
 			return err
 		}
 
-		// Validate event is active
-		if eventInfo.Status != "ACTIVE" {
-			return NewEventNotActiveError(eventID, eventInfo.Status)
+		// Orders outside the sale window roll back the reservation with the transaction
+		now := s.clock.Now()
+		if err := eventInfo.checkSaleWindow(now); err != nil {
+			return err
+		}
+		if err := s.checkAge(ctx, eventInfo, p.userID, now); err != nil {
+			return err
 		}
 
-		// Check if sufficient tickets are available
-		if eventInfo.AvailableTickets < quantity {
-			return NewInsufficientTicketsError(quantity, eventInfo.AvailableTickets)
+		answers, err := eventInfo.Questions.ValidateAnswers(p.details.Answers)
+		if err != nil {
+			return NewInvalidAnswersError(err)
+		}
+
+		accessCode := strings.TrimSpace(p.details.AccessCode)
+		if eventInfo.AccessCodeRequired && accessCode == "" {
+			return NewAccessCodeRequiredError(p.eventID)
 		}
 
-		// Calculate total amount
-		totalAmount := eventInfo.TicketPrice * float64(quantity)
+		// Price the tickets at the tier active now; the locked event row keeps the schedule from changing meanwhile
+		price := values.Money(eventInfo.priceAt(now))
+		amounts := make([]float64, len(p.shares))
+		totalAmount := 0.0
+		for i, share := range p.shares {
+			amount, err := price.Times(share)
+			if err != nil {
+				return NewValidationError("Order total " + values.ErrInvalidMoney.Rule)
+			}
+			amounts[i] = amount.Float64()
+			totalAmount += amounts[i]
+		}
 
-		// Create order entity
-		newOrder := &Order{
-			ID:          uuid.New(),
-			UserID:      userID,
-			EventID:     eventID,
+		status := StatusPending
+		assessment := s.assessRisk(ctx, RiskInput{
+			UserID:      p.userID,
+			EventID:     p.eventID,
 			Quantity:    quantity,
 			TotalAmount: totalAmount,
-			Status:      StatusPending,
-			CreatedAt:   time.Now(),
+			ClientIP:    p.details.ClientIP,
+			Country:     p.details.Country,
+			PlacedAt:    now,
+		})
+		switch {
+		case s.thresholds.Reject > 0 && assessment.Score >= s.thresholds.Reject:
+			logging.From(ctx).Info("Order rejected", "user_id", p.userID, "event_id", p.eventID, "score", assessment.Score, "reasons", assessment.Reasons)
+			flagged = &OrderFlagged{UserID: p.userID, EventID: p.eventID, Score: assessment.Score, Reasons: assessment.Reasons, Rejected: true}
+			return NewOrderRejectedError()
+		case s.thresholds.Review > 0 && assessment.Score >= s.thresholds.Review:
+			status = StatusReview
 		}
 
-		// Create order within transaction
-		if err := s.repository.CreateWithTx(ctx, tx, newOrder); err != nil {
-			return NewOrderCreationError(err)
+		// The group's hold starts once its tickets are taken
+		var holdUntil *time.Time
+		if p.group != nil {
+			p.group.HoldUntil = now.Add(s.groupHold)
+			p.group.CreatedAt = now
+			if err := s.repository.CreateGroupWithTx(ctx, tx, p.group); err != nil {
+				return NewOrderCreationError(err)
+			}
+			holdUntil = &p.group.HoldUntil
 		}
 
-		// Update event available tickets within transaction
-		newAvailableTickets := eventInfo.AvailableTickets - quantity
-		if err := s.repository.UpdateEventTicketsWithTx(ctx, tx, eventID, newAvailableTickets); err != nil {
-			return NewOrderCreationError(err)
+		// Create order entities
+		createdOrders = make([]*Order, len(p.shares))
+		for i, share := range p.shares {
+			newOrder := &Order{
+				ID:            s.ids.NewID(),
+				UserID:        p.userID,
+				EventID:       p.eventID,
+				Quantity:      int(share),
+				TotalAmount:   amounts[i],
+				Status:        status,
+				Notes:         notes,
+				Answers:       answers,
+				RiskScore:     assessment.Score,
+				RiskReasons:   assessment.Reasons,
+				Country:       strings.ToUpper(p.details.Country),
+				GiftRecipient: p.giftRecipient,
+				HoldUntil:     holdUntil,
+				CreatedAt:     now,
+			}
+			if p.group != nil {
+				newOrder.GroupID = &p.group.ID
+				newOrder.ShareTokenHash = p.shareHashes[i]
+			}
+
+			// Rejected orders never get here, so they don't use up the code; a group redeems it once
+			if i == 0 {
+				if err := s.redeemAccessCode(ctx, tx, eventInfo, newOrder, accessCode); err != nil {
+					return err
+				}
+			}
+
+			// Create order within transaction
+			if err := s.repository.CreateWithTx(ctx, tx, newOrder); err != nil {
+				return NewOrderCreationError(err)
+			}
+			createdOrders[i] = newOrder
 		}
 
-		createdOrder = newOrder
+		// Without the conditional UPDATE the new ticket count is written back here
+		if s.legacyTicketUpdate {
+			newAvailableTickets := eventInfo.AvailableTickets - quantity
+			if err := s.repository.UpdateEventTicketsWithTx(ctx, tx, p.eventID, newAvailableTickets); err != nil {
+				return NewOrderCreationError(err)
+			}
+		}
+
+		if status == StatusReview {
+			flagged = &OrderFlagged{OrderID: createdOrders[0].ID, UserID: p.userID, EventID: p.eventID, Score: assessment.Score, Reasons: assessment.Reasons}
+		}
 		return nil
 	})
 
+	// Subscribers such as automatic suspensions only hear of flags that stood: a held order whose
+	// transaction failed later was never placed
+	if flagged != nil && s.publisher != nil && (err == nil || flagged.Rejected) {
+		s.publisher.Publish(ctx, *flagged)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.publisher != nil {
+		for _, createdOrder := range createdOrders {
+			s.publisher.Publish(ctx, OrderPlaced{
+				OrderID:  createdOrder.ID,
+				UserID:   createdOrder.UserID,
+				EventID:  createdOrder.EventID,
+				Quantity: createdOrder.Quantity,
+				Status:   createdOrder.Status,
+			})
+		}
+	}
+
+	return createdOrders, nil
+}
+
+// takeTickets reserves quantity tickets of an event within tx and returns the event
+// The conditional UPDATE returns the event with its count already reduced. When it takes nothing,
+// and always with the legacy update, the event is locked and read to tell why.
+func (s *OrderService) takeTickets(ctx context.Context, tx *gorm.DB, eventID uuid.UUID, quantity int) (*EventInfo, error) {
+	if !s.legacyTicketUpdate {
+		reserved, err := s.repository.ReserveTicketsWithTx(ctx, tx, eventID, quantity)
+		if err != nil || reserved != nil {
+			return reserved, err
+		}
+	}
+
+	eventInfo, err := s.repository.LockEventWithTx(ctx, tx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	// Events held by moderation aren't published yet, so buyers can't tell them from missing ones
+	if eventInfo.Flagged {
+		return nil, NewEventNotFoundError(eventID)
+	}
+	if eventInfo.Status != event.StatusActive {
+		return nil, NewEventNotActiveError(eventID, eventInfo.Status)
+	}
+	if eventInfo.AvailableTickets < quantity {
+		return nil, NewInsufficientTicketsError(quantity, eventInfo.AvailableTickets)
+	}
+	if s.legacyTicketUpdate {
+		return eventInfo, nil
+	}
+
+	// Tickets were returned between the two statements; with the row locked the update can't race again
+	reserved, err := s.repository.ReserveTicketsWithTx(ctx, tx, eventID, quantity)
 	if err != nil {
 		return nil, err
 	}
+	if reserved == nil {
+		return nil, NewInsufficientTicketsError(quantity, eventInfo.AvailableTickets)
+	}
+	return reserved, nil
+}
 
-	return createdOrder, nil
+// assessRisk scores an order, treating scorer failures as no risk so an outage can't block sales
+func (s *OrderService) assessRisk(ctx context.Context, input RiskInput) RiskAssessment {
+	if s.scorer == nil {
+		return RiskAssessment{}
+	}
+	assessment, err := s.scorer.Score(ctx, input)
+	if err != nil {
+		logging.From(ctx).Warn("Failed to score order risk", "user_id", input.UserID, "error", err)
+		return RiskAssessment{}
+	}
+	return assessment
 }
 
 // GetOrderByID retrieves an order by its ID
@@ -101,6 +376,11 @@ func (s *OrderService) GetOrderByID(ctx context.Context, id uuid.UUID) (*Order,
 	return s.repository.GetByID(ctx, id)
 }
 
+// GetOrderStatus retrieves the status of an order without loading the rest of it
+func (s *OrderService) GetOrderStatus(ctx context.Context, id uuid.UUID) (*StatusSnapshot, error) {
+	return s.repository.GetStatus(ctx, id)
+}
+
 // GetOrdersByUserID retrieves all orders for a specific user
 func (s *OrderService) GetOrdersByUserID(ctx context.Context, userID uuid.UUID) ([]*Order, error) {
 	return s.repository.GetByUserID(ctx, userID)
@@ -111,11 +391,16 @@ func (s *OrderService) GetOrdersByEventID(ctx context.Context, eventID uuid.UUID
 	return s.repository.GetByEventID(ctx, eventID)
 }
 
+// GetAttendees retrieves the completed orders of an event with their buyers
+func (s *OrderService) GetAttendees(ctx context.Context, eventID uuid.UUID) ([]*Attendee, error) {
+	return s.repository.GetAttendees(ctx, eventID)
+}
+
 // UpdateOrderStatus updates the status of an order
-func (s *OrderService) UpdateOrderStatus(ctx context.Context, id uuid.UUID, status string) error {
+func (s *OrderService) UpdateOrderStatus(ctx context.Context, id uuid.UUID, status Status) error {
 	// Validate status
-	if !isValidStatus(status) {
-		return NewValidationError("Invalid order status: " + status)
+	if !status.Valid() {
+		return NewValidationError("Invalid order status: " + string(status))
 	}
 
 	// Get existing order
@@ -124,29 +409,176 @@ func (s *OrderService) UpdateOrderStatus(ctx context.Context, id uuid.UUID, stat
 		return err
 	}
 
+	if existingOrder.IsArchived() {
+		return NewOrderArchivedError(id)
+	}
+	if !existingOrder.Status.CanTransitionTo(status) {
+		return NewInvalidTransitionError(id, existingOrder.Status, status)
+	}
+
 	// Update status
+	confirmed := status == StatusCompleted && !existingOrder.IsCompleted()
 	existingOrder.Status = status
-	return s.repository.Update(ctx, existingOrder)
+	if err := s.repository.Update(ctx, existingOrder); err != nil {
+		return err
+	}
+
+	if confirmed && s.publisher != nil {
+		s.publisher.Publish(ctx, OrderConfirmed{
+			OrderID:     existingOrder.ID,
+			UserID:      existingOrder.UserID,
+			EventID:     existingOrder.EventID,
+			Quantity:    existingOrder.Quantity,
+			TotalAmount: existingOrder.TotalAmount,
+		})
+	}
+
+	// The order is paid either way; the buyer can resend the claim link if queueing it failed
+	if confirmed && existingOrder.IsGift() && !existingOrder.IsGiftClaimed() {
+		if err := s.sendGiftClaim(ctx, existingOrder); err != nil {
+			logging.From(ctx).Warn("Failed to send gift claim link", "order_id", existingOrder.ID, "error", err)
+		}
+	}
+	return nil
 }
 
 // DeleteOrder deletes an order
 func (s *OrderService) DeleteOrder(ctx context.Context, id uuid.UUID) error {
 	// Check if order exists
-	_, err := s.repository.GetByID(ctx, id)
+	existingOrder, err := s.repository.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
+	if existingOrder.IsArchived() {
+		return NewOrderArchivedError(id)
+	}
 
 	return s.repository.Delete(ctx, id)
 }
 
-// isValidStatus checks if the provided status is valid
-func isValidStatus(status string) bool {
-	validStatuses := []string{StatusPending, StatusCompleted, StatusFailed}
-	for _, validStatus := range validStatuses {
-		if status == validStatus {
-			return true
+// CheckIn admits the holder of a completed order to its event
+// Orders of other events are reported as not found so IDs can't be probed across events
+func (s *OrderService) CheckIn(ctx context.Context, eventID uuid.UUID, orderID uuid.UUID) (*Order, error) {
+	existingOrder, err := s.repository.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if existingOrder.EventID != eventID {
+		return nil, NewOrderNotFoundError(orderID)
+	}
+	if existingOrder.IsArchived() {
+		return nil, NewOrderArchivedError(orderID)
+	}
+	if !existingOrder.IsCompleted() {
+		return nil, NewOrderNotCompletedError(orderID, existingOrder.Status)
+	}
+
+	now := s.clock.Now()
+	checkedIn, err := s.repository.MarkCheckedIn(ctx, orderID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !checkedIn {
+		return nil, NewAlreadyCheckedInError(orderID)
+	}
+
+	existingOrder.CheckedInAt = &now
+	return existingOrder, nil
+}
+
+// ExpirePendingOrders fails orders left pending for longer than olderThan and releases their tickets
+// Group shares are released when their hold ends instead.
+func (s *OrderService) ExpirePendingOrders(ctx context.Context, olderThan time.Duration) (int64, error) {
+	if olderThan <= 0 {
+		return 0, NewValidationError("Expiry age must be positive")
+	}
+	now := s.clock.Now()
+	return s.repository.ExpirePending(ctx, now.Add(-olderThan), now)
+}
+
+// ListOrdersForReview lists orders held by the risk checks, oldest first
+func (s *OrderService) ListOrdersForReview(ctx context.Context, limit int) ([]*Order, error) {
+	if limit <= 0 || limit > MaxReviewPageSize {
+		limit = MaxReviewPageSize
+	}
+	return s.repository.ListByStatus(ctx, StatusReview, limit)
+}
+
+// ReviewOrder settles an order held for review
+// Approved orders go back to PENDING; rejected ones fail and release their tickets.
+func (s *OrderService) ReviewOrder(ctx context.Context, id uuid.UUID, approve bool) (*Order, error) {
+	existingOrder, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !existingOrder.IsInReview() {
+		return nil, NewOrderNotInReviewError(id, existingOrder.Status)
+	}
+
+	status := StatusFailed
+	if approve {
+		status = StatusPending
+	}
+	resolved, err := s.repository.ResolveReview(ctx, id, status)
+	if err != nil {
+		return nil, err
+	}
+	if !resolved {
+		// Another admin decided first; report what they chose
+		current, err := s.repository.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return nil, NewOrderNotInReviewError(id, current.Status)
+	}
+
+	existingOrder.Status = status
+	return existingOrder, nil
+}
+
+// ReconcileTickets compares event ticket counters with the tickets their orders hold
+// Oversold events are reported but left alone, and a counter that moved since it was read is
+// skipped: the next run will see it again if it is still off.
+func (s *OrderService) ReconcileTickets(ctx context.Context, dryRun bool) (*Reconciliation, error) {
+	counts, err := s.repository.FindTicketDrift(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Reconciliation{DryRun: dryRun, Discrepancies: make([]Discrepancy, 0, len(counts))}
+	for _, count := range counts {
+		discrepancy := Discrepancy{TicketCount: *count}
+		if !dryRun && !count.IsOversold() {
+			corrected, err := s.repository.CorrectAvailableTickets(ctx, count.EventID, count.AvailableTickets)
+			if err != nil {
+				return nil, err
+			}
+			if corrected {
+				discrepancy.Corrected = true
+				result.Corrected++
+			}
+		}
+		result.Discrepancies = append(result.Discrepancies, discrepancy)
+	}
+	return result, nil
+}
+
+// ArchiveEvents moves events that took place before cutoff, and their orders, to the archive tables
+// Events are moved in batches so no transaction holds locks on many rows; it stops once a batch comes up short.
+func (s *OrderService) ArchiveEvents(ctx context.Context, cutoff time.Time) (*Archived, error) {
+	if !cutoff.Before(s.clock.Now()) {
+		return nil, NewValidationError("Archive cutoff must be in the past")
+	}
+
+	total := &Archived{}
+	for {
+		batch, err := s.repository.ArchiveEvents(ctx, cutoff, archiveBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total.add(batch)
+		if batch.Events < archiveBatchSize {
+			return total, nil
 		}
 	}
-	return false
 }
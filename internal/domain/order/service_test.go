@@ -2,14 +2,23 @@ package order_test
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/eventbus"
 	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/values"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
@@ -28,6 +37,11 @@ func (m *MockOrderRepository) CreateWithTx(ctx context.Context, tx *gorm.DB, ord
 	return args.Error(0)
 }
 
+func (m *MockOrderRepository) CreateGroupWithTx(ctx context.Context, tx *gorm.DB, group *order.Group) error {
+	args := m.Called(ctx, tx, group)
+	return args.Error(0)
+}
+
 func (m *MockOrderRepository) GetByID(ctx context.Context, id uuid.UUID) (*order.Order, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -36,6 +50,14 @@ func (m *MockOrderRepository) GetByID(ctx context.Context, id uuid.UUID) (*order
 	return args.Get(0).(*order.Order), args.Error(1)
 }
 
+func (m *MockOrderRepository) GetStatus(ctx context.Context, id uuid.UUID) (*order.StatusSnapshot, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.StatusSnapshot), args.Error(1)
+}
+
 func (m *MockOrderRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*order.Order, error) {
 	args := m.Called(ctx, userID)
 	return args.Get(0).([]*order.Order), args.Error(1)
@@ -46,6 +68,14 @@ func (m *MockOrderRepository) GetByEventID(ctx context.Context, eventID uuid.UUI
 	return args.Get(0).([]*order.Order), args.Error(1)
 }
 
+func (m *MockOrderRepository) GetAttendees(ctx context.Context, eventID uuid.UUID) ([]*order.Attendee, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Attendee), args.Error(1)
+}
+
 func (m *MockOrderRepository) Update(ctx context.Context, orderEntity *order.Order) error {
 	args := m.Called(ctx, orderEntity)
 	return args.Error(0)
@@ -56,7 +86,111 @@ func (m *MockOrderRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return args.Error(0)
 }
 
-func (m *MockOrderRepository) GetEventWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID) (*order.EventInfo, error) {
+func (m *MockOrderRepository) MarkCheckedIn(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	args := m.Called(ctx, id, at)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockOrderRepository) ExpirePending(ctx context.Context, cutoff, now time.Time) (int64, error) {
+	args := m.Called(ctx, cutoff, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetPurchaseHistory(ctx context.Context, userID, eventID uuid.UUID, since time.Time) (*order.PurchaseHistory, error) {
+	args := m.Called(ctx, userID, eventID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.PurchaseHistory), args.Error(1)
+}
+
+func (m *MockOrderRepository) ListByStatus(ctx context.Context, status order.Status, limit int) ([]*order.Order, error) {
+	args := m.Called(ctx, status, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) ResolveReview(ctx context.Context, id uuid.UUID, status order.Status) (bool, error) {
+	args := m.Called(ctx, id, status)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockOrderRepository) FindTicketDrift(ctx context.Context) ([]*order.TicketCount, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.TicketCount), args.Error(1)
+}
+
+func (m *MockOrderRepository) CorrectAvailableTickets(ctx context.Context, eventID uuid.UUID, observed int) (bool, error) {
+	args := m.Called(ctx, eventID, observed)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockOrderRepository) ArchiveEvents(ctx context.Context, cutoff time.Time, limit int) (*order.Archived, error) {
+	args := m.Called(ctx, cutoff, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Archived), args.Error(1)
+}
+
+func (m *MockOrderRepository) SetGiftToken(ctx context.Context, id uuid.UUID, tokenHash string) (bool, error) {
+	args := m.Called(ctx, id, tokenHash)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetByGiftToken(ctx context.Context, tokenHash string) (*order.Order, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) ClaimGift(ctx context.Context, id, purchaserID, recipientID uuid.UUID, at time.Time) (bool, error) {
+	args := m.Called(ctx, id, purchaserID, recipientID, at)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetGroup(ctx context.Context, id uuid.UUID) (*order.Group, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Group), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetByGroupID(ctx context.Context, groupID uuid.UUID) ([]*order.Order, error) {
+	args := m.Called(ctx, groupID)
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetByShareToken(ctx context.Context, tokenHash string) (*order.Order, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) TakeShare(ctx context.Context, id uuid.UUID, tokenHash string, userID uuid.UUID, now time.Time) (bool, error) {
+	args := m.Called(ctx, id, tokenHash, userID, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockOrderRepository) ReserveTicketsWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID, quantity int) (*order.EventInfo, error) {
+	args := m.Called(ctx, tx, eventID, quantity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.EventInfo), args.Error(1)
+}
+
+func (m *MockOrderRepository) LockEventWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID) (*order.EventInfo, error) {
 	args := m.Called(ctx, tx, eventID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -73,27 +207,355 @@ func (m *MockOrderRepository) UpdateEventTicketsWithTx(ctx context.Context, tx *
 func TestOrderService_CreateOrder_InvalidQuantity(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockOrderRepository)
-	service := order.NewOrderService(mockRepo, nil) // DB not used for validation
+	service := order.NewOrderService(mockRepo, nil, nil) // DB not used for validation
 
 	ctx := context.Background()
 	userID := uuid.New()
 	eventID := uuid.New()
-	quantity := 0
+
+	for _, quantity := range []int{0, int(values.MaxQuantity) + 1} {
+		// Act
+		createdOrder, err := service.CreateOrder(ctx, userID, eventID, quantity, order.Details{})
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, createdOrder)
+		assert.True(t, order.IsInvalidQuantityError(err), "%d", quantity)
+	}
+}
+
+// TestOrderService_CreateOrder_NotesTooLong tests notes are limited before the order is placed
+func TestOrderService_CreateOrder_NotesTooLong(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockOrderRepository)
+	service := order.NewOrderService(mockRepo, nil, nil) // DB not used for validation
+
+	details := order.Details{Notes: strings.Repeat("a", order.MaxNotesLength+1)}
 
 	// Act
-	createdOrder, err := service.CreateOrder(ctx, userID, eventID, quantity)
+	createdOrder, err := service.CreateOrder(context.Background(), uuid.New(), uuid.New(), 1, details)
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, createdOrder)
-	assert.True(t, order.IsInvalidQuantityError(err))
+	assert.True(t, order.IsValidationError(err))
+}
+
+// fakeTxPool lets the service open and commit transactions without a database
+// The mocked repository runs no SQL, so the pool only has to hand out transactions.
+type fakeTxPool struct{ gorm.ConnPool }
+
+func (fakeTxPool) BeginTx(context.Context, *sql.TxOptions) (gorm.ConnPool, error) {
+	return &fakeTx{}, nil
+}
+
+type fakeTx struct{ gorm.ConnPool }
+
+func (*fakeTx) Commit() error   { return nil }
+func (*fakeTx) Rollback() error { return nil }
+
+func newTxDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: fakeTxPool{}}), &gorm.Config{DisableAutomaticPing: true})
+	require.NoError(t, err)
+	return db
+}
+
+func TestOrderService_CreateOrder_ReservesTicketsWithConditionalUpdate(t *testing.T) {
+	mockRepo := new(MockOrderRepository)
+	service := order.NewOrderService(mockRepo, newTxDB(t), nil)
+	eventID := uuid.New()
+
+	mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 2).
+		Return(&order.EventInfo{ID: eventID, TicketPrice: 25, AvailableTickets: 8, Status: "ACTIVE"}, nil)
+	mockRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil)
+
+	created, err := service.CreateOrder(context.Background(), uuid.New(), eventID, 2, order.Details{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, created.TotalAmount)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "LockEventWithTx", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateEventTicketsWithTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestOrderService_CreateOrder_ExplainsFailedReservation(t *testing.T) {
+	tests := []struct {
+		name  string
+		event *order.EventInfo
+		err   error
+		check func(error) bool
+	}{
+		{"missing event", nil, order.NewEventNotFoundError(uuid.Nil), order.IsEventNotFoundError},
+		{"inactive event", &order.EventInfo{Status: "CANCELLED", AvailableTickets: 10}, nil, order.IsEventNotActiveError},
+		{"event held by moderation", &order.EventInfo{Status: "ACTIVE", Flagged: true, AvailableTickets: 10}, nil, order.IsEventNotFoundError},
+		{"too few tickets", &order.EventInfo{Status: "ACTIVE", AvailableTickets: 1}, nil, order.IsInsufficientTicketsError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockOrderRepository)
+			service := order.NewOrderService(mockRepo, newTxDB(t), nil)
+			eventID := uuid.New()
+
+			mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 2).Return(nil, nil)
+			if tt.event != nil {
+				mockRepo.On("LockEventWithTx", mock.Anything, mock.Anything, eventID).Return(tt.event, nil)
+			} else {
+				mockRepo.On("LockEventWithTx", mock.Anything, mock.Anything, eventID).Return(nil, tt.err)
+			}
+
+			created, err := service.CreateOrder(context.Background(), uuid.New(), eventID, 2, order.Details{})
+
+			assert.Nil(t, created)
+			assert.True(t, tt.check(err), "unexpected error: %v", err)
+			mockRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestOrderService_CreateOrder_RetriesReservationWhenTicketsWereReturned(t *testing.T) {
+	mockRepo := new(MockOrderRepository)
+	service := order.NewOrderService(mockRepo, newTxDB(t), nil)
+	eventID := uuid.New()
+
+	mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 2).Return(nil, nil).Once()
+	mockRepo.On("LockEventWithTx", mock.Anything, mock.Anything, eventID).
+		Return(&order.EventInfo{ID: eventID, Status: "ACTIVE", AvailableTickets: 3}, nil)
+	mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 2).
+		Return(&order.EventInfo{ID: eventID, TicketPrice: 10, Status: "ACTIVE", AvailableTickets: 1}, nil).Once()
+	mockRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil)
+
+	_, err := service.CreateOrder(context.Background(), uuid.New(), eventID, 2, order.Details{})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestOrderService_CreateOrder_LegacyTicketUpdate(t *testing.T) {
+	mockRepo := new(MockOrderRepository)
+	service := order.NewOrderService(mockRepo, newTxDB(t), nil, order.WithLegacyTicketUpdate())
+	eventID := uuid.New()
+
+	mockRepo.On("LockEventWithTx", mock.Anything, mock.Anything, eventID).
+		Return(&order.EventInfo{ID: eventID, TicketPrice: 10, Status: "ACTIVE", AvailableTickets: 5}, nil)
+	mockRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil)
+	mockRepo.On("UpdateEventTicketsWithTx", mock.Anything, mock.Anything, eventID, 3).Return(nil)
+
+	_, err := service.CreateOrder(context.Background(), uuid.New(), eventID, 2, order.Details{})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "ReserveTicketsWithTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// stubRedeemer accepts the codes in valid and records what was redeemed
+type stubRedeemer struct {
+	valid    map[string]bool
+	redeemed []order.AccessCodeRedemption
+}
+
+func (r *stubRedeemer) RedeemWithTx(ctx context.Context, tx *gorm.DB, redemption order.AccessCodeRedemption) (bool, error) {
+	if !r.valid[redemption.Code] {
+		return false, nil
+	}
+	r.redeemed = append(r.redeemed, redemption)
+	return true, nil
+}
+
+func TestOrderService_CreateOrder_AccessCodes(t *testing.T) {
+	eventID := uuid.New()
+	userID := uuid.New()
+	gated := &order.EventInfo{ID: eventID, TicketPrice: 10, Status: "ACTIVE", AvailableTickets: 8, AccessCodeRequired: true}
+
+	tests := []struct {
+		name     string
+		code     string
+		redeemer *stubRedeemer
+		check    func(error) bool
+	}{
+		{name: "missing code", code: " ", redeemer: &stubRedeemer{}, check: order.IsAccessCodeRequiredError},
+		{name: "unknown code", code: "NOPE", redeemer: &stubRedeemer{}, check: order.IsAccessCodeInvalidError},
+		{name: "no redeemer configured", code: "VIP-2024", check: order.IsAccessCodeInvalidError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockOrderRepository)
+			mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 2).Return(gated, nil)
+			var opts []order.ServiceOption
+			if tt.redeemer != nil {
+				opts = append(opts, order.WithAccessCodes(tt.redeemer))
+			}
+			service := order.NewOrderService(mockRepo, newTxDB(t), nil, opts...)
+
+			created, err := service.CreateOrder(context.Background(), userID, eventID, 2, order.Details{AccessCode: tt.code})
+
+			assert.Nil(t, created)
+			assert.True(t, tt.check(err), "unexpected error: %v", err)
+			mockRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("valid code is redeemed", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 2).Return(gated, nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil)
+		redeemer := &stubRedeemer{valid: map[string]bool{"VIP-2024": true}}
+		service := order.NewOrderService(mockRepo, newTxDB(t), nil, order.WithAccessCodes(redeemer))
+
+		created, err := service.CreateOrder(context.Background(), userID, eventID, 2, order.Details{AccessCode: " VIP-2024 "})
+
+		require.NoError(t, err)
+		require.Len(t, redeemer.redeemed, 1)
+		assert.Equal(t, order.AccessCodeRedemption{EventID: eventID, OrderID: created.ID, UserID: userID, Code: "VIP-2024", Quantity: 2}, redeemer.redeemed[0])
+	})
+
+	t.Run("events without codes ignore them", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 2).
+			Return(&order.EventInfo{ID: eventID, TicketPrice: 10, Status: "ACTIVE", AvailableTickets: 8}, nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil)
+		redeemer := &stubRedeemer{}
+		service := order.NewOrderService(mockRepo, newTxDB(t), nil, order.WithAccessCodes(redeemer))
+
+		_, err := service.CreateOrder(context.Background(), userID, eventID, 2, order.Details{AccessCode: "ANY"})
+
+		require.NoError(t, err)
+		assert.Empty(t, redeemer.redeemed)
+	})
+}
+
+func TestOrderService_CreateOrder_SaleWindow(t *testing.T) {
+	eventID := uuid.New()
+	userID := uuid.New()
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name  string
+		start *time.Time
+		end   *time.Time
+		check func(error) bool
+	}{
+		{name: "before sale start", start: &future, check: order.IsSalesNotStartedError},
+		{name: "after sale end", start: &past, end: &past, check: order.IsSalesEndedError},
+		{name: "inside the window", start: &past, end: &future},
+		{name: "no window"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockOrderRepository)
+			mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 2).
+				Return(&order.EventInfo{ID: eventID, TicketPrice: 10, Status: "ACTIVE", AvailableTickets: 8, SaleStart: tt.start, SaleEnd: tt.end}, nil)
+			mockRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil)
+			service := order.NewOrderService(mockRepo, newTxDB(t), nil)
+
+			created, err := service.CreateOrder(context.Background(), userID, eventID, 2, order.Details{})
+
+			if tt.check == nil {
+				require.NoError(t, err)
+				assert.NotNil(t, created)
+				return
+			}
+			assert.Nil(t, created)
+			assert.True(t, tt.check(err), "unexpected error: %v", err)
+			mockRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestOrderService_CreateOrder_PricesAtActiveTier(t *testing.T) {
+	eventID := uuid.New()
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		schedule  event.PriceSchedule
+		wantTotal float64
+	}{
+		{name: "no schedule", wantTotal: 100},
+		{name: "early bird running", schedule: event.PriceSchedule{{Name: "Early bird", Price: 35, To: &future}}, wantTotal: 70},
+		{name: "early bird over", schedule: event.PriceSchedule{{Name: "Early bird", Price: 35, To: &past}}, wantTotal: 100},
+		{name: "late tier not started", schedule: event.PriceSchedule{{Name: "Door", Price: 60, From: &future}}, wantTotal: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockOrderRepository)
+			mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 2).
+				Return(&order.EventInfo{ID: eventID, TicketPrice: 50, PriceSchedule: tt.schedule, Status: "ACTIVE", AvailableTickets: 8}, nil)
+			mockRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil)
+			service := order.NewOrderService(mockRepo, newTxDB(t), nil)
+
+			created, err := service.CreateOrder(context.Background(), uuid.New(), eventID, 2, order.Details{})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, created.TotalAmount)
+		})
+	}
+}
+
+// stubBirthDates knows the dates of birth of the users in it
+type stubBirthDates map[uuid.UUID]time.Time
+
+func (b stubBirthDates) DateOfBirth(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	if dateOfBirth, ok := b[userID]; ok {
+		return &dateOfBirth, nil
+	}
+	return nil, nil
+}
+
+func TestOrderService_CreateOrder_AgeGate(t *testing.T) {
+	eventID := uuid.New()
+	adult := uuid.New()
+	minor := uuid.New()
+	unknown := uuid.New()
+	now := time.Now().UTC()
+	birthDates := stubBirthDates{
+		adult: now.AddDate(-18, 0, 0),
+		minor: now.AddDate(-18, 0, 1),
+	}
+
+	tests := []struct {
+		name       string
+		userID     uuid.UUID
+		birthDates order.BirthDates
+		check      func(error) bool
+	}{
+		{name: "turns 18 today", userID: adult, birthDates: birthDates},
+		{name: "turns 18 tomorrow", userID: minor, birthDates: birthDates, check: order.IsAgeRestrictedError},
+		{name: "no date of birth", userID: unknown, birthDates: birthDates, check: order.IsDateOfBirthRequiredError},
+		{name: "no age gate configured", userID: adult, check: order.IsDateOfBirthRequiredError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockOrderRepository)
+			mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 1).
+				Return(&order.EventInfo{ID: eventID, TicketPrice: 10, Status: "ACTIVE", AvailableTickets: 9, MinimumAge: 18}, nil)
+			mockRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil)
+			var options []order.ServiceOption
+			if tt.birthDates != nil {
+				options = append(options, order.WithAgeGate(tt.birthDates))
+			}
+			service := order.NewOrderService(mockRepo, newTxDB(t), nil, options...)
+
+			created, err := service.CreateOrder(context.Background(), tt.userID, eventID, 1, order.Details{})
+
+			if tt.check == nil {
+				require.NoError(t, err)
+				assert.NotNil(t, created)
+				return
+			}
+			assert.Nil(t, created)
+			assert.True(t, tt.check(err), "unexpected error: %v", err)
+			mockRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
 }
 
 // TestOrderService_GetOrderByID_Success tests successful order retrieval
 func TestOrderService_GetOrderByID_Success(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockOrderRepository)
-	service := order.NewOrderService(mockRepo, nil)
+	service := order.NewOrderService(mockRepo, nil, nil)
 
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -127,7 +589,7 @@ func TestOrderService_GetOrderByID_Success(t *testing.T) {
 func TestOrderService_GetOrderByID_NotFound(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockOrderRepository)
-	service := order.NewOrderService(mockRepo, nil)
+	service := order.NewOrderService(mockRepo, nil, nil)
 
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -149,7 +611,7 @@ func TestOrderService_GetOrderByID_NotFound(t *testing.T) {
 func TestOrderService_GetOrdersByUserID_Success(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockOrderRepository)
-	service := order.NewOrderService(mockRepo, nil)
+	service := order.NewOrderService(mockRepo, nil, nil)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -194,7 +656,7 @@ func TestOrderService_GetOrdersByUserID_Success(t *testing.T) {
 func TestOrderService_UpdateOrderStatus_Success(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockOrderRepository)
-	service := order.NewOrderService(mockRepo, nil)
+	service := order.NewOrderService(mockRepo, nil, nil)
 
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -222,15 +684,119 @@ func TestOrderService_UpdateOrderStatus_Success(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+// recordingPublisher records published domain events
+type recordingPublisher struct {
+	events []eventbus.Event
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, e eventbus.Event) {
+	p.events = append(p.events, e)
+}
+
+// TestOrderService_CreateOrder_PublishesPlacement tests that only orders actually created raise OrderPlaced
+func TestOrderService_CreateOrder_PublishesPlacement(t *testing.T) {
+	userID := uuid.New()
+	eventID := uuid.New()
+
+	t.Run("created order", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 2).
+			Return(&order.EventInfo{ID: eventID, TicketPrice: 25, AvailableTickets: 8, Status: "ACTIVE"}, nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil)
+		publisher := &recordingPublisher{}
+
+		created, err := order.NewOrderService(mockRepo, newTxDB(t), publisher).CreateOrder(context.Background(), userID, eventID, 2, order.Details{})
+
+		require.NoError(t, err)
+		require.Len(t, publisher.events, 1)
+		assert.Equal(t, order.OrderPlaced{OrderID: created.ID, UserID: userID, EventID: eventID, Quantity: 2, Status: order.StatusPending}, publisher.events[0])
+	})
+
+	t.Run("failed order", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("ReserveTicketsWithTx", mock.Anything, mock.Anything, eventID, 2).Return(nil, nil)
+		mockRepo.On("LockEventWithTx", mock.Anything, mock.Anything, eventID).
+			Return(&order.EventInfo{ID: eventID, Status: "ACTIVE", AvailableTickets: 1}, nil)
+		publisher := &recordingPublisher{}
+
+		_, err := order.NewOrderService(mockRepo, newTxDB(t), publisher).CreateOrder(context.Background(), userID, eventID, 2, order.Details{})
+
+		require.Error(t, err)
+		assert.Empty(t, publisher.events)
+	})
+}
+
+// TestOrderService_UpdateOrderStatus_PublishesConfirmation tests that completing an order raises OrderConfirmed once
+func TestOrderService_UpdateOrderStatus_PublishesConfirmation(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.New()
+	userID := uuid.New()
+	eventID := uuid.New()
+
+	newOrder := func(status order.Status) *order.Order {
+		return &order.Order{ID: orderID, UserID: userID, EventID: eventID, Quantity: 2, TotalAmount: 100.0, Status: status}
+	}
+
+	t.Run("pending to completed", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusPending), nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*order.Order")).Return(nil)
+		publisher := &recordingPublisher{}
+
+		err := order.NewOrderService(mockRepo, nil, publisher).UpdateOrderStatus(ctx, orderID, order.StatusCompleted)
+
+		require.NoError(t, err)
+		require.Len(t, publisher.events, 1)
+		assert.Equal(t, order.OrderConfirmed{OrderID: orderID, UserID: userID, EventID: eventID, Quantity: 2, TotalAmount: 100.0}, publisher.events[0])
+	})
+
+	t.Run("already completed", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusCompleted), nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*order.Order")).Return(nil)
+		publisher := &recordingPublisher{}
+
+		err := order.NewOrderService(mockRepo, nil, publisher).UpdateOrderStatus(ctx, orderID, order.StatusCompleted)
+
+		require.NoError(t, err)
+		assert.Empty(t, publisher.events)
+	})
+
+	t.Run("update failure publishes nothing", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusPending), nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*order.Order")).Return(errors.New("db down"))
+		publisher := &recordingPublisher{}
+
+		err := order.NewOrderService(mockRepo, nil, publisher).UpdateOrderStatus(ctx, orderID, order.StatusCompleted)
+
+		assert.Error(t, err)
+		assert.Empty(t, publisher.events)
+	})
+}
+
+// TestOrderService_UpdateOrderStatus_InvalidTransition tests that final statuses can't be left
+func TestOrderService_UpdateOrderStatus_InvalidTransition(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.New()
+	mockRepo := new(MockOrderRepository)
+	mockRepo.On("GetByID", ctx, orderID).Return(&order.Order{ID: orderID, Status: order.StatusFailed}, nil)
+
+	err := order.NewOrderService(mockRepo, nil, nil).UpdateOrderStatus(ctx, orderID, order.StatusCompleted)
+
+	assert.True(t, order.IsInvalidTransitionError(err))
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
 // TestOrderService_UpdateOrderStatus_InvalidStatus tests invalid status validation
 func TestOrderService_UpdateOrderStatus_InvalidStatus(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockOrderRepository)
-	service := order.NewOrderService(mockRepo, nil)
+	service := order.NewOrderService(mockRepo, nil, nil)
 
 	ctx := context.Background()
 	orderID := uuid.New()
-	invalidStatus := "INVALID_STATUS"
+	invalidStatus := order.Status("INVALID_STATUS")
 
 	// Act
 	err := service.UpdateOrderStatus(ctx, orderID, invalidStatus)
@@ -244,7 +810,7 @@ func TestOrderService_UpdateOrderStatus_InvalidStatus(t *testing.T) {
 func TestOrderService_DeleteOrder_Success(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockOrderRepository)
-	service := order.NewOrderService(mockRepo, nil)
+	service := order.NewOrderService(mockRepo, nil, nil)
 
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -275,7 +841,7 @@ func TestOrderService_DeleteOrder_Success(t *testing.T) {
 func TestOrderService_DeleteOrder_NotFound(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockOrderRepository)
-	service := order.NewOrderService(mockRepo, nil)
+	service := order.NewOrderService(mockRepo, nil, nil)
 
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -292,6 +858,314 @@ func TestOrderService_DeleteOrder_NotFound(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+// TestOrderService_CheckIn tests admitting ticket holders
+func TestOrderService_CheckIn(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+	orderID := uuid.New()
+
+	newOrder := func(status order.Status) *order.Order {
+		return &order.Order{ID: orderID, EventID: eventID, Quantity: 2, Status: status}
+	}
+
+	t.Run("completed order is checked in", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		service := order.NewOrderService(mockRepo, nil, nil)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusCompleted), nil)
+		mockRepo.On("MarkCheckedIn", ctx, orderID, mock.AnythingOfType("time.Time")).Return(true, nil)
+
+		result, err := service.CheckIn(ctx, eventID, orderID)
+
+		assert.NoError(t, err)
+		assert.True(t, result.IsCheckedIn())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("second check-in is rejected", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		service := order.NewOrderService(mockRepo, nil, nil)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusCompleted), nil)
+		mockRepo.On("MarkCheckedIn", ctx, orderID, mock.AnythingOfType("time.Time")).Return(false, nil)
+
+		_, err := service.CheckIn(ctx, eventID, orderID)
+
+		assert.True(t, order.IsAlreadyCheckedInError(err))
+	})
+
+	t.Run("pending order is rejected", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		service := order.NewOrderService(mockRepo, nil, nil)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusPending), nil)
+
+		_, err := service.CheckIn(ctx, eventID, orderID)
+
+		assert.True(t, order.IsOrderNotCompletedError(err))
+		mockRepo.AssertNotCalled(t, "MarkCheckedIn", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("order of another event is not found", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		service := order.NewOrderService(mockRepo, nil, nil)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusCompleted), nil)
+
+		_, err := service.CheckIn(ctx, uuid.New(), orderID)
+
+		assert.True(t, order.IsOrderNotFoundError(err))
+	})
+
+	t.Run("archived order is read-only", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		service := order.NewOrderService(mockRepo, nil, nil)
+		archived := newOrder(order.StatusCompleted)
+		archivedAt := time.Now()
+		archived.ArchivedAt = &archivedAt
+		mockRepo.On("GetByID", ctx, orderID).Return(archived, nil)
+
+		_, err := service.CheckIn(ctx, eventID, orderID)
+
+		assert.True(t, order.IsOrderArchivedError(err))
+		mockRepo.AssertNotCalled(t, "MarkCheckedIn", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 // Note: Transaction-related tests (CreateOrder with business logic) are skipped
 // because they require integration testing with a real database for GORM transactions
 // These tests should be implemented in integration test files.
+
+func TestOrderService_ExpirePendingOrders(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("expires orders older than the cutoff", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+		mockRepo.On("ExpirePending", ctx, now.Add(-30*time.Minute), now).Return(int64(3), nil)
+		service := order.NewOrderService(mockRepo, nil, nil, order.WithClock(clock.NewFake(now)))
+
+		expired, err := service.ExpirePendingOrders(ctx, 30*time.Minute)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), expired)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("moves the cutoff along with the clock", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		placedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+		now := clock.NewFake(placedAt)
+		service := order.NewOrderService(mockRepo, nil, nil, order.WithClock(now))
+
+		// An order placed at placedAt is still inside its 30 minutes, then falls behind the cutoff
+		now.Advance(29 * time.Minute)
+		mockRepo.On("ExpirePending", ctx, placedAt.Add(-time.Minute), now.Now()).Return(int64(0), nil).Once()
+		expired, err := service.ExpirePendingOrders(ctx, 30*time.Minute)
+		require.NoError(t, err)
+		assert.Zero(t, expired)
+
+		now.Advance(2 * time.Minute)
+		mockRepo.On("ExpirePending", ctx, placedAt.Add(time.Minute), now.Now()).Return(int64(1), nil).Once()
+		expired, err = service.ExpirePendingOrders(ctx, 30*time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), expired)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects a non-positive age", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		_, err := service.ExpirePendingOrders(ctx, 0)
+
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "ExpirePending", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestOrderService_ListOrdersForReview(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("caps the page size", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		held := []*order.Order{{ID: uuid.New(), Status: order.StatusReview}}
+		mockRepo.On("ListByStatus", ctx, order.StatusReview, order.MaxReviewPageSize).Return(held, nil)
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		result, err := service.ListOrdersForReview(ctx, 1000)
+
+		require.NoError(t, err)
+		assert.Equal(t, held, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("keeps a smaller page size", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("ListByStatus", ctx, order.StatusReview, 10).Return([]*order.Order{}, nil)
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		_, err := service.ListOrdersForReview(ctx, 10)
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestOrderService_ReviewOrder(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	newOrder := func(status order.Status) *order.Order {
+		return &order.Order{ID: orderID, EventID: uuid.New(), Quantity: 2, Status: status}
+	}
+
+	t.Run("approval releases the order as pending", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusReview), nil)
+		mockRepo.On("ResolveReview", ctx, orderID, order.StatusPending).Return(true, nil)
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		result, err := service.ReviewOrder(ctx, orderID, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, order.StatusPending, result.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejection fails the order", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusReview), nil)
+		mockRepo.On("ResolveReview", ctx, orderID, order.StatusFailed).Return(true, nil)
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		result, err := service.ReviewOrder(ctx, orderID, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, order.StatusFailed, result.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("order not in review is refused", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusPending), nil)
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		_, err := service.ReviewOrder(ctx, orderID, true)
+
+		assert.True(t, order.IsOrderNotInReviewError(err))
+		mockRepo.AssertNotCalled(t, "ResolveReview", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("concurrent decision is reported", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusReview), nil).Once()
+		mockRepo.On("ResolveReview", ctx, orderID, order.StatusPending).Return(false, nil)
+		mockRepo.On("GetByID", ctx, orderID).Return(newOrder(order.StatusFailed), nil).Once()
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		_, err := service.ReviewOrder(ctx, orderID, true)
+
+		assert.True(t, order.IsOrderNotInReviewError(err))
+		assert.Contains(t, err.Error(), order.StatusFailed)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestOrderService_ReconcileTickets(t *testing.T) {
+	ctx := context.Background()
+	drifted := &order.TicketCount{EventID: uuid.New(), TotalTickets: 100, AvailableTickets: 90, ReservedTickets: 5}
+	oversold := &order.TicketCount{EventID: uuid.New(), TotalTickets: 10, AvailableTickets: 0, ReservedTickets: 12}
+
+	t.Run("dry run only reports", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("FindTicketDrift", ctx).Return([]*order.TicketCount{drifted, oversold}, nil)
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		result, err := service.ReconcileTickets(ctx, true)
+
+		require.NoError(t, err)
+		assert.True(t, result.DryRun)
+		require.Len(t, result.Discrepancies, 2)
+		assert.Equal(t, 95, result.Discrepancies[0].ExpectedAvailable())
+		assert.Equal(t, -5, result.Discrepancies[0].Drift())
+		assert.True(t, result.Discrepancies[1].IsOversold())
+		assert.Zero(t, result.Corrected)
+		mockRepo.AssertNotCalled(t, "CorrectAvailableTickets", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("corrects drifted counters but not oversold events", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("FindTicketDrift", ctx).Return([]*order.TicketCount{drifted, oversold}, nil)
+		mockRepo.On("CorrectAvailableTickets", ctx, drifted.EventID, 90).Return(true, nil)
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		result, err := service.ReconcileTickets(ctx, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Corrected)
+		assert.True(t, result.Discrepancies[0].Corrected)
+		assert.False(t, result.Discrepancies[1].Corrected)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "CorrectAvailableTickets", ctx, oversold.EventID, mock.Anything)
+	})
+
+	t.Run("counter that moved is left for the next run", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("FindTicketDrift", ctx).Return([]*order.TicketCount{drifted}, nil)
+		mockRepo.On("CorrectAvailableTickets", ctx, drifted.EventID, 90).Return(false, nil)
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		result, err := service.ReconcileTickets(ctx, false)
+
+		require.NoError(t, err)
+		assert.Zero(t, result.Corrected)
+		assert.False(t, result.Discrepancies[0].Corrected)
+	})
+
+	t.Run("repository failure", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("FindTicketDrift", ctx).Return(nil, errors.New("connection refused"))
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		_, err := service.ReconcileTickets(ctx, true)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestOrderService_ArchiveEvents(t *testing.T) {
+	ctx := context.Background()
+	cutoff := time.Now().AddDate(-1, 0, 0)
+
+	t.Run("archives batches until one comes up short", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("ArchiveEvents", ctx, cutoff, 100).Return(&order.Archived{Events: 100, Orders: 250}, nil).Once()
+		mockRepo.On("ArchiveEvents", ctx, cutoff, 100).Return(&order.Archived{Events: 3, Orders: 7}, nil).Once()
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		archived, err := service.ArchiveEvents(ctx, cutoff)
+
+		require.NoError(t, err)
+		assert.Equal(t, &order.Archived{Events: 103, Orders: 257}, archived)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("reports what was archived before a failure", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		mockRepo.On("ArchiveEvents", ctx, cutoff, 100).Return(&order.Archived{Events: 100, Orders: 100}, nil).Once()
+		mockRepo.On("ArchiveEvents", ctx, cutoff, 100).Return(nil, errors.New("connection refused")).Once()
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		archived, err := service.ArchiveEvents(ctx, cutoff)
+
+		assert.Error(t, err)
+		assert.Equal(t, 100, archived.Events)
+	})
+
+	t.Run("rejects a cutoff in the future", func(t *testing.T) {
+		mockRepo := new(MockOrderRepository)
+		service := order.NewOrderService(mockRepo, nil, nil)
+
+		_, err := service.ArchiveEvents(ctx, time.Now().Add(time.Hour))
+
+		assert.True(t, order.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "ArchiveEvents", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
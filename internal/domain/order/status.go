@@ -0,0 +1,65 @@
+package order
+
+import "slices"
+
+// Status is the state of an order
+// Orders are placed PENDING, or REVIEW when the risk checks hold them, and end COMPLETED once
+// paid or FAILED when rejected, abandoned or not payable.
+type Status string
+
+// Order status constants
+const (
+	StatusPending   Status = "PENDING"
+	StatusCompleted Status = "COMPLETED"
+	StatusFailed    Status = "FAILED"
+	StatusReview    Status = "REVIEW" // Held for manual review; its tickets stay reserved until an admin decides
+)
+
+// Statuses lists every order status
+var Statuses = []Status{StatusPending, StatusReview, StatusCompleted, StatusFailed}
+
+// orderTransitions lists the statuses each status may move to
+var orderTransitions = map[Status][]Status{
+	StatusPending: {StatusCompleted, StatusFailed},
+	StatusReview:  {StatusPending, StatusFailed},
+}
+
+// Valid reports whether s is a known order status
+func (s Status) Valid() bool {
+	return slices.Contains(Statuses, s)
+}
+
+// Transitions returns the statuses an order in status s may move to; none for final statuses
+func (s Status) Transitions() []Status {
+	return slices.Clone(orderTransitions[s])
+}
+
+// CanTransitionTo reports whether an order in status s may be moved to next
+// Setting the status it already has is allowed and changes nothing.
+func (s Status) CanTransitionTo(next Status) bool {
+	return next.Valid() && (s == next || slices.Contains(orderTransitions[s], next))
+}
+
+// ParseStatus returns the order status named by value
+func ParseStatus(value string) (Status, error) {
+	status := Status(value)
+	if !status.Valid() {
+		return "", NewValidationError("Invalid order status: " + value)
+	}
+	return status, nil
+}
+
+// UnmarshalText decodes a status from JSON and query strings, rejecting unknown ones
+// The empty string decodes to the zero value, meaning no status was given.
+func (s *Status) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*s = ""
+		return nil
+	}
+	status, err := ParseStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
@@ -0,0 +1,51 @@
+package order_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus_Transitions(t *testing.T) {
+	tests := []struct {
+		from, to order.Status
+		allowed  bool
+	}{
+		{from: order.StatusPending, to: order.StatusCompleted, allowed: true},
+		{from: order.StatusPending, to: order.StatusFailed, allowed: true},
+		{from: order.StatusPending, to: order.StatusReview},
+		{from: order.StatusReview, to: order.StatusPending, allowed: true},
+		{from: order.StatusReview, to: order.StatusFailed, allowed: true},
+		{from: order.StatusReview, to: order.StatusCompleted},
+		{from: order.StatusCompleted, to: order.StatusCompleted, allowed: true},
+		{from: order.StatusCompleted, to: order.StatusPending},
+		{from: order.StatusFailed, to: order.StatusCompleted},
+		{from: order.StatusPending, to: "REFUNDED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" to "+string(tt.to), func(t *testing.T) {
+			assert.Equal(t, tt.allowed, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+
+	for _, status := range order.Statuses {
+		for _, next := range status.Transitions() {
+			assert.True(t, next.Valid(), "%s leads to unknown status %s", status, next)
+		}
+	}
+}
+
+func TestStatus_UnmarshalJSON(t *testing.T) {
+	var o order.Order
+	require.NoError(t, json.Unmarshal([]byte(`{"status":"REVIEW"}`), &o))
+	assert.Equal(t, order.StatusReview, o.Status)
+
+	err := json.Unmarshal([]byte(`{"status":"SHIPPED"}`), &o)
+	require.Error(t, err)
+	assert.True(t, order.IsValidationError(err))
+}
@@ -0,0 +1,74 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// PlanError represents domain-specific plan errors
+type PlanError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *PlanError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *PlanError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined plan domain errors
+var (
+	ErrPlanNotFound        = &PlanError{Code: "PLAN_NOT_FOUND", Message: "plan not found"}
+	ErrUserNotFound        = &PlanError{Code: "USER_NOT_FOUND", Message: "user not found"}
+	ErrPlanRetrievalFailed = &PlanError{Code: "PLAN_RETRIEVAL_FAILED", Message: "failed to retrieve plan"}
+	ErrPlanAssignFailed    = &PlanError{Code: "PLAN_ASSIGN_FAILED", Message: "failed to assign plan"}
+)
+
+// NewPlanError creates a new PlanError with a cause
+func NewPlanError(baseError *PlanError, cause error) *PlanError {
+	return &PlanError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// NewPlanNotFoundError creates a specific error for plan not found
+func NewPlanNotFoundError(name string) *PlanError {
+	return &PlanError{
+		Code:    "PLAN_NOT_FOUND",
+		Message: fmt.Sprintf("plan %s not found", name),
+	}
+}
+
+// NewUserNotFoundError creates a specific error for assigning a plan to a missing user
+func NewUserNotFoundError(userID uuid.UUID) *PlanError {
+	return &PlanError{
+		Code:    "USER_NOT_FOUND",
+		Message: fmt.Sprintf("user with ID %s not found", userID),
+	}
+}
+
+// GetPlanErrorCode extracts the error code from a PlanError
+func GetPlanErrorCode(err error) string {
+	var planErr *PlanError
+	if errors.As(err, &planErr) {
+		return planErr.Code
+	}
+	return ""
+}
+
+// IsNotFoundError checks if an error is a plan or user "not found" error
+func IsNotFoundError(err error) bool {
+	code := GetPlanErrorCode(err)
+	return code == "PLAN_NOT_FOUND" || code == "USER_NOT_FOUND"
+}
@@ -0,0 +1,42 @@
+package plan
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Plan is a subscription plan that limits what an organizer can do
+// A limit of 0 means unlimited
+type Plan struct {
+	ID                 uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	Name               string    `gorm:"unique;not null;size:50" json:"name"`
+	Description        string    `gorm:"type:text" json:"description"`
+	MaxActiveEvents    int       `gorm:"not null;default:0" json:"max_active_events"`     // Active events an organizer may have at once
+	MaxTicketsPerEvent int       `gorm:"not null;default:0" json:"max_tickets_per_event"` // Total tickets allowed for a single event
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Built-in plan names seeded by migrations
+const (
+	PlanFree       = "FREE"       // Default plan for users without an assigned plan
+	PlanPro        = "PRO"        // Paid plan with higher limits
+	PlanEnterprise = "ENTERPRISE" // Unlimited plan
+)
+
+// TableName tells GORM what table to use for this model
+func (Plan) TableName() string {
+	return "plans"
+}
+
+// AllowsActiveEvents reports whether an organizer with count active events may create another
+func (p *Plan) AllowsActiveEvents(count int) bool {
+	return p.MaxActiveEvents == 0 || count < p.MaxActiveEvents
+}
+
+// AllowsTickets reports whether a single event may have total tickets
+func (p *Plan) AllowsTickets(total int) bool {
+	return p.MaxTicketsPerEvent == 0 || total <= p.MaxTicketsPerEvent
+}
@@ -0,0 +1,22 @@
+package plan
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for plan data operations
+type Repository interface {
+	// GetAll retrieves all plans
+	GetAll(ctx context.Context) ([]*Plan, error)
+
+	// GetByName retrieves a plan by its name
+	GetByName(ctx context.Context, name string) (*Plan, error)
+
+	// GetByUserID retrieves the plan assigned to a user, or nil if none is assigned
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*Plan, error)
+
+	// AssignToUser sets the plan of a user
+	AssignToUser(ctx context.Context, userID, planID uuid.UUID) error
+}
@@ -0,0 +1,62 @@
+package plan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for subscription plans
+type Service interface {
+	// GetAllPlans retrieves all plans
+	GetAllPlans(ctx context.Context) ([]*Plan, error)
+
+	// GetUserPlan retrieves the plan of a user, falling back to the FREE plan
+	GetUserPlan(ctx context.Context, userID uuid.UUID) (*Plan, error)
+
+	// AssignPlan assigns the named plan to a user
+	AssignPlan(ctx context.Context, userID uuid.UUID, planName string) (*Plan, error)
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo Repository
+}
+
+// NewService creates a new plan service instance
+func NewService(repo Repository) Service {
+	return &serviceImpl{repo: repo}
+}
+
+// GetAllPlans retrieves all plans
+func (s *serviceImpl) GetAllPlans(ctx context.Context) ([]*Plan, error) {
+	return s.repo.GetAll(ctx)
+}
+
+// GetUserPlan retrieves the plan of a user, falling back to the FREE plan
+func (s *serviceImpl) GetUserPlan(ctx context.Context, userID uuid.UUID) (*Plan, error) {
+	p, err := s.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		return p, nil
+	}
+
+	return s.repo.GetByName(ctx, PlanFree)
+}
+
+// AssignPlan assigns the named plan to a user
+func (s *serviceImpl) AssignPlan(ctx context.Context, userID uuid.UUID, planName string) (*Plan, error) {
+	p, err := s.repo.GetByName(ctx, strings.ToUpper(planName))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.AssignToUser(ctx, userID, p.ID); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
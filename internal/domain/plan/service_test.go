@@ -0,0 +1,95 @@
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetAll(ctx context.Context) ([]*Plan, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Plan), args.Error(1)
+}
+
+func (m *MockRepository) GetByName(ctx context.Context, name string) (*Plan, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Plan), args.Error(1)
+}
+
+func (m *MockRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*Plan, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Plan), args.Error(1)
+}
+
+func (m *MockRepository) AssignToUser(ctx context.Context, userID, planID uuid.UUID) error {
+	args := m.Called(ctx, userID, planID)
+	return args.Error(0)
+}
+
+func TestPlanService_GetUserPlan_FallsBackToFree(t *testing.T) {
+	repo := new(MockRepository)
+	userID := uuid.New()
+	free := &Plan{ID: uuid.New(), Name: PlanFree}
+
+	repo.On("GetByUserID", mock.Anything, userID).Return(nil, nil)
+	repo.On("GetByName", mock.Anything, PlanFree).Return(free, nil)
+
+	p, err := NewService(repo).GetUserPlan(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, free, p)
+}
+
+func TestPlanService_AssignPlan(t *testing.T) {
+	repo := new(MockRepository)
+	userID := uuid.New()
+	pro := &Plan{ID: uuid.New(), Name: PlanPro}
+
+	repo.On("GetByName", mock.Anything, PlanPro).Return(pro, nil)
+	repo.On("AssignToUser", mock.Anything, userID, pro.ID).Return(nil)
+
+	p, err := NewService(repo).AssignPlan(context.Background(), userID, "pro")
+
+	assert.NoError(t, err)
+	assert.Equal(t, pro, p)
+	repo.AssertExpectations(t)
+}
+
+func TestPlanService_AssignPlan_UnknownPlan(t *testing.T) {
+	repo := new(MockRepository)
+	repo.On("GetByName", mock.Anything, "GOLD").Return(nil, NewPlanNotFoundError("GOLD"))
+
+	_, err := NewService(repo).AssignPlan(context.Background(), uuid.New(), "gold")
+
+	assert.True(t, IsNotFoundError(err))
+	repo.AssertNotCalled(t, "AssignToUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPlan_Limits(t *testing.T) {
+	limited := &Plan{MaxActiveEvents: 2, MaxTicketsPerEvent: 100}
+	unlimited := &Plan{}
+
+	assert.True(t, limited.AllowsActiveEvents(1))
+	assert.False(t, limited.AllowsActiveEvents(2))
+	assert.True(t, limited.AllowsTickets(100))
+	assert.False(t, limited.AllowsTickets(101))
+	assert.True(t, unlimited.AllowsActiveEvents(1000))
+	assert.True(t, unlimited.AllowsTickets(1000000))
+}
@@ -0,0 +1,47 @@
+package recommendation
+
+import (
+	"enterprise-crud/internal/domain/event"
+
+	"github.com/google/uuid"
+)
+
+// Reasons a Scorer gives for recommending an event
+const (
+	ReasonSameVenue     = "SAME_VENUE"     // At a venue the user bought tickets for before
+	ReasonSameOrganizer = "SAME_ORGANIZER" // By an organizer the user bought tickets from before
+	ReasonSellingFast   = "SELLING_FAST"   // Most of its tickets are gone
+	ReasonUpcoming      = "UPCOMING"       // Nothing in the user's history matched; one of the soonest events
+)
+
+// Profile summarises what a user bought before
+type Profile struct {
+	UserID     uuid.UUID
+	Venues     map[uuid.UUID]int  // Orders per venue
+	Organizers map[uuid.UUID]int  // Orders per organizer
+	Ordered    map[uuid.UUID]bool // Events the user already has orders for
+}
+
+// NewProfile creates an empty profile for a user
+func NewProfile(userID uuid.UUID) *Profile {
+	return &Profile{
+		UserID:     userID,
+		Venues:     make(map[uuid.UUID]int),
+		Organizers: make(map[uuid.UUID]int),
+		Ordered:    make(map[uuid.UUID]bool),
+	}
+}
+
+// Add counts an order for e in the profile
+func (p *Profile) Add(e *event.Event) {
+	p.Venues[e.VenueID]++
+	p.Organizers[e.OrganizerID]++
+	p.Ordered[e.ID] = true
+}
+
+// Recommendation is an event suggested to a user with why it was picked
+type Recommendation struct {
+	Event   *event.Event
+	Score   float64
+	Reasons []string
+}
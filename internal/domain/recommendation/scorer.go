@@ -0,0 +1,52 @@
+package recommendation
+
+import (
+	"enterprise-crud/internal/domain/event"
+)
+
+// Scorer rates how well an upcoming event suits a user
+// Events scoring zero or less are not recommended. Implementations must be safe for concurrent use.
+type Scorer interface {
+	Score(profile *Profile, e *event.Event) (float64, []string)
+}
+
+// Weights of the AffinityScorer signals
+const (
+	venueWeight       = 2.0
+	organizerWeight   = 3.0
+	sellingFastWeight = 1.0
+	maxRepeatOrders   = 3   // Orders beyond this at one venue or organizer add nothing
+	sellingFastShare  = 0.8 // Share of tickets sold from which an event counts as selling fast
+)
+
+// AffinityScorer recommends events at venues and by organizers the user bought tickets for before
+// Selling fast only adds to an event that already matched, so popularity alone never recommends one.
+type AffinityScorer struct{}
+
+// Score rates an event by the user's earlier orders at its venue and from its organizer
+func (AffinityScorer) Score(profile *Profile, e *event.Event) (float64, []string) {
+	var score float64
+	var reasons []string
+	if n := profile.Venues[e.VenueID]; n > 0 {
+		score += venueWeight * float64(min(n, maxRepeatOrders))
+		reasons = append(reasons, ReasonSameVenue)
+	}
+	if n := profile.Organizers[e.OrganizerID]; n > 0 {
+		score += organizerWeight * float64(min(n, maxRepeatOrders))
+		reasons = append(reasons, ReasonSameOrganizer)
+	}
+	if score > 0 && isSellingFast(e) {
+		score += sellingFastWeight
+		reasons = append(reasons, ReasonSellingFast)
+	}
+	return score, reasons
+}
+
+// isSellingFast reports whether most of an event's tickets are sold
+func isSellingFast(e *event.Event) bool {
+	if e.TotalTickets <= 0 {
+		return false
+	}
+	sold := e.TotalTickets - e.AvailableTickets
+	return float64(sold) >= sellingFastShare*float64(e.TotalTickets)
+}
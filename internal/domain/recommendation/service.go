@@ -0,0 +1,135 @@
+package recommendation
+
+import (
+	"context"
+	"sort"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+)
+
+// Page sizes of Recommend
+const (
+	DefaultLimit = 10
+	MaxLimit     = 50
+)
+
+// historyOrders bounds how many of a user's most recent orders make up their profile
+const historyOrders = 50
+
+// Service defines the business logic interface for event recommendations
+type Service interface {
+	// Recommend returns up to limit upcoming events for a user, best match first
+	// Events the user already ordered and sold out events are left out. When too few
+	// events match the user's history, the rest are the soonest upcoming events.
+	Recommend(ctx context.Context, userID uuid.UUID, limit int) ([]*Recommendation, error)
+}
+
+// Option configures optional Service behaviour
+type Option func(*serviceImpl)
+
+// WithScorer replaces the AffinityScorer, e.g. with a trained model
+func WithScorer(scorer Scorer) Option {
+	return func(s *serviceImpl) {
+		s.scorer = scorer
+	}
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	orderService order.Service
+	eventService event.Service
+	scorer       Scorer
+}
+
+// NewService creates a new recommendation service instance
+func NewService(orderService order.Service, eventService event.Service, opts ...Option) Service {
+	s := &serviceImpl{
+		orderService: orderService,
+		eventService: eventService,
+		scorer:       AffinityScorer{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Recommend returns up to limit upcoming events for a user, best match first
+func (s *serviceImpl) Recommend(ctx context.Context, userID uuid.UUID, limit int) ([]*Recommendation, error) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	profile, err := s.profile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	upcoming, err := s.eventService.GetUpcomingEvents(ctx, event.UpcomingFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	var matched, rest []*Recommendation
+	for _, e := range upcoming {
+		if profile.Ordered[e.ID] || e.AvailableTickets <= 0 {
+			continue
+		}
+		if score, reasons := s.scorer.Score(profile, e); score > 0 {
+			matched = append(matched, &Recommendation{Event: e, Score: score, Reasons: reasons})
+		} else {
+			rest = append(rest, &Recommendation{Event: e, Reasons: []string{ReasonUpcoming}})
+		}
+	}
+
+	// Upcoming events come soonest first, so equal scores stay in date order
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].Score > matched[j].Score })
+	recommendations := append(matched, rest...)
+	if len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+	return recommendations, nil
+}
+
+// profile builds a user's profile from the events of their most recent orders
+// Failed orders are skipped, and so are orders of archived events, which can no longer be looked up.
+func (s *serviceImpl) profile(ctx context.Context, userID uuid.UUID) (*Profile, error) {
+	orders, err := s.orderService.GetOrdersByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := NewProfile(userID)
+	events := make(map[uuid.UUID]*event.Event)
+	counted := 0
+	for _, o := range orders {
+		if counted == historyOrders {
+			break
+		}
+		if o.IsFailed() || o.IsArchived() {
+			continue
+		}
+
+		e, seen := events[o.EventID]
+		if !seen {
+			e, err = s.eventService.GetEventByID(ctx, o.EventID)
+			if err != nil {
+				if !event.IsEventNotFoundError(err) {
+					return nil, err
+				}
+				e = nil
+			}
+			events[o.EventID] = e
+		}
+		if e == nil {
+			continue
+		}
+		profile.Add(e)
+		counted++
+	}
+	return profile, nil
+}
@@ -0,0 +1,131 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/recommendation"
+	"enterprise-crud/internal/fixtures"
+	"enterprise-crud/internal/infrastructure/memory"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFixtureServices returns order and event services over a fresh in-memory fixture store
+func newFixtureServices() (order.Service, event.Service) {
+	store := memory.NewStore(fixtures.Default(time.Now()))
+	eventService := event.NewService(memory.NewEventRepository(store), memory.NewVenueRepository(store), nil, nil)
+	orderService := order.NewOrderService(memory.NewOrderRepository(store), nil, nil)
+	return orderService, eventService
+}
+
+// eventIDs lists the events of recommendations in order
+func eventIDs(recommendations []*recommendation.Recommendation) []uuid.UUID {
+	ids := make([]uuid.UUID, len(recommendations))
+	for i, r := range recommendations {
+		ids[i] = r.Event.ID
+	}
+	return ids
+}
+
+// fixedScorer scores only the events it lists
+type fixedScorer map[uuid.UUID]float64
+
+func (s fixedScorer) Score(_ *recommendation.Profile, e *event.Event) (float64, []string) {
+	return s[e.ID], []string{"FIXED"}
+}
+
+func TestService_Recommend(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("skips ordered and sold out events", func(t *testing.T) {
+		service := recommendation.NewService(newFixtureServices())
+
+		recommendations, err := service.Recommend(ctx, fixtures.UserID, 0)
+
+		require.NoError(t, err)
+		require.Len(t, recommendations, 1)
+		assert.Equal(t, fixtures.WorkshopID, recommendations[0].Event.ID)
+		assert.Equal(t, []string{recommendation.ReasonSameOrganizer}, recommendations[0].Reasons)
+		assert.Equal(t, 6.0, recommendations[0].Score) // Concert and last season's finale, both by the organizer
+	})
+
+	t.Run("ranks venue and organizer matches first", func(t *testing.T) {
+		service := recommendation.NewService(newFixtureServices())
+
+		recommendations, err := service.Recommend(ctx, fixtures.AdminID, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{fixtures.WorkshopID, fixtures.ConcertID}, eventIDs(recommendations))
+		assert.Equal(t, []string{recommendation.ReasonSameVenue, recommendation.ReasonSameOrganizer}, recommendations[0].Reasons)
+		assert.Equal(t, []string{recommendation.ReasonSameOrganizer}, recommendations[1].Reasons)
+	})
+
+	t.Run("falls back to upcoming events without history", func(t *testing.T) {
+		service := recommendation.NewService(newFixtureServices())
+
+		recommendations, err := service.Recommend(ctx, fixtures.OrganizerID, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{fixtures.WorkshopID, fixtures.ConcertID}, eventIDs(recommendations))
+		for _, r := range recommendations {
+			assert.Zero(t, r.Score)
+			assert.Equal(t, []string{recommendation.ReasonUpcoming}, r.Reasons)
+		}
+	})
+
+	t.Run("limits the results", func(t *testing.T) {
+		service := recommendation.NewService(newFixtureServices())
+
+		recommendations, err := service.Recommend(ctx, fixtures.AdminID, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{fixtures.WorkshopID}, eventIDs(recommendations))
+	})
+
+	t.Run("uses a custom scorer", func(t *testing.T) {
+		orderService, eventService := newFixtureServices()
+		service := recommendation.NewService(orderService, eventService,
+			recommendation.WithScorer(fixedScorer{fixtures.ConcertID: 1}))
+
+		recommendations, err := service.Recommend(ctx, fixtures.OrganizerID, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{fixtures.ConcertID, fixtures.WorkshopID}, eventIDs(recommendations))
+		assert.Equal(t, []string{"FIXED"}, recommendations[0].Reasons)
+	})
+}
+
+func TestAffinityScorer_Score(t *testing.T) {
+	venueID, organizerID := uuid.New(), uuid.New()
+	profile := recommendation.NewProfile(uuid.New())
+	for range 5 {
+		profile.Add(&event.Event{ID: uuid.New(), VenueID: venueID, OrganizerID: uuid.New()})
+	}
+
+	t.Run("caps repeat orders", func(t *testing.T) {
+		score, reasons := recommendation.AffinityScorer{}.Score(profile, &event.Event{VenueID: venueID, TotalTickets: 100, AvailableTickets: 100})
+
+		assert.Equal(t, 6.0, score)
+		assert.Equal(t, []string{recommendation.ReasonSameVenue}, reasons)
+	})
+
+	t.Run("boosts matches selling fast", func(t *testing.T) {
+		score, reasons := recommendation.AffinityScorer{}.Score(profile, &event.Event{VenueID: venueID, TotalTickets: 100, AvailableTickets: 20})
+
+		assert.Equal(t, 7.0, score)
+		assert.Equal(t, []string{recommendation.ReasonSameVenue, recommendation.ReasonSellingFast}, reasons)
+	})
+
+	t.Run("popularity alone is no match", func(t *testing.T) {
+		score, reasons := recommendation.AffinityScorer{}.Score(profile, &event.Event{VenueID: uuid.New(), OrganizerID: organizerID, TotalTickets: 100, AvailableTickets: 1})
+
+		assert.Zero(t, score)
+		assert.Empty(t, reasons)
+	})
+}
@@ -0,0 +1,97 @@
+package refund
+
+import (
+	"errors"
+	"fmt"
+)
+
+// RefundError represents domain-specific refund errors
+type RefundError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *RefundError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *RefundError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined refund domain errors
+var (
+	ErrInvalidKind           = &RefundError{Code: "INVALID_REFUND_KIND", Message: "kind must be REFUND or DISPUTE"}
+	ErrInvalidStatus         = &RefundError{Code: "INVALID_REFUND_STATUS", Message: "status must be OPEN, APPROVED, REFUNDED, REFUND_FAILED or DENIED"}
+	ErrInvalidReason         = &RefundError{Code: "INVALID_REASON", Message: fmt.Sprintf("a reason of at most %d characters is required", MaxReasonLength)}
+	ErrInvalidAmount         = &RefundError{Code: "INVALID_AMOUNT", Message: "amount must be positive and at most the order total"}
+	ErrRequestWindowClosed   = &RefundError{Code: "REFUND_WINDOW_CLOSED", Message: "refunds can no longer be requested for this event"}
+	ErrRefundsNotOffered     = &RefundError{Code: "REFUNDS_NOT_OFFERED", Message: "the organizer doesn't offer refunds for this event"}
+	ErrRefundDeadlinePassed  = &RefundError{Code: "REFUND_DEADLINE_PASSED", Message: "the event's refund policy no longer allows refunds"}
+	ErrExceedsRefundPolicy   = &RefundError{Code: "EXCEEDS_REFUND_POLICY", Message: "amount is more than the event's refund policy allows"}
+	ErrAlreadyRequested      = &RefundError{Code: "ALREADY_REQUESTED", Message: "this order already has a refund request or dispute"}
+	ErrRequestNotFound       = &RefundError{Code: "REFUND_REQUEST_NOT_FOUND", Message: "refund request not found"}
+	ErrNotOrganizer          = &RefundError{Code: "NOT_EVENT_ORGANIZER", Message: "only the event organizer can decide refund requests"}
+	ErrAlreadyDecided        = &RefundError{Code: "ALREADY_DECIDED", Message: "refund request was already decided"}
+	ErrCancelledEventRefund  = &RefundError{Code: "CANCELLED_EVENT_REFUND", Message: "buyers of cancelled events are owed a full refund"}
+	ErrProviderFailed        = &RefundError{Code: "REFUND_PROVIDER_FAILED", Message: "payment provider failed to refund"}
+	ErrRefundSaveFailed      = &RefundError{Code: "REFUND_SAVE_FAILED", Message: "failed to save refund request"}
+	ErrRefundRetrievalFailed = &RefundError{Code: "REFUND_RETRIEVAL_FAILED", Message: "failed to retrieve refund requests"}
+)
+
+// NewRefundError creates a new RefundError with a cause
+func NewRefundError(baseError *RefundError, cause error) *RefundError {
+	return &RefundError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetRefundErrorCode extracts the error code from a RefundError
+func GetRefundErrorCode(err error) string {
+	var refundErr *RefundError
+	if errors.As(err, &refundErr) {
+		return refundErr.Code
+	}
+	return ""
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetRefundErrorCode(err) {
+	case "INVALID_REFUND_KIND", "INVALID_REFUND_STATUS", "INVALID_REASON", "INVALID_AMOUNT":
+		return true
+	}
+	return false
+}
+
+// IsNotFoundError checks if an error reports a missing refund request
+func IsNotFoundError(err error) bool {
+	return GetRefundErrorCode(err) == "REFUND_REQUEST_NOT_FOUND"
+}
+
+// IsForbiddenError checks if an error denies the actor a decision
+func IsForbiddenError(err error) bool {
+	return GetRefundErrorCode(err) == "NOT_EVENT_ORGANIZER"
+}
+
+// IsConflictError checks if an error is caused by the state of the order or request,
+// including requests and decisions the platform or event refund policy doesn't allow
+func IsConflictError(err error) bool {
+	switch GetRefundErrorCode(err) {
+	case "REFUND_WINDOW_CLOSED", "REFUNDS_NOT_OFFERED", "REFUND_DEADLINE_PASSED", "EXCEEDS_REFUND_POLICY",
+		"ALREADY_REQUESTED", "ALREADY_DECIDED", "CANCELLED_EVENT_REFUND":
+		return true
+	}
+	return false
+}
+
+// IsProviderError checks if an error reports a refund the payment provider failed
+func IsProviderError(err error) bool {
+	return GetRefundErrorCode(err) == "REFUND_PROVIDER_FAILED"
+}
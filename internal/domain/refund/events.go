@@ -0,0 +1,25 @@
+package refund
+
+import "github.com/google/uuid"
+
+// Event bus topics of refund events
+const (
+	TopicRefundIssued = "refund.issued"
+)
+
+// RefundIssued is published when the payment provider has refunded a buyer
+type RefundIssued struct {
+	RequestID         uuid.UUID
+	OrderID           uuid.UUID
+	EventID           uuid.UUID
+	UserID            uuid.UUID // Buyer
+	Kind              string
+	Amount            float64
+	OrderAmount       float64
+	ProviderReference string
+}
+
+// Topic implements eventbus.Event
+func (RefundIssued) Topic() string {
+	return TopicRefundIssued
+}
@@ -0,0 +1,144 @@
+package refund
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Request kinds a buyer can open on a completed order
+const (
+	KindRefund  = "REFUND"  // The buyer asks for their money back
+	KindDispute = "DISPUTE" // The buyer contests the charge, e.g. the event was not as described
+)
+
+// Request statuses
+const (
+	StatusOpen     = "OPEN"          // Waiting for the organizer's decision
+	StatusApproved = "APPROVED"      // Approved, the refund is with the payment provider
+	StatusRefunded = "REFUNDED"      // The payment provider refunded the buyer
+	StatusFailed   = "REFUND_FAILED" // The payment provider failed; approving again retries
+	StatusDenied   = "DENIED"        // Denied by the organizer
+)
+
+// Audit actions recorded for every change of a request
+const (
+	ActionOpened       = "OPENED"
+	ActionApproved     = "APPROVED"
+	ActionDenied       = "DENIED"
+	ActionRefunded     = "REFUNDED"
+	ActionRefundFailed = "REFUND_FAILED"
+)
+
+// MaxReasonLength bounds the reasons given by buyers and organizers in characters
+const MaxReasonLength = 1000
+
+// Page sizes for the refund queue
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 100
+)
+
+// Request is a buyer's refund request or dispute on one order
+type Request struct {
+	ID      uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	OrderID uuid.UUID `gorm:"not null;type:uuid" json:"order_id"`
+	EventID uuid.UUID `gorm:"not null;type:uuid" json:"event_id"`
+	UserID  uuid.UUID `gorm:"not null;type:uuid" json:"user_id"` // Buyer
+	Kind    string    `gorm:"not null;size:20" json:"kind"`
+	Status  string    `gorm:"not null;size:20;default:'OPEN'" json:"status"`
+	Reason  string    `gorm:"type:text;not null" json:"reason"` // Given by the buyer
+
+	// OrderAmount is the order total when the request was opened, the most that can be refunded
+	OrderAmount float64 `gorm:"type:decimal(10,2);not null" json:"order_amount"`
+
+	// RefundableAmount is the share of OrderAmount the event's refund policy allowed when the request was opened
+	RefundableAmount float64 `gorm:"type:decimal(10,2);not null;default:0" json:"refundable_amount"`
+
+	// Set when the request is decided
+	Amount            float64    `gorm:"type:decimal(10,2);not null;default:0" json:"amount"` // Refunded amount
+	Decision          string     `gorm:"type:text;not null;default:''" json:"decision"`       // Reason given by the organizer
+	DecidedBy         *uuid.UUID `gorm:"type:uuid" json:"decided_by,omitempty"`
+	DecidedAt         *time.Time `json:"decided_at,omitempty"`
+	ProviderReference string     `gorm:"size:255;not null;default:''" json:"provider_reference,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Event title and buyer email for the queue, filled in by listing queries
+	EventTitle string `gorm:"->;-:migration" json:"event_title,omitempty"`
+	Email      string `gorm:"->;-:migration" json:"email,omitempty"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Request) TableName() string {
+	return "refund_requests"
+}
+
+// IsDecidable checks if the request still waits for a decision
+func (r *Request) IsDecidable() bool {
+	return r.Status == StatusOpen
+}
+
+// IsApprovable checks if the request may be approved, which retries failed refunds
+func (r *Request) IsApprovable() bool {
+	return r.Status == StatusOpen || r.Status == StatusFailed
+}
+
+// AuditEntry records one change of a request: who made it, when and why
+type AuditEntry struct {
+	ID        uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	RequestID uuid.UUID  `gorm:"not null;type:uuid" json:"request_id"`
+	Action    string     `gorm:"not null;size:20" json:"action"`
+	ActorID   *uuid.UUID `gorm:"type:uuid" json:"actor_id,omitempty"` // nil for the payment provider's outcome
+	Amount    float64    `gorm:"type:decimal(10,2);not null;default:0" json:"amount"`
+	Note      string     `gorm:"type:text;not null;default:''" json:"note"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (AuditEntry) TableName() string {
+	return "refund_audit_log"
+}
+
+// Actor identifies the user acting on a request
+type Actor struct {
+	UserID  uuid.UUID
+	IsAdmin bool // Admins may act on requests for any event
+}
+
+// ListFilter narrows and pages the refund queue
+type ListFilter struct {
+	OrganizerID *uuid.UUID // Only requests on this organizer's events; set by the service for non-admins
+	EventID     *uuid.UUID // Only requests on this event
+	Status      string     // Only requests with this status; empty means any
+	Limit       int        // Page size; 0 means DefaultPageSize, capped at MaxPageSize
+	Offset      int        // Number of matching requests to skip
+}
+
+// PageSize returns the number of requests a page holds under this filter
+func (f ListFilter) PageSize() int {
+	if f.Limit <= 0 {
+		return DefaultPageSize
+	}
+	return min(f.Limit, MaxPageSize)
+}
+
+// Policy is the platform refund policy organizers decide within
+type Policy struct {
+	RequestWindow time.Duration // How long after the event starts buyers may still open requests
+}
+
+// IsValidKind checks if a buyer can open a request of this kind
+func IsValidKind(kind string) bool {
+	return kind == KindRefund || kind == KindDispute
+}
+
+// IsValidStatus checks if a status is known
+func IsValidStatus(status string) bool {
+	switch status {
+	case StatusOpen, StatusApproved, StatusRefunded, StatusFailed, StatusDenied:
+		return true
+	}
+	return false
+}
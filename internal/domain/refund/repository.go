@@ -0,0 +1,37 @@
+package refund
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for refund request data access
+// Every change of a request is stored together with its audit entry.
+type Repository interface {
+	// Create stores a new request with the audit entry of its opening
+	// Returns ErrAlreadyRequested when the order has a request that wasn't denied
+	Create(ctx context.Context, req *Request, entry *AuditEntry) error
+
+	// GetByID retrieves a request by its ID
+	// Returns ErrRequestNotFound when there is none
+	GetByID(ctx context.Context, id uuid.UUID) (*Request, error)
+
+	// List retrieves the requests matching the filter with event title and buyer email, oldest first
+	List(ctx context.Context, filter ListFilter) ([]*Request, error)
+
+	// Transition saves the request's status and decision if its stored status is one of from
+	// Returns ErrAlreadyDecided when the status changed in the meantime
+	Transition(ctx context.Context, req *Request, from []string, entry *AuditEntry) error
+
+	// ListAudit retrieves the audit entries of a request, oldest first
+	ListAudit(ctx context.Context, requestID uuid.UUID) ([]*AuditEntry, error)
+}
+
+// PaymentProvider sends approved refunds to the payment provider that charged the buyer
+type PaymentProvider interface {
+	// Refund pays req.Amount back to the buyer and returns the provider's reference for it
+	// The request ID stays the same when a failed refund is retried, so providers can use it
+	// as idempotency key.
+	Refund(ctx context.Context, req *Request) (string, error)
+}
@@ -0,0 +1,337 @@
+package refund
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for refund requests and disputes
+type Service interface {
+	// Open opens a refund request or dispute on one of the buyer's completed orders
+	Open(ctx context.Context, userID, orderID uuid.UUID, kind, reason string) (*Request, error)
+
+	// Get retrieves a request with its audit trail for its buyer, the event organizer or an admin
+	Get(ctx context.Context, actor Actor, id uuid.UUID) (*Request, []*AuditEntry, error)
+
+	// List retrieves the requests on the actor's events, or on every event for admins
+	List(ctx context.Context, actor Actor, filter ListFilter) ([]*Request, error)
+
+	// Approve approves a request and refunds amount through the payment provider
+	// An amount of 0 refunds what the event's refund policy allows. Approving a failed refund retries it.
+	Approve(ctx context.Context, actor Actor, id uuid.UUID, amount float64, reason string) (*Request, error)
+
+	// Deny denies an open request
+	Deny(ctx context.Context, actor Actor, id uuid.UUID, reason string) (*Request, error)
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo         Repository
+	orderService order.Service
+	eventService event.Service
+	provider     PaymentProvider
+	policy       Policy
+	clock        clock.Clock        // Tells the time refund windows are checked against
+	publisher    eventbus.Publisher // Receives RefundIssued; nil publishes nothing
+}
+
+// ServiceOption configures optional refund Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock checks refund windows against c instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// WithPublisher publishes RefundIssued to p when a buyer has been refunded
+func WithPublisher(p eventbus.Publisher) ServiceOption {
+	return func(s *serviceImpl) {
+		s.publisher = p
+	}
+}
+
+// NewService creates a new refund service instance
+func NewService(repo Repository, orderService order.Service, eventService event.Service, provider PaymentProvider, policy Policy, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repo:         repo,
+		orderService: orderService,
+		eventService: eventService,
+		provider:     provider,
+		policy:       policy,
+		clock:        clock.System{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Open opens a refund request or dispute on one of the buyer's completed orders
+// Orders of other users are reported as not found. Requests on cancelled events may be
+// opened at any time; otherwise only until the policy's window after the event has passed,
+// and refunds only as far as the event's refund policy allows.
+func (s *serviceImpl) Open(ctx context.Context, userID, orderID uuid.UUID, kind, reason string) (*Request, error) {
+	kind = strings.ToUpper(strings.TrimSpace(kind))
+	if !IsValidKind(kind) {
+		return nil, ErrInvalidKind
+	}
+	reason, err := validReason(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := s.orderService.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if o.UserID != userID {
+		return nil, order.NewOrderNotFoundError(orderID)
+	}
+	if o.Status != order.StatusCompleted {
+		return nil, order.NewOrderNotCompletedError(orderID, o.Status)
+	}
+
+	e, err := s.eventService.GetEventByID(ctx, o.EventID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.clock.Now()
+	if !e.IsCancelled() && now.After(e.EventDate.Add(s.policy.RequestWindow)) {
+		return nil, ErrRequestWindowClosed
+	}
+	refundable, err := refundableAmount(e, kind, o.TotalAmount, now)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &Request{
+		OrderID:          o.ID,
+		EventID:          o.EventID,
+		UserID:           userID,
+		Kind:             kind,
+		Status:           StatusOpen,
+		Reason:           reason,
+		OrderAmount:      o.TotalAmount,
+		RefundableAmount: refundable,
+	}
+	entry := &AuditEntry{Action: ActionOpened, ActorID: &userID, Amount: o.TotalAmount, Note: reason}
+	if err := s.repo.Create(ctx, req, entry); err != nil {
+		return nil, err
+	}
+
+	logging.From(ctx).Info("Refund requested", "refund_request_id", req.ID, "order_id", o.ID, "kind", kind, "user_id", userID)
+	return req, nil
+}
+
+// Get retrieves a request with its audit trail
+// Requests the actor may not see are reported as not found.
+func (s *serviceImpl) Get(ctx context.Context, actor Actor, id uuid.UUID) (*Request, []*AuditEntry, error) {
+	req, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.UserID != actor.UserID {
+		if _, err := s.decidingEvent(ctx, actor, req); err != nil {
+			if IsForbiddenError(err) {
+				return nil, nil, ErrRequestNotFound
+			}
+			return nil, nil, err
+		}
+	}
+
+	entries, err := s.repo.ListAudit(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return req, entries, nil
+}
+
+// List retrieves the requests on the actor's events, or on every event for admins
+func (s *serviceImpl) List(ctx context.Context, actor Actor, filter ListFilter) ([]*Request, error) {
+	filter.Status = strings.ToUpper(strings.TrimSpace(filter.Status))
+	if filter.Status != "" && !IsValidStatus(filter.Status) {
+		return nil, ErrInvalidStatus
+	}
+	filter.OrganizerID = nil
+	if !actor.IsAdmin {
+		filter.OrganizerID = &actor.UserID
+	}
+	return s.repo.List(ctx, filter)
+}
+
+// Approve approves a request and refunds amount through the payment provider
+// The request is claimed as APPROVED before the provider is called, so concurrent approvals
+// can't refund twice. Organizers must refund cancelled events in full and may not refund more
+// than the event's refund policy allowed when the request was opened; admins may overrule both.
+func (s *serviceImpl) Approve(ctx context.Context, actor Actor, id uuid.UUID, amount float64, reason string) (*Request, error) {
+	reason, err := validReason(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.decidingEvent(ctx, actor, req)
+	if err != nil {
+		return nil, err
+	}
+	if !req.IsApprovable() {
+		return nil, ErrAlreadyDecided
+	}
+
+	// Buyers of cancelled events are owed the whole order whatever the refund policy
+	refundable := req.RefundableAmount
+	if e.IsCancelled() {
+		refundable = req.OrderAmount
+	}
+	if amount == 0 {
+		amount = refundable
+	}
+	amount = math.Round(amount*100) / 100
+	if amount <= 0 || amount > req.OrderAmount {
+		return nil, ErrInvalidAmount
+	}
+	if e.IsCancelled() && !actor.IsAdmin && amount < req.OrderAmount {
+		return nil, ErrCancelledEventRefund
+	}
+	if amount > refundable && !actor.IsAdmin {
+		return nil, ErrExceedsRefundPolicy
+	}
+
+	now := s.clock.Now()
+	from := req.Status
+	req.Status = StatusApproved
+	req.Amount = amount
+	req.Decision = reason
+	req.DecidedBy = &actor.UserID
+	req.DecidedAt = &now
+	approved := &AuditEntry{Action: ActionApproved, ActorID: &actor.UserID, Amount: amount, Note: reason}
+	if err := s.repo.Transition(ctx, req, []string{from}, approved); err != nil {
+		return nil, err
+	}
+
+	log := logging.From(ctx).With("refund_request_id", req.ID, "order_id", req.OrderID, "amount", amount, "decided_by", actor.UserID)
+	reference, refundErr := s.provider.Refund(ctx, req)
+	if refundErr != nil {
+		req.Status = StatusFailed
+		failed := &AuditEntry{Action: ActionRefundFailed, Amount: amount, Note: refundErr.Error()}
+		if err := s.repo.Transition(ctx, req, []string{StatusApproved}, failed); err != nil {
+			log.Warn("Failed to record failed refund", "error", err)
+		}
+		log.Warn("Refund failed", "error", refundErr)
+		return nil, NewRefundError(ErrProviderFailed, refundErr)
+	}
+
+	req.Status = StatusRefunded
+	req.ProviderReference = reference
+	refunded := &AuditEntry{Action: ActionRefunded, Amount: amount, Note: reference}
+	if err := s.repo.Transition(ctx, req, []string{StatusApproved}, refunded); err != nil {
+		// The buyer was refunded; the request stays APPROVED so it is not refunded again
+		log.Warn("Failed to record refund", "provider_reference", reference, "error", err)
+		return nil, err
+	}
+
+	log.Info("Refund issued", "provider_reference", reference)
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, RefundIssued{
+			RequestID:         req.ID,
+			OrderID:           req.OrderID,
+			EventID:           req.EventID,
+			UserID:            req.UserID,
+			Kind:              req.Kind,
+			Amount:            amount,
+			OrderAmount:       req.OrderAmount,
+			ProviderReference: reference,
+		})
+	}
+	return req, nil
+}
+
+// Deny denies an open request
+// Organizers can't deny requests on cancelled events; admins may overrule it.
+func (s *serviceImpl) Deny(ctx context.Context, actor Actor, id uuid.UUID, reason string) (*Request, error) {
+	reason, err := validReason(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.decidingEvent(ctx, actor, req)
+	if err != nil {
+		return nil, err
+	}
+	if !req.IsDecidable() {
+		return nil, ErrAlreadyDecided
+	}
+	if e.IsCancelled() && !actor.IsAdmin {
+		return nil, ErrCancelledEventRefund
+	}
+
+	now := s.clock.Now()
+	req.Status = StatusDenied
+	req.Decision = reason
+	req.DecidedBy = &actor.UserID
+	req.DecidedAt = &now
+	entry := &AuditEntry{Action: ActionDenied, ActorID: &actor.UserID, Note: reason}
+	if err := s.repo.Transition(ctx, req, []string{StatusOpen}, entry); err != nil {
+		return nil, err
+	}
+
+	logging.From(ctx).Info("Refund denied", "refund_request_id", req.ID, "order_id", req.OrderID, "decided_by", actor.UserID)
+	return req, nil
+}
+
+// decidingEvent retrieves the event of a request the actor may decide
+func (s *serviceImpl) decidingEvent(ctx context.Context, actor Actor, req *Request) (*event.Event, error) {
+	e, err := s.eventService.GetEventByID(ctx, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.IsAdmin && e.OrganizerID != actor.UserID {
+		return nil, ErrNotOrganizer
+	}
+	return e, nil
+}
+
+// refundableAmount returns the share of an order's total the event's refund policy allows a request of kind opened at now
+// Disputes and requests on cancelled events may claim the whole order.
+func refundableAmount(e *event.Event, kind string, total float64, now time.Time) (float64, error) {
+	if kind != KindRefund || e.IsCancelled() {
+		return total, nil
+	}
+	if e.RefundPolicy.Type == event.RefundPolicyNone {
+		return 0, ErrRefundsNotOffered
+	}
+	percent := e.RefundPolicy.RefundPercentAt(e.EventDate, now)
+	if percent == 0 {
+		return 0, ErrRefundDeadlinePassed
+	}
+	return math.Round(total*float64(percent)) / 100, nil
+}
+
+// validReason trims a reason and checks it is given and not too long
+func validReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > MaxReasonLength {
+		return "", ErrInvalidReason
+	}
+	return reason, nil
+}
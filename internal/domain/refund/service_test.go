@@ -0,0 +1,458 @@
+package refund_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/refund"
+	"enterprise-crud/internal/fixtures"
+	"enterprise-crud/internal/infrastructure/memory"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepository keeps requests and audit entries in memory
+type fakeRepository struct {
+	mu       sync.Mutex
+	requests map[uuid.UUID]refund.Request
+	audit    []*refund.AuditEntry
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{requests: make(map[uuid.UUID]refund.Request)}
+}
+
+func (r *fakeRepository) Create(ctx context.Context, req *refund.Request, entry *refund.AuditEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.requests {
+		if existing.OrderID == req.OrderID && existing.Status != refund.StatusDenied {
+			return refund.ErrAlreadyRequested
+		}
+	}
+	req.ID = uuid.New()
+	r.requests[req.ID] = *req
+	entry.RequestID = req.ID
+	r.audit = append(r.audit, entry)
+	return nil
+}
+
+func (r *fakeRepository) GetByID(ctx context.Context, id uuid.UUID) (*refund.Request, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	req, ok := r.requests[id]
+	if !ok {
+		return nil, refund.ErrRequestNotFound
+	}
+	return &req, nil
+}
+
+func (r *fakeRepository) List(ctx context.Context, filter refund.ListFilter) ([]*refund.Request, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var requests []*refund.Request
+	for _, req := range r.requests {
+		if filter.OrganizerID != nil && *filter.OrganizerID != fixtures.OrganizerID {
+			continue // Every fixture event is the fixture organizer's
+		}
+		if filter.Status != "" && req.Status != filter.Status {
+			continue
+		}
+		requests = append(requests, &req)
+	}
+	return requests, nil
+}
+
+func (r *fakeRepository) Transition(ctx context.Context, req *refund.Request, from []string, entry *refund.AuditEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !slices.Contains(from, r.requests[req.ID].Status) {
+		return refund.ErrAlreadyDecided
+	}
+	r.requests[req.ID] = *req
+	entry.RequestID = req.ID
+	r.audit = append(r.audit, entry)
+	return nil
+}
+
+func (r *fakeRepository) ListAudit(ctx context.Context, requestID uuid.UUID) ([]*refund.AuditEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var entries []*refund.AuditEntry
+	for _, e := range r.audit {
+		if e.RequestID == requestID {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+// fakeProvider records refunds, failing them while err is set
+type fakeProvider struct {
+	err      error
+	refunded []float64
+}
+
+func (p *fakeProvider) Refund(ctx context.Context, req *refund.Request) (string, error) {
+	if p.err != nil {
+		return "", p.err
+	}
+	p.refunded = append(p.refunded, req.Amount)
+	return "re_" + req.ID.String(), nil
+}
+
+// fixture is a refund service over fresh fixture orders and events
+type fixture struct {
+	service      refund.Service
+	eventService event.Service
+	repo         *fakeRepository
+	provider     *fakeProvider
+	issued       []refund.RefundIssued // Published refunds
+}
+
+func newFixture() *fixture {
+	store := memory.NewStore(fixtures.Default(time.Now()))
+	eventService := event.NewService(memory.NewEventRepository(store), memory.NewVenueRepository(store), nil, nil)
+	orderService := order.NewOrderService(memory.NewOrderRepository(store), nil, nil)
+	f := &fixture{eventService: eventService, repo: newFakeRepository(), provider: &fakeProvider{}}
+	bus := eventbus.New()
+	bus.Subscribe(refund.TopicRefundIssued, func(ctx context.Context, e eventbus.Event) error {
+		f.issued = append(f.issued, e.(refund.RefundIssued))
+		return nil
+	})
+	f.service = refund.NewService(f.repo, orderService, eventService, f.provider, refund.Policy{RequestWindow: 7 * 24 * time.Hour}, refund.WithPublisher(bus))
+	return f
+}
+
+var (
+	organizer = refund.Actor{UserID: fixtures.OrganizerID}
+	admin     = refund.Actor{UserID: fixtures.AdminID, IsAdmin: true}
+	buyer     = refund.Actor{UserID: fixtures.UserID}
+)
+
+// open opens a refund request on the buyer's concert order
+func (f *fixture) open(t *testing.T) *refund.Request {
+	t.Helper()
+	req, err := f.service.Open(context.Background(), fixtures.UserID, fixtures.ConcertOrderID, "refund", "  Can't make it  ")
+	require.NoError(t, err)
+	return req
+}
+
+// setRefundPolicy sets the refund policy of the concert
+func (f *fixture) setRefundPolicy(t *testing.T, policy event.RefundPolicy) {
+	t.Helper()
+	concert, err := f.eventService.GetEventByID(context.Background(), fixtures.ConcertID)
+	require.NoError(t, err)
+	concert.RefundPolicy = policy
+	require.NoError(t, f.eventService.UpdateEvent(context.Background(), concert))
+}
+
+func TestService_Open(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("opens a request for the whole order", func(t *testing.T) {
+		f := newFixture()
+
+		req := f.open(t)
+
+		assert.Equal(t, refund.KindRefund, req.Kind)
+		assert.Equal(t, refund.StatusOpen, req.Status)
+		assert.Equal(t, "Can't make it", req.Reason)
+		assert.Equal(t, fixtures.ConcertID, req.EventID)
+		assert.Equal(t, 239.96, req.OrderAmount)
+		require.Len(t, f.repo.audit, 1)
+		assert.Equal(t, refund.ActionOpened, f.repo.audit[0].Action)
+	})
+
+	t.Run("allows one live request per order", func(t *testing.T) {
+		f := newFixture()
+		f.open(t)
+
+		_, err := f.service.Open(ctx, fixtures.UserID, fixtures.ConcertOrderID, refund.KindDispute, "Still want it back")
+
+		assert.ErrorIs(t, err, refund.ErrAlreadyRequested)
+	})
+
+	t.Run("hides orders of other users", func(t *testing.T) {
+		_, err := newFixture().service.Open(ctx, fixtures.OrganizerID, fixtures.ConcertOrderID, refund.KindRefund, "Not mine")
+
+		assert.True(t, order.IsOrderNotFoundError(err))
+	})
+
+	t.Run("closes after the request window", func(t *testing.T) {
+		_, err := newFixture().service.Open(ctx, fixtures.UserID, fixtures.PastOrderID, refund.KindRefund, "Too late")
+
+		assert.ErrorIs(t, err, refund.ErrRequestWindowClosed)
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		f := newFixture()
+
+		_, err := f.service.Open(ctx, fixtures.UserID, fixtures.ConcertOrderID, "CHARGEBACK", "Reason")
+		assert.ErrorIs(t, err, refund.ErrInvalidKind)
+
+		_, err = f.service.Open(ctx, fixtures.UserID, fixtures.ConcertOrderID, refund.KindRefund, "   ")
+		assert.ErrorIs(t, err, refund.ErrInvalidReason)
+	})
+}
+
+func TestService_Open_RefundPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("refuses refunds the organizer doesn't offer", func(t *testing.T) {
+		f := newFixture()
+		f.setRefundPolicy(t, event.RefundPolicy{Type: event.RefundPolicyNone})
+
+		_, err := f.service.Open(ctx, fixtures.UserID, fixtures.ConcertOrderID, refund.KindRefund, "Can't make it")
+		assert.ErrorIs(t, err, refund.ErrRefundsNotOffered)
+		assert.True(t, refund.IsConflictError(err))
+
+		dispute, err := f.service.Open(ctx, fixtures.UserID, fixtures.ConcertOrderID, refund.KindDispute, "The headliner cancelled")
+		require.NoError(t, err)
+		assert.Equal(t, 239.96, dispute.RefundableAmount, "disputes are exempt")
+	})
+
+	t.Run("refuses refunds past the deadline", func(t *testing.T) {
+		f := newFixture()
+		f.setRefundPolicy(t, event.RefundPolicy{Type: event.RefundPolicyDaysBefore, DaysBefore: 45})
+
+		_, err := f.service.Open(ctx, fixtures.UserID, fixtures.ConcertOrderID, refund.KindRefund, "Can't make it")
+
+		assert.ErrorIs(t, err, refund.ErrRefundDeadlinePassed)
+	})
+
+	t.Run("records the share the sliding scale allows", func(t *testing.T) {
+		f := newFixture()
+		f.setRefundPolicy(t, event.RefundPolicy{Type: event.RefundPolicySliding, Tiers: []event.RefundTier{{DaysBefore: 60, Percent: 100}, {DaysBefore: 14, Percent: 50}}})
+
+		req := f.open(t)
+
+		assert.Equal(t, 239.96, req.OrderAmount)
+		assert.Equal(t, 119.98, req.RefundableAmount)
+	})
+
+	t.Run("cancelled events are refunded whatever the policy", func(t *testing.T) {
+		f := newFixture()
+		f.setRefundPolicy(t, event.RefundPolicy{Type: event.RefundPolicyNone})
+		require.NoError(t, f.eventService.CancelEvent(ctx, fixtures.ConcertID, fixtures.OrganizerID))
+
+		req := f.open(t)
+
+		assert.Equal(t, 239.96, req.RefundableAmount)
+	})
+}
+
+func TestService_Approve(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("refunds the whole order by default", func(t *testing.T) {
+		f := newFixture()
+		req := f.open(t)
+
+		approved, err := f.service.Approve(ctx, organizer, req.ID, 0, "Fair enough")
+
+		require.NoError(t, err)
+		assert.Equal(t, refund.StatusRefunded, approved.Status)
+		assert.Equal(t, 239.96, approved.Amount)
+		assert.Equal(t, "re_"+req.ID.String(), approved.ProviderReference)
+		assert.Equal(t, &fixtures.OrganizerID, approved.DecidedBy)
+		assert.Equal(t, []float64{239.96}, f.provider.refunded)
+		require.Len(t, f.issued, 1)
+		assert.Equal(t, refund.RefundIssued{
+			RequestID:         req.ID,
+			OrderID:           fixtures.ConcertOrderID,
+			EventID:           req.EventID,
+			UserID:            fixtures.UserID,
+			Kind:              refund.KindRefund,
+			Amount:            239.96,
+			OrderAmount:       239.96,
+			ProviderReference: "re_" + req.ID.String(),
+		}, f.issued[0])
+
+		_, entries, err := f.service.Get(ctx, buyer, req.ID)
+		require.NoError(t, err)
+		actions := make([]string, len(entries))
+		for i, e := range entries {
+			actions[i] = e.Action
+		}
+		assert.Equal(t, []string{refund.ActionOpened, refund.ActionApproved, refund.ActionRefunded}, actions)
+	})
+
+	t.Run("refunds part of the order", func(t *testing.T) {
+		f := newFixture()
+		req := f.open(t)
+
+		approved, err := f.service.Approve(ctx, organizer, req.ID, 59.99, "One ticket")
+
+		require.NoError(t, err)
+		assert.Equal(t, 59.99, approved.Amount)
+	})
+
+	t.Run("rejects amounts above the order total", func(t *testing.T) {
+		f := newFixture()
+		req := f.open(t)
+
+		_, err := f.service.Approve(ctx, organizer, req.ID, 240, "Plus fees")
+
+		assert.ErrorIs(t, err, refund.ErrInvalidAmount)
+		assert.Empty(t, f.provider.refunded)
+	})
+
+	t.Run("is only for the organizer or an admin", func(t *testing.T) {
+		f := newFixture()
+		req := f.open(t)
+
+		_, err := f.service.Approve(ctx, buyer, req.ID, 0, "Approving my own")
+		assert.ErrorIs(t, err, refund.ErrNotOrganizer)
+
+		_, err = f.service.Approve(ctx, admin, req.ID, 0, "Support ticket 42")
+		assert.NoError(t, err)
+	})
+
+	t.Run("refunds only once", func(t *testing.T) {
+		f := newFixture()
+		req := f.open(t)
+		_, err := f.service.Approve(ctx, organizer, req.ID, 0, "Fair enough")
+		require.NoError(t, err)
+
+		_, err = f.service.Approve(ctx, organizer, req.ID, 0, "Again")
+
+		assert.ErrorIs(t, err, refund.ErrAlreadyDecided)
+		assert.Len(t, f.provider.refunded, 1)
+	})
+
+	t.Run("retries a failed refund", func(t *testing.T) {
+		f := newFixture()
+		req := f.open(t)
+		f.provider.err = errors.New("card expired")
+
+		_, err := f.service.Approve(ctx, organizer, req.ID, 0, "Fair enough")
+
+		assert.True(t, refund.IsProviderError(err))
+		stored, err := f.repo.GetByID(ctx, req.ID)
+		require.NoError(t, err)
+		assert.Equal(t, refund.StatusFailed, stored.Status)
+		assert.Empty(t, f.issued)
+
+		f.provider.err = nil
+		approved, err := f.service.Approve(ctx, organizer, req.ID, 0, "Retrying")
+
+		require.NoError(t, err)
+		assert.Equal(t, refund.StatusRefunded, approved.Status)
+	})
+
+	t.Run("refunds cancelled events in full", func(t *testing.T) {
+		f := newFixture()
+		req := f.open(t)
+		require.NoError(t, f.eventService.CancelEvent(ctx, fixtures.ConcertID, fixtures.OrganizerID))
+
+		_, err := f.service.Approve(ctx, organizer, req.ID, 10, "Partial")
+
+		assert.ErrorIs(t, err, refund.ErrCancelledEventRefund)
+	})
+
+	t.Run("refunds what the refund policy allows", func(t *testing.T) {
+		f := newFixture()
+		f.setRefundPolicy(t, event.RefundPolicy{Type: event.RefundPolicySliding, Tiers: []event.RefundTier{{DaysBefore: 14, Percent: 50}}})
+		req := f.open(t)
+
+		_, err := f.service.Approve(ctx, organizer, req.ID, 200, "Most of it")
+		assert.ErrorIs(t, err, refund.ErrExceedsRefundPolicy)
+
+		approved, err := f.service.Approve(ctx, organizer, req.ID, 0, "Half, as the policy says")
+		require.NoError(t, err)
+		assert.Equal(t, 119.98, approved.Amount)
+	})
+
+	t.Run("admins may refund beyond the policy", func(t *testing.T) {
+		f := newFixture()
+		f.setRefundPolicy(t, event.RefundPolicy{Type: event.RefundPolicySliding, Tiers: []event.RefundTier{{DaysBefore: 14, Percent: 50}}})
+		req := f.open(t)
+
+		approved, err := f.service.Approve(ctx, admin, req.ID, 239.96, "Support ticket 43")
+
+		require.NoError(t, err)
+		assert.Equal(t, 239.96, approved.Amount)
+	})
+}
+
+func TestService_Deny(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("denies with a reason", func(t *testing.T) {
+		f := newFixture()
+		req := f.open(t)
+
+		denied, err := f.service.Deny(ctx, organizer, req.ID, "No refunds within a week of the event")
+
+		require.NoError(t, err)
+		assert.Equal(t, refund.StatusDenied, denied.Status)
+		assert.Equal(t, "No refunds within a week of the event", denied.Decision)
+		assert.Empty(t, f.provider.refunded)
+
+		// A denied request makes way for a new one
+		_, err = f.service.Open(ctx, fixtures.UserID, fixtures.ConcertOrderID, refund.KindDispute, "The lineup changed")
+		assert.NoError(t, err)
+	})
+
+	t.Run("requires a reason", func(t *testing.T) {
+		f := newFixture()
+		req := f.open(t)
+
+		_, err := f.service.Deny(ctx, organizer, req.ID, "")
+
+		assert.ErrorIs(t, err, refund.ErrInvalidReason)
+	})
+
+	t.Run("cannot deny cancelled events unless admin", func(t *testing.T) {
+		f := newFixture()
+		req := f.open(t)
+		require.NoError(t, f.eventService.CancelEvent(ctx, fixtures.ConcertID, fixtures.OrganizerID))
+
+		_, err := f.service.Deny(ctx, organizer, req.ID, "Rescheduled instead")
+		assert.ErrorIs(t, err, refund.ErrCancelledEventRefund)
+
+		_, err = f.service.Deny(ctx, admin, req.ID, "Chargeback fraud")
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_Get(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture()
+	req := f.open(t)
+
+	for _, actor := range []refund.Actor{buyer, organizer, admin} {
+		_, _, err := f.service.Get(ctx, actor, req.ID)
+		assert.NoError(t, err)
+	}
+
+	_, _, err := f.service.Get(ctx, refund.Actor{UserID: uuid.New()}, req.ID)
+	assert.ErrorIs(t, err, refund.ErrRequestNotFound)
+}
+
+func TestService_List(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture()
+	f.open(t)
+
+	requests, err := f.service.List(ctx, organizer, refund.ListFilter{Status: "open"})
+	require.NoError(t, err)
+	assert.Len(t, requests, 1)
+
+	requests, err = f.service.List(ctx, refund.Actor{UserID: uuid.New()}, refund.ListFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, requests)
+
+	_, err = f.service.List(ctx, organizer, refund.ListFilter{Status: "LOST"})
+	assert.ErrorIs(t, err, refund.ErrInvalidStatus)
+}
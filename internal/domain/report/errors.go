@@ -0,0 +1,89 @@
+package report
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ReportError represents domain-specific report errors
+type ReportError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *ReportError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *ReportError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined report domain errors
+var (
+	ErrUserNotFound           = &ReportError{Code: "USER_NOT_FOUND", Message: "user not found"}
+	ErrInvalidFrequency       = &ReportError{Code: "INVALID_FREQUENCY", Message: "frequency must be DAILY or WEEKLY"}
+	ErrInvalidPeriod          = &ReportError{Code: "INVALID_PERIOD", Message: "report period must end after it starts and span at most 366 days"}
+	ErrScheduleSaveFailed     = &ReportError{Code: "SCHEDULE_SAVE_FAILED", Message: "failed to save report schedule"}
+	ErrReportRetrievalFailed  = &ReportError{Code: "REPORT_RETRIEVAL_FAILED", Message: "failed to retrieve report data"}
+	ErrReportSchedulingFailed = &ReportError{Code: "REPORT_SCHEDULING_FAILED", Message: "failed to schedule report"}
+)
+
+// NewReportError creates a new ReportError with a cause
+func NewReportError(baseError *ReportError, cause error) *ReportError {
+	return &ReportError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// NewUserNotFoundError creates a specific error for an unknown report recipient
+func NewUserNotFoundError(id uuid.UUID) *ReportError {
+	return &ReportError{
+		Code:    "USER_NOT_FOUND",
+		Message: fmt.Sprintf("user with ID %s not found", id),
+	}
+}
+
+// NewEventNotFoundError creates a specific error for forecasting an unknown event
+func NewEventNotFoundError(id uuid.UUID) *ReportError {
+	return &ReportError{
+		Code:    "EVENT_NOT_FOUND",
+		Message: fmt.Sprintf("event with ID %s not found", id),
+	}
+}
+
+// GetReportErrorCode extracts the error code from a ReportError
+func GetReportErrorCode(err error) string {
+	var reportErr *ReportError
+	if errors.As(err, &reportErr) {
+		return reportErr.Code
+	}
+	return ""
+}
+
+// IsUserNotFoundError checks if an error is a "user not found" error
+func IsUserNotFoundError(err error) bool {
+	return GetReportErrorCode(err) == "USER_NOT_FOUND"
+}
+
+// IsEventNotFoundError checks if an error is an "event not found" error
+func IsEventNotFoundError(err error) bool {
+	return GetReportErrorCode(err) == "EVENT_NOT_FOUND"
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetReportErrorCode(err) {
+	case "INVALID_FREQUENCY", "INVALID_PERIOD":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package report
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// minForecastHours is how many hours with sales a forecast needs before it projects a sellout
+const minForecastHours = 3
+
+// SalesSeries is an event's ticket sales over time, the input of a sellout forecast
+type SalesSeries struct {
+	EventID          uuid.UUID
+	OrganizerID      uuid.UUID
+	Title            string
+	EventDate        time.Time
+	TotalTickets     int
+	AvailableTickets int
+	Hours            []SalesHour // Hours with sales, oldest first
+}
+
+// SalesHour holds the tickets taken by orders placed within one hour
+// Pending, in-review and completed orders count; failed orders have released their tickets.
+type SalesHour struct {
+	Hour    time.Time
+	Tickets int
+}
+
+// Forecast estimates when an event sells out
+type Forecast struct {
+	EventID      uuid.UUID
+	OrganizerID  uuid.UUID
+	Title        string
+	EventDate    time.Time
+	TotalTickets int
+	TicketsSold  int
+
+	TicketsPerDay float64    // Sales rate fitted over the sales so far
+	Fit           float64    // R² of the fit, 0-1; low values mean sales are irregular
+	SoldOut       bool       // No tickets are left
+	SelloutAt     *time.Time // Projected sellout; nil when sold out, too few sales or not before the event
+	ProjectedSold int        // Tickets projected to be sold by the event date
+
+	GeneratedAt time.Time
+}
+
+// ForecastSellout fits a line through the cumulative tickets sold per hour and projects when it reaches the total
+// Fewer than minForecastHours hours with sales give no projection, and neither does a sellout after the event.
+func ForecastSellout(series *SalesSeries, now time.Time) *Forecast {
+	sold := series.TotalTickets - series.AvailableTickets
+	forecast := &Forecast{
+		EventID:       series.EventID,
+		OrganizerID:   series.OrganizerID,
+		Title:         series.Title,
+		EventDate:     series.EventDate,
+		TotalTickets:  series.TotalTickets,
+		TicketsSold:   sold,
+		SoldOut:       series.AvailableTickets <= 0,
+		ProjectedSold: sold,
+		GeneratedAt:   now,
+	}
+	if len(series.Hours) < minForecastHours {
+		return forecast
+	}
+
+	// Least squares over (hours since the first sale, cumulative tickets at the end of the hour)
+	start := series.Hours[0].Hour
+	n := float64(len(series.Hours))
+	var sumX, sumY, sumXY, sumXX, sumYY float64
+	cumulative := 0
+	for _, h := range series.Hours {
+		cumulative += h.Tickets
+		x := h.Hour.Sub(start).Hours() + 1
+		y := float64(cumulative)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+		sumYY += y * y
+	}
+	varX := n*sumXX - sumX*sumX
+	varY := n*sumYY - sumY*sumY
+	if varX <= 0 {
+		return forecast
+	}
+	slope := (n*sumXY - sumX*sumY) / varX
+	if varY > 0 {
+		r := (n*sumXY - sumX*sumY) / math.Sqrt(varX*varY)
+		forecast.Fit = r * r
+	}
+	if slope <= 0 {
+		return forecast
+	}
+	forecast.TicketsPerDay = slope * 24
+
+	if forecast.SoldOut {
+		forecast.ProjectedSold = series.TotalTickets
+		return forecast
+	}
+
+	hoursLeft := series.EventDate.Sub(now).Hours()
+	if hoursLeft <= 0 {
+		return forecast
+	}
+	forecast.ProjectedSold = min(series.TotalTickets, sold+int(slope*hoursLeft))
+
+	hoursToSellout := float64(series.AvailableTickets) / slope
+	if hoursToSellout < hoursLeft {
+		selloutAt := now.Add(time.Duration(hoursToSellout * float64(time.Hour))).Truncate(time.Minute)
+		forecast.SelloutAt = &selloutAt
+	}
+	return forecast
+}
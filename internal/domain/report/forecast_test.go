@@ -0,0 +1,125 @@
+package report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// steadySales returns hours consecutive hours selling perHour tickets each, the last one ending at end
+func steadySales(end time.Time, hours, perHour int) []SalesHour {
+	sales := make([]SalesHour, hours)
+	for i := range sales {
+		sales[i] = SalesHour{Hour: end.Add(time.Duration(i-hours) * time.Hour), Tickets: perHour}
+	}
+	return sales
+}
+
+func TestForecastSellout(t *testing.T) {
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	t.Run("projects sellout from a steady rate", func(t *testing.T) {
+		series := &SalesSeries{
+			TotalTickets:     1000,
+			AvailableTickets: 760,
+			EventDate:        now.AddDate(0, 1, 0),
+			Hours:            steadySales(now, 24, 10),
+		}
+
+		forecast := ForecastSellout(series, now)
+
+		assert.Equal(t, 240, forecast.TicketsSold)
+		assert.InDelta(t, 240, forecast.TicketsPerDay, 0.01)
+		assert.InDelta(t, 1, forecast.Fit, 0.0001)
+		require.NotNil(t, forecast.SelloutAt)
+		assert.Equal(t, now.Add(76*time.Hour), *forecast.SelloutAt)
+		assert.Equal(t, 1000, forecast.ProjectedSold)
+		assert.False(t, forecast.SoldOut)
+	})
+
+	t.Run("no sellout before the event", func(t *testing.T) {
+		series := &SalesSeries{
+			TotalTickets:     1000,
+			AvailableTickets: 760,
+			EventDate:        now.Add(48 * time.Hour),
+			Hours:            steadySales(now, 24, 10),
+		}
+
+		forecast := ForecastSellout(series, now)
+
+		assert.Nil(t, forecast.SelloutAt)
+		assert.Equal(t, 720, forecast.ProjectedSold)
+	})
+
+	t.Run("too few sales to project", func(t *testing.T) {
+		series := &SalesSeries{
+			TotalTickets:     100,
+			AvailableTickets: 95,
+			EventDate:        now.AddDate(0, 1, 0),
+			Hours:            steadySales(now, 2, 5),
+		}
+
+		forecast := ForecastSellout(series, now)
+
+		assert.Nil(t, forecast.SelloutAt)
+		assert.Zero(t, forecast.TicketsPerDay)
+		assert.Equal(t, 5, forecast.ProjectedSold)
+	})
+
+	t.Run("sold out", func(t *testing.T) {
+		series := &SalesSeries{
+			TotalTickets:     240,
+			AvailableTickets: 0,
+			EventDate:        now.AddDate(0, 1, 0),
+			Hours:            steadySales(now, 24, 10),
+		}
+
+		forecast := ForecastSellout(series, now)
+
+		assert.True(t, forecast.SoldOut)
+		assert.Nil(t, forecast.SelloutAt)
+		assert.Equal(t, 240, forecast.ProjectedSold)
+	})
+
+	t.Run("irregular sales fit worse", func(t *testing.T) {
+		hours := steadySales(now, 24, 1)
+		hours[3].Tickets, hours[20].Tickets = 80, 60
+		series := &SalesSeries{TotalTickets: 1000, AvailableTickets: 838, EventDate: now.AddDate(0, 1, 0), Hours: hours}
+
+		forecast := ForecastSellout(series, now)
+
+		assert.Less(t, forecast.Fit, 0.95)
+		assert.Greater(t, forecast.TicketsPerDay, 0.0)
+	})
+}
+
+func TestReportService_GetForecast(t *testing.T) {
+	eventID := uuid.New()
+
+	t.Run("forecasts the event's sales", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetSalesSeries", mock.Anything, eventID).Return(&SalesSeries{
+			EventID: eventID, TotalTickets: 100, AvailableTickets: 40, EventDate: time.Now().AddDate(0, 1, 0),
+		}, nil)
+
+		forecast, err := NewService(repo, nil).GetForecast(context.Background(), eventID)
+
+		require.NoError(t, err)
+		assert.Equal(t, eventID, forecast.EventID)
+		assert.Equal(t, 60, forecast.TicketsSold)
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetSalesSeries", mock.Anything, eventID).Return(nil, NewEventNotFoundError(eventID))
+
+		_, err := NewService(repo, nil).GetForecast(context.Background(), eventID)
+
+		assert.True(t, IsEventNotFoundError(err))
+	})
+}
@@ -0,0 +1,101 @@
+package report
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Report frequency constants
+const (
+	FrequencyDaily  = "DAILY"
+	FrequencyWeekly = "WEEKLY"
+)
+
+// JobTypeSalesSummary is the job type that emails a sales summary to an organizer
+const JobTypeSalesSummary = "report.sales_summary"
+
+// Schedule stores an organizer's opt-in to periodic sales summary emails
+// Reports cover whole UTC days (DAILY) or Monday-to-Monday UTC weeks (WEEKLY)
+type Schedule struct {
+	ID         uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	UserID     uuid.UUID  `gorm:"not null;uniqueIndex;type:uuid" json:"user_id"`
+	Frequency  string     `gorm:"size:10;not null" json:"frequency"`
+	Enabled    bool       `gorm:"not null;default:false" json:"enabled"`
+	NextRunAt  time.Time  `gorm:"not null" json:"next_run_at"`
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Schedule) TableName() string {
+	return "report_schedules"
+}
+
+// IsValidFrequency checks if a frequency is supported
+func IsValidFrequency(frequency string) bool {
+	return frequency == FrequencyDaily || frequency == FrequencyWeekly
+}
+
+// Period returns the length of the reporting period for a frequency
+func Period(frequency string) time.Duration {
+	if frequency == FrequencyWeekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// NextRun returns the first period boundary strictly after t
+// Boundaries are midnight UTC for daily reports and Monday midnight UTC for weekly reports
+func NextRun(frequency string, t time.Time) time.Time {
+	t = t.UTC()
+	next := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	if frequency == FrequencyWeekly {
+		for next.Weekday() != time.Monday {
+			next = next.AddDate(0, 0, 1)
+		}
+	}
+	return next
+}
+
+// SalesSummary aggregates completed orders for an organizer's events over a period
+type SalesSummary struct {
+	OrganizerID  uuid.UUID
+	From         time.Time
+	To           time.Time
+	Events       []EventSales
+	TotalOrders  int
+	TotalTickets int
+	TotalRevenue float64
+}
+
+// EventSales holds the sales of a single event within a summary
+type EventSales struct {
+	EventID     uuid.UUID
+	Title       string
+	Orders      int
+	TicketsSold int
+	Revenue     float64
+}
+
+// Recipient identifies who a report is emailed to
+type Recipient struct {
+	UserID   uuid.UUID
+	Email    string
+	Username string
+}
+
+// SalesReport is everything needed to render a sales summary email
+type SalesReport struct {
+	Recipient *Recipient
+	Frequency string
+	Summary   *SalesSummary
+}
+
+// SalesSummaryPayload is the job payload for JobTypeSalesSummary
+type SalesSummaryPayload struct {
+	UserID uuid.UUID `json:"user_id"`
+	From   time.Time `json:"from"`
+	To     time.Time `json:"to"`
+}
@@ -0,0 +1,36 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for report data access
+type Repository interface {
+	// GetSchedule retrieves the report schedule of a user, or nil if they never configured one
+	GetSchedule(ctx context.Context, userID uuid.UUID) (*Schedule, error)
+
+	// SaveSchedule creates or updates a report schedule
+	SaveSchedule(ctx context.Context, schedule *Schedule) error
+
+	// GetDueSchedules retrieves enabled schedules whose next run is at or before now
+	GetDueSchedules(ctx context.Context, now time.Time, limit int) ([]*Schedule, error)
+
+	// AdvanceSchedule moves a schedule's next run from expected to next
+	// It reports false when another instance advanced the schedule first
+	AdvanceSchedule(ctx context.Context, id uuid.UUID, expected, next time.Time) (bool, error)
+
+	// MarkSent records when a user's last report was sent
+	MarkSent(ctx context.Context, userID uuid.UUID, sentAt time.Time) error
+
+	// GetSalesSummary aggregates orders completed in [from, to) for an organizer's events
+	GetSalesSummary(ctx context.Context, organizerID uuid.UUID, from, to time.Time) (*SalesSummary, error)
+
+	// GetSalesSeries retrieves an event's tickets sold per hour, for forecasting its sellout
+	GetSalesSeries(ctx context.Context, eventID uuid.UUID) (*SalesSeries, error)
+
+	// GetRecipient retrieves the email details of a user
+	GetRecipient(ctx context.Context, userID uuid.UUID) (*Recipient, error)
+}
@@ -0,0 +1,214 @@
+package report
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// maxPeriod bounds ad-hoc sales summaries
+const maxPeriod = 366 * 24 * time.Hour
+
+// dueBatchSize limits how many schedules are processed per EnqueueDueReports call
+const dueBatchSize = 100
+
+// Service defines the business logic interface for organizer reports
+type Service interface {
+	// GetSchedule retrieves a user's report schedule, returning a disabled default if none is stored
+	GetSchedule(ctx context.Context, userID uuid.UUID) (*Schedule, error)
+
+	// UpdateSchedule opts a user in or out of periodic sales summary emails
+	UpdateSchedule(ctx context.Context, userID uuid.UUID, frequency string, enabled bool) (*Schedule, error)
+
+	// GetSalesSummary aggregates an organizer's sales over [from, to)
+	GetSalesSummary(ctx context.Context, organizerID uuid.UUID, from, to time.Time) (*SalesSummary, error)
+
+	// GetForecast estimates when an event sells out from its sales so far
+	GetForecast(ctx context.Context, eventID uuid.UUID) (*Forecast, error)
+
+	// EnqueueDueReports enqueues a sales summary job for every schedule due at now
+	EnqueueDueReports(ctx context.Context, now time.Time) (int, error)
+
+	// PrepareSalesReport gathers the data for a queued sales summary
+	// It returns nil if the organizer opted out after the job was queued
+	PrepareSalesReport(ctx context.Context, payload SalesSummaryPayload) (*SalesReport, error)
+
+	// MarkReportSent records that a user's report was delivered
+	MarkReportSent(ctx context.Context, userID uuid.UUID, sentAt time.Time) error
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo       Repository
+	jobService job.Service
+	clock      clock.Clock       // Tells the time schedules and forecasts start from
+	ids        clock.IDGenerator // Creates the IDs of new schedules
+}
+
+// ServiceOption configures optional report Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock schedules reports and forecasts sell-outs from the time c tells instead of now
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// WithIDGenerator creates the IDs of new schedules with ids instead of at random
+func WithIDGenerator(ids clock.IDGenerator) ServiceOption {
+	return func(s *serviceImpl) {
+		s.ids = ids
+	}
+}
+
+// NewService creates a new report service instance
+func NewService(repo Repository, jobService job.Service, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repo:       repo,
+		jobService: jobService,
+		clock:      clock.System{},
+		ids:        clock.RandomIDs{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// GetSchedule retrieves a user's report schedule
+func (s *serviceImpl) GetSchedule(ctx context.Context, userID uuid.UUID) (*Schedule, error) {
+	schedule, err := s.repo.GetSchedule(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil {
+		schedule = &Schedule{
+			UserID:    userID,
+			Frequency: FrequencyWeekly,
+			NextRunAt: NextRun(FrequencyWeekly, s.clock.Now()),
+		}
+	}
+	return schedule, nil
+}
+
+// UpdateSchedule opts a user in or out of periodic sales summary emails
+func (s *serviceImpl) UpdateSchedule(ctx context.Context, userID uuid.UUID, frequency string, enabled bool) (*Schedule, error) {
+	frequency = strings.ToUpper(frequency)
+	if !IsValidFrequency(frequency) {
+		return nil, ErrInvalidFrequency
+	}
+
+	schedule, err := s.repo.GetSchedule(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil {
+		schedule = &Schedule{ID: s.ids.NewID(), UserID: userID}
+	}
+
+	// Restart the cycle when the period changes or reports are switched back on,
+	// so the first email never covers time from before the organizer opted in
+	if schedule.Frequency != frequency || (enabled && !schedule.Enabled) {
+		schedule.NextRunAt = NextRun(frequency, s.clock.Now())
+	}
+	schedule.Frequency = frequency
+	schedule.Enabled = enabled
+
+	if err := s.repo.SaveSchedule(ctx, schedule); err != nil {
+		return nil, err // Repository already returns custom error
+	}
+	return schedule, nil
+}
+
+// GetSalesSummary aggregates an organizer's sales over [from, to)
+func (s *serviceImpl) GetSalesSummary(ctx context.Context, organizerID uuid.UUID, from, to time.Time) (*SalesSummary, error) {
+	if !to.After(from) || to.Sub(from) > maxPeriod {
+		return nil, ErrInvalidPeriod
+	}
+	return s.repo.GetSalesSummary(ctx, organizerID, from, to)
+}
+
+// GetForecast estimates when an event sells out from its sales so far
+func (s *serviceImpl) GetForecast(ctx context.Context, eventID uuid.UUID) (*Forecast, error) {
+	series, err := s.repo.GetSalesSeries(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return ForecastSellout(series, s.clock.Now()), nil
+}
+
+// EnqueueDueReports enqueues a sales summary job for every schedule due at now
+// When reports were missed, e.g. during downtime, only the latest complete period is sent.
+func (s *serviceImpl) EnqueueDueReports(ctx context.Context, now time.Time) (int, error) {
+	schedules, err := s.repo.GetDueSchedules(ctx, now, dueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	enqueued := 0
+	for _, schedule := range schedules {
+		next := NextRun(schedule.Frequency, now)
+
+		// Claim the period first so concurrent schedulers never send it twice
+		claimed, err := s.repo.AdvanceSchedule(ctx, schedule.ID, schedule.NextRunAt, next)
+		if err != nil {
+			return enqueued, err
+		}
+		if !claimed {
+			continue
+		}
+
+		to := next.Add(-Period(schedule.Frequency))
+		payload := SalesSummaryPayload{
+			UserID: schedule.UserID,
+			From:   to.Add(-Period(schedule.Frequency)),
+			To:     to,
+		}
+		if _, err := s.jobService.Enqueue(ctx, JobTypeSalesSummary, payload); err != nil {
+			logging.From(ctx).Warn("Failed to enqueue sales report", "user_id", schedule.UserID, "error", err)
+			return enqueued, NewReportError(ErrReportSchedulingFailed, err)
+		}
+		enqueued++
+	}
+
+	return enqueued, nil
+}
+
+// PrepareSalesReport gathers the data for a queued sales summary
+func (s *serviceImpl) PrepareSalesReport(ctx context.Context, payload SalesSummaryPayload) (*SalesReport, error) {
+	schedule, err := s.repo.GetSchedule(ctx, payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil || !schedule.Enabled {
+		return nil, nil
+	}
+
+	recipient, err := s.repo.GetRecipient(ctx, payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.repo.GetSalesSummary(ctx, payload.UserID, payload.From, payload.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SalesReport{
+		Recipient: recipient,
+		Frequency: schedule.Frequency,
+		Summary:   summary,
+	}, nil
+}
+
+// MarkReportSent records that a user's report was delivered
+func (s *serviceImpl) MarkReportSent(ctx context.Context, userID uuid.UUID, sentAt time.Time) error {
+	return s.repo.MarkSent(ctx, userID, sentAt)
+}
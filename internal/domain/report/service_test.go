@@ -0,0 +1,242 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/job"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetSchedule(ctx context.Context, userID uuid.UUID) (*Schedule, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Schedule), args.Error(1)
+}
+
+func (m *MockRepository) SaveSchedule(ctx context.Context, schedule *Schedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetDueSchedules(ctx context.Context, now time.Time, limit int) ([]*Schedule, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Schedule), args.Error(1)
+}
+
+func (m *MockRepository) AdvanceSchedule(ctx context.Context, id uuid.UUID, expected, next time.Time) (bool, error) {
+	args := m.Called(ctx, id, expected, next)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) MarkSent(ctx context.Context, userID uuid.UUID, sentAt time.Time) error {
+	args := m.Called(ctx, userID, sentAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetSalesSummary(ctx context.Context, organizerID uuid.UUID, from, to time.Time) (*SalesSummary, error) {
+	args := m.Called(ctx, organizerID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SalesSummary), args.Error(1)
+}
+
+func (m *MockRepository) GetSalesSeries(ctx context.Context, eventID uuid.UUID) (*SalesSeries, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SalesSeries), args.Error(1)
+}
+
+func (m *MockRepository) GetRecipient(ctx context.Context, userID uuid.UUID) (*Recipient, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Recipient), args.Error(1)
+}
+
+// MockJobService is a mock implementation of job.Service interface
+type MockJobService struct {
+	mock.Mock
+}
+
+func (m *MockJobService) Enqueue(ctx context.Context, jobType string, payload interface{}, opts ...job.EnqueueOption) (*job.Job, error) {
+	args := m.Called(ctx, jobType, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) GetJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) ListJobs(ctx context.Context, status string, limit int) ([]*job.Job, error) {
+	args := m.Called(ctx, status, limit)
+	return args.Get(0).([]*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) RetryJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func TestNextRun(t *testing.T) {
+	// Wednesday afternoon
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), NextRun(FrequencyDaily, now))
+	assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), NextRun(FrequencyWeekly, now))
+
+	// Boundaries themselves move on to the following period
+	monday := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC), NextRun(FrequencyWeekly, monday))
+}
+
+func TestReportService_UpdateSchedule(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("creates schedule on opt in", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetSchedule", mock.Anything, userID).Return(nil, nil)
+		repo.On("SaveSchedule", mock.Anything, mock.AnythingOfType("*report.Schedule")).Return(nil)
+
+		schedule, err := NewService(repo, nil).UpdateSchedule(context.Background(), userID, "daily", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, FrequencyDaily, schedule.Frequency)
+		assert.True(t, schedule.Enabled)
+		assert.True(t, schedule.NextRunAt.After(time.Now()))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("keeps next run when nothing changes", func(t *testing.T) {
+		nextRun := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+		existing := &Schedule{ID: uuid.New(), UserID: userID, Frequency: FrequencyWeekly, Enabled: true, NextRunAt: nextRun}
+		repo := new(MockRepository)
+		repo.On("GetSchedule", mock.Anything, userID).Return(existing, nil)
+		repo.On("SaveSchedule", mock.Anything, existing).Return(nil)
+
+		schedule, err := NewService(repo, nil).UpdateSchedule(context.Background(), userID, FrequencyWeekly, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, nextRun, schedule.NextRunAt)
+	})
+
+	t.Run("rejects unknown frequency", func(t *testing.T) {
+		repo := new(MockRepository)
+
+		_, err := NewService(repo, nil).UpdateSchedule(context.Background(), userID, "HOURLY", true)
+
+		assert.True(t, IsValidationError(err))
+		repo.AssertNotCalled(t, "SaveSchedule", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReportService_GetSalesSummary_InvalidPeriod(t *testing.T) {
+	repo := new(MockRepository)
+	now := time.Now()
+
+	_, err := NewService(repo, nil).GetSalesSummary(context.Background(), uuid.New(), now, now.Add(-time.Hour))
+
+	assert.Equal(t, ErrInvalidPeriod, err)
+}
+
+func TestReportService_EnqueueDueReports(t *testing.T) {
+	now := time.Date(2026, 10, 14, 0, 5, 0, 0, time.UTC)
+	due := &Schedule{ID: uuid.New(), UserID: uuid.New(), Frequency: FrequencyDaily, Enabled: true, NextRunAt: time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)}
+	// Missed several periods: only the latest complete day is reported
+	stale := &Schedule{ID: uuid.New(), UserID: uuid.New(), Frequency: FrequencyDaily, Enabled: true, NextRunAt: time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)}
+	taken := &Schedule{ID: uuid.New(), UserID: uuid.New(), Frequency: FrequencyWeekly, Enabled: true, NextRunAt: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)}
+
+	tomorrow := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	repo := new(MockRepository)
+	repo.On("GetDueSchedules", mock.Anything, now, dueBatchSize).Return([]*Schedule{due, stale, taken}, nil)
+	repo.On("AdvanceSchedule", mock.Anything, due.ID, due.NextRunAt, tomorrow).Return(true, nil)
+	repo.On("AdvanceSchedule", mock.Anything, stale.ID, stale.NextRunAt, tomorrow).Return(true, nil)
+	repo.On("AdvanceSchedule", mock.Anything, taken.ID, taken.NextRunAt, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)).Return(false, nil)
+
+	period := SalesSummaryPayload{From: time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC), To: time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)}
+	dueRun, staleRun := period, period
+	dueRun.UserID = due.UserID
+	staleRun.UserID = stale.UserID
+
+	jobService := new(MockJobService)
+	jobService.On("Enqueue", mock.Anything, JobTypeSalesSummary, dueRun).Return(&job.Job{}, nil)
+	jobService.On("Enqueue", mock.Anything, JobTypeSalesSummary, staleRun).Return(&job.Job{}, nil)
+
+	enqueued, err := NewService(repo, jobService).EnqueueDueReports(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, enqueued)
+	repo.AssertExpectations(t)
+	jobService.AssertExpectations(t)
+}
+
+func TestReportService_EnqueueDueReports_EnqueueFails(t *testing.T) {
+	now := time.Date(2026, 10, 14, 0, 5, 0, 0, time.UTC)
+	due := &Schedule{ID: uuid.New(), UserID: uuid.New(), Frequency: FrequencyDaily, Enabled: true, NextRunAt: time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)}
+
+	repo := new(MockRepository)
+	repo.On("GetDueSchedules", mock.Anything, now, dueBatchSize).Return([]*Schedule{due}, nil)
+	repo.On("AdvanceSchedule", mock.Anything, due.ID, mock.Anything, mock.Anything).Return(true, nil)
+	jobService := new(MockJobService)
+	jobService.On("Enqueue", mock.Anything, JobTypeSalesSummary, mock.Anything).Return(nil, errors.New("db down"))
+
+	enqueued, err := NewService(repo, jobService).EnqueueDueReports(context.Background(), now)
+
+	assert.Equal(t, 0, enqueued)
+	assert.Equal(t, "REPORT_SCHEDULING_FAILED", GetReportErrorCode(err))
+}
+
+func TestReportService_PrepareSalesReport(t *testing.T) {
+	userID := uuid.New()
+	payload := SalesSummaryPayload{UserID: userID, From: time.Now().Add(-24 * time.Hour), To: time.Now()}
+
+	t.Run("skips organizers who opted out", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetSchedule", mock.Anything, userID).Return(&Schedule{UserID: userID, Frequency: FrequencyDaily}, nil)
+
+		salesReport, err := NewService(repo, nil).PrepareSalesReport(context.Background(), payload)
+
+		assert.NoError(t, err)
+		assert.Nil(t, salesReport)
+		repo.AssertNotCalled(t, "GetSalesSummary", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("gathers recipient and summary", func(t *testing.T) {
+		recipient := &Recipient{UserID: userID, Email: "organizer@example.com"}
+		summary := &SalesSummary{OrganizerID: userID, TotalOrders: 3}
+		repo := new(MockRepository)
+		repo.On("GetSchedule", mock.Anything, userID).Return(&Schedule{UserID: userID, Frequency: FrequencyDaily, Enabled: true}, nil)
+		repo.On("GetRecipient", mock.Anything, userID).Return(recipient, nil)
+		repo.On("GetSalesSummary", mock.Anything, userID, payload.From, payload.To).Return(summary, nil)
+
+		salesReport, err := NewService(repo, nil).PrepareSalesReport(context.Background(), payload)
+
+		assert.NoError(t, err)
+		assert.Equal(t, recipient, salesReport.Recipient)
+		assert.Equal(t, summary, salesReport.Summary)
+		assert.Equal(t, FrequencyDaily, salesReport.Frequency)
+	})
+}
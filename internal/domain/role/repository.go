@@ -5,5 +5,5 @@ import "context"
 // Repository defines the data access interface for role operations
 // This allows us to get roles from the database
 type Repository interface {
-	GetByName(ctx context.Context, name string) (*Role, error) // Gets a role by its name (like "USER", "ADMIN")
+	GetByName(ctx context.Context, name Name) (*Role, error) // Gets a role by its name (like RoleUser, RoleAdmin)
 }
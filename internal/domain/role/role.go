@@ -1,6 +1,8 @@
 package role
 
 import (
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,7 +16,7 @@ type Role struct {
 
 	// Name is the role name (ADMIN, USER, etc.)
 	// Must be unique - no two roles can have the same name
-	Name string `gorm:"unique;not null;size:50" json:"name" binding:"required"`
+	Name Name `gorm:"unique;not null;size:50" json:"name" binding:"required"`
 
 	// Description explains what this role can do
 	Description string `gorm:"type:text" json:"description"`
@@ -24,13 +26,43 @@ type Role struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// Name identifies a role; it is what tokens carry and what the roles table is keyed by
+type Name string
+
 // Common role names used in the application
 const (
-	RoleAdmin     = "ADMIN"     // Administrator with full access
-	RoleUser      = "USER"      // Regular user with basic access
-	RoleOrganizer = "ORGANIZER" // Event organizer with event management access
+	RoleAdmin     Name = "ADMIN"     // Administrator with full access
+	RoleUser      Name = "USER"      // Regular user with basic access
+	RoleOrganizer Name = "ORGANIZER" // Event organizer with event management access
 )
 
+// Names lists every role the application knows about
+func Names() []Name {
+	return []Name{RoleAdmin, RoleUser, RoleOrganizer}
+}
+
+// ParseName converts user input such as "admin" into a role name
+// ok is false for names that are not one of Names.
+func ParseName(s string) (name Name, ok bool) {
+	name = Name(strings.ToUpper(strings.TrimSpace(s)))
+	return name, slices.Contains(Names(), name)
+}
+
+// String returns the role name as stored and as carried in tokens
+func (n Name) String() string {
+	return string(n)
+}
+
+// HasAny reports whether held, a list of role names such as a token's roles, includes any of wanted
+func HasAny(held []string, wanted ...Name) bool {
+	for _, name := range wanted {
+		if slices.Contains(held, string(name)) {
+			return true
+		}
+	}
+	return false
+}
+
 // TableName tells GORM what table to use for this model
 func (Role) TableName() string {
 	return "roles"
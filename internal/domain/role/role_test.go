@@ -0,0 +1,24 @@
+package role
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseName(t *testing.T) {
+	name, ok := ParseName(" organizer ")
+	assert.True(t, ok)
+	assert.Equal(t, RoleOrganizer, name)
+
+	_, ok = ParseName("superuser")
+	assert.False(t, ok)
+}
+
+func TestHasAny(t *testing.T) {
+	held := []string{"USER", "ORGANIZER"}
+
+	assert.True(t, HasAny(held, RoleAdmin, RoleOrganizer))
+	assert.False(t, HasAny(held, RoleAdmin))
+	assert.False(t, HasAny(nil, RoleUser))
+}
@@ -0,0 +1,60 @@
+package share
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ShareError represents domain-specific sharing errors
+type ShareError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *ShareError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *ShareError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined share domain errors
+var (
+	ErrLinkNotFound       = &ShareError{Code: "LINK_NOT_FOUND", Message: "short link not found"}
+	ErrCodeTaken          = &ShareError{Code: "CODE_TAKEN", Message: "short link code already in use"}
+	ErrLinkStoreFailed    = &ShareError{Code: "LINK_STORE_FAILED", Message: "failed to access short links"}
+	ErrLinkCreationFailed = &ShareError{Code: "LINK_CREATION_FAILED", Message: "failed to generate a unique short link code"}
+)
+
+// NewShareError creates a new ShareError with a cause
+func NewShareError(baseError *ShareError, cause error) *ShareError {
+	return &ShareError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetShareErrorCode extracts the error code from a ShareError
+func GetShareErrorCode(err error) string {
+	var shareErr *ShareError
+	if errors.As(err, &shareErr) {
+		return shareErr.Code
+	}
+	return ""
+}
+
+// IsLinkNotFoundError checks if an error is a "short link not found" error
+func IsLinkNotFoundError(err error) bool {
+	return GetShareErrorCode(err) == "LINK_NOT_FOUND"
+}
+
+// IsCodeTakenError checks if an error reports a short code collision
+func IsCodeTakenError(err error) bool {
+	return GetShareErrorCode(err) == "CODE_TAKEN"
+}
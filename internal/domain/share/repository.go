@@ -0,0 +1,20 @@
+package share
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LinkRepository stores short links
+type LinkRepository interface {
+	// Create stores a new short link, returning ErrCodeTaken if its code is already in use
+	Create(ctx context.Context, link *ShortLink, ttl time.Duration) error
+
+	// GetByCode retrieves a short link by its code
+	GetByCode(ctx context.Context, code string) (*ShortLink, error)
+
+	// GetByEventID retrieves the short link of an event, or nil if it has none
+	GetByEventID(ctx context.Context, eventID uuid.UUID) (*ShortLink, error)
+}
@@ -0,0 +1,176 @@
+package share
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/shorturl"
+	"enterprise-crud/internal/domain/venue"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// maxCodeAttempts bounds retries after code collisions
+const maxCodeAttempts = 5
+
+// maxDescriptionLength keeps descriptions within what link previews display
+const maxDescriptionLength = 200
+
+// Service defines the business logic interface for event sharing
+type Service interface {
+	// GetEventMetadata builds share metadata for an event the viewer may see, creating its short link if needed
+	GetEventMetadata(ctx context.Context, eventID uuid.UUID, viewer event.Viewer) (*Metadata, error)
+
+	// ResolveShortLink looks up the short link with the given code
+	ResolveShortLink(ctx context.Context, code string) (*ShortLink, error)
+}
+
+// Config holds the settings used to build share URLs
+type Config struct {
+	PublicURL string        // Base URL of the public site
+	ImageURL  string        // Share image URL; "{id}" is replaced by the event ID
+	LinkTTL   time.Duration // How long short links stay valid, 0 keeps them forever
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	eventService event.Service
+	venueService venue.Service
+	links        LinkRepository // Nil disables short links
+	cfg          Config
+	clock        clock.Clock // Stamps new short links
+}
+
+// ServiceOption configures optional share Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock stamps short links with the time c tells instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// NewService creates a new share service instance
+func NewService(eventService event.Service, venueService venue.Service, links LinkRepository, cfg Config, opts ...ServiceOption) Service {
+	cfg.PublicURL = strings.TrimRight(cfg.PublicURL, "/")
+	s := &serviceImpl{
+		eventService: eventService,
+		venueService: venueService,
+		links:        links,
+		cfg:          cfg,
+		clock:        clock.System{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// GetEventMetadata builds share metadata for an event
+// Events held by moderation aren't published yet, so only admins and those managing them see theirs.
+// A failing link store only drops the short URL; the rest of the metadata is still returned.
+func (s *serviceImpl) GetEventMetadata(ctx context.Context, eventID uuid.UUID, viewer event.Viewer) (*Metadata, error) {
+	e, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if e.IsFlagged() && !s.canSeeFlagged(ctx, e, viewer) {
+		return nil, event.NewEventNotFoundError(eventID)
+	}
+
+	metadata := &Metadata{
+		EventID:      e.ID,
+		Title:        e.Title,
+		Description:  truncate(e.Description, maxDescriptionLength),
+		CanonicalURL: s.eventURL(e.ID),
+		StartTime:    e.EventDate,
+		Status:       e.Status,
+	}
+	if s.cfg.ImageURL != "" {
+		metadata.ImageURL = strings.ReplaceAll(s.cfg.ImageURL, "{id}", e.ID.String())
+	}
+
+	if v, err := s.venueService.GetVenueByID(ctx, e.VenueID); err == nil {
+		metadata.VenueName = v.Name
+	}
+
+	if s.links != nil {
+		link, err := s.shortLink(ctx, e.ID, metadata.CanonicalURL)
+		if err != nil {
+			logging.From(ctx).Warn("Failed to get short link", "event_id", e.ID, "error", err)
+		} else {
+			metadata.ShortURL = s.cfg.PublicURL + "/s/" + link.Code
+		}
+	}
+
+	return metadata, nil
+}
+
+// canSeeFlagged checks if the viewer may see an event held by moderation
+func (s *serviceImpl) canSeeFlagged(ctx context.Context, e *event.Event, viewer event.Viewer) bool {
+	if viewer.Admin {
+		return true
+	}
+	if viewer.UserID == uuid.Nil {
+		return false
+	}
+	canManage, err := s.eventService.CanManage(ctx, e, viewer.UserID)
+	return err == nil && canManage
+}
+
+// ResolveShortLink looks up the short link with the given code
+func (s *serviceImpl) ResolveShortLink(ctx context.Context, code string) (*ShortLink, error) {
+	if s.links == nil || !shorturl.ValidCode(code) {
+		return nil, ErrLinkNotFound
+	}
+	return s.links.GetByCode(ctx, code)
+}
+
+// shortLink returns the event's existing short link or creates one
+func (s *serviceImpl) shortLink(ctx context.Context, eventID uuid.UUID, target string) (*ShortLink, error) {
+	link, err := s.links.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if link != nil {
+		return link, nil
+	}
+
+	for attempt := 0; attempt < maxCodeAttempts; attempt++ {
+		code, err := shorturl.NewCode()
+		if err != nil {
+			return nil, NewShareError(ErrLinkCreationFailed, err)
+		}
+
+		link = &ShortLink{Code: code, EventID: eventID, TargetURL: target, CreatedAt: s.clock.Now()}
+		err = s.links.Create(ctx, link, s.cfg.LinkTTL)
+		if err == nil {
+			return link, nil
+		}
+		if !IsCodeTakenError(err) {
+			return nil, err
+		}
+	}
+	return nil, ErrLinkCreationFailed
+}
+
+// eventURL returns the canonical public URL of an event page
+func (s *serviceImpl) eventURL(id uuid.UUID) string {
+	return s.cfg.PublicURL + "/events/" + id.String()
+}
+
+// truncate shortens s to at most max runes, ending with an ellipsis when cut
+func truncate(s string, max int) string {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)
+	return strings.TrimSpace(string(runes[:max-1])) + "…"
+}
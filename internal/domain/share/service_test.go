@@ -0,0 +1,351 @@
+package share
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/shorturl"
+	"enterprise-crud/internal/domain/venue"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockEventService is a mock implementation of event.Service interface
+type MockEventService struct {
+	mock.Mock
+}
+
+func (m *MockEventService) CreateEvent(ctx context.Context, e *event.Event) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockEventService) GetEventByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetEventBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetAllEvents(ctx context.Context, filter event.ListFilter, viewer event.Viewer) ([]*event.Event, error) {
+	args := m.Called(ctx, filter, viewer)
+	return args.Get(0).([]*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, filter event.OrganizerFilter) ([]*event.Event, int, error) {
+	args := m.Called(ctx, organizerID, filter)
+	return args.Get(0).([]*event.Event), args.Int(1), args.Error(2)
+}
+
+func (m *MockEventService) GetUpcomingEvents(ctx context.Context, filter event.UpcomingFilter) ([]*event.Event, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) UpdateEvent(ctx context.Context, e *event.Event) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockEventService) CancelEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
+	args := m.Called(ctx, eventID, organizerID)
+	return args.Error(0)
+}
+
+func (m *MockEventService) ApproveModeration(ctx context.Context, eventID uuid.UUID, adminID uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, eventID, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) CanManage(ctx context.Context, e *event.Event, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, e, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockEventService) RefreshOnSale(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockEventService) AdjustInventory(ctx context.Context, eventID uuid.UUID, viewer event.Viewer, delta int, reason string) (*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer, delta, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID, viewer event.Viewer) ([]*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) DeleteEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
+	args := m.Called(ctx, eventID, organizerID)
+	return args.Error(0)
+}
+
+// MockVenueService is a mock implementation of venue.Service interface
+type MockVenueService struct {
+	mock.Mock
+}
+
+func (m *MockVenueService) CreateVenue(ctx context.Context, v *venue.Venue) error {
+	args := m.Called(ctx, v)
+	return args.Error(0)
+}
+
+func (m *MockVenueService) GetVenueByID(ctx context.Context, id uuid.UUID) (*venue.Venue, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*venue.Venue), args.Error(1)
+}
+
+func (m *MockVenueService) GetAllVenues(ctx context.Context) ([]*venue.Venue, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*venue.Venue), args.Error(1)
+}
+
+func (m *MockVenueService) UpdateVenue(ctx context.Context, actor venue.Actor, v *venue.Venue) error {
+	args := m.Called(ctx, actor, v)
+	return args.Error(0)
+}
+
+func (m *MockVenueService) DeleteVenue(ctx context.Context, actor venue.Actor, id uuid.UUID) error {
+	args := m.Called(ctx, actor, id)
+	return args.Error(0)
+}
+
+func (m *MockVenueService) TransferOwnership(ctx context.Context, actor venue.Actor, id, newOwnerID uuid.UUID) (*venue.Venue, error) {
+	args := m.Called(ctx, actor, id, newOwnerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*venue.Venue), args.Error(1)
+}
+
+// MockLinkRepository is a mock implementation of LinkRepository interface
+type MockLinkRepository struct {
+	mock.Mock
+}
+
+func (m *MockLinkRepository) Create(ctx context.Context, link *ShortLink, ttl time.Duration) error {
+	args := m.Called(ctx, link, ttl)
+	return args.Error(0)
+}
+
+func (m *MockLinkRepository) GetByCode(ctx context.Context, code string) (*ShortLink, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ShortLink), args.Error(1)
+}
+
+func (m *MockLinkRepository) GetByEventID(ctx context.Context, eventID uuid.UUID) (*ShortLink, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ShortLink), args.Error(1)
+}
+
+var testConfig = Config{
+	PublicURL: "https://tickets.example.com/",
+	ImageURL:  "https://cdn.example.com/events/{id}.png",
+	LinkTTL:   time.Hour,
+}
+
+func testEvent() *event.Event {
+	return &event.Event{
+		ID:          uuid.New(),
+		VenueID:     uuid.New(),
+		Title:       "Spring Concert",
+		Description: "An evening of chamber music",
+		EventDate:   time.Date(2030, 4, 1, 19, 0, 0, 0, time.UTC),
+		Status:      event.StatusActive,
+	}
+}
+
+func TestService_GetEventMetadata(t *testing.T) {
+	ctx := context.Background()
+	e := testEvent()
+
+	t.Run("creates short link on first share", func(t *testing.T) {
+		eventService := new(MockEventService)
+		venueService := new(MockVenueService)
+		links := new(MockLinkRepository)
+		service := NewService(eventService, venueService, links, testConfig)
+
+		eventService.On("GetEventByID", ctx, e.ID).Return(e, nil)
+		venueService.On("GetVenueByID", ctx, e.VenueID).Return(&venue.Venue{ID: e.VenueID, Name: "Main Hall"}, nil)
+		links.On("GetByEventID", ctx, e.ID).Return(nil, nil)
+		links.On("Create", ctx, mock.MatchedBy(func(l *ShortLink) bool {
+			return l.EventID == e.ID && shorturl.ValidCode(l.Code)
+		}), time.Hour).Return(nil)
+
+		metadata, err := service.GetEventMetadata(ctx, e.ID, event.Viewer{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Spring Concert", metadata.Title)
+		assert.Equal(t, "https://tickets.example.com/events/"+e.ID.String(), metadata.CanonicalURL)
+		assert.Equal(t, "https://cdn.example.com/events/"+e.ID.String()+".png", metadata.ImageURL)
+		assert.Equal(t, "Main Hall", metadata.VenueName)
+		assert.True(t, strings.HasPrefix(metadata.ShortURL, "https://tickets.example.com/s/"))
+		links.AssertExpectations(t)
+	})
+
+	t.Run("reuses existing short link", func(t *testing.T) {
+		eventService := new(MockEventService)
+		venueService := new(MockVenueService)
+		links := new(MockLinkRepository)
+		service := NewService(eventService, venueService, links, testConfig)
+
+		eventService.On("GetEventByID", ctx, e.ID).Return(e, nil)
+		venueService.On("GetVenueByID", ctx, e.VenueID).Return(nil, errors.New("venue not found"))
+		links.On("GetByEventID", ctx, e.ID).Return(&ShortLink{Code: "abc1234", EventID: e.ID}, nil)
+
+		metadata, err := service.GetEventMetadata(ctx, e.ID, event.Viewer{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://tickets.example.com/s/abc1234", metadata.ShortURL)
+		assert.Empty(t, metadata.VenueName)
+		links.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("retries on code collision", func(t *testing.T) {
+		eventService := new(MockEventService)
+		venueService := new(MockVenueService)
+		links := new(MockLinkRepository)
+		service := NewService(eventService, venueService, links, testConfig)
+
+		eventService.On("GetEventByID", ctx, e.ID).Return(e, nil)
+		venueService.On("GetVenueByID", ctx, e.VenueID).Return(&venue.Venue{Name: "Main Hall"}, nil)
+		links.On("GetByEventID", ctx, e.ID).Return(nil, nil)
+		links.On("Create", ctx, mock.Anything, time.Hour).Return(ErrCodeTaken).Once()
+		links.On("Create", ctx, mock.Anything, time.Hour).Return(nil).Once()
+
+		metadata, err := service.GetEventMetadata(ctx, e.ID, event.Viewer{})
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, metadata.ShortURL)
+		links.AssertNumberOfCalls(t, "Create", 2)
+	})
+
+	t.Run("link store failure omits short URL", func(t *testing.T) {
+		eventService := new(MockEventService)
+		venueService := new(MockVenueService)
+		links := new(MockLinkRepository)
+		service := NewService(eventService, venueService, links, testConfig)
+
+		eventService.On("GetEventByID", ctx, e.ID).Return(e, nil)
+		venueService.On("GetVenueByID", ctx, e.VenueID).Return(&venue.Venue{Name: "Main Hall"}, nil)
+		links.On("GetByEventID", ctx, e.ID).Return(nil, NewShareError(ErrLinkStoreFailed, errors.New("connection refused")))
+
+		metadata, err := service.GetEventMetadata(ctx, e.ID, event.Viewer{})
+
+		require.NoError(t, err)
+		assert.Empty(t, metadata.ShortURL)
+		assert.Equal(t, "Spring Concert", metadata.Title)
+	})
+
+	t.Run("event not found", func(t *testing.T) {
+		eventService := new(MockEventService)
+		service := NewService(eventService, new(MockVenueService), nil, testConfig)
+
+		eventService.On("GetEventByID", ctx, e.ID).Return(nil, event.ErrEventNotFound)
+
+		metadata, err := service.GetEventMetadata(ctx, e.ID, event.Viewer{})
+
+		assert.Nil(t, metadata)
+		assert.True(t, event.IsEventNotFoundError(err))
+	})
+
+	t.Run("events held by moderation are only shown to those managing them", func(t *testing.T) {
+		flagged := testEvent()
+		flagged.ModerationStatus = event.ModerationFlagged
+		organizerID, strangerID := uuid.New(), uuid.New()
+		eventService := new(MockEventService)
+		venueService := new(MockVenueService)
+		service := NewService(eventService, venueService, nil, testConfig)
+
+		eventService.On("GetEventByID", ctx, flagged.ID).Return(flagged, nil)
+		eventService.On("CanManage", ctx, flagged, organizerID).Return(true, nil)
+		eventService.On("CanManage", ctx, flagged, strangerID).Return(false, nil)
+		venueService.On("GetVenueByID", ctx, flagged.VenueID).Return(&venue.Venue{Name: "Main Hall"}, nil)
+
+		for _, viewer := range []event.Viewer{{}, {UserID: strangerID}} {
+			metadata, err := service.GetEventMetadata(ctx, flagged.ID, viewer)
+			assert.Nil(t, metadata)
+			assert.True(t, event.IsEventNotFoundError(err), "viewer %s", viewer.UserID)
+		}
+		for _, viewer := range []event.Viewer{{UserID: organizerID}, {UserID: uuid.New(), Admin: true}} {
+			metadata, err := service.GetEventMetadata(ctx, flagged.ID, viewer)
+			require.NoError(t, err)
+			assert.Equal(t, "Spring Concert", metadata.Title)
+		}
+	})
+}
+
+func TestService_ResolveShortLink(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("found", func(t *testing.T) {
+		links := new(MockLinkRepository)
+		service := NewService(new(MockEventService), new(MockVenueService), links, testConfig)
+		link := &ShortLink{Code: "abc1234", TargetURL: "https://tickets.example.com/events/1"}
+		links.On("GetByCode", ctx, "abc1234").Return(link, nil)
+
+		result, err := service.ResolveShortLink(ctx, "abc1234")
+
+		require.NoError(t, err)
+		assert.Equal(t, link, result)
+	})
+
+	t.Run("invalid code is not looked up", func(t *testing.T) {
+		links := new(MockLinkRepository)
+		service := NewService(new(MockEventService), new(MockVenueService), links, testConfig)
+
+		_, err := service.ResolveShortLink(ctx, "../etc")
+
+		assert.True(t, IsLinkNotFoundError(err))
+		links.AssertNotCalled(t, "GetByCode", mock.Anything, mock.Anything)
+	})
+
+	t.Run("short links disabled", func(t *testing.T) {
+		service := NewService(new(MockEventService), new(MockVenueService), nil, testConfig)
+
+		_, err := service.ResolveShortLink(ctx, "abc1234")
+
+		assert.True(t, IsLinkNotFoundError(err))
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "short", truncate("  short  ", 10))
+	assert.Equal(t, "ünïcö…", truncate("ünïcödé text", 6))
+}
@@ -0,0 +1,30 @@
+package share
+
+import (
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+
+	"github.com/google/uuid"
+)
+
+// Metadata is the share-ready description of an event, in the shape of Open Graph tags
+type Metadata struct {
+	EventID      uuid.UUID
+	Title        string
+	Description  string
+	ImageURL     string // Empty when no share image is configured
+	CanonicalURL string
+	ShortURL     string // Empty when short links are unavailable
+	StartTime    time.Time
+	VenueName    string
+	Status       event.Status
+}
+
+// ShortLink maps a short code to an event page
+type ShortLink struct {
+	Code      string    `json:"code"`
+	EventID   uuid.UUID `json:"event_id"`
+	TargetURL string    `json:"target_url"`
+	CreatedAt time.Time `json:"created_at"`
+}
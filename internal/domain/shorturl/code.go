@@ -0,0 +1,41 @@
+package shorturl
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+// codeAlphabet is used for short codes; 62^7 codes make guessing impractical
+const codeAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// CodeLength is the number of characters in a short code
+const CodeLength = 7
+
+// NewCode returns a random short code
+func NewCode() (string, error) {
+	max := big.NewInt(int64(len(codeAlphabet)))
+	code := make([]byte, CodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = codeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// ValidCode checks that a code could have been generated by NewCode
+// Lookups of anything else can be rejected without touching storage.
+func ValidCode(code string) bool {
+	if len(code) != CodeLength {
+		return false
+	}
+	for _, c := range code {
+		if !strings.ContainsRune(codeAlphabet, c) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+package shorturl
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ShortURLError represents domain-specific short URL errors
+type ShortURLError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *ShortURLError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *ShortURLError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined short URL domain errors
+var (
+	ErrShortURLNotFound        = &ShortURLError{Code: "SHORT_URL_NOT_FOUND", Message: "short URL not found"}
+	ErrShortURLCreationFailed  = &ShortURLError{Code: "SHORT_URL_CREATION_FAILED", Message: "failed to create short URL"}
+	ErrShortURLRetrievalFailed = &ShortURLError{Code: "SHORT_URL_RETRIEVAL_FAILED", Message: "failed to retrieve short URL"}
+	ErrClickRecordingFailed    = &ShortURLError{Code: "CLICK_RECORDING_FAILED", Message: "failed to record short URL click"}
+)
+
+// NewShortURLError creates a new ShortURLError with a cause
+func NewShortURLError(baseError *ShortURLError, cause error) *ShortURLError {
+	return &ShortURLError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetShortURLErrorCode extracts the error code from a ShortURLError
+func GetShortURLErrorCode(err error) string {
+	var shortURLErr *ShortURLError
+	if errors.As(err, &shortURLErr) {
+		return shortURLErr.Code
+	}
+	return ""
+}
+
+// IsShortURLNotFoundError checks if an error is a "short URL not found" error
+func IsShortURLNotFoundError(err error) bool {
+	return GetShortURLErrorCode(err) == "SHORT_URL_NOT_FOUND"
+}
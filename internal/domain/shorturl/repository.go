@@ -0,0 +1,26 @@
+package shorturl
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for short URL data access
+type Repository interface {
+	// Create stores a short URL unless its code or event already has one
+	// It reports false when nothing was stored because of such a conflict
+	Create(ctx context.Context, shortURL *ShortURL) (bool, error)
+
+	// GetByCode retrieves a short URL by its code
+	GetByCode(ctx context.Context, code string) (*ShortURL, error)
+
+	// GetByEventID retrieves the short URL of an event, or nil if it has none
+	GetByEventID(ctx context.Context, eventID uuid.UUID) (*ShortURL, error)
+
+	// GetByEventIDs retrieves the short URLs of several events, keyed by event ID
+	GetByEventIDs(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID]*ShortURL, error)
+
+	// IncrementClicks adds one to the click count of a short URL
+	IncrementClicks(ctx context.Context, code string) error
+}
@@ -0,0 +1,146 @@
+package shorturl
+
+import (
+	"context"
+	"strings"
+
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// maxCodeAttempts bounds retries after code collisions
+const maxCodeAttempts = 5
+
+// Service defines the business logic interface for event short URLs
+type Service interface {
+	// CreateForEvent returns the event's short URL, generating one if it has none yet
+	CreateForEvent(ctx context.Context, eventID uuid.UUID) (*ShortURL, error)
+
+	// GetForEvent retrieves the short URL of an event
+	GetForEvent(ctx context.Context, eventID uuid.UUID) (*ShortURL, error)
+
+	// GetURLs returns the public short URLs of the given events, keyed by event ID
+	// Events without a short URL are left out.
+	GetURLs(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID]string, error)
+
+	// Resolve looks up a short code and counts the click
+	Resolve(ctx context.Context, code string) (*ShortURL, error)
+
+	// URL returns the public short URL for a code
+	URL(code string) string
+
+	// TargetURL returns the event page a short URL points to
+	TargetURL(shortURL *ShortURL) string
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo      Repository
+	publicURL string
+}
+
+// NewService creates a new short URL service instance
+// Short URLs and their targets are built on publicURL, the base URL of the public site.
+func NewService(repo Repository, publicURL string) Service {
+	return &serviceImpl{
+		repo:      repo,
+		publicURL: strings.TrimRight(publicURL, "/"),
+	}
+}
+
+// CreateForEvent returns the event's short URL, generating one if needed
+// Concurrent calls for the same event all return the one URL that was stored.
+func (s *serviceImpl) CreateForEvent(ctx context.Context, eventID uuid.UUID) (*ShortURL, error) {
+	existing, err := s.repo.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	for attempt := 0; attempt < maxCodeAttempts; attempt++ {
+		code, err := NewCode()
+		if err != nil {
+			return nil, NewShortURLError(ErrShortURLCreationFailed, err)
+		}
+
+		shortURL := &ShortURL{Code: code, EventID: eventID}
+		created, err := s.repo.Create(ctx, shortURL)
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			return shortURL, nil
+		}
+
+		// Either the code collided or the event got a short URL concurrently
+		existing, err := s.repo.GetByEventID(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return existing, nil
+		}
+	}
+	return nil, ErrShortURLCreationFailed
+}
+
+// GetForEvent retrieves the short URL of an event
+func (s *serviceImpl) GetForEvent(ctx context.Context, eventID uuid.UUID) (*ShortURL, error) {
+	shortURL, err := s.repo.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if shortURL == nil {
+		return nil, ErrShortURLNotFound
+	}
+	return shortURL, nil
+}
+
+// GetURLs returns the public short URLs of the given events
+func (s *serviceImpl) GetURLs(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	if len(eventIDs) == 0 {
+		return map[uuid.UUID]string{}, nil
+	}
+
+	shortURLs, err := s.repo.GetByEventIDs(ctx, eventIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make(map[uuid.UUID]string, len(shortURLs))
+	for eventID, shortURL := range shortURLs {
+		urls[eventID] = s.URL(shortURL.Code)
+	}
+	return urls, nil
+}
+
+// Resolve looks up a short code and counts the click
+// A failure to count the click is logged and does not fail the lookup.
+func (s *serviceImpl) Resolve(ctx context.Context, code string) (*ShortURL, error) {
+	if !ValidCode(code) {
+		return nil, ErrShortURLNotFound
+	}
+
+	shortURL, err := s.repo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.IncrementClicks(ctx, code); err != nil {
+		logging.From(ctx).Warn("Failed to record short URL click", "code", code, "error", err)
+	}
+	return shortURL, nil
+}
+
+// URL returns the public short URL for a code
+func (s *serviceImpl) URL(code string) string {
+	return s.publicURL + "/e/" + code
+}
+
+// TargetURL returns the event page a short URL points to
+func (s *serviceImpl) TargetURL(shortURL *ShortURL) string {
+	return s.publicURL + "/events/" + shortURL.EventID.String()
+}
@@ -0,0 +1,201 @@
+package shorturl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, shortURL *ShortURL) (bool, error) {
+	args := m.Called(ctx, shortURL)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) GetByCode(ctx context.Context, code string) (*ShortURL, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ShortURL), args.Error(1)
+}
+
+func (m *MockRepository) GetByEventID(ctx context.Context, eventID uuid.UUID) (*ShortURL, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ShortURL), args.Error(1)
+}
+
+func (m *MockRepository) GetByEventIDs(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID]*ShortURL, error) {
+	args := m.Called(ctx, eventIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]*ShortURL), args.Error(1)
+}
+
+func (m *MockRepository) IncrementClicks(ctx context.Context, code string) error {
+	args := m.Called(ctx, code)
+	return args.Error(0)
+}
+
+const testPublicURL = "https://tickets.example.com/"
+
+func TestService_CreateForEvent(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+
+	t.Run("creates new short URL", func(t *testing.T) {
+		repo := new(MockRepository)
+		service := NewService(repo, testPublicURL)
+		repo.On("GetByEventID", ctx, eventID).Return(nil, nil)
+		repo.On("Create", ctx, mock.MatchedBy(func(s *ShortURL) bool {
+			return s.EventID == eventID && ValidCode(s.Code)
+		})).Return(true, nil)
+
+		result, err := service.CreateForEvent(ctx, eventID)
+
+		require.NoError(t, err)
+		assert.Equal(t, eventID, result.EventID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("returns existing short URL", func(t *testing.T) {
+		repo := new(MockRepository)
+		service := NewService(repo, testPublicURL)
+		existing := &ShortURL{Code: "abc1234", EventID: eventID, Clicks: 3}
+		repo.On("GetByEventID", ctx, eventID).Return(existing, nil)
+
+		result, err := service.CreateForEvent(ctx, eventID)
+
+		require.NoError(t, err)
+		assert.Equal(t, existing, result)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns short URL created concurrently", func(t *testing.T) {
+		repo := new(MockRepository)
+		service := NewService(repo, testPublicURL)
+		concurrent := &ShortURL{Code: "xyz9876", EventID: eventID}
+		repo.On("GetByEventID", ctx, eventID).Return(nil, nil).Once()
+		repo.On("Create", ctx, mock.Anything).Return(false, nil).Once()
+		repo.On("GetByEventID", ctx, eventID).Return(concurrent, nil).Once()
+
+		result, err := service.CreateForEvent(ctx, eventID)
+
+		require.NoError(t, err)
+		assert.Equal(t, concurrent, result)
+	})
+
+	t.Run("retries on code collision", func(t *testing.T) {
+		repo := new(MockRepository)
+		service := NewService(repo, testPublicURL)
+		repo.On("GetByEventID", ctx, eventID).Return(nil, nil)
+		repo.On("Create", ctx, mock.Anything).Return(false, nil).Once()
+		repo.On("Create", ctx, mock.Anything).Return(true, nil).Once()
+
+		result, err := service.CreateForEvent(ctx, eventID)
+
+		require.NoError(t, err)
+		assert.Equal(t, eventID, result.EventID)
+		repo.AssertNumberOfCalls(t, "Create", 2)
+	})
+
+	t.Run("gives up after repeated collisions", func(t *testing.T) {
+		repo := new(MockRepository)
+		service := NewService(repo, testPublicURL)
+		repo.On("GetByEventID", ctx, eventID).Return(nil, nil)
+		repo.On("Create", ctx, mock.Anything).Return(false, nil)
+
+		_, err := service.CreateForEvent(ctx, eventID)
+
+		assert.Equal(t, "SHORT_URL_CREATION_FAILED", GetShortURLErrorCode(err))
+		repo.AssertNumberOfCalls(t, "Create", maxCodeAttempts)
+	})
+}
+
+func TestService_GetForEvent(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+	repo := new(MockRepository)
+	service := NewService(repo, testPublicURL)
+	repo.On("GetByEventID", ctx, eventID).Return(nil, nil)
+
+	_, err := service.GetForEvent(ctx, eventID)
+
+	assert.True(t, IsShortURLNotFoundError(err))
+}
+
+func TestService_GetURLs(t *testing.T) {
+	ctx := context.Background()
+	withURL, withoutURL := uuid.New(), uuid.New()
+	repo := new(MockRepository)
+	service := NewService(repo, testPublicURL)
+	repo.On("GetByEventIDs", ctx, []uuid.UUID{withURL, withoutURL}).Return(map[uuid.UUID]*ShortURL{
+		withURL: {Code: "abc1234", EventID: withURL},
+	}, nil)
+
+	urls, err := service.GetURLs(ctx, []uuid.UUID{withURL, withoutURL})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]string{withURL: "https://tickets.example.com/e/abc1234"}, urls)
+}
+
+func TestService_Resolve(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("counts click", func(t *testing.T) {
+		repo := new(MockRepository)
+		service := NewService(repo, testPublicURL)
+		shortURL := &ShortURL{Code: "abc1234", EventID: uuid.New()}
+		repo.On("GetByCode", ctx, "abc1234").Return(shortURL, nil)
+		repo.On("IncrementClicks", ctx, "abc1234").Return(nil)
+
+		result, err := service.Resolve(ctx, "abc1234")
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://tickets.example.com/events/"+shortURL.EventID.String(), service.TargetURL(result))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("click recording failure does not fail redirect", func(t *testing.T) {
+		repo := new(MockRepository)
+		service := NewService(repo, testPublicURL)
+		repo.On("GetByCode", ctx, "abc1234").Return(&ShortURL{Code: "abc1234"}, nil)
+		repo.On("IncrementClicks", ctx, "abc1234").Return(NewShortURLError(ErrClickRecordingFailed, errors.New("timeout")))
+
+		_, err := service.Resolve(ctx, "abc1234")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid code is not looked up", func(t *testing.T) {
+		repo := new(MockRepository)
+		service := NewService(repo, testPublicURL)
+
+		_, err := service.Resolve(ctx, "not/a/code")
+
+		assert.True(t, IsShortURLNotFoundError(err))
+		repo.AssertNotCalled(t, "GetByCode", mock.Anything, mock.Anything)
+	})
+}
+
+func TestValidCode(t *testing.T) {
+	code, err := NewCode()
+	require.NoError(t, err)
+
+	assert.True(t, ValidCode(code))
+	assert.False(t, ValidCode("abc123"))
+	assert.False(t, ValidCode("abc-123"))
+}
@@ -0,0 +1,22 @@
+package shorturl
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShortURL maps a short code to an event page and counts how often it was followed
+type ShortURL struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	Code      string    `gorm:"uniqueIndex;not null;size:16" json:"code"`
+	EventID   uuid.UUID `gorm:"uniqueIndex;not null;type:uuid" json:"event_id"`
+	Clicks    int64     `gorm:"not null;default:0" json:"clicks"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (ShortURL) TableName() string {
+	return "short_urls"
+}
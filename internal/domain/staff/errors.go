@@ -0,0 +1,88 @@
+package staff
+
+import (
+	"errors"
+	"fmt"
+)
+
+// StaffError represents domain-specific event staff errors
+type StaffError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *StaffError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *StaffError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined staff domain errors
+var (
+	ErrInvalidRole          = &StaffError{Code: "INVALID_STAFF_ROLE", Message: "staff role must be CHECK_IN, VIEWER or CO_ORGANIZER"}
+	ErrUserNotFound         = &StaffError{Code: "USER_NOT_FOUND", Message: "no user with this email"}
+	ErrAlreadyAssigned      = &StaffError{Code: "ALREADY_ASSIGNED", Message: "user is already staff or invited on this event"}
+	ErrCannotAssignSelf     = &StaffError{Code: "CANNOT_ASSIGN_ORGANIZER", Message: "the organizer cannot be added as staff"}
+	ErrAssignmentNotFound   = &StaffError{Code: "ASSIGNMENT_NOT_FOUND", Message: "staff assignment not found"}
+	ErrInvitationNotFound   = &StaffError{Code: "INVITATION_NOT_FOUND", Message: "invitation not found"}
+	ErrNotOrganizer         = &StaffError{Code: "NOT_EVENT_ORGANIZER", Message: "only the event organizer can manage staff"}
+	ErrAccessDenied         = &StaffError{Code: "ACCESS_DENIED", Message: "you are not the organizer or assigned staff of this event"}
+	ErrStaffSaveFailed      = &StaffError{Code: "STAFF_SAVE_FAILED", Message: "failed to save staff assignment"}
+	ErrStaffRetrievalFailed = &StaffError{Code: "STAFF_RETRIEVAL_FAILED", Message: "failed to retrieve staff assignments"}
+)
+
+// NewStaffError creates a new StaffError with a cause
+func NewStaffError(baseError *StaffError, cause error) *StaffError {
+	return &StaffError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetStaffErrorCode extracts the error code from a StaffError
+func GetStaffErrorCode(err error) string {
+	var staffErr *StaffError
+	if errors.As(err, &staffErr) {
+		return staffErr.Code
+	}
+	return ""
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetStaffErrorCode(err) {
+	case "INVALID_STAFF_ROLE", "CANNOT_ASSIGN_ORGANIZER":
+		return true
+	}
+	return false
+}
+
+// IsNotFoundError checks if an error reports a missing user, assignment or invitation
+func IsNotFoundError(err error) bool {
+	switch GetStaffErrorCode(err) {
+	case "USER_NOT_FOUND", "ASSIGNMENT_NOT_FOUND", "INVITATION_NOT_FOUND":
+		return true
+	}
+	return false
+}
+
+// IsForbiddenError checks if an error denies the actor access to the event
+func IsForbiddenError(err error) bool {
+	switch GetStaffErrorCode(err) {
+	case "NOT_EVENT_ORGANIZER", "ACCESS_DENIED":
+		return true
+	}
+	return false
+}
+
+// IsAlreadyAssignedError checks if an error reports a duplicate assignment
+func IsAlreadyAssignedError(err error) bool {
+	return GetStaffErrorCode(err) == "ALREADY_ASSIGNED"
+}
@@ -0,0 +1,42 @@
+package staff
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for event staff data access
+type Repository interface {
+	// Create stores a new assignment
+	// Returns ErrAlreadyAssigned when the user already has one on the event
+	Create(ctx context.Context, assignment *Assignment) error
+
+	// GetByID retrieves an assignment by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Assignment, error)
+
+	// Get retrieves a user's assignment on an event, or nil if they have none
+	Get(ctx context.Context, eventID, userID uuid.UUID) (*Assignment, error)
+
+	// GetByEvent retrieves the staff of an event with their email and username
+	GetByEvent(ctx context.Context, eventID uuid.UUID) ([]*Assignment, error)
+
+	// GetPendingByUser retrieves the invitations a user has not accepted yet
+	GetPendingByUser(ctx context.Context, userID uuid.UUID) ([]*Assignment, error)
+
+	// Accept marks a pending assignment as accepted
+	Accept(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// Delete removes a user's assignment on an event
+	// Returns ErrAssignmentNotFound when there is none
+	Delete(ctx context.Context, eventID, userID uuid.UUID) error
+
+	// IsCoOrganizer reports whether the user accepted a co-organizer role on the event
+	// It lets the event service authorize co-organizers.
+	IsCoOrganizer(ctx context.Context, eventID, userID uuid.UUID) (bool, error)
+
+	// FindUserIDByEmail resolves the user invited by email
+	// Returns ErrUserNotFound when no user has the email
+	FindUserIDByEmail(ctx context.Context, email string) (uuid.UUID, error)
+}
@@ -0,0 +1,175 @@
+package staff
+
+import (
+	"context"
+	"strings"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for event staff
+type Service interface {
+	// Invite invites the user with the given email to an event's staff
+	Invite(ctx context.Context, actor Actor, eventID uuid.UUID, email, role string) (*Assignment, error)
+
+	// ListStaff retrieves the staff and pending invitations of an event
+	ListStaff(ctx context.Context, actor Actor, eventID uuid.UUID) ([]*Assignment, error)
+
+	// RemoveStaff revokes a user's staff role or invitation on an event
+	RemoveStaff(ctx context.Context, actor Actor, eventID, userID uuid.UUID) error
+
+	// ListInvitations retrieves the invitations a user has not accepted yet
+	ListInvitations(ctx context.Context, userID uuid.UUID) ([]*Assignment, error)
+
+	// AcceptInvitation accepts one of the user's invitations
+	AcceptInvitation(ctx context.Context, userID, invitationID uuid.UUID) (*Assignment, error)
+
+	// Authorize checks that the actor is the event's organizer, an admin, or staff
+	// granted the permission, and returns the event
+	Authorize(ctx context.Context, actor Actor, eventID uuid.UUID, permission Permission) (*event.Event, error)
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo         Repository
+	eventService event.Service
+	clock        clock.Clock // Tells the time check-ins are stamped with
+}
+
+// ServiceOption configures optional staff Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock stamps check-ins with the time c tells instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// NewService creates a new staff service instance
+func NewService(repo Repository, eventService event.Service, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repo:         repo,
+		eventService: eventService,
+		clock:        clock.System{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Invite invites the user with the given email to an event's staff
+func (s *serviceImpl) Invite(ctx context.Context, actor Actor, eventID uuid.UUID, email, role string) (*Assignment, error) {
+	role = strings.ToUpper(strings.TrimSpace(role))
+	if !IsValidRole(role) {
+		return nil, ErrInvalidRole
+	}
+
+	e, err := s.organizedEvent(ctx, actor, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.repo.FindUserIDByEmail(ctx, strings.TrimSpace(email))
+	if err != nil {
+		return nil, err
+	}
+	if userID == e.OrganizerID {
+		return nil, ErrCannotAssignSelf
+	}
+
+	assignment := &Assignment{
+		EventID:   eventID,
+		UserID:    userID,
+		Role:      role,
+		Status:    StatusPending,
+		InvitedBy: actor.UserID,
+	}
+	if err := s.repo.Create(ctx, assignment); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// ListStaff retrieves the staff and pending invitations of an event
+func (s *serviceImpl) ListStaff(ctx context.Context, actor Actor, eventID uuid.UUID) ([]*Assignment, error) {
+	if _, err := s.organizedEvent(ctx, actor, eventID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByEvent(ctx, eventID)
+}
+
+// RemoveStaff revokes a user's staff role or invitation on an event
+func (s *serviceImpl) RemoveStaff(ctx context.Context, actor Actor, eventID, userID uuid.UUID) error {
+	if _, err := s.organizedEvent(ctx, actor, eventID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, eventID, userID)
+}
+
+// ListInvitations retrieves the invitations a user has not accepted yet
+func (s *serviceImpl) ListInvitations(ctx context.Context, userID uuid.UUID) ([]*Assignment, error) {
+	return s.repo.GetPendingByUser(ctx, userID)
+}
+
+// AcceptInvitation accepts one of the user's invitations
+// Invitations of other users are reported as not found.
+func (s *serviceImpl) AcceptInvitation(ctx context.Context, userID, invitationID uuid.UUID) (*Assignment, error) {
+	assignment, err := s.repo.GetByID(ctx, invitationID)
+	if err != nil {
+		if GetStaffErrorCode(err) == ErrAssignmentNotFound.Code {
+			return nil, ErrInvitationNotFound
+		}
+		return nil, err
+	}
+	if assignment.UserID != userID {
+		return nil, ErrInvitationNotFound
+	}
+	if assignment.IsAccepted() {
+		return assignment, nil
+	}
+
+	now := s.clock.Now()
+	if err := s.repo.Accept(ctx, assignment.ID, now); err != nil {
+		return nil, err
+	}
+	assignment.Status = StatusAccepted
+	assignment.AcceptedAt = &now
+	return assignment, nil
+}
+
+// Authorize checks that the actor may perform the permission on the event
+func (s *serviceImpl) Authorize(ctx context.Context, actor Actor, eventID uuid.UUID, permission Permission) (*event.Event, error) {
+	e, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if actor.IsAdmin || e.OrganizerID == actor.UserID {
+		return e, nil
+	}
+
+	assignment, err := s.repo.Get(ctx, eventID, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if assignment == nil || !assignment.Grants(permission) {
+		return nil, ErrAccessDenied
+	}
+	return e, nil
+}
+
+// organizedEvent retrieves an event the actor may manage staff of
+func (s *serviceImpl) organizedEvent(ctx context.Context, actor Actor, eventID uuid.UUID) (*event.Event, error) {
+	e, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.IsAdmin && e.OrganizerID != actor.UserID {
+		return nil, ErrNotOrganizer
+	}
+	return e, nil
+}
@@ -0,0 +1,330 @@
+package staff
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockEventService is a mock implementation of event.Service interface
+type MockEventService struct {
+	mock.Mock
+}
+
+func (m *MockEventService) CreateEvent(ctx context.Context, e *event.Event) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockEventService) GetEventByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetEventBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetAllEvents(ctx context.Context, filter event.ListFilter, viewer event.Viewer) ([]*event.Event, error) {
+	args := m.Called(ctx, filter, viewer)
+	return args.Get(0).([]*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, filter event.OrganizerFilter) ([]*event.Event, int, error) {
+	args := m.Called(ctx, organizerID, filter)
+	return args.Get(0).([]*event.Event), args.Int(1), args.Error(2)
+}
+
+func (m *MockEventService) GetUpcomingEvents(ctx context.Context, filter event.UpcomingFilter) ([]*event.Event, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) UpdateEvent(ctx context.Context, e *event.Event) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockEventService) CancelEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
+	args := m.Called(ctx, eventID, organizerID)
+	return args.Error(0)
+}
+
+func (m *MockEventService) ApproveModeration(ctx context.Context, eventID uuid.UUID, adminID uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, eventID, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) CanManage(ctx context.Context, e *event.Event, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, e, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockEventService) RefreshOnSale(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockEventService) AdjustInventory(ctx context.Context, eventID uuid.UUID, viewer event.Viewer, delta int, reason string) (*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer, delta, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID, viewer event.Viewer) ([]*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) DeleteEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
+	args := m.Called(ctx, eventID, organizerID)
+	return args.Error(0)
+}
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, assignment *Assignment) error {
+	args := m.Called(ctx, assignment)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (*Assignment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Assignment), args.Error(1)
+}
+
+func (m *MockRepository) Get(ctx context.Context, eventID, userID uuid.UUID) (*Assignment, error) {
+	args := m.Called(ctx, eventID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Assignment), args.Error(1)
+}
+
+func (m *MockRepository) GetByEvent(ctx context.Context, eventID uuid.UUID) ([]*Assignment, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).([]*Assignment), args.Error(1)
+}
+
+func (m *MockRepository) GetPendingByUser(ctx context.Context, userID uuid.UUID) ([]*Assignment, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*Assignment), args.Error(1)
+}
+
+func (m *MockRepository) Accept(ctx context.Context, id uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, id, at)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, eventID, userID uuid.UUID) error {
+	args := m.Called(ctx, eventID, userID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) IsCoOrganizer(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, eventID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) FindUserIDByEmail(ctx context.Context, email string) (uuid.UUID, error) {
+	args := m.Called(ctx, email)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func TestService_Invite(t *testing.T) {
+	ctx := context.Background()
+	organizerID := uuid.New()
+	staffID := uuid.New()
+	eventID := uuid.New()
+	e := &event.Event{ID: eventID, OrganizerID: organizerID}
+
+	t.Run("invalid role", func(t *testing.T) {
+		service := NewService(new(MockRepository), new(MockEventService))
+
+		_, err := service.Invite(ctx, Actor{UserID: organizerID}, eventID, "door@example.com", "OWNER")
+		assert.Equal(t, ErrInvalidRole, err)
+	})
+
+	t.Run("only the organizer can invite", func(t *testing.T) {
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(e, nil)
+		service := NewService(new(MockRepository), eventService)
+
+		_, err := service.Invite(ctx, Actor{UserID: uuid.New()}, eventID, "door@example.com", RoleViewer)
+		assert.Equal(t, ErrNotOrganizer, err)
+	})
+
+	t.Run("organizer cannot invite themselves", func(t *testing.T) {
+		repo := new(MockRepository)
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(e, nil)
+		repo.On("FindUserIDByEmail", ctx, "me@example.com").Return(organizerID, nil)
+		service := NewService(repo, eventService)
+
+		_, err := service.Invite(ctx, Actor{UserID: organizerID}, eventID, "me@example.com", RoleViewer)
+		assert.Equal(t, ErrCannotAssignSelf, err)
+	})
+
+	t.Run("creates a pending invitation", func(t *testing.T) {
+		repo := new(MockRepository)
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(e, nil)
+		repo.On("FindUserIDByEmail", ctx, "door@example.com").Return(staffID, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*staff.Assignment")).Return(nil)
+		service := NewService(repo, eventService)
+
+		assignment, err := service.Invite(ctx, Actor{UserID: organizerID}, eventID, " door@example.com ", "check_in")
+		require.NoError(t, err)
+		assert.Equal(t, staffID, assignment.UserID)
+		assert.Equal(t, RoleCheckIn, assignment.Role)
+		assert.Equal(t, StatusPending, assignment.Status)
+		assert.Equal(t, organizerID, assignment.InvitedBy)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestService_Authorize(t *testing.T) {
+	ctx := context.Background()
+	organizerID := uuid.New()
+	staffID := uuid.New()
+	eventID := uuid.New()
+	e := &event.Event{ID: eventID, OrganizerID: organizerID}
+
+	tests := []struct {
+		name        string
+		actor       Actor
+		permission  Permission
+		assignment  *Assignment
+		expectRepo  bool
+		expectedErr error
+	}{
+		{name: "organizer", actor: Actor{UserID: organizerID}, permission: PermissionCheckIn},
+		{name: "admin", actor: Actor{UserID: uuid.New(), IsAdmin: true}, permission: PermissionCheckIn},
+		{
+			name:       "accepted check-in staff",
+			actor:      Actor{UserID: staffID},
+			permission: PermissionCheckIn,
+			assignment: &Assignment{UserID: staffID, Role: RoleCheckIn, Status: StatusAccepted},
+			expectRepo: true,
+		},
+		{
+			name:       "viewer staff can view",
+			actor:      Actor{UserID: staffID},
+			permission: PermissionView,
+			assignment: &Assignment{UserID: staffID, Role: RoleViewer, Status: StatusAccepted},
+			expectRepo: true,
+		},
+		{
+			name:        "viewer staff cannot check in",
+			actor:       Actor{UserID: staffID},
+			permission:  PermissionCheckIn,
+			assignment:  &Assignment{UserID: staffID, Role: RoleViewer, Status: StatusAccepted},
+			expectRepo:  true,
+			expectedErr: ErrAccessDenied,
+		},
+		{
+			name:       "co-organizer can check in",
+			actor:      Actor{UserID: staffID},
+			permission: PermissionCheckIn,
+			assignment: &Assignment{UserID: staffID, Role: RoleCoOrganizer, Status: StatusAccepted},
+			expectRepo: true,
+		},
+		{
+			name:        "pending invitation grants nothing",
+			actor:       Actor{UserID: staffID},
+			permission:  PermissionView,
+			assignment:  &Assignment{UserID: staffID, Role: RoleCheckIn, Status: StatusPending},
+			expectRepo:  true,
+			expectedErr: ErrAccessDenied,
+		},
+		{
+			name:        "unrelated user",
+			actor:       Actor{UserID: staffID},
+			permission:  PermissionView,
+			expectRepo:  true,
+			expectedErr: ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			eventService := new(MockEventService)
+			eventService.On("GetEventByID", ctx, eventID).Return(e, nil)
+			if tt.expectRepo {
+				if tt.assignment != nil {
+					repo.On("Get", ctx, eventID, tt.actor.UserID).Return(tt.assignment, nil)
+				} else {
+					repo.On("Get", ctx, eventID, tt.actor.UserID).Return(nil, nil)
+				}
+			}
+			service := NewService(repo, eventService)
+
+			result, err := service.Authorize(ctx, tt.actor, eventID, tt.permission)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, e, result)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_AcceptInvitation(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	invitationID := uuid.New()
+
+	t.Run("another user's invitation is not found", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetByID", ctx, invitationID).Return(&Assignment{ID: invitationID, UserID: uuid.New(), Status: StatusPending}, nil)
+		service := NewService(repo, new(MockEventService))
+
+		_, err := service.AcceptInvitation(ctx, userID, invitationID)
+		assert.Equal(t, ErrInvitationNotFound, err)
+		repo.AssertNotCalled(t, "Accept", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("accepts own invitation", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetByID", ctx, invitationID).Return(&Assignment{ID: invitationID, UserID: userID, Status: StatusPending}, nil)
+		repo.On("Accept", ctx, invitationID, mock.AnythingOfType("time.Time")).Return(nil)
+		service := NewService(repo, new(MockEventService))
+
+		assignment, err := service.AcceptInvitation(ctx, userID, invitationID)
+		require.NoError(t, err)
+		assert.True(t, assignment.IsAccepted())
+		assert.NotNil(t, assignment.AcceptedAt)
+	})
+}
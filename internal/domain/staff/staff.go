@@ -0,0 +1,82 @@
+package staff
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Staff roles an organizer can grant on one of their events
+const (
+	RoleCheckIn     = "CHECK_IN"     // Check in ticket holders and view event statistics
+	RoleViewer      = "VIEWER"       // View event statistics only
+	RoleCoOrganizer = "CO_ORGANIZER" // Update and cancel the event as well as everything CHECK_IN may do
+)
+
+// Assignment statuses
+const (
+	StatusPending  = "PENDING"  // Invited, not yet accepted
+	StatusAccepted = "ACCEPTED" // Active staff member
+)
+
+// Permission is an action on an event that staff may be allowed to perform
+type Permission string
+
+// Permissions checked by Service.Authorize
+const (
+	PermissionView    Permission = "VIEW"
+	PermissionCheckIn Permission = "CHECK_IN"
+)
+
+// Assignment grants a user a staff role on a single event
+type Assignment struct {
+	ID         uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	EventID    uuid.UUID  `gorm:"not null;type:uuid;uniqueIndex:idx_event_staff_event_user" json:"event_id"`
+	UserID     uuid.UUID  `gorm:"not null;type:uuid;uniqueIndex:idx_event_staff_event_user" json:"user_id"`
+	Role       string     `gorm:"not null;size:20" json:"role"`
+	Status     string     `gorm:"not null;size:20;default:'PENDING'" json:"status"`
+	InvitedBy  uuid.UUID  `gorm:"not null;type:uuid" json:"invited_by"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+
+	// Email and Username of the staff member, filled in by listing queries
+	Email    string `gorm:"->;-:migration" json:"email,omitempty"`
+	Username string `gorm:"->;-:migration" json:"username,omitempty"`
+}
+
+// Actor identifies the user performing an action
+type Actor struct {
+	UserID  uuid.UUID
+	IsAdmin bool // Admins may act on any event
+}
+
+// TableName tells GORM what table to use for this model
+func (Assignment) TableName() string {
+	return "event_staff"
+}
+
+// IsAccepted checks if the invitation was accepted
+func (a *Assignment) IsAccepted() bool {
+	return a.Status == StatusAccepted
+}
+
+// Grants checks if the assignment allows the given permission
+// Pending invitations grant nothing.
+func (a *Assignment) Grants(permission Permission) bool {
+	if !a.IsAccepted() {
+		return false
+	}
+	switch permission {
+	case PermissionView:
+		return a.Role == RoleCheckIn || a.Role == RoleViewer || a.Role == RoleCoOrganizer
+	case PermissionCheckIn:
+		return a.Role == RoleCheckIn || a.Role == RoleCoOrganizer
+	}
+	return false
+}
+
+// IsValidRole checks if a role can be granted to staff
+func IsValidRole(role string) bool {
+	return role == RoleCheckIn || role == RoleViewer || role == RoleCoOrganizer
+}
@@ -0,0 +1,83 @@
+package transfer
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TransferError represents domain-specific event transfer errors
+type TransferError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *TransferError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *TransferError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined transfer domain errors
+var (
+	ErrOrganizerNotFound       = &TransferError{Code: "ORGANIZER_NOT_FOUND", Message: "no active organizer with this email"}
+	ErrSameOrganizer           = &TransferError{Code: "SAME_ORGANIZER", Message: "the event already belongs to this organizer"}
+	ErrNotOrganizer            = &TransferError{Code: "NOT_EVENT_ORGANIZER", Message: "only the event organizer can transfer it"}
+	ErrTransferNotFound        = &TransferError{Code: "TRANSFER_NOT_FOUND", Message: "event transfer not found"}
+	ErrTransferPending         = &TransferError{Code: "TRANSFER_PENDING", Message: "the event already has a pending transfer"}
+	ErrNotPending              = &TransferError{Code: "TRANSFER_NOT_PENDING", Message: "event transfer is no longer pending"}
+	ErrOrganizerChanged        = &TransferError{Code: "ORGANIZER_CHANGED", Message: "the event changed organizer since the transfer was requested"}
+	ErrTransferSaveFailed      = &TransferError{Code: "TRANSFER_SAVE_FAILED", Message: "failed to save event transfer"}
+	ErrTransferRetrievalFailed = &TransferError{Code: "TRANSFER_RETRIEVAL_FAILED", Message: "failed to retrieve event transfers"}
+)
+
+// NewTransferError creates a new TransferError with a cause
+func NewTransferError(baseError *TransferError, cause error) *TransferError {
+	return &TransferError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetTransferErrorCode extracts the error code from a TransferError
+func GetTransferErrorCode(err error) string {
+	var transferErr *TransferError
+	if errors.As(err, &transferErr) {
+		return transferErr.Code
+	}
+	return ""
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetTransferErrorCode(err) {
+	case "ORGANIZER_NOT_FOUND", "SAME_ORGANIZER":
+		return true
+	}
+	return false
+}
+
+// IsNotFoundError checks if an error reports a missing transfer
+func IsNotFoundError(err error) bool {
+	return GetTransferErrorCode(err) == "TRANSFER_NOT_FOUND"
+}
+
+// IsForbiddenError checks if an error denies the actor the transfer
+func IsForbiddenError(err error) bool {
+	return GetTransferErrorCode(err) == "NOT_EVENT_ORGANIZER"
+}
+
+// IsConflictError checks if an error is caused by the state of the event or transfer
+func IsConflictError(err error) bool {
+	switch GetTransferErrorCode(err) {
+	case "TRANSFER_PENDING", "TRANSFER_NOT_PENDING", "ORGANIZER_CHANGED":
+		return true
+	}
+	return false
+}
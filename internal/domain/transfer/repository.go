@@ -0,0 +1,48 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for event transfer data access
+// Every change of a transfer is stored together with its audit entry.
+type Repository interface {
+	// Create stores a new pending transfer with the audit entry of its request
+	// Returns ErrTransferPending when the event already has a pending transfer
+	Create(ctx context.Context, t *Transfer, entry *AuditEntry) error
+
+	// GetByID retrieves a transfer by its ID
+	// Returns ErrTransferNotFound when there is none
+	GetByID(ctx context.Context, id uuid.UUID) (*Transfer, error)
+
+	// ListByEvent retrieves the transfers of an event, newest first
+	ListByEvent(ctx context.Context, eventID uuid.UUID) ([]*Transfer, error)
+
+	// ListPendingForUser retrieves the transfers waiting for a user to accept, with event titles
+	ListPendingForUser(ctx context.Context, userID uuid.UUID) ([]*Transfer, error)
+
+	// Accept marks a pending transfer as accepted and makes its new organizer the event's
+	// organizer in one transaction. The new organizer's staff role on the event is dropped.
+	// Returns ErrNotPending when the transfer was decided in the meantime and
+	// ErrOrganizerChanged when the event no longer belongs to the transfer's old organizer
+	Accept(ctx context.Context, t *Transfer, entry *AuditEntry) error
+
+	// Close saves the declined or cancelled status of a pending transfer
+	// Returns ErrNotPending when the transfer was decided in the meantime
+	Close(ctx context.Context, t *Transfer, entry *AuditEntry) error
+
+	// ListAudit retrieves the audit entries of a transfer, oldest first
+	ListAudit(ctx context.Context, transferID uuid.UUID) ([]*AuditEntry, error)
+
+	// FindOrganizerByEmail resolves an active user with the ORGANIZER role by email
+	// Returns ErrOrganizerNotFound when there is none
+	FindOrganizerByEmail(ctx context.Context, email string) (uuid.UUID, error)
+}
+
+// CacheInvalidator drops cached event data once an event changed hands
+// It must also stop reads that started before the change from caching what they loaded.
+type CacheInvalidator interface {
+	InvalidateEventRelatedCaches(ctx context.Context, eventID, venueID, organizerID uuid.UUID) error
+}
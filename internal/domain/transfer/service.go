@@ -0,0 +1,260 @@
+package transfer
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for event ownership transfers
+type Service interface {
+	// Request asks the organizer with the given email to take over an event
+	Request(ctx context.Context, actor Actor, eventID uuid.UUID, email string) (*Transfer, error)
+
+	// Get retrieves a transfer with its audit trail for either organizer or an admin
+	Get(ctx context.Context, actor Actor, id uuid.UUID) (*Transfer, []*AuditEntry, error)
+
+	// ListForEvent retrieves the transfer history of an event for its organizer or an admin
+	ListForEvent(ctx context.Context, actor Actor, eventID uuid.UUID) ([]*Transfer, error)
+
+	// ListPending retrieves the transfers waiting for the user to accept
+	ListPending(ctx context.Context, userID uuid.UUID) ([]*Transfer, error)
+
+	// Accept makes the user the organizer of a transfer's event
+	Accept(ctx context.Context, userID, id uuid.UUID) (*Transfer, error)
+
+	// Decline declines a transfer offered to the user
+	Decline(ctx context.Context, userID, id uuid.UUID) (*Transfer, error)
+
+	// Cancel withdraws a pending transfer
+	Cancel(ctx context.Context, actor Actor, id uuid.UUID) (*Transfer, error)
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo         Repository
+	eventService event.Service
+	caches       CacheInvalidator
+	clock        clock.Clock       // Tells the time transfers expire and are accepted at
+	ids          clock.IDGenerator // Creates the IDs of new transfers and tickets
+}
+
+// ServiceOption configures optional transfer Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock checks and stamps transfers with the time c tells instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// WithIDGenerator creates the IDs of new transfers and tickets with ids instead of at random
+func WithIDGenerator(ids clock.IDGenerator) ServiceOption {
+	return func(s *serviceImpl) {
+		s.ids = ids
+	}
+}
+
+// NewService creates a new event transfer service instance
+// caches may be nil when events are not cached.
+func NewService(repo Repository, eventService event.Service, caches CacheInvalidator, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repo:         repo,
+		eventService: eventService,
+		caches:       caches,
+		clock:        clock.System{},
+		ids:          clock.RandomIDs{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Request asks the organizer with the given email to take over an event
+// Only the event's organizer or an admin may hand it over; co-organizers may not.
+func (s *serviceImpl) Request(ctx context.Context, actor Actor, eventID uuid.UUID, email string) (*Transfer, error) {
+	e, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.IsAdmin && e.OrganizerID != actor.UserID {
+		return nil, ErrNotOrganizer
+	}
+
+	toID, err := s.repo.FindOrganizerByEmail(ctx, strings.TrimSpace(email))
+	if err != nil {
+		return nil, err
+	}
+	if toID == e.OrganizerID {
+		return nil, ErrSameOrganizer
+	}
+
+	t := &Transfer{
+		ID:              s.ids.NewID(),
+		EventID:         e.ID,
+		FromOrganizerID: e.OrganizerID,
+		ToOrganizerID:   toID,
+		Status:          StatusPending,
+		RequestedBy:     actor.UserID,
+		EventTitle:      e.Title,
+	}
+	if err := s.repo.Create(ctx, t, s.entry(t, ActionRequested, actor.UserID)); err != nil {
+		return nil, err
+	}
+
+	logging.From(ctx).Info("Event transfer requested", "transfer_id", t.ID, "event_id", e.ID, "from_organizer_id", t.FromOrganizerID, "to_organizer_id", toID, "requested_by", actor.UserID)
+	return t, nil
+}
+
+// Get retrieves a transfer with its audit trail
+// Transfers the actor is not a party to are reported as not found.
+func (s *serviceImpl) Get(ctx context.Context, actor Actor, id uuid.UUID) (*Transfer, []*AuditEntry, error) {
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !actor.IsAdmin && actor.UserID != t.FromOrganizerID && actor.UserID != t.ToOrganizerID {
+		return nil, nil, ErrTransferNotFound
+	}
+
+	audit, err := s.repo.ListAudit(ctx, t.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, audit, nil
+}
+
+// ListForEvent retrieves the transfer history of an event, newest first
+func (s *serviceImpl) ListForEvent(ctx context.Context, actor Actor, eventID uuid.UUID) ([]*Transfer, error) {
+	e, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.IsAdmin && e.OrganizerID != actor.UserID {
+		return nil, ErrNotOrganizer
+	}
+	return s.repo.ListByEvent(ctx, eventID)
+}
+
+// ListPending retrieves the transfers waiting for the user to accept
+func (s *serviceImpl) ListPending(ctx context.Context, userID uuid.UUID) ([]*Transfer, error) {
+	return s.repo.ListPendingForUser(ctx, userID)
+}
+
+// Accept makes the user the organizer of a transfer's event
+// The event's cached data is dropped for both organizers so their listings reflect the change.
+func (s *serviceImpl) Accept(ctx context.Context, userID, id uuid.UUID) (*Transfer, error) {
+	t, err := s.offeredTransfer(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	t.Status = StatusAccepted
+	t.RespondedAt = &now
+	if err := s.repo.Accept(ctx, t, s.entry(t, ActionAccepted, userID)); err != nil {
+		return nil, err
+	}
+
+	log := logging.From(ctx).With("transfer_id", t.ID, "event_id", t.EventID, "from_organizer_id", t.FromOrganizerID, "to_organizer_id", t.ToOrganizerID)
+	log.Info("Event transfer accepted")
+	s.invalidateCaches(ctx, log, t)
+	return t, nil
+}
+
+// Decline declines a transfer offered to the user
+func (s *serviceImpl) Decline(ctx context.Context, userID, id uuid.UUID) (*Transfer, error) {
+	t, err := s.offeredTransfer(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.close(ctx, t, StatusDeclined, ActionDeclined, userID); err != nil {
+		return nil, err
+	}
+	logging.From(ctx).Info("Event transfer declined", "transfer_id", t.ID, "event_id", t.EventID, "to_organizer_id", userID)
+	return t, nil
+}
+
+// Cancel withdraws a pending transfer
+// Only the organizer handing the event over or an admin may withdraw it.
+func (s *serviceImpl) Cancel(ctx context.Context, actor Actor, id uuid.UUID) (*Transfer, error) {
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.IsAdmin && actor.UserID != t.FromOrganizerID {
+		if actor.UserID == t.ToOrganizerID {
+			return nil, ErrNotOrganizer
+		}
+		return nil, ErrTransferNotFound
+	}
+	if !t.IsPending() {
+		return nil, ErrNotPending
+	}
+	if err := s.close(ctx, t, StatusCancelled, ActionCancelled, actor.UserID); err != nil {
+		return nil, err
+	}
+	logging.From(ctx).Info("Event transfer cancelled", "transfer_id", t.ID, "event_id", t.EventID, "cancelled_by", actor.UserID)
+	return t, nil
+}
+
+// offeredTransfer retrieves a pending transfer offered to the user
+// Transfers offered to other users are reported as not found.
+func (s *serviceImpl) offeredTransfer(ctx context.Context, userID, id uuid.UUID) (*Transfer, error) {
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.ToOrganizerID != userID {
+		return nil, ErrTransferNotFound
+	}
+	if !t.IsPending() {
+		return nil, ErrNotPending
+	}
+	return t, nil
+}
+
+// close saves a pending transfer as declined or cancelled
+func (s *serviceImpl) close(ctx context.Context, t *Transfer, status, action string, actorID uuid.UUID) error {
+	now := s.clock.Now()
+	t.Status = status
+	t.RespondedAt = &now
+	return s.repo.Close(ctx, t, s.entry(t, action, actorID))
+}
+
+// entry builds the audit entry of a change to a transfer
+func (s *serviceImpl) entry(t *Transfer, action string, actorID uuid.UUID) *AuditEntry {
+	return &AuditEntry{
+		ID:         s.ids.NewID(),
+		TransferID: t.ID,
+		EventID:    t.EventID,
+		Action:     action,
+		ActorID:    actorID,
+	}
+}
+
+// invalidateCaches drops the event's cached data for both organizers
+// The transfer is already saved, so failures are only logged; the caches expire on their own.
+func (s *serviceImpl) invalidateCaches(ctx context.Context, log *slog.Logger, t *Transfer) {
+	if s.caches == nil {
+		return
+	}
+	e, err := s.eventService.GetEventByID(ctx, t.EventID)
+	if err != nil {
+		log.Warn("Failed to load transferred event for cache invalidation", "error", err)
+		return
+	}
+	for _, organizerID := range []uuid.UUID{t.FromOrganizerID, t.ToOrganizerID} {
+		if err := s.caches.InvalidateEventRelatedCaches(ctx, t.EventID, e.VenueID, organizerID); err != nil {
+			log.Warn("Failed to invalidate event caches after transfer", "organizer_id", organizerID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package transfer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockEventService is a mock implementation of event.Service interface
+type MockEventService struct {
+	mock.Mock
+}
+
+func (m *MockEventService) CreateEvent(ctx context.Context, e *event.Event) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockEventService) GetEventByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetEventBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetAllEvents(ctx context.Context, filter event.ListFilter, viewer event.Viewer) ([]*event.Event, error) {
+	args := m.Called(ctx, filter, viewer)
+	return args.Get(0).([]*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, filter event.OrganizerFilter) ([]*event.Event, int, error) {
+	args := m.Called(ctx, organizerID, filter)
+	return args.Get(0).([]*event.Event), args.Int(1), args.Error(2)
+}
+
+func (m *MockEventService) GetUpcomingEvents(ctx context.Context, filter event.UpcomingFilter) ([]*event.Event, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) UpdateEvent(ctx context.Context, e *event.Event) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockEventService) CancelEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
+	args := m.Called(ctx, eventID, organizerID)
+	return args.Error(0)
+}
+
+func (m *MockEventService) ApproveModeration(ctx context.Context, eventID uuid.UUID, adminID uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, eventID, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) CanManage(ctx context.Context, e *event.Event, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, e, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockEventService) RefreshOnSale(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockEventService) AdjustInventory(ctx context.Context, eventID uuid.UUID, viewer event.Viewer, delta int, reason string) (*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer, delta, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID, viewer event.Viewer) ([]*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) DeleteEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
+	args := m.Called(ctx, eventID, organizerID)
+	return args.Error(0)
+}
+
+// MockRepository is a mock implementation of Repository interface
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, t *Transfer, entry *AuditEntry) error {
+	args := m.Called(ctx, t, entry)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (*Transfer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Transfer), args.Error(1)
+}
+
+func (m *MockRepository) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]*Transfer, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).([]*Transfer), args.Error(1)
+}
+
+func (m *MockRepository) ListPendingForUser(ctx context.Context, userID uuid.UUID) ([]*Transfer, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*Transfer), args.Error(1)
+}
+
+func (m *MockRepository) Accept(ctx context.Context, t *Transfer, entry *AuditEntry) error {
+	args := m.Called(ctx, t, entry)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Close(ctx context.Context, t *Transfer, entry *AuditEntry) error {
+	args := m.Called(ctx, t, entry)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListAudit(ctx context.Context, transferID uuid.UUID) ([]*AuditEntry, error) {
+	args := m.Called(ctx, transferID)
+	return args.Get(0).([]*AuditEntry), args.Error(1)
+}
+
+func (m *MockRepository) FindOrganizerByEmail(ctx context.Context, email string) (uuid.UUID, error) {
+	args := m.Called(ctx, email)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+// MockCaches records the organizers whose event caches were invalidated
+type MockCaches struct {
+	organizers []uuid.UUID
+}
+
+func (m *MockCaches) InvalidateEventRelatedCaches(ctx context.Context, eventID, venueID, organizerID uuid.UUID) error {
+	m.organizers = append(m.organizers, organizerID)
+	return nil
+}
+
+func TestService_Request(t *testing.T) {
+	ctx := context.Background()
+	organizerID := uuid.New()
+	newOrganizerID := uuid.New()
+	eventID := uuid.New()
+	e := &event.Event{ID: eventID, OrganizerID: organizerID, Title: "Summer Concert"}
+
+	t.Run("only the organizer or an admin can transfer", func(t *testing.T) {
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(e, nil)
+		service := NewService(new(MockRepository), eventService, nil)
+
+		_, err := service.Request(ctx, Actor{UserID: uuid.New()}, eventID, "new@example.com")
+		assert.Equal(t, ErrNotOrganizer, err)
+	})
+
+	t.Run("cannot transfer to the current organizer", func(t *testing.T) {
+		repo := new(MockRepository)
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(e, nil)
+		repo.On("FindOrganizerByEmail", ctx, "me@example.com").Return(organizerID, nil)
+		service := NewService(repo, eventService, nil)
+
+		_, err := service.Request(ctx, Actor{UserID: uuid.New(), IsAdmin: true}, eventID, "me@example.com")
+		assert.Equal(t, ErrSameOrganizer, err)
+	})
+
+	t.Run("creates a pending transfer with its audit entry", func(t *testing.T) {
+		repo := new(MockRepository)
+		eventService := new(MockEventService)
+		eventService.On("GetEventByID", ctx, eventID).Return(e, nil)
+		repo.On("FindOrganizerByEmail", ctx, "new@example.com").Return(newOrganizerID, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*transfer.Transfer"), mock.MatchedBy(func(entry *AuditEntry) bool {
+			return entry.Action == ActionRequested && entry.ActorID == organizerID
+		})).Return(nil)
+		service := NewService(repo, eventService, nil)
+
+		transfer, err := service.Request(ctx, Actor{UserID: organizerID}, eventID, " new@example.com ")
+		require.NoError(t, err)
+		assert.Equal(t, StatusPending, transfer.Status)
+		assert.Equal(t, organizerID, transfer.FromOrganizerID)
+		assert.Equal(t, newOrganizerID, transfer.ToOrganizerID)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestService_Accept(t *testing.T) {
+	ctx := context.Background()
+	fromID := uuid.New()
+	toID := uuid.New()
+	eventID := uuid.New()
+	venueID := uuid.New()
+	transferID := uuid.New()
+	pending := func() *Transfer {
+		return &Transfer{ID: transferID, EventID: eventID, FromOrganizerID: fromID, ToOrganizerID: toID, Status: StatusPending}
+	}
+
+	t.Run("transfers offered to other users are not found", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetByID", ctx, transferID).Return(pending(), nil)
+		service := NewService(repo, new(MockEventService), nil)
+
+		_, err := service.Accept(ctx, fromID, transferID)
+		assert.Equal(t, ErrTransferNotFound, err)
+		repo.AssertNotCalled(t, "Accept", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("decided transfers cannot be accepted", func(t *testing.T) {
+		declined := pending()
+		declined.Status = StatusDeclined
+		repo := new(MockRepository)
+		repo.On("GetByID", ctx, transferID).Return(declined, nil)
+		service := NewService(repo, new(MockEventService), nil)
+
+		_, err := service.Accept(ctx, toID, transferID)
+		assert.Equal(t, ErrNotPending, err)
+	})
+
+	t.Run("accepts and invalidates caches of both organizers", func(t *testing.T) {
+		repo := new(MockRepository)
+		eventService := new(MockEventService)
+		caches := &MockCaches{}
+		repo.On("GetByID", ctx, transferID).Return(pending(), nil)
+		repo.On("Accept", ctx, mock.AnythingOfType("*transfer.Transfer"), mock.MatchedBy(func(entry *AuditEntry) bool {
+			return entry.Action == ActionAccepted && entry.ActorID == toID
+		})).Return(nil)
+		eventService.On("GetEventByID", ctx, eventID).Return(&event.Event{ID: eventID, VenueID: venueID, OrganizerID: toID}, nil)
+		service := NewService(repo, eventService, caches)
+
+		transfer, err := service.Accept(ctx, toID, transferID)
+		require.NoError(t, err)
+		assert.Equal(t, StatusAccepted, transfer.Status)
+		assert.NotNil(t, transfer.RespondedAt)
+		assert.ElementsMatch(t, []uuid.UUID{fromID, toID}, caches.organizers)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestService_Cancel(t *testing.T) {
+	ctx := context.Background()
+	fromID := uuid.New()
+	toID := uuid.New()
+	transferID := uuid.New()
+	pending := &Transfer{ID: transferID, EventID: uuid.New(), FromOrganizerID: fromID, ToOrganizerID: toID, Status: StatusPending}
+
+	tests := []struct {
+		name        string
+		actor       Actor
+		expectedErr error
+	}{
+		{name: "organizer", actor: Actor{UserID: fromID}},
+		{name: "admin", actor: Actor{UserID: uuid.New(), IsAdmin: true}},
+		{name: "new organizer must decline instead", actor: Actor{UserID: toID}, expectedErr: ErrNotOrganizer},
+		{name: "unrelated user", actor: Actor{UserID: uuid.New()}, expectedErr: ErrTransferNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfer := *pending
+			repo := new(MockRepository)
+			repo.On("GetByID", ctx, transferID).Return(&transfer, nil)
+			repo.On("Close", ctx, mock.AnythingOfType("*transfer.Transfer"), mock.AnythingOfType("*transfer.AuditEntry")).Return(nil)
+			service := NewService(repo, new(MockEventService), nil)
+
+			result, err := service.Cancel(ctx, tt.actor, transferID)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				repo.AssertNotCalled(t, "Close", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, StatusCancelled, result.Status)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package transfer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Transfer statuses
+const (
+	StatusPending   = "PENDING"   // Waiting for the new organizer to accept
+	StatusAccepted  = "ACCEPTED"  // The event changed hands
+	StatusDeclined  = "DECLINED"  // Declined by the new organizer
+	StatusCancelled = "CANCELLED" // Withdrawn by the organizer or an admin
+)
+
+// Audit actions recorded for every change of a transfer
+const (
+	ActionRequested = "REQUESTED"
+	ActionAccepted  = "ACCEPTED"
+	ActionDeclined  = "DECLINED"
+	ActionCancelled = "CANCELLED"
+)
+
+// Transfer hands an event from its organizer to another organizer once they accept
+type Transfer struct {
+	ID              uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	EventID         uuid.UUID  `gorm:"not null;type:uuid" json:"event_id"`
+	FromOrganizerID uuid.UUID  `gorm:"not null;type:uuid" json:"from_organizer_id"`
+	ToOrganizerID   uuid.UUID  `gorm:"not null;type:uuid" json:"to_organizer_id"`
+	Status          string     `gorm:"not null;size:20;default:'PENDING'" json:"status"`
+	RequestedBy     uuid.UUID  `gorm:"not null;type:uuid" json:"requested_by"` // The organizer or an admin
+	RespondedAt     *time.Time `json:"responded_at,omitempty"`                 // When the transfer stopped being pending
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+
+	// EventTitle of the transferred event, filled in by listing queries
+	EventTitle string `gorm:"->;-:migration" json:"event_title,omitempty"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Transfer) TableName() string {
+	return "event_transfers"
+}
+
+// IsPending checks if the transfer still waits for the new organizer
+func (t *Transfer) IsPending() bool {
+	return t.Status == StatusPending
+}
+
+// AuditEntry records one change of a transfer: who made it and when
+// Entries outlive the transfer's status, so the event's ownership history can always be traced.
+type AuditEntry struct {
+	ID         uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	TransferID uuid.UUID `gorm:"not null;type:uuid" json:"transfer_id"`
+	EventID    uuid.UUID `gorm:"not null;type:uuid" json:"event_id"`
+	Action     string    `gorm:"not null;size:20" json:"action"`
+	ActorID    uuid.UUID `gorm:"not null;type:uuid" json:"actor_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (AuditEntry) TableName() string {
+	return "event_transfer_audit_log"
+}
+
+// Actor identifies the user acting on a transfer
+type Actor struct {
+	UserID  uuid.UUID
+	IsAdmin bool // Admins may hand over and withdraw transfers of any event
+}
@@ -0,0 +1,22 @@
+package trending
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository keeps the decaying scores of events
+type Repository interface {
+	// Increment adds 1 to an event's count of signal and weight to its score
+	Increment(ctx context.Context, eventID uuid.UUID, signal Signal, weight float64) error
+
+	// Top returns up to limit events with the highest scores, highest first
+	Top(ctx context.Context, limit int) ([]*Score, error)
+
+	// Decay halves scores and counts for every halfLife passed since the previous decay and
+	// drops events whose score fell below floor. The first call only records now.
+	// Decaying by the time actually passed keeps instances sharing the scores from decaying them twice.
+	Decay(ctx context.Context, now time.Time, halfLife time.Duration, floor float64) error
+}
@@ -0,0 +1,125 @@
+package trending
+
+import (
+	"context"
+	"time"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+
+	"github.com/google/uuid"
+)
+
+// Page sizes of GetTrending
+const (
+	DefaultLimit = 10
+	MaxLimit     = 50
+)
+
+// minScore is the score below which a decayed event is forgotten, about seven half-lives after a single view
+const minScore = 0.01
+
+// Config holds the weights and decay of trending scores
+type Config struct {
+	HalfLife       time.Duration // Time after which a view or purchase counts half as much
+	ViewWeight     float64       // Score of an event page view
+	PurchaseWeight float64       // Score of a placed order
+}
+
+// Service defines the business logic interface for trending events
+type Service interface {
+	// RecordView counts a view of an event's page
+	RecordView(ctx context.Context, eventID uuid.UUID) error
+
+	// RecordPurchase counts an order placed for an event
+	RecordPurchase(ctx context.Context, eventID uuid.UUID) error
+
+	// GetTrending returns up to limit active upcoming events with the highest scores, highest first
+	GetTrending(ctx context.Context, limit int) ([]*Trend, error)
+
+	// Decay lowers every score by the time passed since the previous decay
+	Decay(ctx context.Context, now time.Time) error
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repository   Repository
+	eventService event.Service
+	config       Config
+	clock        clock.Clock // Tells the time the trending window ends at
+}
+
+// ServiceOption configures optional trending Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock ranks events over the window ending at the time c tells instead of now
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// NewService creates a new trending service instance
+func NewService(repository Repository, eventService event.Service, config Config, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repository:   repository,
+		eventService: eventService,
+		config:       config,
+		clock:        clock.System{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// RecordView counts a view of an event's page
+func (s *serviceImpl) RecordView(ctx context.Context, eventID uuid.UUID) error {
+	return s.repository.Increment(ctx, eventID, SignalView, s.config.ViewWeight)
+}
+
+// RecordPurchase counts an order placed for an event
+func (s *serviceImpl) RecordPurchase(ctx context.Context, eventID uuid.UUID) error {
+	return s.repository.Increment(ctx, eventID, SignalPurchase, s.config.PurchaseWeight)
+}
+
+// GetTrending returns up to limit active upcoming events with the highest scores, highest first
+// Scores of past, cancelled and deleted events linger until they decay, so twice as many
+// candidates as needed are read to fill the page.
+func (s *serviceImpl) GetTrending(ctx context.Context, limit int) ([]*Trend, error) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	scores, err := s.repository.Top(ctx, 2*limit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	trends := make([]*Trend, 0, limit)
+	for _, score := range scores {
+		e, err := s.eventService.GetEventByID(ctx, score.EventID)
+		if event.IsEventNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsActive() || !e.EventDate.After(now) || e.IsFlagged() {
+			continue
+		}
+
+		trends = append(trends, &Trend{Event: e, Score: *score})
+		if len(trends) == limit {
+			break
+		}
+	}
+	return trends, nil
+}
+
+// Decay lowers every score by the time passed since the previous decay
+func (s *serviceImpl) Decay(ctx context.Context, now time.Time) error {
+	return s.repository.Decay(ctx, now, s.config.HalfLife, minScore)
+}
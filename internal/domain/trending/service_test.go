@@ -0,0 +1,122 @@
+package trending_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/trending"
+	"enterprise-crud/internal/fixtures"
+	"enterprise-crud/internal/infrastructure/memory"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = trending.Config{HalfLife: 24 * time.Hour, ViewWeight: 1, PurchaseWeight: 10}
+
+// newFixtureService returns a trending service over fresh fixture events and empty scores
+func newFixtureService() trending.Service {
+	store := memory.NewStore(fixtures.Default(time.Now()))
+	eventService := event.NewService(memory.NewEventRepository(store), memory.NewVenueRepository(store), nil, nil)
+	return trending.NewService(memory.NewTrendingRepository(), eventService, testConfig)
+}
+
+// trendIDs lists the events of trends in order
+func trendIDs(trends []*trending.Trend) []uuid.UUID {
+	ids := make([]uuid.UUID, len(trends))
+	for i, t := range trends {
+		ids[i] = t.Event.ID
+	}
+	return ids
+}
+
+func TestService_GetTrending(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ranks purchases above views", func(t *testing.T) {
+		service := newFixtureService()
+		for range 5 {
+			require.NoError(t, service.RecordView(ctx, fixtures.ConcertID))
+		}
+		require.NoError(t, service.RecordView(ctx, fixtures.WorkshopID))
+		require.NoError(t, service.RecordPurchase(ctx, fixtures.WorkshopID))
+
+		trends, err := service.GetTrending(ctx, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{fixtures.WorkshopID, fixtures.ConcertID}, trendIDs(trends))
+		assert.Equal(t, trending.Score{EventID: fixtures.WorkshopID, Score: 11, Views: 1, Purchases: 1}, trends[0].Score)
+		assert.Equal(t, trending.Score{EventID: fixtures.ConcertID, Score: 5, Views: 5}, trends[1].Score)
+	})
+
+	t.Run("leaves out past and unknown events", func(t *testing.T) {
+		service := newFixtureService()
+		require.NoError(t, service.RecordPurchase(ctx, fixtures.PastID))
+		require.NoError(t, service.RecordPurchase(ctx, uuid.New()))
+		require.NoError(t, service.RecordView(ctx, fixtures.ConcertID))
+
+		trends, err := service.GetTrending(ctx, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{fixtures.ConcertID}, trendIDs(trends))
+	})
+
+	t.Run("limits the results", func(t *testing.T) {
+		service := newFixtureService()
+		require.NoError(t, service.RecordView(ctx, fixtures.ConcertID))
+		require.NoError(t, service.RecordPurchase(ctx, fixtures.WorkshopID))
+
+		trends, err := service.GetTrending(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{fixtures.WorkshopID}, trendIDs(trends))
+	})
+}
+
+func TestService_Decay(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now()
+	service := newFixtureService()
+	require.NoError(t, service.RecordPurchase(ctx, fixtures.ConcertID))
+	require.NoError(t, service.RecordView(ctx, fixtures.WorkshopID))
+
+	// The first decay only marks the time
+	require.NoError(t, service.Decay(ctx, start))
+	trends, err := service.GetTrending(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, trends, 2)
+	assert.Equal(t, 10.0, trends[0].Score.Score)
+
+	require.NoError(t, service.Decay(ctx, start.Add(24*time.Hour)))
+	trends, err = service.GetTrending(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, trends, 2)
+	assert.InDelta(t, 5.0, trends[0].Score.Score, 1e-9)
+	assert.InDelta(t, 0.5, trends[0].Purchases, 1e-9)
+	assert.InDelta(t, 0.5, trends[1].Score.Score, 1e-9)
+
+	// A week on the single view is forgotten, while the purchase still counts
+	require.NoError(t, service.Decay(ctx, start.Add(8*24*time.Hour)))
+	trends, err = service.GetTrending(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{fixtures.ConcertID}, trendIDs(trends))
+}
+
+func TestSubscribe_CountsPlacedOrders(t *testing.T) {
+	ctx := context.Background()
+	service := newFixtureService()
+	bus := eventbus.New()
+	trending.Subscribe(bus, service)
+
+	bus.Publish(ctx, order.OrderPlaced{OrderID: uuid.New(), UserID: fixtures.UserID, EventID: fixtures.ConcertID, Quantity: 4, Status: order.StatusPending})
+
+	trends, err := service.GetTrending(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, trends, 1)
+	assert.Equal(t, 1.0, trends[0].Purchases)
+}
@@ -0,0 +1,17 @@
+package trending
+
+import (
+	"context"
+
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/order"
+)
+
+// Subscribe registers the handler that counts placed orders as purchases
+// Orders held for review count too: they took tickets, which is what makes an event trend.
+func Subscribe(bus *eventbus.Bus, service Service) {
+	bus.Subscribe(order.TopicOrderPlaced, func(ctx context.Context, e eventbus.Event) error {
+		placed := e.(order.OrderPlaced)
+		return service.RecordPurchase(ctx, placed.EventID)
+	})
+}
@@ -0,0 +1,33 @@
+// Package trending ranks events by their recent page views and purchases
+// Every view and placed order raises an event's score; a periodic decay halves scores every
+// half-life, so events people stopped looking at drop out of the list.
+package trending
+
+import (
+	"enterprise-crud/internal/domain/event"
+
+	"github.com/google/uuid"
+)
+
+// Signal is a kind of activity that makes an event trend
+type Signal string
+
+// Signals counted per event
+const (
+	SignalView     Signal = "views"
+	SignalPurchase Signal = "purchases"
+)
+
+// Score is an event's decayed trending score with the decayed counts of each signal behind it
+type Score struct {
+	EventID   uuid.UUID
+	Score     float64
+	Views     float64
+	Purchases float64
+}
+
+// Trend is a trending event with its score
+type Trend struct {
+	Event *event.Event
+	Score
+}
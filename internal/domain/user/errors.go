@@ -1,7 +1,11 @@
 package user
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+
+	"enterprise-crud/internal/domain/errcode"
 )
 
 // UserError represents domain-specific user errors
@@ -31,6 +35,14 @@ var (
 	ErrUserCreationFailed  = &UserError{Code: "USER_CREATION_FAILED", Message: "failed to create user"}
 	ErrUserRetrievalFailed = &UserError{Code: "USER_RETRIEVAL_FAILED", Message: "failed to retrieve user"}
 	ErrRoleRetrievalFailed = &UserError{Code: "ROLE_RETRIEVAL_FAILED", Message: "failed to retrieve user role"}
+	ErrRoleNotFound        = &UserError{Code: "ROLE_NOT_FOUND", Message: "role not found"}
+	ErrRoleUpdateFailed    = &UserError{Code: "ROLE_UPDATE_FAILED", Message: "failed to update user roles"}
+	ErrStatusUpdateFailed  = &UserError{Code: "STATUS_UPDATE_FAILED", Message: "failed to update account status"}
+	ErrInvalidStatus       = &UserError{Code: "INVALID_STATUS", Message: "status must be ACTIVE, SUSPENDED or BANNED"}
+	ErrReasonRequired      = &UserError{Code: "REASON_REQUIRED", Message: "a reason is required to suspend or ban an account"}
+	ErrOwnStatusChange     = &UserError{Code: "OWN_STATUS_CHANGE", Message: "you cannot change the status of your own account"}
+	ErrInvalidDateOfBirth  = &UserError{Code: "INVALID_DATE_OF_BIRTH", Message: "date of birth must be in the past and no earlier than 1900-01-01"}
+	ErrProfileUpdateFailed = &UserError{Code: "PROFILE_UPDATE_FAILED", Message: "failed to update profile"}
 )
 
 // NewUserError creates a new UserError with a cause
@@ -49,3 +61,66 @@ func NewUserExistsError(email string) *UserError {
 		Message: fmt.Sprintf("user with email %s already exists", email),
 	}
 }
+
+// NewUsernameExistsError creates a specific error for a username that is already taken
+func NewUsernameExistsError(username string) *UserError {
+	return &UserError{
+		Code:    "USERNAME_EXISTS",
+		Message: fmt.Sprintf("username %s is already taken", username),
+	}
+}
+
+// NewAccountBlockedError creates an error for a suspended or banned account
+// The reason is shown to the user so they know why and whom to contact.
+func NewAccountBlockedError(status, reason string) *UserError {
+	message := "account is " + strings.ToLower(status)
+	if reason != "" {
+		message += ": " + reason
+	}
+	return &UserError{
+		Code:    "ACCOUNT_" + status,
+		Message: message,
+	}
+}
+
+// IsAccountBlockedError checks if an error reports a suspended or banned account
+func IsAccountBlockedError(err error) bool {
+	var userErr *UserError
+	if !errors.As(err, &userErr) {
+		return false
+	}
+	return userErr.Code == "ACCOUNT_"+StatusSuspended || userErr.Code == "ACCOUNT_"+StatusBanned
+}
+
+// GetUserErrorCode extracts the error code from a user error
+func GetUserErrorCode(err error) string {
+	var userErr *UserError
+	if errors.As(err, &userErr) {
+		return userErr.Code
+	}
+	return ""
+}
+
+// ErrorCodes describes every code user errors carry, for the API's error catalogue
+func ErrorCodes() []errcode.Code {
+	return []errcode.Code{
+		{Code: ErrUserNotFound.Code, Kind: errcode.NotFound, Description: ErrUserNotFound.Message},
+		{Code: ErrUserAlreadyExists.Code, Kind: errcode.Conflict, Description: "a user with this email already exists"},
+		{Code: "USERNAME_EXISTS", Kind: errcode.Conflict, Description: "the username is already taken"},
+		{Code: ErrInvalidCredentials.Code, Kind: errcode.Unauthenticated, Description: ErrInvalidCredentials.Message},
+		{Code: ErrPasswordHashFailed.Code, Kind: errcode.Internal, Description: ErrPasswordHashFailed.Message},
+		{Code: ErrUserCreationFailed.Code, Kind: errcode.Internal, Description: ErrUserCreationFailed.Message},
+		{Code: ErrUserRetrievalFailed.Code, Kind: errcode.Internal, Description: ErrUserRetrievalFailed.Message},
+		{Code: ErrRoleRetrievalFailed.Code, Kind: errcode.Internal, Description: ErrRoleRetrievalFailed.Message},
+		{Code: ErrRoleNotFound.Code, Kind: errcode.NotFound, Description: ErrRoleNotFound.Message},
+		{Code: ErrRoleUpdateFailed.Code, Kind: errcode.Internal, Description: ErrRoleUpdateFailed.Message},
+		{Code: ErrStatusUpdateFailed.Code, Kind: errcode.Internal, Description: ErrStatusUpdateFailed.Message},
+		{Code: ErrInvalidStatus.Code, Kind: errcode.Invalid, Description: ErrInvalidStatus.Message},
+		{Code: ErrReasonRequired.Code, Kind: errcode.Invalid, Description: ErrReasonRequired.Message},
+		{Code: ErrOwnStatusChange.Code, Kind: errcode.Invalid, Description: ErrOwnStatusChange.Message},
+		{Code: ErrInvalidDateOfBirth.Code, Kind: errcode.Invalid, Description: ErrInvalidDateOfBirth.Message},
+		{Code: ErrProfileUpdateFailed.Code, Kind: errcode.Internal, Description: ErrProfileUpdateFailed.Message},
+		{Code: "ACCOUNT_" + StatusSuspended, Kind: errcode.Forbidden, Description: "the account is suspended; the message gives the reason"},
+		{Code: "ACCOUNT_" + StatusBanned, Kind: errcode.Forbidden, Description: "the account is banned; the message gives the reason"},
+	}
+}
@@ -0,0 +1,180 @@
+package user
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Password hashing algorithms, as recorded with each user's hash
+const (
+	AlgorithmBcrypt   = "bcrypt"
+	AlgorithmArgon2id = "argon2id"
+)
+
+// Default hashing parameters
+// The argon2id defaults follow the OWASP recommendation of 19 MiB, two passes and one thread.
+const (
+	DefaultBcryptCost      = bcrypt.DefaultCost
+	DefaultArgon2Time      = 2
+	DefaultArgon2MemoryKiB = 19 * 1024
+	DefaultArgon2Threads   = 1
+)
+
+// argon2id salt and key lengths in bytes
+const (
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+// PasswordHasher hashes passwords for storage and checks passwords against stored hashes
+type PasswordHasher interface {
+	Algorithm() string                    // Name recorded with the hashes it makes
+	Hash(password string) (string, error) // Hashes a password with a fresh salt
+	Verify(hash, password string) bool    // Reports whether password matches a hash of this algorithm
+	NeedsRehash(hash string) bool         // Reports whether a hash of this algorithm was made with other parameters than configured
+}
+
+// BcryptHasher hashes passwords with bcrypt
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher creates a bcrypt hasher with the given work factor
+func NewBcryptHasher(cost int) (*BcryptHasher, error) {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("bcrypt cost must be between %d and %d, got %d", bcrypt.MinCost, bcrypt.MaxCost, cost)
+	}
+	return &BcryptHasher{cost: cost}, nil
+}
+
+// Algorithm returns "bcrypt"
+func (h *BcryptHasher) Algorithm() string {
+	return AlgorithmBcrypt
+}
+
+// Hash hashes a password with the configured cost
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// Verify reports whether password matches a bcrypt hash of any cost
+// bcrypt.CompareHashAndPassword compares in constant time.
+func (h *BcryptHasher) Verify(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// NeedsRehash reports whether a hash was made with another cost, or cannot be read
+func (h *BcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.cost
+}
+
+// Argon2idHasher hashes passwords with argon2id
+// Hashes are stored in the PHC string format, e.g. $argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>,
+// so they can be verified after the parameters change.
+type Argon2idHasher struct {
+	params argon2Params
+}
+
+// argon2Params are the cost parameters of an argon2id hash
+type argon2Params struct {
+	time      uint32 // Passes over memory
+	memoryKiB uint32 // Memory used in KiB
+	threads   uint8  // Degree of parallelism
+}
+
+// NewArgon2idHasher creates an argon2id hasher with the given parameters
+func NewArgon2idHasher(time, memoryKiB uint32, threads uint8) (*Argon2idHasher, error) {
+	if time < 1 || threads < 1 {
+		return nil, fmt.Errorf("argon2id time and threads must be at least 1, got %d and %d", time, threads)
+	}
+	// argon2 needs at least 8 KiB of memory per thread
+	if memoryKiB < 8*uint32(threads) {
+		return nil, fmt.Errorf("argon2id memory must be at least %d KiB for %d threads, got %d", 8*uint32(threads), threads, memoryKiB)
+	}
+	return &Argon2idHasher{params: argon2Params{time: time, memoryKiB: memoryKiB, threads: threads}}, nil
+}
+
+// Algorithm returns "argon2id"
+func (h *Argon2idHasher) Algorithm() string {
+	return AlgorithmArgon2id
+}
+
+// Hash hashes a password with the configured parameters and a random salt
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	p := h.params
+	key := argon2.IDKey([]byte(password), salt, p.time, p.memoryKiB, p.threads, argon2KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, p.memoryKiB, p.time, p.threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify reports whether password matches an argon2id hash, using the parameters stored in it
+func (h *Argon2idHasher) Verify(hash, password string) bool {
+	p, salt, key, err := parseArgon2id(hash)
+	if err != nil {
+		return false
+	}
+	actual := argon2.IDKey([]byte(password), salt, p.time, p.memoryKiB, p.threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(actual, key) == 1
+}
+
+// NeedsRehash reports whether a hash was made with other parameters, or cannot be read
+func (h *Argon2idHasher) NeedsRehash(hash string) bool {
+	p, _, _, err := parseArgon2id(hash)
+	return err != nil || p != h.params
+}
+
+// parseArgon2id splits a PHC-formatted argon2id hash into its parameters, salt and key
+func parseArgon2id(hash string) (argon2Params, []byte, []byte, error) {
+	var p argon2Params
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != AlgorithmArgon2id {
+		return p, nil, nil, fmt.Errorf("not an argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, fmt.Errorf("unsupported argon2 version %q", parts[2])
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memoryKiB, &p.time, &p.threads); err != nil {
+		return p, nil, nil, fmt.Errorf("invalid argon2id parameters %q: %w", parts[3], err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("invalid argon2id salt: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return p, nil, nil, fmt.Errorf("invalid argon2id key")
+	}
+	return p, salt, key, nil
+}
+
+// verifierFor returns a hasher able to verify hashes of the given algorithm
+// Verification reads the parameters from the hash itself, so the defaults do for algorithms
+// other than the configured one; users hashed before algorithms were recorded use bcrypt.
+func verifierFor(algorithm string) (PasswordHasher, bool) {
+	switch algorithm {
+	case AlgorithmBcrypt, "":
+		return &BcryptHasher{cost: DefaultBcryptCost}, true
+	case AlgorithmArgon2id:
+		return &Argon2idHasher{params: argon2Params{time: DefaultArgon2Time, memoryKiB: DefaultArgon2MemoryKiB, threads: DefaultArgon2Threads}}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestBcryptHasher(t *testing.T) {
+	hasher, err := NewBcryptHasher(bcrypt.MinCost)
+	require.NoError(t, err)
+
+	hash, err := hasher.Hash("password123")
+	require.NoError(t, err)
+	assert.True(t, hasher.Verify(hash, "password123"))
+	assert.False(t, hasher.Verify(hash, "password124"))
+	assert.False(t, hasher.NeedsRehash(hash))
+
+	stronger, err := NewBcryptHasher(bcrypt.MinCost + 1)
+	require.NoError(t, err)
+	assert.True(t, stronger.Verify(hash, "password123"), "hashes of any cost are verified")
+	assert.True(t, stronger.NeedsRehash(hash))
+	assert.True(t, stronger.NeedsRehash("not a hash"))
+
+	_, err = NewBcryptHasher(bcrypt.MaxCost + 1)
+	assert.Error(t, err)
+}
+
+func TestArgon2idHasher(t *testing.T) {
+	hasher, err := NewArgon2idHasher(1, 64, 1)
+	require.NoError(t, err)
+
+	hash, err := hasher.Hash("password123")
+	require.NoError(t, err)
+	assert.Regexp(t, `^\$argon2id\$v=19\$m=64,t=1,p=1\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{43}$`, hash)
+	assert.True(t, hasher.Verify(hash, "password123"))
+	assert.False(t, hasher.Verify(hash, "password124"))
+	assert.False(t, hasher.NeedsRehash(hash))
+
+	other, _ := hasher.Hash("password123")
+	assert.NotEqual(t, hash, other, "every hash has its own salt")
+
+	stronger, err := NewArgon2idHasher(2, 128, 1)
+	require.NoError(t, err)
+	assert.True(t, stronger.Verify(hash, "password123"), "hashes are verified with their own parameters")
+	assert.True(t, stronger.NeedsRehash(hash))
+
+	for _, invalid := range []string{"", "$argon2id$v=18$m=64,t=1,p=1$c2FsdA$a2V5", "$argon2i$v=19$m=64,t=1,p=1$c2FsdA$a2V5", "$argon2id$v=19$m=64$c2FsdA$a2V5"} {
+		assert.False(t, hasher.Verify(invalid, "password123"), invalid)
+		assert.True(t, hasher.NeedsRehash(invalid), invalid)
+	}
+
+	_, err = NewArgon2idHasher(1, 4, 1)
+	assert.Error(t, err, "argon2 needs 8 KiB per thread")
+	_, err = NewArgon2idHasher(0, 64, 1)
+	assert.Error(t, err)
+}
@@ -1,11 +1,30 @@
 package user
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"enterprise-crud/internal/domain/role"
+
+	"github.com/google/uuid"
+)
 
 // Repository defines the data access interface for user operations
 // This is the repository pattern similar to Spring Data JPA repositories
 // Abstracts database operations and provides a clean interface for data access
 type Repository interface {
-	Create(ctx context.Context, user *User) error                // Persists a new user to the database
-	GetByEmail(ctx context.Context, email string) (*User, error) // Retrieves a user by their email address
+	Create(ctx context.Context, user *User) error                      // Persists a new user to the database
+	GetByEmail(ctx context.Context, email string) (*User, error)       // Retrieves a user by their email address, ignoring case
+	GetByUsername(ctx context.Context, username string) (*User, error) // Retrieves a user by their username
+
+	AddRole(ctx context.Context, userID uuid.UUID, r *role.Role) error    // Grants a role to a user
+	RemoveRole(ctx context.Context, userID uuid.UUID, r *role.Role) error // Revokes a role from a user
+
+	GetByID(ctx context.Context, id uuid.UUID) (*User, error)                         // Retrieves a user by ID with their roles
+	GetStatus(ctx context.Context, id uuid.UUID) (*User, error)                       // Retrieves only a user's ID and status fields, for checks on every request
+	UpdateStatus(ctx context.Context, change *StatusChange) error                     // Sets a user's status and records the change
+	ListStatusChanges(ctx context.Context, userID uuid.UUID) ([]*StatusChange, error) // Retrieves a user's status changes, newest first
+
+	SetDateOfBirth(ctx context.Context, id uuid.UUID, dateOfBirth *time.Time) error // Sets or, with nil, removes a user's date of birth
+	UpdatePassword(ctx context.Context, id uuid.UUID, hash, algorithm string) error // Replaces a user's password hash and the algorithm it was made with
 }
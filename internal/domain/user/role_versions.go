@@ -0,0 +1,14 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// RoleVersions counts the role changes of each user in a store every instance shares
+// Tokens carry the count they were issued at, so ones issued before a change can be told apart.
+type RoleVersions interface {
+	Current(ctx context.Context, userID uuid.UUID) (int64, error) // 0 for users whose roles never changed
+	Bump(ctx context.Context, userID uuid.UUID) (int64, error)    // Counts a change and returns the new version
+}
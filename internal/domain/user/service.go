@@ -2,11 +2,14 @@ package user
 
 import (
 	"context"
+	"enterprise-crud/internal/domain/clock"
 	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/logging"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
@@ -16,24 +19,80 @@ import (
 type Service interface {
 	CreateUser(ctx context.Context, email, username, password string) (*User, error) // Creates a new user with validation and password hashing
 	GetUserByEmail(ctx context.Context, email string) (*User, error)                 // Retrieves a user by email with business logic
+	IsUsernameAvailable(ctx context.Context, username string) (bool, error)          // Reports whether no user has taken the username yet
 	AuthenticateUser(ctx context.Context, email, password string) (*User, error)     // Authenticates user with email and password
+	GrantRole(ctx context.Context, email, roleName string) (*User, error)            // Adds a role to a user; granting a role the user has is a no-op
+	RevokeRole(ctx context.Context, email, roleName string) (*User, error)           // Removes a role from a user; revoking a missing role is a no-op
+	RolesVersion(ctx context.Context, userID uuid.UUID) (int64, error)               // Counts the user's role changes, so tokens issued before one can be spotted
+
+	// Account status lifecycle
+	GetUserByID(ctx context.Context, id uuid.UUID) (*User, error)                                                     // Retrieves a user by ID
+	SetAccountStatus(ctx context.Context, userID uuid.UUID, status, reason string, actorID *uuid.UUID) (*User, error) // Suspends, bans or reactivates a user; a nil actor marks an automatic change
+	CheckAccountStatus(ctx context.Context, userID uuid.UUID) error                                                   // Returns an account blocked error for suspended or banned users
+	GetStatusHistory(ctx context.Context, userID uuid.UUID) ([]*StatusChange, error)                                  // Lists a user's status changes, newest first
+
+	// Profile
+	SetDateOfBirth(ctx context.Context, userID uuid.UUID, dateOfBirth *time.Time) (*User, error) // Sets or, with nil, removes the user's date of birth
+	DateOfBirth(ctx context.Context, userID uuid.UUID) (*time.Time, error)                       // Returns the user's date of birth, nil when not given
 }
 
 // userService implements the Service interface
 // This is the concrete implementation of business logic, similar to Spring Boot's @Service classes
 // Encapsulates all user-related business operations and rules
 type userService struct {
-	repo     Repository      // Repository dependency for data persistence - similar to @Autowired in Spring
-	roleRepo role.Repository // Role repository to assign default roles to users
+	repo     Repository        // Repository dependency for data persistence - similar to @Autowired in Spring
+	roleRepo role.Repository   // Role repository to assign default roles to users
+	clock    clock.Clock       // Stamps status changes and bounds dates of birth
+	ids      clock.IDGenerator // Creates the IDs of new users and status changes
+	hasher   PasswordHasher    // Hashes new passwords; older hashes are replaced with its hashes at login
+	versions RoleVersions      // Counts role changes for issued tokens; nil keeps every version at 0
+}
+
+// ServiceOption configures optional userService behaviour
+type ServiceOption func(*userService)
+
+// WithClock reads the time from c instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *userService) {
+		s.clock = c
+	}
+}
+
+// WithIDGenerator creates the IDs of new users and status changes with ids instead of at random
+func WithIDGenerator(ids clock.IDGenerator) ServiceOption {
+	return func(s *userService) {
+		s.ids = ids
+	}
+}
+
+// WithPasswordHasher hashes passwords with hasher instead of bcrypt at the default cost
+func WithPasswordHasher(hasher PasswordHasher) ServiceOption {
+	return func(s *userService) {
+		s.hasher = hasher
+	}
+}
+
+// WithRoleVersions counts role changes in versions, so tokens issued before a change pick it up
+func WithRoleVersions(versions RoleVersions) ServiceOption {
+	return func(s *userService) {
+		s.versions = versions
+	}
 }
 
 // NewUserService creates a new instance of userService
 // Returns a service implementation for user business logic
-func NewUserService(repo Repository, roleRepo role.Repository) Service {
-	return &userService{
+func NewUserService(repo Repository, roleRepo role.Repository, opts ...ServiceOption) Service {
+	s := &userService{
 		repo:     repo,
 		roleRepo: roleRepo,
+		clock:    clock.System{},
+		ids:      clock.RandomIDs{},
+		hasher:   &BcryptHasher{cost: DefaultBcryptCost},
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // CreateUser creates a new user with the provided information
@@ -54,6 +113,8 @@ func NewUserService(repo Repository, roleRepo role.Repository) Service {
 //
 // Validates input, hashes password, and persists user data
 func (s *userService) CreateUser(ctx context.Context, email, username, password string) (*User, error) {
+	email = NormalizeEmail(email)
+
 	// STEP 1: BUSINESS RULE VALIDATION
 	// Check if user already exists with this email
 	// This is a business rule: "Users must have unique email addresses"
@@ -64,87 +125,360 @@ func (s *userService) CreateUser(ctx context.Context, email, username, password
 	}
 	// If error is not "record not found", it's a database error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, NewUserError(ErrUserRetrievalFailed, err)
+		return nil, repoError(err, ErrUserRetrievalFailed)
+	}
+
+	// Usernames must be unique too; the database constraint still catches concurrent registrations
+	available, err := s.IsUsernameAvailable(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if !available {
+		return nil, NewUsernameExistsError(username)
 	}
 
 	// STEP 2: SECURITY IMPLEMENTATION
-	// Hash the password for secure storage
-	// bcrypt.DefaultCost provides good security vs. performance balance
+	// Hash the password for secure storage with the configured hasher (bcrypt or argon2id)
 	// Never store plain text passwords in database
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashedPassword, err := s.hasher.Hash(password)
 	if err != nil {
 		// Return wrapped error with context
 		return nil, NewUserError(ErrPasswordHashFailed, err)
 	}
 
 	// STEP 3: GET DEFAULT USER ROLE
-	// Every new user gets the "USER" role by default
+	// Every new user gets the USER role by default
 	// This is a business rule: all registered users start as regular users
 	userRole, err := s.roleRepo.GetByName(ctx, role.RoleUser)
 	if err != nil {
-		return nil, NewUserError(ErrRoleRetrievalFailed, err)
+		return nil, repoError(err, ErrRoleRetrievalFailed)
 	}
 
 	// STEP 4: DOMAIN ENTITY CREATION
 	// Create new user entity with all required fields and default role
 	user := &User{
-		ID:       uuid.New(),             // Generate unique identifier (UUID v4)
-		Email:    email,                  // Set email address (validated by HTTP layer)
-		Username: username,               // Set username (validated by HTTP layer)
-		Password: string(hashedPassword), // Store hashed password
-		Roles:    []role.Role{*userRole}, // Assign default USER role
+		ID:                s.ids.NewID(),          // Generate unique identifier (UUID v4 unless configured otherwise)
+		Email:             email,                  // Set normalized email address (validated by HTTP layer)
+		Username:          username,               // Set username (validated by HTTP layer)
+		Password:          hashedPassword,         // Store hashed password
+		PasswordAlgorithm: s.hasher.Algorithm(),   // Record how it was hashed
+		Roles:             []role.Role{*userRole}, // Assign default USER role
 	}
 
 	// STEP 5: PERSIST USER TO DATABASE
 	// This will save both the user and the role assignment
 	if err := s.repo.Create(ctx, user); err != nil {
-		return nil, NewUserError(ErrUserCreationFailed, err)
+		// A concurrent registration may have taken the email or username since they were checked,
+		// which the repository reports as a typed error that repoError passes through
+		return nil, repoError(err, ErrUserCreationFailed)
 	}
 
 	return user, nil
 }
 
-// GetUserByEmail retrieves a user by their email address
+// GetUserByEmail retrieves a user by their email address, ignoring case
 // Returns user if found, error if not found or database error occurs
 func (s *userService) GetUserByEmail(ctx context.Context, email string) (*User, error) {
-	user, err := s.repo.GetByEmail(ctx, email)
+	user, err := s.repo.GetByEmail(ctx, NormalizeEmail(email))
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrUserNotFound
-		}
-		return nil, NewUserError(ErrUserRetrievalFailed, err)
+		return nil, repoError(err, ErrUserRetrievalFailed)
 	}
 	return user, nil
 }
 
+// IsUsernameAvailable reports whether no user has taken the username yet
+func (s *userService) IsUsernameAvailable(ctx context.Context, username string) (bool, error) {
+	_, err := s.repo.GetByUsername(ctx, username)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, repoError(err, ErrUserRetrievalFailed)
+	}
+	return false, nil
+}
+
 // AuthenticateUser validates user credentials and returns user if valid
 //
 // AUTHENTICATION FLOW:
 // 1. Retrieve user by email from database
 // 2. Compare provided password with stored hashed password
 // 3. Return user if passwords match, error if not
+// 4. Replace the hash if it was made with another algorithm or parameters than configured
 //
 // SECURITY CONSIDERATIONS:
-// - Uses bcrypt for password verification (secure against timing attacks)
+// - Verifies with the algorithm recorded for the user, comparing in constant time
 // - Never returns the hashed password to prevent exposure
 // - Provides generic error messages to prevent user enumeration
 func (s *userService) AuthenticateUser(ctx context.Context, email, password string) (*User, error) {
 	// STEP 1: GET USER BY EMAIL
-	user, err := s.repo.GetByEmail(ctx, email)
-	if err != nil {
+	user, err := s.repo.GetByEmail(ctx, NormalizeEmail(email))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
 		// Return generic error to prevent user enumeration
 		return nil, ErrInvalidCredentials
 	}
+	if err != nil {
+		// A failed lookup is an outage, not a wrong password
+		return nil, repoError(err, ErrUserRetrievalFailed)
+	}
 
 	// STEP 2: VERIFY PASSWORD
-	// bcrypt.CompareHashAndPassword is secure against timing attacks
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
-	if err != nil {
+	// Both bcrypt and argon2id verification are secure against timing attacks
+	verifier, ok := s.verifier(user.PasswordAlgorithm)
+	if !ok || !verifier.Verify(user.Password, password) {
 		// Return generic error to prevent user enumeration
 		return nil, ErrInvalidCredentials
 	}
 
-	// STEP 3: RETURN AUTHENTICATED USER
+	// STEP 3: CHECK ACCOUNT STATUS
+	// Only reported after the password matched, so the status of other accounts can't be probed
+	if !user.IsActive() {
+		return nil, NewAccountBlockedError(user.Status, user.StatusReason)
+	}
+
+	// STEP 4: UPGRADE THE HASH
+	// The plain password is only known now, so this is the one chance to rehash it
+	if s.needsRehash(user) {
+		s.rehash(ctx, user, password)
+	}
+
+	// STEP 5: RETURN AUTHENTICATED USER
 	// Password verification successful
 	return user, nil
 }
+
+// verifier returns the hasher checking hashes made with algorithm
+func (s *userService) verifier(algorithm string) (PasswordHasher, bool) {
+	if algorithm == "" {
+		algorithm = AlgorithmBcrypt
+	}
+	if algorithm == s.hasher.Algorithm() {
+		return s.hasher, true
+	}
+	return verifierFor(algorithm)
+}
+
+// needsRehash reports whether a user's hash was made with another algorithm or parameters than configured
+func (s *userService) needsRehash(user *User) bool {
+	algorithm := user.PasswordAlgorithm
+	if algorithm == "" {
+		algorithm = AlgorithmBcrypt
+	}
+	return algorithm != s.hasher.Algorithm() || s.hasher.NeedsRehash(user.Password)
+}
+
+// rehash replaces a user's hash with one made by the configured hasher
+// Failures are only logged: the login succeeded, and the next one tries again.
+func (s *userService) rehash(ctx context.Context, user *User, password string) {
+	hash, err := s.hasher.Hash(password)
+	if err == nil {
+		err = s.repo.UpdatePassword(ctx, user.ID, hash, s.hasher.Algorithm())
+	}
+	if err != nil {
+		logging.From(ctx).Warn("Failed to rehash password", "user_id", user.ID, "algorithm", s.hasher.Algorithm(), "error", err)
+		return
+	}
+	user.Password = hash
+	user.PasswordAlgorithm = s.hasher.Algorithm()
+}
+
+// GrantRole adds a role to a user
+// Roles are embedded in issued tokens: with role versions, tokens issued before the change pick
+// it up on their next request, otherwise at the user's next login.
+func (s *userService) GrantRole(ctx context.Context, email, roleName string) (*User, error) {
+	user, r, err := s.userAndRole(ctx, email, roleName)
+	if err != nil {
+		return nil, err
+	}
+	if !user.HasRole(r.Name) {
+		if err := s.repo.AddRole(ctx, user.ID, r); err != nil {
+			return nil, repoError(err, ErrRoleUpdateFailed)
+		}
+		user.Roles = append(user.Roles, *r)
+	}
+	if err := s.bumpRolesVersion(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// RevokeRole removes a role from a user
+// With role versions, tokens issued before the change lose the role on their next request;
+// otherwise they keep it until they expire.
+func (s *userService) RevokeRole(ctx context.Context, email, roleName string) (*User, error) {
+	user, r, err := s.userAndRole(ctx, email, roleName)
+	if err != nil {
+		return nil, err
+	}
+	if user.HasRole(r.Name) {
+		if err := s.repo.RemoveRole(ctx, user.ID, r); err != nil {
+			return nil, repoError(err, ErrRoleUpdateFailed)
+		}
+		roles := make([]role.Role, 0, len(user.Roles))
+		for _, existing := range user.Roles {
+			if existing.Name != r.Name {
+				roles = append(roles, existing)
+			}
+		}
+		user.Roles = roles
+	}
+	if err := s.bumpRolesVersion(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// bumpRolesVersion counts a role change of the user
+// It runs even when the change was a no-op, so repeating a change whose bump failed completes it.
+func (s *userService) bumpRolesVersion(ctx context.Context, userID uuid.UUID) error {
+	if s.versions == nil {
+		return nil
+	}
+	if _, err := s.versions.Bump(ctx, userID); err != nil {
+		return NewUserError(ErrRoleUpdateFailed, err)
+	}
+	return nil
+}
+
+// RolesVersion returns how often the user's roles changed, 0 without role versions
+func (s *userService) RolesVersion(ctx context.Context, userID uuid.UUID) (int64, error) {
+	if s.versions == nil {
+		return 0, nil
+	}
+	return s.versions.Current(ctx, userID)
+}
+
+// userAndRole looks up the user and role a role change applies to
+func (s *userService) userAndRole(ctx context.Context, email, roleName string) (*User, *role.Role, error) {
+	user, err := s.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, nil, err
+	}
+	name, ok := role.ParseName(roleName)
+	if !ok {
+		return nil, nil, ErrRoleNotFound
+	}
+	r, err := s.roleRepo.GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrRoleNotFound
+		}
+		return nil, nil, repoError(err, ErrRoleRetrievalFailed)
+	}
+	return user, r, nil
+}
+
+// GetUserByID retrieves a user by ID
+func (s *userService) GetUserByID(ctx context.Context, id uuid.UUID) (*User, error) {
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, repoError(err, ErrUserRetrievalFailed)
+	}
+	return user, nil
+}
+
+// SetAccountStatus suspends, bans or reactivates a user and records why
+// Suspended and banned users are refused at login and on every authenticated request.
+func (s *userService) SetAccountStatus(ctx context.Context, userID uuid.UUID, status, reason string, actorID *uuid.UUID) (*User, error) {
+	status = strings.ToUpper(strings.TrimSpace(status))
+	reason = strings.TrimSpace(reason)
+	switch status {
+	case StatusActive:
+	case StatusSuspended, StatusBanned:
+		if reason == "" {
+			return nil, ErrReasonRequired
+		}
+	default:
+		return nil, ErrInvalidStatus
+	}
+	if actorID != nil && *actorID == userID {
+		return nil, ErrOwnStatusChange
+	}
+
+	user, err := s.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &StatusChange{
+		ID:        s.ids.NewID(),
+		UserID:    userID,
+		Status:    status,
+		Reason:    reason,
+		ChangedBy: actorID,
+		CreatedAt: s.clock.Now(),
+	}
+	if err := s.repo.UpdateStatus(ctx, change); err != nil {
+		return nil, repoError(err, ErrStatusUpdateFailed)
+	}
+
+	user.Status = status
+	user.StatusReason = reason
+	user.StatusChangedAt = &change.CreatedAt
+	return user, nil
+}
+
+// CheckAccountStatus returns an account blocked error for suspended or banned users
+func (s *userService) CheckAccountStatus(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.repo.GetStatus(ctx, userID)
+	if err != nil {
+		return repoError(err, ErrUserRetrievalFailed)
+	}
+	if !user.IsActive() {
+		return NewAccountBlockedError(user.Status, user.StatusReason)
+	}
+	return nil
+}
+
+// GetStatusHistory lists a user's status changes, newest first
+func (s *userService) GetStatusHistory(ctx context.Context, userID uuid.UUID) ([]*StatusChange, error) {
+	changes, err := s.repo.ListStatusChanges(ctx, userID)
+	if err != nil {
+		return nil, repoError(err, ErrUserRetrievalFailed)
+	}
+	return changes, nil
+}
+
+// SetDateOfBirth sets or removes the user's date of birth
+// Only the calendar date is kept; times of day are dropped.
+func (s *userService) SetDateOfBirth(ctx context.Context, userID uuid.UUID, dateOfBirth *time.Time) (*User, error) {
+	if dateOfBirth != nil {
+		date := time.Date(dateOfBirth.Year(), dateOfBirth.Month(), dateOfBirth.Day(), 0, 0, 0, 0, time.UTC)
+		if date.Before(minDateOfBirth) || !date.Before(s.clock.Now()) {
+			return nil, ErrInvalidDateOfBirth
+		}
+		dateOfBirth = &date
+	}
+
+	if err := s.repo.SetDateOfBirth(ctx, userID, dateOfBirth); err != nil {
+		return nil, repoError(err, ErrProfileUpdateFailed)
+	}
+
+	user, err := s.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// DateOfBirth returns the user's date of birth, nil when not given
+func (s *userService) DateOfBirth(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	user, err := s.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return user.DateOfBirth, nil
+}
+
+// repoError converts a repository error into a typed user error, so callers only ever see *UserError
+// Typed errors pass through, a missing record becomes ErrUserNotFound and anything else is wrapped in fallback.
+func repoError(err error, fallback *UserError) error {
+	var userErr *UserError
+	if errors.As(err, &userErr) {
+		return userErr
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrUserNotFound
+	}
+	return NewUserError(fallback, err)
+}
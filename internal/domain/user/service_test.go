@@ -3,8 +3,12 @@ package user
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/order"
 	"enterprise-crud/internal/domain/role"
 
 	"github.com/google/uuid"
@@ -37,6 +41,72 @@ func (m *MockRepository) GetByEmail(ctx context.Context, email string) (*User, e
 	return args.Get(0).(*User), args.Error(1)
 }
 
+// GetByUsername mocks the GetByUsername method of Repository interface
+func (m *MockRepository) GetByUsername(ctx context.Context, username string) (*User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*User), args.Error(1)
+}
+
+// AddRole mocks the AddRole method of Repository interface
+func (m *MockRepository) AddRole(ctx context.Context, userID uuid.UUID, r *role.Role) error {
+	args := m.Called(ctx, userID, r)
+	return args.Error(0)
+}
+
+// RemoveRole mocks the RemoveRole method of Repository interface
+func (m *MockRepository) RemoveRole(ctx context.Context, userID uuid.UUID, r *role.Role) error {
+	args := m.Called(ctx, userID, r)
+	return args.Error(0)
+}
+
+// GetByID mocks the GetByID method of Repository interface
+func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (*User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*User), args.Error(1)
+}
+
+// GetStatus mocks the GetStatus method of Repository interface
+func (m *MockRepository) GetStatus(ctx context.Context, id uuid.UUID) (*User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*User), args.Error(1)
+}
+
+// UpdateStatus mocks the UpdateStatus method of Repository interface
+func (m *MockRepository) UpdateStatus(ctx context.Context, change *StatusChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+// ListStatusChanges mocks the ListStatusChanges method of Repository interface
+func (m *MockRepository) ListStatusChanges(ctx context.Context, userID uuid.UUID) ([]*StatusChange, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*StatusChange), args.Error(1)
+}
+
+// SetDateOfBirth mocks the SetDateOfBirth method of Repository interface
+func (m *MockRepository) SetDateOfBirth(ctx context.Context, id uuid.UUID, dateOfBirth *time.Time) error {
+	args := m.Called(ctx, id, dateOfBirth)
+	return args.Error(0)
+}
+
+// UpdatePassword mocks the UpdatePassword method of Repository interface
+func (m *MockRepository) UpdatePassword(ctx context.Context, id uuid.UUID, hash, algorithm string) error {
+	args := m.Called(ctx, id, hash, algorithm)
+	return args.Error(0)
+}
+
 // MockRoleRepository is a mock implementation of role.Repository interface
 // Used for testing service layer without database dependencies
 type MockRoleRepository struct {
@@ -44,7 +114,7 @@ type MockRoleRepository struct {
 }
 
 // GetByName mocks the GetByName method of role.Repository interface
-func (m *MockRoleRepository) GetByName(ctx context.Context, name string) (*role.Role, error) {
+func (m *MockRoleRepository) GetByName(ctx context.Context, name role.Name) (*role.Role, error) {
 	args := m.Called(ctx, name)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -73,13 +143,14 @@ func TestUserService_CreateUser(t *testing.T) {
 			mockFunc: func(m *MockRepository) {
 				// Mock GetByEmail to return "not found" error (user doesn't exist)
 				m.On("GetByEmail", mock.Anything, "test@example.com").Return((*User)(nil), gorm.ErrRecordNotFound)
+				m.On("GetByUsername", mock.Anything, "testuser").Return((*User)(nil), gorm.ErrRecordNotFound)
 				// Mock Create to succeed
 				m.On("Create", mock.Anything, mock.AnythingOfType("*user.User")).Return(nil)
 			},
 			roleMockFunc: func(m *MockRoleRepository) {
 				// Mock GetByName to return default USER role
-				userRole := &role.Role{Name: "USER", Description: "Default user role"}
-				m.On("GetByName", mock.Anything, "USER").Return(userRole, nil)
+				userRole := &role.Role{Name: role.RoleUser, Description: "Default user role"}
+				m.On("GetByName", mock.Anything, role.RoleUser).Return(userRole, nil)
 			},
 			wantErr: false,
 		},
@@ -111,17 +182,47 @@ func TestUserService_CreateUser(t *testing.T) {
 			mockFunc: func(m *MockRepository) {
 				// Mock GetByEmail to return "not found" error
 				m.On("GetByEmail", mock.Anything, "test@example.com").Return((*User)(nil), gorm.ErrRecordNotFound)
+				m.On("GetByUsername", mock.Anything, "testuser").Return((*User)(nil), gorm.ErrRecordNotFound)
 				// Mock Create to fail
 				m.On("Create", mock.Anything, mock.AnythingOfType("*user.User")).Return(errors.New("database error"))
 			},
 			roleMockFunc: func(m *MockRoleRepository) {
 				// Mock GetByName to return default USER role
-				userRole := &role.Role{Name: "USER", Description: "Default user role"}
-				m.On("GetByName", mock.Anything, "USER").Return(userRole, nil)
+				userRole := &role.Role{Name: role.RoleUser, Description: "Default user role"}
+				m.On("GetByName", mock.Anything, role.RoleUser).Return(userRole, nil)
 			},
 			wantErr: true,
 			errMsg:  "failed to create user",
 		},
+		{
+			name:     "username already taken",
+			email:    "test@example.com",
+			username: "takenuser",
+			password: "password123",
+			mockFunc: func(m *MockRepository) {
+				m.On("GetByEmail", mock.Anything, "test@example.com").Return((*User)(nil), gorm.ErrRecordNotFound)
+				m.On("GetByUsername", mock.Anything, "takenuser").Return(&User{ID: uuid.New(), Username: "takenuser"}, nil)
+			},
+			roleMockFunc: func(m *MockRoleRepository) {},
+			wantErr:      true,
+			errMsg:       "username takenuser is already taken",
+		},
+		{
+			name:     "username taken by a concurrent registration",
+			email:    "test@example.com",
+			username: "testuser",
+			password: "password123",
+			mockFunc: func(m *MockRepository) {
+				m.On("GetByEmail", mock.Anything, "test@example.com").Return((*User)(nil), gorm.ErrRecordNotFound)
+				m.On("GetByUsername", mock.Anything, "testuser").Return((*User)(nil), gorm.ErrRecordNotFound)
+				m.On("Create", mock.Anything, mock.AnythingOfType("*user.User")).Return(NewUsernameExistsError("testuser"))
+			},
+			roleMockFunc: func(m *MockRoleRepository) {
+				m.On("GetByName", mock.Anything, role.RoleUser).Return(&role.Role{Name: role.RoleUser}, nil)
+			},
+			wantErr: true,
+			errMsg:  "username testuser is already taken",
+		},
 	}
 
 	for _, tt := range tests {
@@ -234,3 +335,535 @@ func TestUserService_GetUserByEmail(t *testing.T) {
 		})
 	}
 }
+
+// TestUserService_GrantRole tests granting roles to existing users
+func TestUserService_GrantRole(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	adminRole := &role.Role{ID: uuid.New(), Name: role.RoleAdmin}
+
+	t.Run("grants a missing role", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRoleRepo := new(MockRoleRepository)
+		mockRepo.On("GetByEmail", ctx, "ops@example.com").Return(&User{ID: userID, Roles: []role.Role{{Name: role.RoleUser}}}, nil)
+		mockRoleRepo.On("GetByName", ctx, role.RoleAdmin).Return(adminRole, nil)
+		mockRepo.On("AddRole", ctx, userID, adminRole).Return(nil)
+
+		user, err := NewUserService(mockRepo, mockRoleRepo).GrantRole(ctx, "ops@example.com", "admin")
+
+		assert.NoError(t, err)
+		assert.True(t, user.HasRole(role.RoleAdmin))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("granting an existing role is a no-op", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRoleRepo := new(MockRoleRepository)
+		mockRepo.On("GetByEmail", ctx, "ops@example.com").Return(&User{ID: userID, Roles: []role.Role{*adminRole}}, nil)
+		mockRoleRepo.On("GetByName", ctx, role.RoleAdmin).Return(adminRole, nil)
+
+		_, err := NewUserService(mockRepo, mockRoleRepo).GrantRole(ctx, "ops@example.com", role.RoleAdmin.String())
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "AddRole", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRoleRepo := new(MockRoleRepository)
+		mockRepo.On("GetByEmail", ctx, "ops@example.com").Return(&User{ID: userID}, nil)
+
+		_, err := NewUserService(mockRepo, mockRoleRepo).GrantRole(ctx, "ops@example.com", "superuser")
+
+		assert.Equal(t, ErrRoleNotFound, err)
+		mockRoleRepo.AssertNotCalled(t, "GetByName", mock.Anything, mock.Anything)
+	})
+}
+
+// TestUserService_RevokeRole tests revoking roles from existing users
+func TestUserService_RevokeRole(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	organizerRole := &role.Role{ID: uuid.New(), Name: role.RoleOrganizer}
+	mockRepo := new(MockRepository)
+	mockRoleRepo := new(MockRoleRepository)
+	mockRepo.On("GetByEmail", ctx, "host@example.com").Return(&User{ID: userID, Roles: []role.Role{{Name: role.RoleUser}, *organizerRole}}, nil)
+	mockRoleRepo.On("GetByName", ctx, role.RoleOrganizer).Return(organizerRole, nil)
+	mockRepo.On("RemoveRole", ctx, userID, organizerRole).Return(nil)
+
+	user, err := NewUserService(mockRepo, mockRoleRepo).RevokeRole(ctx, "host@example.com", role.RoleOrganizer.String())
+
+	assert.NoError(t, err)
+	assert.False(t, user.HasRole(role.RoleOrganizer))
+	assert.True(t, user.HasRole(role.RoleUser))
+	mockRepo.AssertExpectations(t)
+}
+
+// fakeRoleVersions counts role changes in memory, failing bumps while err is set
+type fakeRoleVersions struct {
+	err      error
+	versions map[uuid.UUID]int64
+}
+
+func (f *fakeRoleVersions) Current(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return f.versions[userID], nil
+}
+
+func (f *fakeRoleVersions) Bump(ctx context.Context, userID uuid.UUID) (int64, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	if f.versions == nil {
+		f.versions = make(map[uuid.UUID]int64)
+	}
+	f.versions[userID]++
+	return f.versions[userID], nil
+}
+
+// TestUserService_RoleVersions tests that role changes are counted for issued tokens
+func TestUserService_RoleVersions(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	organizerRole := &role.Role{ID: uuid.New(), Name: role.RoleOrganizer}
+
+	t.Run("every role change bumps the version", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRoleRepo := new(MockRoleRepository)
+		mockRepo.On("GetByEmail", ctx, "host@example.com").Return(&User{ID: userID, Roles: []role.Role{*organizerRole}}, nil)
+		mockRoleRepo.On("GetByName", ctx, role.RoleOrganizer).Return(organizerRole, nil)
+		mockRepo.On("RemoveRole", ctx, userID, organizerRole).Return(nil)
+		versions := &fakeRoleVersions{}
+		service := NewUserService(mockRepo, mockRoleRepo, WithRoleVersions(versions))
+
+		before, err := service.RolesVersion(ctx, userID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), before)
+
+		_, err = service.RevokeRole(ctx, "host@example.com", role.RoleOrganizer.String())
+		assert.NoError(t, err)
+		after, err := service.RolesVersion(ctx, userID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), after)
+	})
+
+	t.Run("repeating a change whose bump failed bumps the version", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRoleRepo := new(MockRoleRepository)
+		mockRepo.On("GetByEmail", ctx, "host@example.com").Return(&User{ID: userID, Roles: []role.Role{{Name: role.RoleUser}}}, nil)
+		mockRoleRepo.On("GetByName", ctx, role.RoleOrganizer).Return(organizerRole, nil)
+		versions := &fakeRoleVersions{err: errors.New("redis down")}
+		service := NewUserService(mockRepo, mockRoleRepo, WithRoleVersions(versions))
+
+		_, err := service.RevokeRole(ctx, "host@example.com", role.RoleOrganizer.String())
+		assert.Equal(t, "ROLE_UPDATE_FAILED", GetUserErrorCode(err))
+
+		versions.err = nil
+		_, err = service.RevokeRole(ctx, "host@example.com", role.RoleOrganizer.String())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), versions.versions[userID])
+		mockRepo.AssertNotCalled(t, "RemoveRole", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("versions stay at 0 without a store", func(t *testing.T) {
+		version, err := NewUserService(new(MockRepository), new(MockRoleRepository)).RolesVersion(ctx, userID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), version)
+	})
+}
+
+// TestUserService_IsUsernameAvailable tests the username availability lookup
+func TestUserService_IsUsernameAvailable(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("free username is available", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByUsername", ctx, "newuser").Return((*User)(nil), gorm.ErrRecordNotFound)
+
+		available, err := NewUserService(mockRepo, new(MockRoleRepository)).IsUsernameAvailable(ctx, "newuser")
+
+		assert.NoError(t, err)
+		assert.True(t, available)
+	})
+
+	t.Run("taken username is not available", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByUsername", ctx, "takenuser").Return(&User{ID: uuid.New()}, nil)
+
+		available, err := NewUserService(mockRepo, new(MockRoleRepository)).IsUsernameAvailable(ctx, "takenuser")
+
+		assert.NoError(t, err)
+		assert.False(t, available)
+	})
+
+	t.Run("lookup errors are wrapped", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByUsername", ctx, "anyuser").Return((*User)(nil), errors.New("connection refused"))
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository)).IsUsernameAvailable(ctx, "anyuser")
+
+		var userErr *UserError
+		assert.ErrorAs(t, err, &userErr)
+		assert.Equal(t, ErrUserRetrievalFailed.Code, userErr.Code)
+	})
+}
+
+// TestUserService_NormalizesEmails tests that emails differing in case or spacing are treated as one
+func TestUserService_NormalizesEmails(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("registration stores the normalized email", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRoleRepo := new(MockRoleRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return((*User)(nil), gorm.ErrRecordNotFound)
+		mockRepo.On("GetByUsername", ctx, "someone").Return((*User)(nil), gorm.ErrRecordNotFound)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(u *User) bool { return u.Email == "user@example.com" })).Return(nil)
+		mockRoleRepo.On("GetByName", ctx, role.RoleUser).Return(&role.Role{Name: role.RoleUser}, nil)
+
+		created, err := NewUserService(mockRepo, mockRoleRepo).CreateUser(ctx, "  User@Example.COM ", "someone", "password123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user@example.com", created.Email)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("registration with a differently cased email conflicts", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return(&User{ID: uuid.New(), Email: "user@example.com"}, nil)
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository)).CreateUser(ctx, "USER@example.com", "someone", "password123")
+
+		var userErr *UserError
+		assert.ErrorAs(t, err, &userErr)
+		assert.Equal(t, ErrUserAlreadyExists.Code, userErr.Code)
+	})
+
+	t.Run("login matches any case", func(t *testing.T) {
+		hashed, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return(&User{ID: uuid.New(), Email: "user@example.com", Password: string(hashed)}, nil)
+		hasher, _ := NewBcryptHasher(bcrypt.MinCost)
+
+		authenticated, err := NewUserService(mockRepo, new(MockRoleRepository), WithPasswordHasher(hasher)).AuthenticateUser(ctx, "User@Example.com", "password123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user@example.com", authenticated.Email)
+	})
+}
+
+// TestUserService_PasswordHashing tests hashing with the configured algorithm and upgrading older hashes at login
+func TestUserService_PasswordHashing(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	argon, err := NewArgon2idHasher(1, 64, 1)
+	assert.NoError(t, err)
+	legacy, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+
+	t.Run("registration records the configured algorithm", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRoleRepo := new(MockRoleRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return((*User)(nil), gorm.ErrRecordNotFound)
+		mockRepo.On("GetByUsername", ctx, "someone").Return((*User)(nil), gorm.ErrRecordNotFound)
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*user.User")).Return(nil)
+		mockRoleRepo.On("GetByName", ctx, role.RoleUser).Return(&role.Role{Name: role.RoleUser}, nil)
+
+		created, err := NewUserService(mockRepo, mockRoleRepo, WithPasswordHasher(argon)).CreateUser(ctx, "user@example.com", "someone", "password123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, AlgorithmArgon2id, created.PasswordAlgorithm)
+		assert.True(t, strings.HasPrefix(created.Password, "$argon2id$"))
+		assert.True(t, argon.Verify(created.Password, "password123"))
+	})
+
+	t.Run("bcrypt hash is replaced by argon2id at login", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return(&User{ID: userID, Email: "user@example.com", Password: string(legacy)}, nil)
+		mockRepo.On("UpdatePassword", ctx, userID, mock.MatchedBy(func(hash string) bool { return argon.Verify(hash, "password123") }), AlgorithmArgon2id).Return(nil)
+
+		authenticated, err := NewUserService(mockRepo, new(MockRoleRepository), WithPasswordHasher(argon)).AuthenticateUser(ctx, "user@example.com", "password123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, AlgorithmArgon2id, authenticated.PasswordAlgorithm)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("bcrypt hash is replaced when the cost is raised", func(t *testing.T) {
+		hasher, _ := NewBcryptHasher(bcrypt.MinCost + 1)
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return(&User{ID: userID, Password: string(legacy), PasswordAlgorithm: AlgorithmBcrypt}, nil)
+		mockRepo.On("UpdatePassword", ctx, userID, mock.MatchedBy(func(hash string) bool {
+			cost, err := bcrypt.Cost([]byte(hash))
+			return err == nil && cost == bcrypt.MinCost+1
+		}), AlgorithmBcrypt).Return(nil)
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository), WithPasswordHasher(hasher)).AuthenticateUser(ctx, "user@example.com", "password123")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("current hash is kept", func(t *testing.T) {
+		hash, _ := argon.Hash("password123")
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return(&User{ID: userID, Password: hash, PasswordAlgorithm: AlgorithmArgon2id}, nil)
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository), WithPasswordHasher(argon)).AuthenticateUser(ctx, "user@example.com", "password123")
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed rehash does not fail the login", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return(&User{ID: userID, Password: string(legacy)}, nil)
+		mockRepo.On("UpdatePassword", ctx, userID, mock.Anything, AlgorithmArgon2id).Return(errors.New("connection refused"))
+
+		authenticated, err := NewUserService(mockRepo, new(MockRoleRepository), WithPasswordHasher(argon)).AuthenticateUser(ctx, "user@example.com", "password123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, string(legacy), authenticated.Password)
+	})
+
+	t.Run("wrong password is not rehashed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return(&User{ID: userID, Password: string(legacy)}, nil)
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository), WithPasswordHasher(argon)).AuthenticateUser(ctx, "user@example.com", "wrong-password")
+
+		assert.Equal(t, ErrInvalidCredentials, err)
+		mockRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown algorithm is refused", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return(&User{ID: userID, Password: string(legacy), PasswordAlgorithm: "md5"}, nil)
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository)).AuthenticateUser(ctx, "user@example.com", "password123")
+
+		assert.Equal(t, ErrInvalidCredentials, err)
+	})
+}
+
+// TestUserService_ReturnsTypedErrors tests that repository failures always reach callers as user errors
+func TestUserService_ReturnsTypedErrors(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("failed login lookup is not reported as wrong credentials", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return((*User)(nil), errors.New("connection refused"))
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository)).AuthenticateUser(ctx, "user@example.com", "password123")
+
+		assert.Equal(t, "USER_RETRIEVAL_FAILED", GetUserErrorCode(err))
+	})
+
+	t.Run("missing user becomes not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByID", ctx, userID).Return((*User)(nil), gorm.ErrRecordNotFound)
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository)).GetUserByID(ctx, userID)
+
+		assert.Equal(t, ErrUserNotFound, err)
+	})
+
+	t.Run("database failure is wrapped with its cause", func(t *testing.T) {
+		cause := errors.New("connection refused")
+		mockRepo := new(MockRepository)
+		mockRepo.On("ListStatusChanges", ctx, userID).Return(nil, cause)
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository)).GetStatusHistory(ctx, userID)
+
+		assert.Equal(t, "USER_RETRIEVAL_FAILED", GetUserErrorCode(err))
+		assert.ErrorIs(t, err, cause)
+	})
+}
+
+// TestUserService_SetAccountStatus tests suspending, banning and reactivating users
+func TestUserService_SetAccountStatus(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	adminID := uuid.New()
+
+	t.Run("suspension is recorded with its reason", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByID", ctx, userID).Return(&User{ID: userID, Status: StatusActive}, nil)
+		mockRepo.On("UpdateStatus", ctx, mock.MatchedBy(func(change *StatusChange) bool {
+			return change.UserID == userID && change.Status == StatusSuspended &&
+				change.Reason == "Chargebacks" && change.ChangedBy != nil && *change.ChangedBy == adminID
+		})).Return(nil)
+
+		updated, err := NewUserService(mockRepo, new(MockRoleRepository)).SetAccountStatus(ctx, userID, "suspended", " Chargebacks ", &adminID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, StatusSuspended, updated.Status)
+		assert.Equal(t, "Chargebacks", updated.StatusReason)
+		assert.NotNil(t, updated.StatusChangedAt)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ban requires a reason", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository)).SetAccountStatus(ctx, userID, StatusBanned, "  ", &adminID)
+
+		assert.Equal(t, ErrReasonRequired, err)
+		mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reactivation needs no reason", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByID", ctx, userID).Return(&User{ID: userID, Status: StatusBanned}, nil)
+		mockRepo.On("UpdateStatus", ctx, mock.AnythingOfType("*user.StatusChange")).Return(nil)
+
+		updated, err := NewUserService(mockRepo, new(MockRoleRepository)).SetAccountStatus(ctx, userID, StatusActive, "", &adminID)
+
+		assert.NoError(t, err)
+		assert.True(t, updated.IsActive())
+	})
+
+	t.Run("unknown status is rejected", func(t *testing.T) {
+		_, err := NewUserService(new(MockRepository), new(MockRoleRepository)).SetAccountStatus(ctx, userID, "FROZEN", "why", &adminID)
+
+		assert.Equal(t, ErrInvalidStatus, err)
+	})
+
+	t.Run("admins cannot change their own status", func(t *testing.T) {
+		_, err := NewUserService(new(MockRepository), new(MockRoleRepository)).SetAccountStatus(ctx, adminID, StatusSuspended, "oops", &adminID)
+
+		assert.Equal(t, ErrOwnStatusChange, err)
+	})
+}
+
+// TestUserService_SetDateOfBirth tests adding and removing dates of birth
+func TestUserService_SetDateOfBirth(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("stored as a date", func(t *testing.T) {
+		given := time.Date(1990, time.May, 17, 15, 30, 0, 0, time.UTC)
+		date := time.Date(1990, time.May, 17, 0, 0, 0, 0, time.UTC)
+		mockRepo := new(MockRepository)
+		mockRepo.On("SetDateOfBirth", ctx, userID, &date).Return(nil)
+		mockRepo.On("GetByID", ctx, userID).Return(&User{ID: userID, DateOfBirth: &date}, nil)
+
+		updated, err := NewUserService(mockRepo, new(MockRoleRepository)).SetDateOfBirth(ctx, userID, &given)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &date, updated.DateOfBirth)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("nil removes it", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("SetDateOfBirth", ctx, userID, (*time.Time)(nil)).Return(nil)
+		mockRepo.On("GetByID", ctx, userID).Return(&User{ID: userID}, nil)
+
+		updated, err := NewUserService(mockRepo, new(MockRoleRepository)).SetDateOfBirth(ctx, userID, nil)
+
+		assert.NoError(t, err)
+		assert.Nil(t, updated.DateOfBirth)
+	})
+
+	for name, dateOfBirth := range map[string]time.Time{
+		"in the future": time.Now().AddDate(0, 0, 2),
+		"before 1900":   time.Date(1899, time.December, 31, 0, 0, 0, 0, time.UTC),
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+
+			_, err := NewUserService(mockRepo, new(MockRoleRepository)).SetDateOfBirth(ctx, userID, &dateOfBirth)
+
+			assert.Equal(t, ErrInvalidDateOfBirth, err)
+			mockRepo.AssertNotCalled(t, "SetDateOfBirth", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestUserService_AccountStatusEnforcement tests that blocked users are refused with the reason
+func TestUserService_AccountStatusEnforcement(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("suspended user cannot log in", func(t *testing.T) {
+		hashed, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return(&User{ID: userID, Password: string(hashed), Status: StatusSuspended, StatusReason: "Chargebacks"}, nil)
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository)).AuthenticateUser(ctx, "user@example.com", "password123")
+
+		assert.True(t, IsAccountBlockedError(err))
+		assert.Equal(t, "ACCOUNT_SUSPENDED", GetUserErrorCode(err))
+		assert.Contains(t, err.Error(), "Chargebacks")
+	})
+
+	t.Run("wrong password does not reveal the status", func(t *testing.T) {
+		hashed, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", ctx, "user@example.com").Return(&User{ID: userID, Password: string(hashed), Status: StatusBanned}, nil)
+
+		_, err := NewUserService(mockRepo, new(MockRoleRepository)).AuthenticateUser(ctx, "user@example.com", "wrong-password")
+
+		assert.Equal(t, ErrInvalidCredentials, err)
+	})
+
+	t.Run("status check reports blocked accounts", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetStatus", ctx, userID).Return(&User{ID: userID, Status: StatusBanned, StatusReason: "Fraud"}, nil)
+
+		err := NewUserService(mockRepo, new(MockRoleRepository)).CheckAccountStatus(ctx, userID)
+
+		assert.True(t, IsAccountBlockedError(err))
+		assert.Equal(t, "ACCOUNT_BANNED", GetUserErrorCode(err))
+	})
+
+	t.Run("status check passes active accounts", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetStatus", ctx, userID).Return(&User{ID: userID, Status: StatusActive}, nil)
+
+		assert.NoError(t, NewUserService(mockRepo, new(MockRoleRepository)).CheckAccountStatus(ctx, userID))
+	})
+}
+
+// TestSubscribeFraudSuspensions tests automatic suspensions by the fraud checks
+func TestSubscribeFraudSuspensions(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("high score suspends the buyer", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetStatus", ctx, userID).Return(&User{ID: userID, Status: StatusActive}, nil)
+		mockRepo.On("GetByID", ctx, userID).Return(&User{ID: userID, Status: StatusActive}, nil)
+		mockRepo.On("UpdateStatus", ctx, mock.MatchedBy(func(change *StatusChange) bool {
+			return change.Status == StatusSuspended && change.ChangedBy == nil &&
+				strings.Contains(change.Reason, order.ReasonRapidPurchases)
+		})).Return(nil)
+		bus := eventbus.New()
+		SubscribeFraudSuspensions(bus, NewUserService(mockRepo, new(MockRoleRepository)), 90)
+
+		bus.Publish(ctx, order.OrderFlagged{UserID: userID, Score: 90, Reasons: []string{order.ReasonRapidPurchases}, Rejected: true})
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("lower score leaves the account alone", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		bus := eventbus.New()
+		SubscribeFraudSuspensions(bus, NewUserService(mockRepo, new(MockRoleRepository)), 90)
+
+		bus.Publish(ctx, order.OrderFlagged{UserID: userID, Score: 40})
+
+		mockRepo.AssertNotCalled(t, "GetStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("banned account is not downgraded to suspended", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetStatus", ctx, userID).Return(&User{ID: userID, Status: StatusBanned}, nil)
+		bus := eventbus.New()
+		SubscribeFraudSuspensions(bus, NewUserService(mockRepo, new(MockRoleRepository)), 90)
+
+		bus.Publish(ctx, order.OrderFlagged{UserID: userID, Score: 120})
+
+		mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+}
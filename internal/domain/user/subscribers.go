@@ -0,0 +1,32 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/order"
+)
+
+// SubscribeFraudSuspensions registers the handler that suspends users whose orders
+// the risk checks score at least minScore, pending a review by an admin
+// Accounts that are already suspended or banned are left alone.
+func SubscribeFraudSuspensions(bus *eventbus.Bus, service Service, minScore int) {
+	bus.Subscribe(order.TopicOrderFlagged, func(ctx context.Context, e eventbus.Event) error {
+		flagged := e.(order.OrderFlagged)
+		if flagged.Score < minScore {
+			return nil
+		}
+		if err := service.CheckAccountStatus(ctx, flagged.UserID); err != nil {
+			if IsAccountBlockedError(err) {
+				return nil
+			}
+			return err
+		}
+
+		reason := fmt.Sprintf("Automatic suspension: order risk score %d (%s)", flagged.Score, strings.Join(flagged.Reasons, ", "))
+		_, err := service.SetAccountStatus(ctx, flagged.UserID, StatusSuspended, reason, nil)
+		return err
+	})
+}
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"strings"
 	"time"
 
 	"enterprise-crud/internal/domain/role"
@@ -13,16 +14,95 @@ import (
 // Contains all user-related data and business rules
 type User struct {
 	ID       uuid.UUID `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"` // Unique identifier, auto-generated UUID primary key
-	Email    string    `json:"email" gorm:"unique; not null"`                             // User email address, must be unique across system
+	Email    string    `json:"email" gorm:"unique; not null"`                             // User email address in normalized form, unique across system regardless of case
 	Username string    `json:"username" gorm:"unique; not null"`                          // User chosen username, must be unique across system
 	Password string    `json:"-" gorm:"not null"`                                         // Encrypted password, excluded from JSON serialization for security
 
+	// PasswordAlgorithm names the algorithm Password was hashed with, "bcrypt" or "argon2id"
+	// Hashes made with another algorithm or parameters than configured are replaced at the next login
+	PasswordAlgorithm string `json:"-" gorm:"size:20;not null;default:bcrypt"`
+
 	// Roles defines what this user can do in the system
 	// Many-to-many relationship: one user can have multiple roles, one role can belong to multiple users
 	// GORM will automatically handle the user_roles junction table
 	Roles []role.Role `json:"roles" gorm:"many2many:user_roles;"`
 
+	// PlanID references the subscription plan limiting what the user can organize
+	// Nil means the user is on the default FREE plan
+	PlanID *uuid.UUID `json:"plan_id,omitempty" gorm:"type:uuid"`
+
+	// Phone is a verified number in E.164 format that receives critical alerts by SMS
+	// It is only set once the user has entered the code texted to it
+	Phone           *string    `json:"phone,omitempty" gorm:"size:20"`
+	PhoneVerifiedAt *time.Time `json:"phone_verified_at,omitempty"`
+
+	// DateOfBirth is optional; buyers need it to order tickets for age-restricted events
+	DateOfBirth *time.Time `json:"date_of_birth,omitempty" gorm:"type:date"`
+
+	// Status controls whether the user may sign in and use the API
+	// StatusReason explains the last change, e.g. why the account was suspended
+	Status          string     `json:"status" gorm:"size:20;not null;default:ACTIVE"`
+	StatusReason    string     `json:"status_reason,omitempty"`
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
+
 	// Timestamps track when the user was created and last updated
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+// Account statuses
+const (
+	StatusActive    = "ACTIVE"
+	StatusSuspended = "SUSPENDED" // Temporarily blocked, e.g. pending an investigation
+	StatusBanned    = "BANNED"    // Permanently blocked
+)
+
+// Oldest date of birth accepted on a profile
+var minDateOfBirth = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// StatusChange records a change of a user's account status
+// ChangedBy is nil for automatic changes, e.g. suspensions by the fraud checks.
+type StatusChange struct {
+	ID        uuid.UUID  `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	UserID    uuid.UUID  `json:"user_id" gorm:"type:uuid;not null"`
+	Status    string     `json:"status" gorm:"size:20;not null"`
+	Reason    string     `json:"reason"`
+	ChangedBy *uuid.UUID `json:"changed_by,omitempty" gorm:"type:uuid"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (StatusChange) TableName() string {
+	return "user_status_changes"
+}
+
+// IsActive checks if the user may sign in and use the API
+// Users created before statuses existed have an empty status and count as active.
+func (u *User) IsActive() bool {
+	return u.Status == StatusActive || u.Status == ""
+}
+
+// HasRole checks if the user has a role
+func (u *User) HasRole(name role.Name) bool {
+	for _, r := range u.Roles {
+		if r.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleNames returns the names of the user's roles, as carried in tokens and responses
+func (u *User) RoleNames() []string {
+	names := make([]string, len(u.Roles))
+	for i, r := range u.Roles {
+		names[i] = r.Name.String()
+	}
+	return names
+}
+
+// NormalizeEmail returns the form emails are stored and compared in
+// Emails are case-insensitive in practice, so User@Example.com and user@example.com are one account.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
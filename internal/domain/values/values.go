@@ -0,0 +1,87 @@
+// Package values holds the value objects shared by the ticketing domains: prices and totals,
+// ticket quantities and capacities. Their constructors are the one place the bounds are
+// checked; request binding, services and entities all go through them.
+package values
+
+import (
+	"fmt"
+	"math"
+)
+
+// ValueError reports a value outside the bounds of its value object
+type ValueError struct {
+	Code    string
+	Subject string // What was checked, e.g. "amount"
+	Rule    string // The bound it broke, e.g. "must be between 1 and 100"
+}
+
+func (e *ValueError) Error() string {
+	return e.Subject + " " + e.Rule
+}
+
+// Bounds of the value objects
+const (
+	// MaxMoney is the largest amount a decimal(10,2) column holds
+	MaxMoney Money = 99_999_999.99
+
+	// MaxQuantity is the most tickets a single order may buy
+	MaxQuantity Quantity = 100
+
+	// MaxCapacity is the most tickets or people an event or venue may have
+	MaxCapacity Capacity = 1_000_000
+)
+
+// Pre-defined value errors
+var (
+	ErrInvalidMoney    = &ValueError{Code: "INVALID_AMOUNT", Subject: "amount", Rule: fmt.Sprintf("must be between 0 and %.2f", float64(MaxMoney))}
+	ErrInvalidQuantity = &ValueError{Code: "INVALID_QUANTITY", Subject: "quantity", Rule: fmt.Sprintf("must be between 1 and %d", MaxQuantity)}
+	ErrInvalidCapacity = &ValueError{Code: "INVALID_CAPACITY", Subject: "capacity", Rule: fmt.Sprintf("must be between 1 and %d", MaxCapacity)}
+)
+
+// Money is an amount in the configured currency, in whole cents as the database stores it
+type Money float64
+
+// NewMoney checks amount is a finite, non-negative amount the database can hold and rounds it to cents
+func NewMoney(amount float64) (Money, error) {
+	if math.IsNaN(amount) || amount < 0 {
+		return 0, ErrInvalidMoney
+	}
+	rounded := Money(math.Round(amount*100) / 100)
+	if rounded > MaxMoney {
+		return 0, ErrInvalidMoney
+	}
+	return rounded, nil
+}
+
+// Times returns the amount of quantity items priced m, rounded to cents
+// It fails when the total no longer fits the database.
+func (m Money) Times(quantity Quantity) (Money, error) {
+	return NewMoney(float64(m) * float64(quantity))
+}
+
+// Float64 returns the amount as stored on entities
+func (m Money) Float64() float64 {
+	return float64(m)
+}
+
+// Quantity is the number of tickets in an order
+type Quantity int
+
+// NewQuantity checks n is between 1 and MaxQuantity
+func NewQuantity(n int) (Quantity, error) {
+	if n < 1 || Quantity(n) > MaxQuantity {
+		return 0, ErrInvalidQuantity
+	}
+	return Quantity(n), nil
+}
+
+// Capacity is the number of tickets an event offers or people a venue holds
+type Capacity int
+
+// NewCapacity checks n is between 1 and MaxCapacity
+func NewCapacity(n int) (Capacity, error) {
+	if n < 1 || Capacity(n) > MaxCapacity {
+		return 0, ErrInvalidCapacity
+	}
+	return Capacity(n), nil
+}
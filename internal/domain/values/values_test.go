@@ -0,0 +1,70 @@
+package values
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMoney(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		expected Money
+		valid    bool
+	}{
+		{amount: 0, expected: 0, valid: true},
+		{amount: 19.99, expected: 19.99, valid: true},
+		{amount: 10.005, expected: 10.01, valid: true},
+		{amount: 99_999_999.99, expected: MaxMoney, valid: true},
+		{amount: 100_000_000},
+		{amount: -0.01},
+		{amount: math.NaN()},
+		{amount: math.Inf(1)},
+	}
+
+	for _, tt := range tests {
+		money, err := NewMoney(tt.amount)
+		if !tt.valid {
+			assert.ErrorIs(t, err, ErrInvalidMoney, "%v", tt.amount)
+			continue
+		}
+		require.NoError(t, err, "%v", tt.amount)
+		assert.Equal(t, tt.expected, money)
+	}
+}
+
+func TestMoney_Times(t *testing.T) {
+	total, err := Money(19.99).Times(3)
+	require.NoError(t, err)
+	assert.Equal(t, Money(59.97), total)
+
+	_, err = Money(MaxMoney).Times(2)
+	assert.ErrorIs(t, err, ErrInvalidMoney)
+}
+
+func TestNewQuantity(t *testing.T) {
+	for _, n := range []int{1, 100} {
+		q, err := NewQuantity(n)
+		require.NoError(t, err)
+		assert.Equal(t, Quantity(n), q)
+	}
+	for _, n := range []int{0, -1, 101} {
+		_, err := NewQuantity(n)
+		assert.ErrorIs(t, err, ErrInvalidQuantity, "%d", n)
+	}
+}
+
+func TestNewCapacity(t *testing.T) {
+	for _, n := range []int{1, 1_000_000} {
+		c, err := NewCapacity(n)
+		require.NoError(t, err)
+		assert.Equal(t, Capacity(n), c)
+	}
+	for _, n := range []int{0, 1_000_001} {
+		_, err := NewCapacity(n)
+		assert.ErrorIs(t, err, ErrInvalidCapacity, "%d", n)
+	}
+	assert.EqualError(t, ErrInvalidCapacity, "capacity must be between 1 and 1000000")
+}
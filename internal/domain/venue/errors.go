@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	"enterprise-crud/internal/domain/errcode"
+
 	"github.com/google/uuid"
 )
 
@@ -33,7 +35,9 @@ var (
 	ErrVenueUpdateFailed    = &VenueError{Code: "VENUE_UPDATE_FAILED", Message: "failed to update venue"}
 	ErrVenueDeletionFailed  = &VenueError{Code: "VENUE_DELETION_FAILED", Message: "failed to delete venue"}
 	ErrVenueRetrievalFailed = &VenueError{Code: "VENUE_RETRIEVAL_FAILED", Message: "failed to retrieve venue"}
-	ErrInvalidVenueCapacity = &VenueError{Code: "INVALID_VENUE_CAPACITY", Message: "venue capacity must be greater than 0"}
+	ErrInvalidVenueCapacity = &VenueError{Code: "INVALID_VENUE_CAPACITY", Message: "invalid venue capacity"}
+	ErrNotVenueOwner        = &VenueError{Code: "NOT_VENUE_OWNER", Message: "only the venue owner or an admin can manage this venue"}
+	ErrInvalidNewOwner      = &VenueError{Code: "INVALID_NEW_OWNER", Message: "the new owner must be an active organizer or admin"}
 )
 
 // NewVenueError creates a new VenueError with a cause
@@ -53,6 +57,14 @@ func NewVenueNotFoundError(venueID uuid.UUID) *VenueError {
 	}
 }
 
+// NewInvalidLayoutsError creates a specific error for malformed capacity layouts
+func NewInvalidLayoutsError(message string) *VenueError {
+	return &VenueError{
+		Code:    "INVALID_LAYOUTS",
+		Message: "invalid capacity layouts: " + message,
+	}
+}
+
 // IsVenueError checks if an error is a VenueError
 func IsVenueError(err error) bool {
 	var venueErr *VenueError
@@ -73,3 +85,24 @@ func IsVenueNotFoundError(err error) bool {
 	var venueErr *VenueError
 	return errors.As(err, &venueErr) && venueErr.Code == "VENUE_NOT_FOUND"
 }
+
+// IsNotVenueOwnerError checks if an error is a permission error
+func IsNotVenueOwnerError(err error) bool {
+	return GetVenueErrorCode(err) == "NOT_VENUE_OWNER"
+}
+
+// ErrorCodes describes every code venue errors carry, for the API's error catalogue
+func ErrorCodes() []errcode.Code {
+	return []errcode.Code{
+		{Code: ErrVenueNotFound.Code, Kind: errcode.NotFound, Description: ErrVenueNotFound.Message},
+		{Code: ErrVenueAlreadyExists.Code, Kind: errcode.Invalid, Description: ErrVenueAlreadyExists.Message},
+		{Code: ErrVenueCreationFailed.Code, Kind: errcode.Invalid, Description: ErrVenueCreationFailed.Message},
+		{Code: ErrVenueUpdateFailed.Code, Kind: errcode.Invalid, Description: ErrVenueUpdateFailed.Message},
+		{Code: ErrVenueDeletionFailed.Code, Kind: errcode.Invalid, Description: ErrVenueDeletionFailed.Message},
+		{Code: ErrVenueRetrievalFailed.Code, Kind: errcode.Internal, Description: ErrVenueRetrievalFailed.Message},
+		{Code: ErrInvalidVenueCapacity.Code, Kind: errcode.Invalid, Description: ErrInvalidVenueCapacity.Message},
+		{Code: ErrNotVenueOwner.Code, Kind: errcode.Forbidden, Description: ErrNotVenueOwner.Message},
+		{Code: ErrInvalidNewOwner.Code, Kind: errcode.Invalid, Description: ErrInvalidNewOwner.Message},
+		{Code: "INVALID_LAYOUTS", Kind: errcode.Invalid, Description: "the capacity layouts are malformed"},
+	}
+}
@@ -0,0 +1,91 @@
+package venue
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"enterprise-crud/internal/domain/values"
+)
+
+// Limits on capacity layouts
+const (
+	MaxLayouts          = 20
+	maxLayoutNameLength = 50
+)
+
+// Layout is a named way of setting up a venue, e.g. standing for concerts or seated for shows
+type Layout struct {
+	Name     string `json:"name"`     // Events choose the layout by name, unique per venue regardless of case
+	Capacity int    `json:"capacity"` // People the venue holds set up this way, at most the venue's capacity
+}
+
+// Layouts are the capacity configurations of a venue, stored as JSONB
+type Layouts []Layout
+
+// Value implements driver.Valuer so layouts are stored as JSON
+func (l Layouts) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner for layouts stored as JSON
+func (l *Layouts) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported JSON column type")
+	}
+	return json.Unmarshal(data, l)
+}
+
+// Find returns the layout with the given name, ignoring case
+func (l Layouts) Find(name string) (Layout, bool) {
+	for _, layout := range l {
+		if strings.EqualFold(layout.Name, name) {
+			return layout, true
+		}
+	}
+	return Layout{}, false
+}
+
+// Validate checks the layouts fit a venue of the given capacity
+func (l Layouts) Validate(venueCapacity int) error {
+	if len(l) > MaxLayouts {
+		return NewInvalidLayoutsError(fmt.Sprintf("at most %d layouts are allowed", MaxLayouts))
+	}
+
+	seen := make(map[string]bool, len(l))
+	for _, layout := range l {
+		name := strings.TrimSpace(layout.Name)
+		if name == "" || len([]rune(name)) > maxLayoutNameLength || name != layout.Name {
+			return NewInvalidLayoutsError(fmt.Sprintf(
+				"layout name %q must be 1 to %d characters without surrounding spaces", layout.Name, maxLayoutNameLength))
+		}
+		key := strings.ToLower(name)
+		if seen[key] {
+			return NewInvalidLayoutsError(fmt.Sprintf("layout %q is defined twice", layout.Name))
+		}
+		seen[key] = true
+
+		if _, err := values.NewCapacity(layout.Capacity); err != nil || layout.Capacity > venueCapacity {
+			return NewInvalidLayoutsError(fmt.Sprintf(
+				"capacity of layout %q must be between 1 and the venue capacity (%d)", layout.Name, venueCapacity))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package venue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLayouts_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		layouts Layouts
+		wantErr bool
+	}{
+		{name: "no layouts", layouts: nil},
+		{name: "valid layouts", layouts: Layouts{{Name: "Standing", Capacity: 500}, {Name: "Seated", Capacity: 300}}},
+		{name: "blank name", layouts: Layouts{{Name: " ", Capacity: 100}}, wantErr: true},
+		{name: "padded name", layouts: Layouts{{Name: " Seated", Capacity: 100}}, wantErr: true},
+		{name: "duplicate name", layouts: Layouts{{Name: "Seated", Capacity: 100}, {Name: "SEATED", Capacity: 200}}, wantErr: true},
+		{name: "zero capacity", layouts: Layouts{{Name: "Seated", Capacity: 0}}, wantErr: true},
+		{name: "larger than venue", layouts: Layouts{{Name: "Standing", Capacity: 501}}, wantErr: true},
+		{name: "too many layouts", layouts: make(Layouts, MaxLayouts+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.layouts.Validate(500)
+			if tt.wantErr {
+				assert.True(t, IsVenueError(err))
+				assert.Equal(t, "INVALID_LAYOUTS", GetVenueErrorCode(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLayouts_Find(t *testing.T) {
+	layouts := Layouts{{Name: "Standing", Capacity: 500}, {Name: "Seated", Capacity: 300}}
+
+	layout, ok := layouts.Find("seated")
+	assert.True(t, ok)
+	assert.Equal(t, Layout{Name: "Seated", Capacity: 300}, layout)
+
+	_, ok = layouts.Find("Theatre")
+	assert.False(t, ok)
+}
@@ -3,6 +3,9 @@ package venue
 import (
 	"context"
 
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/values"
+
 	"github.com/google/uuid"
 )
 
@@ -11,29 +14,67 @@ type Service interface {
 	CreateVenue(ctx context.Context, venue *Venue) error
 	GetVenueByID(ctx context.Context, id uuid.UUID) (*Venue, error)
 	GetAllVenues(ctx context.Context) ([]*Venue, error)
-	UpdateVenue(ctx context.Context, venue *Venue) error
-	DeleteVenue(ctx context.Context, id uuid.UUID) error
+	UpdateVenue(ctx context.Context, actor Actor, venue *Venue) error
+	DeleteVenue(ctx context.Context, actor Actor, id uuid.UUID) error
+
+	// TransferOwnership hands the venue to another organizer or admin
+	TransferOwnership(ctx context.Context, actor Actor, id, newOwnerID uuid.UUID) (*Venue, error)
 }
 
+// OwnerCheck reports whether a user may own venues, i.e. is an active organizer or admin
+// It returns false for users that don't exist.
+type OwnerCheck func(ctx context.Context, userID uuid.UUID) (bool, error)
+
 // VenueService implements the venue service interface
 type VenueService struct {
 	repository Repository
+	canOwn     OwnerCheck        // Vets new owners on transfer
+	clock      clock.Clock       // Stamps created and updated venues
+	ids        clock.IDGenerator // Creates the IDs of new venues
+}
+
+// ServiceOption configures optional VenueService behaviour
+type ServiceOption func(*VenueService)
+
+// WithClock stamps venues with the time c tells instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *VenueService) {
+		s.clock = c
+	}
+}
+
+// WithIDGenerator creates the IDs of new venues with ids instead of at random
+func WithIDGenerator(ids clock.IDGenerator) ServiceOption {
+	return func(s *VenueService) {
+		s.ids = ids
+	}
 }
 
 // NewVenueService creates a new instance of venue service
-func NewVenueService(repository Repository) Service {
-	return &VenueService{
+func NewVenueService(repository Repository, canOwn OwnerCheck, opts ...ServiceOption) Service {
+	s := &VenueService{
 		repository: repository,
+		canOwn:     canOwn,
+		clock:      clock.System{},
+		ids:        clock.RandomIDs{},
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
-// CreateVenue creates a new venue
+// CreateVenue creates a new venue, giving it its ID and timestamps
 func (s *VenueService) CreateVenue(ctx context.Context, venue *Venue) error {
 	// Validate venue data
 	if err := s.validateVenue(venue); err != nil {
 		return err
 	}
 
+	venue.ID = s.ids.NewID()
+	venue.CreatedAt = s.clock.Now()
+	venue.UpdatedAt = venue.CreatedAt
+
 	// Create the venue
 	return s.repository.Create(ctx, venue)
 }
@@ -48,40 +89,83 @@ func (s *VenueService) GetAllVenues(ctx context.Context) ([]*Venue, error) {
 	return s.repository.GetAll(ctx)
 }
 
-// UpdateVenue updates an existing venue
-func (s *VenueService) UpdateVenue(ctx context.Context, venue *Venue) error {
+// UpdateVenue updates an existing venue the actor manages
+func (s *VenueService) UpdateVenue(ctx context.Context, actor Actor, venue *Venue) error {
 	// Validate venue data
 	if err := s.validateVenue(venue); err != nil {
 		return err
 	}
 
-	// Check if venue exists
-	_, err := s.repository.GetByID(ctx, venue.ID)
+	existing, err := s.managedVenue(ctx, actor, venue.ID)
 	if err != nil {
 		return err
 	}
 
+	// Ownership only changes through TransferOwnership
+	venue.OwnerID = existing.OwnerID
+	venue.CreatedAt = existing.CreatedAt
+	venue.UpdatedAt = s.clock.Now()
+
+	// Updates without layouts keep the existing ones, which must still fit
+	if venue.Layouts == nil {
+		venue.Layouts = existing.Layouts
+		if err := venue.Layouts.Validate(venue.Capacity); err != nil {
+			return err
+		}
+	}
+
 	// Update the venue
 	return s.repository.Update(ctx, venue)
 }
 
-// DeleteVenue deletes a venue by its ID
-func (s *VenueService) DeleteVenue(ctx context.Context, id uuid.UUID) error {
-	// Check if venue exists
-	_, err := s.repository.GetByID(ctx, id)
-	if err != nil {
+// DeleteVenue deletes a venue the actor manages
+func (s *VenueService) DeleteVenue(ctx context.Context, actor Actor, id uuid.UUID) error {
+	if _, err := s.managedVenue(ctx, actor, id); err != nil {
 		return err
 	}
 
 	return s.repository.Delete(ctx, id)
 }
 
+// TransferOwnership hands a venue the actor manages to another organizer or admin
+func (s *VenueService) TransferOwnership(ctx context.Context, actor Actor, id, newOwnerID uuid.UUID) (*Venue, error) {
+	venue, err := s.managedVenue(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	eligible, err := s.canOwn(ctx, newOwnerID)
+	if err != nil {
+		return nil, err
+	}
+	if !eligible {
+		return nil, ErrInvalidNewOwner
+	}
+
+	venue.OwnerID = &newOwnerID
+	if err := s.repository.Update(ctx, venue); err != nil {
+		return nil, err
+	}
+	return venue, nil
+}
+
+// managedVenue retrieves a venue the actor may manage
+func (s *VenueService) managedVenue(ctx context.Context, actor Actor, id uuid.UUID) (*Venue, error) {
+	venue, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !venue.CanManage(actor) {
+		return nil, ErrNotVenueOwner
+	}
+	return venue, nil
+}
+
 // validateVenue validates venue data
 func (s *VenueService) validateVenue(venue *Venue) error {
-	if venue.Capacity <= 0 {
-		return ErrInvalidVenueCapacity
+	if _, err := values.NewCapacity(venue.Capacity); err != nil {
+		return NewVenueError(ErrInvalidVenueCapacity, err)
 	}
 
-	// Add more validation rules as needed
-	return nil
+	return venue.Layouts.Validate(venue.Capacity)
 }
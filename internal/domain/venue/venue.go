@@ -18,11 +18,18 @@ type Venue struct {
 	Address string `gorm:"not null;type:text" json:"address" binding:"required"`
 
 	// Capacity is the maximum number of people the venue can hold
-	Capacity int `gorm:"not null;check:capacity > 0" json:"capacity" binding:"required,min=1"`
+	Capacity int `gorm:"not null;check:capacity > 0" json:"capacity" binding:"required,capacity"`
 
 	// Description provides additional information about the venue
 	Description string `gorm:"type:text" json:"description"`
 
+	// Layouts are named capacity configurations events can choose from, e.g. standing or seated
+	Layouts Layouts `gorm:"type:jsonb;not null;default:'[]'" json:"layouts"`
+
+	// OwnerID is the organizer who manages the venue; venues created before ownership
+	// existed have none and only admins may change them
+	OwnerID *uuid.UUID `gorm:"type:uuid;index" json:"owner_id,omitempty"`
+
 	// Timestamps track when the venue was created and last updated
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
@@ -32,3 +39,14 @@ type Venue struct {
 func (Venue) TableName() string {
 	return "venues"
 }
+
+// Actor identifies the user performing an action
+type Actor struct {
+	UserID  uuid.UUID
+	IsAdmin bool // Admins may manage any venue
+}
+
+// CanManage reports whether the actor may update, delete or transfer the venue
+func (v *Venue) CanManage(actor Actor) bool {
+	return actor.IsAdmin || (v.OwnerID != nil && *v.OwnerID == actor.UserID)
+}
@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// WebhookError represents domain-specific webhook errors
+type WebhookError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *WebhookError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *WebhookError) Unwrap() error {
+	return e.Cause
+}
+
+// Pre-defined webhook domain errors
+var (
+	ErrInvalidURL                  = &WebhookError{Code: "INVALID_WEBHOOK_URL", Message: "webhook URL must be an absolute https URL of a public host"}
+	ErrInvalidEvent                = &WebhookError{Code: "INVALID_WEBHOOK_EVENT", Message: "webhook events must be among " + strings.Join(Events, ", ")}
+	ErrTooManySubscriptions        = &WebhookError{Code: "TOO_MANY_WEBHOOKS", Message: "webhook subscription limit reached; delete one first"}
+	ErrSubscriptionNotFound        = &WebhookError{Code: "WEBHOOK_NOT_FOUND", Message: "webhook subscription not found"}
+	ErrSubscriptionSaveFailed      = &WebhookError{Code: "WEBHOOK_SAVE_FAILED", Message: "failed to save webhook subscription"}
+	ErrSubscriptionRetrievalFailed = &WebhookError{Code: "WEBHOOK_RETRIEVAL_FAILED", Message: "failed to retrieve webhook subscriptions"}
+	ErrDeliveryFailed              = &WebhookError{Code: "WEBHOOK_DELIVERY_FAILED", Message: "failed to schedule webhook delivery"}
+)
+
+// NewWebhookError creates a new WebhookError with a cause
+func NewWebhookError(baseError *WebhookError, cause error) *WebhookError {
+	return &WebhookError{
+		Code:    baseError.Code,
+		Message: baseError.Message,
+		Cause:   cause,
+	}
+}
+
+// GetWebhookErrorCode extracts the error code from a WebhookError
+func GetWebhookErrorCode(err error) string {
+	var webhookErr *WebhookError
+	if errors.As(err, &webhookErr) {
+		return webhookErr.Code
+	}
+	return ""
+}
+
+// IsValidationError checks if an error is caused by invalid input
+func IsValidationError(err error) bool {
+	switch GetWebhookErrorCode(err) {
+	case "INVALID_WEBHOOK_URL", "INVALID_WEBHOOK_EVENT":
+		return true
+	}
+	return false
+}
+
+// IsLimitError checks if an error is caused by a user having too many subscriptions
+func IsLimitError(err error) bool {
+	return GetWebhookErrorCode(err) == "TOO_MANY_WEBHOOKS"
+}
+
+// IsNotFoundError checks if an error is caused by a missing subscription
+func IsNotFoundError(err error) bool {
+	return GetWebhookErrorCode(err) == "WEBHOOK_NOT_FOUND"
+}
@@ -0,0 +1,22 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the contract for webhook subscription data access
+type Repository interface {
+	// Create stores a new subscription
+	Create(ctx context.Context, s *Subscription) error
+
+	// GetByID retrieves a subscription by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Subscription, error)
+
+	// ListByUser retrieves a user's subscriptions, oldest first
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*Subscription, error)
+
+	// Delete removes a user's subscription, reporting ErrSubscriptionNotFound if they have none with the ID
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+}
@@ -0,0 +1,217 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/logging"
+
+	"github.com/google/uuid"
+)
+
+// secretPrefix marks subscription secrets, so they are recognised when pasted somewhere they shouldn't be
+const secretPrefix = "whsec_"
+
+// Service defines the business logic interface for order status webhooks
+type Service interface {
+	// Create subscribes an endpoint of the user's to events of their own orders
+	// No events subscribes it to every event. The returned subscription carries its secret.
+	Create(ctx context.Context, userID uuid.UUID, endpoint string, events []string) (*Subscription, error)
+
+	// List retrieves the user's subscriptions, oldest first
+	List(ctx context.Context, userID uuid.UUID) ([]*Subscription, error)
+
+	// Delete removes one of the user's subscriptions
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+
+	// GetSubscription retrieves a subscription by its ID, for delivering to it
+	GetSubscription(ctx context.Context, id uuid.UUID) (*Subscription, error)
+
+	// Dispatch schedules a delivery of event to every subscription of the user receiving it
+	Dispatch(ctx context.Context, userID uuid.UUID, event string, data OrderData) error
+}
+
+// Settings bounds the subscriptions users can register
+type Settings struct {
+	MaxPerUser   int  // Subscriptions one user may register; 0 means no limit
+	AllowHTTP    bool // Accept plain http:// endpoints, for local development
+	AllowPrivate bool // Accept localhost and private addresses, for local development
+}
+
+// serviceImpl implements the Service interface
+type serviceImpl struct {
+	repo       Repository
+	jobService job.Service
+	settings   Settings
+	clock      clock.Clock       // Stamps deliveries
+	ids        clock.IDGenerator // Creates the IDs of deliveries
+}
+
+// ServiceOption configures optional webhook Service behaviour
+type ServiceOption func(*serviceImpl)
+
+// WithClock stamps deliveries with the time c tells instead of the system clock
+func WithClock(c clock.Clock) ServiceOption {
+	return func(s *serviceImpl) {
+		s.clock = c
+	}
+}
+
+// WithIDGenerator creates the IDs of deliveries with ids instead of at random
+func WithIDGenerator(ids clock.IDGenerator) ServiceOption {
+	return func(s *serviceImpl) {
+		s.ids = ids
+	}
+}
+
+// NewService creates a new webhook service instance
+// Deliveries are posted by job workers, which retry endpoints that are down.
+func NewService(repo Repository, jobService job.Service, settings Settings, opts ...ServiceOption) Service {
+	s := &serviceImpl{
+		repo:       repo,
+		jobService: jobService,
+		settings:   settings,
+		clock:      clock.System{},
+		ids:        clock.RandomIDs{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Create subscribes an endpoint to events of the user's own orders
+func (s *serviceImpl) Create(ctx context.Context, userID uuid.UUID, endpoint string, events []string) (*Subscription, error) {
+	endpoint = strings.TrimSpace(endpoint)
+	if !s.validURL(endpoint) {
+		return nil, ErrInvalidURL
+	}
+	events, err := validEvents(events)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.settings.MaxPerUser > 0 {
+		existing, err := s.repo.ListByUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if len(existing) >= s.settings.MaxPerUser {
+			return nil, ErrTooManySubscriptions
+		}
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, NewWebhookError(ErrSubscriptionSaveFailed, err)
+	}
+	sub := &Subscription{UserID: userID, URL: endpoint, Secret: secret, Events: events}
+	if err := s.repo.Create(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	logging.From(ctx).Info("Webhook subscribed", "webhook_id", sub.ID, "user_id", userID, "events", strings.Join(events, ","))
+	return sub, nil
+}
+
+// List retrieves the user's subscriptions
+func (s *serviceImpl) List(ctx context.Context, userID uuid.UUID) ([]*Subscription, error) {
+	return s.repo.ListByUser(ctx, userID)
+}
+
+// Delete removes one of the user's subscriptions
+// Deliveries already scheduled to it are dropped when they come up.
+func (s *serviceImpl) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	return s.repo.Delete(ctx, userID, id)
+}
+
+// GetSubscription retrieves a subscription by its ID
+func (s *serviceImpl) GetSubscription(ctx context.Context, id uuid.UUID) (*Subscription, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Dispatch schedules a delivery of event to the user's subscriptions receiving it
+// Every subscription gets its own job, so one endpoint being down doesn't hold up the others.
+func (s *serviceImpl) Dispatch(ctx context.Context, userID uuid.UUID, event string, data OrderData) error {
+	subs, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subs {
+		if !sub.Receives(event) {
+			continue
+		}
+		delivery := Delivery{ID: s.ids.NewID(), Type: event, CreatedAt: s.clock.Now(), Data: data}
+		body, err := json.Marshal(delivery)
+		if err != nil {
+			return NewWebhookError(ErrDeliveryFailed, err)
+		}
+		payload := DeliveryPayload{SubscriptionID: sub.ID, DeliveryID: delivery.ID, Event: event, Body: body}
+		if _, err := s.jobService.Enqueue(ctx, JobTypeDeliver, payload); err != nil {
+			return NewWebhookError(ErrDeliveryFailed, err)
+		}
+	}
+	return nil
+}
+
+// validURL checks an endpoint is an absolute https URL, or http when allowed, of a public host
+// Host names are only resolved when delivering, where the addresses they resolve to are checked again.
+func (s *serviceImpl) validURL(endpoint string) bool {
+	if endpoint == "" || len(endpoint) > 2048 {
+		return false
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || u.User != nil {
+		return false
+	}
+	if u.Scheme != "https" && !(u.Scheme == "http" && s.settings.AllowHTTP) {
+		return false
+	}
+	if s.settings.AllowPrivate {
+		return true
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil && !IsPublicIP(ip) {
+		return false
+	}
+	return true
+}
+
+// validEvents checks and deduplicates the events of a subscription, defaulting to every event
+func validEvents(events []string) ([]string, error) {
+	if len(events) == 0 {
+		return slices.Clone(Events), nil
+	}
+	var valid []string
+	for _, e := range events {
+		e = strings.ToLower(strings.TrimSpace(e))
+		if !IsValidEvent(e) {
+			return nil, ErrInvalidEvent
+		}
+		if !slices.Contains(valid, e) {
+			valid = append(valid, e)
+		}
+	}
+	return valid, nil
+}
+
+// newSecret generates a random subscription secret
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
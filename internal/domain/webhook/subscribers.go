@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"context"
+
+	"enterprise-crud/internal/domain/eventbus"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/refund"
+)
+
+// Subscribe registers the handlers that turn order and refund events into deliveries to the buyer's endpoints
+func Subscribe(bus *eventbus.Bus, service Service) {
+	bus.Subscribe(order.TopicOrderConfirmed, func(ctx context.Context, e eventbus.Event) error {
+		confirmed := e.(order.OrderConfirmed)
+		return service.Dispatch(ctx, confirmed.UserID, EventOrderCompleted, OrderData{
+			OrderID:     confirmed.OrderID,
+			EventID:     confirmed.EventID,
+			Quantity:    confirmed.Quantity,
+			TotalAmount: confirmed.TotalAmount,
+		})
+	})
+
+	bus.Subscribe(refund.TopicRefundIssued, func(ctx context.Context, e eventbus.Event) error {
+		issued := e.(refund.RefundIssued)
+		return service.Dispatch(ctx, issued.UserID, EventOrderRefunded, OrderData{
+			OrderID:     issued.OrderID,
+			EventID:     issued.EventID,
+			TotalAmount: issued.OrderAmount,
+			Refund: &RefundData{
+				RequestID: issued.RequestID,
+				Kind:      issued.Kind,
+				Amount:    issued.Amount,
+			},
+		})
+	})
+}
@@ -0,0 +1,132 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobTypeDeliver is the job type that posts one delivery to a subscribed endpoint
+const JobTypeDeliver = "webhook.deliver"
+
+// Events buyers can subscribe to
+const (
+	EventOrderCompleted = "order.completed" // One of the buyer's orders was paid and its tickets issued
+	EventOrderRefunded  = "order.refunded"  // The payment provider refunded one of the buyer's orders, in full or in part
+)
+
+// Events lists every event a subscription can receive
+var Events = []string{EventOrderCompleted, EventOrderRefunded}
+
+// IsValidEvent checks if subscriptions can receive an event
+func IsValidEvent(name string) bool {
+	return slices.Contains(Events, name)
+}
+
+// Headers sent with every delivery
+const (
+	HeaderID        = "X-Webhook-Id"        // ID of the delivery, the same on every attempt
+	HeaderEvent     = "X-Webhook-Event"     // Event of the delivery, e.g. order.completed
+	HeaderTimestamp = "X-Webhook-Timestamp" // Unix time in seconds the attempt was signed at
+	HeaderSignature = "X-Webhook-Signature" // "sha256=" and the hex HMAC-SHA256 of timestamp + "." + body
+)
+
+// SignatureTolerance is how old a signed timestamp receivers should accept, against replays
+const SignatureTolerance = 5 * time.Minute
+
+// Subscription is an endpoint of a buyer's own system that is sent the status changes of their orders
+// Only orders the subscribing user placed are delivered to it.
+type Subscription struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	UserID    uuid.UUID `gorm:"not null;type:uuid;index" json:"user_id"`
+	URL       string    `gorm:"not null;size:2048" json:"url"`
+	Secret    string    `gorm:"not null;size:100" json:"-"`               // Signs deliveries; only shown when the subscription is created
+	Events    []string  `gorm:"type:jsonb;serializer:json" json:"events"` // Events delivered to the endpoint
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName tells GORM what table to use for this model
+func (Subscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// Receives checks if the subscription is sent an event
+func (s *Subscription) Receives(event string) bool {
+	return slices.Contains(s.Events, event)
+}
+
+// Delivery is the JSON body posted to subscribed endpoints
+type Delivery struct {
+	ID        uuid.UUID `json:"id"`   // Same as the X-Webhook-Id header; receivers can drop deliveries they have seen
+	Type      string    `json:"type"` // Event of the delivery
+	CreatedAt time.Time `json:"created_at"`
+	Data      OrderData `json:"data"`
+}
+
+// OrderData describes the order a delivery is about
+type OrderData struct {
+	OrderID     uuid.UUID   `json:"order_id"`
+	EventID     uuid.UUID   `json:"event_id"`
+	Quantity    int         `json:"quantity,omitempty"`
+	TotalAmount float64     `json:"total_amount"`
+	Refund      *RefundData `json:"refund,omitempty"` // Set on order.refunded
+}
+
+// RefundData describes the refund of an order.refunded delivery
+type RefundData struct {
+	RequestID uuid.UUID `json:"request_id"`
+	Kind      string    `json:"kind"`   // REFUND or DISPUTE
+	Amount    float64   `json:"amount"` // Refunded amount, at most the order total
+}
+
+// DeliveryPayload is the job payload for JobTypeDeliver
+// The body is built when the event happens, so retries send the same document.
+type DeliveryPayload struct {
+	SubscriptionID uuid.UUID       `json:"subscription_id"`
+	DeliveryID     uuid.UUID       `json:"delivery_id"`
+	Event          string          `json:"event"`
+	Body           json.RawMessage `json:"body"`
+}
+
+// IsPublicIP checks if ip may receive deliveries
+// Loopback, private, link-local, unspecified and multicast addresses reach the service's own
+// network rather than a buyer's system, so posting to them would let buyers probe it.
+func IsPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil && ip4[0] == 0 {
+		return false // 0.0.0.0/8 means this host
+	}
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified())
+}
+
+// Sign returns the X-Webhook-Signature value of a body signed at timestamp with secret
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature and timestamp headers of a delivery as a receiver should
+// Deliveries signed more than SignatureTolerance before or after now are refused.
+func Verify(secret, signature, timestamp string, body []byte, now time.Time) bool {
+	unix, err := strconv.ParseInt(strings.TrimSpace(timestamp), 10, 64)
+	if err != nil {
+		return false
+	}
+	signedAt := time.Unix(unix, 0)
+	if now.Sub(signedAt).Abs() > SignatureTolerance {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, signedAt, body)))
+}
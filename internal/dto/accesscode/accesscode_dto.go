@@ -0,0 +1,57 @@
+package accesscode
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateAccessCodeRequest represents the request structure for adding an access code to an event
+type CreateAccessCodeRequest struct {
+	Code    string `json:"code" binding:"omitempty,max=32" example:"VIP-PRESALE"` // Omit to generate one
+	MaxUses int    `json:"max_uses" binding:"min=0" example:"1"`                  // 1 for a single-use code, 0 for no limit
+}
+
+// AccessCodeResponse represents an access code with its usage
+type AccessCodeResponse struct {
+	ID            uuid.UUID `json:"id"`
+	EventID       uuid.UUID `json:"event_id"`
+	Code          string    `json:"code" example:"VIP-PRESALE"`
+	MaxUses       int       `json:"max_uses" example:"1"` // 0 for no limit
+	Uses          int       `json:"uses" example:"0"`
+	RemainingUses *int      `json:"remaining_uses,omitempty" example:"1"` // Omitted for codes without a limit
+	CreatedBy     uuid.UUID `json:"created_by"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// AccessCodeListResponse represents the response structure for listing an event's access codes
+type AccessCodeListResponse struct {
+	AccessCodes []AccessCodeResponse `json:"access_codes"`
+	Count       int                  `json:"count"`
+}
+
+// RedemptionResponse represents an order placed with an access code
+type RedemptionResponse struct {
+	OrderID   uuid.UUID `json:"order_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Email     string    `json:"email,omitempty"`
+	Quantity  int       `json:"quantity" example:"2"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RedemptionListResponse represents the response structure for listing an access code's redemptions
+type RedemptionListResponse struct {
+	Redemptions []RedemptionResponse `json:"redemptions"`
+	Count       int                  `json:"count"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// SuccessResponse represents success response structure
+type SuccessResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,28 @@
+package account
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportResponse represents a personal data export
+type ExportResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Status      string     `json:"status" example:"PENDING"`
+	DownloadURL string     `json:"download_url" example:"/api/v1/users/me/exports/6f1c2b8e-2f44-4c8e-9b53-0d3f6c1e7a10"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// DeletionResponse represents a scheduled account deletion
+type DeletionResponse struct {
+	ScheduledFor time.Time `json:"scheduled_for"`
+	Message      string    `json:"message" example:"Your account will be deleted on the scheduled date unless you cancel the request"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,45 @@
+package analytics
+
+import "time"
+
+// DailySnapshotResponse represents the platform figures of one UTC day
+type DailySnapshotResponse struct {
+	Day          string    `json:"day" example:"2026-10-15"`
+	Orders       int       `json:"orders"`
+	TicketsSold  int       `json:"tickets_sold"`
+	Revenue      float64   `json:"revenue"`
+	ActiveEvents int       `json:"active_events"`
+	NewUsers     int       `json:"new_users"`
+	TakenAt      time.Time `json:"taken_at"`
+}
+
+// DailySnapshotsResponse represents the response structure for platform trends
+type DailySnapshotsResponse struct {
+	Days []DailySnapshotResponse `json:"days"` // Oldest first; days without a snapshot are missing
+}
+
+// SalesDayResponse represents an organizer's sales and event page views on one UTC day
+type SalesDayResponse struct {
+	Day            string  `json:"day" example:"2026-10-15"`
+	Orders         int     `json:"orders"`
+	TicketsSold    int     `json:"tickets_sold"`
+	Revenue        float64 `json:"revenue"`
+	Views          int     `json:"views"`
+	UniqueVisitors int     `json:"unique_visitors"` // Estimated per event and added up over the organizer's events
+}
+
+// SalesTrendResponse represents the response structure for an organizer's sales trend
+// Days without sales or views are omitted.
+type SalesTrendResponse struct {
+	TotalOrders  int                `json:"total_orders"`
+	TotalTickets int                `json:"total_tickets"`
+	TotalRevenue float64            `json:"total_revenue"`
+	TotalViews   int                `json:"total_views"`
+	Days         []SalesDayResponse `json:"days"` // Oldest first
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,30 @@
+package branding
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SetColorsRequest represents the request to set an organizer's brand colors
+type SetColorsRequest struct {
+	PrimaryColor string `json:"primary_color" binding:"max=7" example:"#1a2b3c"` // #rrggbb or #rgb; empty restores the default
+	AccentColor  string `json:"accent_color" binding:"max=7" example:"#ff8800"`  // #rrggbb or #rgb; empty restores the default
+}
+
+// BrandingResponse represents an organizer's branding
+type BrandingResponse struct {
+	OrganizerID  uuid.UUID  `json:"organizer_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	PrimaryColor string     `json:"primary_color" example:"#1a2b3c"` // Empty for the default
+	AccentColor  string     `json:"accent_color" example:"#ff8800"`  // Empty for the default
+	LogoURL      string     `json:"logo_url,omitempty" example:"https://tickets.example.com/api/v1/organizers/550e8400-e29b-41d4-a716-446655440000/branding/logo?v=20261017T093000.000000000Z"`
+	LogoWidth    int        `json:"logo_width,omitempty" example:"240"`                  // Pixels
+	LogoHeight   int        `json:"logo_height,omitempty" example:"80"`                  // Pixels
+	UpdatedAt    *time.Time `json:"updated_at,omitempty" example:"2026-10-17T09:30:00Z"` // Omitted until branding is first set
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
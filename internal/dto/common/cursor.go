@@ -0,0 +1,32 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidCursor is returned for cursor tokens that were not issued by a list response
+var ErrInvalidCursor = errors.New("cursor must be a next_cursor returned by this list")
+
+// Cursor marks where the next page of a list starts
+// Clients only see it as an opaque token, so what it holds can change without breaking them.
+type Cursor struct {
+	Offset int `json:"o"` // Items before the page
+}
+
+// Encode returns the token clients send back as the cursor query parameter
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseCursor decodes a token returned by Encode
+func ParseCursor(token string) (Cursor, error) {
+	var c Cursor
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || json.Unmarshal(data, &c) != nil || c.Offset < 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
@@ -1,5 +1,7 @@
 package common
 
+import "net/url"
+
 // ErrorResponse represents the standard error response structure
 type ErrorResponse struct {
 	Error   string `json:"error"`
@@ -11,8 +13,67 @@ type SuccessResponse struct {
 	Message string `json:"message"`
 }
 
-// ListResponse represents a generic list response structure
+// ListResponse is the envelope list endpoints answer with
+// Every list pages the same way: follow links.next, or send meta.next_cursor back as the
+// cursor query parameter, until the response leaves it out.
 type ListResponse[T any] struct {
-	Items []T `json:"items"`
-	Count int `json:"count"`
+	Data  []T       `json:"data"`
+	Meta  ListMeta  `json:"meta"`
+	Links ListLinks `json:"links"`
+}
+
+// ListMeta describes the page a list response holds
+type ListMeta struct {
+	Count      int      `json:"count" example:"20"`                          // Items on this page
+	Total      *int     `json:"total,omitempty" example:"120"`               // Items matching the filters, on lists that count them
+	NextCursor string   `json:"next_cursor,omitempty" example:"eyJvIjoyMH0"` // Omitted on the last page
+	Warnings   []string `json:"warnings,omitempty"`                          // Deprecated features the request used, with their removal date
+}
+
+// ListLinks holds the links of a list response, relative to the API host
+type ListLinks struct {
+	Self string `json:"self" example:"/api/v1/events/my-events?limit=20"`
+	Next string `json:"next,omitempty" example:"/api/v1/events/my-events?cursor=eyJvIjoyMH0&limit=20"` // Omitted on the last page
+}
+
+// NewListResponse wraps the items of a single-page list requested at self
+func NewListResponse[T any](data []T, self *url.URL) ListResponse[T] {
+	if data == nil {
+		data = []T{}
+	}
+	return ListResponse[T]{
+		Data:  data,
+		Meta:  ListMeta{Count: len(data)},
+		Links: ListLinks{Self: self.RequestURI()},
+	}
+}
+
+// WithTotal sets the number of items matching the filters across all pages
+func (r ListResponse[T]) WithTotal(total int) ListResponse[T] {
+	r.Meta.Total = &total
+	return r
+}
+
+// WithWarnings sets the deprecation warnings of the request
+func (r ListResponse[T]) WithWarnings(warnings []string) ListResponse[T] {
+	r.Meta.Warnings = warnings
+	return r
+}
+
+// WithNext points the response at the page starting at next
+// The next link repeats the request's query with the cursor in place of any offset.
+func (r ListResponse[T]) WithNext(next Cursor) ListResponse[T] {
+	token := next.Encode()
+	r.Meta.NextCursor = token
+
+	link, err := url.Parse(r.Links.Self)
+	if err != nil {
+		return r
+	}
+	query := link.Query()
+	query.Del("offset")
+	query.Set("cursor", token)
+	link.RawQuery = query.Encode()
+	r.Links.Next = link.RequestURI()
+	return r
 }
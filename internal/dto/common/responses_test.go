@@ -0,0 +1,42 @@
+package common
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListResponse_WithNext(t *testing.T) {
+	self, _ := url.Parse("/api/v1/events/my-events?status=ACTIVE&offset=20&limit=10")
+
+	page := NewListResponse([]string{"a", "b"}, self).WithTotal(42).WithNext(Cursor{Offset: 30})
+
+	assert.Equal(t, 2, page.Meta.Count)
+	assert.Equal(t, 42, *page.Meta.Total)
+	assert.Equal(t, "/api/v1/events/my-events?status=ACTIVE&offset=20&limit=10", page.Links.Self)
+	assert.Equal(t, "/api/v1/events/my-events?cursor="+page.Meta.NextCursor+"&limit=10&status=ACTIVE", page.Links.Next)
+
+	next, err := ParseCursor(page.Meta.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, 30, next.Offset)
+}
+
+func TestNewListResponse_EmptyData(t *testing.T) {
+	self, _ := url.Parse("/api/v1/venues")
+
+	page := NewListResponse[string](nil, self)
+
+	assert.NotNil(t, page.Data)
+	assert.Nil(t, page.Meta.Total)
+	assert.Empty(t, page.Meta.NextCursor)
+	assert.Empty(t, page.Links.Next)
+}
+
+func TestParseCursor_Invalid(t *testing.T) {
+	for _, token := range []string{"not base64!", "bm90IGpzb24", Cursor{Offset: -1}.Encode()} {
+		_, err := ParseCursor(token)
+		assert.ErrorIs(t, err, ErrInvalidCursor, token)
+	}
+}
@@ -0,0 +1,52 @@
+package consent
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PublishVersionRequest represents the request structure for publishing a policy version
+type PublishVersionRequest struct {
+	Type    string `json:"type" binding:"required,oneof=TERMS PRIVACY" example:"TERMS"`
+	Version string `json:"version" binding:"required,max=50" example:"2026-05"`
+	URL     string `json:"url" binding:"required,url,max=500" example:"https://example.com/legal/terms-2026-05"`
+}
+
+// VersionResponse represents a published policy version
+type VersionResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Type        string    `json:"type" example:"TERMS"`
+	Version     string    `json:"version" example:"2026-05"`
+	URL         string    `json:"url" example:"https://example.com/legal/terms-2026-05"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// VersionListResponse represents the response structure for listing policy versions
+type VersionListResponse struct {
+	Versions []VersionResponse `json:"versions"`
+}
+
+// AcceptRequest represents the request structure for accepting policy versions
+type AcceptRequest struct {
+	VersionIDs []uuid.UUID `json:"version_ids" binding:"required,min=1,max=10"`
+}
+
+// AcceptanceResponse represents a policy version the user accepted
+type AcceptanceResponse struct {
+	VersionResponse
+	AcceptedAt time.Time `json:"accepted_at"`
+}
+
+// ConsentsResponse represents the user's consent status
+// Pending lists the current versions the user still has to accept.
+type ConsentsResponse struct {
+	Accepted []AcceptanceResponse `json:"accepted"`
+	Pending  []VersionResponse    `json:"pending"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,25 @@
+package diagnostics
+
+// CaptureRequest represents the request structure for capturing profile dumps
+type CaptureRequest struct {
+	Profiles []string `json:"profiles" binding:"max=6,dive,oneof=goroutine heap allocs block mutex threadcreate" example:"goroutine,heap"` // Empty captures goroutines and heap
+}
+
+// DumpResponse represents a profile dump written on the instance that served the request
+type DumpResponse struct {
+	Profile string `json:"profile" example:"goroutine"`
+	Path    string `json:"path" example:"/tmp/enterprise-crud-dumps/goroutine-20261017T093000.000Z-1234567890.txt"`
+	Bytes   int64  `json:"bytes" example:"48213"`
+}
+
+// CaptureResponse represents the dumps captured by one request
+type CaptureResponse struct {
+	Host  string         `json:"host" example:"api-7f9c6d-2x4kq"` // Instance holding the files
+	Dumps []DumpResponse `json:"dumps"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,13 @@
+package emailpreview
+
+// TemplatesResponse represents the email templates that can be previewed
+type TemplatesResponse struct {
+	Templates []string `json:"templates" example:"event_update,gift_claim,notification,order_confirmation,password_reset,sales_summary"`
+	Locales   []string `json:"locales" example:"de,en"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
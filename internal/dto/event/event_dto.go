@@ -3,17 +3,62 @@ package event
 import (
 	"time"
 
+	"enterprise-crud/internal/dto/common"
+
 	"github.com/google/uuid"
 )
 
+// AttendeeQuestion is a custom question ticket buyers answer when ordering
+type AttendeeQuestion struct {
+	Key       string   `json:"key" binding:"required" example:"tshirt_size"`
+	Label     string   `json:"label" binding:"required" example:"T-shirt size"`
+	Type      string   `json:"type" binding:"required,oneof=text number choice boolean" example:"choice"`
+	Required  bool     `json:"required" example:"true"`
+	Options   []string `json:"options,omitempty" example:"S,M,L,XL"` // Allowed answers of choice questions
+	MaxLength int      `json:"max_length,omitempty" example:"200"`   // Longest text answer (default: 200)
+}
+
+// PriceTier sets the ticket price for orders placed between From and To, e.g. an early-bird price
+type PriceTier struct {
+	Name  string     `json:"name" example:"Early bird"`                     // Defaults to "Tier n"
+	Price float64    `json:"price" binding:"money" example:"35.00"`         // Price per ticket while the tier is active
+	From  *time.Time `json:"from,omitempty" example:"2024-06-01T10:00:00Z"` // Omit to start when the event is created
+	To    *time.Time `json:"to,omitempty" example:"2024-07-01T00:00:00Z"`   // Exclusive; omit to run until the event
+}
+
+// RefundPolicy sets the refunds buyers may ask for
+type RefundPolicy struct {
+	Type       string       `json:"type" example:"SLIDING"`                   // FLEXIBLE (default), NONE, DAYS_BEFORE or SLIDING
+	DaysBefore int          `json:"days_before,omitempty" example:"7"`        // Full refunds until this many days before the event; DAYS_BEFORE only
+	Tiers      []RefundTier `json:"tiers,omitempty" binding:"omitempty,dive"` // SLIDING only
+}
+
+// RefundTier refunds a percentage of the order to buyers asking at least DaysBefore days before the event
+type RefundTier struct {
+	DaysBefore int `json:"days_before" binding:"min=0" example:"30"`
+	Percent    int `json:"percent" binding:"min=1,max=100" example:"50"`
+}
+
 // CreateEventRequest represents the request to create a new event
 type CreateEventRequest struct {
-	VenueID      uuid.UUID `json:"venue_id" binding:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Title        string    `json:"title" binding:"required" example:"Summer Concert"`
-	Description  string    `json:"description" example:"An amazing summer concert with live music"`
-	EventDate    time.Time `json:"event_date" binding:"required" example:"2024-08-15T20:00:00Z"`
-	TicketPrice  float64   `json:"ticket_price" binding:"required,min=0" example:"50.00"`
-	TotalTickets int       `json:"total_tickets" binding:"required,min=1" example:"100"`
+	VenueID           uuid.UUID          `json:"venue_id" binding:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title             string             `json:"title" binding:"required" example:"Summer Concert"`
+	Description       string             `json:"description" example:"An amazing summer concert with live music"`
+	EventDate         time.Time          `json:"event_date" binding:"required" example:"2024-08-15T20:00:00Z"`
+	TicketPrice       float64            `json:"ticket_price" binding:"required,money" example:"50.00"`
+	TotalTickets      int                `json:"total_tickets" binding:"required,capacity" example:"100"`
+	Layout            string             `json:"layout,omitempty" example:"Seated"` // Venue layout to hold the event in; tickets must fit its capacity
+	AttendeeQuestions []AttendeeQuestion `json:"attendee_questions" binding:"omitempty,dive"`
+	PriceSchedule     []PriceTier        `json:"price_schedule" binding:"omitempty,dive"` // Tiers may not overlap; ticket_price applies outside them
+	RefundPolicy      *RefundPolicy      `json:"refund_policy,omitempty"`                 // Omit for a FLEXIBLE policy
+
+	AccessCodeRequired bool `json:"access_code_required" example:"false"` // Only buyers with one of the event's access codes may order
+
+	SaleStart *time.Time `json:"sale_start,omitempty" example:"2024-06-01T10:00:00Z"` // Omit to sell right away
+	SaleEnd   *time.Time `json:"sale_end,omitempty" example:"2024-08-15T18:00:00Z"`   // Omit to sell until the event is over
+
+	MinimumAge      int      `json:"minimum_age" binding:"min=0,max=99" example:"18"`     // Age buyers must have reached; 0 for no limit
+	ContentWarnings []string `json:"content_warnings" example:"strobe lights,loud music"` // Shown to buyers before they order
 }
 
 // UpdateEventRequest represents the request to update an existing event
@@ -22,36 +67,128 @@ type UpdateEventRequest struct {
 	Title        string    `json:"title" binding:"required" example:"Summer Concert - Updated"`
 	Description  string    `json:"description" example:"An amazing summer concert with live music - Updated"`
 	EventDate    time.Time `json:"event_date" binding:"required" example:"2024-08-15T20:00:00Z"`
-	TicketPrice  float64   `json:"ticket_price" binding:"required,min=0" example:"60.00"`
-	TotalTickets int       `json:"total_tickets" binding:"required,min=1" example:"150"`
+	TicketPrice  float64   `json:"ticket_price" binding:"required,money" example:"60.00"`
+	TotalTickets int       `json:"total_tickets" binding:"required,capacity" example:"150"`
+	Layout       *string   `json:"layout" example:"Seated"` // Omit to keep the current layout, "" for the whole venue
+
+	AttendeeQuestions *[]AttendeeQuestion `json:"attendee_questions" binding:"omitempty,dive"` // Omit to keep the current questions
+	PriceSchedule     *[]PriceTier        `json:"price_schedule" binding:"omitempty,dive"`     // Omit to keep the current tiers, [] to remove them
+	RefundPolicy      *RefundPolicy       `json:"refund_policy"`                               // Omit to keep the current policy; applies to requests opened afterwards
+
+	AccessCodeRequired *bool `json:"access_code_required" example:"true"` // Omit to keep the current setting
+
+	SaleStart *time.Time `json:"sale_start" example:"2024-06-01T10:00:00Z"` // Omit to keep the current start
+	SaleEnd   *time.Time `json:"sale_end" example:"2024-08-15T18:00:00Z"`   // Omit to keep the current end
+
+	MinimumAge      *int      `json:"minimum_age" binding:"omitempty,min=0,max=99" example:"18"` // Omit to keep the current limit, 0 to remove it
+	ContentWarnings *[]string `json:"content_warnings" example:"strobe lights,loud music"`       // Omit to keep the current warnings
 }
 
 // EventResponse represents the response when returning event data
 type EventResponse struct {
 	ID               uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
 	VenueID          uuid.UUID `json:"venue_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Layout           string    `json:"layout,omitempty" example:"Seated"` // Omitted for events using the whole venue
 	OrganizerID      uuid.UUID `json:"organizer_id" example:"550e8400-e29b-41d4-a716-446655440000"`
 	Title            string    `json:"title" example:"Summer Concert"`
+	Slug             string    `json:"slug" example:"summer-concert-k3x9qa"` // Changes with the title; old slugs redirect to the current one
 	Description      string    `json:"description" example:"An amazing summer concert with live music"`
 	EventDate        time.Time `json:"event_date" example:"2024-08-15T20:00:00Z"`
-	TicketPrice      float64   `json:"ticket_price" example:"50.00"`
+	TicketPrice      float64   `json:"ticket_price" example:"50.00"` // Price outside every tier of the price schedule
 	AvailableTickets int       `json:"available_tickets" example:"75"`
 	TotalTickets     int       `json:"total_tickets" example:"100"`
 	Status           string    `json:"status" example:"ACTIVE"`
-	CreatedAt        time.Time `json:"created_at" example:"2024-01-01T00:00:00Z"`
-	UpdatedAt        time.Time `json:"updated_at" example:"2024-01-01T00:00:00Z"`
+	ShortURL         string    `json:"short_url,omitempty" example:"https://tickets.example.com/e/aZ3kP9q"` // Omitted when the event has no short URL
+	ModerationStatus string    `json:"moderation_status" example:"APPROVED"`                                // FLAGGED events are hidden from public listings until an admin approves them
+	ModerationReason string    `json:"moderation_reason,omitempty" example:"contains review term \"rave\""` // Why the event was flagged
+
+	Branding *OrganizerBranding `json:"branding,omitempty"` // Omitted when the organizer has no branding
+
+	AttendeeQuestions []AttendeeQuestion `json:"attendee_questions"`
+
+	PriceSchedule []PriceTier `json:"price_schedule"`                              // Empty when the event has a single price
+	CurrentPrice  float64     `json:"current_price" example:"35.00"`               // Price per ticket of an order placed now
+	CurrentTier   string      `json:"current_tier,omitempty" example:"Early bird"` // Omitted when no tier is active
+
+	RefundPolicy RefundPolicy `json:"refund_policy"`
+
+	AccessCodeRequired bool `json:"access_code_required" example:"false"` // Orders need one of the event's access codes
+
+	SaleStart *time.Time `json:"sale_start,omitempty" example:"2024-06-01T10:00:00Z"` // Omitted when sales opened with the event
+	SaleEnd   *time.Time `json:"sale_end,omitempty" example:"2024-08-15T18:00:00Z"`   // Omitted when sales run until the event
+	OnSale    bool       `json:"on_sale" example:"true"`                              // Whether the sale window is open, refreshed periodically
+
+	MinimumAge      int      `json:"minimum_age" example:"18"`                            // Age buyers must have reached; 0 when there is no limit
+	ContentWarnings []string `json:"content_warnings" example:"strobe lights,loud music"` // Empty when there are none
+
+	CreatedAt time.Time `json:"created_at" example:"2024-01-01T00:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2024-01-01T00:00:00Z"`
 }
 
-// EventListResponse represents the response when returning a list of events
+// OrganizerBranding represents how the organizer styles their event pages
+type OrganizerBranding struct {
+	PrimaryColor string `json:"primary_color,omitempty" example:"#1a2b3c"`
+	AccentColor  string `json:"accent_color,omitempty" example:"#ff8800"`
+	LogoURL      string `json:"logo_url,omitempty" example:"https://tickets.example.com/api/v1/organizers/550e8400-e29b-41d4-a716-446655440000/branding/logo?v=20261017T093000.000000000Z"`
+}
+
+// EventListResponse represents a list of events
 type EventListResponse struct {
-	Events []EventResponse `json:"events"`
-	Count  int             `json:"count"`
+	common.ListResponse[EventResponse]
+}
+
+// RecommendedEventResponse represents an event recommended to the current user
+type RecommendedEventResponse struct {
+	EventResponse
+	Score   float64  `json:"score" example:"7"`                         // Higher is a better match; 0 for events picked only because they are soon
+	Reasons []string `json:"reasons" example:"SAME_VENUE,SELLING_FAST"` // SAME_VENUE, SAME_ORGANIZER, SELLING_FAST or UPCOMING
+}
+
+// RecommendedEventsResponse represents the events recommended to the current user, best match first
+type RecommendedEventsResponse struct {
+	common.ListResponse[RecommendedEventResponse]
+}
+
+// TrendingEventResponse represents an event with its trending score
+type TrendingEventResponse struct {
+	EventResponse
+	Score     float64 `json:"score" example:"42.5"`    // Weighted views and purchases, halving every half-life
+	Views     float64 `json:"views" example:"30.2"`    // Page views, decayed like the score
+	Purchases float64 `json:"purchases" example:"1.2"` // Orders placed, decayed like the score
+}
+
+// TrendingEventsResponse represents the trending events, highest score first
+type TrendingEventsResponse struct {
+	common.ListResponse[TrendingEventResponse]
+}
+
+// InventoryAdjustmentRequest represents the request to add tickets to an event or hold some back
+type InventoryAdjustmentRequest struct {
+	Delta  int    `json:"delta" binding:"required" example:"-20"` // Tickets added, or removed when negative; only unsold tickets can be removed
+	Reason string `json:"reason" binding:"required,max=500" example:"Production hold for camera platform"`
+}
+
+// InventoryAdjustmentResponse represents an inventory adjustment in the audit trail of an event
+type InventoryAdjustmentResponse struct {
+	ID               uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EventID          uuid.UUID  `json:"event_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ActorID          *uuid.UUID `json:"actor_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"` // Omitted once the user who made it is deleted
+	Delta            int        `json:"delta" example:"-20"`
+	Reason           string     `json:"reason" example:"Production hold for camera platform"`
+	TotalTickets     int        `json:"total_tickets" example:"130"`    // Total tickets of the event after the adjustment
+	AvailableTickets int        `json:"available_tickets" example:"55"` // Unsold tickets of the event after the adjustment
+	CreatedAt        time.Time  `json:"created_at" example:"2024-01-01T00:00:00Z"`
+}
+
+// InventoryAdjustmentListResponse represents the inventory adjustments of an event, newest first
+type InventoryAdjustmentListResponse struct {
+	common.ListResponse[InventoryAdjustmentResponse]
 }
 
 // ErrorResponse represents an error response
 type ErrorResponse struct {
-	Error   string `json:"error" example:"validation_error"`
-	Message string `json:"message" example:"Invalid input data"`
+	Error   string `json:"error" example:"EVENT_NOT_FOUND"` // Error code, listed by GET /api/v1/meta/error-codes
+	Message string `json:"message" example:"event with ID 9b2d4c1e-5f6a-4b7c-8d9e-0f1a2b3c4d5e not found"`
 }
 
 // SuccessResponse represents a success response
@@ -0,0 +1,13 @@
+package invoice
+
+// PendingResponse is returned while an invoice is being generated
+type PendingResponse struct {
+	Status  string `json:"status" example:"PENDING"`
+	Message string `json:"message" example:"Invoice is being generated, retry shortly"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
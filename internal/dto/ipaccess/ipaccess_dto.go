@@ -0,0 +1,35 @@
+package ipaccess
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateRuleRequest represents the request structure for adding an admin IP rule
+type CreateRuleRequest struct {
+	CIDR        string `json:"cidr" binding:"required,max=50" example:"203.0.113.0/24"`
+	Action      string `json:"action" binding:"required,oneof=ALLOW DENY" example:"ALLOW"`
+	Description string `json:"description" binding:"max=255" example:"Office network"`
+}
+
+// RuleResponse represents an admin IP rule
+type RuleResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	CIDR        string     `json:"cidr" example:"203.0.113.0/24"`
+	Action      string     `json:"action" example:"ALLOW"`
+	Description string     `json:"description,omitempty" example:"Office network"`
+	CreatedBy   *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// RuleListResponse represents the response structure for listing admin IP rules
+type RuleListResponse struct {
+	Rules []RuleResponse `json:"rules"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,50 @@
+package maintenance
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SetModeRequest represents the request structure for turning the maintenance switch on or off
+type SetModeRequest struct {
+	Enabled *bool  `json:"enabled" binding:"required" example:"true"`
+	Message string `json:"message" binding:"max=500" example:"We are upgrading the database and will be back shortly."` // Empty uses the configured message
+}
+
+// ScheduleWindowRequest represents the request structure for scheduling a maintenance window
+type ScheduleWindowRequest struct {
+	StartsAt time.Time `json:"starts_at" binding:"required" example:"2026-11-01T02:00:00Z"`
+	EndsAt   time.Time `json:"ends_at" binding:"required" example:"2026-11-01T03:00:00Z"`
+	Message  string    `json:"message" binding:"max=500" example:"Scheduled database upgrade"` // Empty uses the configured message
+}
+
+// WindowResponse represents a scheduled maintenance window
+type WindowResponse struct {
+	StartsAt time.Time `json:"starts_at" example:"2026-11-01T02:00:00Z"`
+	EndsAt   time.Time `json:"ends_at" example:"2026-11-01T03:00:00Z"`
+	Message  string    `json:"message,omitempty" example:"Scheduled database upgrade"`
+}
+
+// StatusResponse represents whether writes are currently rejected for maintenance
+type StatusResponse struct {
+	Active  bool            `json:"active" example:"false"`
+	Message string          `json:"message,omitempty" example:"We are upgrading the database and will be back shortly."` // Only while active
+	EndsAt  *time.Time      `json:"ends_at,omitempty" example:"2026-11-01T03:00:00Z"`                                    // Only while a window is active
+	Window  *WindowResponse `json:"window,omitempty"`                                                                    // The current or upcoming window
+}
+
+// AdminStatusResponse represents maintenance mode with the switch and who changed it last
+type AdminStatusResponse struct {
+	StatusResponse
+	Source        string     `json:"source,omitempty" example:"switch"` // config, switch or window; only while active
+	SwitchEnabled bool       `json:"switch_enabled" example:"false"`
+	UpdatedBy     *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,48 @@
+package messaging
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PostMessageRequest represents the request structure for writing in an order thread
+type PostMessageRequest struct {
+	Body string `json:"body" binding:"required" example:"Is there step-free access to the venue?"`
+}
+
+// MessageResponse represents one message in an order thread
+type MessageResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	OrderID     uuid.UUID  `json:"order_id"`
+	SenderID    uuid.UUID  `json:"sender_id"`
+	RecipientID uuid.UUID  `json:"recipient_id"`
+	SenderRole  string     `json:"sender_role" example:"BUYER"`
+	Body        string     `json:"body"`
+	ReadAt      *time.Time `json:"read_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ThreadResponse represents the messages of an order thread, oldest first
+type ThreadResponse struct {
+	Messages []MessageResponse `json:"messages"`
+	Count    int               `json:"count"`
+}
+
+// UnreadThreadResponse represents the unread messages of one thread
+type UnreadThreadResponse struct {
+	OrderID uuid.UUID `json:"order_id"`
+	Unread  int       `json:"unread"`
+}
+
+// UnreadCountsResponse represents a user's unread messages across their order threads
+type UnreadCountsResponse struct {
+	Total   int                    `json:"total"`
+	Threads []UnreadThreadResponse `json:"threads"` // Most recent first
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,17 @@
+package meta
+
+import "enterprise-crud/internal/dto/common"
+
+// ErrorCodeResponse describes an error code the API answers with
+type ErrorCodeResponse struct {
+	Code        string `json:"code" example:"EVENT_NOT_FOUND"`
+	Domain      string `json:"domain" example:"event"`                // event, order, user or venue
+	Kind        string `json:"kind" example:"NOT_FOUND"`              // Class of failure, e.g. INVALID, FORBIDDEN or CONFLICT
+	HTTPStatus  int    `json:"http_status" example:"404"`             // Status the API usually answers the code with
+	Description string `json:"description" example:"event not found"` // What the code means; the error message has the details
+}
+
+// ErrorCodeListResponse represents the catalogue of error codes
+type ErrorCodeListResponse struct {
+	common.ListResponse[ErrorCodeResponse]
+}
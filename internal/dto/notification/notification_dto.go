@@ -0,0 +1,102 @@
+package notification
+
+import (
+	"time"
+
+	"enterprise-crud/internal/dto/common"
+
+	"github.com/google/uuid"
+)
+
+// NotificationResponse represents a notification in the notification center
+type NotificationResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	Type      string     `json:"type" example:"ORDER_CONFIRMED"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	EventID   *uuid.UUID `json:"event_id,omitempty"`
+	OrderID   *uuid.UUID `json:"order_id,omitempty"`
+	Read      bool       `json:"read"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// NotificationListResponse represents the response structure for listing notifications
+type NotificationListResponse struct {
+	common.ListResponse[NotificationResponse]
+	UnreadCount int64 `json:"unread_count"` // Across all pages
+}
+
+// MarkAllReadResponse represents the response structure for marking all notifications read
+type MarkAllReadResponse struct {
+	Marked int64 `json:"marked"`
+}
+
+// PreferenceRequest represents the channels chosen for one notification type
+// Channels left out keep their current setting.
+type PreferenceRequest struct {
+	Type  string `json:"type" binding:"required" example:"EVENT_CHANGED"`
+	Email *bool  `json:"email,omitempty" example:"false"`
+	InApp *bool  `json:"in_app,omitempty" example:"true"`
+	Push  *bool  `json:"push,omitempty" example:"true"`
+}
+
+// UpdatePreferencesRequest represents the request structure for updating notification preferences
+// Types left out keep their current settings.
+type UpdatePreferencesRequest struct {
+	Preferences []PreferenceRequest `json:"preferences" binding:"required,min=1,dive"`
+}
+
+// PreferenceResponse represents the channels a notification type is delivered on
+type PreferenceResponse struct {
+	Type  string `json:"type" example:"EVENT_CHANGED"`
+	Email bool   `json:"email"`
+	InApp bool   `json:"in_app"`
+	Push  bool   `json:"push"`
+}
+
+// PreferencesResponse represents the response structure for notification preferences
+type PreferencesResponse struct {
+	Preferences []PreferenceResponse `json:"preferences"`
+}
+
+// RegisterDeviceRequest represents the request structure for registering a device for push notifications
+type RegisterDeviceRequest struct {
+	Platform string `json:"platform" binding:"required" example:"IOS"`
+	Token    string `json:"token" binding:"required,max=512"`
+}
+
+// DeviceResponse represents a device registered for push notifications
+type DeviceResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Platform  string    `json:"platform" example:"IOS"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// StartPhoneVerificationRequest represents the request structure for adding a phone number
+type StartPhoneVerificationRequest struct {
+	Phone string `json:"phone" binding:"required,max=30" example:"+14155550123"`
+}
+
+// VerifyPhoneRequest represents the request structure for confirming a phone number
+type VerifyPhoneRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric" example:"123456"`
+}
+
+// PhoneVerificationResponse represents a verification code that was sent
+type PhoneVerificationResponse struct {
+	Phone     string    `json:"phone" example:"+14155550123"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// PhoneResponse represents the verified phone number of the current user
+type PhoneResponse struct {
+	Phone      string     `json:"phone,omitempty" example:"+14155550123"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
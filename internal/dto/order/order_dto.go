@@ -3,36 +3,145 @@ package order
 import (
 	"time"
 
+	"enterprise-crud/internal/dto/common"
+
 	"github.com/google/uuid"
 )
 
 // CreateOrderRequest represents the request structure for creating a new order
 type CreateOrderRequest struct {
-	EventID  uuid.UUID `json:"event_id" binding:"required"`
-	Quantity int       `json:"quantity" binding:"required,min=1"`
+	EventID  uuid.UUID              `json:"event_id" binding:"required"`
+	Quantity int                    `json:"quantity" binding:"required,quantity"`
+	Notes    string                 `json:"notes" binding:"omitempty,max=1000"` // Optional note to the organizer
+	Answers  map[string]interface{} `json:"answers"`                            // Answers to the event's attendee questions by key
+
+	AccessCode string `json:"access_code,omitempty" example:"VIP-PRESALE"` // Needed for events that require an access code
+
+	// Buys the tickets for someone else: once paid, a claim link is emailed to this address
+	GiftRecipient string `json:"gift_recipient,omitempty" binding:"omitempty,email,max=255" example:"friend@example.com"`
 }
 
 // OrderResponse represents the response structure for order operations
 type OrderResponse struct {
-	ID          uuid.UUID `json:"id"`
-	UserID      uuid.UUID `json:"user_id"`
-	EventID     uuid.UUID `json:"event_id"`
+	ID            uuid.UUID              `json:"id"`
+	UserID        uuid.UUID              `json:"user_id"`
+	EventID       uuid.UUID              `json:"event_id"`
+	Quantity      int                    `json:"quantity"`
+	TotalAmount   float64                `json:"total_amount"`
+	Status        string                 `json:"status"`
+	CheckedInAt   *time.Time             `json:"checked_in_at,omitempty"`
+	Notes         string                 `json:"notes,omitempty"`
+	Answers       map[string]interface{} `json:"answers,omitempty"`
+	InvoiceNumber string                 `json:"invoice_number,omitempty" example:"INV-000042"` // Set once the invoice was requested
+	CreatedAt     time.Time              `json:"created_at"`
+	Archived      bool                   `json:"archived,omitempty"` // The event is archived; the order is read-only history
+	Gift          *GiftResponse          `json:"gift,omitempty"`     // Set on orders bought for someone else
+
+	// Set on the shares of a group reservation; unpaid shares are released at hold_until
+	GroupID   *uuid.UUID `json:"group_id,omitempty"`
+	HoldUntil *time.Time `json:"hold_until,omitempty"`
+}
+
+// Gift statuses
+const (
+	GiftStatusUnclaimed = "UNCLAIMED"
+	GiftStatusClaimed   = "CLAIMED"
+)
+
+// GiftResponse describes an order bought for someone else
+// Until the gift is claimed the order belongs to its buyer; afterwards to the recipient, with the buyer in gifted_by.
+type GiftResponse struct {
+	Recipient string     `json:"recipient" example:"friend@example.com"`
+	Status    string     `json:"status" example:"CLAIMED"`
+	GiftedBy  *uuid.UUID `json:"gifted_by,omitempty"`
+	ClaimedAt *time.Time `json:"claimed_at,omitempty"`
+}
+
+// ClaimGiftRequest represents the request structure for claiming a gift with the token from its claim link
+type ClaimGiftRequest struct {
+	Token string `json:"token" binding:"required,max=100"`
+}
+
+// CreateGroupRequest represents the request structure for reserving tickets for a group
+// Every share becomes a pending order with its own payment link; notes, answers and the access code apply to all of them.
+type CreateGroupRequest struct {
+	EventID uuid.UUID              `json:"event_id" binding:"required"`
+	Shares  []GroupShareRequest    `json:"shares" binding:"required,min=2,max=20,dive"`
+	Notes   string                 `json:"notes" binding:"omitempty,max=1000"`
+	Answers map[string]interface{} `json:"answers"`
+
+	AccessCode string `json:"access_code,omitempty" example:"VIP-PRESALE"`
+}
+
+// GroupShareRequest represents one member's share of a group reservation
+type GroupShareRequest struct {
+	Quantity int `json:"quantity" binding:"required,quantity" example:"2"`
+}
+
+// GroupResponse represents a group reservation with its shares
+type GroupResponse struct {
+	ID          uuid.UUID            `json:"id"`
+	EventID     uuid.UUID            `json:"event_id"`
+	HoldUntil   time.Time            `json:"hold_until"` // Shares not paid by then are released
+	TotalAmount float64              `json:"total_amount"`
+	Shares      []GroupShareResponse `json:"shares"`
+	CreatedAt   time.Time            `json:"created_at"`
+}
+
+// GroupShareResponse represents one member's share of a group reservation
+type GroupShareResponse struct {
+	OrderID     uuid.UUID `json:"order_id"`
 	Quantity    int       `json:"quantity"`
 	TotalAmount float64   `json:"total_amount"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"created_at"`
+	Status      string    `json:"status" example:"PENDING"`
+	Taken       bool      `json:"taken"`                                                                               // A member took the share with its payment link
+	HolderID    uuid.UUID `json:"holder_id"`                                                                           // The leader until the share is taken
+	PaymentURL  string    `json:"payment_url,omitempty" example:"https://tickets.example.com/groups/pay?token=3q2-7w"` // Only returned when the group is created
+}
+
+// TakeGroupShareRequest represents the request structure for taking a group share with the token from its payment link
+type TakeGroupShareRequest struct {
+	Token string `json:"token" binding:"required,max=100"`
+}
+
+// OrderStatusResponse represents the status of an order as returned to clients polling it
+type OrderStatusResponse struct {
+	Status    string    `json:"status" example:"COMPLETED"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CheckInRequest represents the request structure for checking in a ticket holder
+type CheckInRequest struct {
+	OrderID uuid.UUID `json:"order_id" binding:"required"`
 }
 
 // OrderListResponse represents the response structure for listing orders
 type OrderListResponse struct {
-	Orders []OrderResponse `json:"orders"`
-	Count  int             `json:"count"`
+	common.ListResponse[OrderResponse]
+}
+
+// ReviewOrderRequest represents an admin's decision on an order held for review
+type ReviewOrderRequest struct {
+	Approve *bool `json:"approve" binding:"required"` // true releases the order as PENDING, false fails it and returns its tickets
+}
+
+// ReviewOrderResponse represents an order held for review together with why it was held
+type ReviewOrderResponse struct {
+	OrderResponse
+	RiskScore   int      `json:"risk_score"`
+	RiskReasons []string `json:"risk_reasons"`
+	Country     string   `json:"country,omitempty"`
+}
+
+// ReviewQueueResponse represents the orders awaiting review, oldest first
+type ReviewQueueResponse struct {
+	common.ListResponse[ReviewOrderResponse]
 }
 
 // ErrorResponse represents error response structure
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message"`
+	Error   string `json:"error" example:"INSUFFICIENT_TICKETS"` // Error code, listed by GET /api/v1/meta/error-codes
+	Message string `json:"message" example:"Insufficient tickets: requested 4, available 2"`
 }
 
 // SuccessResponse represents success response structure
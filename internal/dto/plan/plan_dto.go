@@ -0,0 +1,37 @@
+package plan
+
+import (
+	"github.com/google/uuid"
+)
+
+// AssignPlanRequest represents the request structure for assigning a plan to a user
+type AssignPlanRequest struct {
+	Plan string `json:"plan" binding:"required" example:"PRO"`
+}
+
+// PlanResponse represents the response structure for plan operations
+type PlanResponse struct {
+	ID                 uuid.UUID `json:"id"`
+	Name               string    `json:"name"`
+	Description        string    `json:"description"`
+	MaxActiveEvents    int       `json:"max_active_events"`     // 0 means unlimited
+	MaxTicketsPerEvent int       `json:"max_tickets_per_event"` // 0 means unlimited
+}
+
+// PlanListResponse represents the response structure for listing plans
+type PlanListResponse struct {
+	Plans []PlanResponse `json:"plans"`
+	Count int            `json:"count"`
+}
+
+// UserPlanResponse represents the plan assigned to a user
+type UserPlanResponse struct {
+	UserID uuid.UUID    `json:"user_id"`
+	Plan   PlanResponse `json:"plan"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
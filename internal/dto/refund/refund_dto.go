@@ -0,0 +1,75 @@
+package refund
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OpenRefundRequest represents the request structure for opening a refund request or dispute
+type OpenRefundRequest struct {
+	Kind   string `json:"kind" binding:"required" example:"REFUND"` // REFUND or DISPUTE
+	Reason string `json:"reason" binding:"required" example:"I can no longer attend"`
+}
+
+// ApproveRefundRequest represents the request structure for approving a refund request
+type ApproveRefundRequest struct {
+	Amount float64 `json:"amount,omitempty" binding:"omitempty,gt=0" example:"49.99"` // Defaults to the refundable amount
+	Reason string  `json:"reason" binding:"required" example:"Approved as a goodwill gesture"`
+}
+
+// DenyRefundRequest represents the request structure for denying a refund request
+type DenyRefundRequest struct {
+	Reason string `json:"reason" binding:"required" example:"Refunds close 30 days before the event"`
+}
+
+// RefundResponse represents a refund request or dispute
+type RefundResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	OrderID           uuid.UUID  `json:"order_id"`
+	EventID           uuid.UUID  `json:"event_id"`
+	EventTitle        string     `json:"event_title,omitempty"`
+	UserID            uuid.UUID  `json:"user_id"`
+	Email             string     `json:"email,omitempty"`
+	Kind              string     `json:"kind" example:"REFUND"`
+	Status            string     `json:"status" example:"OPEN"`
+	Reason            string     `json:"reason"`
+	OrderAmount       float64    `json:"order_amount" example:"99.98"`
+	RefundableAmount  float64    `json:"refundable_amount" example:"49.99"` // Most the event's refund policy allows
+	Amount            float64    `json:"amount" example:"99.98"`            // Refunded amount, 0 until approved
+	Decision          string     `json:"decision,omitempty"`
+	DecidedBy         *uuid.UUID `json:"decided_by,omitempty"`
+	DecidedAt         *time.Time `json:"decided_at,omitempty"`
+	ProviderReference string     `json:"provider_reference,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// RefundDetailResponse represents a refund request with its audit trail
+type RefundDetailResponse struct {
+	RefundResponse
+	History []AuditEntryResponse `json:"history"` // Oldest first
+}
+
+// AuditEntryResponse represents one recorded change of a refund request
+type AuditEntryResponse struct {
+	Action    string     `json:"action" example:"APPROVED"`
+	ActorID   *uuid.UUID `json:"actor_id,omitempty"` // Omitted for the payment provider's outcome
+	Amount    float64    `json:"amount"`
+	Note      string     `json:"note"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// RefundListResponse represents a page of the refund queue
+type RefundListResponse struct {
+	Requests []RefundResponse `json:"requests"`
+	Count    int              `json:"count"`
+	Limit    int              `json:"limit"`
+	Offset   int              `json:"offset"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
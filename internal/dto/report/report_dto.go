@@ -0,0 +1,61 @@
+package report
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UpdateScheduleRequest represents the request structure for configuring report emails
+type UpdateScheduleRequest struct {
+	Frequency string `json:"frequency" binding:"required" example:"WEEKLY"`
+	Enabled   *bool  `json:"enabled" binding:"required" example:"true"`
+}
+
+// ScheduleResponse represents the response structure for report schedule operations
+type ScheduleResponse struct {
+	Frequency  string     `json:"frequency"`
+	Enabled    bool       `json:"enabled"`
+	NextRunAt  *time.Time `json:"next_run_at,omitempty"` // Only set while enabled
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+}
+
+// EventSalesResponse represents the sales of one event within a summary
+type EventSalesResponse struct {
+	EventID     uuid.UUID `json:"event_id"`
+	Title       string    `json:"title"`
+	Orders      int       `json:"orders"`
+	TicketsSold int       `json:"tickets_sold"`
+	Revenue     float64   `json:"revenue"`
+}
+
+// SalesSummaryResponse represents the response structure for a sales summary
+type SalesSummaryResponse struct {
+	From         time.Time            `json:"from"`
+	To           time.Time            `json:"to"`
+	TotalOrders  int                  `json:"total_orders"`
+	TotalTickets int                  `json:"total_tickets"`
+	TotalRevenue float64              `json:"total_revenue"`
+	Events       []EventSalesResponse `json:"events"`
+}
+
+// ForecastResponse represents the sellout forecast of an event
+type ForecastResponse struct {
+	EventID       uuid.UUID  `json:"event_id"`
+	Title         string     `json:"title"`
+	EventDate     time.Time  `json:"event_date"`
+	TotalTickets  int        `json:"total_tickets"`
+	TicketsSold   int        `json:"tickets_sold"`
+	TicketsPerDay float64    `json:"tickets_per_day" example:"42.5"` // Sales rate fitted over the sales so far
+	Fit           float64    `json:"fit" example:"0.93"`             // R² of the fit; low values mean sales are irregular
+	SoldOut       bool       `json:"sold_out"`
+	SelloutAt     *time.Time `json:"sellout_at,omitempty"`          // Projected sellout; omitted when sold out, too few sales or not before the event
+	ProjectedSold int        `json:"projected_sold" example:"1800"` // Tickets projected to be sold by the event date
+	GeneratedAt   time.Time  `json:"generated_at"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
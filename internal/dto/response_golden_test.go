@@ -0,0 +1,251 @@
+package dto_test
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/dto/accesscode"
+	"enterprise-crud/internal/dto/account"
+	"enterprise-crud/internal/dto/analytics"
+	"enterprise-crud/internal/dto/branding"
+	"enterprise-crud/internal/dto/common"
+	"enterprise-crud/internal/dto/consent"
+	"enterprise-crud/internal/dto/diagnostics"
+	"enterprise-crud/internal/dto/emailpreview"
+	"enterprise-crud/internal/dto/event"
+	"enterprise-crud/internal/dto/invoice"
+	"enterprise-crud/internal/dto/ipaccess"
+	"enterprise-crud/internal/dto/job"
+	"enterprise-crud/internal/dto/maintenance"
+	"enterprise-crud/internal/dto/messaging"
+	"enterprise-crud/internal/dto/meta"
+	"enterprise-crud/internal/dto/notification"
+	"enterprise-crud/internal/dto/order"
+	"enterprise-crud/internal/dto/plan"
+	"enterprise-crud/internal/dto/refund"
+	"enterprise-crud/internal/dto/report"
+	"enterprise-crud/internal/dto/share"
+	"enterprise-crud/internal/dto/shorturl"
+	"enterprise-crud/internal/dto/staff"
+	"enterprise-crud/internal/dto/transfer"
+	"enterprise-crud/internal/dto/user"
+	"enterprise-crud/internal/dto/venue"
+	"enterprise-crud/internal/dto/webhook"
+	"enterprise-crud/internal/golden"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// responses lists every response DTO by package; each package has one golden file
+var responses = map[string][]interface{}{
+	"accesscode": {
+		accesscode.AccessCodeResponse{}, accesscode.AccessCodeListResponse{}, accesscode.RedemptionResponse{},
+		accesscode.RedemptionListResponse{}, accesscode.ErrorResponse{}, accesscode.SuccessResponse{},
+	},
+	"account": {
+		account.ExportResponse{}, account.DeletionResponse{}, account.ErrorResponse{},
+	},
+	"analytics": {
+		analytics.DailySnapshotResponse{}, analytics.DailySnapshotsResponse{}, analytics.SalesDayResponse{},
+		analytics.SalesTrendResponse{}, analytics.ErrorResponse{},
+	},
+	"branding": {
+		branding.BrandingResponse{}, branding.ErrorResponse{},
+	},
+	"common": {
+		common.ErrorResponse{}, common.SuccessResponse{}, common.ListResponse[string]{},
+	},
+	"consent": {
+		consent.VersionResponse{}, consent.VersionListResponse{}, consent.AcceptanceResponse{},
+		consent.ConsentsResponse{}, consent.ErrorResponse{},
+	},
+	"diagnostics": {
+		diagnostics.CaptureResponse{}, diagnostics.DumpResponse{}, diagnostics.ErrorResponse{},
+	},
+	"emailpreview": {
+		emailpreview.TemplatesResponse{}, emailpreview.ErrorResponse{},
+	},
+	"event": {
+		event.EventResponse{}, event.EventListResponse{},
+		event.RecommendedEventResponse{}, event.RecommendedEventsResponse{},
+		event.TrendingEventResponse{}, event.TrendingEventsResponse{},
+		event.InventoryAdjustmentResponse{}, event.InventoryAdjustmentListResponse{}, event.ErrorResponse{}, event.SuccessResponse{},
+	},
+	"invoice": {
+		invoice.PendingResponse{}, invoice.ErrorResponse{},
+	},
+	"ipaccess": {
+		ipaccess.RuleResponse{}, ipaccess.RuleListResponse{}, ipaccess.ErrorResponse{},
+	},
+	"job": {
+		job.JobResponse{}, job.JobListResponse{}, job.ErrorResponse{},
+	},
+	"maintenance": {
+		maintenance.StatusResponse{}, maintenance.AdminStatusResponse{}, maintenance.WindowResponse{}, maintenance.ErrorResponse{},
+	},
+	"meta": {
+		meta.ErrorCodeResponse{}, meta.ErrorCodeListResponse{},
+	},
+	"messaging": {
+		messaging.MessageResponse{}, messaging.ThreadResponse{}, messaging.UnreadThreadResponse{},
+		messaging.UnreadCountsResponse{}, messaging.ErrorResponse{},
+	},
+	"notification": {
+		notification.NotificationResponse{}, notification.NotificationListResponse{}, notification.MarkAllReadResponse{},
+		notification.PreferenceResponse{}, notification.PreferencesResponse{}, notification.DeviceResponse{},
+		notification.PhoneVerificationResponse{}, notification.PhoneResponse{}, notification.ErrorResponse{},
+	},
+	"order": {
+		order.OrderResponse{}, order.OrderStatusResponse{}, order.OrderListResponse{}, order.ReviewOrderResponse{}, order.GiftResponse{},
+		order.GroupResponse{}, order.GroupShareResponse{},
+		order.ReviewQueueResponse{}, order.ErrorResponse{}, order.SuccessResponse{},
+	},
+	"plan": {
+		plan.PlanResponse{}, plan.PlanListResponse{}, plan.UserPlanResponse{}, plan.ErrorResponse{},
+	},
+	"refund": {
+		refund.RefundResponse{}, refund.RefundDetailResponse{}, refund.AuditEntryResponse{},
+		refund.RefundListResponse{}, refund.ErrorResponse{},
+	},
+	"report": {
+		report.ScheduleResponse{}, report.EventSalesResponse{}, report.SalesSummaryResponse{}, report.ForecastResponse{}, report.ErrorResponse{},
+	},
+	"share": {
+		share.ShareResponse{}, share.ErrorResponse{},
+	},
+	"shorturl": {
+		shorturl.ShortURLResponse{}, shorturl.ErrorResponse{},
+	},
+	"staff": {
+		staff.StaffResponse{}, staff.StaffListResponse{}, staff.ErrorResponse{}, staff.SuccessResponse{},
+	},
+	"transfer": {
+		transfer.TransferResponse{}, transfer.TransferDetailResponse{}, transfer.AuditEntryResponse{},
+		transfer.TransferListResponse{}, transfer.ErrorResponse{},
+	},
+	"user": {
+		user.UserResponse{}, user.UsernameAvailabilityResponse{}, user.DateOfBirthResponse{}, user.AccountStatusResponse{},
+		user.StatusChangeResponse{}, user.LoginResponse{}, user.PolicyResponse{}, user.ErrorResponse{},
+	},
+	"venue": {
+		venue.VenueResponse{}, venue.VenueListResponse{}, venue.ErrorResponse{}, venue.SuccessResponse{},
+	},
+	"webhook": {
+		webhook.SubscriptionResponse{}, webhook.CreatedSubscriptionResponse{}, webhook.SubscriptionListResponse{},
+		webhook.ErrorResponse{},
+	},
+}
+
+// TestResponses_MatchGoldenFiles fails when a response DTO's JSON changes
+// Every field is filled, omitempty ones included, so renamed fields, changed types and dropped tags all show up.
+func TestResponses_MatchGoldenFiles(t *testing.T) {
+	for pkg, values := range responses {
+		t.Run(pkg, func(t *testing.T) {
+			shapes := make(map[string]interface{}, len(values))
+			for _, value := range values {
+				typ := reflect.TypeOf(value)
+				shapes[typeName(typ)] = sample(typ).Interface()
+			}
+			golden.AssertJSON(t, pkg, shapes)
+		})
+	}
+}
+
+// TestResponses_AllRegistered fails when a response type is added without a golden shape
+func TestResponses_AllRegistered(t *testing.T) {
+	registered := make(map[string]bool)
+	for pkg, values := range responses {
+		for _, value := range values {
+			registered[pkg+"."+typeName(reflect.TypeOf(value))] = true
+		}
+	}
+
+	files, err := filepath.Glob("*/*.go")
+	require.NoError(t, err)
+	var missing []string
+	for _, file := range files {
+		parsed, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.SkipObjectResolution)
+		require.NoError(t, err)
+		for _, decl := range parsed.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				name := spec.(*ast.TypeSpec).Name.Name
+				key := parsed.Name.Name + "." + name
+				if strings.HasSuffix(name, "Response") && !registered[key] {
+					missing = append(missing, key)
+				}
+			}
+		}
+	}
+	sort.Strings(missing)
+	require.Empty(t, missing, "add these types to responses and run the test with -update")
+}
+
+// typeName returns a type's name without its package or type arguments
+func typeName(typ reflect.Type) string {
+	name, _, _ := strings.Cut(typ.Name(), "[")
+	return name
+}
+
+var (
+	sampleTime = time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC)
+	sampleID   = uuid.MustParse("00000000-0000-4000-8000-000000000001")
+)
+
+// sample returns a value of typ with every field set
+// Each kind gets a fixed value, so the golden files only change when a shape does.
+func sample(typ reflect.Type) reflect.Value {
+	v := reflect.New(typ).Elem()
+	switch typ {
+	case reflect.TypeOf(time.Time{}):
+		v.Set(reflect.ValueOf(sampleTime))
+		return v
+	case reflect.TypeOf(uuid.UUID{}):
+		v.Set(reflect.ValueOf(sampleID))
+		return v
+	case reflect.TypeOf(json.RawMessage{}):
+		v.Set(reflect.ValueOf(json.RawMessage(`{"key":"value"}`)))
+		return v
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		v.SetString("string")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.Pointer:
+		v.Set(sample(typ.Elem()).Addr())
+	case reflect.Slice:
+		v.Set(reflect.Append(reflect.MakeSlice(typ, 0, 1), sample(typ.Elem())))
+	case reflect.Map:
+		v.Set(reflect.MakeMap(typ))
+		v.SetMapIndex(sample(typ.Key()), sample(typ.Elem()))
+	case reflect.Interface:
+		v.Set(reflect.ValueOf("value"))
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			if typ.Field(i).IsExported() {
+				v.Field(i).Set(sample(typ.Field(i).Type))
+			}
+		}
+	}
+	return v
+}
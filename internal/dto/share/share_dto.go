@@ -0,0 +1,27 @@
+package share
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShareResponse represents the share metadata of an event
+// Fields map onto Open Graph tags: og:title, og:description, og:image and og:url
+type ShareResponse struct {
+	EventID      uuid.UUID `json:"event_id"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	ImageURL     string    `json:"image_url,omitempty"` // Omitted when no share image is configured
+	CanonicalURL string    `json:"canonical_url"`
+	ShortURL     string    `json:"short_url,omitempty"` // Omitted when short links are unavailable
+	StartTime    time.Time `json:"start_time"`
+	VenueName    string    `json:"venue_name,omitempty"`
+	Status       string    `json:"status"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,22 @@
+package shorturl
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShortURLResponse represents an event's short URL and its click count
+type ShortURLResponse struct {
+	EventID   uuid.UUID `json:"event_id"`
+	Code      string    `json:"code" example:"aZ3kP9q"`
+	ShortURL  string    `json:"short_url" example:"https://tickets.example.com/e/aZ3kP9q"`
+	Clicks    int64     `json:"clicks" example:"42"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
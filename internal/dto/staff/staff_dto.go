@@ -0,0 +1,44 @@
+package staff
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InviteStaffRequest represents the request structure for inviting event staff
+type InviteStaffRequest struct {
+	Email string `json:"email" binding:"required,email" example:"door@example.com"`
+	Role  string `json:"role" binding:"required" example:"CHECK_IN"`
+}
+
+// StaffResponse represents a staff assignment or invitation
+type StaffResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	EventID    uuid.UUID  `json:"event_id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	Email      string     `json:"email,omitempty"`
+	Username   string     `json:"username,omitempty"`
+	Role       string     `json:"role" example:"CHECK_IN"`
+	Status     string     `json:"status" example:"PENDING"`
+	InvitedBy  uuid.UUID  `json:"invited_by"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// StaffListResponse represents the response structure for listing staff or invitations
+type StaffListResponse struct {
+	Staff []StaffResponse `json:"staff"`
+	Count int             `json:"count"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// SuccessResponse represents success response structure
+type SuccessResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,51 @@
+package transfer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransferEventRequest represents the request structure for handing an event to another organizer
+type TransferEventRequest struct {
+	Email string `json:"email" binding:"required,email" example:"new.organizer@example.com"`
+}
+
+// TransferResponse represents an event ownership transfer
+type TransferResponse struct {
+	ID              uuid.UUID  `json:"id"`
+	EventID         uuid.UUID  `json:"event_id"`
+	EventTitle      string     `json:"event_title,omitempty"`
+	FromOrganizerID uuid.UUID  `json:"from_organizer_id"`
+	ToOrganizerID   uuid.UUID  `json:"to_organizer_id"`
+	Status          string     `json:"status" example:"PENDING"`
+	RequestedBy     uuid.UUID  `json:"requested_by"`
+	RespondedAt     *time.Time `json:"responded_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// TransferDetailResponse represents a transfer with its audit trail
+type TransferDetailResponse struct {
+	TransferResponse
+	History []AuditEntryResponse `json:"history"` // Oldest first
+}
+
+// AuditEntryResponse represents one recorded change of a transfer
+type AuditEntryResponse struct {
+	Action    string    `json:"action" example:"ACCEPTED"`
+	ActorID   uuid.UUID `json:"actor_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TransferListResponse represents the response structure for listing transfers
+type TransferListResponse struct {
+	Transfers []TransferResponse `json:"transfers"`
+	Count     int                `json:"count"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
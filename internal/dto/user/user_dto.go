@@ -1,6 +1,10 @@
 package user
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // CreateUserRequest represents the request payload for creating a new user
 // Contains required fields for user registration
@@ -8,6 +12,13 @@ type CreateUserRequest struct {
 	Email    string `json:"email" binding:"required,email" example:"user@example.com"` // User's email address - must be unique
 	Username string `json:"username" binding:"required,min=3" example:"john_doe"`      // Username - must be at least 3 characters
 	Password string `json:"password" binding:"required,min=8" example:"password123"`   // Password - must be at least 8 characters
+
+	// AcceptTerms accepts the current terms of service and privacy policy (see GET /api/v1/policies)
+	// Required once policies have been published
+	AcceptTerms bool `json:"accept_terms" example:"true"`
+
+	// GiftToken claims the gift whose claim link led to the registration
+	GiftToken string `json:"gift_token,omitempty" binding:"omitempty,max=100"`
 }
 
 // UserResponse represents the response payload for user operations
@@ -19,11 +30,53 @@ type UserResponse struct {
 	Roles    []string  `json:"roles" example:"USER,ADMIN"`                        // User's roles in the system
 }
 
+// UsernameAvailabilityResponse reports whether a username can still be registered
+type UsernameAvailabilityResponse struct {
+	Username  string `json:"username" example:"john_doe"`
+	Available bool   `json:"available" example:"true"`
+}
+
+// DateOfBirthRequest represents the request payload for setting the current user's date of birth
+type DateOfBirthRequest struct {
+	DateOfBirth *string `json:"date_of_birth" example:"1990-05-17"` // YYYY-MM-DD; null removes it
+}
+
+// DateOfBirthResponse represents the current user's date of birth, checked when ordering for age-restricted events
+type DateOfBirthResponse struct {
+	DateOfBirth *string `json:"date_of_birth" example:"1990-05-17"` // YYYY-MM-DD; null when not given
+}
+
+// AccountStatusRequest represents the request payload for suspending, banning or reactivating a user
+type AccountStatusRequest struct {
+	Reason string `json:"reason" binding:"max=500" example:"Chargebacks on several orders"` // Required to suspend or ban; shown to the user
+}
+
+// AccountStatusResponse represents a user's account status and how it got there
+type AccountStatusResponse struct {
+	UserID    uuid.UUID              `json:"user_id"`
+	Email     string                 `json:"email" example:"user@example.com"`
+	Status    string                 `json:"status" example:"SUSPENDED"`
+	Reason    string                 `json:"reason,omitempty" example:"Chargebacks on several orders"`
+	ChangedAt *time.Time             `json:"changed_at,omitempty"`
+	History   []StatusChangeResponse `json:"history"` // Newest first
+}
+
+// StatusChangeResponse represents one change of a user's account status
+type StatusChangeResponse struct {
+	Status    string     `json:"status" example:"SUSPENDED"`
+	Reason    string     `json:"reason,omitempty"`
+	ChangedBy *uuid.UUID `json:"changed_by,omitempty"` // Admin who made the change; omitted for automatic suspensions
+	CreatedAt time.Time  `json:"created_at"`
+}
+
 // LoginRequest represents the request payload for user login
 // Contains credentials for authentication
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email" example:"user@example.com"` // User's email address
 	Password string `json:"password" binding:"required" example:"password123"`         // User's password
+
+	// AcceptTerms accepts the policy versions published since the user last accepted them
+	AcceptTerms bool `json:"accept_terms" example:"false"`
 }
 
 // LoginResponse represents the response payload for successful login
@@ -32,11 +85,24 @@ type LoginResponse struct {
 	User      UserResponse `json:"user"`                                                    // User information
 	Token     string       `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."` // JWT access token
 	ExpiresAt int64        `json:"expires_at" example:"1735689600"`                         // Token expiration timestamp
+
+	// PendingPolicies lists current policy versions the user has not accepted yet
+	// Clients should show them and log in again with accept_terms, or accept them through the consents API
+	PendingPolicies []PolicyResponse `json:"pending_policies,omitempty"`
+}
+
+// PolicyResponse represents a policy version the user is asked to accept
+type PolicyResponse struct {
+	ID      uuid.UUID `json:"id"`
+	Type    string    `json:"type" example:"TERMS"`
+	Version string    `json:"version" example:"2026-05"`
+	URL     string    `json:"url" example:"https://example.com/legal/terms-2026-05"`
 }
 
 // ErrorResponse represents error response structure
 // Provides consistent error messaging across the API
 type ErrorResponse struct {
 	Error   string `json:"error" example:"Error message"`                        // Error message
+	Code    string `json:"code,omitempty" example:"USERNAME_EXISTS"`             // Domain error code, when the error has one
 	Message string `json:"message,omitempty" example:"Additional error details"` // Additional error details
 }
@@ -3,46 +3,66 @@ package venue
 import (
 	"time"
 
+	"enterprise-crud/internal/dto/common"
+
 	"github.com/google/uuid"
 )
 
+// VenueLayout is a named capacity configuration of a venue, e.g. standing or seated
+type VenueLayout struct {
+	Name     string `json:"name" binding:"required,max=50" example:"Seated"`
+	Capacity int    `json:"capacity" binding:"required,capacity" example:"800"` // At most the venue's capacity
+}
+
 // CreateVenueRequest represents the request structure for creating a new venue
 type CreateVenueRequest struct {
 	Name        string `json:"name" binding:"required,min=1,max=255"`
 	Address     string `json:"address" binding:"required,min=1"`
-	Capacity    int    `json:"capacity" binding:"required,min=1"`
+	Capacity    int    `json:"capacity" binding:"required,capacity"`
 	Description string `json:"description,omitempty"`
+
+	Layouts []VenueLayout `json:"layouts,omitempty" binding:"omitempty,max=20,dive"`
 }
 
 // UpdateVenueRequest represents the request structure for updating a venue
 type UpdateVenueRequest struct {
 	Name        string `json:"name" binding:"required,min=1,max=255"`
 	Address     string `json:"address" binding:"required,min=1"`
-	Capacity    int    `json:"capacity" binding:"required,min=1"`
+	Capacity    int    `json:"capacity" binding:"required,capacity"`
 	Description string `json:"description,omitempty"`
+
+	Layouts *[]VenueLayout `json:"layouts" binding:"omitempty,max=20,dive"` // Omit to keep the current layouts
 }
 
 // VenueResponse represents the response structure for venue operations
 type VenueResponse struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Address     string    `json:"address"`
-	Capacity    int       `json:"capacity"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Address     string     `json:"address"`
+	Capacity    int        `json:"capacity"`
+	Description string     `json:"description"`
+	OwnerID     *uuid.UUID `json:"owner_id"` // Null for venues only admins manage
+
+	Layouts []VenueLayout `json:"layouts"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TransferVenueRequest represents the request to hand a venue to another organizer
+type TransferVenueRequest struct {
+	OwnerID uuid.UUID `json:"owner_id" binding:"required" example:"550e8400-e29b-41d4-a716-446655440000"` // An active ORGANIZER or ADMIN
 }
 
 // VenueListResponse represents the response structure for listing venues
 type VenueListResponse struct {
-	Venues []VenueResponse `json:"venues"`
-	Count  int             `json:"count"`
+	common.ListResponse[VenueResponse]
 }
 
 // ErrorResponse represents error response structure
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message"`
+	Error   string `json:"error" example:"VENUE_NOT_FOUND"` // Error code, listed by GET /api/v1/meta/error-codes
+	Message string `json:"message" example:"venue with ID 9b2d4c1e-5f6a-4b7c-8d9e-0f1a2b3c4d5e not found"`
 }
 
 // SuccessResponse represents success response structure
@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateSubscriptionRequest represents the request structure for subscribing an endpoint to order status changes
+type CreateSubscriptionRequest struct {
+	URL    string   `json:"url" binding:"required,max=2048" example:"https://erp.example.com/hooks/tickets"`
+	Events []string `json:"events,omitempty" example:"order.completed,order.refunded"` // Every event when left out
+}
+
+// SubscriptionResponse represents a webhook subscription
+type SubscriptionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	URL       string    `json:"url" example:"https://erp.example.com/hooks/tickets"`
+	Events    []string  `json:"events" example:"order.completed,order.refunded"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreatedSubscriptionResponse represents a new webhook subscription with the secret its deliveries are signed with
+type CreatedSubscriptionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	URL       string    `json:"url" example:"https://erp.example.com/hooks/tickets"`
+	Events    []string  `json:"events" example:"order.completed,order.refunded"`
+	Secret    string    `json:"secret" example:"whsec_5f2b8c..."` // Only returned now; store it to verify signatures
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SubscriptionListResponse represents the webhook subscriptions of the current user, oldest first
+type SubscriptionListResponse struct {
+	Subscriptions []SubscriptionResponse `json:"subscriptions"`
+	Count         int                    `json:"count"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
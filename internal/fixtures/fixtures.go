@@ -0,0 +1,167 @@
+// Package fixtures provides a small, consistent set of sample data: roles, an account per role,
+// venues, events and orders. IDs are fixed so links and bookmarks keep working across restarts;
+// dates are relative to the time the data is built so the events stay upcoming.
+package fixtures
+
+import (
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/plan"
+	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/domain/venue"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Password is the password of every fixture account
+const Password = "password123"
+
+// Fixture account emails, one per role
+const (
+	AdminEmail     = "admin@example.com"
+	OrganizerEmail = "organizer@example.com"
+	UserEmail      = "user@example.com"
+)
+
+// Fixed IDs of the fixture records
+var (
+	AdminRoleID     = uuid.MustParse("00000000-0000-4000-8000-000000000011")
+	UserRoleID      = uuid.MustParse("00000000-0000-4000-8000-000000000012")
+	OrganizerRoleID = uuid.MustParse("00000000-0000-4000-8000-000000000013")
+
+	FreePlanID       = uuid.MustParse("00000000-0000-4000-8000-000000000021")
+	ProPlanID        = uuid.MustParse("00000000-0000-4000-8000-000000000022")
+	EnterprisePlanID = uuid.MustParse("00000000-0000-4000-8000-000000000023")
+
+	AdminID     = uuid.MustParse("00000000-0000-4000-8000-000000000001")
+	OrganizerID = uuid.MustParse("00000000-0000-4000-8000-000000000002")
+	UserID      = uuid.MustParse("00000000-0000-4000-8000-000000000003")
+
+	ArenaID = uuid.MustParse("00000000-0000-4000-8000-000000000101")
+	ClubID  = uuid.MustParse("00000000-0000-4000-8000-000000000102")
+
+	ConcertID  = uuid.MustParse("00000000-0000-4000-8000-000000000201")
+	WorkshopID = uuid.MustParse("00000000-0000-4000-8000-000000000202")
+	SoldOutID  = uuid.MustParse("00000000-0000-4000-8000-000000000203")
+	PastID     = uuid.MustParse("00000000-0000-4000-8000-000000000204")
+
+	ConcertOrderID = uuid.MustParse("00000000-0000-4000-8000-000000000301")
+	PastOrderID    = uuid.MustParse("00000000-0000-4000-8000-000000000302")
+	SoldOutOrderID = uuid.MustParse("00000000-0000-4000-8000-000000000303")
+)
+
+// Data is a set of related records to load into a store
+type Data struct {
+	Roles  []role.Role
+	Plans  []plan.Plan // The plans migrations seed
+	Users  []user.User // Passwords are bcrypt hashes of Password
+	Venues []venue.Venue
+	Events []event.Event
+	Orders []order.Order
+}
+
+// Default builds the standard fixture data with dates relative to now
+func Default(now time.Time) *Data {
+	now = now.UTC().Truncate(time.Second)
+
+	roles := map[role.Name]role.Role{
+		role.RoleAdmin:     {ID: AdminRoleID, Name: role.RoleAdmin, Description: "Administrator with full access", CreatedAt: now, UpdatedAt: now},
+		role.RoleUser:      {ID: UserRoleID, Name: role.RoleUser, Description: "Regular user with basic access", CreatedAt: now, UpdatedAt: now},
+		role.RoleOrganizer: {ID: OrganizerRoleID, Name: role.RoleOrganizer, Description: "Event organizer with event management access", CreatedAt: now, UpdatedAt: now},
+	}
+
+	// The minimum cost keeps startup fast; these accounts only ever guard sample data
+	hash, err := bcrypt.GenerateFromPassword([]byte(Password), bcrypt.MinCost)
+	if err != nil {
+		panic(err) // Only fails for passwords longer than 72 bytes
+	}
+	account := func(id uuid.UUID, email, username string, names ...role.Name) user.User {
+		u := user.User{
+			ID:        id,
+			Email:     email,
+			Username:  username,
+			Password:  string(hash),
+			Status:    user.StatusActive,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		for _, name := range names {
+			u.Roles = append(u.Roles, roles[name])
+		}
+		return u
+	}
+
+	venueAt := func(id uuid.UUID, name, address string, capacity int, description string) venue.Venue {
+		return venue.Venue{ID: id, Name: name, Address: address, Capacity: capacity, Description: description, OwnerID: &OrganizerID, CreatedAt: now, UpdatedAt: now}
+	}
+
+	eventAt := func(id, venueID uuid.UUID, title string, date time.Time, price float64, total, available int) event.Event {
+		return event.Event{
+			ID:                id,
+			VenueID:           venueID,
+			OrganizerID:       OrganizerID,
+			Title:             title,
+			Slug:              event.Slugify(title) + "-" + id.String()[:6],
+			Description:       "Sample event for local development",
+			EventDate:         date,
+			TicketPrice:       price,
+			TotalTickets:      total,
+			AvailableTickets:  available,
+			AttendeeQuestions: event.Questions{},
+			Status:            event.StatusActive,
+			ModerationStatus:  event.ModerationApproved,
+			OnSale:            true,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		}
+	}
+
+	// The arena is standing for concerts and seated for shows
+	arena := venueAt(ArenaID, "City Arena", "1 Stadium Way, Springfield", 500, "Indoor arena for concerts and sports")
+	arena.Layouts = venue.Layouts{{Name: "Standing", Capacity: 500}, {Name: "Seated", Capacity: 300}}
+
+	// The organizer is on PRO, as FREE's three active events are taken by the fixtures already
+	organizer := account(OrganizerID, OrganizerEmail, "organizer", role.RoleOrganizer, role.RoleUser)
+	organizer.PlanID = &ProPlanID
+
+	workshop := eventAt(WorkshopID, ClubID, "Go Workshop", now.AddDate(0, 0, 14), 25, 40, 40)
+	workshop.AttendeeQuestions = event.Questions{
+		{Key: "experience", Label: "How long have you written Go?", Type: event.QuestionChoice, Required: true, Options: []string{"Never", "Under a year", "Over a year"}},
+		{Key: "dietary", Label: "Dietary requirements", Type: event.QuestionText, MaxLength: 200},
+	}
+	past := eventAt(PastID, ArenaID, "Last Season Finale", now.AddDate(0, -1, 0), 45, 500, 498)
+	past.Status = event.StatusCompleted
+
+	return &Data{
+		Roles: []role.Role{roles[role.RoleAdmin], roles[role.RoleUser], roles[role.RoleOrganizer]},
+		Plans: []plan.Plan{
+			{ID: FreePlanID, Name: plan.PlanFree, Description: "Default plan for new organizers", MaxActiveEvents: 3, MaxTicketsPerEvent: 500, CreatedAt: now, UpdatedAt: now},
+			{ID: ProPlanID, Name: plan.PlanPro, Description: "Paid plan for regular organizers", MaxActiveEvents: 25, MaxTicketsPerEvent: 10000, CreatedAt: now, UpdatedAt: now},
+			{ID: EnterprisePlanID, Name: plan.PlanEnterprise, Description: "Unlimited events and tickets", CreatedAt: now, UpdatedAt: now},
+		},
+		Users: []user.User{
+			account(AdminID, AdminEmail, "admin", role.RoleAdmin, role.RoleUser),
+			organizer,
+			account(UserID, UserEmail, "user", role.RoleUser),
+		},
+		Venues: []venue.Venue{
+			arena,
+			venueAt(ClubID, "Riverside Club", "42 River Street, Springfield", 40, "Small room for workshops and meetups"),
+		},
+		Events: []event.Event{
+			eventAt(ConcertID, ArenaID, "Summer Concert", now.AddDate(0, 0, 30), 59.99, 500, 496),
+			workshop,
+			eventAt(SoldOutID, ClubID, "Sold Out Meetup", now.AddDate(0, 0, 7), 0, 40, 0),
+			past,
+		},
+		Orders: []order.Order{
+			{ID: ConcertOrderID, UserID: UserID, EventID: ConcertID, Quantity: 4, TotalAmount: 239.96, Status: order.StatusCompleted, CreatedAt: now.Add(-time.Hour), UpdatedAt: now.Add(-time.Hour)},
+			{ID: SoldOutOrderID, UserID: AdminID, EventID: SoldOutID, Quantity: 40, TotalAmount: 0, Status: order.StatusCompleted, CreatedAt: now.AddDate(0, 0, -3), UpdatedAt: now.AddDate(0, 0, -3)},
+			{ID: PastOrderID, UserID: UserID, EventID: PastID, Quantity: 2, TotalAmount: 90, Status: order.StatusCompleted, CreatedAt: now.AddDate(0, -2, 0), UpdatedAt: now.AddDate(0, -2, 0)},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+// Package golden compares test output with checked-in golden files
+// Run the tests with -update to rewrite the files after an intended change, then review the diff.
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files with the current output")
+
+// AssertJSON marshals got as indented JSON and compares it with testdata/<name>.golden
+func AssertJSON(t *testing.T, name string, got interface{}) {
+	t.Helper()
+
+	data, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatalf("marshal %s: %v", name, err)
+	}
+	Assert(t, name, data)
+}
+
+// Assert compares got with testdata/<name>.golden
+// JSON is reindented first, so compact response bodies produce readable files.
+func Assert(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	var indented bytes.Buffer
+	if json.Indent(&indented, got, "", "  ") == nil {
+		got = indented.Bytes()
+	}
+	got = append(bytes.TrimRight(got, "\n"), '\n')
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v (run the test with -update to create it)", path, err)
+	}
+	if !bytes.Equal(want, got) {
+		t.Errorf("%s does not match the output; if the change is intended, rerun with -update and review the diff\n--- want\n%s\n--- got\n%s", path, want, got)
+	}
+}
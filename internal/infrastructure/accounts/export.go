@@ -0,0 +1,154 @@
+// Package accounts builds personal data exports and deletes accounts whose grace period has ended
+package accounts
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"enterprise-crud/internal/domain/account"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/infrastructure/jobs"
+
+	"github.com/google/uuid"
+)
+
+// archiveReadme explains the export to the person downloading it
+const archiveReadme = `This archive contains the personal data stored about your account.
+Each JSON file holds one section:
+
+  export.json                    when and for which account the export was made
+  profile.json                   your account details and roles
+  orders.json                    orders you placed, with notes, answers and gift recipients you gave
+  tickets.json                   tickets issued to you
+  notifications.json             notifications sent to you
+  notification_preferences.json  the channels you chose per notification type
+  devices.json                   devices registered for push notifications
+  staff_assignments.json         your roles on other organizers' events
+  organized_events.json          events you organize
+  consents.json                  the terms of service and privacy policy versions you accepted
+  webhooks.json                  endpoints you subscribed to your orders' status changes
+  messages.json                  messages you sent and received about your orders and events
+  refund_requests.json           refunds and disputes you opened, with the reasons you gave
+  refund_audit_log.json          every change of your refund requests and of those you decided
+  status_changes.json            suspensions and reinstatements of your account, with their reasons
+  event_transfers.json           transfers of events to or from you
+  event_transfer_audit_log.json  every change of those transfers
+  access_code_redemptions.json   access codes you redeemed for invitation-only events
+  order_groups.json              group reservations you lead
+  report_schedule.json           your sales report email settings, if any
+`
+
+// BuildArchive packs personal data into a ZIP with one JSON file per section
+func BuildArchive(exportID uuid.UUID, data *account.PersonalData) ([]byte, error) {
+	files := []struct {
+		name    string
+		content interface{}
+	}{
+		{"export.json", map[string]interface{}{"export_id": exportID, "user_id": data.Profile.ID, "exported_at": data.ExportedAt}},
+		{"profile.json", data.Profile},
+		{"orders.json", nonNil(data.Orders)},
+		{"tickets.json", nonNil(data.Tickets)},
+		{"notifications.json", nonNil(data.Notifications)},
+		{"notification_preferences.json", nonNil(data.NotificationPreferences)},
+		{"devices.json", nonNil(data.Devices)},
+		{"staff_assignments.json", nonNil(data.StaffAssignments)},
+		{"organized_events.json", nonNil(data.OrganizedEvents)},
+		{"consents.json", nonNil(data.Consents)},
+		{"webhooks.json", nonNil(data.Webhooks)},
+		{"messages.json", nonNil(data.Messages)},
+		{"refund_requests.json", nonNil(data.RefundRequests)},
+		{"refund_audit_log.json", nonNil(data.RefundAuditLog)},
+		{"status_changes.json", nonNil(data.StatusChanges)},
+		{"event_transfers.json", nonNil(data.EventTransfers)},
+		{"event_transfer_audit_log.json", nonNil(data.TransferAuditLog)},
+		{"access_code_redemptions.json", nonNil(data.AccessCodeRedemptions)},
+		{"order_groups.json", nonNil(data.OrderGroups)},
+		{"report_schedule.json", data.ReportSchedule},
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	modified := data.ExportedAt
+	if modified.IsZero() {
+		modified = time.Now()
+	}
+
+	write := func(name string, content []byte) error {
+		w, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(content)
+		return err
+	}
+
+	if err := write("README.txt", []byte(archiveReadme)); err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		content, err := json.MarshalIndent(file.content, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", file.name, err)
+		}
+		if err := write(file.name, content); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// nonNil encodes empty sections as [] rather than null
+func nonNil[T any](records []T) []T {
+	if records == nil {
+		return []T{}
+	}
+	return records
+}
+
+// ExportHandler returns the job handler that builds a user's data export into storage
+// The export is marked failed when the job gives up, so the user can request a new one.
+func ExportHandler(accountService account.Service) jobs.Handler {
+	return func(ctx context.Context, j *job.Job) error {
+		var payload account.ExportPayload
+		if err := json.Unmarshal(j.Payload, &payload); err != nil {
+			return fmt.Errorf("%w: invalid payload: %v", job.ErrPermanent, err)
+		}
+
+		err := buildExport(ctx, accountService, payload.ExportID)
+		if err != nil && (errors.Is(err, job.ErrPermanent) || !j.HasAttemptsLeft()) {
+			if failErr := accountService.FailExport(ctx, payload.ExportID); failErr != nil {
+				log.Printf("Warning: Failed to mark export %s as failed: %v", payload.ExportID, failErr)
+			}
+		}
+		return err
+	}
+}
+
+// buildExport gathers, packs and stores one export
+func buildExport(ctx context.Context, accountService account.Service, exportID uuid.UUID) error {
+	export, data, err := accountService.PrepareExport(ctx, exportID)
+	if err != nil {
+		if account.IsExportNotFoundError(err) || account.IsUserNotFoundError(err) {
+			return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+		}
+		return err
+	}
+	if data == nil {
+		return nil
+	}
+
+	archive, err := BuildArchive(export.ID, data)
+	if err != nil {
+		return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+	}
+	return accountService.SaveExport(ctx, export, archive)
+}
@@ -0,0 +1,181 @@
+package accounts
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/account"
+	"enterprise-crud/internal/domain/job"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAccountService is a mock implementation of account.Service
+// Only the export methods are used by the job handler.
+type mockAccountService struct {
+	account.Service
+	mock.Mock
+}
+
+func (m *mockAccountService) PrepareExport(ctx context.Context, exportID uuid.UUID) (*account.Export, *account.PersonalData, error) {
+	args := m.Called(ctx, exportID)
+	export, _ := args.Get(0).(*account.Export)
+	data, _ := args.Get(1).(*account.PersonalData)
+	return export, data, args.Error(2)
+}
+
+func (m *mockAccountService) SaveExport(ctx context.Context, export *account.Export, archive []byte) error {
+	args := m.Called(ctx, export, archive)
+	return args.Error(0)
+}
+
+func (m *mockAccountService) FailExport(ctx context.Context, exportID uuid.UUID) error {
+	args := m.Called(ctx, exportID)
+	return args.Error(0)
+}
+
+func exportJob(t *testing.T, exportID uuid.UUID, attempts int) *job.Job {
+	data, err := json.Marshal(account.ExportPayload{ExportID: exportID})
+	require.NoError(t, err)
+	return &job.Job{ID: uuid.New(), Type: account.JobTypeExport, Payload: data, Attempts: attempts, MaxAttempts: 3}
+}
+
+// readArchive returns the files of a ZIP by name
+func readArchive(t *testing.T, archive []byte) map[string][]byte {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte)
+	for _, f := range reader.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = content
+	}
+	return files
+}
+
+func TestBuildArchive(t *testing.T) {
+	userID := uuid.New()
+	exportID := uuid.New()
+	data := &account.PersonalData{
+		ExportedAt: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC),
+		Profile:    account.Profile{ID: userID, Email: "user@example.com", Username: "user", Roles: []string{"USER"}},
+		Orders: []account.OrderRecord{{
+			ID:         uuid.New(),
+			EventTitle: "Go Conference",
+			Quantity:   2,
+			Notes:      "wheelchair access",
+			Answers:    map[string]interface{}{"tshirt_size": "M"},
+
+			GiftRecipient: "friend@example.com",
+		}},
+		Webhooks: []account.WebhookRecord{{URL: "https://erp.example.com/hooks", Events: []string{"order.completed"}}},
+		Messages: []account.MessageRecord{{OrderID: uuid.New(), SenderRole: "BUYER", Sent: true, Body: "Is there parking?"}},
+
+		RefundRequests:        []account.RefundRequestRecord{{ID: uuid.New(), Kind: "REFUND", Status: "OPEN", Reason: "I am ill"}},
+		StatusChanges:         []account.StatusChangeRecord{{Status: "SUSPENDED", Reason: "chargebacks"}},
+		AccessCodeRedemptions: []account.RedemptionRecord{{EventID: uuid.New(), Code: "VIP2026", Quantity: 1}},
+	}
+
+	archive, err := BuildArchive(exportID, data)
+	require.NoError(t, err)
+	files := readArchive(t, archive)
+
+	assert.Contains(t, files, "README.txt")
+	assert.Contains(t, string(files["profile.json"]), "user@example.com")
+	assert.Contains(t, string(files["orders.json"]), "wheelchair access")
+	assert.Contains(t, string(files["orders.json"]), "tshirt_size")
+	assert.Contains(t, string(files["export.json"]), exportID.String())
+	assert.Contains(t, string(files["webhooks.json"]), "https://erp.example.com/hooks")
+	assert.Contains(t, string(files["messages.json"]), "Is there parking?")
+	assert.Contains(t, string(files["orders.json"]), "friend@example.com")
+	assert.Contains(t, string(files["refund_requests.json"]), "I am ill")
+	assert.Contains(t, string(files["status_changes.json"]), "chargebacks")
+	assert.Contains(t, string(files["access_code_redemptions.json"]), "VIP2026")
+	assert.JSONEq(t, "[]", string(files["event_transfers.json"]))
+	assert.JSONEq(t, "[]", string(files["order_groups.json"]))
+	assert.JSONEq(t, "[]", string(files["tickets.json"]), "empty sections are empty lists")
+	assert.JSONEq(t, "null", string(files["report_schedule.json"]))
+}
+
+func TestExportHandler(t *testing.T) {
+	exportID := uuid.New()
+	export := &account.Export{ID: exportID, UserID: uuid.New(), Status: account.ExportPending}
+	data := &account.PersonalData{Profile: account.Profile{ID: export.UserID, Email: "user@example.com"}}
+
+	t.Run("stores the archive", func(t *testing.T) {
+		service := new(mockAccountService)
+		service.On("PrepareExport", mock.Anything, exportID).Return(export, data, nil)
+		service.On("SaveExport", mock.Anything, export, mock.MatchedBy(func(archive []byte) bool {
+			return bytes.Contains(readArchive(t, archive)["profile.json"], []byte("user@example.com"))
+		})).Return(nil)
+
+		err := ExportHandler(service)(context.Background(), exportJob(t, exportID, 1))
+
+		require.NoError(t, err)
+		service.AssertExpectations(t)
+	})
+
+	t.Run("skips exports that are already done", func(t *testing.T) {
+		service := new(mockAccountService)
+		service.On("PrepareExport", mock.Anything, exportID).Return(&account.Export{ID: exportID, Status: account.ExportReady}, nil, nil)
+
+		err := ExportHandler(service)(context.Background(), exportJob(t, exportID, 1))
+
+		require.NoError(t, err)
+		service.AssertNotCalled(t, "SaveExport", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("retries transient errors without failing the export", func(t *testing.T) {
+		service := new(mockAccountService)
+		service.On("PrepareExport", mock.Anything, exportID).Return(nil, nil, errors.New("connection reset"))
+
+		err := ExportHandler(service)(context.Background(), exportJob(t, exportID, 1))
+
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, job.ErrPermanent))
+		service.AssertNotCalled(t, "FailExport", mock.Anything, mock.Anything)
+	})
+
+	t.Run("fails the export on the last attempt", func(t *testing.T) {
+		service := new(mockAccountService)
+		service.On("PrepareExport", mock.Anything, exportID).Return(nil, nil, errors.New("connection reset"))
+		service.On("FailExport", mock.Anything, exportID).Return(nil)
+
+		err := ExportHandler(service)(context.Background(), exportJob(t, exportID, 3))
+
+		require.Error(t, err)
+		service.AssertExpectations(t)
+	})
+
+	t.Run("deleted user is permanent", func(t *testing.T) {
+		service := new(mockAccountService)
+		service.On("PrepareExport", mock.Anything, exportID).Return(nil, nil, account.ErrUserNotFound)
+		service.On("FailExport", mock.Anything, exportID).Return(nil)
+
+		err := ExportHandler(service)(context.Background(), exportJob(t, exportID, 1))
+
+		assert.ErrorIs(t, err, job.ErrPermanent)
+		service.AssertExpectations(t)
+	})
+
+	t.Run("invalid payload is permanent", func(t *testing.T) {
+		j := &job.Job{Type: account.JobTypeExport, Payload: []byte(`{"export_id":`)}
+
+		err := ExportHandler(new(mockAccountService))(context.Background(), j)
+
+		assert.ErrorIs(t, err, job.ErrPermanent)
+	})
+}
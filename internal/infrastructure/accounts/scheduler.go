@@ -0,0 +1,77 @@
+package accounts
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/domain/account"
+)
+
+// DeletionScheduler periodically deletes accounts whose deletion grace period has ended
+// Several instances may run it; each account is claimed atomically so it is deleted once.
+type DeletionScheduler struct {
+	accountService account.Service
+	interval       time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDeletionScheduler creates a scheduler checking for due deletions every interval
+func NewDeletionScheduler(accountService account.Service, interval time.Duration) *DeletionScheduler {
+	return &DeletionScheduler{
+		accountService: accountService,
+		interval:       interval,
+	}
+}
+
+// Start begins checking for due deletions in the background
+func (s *DeletionScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go s.run(ctx)
+
+	log.Printf("Account deletion scheduler started, checking every %s", s.interval)
+}
+
+// Stop halts the scheduler and waits for the current check to finish
+func (s *DeletionScheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+	log.Println("Account deletion scheduler stopped")
+}
+
+func (s *DeletionScheduler) run(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick deletes every account due at now
+func (s *DeletionScheduler) tick(ctx context.Context, now time.Time) {
+	deleted, err := s.accountService.ProcessDueDeletions(ctx, now)
+	if err != nil {
+		log.Printf("Warning: Failed to process due account deletions: %v", err)
+	}
+	if deleted > 0 {
+		log.Printf("Deleted %d accounts after their grace period", deleted)
+	}
+}
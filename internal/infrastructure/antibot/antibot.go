@@ -0,0 +1,150 @@
+// Package antibot challenges risky registrations and checkouts with a CAPTCHA
+// Requests are risky when their address is unusually busy or their email uses a
+// disposable domain; only those have to present a token from the configured provider.
+package antibot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/config"
+)
+
+var (
+	// ErrChallengeRequired is returned for risky requests without a CAPTCHA token
+	ErrChallengeRequired = errors.New("antibot: challenge required")
+
+	// ErrChallengeFailed is returned when the provider rejects the CAPTCHA token
+	ErrChallengeFailed = errors.New("antibot: challenge failed")
+
+	// ErrProviderUnavailable is returned when the provider could not be asked
+	ErrProviderUnavailable = errors.New("antibot: provider unavailable")
+)
+
+// Attempt describes a request to check
+type Attempt struct {
+	Action string // What is being protected, e.g. "register" or "checkout"; velocity is counted per action
+	IP     string // Client address
+	Email  string // Email address involved, if known
+	Token  string // CAPTCHA token sent by the client, if any
+}
+
+// Verifier checks CAPTCHA tokens with a provider
+// It returns false for tokens the provider rejects and an error only when the provider could not answer.
+type Verifier interface {
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
+
+// Counter counts attempts per key within a fixed window
+type Counter interface {
+	// Incr adds an attempt and returns the attempts in the current window, including this one
+	Incr(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
+// Guard decides which attempts need a CAPTCHA and verifies the tokens
+type Guard struct {
+	verifier   Verifier
+	counter    Counter
+	provider   string
+	siteKey    string
+	limit      int64
+	window     time.Duration
+	disposable map[string]bool
+}
+
+// NewGuard creates a guard for the configured provider
+// It fails for unknown providers, so a typo does not silently switch the protection off.
+func NewGuard(cfg *config.AntiBotConfig, counter Counter) (*Guard, error) {
+	verifier, err := NewVerifier(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	disposable := make(map[string]bool, len(disposableDomains)+len(cfg.DisposableDomains))
+	for _, domain := range disposableDomains {
+		disposable[domain] = true
+	}
+	for _, domain := range cfg.DisposableDomains {
+		disposable[strings.ToLower(strings.TrimSpace(domain))] = true
+	}
+
+	return &Guard{
+		verifier:   verifier,
+		counter:    counter,
+		provider:   cfg.Provider,
+		siteKey:    cfg.SiteKey,
+		limit:      int64(cfg.VelocityLimit),
+		window:     cfg.VelocityWindow,
+		disposable: disposable,
+	}, nil
+}
+
+// Provider returns the CAPTCHA provider clients must render
+func (g *Guard) Provider() string {
+	return g.provider
+}
+
+// SiteKey returns the public key clients render the CAPTCHA widget with
+func (g *Guard) SiteKey() string {
+	return g.siteKey
+}
+
+// Check lets low-risk attempts through and requires a valid CAPTCHA token from risky ones
+func (g *Guard) Check(ctx context.Context, attempt Attempt) error {
+	if !g.isRisky(ctx, attempt) {
+		return nil
+	}
+	if attempt.Token == "" {
+		return ErrChallengeRequired
+	}
+
+	ok, err := g.verifier.Verify(ctx, attempt.Token, attempt.IP)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrProviderUnavailable, err)
+	}
+	if !ok {
+		return ErrChallengeFailed
+	}
+	return nil
+}
+
+// isRisky applies the risk heuristics
+// Every attempt is counted, so an address keeps needing a CAPTCHA until its window ends.
+// Counter failures are logged and ignored: blocking all sign-ups during a Redis outage is worse.
+func (g *Guard) isRisky(ctx context.Context, attempt Attempt) bool {
+	risky := g.IsDisposableEmail(attempt.Email)
+
+	if g.limit > 0 && attempt.IP != "" {
+		count, err := g.counter.Incr(ctx, "antibot:"+attempt.Action+":"+attempt.IP, g.window)
+		if err != nil {
+			log.Printf("Warning: Failed to count %s attempts from %s: %v", attempt.Action, attempt.IP, err)
+		} else if count > g.limit {
+			risky = true
+		}
+	}
+	return risky
+}
+
+// IsDisposableEmail reports whether the email's domain, or a parent domain, is a known disposable provider
+func (g *Guard) IsDisposableEmail(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := strings.ToLower(strings.TrimSpace(email[at+1:]))
+	for domain != "" {
+		if g.disposable[domain] {
+			return true
+		}
+		dot := strings.Index(domain, ".")
+		if dot < 0 {
+			break
+		}
+		domain = domain[dot+1:]
+	}
+	return false
+}
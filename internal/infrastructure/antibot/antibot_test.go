@@ -0,0 +1,155 @@
+package antibot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubVerifier accepts the token "good"
+type stubVerifier struct {
+	err   error
+	calls int
+}
+
+func (v *stubVerifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	v.calls++
+	return token == "good", v.err
+}
+
+func newTestGuard(t *testing.T, limit int) (*Guard, *stubVerifier) {
+	guard, err := NewGuard(&config.AntiBotConfig{
+		Provider:          "turnstile",
+		SiteKey:           "site-key",
+		SecretKey:         "secret",
+		VelocityLimit:     limit,
+		VelocityWindow:    time.Hour,
+		DisposableDomains: []string{"Throwaway.Example"},
+	}, NewMemoryCounter())
+	require.NoError(t, err)
+	verifier := &stubVerifier{}
+	guard.verifier = verifier
+	return guard, verifier
+}
+
+func TestNewGuard_UnknownProvider(t *testing.T) {
+	_, err := NewGuard(&config.AntiBotConfig{Provider: "captchaland", SecretKey: "secret"}, NewMemoryCounter())
+	assert.Error(t, err)
+
+	_, err = NewGuard(&config.AntiBotConfig{Provider: "hcaptcha"}, NewMemoryCounter())
+	assert.Error(t, err, "a secret key is required")
+}
+
+func TestGuard_IsDisposableEmail(t *testing.T) {
+	guard, _ := newTestGuard(t, 0)
+
+	assert.True(t, guard.IsDisposableEmail("bot@mailinator.com"))
+	assert.True(t, guard.IsDisposableEmail("bot@eu.Mailinator.com"))
+	assert.True(t, guard.IsDisposableEmail("bot@throwaway.example"), "configured domains are added")
+	assert.False(t, guard.IsDisposableEmail("jane@example.com"))
+	assert.False(t, guard.IsDisposableEmail("not-an-email"))
+}
+
+func TestGuard_Check(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("low-risk attempts pass without a token", func(t *testing.T) {
+		guard, verifier := newTestGuard(t, 2)
+
+		assert.NoError(t, guard.Check(ctx, Attempt{Action: "register", IP: "198.51.100.1", Email: "jane@example.com"}))
+		assert.Zero(t, verifier.calls)
+	})
+
+	t.Run("disposable email needs a token", func(t *testing.T) {
+		guard, _ := newTestGuard(t, 2)
+		attempt := Attempt{Action: "register", IP: "198.51.100.1", Email: "bot@yopmail.com"}
+
+		assert.ErrorIs(t, guard.Check(ctx, attempt), ErrChallengeRequired)
+
+		attempt.Token = "bad"
+		assert.ErrorIs(t, guard.Check(ctx, attempt), ErrChallengeFailed)
+
+		attempt.Token = "good"
+		assert.NoError(t, guard.Check(ctx, attempt))
+	})
+
+	t.Run("busy address needs a token once over the limit", func(t *testing.T) {
+		guard, _ := newTestGuard(t, 2)
+		attempt := Attempt{Action: "checkout", IP: "198.51.100.1"}
+
+		assert.NoError(t, guard.Check(ctx, attempt))
+		assert.NoError(t, guard.Check(ctx, attempt))
+		assert.ErrorIs(t, guard.Check(ctx, attempt), ErrChallengeRequired)
+		assert.NoError(t, guard.Check(ctx, Attempt{Action: "register", IP: "198.51.100.1"}), "actions are counted separately")
+		assert.NoError(t, guard.Check(ctx, Attempt{Action: "checkout", IP: "198.51.100.2"}))
+	})
+
+	t.Run("provider outage is reported", func(t *testing.T) {
+		guard, verifier := newTestGuard(t, 2)
+		verifier.err = errors.New("timeout")
+
+		err := guard.Check(ctx, Attempt{Action: "register", Email: "bot@yopmail.com", Token: "good"})
+
+		assert.ErrorIs(t, err, ErrProviderUnavailable)
+	})
+}
+
+func TestMemoryCounter_Incr(t *testing.T) {
+	counter := NewMemoryCounter()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	counter.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	count, _ := counter.Incr(ctx, "k", time.Minute)
+	assert.Equal(t, int64(1), count)
+	count, _ = counter.Incr(ctx, "k", time.Minute)
+	assert.Equal(t, int64(2), count)
+
+	now = now.Add(time.Minute)
+	count, _ = counter.Incr(ctx, "k", time.Minute)
+	assert.Equal(t, int64(1), count, "a new window starts after the old one ends")
+}
+
+func TestSiteVerifier_Verify(t *testing.T) {
+	var response string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "secret", r.PostForm.Get("secret"))
+		assert.Equal(t, "token", r.PostForm.Get("response"))
+		assert.Equal(t, "198.51.100.1", r.PostForm.Get("remoteip"))
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	verifier, err := NewVerifier(&config.AntiBotConfig{Provider: "turnstile", SecretKey: "secret", Timeout: time.Second})
+	require.NoError(t, err)
+	verifier.(*SiteVerifier).endpoint = server.URL
+
+	t.Run("valid token", func(t *testing.T) {
+		response = `{"success":true}`
+		ok, err := verifier.Verify(context.Background(), "token", "198.51.100.1")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("rejected token", func(t *testing.T) {
+		response = `{"success":false,"error-codes":["invalid-input-response"]}`
+		ok, err := verifier.Verify(context.Background(), "token", "198.51.100.1")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("wrong secret is an error, not a bot", func(t *testing.T) {
+		response = `{"success":false,"error-codes":["invalid-input-secret"]}`
+		_, err := verifier.Verify(context.Background(), "token", "198.51.100.1")
+		assert.Error(t, err)
+	})
+}
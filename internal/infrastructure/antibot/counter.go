@@ -0,0 +1,75 @@
+package antibot
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/infrastructure/cache"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisCounter counts attempts in Redis, so every instance sees the same velocity
+type RedisCounter struct {
+	client *redis.Client
+}
+
+// NewRedisCounter creates a counter on the given Redis client
+func NewRedisCounter(redisClient *cache.RedisClient) *RedisCounter {
+	return &RedisCounter{client: redisClient.GetClient()}
+}
+
+// Incr counts an attempt; the window starts with the first attempt under the key
+func (c *RedisCounter) Incr(ctx context.Context, key string, window time.Duration) (int64, error) {
+	pipe := c.client.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// MemoryCounter counts attempts in process memory, for single instances without Redis
+type MemoryCounter struct {
+	mu      sync.Mutex
+	windows map[string]*counterWindow
+	now     func() time.Time
+}
+
+type counterWindow struct {
+	count   int64
+	expires time.Time
+}
+
+// memorySweepSize is how many keys are kept before expired windows are swept
+const memorySweepSize = 10000
+
+// NewMemoryCounter creates an empty in-memory counter
+func NewMemoryCounter() *MemoryCounter {
+	return &MemoryCounter{windows: make(map[string]*counterWindow), now: time.Now}
+}
+
+// Incr counts an attempt; the window starts with the first attempt under the key
+func (c *MemoryCounter) Incr(ctx context.Context, key string, window time.Duration) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.windows) >= memorySweepSize {
+		for k, w := range c.windows {
+			if !now.Before(w.expires) {
+				delete(c.windows, k)
+			}
+		}
+	}
+
+	w, ok := c.windows[key]
+	if !ok || !now.Before(w.expires) {
+		w = &counterWindow{expires: now.Add(window)}
+		c.windows[key] = w
+	}
+	w.count++
+	return w.count, nil
+}
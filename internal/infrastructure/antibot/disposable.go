@@ -0,0 +1,26 @@
+package antibot
+
+// disposableDomains are common throwaway email providers; more can be added in configuration
+// Subdomains match too, so "mailinator.com" also covers "x.mailinator.com".
+var disposableDomains = []string{
+	"10minutemail.com",
+	"discard.email",
+	"dispostable.com",
+	"emailondeck.com",
+	"fakeinbox.com",
+	"getnada.com",
+	"guerrillamail.com",
+	"guerrillamail.net",
+	"maildrop.cc",
+	"mailinator.com",
+	"mailnesia.com",
+	"mintemail.com",
+	"mohmal.com",
+	"sharklasers.com",
+	"spamgourmet.com",
+	"temp-mail.org",
+	"tempmail.dev",
+	"throwawaymail.com",
+	"trashmail.com",
+	"yopmail.com",
+}
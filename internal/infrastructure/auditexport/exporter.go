@@ -0,0 +1,181 @@
+package auditexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/audit"
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/infrastructure/metrics"
+)
+
+// Exporter copies the audit trails to a sink, batch by batch and in the order entries were created
+// It pulls entries from the database rather than being handed them, so the database is the buffer:
+// it reads the next batch of a source only after the sink acknowledged the previous one, and its
+// cursor moves past a batch only then. A slow sink slows the export, a failing one stops it with
+// backoff, and neither loses entries or holds up the requests that wrote them. Entries of a batch
+// that failed part way are sent again, so delivery is at least once and sinks drop repeated IDs.
+// Entries younger than the settle delay wait for a later pass: a transaction still open when the
+// cursor moved would otherwise commit its entry behind the cursor, where it is never read.
+type Exporter struct {
+	repo           audit.Repository
+	sink           Sink
+	sinkName       string // Keys the cursors, so each kind of sink exports the trails once
+	batchSize      int
+	interval       time.Duration
+	settleDelay    time.Duration
+	retryBaseDelay time.Duration
+	retryMaxDelay  time.Duration
+	timeout        time.Duration
+	clock          clock.Clock
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewExporter creates an exporter sending the audit trails to sink
+func NewExporter(repo audit.Repository, sink Sink, cfg *config.AuditExportConfig) *Exporter {
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	return &Exporter{
+		repo:           repo,
+		sink:           sink,
+		sinkName:       strings.ToLower(cfg.Sink),
+		batchSize:      batchSize,
+		interval:       cfg.Interval,
+		settleDelay:    cfg.SettleDelay,
+		retryBaseDelay: cfg.RetryBaseDelay,
+		retryMaxDelay:  cfg.RetryMaxDelay,
+		timeout:        cfg.Timeout,
+		clock:          clock.System{},
+	}
+}
+
+// Start begins exporting in the background
+func (e *Exporter) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+
+	e.wg.Add(1)
+	go e.run(ctx)
+
+	log.Printf("Audit exporter started, sending to the %s sink", e.sinkName)
+}
+
+// Stop halts the exporter; a batch being sent is sent again on the next start
+func (e *Exporter) Stop() {
+	if e.cancel == nil {
+		return
+	}
+	e.cancel()
+	e.wg.Wait()
+	log.Println("Audit exporter stopped")
+}
+
+func (e *Exporter) run(ctx context.Context) {
+	defer e.wg.Done()
+
+	failures := 0
+	for {
+		more, err := e.Export(ctx)
+
+		var wait time.Duration
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			wait = e.backoff(failures)
+			failures++
+			log.Printf("Warning: Failed to export audit entries, retrying in %s: %v", wait, err)
+		case more:
+			// Catching up: send the next batch straight away
+			failures = 0
+			continue
+		default:
+			failures = 0
+			wait = e.interval
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the delay before the next attempt after failures consecutive failed ones
+func (e *Exporter) backoff(failures int) time.Duration {
+	delay := e.retryBaseDelay
+	for i := 0; i < failures && delay < e.retryMaxDelay; i++ {
+		delay *= 2
+	}
+	if e.retryMaxDelay > 0 && delay > e.retryMaxDelay {
+		delay = e.retryMaxDelay
+	}
+	return delay
+}
+
+// Export sends the next batch of every source and reports whether any source has more waiting
+// A source failing doesn't stop the others from being sent.
+func (e *Exporter) Export(ctx context.Context) (bool, error) {
+	more := false
+	var errs []error
+	for _, source := range audit.Sources {
+		sent, err := e.exportSource(ctx, source)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if sent == e.batchSize {
+			more = true
+		}
+	}
+	return more, errors.Join(errs...)
+}
+
+// exportSource sends the entries of source after its cursor and moves the cursor past them
+func (e *Exporter) exportSource(ctx context.Context, source string) (int, error) {
+	cursor, err := e.repo.GetCursor(ctx, e.sinkName, source)
+	if err != nil {
+		return 0, err
+	}
+	now := e.clock.Now()
+	entries, err := e.repo.List(ctx, source, cursor, now.Add(-e.settleDelay), e.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	writeCtx := ctx
+	if e.timeout > 0 {
+		var cancel context.CancelFunc
+		writeCtx, cancel = context.WithTimeout(ctx, e.timeout)
+		defer cancel()
+	}
+	if err := e.sink.Write(writeCtx, entries); err != nil {
+		metrics.AuditExportFailures.WithLabelValues(source).Inc()
+		return 0, fmt.Errorf("%s entries: %w", source, err)
+	}
+
+	// Should saving fail, the batch is sent again, which the sink tolerates
+	last := entries[len(entries)-1]
+	if err := e.repo.SaveCursor(ctx, e.sinkName, source, audit.CursorOf(last)); err != nil {
+		return 0, err
+	}
+	metrics.AuditExportEntries.WithLabelValues(source).Add(float64(len(entries)))
+	metrics.AuditExportLag.WithLabelValues(source).Set(now.Sub(last.CreatedAt).Seconds())
+	return len(entries), nil
+}
@@ -0,0 +1,145 @@
+package auditexport
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/audit"
+	"enterprise-crud/internal/domain/clock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepository keeps audit entries and cursors in memory
+type fakeRepository struct {
+	entries map[string][]*audit.Entry
+	cursors map[string]audit.Cursor
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{entries: make(map[string][]*audit.Entry), cursors: make(map[string]audit.Cursor)}
+}
+
+func (r *fakeRepository) add(source string, createdAt time.Time) *audit.Entry {
+	entry := &audit.Entry{ID: uuid.New(), Source: source, Action: "APPROVED", SubjectID: uuid.New(), CreatedAt: createdAt}
+	r.entries[source] = append(r.entries[source], entry)
+	sort.Slice(r.entries[source], func(i, j int) bool {
+		a, b := r.entries[source][i], r.entries[source][j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.ID.String() < b.ID.String()
+	})
+	return entry
+}
+
+func (r *fakeRepository) List(ctx context.Context, source string, after audit.Cursor, until time.Time, limit int) ([]*audit.Entry, error) {
+	var entries []*audit.Entry
+	for _, e := range r.entries[source] {
+		isAfter := e.CreatedAt.After(after.CreatedAt) ||
+			(e.CreatedAt.Equal(after.CreatedAt) && e.ID.String() > after.EntryID.String())
+		if isAfter && e.CreatedAt.Before(until) && len(entries) < limit {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+func (r *fakeRepository) GetCursor(ctx context.Context, sink, source string) (audit.Cursor, error) {
+	return r.cursors[sink+"/"+source], nil
+}
+
+func (r *fakeRepository) SaveCursor(ctx context.Context, sink, source string, cursor audit.Cursor) error {
+	r.cursors[sink+"/"+source] = cursor
+	return nil
+}
+
+// fakeSink records the batches it accepted, failing while err is set
+type fakeSink struct {
+	err     error
+	batches [][]*audit.Entry
+}
+
+func (s *fakeSink) Write(ctx context.Context, entries []*audit.Entry) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.batches = append(s.batches, entries)
+	return nil
+}
+
+func newExporter(repo audit.Repository, sink Sink, now time.Time) *Exporter {
+	e := NewExporter(repo, sink, &config.AuditExportConfig{Sink: "file", BatchSize: 2, SettleDelay: 5 * time.Second})
+	e.clock = clock.NewFake(now)
+	return e
+}
+
+func TestExporter_Export(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 14, 9, 30, 0, 0, time.UTC)
+	repo := newFakeRepository()
+	first := repo.add(audit.SourceRefund, now.Add(-time.Hour))
+	second := repo.add(audit.SourceRefund, now.Add(-30*time.Minute))
+	third := repo.add(audit.SourceRefund, now.Add(-time.Minute))
+	repo.add(audit.SourceRefund, now.Add(-time.Second)) // Still settling
+	transfer := repo.add(audit.SourceTransfer, now.Add(-time.Hour))
+	sink := &fakeSink{}
+	exporter := newExporter(repo, sink, now)
+
+	more, err := exporter.Export(ctx)
+	require.NoError(t, err)
+	assert.True(t, more, "a full batch of refunds was sent")
+	require.Len(t, sink.batches, 2)
+	assert.Equal(t, []*audit.Entry{first, second}, sink.batches[0])
+	assert.Equal(t, []*audit.Entry{transfer}, sink.batches[1])
+
+	more, err = exporter.Export(ctx)
+	require.NoError(t, err)
+	assert.False(t, more)
+	require.Len(t, sink.batches, 3)
+	assert.Equal(t, []*audit.Entry{third}, sink.batches[2], "entries younger than the settle delay wait")
+
+	more, err = exporter.Export(ctx)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Len(t, sink.batches, 3, "nothing is sent twice once acknowledged")
+}
+
+func TestExporter_ExportResendsFailedBatches(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 14, 9, 30, 0, 0, time.UTC)
+	repo := newFakeRepository()
+	entry := repo.add(audit.SourceInventory, now.Add(-time.Hour))
+	sink := &fakeSink{err: errors.New("collector down")}
+	exporter := newExporter(repo, sink, now)
+
+	_, err := exporter.Export(ctx)
+	assert.ErrorContains(t, err, "collector down")
+	cursor, _ := repo.GetCursor(ctx, "file", audit.SourceInventory)
+	assert.True(t, cursor.IsZero(), "the cursor stays put while the sink fails")
+
+	sink.err = nil
+	_, err = exporter.Export(ctx)
+	require.NoError(t, err)
+	require.Len(t, sink.batches, 1)
+	assert.Equal(t, []*audit.Entry{entry}, sink.batches[0])
+	cursor, _ = repo.GetCursor(ctx, "file", audit.SourceInventory)
+	assert.Equal(t, audit.CursorOf(entry), cursor)
+}
+
+func TestExporter_Backoff(t *testing.T) {
+	exporter := NewExporter(newFakeRepository(), &fakeSink{}, &config.AuditExportConfig{
+		RetryBaseDelay: time.Second,
+		RetryMaxDelay:  10 * time.Second,
+	})
+
+	assert.Equal(t, time.Second, exporter.backoff(0))
+	assert.Equal(t, 4*time.Second, exporter.backoff(2))
+	assert.Equal(t, 10*time.Second, exporter.backoff(10))
+}
@@ -0,0 +1,42 @@
+package auditexport
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"enterprise-crud/internal/domain/audit"
+)
+
+// FileSink appends entries to a file as JSON lines, e.g. one a log shipper tails
+type FileSink struct {
+	path string
+}
+
+// NewFileSink creates a sink appending to the file at path
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+// Write appends one line per entry and syncs the file to disk
+// The file is opened for every batch, so it can be rotated by moving it away.
+func (s *FileSink) Write(ctx context.Context, entries []*audit.Entry) error {
+	data, err := encodeLines(entries)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write audit file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to sync audit file: %w", err)
+	}
+	return f.Close()
+}
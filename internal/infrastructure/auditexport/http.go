@@ -0,0 +1,56 @@
+package auditexport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/audit"
+)
+
+// HTTPSink POSTs batches to a collector as newline-delimited JSON
+type HTTPSink struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+// NewHTTPSink creates a sink posting to the configured collector
+func NewHTTPSink(cfg *config.AuditExportConfig) *HTTPSink {
+	return &HTTPSink{
+		client: &http.Client{},
+		url:    cfg.HTTPURL,
+		token:  cfg.HTTPToken,
+	}
+}
+
+// Write posts the batch in one request; any answer but 2xx fails the batch
+func (s *HTTPSink) Write(ctx context.Context, entries []*audit.Entry) error {
+	body, err := encodeLines(entries)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("audit collector request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("audit collector refused batch: %s", resp.Status)
+	}
+	return nil
+}
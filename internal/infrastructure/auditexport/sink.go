@@ -0,0 +1,55 @@
+// Package auditexport ships audit entries to an external system, such as a SIEM, for compliance teams
+package auditexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/audit"
+)
+
+// Sink stores batches of audit entries outside the service
+// Write returns nil only once the sink holds every entry of the batch; after an error the whole
+// batch is sent again, so sinks may see an entry more than once and should drop repeated IDs.
+type Sink interface {
+	Write(ctx context.Context, entries []*audit.Entry) error
+}
+
+// NewSink creates the sink configured by cfg.Sink
+func NewSink(cfg *config.AuditExportConfig) (Sink, error) {
+	switch strings.ToLower(cfg.Sink) {
+	case "file":
+		if cfg.FilePath == "" {
+			return nil, fmt.Errorf("audit export file sink needs a file_path")
+		}
+		return NewFileSink(cfg.FilePath), nil
+	case "http":
+		if cfg.HTTPURL == "" {
+			return nil, fmt.Errorf("audit export http sink needs an http_url")
+		}
+		return NewHTTPSink(cfg), nil
+	case "syslog":
+		if cfg.SyslogAddress == "" {
+			return nil, fmt.Errorf("audit export syslog sink needs a syslog_address")
+		}
+		return NewSyslogSink(cfg)
+	default:
+		return nil, fmt.Errorf("unknown audit export sink %q, want \"file\", \"http\" or \"syslog\"", cfg.Sink)
+	}
+}
+
+// encodeLines encodes entries as newline-delimited JSON
+func encodeLines(entries []*audit.Entry) ([]byte, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return nil, fmt.Errorf("failed to encode audit entry %s: %w", entry.ID, err)
+		}
+	}
+	return b.Bytes(), nil
+}
@@ -0,0 +1,139 @@
+package auditexport
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/audit"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEntries() []*audit.Entry {
+	actorID := uuid.MustParse("8c1b3f0e-5d2a-4f6b-9e7c-1a2b3c4d5e6f")
+	return []*audit.Entry{
+		{ID: uuid.New(), Source: audit.SourceRefund, Action: "APPROVED", ActorID: &actorID, SubjectID: uuid.New(),
+			Details: map[string]any{"amount": 25.0, "note": "Event cancelled"}, CreatedAt: time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)},
+		{ID: uuid.New(), Source: audit.SourceTransfer, Action: "ACCEPTED", SubjectID: uuid.New(),
+			CreatedAt: time.Date(2026, 3, 14, 9, 1, 0, 0, time.UTC)},
+	}
+}
+
+// decodeLines decodes newline-delimited JSON entries
+func decodeLines(t *testing.T, data string) []*audit.Entry {
+	var entries []*audit.Entry
+	for _, line := range strings.Split(strings.TrimSuffix(data, "\n"), "\n") {
+		var entry audit.Entry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, &entry)
+	}
+	return entries
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink := NewFileSink(path)
+	entries := testEntries()
+
+	require.NoError(t, sink.Write(context.Background(), entries[:1]))
+	require.NoError(t, sink.Write(context.Background(), entries[1:]))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, entries, decodeLines(t, string(data)))
+}
+
+func TestHTTPSink(t *testing.T) {
+	status := http.StatusAccepted
+	var got []*audit.Entry
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer siem-token", r.Header.Get("Authorization"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		got = decodeLines(t, string(body))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	sink := NewHTTPSink(&config.AuditExportConfig{HTTPURL: server.URL + "/ingest", HTTPToken: "siem-token"})
+	entries := testEntries()
+
+	require.NoError(t, sink.Write(context.Background(), entries))
+	assert.Equal(t, entries, got)
+
+	status = http.StatusServiceUnavailable
+	assert.Error(t, sink.Write(context.Background(), entries))
+}
+
+func TestSyslogSink_TCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		var messages []string
+		for len(messages) < 2 {
+			length, err := r.ReadString(' ')
+			if err != nil {
+				break
+			}
+			n, _ := strconv.Atoi(strings.TrimSpace(length))
+			message := make([]byte, n)
+			if _, err := io.ReadFull(r, message); err != nil {
+				break
+			}
+			messages = append(messages, string(message))
+		}
+		received <- messages
+	}()
+
+	sink, err := NewSyslogSink(&config.AuditExportConfig{SyslogNetwork: "tcp", SyslogAddress: listener.Addr().String()})
+	require.NoError(t, err)
+	entries := testEntries()
+	require.NoError(t, sink.Write(context.Background(), entries))
+
+	messages := <-received
+	require.Len(t, messages, 2)
+	prefix := "<85>1 2026-03-14T09:00:00Z " + sink.hostname + " enterprise-crud - audit.refund - "
+	require.True(t, strings.HasPrefix(messages[0], prefix), messages[0])
+	var entry audit.Entry
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(messages[0], prefix)), &entry))
+	assert.Equal(t, *entries[0], entry)
+	assert.Contains(t, messages[1], " audit.transfer - ")
+}
+
+func TestNewSink(t *testing.T) {
+	_, err := NewSink(&config.AuditExportConfig{Sink: "kafka"})
+	assert.Error(t, err)
+
+	_, err = NewSink(&config.AuditExportConfig{Sink: "http"})
+	assert.Error(t, err, "http needs a URL")
+
+	_, err = NewSink(&config.AuditExportConfig{Sink: "syslog", SyslogNetwork: "unix", SyslogAddress: "/dev/log"})
+	assert.Error(t, err)
+
+	sink, err := NewSink(&config.AuditExportConfig{Sink: "File", FilePath: "audit.log"})
+	require.NoError(t, err)
+	assert.IsType(t, &FileSink{}, sink)
+}
@@ -0,0 +1,81 @@
+package auditexport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/audit"
+)
+
+// syslogPriority is facility authpriv (10) at severity notice (5)
+const syslogPriority = 10*8 + 5
+
+// syslogAppName is the APP-NAME of every message
+const syslogAppName = "enterprise-crud"
+
+// SyslogSink sends one RFC 5424 message per entry to a syslog server
+// Over TCP messages are framed with octet counting (RFC 6587); over UDP each is a datagram, which
+// the server never acknowledges, so entries lost on the way are not sent again.
+type SyslogSink struct {
+	network  string
+	address  string
+	hostname string
+}
+
+// NewSyslogSink creates a sink sending to the configured syslog server
+func NewSyslogSink(cfg *config.AuditExportConfig) (*SyslogSink, error) {
+	network := strings.ToLower(cfg.SyslogNetwork)
+	if network != "udp" && network != "tcp" {
+		return nil, fmt.Errorf("unknown audit export syslog network %q, want \"udp\" or \"tcp\"", cfg.SyslogNetwork)
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &SyslogSink{network: network, address: cfg.SyslogAddress, hostname: hostname}, nil
+}
+
+// Write sends the batch over a new connection
+func (s *SyslogSink) Write(ctx context.Context, entries []*audit.Entry) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog server: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetWriteDeadline(deadline)
+	}
+
+	for _, entry := range entries {
+		message, err := s.format(entry)
+		if err != nil {
+			return err
+		}
+		if s.network == "tcp" {
+			message = append([]byte(strconv.Itoa(len(message))+" "), message...)
+		}
+		if _, err := conn.Write(message); err != nil {
+			return fmt.Errorf("failed to send to syslog server: %w", err)
+		}
+	}
+	return nil
+}
+
+// format renders entry as an RFC 5424 message whose MSG is the entry as JSON
+func (s *SyslogSink) format(entry *audit.Entry) ([]byte, error) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit entry %s: %w", entry.ID, err)
+	}
+	header := fmt.Sprintf("<%d>1 %s %s %s - %s - ", syslogPriority,
+		entry.CreatedAt.UTC().Format(time.RFC3339Nano), s.hostname, syslogAppName, "audit."+entry.Source)
+	return append([]byte(header), data...), nil
+}
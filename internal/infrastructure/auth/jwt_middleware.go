@@ -1,10 +1,12 @@
 package auth
 
 import (
-	"net/http"
+	"errors"
+	"log"
+
+	"enterprise-crud/internal/domain/user"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 )
 
 // JWTMiddleware provides JWT authentication middleware
@@ -20,57 +22,152 @@ func NewJWTMiddleware(jwtService *JWTService) *JWTMiddleware {
 }
 
 // AuthRequired middleware that requires valid JWT token
+// Requests without one answer 401 with a WWW-Authenticate challenge.
 func (m *JWTMiddleware) AuthRequired() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			AbortUnauthenticated(c)
+			return
+		}
 
 		tokenString, err := ExtractTokenFromHeader(authHeader)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error":   "Authorization required",
-				"message": err.Error(),
-			})
+			abortInvalidToken(c, err.Error())
+			return
+		}
+
+		claims, err := m.jwtService.ValidateToken(tokenString)
+		if err != nil {
+			abortInvalidToken(c, err.Error())
+			return
+		}
+
+		if !m.accountAllowed(c, claims) {
 			c.Abort()
 			return
 		}
 
+		claims, ok := m.currentRoles(c, claims)
+		if !ok {
+			return
+		}
+
+		setClaims(c, claims)
+		c.Next()
+	}
+}
+
+// AuthOptional middleware that identifies the user when a valid JWT token is sent
+// Requests without a token, or with one that does not validate, continue anonymously; if the
+// handler then needs a signed-in user, AbortUnauthenticated reports why the token was ignored.
+func (m *JWTMiddleware) AuthOptional() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			c.Next()
+			return
+		}
+
+		tokenString, err := ExtractTokenFromHeader(authHeader)
+		if err != nil {
+			c.Set(invalidTokenKey, err.Error())
+			c.Next()
+			return
+		}
+
 		claims, err := m.jwtService.ValidateToken(tokenString)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error":   "Invalid token",
-				"message": err.Error(),
-			})
+			c.Set(invalidTokenKey, err.Error())
+			c.Next()
+			return
+		}
+
+		if !m.accountAllowed(c, claims) {
 			c.Abort()
 			return
 		}
 
-		// Set user information in context
-		c.Set("user_id", claims.UserID)
-		c.Set("user_email", claims.Email)
-		c.Set("user_username", claims.Username)
-		c.Set("jwt_claims", claims)
-		c.Set("user", claims) // Also set for role middleware compatibility
+		claims, ok := m.currentRoles(c, claims)
+		if !ok {
+			return
+		}
 
+		setClaims(c, claims)
 		c.Next()
 	}
 }
 
-// GetUserFromContext extracts user information from Gin context
-func GetUserFromContext(c *gin.Context) (uuid.UUID, string, string, bool) {
-	userID, exists := c.Get("user_id")
-	if !exists {
-		return uuid.Nil, "", "", false
+// setClaims stores the authenticated user's information in the context
+func setClaims(c *gin.Context, claims *JWTClaims) {
+	c.Set("user_id", claims.UserID)
+	c.Set("user_email", claims.Email)
+	c.Set("user_username", claims.Username)
+	c.Set("jwt_claims", claims)
+	c.Set("user", claims) // Also set for role middleware compatibility
+}
+
+// accountAllowed checks that the token's account is neither suspended nor banned, responding if it is
+// A failed lookup lets the request through: the token itself is valid, and refusing every
+// authenticated request while the database struggles would turn a hiccup into an outage.
+func (m *JWTMiddleware) accountAllowed(c *gin.Context, claims *JWTClaims) bool {
+	if m.jwtService.accounts == nil {
+		return true
+	}
+
+	err := m.jwtService.accounts.CheckAccountStatus(c.Request.Context(), claims.UserID)
+	switch {
+	case err == nil:
+		return true
+	case user.IsAccountBlockedError(err):
+		AbortForbidden(c, user.GetUserErrorCode(err), err.Error())
+		return false
+	case errors.Is(err, user.ErrUserNotFound):
+		abortInvalidToken(c, "account no longer exists")
+		return false
+	default:
+		log.Printf("Warning: Failed to check account status of user %s: %v", claims.UserID, err)
+		return true
+	}
+}
+
+// currentRoles replaces the roles of a token issued before the user's roles changed
+// The request goes on with the roles the user holds now, and the response carries a token with them
+// in X-Refreshed-Token. A failed version lookup lets the token through as it is, like a failed account
+// check; a token known to be stale whose roles can't be reloaded is refused, so the user signs in again.
+func (m *JWTMiddleware) currentRoles(c *gin.Context, claims *JWTClaims) (*JWTClaims, bool) {
+	checker := m.jwtService.roles
+	if checker == nil {
+		return claims, true
 	}
 
-	email, exists := c.Get("user_email")
-	if !exists {
-		return uuid.Nil, "", "", false
+	ctx := c.Request.Context()
+	version, err := checker.RolesVersion(ctx, claims.UserID)
+	if err != nil {
+		log.Printf("Warning: Failed to check roles version of user %s: %v", claims.UserID, err)
+		return claims, true
+	}
+	if version == claims.RolesVersion {
+		return claims, true
 	}
 
-	username, exists := c.Get("user_username")
-	if !exists {
-		return uuid.Nil, "", "", false
+	current, err := checker.GetUserByID(ctx, claims.UserID)
+	if errors.Is(err, user.ErrUserNotFound) {
+		abortInvalidToken(c, "account no longer exists")
+		return nil, false
+	}
+	if err != nil {
+		log.Printf("Warning: Failed to reload roles of user %s: %v", claims.UserID, err)
+		abortInvalidToken(c, "roles changed since the token was issued, sign in again")
+		return nil, false
 	}
 
-	return userID.(uuid.UUID), email.(string), username.(string), true
+	refreshed := m.jwtService.refreshRoles(claims, current.RoleNames(), version)
+	token, err := m.jwtService.sign(refreshed)
+	if err != nil {
+		log.Printf("Warning: Failed to refresh token of user %s: %v", claims.UserID, err)
+	} else {
+		c.Header(HeaderRefreshedToken, token)
+	}
+	return refreshed, true
 }
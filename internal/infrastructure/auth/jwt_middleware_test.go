@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/domain/user"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRoleChecker serves one user's roles and roles version
+type fakeRoleChecker struct {
+	version    int64
+	versionErr error
+	user       *user.User
+	userErr    error
+}
+
+func (f *fakeRoleChecker) RolesVersion(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return f.version, f.versionErr
+}
+
+func (f *fakeRoleChecker) GetUserByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return f.user, f.userErr
+}
+
+func TestJWTMiddleware_RoleChanges(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	userID := uuid.New()
+	organizer := []string{role.RoleUser.String(), role.RoleOrganizer.String()}
+
+	setup := func(checker *fakeRoleChecker) (*JWTService, *gin.Engine) {
+		service := NewJWTService("test-secret", "test", time.Hour)
+		service.CheckRolesWith(checker)
+		router := gin.New()
+		router.GET("/me", NewJWTMiddleware(service).AuthRequired(), func(c *gin.Context) {
+			currentUser, _ := CurrentUser(c)
+			c.JSON(http.StatusOK, currentUser.Roles)
+		})
+		return service, router
+	}
+	request := func(router *gin.Engine, token string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("tokens issued after the last change keep their roles", func(t *testing.T) {
+		checker := &fakeRoleChecker{version: 2}
+		service, router := setup(checker)
+		token, err := service.GenerateToken(userID, "host@example.com", "host", organizer, service.RolesVersion(context.Background(), userID))
+		require.NoError(t, err)
+
+		w := request(router, token)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `["USER","ORGANIZER"]`, w.Body.String())
+		assert.Empty(t, w.Header().Get(HeaderRefreshedToken))
+	})
+
+	t.Run("stale tokens get the current roles and a refreshed token", func(t *testing.T) {
+		checker := &fakeRoleChecker{version: 1}
+		service, router := setup(checker)
+		token, err := service.GenerateToken(userID, "host@example.com", "host", organizer, service.RolesVersion(context.Background(), userID))
+		require.NoError(t, err)
+		original, err := service.ValidateToken(token)
+		require.NoError(t, err)
+
+		checker.version = 2
+		checker.user = &user.User{ID: userID, Roles: []role.Role{{Name: role.RoleUser}}}
+		w := request(router, token)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `["USER"]`, w.Body.String(), "ORGANIZER was revoked")
+		refreshed, err := service.ValidateToken(w.Header().Get(HeaderRefreshedToken))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"USER"}, refreshed.Roles)
+		assert.Equal(t, int64(2), refreshed.RolesVersion)
+		assert.Equal(t, original.ExpiresAt, refreshed.ExpiresAt, "refreshing doesn't extend the session")
+
+		w = request(router, w.Header().Get(HeaderRefreshedToken))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get(HeaderRefreshedToken))
+	})
+
+	t.Run("stale tokens whose roles can't be reloaded must sign in again", func(t *testing.T) {
+		checker := &fakeRoleChecker{}
+		service, router := setup(checker)
+		token, err := service.GenerateToken(userID, "host@example.com", "host", organizer, 0)
+		require.NoError(t, err)
+
+		checker.version = 1
+		checker.userErr = errors.New("database down")
+		w := request(router, token)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), CodeInvalidToken)
+	})
+
+	t.Run("a failed version lookup trusts the token", func(t *testing.T) {
+		checker := &fakeRoleChecker{}
+		service, router := setup(checker)
+		token, err := service.GenerateToken(userID, "host@example.com", "host", organizer, 0)
+		require.NoError(t, err)
+
+		checker.versionErr = errors.New("redis down")
+		w := request(router, token)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `["USER","ORGANIZER"]`, w.Body.String())
+	})
+}
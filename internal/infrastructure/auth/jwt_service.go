@@ -4,9 +4,14 @@
 package auth
 
 import (
+	"context"
 	"errors"
+	"log"
+	"strings"
 	"time"
 
+	"enterprise-crud/internal/domain/user"
+
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
@@ -16,8 +21,27 @@ type JWTService struct {
 	secretKey  []byte
 	issuer     string
 	expiration time.Duration
+	accounts   AccountChecker // Consulted by JWTMiddleware on every request; nil trusts any valid token
+	roles      RoleChecker    // Consulted by JWTMiddleware on every request; nil trusts the roles of any valid token
+}
+
+// AccountChecker reports whether the holder of a valid token may still use the API
+// CheckAccountStatus returns a user.IsAccountBlockedError error for suspended or banned accounts.
+type AccountChecker interface {
+	CheckAccountStatus(ctx context.Context, userID uuid.UUID) error
 }
 
+// RoleChecker tells whether the roles in a valid token are still the user's
+// RolesVersion counts the user's role changes; tokens carry the count they were issued at.
+type RoleChecker interface {
+	RolesVersion(ctx context.Context, userID uuid.UUID) (int64, error)
+	GetUserByID(ctx context.Context, id uuid.UUID) (*user.User, error)
+}
+
+// HeaderRefreshedToken carries a new token when the roles of the one sent changed since it was issued
+// Clients should use it instead of the old token from then on.
+const HeaderRefreshedToken = "X-Refreshed-Token"
+
 // JWTClaims represents the JWT claims structure
 // Now includes roles for authorization checking
 type JWTClaims struct {
@@ -25,6 +49,9 @@ type JWTClaims struct {
 	Email    string    `json:"email"`
 	Username string    `json:"username"`
 	Roles    []string  `json:"roles"` // Array of role names (ADMIN, USER, etc.)
+
+	// RolesVersion is the count of the user's role changes when Roles was read
+	RolesVersion int64 `json:"roles_version,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -37,15 +64,45 @@ func NewJWTService(secretKey string, issuer string, expiration time.Duration) *J
 	}
 }
 
+// CheckAccountsWith makes JWTMiddleware refuse tokens of accounts that checker reports as blocked
+// Without it a suspended user keeps access until their token expires.
+func (j *JWTService) CheckAccountsWith(checker AccountChecker) {
+	j.accounts = checker
+}
+
+// CheckRolesWith makes JWTMiddleware replace the roles of tokens issued before a user's roles changed
+// Without it a revoked role stays in the user's token until the token expires.
+func (j *JWTService) CheckRolesWith(checker RoleChecker) {
+	j.roles = checker
+}
+
+// RolesVersion returns the user's roles version for GenerateToken, 0 without a role checker
+// Read it before loading the roles the token will carry: a role change landing in between then leaves
+// the token stale, and reloaded on its first request, rather than carrying old roles as current.
+func (j *JWTService) RolesVersion(ctx context.Context, userID uuid.UUID) int64 {
+	if j.roles == nil {
+		return 0
+	}
+	version, err := j.roles.RolesVersion(ctx, userID)
+	if err != nil {
+		// Version 0 only makes the token's roles be reloaded on its first requests
+		log.Printf("Warning: Failed to get roles version of user %s: %v", userID, err)
+		return 0
+	}
+	return version
+}
+
 // GenerateToken generates a new JWT token for the user with their roles
-func (j *JWTService) GenerateToken(userID uuid.UUID, email, username string, roles []string) (string, error) {
+// rolesVersion is the user's roles version read before roles was, so later role changes reach the token.
+func (j *JWTService) GenerateToken(userID uuid.UUID, email, username string, roles []string, rolesVersion int64) (string, error) {
 	now := time.Now()
 
 	claims := &JWTClaims{
-		UserID:   userID,
-		Email:    email,
-		Username: username,
-		Roles:    roles, // Include user roles in the token
+		UserID:       userID,
+		Email:        email,
+		Username:     username,
+		Roles:        roles, // Include user roles in the token
+		RolesVersion: rolesVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(j.expiration)),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -55,6 +112,21 @@ func (j *JWTService) GenerateToken(userID uuid.UUID, email, username string, rol
 		},
 	}
 
+	return j.sign(claims)
+}
+
+// refreshRoles returns a copy of claims with the user's current roles
+// The copy expires with the original, so refreshing never extends a session.
+func (j *JWTService) refreshRoles(claims *JWTClaims, roles []string, rolesVersion int64) *JWTClaims {
+	refreshed := *claims
+	refreshed.Roles = roles
+	refreshed.RolesVersion = rolesVersion
+	refreshed.IssuedAt = jwt.NewNumericDate(time.Now())
+	return &refreshed
+}
+
+// sign encodes claims as a signed token
+func (j *JWTService) sign(claims *JWTClaims) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString(j.secretKey)
 }
@@ -96,5 +168,10 @@ func ExtractTokenFromHeader(authHeader string) (string, error) {
 		return "", errors.New("invalid authorization header format")
 	}
 
-	return authHeader[len(bearerPrefix):], nil
+	token := authHeader[len(bearerPrefix):]
+	if strings.TrimSpace(token) == "" {
+		return "", errors.New("bearer token is empty")
+	}
+
+	return token, nil
 }
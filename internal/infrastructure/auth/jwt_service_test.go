@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+func FuzzExtractTokenFromHeader(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		"Bearer",
+		"Bearer ",
+		"Bearer   ",
+		"Bearer abc.def.ghi",
+		"bearer abc.def.ghi",
+		"Basic dXNlcjpwYXNz",
+		"Bearer\tabc",
+		"Bearer Bearer abc",
+		"Bearer \x00",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, header string) {
+		token, err := ExtractTokenFromHeader(header)
+		if err != nil {
+			if token != "" {
+				t.Fatalf("ExtractTokenFromHeader(%q) returned token %q with error %v", header, token, err)
+			}
+			return
+		}
+		if strings.TrimSpace(token) == "" {
+			t.Fatalf("ExtractTokenFromHeader(%q) accepted an empty token", header)
+		}
+		if header != "Bearer "+token {
+			t.Fatalf("ExtractTokenFromHeader(%q) = %q, which is not what follows the Bearer prefix", header, token)
+		}
+	})
+}
+
+func FuzzValidateToken(f *testing.F) {
+	service := NewJWTService("fuzz-secret", "fuzz", time.Hour)
+	valid, err := service.GenerateToken(uuid.New(), "user@example.com", "user", []string{"USER"}, 0)
+	if err != nil {
+		f.Fatal(err)
+	}
+	otherKey, err := NewJWTService("other-secret", "fuzz", time.Hour).GenerateToken(uuid.New(), "user@example.com", "user", nil, 0)
+	if err != nil {
+		f.Fatal(err)
+	}
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, &JWTClaims{UserID: uuid.New()}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		f.Fatal(err)
+	}
+	parts := strings.Split(valid, ".")
+	for _, seed := range []string{
+		"", ".", "..", "a.b.c", valid, otherKey, unsigned,
+		parts[0] + "." + parts[1] + ".",
+		parts[0] + ".e30." + parts[2],
+		valid + "x",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, tokenString string) {
+		claims, err := service.ValidateToken(tokenString)
+		if err != nil {
+			if claims != nil {
+				t.Fatalf("ValidateToken(%q) returned claims with error %v", tokenString, err)
+			}
+			return
+		}
+		if claims == nil {
+			t.Fatalf("ValidateToken(%q) returned neither claims nor an error", tokenString)
+		}
+		// Only tokens this service signed can validate, and the fuzzer cannot forge the signature
+		if tokenString != valid {
+			t.Fatalf("ValidateToken accepted a token it did not issue: %q", tokenString)
+		}
+	})
+}
+
+func FuzzGenerateToken_RoundTrip(f *testing.F) {
+	service := NewJWTService("fuzz-secret", "fuzz", time.Hour)
+	f.Add([]byte("0123456789abcdef"), "user@example.com", "user", "USER")
+	f.Add([]byte{}, "", "", "")
+	f.Add([]byte("\xff\xfe"), "\"quoted\"@example.com", "über", "ADMIN,USER")
+
+	f.Fuzz(func(t *testing.T, id []byte, email, username, roleName string) {
+		var userID uuid.UUID
+		copy(userID[:], id)
+
+		token, err := service.GenerateToken(userID, email, username, []string{roleName}, 0)
+		if err != nil {
+			t.Fatalf("GenerateToken: %v", err)
+		}
+		claims, err := service.ValidateToken(token)
+		if err != nil {
+			t.Fatalf("ValidateToken rejected a token it issued: %v", err)
+		}
+
+		// JSON replaces invalid UTF-8, so that is what comes back
+		valid := func(s string) string { return strings.ToValidUTF8(s, "�") }
+		if claims.UserID != userID || claims.Email != valid(email) || claims.Username != valid(username) ||
+			len(claims.Roles) != 1 || claims.Roles[0] != valid(roleName) {
+			t.Fatalf("claims changed on the way through the token: %+v", claims)
+		}
+	})
+}
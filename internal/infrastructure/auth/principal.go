@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"enterprise-crud/internal/domain/role"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Principal is the authenticated user a request acts on behalf of
+type Principal struct {
+	UserID   uuid.UUID
+	Email    string
+	Username string
+	Roles    []string // Role names carried by the token
+}
+
+// HasRole reports whether the principal holds any of the given roles
+func (p *Principal) HasRole(names ...role.Name) bool {
+	return role.HasAny(p.Roles, names...)
+}
+
+// IsAdmin reports whether the principal is an administrator
+func (p *Principal) IsAdmin() bool {
+	return p.HasRole(role.RoleAdmin)
+}
+
+// CurrentUser returns the user authenticated by JWTMiddleware
+// ok is false on routes without authentication, or when AuthOptional found no valid token.
+func CurrentUser(c *gin.Context) (principal *Principal, ok bool) {
+	userClaims, exists := c.Get("user")
+	if !exists {
+		return nil, false
+	}
+	claims, ok := userClaims.(*JWTClaims)
+	if !ok || claims == nil {
+		return nil, false
+	}
+	return &Principal{
+		UserID:   claims.UserID,
+		Email:    claims.Email,
+		Username: claims.Username,
+		Roles:    claims.Roles,
+	}, true
+}
+
+// RequireCurrentUser returns the authenticated user, responding with 401 when there is none
+// Handlers return straight away when ok is false.
+func RequireCurrentUser(c *gin.Context) (principal *Principal, ok bool) {
+	principal, ok = CurrentUser(c)
+	if !ok {
+		AbortUnauthenticated(c)
+		return nil, false
+	}
+	return principal, true
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"enterprise-crud/internal/domain/role"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrentUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("returns the principal from the token claims", func(t *testing.T) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		userID := uuid.New()
+		c.Set("user", &JWTClaims{
+			UserID:   userID,
+			Email:    "organizer@example.com",
+			Username: "organizer",
+			Roles:    []string{role.RoleOrganizer.String()},
+		})
+
+		currentUser, ok := CurrentUser(c)
+		require.True(t, ok)
+		assert.Equal(t, userID, currentUser.UserID)
+		assert.Equal(t, "organizer@example.com", currentUser.Email)
+		assert.Equal(t, "organizer", currentUser.Username)
+		assert.True(t, currentUser.HasRole(role.RoleOrganizer))
+		assert.False(t, currentUser.IsAdmin())
+	})
+
+	t.Run("unauthenticated request", func(t *testing.T) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+		_, ok := CurrentUser(c)
+		assert.False(t, ok)
+	})
+
+	t.Run("unexpected value in context", func(t *testing.T) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Set("user", "not-claims")
+
+		_, ok := CurrentUser(c)
+		assert.False(t, ok)
+	})
+}
+
+func TestRequireCurrentUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("writes 401 when unauthenticated", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+
+		_, ok := RequireCurrentUser(c)
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, `Bearer realm="enterprise-crud"`, w.Header().Get("WWW-Authenticate"))
+		assert.Contains(t, w.Body.String(), CodeAuthenticationRequired)
+	})
+
+	t.Run("names the token AuthOptional ignored", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set(invalidTokenKey, "token is expired")
+
+		_, ok := RequireCurrentUser(c)
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, `Bearer realm="enterprise-crud", error="invalid_token", error_description="token is expired"`, w.Header().Get("WWW-Authenticate"))
+		assert.Contains(t, w.Body.String(), CodeInvalidToken)
+	})
+
+	t.Run("admin principal", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("user", &JWTClaims{UserID: uuid.New(), Roles: []string{role.RoleAdmin.String()}})
+
+		currentUser, ok := RequireCurrentUser(c)
+		require.True(t, ok)
+		assert.True(t, currentUser.IsAdmin())
+		assert.True(t, HasRole(c, role.RoleAdmin))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Realm names the protection space in WWW-Authenticate challenges
+const Realm = "enterprise-crud"
+
+// Codes of requests refused for who sent them
+// 401 means the request did not prove who sent it; 403 means the sender is known but may not do this.
+const (
+	CodeAuthenticationRequired = "AUTHENTICATION_REQUIRED" // 401: no bearer token was sent
+	CodeInvalidToken           = "INVALID_TOKEN"           // 401: the token is malformed, expired, its account no longer exists or its changed roles could not be reloaded
+	CodeInsufficientRole       = "INSUFFICIENT_ROLE"       // 403: the user holds none of the roles the route allows
+)
+
+// invalidTokenKey is where AuthOptional notes why it ignored the token sent
+const invalidTokenKey = "auth_invalid_token"
+
+// AbortUnauthenticated answers 401 to a request that needs a signed-in user but has none
+// When AuthOptional ignored an invalid token the client is told so, otherwise that a token is required.
+func AbortUnauthenticated(c *gin.Context) {
+	if reason := c.GetString(invalidTokenKey); reason != "" {
+		abortInvalidToken(c, reason)
+		return
+	}
+	c.Header("WWW-Authenticate", fmt.Sprintf("Bearer realm=%q", Realm))
+	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+		"error":   "unauthorized",
+		"code":    CodeAuthenticationRequired,
+		"message": "Sign in and send your token in the Authorization header as a Bearer token",
+	})
+}
+
+// abortInvalidToken answers 401 to a request whose token was rejected, challenging the client as RFC 6750 describes
+func abortInvalidToken(c *gin.Context, reason string) {
+	description := strings.NewReplacer(`"`, "'", `\`, "/").Replace(reason)
+	c.Header("WWW-Authenticate", fmt.Sprintf(`Bearer realm=%q, error="invalid_token", error_description="%s"`, Realm, description))
+	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+		"error":   "unauthorized",
+		"code":    CodeInvalidToken,
+		"message": reason,
+	})
+}
+
+// AbortForbidden answers 403 to a signed-in user who may not make the request
+// Use it for missing roles and ownership; requests without a valid token get AbortUnauthenticated instead.
+func AbortForbidden(c *gin.Context, code, message string) {
+	c.AbortWithStatusJSON(http.StatusForbidden, forbiddenBody(code, message))
+}
+
+// forbiddenBody is the body of a 403 answer, for callers adding their own fields
+func forbiddenBody(code, message string) gin.H {
+	return gin.H{
+		"error":   "forbidden",
+		"code":    code,
+		"message": message,
+	}
+}
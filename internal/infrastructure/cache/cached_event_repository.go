@@ -3,24 +3,36 @@ package cache
 import (
 	"context"
 	"log"
+	"sync"
+	"time"
 
 	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/infrastructure/metrics"
 
 	"github.com/google/uuid"
 )
 
 // CachedEventRepository implements the event.Repository interface with Redis caching
 // It uses the cache-aside pattern: check cache first, fallback to database, then populate cache
+//
+// Every mutation bumps a generation counter before invalidating. A read only populates
+// the cache if no mutation happened since it started, so a slow read can't put data
+// loaded before a write back into the cache after that write invalidated it.
 type CachedEventRepository struct {
-	baseRepo event.Repository   // The original database repository
-	cache    *EventCacheService // Redis cache service
+	baseRepo  event.Repository   // The original database repository
+	cache     *EventCacheService // Redis cache service
+	populator *Populator         // Bounded worker pool for cache writes on read paths
+
+	mu         sync.RWMutex // Held for writing while bumping generation, for reading while populating
+	generation uint64       // Incremented on every successful mutation
 }
 
 // NewCachedEventRepository creates a new cached event repository
-func NewCachedEventRepository(baseRepo event.Repository, cache *EventCacheService) *CachedEventRepository {
+func NewCachedEventRepository(baseRepo event.Repository, cache *EventCacheService, populator *Populator) *CachedEventRepository {
 	return &CachedEventRepository{
-		baseRepo: baseRepo,
-		cache:    cache,
+		baseRepo:  baseRepo,
+		cache:     cache,
+		populator: populator,
 	}
 }
 
@@ -32,6 +44,7 @@ func (r *CachedEventRepository) Create(ctx context.Context, evt *event.Event) er
 	}
 
 	// Invalidate caches since we have a new event
+	r.bumpGeneration()
 	if err := r.cache.InvalidateEventRelatedCaches(ctx, evt.ID, evt.VenueID, evt.OrganizerID); err != nil {
 		// Log but don't fail the operation - cache invalidation is not critical for data consistency
 		log.Printf("Warning: Failed to invalidate cache after event creation: %v", err)
@@ -43,50 +56,66 @@ func (r *CachedEventRepository) Create(ctx context.Context, evt *event.Event) er
 // GetByID implements cache-aside pattern for single event retrieval
 func (r *CachedEventRepository) GetByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
 	// 1. Try cache first (cache-aside pattern)
+	start, result := time.Now(), resultMiss
 	if cachedEvent, err := r.cache.GetEvent(ctx, id); err != nil {
 		log.Printf("Cache error for event %s: %v", id, err)
+		result = resultError
 	} else if cachedEvent != nil {
 		// Cache hit!
+		observeRead(familyByID, resultHit, start)
 		return cachedEvent, nil
 	}
 
 	// 2. Cache miss - get from database
+	gen := r.currentGeneration()
 	evt, err := r.baseRepo.GetByID(ctx, id)
+	observeRead(familyByID, result, start)
 	if err != nil {
 		return nil, err
 	}
 
-	// 3. Populate cache for next time (async to avoid blocking)
-	go func() {
-		if err := r.cache.SetEvent(context.Background(), evt); err != nil {
+	// 3. Populate cache for next time (in the background to avoid blocking)
+	r.populate(gen, func(ctx context.Context) {
+		if err := r.cache.SetEvent(ctx, evt); err != nil {
 			log.Printf("Warning: Failed to cache event %s: %v", id, err)
 		}
-	}()
+	})
 
 	return evt, nil
 }
 
+// GetBySlug retrieves an event by slug without caching
+// Slug lookups are rare next to ID lookups, and caching them would need invalidation on every title change.
+func (r *CachedEventRepository) GetBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	return r.baseRepo.GetBySlug(ctx, slug)
+}
+
 // GetAll implements caching for all events
 func (r *CachedEventRepository) GetAll(ctx context.Context) ([]*event.Event, error) {
 	// 1. Try cache first
+	start, result := time.Now(), resultMiss
 	if cachedEvents, err := r.cache.GetAllEvents(ctx); err != nil {
 		log.Printf("Cache error for all events: %v", err)
+		result = resultError
 	} else if cachedEvents != nil {
+		observeRead(familyAll, resultHit, start)
 		return cachedEvents, nil
 	}
 
 	// 2. Cache miss - get from database
+	gen := r.currentGeneration()
 	events, err := r.baseRepo.GetAll(ctx)
+	observeRead(familyAll, result, start)
 	if err != nil {
 		return nil, err
 	}
 
-	// 3. Populate cache (async)
-	go func() {
-		if err := r.cache.SetAllEvents(context.Background(), events); err != nil {
+	// 3. Populate cache (in the background)
+	r.populate(gen, func(ctx context.Context) {
+		if err := r.cache.SetAllEvents(ctx, events); err != nil {
 			log.Printf("Warning: Failed to cache all events: %v", err)
 		}
-	}()
+	})
 
 	return events, nil
 }
@@ -94,24 +123,29 @@ func (r *CachedEventRepository) GetAll(ctx context.Context) ([]*event.Event, err
 // GetByOrganizer implements caching for events by organizer
 func (r *CachedEventRepository) GetByOrganizer(ctx context.Context, organizerID uuid.UUID) ([]*event.Event, error) {
 	// 1. Try cache first
+	start, result := time.Now(), resultMiss
 	if cachedEvents, err := r.cache.GetEventsByOrganizer(ctx, organizerID); err != nil {
 		log.Printf("Cache error for organizer %s events: %v", organizerID, err)
+		result = resultError
 	} else if cachedEvents != nil {
+		observeRead(familyByOrganizer, resultHit, start)
 		return cachedEvents, nil
 	}
 
 	// 2. Cache miss - get from database
+	gen := r.currentGeneration()
 	events, err := r.baseRepo.GetByOrganizer(ctx, organizerID)
+	observeRead(familyByOrganizer, result, start)
 	if err != nil {
 		return nil, err
 	}
 
-	// 3. Populate cache (async)
-	go func() {
-		if err := r.cache.SetEventsByOrganizer(context.Background(), organizerID, events); err != nil {
+	// 3. Populate cache (in the background)
+	r.populate(gen, func(ctx context.Context) {
+		if err := r.cache.SetEventsByOrganizer(ctx, organizerID, events); err != nil {
 			log.Printf("Warning: Failed to cache events for organizer %s: %v", organizerID, err)
 		}
-	}()
+	})
 
 	return events, nil
 }
@@ -119,24 +153,29 @@ func (r *CachedEventRepository) GetByOrganizer(ctx context.Context, organizerID
 // GetByVenue implements caching for events by venue
 func (r *CachedEventRepository) GetByVenue(ctx context.Context, venueID uuid.UUID) ([]*event.Event, error) {
 	// 1. Try cache first
+	start, result := time.Now(), resultMiss
 	if cachedEvents, err := r.cache.GetEventsByVenue(ctx, venueID); err != nil {
 		log.Printf("Cache error for venue %s events: %v", venueID, err)
+		result = resultError
 	} else if cachedEvents != nil {
+		observeRead(familyByVenue, resultHit, start)
 		return cachedEvents, nil
 	}
 
 	// 2. Cache miss - get from database
+	gen := r.currentGeneration()
 	events, err := r.baseRepo.GetByVenue(ctx, venueID)
+	observeRead(familyByVenue, result, start)
 	if err != nil {
 		return nil, err
 	}
 
-	// 3. Populate cache (async)
-	go func() {
-		if err := r.cache.SetEventsByVenue(context.Background(), venueID, events); err != nil {
+	// 3. Populate cache (in the background)
+	r.populate(gen, func(ctx context.Context) {
+		if err := r.cache.SetEventsByVenue(ctx, venueID, events); err != nil {
 			log.Printf("Warning: Failed to cache events for venue %s: %v", venueID, err)
 		}
-	}()
+	})
 
 	return events, nil
 }
@@ -149,6 +188,7 @@ func (r *CachedEventRepository) Update(ctx context.Context, evt *event.Event) er
 	}
 
 	// Invalidate related caches
+	r.bumpGeneration()
 	if err := r.cache.InvalidateEventRelatedCaches(ctx, evt.ID, evt.VenueID, evt.OrganizerID); err != nil {
 		log.Printf("Warning: Failed to invalidate cache after event update: %v", err)
 	}
@@ -170,9 +210,101 @@ func (r *CachedEventRepository) Delete(ctx context.Context, id uuid.UUID) error
 	}
 
 	// Invalidate related caches
+	r.bumpGeneration()
 	if err := r.cache.InvalidateEventRelatedCaches(ctx, evt.ID, evt.VenueID, evt.OrganizerID); err != nil {
 		log.Printf("Warning: Failed to invalidate cache after event deletion: %v", err)
 	}
 
 	return nil
 }
+
+// RefreshOnSale refreshes on-sale flags and invalidates the caches of every event that changed
+func (r *CachedEventRepository) RefreshOnSale(ctx context.Context, now time.Time) ([]*event.Event, error) {
+	changed, err := r.baseRepo.RefreshOnSale(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	if len(changed) == 0 {
+		return changed, nil
+	}
+
+	r.bumpGeneration()
+	for _, evt := range changed {
+		if err := r.cache.InvalidateEventRelatedCaches(ctx, evt.ID, evt.VenueID, evt.OrganizerID); err != nil {
+			log.Printf("Warning: Failed to invalidate cache after on-sale refresh: %v", err)
+		}
+	}
+
+	return changed, nil
+}
+
+// AdjustInventory adjusts an event's ticket counts and invalidates related caches
+func (r *CachedEventRepository) AdjustInventory(ctx context.Context, adjustment *event.InventoryAdjustment) error {
+	// Get event details before the adjustment for cache invalidation
+	evt, err := r.baseRepo.GetByID(ctx, adjustment.EventID)
+	if err != nil {
+		return err
+	}
+
+	if err := r.baseRepo.AdjustInventory(ctx, adjustment); err != nil {
+		return err
+	}
+
+	// Invalidate related caches; the venue and organizer listings hold the old counts too
+	r.bumpGeneration()
+	if err := r.cache.InvalidateEventRelatedCaches(ctx, evt.ID, evt.VenueID, evt.OrganizerID); err != nil {
+		log.Printf("Warning: Failed to invalidate cache after inventory adjustment: %v", err)
+	}
+
+	return nil
+}
+
+// InvalidateEventRelatedCaches drops an event's cached data after it was changed without this repository
+// Writers such as transfers update events directly; going through here bumps the generation as the
+// repository's own mutations do, so reads that started before the change can't repopulate the cache.
+func (r *CachedEventRepository) InvalidateEventRelatedCaches(ctx context.Context, eventID, venueID, organizerID uuid.UUID) error {
+	r.bumpGeneration()
+	return r.cache.InvalidateEventRelatedCaches(ctx, eventID, venueID, organizerID)
+}
+
+// ListInventoryAdjustments retrieves an event's inventory adjustments without caching; they are rarely read
+func (r *CachedEventRepository) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID) ([]*event.InventoryAdjustment, error) {
+	return r.baseRepo.ListInventoryAdjustments(ctx, eventID)
+}
+
+// observeRead records the outcome of a read and how long it took, including the database fallback
+func observeRead(family, result string, start time.Time) {
+	metrics.CacheRequests.WithLabelValues(family, result).Inc()
+
+	source := "database"
+	if result == resultHit {
+		source = "cache"
+	}
+	metrics.CacheReadDuration.WithLabelValues(family, source).Observe(time.Since(start).Seconds())
+}
+
+// currentGeneration returns the mutation generation observed before a database read
+func (r *CachedEventRepository) currentGeneration() uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.generation
+}
+
+// bumpGeneration marks cached data loaded before this point as stale
+func (r *CachedEventRepository) bumpGeneration() {
+	r.mu.Lock()
+	r.generation++
+	r.mu.Unlock()
+}
+
+// populate queues set on the populator; it runs only if no mutation happened since gen was observed
+func (r *CachedEventRepository) populate(gen uint64, set func(ctx context.Context)) {
+	r.populator.Submit(func(ctx context.Context) {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		if r.generation != gen {
+			return
+		}
+		set(ctx)
+	})
+}
@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/infrastructure/metrics"
+	"enterprise-crud/internal/lifecycle"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCachedRepository(t *testing.T) *CachedEventRepository {
+	svc := NewLocalEventCacheService(&config.RedisConfig{
+		CacheTTL:       time.Minute,
+		LocalCacheSize: 10,
+		LocalCacheTTL:  time.Minute,
+	})
+
+	// A single worker runs writes in submission order
+	populator := NewPopulator(lifecycle.New(context.Background()), 1, 10, time.Second)
+	t.Cleanup(populator.Close)
+
+	return NewCachedEventRepository(nil, svc, populator)
+}
+
+// waitForPopulator blocks until every previously submitted write has run
+func waitForPopulator(t *testing.T, p *Populator) {
+	done := make(chan struct{})
+	require.True(t, p.Submit(func(ctx context.Context) { close(done) }))
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("populator did not run queued writes")
+	}
+}
+
+func TestCachedEventRepository_PopulateSkippedAfterMutation(t *testing.T) {
+	repo := newTestCachedRepository(t)
+	ctx := context.Background()
+	stale := []*event.Event{{ID: uuid.New(), Title: "Before update"}}
+
+	// A read observes the generation, then a write lands before the read populates the cache
+	gen := repo.currentGeneration()
+	repo.bumpGeneration()
+	repo.populate(gen, func(ctx context.Context) {
+		_ = repo.cache.SetAllEvents(ctx, stale)
+	})
+	waitForPopulator(t, repo.populator)
+
+	cached, err := repo.cache.GetAllEvents(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, cached, "stale read must not repopulate the cache after a write")
+}
+
+func TestCachedEventRepository_PopulateSkippedAfterOutsideInvalidation(t *testing.T) {
+	repo := newTestCachedRepository(t)
+	ctx := context.Background()
+	evt := &event.Event{ID: uuid.New(), Title: "Before transfer"}
+
+	// A read observes the generation, then a transfer changes the event's organizer
+	gen := repo.currentGeneration()
+	require.NoError(t, repo.InvalidateEventRelatedCaches(ctx, evt.ID, uuid.New(), uuid.New()))
+	repo.populate(gen, func(ctx context.Context) {
+		_ = repo.cache.SetEvent(ctx, evt)
+	})
+	waitForPopulator(t, repo.populator)
+
+	cached, err := repo.cache.GetEvent(ctx, evt.ID)
+	require.NoError(t, err)
+	assert.Nil(t, cached, "stale read must not repopulate the cache after an outside change")
+}
+
+func TestCachedEventRepository_PopulateWithoutMutation(t *testing.T) {
+	repo := newTestCachedRepository(t)
+	called := false
+
+	repo.populate(repo.currentGeneration(), func(ctx context.Context) { called = true })
+	waitForPopulator(t, repo.populator)
+
+	assert.True(t, called)
+}
+
+// stubEventRepository serves GetByID from memory; other methods are not used by these tests
+type stubEventRepository struct {
+	event.Repository
+	events map[uuid.UUID]*event.Event
+}
+
+func (s *stubEventRepository) GetByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
+	evt, ok := s.events[id]
+	if !ok {
+		return nil, event.ErrEventNotFound
+	}
+	return evt, nil
+}
+
+func TestCachedEventRepository_RecordsHitsAndMisses(t *testing.T) {
+	repo := newTestCachedRepository(t)
+	ctx := context.Background()
+	evt := &event.Event{ID: uuid.New(), Title: "Concert"}
+	repo.baseRepo = &stubEventRepository{events: map[uuid.UUID]*event.Event{evt.ID: evt}}
+
+	requests := func(result string) float64 {
+		return testutil.ToFloat64(metrics.CacheRequests.WithLabelValues(familyByID, result))
+	}
+	lookups := func(result string) float64 {
+		return testutil.ToFloat64(metrics.CacheLookups.WithLabelValues(familyByID, "local", result))
+	}
+	hits, misses := requests(resultHit), requests(resultMiss)
+	localHits, localMisses := lookups(resultHit), lookups(resultMiss)
+	cacheReads, databaseReads := readCount(t, "cache"), readCount(t, "database")
+
+	// First read misses and populates the cache, second read is served from it
+	_, err := repo.GetByID(ctx, evt.ID)
+	require.NoError(t, err)
+	waitForPopulator(t, repo.populator)
+	_, err = repo.GetByID(ctx, evt.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, misses+1, requests(resultMiss))
+	assert.Equal(t, hits+1, requests(resultHit))
+	assert.Equal(t, localMisses+1, lookups(resultMiss))
+	assert.Equal(t, localHits+1, lookups(resultHit))
+	assert.Equal(t, databaseReads+1, readCount(t, "database"))
+	assert.Equal(t, cacheReads+1, readCount(t, "cache"))
+}
+
+// readCount returns how many by-id reads were timed for source
+func readCount(t *testing.T, source string) uint64 {
+	var m dto.Metric
+	require.NoError(t, metrics.CacheReadDuration.WithLabelValues(familyByID, source).(prometheus.Histogram).Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestCachedEventRepository_RecordsMissWhenDatabaseFails(t *testing.T) {
+	repo := newTestCachedRepository(t)
+	repo.baseRepo = &stubEventRepository{events: map[uuid.UUID]*event.Event{}}
+	misses := testutil.ToFloat64(metrics.CacheRequests.WithLabelValues(familyByID, resultMiss))
+
+	_, err := repo.GetByID(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, event.ErrEventNotFound)
+	assert.Equal(t, misses+1, testutil.ToFloat64(metrics.CacheRequests.WithLabelValues(familyByID, resultMiss)))
+}
+
+func TestKeyFamily(t *testing.T) {
+	id := uuid.New().String()
+	assert.Equal(t, familyByID, keyFamily(eventByIDKeyPrefix+id))
+	assert.Equal(t, familyByVenue, keyFamily(eventsByVenueKeyPrefix+id))
+	assert.Equal(t, familyByOrganizer, keyFamily(eventsByOrgKeyPrefix+id))
+	assert.Equal(t, familyAll, keyFamily(allEventsKey))
+}
+
+// recordingEdge records the paths it is asked to purge
+type recordingEdge struct {
+	paths []string
+}
+
+func (e *recordingEdge) Purge(paths ...string) {
+	e.paths = append(e.paths, paths...)
+}
+
+func TestEventCacheService_PurgesEdgeOnInvalidation(t *testing.T) {
+	repo := newTestCachedRepository(t)
+	edge := &recordingEdge{}
+	repo.cache.PurgeEdgeWith(edge)
+	eventID := uuid.New()
+
+	require.NoError(t, repo.cache.InvalidateEventRelatedCaches(context.Background(), eventID, uuid.New(), uuid.New()))
+
+	assert.Equal(t, []string{
+		"/api/v1/events/" + eventID.String(),
+		"/api/v1/events/" + eventID.String() + "/share",
+		"/api/v1/events",
+	}, edge.paths)
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"enterprise-crud/internal/domain/report"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// salesSeriesKeyPrefix prefixes cached sales series; keys must not start with "event", see shortURLByCodeKeyPrefix
+const salesSeriesKeyPrefix = "report:sales-series:"
+
+// CachedReportRepository caches the sales series behind sellout forecasts in Redis
+// A forecast only moves noticeably over hours, so series are cached for a fixed TTL
+// without invalidation. Every other method goes straight to the base repository.
+type CachedReportRepository struct {
+	report.Repository
+	client   *redis.Client
+	cacheTTL time.Duration
+}
+
+// NewCachedReportRepository creates a report repository caching sales series for ttl
+func NewCachedReportRepository(baseRepo report.Repository, redisClient *RedisClient, ttl time.Duration) *CachedReportRepository {
+	return &CachedReportRepository{
+		Repository: baseRepo,
+		client:     redisClient.GetClient(),
+		cacheTTL:   ttl,
+	}
+}
+
+// GetSalesSeries implements cache-aside lookups of an event's sales series
+func (r *CachedReportRepository) GetSalesSeries(ctx context.Context, eventID uuid.UUID) (*report.SalesSeries, error) {
+	key := salesSeriesKeyPrefix + eventID.String()
+	data, err := r.client.Get(ctx, key).Bytes()
+	if err == nil {
+		var series report.SalesSeries
+		if err := json.Unmarshal(data, &series); err == nil {
+			return &series, nil
+		}
+	} else if err != redis.Nil {
+		log.Printf("Cache error for sales series %s: %v", eventID, err)
+	}
+
+	series, err := r.Repository.GetSalesSeries(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, err := json.Marshal(series); err == nil {
+		if err := r.client.Set(ctx, key, data, r.cacheTTL).Err(); err != nil {
+			log.Printf("Warning: Failed to cache sales series %s: %v", eventID, err)
+		}
+	}
+	return series, nil
+}
@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"enterprise-crud/internal/domain/shorturl"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Short URL keys must not start with "event": those are flushed on event cache invalidation
+const (
+	shortURLByCodeKeyPrefix  = "shorturl:code:"
+	shortURLByEventKeyPrefix = "shorturl:event:"
+)
+
+// CachedShortURLRepository implements the shorturl.Repository interface with Redis caching
+// A code never changes once assigned to an event, so lookups by code and by event
+// are cached without invalidation. Cached entries carry no click counts; GetByEventID,
+// which backs the click statistics, always reads from the database.
+type CachedShortURLRepository struct {
+	baseRepo shorturl.Repository
+	client   *redis.Client
+	cacheTTL time.Duration
+}
+
+// NewCachedShortURLRepository creates a new cached short URL repository
+func NewCachedShortURLRepository(baseRepo shorturl.Repository, redisClient *RedisClient) *CachedShortURLRepository {
+	return &CachedShortURLRepository{
+		baseRepo: baseRepo,
+		client:   redisClient.GetClient(),
+		cacheTTL: redisClient.GetConfig().CacheTTL,
+	}
+}
+
+// Create stores a short URL and caches it
+func (r *CachedShortURLRepository) Create(ctx context.Context, shortURL *shorturl.ShortURL) (bool, error) {
+	created, err := r.baseRepo.Create(ctx, shortURL)
+	if err != nil || !created {
+		return created, err
+	}
+
+	r.set(ctx, shortURL)
+	return true, nil
+}
+
+// GetByCode implements cache-aside lookups of short URLs by code
+func (r *CachedShortURLRepository) GetByCode(ctx context.Context, code string) (*shorturl.ShortURL, error) {
+	if cached := r.get(ctx, []string{shortURLByCodeKeyPrefix + code}); cached[0] != nil {
+		return cached[0], nil
+	}
+
+	shortURL, err := r.baseRepo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	r.set(ctx, shortURL)
+	return shortURL, nil
+}
+
+// GetByEventID reads from the database so click counts are current
+func (r *CachedShortURLRepository) GetByEventID(ctx context.Context, eventID uuid.UUID) (*shorturl.ShortURL, error) {
+	return r.baseRepo.GetByEventID(ctx, eventID)
+}
+
+// GetByEventIDs reads cached short URLs and loads only the misses from the database
+func (r *CachedShortURLRepository) GetByEventIDs(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID]*shorturl.ShortURL, error) {
+	keys := make([]string, len(eventIDs))
+	for i, id := range eventIDs {
+		keys[i] = shortURLByEventKeyPrefix + id.String()
+	}
+
+	byEvent := make(map[uuid.UUID]*shorturl.ShortURL, len(eventIDs))
+	var misses []uuid.UUID
+	for i, cached := range r.get(ctx, keys) {
+		if cached != nil {
+			byEvent[eventIDs[i]] = cached
+		} else {
+			misses = append(misses, eventIDs[i])
+		}
+	}
+	if len(misses) == 0 {
+		return byEvent, nil
+	}
+
+	loaded, err := r.baseRepo.GetByEventIDs(ctx, misses)
+	if err != nil {
+		return nil, err
+	}
+	for eventID, shortURL := range loaded {
+		byEvent[eventID] = shortURL
+		r.set(ctx, shortURL)
+	}
+	return byEvent, nil
+}
+
+// IncrementClicks counts the click in the database
+func (r *CachedShortURLRepository) IncrementClicks(ctx context.Context, code string) error {
+	return r.baseRepo.IncrementClicks(ctx, code)
+}
+
+// get reads cached short URLs; misses and cache errors are returned as nil entries
+func (r *CachedShortURLRepository) get(ctx context.Context, keys []string) []*shorturl.ShortURL {
+	results := make([]*shorturl.ShortURL, len(keys))
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		log.Printf("Cache error for short URLs: %v", err)
+		return results
+	}
+
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+		var shortURL shorturl.ShortURL
+		if err := json.Unmarshal([]byte(data), &shortURL); err == nil {
+			results[i] = &shortURL
+		}
+	}
+	return results
+}
+
+// set caches a short URL under both its code and its event
+func (r *CachedShortURLRepository) set(ctx context.Context, shortURL *shorturl.ShortURL) {
+	cached := *shortURL
+	cached.Clicks = 0
+	data, err := json.Marshal(&cached)
+	if err != nil {
+		return
+	}
+
+	pipe := r.client.Pipeline()
+	pipe.Set(ctx, shortURLByCodeKeyPrefix+shortURL.Code, data, r.cacheTTL)
+	pipe.Set(ctx, shortURLByEventKeyPrefix+shortURL.EventID.String(), data, r.cacheTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("Warning: Failed to cache short URL %s: %v", shortURL.Code, err)
+	}
+}
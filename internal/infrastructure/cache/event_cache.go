@@ -3,30 +3,86 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
+	"strings"
+	"sync"
 	"time"
 
+	"enterprise-crud/internal/config"
 	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/infrastructure/metrics"
+	"enterprise-crud/internal/infrastructure/resilience"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 )
 
 // EventCacheService provides caching functionality for events
-// It implements a cache-aside pattern with automatic TTL management
+// It implements a cache-aside pattern with automatic TTL management.
+// Lookups go through a small in-process LRU first and then Redis; when Redis
+// keeps failing its circuit breaker opens and only the local cache is used.
 type EventCacheService struct {
-	client   *redis.Client
+	client   *redis.Client // Nil when running without Redis (local cache only)
 	cacheTTL time.Duration
+	local    *LocalCache
+	redis    *resilience.Executor // Circuit breaker around Redis calls
+
+	staleMu sync.Mutex
+	stale   bool // Invalidations were skipped while Redis was bypassed; Redis must be flushed on recovery
+
+	edge EdgePurger // Purges public event responses cached by the CDN; nil when there is none
+}
+
+// EdgePurger purges API responses cached outside the service, e.g. by a CDN
+// Purge must not block; paths are relative to the API's public origin.
+type EdgePurger interface {
+	Purge(paths ...string)
 }
 
 // NewEventCacheService creates a new event cache service
 func NewEventCacheService(redisClient *RedisClient) *EventCacheService {
+	cfg := redisClient.GetConfig()
 	return &EventCacheService{
 		client:   redisClient.GetClient(),
-		cacheTTL: redisClient.GetConfig().CacheTTL,
+		cacheTTL: cfg.CacheTTL,
+		local:    NewLocalCache(cfg.LocalCacheSize, cfg.LocalCacheTTL),
+		redis:    newRedisExecutor(cfg),
+	}
+}
+
+// NewLocalEventCacheService creates an event cache service backed only by the in-process cache
+// Used when Redis is not reachable at startup so hot reads are still cached
+func NewLocalEventCacheService(cfg *config.RedisConfig) *EventCacheService {
+	return &EventCacheService{
+		cacheTTL: cfg.CacheTTL,
+		local:    NewLocalCache(cfg.LocalCacheSize, cfg.LocalCacheTTL),
 	}
 }
 
+// newRedisExecutor creates the breaker guarding Redis
+// Cache calls are best-effort so they are never retried; a miss (redis.Nil) is not a failure
+func newRedisExecutor(cfg *config.RedisConfig) *resilience.Executor {
+	return resilience.NewExecutor(resilience.Settings{
+		Name:             "redis",
+		FailureThreshold: cfg.FailureThreshold,
+		OpenTimeout:      cfg.BypassDuration,
+		IsTransient: func(err error) bool {
+			return err != redis.Nil && !errors.Is(err, context.Canceled)
+		},
+	})
+}
+
+// PurgeEdgeWith purges the CDN's copies of an event's public responses whenever its caches are invalidated
+// It must be called before the cache is used.
+func (s *EventCacheService) PurgeEdgeWith(edge EdgePurger) {
+	s.edge = edge
+}
+
+// eventsPath is where public event responses the CDN may cache live, see middleware.CacheControl
+const eventsPath = "/api/v1/events"
+
 // Cache Keys - Educational: Good practice to centralize cache key generation
 const (
 	eventByIDKeyPrefix     = "event:id:"
@@ -35,179 +91,322 @@ const (
 	allEventsKey           = "events:all"
 )
 
+// Key families label cache metrics so hit ratios can be compared per kind of lookup
+const (
+	familyByID        = "by_id"
+	familyByVenue     = "by_venue"
+	familyByOrganizer = "by_organizer"
+	familyAll         = "all"
+	familyRelated     = "related" // Invalidation of an event together with the lists containing it
+	familyAny         = "any"     // Flush of every event key
+)
+
+// Lookup results recorded in cache metrics
+const (
+	resultHit      = "hit"
+	resultMiss     = "miss"
+	resultError    = "error"
+	resultBypassed = "bypassed"
+)
+
+// keyFamily returns the metrics family of a cache key
+func keyFamily(key string) string {
+	switch {
+	case strings.HasPrefix(key, eventByIDKeyPrefix):
+		return familyByID
+	case strings.HasPrefix(key, eventsByVenueKeyPrefix):
+		return familyByVenue
+	case strings.HasPrefix(key, eventsByOrgKeyPrefix):
+		return familyByOrganizer
+	default:
+		return familyAll
+	}
+}
+
 // GetEvent retrieves an event from cache by ID
 // Returns nil if not found in cache (cache miss)
 func (s *EventCacheService) GetEvent(ctx context.Context, id uuid.UUID) (*event.Event, error) {
-	key := eventByIDKeyPrefix + id.String()
-
-	data, err := s.client.Get(ctx, key).Result()
+	var cachedEvent event.Event
+	found, err := s.get(ctx, eventByIDKeyPrefix+id.String(), &cachedEvent)
 	if err != nil {
-		if err == redis.Nil {
-			return nil, nil // Cache miss
-		}
 		return nil, fmt.Errorf("failed to get event from cache: %w", err)
 	}
-
-	var cachedEvent event.Event
-	if err := json.Unmarshal([]byte(data), &cachedEvent); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal cached event: %w", err)
+	if !found {
+		return nil, nil // Cache miss
 	}
-
 	return &cachedEvent, nil
 }
 
 // SetEvent stores an event in cache with TTL
 func (s *EventCacheService) SetEvent(ctx context.Context, evt *event.Event) error {
-	key := eventByIDKeyPrefix + evt.ID.String()
-
-	data, err := json.Marshal(evt)
-	if err != nil {
-		return fmt.Errorf("failed to marshal event for cache: %w", err)
-	}
-
-	if err := s.client.Set(ctx, key, data, s.cacheTTL).Err(); err != nil {
+	if err := s.set(ctx, eventByIDKeyPrefix+evt.ID.String(), evt); err != nil {
 		return fmt.Errorf("failed to set event in cache: %w", err)
 	}
-
 	return nil
 }
 
 // DeleteEvent removes an event from cache
 func (s *EventCacheService) DeleteEvent(ctx context.Context, id uuid.UUID) error {
-	key := eventByIDKeyPrefix + id.String()
-
-	if err := s.client.Del(ctx, key).Err(); err != nil {
+	if err := s.del(ctx, eventByIDKeyPrefix+id.String()); err != nil {
 		return fmt.Errorf("failed to delete event from cache: %w", err)
 	}
-
 	return nil
 }
 
 // GetEventsByVenue retrieves cached events for a specific venue
 func (s *EventCacheService) GetEventsByVenue(ctx context.Context, venueID uuid.UUID) ([]*event.Event, error) {
-	key := eventsByVenueKeyPrefix + venueID.String()
+	return s.getList(ctx, eventsByVenueKeyPrefix+venueID.String())
+}
 
-	data, err := s.client.Get(ctx, key).Result()
-	if err != nil {
-		if err == redis.Nil {
-			return nil, nil // Cache miss
-		}
-		return nil, fmt.Errorf("failed to get events by venue from cache: %w", err)
+// SetEventsByVenue stores events for a venue in cache
+func (s *EventCacheService) SetEventsByVenue(ctx context.Context, venueID uuid.UUID, events []*event.Event) error {
+	if err := s.set(ctx, eventsByVenueKeyPrefix+venueID.String(), events); err != nil {
+		return fmt.Errorf("failed to set events by venue in cache: %w", err)
 	}
+	return nil
+}
 
-	var events []*event.Event
-	if err := json.Unmarshal([]byte(data), &events); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal cached events: %w", err)
+// GetEventsByOrganizer retrieves cached events for a specific organizer
+func (s *EventCacheService) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID) ([]*event.Event, error) {
+	return s.getList(ctx, eventsByOrgKeyPrefix+organizerID.String())
+}
+
+// SetEventsByOrganizer stores events for an organizer in cache
+func (s *EventCacheService) SetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, events []*event.Event) error {
+	if err := s.set(ctx, eventsByOrgKeyPrefix+organizerID.String(), events); err != nil {
+		return fmt.Errorf("failed to set events by organizer in cache: %w", err)
 	}
+	return nil
+}
 
-	return events, nil
+// GetAllEvents retrieves all cached events
+func (s *EventCacheService) GetAllEvents(ctx context.Context) ([]*event.Event, error) {
+	return s.getList(ctx, allEventsKey)
 }
 
-// SetEventsByVenue stores events for a venue in cache
-func (s *EventCacheService) SetEventsByVenue(ctx context.Context, venueID uuid.UUID, events []*event.Event) error {
-	key := eventsByVenueKeyPrefix + venueID.String()
+// SetAllEvents stores all events in cache
+func (s *EventCacheService) SetAllEvents(ctx context.Context, events []*event.Event) error {
+	if err := s.set(ctx, allEventsKey, events); err != nil {
+		return fmt.Errorf("failed to set all events in cache: %w", err)
+	}
+	return nil
+}
 
-	data, err := json.Marshal(events)
-	if err != nil {
-		return fmt.Errorf("failed to marshal events for cache: %w", err)
+// InvalidateEventCaches removes all event-related caches
+// This is called when events are modified to ensure cache consistency
+func (s *EventCacheService) InvalidateEventCaches(ctx context.Context) error {
+	s.local.DeletePrefix("event")
+	if s.edge != nil {
+		s.edge.Purge(eventsPath)
 	}
 
-	if err := s.client.Set(ctx, key, data, s.cacheTTL).Err(); err != nil {
-		return fmt.Errorf("failed to set events by venue in cache: %w", err)
+	if s.client == nil {
+		return nil
 	}
 
+	err := s.callRedis(ctx, familyAny, "flush", s.flushRedis)
+	if errors.Is(err, resilience.ErrCircuitOpen) {
+		s.markStale()
+		return nil
+	}
+	if err != nil {
+		s.markStale()
+		return err
+	}
+	s.redisSucceeded(ctx)
+
 	return nil
 }
 
-// GetEventsByOrganizer retrieves cached events for a specific organizer
-func (s *EventCacheService) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID) ([]*event.Event, error) {
-	key := eventsByOrgKeyPrefix + organizerID.String()
+// InvalidateEventRelatedCaches invalidates caches related to a specific event
+// This is more granular than full cache invalidation
+func (s *EventCacheService) InvalidateEventRelatedCaches(ctx context.Context, eventID, venueID, organizerID uuid.UUID) error {
+	// Specific event, venue-related, organizer-related and all-events caches
+	keys := []string{
+		eventByIDKeyPrefix + eventID.String(),
+		eventsByVenueKeyPrefix + venueID.String(),
+		eventsByOrgKeyPrefix + organizerID.String(),
+		allEventsKey,
+	}
+	if s.edge != nil {
+		s.edge.Purge(eventsPath+"/"+eventID.String(), eventsPath+"/"+eventID.String()+"/share", eventsPath)
+	}
 
-	data, err := s.client.Get(ctx, key).Result()
-	if err != nil {
-		if err == redis.Nil {
-			return nil, nil // Cache miss
-		}
-		return nil, fmt.Errorf("failed to get events by organizer from cache: %w", err)
+	if err := s.del(ctx, keys...); err != nil {
+		return fmt.Errorf("failed to execute event-related cache invalidation: %w", err)
 	}
 
+	return nil
+}
+
+// getList reads a cached list of events stored under key
+func (s *EventCacheService) getList(ctx context.Context, key string) ([]*event.Event, error) {
 	var events []*event.Event
-	if err := json.Unmarshal([]byte(data), &events); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal cached events: %w", err)
+	found, err := s.get(ctx, key, &events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cached events: %w", err)
+	}
+	if !found {
+		return nil, nil // Cache miss
 	}
-
 	return events, nil
 }
 
-// SetEventsByOrganizer stores events for an organizer in cache
-func (s *EventCacheService) SetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, events []*event.Event) error {
-	key := eventsByOrgKeyPrefix + organizerID.String()
+// get looks a key up in the local cache, then Redis, and decodes it into dest
+// Returns false without an error on a miss or while Redis is being bypassed
+func (s *EventCacheService) get(ctx context.Context, key string, dest interface{}) (bool, error) {
+	family := keyFamily(key)
+	if data, ok := s.local.Get(key); ok {
+		metrics.CacheLookups.WithLabelValues(family, "local", resultHit).Inc()
+		return true, json.Unmarshal(data, dest)
+	}
+	metrics.CacheLookups.WithLabelValues(family, "local", resultMiss).Inc()
 
-	data, err := json.Marshal(events)
-	if err != nil {
-		return fmt.Errorf("failed to marshal events for cache: %w", err)
+	if s.client == nil {
+		return false, nil
 	}
 
-	if err := s.client.Set(ctx, key, data, s.cacheTTL).Err(); err != nil {
-		return fmt.Errorf("failed to set events by organizer in cache: %w", err)
+	var data []byte
+	err := s.callRedis(ctx, family, "get", func(ctx context.Context) error {
+		var err error
+		data, err = s.client.Get(ctx, key).Bytes()
+		return err
+	})
+	switch {
+	case err == redis.Nil:
+		metrics.CacheLookups.WithLabelValues(family, "redis", resultMiss).Inc()
+		s.redisSucceeded(ctx)
+		return false, nil
+	case errors.Is(err, resilience.ErrCircuitOpen):
+		metrics.CacheLookups.WithLabelValues(family, "redis", resultBypassed).Inc()
+		return false, nil
+	case err != nil:
+		metrics.CacheLookups.WithLabelValues(family, "redis", resultError).Inc()
+		return false, err
 	}
+	metrics.CacheLookups.WithLabelValues(family, "redis", resultHit).Inc()
+	s.redisSucceeded(ctx)
 
-	return nil
+	if err := json.Unmarshal(data, dest); err != nil {
+		return false, fmt.Errorf("failed to unmarshal cached value: %w", err)
+	}
+
+	s.local.Set(key, data)
+	return true, nil
 }
 
-// GetAllEvents retrieves all cached events
-func (s *EventCacheService) GetAllEvents(ctx context.Context) ([]*event.Event, error) {
-	data, err := s.client.Get(ctx, allEventsKey).Result()
+// set encodes value and stores it locally and in Redis
+func (s *EventCacheService) set(ctx context.Context, key string, value interface{}) error {
+	data, err := json.Marshal(value)
 	if err != nil {
-		if err == redis.Nil {
-			return nil, nil // Cache miss
-		}
-		return nil, fmt.Errorf("failed to get all events from cache: %w", err)
+		return fmt.Errorf("failed to marshal value for cache: %w", err)
 	}
 
-	var events []*event.Event
-	if err := json.Unmarshal([]byte(data), &events); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal cached events: %w", err)
+	s.local.Set(key, data)
+
+	if s.client == nil {
+		return nil
 	}
 
-	return events, nil
+	err = s.callRedis(ctx, keyFamily(key), "set", func(ctx context.Context) error {
+		return s.client.Set(ctx, key, data, s.cacheTTL).Err()
+	})
+	if errors.Is(err, resilience.ErrCircuitOpen) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.redisSucceeded(ctx)
+
+	return nil
 }
 
-// SetAllEvents stores all events in cache
-func (s *EventCacheService) SetAllEvents(ctx context.Context, events []*event.Event) error {
-	data, err := json.Marshal(events)
-	if err != nil {
-		return fmt.Errorf("failed to marshal events for cache: %w", err)
+// del removes keys locally and from Redis
+// If Redis can't be reached the missed invalidation is remembered and Redis is flushed on recovery
+func (s *EventCacheService) del(ctx context.Context, keys ...string) error {
+	s.local.Delete(keys...)
+
+	if s.client == nil {
+		return nil
 	}
 
-	if err := s.client.Set(ctx, allEventsKey, data, s.cacheTTL).Err(); err != nil {
-		return fmt.Errorf("failed to set all events in cache: %w", err)
+	family := familyRelated
+	if len(keys) == 1 {
+		family = keyFamily(keys[0])
+	}
+
+	err := s.callRedis(ctx, family, "delete", func(ctx context.Context) error {
+		return s.client.Del(ctx, keys...).Err()
+	})
+	if errors.Is(err, resilience.ErrCircuitOpen) {
+		s.markStale()
+		return nil
 	}
+	if err != nil {
+		s.markStale()
+		return err
+	}
+	s.redisSucceeded(ctx)
 
 	return nil
 }
 
-// InvalidateEventCaches removes all event-related caches
-// This is called when events are modified to ensure cache consistency
-func (s *EventCacheService) InvalidateEventCaches(ctx context.Context) error {
+// callRedis runs fn through the Redis circuit breaker and records its latency and failures
+// Calls skipped while the breaker is open are not timed; a miss is not a failure.
+func (s *EventCacheService) callRedis(ctx context.Context, family, operation string, fn func(ctx context.Context) error) error {
+	start := time.Now()
+	err := s.redis.Once(ctx, fn)
+	if errors.Is(err, resilience.ErrCircuitOpen) {
+		return err
+	}
+
+	metrics.CacheRedisDuration.WithLabelValues(family, operation).Observe(time.Since(start).Seconds())
+	if err != nil && err != redis.Nil {
+		metrics.CacheRedisErrors.WithLabelValues(family, operation).Inc()
+	}
+	return err
+}
+
+// markStale records that an invalidation could not reach Redis
+func (s *EventCacheService) markStale() {
+	s.staleMu.Lock()
+	defer s.staleMu.Unlock()
+	s.stale = true
+}
+
+// redisSucceeded flushes stale entries left behind in Redis while it was bypassed
+func (s *EventCacheService) redisSucceeded(ctx context.Context) {
+	s.staleMu.Lock()
+	stale := s.stale
+	s.stale = false
+	s.staleMu.Unlock()
+
+	if !stale {
+		return
+	}
+
+	log.Println("Redis reachable again, flushing event caches that missed invalidations")
+	if err := s.flushRedis(ctx); err != nil {
+		log.Printf("Warning: Failed to flush stale event caches after Redis recovery: %v", err)
+		s.markStale()
+	}
+}
+
+// flushRedis deletes every event-related key from Redis
+func (s *EventCacheService) flushRedis(ctx context.Context) error {
 	// Use a pipeline for efficient batch operations
 	pipe := s.client.Pipeline()
 
 	// Delete pattern-based keys (requires Redis SCAN)
-	iter := s.client.Scan(ctx, 0, "event:*", 0).Iterator()
-	var keys []string
+	iter := s.client.Scan(ctx, 0, "event*", 0).Iterator()
 	for iter.Next(ctx) {
-		keys = append(keys, iter.Val())
+		pipe.Del(ctx, iter.Val())
 	}
 	if err := iter.Err(); err != nil {
 		return fmt.Errorf("failed to scan event keys: %w", err)
 	}
 
-	// Add all keys to deletion pipeline
-	for _, key := range keys {
-		pipe.Del(ctx, key)
-	}
-
 	// Delete specific keys
 	pipe.Del(ctx, allEventsKey)
 
@@ -218,27 +417,3 @@ func (s *EventCacheService) InvalidateEventCaches(ctx context.Context) error {
 
 	return nil
 }
-
-// InvalidateEventRelatedCaches invalidates caches related to a specific event
-// This is more granular than full cache invalidation
-func (s *EventCacheService) InvalidateEventRelatedCaches(ctx context.Context, eventID, venueID, organizerID uuid.UUID) error {
-	pipe := s.client.Pipeline()
-
-	// Delete specific event cache
-	pipe.Del(ctx, eventByIDKeyPrefix+eventID.String())
-
-	// Delete venue-related cache
-	pipe.Del(ctx, eventsByVenueKeyPrefix+venueID.String())
-
-	// Delete organizer-related cache
-	pipe.Del(ctx, eventsByOrgKeyPrefix+organizerID.String())
-
-	// Delete all events cache
-	pipe.Del(ctx, allEventsKey)
-
-	if _, err := pipe.Exec(ctx); err != nil {
-		return fmt.Errorf("failed to execute event-related cache invalidation: %w", err)
-	}
-
-	return nil
-}
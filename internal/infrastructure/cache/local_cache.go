@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LocalCache is a small in-process LRU cache with a per-entry TTL
+// It sits in front of Redis so hot keys are served from memory and the
+// application keeps a (short-lived) cache even when Redis is unavailable.
+// Values are stored as raw bytes so callers never share mutable objects.
+type LocalCache struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	ll       *list.List               // Most recently used entries at the front
+	items    map[string]*list.Element // Key -> list element holding *localEntry
+}
+
+// localEntry is a single cached value with its expiration time
+type localEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLocalCache creates a new LRU cache holding at most capacity entries for ttl
+// A non-positive capacity disables the cache (all lookups miss)
+func NewLocalCache(capacity int, ttl time.Duration) *LocalCache {
+	return &LocalCache{
+		capacity: capacity,
+		ttl:      ttl,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the cached value for key and whether it was found and not expired
+func (c *LocalCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*localEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.removeElement(elem)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set stores value under key, evicting the least recently used entry when full
+func (c *LocalCache) Set(key string, value []byte) {
+	if c.capacity <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(c.ttl)
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*localEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.ll.MoveToFront(elem)
+		return
+	}
+
+	elem := c.ll.PushFront(&localEntry{key: key, value: value, expiresAt: expiresAt})
+	c.items[key] = elem
+
+	for c.ll.Len() > c.capacity {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// Delete removes the given keys from the cache
+func (c *LocalCache) Delete(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if elem, ok := c.items[key]; ok {
+			c.removeElement(elem)
+		}
+	}
+}
+
+// DeletePrefix removes every key starting with prefix
+func (c *LocalCache) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, elem := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.removeElement(elem)
+		}
+	}
+}
+
+// Len returns the number of entries currently held (including expired ones not yet evicted)
+func (c *LocalCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// removeElement unlinks an element from both the list and the index; caller holds the lock
+func (c *LocalCache) removeElement(elem *list.Element) {
+	c.ll.Remove(elem)
+	delete(c.items, elem.Value.(*localEntry).key)
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/event"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLocalCache(2, time.Minute)
+
+	c.Set("a", []byte("1"))
+	c.Set("b", []byte("2"))
+	_, _ = c.Get("a") // "a" becomes most recently used
+	c.Set("c", []byte("3"))
+
+	_, foundA := c.Get("a")
+	_, foundB := c.Get("b")
+	_, foundC := c.Get("c")
+
+	assert.True(t, foundA)
+	assert.False(t, foundB, "least recently used entry should be evicted")
+	assert.True(t, foundC)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestLocalCache_ExpiresEntries(t *testing.T) {
+	c := NewLocalCache(10, time.Millisecond)
+
+	c.Set("a", []byte("1"))
+	time.Sleep(5 * time.Millisecond)
+
+	_, found := c.Get("a")
+	assert.False(t, found)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestLocalCache_DeletePrefix(t *testing.T) {
+	c := NewLocalCache(10, time.Minute)
+
+	c.Set("event:id:1", []byte("1"))
+	c.Set("events:all", []byte("2"))
+	c.Set("venue:1", []byte("3"))
+
+	c.DeletePrefix("event")
+
+	assert.Equal(t, 1, c.Len())
+	_, found := c.Get("venue:1")
+	assert.True(t, found)
+}
+
+func TestLocalCache_ZeroCapacityDisablesCache(t *testing.T) {
+	c := NewLocalCache(0, time.Minute)
+
+	c.Set("a", []byte("1"))
+
+	_, found := c.Get("a")
+	assert.False(t, found)
+}
+
+func TestEventCacheService_LocalOnly(t *testing.T) {
+	cfg := &config.RedisConfig{
+		CacheTTL:       time.Minute,
+		LocalCacheSize: 10,
+		LocalCacheTTL:  time.Minute,
+	}
+	svc := NewLocalEventCacheService(cfg)
+	ctx := context.Background()
+
+	evt := &event.Event{ID: uuid.New(), VenueID: uuid.New(), OrganizerID: uuid.New(), Title: "Cached"}
+
+	cached, err := svc.GetEvent(ctx, evt.ID)
+	require.NoError(t, err)
+	assert.Nil(t, cached, "expected cache miss")
+
+	require.NoError(t, svc.SetEvent(ctx, evt))
+
+	cached, err = svc.GetEvent(ctx, evt.ID)
+	require.NoError(t, err)
+	require.NotNil(t, cached)
+	assert.Equal(t, "Cached", cached.Title)
+
+	require.NoError(t, svc.InvalidateEventRelatedCaches(ctx, evt.ID, evt.VenueID, evt.OrganizerID))
+
+	cached, err = svc.GetEvent(ctx, evt.ID)
+	require.NoError(t, err)
+	assert.Nil(t, cached, "expected invalidated entry to be gone")
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+
+	"enterprise-crud/internal/domain/maintenance"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// maintenanceKey holds the maintenance state; it must not start with "event", those keys are flushed on event cache invalidation
+const maintenanceKey = "maintenance:state"
+
+// MaintenanceStore keeps the maintenance state in Redis, so every instance answers writes the same way
+// The state has no expiry: a switch left on stays on until an admin turns it off.
+type MaintenanceStore struct {
+	client *redis.Client
+}
+
+// NewMaintenanceStore creates a maintenance state store on the given Redis client
+func NewMaintenanceStore(redisClient *RedisClient) maintenance.Repository {
+	return &MaintenanceStore{client: redisClient.GetClient()}
+}
+
+// Get retrieves the maintenance state
+func (s *MaintenanceStore) Get(ctx context.Context) (*maintenance.State, error) {
+	data, err := s.client.Get(ctx, maintenanceKey).Bytes()
+	if err == redis.Nil {
+		return &maintenance.State{}, nil
+	}
+	if err != nil {
+		return nil, maintenance.NewMaintenanceError(maintenance.ErrStateRetrievalFailed, err)
+	}
+
+	var state maintenance.State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, maintenance.NewMaintenanceError(maintenance.ErrStateRetrievalFailed, err)
+	}
+	return &state, nil
+}
+
+// Save replaces the maintenance state
+func (s *MaintenanceStore) Save(ctx context.Context, state *maintenance.State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return maintenance.NewMaintenanceError(maintenance.ErrStateSaveFailed, err)
+	}
+	if err := s.client.Set(ctx, maintenanceKey, data, 0).Err(); err != nil {
+		return maintenance.NewMaintenanceError(maintenance.ErrStateSaveFailed, err)
+	}
+	return nil
+}
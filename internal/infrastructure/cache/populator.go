@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/lifecycle"
+)
+
+// Populator writes cache entries in the background with a fixed number of workers
+// Cache population is best effort: when the queue is full the write is dropped
+// rather than blocking the request or spawning more goroutines.
+type Populator struct {
+	queue    chan func(ctx context.Context)
+	timeout  time.Duration
+	stopping <-chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	closeOnce sync.Once
+}
+
+// NewPopulator starts workers goroutines on the registry consuming a queue of queueSize pending writes
+// Each write gets its own timeout. When the registry shuts down, workers finish the queued writes
+// and exit; closing the populator instead cancels in-flight writes and discards queued ones.
+func NewPopulator(registry *lifecycle.Registry, workers, queueSize int, timeout time.Duration) *Populator {
+	if workers < 1 {
+		workers = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+
+	ctx, cancel := context.WithCancel(registry.Context())
+	p := &Populator{
+		queue:    make(chan func(ctx context.Context), queueSize),
+		timeout:  timeout,
+		stopping: registry.Stopping(),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+
+	for i := 0; i < workers; i++ {
+		p.wg.Add(1)
+		if !registry.Go("cache populator", p.work) {
+			p.wg.Done()
+		}
+	}
+
+	return p
+}
+
+// Submit queues a cache write, returning false if it was dropped
+func (p *Populator) Submit(task func(ctx context.Context)) bool {
+	if p.ctx.Err() != nil {
+		return false
+	}
+	select {
+	case <-p.stopping:
+		return false
+	default:
+	}
+
+	select {
+	case p.queue <- task:
+		return true
+	default:
+		log.Println("Warning: Cache population queue is full, dropping write")
+		return false
+	}
+}
+
+// Close cancels in-flight writes, discards queued ones and waits for workers to exit
+func (p *Populator) Close() {
+	p.closeOnce.Do(func() {
+		p.cancel()
+		p.wg.Wait()
+	})
+}
+
+func (p *Populator) work(context.Context) {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-p.stopping:
+			p.drain()
+			return
+		case task := <-p.queue:
+			p.run(task)
+		}
+	}
+}
+
+// drain runs the writes queued before shutdown began, until the queue is empty or the
+// shutdown deadline cancels them
+func (p *Populator) drain() {
+	for p.ctx.Err() == nil {
+		select {
+		case task := <-p.queue:
+			p.run(task)
+		default:
+			return
+		}
+	}
+}
+
+func (p *Populator) run(task func(ctx context.Context)) {
+	ctx, cancel := p.ctx, context.CancelFunc(func() {})
+	if p.timeout > 0 {
+		ctx, cancel = context.WithTimeout(p.ctx, p.timeout)
+	}
+	defer cancel()
+
+	task(ctx)
+}
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/lifecycle"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPopulator_DropsWritesWhenQueueFull(t *testing.T) {
+	p := NewPopulator(lifecycle.New(context.Background()), 1, 1, time.Second)
+	defer p.Close()
+
+	// Block the only worker so the queue fills up
+	release := make(chan struct{})
+	started := make(chan struct{})
+	require.True(t, p.Submit(func(ctx context.Context) {
+		close(started)
+		<-release
+	}))
+	<-started
+
+	assert.True(t, p.Submit(func(ctx context.Context) {}), "first write fits in the queue")
+	assert.False(t, p.Submit(func(ctx context.Context) {}), "write beyond the queue limit is dropped")
+
+	close(release)
+}
+
+func TestPopulator_CloseCancelsWrites(t *testing.T) {
+	p := NewPopulator(lifecycle.New(context.Background()), 1, 1, time.Minute)
+
+	cancelled := make(chan struct{})
+	started := make(chan struct{})
+	require.True(t, p.Submit(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		close(cancelled)
+	}))
+	<-started
+
+	p.Close()
+
+	select {
+	case <-cancelled:
+	default:
+		t.Fatal("in-flight write should be cancelled on close")
+	}
+	assert.False(t, p.Submit(func(ctx context.Context) {}), "closed populator rejects writes")
+}
+
+func TestPopulator_ShutdownFinishesWrites(t *testing.T) {
+	registry := lifecycle.New(context.Background())
+	p := NewPopulator(registry, 1, 2, time.Minute)
+	defer p.Close()
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var completed []string
+	require.True(t, p.Submit(func(ctx context.Context) {
+		close(started)
+		<-release
+		if ctx.Err() == nil {
+			completed = append(completed, "in-flight")
+		}
+	}))
+	<-started
+	require.True(t, p.Submit(func(ctx context.Context) {
+		completed = append(completed, "queued")
+	}))
+
+	shutdown := make(chan error)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		shutdown <- registry.Shutdown(ctx)
+	}()
+	<-registry.Stopping()
+	assert.False(t, p.Submit(func(ctx context.Context) {}), "shutting down populator rejects writes")
+
+	close(release)
+	require.NoError(t, <-shutdown)
+	assert.Equal(t, []string{"in-flight", "queued"}, completed)
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"enterprise-crud/internal/domain/user"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// roleVersionKeyPrefix starts the key of a user's roles version; it must not start with "event",
+// those keys are flushed on event cache invalidation
+const roleVersionKeyPrefix = "roles:version:"
+
+// RoleVersionStore keeps the roles version of each user in Redis, where every instance and the
+// admin CLI see the same count
+// Versions have no expiry: a lost version would make tokens issued before it look current again.
+type RoleVersionStore struct {
+	client *redis.Client
+}
+
+// NewRoleVersionStore creates a roles version store on the given Redis client
+func NewRoleVersionStore(redisClient *RedisClient) user.RoleVersions {
+	return &RoleVersionStore{client: redisClient.GetClient()}
+}
+
+// Current returns the user's roles version, 0 when their roles never changed
+func (s *RoleVersionStore) Current(ctx context.Context, userID uuid.UUID) (int64, error) {
+	version, err := s.client.Get(ctx, roleVersionKeyPrefix+userID.String()).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get roles version: %w", err)
+	}
+	return version, nil
+}
+
+// Bump increments the user's roles version
+func (s *RoleVersionStore) Bump(ctx context.Context, userID uuid.UUID) (int64, error) {
+	version, err := s.client.Incr(ctx, roleVersionKeyPrefix+userID.String()).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to bump roles version: %w", err)
+	}
+	return version, nil
+}
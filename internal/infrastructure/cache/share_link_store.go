@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"enterprise-crud/internal/domain/share"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Share link keys must not start with "event": those are flushed on event cache invalidation
+const (
+	shareLinkKeyPrefix  = "share:link:"
+	shareEventKeyPrefix = "share:event:"
+)
+
+// ShareLinkStore stores short links in Redis
+// Links are kept under their code, with a second key mapping the event to its code.
+type ShareLinkStore struct {
+	client *redis.Client
+}
+
+// NewShareLinkStore creates a short link store on the given Redis client
+func NewShareLinkStore(redisClient *RedisClient) share.LinkRepository {
+	return &ShareLinkStore{client: redisClient.GetClient()}
+}
+
+// Create stores a new short link unless its code is already taken
+func (s *ShareLinkStore) Create(ctx context.Context, link *share.ShortLink, ttl time.Duration) error {
+	data, err := json.Marshal(link)
+	if err != nil {
+		return share.NewShareError(share.ErrLinkStoreFailed, err)
+	}
+
+	created, err := s.client.SetNX(ctx, shareLinkKeyPrefix+link.Code, data, ttl).Result()
+	if err != nil {
+		return share.NewShareError(share.ErrLinkStoreFailed, err)
+	}
+	if !created {
+		return share.ErrCodeTaken
+	}
+
+	if err := s.client.Set(ctx, shareEventKeyPrefix+link.EventID.String(), link.Code, ttl).Err(); err != nil {
+		return share.NewShareError(share.ErrLinkStoreFailed, err)
+	}
+	return nil
+}
+
+// GetByCode retrieves a short link by its code
+func (s *ShareLinkStore) GetByCode(ctx context.Context, code string) (*share.ShortLink, error) {
+	data, err := s.client.Get(ctx, shareLinkKeyPrefix+code).Bytes()
+	if err == redis.Nil {
+		return nil, share.ErrLinkNotFound
+	}
+	if err != nil {
+		return nil, share.NewShareError(share.ErrLinkStoreFailed, err)
+	}
+
+	var link share.ShortLink
+	if err := json.Unmarshal(data, &link); err != nil {
+		return nil, share.NewShareError(share.ErrLinkStoreFailed, err)
+	}
+	return &link, nil
+}
+
+// GetByEventID retrieves the short link of an event, or nil if it has none
+func (s *ShareLinkStore) GetByEventID(ctx context.Context, eventID uuid.UUID) (*share.ShortLink, error) {
+	code, err := s.client.Get(ctx, shareEventKeyPrefix+eventID.String()).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, share.NewShareError(share.ErrLinkStoreFailed, err)
+	}
+
+	link, err := s.GetByCode(ctx, code)
+	if share.IsLinkNotFoundError(err) {
+		// The code expired before the event mapping did
+		return nil, nil
+	}
+	return link, err
+}
@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"enterprise-crud/internal/domain/trending"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Trending keys must not start with "event": those are flushed on event cache invalidation
+const (
+	trendingScoreKey     = "trending:score"
+	trendingDecayedAtKey = "trending:decayed_at"
+	trendingKeyPrefix    = "trending:" // Followed by the signal, e.g. trending:views
+)
+
+// trendingDecayScript multiplies every sorted set in KEYS[2:] by 0.5^(elapsed/half-life) and drops
+// the members whose score in KEYS[2] fell below the floor. KEYS[1] holds the time of the previous
+// decay in Unix milliseconds; a clock behind it decays nothing.
+var trendingDecayScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local last = tonumber(redis.call('GET', KEYS[1]))
+if last and now <= last then
+	return 0
+end
+redis.call('SET', KEYS[1], now)
+if not last then
+	return 0
+end
+
+local factor = 0.5 ^ ((now - last) / tonumber(ARGV[2]))
+for i = 2, #KEYS do
+	redis.call('ZUNIONSTORE', KEYS[i], 1, KEYS[i], 'WEIGHTS', factor)
+end
+
+local gone = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', '(' .. ARGV[3])
+for i = 1, #gone, 500 do
+	local chunk = {unpack(gone, i, math.min(i + 499, #gone))}
+	for k = 2, #KEYS do
+		redis.call('ZREM', KEYS[k], unpack(chunk))
+	end
+end
+return #gone
+`)
+
+// TrendingStore keeps trending scores in Redis sorted sets
+// One set holds the weighted score of each event and one per signal its decayed count.
+type TrendingStore struct {
+	client *redis.Client
+}
+
+// NewTrendingStore creates a trending score store on the given Redis client
+func NewTrendingStore(redisClient *RedisClient) trending.Repository {
+	return &TrendingStore{client: redisClient.GetClient()}
+}
+
+// Increment adds 1 to an event's count of signal and weight to its score
+func (s *TrendingStore) Increment(ctx context.Context, eventID uuid.UUID, signal trending.Signal, weight float64) error {
+	member := eventID.String()
+	pipe := s.client.TxPipeline()
+	pipe.ZIncrBy(ctx, trendingScoreKey, weight, member)
+	pipe.ZIncrBy(ctx, trendingKeyPrefix+string(signal), 1, member)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Top returns up to limit events with the highest scores, highest first
+func (s *TrendingStore) Top(ctx context.Context, limit int) ([]*trending.Score, error) {
+	top, err := s.client.ZRevRangeWithScores(ctx, trendingScoreKey, 0, int64(limit-1)).Result()
+	if err != nil || len(top) == 0 {
+		return nil, err
+	}
+
+	members := make([]string, len(top))
+	for i, z := range top {
+		members[i] = z.Member.(string)
+	}
+	pipe := s.client.Pipeline()
+	views := pipe.ZMScore(ctx, trendingKeyPrefix+string(trending.SignalView), members...)
+	purchases := pipe.ZMScore(ctx, trendingKeyPrefix+string(trending.SignalPurchase), members...)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	scores := make([]*trending.Score, 0, len(top))
+	for i, z := range top {
+		eventID, err := uuid.Parse(members[i])
+		if err != nil {
+			continue
+		}
+		scores = append(scores, &trending.Score{
+			EventID:   eventID,
+			Score:     z.Score,
+			Views:     views.Val()[i],
+			Purchases: purchases.Val()[i],
+		})
+	}
+	return scores, nil
+}
+
+// Decay halves scores and counts for every halfLife passed since the previous decay
+func (s *TrendingStore) Decay(ctx context.Context, now time.Time, halfLife time.Duration, floor float64) error {
+	keys := []string{
+		trendingDecayedAtKey,
+		trendingScoreKey,
+		trendingKeyPrefix + string(trending.SignalView),
+		trendingKeyPrefix + string(trending.SignalPurchase),
+	}
+	return trendingDecayScript.Run(ctx, s.client, keys, now.UnixMilli(), halfLife.Milliseconds(), floor).Err()
+}
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"enterprise-crud/internal/domain/analytics"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// View keys must not start with "event": those are flushed on event cache invalidation
+const viewKeyPrefix = "views:"
+
+// viewKeyTTL keeps a day's counts until MoveViews has stopped looking for them
+const viewKeyTTL = (analytics.MaxViewCatchUpDays + 1) * 24 * time.Hour
+
+// ViewCounter counts event page views in Redis
+// Per day it keeps a set of the events viewed, and per event a view count and a HyperLogLog of
+// visitor hashes, which estimates unique visitors in 12 KB without storing the hashes.
+type ViewCounter struct {
+	client *redis.Client
+}
+
+// NewViewCounter creates a view counter on the given Redis client
+func NewViewCounter(redisClient *RedisClient) analytics.ViewCounter {
+	return &ViewCounter{client: redisClient.GetClient()}
+}
+
+// viewDayKey is the prefix of the keys of a day's counts
+func viewDayKey(day time.Time) string {
+	return viewKeyPrefix + day.Format(time.DateOnly) + ":"
+}
+
+// Record counts a view of an event on day by visitor
+func (c *ViewCounter) Record(ctx context.Context, day time.Time, eventID uuid.UUID, visitor string) error {
+	prefix := viewDayKey(day)
+	eventsKey, countKey, visitorsKey := prefix+"events", prefix+eventID.String()+":count", prefix+eventID.String()+":visitors"
+
+	pipe := c.client.TxPipeline()
+	pipe.SAdd(ctx, eventsKey, eventID.String())
+	pipe.Incr(ctx, countKey)
+	pipe.PFAdd(ctx, visitorsKey, visitor)
+	pipe.Expire(ctx, eventsKey, viewKeyTTL)
+	pipe.Expire(ctx, countKey, viewKeyTTL)
+	pipe.Expire(ctx, visitorsKey, viewKeyTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Counts returns the views of every event viewed on day
+func (c *ViewCounter) Counts(ctx context.Context, day time.Time) ([]*analytics.ViewCount, error) {
+	prefix := viewDayKey(day)
+	members, err := c.client.SMembers(ctx, prefix+"events").Result()
+	if err != nil || len(members) == 0 {
+		return nil, err
+	}
+
+	pipe := c.client.Pipeline()
+	views := make([]*redis.StringCmd, len(members))
+	visitors := make([]*redis.IntCmd, len(members))
+	for i, member := range members {
+		views[i] = pipe.Get(ctx, prefix+member+":count")
+		visitors[i] = pipe.PFCount(ctx, prefix+member+":visitors")
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	counts := make([]*analytics.ViewCount, 0, len(members))
+	for i, member := range members {
+		eventID, err := uuid.Parse(member)
+		if err != nil {
+			continue
+		}
+		n, _ := strconv.Atoi(views[i].Val())
+		counts = append(counts, &analytics.ViewCount{
+			EventID:        eventID,
+			Views:          n,
+			UniqueVisitors: int(visitors[i].Val()),
+		})
+	}
+	return counts, nil
+}
+
+// Clear forgets the counts of day
+func (c *ViewCounter) Clear(ctx context.Context, day time.Time) error {
+	prefix := viewDayKey(day)
+	members, err := c.client.SMembers(ctx, prefix+"events").Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, 2*len(members)+1)
+	for _, member := range members {
+		keys = append(keys, prefix+member+":count", prefix+member+":visitors")
+	}
+	keys = append(keys, prefix+"events")
+	return c.client.Del(ctx, keys...).Err()
+}
@@ -0,0 +1,42 @@
+// Package cdn purges API responses cached at the edge once the data behind them changes
+package cdn
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"enterprise-crud/internal/config"
+)
+
+// ErrRejected is returned when the CDN refuses a purge for a reason retrying doesn't fix
+var ErrRejected = errors.New("cdn: purge rejected")
+
+// Purger removes URLs from a CDN's cache
+// Errors other than ErrRejected are transient and worth retrying.
+type Purger interface {
+	Purge(ctx context.Context, urls []string) error
+}
+
+// NewPurger creates the purger of the configured provider
+// Without a provider purges are only logged, which suits local development.
+func NewPurger(cfg *config.CDNConfig) Purger {
+	switch strings.ToLower(cfg.Provider) {
+	case "cloudflare":
+		return NewCloudflarePurger(cfg)
+	case "fastly":
+		return NewFastlyPurger(cfg)
+	default:
+		log.Println("CDN purging disabled: no provider configured, purges will be logged")
+		return logPurger{}
+	}
+}
+
+// logPurger logs purges instead of sending them
+type logPurger struct{}
+
+func (logPurger) Purge(ctx context.Context, urls []string) error {
+	log.Printf("CDN purge not sent (provider not configured): %s", strings.Join(urls, ", "))
+	return nil
+}
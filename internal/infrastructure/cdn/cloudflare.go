@@ -0,0 +1,78 @@
+package cdn
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"enterprise-crud/internal/config"
+)
+
+const cloudflareEndpoint = "https://api.cloudflare.com"
+
+// CloudflarePurger purges URLs with the Cloudflare cache purge API
+type CloudflarePurger struct {
+	client   *http.Client
+	endpoint string
+	zoneID   string
+	apiToken string
+}
+
+// NewCloudflarePurger creates a purger for the configured Cloudflare zone
+func NewCloudflarePurger(cfg *config.CDNConfig) *CloudflarePurger {
+	return &CloudflarePurger{
+		client:   &http.Client{Timeout: cfg.Timeout},
+		endpoint: cloudflareEndpoint,
+		zoneID:   cfg.CloudflareZoneID,
+		apiToken: cfg.CloudflareAPIToken,
+	}
+}
+
+// Purge removes urls from the zone's cache in a single request
+func (p *CloudflarePurger) Purge(ctx context.Context, urls []string) error {
+	body, err := json.Marshal(map[string][]string{"files": urls})
+	if err != nil {
+		return err
+	}
+	purgeURL := fmt.Sprintf("%s/client/v4/zones/%s/purge_cache", p.endpoint, url.PathEscape(p.zoneID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, purgeURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("cloudflare request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Success bool `json:"success"`
+		Errors  []struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&result)
+	if resp.StatusCode == http.StatusOK && result.Success {
+		return nil
+	}
+
+	messages := make([]string, len(result.Errors))
+	for i, e := range result.Errors {
+		messages[i] = fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+	}
+	// 401 and 403 mean broken credentials, which an operator can fix while purges are retried
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 ||
+		resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("cloudflare unavailable: %s %s", resp.Status, strings.Join(messages, "; "))
+	}
+	return fmt.Errorf("%w: cloudflare %s %s", ErrRejected, resp.Status, strings.Join(messages, "; "))
+}
@@ -0,0 +1,86 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudflarePurger_Purge(t *testing.T) {
+	var status atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/client/v4/zones/zone123/purge_cache", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var body struct {
+			Files []string `json:"files"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"https://api.example.com/api/v1/events"}, body.Files)
+
+		w.WriteHeader(int(status.Load()))
+		if status.Load() == http.StatusOK {
+			_, _ = w.Write([]byte(`{"success":true,"errors":[]}`))
+		} else {
+			_, _ = w.Write([]byte(`{"success":false,"errors":[{"code":1012,"message":"Request must contain one of purge_everything, files, tags, hosts or prefixes"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	purger := NewCloudflarePurger(&config.CDNConfig{CloudflareZoneID: "zone123", CloudflareAPIToken: "secret", Timeout: time.Second})
+	purger.endpoint = server.URL
+	urls := []string{"https://api.example.com/api/v1/events"}
+
+	t.Run("purges the URLs", func(t *testing.T) {
+		status.Store(http.StatusOK)
+
+		assert.NoError(t, purger.Purge(context.Background(), urls))
+	})
+
+	t.Run("bad request is rejected", func(t *testing.T) {
+		status.Store(http.StatusBadRequest)
+
+		err := purger.Purge(context.Background(), urls)
+		assert.ErrorIs(t, err, ErrRejected)
+		assert.Contains(t, err.Error(), "1012")
+	})
+
+	t.Run("outages are transient", func(t *testing.T) {
+		status.Store(http.StatusServiceUnavailable)
+
+		err := purger.Purge(context.Background(), urls)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrRejected)
+	})
+}
+
+func TestFastlyPurger_Purge(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "secret", r.Header.Get("Fastly-Key"))
+		assert.Equal(t, "1", r.Header.Get("Fastly-Soft-Purge"))
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	purger := NewFastlyPurger(&config.CDNConfig{FastlyAPIToken: "secret", FastlySoftPurge: true, Timeout: time.Second})
+	purger.endpoint = server.URL
+
+	err := purger.Purge(context.Background(), []string{
+		"https://api.example.com/api/v1/events",
+		"https://api.example.com/api/v1/events/42",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/purge/api.example.com/api/v1/events", "/purge/api.example.com/api/v1/events/42"}, paths)
+}
@@ -0,0 +1,82 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"enterprise-crud/internal/config"
+)
+
+const fastlyEndpoint = "https://api.fastly.com"
+
+// FastlyPurger purges URLs with the Fastly purge API
+// Fastly purges one URL per request, so a batch is sent URL by URL.
+type FastlyPurger struct {
+	client   *http.Client
+	endpoint string
+	apiToken string
+	soft     bool
+}
+
+// NewFastlyPurger creates a purger authenticated with the configured Fastly API token
+func NewFastlyPurger(cfg *config.CDNConfig) *FastlyPurger {
+	return &FastlyPurger{
+		client:   &http.Client{Timeout: cfg.Timeout},
+		endpoint: fastlyEndpoint,
+		apiToken: cfg.FastlyAPIToken,
+		soft:     cfg.FastlySoftPurge,
+	}
+}
+
+// Purge removes each of urls from Fastly's cache
+// It stops at the first failure; URLs purged before it are simply purged again on retry.
+func (p *FastlyPurger) Purge(ctx context.Context, urls []string) error {
+	for _, u := range urls {
+		if err := p.purge(ctx, u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// purge removes a single URL, addressed by host and path without the scheme
+func (p *FastlyPurger) purge(ctx context.Context, rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("%w: invalid URL %q", ErrRejected, rawURL)
+	}
+	cached := parsed.Host + parsed.EscapedPath()
+	if parsed.RawQuery != "" {
+		cached += "?" + parsed.RawQuery
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/purge/"+cached, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Fastly-Key", p.apiToken)
+	req.Header.Set("Accept", "application/json")
+	if p.soft {
+		// Soft purges mark the URL stale, so it can still be served while being revalidated
+		req.Header.Set("Fastly-Soft-Purge", "1")
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("fastly request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	// 401 and 403 mean broken credentials, which an operator can fix while purges are retried
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 ||
+		resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("fastly unavailable: %s", resp.Status)
+	}
+	return fmt.Errorf("%w: fastly %s for %s", ErrRejected, resp.Status, strings.TrimPrefix(cached, parsed.Host))
+}
@@ -0,0 +1,158 @@
+package cdn
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/infrastructure/resilience"
+)
+
+// Queue collects paths to purge and sends them to the CDN in batches
+// Invalidations only enqueue, so a slow or failing CDN never delays the write that changed the data.
+// Batches are sent every BatchInterval, or as soon as BatchSize paths are waiting, and transient
+// failures are retried with backoff; a batch still failing after that is logged and dropped,
+// leaving its URLs to expire with their max-age.
+type Queue struct {
+	purger    Purger
+	baseURL   string
+	batchSize int
+	interval  time.Duration
+	exec      *resilience.Executor // Retries transient purge failures
+
+	mu      sync.Mutex
+	pending []string
+	queued  map[string]bool
+	full    chan struct{} // Signalled when a batch is ready before the interval is up
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewQueue creates a queue purging paths under the configured base URL through purger
+func NewQueue(purger Purger, cfg *config.CDNConfig) *Queue {
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	return &Queue{
+		purger:    purger,
+		baseURL:   strings.TrimSuffix(cfg.BaseURL, "/"),
+		batchSize: batchSize,
+		interval:  cfg.BatchInterval,
+		exec: resilience.NewExecutor(resilience.Settings{
+			Name:           "cdn",
+			MaxRetries:     cfg.MaxRetries,
+			RetryBaseDelay: cfg.RetryBaseDelay,
+			RetryMaxDelay:  cfg.RetryMaxDelay,
+			IsTransient: func(err error) bool {
+				return !errors.Is(err, ErrRejected) && !errors.Is(err, context.Canceled)
+			},
+		}),
+		queued: make(map[string]bool),
+		full:   make(chan struct{}, 1),
+	}
+}
+
+// Purge queues paths, e.g. "/api/v1/events/<id>", to be purged with the next batch
+// Paths already waiting are not queued twice.
+func (q *Queue) Purge(paths ...string) {
+	q.mu.Lock()
+	for _, path := range paths {
+		purgeURL := q.baseURL + path
+		if q.queued[purgeURL] {
+			continue
+		}
+		q.queued[purgeURL] = true
+		q.pending = append(q.pending, purgeURL)
+	}
+	ready := len(q.pending) >= q.batchSize
+	q.mu.Unlock()
+
+	if ready {
+		select {
+		case q.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Start begins sending batches in the background
+func (q *Queue) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	q.cancel = cancel
+
+	q.wg.Add(1)
+	go q.run(ctx)
+
+	log.Printf("CDN purge queue started, sending every %s", q.interval)
+}
+
+// Stop halts the queue after sending the paths still waiting
+func (q *Queue) Stop() {
+	if q.cancel == nil {
+		return
+	}
+	q.cancel()
+	q.wg.Wait()
+
+	// One last attempt without retries, so shutdown isn't held up by a failing CDN
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, batch := range q.takeBatches() {
+		if err := q.purger.Purge(ctx, batch); err != nil {
+			log.Printf("Warning: Failed to purge %d URLs from the CDN at shutdown: %v", len(batch), err)
+		}
+	}
+	log.Println("CDN purge queue stopped")
+}
+
+func (q *Queue) run(ctx context.Context) {
+	defer q.wg.Done()
+
+	ticker := time.NewTicker(q.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-q.full:
+		}
+		q.flush(ctx)
+	}
+}
+
+// flush sends every waiting path, batch by batch
+func (q *Queue) flush(ctx context.Context) {
+	for _, batch := range q.takeBatches() {
+		err := q.exec.Do(ctx, func(ctx context.Context) error {
+			return q.purger.Purge(ctx, batch)
+		})
+		if err != nil {
+			log.Printf("Warning: Failed to purge %d URLs from the CDN: %v", len(batch), err)
+		}
+	}
+}
+
+// takeBatches empties the queue into batches of at most batchSize URLs
+func (q *Queue) takeBatches() [][]string {
+	q.mu.Lock()
+	pending := q.pending
+	q.pending = nil
+	q.queued = make(map[string]bool)
+	q.mu.Unlock()
+
+	var batches [][]string
+	for len(pending) > 0 {
+		n := min(q.batchSize, len(pending))
+		batches = append(batches, pending[:n])
+		pending = pending[n:]
+	}
+	return batches
+}
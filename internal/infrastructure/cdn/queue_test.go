@@ -0,0 +1,106 @@
+package cdn
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingPurger records the batches it is sent, failing its first failures calls with err
+type recordingPurger struct {
+	mu       sync.Mutex
+	batches  [][]string
+	failures int
+	err      error
+}
+
+func (p *recordingPurger) Purge(ctx context.Context, urls []string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.batches = append(p.batches, append([]string(nil), urls...))
+	if p.failures > 0 {
+		p.failures--
+		return p.err
+	}
+	return nil
+}
+
+func (p *recordingPurger) sent() [][]string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.batches
+}
+
+func newTestQueue(purger Purger, batchSize int) *Queue {
+	return NewQueue(purger, &config.CDNConfig{
+		BaseURL:        "https://api.example.com/",
+		BatchSize:      batchSize,
+		BatchInterval:  time.Hour,
+		MaxRetries:     2,
+		RetryBaseDelay: time.Millisecond,
+	})
+}
+
+func TestQueue_BatchesAndDeduplicates(t *testing.T) {
+	purger := &recordingPurger{}
+	q := newTestQueue(purger, 2)
+
+	q.Purge("/api/v1/events/1", "/api/v1/events")
+	q.Purge("/api/v1/events", "/api/v1/events/2")
+	q.flush(context.Background())
+
+	assert.Equal(t, [][]string{
+		{"https://api.example.com/api/v1/events/1", "https://api.example.com/api/v1/events"},
+		{"https://api.example.com/api/v1/events/2"},
+	}, purger.sent())
+}
+
+func TestQueue_SendsFullBatchBeforeInterval(t *testing.T) {
+	purger := &recordingPurger{}
+	q := newTestQueue(purger, 2)
+	q.Start()
+	defer q.Stop()
+
+	q.Purge("/api/v1/events/1", "/api/v1/events")
+
+	assert.Eventually(t, func() bool { return len(purger.sent()) == 1 }, time.Second, time.Millisecond)
+}
+
+func TestQueue_RetriesTransientFailures(t *testing.T) {
+	t.Run("transient failures are retried", func(t *testing.T) {
+		purger := &recordingPurger{failures: 2, err: errors.New("cdn unavailable")}
+		q := newTestQueue(purger, 30)
+
+		q.Purge("/api/v1/events")
+		q.flush(context.Background())
+
+		assert.Len(t, purger.sent(), 3)
+	})
+
+	t.Run("rejected purges are not", func(t *testing.T) {
+		purger := &recordingPurger{failures: 3, err: ErrRejected}
+		q := newTestQueue(purger, 30)
+
+		q.Purge("/api/v1/events")
+		q.flush(context.Background())
+
+		assert.Len(t, purger.sent(), 1)
+	})
+}
+
+func TestQueue_StopSendsWaitingPaths(t *testing.T) {
+	purger := &recordingPurger{}
+	q := newTestQueue(purger, 30)
+	q.Start()
+
+	q.Purge("/api/v1/events")
+	q.Stop()
+
+	assert.Equal(t, [][]string{{"https://api.example.com/api/v1/events"}}, purger.sent())
+}
@@ -0,0 +1,93 @@
+// Package chaos injects latency and transient errors into calls to the database and Redis.
+// It is a development and test aid: wrapping repositories and the Redis client with an Injector
+// shows how retries, circuit breakers and error responses behave when a dependency is slow or
+// failing, without having to break one.
+package chaos
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"enterprise-crud/internal/config"
+)
+
+// ErrInjected is the error calls fail with when a fault is injected
+// It wraps driver.ErrBadConn, so resilience.IsTransient treats it as a dropped connection.
+var ErrInjected = fmt.Errorf("chaos: injected fault: %w", driver.ErrBadConn)
+
+// Settings configures an Injector
+type Settings struct {
+	Latency       time.Duration // Delay added to every call
+	LatencyJitter time.Duration // Up to this much random delay on top of Latency
+	ErrorPercent  float64       // Percentage of calls failing with ErrInjected, 0-100
+}
+
+// SettingsFromConfig builds injector settings from the application chaos config
+func SettingsFromConfig(cfg *config.ChaosConfig) Settings {
+	return Settings{
+		Latency:       cfg.Latency,
+		LatencyJitter: cfg.LatencyJitter,
+		ErrorPercent:  cfg.ErrorPercent,
+	}
+}
+
+// Injector delays and fails calls according to its settings
+type Injector struct {
+	settings Settings
+	random   func() float64 // Returns a number in [0, 1), replaced in tests
+}
+
+// NewInjector creates an injector
+func NewInjector(settings Settings) *Injector {
+	return &Injector{settings: settings, random: rand.Float64}
+}
+
+// Inject waits out the configured latency and then decides whether the call fails
+// It returns the context's error if the context ends while waiting, ErrInjected for
+// a failing call and nil for one that should go ahead.
+func (i *Injector) Inject(ctx context.Context) error {
+	if delay := i.delay(); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if i.settings.ErrorPercent > 0 && i.random()*100 < i.settings.ErrorPercent {
+		return ErrInjected
+	}
+	return nil
+}
+
+// Do runs fn unless a fault is injected first
+// Faults are injected before the call, so a failed write was never applied.
+func (i *Injector) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := i.Inject(ctx); err != nil {
+		return err
+	}
+	return fn(ctx)
+}
+
+// Call runs fn unless a fault is injected first and returns its result
+func Call[T any](ctx context.Context, i *Injector, fn func(ctx context.Context) (T, error)) (T, error) {
+	if err := i.Inject(ctx); err != nil {
+		var zero T
+		return zero, err
+	}
+	return fn(ctx)
+}
+
+// delay returns how long the next call is held up
+func (i *Injector) delay() time.Duration {
+	delay := i.settings.Latency
+	if i.settings.LatencyJitter > 0 {
+		delay += time.Duration(i.random() * float64(i.settings.LatencyJitter))
+	}
+	return delay
+}
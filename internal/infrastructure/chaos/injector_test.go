@@ -0,0 +1,97 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/fixtures"
+	"enterprise-crud/internal/infrastructure/memory"
+	"enterprise-crud/internal/infrastructure/resilience"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjector_ErrorPercent(t *testing.T) {
+	rolls := []float64{0.05, 0.25, 0.5, 0.99}
+	faults := NewInjector(Settings{ErrorPercent: 30})
+	faults.random = func() float64 {
+		roll := rolls[0]
+		rolls = rolls[1:]
+		return roll
+	}
+
+	var failed []bool
+	for range 4 {
+		err := faults.Inject(context.Background())
+		failed = append(failed, errors.Is(err, ErrInjected))
+	}
+
+	assert.Equal(t, []bool{true, true, false, false}, failed)
+}
+
+func TestInjector_NoFaultsByDefault(t *testing.T) {
+	faults := NewInjector(Settings{})
+	for range 100 {
+		require.NoError(t, faults.Inject(context.Background()))
+	}
+}
+
+func TestInjector_Latency(t *testing.T) {
+	faults := NewInjector(Settings{Latency: 20 * time.Millisecond, LatencyJitter: 10 * time.Millisecond})
+	faults.random = func() float64 { return 0.5 }
+
+	start := time.Now()
+	require.NoError(t, faults.Inject(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 25*time.Millisecond)
+}
+
+func TestInjector_LatencyEndsWithContext(t *testing.T) {
+	faults := NewInjector(Settings{Latency: time.Hour})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := faults.Inject(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestInjectedErrorsAreTransient(t *testing.T) {
+	assert.True(t, resilience.IsTransient(ErrInjected))
+}
+
+func TestEventRepository_RetriedByResilience(t *testing.T) {
+	// Every other call fails; one retry gets every read through
+	failing := false
+	faults := NewInjector(Settings{ErrorPercent: 50})
+	faults.random = func() float64 {
+		failing = !failing
+		if failing {
+			return 0.1
+		}
+		return 0.9
+	}
+	store := memory.NewStore(fixtures.Default(time.Now()))
+	flaky := NewEventRepository(memory.NewEventRepository(store), faults)
+
+	_, err := flaky.GetByID(context.Background(), fixtures.ConcertID)
+	require.ErrorIs(t, err, ErrInjected)
+
+	exec := resilience.NewExecutor(resilience.Settings{Name: "chaos-test", MaxRetries: 1, RetryBaseDelay: time.Millisecond})
+	repo := resilience.NewEventRepository(flaky, exec)
+	for range 3 {
+		e, err := repo.GetByID(context.Background(), fixtures.ConcertID)
+		require.NoError(t, err)
+		assert.Equal(t, fixtures.ConcertID, e.ID)
+	}
+
+	// Writes aren't retried, so the injected failure reaches the caller and nothing is written
+	err = repo.Update(context.Background(), &event.Event{ID: fixtures.ConcertID, Title: "Renamed"})
+	require.ErrorIs(t, err, ErrInjected)
+	stored, err := memory.NewEventRepository(store).GetByID(context.Background(), fixtures.ConcertID)
+	require.NoError(t, err)
+	assert.NotEqual(t, "Renamed", stored.Title)
+}
@@ -0,0 +1,44 @@
+package chaos
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisHook runs Redis commands through an Injector
+type redisHook struct {
+	faults *Injector
+}
+
+// NewRedisHook creates a go-redis hook injecting faults into commands and pipelines
+// Add it with client.AddHook; dialing is left alone so connections come up normally.
+func NewRedisHook(faults *Injector) redis.Hook {
+	return redisHook{faults: faults}
+}
+
+func (h redisHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h redisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := h.faults.Inject(ctx); err != nil {
+			cmd.SetErr(err)
+			return err
+		}
+		return next(ctx, cmd)
+	}
+}
+
+func (h redisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if err := h.faults.Inject(ctx); err != nil {
+			for _, cmd := range cmds {
+				cmd.SetErr(err)
+			}
+			return err
+		}
+		return next(ctx, cmds)
+	}
+}
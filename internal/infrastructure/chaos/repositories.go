@@ -0,0 +1,350 @@
+package chaos
+
+import (
+	"context"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/plan"
+	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/domain/venue"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Repository decorators below run every call through an Injector. Wrap the
+// database repositories with them before the resilience decorators, so the
+// injected faults reach retries and breakers as real ones would.
+
+// eventRepository decorates an event.Repository with injected faults
+type eventRepository struct {
+	base   event.Repository
+	faults *Injector
+}
+
+// NewEventRepository wraps an event repository with the given injector
+func NewEventRepository(base event.Repository, faults *Injector) event.Repository {
+	return &eventRepository{base: base, faults: faults}
+}
+
+func (r *eventRepository) Create(ctx context.Context, e *event.Event) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.Create(ctx, e) })
+}
+
+func (r *eventRepository) GetByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*event.Event, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *eventRepository) GetBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*event.Event, error) { return r.base.GetBySlug(ctx, slug) })
+}
+
+func (r *eventRepository) GetAll(ctx context.Context) ([]*event.Event, error) {
+	return Call(ctx, r.faults, r.base.GetAll)
+}
+
+func (r *eventRepository) GetByOrganizer(ctx context.Context, organizerID uuid.UUID) ([]*event.Event, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) ([]*event.Event, error) {
+		return r.base.GetByOrganizer(ctx, organizerID)
+	})
+}
+
+func (r *eventRepository) GetByVenue(ctx context.Context, venueID uuid.UUID) ([]*event.Event, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) ([]*event.Event, error) {
+		return r.base.GetByVenue(ctx, venueID)
+	})
+}
+
+func (r *eventRepository) Update(ctx context.Context, e *event.Event) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.Update(ctx, e) })
+}
+
+func (r *eventRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.Delete(ctx, id) })
+}
+
+func (r *eventRepository) RefreshOnSale(ctx context.Context, now time.Time) ([]*event.Event, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) ([]*event.Event, error) { return r.base.RefreshOnSale(ctx, now) })
+}
+
+func (r *eventRepository) AdjustInventory(ctx context.Context, adjustment *event.InventoryAdjustment) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.AdjustInventory(ctx, adjustment) })
+}
+
+func (r *eventRepository) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID) ([]*event.InventoryAdjustment, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) ([]*event.InventoryAdjustment, error) {
+		return r.base.ListInventoryAdjustments(ctx, eventID)
+	})
+}
+
+// venueRepository decorates a venue.Repository with injected faults
+type venueRepository struct {
+	base   venue.Repository
+	faults *Injector
+}
+
+// NewVenueRepository wraps a venue repository with the given injector
+func NewVenueRepository(base venue.Repository, faults *Injector) venue.Repository {
+	return &venueRepository{base: base, faults: faults}
+}
+
+func (r *venueRepository) Create(ctx context.Context, v *venue.Venue) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.Create(ctx, v) })
+}
+
+func (r *venueRepository) GetByID(ctx context.Context, id uuid.UUID) (*venue.Venue, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*venue.Venue, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *venueRepository) GetAll(ctx context.Context) ([]*venue.Venue, error) {
+	return Call(ctx, r.faults, r.base.GetAll)
+}
+
+func (r *venueRepository) Update(ctx context.Context, v *venue.Venue) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.Update(ctx, v) })
+}
+
+func (r *venueRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.Delete(ctx, id) })
+}
+
+// userRepository decorates a user.Repository with injected faults
+type userRepository struct {
+	base   user.Repository
+	faults *Injector
+}
+
+// NewUserRepository wraps a user repository with the given injector
+func NewUserRepository(base user.Repository, faults *Injector) user.Repository {
+	return &userRepository{base: base, faults: faults}
+}
+
+func (r *userRepository) Create(ctx context.Context, u *user.User) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.Create(ctx, u) })
+}
+
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*user.User, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*user.User, error) { return r.base.GetByEmail(ctx, email) })
+}
+
+func (r *userRepository) GetByUsername(ctx context.Context, username string) (*user.User, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*user.User, error) { return r.base.GetByUsername(ctx, username) })
+}
+
+func (r *userRepository) AddRole(ctx context.Context, userID uuid.UUID, ro *role.Role) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.AddRole(ctx, userID, ro) })
+}
+
+func (r *userRepository) RemoveRole(ctx context.Context, userID uuid.UUID, ro *role.Role) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.RemoveRole(ctx, userID, ro) })
+}
+
+func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*user.User, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *userRepository) GetStatus(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*user.User, error) { return r.base.GetStatus(ctx, id) })
+}
+
+func (r *userRepository) UpdateStatus(ctx context.Context, change *user.StatusChange) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.UpdateStatus(ctx, change) })
+}
+
+func (r *userRepository) ListStatusChanges(ctx context.Context, userID uuid.UUID) ([]*user.StatusChange, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) ([]*user.StatusChange, error) {
+		return r.base.ListStatusChanges(ctx, userID)
+	})
+}
+
+func (r *userRepository) SetDateOfBirth(ctx context.Context, id uuid.UUID, dateOfBirth *time.Time) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.SetDateOfBirth(ctx, id, dateOfBirth) })
+}
+
+func (r *userRepository) UpdatePassword(ctx context.Context, id uuid.UUID, hash, algorithm string) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.UpdatePassword(ctx, id, hash, algorithm) })
+}
+
+// roleRepository decorates a role.Repository with injected faults
+type roleRepository struct {
+	base   role.Repository
+	faults *Injector
+}
+
+// NewRoleRepository wraps a role repository with the given injector
+func NewRoleRepository(base role.Repository, faults *Injector) role.Repository {
+	return &roleRepository{base: base, faults: faults}
+}
+
+func (r *roleRepository) GetByName(ctx context.Context, name role.Name) (*role.Role, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*role.Role, error) { return r.base.GetByName(ctx, name) })
+}
+
+// orderRepository decorates an order.Repository with injected faults
+// Methods taking a transaction fail too, which exercises rolling back order creation. The
+// in-memory repositories can't roll back, so don't wrap them with this one.
+type orderRepository struct {
+	base   order.Repository
+	faults *Injector
+}
+
+// NewOrderRepository wraps an order repository with the given injector
+func NewOrderRepository(base order.Repository, faults *Injector) order.Repository {
+	return &orderRepository{base: base, faults: faults}
+}
+
+func (r *orderRepository) Create(ctx context.Context, o *order.Order) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.Create(ctx, o) })
+}
+
+func (r *orderRepository) GetByID(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*order.Order, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *orderRepository) GetStatus(ctx context.Context, id uuid.UUID) (*order.StatusSnapshot, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*order.StatusSnapshot, error) { return r.base.GetStatus(ctx, id) })
+}
+
+func (r *orderRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*order.Order, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) ([]*order.Order, error) { return r.base.GetByUserID(ctx, userID) })
+}
+
+func (r *orderRepository) Update(ctx context.Context, o *order.Order) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.Update(ctx, o) })
+}
+
+func (r *orderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.Delete(ctx, id) })
+}
+
+func (r *orderRepository) GetByEventID(ctx context.Context, eventID uuid.UUID) ([]*order.Order, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) ([]*order.Order, error) { return r.base.GetByEventID(ctx, eventID) })
+}
+
+func (r *orderRepository) GetAttendees(ctx context.Context, eventID uuid.UUID) ([]*order.Attendee, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) ([]*order.Attendee, error) { return r.base.GetAttendees(ctx, eventID) })
+}
+
+func (r *orderRepository) MarkCheckedIn(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (bool, error) { return r.base.MarkCheckedIn(ctx, id, at) })
+}
+
+func (r *orderRepository) ExpirePending(ctx context.Context, cutoff, now time.Time) (int64, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (int64, error) { return r.base.ExpirePending(ctx, cutoff, now) })
+}
+
+func (r *orderRepository) GetPurchaseHistory(ctx context.Context, userID, eventID uuid.UUID, since time.Time) (*order.PurchaseHistory, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*order.PurchaseHistory, error) {
+		return r.base.GetPurchaseHistory(ctx, userID, eventID, since)
+	})
+}
+
+func (r *orderRepository) ListByStatus(ctx context.Context, status order.Status, limit int) ([]*order.Order, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) ([]*order.Order, error) { return r.base.ListByStatus(ctx, status, limit) })
+}
+
+func (r *orderRepository) ResolveReview(ctx context.Context, id uuid.UUID, status order.Status) (bool, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (bool, error) { return r.base.ResolveReview(ctx, id, status) })
+}
+
+func (r *orderRepository) FindTicketDrift(ctx context.Context) ([]*order.TicketCount, error) {
+	return Call(ctx, r.faults, r.base.FindTicketDrift)
+}
+
+func (r *orderRepository) CorrectAvailableTickets(ctx context.Context, eventID uuid.UUID, observed int) (bool, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (bool, error) {
+		return r.base.CorrectAvailableTickets(ctx, eventID, observed)
+	})
+}
+
+func (r *orderRepository) ArchiveEvents(ctx context.Context, cutoff time.Time, limit int) (*order.Archived, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*order.Archived, error) { return r.base.ArchiveEvents(ctx, cutoff, limit) })
+}
+
+func (r *orderRepository) SetGiftToken(ctx context.Context, id uuid.UUID, tokenHash string) (bool, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (bool, error) { return r.base.SetGiftToken(ctx, id, tokenHash) })
+}
+
+func (r *orderRepository) GetByGiftToken(ctx context.Context, tokenHash string) (*order.Order, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*order.Order, error) { return r.base.GetByGiftToken(ctx, tokenHash) })
+}
+
+func (r *orderRepository) ClaimGift(ctx context.Context, id, purchaserID, recipientID uuid.UUID, at time.Time) (bool, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (bool, error) {
+		return r.base.ClaimGift(ctx, id, purchaserID, recipientID, at)
+	})
+}
+
+func (r *orderRepository) GetGroup(ctx context.Context, id uuid.UUID) (*order.Group, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*order.Group, error) { return r.base.GetGroup(ctx, id) })
+}
+
+func (r *orderRepository) GetByGroupID(ctx context.Context, groupID uuid.UUID) ([]*order.Order, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) ([]*order.Order, error) { return r.base.GetByGroupID(ctx, groupID) })
+}
+
+func (r *orderRepository) GetByShareToken(ctx context.Context, tokenHash string) (*order.Order, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*order.Order, error) { return r.base.GetByShareToken(ctx, tokenHash) })
+}
+
+func (r *orderRepository) TakeShare(ctx context.Context, id uuid.UUID, tokenHash string, userID uuid.UUID, now time.Time) (bool, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (bool, error) {
+		return r.base.TakeShare(ctx, id, tokenHash, userID, now)
+	})
+}
+
+func (r *orderRepository) CreateWithTx(ctx context.Context, tx *gorm.DB, o *order.Order) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.CreateWithTx(ctx, tx, o) })
+}
+
+func (r *orderRepository) CreateGroupWithTx(ctx context.Context, tx *gorm.DB, g *order.Group) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.CreateGroupWithTx(ctx, tx, g) })
+}
+
+func (r *orderRepository) LockEventWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID) (*order.EventInfo, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*order.EventInfo, error) {
+		return r.base.LockEventWithTx(ctx, tx, eventID)
+	})
+}
+
+func (r *orderRepository) ReserveTicketsWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID, quantity int) (*order.EventInfo, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*order.EventInfo, error) {
+		return r.base.ReserveTicketsWithTx(ctx, tx, eventID, quantity)
+	})
+}
+
+func (r *orderRepository) UpdateEventTicketsWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID, newAvailableTickets int) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error {
+		return r.base.UpdateEventTicketsWithTx(ctx, tx, eventID, newAvailableTickets)
+	})
+}
+
+// planRepository decorates a plan.Repository with injected faults
+type planRepository struct {
+	base   plan.Repository
+	faults *Injector
+}
+
+// NewPlanRepository wraps a plan repository with the given injector
+func NewPlanRepository(base plan.Repository, faults *Injector) plan.Repository {
+	return &planRepository{base: base, faults: faults}
+}
+
+func (r *planRepository) GetAll(ctx context.Context) ([]*plan.Plan, error) {
+	return Call(ctx, r.faults, r.base.GetAll)
+}
+
+func (r *planRepository) GetByName(ctx context.Context, name string) (*plan.Plan, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*plan.Plan, error) { return r.base.GetByName(ctx, name) })
+}
+
+func (r *planRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*plan.Plan, error) {
+	return Call(ctx, r.faults, func(ctx context.Context) (*plan.Plan, error) { return r.base.GetByUserID(ctx, userID) })
+}
+
+func (r *planRepository) AssignToUser(ctx context.Context, userID, planID uuid.UUID) error {
+	return r.faults.Do(ctx, func(ctx context.Context) error { return r.base.AssignToUser(ctx, userID, planID) })
+}
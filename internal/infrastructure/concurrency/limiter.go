@@ -0,0 +1,156 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"enterprise-crud/internal/infrastructure/metrics"
+
+	"github.com/google/uuid"
+)
+
+// Defaults for how slots are held and waited for
+const (
+	// DefaultLease frees the slots of a request whose instance died before releasing them
+	DefaultLease = time.Minute
+
+	// DefaultPollInterval is how often a waiting request checks for a free slot
+	DefaultPollInterval = 25 * time.Millisecond
+)
+
+// Semaphores a request takes a slot of, as labelled in metrics
+const (
+	LimitUser  = "user"
+	LimitTotal = "total"
+)
+
+// LimitError reports that a request found no free slot within the wait time
+type LimitError struct {
+	Limit string // The semaphore that had no free slot, LimitUser or LimitTotal
+}
+
+// Error describes the limit that was reached
+func (e *LimitError) Error() string {
+	return "concurrency limit reached (" + e.Limit + ")"
+}
+
+// IsLimitError reports whether err means the request found no free slot
+func IsLimitError(err error) bool {
+	var limitErr *LimitError
+	return errors.As(err, &limitErr)
+}
+
+// Limits are the slots of a route's semaphores; 0 leaves a semaphore unlimited
+type Limits struct {
+	PerUser int // Requests one user may have running at once
+	Total   int // Requests all users together may have running at once
+}
+
+// Limiter hands out slots of the per-user and total semaphores of routes
+type Limiter struct {
+	store  Store
+	limits Limits
+	wait   time.Duration // How long a request waits for a slot before it is rejected
+	lease  time.Duration
+	poll   time.Duration
+}
+
+// NewLimiter creates a limiter keeping its semaphores in store
+func NewLimiter(store Store, limits Limits, wait time.Duration) *Limiter {
+	return &Limiter{store: store, limits: limits, wait: wait, lease: DefaultLease, poll: DefaultPollInterval}
+}
+
+// Enabled reports whether any limit is set
+func (l *Limiter) Enabled() bool {
+	return l.limits.PerUser > 0 || l.limits.Total > 0
+}
+
+// semaphore is one semaphore a request takes a slot of
+type semaphore struct {
+	key   string
+	limit int
+	name  string // LimitUser or LimitTotal
+}
+
+// Acquire takes a slot of route's semaphores for a request of userID, waiting up to the wait time for them
+// The user's slot is taken first, so a user's surplus requests queue without holding total slots others
+// could use. It returns a function freeing the slots; anonymous requests (uuid.Nil) only take a total slot.
+// A *LimitError is returned when no slot frees up in time, store failures as they are.
+func (l *Limiter) Acquire(ctx context.Context, route string, userID uuid.UUID) (func(), error) {
+	var semaphores []semaphore
+	if l.limits.PerUser > 0 && userID != uuid.Nil {
+		semaphores = append(semaphores, semaphore{key: route + ":user:" + userID.String(), limit: l.limits.PerUser, name: LimitUser})
+	}
+	if l.limits.Total > 0 {
+		semaphores = append(semaphores, semaphore{key: route, limit: l.limits.Total, name: LimitTotal})
+	}
+
+	holder := uuid.NewString()
+	started := time.Now()
+	deadline := started.Add(l.wait)
+	queued := false
+	var held []semaphore
+
+	release := func() {
+		// Slots are freed even when the request was cancelled; otherwise they stay taken until their lease ends
+		ctx := context.WithoutCancel(ctx)
+		for _, s := range held {
+			_ = l.store.Release(ctx, s.key, holder)
+		}
+	}
+
+	for _, s := range semaphores {
+		for {
+			acquired, err := l.store.TryAcquire(ctx, s.key, holder, s.limit, l.lease)
+			if err != nil {
+				release()
+				l.dequeue(route, queued)
+				return nil, err
+			}
+			if acquired {
+				held = append(held, s)
+				break
+			}
+
+			if !queued {
+				queued = true
+				metrics.ConcurrencyQueued.WithLabelValues(route).Inc()
+			}
+			if !time.Now().Before(deadline) || !sleep(ctx, min(l.poll, time.Until(deadline))) {
+				release()
+				l.dequeue(route, queued)
+				metrics.ConcurrencyWaitDuration.WithLabelValues(route, "rejected").Observe(time.Since(started).Seconds())
+				metrics.ConcurrencyRejections.WithLabelValues(route, s.name).Inc()
+				return nil, &LimitError{Limit: s.name}
+			}
+		}
+	}
+
+	l.dequeue(route, queued)
+	metrics.ConcurrencyWaitDuration.WithLabelValues(route, "acquired").Observe(time.Since(started).Seconds())
+	metrics.ConcurrencyInFlight.WithLabelValues(route).Inc()
+	return func() {
+		release()
+		metrics.ConcurrencyInFlight.WithLabelValues(route).Dec()
+	}, nil
+}
+
+// dequeue takes a request that waited off the queue gauge
+func (l *Limiter) dequeue(route string, queued bool) {
+	if queued {
+		metrics.ConcurrencyQueued.WithLabelValues(route).Dec()
+	}
+}
+
+// sleep waits for d, reporting false when ctx ends first
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,91 @@
+package concurrency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore_TryAcquire(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	acquired, _ := store.TryAcquire(ctx, "k", "a", 2, time.Minute)
+	assert.True(t, acquired)
+	acquired, _ = store.TryAcquire(ctx, "k", "b", 2, time.Minute)
+	assert.True(t, acquired)
+	acquired, _ = store.TryAcquire(ctx, "k", "c", 2, time.Minute)
+	assert.False(t, acquired, "both slots are held")
+
+	assert.NoError(t, store.Release(ctx, "k", "a"))
+	acquired, _ = store.TryAcquire(ctx, "k", "c", 2, time.Minute)
+	assert.True(t, acquired, "released slots can be taken again")
+
+	now = now.Add(time.Minute)
+	acquired, _ = store.TryAcquire(ctx, "k", "d", 1, time.Minute)
+	assert.True(t, acquired, "slots are freed when their lease ends")
+}
+
+func TestLimiter_Acquire(t *testing.T) {
+	ctx := context.Background()
+	user, other := uuid.New(), uuid.New()
+
+	t.Run("waits for a slot to free up", func(t *testing.T) {
+		limiter := NewLimiter(NewMemoryStore(), Limits{PerUser: 1}, time.Second)
+		release, err := limiter.Acquire(ctx, "checkout", user)
+		require.NoError(t, err)
+
+		time.AfterFunc(30*time.Millisecond, release)
+		started := time.Now()
+		second, err := limiter.Acquire(ctx, "checkout", user)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(started), 30*time.Millisecond)
+		second()
+	})
+
+	t.Run("rejects when no slot frees up in time", func(t *testing.T) {
+		limiter := NewLimiter(NewMemoryStore(), Limits{PerUser: 1}, 10*time.Millisecond)
+		release, err := limiter.Acquire(ctx, "checkout", user)
+		require.NoError(t, err)
+		defer release()
+
+		_, err = limiter.Acquire(ctx, "checkout", user)
+		assert.Equal(t, &LimitError{Limit: LimitUser}, err)
+		assert.True(t, IsLimitError(err))
+
+		otherRelease, err := limiter.Acquire(ctx, "checkout", other)
+		assert.NoError(t, err, "other users have their own slots")
+		otherRelease()
+		anotherRoute, err := limiter.Acquire(ctx, "groups", user)
+		assert.NoError(t, err, "other routes have their own slots")
+		anotherRoute()
+	})
+
+	t.Run("total limit applies across users", func(t *testing.T) {
+		store := NewMemoryStore()
+		limiter := NewLimiter(store, Limits{PerUser: 2, Total: 1}, 10*time.Millisecond)
+		release, err := limiter.Acquire(ctx, "checkout", user)
+		require.NoError(t, err)
+
+		_, err = limiter.Acquire(ctx, "checkout", other)
+		assert.Equal(t, &LimitError{Limit: LimitTotal}, err)
+		assert.NotContains(t, store.slots, "checkout:user:"+other.String(), "a rejected request frees the user slot it took")
+
+		release()
+		assert.Empty(t, store.slots)
+	})
+
+	t.Run("anonymous requests only take a total slot", func(t *testing.T) {
+		store := NewMemoryStore()
+		release, err := NewLimiter(store, Limits{PerUser: 1, Total: 5}, 0).Acquire(ctx, "checkout", uuid.Nil)
+		require.NoError(t, err)
+		assert.Len(t, store.slots, 1)
+		release()
+	})
+}
@@ -0,0 +1,113 @@
+// Package concurrency limits how many requests of a route run at once, per user and in total
+// Each request takes a slot of counting semaphores before it runs and frees them when it is done.
+// Requests finding every slot taken wait in line for a short time and are turned away when none
+// frees up, so one user's burst cannot starve everyone else during an on-sale.
+package concurrency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/infrastructure/cache"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix namespaces semaphores in Redis; it must not start with "event", those keys are flushed on event cache invalidation
+const keyPrefix = "concurrency:"
+
+// Store tracks the slots held of each semaphore
+type Store interface {
+	// TryAcquire takes a slot of key for holder if fewer than limit are held, reporting whether it did
+	// Slots not released within lease are freed, so a crashed instance cannot hold them forever.
+	TryAcquire(ctx context.Context, key, holder string, limit int, lease time.Duration) (bool, error)
+
+	// Release frees holder's slot of key
+	Release(ctx context.Context, key, holder string) error
+}
+
+// acquireScript takes a slot of the sorted set KEYS[1], whose members are holders scored by when their lease ends
+// ARGV: current time in ms, limit, lease in ms, holder
+var acquireScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now)
+if redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[2]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], now + tonumber(ARGV[3]), ARGV[4])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return 1
+`)
+
+// RedisStore keeps semaphores in Redis, so the limits hold across all instances
+// Leases are timed by the clocks of the instances, which must roughly agree.
+type RedisStore struct {
+	client *redis.Client
+	now    func() time.Time
+}
+
+// NewRedisStore creates a semaphore store on the given Redis client
+func NewRedisStore(redisClient *cache.RedisClient) *RedisStore {
+	return &RedisStore{client: redisClient.GetClient(), now: time.Now}
+}
+
+// TryAcquire takes a slot of key unless limit slots are held
+func (s *RedisStore) TryAcquire(ctx context.Context, key, holder string, limit int, lease time.Duration) (bool, error) {
+	acquired, err := acquireScript.Run(ctx, s.client, []string{keyPrefix + key},
+		s.now().UnixMilli(), limit, lease.Milliseconds(), holder).Int()
+	return acquired == 1, err
+}
+
+// Release frees holder's slot of key
+func (s *RedisStore) Release(ctx context.Context, key, holder string) error {
+	return s.client.ZRem(ctx, keyPrefix+key, holder).Err()
+}
+
+// MemoryStore keeps semaphores in process memory, for single instances without Redis
+// With several instances each enforces the limits on its own share of the traffic.
+type MemoryStore struct {
+	mu    sync.Mutex
+	slots map[string]map[string]time.Time // Key to its holders and the ends of their leases
+	now   func() time.Time
+}
+
+// NewMemoryStore creates an empty in-memory semaphore store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{slots: make(map[string]map[string]time.Time), now: time.Now}
+}
+
+// TryAcquire takes a slot of key unless limit slots are held
+func (s *MemoryStore) TryAcquire(ctx context.Context, key, holder string, limit int, lease time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	holders := s.slots[key]
+	for h, expires := range holders {
+		if !now.Before(expires) {
+			delete(holders, h)
+		}
+	}
+	if len(holders) >= limit {
+		return false, nil
+	}
+	if holders == nil {
+		holders = make(map[string]time.Time)
+		s.slots[key] = holders
+	}
+	holders[holder] = now.Add(lease)
+	return true, nil
+}
+
+// Release frees holder's slot of key
+func (s *MemoryStore) Release(ctx context.Context, key, holder string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.slots[key], holder)
+	if len(s.slots[key]) == 0 {
+		delete(s.slots, key)
+	}
+	return nil
+}
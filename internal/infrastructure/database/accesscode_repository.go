@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"enterprise-crud/internal/domain/accesscode"
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// accessCodeRepository implements the accesscode.Repository interface
+type accessCodeRepository struct {
+	db *gorm.DB
+}
+
+// NewAccessCodeRepository creates a new access code repository instance
+func NewAccessCodeRepository(db *gorm.DB) accesscode.Repository {
+	return &accessCodeRepository{db: db}
+}
+
+// Create stores a new access code, reporting a duplicate when the event already has the code
+func (r *accessCodeRepository) Create(ctx context.Context, code *accesscode.AccessCode) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(code)
+	if result.Error != nil {
+		return accesscode.NewAccessCodeError(accesscode.ErrAccessCodeSaveFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return accesscode.ErrDuplicateCode
+	}
+	return nil
+}
+
+// GetByID retrieves an access code by its ID
+func (r *accessCodeRepository) GetByID(ctx context.Context, id uuid.UUID) (*accesscode.AccessCode, error) {
+	var code accesscode.AccessCode
+	if err := r.db.WithContext(ctx).First(&code, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, accesscode.ErrAccessCodeNotFound
+		}
+		return nil, accesscode.NewAccessCodeError(accesscode.ErrAccessCodeRetrievalFailed, err)
+	}
+	return &code, nil
+}
+
+// ListByEvent retrieves the access codes of an event, oldest first
+func (r *accessCodeRepository) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]*accesscode.AccessCode, error) {
+	var codes []*accesscode.AccessCode
+	err := r.db.WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("created_at, id").
+		Find(&codes).Error
+	if err != nil {
+		return nil, accesscode.NewAccessCodeError(accesscode.ErrAccessCodeRetrievalFailed, err)
+	}
+	return codes, nil
+}
+
+// Delete removes an access code; its redemptions are removed by the foreign key
+func (r *accessCodeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&accesscode.AccessCode{}, "id = ?", id)
+	if result.Error != nil {
+		return accesscode.NewAccessCodeError(accesscode.ErrAccessCodeSaveFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return accesscode.ErrAccessCodeNotFound
+	}
+	return nil
+}
+
+// ListRedemptions retrieves the redemptions of an access code with buyer emails, oldest first
+func (r *accessCodeRepository) ListRedemptions(ctx context.Context, codeID uuid.UUID) ([]*accesscode.Redemption, error) {
+	var redemptions []*accesscode.Redemption
+	err := r.db.WithContext(ctx).
+		Select("event_access_code_redemptions.*, users.email").
+		Joins("LEFT JOIN users ON users.id = event_access_code_redemptions.user_id").
+		Where("event_access_code_redemptions.access_code_id = ?", codeID).
+		Order("event_access_code_redemptions.created_at, event_access_code_redemptions.id").
+		Find(&redemptions).Error
+	if err != nil {
+		return nil, accesscode.NewAccessCodeError(accesscode.ErrAccessCodeRetrievalFailed, err)
+	}
+	return redemptions, nil
+}
+
+// RedeemWithTx uses up one use of an event's code and records the redemption within tx
+// The use is taken in a single conditional UPDATE, so concurrent orders can't overdraw a code.
+func (r *accessCodeRepository) RedeemWithTx(ctx context.Context, tx *gorm.DB, redemption order.AccessCodeRedemption) (bool, error) {
+	var code accesscode.AccessCode
+	result := tx.WithContext(ctx).Model(&code).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("event_id = ? AND code = ? AND (max_uses = 0 OR uses < max_uses)",
+			redemption.EventID, accesscode.NormalizeCode(redemption.Code)).
+		Updates(map[string]interface{}{"uses": gorm.Expr("uses + 1"), "updated_at": time.Now()})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	err := tx.WithContext(ctx).Create(&accesscode.Redemption{
+		ID:           uuid.New(),
+		AccessCodeID: code.ID,
+		EventID:      redemption.EventID,
+		OrderID:      redemption.OrderID,
+		UserID:       redemption.UserID,
+		Quantity:     redemption.Quantity,
+	}).Error
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,364 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"enterprise-crud/internal/domain/account"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// accountRepository implements the account.Repository interface
+type accountRepository struct {
+	db *gorm.DB
+}
+
+// NewAccountRepository creates a new account repository instance
+func NewAccountRepository(db *gorm.DB) account.Repository {
+	return &accountRepository{db: db}
+}
+
+// personalTables hold rows that only describe their user and are deleted with the account
+var personalTables = []string{
+	"notifications",
+	"notification_preferences",
+	"device_tokens",
+	"phone_verifications",
+	"report_schedules",
+	"event_staff",
+	"user_roles",
+	"user_consents",
+	"user_status_changes",
+	"event_access_code_redemptions",
+	"webhook_subscriptions",
+}
+
+// anonymousGiftRecipient replaces the email of a gift the deleted user bought, which names someone else
+// Unclaimed gifts stay gifts, so their claim links keep working.
+const anonymousGiftRecipient = "deleted@deleted.invalid"
+
+// CreateExport stores a new export request
+func (r *accountRepository) CreateExport(ctx context.Context, export *account.Export) error {
+	if err := r.db.WithContext(ctx).Create(export).Error; err != nil {
+		return account.NewAccountError(account.ErrAccountUpdateFailed, err)
+	}
+	return nil
+}
+
+// GetExport retrieves an export by its ID
+func (r *accountRepository) GetExport(ctx context.Context, id uuid.UUID) (*account.Export, error) {
+	var export account.Export
+	if err := r.db.WithContext(ctx).First(&export, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, account.ErrExportNotFound
+		}
+		return nil, account.NewAccountError(account.ErrAccountRetrievalFailed, err)
+	}
+	return &export, nil
+}
+
+// GetPendingExport retrieves the user's most recent pending export created after since
+func (r *accountRepository) GetPendingExport(ctx context.Context, userID uuid.UUID, since time.Time) (*account.Export, error) {
+	var exports []account.Export
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ? AND created_at > ?", userID, account.ExportPending, since).
+		Order("created_at DESC").
+		Limit(1).
+		Find(&exports).Error
+	if err != nil {
+		return nil, account.NewAccountError(account.ErrAccountRetrievalFailed, err)
+	}
+	if len(exports) == 0 {
+		return nil, nil
+	}
+	return &exports[0], nil
+}
+
+// CompleteExport sets the final status of an export
+func (r *accountRepository) CompleteExport(ctx context.Context, id uuid.UUID, status string, completedAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&account.Export{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"status": status, "completed_at": completedAt}).Error
+	if err != nil {
+		return account.NewAccountError(account.ErrAccountUpdateFailed, err)
+	}
+	return nil
+}
+
+// GetPersonalData collects everything stored about a user
+func (r *accountRepository) GetPersonalData(ctx context.Context, userID uuid.UUID) (*account.PersonalData, error) {
+	db := r.db.WithContext(ctx)
+	data := &account.PersonalData{}
+
+	result := db.Table("users").
+		Select("id, email, username, phone, phone_verified_at, date_of_birth, deletion_scheduled_at, created_at, updated_at").
+		Where("id = ? AND deleted_at IS NULL", userID).
+		Limit(1).
+		Scan(&data.Profile)
+	if result.Error != nil {
+		return nil, account.NewAccountError(account.ErrAccountRetrievalFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, account.ErrUserNotFound
+	}
+
+	queries := []*gorm.DB{
+		db.Table("user_roles").
+			Joins("JOIN roles ON roles.id = user_roles.role_id").
+			Where("user_roles.user_id = ?", userID).
+			Order("roles.name").
+			Pluck("roles.name", &data.Profile.Roles),
+		db.Raw(`SELECT o.id, o.event_id, e.title AS event_title, o.quantity, o.total_amount, o.status, o.notes, o.answers,
+				o.invoice_number, o.gift_recipient, o.gifted_by, o.gift_claimed_at, o.checked_in_at, o.created_at
+			FROM orders o JOIN events e ON e.id = o.event_id WHERE o.user_id = ?
+			UNION ALL
+			SELECT o.id, o.event_id, e.title, o.quantity, o.total_amount, o.status, o.notes, o.answers,
+				o.invoice_number, o.gift_recipient, o.gifted_by, o.gift_claimed_at, o.checked_in_at, o.created_at
+			FROM archived_orders o JOIN archived_events e ON e.id = o.event_id WHERE o.user_id = ?
+			ORDER BY created_at`, userID, userID).
+			Scan(&data.Orders),
+		db.Table("tickets").
+			Select("id, order_id, event_id, seat_info").
+			Where("user_id = ?", userID).
+			Scan(&data.Tickets),
+		db.Table("notifications").
+			Select("id, type, title, body, read_at, created_at").
+			Where("user_id = ?", userID).
+			Order("created_at").
+			Scan(&data.Notifications),
+		db.Table("notification_preferences").
+			Select("type, email, in_app, push").
+			Where("user_id = ?", userID).
+			Order("type").
+			Scan(&data.NotificationPreferences),
+		db.Table("device_tokens").
+			Select("platform, created_at").
+			Where("user_id = ?", userID).
+			Order("created_at").
+			Scan(&data.Devices),
+		db.Table("event_staff").
+			Select("event_id, role, status, accepted_at, created_at").
+			Where("user_id = ?", userID).
+			Order("created_at").
+			Scan(&data.StaffAssignments),
+		db.Raw(`SELECT id, title, event_date, status, created_at FROM events WHERE organizer_id = ?
+			UNION ALL
+			SELECT id, title, event_date, status, created_at FROM archived_events WHERE organizer_id = ?
+			ORDER BY event_date`, userID, userID).
+			Scan(&data.OrganizedEvents),
+		db.Table("user_consents").
+			Select("policy_versions.type, policy_versions.version, policy_versions.url, user_consents.accepted_at").
+			Joins("JOIN policy_versions ON policy_versions.id = user_consents.version_id").
+			Where("user_consents.user_id = ?", userID).
+			Order("user_consents.accepted_at").
+			Scan(&data.Consents),
+		db.Table("webhook_subscriptions").
+			Select("url, events, created_at").
+			Where("user_id = ?", userID).
+			Order("created_at").
+			Scan(&data.Webhooks),
+		db.Table("order_messages").
+			Select("order_id, sender_role, sender_id = ? AS sent, body, read_at, created_at", userID).
+			Where("sender_id = ? OR recipient_id = ?", userID, userID).
+			Order("created_at").
+			Scan(&data.Messages),
+		db.Table("refund_requests").
+			Select("id, order_id, event_id, kind, status, reason, amount, decision, decided_at, created_at").
+			Where("user_id = ?", userID).
+			Order("created_at").
+			Scan(&data.RefundRequests),
+		db.Table("refund_audit_log l").
+			Select("l.request_id, l.action, l.actor_id IS NOT NULL AND l.actor_id = ? AS acted, l.amount, l.note, l.created_at", userID).
+			Joins("JOIN refund_requests r ON r.id = l.request_id").
+			Where("r.user_id = ? OR l.actor_id = ?", userID, userID).
+			Order("l.created_at").
+			Scan(&data.RefundAuditLog),
+		db.Table("user_status_changes").
+			Select("status, reason, created_at").
+			Where("user_id = ?", userID).
+			Order("created_at").
+			Scan(&data.StatusChanges),
+		db.Table("event_transfers").
+			Select("id, event_id, from_organizer_id, to_organizer_id, status, responded_at, created_at").
+			Where("from_organizer_id = ? OR to_organizer_id = ? OR requested_by = ?", userID, userID, userID).
+			Order("created_at").
+			Scan(&data.EventTransfers),
+		db.Table("event_transfer_audit_log l").
+			Select("l.transfer_id, l.event_id, l.action, l.actor_id = ? AS acted, l.created_at", userID).
+			Joins("JOIN event_transfers t ON t.id = l.transfer_id").
+			Where("t.from_organizer_id = ? OR t.to_organizer_id = ? OR l.actor_id = ?", userID, userID, userID).
+			Order("l.created_at").
+			Scan(&data.TransferAuditLog),
+		db.Table("event_access_code_redemptions r").
+			Select("r.event_id, r.order_id, c.code, r.quantity, r.created_at").
+			Joins("JOIN event_access_codes c ON c.id = r.access_code_id").
+			Where("r.user_id = ?", userID).
+			Order("r.created_at").
+			Scan(&data.AccessCodeRedemptions),
+		db.Table("order_groups").
+			Select("id, event_id, hold_until, created_at").
+			Where("leader_id = ?", userID).
+			Order("created_at").
+			Scan(&data.OrderGroups),
+	}
+	for _, query := range queries {
+		if query.Error != nil {
+			return nil, account.NewAccountError(account.ErrAccountRetrievalFailed, query.Error)
+		}
+	}
+
+	var schedules []account.ReportScheduleRecord
+	err := db.Table("report_schedules").
+		Select("frequency, enabled, last_sent_at").
+		Where("user_id = ?", userID).
+		Scan(&schedules).Error
+	if err != nil {
+		return nil, account.NewAccountError(account.ErrAccountRetrievalFailed, err)
+	}
+	if len(schedules) > 0 {
+		data.ReportSchedule = &schedules[0]
+	}
+
+	return data, nil
+}
+
+// ScheduleDeletion schedules the user's account for deletion, keeping an existing schedule
+func (r *accountRepository) ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) (time.Time, error) {
+	var scheduled []time.Time
+	err := r.db.WithContext(ctx).Raw(
+		`UPDATE users SET deletion_scheduled_at = COALESCE(deletion_scheduled_at, ?), updated_at = NOW()
+		WHERE id = ? AND deleted_at IS NULL
+		RETURNING deletion_scheduled_at`,
+		at, userID).Scan(&scheduled).Error
+	if err != nil {
+		return time.Time{}, account.NewAccountError(account.ErrAccountUpdateFailed, err)
+	}
+	if len(scheduled) == 0 {
+		return time.Time{}, account.ErrUserNotFound
+	}
+	return scheduled[0], nil
+}
+
+// CancelDeletion clears a scheduled deletion that has not run yet
+func (r *accountRepository) CancelDeletion(ctx context.Context, userID uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).Exec(
+		`UPDATE users SET deletion_scheduled_at = NULL, updated_at = NOW()
+		WHERE id = ? AND deletion_scheduled_at IS NOT NULL AND deleted_at IS NULL`,
+		userID)
+	if result.Error != nil {
+		return false, account.NewAccountError(account.ErrAccountUpdateFailed, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetDueDeletions retrieves users whose scheduled deletion is at or before now, earliest first
+func (r *accountRepository) GetDueDeletions(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Table("users").
+		Where("deletion_scheduled_at <= ? AND deleted_at IS NULL", now).
+		Order("deletion_scheduled_at").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, account.NewAccountError(account.ErrAccountRetrievalFailed, err)
+	}
+	return ids, nil
+}
+
+// DeleteAccount anonymizes a user due for deletion and removes their personal records
+// Orders, tickets, refund requests and organized events, archived or not, are kept for accounting,
+// event history and invoice retention; they now point at the anonymous tombstone. The conditional
+// update claims the account, so concurrent schedulers and a last-moment
+// cancellation cannot both win.
+func (r *accountRepository) DeleteAccount(ctx context.Context, userID uuid.UUID, now time.Time) ([]string, bool, error) {
+	var storageKeys []string
+	deleted := false
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(
+			`UPDATE users SET email = 'deleted-' || id || '@deleted.invalid', username = 'deleted-' || id,
+				password = '!', phone = NULL, phone_verified_at = NULL, date_of_birth = NULL, deleted_at = ?, updated_at = ?
+			WHERE id = ? AND deletion_scheduled_at <= ? AND deleted_at IS NULL`,
+			now, now, userID, now)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		for _, table := range []string{"orders", "archived_orders"} {
+			err := tx.Table(table).
+				Where("user_id = ?", userID).
+				Updates(map[string]interface{}{
+					"notes":          nil,
+					"answers":        nil,
+					"country":        nil,
+					"gift_recipient": gorm.Expr("CASE WHEN gift_recipient = '' THEN '' ELSE ? END", anonymousGiftRecipient),
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		// Threads stay readable for the organizer, without what the user wrote
+		if err := tx.Table("order_messages").Where("sender_id = ?", userID).Update("body", "").Error; err != nil {
+			return err
+		}
+		// Refund decisions stay auditable, without the reasons the user gave
+		if err := tx.Table("refund_requests").Where("user_id = ?", userID).Update("reason", "").Error; err != nil {
+			return err
+		}
+		if err := tx.Table("refund_audit_log").Where("actor_id = ?", userID).Update("note", "").Error; err != nil {
+			return err
+		}
+
+		// Shares of the user's groups become plain orders before the groups go, which would cascade to them
+		groups := tx.Table("order_groups").Select("id").Where("leader_id = ?", userID)
+		err := tx.Table("orders").
+			Where("group_id IN (?)", groups).
+			Updates(map[string]interface{}{"group_id": nil, "share_token_hash": ""}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM order_groups WHERE leader_id = ?", userID).Error; err != nil {
+			return err
+		}
+
+		var exportIDs []uuid.UUID
+		if err := tx.Table("data_exports").Where("user_id = ?", userID).Pluck("id", &exportIDs).Error; err != nil {
+			return err
+		}
+		for _, id := range exportIDs {
+			storageKeys = append(storageKeys, account.ExportStorageKey(userID, id))
+		}
+		if err := tx.Exec("DELETE FROM data_exports WHERE user_id = ?", userID).Error; err != nil {
+			return err
+		}
+
+		var logoKeys []string
+		err = tx.Table("organizer_brandings").
+			Where("organizer_id = ? AND logo_key <> ''", userID).
+			Pluck("logo_key", &logoKeys).Error
+		if err != nil {
+			return err
+		}
+		storageKeys = append(storageKeys, logoKeys...)
+		if err := tx.Exec("DELETE FROM organizer_brandings WHERE organizer_id = ?", userID).Error; err != nil {
+			return err
+		}
+		for _, table := range personalTables {
+			if err := tx.Exec("DELETE FROM "+table+" WHERE user_id = ?", userID).Error; err != nil {
+				return err
+			}
+		}
+
+		deleted = true
+		return nil
+	})
+	if err != nil {
+		return nil, false, account.NewAccountError(account.ErrAccountUpdateFailed, err)
+	}
+	return storageKeys, deleted, nil
+}
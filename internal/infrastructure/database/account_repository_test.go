@@ -0,0 +1,408 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// recordingConnector opens connections that record every statement they run
+// Queries return the columns and rows of the first answer whose key the query contains, or nothing.
+type recordingConnector struct {
+	answers    map[string]recordingAnswer
+	statements []string
+}
+
+type recordingAnswer struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (c *recordingConnector) Connect(context.Context) (driver.Conn, error) {
+	return &recordingConn{c}, nil
+}
+func (c *recordingConnector) Driver() driver.Driver { return nil }
+
+type recordingConn struct {
+	connector *recordingConnector
+}
+
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *recordingConn) Close() error              { return nil }
+func (c *recordingConn) Begin() (driver.Tx, error) { return c, nil }
+func (c *recordingConn) Commit() error             { return nil }
+func (c *recordingConn) Rollback() error           { return nil }
+
+func (c *recordingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.connector.statements = append(c.connector.statements, query)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *recordingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.connector.statements = append(c.connector.statements, query)
+	for key, answer := range c.connector.answers {
+		if strings.Contains(query, key) {
+			return &recordingRows{answer: answer}, nil
+		}
+	}
+	return &recordingRows{}, nil
+}
+
+type recordingRows struct {
+	answer recordingAnswer
+	next   int
+}
+
+func (r *recordingRows) Columns() []string { return r.answer.columns }
+func (r *recordingRows) Close() error      { return nil }
+
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if r.next == len(r.answer.rows) {
+		return io.EOF
+	}
+	copy(dest, r.answer.rows[r.next])
+	r.next++
+	return nil
+}
+
+// newRecordingDB returns a database that records its statements and answers queries from answers
+func newRecordingDB(t *testing.T, answers map[string]recordingAnswer) (*gorm.DB, *recordingConnector) {
+	connector := &recordingConnector{answers: answers}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(connector)}), &gorm.Config{DisableAutomaticPing: true})
+	require.NoError(t, err)
+	return db, connector
+}
+
+func TestAccountRepository_GetPersonalData_ExportsDateOfBirth(t *testing.T) {
+	db := newDryRunDB(t)
+	var queries []string
+	require.NoError(t, db.Callback().Row().After("gorm:row").Register("test:capture", func(db *gorm.DB) {
+		queries = append(queries, db.Statement.SQL.String())
+	}))
+	repo := NewAccountRepository(db)
+
+	// Dry runs find no profile, so only the profile query runs
+	_, _ = repo.GetPersonalData(context.Background(), uuid.New())
+
+	require.NotEmpty(t, queries)
+	assert.Contains(t, queries[0], `SELECT id, email, username, phone, phone_verified_at, date_of_birth,`)
+}
+
+// personalDataQueries returns the queries GetPersonalData runs for an existing user
+func personalDataQueries(t *testing.T) []string {
+	userID := uuid.New()
+	db, connector := newRecordingDB(t, map[string]recordingAnswer{
+		`FROM "users"`: {columns: []string{"id", "email"}, rows: [][]driver.Value{{userID.String(), "user@example.com"}}},
+	})
+	repo := NewAccountRepository(db)
+
+	data, err := repo.GetPersonalData(context.Background(), userID)
+	require.NoError(t, err)
+	require.Equal(t, "user@example.com", data.Profile.Email)
+	return connector.statements
+}
+
+// assertQueried checks that one of the queries contains every fragment
+func assertQueried(t *testing.T, queries []string, fragments ...string) {
+	t.Helper()
+	for _, query := range queries {
+		found := true
+		for _, fragment := range fragments {
+			found = found && strings.Contains(query, fragment)
+		}
+		if found {
+			return
+		}
+	}
+	assert.Failf(t, "no query contains all fragments", "fragments: %q", fragments)
+}
+
+func TestAccountRepository_GetPersonalData_ExportsGifts(t *testing.T) {
+	queries := personalDataQueries(t)
+
+	assertQueried(t, queries, "FROM orders o", "o.gift_recipient, o.gifted_by, o.gift_claimed_at")
+	assertQueried(t, queries, "FROM archived_orders o", "o.gift_recipient, o.gifted_by, o.gift_claimed_at")
+}
+
+func TestAccountRepository_GetPersonalData_ExportsRefundRequests(t *testing.T) {
+	queries := personalDataQueries(t)
+
+	assertQueried(t, queries, `FROM "refund_requests"`, "reason", "WHERE user_id = $1")
+}
+
+func TestAccountRepository_GetPersonalData_ExportsRefundAuditLog(t *testing.T) {
+	queries := personalDataQueries(t)
+
+	// Entries of the user's own requests and those they acted on as organizer
+	assertQueried(t, queries, `FROM refund_audit_log l`, "l.note", "r.user_id = $2 OR l.actor_id = $3")
+}
+
+func TestAccountRepository_GetPersonalData_ExportsStatusChanges(t *testing.T) {
+	queries := personalDataQueries(t)
+
+	assertQueried(t, queries, `FROM "user_status_changes"`, "reason", "WHERE user_id = $1")
+}
+
+func TestAccountRepository_GetPersonalData_ExportsEventTransfers(t *testing.T) {
+	queries := personalDataQueries(t)
+
+	assertQueried(t, queries, `FROM "event_transfers"`, "from_organizer_id = $1 OR to_organizer_id = $2 OR requested_by = $3")
+}
+
+func TestAccountRepository_GetPersonalData_ExportsTransferAuditLog(t *testing.T) {
+	queries := personalDataQueries(t)
+
+	assertQueried(t, queries, `FROM event_transfer_audit_log l`, "t.from_organizer_id = $2 OR t.to_organizer_id = $3 OR l.actor_id = $4")
+}
+
+func TestAccountRepository_GetPersonalData_ExportsAccessCodeRedemptions(t *testing.T) {
+	queries := personalDataQueries(t)
+
+	assertQueried(t, queries, `FROM event_access_code_redemptions r`, "c.code", "WHERE r.user_id = $1")
+}
+
+func TestAccountRepository_GetPersonalData_ExportsOrderGroups(t *testing.T) {
+	queries := personalDataQueries(t)
+
+	assertQueried(t, queries, `FROM "order_groups"`, "WHERE leader_id = $1")
+}
+
+// deleteAccountStatements returns the statements DeleteAccount runs for an account it claims
+func deleteAccountStatements(t *testing.T) []string {
+	statements, _ := deleteAccount(t, uuid.New(), uuid.New(), "")
+	return statements
+}
+
+// deleteAccount deletes a due account with the given export and logo and returns the statements and storage keys
+func deleteAccount(t *testing.T, userID, exportID uuid.UUID, logoKey string) ([]string, []string) {
+	answers := map[string]recordingAnswer{
+		`FROM "data_exports"`: {columns: []string{"id"}, rows: [][]driver.Value{{exportID.String()}}},
+	}
+	if logoKey != "" {
+		answers[`FROM "organizer_brandings"`] = recordingAnswer{columns: []string{"logo_key"}, rows: [][]driver.Value{{logoKey}}}
+	}
+	db, connector := newRecordingDB(t, answers)
+	repo := NewAccountRepository(db)
+
+	storageKeys, deleted, err := repo.DeleteAccount(context.Background(), userID, time.Now())
+	require.NoError(t, err)
+	require.True(t, deleted)
+	return connector.statements, storageKeys
+}
+
+func TestAccountRepository_DeleteAccount_ErasesDateOfBirth(t *testing.T) {
+	statements := deleteAccountStatements(t)
+
+	require.NotEmpty(t, statements)
+	assert.Contains(t, statements[0], `phone = NULL, phone_verified_at = NULL, date_of_birth = NULL,`)
+}
+
+func TestAccountRepository_DeleteAccount_DeletesPersonalRecords(t *testing.T) {
+	statements := deleteAccountStatements(t)
+
+	// Users are kept as tombstones, so their foreign keys never cascade
+	for _, table := range []string{"notifications", "device_tokens", "user_consents", "webhook_subscriptions",
+		"user_status_changes", "event_access_code_redemptions"} {
+		assert.Contains(t, statements, "DELETE FROM "+table+" WHERE user_id = $1", table)
+	}
+}
+
+func TestAccountRepository_DeleteAccount_ClearsSentMessages(t *testing.T) {
+	statements := deleteAccountStatements(t)
+
+	assert.Contains(t, statements, `UPDATE "order_messages" SET "body"=$1 WHERE sender_id = $2`)
+}
+
+func TestAccountRepository_DeleteAccount_AnonymizesOrders(t *testing.T) {
+	statements := deleteAccountStatements(t)
+
+	for _, table := range []string{"orders", "archived_orders"} {
+		assertQueried(t, statements, `UPDATE "`+table+`" SET`, `"country"=`,
+			`"gift_recipient"=CASE WHEN gift_recipient = '' THEN '' ELSE $`)
+	}
+}
+
+func TestAccountRepository_DeleteAccount_ClearsRefundReasons(t *testing.T) {
+	statements := deleteAccountStatements(t)
+
+	assert.Contains(t, statements, `UPDATE "refund_requests" SET "reason"=$1 WHERE user_id = $2`)
+	assert.Contains(t, statements, `UPDATE "refund_audit_log" SET "note"=$1 WHERE actor_id = $2`)
+}
+
+func TestAccountRepository_DeleteAccount_DissolvesLedGroups(t *testing.T) {
+	statements := deleteAccountStatements(t)
+
+	detach := -1
+	remove := -1
+	for i, statement := range statements {
+		if strings.HasPrefix(statement, `UPDATE "orders" SET "group_id"=$1,"share_token_hash"=$2 WHERE group_id IN`) {
+			detach = i
+		}
+		if statement == "DELETE FROM order_groups WHERE leader_id = $1" {
+			remove = i
+		}
+	}
+	require.NotEqual(t, -1, detach, "shares are detached")
+	require.NotEqual(t, -1, remove, "groups are deleted")
+	assert.Less(t, detach, remove, "groups cascade to orders still in them")
+}
+
+func TestAccountRepository_DeleteAccount_ReturnsStoredFiles(t *testing.T) {
+	userID := uuid.New()
+	exportID := uuid.New()
+	logoKey := "branding/" + userID.String() + "/logo-20260601T120000.000000000Z"
+
+	statements, storageKeys := deleteAccount(t, userID, exportID, logoKey)
+
+	assert.Contains(t, statements, "DELETE FROM organizer_brandings WHERE organizer_id = $1")
+	assert.Equal(t, []string{"exports/" + userID.String() + "/" + exportID.String() + ".zip", logoKey}, storageKeys)
+}
+
+// keptUserTables have a user_id column but are not in personalTables, each with the reason it is kept
+var keptUserTables = map[string]string{
+	"orders":          "kept for accounting; DeleteAccount clears notes, answers, country and gift recipients",
+	"archived_orders": "kept for accounting, like orders",
+	"tickets":         "kept for event history; tickets hold no personal data",
+	"refund_requests": "kept for accounting; DeleteAccount clears the reasons",
+	"data_exports":    "deleted by DeleteAccount along with their archives in storage",
+}
+
+func TestAccountRepository_PersonalTablesCoverSchema(t *testing.T) {
+	covered := make(map[string]bool)
+	for _, table := range personalTables {
+		covered[table] = true
+	}
+	for table := range keptUserTables {
+		covered[table] = true
+	}
+
+	tables := userTables(t)
+	require.Contains(t, tables, "notifications", "migrations are parsed")
+	for _, table := range tables {
+		assert.True(t, covered[table], "%s has a user_id column: add it to personalTables or keptUserTables", table)
+	}
+}
+
+var (
+	sqlComment     = regexp.MustCompile(`--[^\n]*`)
+	createTable    = regexp.MustCompile(`(?is)^CREATE TABLE (?:IF NOT EXISTS )?(\w+)\s*(.*)$`)
+	likeTable      = regexp.MustCompile(`(?i)^\(\s*LIKE (\w+)`)
+	alterTable     = regexp.MustCompile(`(?is)^ALTER TABLE (?:IF EXISTS )?(?:ONLY )?(\w+)\s+(.*)$`)
+	addColumn      = regexp.MustCompile(`(?i)\bADD COLUMN (?:IF NOT EXISTS )?(\w+)`)
+	dropColumn     = regexp.MustCompile(`(?i)\bDROP COLUMN (?:IF EXISTS )?(\w+)`)
+	renameColumn   = regexp.MustCompile(`(?i)\bRENAME COLUMN (\w+) TO (\w+)`)
+	renameTable    = regexp.MustCompile(`(?i)^RENAME TO (\w+)`)
+	dropTable      = regexp.MustCompile(`(?is)^DROP TABLE (?:IF EXISTS )?([\w\s,]+?)(?:\s+CASCADE)?$`)
+	tableRestraint = regexp.MustCompile(`(?i)^(CONSTRAINT|PRIMARY|UNIQUE|CHECK|FOREIGN|EXCLUDE)\b`)
+)
+
+// userTables replays the migrations and returns the tables that end up with a user_id column
+func userTables(t *testing.T) []string {
+	files, err := filepath.Glob("../../../migrations/*.up.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	columns := make(map[string]map[string]bool)
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+
+		for _, statement := range strings.Split(sqlComment.ReplaceAllString(string(content), ""), ";") {
+			statement = strings.TrimSpace(statement)
+			if m := createTable.FindStringSubmatch(statement); m != nil {
+				table, body := m[1], m[2]
+				if strings.HasPrefix(strings.ToUpper(body), "PARTITION OF") {
+					continue
+				}
+				columns[table] = make(map[string]bool)
+				if like := likeTable.FindStringSubmatch(body); like != nil {
+					for column := range columns[like[1]] {
+						columns[table][column] = true
+					}
+					continue
+				}
+				for _, definition := range splitDefinitions(body) {
+					if fields := strings.Fields(definition); len(fields) > 0 && !tableRestraint.MatchString(definition) {
+						columns[table][strings.ToLower(fields[0])] = true
+					}
+				}
+			} else if m := alterTable.FindStringSubmatch(statement); m != nil {
+				table, actions := m[1], m[2]
+				if rename := renameTable.FindStringSubmatch(actions); rename != nil {
+					columns[rename[1]] = columns[table]
+					delete(columns, table)
+					continue
+				}
+				if columns[table] == nil {
+					columns[table] = make(map[string]bool)
+				}
+				for _, add := range addColumn.FindAllStringSubmatch(actions, -1) {
+					columns[table][add[1]] = true
+				}
+				for _, drop := range dropColumn.FindAllStringSubmatch(actions, -1) {
+					delete(columns[table], drop[1])
+				}
+				for _, rename := range renameColumn.FindAllStringSubmatch(actions, -1) {
+					delete(columns[table], rename[1])
+					columns[table][rename[2]] = true
+				}
+			} else if m := dropTable.FindStringSubmatch(statement); m != nil {
+				for _, table := range strings.Split(m[1], ",") {
+					delete(columns, strings.TrimSpace(table))
+				}
+			}
+		}
+	}
+
+	var tables []string
+	for table, tableColumns := range columns {
+		if tableColumns["user_id"] {
+			tables = append(tables, table)
+		}
+	}
+	return tables
+}
+
+// splitDefinitions splits the parenthesized body of a CREATE TABLE into its column and constraint definitions
+func splitDefinitions(body string) []string {
+	start := strings.Index(body, "(")
+	if start == -1 {
+		return nil
+	}
+	var definitions []string
+	depth := 0
+	from := start + 1
+	for i := start; i < len(body); i++ {
+		switch body[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return append(definitions, body[from:i])
+			}
+		case ',':
+			if depth == 1 {
+				definitions = append(definitions, body[from:i])
+				from = i + 1
+			}
+		}
+	}
+	return definitions
+}
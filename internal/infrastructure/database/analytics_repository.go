@@ -0,0 +1,171 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"enterprise-crud/internal/domain/analytics"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// analyticsRepository implements the analytics.Repository interface
+type analyticsRepository struct {
+	db *gorm.DB
+}
+
+// NewAnalyticsRepository creates a new analytics repository instance
+func NewAnalyticsRepository(db *gorm.DB) analytics.Repository {
+	return &analyticsRepository{db: db}
+}
+
+// eventDailySalesSQL sums one day's completed orders per event
+const eventDailySalesSQL = `
+SELECT o.event_id, e.organizer_id,
+       COUNT(o.id) AS orders,
+       COALESCE(SUM(o.quantity), 0) AS tickets_sold,
+       COALESCE(SUM(o.total_amount), 0) AS revenue
+FROM orders o
+JOIN events e ON e.id = o.event_id
+WHERE o.status = ? AND o.created_at >= ? AND o.created_at < ?
+GROUP BY o.event_id, e.organizer_id
+ORDER BY o.event_id`
+
+// ComputeDay aggregates the live tables for the UTC day starting at day
+// Event status has no history, so active events of past days leave out events cancelled since.
+func (r *analyticsRepository) ComputeDay(ctx context.Context, day time.Time) (*analytics.DailySnapshot, []*analytics.EventDailySales, error) {
+	end := day.AddDate(0, 0, 1)
+	db := r.db.WithContext(ctx)
+
+	var sales []*analytics.EventDailySales
+	if err := db.Raw(eventDailySalesSQL, order.StatusCompleted, day, end).Scan(&sales).Error; err != nil {
+		return nil, nil, analytics.NewAnalyticsError(analytics.ErrSnapshotFailed, err)
+	}
+
+	snapshot := &analytics.DailySnapshot{Day: day}
+	for _, s := range sales {
+		s.Day = day
+		snapshot.Orders += s.Orders
+		snapshot.TicketsSold += s.TicketsSold
+		snapshot.Revenue += s.Revenue
+	}
+
+	var activeEvents int64
+	err := db.Model(&event.Event{}).
+		Where("created_at < ? AND event_date >= ? AND status <> ?", end, end, event.StatusCancelled).
+		Count(&activeEvents).Error
+	if err != nil {
+		return nil, nil, analytics.NewAnalyticsError(analytics.ErrSnapshotFailed, err)
+	}
+	snapshot.ActiveEvents = int(activeEvents)
+
+	var newUsers int64
+	if err := db.Table("users").Where("created_at >= ? AND created_at < ?", day, end).Count(&newUsers).Error; err != nil {
+		return nil, nil, analytics.NewAnalyticsError(analytics.ErrSnapshotFailed, err)
+	}
+	snapshot.NewUsers = int(newUsers)
+
+	return snapshot, sales, nil
+}
+
+// SaveDay stores the snapshot of a day, replacing any earlier snapshot and event sales of that day
+func (r *analyticsRepository) SaveDay(ctx context.Context, snapshot *analytics.DailySnapshot, sales []*analytics.EventDailySales) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("day = ?", snapshot.Day).Delete(&analytics.EventDailySales{}).Error; err != nil {
+			return err
+		}
+		if len(sales) > 0 {
+			if err := tx.CreateInBatches(sales, 500).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Save(snapshot).Error
+	})
+	if err != nil {
+		return analytics.NewAnalyticsError(analytics.ErrSnapshotFailed, err)
+	}
+	return nil
+}
+
+// LatestDay returns the most recent day with a snapshot, or nil if none was taken yet
+func (r *analyticsRepository) LatestDay(ctx context.Context) (*time.Time, error) {
+	var latest *time.Time
+	if err := r.db.WithContext(ctx).Model(&analytics.DailySnapshot{}).Select("MAX(day)").Scan(&latest).Error; err != nil {
+		return nil, analytics.NewAnalyticsError(analytics.ErrRetrievalFailed, err)
+	}
+	return latest, nil
+}
+
+// GetSnapshots retrieves the snapshots of days in [from, to), oldest first
+func (r *analyticsRepository) GetSnapshots(ctx context.Context, from, to time.Time) ([]*analytics.DailySnapshot, error) {
+	var snapshots []*analytics.DailySnapshot
+	err := r.db.WithContext(ctx).
+		Where("day >= ? AND day < ?", from, to).
+		Order("day").
+		Find(&snapshots).Error
+	if err != nil {
+		return nil, analytics.NewAnalyticsError(analytics.ErrRetrievalFailed, err)
+	}
+	return snapshots, nil
+}
+
+// saveViewsSQL upserts an event's views of a day, taking its organizer from the event
+// Events deleted since the views were counted insert nothing.
+const saveViewsSQL = `
+INSERT INTO event_daily_views (day, event_id, organizer_id, views, unique_visitors)
+SELECT ?, id, organizer_id, ?, ? FROM events WHERE id = ?
+ON CONFLICT (day, event_id) DO UPDATE SET views = EXCLUDED.views, unique_visitors = EXCLUDED.unique_visitors`
+
+// SaveViews stores the page views of events on a day, replacing any earlier counts of those events
+func (r *analyticsRepository) SaveViews(ctx context.Context, day time.Time, views []*analytics.ViewCount) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, v := range views {
+			if err := tx.Exec(saveViewsSQL, day, v.Views, v.UniqueVisitors, v.EventID).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return analytics.NewAnalyticsError(analytics.ErrSnapshotFailed, err)
+	}
+	return nil
+}
+
+// organizerSalesSQL sums an organizer's event sales and views per day, keeping days with either
+const organizerSalesSQL = `
+SELECT COALESCE(s.day, v.day) AS day,
+       COALESCE(s.orders, 0) AS orders,
+       COALESCE(s.tickets_sold, 0) AS tickets_sold,
+       COALESCE(s.revenue, 0) AS revenue,
+       COALESCE(v.views, 0) AS views,
+       COALESCE(v.unique_visitors, 0) AS unique_visitors
+FROM (
+    SELECT day, SUM(orders) AS orders, SUM(tickets_sold) AS tickets_sold, SUM(revenue) AS revenue
+    FROM event_daily_sales
+    WHERE organizer_id = @organizer AND day >= @from AND day < @to
+    GROUP BY day
+) s
+FULL OUTER JOIN (
+    SELECT day, SUM(views) AS views, SUM(unique_visitors) AS unique_visitors
+    FROM event_daily_views
+    WHERE organizer_id = @organizer AND day >= @from AND day < @to
+    GROUP BY day
+) v ON v.day = s.day
+ORDER BY 1`
+
+// GetOrganizerSales sums an organizer's event sales and page views per day in [from, to), oldest first
+func (r *analyticsRepository) GetOrganizerSales(ctx context.Context, organizerID uuid.UUID, from, to time.Time) ([]*analytics.SalesDay, error) {
+	var days []*analytics.SalesDay
+	err := r.db.WithContext(ctx).Raw(organizerSalesSQL,
+		sql.Named("organizer", organizerID), sql.Named("from", from), sql.Named("to", to)).
+		Scan(&days).Error
+	if err != nil {
+		return nil, analytics.NewAnalyticsError(analytics.ErrRetrievalFailed, err)
+	}
+	return days, nil
+}
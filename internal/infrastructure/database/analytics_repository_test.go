@@ -0,0 +1,30 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestAnalyticsRepository_GetOrganizerSales_JoinsSalesAndViews(t *testing.T) {
+	db := newDryRunDB(t)
+	var queries []string
+	require.NoError(t, db.Callback().Row().After("gorm:row").Register("test:capture", func(db *gorm.DB) {
+		queries = append(queries, db.Statement.SQL.String())
+	}))
+	repo := NewAnalyticsRepository(db)
+
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	// Scanning raw rows fails in dry run mode once the statement is built
+	_, _ = repo.GetOrganizerSales(context.Background(), uuid.New(), from, from.AddDate(0, 0, 7))
+
+	require.Len(t, queries, 1)
+	assert.Contains(t, queries[0], "FROM event_daily_sales\n    WHERE organizer_id = $1 AND day >= $2 AND day < $3")
+	assert.Contains(t, queries[0], "FROM event_daily_views\n    WHERE organizer_id = $4 AND day >= $5 AND day < $6")
+	assert.Contains(t, queries[0], "FULL OUTER JOIN")
+}
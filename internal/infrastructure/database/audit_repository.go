@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"enterprise-crud/internal/domain/audit"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// auditRepository implements the audit.Repository interface
+type auditRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditRepository creates a new audit trail repository instance
+func NewAuditRepository(db *gorm.DB) audit.Repository {
+	return &auditRepository{db: db}
+}
+
+// auditExportCursor is a row of audit_export_cursors
+type auditExportCursor struct {
+	Sink      string    `gorm:"primaryKey"`
+	Source    string    `gorm:"primaryKey"`
+	CreatedAt time.Time `gorm:"autoCreateTime:false"` // Creation time of the entry, not of the row
+	EntryID   uuid.UUID `gorm:"type:uuid"`
+	UpdatedAt time.Time
+}
+
+// TableName tells GORM what table to use for this model
+func (auditExportCursor) TableName() string {
+	return "audit_export_cursors"
+}
+
+// auditEntryRow is a row of any audit trail; columns a source lacks stay zero
+type auditEntryRow struct {
+	ID               uuid.UUID
+	Action           string
+	ActorID          *uuid.UUID
+	SubjectID        uuid.UUID
+	EventID          *uuid.UUID
+	Amount           float64
+	Note             string
+	Delta            int
+	Reason           string
+	TotalTickets     int
+	AvailableTickets int
+	CreatedAt        time.Time
+}
+
+// auditListSQL selects entries after a cursor by source; each takes the cursor's created_at and
+// ID, the upper bound of created_at and a limit
+var auditListSQL = map[string]string{
+	audit.SourceRefund: `
+SELECT l.id, l.action, l.actor_id, l.request_id AS subject_id, r.event_id, l.amount, l.note, l.created_at
+FROM refund_audit_log l
+JOIN refund_requests r ON r.id = l.request_id
+WHERE (l.created_at, l.id) > (?, ?) AND l.created_at < ?
+ORDER BY l.created_at, l.id
+LIMIT ?`,
+	audit.SourceTransfer: `
+SELECT id, action, actor_id, transfer_id AS subject_id, event_id, created_at
+FROM event_transfer_audit_log
+WHERE (created_at, id) > (?, ?) AND created_at < ?
+ORDER BY created_at, id
+LIMIT ?`,
+	audit.SourceInventory: `
+SELECT id, 'ADJUSTED' AS action, actor_id, event_id AS subject_id, event_id, delta, reason,
+       total_tickets, available_tickets, created_at
+FROM event_inventory_adjustments
+WHERE (created_at, id) > (?, ?) AND created_at < ?
+ORDER BY created_at, id
+LIMIT ?`,
+}
+
+// List returns up to limit entries of source after the cursor, oldest first
+func (r *auditRepository) List(ctx context.Context, source string, after audit.Cursor, until time.Time, limit int) ([]*audit.Entry, error) {
+	query, ok := auditListSQL[source]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", audit.ErrUnknownSource, source)
+	}
+
+	var rows []auditEntryRow
+	if err := r.db.WithContext(ctx).Raw(query, after.CreatedAt, after.EntryID, until, limit).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list %s audit entries: %w", source, err)
+	}
+
+	entries := make([]*audit.Entry, len(rows))
+	for i, row := range rows {
+		entries[i] = &audit.Entry{
+			ID:        row.ID,
+			Source:    source,
+			Action:    row.Action,
+			ActorID:   row.ActorID,
+			SubjectID: row.SubjectID,
+			EventID:   row.EventID,
+			Details:   auditDetails(source, row),
+			CreatedAt: row.CreatedAt,
+		}
+	}
+	return entries, nil
+}
+
+// auditDetails picks the columns particular to source
+func auditDetails(source string, row auditEntryRow) map[string]any {
+	switch source {
+	case audit.SourceRefund:
+		return map[string]any{"amount": row.Amount, "note": row.Note}
+	case audit.SourceInventory:
+		return map[string]any{
+			"delta":             row.Delta,
+			"reason":            row.Reason,
+			"total_tickets":     row.TotalTickets,
+			"available_tickets": row.AvailableTickets,
+		}
+	default:
+		return nil
+	}
+}
+
+// GetCursor returns the last entry of source acknowledged by sink
+func (r *auditRepository) GetCursor(ctx context.Context, sink, source string) (audit.Cursor, error) {
+	var row auditExportCursor
+	err := r.db.WithContext(ctx).Where("sink = ? AND source = ?", sink, source).First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return audit.Cursor{}, nil
+	}
+	if err != nil {
+		return audit.Cursor{}, fmt.Errorf("failed to get %s audit export cursor: %w", source, err)
+	}
+	return audit.Cursor{CreatedAt: row.CreatedAt, EntryID: row.EntryID}, nil
+}
+
+// SaveCursor creates or moves the cursor of source for sink
+func (r *auditRepository) SaveCursor(ctx context.Context, sink, source string, cursor audit.Cursor) error {
+	row := auditExportCursor{
+		Sink:      sink,
+		Source:    source,
+		CreatedAt: cursor.CreatedAt,
+		EntryID:   cursor.EntryID,
+		UpdatedAt: time.Now(),
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "sink"}, {Name: "source"}},
+		DoUpdates: clause.AssignmentColumns([]string{"created_at", "entry_id", "updated_at"}),
+	}).Create(&row).Error
+	if err != nil {
+		return fmt.Errorf("failed to save %s audit export cursor: %w", source, err)
+	}
+	return nil
+}
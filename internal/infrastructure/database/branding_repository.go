@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"enterprise-crud/internal/domain/branding"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// brandingRepository implements the branding.Repository interface
+type brandingRepository struct {
+	db *gorm.DB
+}
+
+// NewBrandingRepository creates a new organizer branding repository instance
+func NewBrandingRepository(db *gorm.DB) branding.Repository {
+	return &brandingRepository{db: db}
+}
+
+// Get retrieves an organizer's branding, or nil if none was ever set
+func (r *brandingRepository) Get(ctx context.Context, organizerID uuid.UUID) (*branding.Branding, error) {
+	var b branding.Branding
+	if err := r.db.WithContext(ctx).First(&b, "organizer_id = ?", organizerID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, branding.NewBrandingError(branding.ErrBrandingRetrievalFailed, err)
+	}
+	return &b, nil
+}
+
+// GetMany retrieves the branding of several organizers, keyed by organizer ID
+func (r *brandingRepository) GetMany(ctx context.Context, organizerIDs []uuid.UUID) (map[uuid.UUID]*branding.Branding, error) {
+	var brandings []*branding.Branding
+	if err := r.db.WithContext(ctx).Where("organizer_id IN ?", organizerIDs).Find(&brandings).Error; err != nil {
+		return nil, branding.NewBrandingError(branding.ErrBrandingRetrievalFailed, err)
+	}
+	result := make(map[uuid.UUID]*branding.Branding, len(brandings))
+	for _, b := range brandings {
+		result[b.OrganizerID] = b
+	}
+	return result, nil
+}
+
+// Save creates or replaces an organizer's branding
+func (r *brandingRepository) Save(ctx context.Context, b *branding.Branding) error {
+	b.UpdatedAt = time.Now()
+	err := r.db.WithContext(ctx).Clauses(
+		clause.OnConflict{
+			Columns: []clause.Column{{Name: "organizer_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"primary_color", "accent_color", "logo_key", "logo_content_type", "logo_width", "logo_height", "updated_at",
+			}),
+		},
+		clause.Returning{},
+	).Create(b).Error
+	if err != nil {
+		return branding.NewBrandingError(branding.ErrBrandingSaveFailed, err)
+	}
+	return nil
+}
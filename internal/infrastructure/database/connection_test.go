@@ -0,0 +1,25 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/logger"
+)
+
+func TestParseLogLevel(t *testing.T) {
+	for input, want := range map[string]logger.LogLevel{
+		"silent": logger.Silent,
+		"error":  logger.Error,
+		"WARN":   logger.Warn,
+		"":       logger.Warn,
+		"info":   logger.Info,
+	} {
+		got, err := parseLogLevel(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, want, got, input)
+	}
+
+	_, err := parseLogLevel("debug")
+	assert.Error(t, err)
+}
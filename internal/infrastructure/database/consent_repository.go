@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"enterprise-crud/internal/domain/consent"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// consentRepository implements the consent.Repository interface
+type consentRepository struct {
+	db *gorm.DB
+}
+
+// NewConsentRepository creates a new consent repository instance
+func NewConsentRepository(db *gorm.DB) consent.Repository {
+	return &consentRepository{db: db}
+}
+
+// latestVersionsQuery selects the newest published version of each policy type
+const latestVersionsQuery = `SELECT DISTINCT ON (type) * FROM policy_versions ORDER BY type, published_at DESC`
+
+// CreateVersion stores a newly published policy version, reporting a duplicate label
+func (r *consentRepository) CreateVersion(ctx context.Context, version *consent.Version) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(version)
+	if result.Error != nil {
+		return consent.NewConsentError(consent.ErrConsentSaveFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return consent.ErrVersionExists
+	}
+	return nil
+}
+
+// GetVersions retrieves policy versions by their IDs
+func (r *consentRepository) GetVersions(ctx context.Context, ids []uuid.UUID) ([]*consent.Version, error) {
+	var versions []*consent.Version
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&versions).Error; err != nil {
+		return nil, consent.NewConsentError(consent.ErrConsentRetrievalFailed, err)
+	}
+	return versions, nil
+}
+
+// ListVersions retrieves every version of a policy type, newest first
+func (r *consentRepository) ListVersions(ctx context.Context, policyType string) ([]*consent.Version, error) {
+	var versions []*consent.Version
+	err := r.db.WithContext(ctx).
+		Where("type = ?", policyType).
+		Order("published_at DESC").
+		Find(&versions).Error
+	if err != nil {
+		return nil, consent.NewConsentError(consent.ErrConsentRetrievalFailed, err)
+	}
+	return versions, nil
+}
+
+// GetLatestVersions retrieves the newest published version of each policy type
+func (r *consentRepository) GetLatestVersions(ctx context.Context) ([]*consent.Version, error) {
+	var versions []*consent.Version
+	if err := r.db.WithContext(ctx).Raw(latestVersionsQuery).Scan(&versions).Error; err != nil {
+		return nil, consent.NewConsentError(consent.ErrConsentRetrievalFailed, err)
+	}
+	return versions, nil
+}
+
+// GetPendingVersions retrieves the latest versions the user has not accepted
+func (r *consentRepository) GetPendingVersions(ctx context.Context, userID uuid.UUID) ([]*consent.Version, error) {
+	var versions []*consent.Version
+	err := r.db.WithContext(ctx).Raw(
+		`SELECT latest.* FROM (`+latestVersionsQuery+`) latest
+		WHERE NOT EXISTS (SELECT 1 FROM user_consents WHERE user_id = ? AND version_id = latest.id)
+		ORDER BY latest.type`,
+		userID).Scan(&versions).Error
+	if err != nil {
+		return nil, consent.NewConsentError(consent.ErrConsentRetrievalFailed, err)
+	}
+	return versions, nil
+}
+
+// ListAcceptances retrieves the versions the user accepted, most recent first
+func (r *consentRepository) ListAcceptances(ctx context.Context, userID uuid.UUID) ([]*consent.Acceptance, error) {
+	var acceptances []*consent.Acceptance
+	err := r.db.WithContext(ctx).
+		Table("user_consents").
+		Select("policy_versions.*, user_consents.accepted_at").
+		Joins("JOIN policy_versions ON policy_versions.id = user_consents.version_id").
+		Where("user_consents.user_id = ?", userID).
+		Order("user_consents.accepted_at DESC").
+		Scan(&acceptances).Error
+	if err != nil {
+		return nil, consent.NewConsentError(consent.ErrConsentRetrievalFailed, err)
+	}
+	return acceptances, nil
+}
+
+// RecordConsents records that the user accepted the versions, keeping the first acceptance time
+func (r *consentRepository) RecordConsents(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID, acceptedAt time.Time) error {
+	if len(versionIDs) == 0 {
+		return nil
+	}
+	consents := make([]consent.Consent, len(versionIDs))
+	for i, id := range versionIDs {
+		consents[i] = consent.Consent{UserID: userID, VersionID: id, AcceptedAt: acceptedAt}
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&consents).Error; err != nil {
+		return consent.NewConsentError(consent.ErrConsentSaveFailed, err)
+	}
+	return nil
+}
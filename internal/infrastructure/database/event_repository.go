@@ -3,11 +3,33 @@ package database
 import (
 	"context"
 	"enterprise-crud/internal/domain/event"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// Unique constraints guarding event slugs, as named by PostgreSQL
+const (
+	eventsSlugKey  = "events_slug_key"
+	eventSlugsPkey = "event_slugs_pkey"
+)
+
+// claimSlugSQL records a slug for an event; no row is affected when the slug belongs to another event
+const claimSlugSQL = "INSERT INTO event_slugs (slug, event_id) VALUES (?, ?) " +
+	"ON CONFLICT (slug) DO UPDATE SET event_id = EXCLUDED.event_id WHERE event_slugs.event_id = EXCLUDED.event_id"
+
+// EventSlug records a slug an event has or once had, so links with an old slug still find the event
+type EventSlug struct {
+	Slug      string      `gorm:"primaryKey;size:100"`
+	EventID   uuid.UUID   `gorm:"type:uuid;not null;index"`
+	Event     event.Event `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+}
+
 // eventRepository implements the event.Repository interface
 type eventRepository struct {
 	db *gorm.DB
@@ -18,10 +40,16 @@ func NewEventRepository(db *gorm.DB) event.Repository {
 	return &eventRepository{db: db}
 }
 
-// Create creates a new event in the database
+// Create creates a new event in the database and records its slug
 func (r *eventRepository) Create(ctx context.Context, e *event.Event) error {
-	if err := r.db.WithContext(ctx).Create(e).Error; err != nil {
-		return event.NewEventError(event.ErrEventCreationFailed, err)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(e).Error; err != nil {
+			return err
+		}
+		return claimSlug(tx, e)
+	})
+	if err != nil {
+		return slugError(err, event.ErrEventCreationFailed)
 	}
 	return nil
 }
@@ -38,6 +66,18 @@ func (r *eventRepository) GetByID(ctx context.Context, id uuid.UUID) (*event.Eve
 	return &e, nil
 }
 
+// GetBySlug retrieves the event that has or once had slug
+func (r *eventRepository) GetBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	var e event.Event
+	if err := r.db.WithContext(ctx).Joins("JOIN event_slugs ON event_slugs.event_id = events.id").Where("event_slugs.slug = ?", slug).Take(&e).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, event.NewEventSlugNotFoundError(slug)
+		}
+		return nil, event.NewEventError(event.ErrEventRetrievalFailed, err)
+	}
+	return &e, nil
+}
+
 // GetAll retrieves all events
 func (r *eventRepository) GetAll(ctx context.Context) ([]*event.Event, error) {
 	var events []*event.Event
@@ -48,31 +88,67 @@ func (r *eventRepository) GetAll(ctx context.Context) ([]*event.Event, error) {
 }
 
 // GetByOrganizer retrieves events by organizer ID
+// Ordering by (event_date, id) reads idx_events_organizer_date in order instead of sorting
 func (r *eventRepository) GetByOrganizer(ctx context.Context, organizerID uuid.UUID) ([]*event.Event, error) {
 	var events []*event.Event
-	if err := r.db.WithContext(ctx).Where("organizer_id = ?", organizerID).Order("event_date ASC").Find(&events).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("organizer_id = ?", organizerID).Order("event_date ASC, id ASC").Find(&events).Error; err != nil {
 		return nil, event.NewEventError(event.ErrEventRetrievalFailed, err)
 	}
 	return events, nil
 }
 
 // GetByVenue retrieves events by venue ID
+// The id tie-breaker keeps events on the same date in a stable order
 func (r *eventRepository) GetByVenue(ctx context.Context, venueID uuid.UUID) ([]*event.Event, error) {
 	var events []*event.Event
-	if err := r.db.WithContext(ctx).Where("venue_id = ?", venueID).Order("event_date ASC").Find(&events).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("venue_id = ?", venueID).Order("event_date ASC, id ASC").Find(&events).Error; err != nil {
 		return nil, event.NewEventError(event.ErrEventRetrievalFailed, err)
 	}
 	return events, nil
 }
 
-// Update updates an existing event
+// Update updates an existing event and records its slug if it is new
 func (r *eventRepository) Update(ctx context.Context, e *event.Event) error {
-	if err := r.db.WithContext(ctx).Save(e).Error; err != nil {
-		return event.NewEventError(event.ErrEventUpdateFailed, err)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(e).Error; err != nil {
+			return err
+		}
+		return claimSlug(tx, e)
+	})
+	if err != nil {
+		return slugError(err, event.ErrEventUpdateFailed)
 	}
 	return nil
 }
 
+// claimSlug records the event's slug in event_slugs
+// A slug recorded for another event, even one it has since changed away from, is not taken over.
+func claimSlug(tx *gorm.DB, e *event.Event) error {
+	result := tx.Exec(claimSlugSQL, e.Slug, e.ID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return event.ErrSlugTaken
+	}
+	return nil
+}
+
+// slugError reports a slug already used by another event as ErrSlugTaken, and other failures as failed
+func slugError(err error, failed *event.EventError) error {
+	if errors.Is(err, event.ErrSlugTaken) {
+		return event.ErrSlugTaken
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		switch pgErr.ConstraintName {
+		case eventsSlugKey, eventSlugsPkey:
+			return event.ErrSlugTaken
+		}
+	}
+	return event.NewEventError(failed, err)
+}
+
 // Delete deletes an event by its ID
 func (r *eventRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if err := r.db.WithContext(ctx).Delete(&event.Event{}, id).Error; err != nil {
@@ -80,3 +156,68 @@ func (r *eventRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 	return nil
 }
+
+// RefreshOnSale flips on_sale for events whose sale window opened or closed since the last refresh
+// Only changed rows are written, and updated_at is left alone since the event itself didn't change.
+func (r *eventRepository) RefreshOnSale(ctx context.Context, now time.Time) ([]*event.Event, error) {
+	const onSale = "(sale_start IS NULL OR sale_start <= ?) AND (sale_end IS NULL OR sale_end > ?)"
+
+	var changed []*event.Event
+	err := r.db.WithContext(ctx).Model(&changed).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}, {Name: "venue_id"}, {Name: "organizer_id"}, {Name: "on_sale"}}}).
+		Where("on_sale <> ("+onSale+")", now, now).
+		UpdateColumn("on_sale", gorm.Expr(onSale, now, now)).Error
+	if err != nil {
+		return nil, event.NewEventError(event.ErrEventUpdateFailed, err)
+	}
+	return changed, nil
+}
+
+// AdjustInventory applies an adjustment to the event's ticket counts and records it in one transaction
+// The counts change in a single conditional UPDATE, so concurrent orders can't be oversold by it.
+func (r *eventRepository) AdjustInventory(ctx context.Context, adjustment *event.InventoryAdjustment) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var adjusted event.Event
+		result := tx.Model(&adjusted).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "total_tickets"}, {Name: "available_tickets"}}}).
+			Where("id = ? AND available_tickets + ? >= 0", adjustment.EventID, adjustment.Delta).
+			Updates(map[string]interface{}{
+				"total_tickets":     gorm.Expr("total_tickets + ?", adjustment.Delta),
+				"available_tickets": gorm.Expr("available_tickets + ?", adjustment.Delta),
+				"updated_at":        time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			var count int64
+			if err := tx.Model(&event.Event{}).Where("id = ?", adjustment.EventID).Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				return event.NewEventNotFoundError(adjustment.EventID)
+			}
+			return event.ErrInvalidTicketReduction
+		}
+
+		adjustment.TotalTickets = adjusted.TotalTickets
+		adjustment.AvailableTickets = adjusted.AvailableTickets
+		return tx.Create(adjustment).Error
+	})
+	if event.IsEventNotFoundError(err) || errors.Is(err, event.ErrInvalidTicketReduction) {
+		return err
+	}
+	if err != nil {
+		return event.NewEventError(event.ErrEventUpdateFailed, err)
+	}
+	return nil
+}
+
+// ListInventoryAdjustments retrieves the inventory adjustments of an event, newest first
+func (r *eventRepository) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID) ([]*event.InventoryAdjustment, error) {
+	var adjustments []*event.InventoryAdjustment
+	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("created_at DESC, id DESC").Find(&adjustments).Error; err != nil {
+		return nil, event.NewEventError(event.ErrEventRetrievalFailed, err)
+	}
+	return adjustments, nil
+}
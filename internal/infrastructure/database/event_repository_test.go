@@ -1,10 +1,15 @@
 package database
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"enterprise-crud/internal/domain/event"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -110,3 +115,61 @@ func TestNewEventRepository(t *testing.T) {
 	// Verify it implements the event.Repository interface
 	var _ event.Repository = repo
 }
+
+func TestEventRepository_ListQueriesUseIndexOrder(t *testing.T) {
+	db := newDryRunDB(t)
+	queries := capturedQueries(t, db)
+	repo := NewEventRepository(db)
+
+	_, err := repo.GetByOrganizer(context.Background(), uuid.New())
+	require.NoError(t, err)
+	_, err = repo.GetByVenue(context.Background(), uuid.New())
+	require.NoError(t, err)
+
+	require.Len(t, *queries, 2)
+	assert.Contains(t, (*queries)[0], "WHERE organizer_id = $1 ORDER BY event_date ASC, id ASC")
+	assert.Contains(t, (*queries)[1], "WHERE venue_id = $1 ORDER BY event_date ASC, id ASC")
+}
+
+func TestEventRepository_RefreshOnSale_OnlyWritesChangedFlags(t *testing.T) {
+	db := newDryRunDB(t)
+	var queries []string
+	require.NoError(t, db.Callback().Update().After("gorm:update").Register("test:capture", func(db *gorm.DB) {
+		queries = append(queries, db.Statement.SQL.String())
+	}))
+	// The dry run can't open the transaction GORM wraps writes in
+	repo := NewEventRepository(db.Session(&gorm.Session{SkipDefaultTransaction: true}))
+
+	_, err := repo.RefreshOnSale(context.Background(), time.Now())
+	require.NoError(t, err)
+
+	require.Len(t, queries, 1)
+	assert.Contains(t, queries[0], `UPDATE "events" SET "on_sale"=(sale_start IS NULL OR sale_start <= $1) AND (sale_end IS NULL OR sale_end > $2)`)
+	assert.Contains(t, queries[0], `WHERE on_sale <> ((sale_start IS NULL OR sale_start <= $3) AND (sale_end IS NULL OR sale_end > $4))`)
+	assert.Contains(t, queries[0], `RETURNING "id","venue_id","organizer_id","on_sale"`)
+	assert.NotContains(t, queries[0], "updated_at")
+}
+
+func TestSlugError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		expectTaken bool
+	}{
+		{name: "slug claimed by another event", err: event.ErrSlugTaken, expectTaken: true},
+		{name: "current slug of another event", err: &pgconn.PgError{Code: "23505", ConstraintName: eventsSlugKey}, expectTaken: true},
+		{name: "old slug of another event", err: &pgconn.PgError{Code: "23505", ConstraintName: eventSlugsPkey}, expectTaken: true},
+		{name: "other unique violation", err: &pgconn.PgError{Code: "23505", ConstraintName: "events_pkey"}},
+		{name: "other failure", err: errors.New("connection reset")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := slugError(tt.err, event.ErrEventCreationFailed)
+			assert.Equal(t, tt.expectTaken, event.IsSlugTakenError(err))
+			if !tt.expectTaken {
+				assert.Equal(t, "EVENT_CREATION_FAILED", event.GetEventErrorCode(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/infrastructure/metrics"
+)
+
+// Reasons reported by the health monitor when the database is not ready
+const (
+	HealthReasonUnreachable   = "unreachable"
+	HealthReasonPoolExhausted = "pool_exhausted"
+)
+
+// HealthMonitor periodically pings the database and tracks readiness
+// A failed ping marks the service as not ready and drops idle connections so the
+// pool reconnects from scratch (e.g. to a promoted primary) once the database is back.
+// The pool is considered exhausted when every connection is in use and callers
+// had to wait for one since the previous check.
+type HealthMonitor struct {
+	db           *sql.DB
+	interval     time.Duration
+	timeout      time.Duration
+	maxIdleConns int
+
+	mu            sync.RWMutex
+	ready         bool
+	reason        string
+	lastWaitCount int64
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewHealthMonitor creates a monitor for the given connection
+// maxIdleConns is restored after idle connections are dropped on failure
+func NewHealthMonitor(conn *Connection, interval, timeout time.Duration, maxIdleConns int) (*HealthMonitor, error) {
+	sqlDB, err := conn.DB.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database instance: %w", err)
+	}
+
+	return newHealthMonitor(sqlDB, interval, timeout, maxIdleConns), nil
+}
+
+func newHealthMonitor(db *sql.DB, interval, timeout time.Duration, maxIdleConns int) *HealthMonitor {
+	return &HealthMonitor{
+		db:           db,
+		interval:     interval,
+		timeout:      timeout,
+		maxIdleConns: maxIdleConns,
+		ready:        true, // The connection was verified at startup
+	}
+}
+
+// Start runs health checks in the background until Stop is called
+func (m *HealthMonitor) Start() {
+	m.stop = make(chan struct{})
+	m.done = make(chan struct{})
+
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-m.stop:
+				return
+			case <-ticker.C:
+				m.Check(context.Background())
+			}
+		}
+	}()
+}
+
+// Stop halts background health checks and waits for the current check to finish
+func (m *HealthMonitor) Stop() {
+	if m.stop == nil {
+		return
+	}
+	close(m.stop)
+	<-m.done
+}
+
+// Check performs a single health check and updates readiness
+func (m *HealthMonitor) Check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	if err := m.db.PingContext(ctx); err != nil {
+		m.setState(false, HealthReasonUnreachable, err)
+		m.dropIdleConnections()
+		return
+	}
+
+	stats := m.db.Stats()
+	m.mu.Lock()
+	waited := stats.WaitCount > m.lastWaitCount
+	m.lastWaitCount = stats.WaitCount
+	m.mu.Unlock()
+
+	if stats.MaxOpenConnections > 0 && stats.InUse >= stats.MaxOpenConnections && waited {
+		m.setState(false, HealthReasonPoolExhausted, fmt.Errorf("%d/%d connections in use", stats.InUse, stats.MaxOpenConnections))
+		return
+	}
+
+	m.setState(true, "", nil)
+}
+
+// Status reports whether the database is ready to serve traffic and, if not, why
+func (m *HealthMonitor) Status() (ready bool, reason string) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ready, m.reason
+}
+
+// Stats returns the current connection pool statistics
+func (m *HealthMonitor) Stats() sql.DBStats {
+	return m.db.Stats()
+}
+
+// setState updates readiness, logging transitions and recording metrics
+func (m *HealthMonitor) setState(ready bool, reason string, cause error) {
+	m.mu.Lock()
+	changed := m.ready != ready || m.reason != reason
+	m.ready = ready
+	m.reason = reason
+	m.mu.Unlock()
+
+	if ready {
+		metrics.DBUp.Set(1)
+	} else {
+		metrics.DBHealthCheckFailures.WithLabelValues(reason).Inc()
+		if reason == HealthReasonUnreachable {
+			metrics.DBUp.Set(0)
+		}
+	}
+
+	if !changed {
+		return
+	}
+	if ready {
+		log.Println("Database health check recovered, marking service ready")
+	} else {
+		log.Printf("Database health check failed (%s): %v, marking service not ready", reason, cause)
+	}
+}
+
+// dropIdleConnections closes idle connections so the pool reconnects on next use
+func (m *HealthMonitor) dropIdleConnections() {
+	m.db.SetMaxIdleConns(0)
+	m.db.SetMaxIdleConns(m.maxIdleConns)
+}
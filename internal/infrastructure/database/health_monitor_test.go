@@ -0,0 +1,92 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pingDriver is a minimal driver whose connections fail to ping while down is set
+type pingDriver struct {
+	down atomic.Bool
+}
+
+func (d *pingDriver) Open(name string) (driver.Conn, error) {
+	if d.down.Load() {
+		return nil, errors.New("connection refused")
+	}
+	return &pingConn{driver: d}, nil
+}
+
+type pingConn struct {
+	driver *pingDriver
+}
+
+func (c *pingConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *pingConn) Close() error { return nil }
+
+func (c *pingConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *pingConn) Ping(ctx context.Context) error {
+	if c.driver.down.Load() {
+		return driver.ErrBadConn
+	}
+	return nil
+}
+
+var testPingDriver = &pingDriver{}
+
+func init() {
+	sql.Register("health-monitor-test", testPingDriver)
+}
+
+func TestHealthMonitor_TracksReadiness(t *testing.T) {
+	db, err := sql.Open("health-monitor-test", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	monitor := newHealthMonitor(db, time.Minute, time.Second, 2)
+
+	monitor.Check(context.Background())
+	ready, reason := monitor.Status()
+	assert.True(t, ready)
+	assert.Empty(t, reason)
+
+	testPingDriver.down.Store(true)
+	monitor.Check(context.Background())
+	ready, reason = monitor.Status()
+	assert.False(t, ready)
+	assert.Equal(t, HealthReasonUnreachable, reason)
+	assert.Equal(t, 0, monitor.Stats().Idle, "idle connections should be dropped on failure")
+
+	testPingDriver.down.Store(false)
+	monitor.Check(context.Background())
+	ready, _ = monitor.Status()
+	assert.True(t, ready, "monitor should recover once the database is reachable")
+}
+
+func TestHealthMonitor_StartStop(t *testing.T) {
+	db, err := sql.Open("health-monitor-test", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	monitor := newHealthMonitor(db, time.Millisecond, time.Second, 2)
+	monitor.Start()
+	time.Sleep(5 * time.Millisecond)
+	monitor.Stop()
+
+	ready, _ := monitor.Status()
+	assert.True(t, ready)
+}
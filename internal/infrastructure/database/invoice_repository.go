@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"enterprise-crud/internal/domain/invoice"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// invoiceRepository implements the invoice.Repository interface
+type invoiceRepository struct {
+	db *gorm.DB
+}
+
+// NewInvoiceRepository creates a new invoice repository instance
+func NewInvoiceRepository(db *gorm.DB) invoice.Repository {
+	return &invoiceRepository{db: db}
+}
+
+// GetSource retrieves an order with its buyer, event and venue
+// Orders of archived events are read from the archive tables.
+func (r *invoiceRepository) GetSource(ctx context.Context, orderID uuid.UUID) (*invoice.Source, error) {
+	src, err := r.getSource(ctx, orderID, "orders", "events")
+	if err == nil && src == nil {
+		src, err = r.getSource(ctx, orderID, "archived_orders", "archived_events")
+	}
+	if err != nil {
+		return nil, invoice.NewInvoiceError(invoice.ErrInvoiceRetrievalFailed, err)
+	}
+	if src == nil {
+		return nil, invoice.NewOrderNotFoundError(orderID)
+	}
+	return src, nil
+}
+
+// getSource reads an invoice source from one pair of order and event tables, returning nil when the order isn't there
+func (r *invoiceRepository) getSource(ctx context.Context, orderID uuid.UUID, ordersTable, eventsTable string) (*invoice.Source, error) {
+	var src invoice.Source
+	result := r.db.WithContext(ctx).
+		Table(ordersTable+" AS orders").
+		Select(`orders.id AS order_id, orders.user_id, orders.status, orders.quantity, orders.total_amount,
+			orders.created_at AS ordered_at, orders.invoice_number, orders.invoice_issued_at,
+			users.username AS buyer_name, users.email AS buyer_email,
+			events.title AS event_title, events.event_date, events.organizer_id, venues.name AS venue_name`).
+		Joins("JOIN users ON users.id = orders.user_id").
+		Joins("JOIN "+eventsTable+" AS events ON events.id = orders.event_id").
+		Joins("LEFT JOIN venues ON venues.id = events.venue_id").
+		Where("orders.id = ?", orderID).
+		Limit(1).
+		Scan(&src)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &src, nil
+}
+
+// AssignNumber numbers an order from the invoice_number_seq sequence unless it already has a number
+// Numbers are only drawn for orders without one, so retries never skip numbers.
+// An order missing from orders may have been archived, so the archive is numbered as well.
+func (r *invoiceRepository) AssignNumber(ctx context.Context, orderID uuid.UUID, issuedAt time.Time) (bool, error) {
+	for _, table := range []string{"orders", "archived_orders"} {
+		result := r.db.WithContext(ctx).Exec(
+			`UPDATE `+table+` SET invoice_number = nextval('invoice_number_seq'), invoice_issued_at = ?
+			WHERE id = ? AND invoice_number IS NULL`,
+			issuedAt, orderID)
+		if result.Error != nil {
+			return false, invoice.NewInvoiceError(invoice.ErrInvoiceNumberFailed, result.Error)
+		}
+		if result.RowsAffected > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
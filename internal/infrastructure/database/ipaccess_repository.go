@@ -0,0 +1,54 @@
+package database
+
+import (
+	"context"
+
+	"enterprise-crud/internal/domain/ipaccess"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ipAccessRepository implements the ipaccess.Repository interface
+type ipAccessRepository struct {
+	db *gorm.DB
+}
+
+// NewIPAccessRepository creates a new admin IP rule repository instance
+func NewIPAccessRepository(db *gorm.DB) ipaccess.Repository {
+	return &ipAccessRepository{db: db}
+}
+
+// CreateRule stores a new rule, reporting a duplicate range
+func (r *ipAccessRepository) CreateRule(ctx context.Context, rule *ipaccess.Rule) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(rule)
+	if result.Error != nil {
+		return ipaccess.NewIPAccessError(ipaccess.ErrRuleSaveFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ipaccess.ErrRuleExists
+	}
+	return nil
+}
+
+// ListRules retrieves every stored rule, oldest first
+func (r *ipAccessRepository) ListRules(ctx context.Context) ([]*ipaccess.Rule, error) {
+	var rules []*ipaccess.Rule
+	if err := r.db.WithContext(ctx).Order("created_at").Find(&rules).Error; err != nil {
+		return nil, ipaccess.NewIPAccessError(ipaccess.ErrRuleRetrievalFailed, err)
+	}
+	return rules, nil
+}
+
+// DeleteRule removes a rule
+func (r *ipAccessRepository) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&ipaccess.Rule{}, "id = ?", id)
+	if result.Error != nil {
+		return ipaccess.NewIPAccessError(ipaccess.ErrRuleDeleteFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ipaccess.ErrRuleNotFound
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"enterprise-crud/internal/domain/job"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// jobRepository implements the job.Repository interface on a Postgres table
+// Workers claim jobs with FOR UPDATE SKIP LOCKED so several instances can share the queue.
+type jobRepository struct {
+	db *gorm.DB
+}
+
+// NewJobRepository creates a new job repository instance
+func NewJobRepository(db *gorm.DB) job.Repository {
+	return &jobRepository{db: db}
+}
+
+// claimNextSQL marks the oldest due pending job as running and returns it
+const claimNextSQL = `
+UPDATE jobs
+SET status = 'RUNNING', attempts = attempts + 1, locked_at = NOW(), updated_at = NOW()
+WHERE id = (
+    SELECT id FROM jobs
+    WHERE status = 'PENDING' AND run_at <= NOW()
+    ORDER BY run_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+// Create enqueues a new job
+func (r *jobRepository) Create(ctx context.Context, j *job.Job) error {
+	if err := r.db.WithContext(ctx).Create(j).Error; err != nil {
+		return job.NewJobError(job.ErrJobCreationFailed, err)
+	}
+	return nil
+}
+
+// GetByID retrieves a job by its ID
+func (r *jobRepository) GetByID(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	var j job.Job
+	if err := r.db.WithContext(ctx).First(&j, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, job.NewJobNotFoundError(id)
+		}
+		return nil, job.NewJobError(job.ErrJobRetrievalFailed, err)
+	}
+	return &j, nil
+}
+
+// GetByStatus retrieves the most recently updated jobs with the given status
+func (r *jobRepository) GetByStatus(ctx context.Context, status string, limit int) ([]*job.Job, error) {
+	var jobs []*job.Job
+	err := r.db.WithContext(ctx).
+		Where("status = ?", status).
+		Order("updated_at DESC").
+		Limit(limit).
+		Find(&jobs).Error
+	if err != nil {
+		return nil, job.NewJobError(job.ErrJobRetrievalFailed, err)
+	}
+	return jobs, nil
+}
+
+// ClaimNext atomically marks the next due pending job as running and returns it
+func (r *jobRepository) ClaimNext(ctx context.Context) (*job.Job, error) {
+	var jobs []*job.Job
+	if err := r.db.WithContext(ctx).Raw(claimNextSQL).Scan(&jobs).Error; err != nil {
+		return nil, job.NewJobError(job.ErrJobUpdateFailed, err)
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return jobs[0], nil
+}
+
+// MarkSucceeded records a successful run
+func (r *jobRepository) MarkSucceeded(ctx context.Context, id uuid.UUID) error {
+	return r.update(ctx, id, map[string]interface{}{
+		"status":     job.StatusSucceeded,
+		"locked_at":  nil,
+		"last_error": "",
+	})
+}
+
+// MarkRetry puts a job back in the queue to run again at runAt
+func (r *jobRepository) MarkRetry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error {
+	return r.update(ctx, id, map[string]interface{}{
+		"status":     job.StatusPending,
+		"run_at":     runAt,
+		"locked_at":  nil,
+		"last_error": lastError,
+	})
+}
+
+// MarkFailed dead-letters a job
+func (r *jobRepository) MarkFailed(ctx context.Context, id uuid.UUID, lastError string) error {
+	return r.update(ctx, id, map[string]interface{}{
+		"status":     job.StatusFailed,
+		"locked_at":  nil,
+		"last_error": lastError,
+	})
+}
+
+// Requeue moves a failed job back to pending with a fresh set of attempts
+func (r *jobRepository) Requeue(ctx context.Context, id uuid.UUID) error {
+	return r.update(ctx, id, map[string]interface{}{
+		"status":    job.StatusPending,
+		"attempts":  0,
+		"run_at":    time.Now(),
+		"locked_at": nil,
+	})
+}
+
+// ReleaseStale returns running jobs locked before cutoff to the queue
+func (r *jobRepository) ReleaseStale(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&job.Job{}).
+		Where("status = ? AND locked_at < ?", job.StatusRunning, cutoff).
+		Updates(map[string]interface{}{
+			"status":    job.StatusPending,
+			"locked_at": nil,
+		})
+	if result.Error != nil {
+		return 0, job.NewJobError(job.ErrJobUpdateFailed, result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *jobRepository) update(ctx context.Context, id uuid.UUID, fields map[string]interface{}) error {
+	result := r.db.WithContext(ctx).Model(&job.Job{}).Where("id = ?", id).Updates(fields)
+	if result.Error != nil {
+		return job.NewJobError(job.ErrJobUpdateFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return job.NewJobNotFoundError(id)
+	}
+	return nil
+}
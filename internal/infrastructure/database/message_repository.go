@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"enterprise-crud/internal/domain/messaging"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// messageRepository implements the messaging.Repository interface
+type messageRepository struct {
+	db *gorm.DB
+}
+
+// NewMessageRepository creates a new order message repository instance
+func NewMessageRepository(db *gorm.DB) messaging.Repository {
+	return &messageRepository{db: db}
+}
+
+// Create stores a new message
+func (r *messageRepository) Create(ctx context.Context, m *messaging.Message) error {
+	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+		return messaging.NewMessagingError(messaging.ErrMessageSaveFailed, err)
+	}
+	return nil
+}
+
+// ListByOrder retrieves the messages of an order's thread, oldest first
+func (r *messageRepository) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*messaging.Message, error) {
+	var messages []*messaging.Message
+	err := r.db.WithContext(ctx).
+		Where("order_id = ?", orderID).
+		Order("created_at, id").
+		Find(&messages).Error
+	if err != nil {
+		return nil, messaging.NewMessagingError(messaging.ErrMessageRetrievalFailed, err)
+	}
+	return messages, nil
+}
+
+// MarkRead marks the unread messages to a recipient in an order's thread as read
+func (r *messageRepository) MarkRead(ctx context.Context, orderID, recipientID uuid.UUID, at time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&messaging.Message{}).
+		Where("order_id = ? AND recipient_id = ? AND read_at IS NULL", orderID, recipientID).
+		Update("read_at", at)
+	if result.Error != nil {
+		return 0, messaging.NewMessagingError(messaging.ErrMessageSaveFailed, result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// CountUnread counts a user's unread messages per thread, most recent thread first
+func (r *messageRepository) CountUnread(ctx context.Context, recipientID uuid.UUID) ([]*messaging.ThreadUnread, error) {
+	var counts []*messaging.ThreadUnread
+	err := r.db.WithContext(ctx).Model(&messaging.Message{}).
+		Select("order_id, COUNT(*) AS unread").
+		Where("recipient_id = ? AND read_at IS NULL", recipientID).
+		Group("order_id").
+		Order("MAX(created_at) DESC").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, messaging.NewMessagingError(messaging.ErrMessageRetrievalFailed, err)
+	}
+	return counts, nil
+}
@@ -0,0 +1,275 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"enterprise-crud/internal/domain/notification"
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// notificationRepository implements the notification.Repository interface
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository creates a new notification repository instance
+func NewNotificationRepository(db *gorm.DB) notification.Repository {
+	return &notificationRepository{db: db}
+}
+
+// Create stores a new notification
+func (r *notificationRepository) Create(ctx context.Context, n *notification.Notification) error {
+	if err := r.db.WithContext(ctx).Create(n).Error; err != nil {
+		return notification.NewNotificationError(notification.ErrNotificationSaveFailed, err)
+	}
+	return nil
+}
+
+// ListByUser retrieves a page of a user's notifications, newest first
+func (r *notificationRepository) ListByUser(ctx context.Context, userID uuid.UUID, filter notification.ListFilter) ([]*notification.Notification, error) {
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if filter.UnreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var notifications []*notification.Notification
+	if err := query.Order("created_at DESC, id").Limit(filter.PageSize()).Offset(filter.Offset).Find(&notifications).Error; err != nil {
+		return nil, notification.NewNotificationError(notification.ErrRetrievalFailed, err)
+	}
+	return notifications, nil
+}
+
+// CountUnread counts a user's unread notifications
+func (r *notificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&notification.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, notification.NewNotificationError(notification.ErrRetrievalFailed, err)
+	}
+	return count, nil
+}
+
+// MarkRead marks one of a user's notifications as read, keeping the first read time
+func (r *notificationRepository) MarkRead(ctx context.Context, userID, id uuid.UUID, at time.Time) error {
+	result := r.db.WithContext(ctx).Model(&notification.Notification{}).
+		Where("id = ? AND user_id = ?", id, userID).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", at))
+	if result.Error != nil {
+		return notification.NewNotificationError(notification.ErrNotificationSaveFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return notification.ErrNotificationNotFound
+	}
+	return nil
+}
+
+// MarkAllRead marks all of a user's unread notifications as read
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&notification.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", at)
+	if result.Error != nil {
+		return 0, notification.NewNotificationError(notification.ErrNotificationSaveFailed, result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// GetPreferences retrieves the preferences a user has stored
+func (r *notificationRepository) GetPreferences(ctx context.Context, userID uuid.UUID) ([]*notification.Preference, error) {
+	var prefs []*notification.Preference
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&prefs).Error; err != nil {
+		return nil, notification.NewNotificationError(notification.ErrRetrievalFailed, err)
+	}
+	return prefs, nil
+}
+
+// SavePreferences creates or updates preferences
+func (r *notificationRepository) SavePreferences(ctx context.Context, prefs []*notification.Preference) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"email", "in_app", "push", "updated_at"}),
+	}).Create(&prefs).Error
+	if err != nil {
+		return notification.NewNotificationError(notification.ErrNotificationSaveFailed, err)
+	}
+	return nil
+}
+
+// SaveDevice registers a device token, moving it to device.UserID if it was registered before
+// The stored row is returned into device, so a re-registered token keeps its ID and creation time.
+func (r *notificationRepository) SaveDevice(ctx context.Context, device *notification.Device) error {
+	err := r.db.WithContext(ctx).Clauses(
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "token"}},
+			DoUpdates: clause.AssignmentColumns([]string{"user_id", "platform", "updated_at"}),
+		},
+		clause.Returning{},
+	).Create(device).Error
+	if err != nil {
+		return notification.NewNotificationError(notification.ErrNotificationSaveFailed, err)
+	}
+	return nil
+}
+
+// GetDevices retrieves the devices registered by a user
+func (r *notificationRepository) GetDevices(ctx context.Context, userID uuid.UUID) ([]*notification.Device, error) {
+	var devices []*notification.Device
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at").Find(&devices).Error; err != nil {
+		return nil, notification.NewNotificationError(notification.ErrRetrievalFailed, err)
+	}
+	return devices, nil
+}
+
+// DeleteDevice removes one of a user's devices
+func (r *notificationRepository) DeleteDevice(ctx context.Context, userID, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).Delete(&notification.Device{})
+	if result.Error != nil {
+		return notification.NewNotificationError(notification.ErrNotificationSaveFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return notification.ErrDeviceNotFound
+	}
+	return nil
+}
+
+// DeleteDeviceToken removes a device token regardless of its owner
+func (r *notificationRepository) DeleteDeviceToken(ctx context.Context, token string) error {
+	if err := r.db.WithContext(ctx).Where("token = ?", token).Delete(&notification.Device{}).Error; err != nil {
+		return notification.NewNotificationError(notification.ErrNotificationSaveFailed, err)
+	}
+	return nil
+}
+
+// SavePhoneVerification creates or replaces a user's pending phone verification
+func (r *notificationRepository) SavePhoneVerification(ctx context.Context, v *notification.PhoneVerification) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"phone", "code_hash", "attempts", "expires_at", "created_at"}),
+	}).Create(v).Error
+	if err != nil {
+		return notification.NewNotificationError(notification.ErrNotificationSaveFailed, err)
+	}
+	return nil
+}
+
+// GetPhoneVerification retrieves a user's pending phone verification
+func (r *notificationRepository) GetPhoneVerification(ctx context.Context, userID uuid.UUID) (*notification.PhoneVerification, error) {
+	var v notification.PhoneVerification
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&v).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, notification.ErrVerificationNotFound
+		}
+		return nil, notification.NewNotificationError(notification.ErrRetrievalFailed, err)
+	}
+	return &v, nil
+}
+
+// IncrementVerificationAttempts records a wrong code for a user's pending verification
+func (r *notificationRepository) IncrementVerificationAttempts(ctx context.Context, userID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Model(&notification.PhoneVerification{}).
+		Where("user_id = ?", userID).
+		Update("attempts", gorm.Expr("attempts + 1")).Error
+	if err != nil {
+		return notification.NewNotificationError(notification.ErrNotificationSaveFailed, err)
+	}
+	return nil
+}
+
+// DeletePhoneVerification removes a user's pending phone verification
+func (r *notificationRepository) DeletePhoneVerification(ctx context.Context, userID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&notification.PhoneVerification{}).Error; err != nil {
+		return notification.NewNotificationError(notification.ErrNotificationSaveFailed, err)
+	}
+	return nil
+}
+
+// GetPhone retrieves a user's verified phone number
+func (r *notificationRepository) GetPhone(ctx context.Context, userID uuid.UUID) (*notification.PhoneContact, error) {
+	var contact notification.PhoneContact
+	result := r.db.WithContext(ctx).
+		Table("users").
+		Select("id AS user_id, COALESCE(phone, '') AS phone, phone_verified_at AS verified_at").
+		Where("id = ?", userID).
+		Scan(&contact)
+	if result.Error != nil {
+		return nil, notification.NewNotificationError(notification.ErrRetrievalFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, notification.NewUserNotFoundError(userID)
+	}
+	return &contact, nil
+}
+
+// SetPhone stores a user's verified phone number; an empty phone removes it
+func (r *notificationRepository) SetPhone(ctx context.Context, userID uuid.UUID, phone string, verifiedAt *time.Time) error {
+	var value interface{}
+	if phone != "" {
+		value = phone
+	}
+	result := r.db.WithContext(ctx).
+		Table("users").
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{"phone": value, "phone_verified_at": verifiedAt, "updated_at": time.Now()})
+	if result.Error != nil {
+		return notification.NewNotificationError(notification.ErrNotificationSaveFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return notification.NewUserNotFoundError(userID)
+	}
+	return nil
+}
+
+// GetTicketHolderPhones retrieves the verified phone numbers of the users with completed orders for an event
+func (r *notificationRepository) GetTicketHolderPhones(ctx context.Context, eventID uuid.UUID) ([]notification.PhoneContact, error) {
+	var contacts []notification.PhoneContact
+	err := r.db.WithContext(ctx).
+		Table("users").
+		Select("users.id AS user_id, users.phone AS phone, users.phone_verified_at AS verified_at").
+		Where("users.phone IS NOT NULL AND users.phone_verified_at IS NOT NULL").
+		Where("users.id IN (?)", r.db.Model(&order.Order{}).
+			Select("user_id").
+			Where("event_id = ? AND status = ?", eventID, order.StatusCompleted)).
+		Scan(&contacts).Error
+	if err != nil {
+		return nil, notification.NewNotificationError(notification.ErrRetrievalFailed, err)
+	}
+	return contacts, nil
+}
+
+// GetTicketHolderIDs retrieves the users with completed orders for an event
+func (r *notificationRepository) GetTicketHolderIDs(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := r.db.WithContext(ctx).Model(&order.Order{}).
+		Distinct("user_id").
+		Where("event_id = ? AND status = ?", eventID, order.StatusCompleted).
+		Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return nil, notification.NewNotificationError(notification.ErrRetrievalFailed, err)
+	}
+	return userIDs, nil
+}
+
+// GetRecipient retrieves the email details of a user
+func (r *notificationRepository) GetRecipient(ctx context.Context, userID uuid.UUID) (*notification.Recipient, error) {
+	var recipient notification.Recipient
+	result := r.db.WithContext(ctx).
+		Table("users").
+		Select("id AS user_id, email, username").
+		Where("id = ?", userID).
+		Scan(&recipient)
+	if result.Error != nil {
+		return nil, notification.NewNotificationError(notification.ErrRetrievalFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, notification.NewUserNotFoundError(userID)
+	}
+	return &recipient, nil
+}
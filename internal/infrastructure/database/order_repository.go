@@ -3,12 +3,14 @@ package database
 import (
 	"context"
 	"errors"
+	"time"
 
 	"enterprise-crud/internal/domain/event"
 	"enterprise-crud/internal/domain/order"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // OrderRepository implements the order repository interface
@@ -37,10 +39,20 @@ func (r *OrderRepository) CreateWithTx(ctx context.Context, tx *gorm.DB, orderEn
 	return nil
 }
 
+// CreateGroupWithTx creates a group reservation within a transaction
+func (r *OrderRepository) CreateGroupWithTx(ctx context.Context, tx *gorm.DB, group *order.Group) error {
+	return tx.WithContext(ctx).Create(group).Error
+}
+
 // GetByID retrieves an order by its ID
 func (r *OrderRepository) GetByID(ctx context.Context, id uuid.UUID) (*order.Order, error) {
 	var orderEntity order.Order
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&orderEntity).Error; err != nil {
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&orderEntity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Orders of archived events are still shown in order history
+		err = r.db.WithContext(ctx).Table("archived_orders").Where("id = ?", id).First(&orderEntity).Error
+	}
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, order.NewOrderNotFoundError(id)
 		}
@@ -49,24 +61,69 @@ func (r *OrderRepository) GetByID(ctx context.Context, id uuid.UUID) (*order.Ord
 	return &orderEntity, nil
 }
 
-// GetByUserID retrieves all orders for a specific user
+// GetStatus reads the status and last change of an order, including archived ones
+// Only those columns are selected, so frequent status polling stays cheap on the primary key.
+func (r *OrderRepository) GetStatus(ctx context.Context, id uuid.UUID) (*order.StatusSnapshot, error) {
+	var snapshot order.StatusSnapshot
+	for _, table := range []string{"orders", "archived_orders"} {
+		err := r.db.WithContext(ctx).
+			Table(table).
+			Select("id", "user_id", "status", "updated_at").
+			Where("id = ?", id).
+			Take(&snapshot).Error
+		if err == nil {
+			return &snapshot, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+	return nil, order.NewOrderNotFoundError(id)
+}
+
+// GetByUserID retrieves all orders for a specific user, including archived ones, newest first
+// Both halves are read backwards through their (user_id, created_at) index and merged; archived_orders
+// has the columns of orders followed by archived_at, so the live half pads it with NULL. Gifts the user
+// bought are found through their gifted_by index once claimed.
 func (r *OrderRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*order.Order, error) {
 	var orders []*order.Order
-	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&orders).Error; err != nil {
+	err := r.db.WithContext(ctx).
+		Table(`(SELECT orders.*, NULL::timestamp AS archived_at FROM orders WHERE user_id = ? OR gifted_by = ?
+			UNION ALL SELECT * FROM archived_orders WHERE user_id = ? OR gifted_by = ?) AS orders`, userID, userID, userID, userID).
+		Order("created_at DESC, id DESC").
+		Find(&orders).Error
+	if err != nil {
 		return nil, err
 	}
 	return orders, nil
 }
 
-// GetByEventID retrieves all orders for a specific event
+// GetByEventID retrieves all orders for a specific event, oldest first
+// The event is looked up through idx_orders_event_status, which also serves ticket holder queries
 func (r *OrderRepository) GetByEventID(ctx context.Context, eventID uuid.UUID) ([]*order.Order, error) {
 	var orders []*order.Order
-	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Find(&orders).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("created_at ASC, id ASC").Find(&orders).Error; err != nil {
 		return nil, err
 	}
 	return orders, nil
 }
 
+// GetAttendees retrieves the completed orders of an event with their buyers' email and username
+func (r *OrderRepository) GetAttendees(ctx context.Context, eventID uuid.UUID) ([]*order.Attendee, error) {
+	var attendees []*order.Attendee
+	err := r.db.WithContext(ctx).
+		Table("orders").
+		Select("orders.*, users.email, users.username").
+		Joins("JOIN users ON users.id = orders.user_id").
+		Where("orders.event_id = ? AND orders.status = ?", eventID, order.StatusCompleted).
+		Order("orders.created_at ASC, orders.id ASC").
+		Scan(&attendees).Error
+	if err != nil {
+		return nil, err
+	}
+	return attendees, nil
+}
+
 // Update updates an existing order
 func (r *OrderRepository) Update(ctx context.Context, orderEntity *order.Order) error {
 	if err := r.db.WithContext(ctx).Save(orderEntity).Error; err != nil {
@@ -75,6 +132,301 @@ func (r *OrderRepository) Update(ctx context.Context, orderEntity *order.Order)
 	return nil
 }
 
+// MarkCheckedIn records a check-in unless the order was already checked in
+func (r *OrderRepository) MarkCheckedIn(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&order.Order{}).
+		Where("id = ? AND checked_in_at IS NULL", id).
+		Update("checked_in_at", at)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// SetGiftToken stores the hash of a new claim token on an unclaimed gift
+func (r *OrderRepository) SetGiftToken(ctx context.Context, id uuid.UUID, tokenHash string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&order.Order{}).
+		Where("id = ? AND gift_recipient <> '' AND gift_claimed_at IS NULL", id).
+		Update("gift_token_hash", tokenHash)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// GetByGiftToken retrieves the gift whose current claim token hashes to tokenHash, including archived ones
+func (r *OrderRepository) GetByGiftToken(ctx context.Context, tokenHash string) (*order.Order, error) {
+	var orderEntity order.Order
+	err := r.db.WithContext(ctx).Where("gift_token_hash = ?", tokenHash).First(&orderEntity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = r.db.WithContext(ctx).Table("archived_orders").Where("gift_token_hash = ?", tokenHash).First(&orderEntity).Error
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, order.NewGiftNotFoundError()
+		}
+		return nil, err
+	}
+	return &orderEntity, nil
+}
+
+// GetGroup retrieves a group reservation by its ID
+func (r *OrderRepository) GetGroup(ctx context.Context, id uuid.UUID) (*order.Group, error) {
+	var group order.Group
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&group).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, order.NewGroupNotFoundError(id)
+		}
+		return nil, err
+	}
+	return &group, nil
+}
+
+// GetByGroupID retrieves the shares of a group reservation
+func (r *OrderRepository) GetByGroupID(ctx context.Context, groupID uuid.UUID) ([]*order.Order, error) {
+	var orders []*order.Order
+	if err := r.db.WithContext(ctx).Where("group_id = ?", groupID).Order("created_at ASC, id ASC").Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetByShareToken retrieves the group share whose payment link token hashes to tokenHash
+func (r *OrderRepository) GetByShareToken(ctx context.Context, tokenHash string) (*order.Order, error) {
+	var orderEntity order.Order
+	if err := r.db.WithContext(ctx).Where("share_token_hash = ?", tokenHash).First(&orderEntity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, order.NewGroupShareNotFoundError()
+		}
+		return nil, err
+	}
+	return &orderEntity, nil
+}
+
+// TakeShare moves a held group share to userID in one conditional UPDATE, clearing its payment link
+func (r *OrderRepository) TakeShare(ctx context.Context, id uuid.UUID, tokenHash string, userID uuid.UUID, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&order.Order{}).
+		Where("id = ? AND share_token_hash = ? AND status = ? AND hold_until > ?", id, tokenHash, order.StatusPending, now).
+		Updates(map[string]interface{}{
+			"user_id":          userID,
+			"share_token_hash": "",
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ClaimGift moves an unclaimed gift from its buyer to the recipient in one conditional UPDATE
+// The claim token is cleared with it, so the link can't be used again.
+func (r *OrderRepository) ClaimGift(ctx context.Context, id, purchaserID, recipientID uuid.UUID, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&order.Order{}).
+		Where("id = ? AND user_id = ? AND gift_claimed_at IS NULL", id, purchaserID).
+		Updates(map[string]interface{}{
+			"user_id":         recipientID,
+			"gifted_by":       purchaserID,
+			"gift_claimed_at": at,
+			"gift_token_hash": "",
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ExpirePending marks pending orders created before cutoff as failed and returns their tickets to their events
+// Orders are locked while they are expired, so a concurrent status change can't return tickets twice.
+func (r *OrderRepository) ExpirePending(ctx context.Context, cutoff, now time.Time) (int64, error) {
+	var expired int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var orders []order.Order
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status = ? AND ((hold_until IS NULL AND created_at < ?) OR hold_until < ?)", order.StatusPending, cutoff, now).
+			Find(&orders).Error
+		if err != nil || len(orders) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, len(orders))
+		released := make(map[uuid.UUID]int)
+		for i, o := range orders {
+			ids[i] = o.ID
+			released[o.EventID] += o.Quantity
+		}
+
+		if err := tx.Model(&order.Order{}).Where("id IN ?", ids).Update("status", order.StatusFailed).Error; err != nil {
+			return err
+		}
+		for eventID, quantity := range released {
+			err := tx.Model(&event.Event{}).
+				Where("id = ?", eventID).
+				Update("available_tickets", gorm.Expr("available_tickets + ?", quantity)).Error
+			if err != nil {
+				return err
+			}
+		}
+		expired = int64(len(orders))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return expired, nil
+}
+
+// GetPurchaseHistory summarises a user's earlier orders for risk scoring
+// All three figures come from idx_orders_user_created_at, so the lookups stay cheap on busy checkouts.
+// Recent orders are counted on their own so the created_at bound prunes older partitions.
+func (r *OrderRepository) GetPurchaseHistory(ctx context.Context, userID, eventID uuid.UUID, since time.Time) (*order.PurchaseHistory, error) {
+	var eventOrders, recentOrders int64
+	err := r.db.WithContext(ctx).Model(&order.Order{}).
+		Where("user_id = ? AND event_id = ? AND status <> ?", userID, eventID, order.StatusFailed).
+		Count(&eventOrders).Error
+	if err != nil {
+		return nil, err
+	}
+	err = r.db.WithContext(ctx).Model(&order.Order{}).
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Count(&recentOrders).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var countries []string
+	err = r.db.WithContext(ctx).Model(&order.Order{}).
+		Distinct("country").
+		Where("user_id = ? AND country IS NOT NULL AND country <> ''", userID).
+		Pluck("country", &countries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &order.PurchaseHistory{
+		EventOrders:  int(eventOrders),
+		RecentOrders: int(recentOrders),
+		Countries:    countries,
+	}, nil
+}
+
+// ListByStatus returns up to limit orders with the given status, oldest first
+func (r *OrderRepository) ListByStatus(ctx context.Context, status order.Status, limit int) ([]*order.Order, error) {
+	var orders []*order.Order
+	err := r.db.WithContext(ctx).
+		Where("status = ?", status).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// ResolveReview moves an order out of REVIEW to status, returning its tickets when status is FAILED
+// The status condition makes concurrent decisions safe: only the first one matches the row.
+func (r *OrderRepository) ResolveReview(ctx context.Context, id uuid.UUID, status order.Status) (bool, error) {
+	var resolved bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var held order.Order
+		err := tx.Clauses(clause.Returning{Columns: []clause.Column{{Name: "event_id"}, {Name: "quantity"}}}).
+			Model(&held).
+			Where("id = ? AND status = ?", id, order.StatusReview).
+			Update("status", status).Error
+		if err != nil || held.EventID == uuid.Nil {
+			return err
+		}
+		resolved = true
+
+		if status != order.StatusFailed {
+			return nil
+		}
+		return tx.Model(&event.Event{}).
+			Where("id = ?", held.EventID).
+			Update("available_tickets", gorm.Expr("available_tickets + ?", held.Quantity)).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	return resolved, nil
+}
+
+// reservedTicketsSQL sums the tickets held by an event's orders; failed orders have released theirs
+const reservedTicketsSQL = "(SELECT COALESCE(SUM(o.quantity), 0) FROM orders o WHERE o.event_id = events.id AND o.status <> ?)"
+
+// FindTicketDrift returns the events whose available tickets differ from their total minus reserved tickets
+func (r *OrderRepository) FindTicketDrift(ctx context.Context) ([]*order.TicketCount, error) {
+	var counts []*order.TicketCount
+	err := r.db.WithContext(ctx).Model(&event.Event{}).
+		Select("events.id AS event_id, events.total_tickets, events.available_tickets, "+reservedTicketsSQL+" AS reserved_tickets", order.StatusFailed).
+		Where("events.available_tickets <> events.total_tickets - "+reservedTicketsSQL, order.StatusFailed).
+		Order("events.id").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// CorrectAvailableTickets recomputes an event's available tickets from its orders if the counter still reads observed
+// Orders move the counter in the same transaction as their status, so an unchanged counter means
+// no order was placed or released since the drift was found.
+func (r *OrderRepository) CorrectAvailableTickets(ctx context.Context, eventID uuid.UUID, observed int) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&event.Event{}).
+		Where("id = ? AND available_tickets = ?", eventID, observed).
+		Where("total_tickets - "+reservedTicketsSQL+" >= 0", order.StatusFailed).
+		Update("available_tickets", gorm.Expr("total_tickets - "+reservedTicketsSQL, order.StatusFailed))
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ArchiveEvents moves up to limit events that took place before cutoff, with their orders, to the archive tables
+// The events are locked first: placing or releasing an order updates its event's counter, so no order
+// can slip in between copying and deleting. Events other instances are archiving are skipped.
+// Events with rows in tickets are left in place, as tickets are not archived.
+func (r *OrderRepository) ArchiveEvents(ctx context.Context, cutoff time.Time, limit int) (*order.Archived, error) {
+	archived := &order.Archived{}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []uuid.UUID
+		err := tx.Model(&event.Event{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("event_date < ?", cutoff).
+			Where("NOT EXISTS (SELECT 1 FROM orders o WHERE o.event_id = events.id AND o.status IN ?)",
+				[]order.Status{order.StatusPending, order.StatusReview}).
+			Where("NOT EXISTS (SELECT 1 FROM tickets t WHERE t.event_id = events.id)").
+			Order("event_date ASC, id ASC").
+			Limit(limit).
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		now := time.Now()
+		if err := tx.Exec("INSERT INTO archived_events SELECT events.*, ? FROM events WHERE id IN ?", now, ids).Error; err != nil {
+			return err
+		}
+		result := tx.Exec("INSERT INTO archived_orders SELECT orders.*, ? FROM orders WHERE event_id IN ?", now, ids)
+		if result.Error != nil {
+			return result.Error
+		}
+		if err := tx.Exec("DELETE FROM orders WHERE event_id IN ?", ids).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM events WHERE id IN ?", ids).Error; err != nil {
+			return err
+		}
+
+		archived.Events = len(ids)
+		archived.Orders = int(result.RowsAffected)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return archived, nil
+}
+
 // Delete deletes an order by its ID
 func (r *OrderRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&order.Order{}, id)
@@ -87,10 +439,17 @@ func (r *OrderRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-// GetEventWithTx retrieves event information within a transaction
-func (r *OrderRepository) GetEventWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID) (*order.EventInfo, error) {
+// LockEventWithTx reads what order creation needs of an event and locks its row until the transaction ends
+// Only those columns are selected: the lock serializes concurrent orders for the event, and the
+// rest of the row (description, settings) is neither read nor needed.
+func (r *OrderRepository) LockEventWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID) (*order.EventInfo, error) {
 	var eventEntity event.Event
-	if err := tx.WithContext(ctx).Where("id = ?", eventID).First(&eventEntity).Error; err != nil {
+	err := tx.WithContext(ctx).
+		Select("id", "ticket_price", "price_schedule", "available_tickets", "status", "moderation_status", "attendee_questions", "access_code_required", "sale_start", "sale_end", "minimum_age").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", eventID).
+		Take(&eventEntity).Error
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, order.NewEventNotFoundError(eventID)
 		}
@@ -99,11 +458,53 @@ func (r *OrderRepository) GetEventWithTx(ctx context.Context, tx *gorm.DB, event
 
 	return &order.EventInfo{
 		ID:               eventEntity.ID,
-		Title:            eventEntity.Title,
 		TicketPrice:      eventEntity.TicketPrice,
+		PriceSchedule:    eventEntity.PriceSchedule,
 		AvailableTickets: eventEntity.AvailableTickets,
-		TotalTickets:     eventEntity.TotalTickets,
 		Status:           eventEntity.Status,
+		Flagged:          eventEntity.IsFlagged(),
+		Questions:        eventEntity.AttendeeQuestions,
+
+		AccessCodeRequired: eventEntity.AccessCodeRequired,
+		SaleStart:          eventEntity.SaleStart,
+		SaleEnd:            eventEntity.SaleEnd,
+		MinimumAge:         eventEntity.MinimumAge,
+	}, nil
+}
+
+// ReserveTicketsWithTx takes quantity tickets of an active, approved event in a single conditional UPDATE
+// The decrement and the availability check are one statement, so there is no window between reading
+// and writing the counter. It returns nil when the event is missing, not active, held by moderation
+// or short of tickets.
+func (r *OrderRepository) ReserveTicketsWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID, quantity int) (*order.EventInfo, error) {
+	var reserved event.Event
+	result := tx.WithContext(ctx).Model(&reserved).
+		Clauses(clause.Returning{Columns: []clause.Column{
+			{Name: "id"}, {Name: "ticket_price"}, {Name: "price_schedule"}, {Name: "available_tickets"}, {Name: "status"}, {Name: "moderation_status"},
+			{Name: "attendee_questions"}, {Name: "access_code_required"}, {Name: "sale_start"}, {Name: "sale_end"}, {Name: "minimum_age"},
+		}}).
+		Where("id = ? AND status = ? AND moderation_status = ? AND available_tickets >= ?", eventID, event.StatusActive, event.ModerationApproved, quantity).
+		Update("available_tickets", gorm.Expr("available_tickets - ?", quantity))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return &order.EventInfo{
+		ID:               reserved.ID,
+		TicketPrice:      reserved.TicketPrice,
+		PriceSchedule:    reserved.PriceSchedule,
+		AvailableTickets: reserved.AvailableTickets,
+		Status:           reserved.Status,
+		Flagged:          reserved.IsFlagged(),
+		Questions:        reserved.AttendeeQuestions,
+
+		AccessCodeRequired: reserved.AccessCodeRequired,
+		SaleStart:          reserved.SaleStart,
+		SaleEnd:            reserved.SaleEnd,
+		MinimumAge:         reserved.MinimumAge,
 	}, nil
 }
 
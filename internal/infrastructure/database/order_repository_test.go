@@ -1,10 +1,13 @@
 package database
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"enterprise-crud/internal/domain/order"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -100,7 +103,7 @@ func TestOrderRepository_Delete_NotFound(t *testing.T) {
 	assert.NotNil(t, repo)
 }
 
-func TestOrderRepository_GetEventWithTx_Success(t *testing.T) {
+func TestOrderRepository_LockEventWithTx_Success(t *testing.T) {
 	// Test successful event retrieval with transaction
 	db := &gorm.DB{}
 	repo := &OrderRepository{db: db}
@@ -108,7 +111,7 @@ func TestOrderRepository_GetEventWithTx_Success(t *testing.T) {
 	assert.NotNil(t, repo)
 }
 
-func TestOrderRepository_GetEventWithTx_NotFound(t *testing.T) {
+func TestOrderRepository_LockEventWithTx_NotFound(t *testing.T) {
 	// Test event not found with transaction
 	db := &gorm.DB{}
 	repo := &OrderRepository{db: db}
@@ -116,6 +119,39 @@ func TestOrderRepository_GetEventWithTx_NotFound(t *testing.T) {
 	assert.NotNil(t, repo)
 }
 
+func TestOrderRepository_LockEventWithTx_SelectsOnlyWhatOrdersNeed(t *testing.T) {
+	db := newDryRunDB(t)
+	queries := capturedQueries(t, db)
+	repo := &OrderRepository{db: db}
+
+	_, err := repo.LockEventWithTx(context.Background(), db, uuid.New())
+	require.NoError(t, err)
+
+	require.Len(t, *queries, 1)
+	assert.Equal(t,
+		`SELECT "id","ticket_price","price_schedule","available_tickets","status","moderation_status","attendee_questions","access_code_required","sale_start","sale_end","minimum_age" FROM "events" WHERE id = $1 LIMIT $2 FOR UPDATE`,
+		(*queries)[0])
+}
+
+func TestOrderRepository_ReserveTicketsWithTx_IsOneConditionalUpdate(t *testing.T) {
+	db := newDryRunDB(t)
+	var queries []string
+	require.NoError(t, db.Callback().Update().After("gorm:update").Register("test:capture", func(db *gorm.DB) {
+		queries = append(queries, db.Statement.SQL.String())
+	}))
+	repo := &OrderRepository{db: db}
+
+	// In CreateOrder tx is already a transaction, so GORM opens none of its own
+	tx := db.Session(&gorm.Session{SkipDefaultTransaction: true})
+	_, err := repo.ReserveTicketsWithTx(context.Background(), tx, uuid.New(), 2)
+	require.NoError(t, err)
+
+	require.Len(t, queries, 1)
+	assert.Contains(t, queries[0], `UPDATE "events" SET "available_tickets"=available_tickets - $1`)
+	assert.Contains(t, queries[0], `WHERE id = $3 AND status = $4 AND moderation_status = $5 AND available_tickets >= $6`)
+	assert.Contains(t, queries[0], `RETURNING "id","ticket_price","price_schedule","available_tickets","status","moderation_status","attendee_questions","access_code_required","sale_start","sale_end","minimum_age"`)
+}
+
 func TestOrderRepository_UpdateEventTicketsWithTx_Success(t *testing.T) {
 	// Test successful event tickets update with transaction
 	db := &gorm.DB{}
@@ -142,3 +178,42 @@ func TestNewOrderRepository(t *testing.T) {
 	// Verify it implements the order.Repository interface
 	var _ order.Repository = repo
 }
+
+func TestOrderRepository_ListQueriesUseIndexOrder(t *testing.T) {
+	db := newDryRunDB(t)
+	queries := capturedQueries(t, db)
+	repo := NewOrderRepository(db)
+
+	_, err := repo.GetByUserID(context.Background(), uuid.New())
+	require.NoError(t, err)
+	_, err = repo.GetByEventID(context.Background(), uuid.New())
+	require.NoError(t, err)
+
+	require.Len(t, *queries, 2)
+	assert.Contains(t, (*queries)[0], "UNION ALL SELECT * FROM archived_orders WHERE user_id = $3 OR gifted_by = $4) AS orders ORDER BY created_at DESC, id DESC")
+	assert.Contains(t, (*queries)[1], "WHERE event_id = $1 ORDER BY created_at ASC, id ASC")
+}
+
+func TestOrderRepository_PurchaseHistoryBoundsRecentOrders(t *testing.T) {
+	db := newDryRunDB(t)
+	queries := capturedQueries(t, db)
+	repo := NewOrderRepository(db)
+
+	_, err := repo.GetPurchaseHistory(context.Background(), uuid.New(), uuid.New(), time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	require.Len(t, *queries, 3)
+	assert.Contains(t, (*queries)[1], "WHERE user_id = $1 AND created_at >= $2")
+}
+
+func TestOrderRepository_GetStatus_SelectsOnlyStatusColumns(t *testing.T) {
+	db := newDryRunDB(t)
+	queries := capturedQueries(t, db)
+	repo := NewOrderRepository(db)
+
+	_, err := repo.GetStatus(context.Background(), uuid.New())
+	require.NoError(t, err)
+
+	require.Len(t, *queries, 1)
+	assert.Equal(t, `SELECT "id","user_id","status","updated_at" FROM "orders" WHERE id = $1 LIMIT $2`, (*queries)[0])
+}
@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PartitionMaintainer keeps the monthly partitions of orders created ahead of time
+// Orders outside every monthly partition land in orders_pdefault, and a partition can't be
+// created over a range the default partition holds rows for, so partitions are made months
+// before they are needed. Several instances may run it; existing partitions are left alone.
+type PartitionMaintainer struct {
+	db          *gorm.DB
+	interval    time.Duration
+	monthsAhead int
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPartitionMaintainer creates a maintainer ensuring partitions for the current month and monthsAhead months after it
+func NewPartitionMaintainer(db *gorm.DB, interval time.Duration, monthsAhead int) *PartitionMaintainer {
+	return &PartitionMaintainer{
+		db:          db,
+		interval:    interval,
+		monthsAhead: monthsAhead,
+	}
+}
+
+// Start begins maintaining partitions in the background
+func (m *PartitionMaintainer) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	m.wg.Add(1)
+	go m.run(ctx)
+
+	log.Printf("Order partition maintainer started, keeping %d months of partitions ahead every %s", m.monthsAhead, m.interval)
+}
+
+// Stop halts the maintainer and waits for the current run to finish
+func (m *PartitionMaintainer) Stop() {
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	m.wg.Wait()
+	log.Println("Order partition maintainer stopped")
+}
+
+func (m *PartitionMaintainer) run(ctx context.Context) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.EnsurePartitions(ctx, time.Now()); err != nil {
+			log.Printf("Warning: Failed to create order partitions: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// EnsurePartitions creates the missing partitions from the month of now through monthsAhead months later
+// It returns the names of the partitions it created.
+func (m *PartitionMaintainer) EnsurePartitions(ctx context.Context, now time.Time) ([]string, error) {
+	var created []string
+	start := monthStart(now)
+	for i := 0; i <= m.monthsAhead; i++ {
+		from := start.AddDate(0, i, 0)
+		name := partitionName(from)
+
+		var exists bool
+		if err := m.db.WithContext(ctx).Raw("SELECT to_regclass(?) IS NOT NULL", name).Scan(&exists).Error; err != nil {
+			return created, err
+		}
+		if exists {
+			continue
+		}
+
+		err := m.db.WithContext(ctx).Exec(fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %s PARTITION OF orders FOR VALUES FROM ('%s') TO ('%s')",
+			name, from.Format(time.DateOnly), from.AddDate(0, 1, 0).Format(time.DateOnly))).Error
+		if err != nil {
+			return created, fmt.Errorf("failed to create partition %s: %w", name, err)
+		}
+		log.Printf("Created order partition %s", name)
+		created = append(created, name)
+	}
+	return created, nil
+}
+
+// partitionName is the name of the orders partition holding the month of t
+func partitionName(t time.Time) string {
+	return "orders_p" + t.UTC().Format("200601")
+}
+
+// monthStart truncates t to the first instant of its UTC month
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,22 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionName(t *testing.T) {
+	assert.Equal(t, "orders_p202610", partitionName(time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "orders_p202601", partitionName(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestMonthStart(t *testing.T) {
+	auckland := time.FixedZone("NZDT", 13*60*60)
+
+	got := monthStart(time.Date(2026, 11, 1, 9, 0, 0, 0, auckland))
+
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), got)
+	assert.Equal(t, "orders_p202612", partitionName(got.AddDate(0, 2, 0)))
+}
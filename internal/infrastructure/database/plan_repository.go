@@ -0,0 +1,70 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"enterprise-crud/internal/domain/plan"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// planRepository implements the plan.Repository interface
+type planRepository struct {
+	db *gorm.DB
+}
+
+// NewPlanRepository creates a new plan repository instance
+func NewPlanRepository(db *gorm.DB) plan.Repository {
+	return &planRepository{db: db}
+}
+
+// GetAll retrieves all plans
+func (r *planRepository) GetAll(ctx context.Context) ([]*plan.Plan, error) {
+	var plans []*plan.Plan
+	if err := r.db.WithContext(ctx).Order("name").Find(&plans).Error; err != nil {
+		return nil, plan.NewPlanError(plan.ErrPlanRetrievalFailed, err)
+	}
+	return plans, nil
+}
+
+// GetByName retrieves a plan by its name
+func (r *planRepository) GetByName(ctx context.Context, name string) (*plan.Plan, error) {
+	var p plan.Plan
+	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&p).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, plan.NewPlanNotFoundError(name)
+		}
+		return nil, plan.NewPlanError(plan.ErrPlanRetrievalFailed, err)
+	}
+	return &p, nil
+}
+
+// GetByUserID retrieves the plan assigned to a user, or nil if none is assigned
+func (r *planRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*plan.Plan, error) {
+	var p plan.Plan
+	err := r.db.WithContext(ctx).
+		Joins("JOIN users ON users.plan_id = plans.id").
+		Where("users.id = ?", userID).
+		First(&p).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, plan.NewPlanError(plan.ErrPlanRetrievalFailed, err)
+	}
+	return &p, nil
+}
+
+// AssignToUser sets the plan of a user
+func (r *planRepository) AssignToUser(ctx context.Context, userID, planID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Table("users").Where("id = ?", userID).Update("plan_id", planID)
+	if result.Error != nil {
+		return plan.NewPlanError(plan.ErrPlanAssignFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return plan.NewUserNotFoundError(userID)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package database
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/infrastructure/metrics"
+	"enterprise-crud/internal/logging"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// queryStartKey holds the start time of a statement on its GORM instance
+const queryStartKey = "query_logger:start"
+
+// QueryLoggerConfig controls which queries are logged
+type QueryLoggerConfig struct {
+	Logger        *slog.Logger  // Destination for query logs (default: JSON to stdout, including debug level)
+	SlowThreshold time.Duration // Queries at least this slow are always logged and counted, 0 disables slow-query detection
+	SampleRate    float64       // Fraction of other queries logged at debug level, 0 logs none
+	Explain       bool          // Log the EXPLAIN plan of slow SELECT queries; only enable in development
+
+	LogLevel logger.LogLevel // Level of GORM's own logs (default: logger.Warn)
+}
+
+// newGormLogger creates GORM's own logger at level, printing statements with placeholders instead of bound values
+func newGormLogger(level logger.LogLevel) logger.Interface {
+	return logger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), logger.Config{
+		LogLevel:             level,
+		ParameterizedQueries: true,
+		Colorful:             true,
+	})
+}
+
+// callbackRegistrar is a positioned GORM callback, e.g. db.Callback().Query().Before("gorm:query")
+type callbackRegistrar interface {
+	Register(name string, fn func(*gorm.DB)) error
+}
+
+// QueryLogger is a GORM plugin logging slow and sampled queries
+// Statements are logged with their $n placeholders and only the number of bound
+// parameters, so values such as emails or password hashes never reach the logs.
+// Slow queries also increment the db_slow_queries_total metric.
+type QueryLogger struct {
+	cfg    QueryLoggerConfig
+	logger *slog.Logger
+	sample func() float64 // Returns a number in [0, 1), replaced in tests
+}
+
+// NewQueryLogger creates the query logging plugin; register it with db.Use
+func NewQueryLogger(cfg QueryLoggerConfig) *QueryLogger {
+	l := cfg.Logger
+	if l == nil {
+		// Sampled queries are logged at debug level, which the default handler would drop
+		l = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	}
+	if cfg.LogLevel == 0 {
+		cfg.LogLevel = logger.Warn
+	}
+	return &QueryLogger{cfg: cfg, logger: l, sample: rand.Float64}
+}
+
+// Name implements gorm.Plugin
+func (p *QueryLogger) Name() string {
+	return "query_logger"
+}
+
+// Initialize implements gorm.Plugin by timing every statement GORM executes
+// GORM's own slow-query warning prints bound values, so it is disabled and its
+// error logs are switched to placeholders.
+func (p *QueryLogger) Initialize(db *gorm.DB) error {
+	db.Logger = newGormLogger(p.cfg.LogLevel)
+
+	callbacks := db.Callback()
+	processors := []struct {
+		operation     string
+		before, after callbackRegistrar
+	}{
+		{"create", callbacks.Create().Before("gorm:create"), callbacks.Create().After("gorm:create")},
+		{"query", callbacks.Query().Before("gorm:query"), callbacks.Query().After("gorm:query")},
+		{"update", callbacks.Update().Before("gorm:update"), callbacks.Update().After("gorm:update")},
+		{"delete", callbacks.Delete().Before("gorm:delete"), callbacks.Delete().After("gorm:delete")},
+		{"row", callbacks.Row().Before("gorm:row"), callbacks.Row().After("gorm:row")},
+		{"raw", callbacks.Raw().Before("gorm:raw"), callbacks.Raw().After("gorm:raw")},
+	}
+
+	for _, proc := range processors {
+		if err := proc.before.Register("query_logger:before_"+proc.operation, p.start); err != nil {
+			return err
+		}
+		if err := proc.after.Register("query_logger:after_"+proc.operation, p.finish(proc.operation)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// start records when a statement began
+func (p *QueryLogger) start(db *gorm.DB) {
+	db.InstanceSet(queryStartKey, time.Now())
+}
+
+// finish logs the statement if it was slow or sampled
+func (p *QueryLogger) finish(operation string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(queryStartKey)
+		if !ok {
+			return
+		}
+		elapsed := time.Since(value.(time.Time))
+
+		sql := db.Statement.SQL.String()
+		if sql == "" {
+			return
+		}
+
+		slow := p.cfg.SlowThreshold > 0 && elapsed >= p.cfg.SlowThreshold
+		if slow {
+			metrics.DBSlowQueries.WithLabelValues(operation, db.Statement.Table).Inc()
+		} else if p.cfg.SampleRate <= 0 || p.sample() >= p.cfg.SampleRate {
+			return
+		}
+
+		ctx := db.Statement.Context
+		attrs := []slog.Attr{
+			slog.String("operation", operation),
+			slog.String("table", db.Statement.Table),
+			slog.String("sql", sql),
+			slog.Int("params", len(db.Statement.Vars)),
+			slog.Float64("duration_ms", float64(elapsed.Microseconds())/1000),
+			slog.Int64("rows", db.RowsAffected),
+		}
+		if db.Error != nil {
+			attrs = append(attrs, slog.String("error", db.Error.Error()))
+		}
+		if ctx != nil {
+			if requestID := logging.RequestID(ctx); requestID != "" {
+				attrs = append(attrs, slog.String("request_id", requestID))
+			}
+		}
+
+		if !slow {
+			p.logger.LogAttrs(ctx, slog.LevelDebug, "query", attrs...)
+			return
+		}
+
+		if p.cfg.Explain && db.Error == nil && isSelect(sql) {
+			plan, err := p.explain(ctx, db, sql)
+			if err != nil {
+				attrs = append(attrs, slog.String("explain_error", err.Error()))
+			} else {
+				attrs = append(attrs, slog.String("plan", plan))
+			}
+		}
+		p.logger.LogAttrs(ctx, slog.LevelWarn, "slow query", attrs...)
+	}
+}
+
+// explain returns the query plan of sql using the statement's own bound values
+// It runs on the statement's connection so plans inside a transaction see its data,
+// and bypasses GORM callbacks so it is neither timed nor logged itself.
+func (p *QueryLogger) explain(ctx context.Context, db *gorm.DB, sql string) (string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	rows, err := db.Statement.ConnPool.QueryContext(ctx, "EXPLAIN "+sql, db.Statement.Vars...)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var lines []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			return "", err
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n"), rows.Err()
+}
+
+// isSelect reports whether sql only reads, so EXPLAIN can't have side effects
+func isSelect(sql string) bool {
+	return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(sql)), "SELECT")
+}
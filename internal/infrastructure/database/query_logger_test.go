@@ -0,0 +1,92 @@
+package database
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/infrastructure/metrics"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// newDryRunDB opens a dry-run connection: statements are built and run through
+// callbacks but never sent, so no database is needed
+func newDryRunDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	require.NoError(t, err)
+	return db
+}
+
+// capturedQueries records the SQL of every query run on db
+func capturedQueries(t *testing.T, db *gorm.DB) *[]string {
+	var queries []string
+	require.NoError(t, db.Callback().Query().After("gorm:query").Register("test:capture", func(db *gorm.DB) {
+		queries = append(queries, db.Statement.SQL.String())
+	}))
+	return &queries
+}
+
+func newQueryLoggerDB(t *testing.T, cfg QueryLoggerConfig, buf *bytes.Buffer) (*gorm.DB, *QueryLogger) {
+	db := newDryRunDB(t)
+
+	cfg.Logger = slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	plugin := NewQueryLogger(cfg)
+	require.NoError(t, db.Use(plugin))
+	return db, plugin
+}
+
+func TestQueryLogger_LogsSlowQueriesWithoutParameters(t *testing.T) {
+	var buf bytes.Buffer
+	db, _ := newQueryLoggerDB(t, QueryLoggerConfig{SlowThreshold: time.Nanosecond}, &buf)
+	before := testutil.ToFloat64(metrics.DBSlowQueries.WithLabelValues("query", "users"))
+
+	var found user.User
+	db.Where("email = ?", "secret@example.com").First(&found)
+
+	logged := buf.String()
+	assert.Contains(t, logged, `"msg":"slow query"`)
+	assert.Contains(t, logged, `"table":"users"`)
+	assert.Contains(t, logged, "email = $1")
+	assert.Contains(t, logged, `"params":2`) // email and LIMIT
+	assert.NotContains(t, logged, "secret@example.com")
+	assert.Equal(t, before+1, testutil.ToFloat64(metrics.DBSlowQueries.WithLabelValues("query", "users")))
+}
+
+func TestQueryLogger_SkipsFastQueriesUnlessSampled(t *testing.T) {
+	var buf bytes.Buffer
+	db, plugin := newQueryLoggerDB(t, QueryLoggerConfig{SlowThreshold: time.Hour, SampleRate: 0.5}, &buf)
+
+	plugin.sample = func() float64 { return 0.9 }
+	db.Find(&[]user.User{})
+	assert.Empty(t, buf.String())
+
+	plugin.sample = func() float64 { return 0.1 }
+	db.Find(&[]user.User{})
+	assert.Contains(t, buf.String(), `"level":"DEBUG","msg":"query"`)
+}
+
+func TestQueryLogger_DisabledThreshold(t *testing.T) {
+	var buf bytes.Buffer
+	db, _ := newQueryLoggerDB(t, QueryLoggerConfig{}, &buf)
+
+	db.Find(&[]user.User{})
+
+	assert.Empty(t, buf.String())
+}
+
+func TestIsSelect(t *testing.T) {
+	assert.True(t, isSelect(`SELECT * FROM "users"`))
+	assert.True(t, isSelect("  select 1"))
+	assert.False(t, isSelect(`UPDATE "orders" SET status = $1`))
+	assert.False(t, isSelect(`INSERT INTO "events" DEFAULT VALUES RETURNING id`))
+}
@@ -0,0 +1,137 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"enterprise-crud/internal/domain/refund"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// refundRepository implements the refund.Repository interface
+type refundRepository struct {
+	db *gorm.DB
+}
+
+// NewRefundRepository creates a new refund request repository instance
+func NewRefundRepository(db *gorm.DB) refund.Repository {
+	return &refundRepository{db: db}
+}
+
+// errAlreadyRequested rolls back the opening of a request for an order that has one
+var errAlreadyRequested = errors.New("order already has a refund request")
+
+// Create stores a new request with its audit entry, reporting a duplicate when the order
+// already has a request that wasn't denied
+func (r *refundRepository) Create(ctx context.Context, req *refund.Request, entry *refund.AuditEntry) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(req)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errAlreadyRequested
+		}
+		entry.RequestID = req.ID
+		return tx.Create(entry).Error
+	})
+	if errors.Is(err, errAlreadyRequested) {
+		return refund.ErrAlreadyRequested
+	}
+	if err != nil {
+		return refund.NewRefundError(refund.ErrRefundSaveFailed, err)
+	}
+	return nil
+}
+
+// GetByID retrieves a request by its ID
+func (r *refundRepository) GetByID(ctx context.Context, id uuid.UUID) (*refund.Request, error) {
+	var req refund.Request
+	if err := r.db.WithContext(ctx).First(&req, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, refund.ErrRequestNotFound
+		}
+		return nil, refund.NewRefundError(refund.ErrRefundRetrievalFailed, err)
+	}
+	return &req, nil
+}
+
+// List retrieves the requests matching the filter with event title and buyer email, oldest first
+func (r *refundRepository) List(ctx context.Context, filter refund.ListFilter) ([]*refund.Request, error) {
+	query := r.db.WithContext(ctx).
+		Select("refund_requests.*, events.title AS event_title, users.email").
+		Joins("JOIN events ON events.id = refund_requests.event_id").
+		Joins("JOIN users ON users.id = refund_requests.user_id")
+	if filter.OrganizerID != nil {
+		query = query.Where("events.organizer_id = ?", *filter.OrganizerID)
+	}
+	if filter.EventID != nil {
+		query = query.Where("refund_requests.event_id = ?", *filter.EventID)
+	}
+	if filter.Status != "" {
+		query = query.Where("refund_requests.status = ?", filter.Status)
+	}
+
+	var requests []*refund.Request
+	err := query.
+		Order("refund_requests.created_at, refund_requests.id").
+		Limit(filter.PageSize()).
+		Offset(filter.Offset).
+		Find(&requests).Error
+	if err != nil {
+		return nil, refund.NewRefundError(refund.ErrRefundRetrievalFailed, err)
+	}
+	return requests, nil
+}
+
+// Transition saves the request's status and decision if its stored status is one of from,
+// together with the audit entry
+func (r *refundRepository) Transition(ctx context.Context, req *refund.Request, from []string, entry *refund.AuditEntry) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&refund.Request{}).
+			Where("id = ? AND status IN ?", req.ID, from).
+			Updates(map[string]interface{}{
+				"status":             req.Status,
+				"amount":             req.Amount,
+				"decision":           req.Decision,
+				"decided_by":         req.DecidedBy,
+				"decided_at":         req.DecidedAt,
+				"provider_reference": req.ProviderReference,
+				"updated_at":         now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return refund.ErrAlreadyDecided
+		}
+		entry.RequestID = req.ID
+		return tx.Create(entry).Error
+	})
+	if errors.Is(err, refund.ErrAlreadyDecided) {
+		return refund.ErrAlreadyDecided
+	}
+	if err != nil {
+		return refund.NewRefundError(refund.ErrRefundSaveFailed, err)
+	}
+	req.UpdatedAt = now
+	return nil
+}
+
+// ListAudit retrieves the audit entries of a request, oldest first
+func (r *refundRepository) ListAudit(ctx context.Context, requestID uuid.UUID) ([]*refund.AuditEntry, error) {
+	var entries []*refund.AuditEntry
+	err := r.db.WithContext(ctx).
+		Where("request_id = ?", requestID).
+		Order("created_at, id").
+		Find(&entries).Error
+	if err != nil {
+		return nil, refund.NewRefundError(refund.ErrRefundRetrievalFailed, err)
+	}
+	return entries, nil
+}
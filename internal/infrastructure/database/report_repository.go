@@ -0,0 +1,179 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/report"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reportRepository implements the report.Repository interface
+type reportRepository struct {
+	db *gorm.DB
+}
+
+// NewReportRepository creates a new report repository instance
+func NewReportRepository(db *gorm.DB) report.Repository {
+	return &reportRepository{db: db}
+}
+
+// GetSchedule retrieves the report schedule of a user, or nil if none is stored
+func (r *reportRepository) GetSchedule(ctx context.Context, userID uuid.UUID) (*report.Schedule, error) {
+	var schedule report.Schedule
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&schedule).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, report.NewReportError(report.ErrReportRetrievalFailed, err)
+	}
+	return &schedule, nil
+}
+
+// SaveSchedule creates or updates a report schedule
+func (r *reportRepository) SaveSchedule(ctx context.Context, schedule *report.Schedule) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"frequency", "enabled", "next_run_at", "updated_at"}),
+	}).Create(schedule).Error
+	if err != nil {
+		return report.NewReportError(report.ErrScheduleSaveFailed, err)
+	}
+	return nil
+}
+
+// GetDueSchedules retrieves enabled schedules whose next run is at or before now
+func (r *reportRepository) GetDueSchedules(ctx context.Context, now time.Time, limit int) ([]*report.Schedule, error) {
+	var schedules []*report.Schedule
+	err := r.db.WithContext(ctx).
+		Where("enabled AND next_run_at <= ?", now).
+		Order("next_run_at").
+		Limit(limit).
+		Find(&schedules).Error
+	if err != nil {
+		return nil, report.NewReportError(report.ErrReportRetrievalFailed, err)
+	}
+	return schedules, nil
+}
+
+// AdvanceSchedule moves a schedule's next run forward if nobody else has already done so
+func (r *reportRepository) AdvanceSchedule(ctx context.Context, id uuid.UUID, expected, next time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&report.Schedule{}).
+		Where("id = ? AND next_run_at = ?", id, expected).
+		Updates(map[string]interface{}{"next_run_at": next, "updated_at": time.Now()})
+	if result.Error != nil {
+		return false, report.NewReportError(report.ErrScheduleSaveFailed, result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// MarkSent records when a user's last report was sent
+func (r *reportRepository) MarkSent(ctx context.Context, userID uuid.UUID, sentAt time.Time) error {
+	err := r.db.WithContext(ctx).Model(&report.Schedule{}).
+		Where("user_id = ?", userID).
+		Update("last_sent_at", sentAt).Error
+	if err != nil {
+		return report.NewReportError(report.ErrScheduleSaveFailed, err)
+	}
+	return nil
+}
+
+// salesSummarySQL sums completed orders per event for one organizer
+const salesSummarySQL = `
+SELECT e.id AS event_id, e.title AS title,
+       COUNT(o.id) AS orders,
+       COALESCE(SUM(o.quantity), 0) AS tickets_sold,
+       COALESCE(SUM(o.total_amount), 0) AS revenue
+FROM orders o
+JOIN events e ON e.id = o.event_id
+WHERE e.organizer_id = ? AND o.status = ? AND o.created_at >= ? AND o.created_at < ?
+GROUP BY e.id, e.title
+ORDER BY revenue DESC, e.title`
+
+// GetSalesSummary aggregates orders completed in [from, to) for an organizer's events
+func (r *reportRepository) GetSalesSummary(ctx context.Context, organizerID uuid.UUID, from, to time.Time) (*report.SalesSummary, error) {
+	var events []report.EventSales
+	err := r.db.WithContext(ctx).
+		Raw(salesSummarySQL, organizerID, order.StatusCompleted, from, to).
+		Scan(&events).Error
+	if err != nil {
+		return nil, report.NewReportError(report.ErrReportRetrievalFailed, err)
+	}
+
+	summary := &report.SalesSummary{
+		OrganizerID: organizerID,
+		From:        from,
+		To:          to,
+		Events:      events,
+	}
+	for _, e := range events {
+		summary.TotalOrders += e.Orders
+		summary.TotalTickets += e.TicketsSold
+		summary.TotalRevenue += e.Revenue
+	}
+	return summary, nil
+}
+
+// salesSeriesSQL sums the tickets of an event's orders per hour they were placed in
+const salesSeriesSQL = `
+SELECT date_trunc('hour', created_at) AS hour, SUM(quantity) AS tickets
+FROM orders
+WHERE event_id = ? AND status <> ?
+GROUP BY 1
+ORDER BY 1`
+
+// GetSalesSeries retrieves an event's tickets sold per hour, for forecasting its sellout
+// Orders are read through idx_orders_event_status; failed orders released their tickets and are left out.
+func (r *reportRepository) GetSalesSeries(ctx context.Context, eventID uuid.UUID) (*report.SalesSeries, error) {
+	var e event.Event
+	result := r.db.WithContext(ctx).
+		Select("id", "organizer_id", "title", "event_date", "total_tickets", "available_tickets").
+		Where("id = ?", eventID).
+		Limit(1).
+		Find(&e)
+	if result.Error != nil {
+		return nil, report.NewReportError(report.ErrReportRetrievalFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, report.NewEventNotFoundError(eventID)
+	}
+
+	series := &report.SalesSeries{
+		EventID:          e.ID,
+		OrganizerID:      e.OrganizerID,
+		Title:            e.Title,
+		EventDate:        e.EventDate,
+		TotalTickets:     e.TotalTickets,
+		AvailableTickets: e.AvailableTickets,
+	}
+	err := r.db.WithContext(ctx).
+		Raw(salesSeriesSQL, eventID, order.StatusFailed).
+		Scan(&series.Hours).Error
+	if err != nil {
+		return nil, report.NewReportError(report.ErrReportRetrievalFailed, err)
+	}
+	return series, nil
+}
+
+// GetRecipient retrieves the email details of a user
+func (r *reportRepository) GetRecipient(ctx context.Context, userID uuid.UUID) (*report.Recipient, error) {
+	var recipient report.Recipient
+	result := r.db.WithContext(ctx).
+		Table("users").
+		Select("id AS user_id, email, username").
+		Where("id = ?", userID).
+		Scan(&recipient)
+	if result.Error != nil {
+		return nil, report.NewReportError(report.ErrReportRetrievalFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, report.NewUserNotFoundError(userID)
+	}
+	return &recipient, nil
+}
@@ -19,9 +19,9 @@ func NewRoleRepository(db *gorm.DB) role.Repository {
 	return &roleRepository{db: db}
 }
 
-// GetByName retrieves a role by its name (like "USER" or "ADMIN")
+// GetByName retrieves a role by its name (like role.RoleUser or role.RoleAdmin)
 // This is used when assigning roles to users during registration
-func (r *roleRepository) GetByName(ctx context.Context, name string) (*role.Role, error) {
+func (r *roleRepository) GetByName(ctx context.Context, name role.Name) (*role.Role, error) {
 	var roleEntity role.Role
 
 	// Find the role by name in the database
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"enterprise-crud/internal/domain/shorturl"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// shortURLRepository implements the shorturl.Repository interface
+type shortURLRepository struct {
+	db *gorm.DB
+}
+
+// NewShortURLRepository creates a new short URL repository instance
+func NewShortURLRepository(db *gorm.DB) shorturl.Repository {
+	return &shortURLRepository{db: db}
+}
+
+// Create stores a short URL, skipping it when its code or event is already taken
+func (r *shortURLRepository) Create(ctx context.Context, shortURL *shorturl.ShortURL) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(shortURL)
+	if result.Error != nil {
+		return false, shorturl.NewShortURLError(shorturl.ErrShortURLCreationFailed, result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// GetByCode retrieves a short URL by its code
+func (r *shortURLRepository) GetByCode(ctx context.Context, code string) (*shorturl.ShortURL, error) {
+	var shortURL shorturl.ShortURL
+	if err := r.db.WithContext(ctx).Where("code = ?", code).First(&shortURL).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shorturl.ErrShortURLNotFound
+		}
+		return nil, shorturl.NewShortURLError(shorturl.ErrShortURLRetrievalFailed, err)
+	}
+	return &shortURL, nil
+}
+
+// GetByEventID retrieves the short URL of an event, or nil if it has none
+func (r *shortURLRepository) GetByEventID(ctx context.Context, eventID uuid.UUID) (*shorturl.ShortURL, error) {
+	var shortURL shorturl.ShortURL
+	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).First(&shortURL).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, shorturl.NewShortURLError(shorturl.ErrShortURLRetrievalFailed, err)
+	}
+	return &shortURL, nil
+}
+
+// GetByEventIDs retrieves the short URLs of several events, keyed by event ID
+func (r *shortURLRepository) GetByEventIDs(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID]*shorturl.ShortURL, error) {
+	var shortURLs []*shorturl.ShortURL
+	if err := r.db.WithContext(ctx).Where("event_id IN ?", eventIDs).Find(&shortURLs).Error; err != nil {
+		return nil, shorturl.NewShortURLError(shorturl.ErrShortURLRetrievalFailed, err)
+	}
+
+	byEvent := make(map[uuid.UUID]*shorturl.ShortURL, len(shortURLs))
+	for _, shortURL := range shortURLs {
+		byEvent[shortURL.EventID] = shortURL
+	}
+	return byEvent, nil
+}
+
+// IncrementClicks adds one to the click count of a short URL
+func (r *shortURLRepository) IncrementClicks(ctx context.Context, code string) error {
+	err := r.db.WithContext(ctx).Model(&shorturl.ShortURL{}).
+		Where("code = ?", code).
+		UpdateColumn("clicks", gorm.Expr("clicks + 1")).Error
+	if err != nil {
+		return shorturl.NewShortURLError(shorturl.ErrClickRecordingFailed, err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"enterprise-crud/internal/domain/staff"
+	"enterprise-crud/internal/domain/user"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// staffRepository implements the staff.Repository interface
+type staffRepository struct {
+	db *gorm.DB
+}
+
+// NewStaffRepository creates a new event staff repository instance
+func NewStaffRepository(db *gorm.DB) staff.Repository {
+	return &staffRepository{db: db}
+}
+
+// Create stores a new assignment, reporting a duplicate when the user already has one
+func (r *staffRepository) Create(ctx context.Context, assignment *staff.Assignment) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(assignment)
+	if result.Error != nil {
+		return staff.NewStaffError(staff.ErrStaffSaveFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return staff.ErrAlreadyAssigned
+	}
+	return nil
+}
+
+// GetByID retrieves an assignment by its ID
+func (r *staffRepository) GetByID(ctx context.Context, id uuid.UUID) (*staff.Assignment, error) {
+	var assignment staff.Assignment
+	if err := r.db.WithContext(ctx).First(&assignment, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, staff.ErrAssignmentNotFound
+		}
+		return nil, staff.NewStaffError(staff.ErrStaffRetrievalFailed, err)
+	}
+	return &assignment, nil
+}
+
+// Get retrieves a user's assignment on an event, or nil if they have none
+func (r *staffRepository) Get(ctx context.Context, eventID, userID uuid.UUID) (*staff.Assignment, error) {
+	var assignment staff.Assignment
+	err := r.db.WithContext(ctx).
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		First(&assignment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, staff.NewStaffError(staff.ErrStaffRetrievalFailed, err)
+	}
+	return &assignment, nil
+}
+
+// GetByEvent retrieves the staff of an event with their email and username
+func (r *staffRepository) GetByEvent(ctx context.Context, eventID uuid.UUID) ([]*staff.Assignment, error) {
+	var assignments []*staff.Assignment
+	err := r.db.WithContext(ctx).
+		Select("event_staff.*, users.email, users.username").
+		Joins("JOIN users ON users.id = event_staff.user_id").
+		Where("event_staff.event_id = ?", eventID).
+		Order("event_staff.created_at").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, staff.NewStaffError(staff.ErrStaffRetrievalFailed, err)
+	}
+	return assignments, nil
+}
+
+// GetPendingByUser retrieves the invitations a user has not accepted yet
+func (r *staffRepository) GetPendingByUser(ctx context.Context, userID uuid.UUID) ([]*staff.Assignment, error) {
+	var assignments []*staff.Assignment
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, staff.StatusPending).
+		Order("created_at").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, staff.NewStaffError(staff.ErrStaffRetrievalFailed, err)
+	}
+	return assignments, nil
+}
+
+// Accept marks a pending assignment as accepted
+func (r *staffRepository) Accept(ctx context.Context, id uuid.UUID, at time.Time) error {
+	err := r.db.WithContext(ctx).Model(&staff.Assignment{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"status": staff.StatusAccepted, "accepted_at": at, "updated_at": at}).Error
+	if err != nil {
+		return staff.NewStaffError(staff.ErrStaffSaveFailed, err)
+	}
+	return nil
+}
+
+// Delete removes a user's assignment on an event
+func (r *staffRepository) Delete(ctx context.Context, eventID, userID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		Delete(&staff.Assignment{})
+	if result.Error != nil {
+		return staff.NewStaffError(staff.ErrStaffSaveFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return staff.ErrAssignmentNotFound
+	}
+	return nil
+}
+
+// IsCoOrganizer reports whether the user accepted a co-organizer role on the event
+func (r *staffRepository) IsCoOrganizer(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&staff.Assignment{}).
+		Where("event_id = ? AND user_id = ? AND role = ? AND status = ?", eventID, userID, staff.RoleCoOrganizer, staff.StatusAccepted).
+		Count(&count).Error
+	if err != nil {
+		return false, staff.NewStaffError(staff.ErrStaffRetrievalFailed, err)
+	}
+	return count > 0, nil
+}
+
+// FindUserIDByEmail resolves the user invited by email, ignoring case
+func (r *staffRepository) FindUserIDByEmail(ctx context.Context, email string) (uuid.UUID, error) {
+	var u user.User
+	if err := r.db.WithContext(ctx).Select("id").Where("LOWER(email) = LOWER(?)", email).First(&u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, staff.ErrUserNotFound
+		}
+		return uuid.Nil, staff.NewStaffError(staff.ErrStaffRetrievalFailed, err)
+	}
+	return u.ID, nil
+}
@@ -0,0 +1,203 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/domain/staff"
+	"enterprise-crud/internal/domain/transfer"
+	"enterprise-crud/internal/domain/user"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// transferRepository implements the transfer.Repository interface
+type transferRepository struct {
+	db *gorm.DB
+}
+
+// NewTransferRepository creates a new event transfer repository instance
+func NewTransferRepository(db *gorm.DB) transfer.Repository {
+	return &transferRepository{db: db}
+}
+
+// errTransferPending rolls back the request of a transfer for an event that has one pending
+var errTransferPending = errors.New("event already has a pending transfer")
+
+// Create stores a new transfer with its audit entry, reporting a conflict when the event
+// already has a pending transfer
+func (r *transferRepository) Create(ctx context.Context, t *transfer.Transfer, entry *transfer.AuditEntry) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(t)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errTransferPending
+		}
+		return tx.Create(entry).Error
+	})
+	if errors.Is(err, errTransferPending) {
+		return transfer.ErrTransferPending
+	}
+	if err != nil {
+		return transfer.NewTransferError(transfer.ErrTransferSaveFailed, err)
+	}
+	return nil
+}
+
+// GetByID retrieves a transfer by its ID with the event title
+func (r *transferRepository) GetByID(ctx context.Context, id uuid.UUID) (*transfer.Transfer, error) {
+	var t transfer.Transfer
+	err := r.withEventTitle(ctx).
+		Where("event_transfers.id = ?", id).
+		First(&t).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, transfer.ErrTransferNotFound
+		}
+		return nil, transfer.NewTransferError(transfer.ErrTransferRetrievalFailed, err)
+	}
+	return &t, nil
+}
+
+// ListByEvent retrieves the transfers of an event, newest first
+func (r *transferRepository) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]*transfer.Transfer, error) {
+	var transfers []*transfer.Transfer
+	err := r.withEventTitle(ctx).
+		Where("event_transfers.event_id = ?", eventID).
+		Order("event_transfers.created_at DESC, event_transfers.id").
+		Find(&transfers).Error
+	if err != nil {
+		return nil, transfer.NewTransferError(transfer.ErrTransferRetrievalFailed, err)
+	}
+	return transfers, nil
+}
+
+// ListPendingForUser retrieves the transfers waiting for a user to accept, oldest first
+func (r *transferRepository) ListPendingForUser(ctx context.Context, userID uuid.UUID) ([]*transfer.Transfer, error) {
+	var transfers []*transfer.Transfer
+	err := r.withEventTitle(ctx).
+		Where("event_transfers.to_organizer_id = ? AND event_transfers.status = ?", userID, transfer.StatusPending).
+		Order("event_transfers.created_at, event_transfers.id").
+		Find(&transfers).Error
+	if err != nil {
+		return nil, transfer.NewTransferError(transfer.ErrTransferRetrievalFailed, err)
+	}
+	return transfers, nil
+}
+
+// Accept saves the accepted transfer and hands the event to its new organizer in one transaction
+// The event only changes hands while it still belongs to the transfer's old organizer, and the
+// new organizer's staff role on it is dropped since they now own the event.
+func (r *transferRepository) Accept(ctx context.Context, t *transfer.Transfer, entry *transfer.AuditEntry) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := r.closePending(tx, t, entry, now); err != nil {
+			return err
+		}
+
+		result := tx.Model(&event.Event{}).
+			Where("id = ? AND organizer_id = ?", t.EventID, t.FromOrganizerID).
+			Updates(map[string]interface{}{"organizer_id": t.ToOrganizerID, "updated_at": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return transfer.ErrOrganizerChanged
+		}
+
+		return tx.Where("event_id = ? AND user_id = ?", t.EventID, t.ToOrganizerID).
+			Delete(&staff.Assignment{}).Error
+	})
+	if err != nil {
+		return transferSaveError(err)
+	}
+	t.UpdatedAt = now
+	return nil
+}
+
+// Close saves the declined or cancelled status of a pending transfer with its audit entry
+func (r *transferRepository) Close(ctx context.Context, t *transfer.Transfer, entry *transfer.AuditEntry) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return r.closePending(tx, t, entry, now)
+	})
+	if err != nil {
+		return transferSaveError(err)
+	}
+	t.UpdatedAt = now
+	return nil
+}
+
+// ListAudit retrieves the audit entries of a transfer, oldest first
+func (r *transferRepository) ListAudit(ctx context.Context, transferID uuid.UUID) ([]*transfer.AuditEntry, error) {
+	var entries []*transfer.AuditEntry
+	err := r.db.WithContext(ctx).
+		Where("transfer_id = ?", transferID).
+		Order("created_at, id").
+		Find(&entries).Error
+	if err != nil {
+		return nil, transfer.NewTransferError(transfer.ErrTransferRetrievalFailed, err)
+	}
+	return entries, nil
+}
+
+// FindOrganizerByEmail resolves an active user with the ORGANIZER role by email, ignoring case
+func (r *transferRepository) FindOrganizerByEmail(ctx context.Context, email string) (uuid.UUID, error) {
+	var u user.User
+	err := r.db.WithContext(ctx).
+		Select("users.id").
+		Joins("JOIN user_roles ON user_roles.user_id = users.id").
+		Joins("JOIN roles ON roles.id = user_roles.role_id").
+		Where("LOWER(users.email) = LOWER(?) AND users.status = ? AND roles.name = ?", email, user.StatusActive, role.RoleOrganizer).
+		First(&u).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, transfer.ErrOrganizerNotFound
+		}
+		return uuid.Nil, transfer.NewTransferError(transfer.ErrTransferRetrievalFailed, err)
+	}
+	return u.ID, nil
+}
+
+// withEventTitle starts a transfer query that fills in the event title, archived events included
+func (r *transferRepository) withEventTitle(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Model(&transfer.Transfer{}).
+		Select("event_transfers.*, COALESCE(events.title, archived_events.title) AS event_title").
+		Joins("LEFT JOIN events ON events.id = event_transfers.event_id").
+		Joins("LEFT JOIN archived_events ON archived_events.id = event_transfers.event_id")
+}
+
+// closePending moves a pending transfer to its new status and records the audit entry
+func (r *transferRepository) closePending(tx *gorm.DB, t *transfer.Transfer, entry *transfer.AuditEntry, now time.Time) error {
+	result := tx.Model(&transfer.Transfer{}).
+		Where("id = ? AND status = ?", t.ID, transfer.StatusPending).
+		Updates(map[string]interface{}{
+			"status":       t.Status,
+			"responded_at": t.RespondedAt,
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return transfer.ErrNotPending
+	}
+	return tx.Create(entry).Error
+}
+
+// transferSaveError keeps the transfer domain errors a transaction returned and wraps the rest
+func transferSaveError(err error) error {
+	var transferErr *transfer.TransferError
+	if errors.As(err, &transferErr) {
+		return transferErr
+	}
+	return transfer.NewTransferError(transfer.ErrTransferSaveFailed, err)
+}
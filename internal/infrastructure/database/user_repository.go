@@ -2,11 +2,24 @@ package database
 
 import (
 	"context"
+	"encoding/json"
+	"enterprise-crud/internal/domain/role"
 	"enterprise-crud/internal/domain/user"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
 )
 
+// Unique constraints on the users table, as named by PostgreSQL
+const (
+	usersEmailKey    = "users_email_lower_key"
+	usersUsernameKey = "users_username_key"
+)
+
 // userRepository implements the user.Repository interface
 // Handles database operations for user entities
 type userRepository struct {
@@ -34,13 +47,29 @@ func NewUserRepository(db *gorm.DB) user.Repository {
 // - Database connection errors, validation errors, etc.
 //
 // Returns error if user creation fails or constraints are violated
-func (r *userRepository) Create(ctx context.Context, user *user.User) error {
+// A taken email or username is reported as the matching user domain error
+func (r *userRepository) Create(ctx context.Context, u *user.User) error {
 	// WithContext(ctx) ensures this DB operation:
 	// 1. Can be cancelled if the HTTP request is cancelled
 	// 2. Will timeout if the context has a deadline
 	// 3. Allows distributed tracing across services
 	// 4. Prevents resource leaks and hanging connections
-	return r.db.WithContext(ctx).Create(user).Error
+	// The user and its role links are inserted together, so the transaction is explicit
+	// rather than left to GORM's default transaction, which may be disabled.
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Create(u).Error
+	})
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		switch pgErr.ConstraintName {
+		case usersEmailKey:
+			return user.NewUserExistsError(u.Email)
+		case usersUsernameKey:
+			return user.NewUsernameExistsError(u.Username)
+		}
+	}
+	return err
 }
 
 // GetByEmail retrieves a user by their email address WITH their roles
@@ -51,29 +80,153 @@ func (r *userRepository) Create(ctx context.Context, user *user.User) error {
 // - Essential for long-running queries or network delays
 //
 // SQL QUERY EXPLANATION:
-// - Preload("Roles"): Eagerly loads the user's roles (JOIN with user_roles and roles tables)
-// - Where("email = ?", email): Parameterized query prevents SQL injection
-// - First(&u): Retrieves the first matching record
+// - firstWithRoles: Loads the user and their roles in one query (LEFT JOIN user_roles and roles)
+// - "LOWER(email) = LOWER(?)": Case-insensitive, parameterized query prevents SQL injection
 // - .Error: Returns error if no record found or database error
 //
-// GORM BEHAVIOR:
-// - First() returns ErrRecordNotFound if no user exists
-// - Preload() automatically handles the many-to-many relationship
-// - The ? placeholder is safely replaced with the email parameter
+// It runs on every login, so it doesn't Preload the roles: that would take two more
+// round trips (user_roles, then roles) per call.
 //
 // Returns user with roles if found, nil and error if not found or database error occurs
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*user.User, error) {
+	// Returns ErrRecordNotFound if no user matches users_email_lower_key
+	return r.firstWithRoles(ctx, "LOWER(users.email) = LOWER(?)", email)
+}
+
+// GetByUsername retrieves a user by their username WITH their roles
+// Returns ErrRecordNotFound if no user has the username
+func (r *userRepository) GetByUsername(ctx context.Context, username string) (*user.User, error) {
+	return r.firstWithRoles(ctx, "users.username = ?", username)
+}
+
+// userWithRoles is a users row with the user's roles aggregated into JSON by the same query
+type userWithRoles struct {
+	user.User
+	RolesJSON []byte `gorm:"column:roles_json"`
+}
+
+// rolesJSON aggregates the joined roles; timestamps are rendered with their zone so they decode into time.Time
+const rolesJSON = `COALESCE(json_agg(json_build_object(
+	'id', roles.id,
+	'name', roles.name,
+	'description', roles.description,
+	'created_at', roles.created_at AT TIME ZONE 'UTC',
+	'updated_at', roles.updated_at AT TIME ZONE 'UTC'
+) ORDER BY roles.name) FILTER (WHERE roles.id IS NOT NULL), '[]') AS roles_json`
+
+// firstWithRoles retrieves the user matching query together with their roles in a single query
+// Returns ErrRecordNotFound if no user matches
+func (r *userRepository) firstWithRoles(ctx context.Context, query string, args ...interface{}) (*user.User, error) {
+	var row userWithRoles
+	err := r.db.WithContext(ctx).
+		Table("users").
+		Select("users.*, "+rolesJSON).
+		Joins("LEFT JOIN user_roles ON user_roles.user_id = users.id").
+		Joins("LEFT JOIN roles ON roles.id = user_roles.role_id").
+		Where(query, args...).
+		Group("users.id").
+		Take(&row).Error
+	if err != nil {
+		return nil, err
+	}
+
+	u := row.User
+	u.Roles = []role.Role{}
+	if len(row.RolesJSON) > 0 {
+		if err := json.Unmarshal(row.RolesJSON, &u.Roles); err != nil {
+			return nil, fmt.Errorf("failed to decode roles of user %s: %w", u.ID, err)
+		}
+	}
+	return &u, nil
+}
+
+// AddRole grants a role to a user by inserting a user_roles row
+func (r *userRepository) AddRole(ctx context.Context, userID uuid.UUID, roleEntity *role.Role) error {
+	return r.db.WithContext(ctx).Model(&user.User{ID: userID}).Association("Roles").Append(roleEntity)
+}
+
+// RemoveRole revokes a role from a user by deleting its user_roles row
+func (r *userRepository) RemoveRole(ctx context.Context, userID uuid.UUID, roleEntity *role.Role) error {
+	return r.db.WithContext(ctx).Model(&user.User{ID: userID}).Association("Roles").Delete(roleEntity)
+}
+
+// GetByID retrieves a user by ID with their roles
+func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return r.firstWithRoles(ctx, "users.id = ?", id)
+}
+
+// GetStatus retrieves only a user's ID and status fields
+// It runs on every authenticated request, so it skips the roles and reads the primary key only.
+func (r *userRepository) GetStatus(ctx context.Context, id uuid.UUID) (*user.User, error) {
 	var u user.User
+	err := r.db.WithContext(ctx).
+		Select("id", "status", "status_reason", "status_changed_at").
+		First(&u, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// UpdateStatus sets a user's status and records the change in one transaction
+func (r *userRepository) UpdateStatus(ctx context.Context, change *user.StatusChange) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&user.User{}).Where("id = ?", change.UserID).Updates(map[string]interface{}{
+			"status":            change.Status,
+			"status_reason":     change.Reason,
+			"status_changed_at": change.CreatedAt,
+			"updated_at":        change.CreatedAt,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Create(change).Error
+	})
+}
 
-	// WithContext(ctx) + Preload() + Where() + First() sequence:
-	// 1. WithContext(ctx): Enables cancellation and tracing
-	// 2. Preload("Roles"): Eagerly load the user's roles from the junction table
-	// 3. Where("email = ?", email): Adds WHERE clause (SQL injection safe)
-	// 4. First(&u): Executes SELECT query and scans result into u with roles
-	// 5. .Error: Returns the error (nil if successful, ErrRecordNotFound if no match)
-	err := r.db.WithContext(ctx).Preload("Roles").Where("email = ?", email).First(&u).Error
+// ListStatusChanges retrieves a user's status changes, newest first
+func (r *userRepository) ListStatusChanges(ctx context.Context, userID uuid.UUID) ([]*user.StatusChange, error) {
+	var changes []*user.StatusChange
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&changes).Error
 	if err != nil {
-		return nil, err // Return nil user and the error (could be ErrRecordNotFound)
+		return nil, err
+	}
+	return changes, nil
+}
+
+// SetDateOfBirth sets or, with nil, removes a user's date of birth
+func (r *userRepository) SetDateOfBirth(ctx context.Context, id uuid.UUID, dateOfBirth *time.Time) error {
+	result := r.db.WithContext(ctx).Model(&user.User{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"date_of_birth": dateOfBirth,
+		"updated_at":    time.Now(),
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdatePassword replaces a user's password hash and the algorithm it was made with
+func (r *userRepository) UpdatePassword(ctx context.Context, id uuid.UUID, hash, algorithm string) error {
+	result := r.db.WithContext(ctx).Model(&user.User{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"password":           hash,
+		"password_algorithm": algorithm,
+		"updated_at":         time.Now(),
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
 	}
-	return &u, nil // Return pointer to user with roles loaded and nil error
+	return nil
 }
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/user"
+
+	"github.com/stretchr/testify/require"
+)
+
+// BenchmarkGetUserByEmail compares the login lookup preloading the roles with the single joined query
+// Run it like the benchmarks in connection_bench_test.go; -cpu sets the number of concurrent logins.
+func BenchmarkGetUserByEmail(b *testing.B) {
+	db := benchConnection(b, config.DatabaseConfig{LogLevel: "silent", PrepareStmt: true, SkipDefaultTransaction: true})
+	organizerID, _ := benchEvent(b, db)
+	require.NoError(b, db.Exec(
+		"INSERT INTO user_roles (user_id, role_id) SELECT ?, id FROM roles", organizerID).Error)
+	var email string
+	require.NoError(b, db.Raw("SELECT email FROM users WHERE id = ?", organizerID).Scan(&email).Error)
+
+	lookups := map[string]func(ctx context.Context) error{
+		"preload": func(ctx context.Context) error {
+			var u user.User
+			return db.WithContext(ctx).Preload("Roles").Where("LOWER(email) = LOWER(?)", email).First(&u).Error
+		},
+		"join": func(ctx context.Context) error {
+			_, err := NewUserRepository(db).GetByEmail(ctx, email)
+			return err
+		},
+	}
+	for _, name := range []string{"preload", "join"} {
+		lookup := lookups[name]
+		b.Run(name, func(b *testing.B) {
+			b.RunParallel(func(pb *testing.PB) {
+				ctx := context.Background()
+				for pb.Next() {
+					if err := lookup(ctx); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
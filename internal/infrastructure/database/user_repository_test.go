@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"testing"
 
 	"enterprise-crud/internal/domain/user"
@@ -62,3 +63,19 @@ func TestNewUserRepository(t *testing.T) {
 	// Verify it implements the user.Repository interface
 	var _ user.Repository = repo
 }
+
+func TestUserRepository_GetByEmail_LoadsRolesInOneQuery(t *testing.T) {
+	db := newDryRunDB(t)
+	queries := capturedQueries(t, db)
+	repo := NewUserRepository(db)
+
+	u, err := repo.GetByEmail(context.Background(), "Alice@Example.com")
+	require.NoError(t, err)
+	assert.Empty(t, u.Roles)
+
+	require.Len(t, *queries, 1)
+	assert.Contains(t, (*queries)[0], "LEFT JOIN user_roles ON user_roles.user_id = users.id")
+	assert.Contains(t, (*queries)[0], "LEFT JOIN roles ON roles.id = user_roles.role_id")
+	assert.Contains(t, (*queries)[0], "LOWER(users.email) = LOWER($1)")
+	assert.Contains(t, (*queries)[0], "GROUP BY")
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"enterprise-crud/internal/domain/webhook"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// webhookRepository implements the webhook.Repository interface
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository creates a new webhook subscription repository instance
+func NewWebhookRepository(db *gorm.DB) webhook.Repository {
+	return &webhookRepository{db: db}
+}
+
+// Create stores a new subscription
+func (r *webhookRepository) Create(ctx context.Context, s *webhook.Subscription) error {
+	if err := r.db.WithContext(ctx).Create(s).Error; err != nil {
+		return webhook.NewWebhookError(webhook.ErrSubscriptionSaveFailed, err)
+	}
+	return nil
+}
+
+// GetByID retrieves a subscription by its ID
+func (r *webhookRepository) GetByID(ctx context.Context, id uuid.UUID) (*webhook.Subscription, error) {
+	var s webhook.Subscription
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&s).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, webhook.ErrSubscriptionNotFound
+	}
+	if err != nil {
+		return nil, webhook.NewWebhookError(webhook.ErrSubscriptionRetrievalFailed, err)
+	}
+	return &s, nil
+}
+
+// ListByUser retrieves a user's subscriptions, oldest first
+func (r *webhookRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*webhook.Subscription, error) {
+	var subs []*webhook.Subscription
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at, id").
+		Find(&subs).Error
+	if err != nil {
+		return nil, webhook.NewWebhookError(webhook.ErrSubscriptionRetrievalFailed, err)
+	}
+	return subs, nil
+}
+
+// Delete removes a user's subscription
+func (r *webhookRepository) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).Delete(&webhook.Subscription{})
+	if result.Error != nil {
+		return webhook.NewWebhookError(webhook.ErrSubscriptionSaveFailed, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return webhook.ErrSubscriptionNotFound
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Package dedupe remembers recent requests so accidental double-submits can be rejected
+// A request claims its key for a short window; an identical request arriving within the
+// window finds the key taken. It complements order idempotency keys on endpoints that have none.
+package dedupe
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/infrastructure/cache"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix namespaces claims in Redis; it must not start with "event", those keys are flushed on event cache invalidation
+const keyPrefix = "dedupe:"
+
+// Store records which request keys are claimed
+type Store interface {
+	// Claim takes key for window and reports whether it was free; false means an identical request holds it
+	Claim(ctx context.Context, key string, window time.Duration) (bool, error)
+
+	// Release frees a claimed key before its window ends
+	Release(ctx context.Context, key string) error
+}
+
+// RedisStore keeps claims in Redis, so a double-submit is caught whichever instance it reaches
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore creates a claim store on the given Redis client
+func NewRedisStore(redisClient *cache.RedisClient) *RedisStore {
+	return &RedisStore{client: redisClient.GetClient()}
+}
+
+// Claim takes key for window unless it is already taken
+func (s *RedisStore) Claim(ctx context.Context, key string, window time.Duration) (bool, error) {
+	return s.client.SetNX(ctx, keyPrefix+key, 1, window).Result()
+}
+
+// Release frees a claimed key
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	return s.client.Del(ctx, keyPrefix+key).Err()
+}
+
+// MemoryStore keeps claims in process memory, for single instances without Redis
+type MemoryStore struct {
+	mu     sync.Mutex
+	claims map[string]time.Time // Key to the end of its window
+	now    func() time.Time
+}
+
+// memorySweepSize is how many claims are kept before expired ones are swept
+const memorySweepSize = 10000
+
+// NewMemoryStore creates an empty in-memory claim store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{claims: make(map[string]time.Time), now: time.Now}
+}
+
+// Claim takes key for window unless it is already taken
+func (s *MemoryStore) Claim(ctx context.Context, key string, window time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if len(s.claims) >= memorySweepSize {
+		for k, expires := range s.claims {
+			if !now.Before(expires) {
+				delete(s.claims, k)
+			}
+		}
+	}
+
+	if expires, ok := s.claims[key]; ok && now.Before(expires) {
+		return false, nil
+	}
+	s.claims[key] = now.Add(window)
+	return true, nil
+}
+
+// Release frees a claimed key
+func (s *MemoryStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.claims, key)
+	return nil
+}
@@ -0,0 +1,31 @@
+package dedupe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore_Claim(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	claimed, _ := store.Claim(ctx, "k", time.Minute)
+	assert.True(t, claimed)
+	claimed, _ = store.Claim(ctx, "k", time.Minute)
+	assert.False(t, claimed, "the key is held for the window")
+	claimed, _ = store.Claim(ctx, "other", time.Minute)
+	assert.True(t, claimed)
+
+	now = now.Add(time.Minute)
+	claimed, _ = store.Claim(ctx, "k", time.Minute)
+	assert.True(t, claimed, "the key is free once the window ends")
+
+	assert.NoError(t, store.Release(ctx, "k"))
+	claimed, _ = store.Claim(ctx, "k", time.Minute)
+	assert.True(t, claimed, "released keys can be claimed again")
+}
@@ -0,0 +1,81 @@
+// Package diagnostics captures runtime profiles for troubleshooting a live instance
+// and serves the diagnostics endpoints on an internal address.
+package diagnostics
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"time"
+)
+
+// DefaultProfiles are captured when a dump names no profiles
+var DefaultProfiles = []string{"goroutine", "heap"}
+
+// ErrUnknownProfile is returned for a profile the runtime doesn't record
+var ErrUnknownProfile = errors.New("unknown profile")
+
+// Dump is a profile written to disk
+type Dump struct {
+	Profile string
+	Path    string
+	Bytes   int64
+}
+
+// Capture writes the named profiles (DefaultProfiles when none are named) to dir, one file
+// per profile stamped with now. Goroutine dumps hold every stack as text, so they can be read
+// without tooling; the others are in pprof format for `go tool pprof`.
+func Capture(dir string, now time.Time, profiles ...string) ([]Dump, error) {
+	if len(profiles) == 0 {
+		profiles = DefaultProfiles
+	}
+	for _, name := range profiles {
+		if pprof.Lookup(name) == nil {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownProfile, name)
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create dump directory: %w", err)
+	}
+
+	stamp := now.UTC().Format("20060102T150405.000Z")
+	dumps := make([]Dump, 0, len(profiles))
+	for _, name := range profiles {
+		dump, err := capture(dir, stamp, name)
+		if err != nil {
+			return dumps, err
+		}
+		dumps = append(dumps, dump)
+	}
+	return dumps, nil
+}
+
+func capture(dir, stamp, name string) (Dump, error) {
+	debug, ext := 0, "pb.gz"
+	if name == "goroutine" {
+		debug, ext = 2, "txt"
+	}
+	if name == "heap" {
+		// Bring the heap profile up to date with the last allocations
+		runtime.GC()
+	}
+
+	// The random part keeps dumps taken within the same millisecond apart
+	file, err := os.CreateTemp(dir, fmt.Sprintf("%s-%s-*.%s", name, stamp, ext))
+	if err != nil {
+		return Dump{}, fmt.Errorf("failed to create %s dump: %w", name, err)
+	}
+	defer file.Close()
+
+	if err := pprof.Lookup(name).WriteTo(file, debug); err != nil {
+		return Dump{}, fmt.Errorf("failed to write %s dump: %w", name, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return Dump{}, fmt.Errorf("failed to write %s dump: %w", name, err)
+	}
+	return Dump{Profile: name, Path: file.Name(), Bytes: info.Size()}, nil
+}
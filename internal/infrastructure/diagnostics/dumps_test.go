@@ -0,0 +1,47 @@
+package diagnostics
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapture(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 30, 0, 0, time.UTC)
+
+	t.Run("captures goroutines and heap by default", func(t *testing.T) {
+		dir := t.TempDir()
+
+		dumps, err := Capture(dir, now)
+		require.NoError(t, err)
+		require.Len(t, dumps, 2)
+
+		assert.Equal(t, "goroutine", dumps[0].Profile)
+		assert.True(t, strings.HasPrefix(filepath.Base(dumps[0].Path), "goroutine-20261017T093000.000Z-"))
+		assert.True(t, strings.HasSuffix(dumps[0].Path, ".txt"))
+		stacks, err := os.ReadFile(dumps[0].Path)
+		require.NoError(t, err)
+		assert.Contains(t, string(stacks), "TestCapture")
+		assert.Equal(t, int64(len(stacks)), dumps[0].Bytes)
+
+		assert.Equal(t, "heap", dumps[1].Profile)
+		assert.True(t, strings.HasSuffix(dumps[1].Path, ".pb.gz"))
+		assert.Positive(t, dumps[1].Bytes)
+	})
+
+	t.Run("unknown profile writes nothing", func(t *testing.T) {
+		dir := t.TempDir()
+
+		_, err := Capture(dir, now, "heap", "bogus")
+		assert.ErrorIs(t, err, ErrUnknownProfile)
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
@@ -0,0 +1,48 @@
+package diagnostics
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+)
+
+// shutdownTimeout bounds how long Stop waits for running requests, such as a CPU profile
+const shutdownTimeout = 5 * time.Second
+
+// Server serves the diagnostics endpoints on their own address
+// It has no write timeout, so CPU profiles and traces may run as long as they are asked to.
+type Server struct {
+	server *http.Server
+}
+
+// NewServer creates a server serving handler on addr
+func NewServer(addr string, handler http.Handler) *Server {
+	return &Server{server: &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}}
+}
+
+// Start begins serving in the background
+func (s *Server) Start() {
+	go func() {
+		log.Printf("Diagnostics server starting on %s", s.server.Addr)
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("Warning: Diagnostics server failed: %v", err)
+		}
+	}()
+}
+
+// Stop shuts the server down, waiting briefly for running requests
+func (s *Server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := s.server.Shutdown(ctx); err != nil {
+		log.Printf("Warning: Diagnostics server forced to shut down: %v", err)
+	}
+	log.Println("Diagnostics server stopped")
+}
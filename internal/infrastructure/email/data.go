@@ -0,0 +1,52 @@
+package email
+
+import "time"
+
+// Recipient is who an email is addressed to
+type Recipient struct {
+	Email    string
+	Username string
+}
+
+// OrderConfirmation is the data of the order_confirmation email
+type OrderConfirmation struct {
+	Recipient  Recipient
+	OrderID    string
+	EventTitle string
+	EventDate  time.Time
+	Quantity   int
+	Total      float64
+}
+
+// Event update changes listed by the event_update email
+const (
+	ChangeTitle = "title"
+	ChangeDate  = "event_date"
+	ChangeVenue = "venue"
+)
+
+// EventUpdate is the data of the event_update email, sent to ticket holders when an event changes
+type EventUpdate struct {
+	Recipient  Recipient
+	EventTitle string // Current title
+	EventDate  time.Time
+	Cancelled  bool
+	Changes    []string // ChangeTitle, ChangeDate or ChangeVenue; unused when cancelled
+}
+
+// PasswordReset is the data of the password_reset email
+type PasswordReset struct {
+	Recipient Recipient
+	ResetURL  string
+	ExpiresAt time.Time
+}
+
+// GiftClaim is the data of the gift_claim email, sent to the recipient of a paid gift
+type GiftClaim struct {
+	Recipient  Recipient // Username is the email's local part, as the recipient may have no account yet
+	GiverName  string
+	EventTitle string
+	EventDate  time.Time
+	Quantity   int
+	ClaimURL   string
+}
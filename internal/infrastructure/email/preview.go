@@ -0,0 +1,74 @@
+package email
+
+import (
+	"time"
+
+	"enterprise-crud/internal/domain/report"
+)
+
+// previewRecipient receives every preview
+var previewRecipient = Recipient{Email: "fan@example.com", Username: "fan"}
+
+// Sample returns made-up data for the named template, for previewing it
+func Sample(name string, now time.Time) (interface{}, bool) {
+	eventDate := now.Add(10 * 24 * time.Hour).Truncate(time.Hour)
+
+	switch name {
+	case "notification":
+		return struct {
+			Recipient Recipient
+			Title     string
+			Body      string
+		}{
+			Recipient: previewRecipient,
+			Title:     "Tickets available: Jazz Night",
+			Body:      "Good news: 2 tickets for Jazz Night became available and are being held for you.",
+		}, true
+	case "order_confirmation":
+		return OrderConfirmation{
+			Recipient:  previewRecipient,
+			OrderID:    "3f0c9a3e-6b2d-4c1e-9d8f-2a7b5e1c4d90",
+			EventTitle: "Jazz Night",
+			EventDate:  eventDate,
+			Quantity:   2,
+			Total:      90,
+		}, true
+	case "event_update":
+		return EventUpdate{
+			Recipient:  previewRecipient,
+			EventTitle: "Jazz Night",
+			EventDate:  eventDate,
+			Changes:    []string{ChangeDate, ChangeVenue},
+		}, true
+	case "gift_claim":
+		return GiftClaim{
+			Recipient:  previewRecipient,
+			GiverName:  "alex",
+			EventTitle: "Jazz Night",
+			EventDate:  eventDate,
+			Quantity:   2,
+			ClaimURL:   "https://example.com/gifts/claim?token=preview",
+		}, true
+	case "password_reset":
+		return PasswordReset{
+			Recipient: previewRecipient,
+			ResetURL:  "https://example.com/reset-password?token=preview",
+			ExpiresAt: now.Add(time.Hour),
+		}, true
+	case "sales_summary":
+		to := now.UTC().Truncate(24 * time.Hour)
+		return &report.SalesReport{
+			Recipient: &report.Recipient{Email: previewRecipient.Email, Username: "organizer"},
+			Frequency: report.FrequencyWeekly,
+			Summary: &report.SalesSummary{
+				From:         to.AddDate(0, 0, -7),
+				To:           to,
+				Events:       []report.EventSales{{Title: "Jazz Night", Orders: 4, TicketsSold: 9, Revenue: 450}},
+				TotalOrders:  4,
+				TotalTickets: 9,
+				TotalRevenue: 450,
+			},
+		}, true
+	}
+	return nil, false
+}
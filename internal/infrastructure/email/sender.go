@@ -0,0 +1,39 @@
+// Package email renders templated emails and delivers them over SMTP
+package email
+
+import (
+	"context"
+	"log"
+
+	"enterprise-crud/internal/config"
+)
+
+// Message is a rendered email ready to send
+type Message struct {
+	To      string
+	Subject string
+	Text    string // Plain-text body
+	HTML    string // HTML body, optional
+}
+
+// Sender delivers email messages
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewSender creates an SMTP sender, or a sender that only logs messages when no SMTP host is configured
+func NewSender(cfg *config.EmailConfig) Sender {
+	if cfg.SMTPHost == "" {
+		log.Println("Email delivery disabled: no SMTP host configured, messages will be logged")
+		return logSender{}
+	}
+	return NewSMTPSender(cfg)
+}
+
+// logSender logs messages instead of sending them, for development
+type logSender struct{}
+
+func (logSender) Send(ctx context.Context, msg *Message) error {
+	log.Printf("Email to %s not sent (no SMTP host configured): %s", msg.To, msg.Subject)
+	return nil
+}
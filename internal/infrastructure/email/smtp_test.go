@@ -0,0 +1,29 @@
+package email
+
+import (
+	"mime"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMIME(t *testing.T) {
+	from := &mail.Address{Name: "Enterprise CRUD", Address: "no-reply@example.com"}
+	to := &mail.Address{Address: "organizer@example.com"}
+
+	body, err := buildMIME(from, to, &Message{Subject: "Sales — weekly", Text: "plain", HTML: "<p>html</p>"})
+	require.NoError(t, err)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(body)))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Sales — weekly", subject)
+	assert.Contains(t, parsed.Header.Get("Content-Type"), "multipart/alternative")
+	assert.Contains(t, string(body), "plain")
+	assert.Contains(t, string(body), "<p>html</p>")
+}
@@ -0,0 +1,258 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// Templates are laid out as:
+//
+//	templates/layouts/base.{html,txt}.tmpl   the "layout" template wrapping every email
+//	templates/partials/*.{html,txt}.tmpl     shared templates such as "event" and "button"
+//	templates/emails/<name>.subject.tmpl     the subject line
+//	templates/emails/<name>.{html,txt}.tmpl  the "content" and "footer" templates of one email
+//	templates/locales/<locale>.json          the strings of one language, keyed for the t function
+//
+//go:embed templates
+var templateFS embed.FS
+
+// DefaultLocale is used when no locale is asked for, and for strings a locale lacks
+const DefaultLocale = "en"
+
+// ErrUnknownTemplate is returned when rendering an email that has no templates
+var ErrUnknownTemplate = errors.New("unknown email template")
+
+// templateFuncs are available in every email template
+// t, format, datetime and locale are bound to the locale of each render.
+var templateFuncs = map[string]interface{}{
+	"money": func(amount float64) string { return fmt.Sprintf("%.2f", amount) },
+	"lower": strings.ToLower,
+	"dict":  dict,
+
+	"t":        func(key string, args ...interface{}) string { return key },
+	"format":   func(key string, t time.Time) string { return key },
+	"datetime": func(t time.Time) string { return t.String() },
+	"locale":   func() string { return DefaultLocale },
+}
+
+// emailTemplates are the parsed templates of one email, never executed themselves
+// Every render works on clones so the locale functions can be bound per message.
+type emailTemplates struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+// Renderer renders the embedded email templates in the language of the recipient
+type Renderer struct {
+	emails        map[string]*emailTemplates
+	catalogs      map[string]map[string]string
+	defaultLocale string
+}
+
+// NewRenderer parses the embedded templates and strings; emails are rendered in defaultLocale
+// unless another is asked for (empty means DefaultLocale)
+func NewRenderer(defaultLocale string) (*Renderer, error) {
+	catalogs, err := loadCatalogs()
+	if err != nil {
+		return nil, err
+	}
+	if defaultLocale == "" {
+		defaultLocale = DefaultLocale
+	}
+	if _, ok := catalogs[defaultLocale]; !ok {
+		return nil, fmt.Errorf("no email strings for locale %q", defaultLocale)
+	}
+
+	baseText, err := texttemplate.New("email").Funcs(templateFuncs).ParseFS(templateFS, "templates/layouts/*.txt.tmpl", "templates/partials/*.txt.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse text email layout: %w", err)
+	}
+	baseHTML, err := htmltemplate.New("email").Funcs(templateFuncs).ParseFS(templateFS, "templates/layouts/*.html.tmpl", "templates/partials/*.html.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML email layout: %w", err)
+	}
+
+	subjects, err := fs.Glob(templateFS, "templates/emails/*.subject.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	emails := make(map[string]*emailTemplates, len(subjects))
+	for _, subjectFile := range subjects {
+		name := strings.TrimSuffix(path.Base(subjectFile), ".subject.tmpl")
+		email, err := parseEmail(name, baseText, baseHTML)
+		if err != nil {
+			return nil, err
+		}
+		emails[name] = email
+	}
+
+	return &Renderer{emails: emails, catalogs: catalogs, defaultLocale: defaultLocale}, nil
+}
+
+func parseEmail(name string, baseText *texttemplate.Template, baseHTML *htmltemplate.Template) (*emailTemplates, error) {
+	file := "templates/emails/" + name
+
+	subject, err := texttemplate.New(name).Funcs(templateFuncs).ParseFS(templateFS, file+".subject.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s subject: %w", name, err)
+	}
+	text, err := texttemplate.Must(baseText.Clone()).ParseFS(templateFS, file+".txt.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s text body: %w", name, err)
+	}
+	html, err := htmltemplate.Must(baseHTML.Clone()).ParseFS(templateFS, file+".html.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s HTML body: %w", name, err)
+	}
+	return &emailTemplates{subject: subject.Lookup(path.Base(file) + ".subject.tmpl"), text: text, html: html}, nil
+}
+
+func loadCatalogs() (map[string]map[string]string, error) {
+	files, err := fs.Glob(templateFS, "templates/locales/*.json")
+	if err != nil {
+		return nil, err
+	}
+	catalogs := make(map[string]map[string]string, len(files))
+	for _, file := range files {
+		data, err := templateFS.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var catalog map[string]string
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return nil, fmt.Errorf("failed to parse email strings %s: %w", file, err)
+		}
+		catalogs[strings.TrimSuffix(path.Base(file), ".json")] = catalog
+	}
+	if _, ok := catalogs[DefaultLocale]; !ok {
+		return nil, fmt.Errorf("no email strings for locale %q", DefaultLocale)
+	}
+	return catalogs, nil
+}
+
+// Templates lists the emails the renderer can render
+func (r *Renderer) Templates() []string {
+	names := make([]string, 0, len(r.emails))
+	for name := range r.emails {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Locales lists the languages emails can be rendered in
+func (r *Renderer) Locales() []string {
+	locales := make([]string, 0, len(r.catalogs))
+	for locale := range r.catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Render builds a message for recipient from the named template in the default locale
+func (r *Renderer) Render(name, to string, data interface{}) (*Message, error) {
+	return r.RenderLocalized(name, "", to, data)
+}
+
+// RenderLocalized builds a message for recipient from the named template in locale
+// A regional locale such as "de-AT" falls back to "de", and unknown ones to the default locale.
+func (r *Renderer) RenderLocalized(name, locale, to string, data interface{}) (*Message, error) {
+	email, ok := r.emails[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTemplate, name)
+	}
+	locale = r.resolveLocale(locale)
+	funcs := r.localeFuncs(locale)
+
+	var subject, text, html bytes.Buffer
+	if err := texttemplate.Must(email.subject.Clone()).Funcs(funcs).Execute(&subject, data); err != nil {
+		return nil, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	if err := texttemplate.Must(email.text.Clone()).Funcs(funcs).ExecuteTemplate(&text, "layout", data); err != nil {
+		return nil, fmt.Errorf("failed to render %s text body: %w", name, err)
+	}
+	htmlTemplate, err := email.html.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("failed to render %s HTML body: %w", name, err)
+	}
+	if err := htmlTemplate.Funcs(funcs).ExecuteTemplate(&html, "layout", data); err != nil {
+		return nil, fmt.Errorf("failed to render %s HTML body: %w", name, err)
+	}
+
+	return &Message{
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+// resolveLocale picks the catalog closest to locale
+func (r *Renderer) resolveLocale(locale string) string {
+	locale = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+	if _, ok := r.catalogs[locale]; ok {
+		return locale
+	}
+	if language, _, found := strings.Cut(locale, "-"); found {
+		if _, ok := r.catalogs[language]; ok {
+			return language
+		}
+	}
+	return r.defaultLocale
+}
+
+// localeFuncs binds the translation and date functions to locale
+// Strings missing from locale come from the default locale, then DefaultLocale; unknown keys render as themselves.
+func (r *Renderer) localeFuncs(locale string) map[string]interface{} {
+	lookup := func(key string) string {
+		for _, candidate := range []string{locale, r.defaultLocale, DefaultLocale} {
+			if s, ok := r.catalogs[candidate][key]; ok {
+				return s
+			}
+		}
+		return key
+	}
+	format := func(key string, t time.Time) string {
+		return t.Format(lookup(key))
+	}
+
+	return map[string]interface{}{
+		"t": func(key string, args ...interface{}) string {
+			if len(args) == 0 {
+				return lookup(key)
+			}
+			return fmt.Sprintf(lookup(key), args...)
+		},
+		"format":   format,
+		"datetime": func(t time.Time) string { return format("format.datetime", t) },
+		"locale":   func() string { return locale },
+	}
+}
+
+// dict builds a map from alternating keys and values, to pass several values to a partial
+func dict(pairs ...interface{}) (map[string]interface{}, error) {
+	if len(pairs)%2 != 0 {
+		return nil, errors.New("dict needs key and value pairs")
+	}
+	m := make(map[string]interface{}, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		key, ok := pairs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("dict key %v is not a string", pairs[i])
+		}
+		m[key] = pairs[i+1]
+	}
+	return m, nil
+}
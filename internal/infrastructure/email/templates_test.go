@@ -0,0 +1,154 @@
+package email
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/notification"
+	"enterprise-crud/internal/domain/report"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSalesReport(events ...report.EventSales) *report.SalesReport {
+	return &report.SalesReport{
+		Recipient: &report.Recipient{UserID: uuid.New(), Email: "organizer@example.com", Username: "organizer"},
+		Frequency: report.FrequencyWeekly,
+		Summary: &report.SalesSummary{
+			From:         time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC),
+			To:           time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC),
+			Events:       events,
+			TotalOrders:  4,
+			TotalTickets: 9,
+			TotalRevenue: 450,
+		},
+	}
+}
+
+func TestRenderer_SalesSummary(t *testing.T) {
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	salesReport := testSalesReport(report.EventSales{Title: "<Jazz> Night", Orders: 4, TicketsSold: 9, Revenue: 450})
+	msg, err := renderer.Render("sales_summary", "organizer@example.com", salesReport)
+	require.NoError(t, err)
+
+	assert.Equal(t, "organizer@example.com", msg.To)
+	assert.Equal(t, "Your weekly sales summary for Oct 5 - Oct 11, 2026", msg.Subject)
+	assert.Contains(t, msg.Text, "- <Jazz> Night: 9 tickets in 4 orders, 450.00")
+	assert.Contains(t, msg.HTML, "&lt;Jazz&gt; Night", "event titles must be escaped in HTML")
+	assert.NotContains(t, msg.HTML, "<Jazz>")
+}
+
+func TestRenderer_SalesSummaryWithoutSales(t *testing.T) {
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	msg, err := renderer.Render("sales_summary", "organizer@example.com", testSalesReport())
+	require.NoError(t, err)
+
+	assert.Contains(t, msg.Text, "None of your events had completed orders")
+	assert.Contains(t, msg.HTML, "None of your events had completed orders")
+}
+
+func TestRenderer_UnknownTemplate(t *testing.T) {
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	_, err = renderer.Render("missing", "organizer@example.com", nil)
+	assert.Error(t, err)
+}
+
+func TestRenderer_Notification(t *testing.T) {
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	mail := &notification.Email{
+		Recipient: &notification.Recipient{UserID: uuid.New(), Email: "fan@example.com", Username: "fan"},
+		Title:     "<Jazz> Night has been cancelled",
+		Body:      "The organizer has cancelled <Jazz> Night.",
+	}
+	msg, err := renderer.Render("notification", "fan@example.com", mail)
+	require.NoError(t, err)
+
+	assert.Equal(t, "<Jazz> Night has been cancelled", msg.Subject)
+	assert.Contains(t, msg.Text, "The organizer has cancelled <Jazz> Night.")
+	assert.Contains(t, msg.HTML, "&lt;Jazz&gt; Night", "notification bodies must be escaped in HTML")
+}
+
+func TestRenderer_EverySampleInEveryLocale(t *testing.T) {
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	for _, name := range renderer.Templates() {
+		data, ok := Sample(name, time.Now())
+		require.True(t, ok, "no preview sample for %s", name)
+		for _, locale := range renderer.Locales() {
+			msg, err := renderer.RenderLocalized(name, locale, "fan@example.com", data)
+			require.NoError(t, err, "%s in %s", name, locale)
+			assert.NotEmpty(t, msg.Subject, "%s in %s", name, locale)
+			assert.NotContains(t, msg.Text, "%!", "%s in %s has a bad format argument", name, locale)
+			assert.NotContains(t, msg.HTML, "%!", "%s in %s has a bad format argument", name, locale)
+		}
+	}
+}
+
+func TestLocales_HaveEveryString(t *testing.T) {
+	catalogs, err := loadCatalogs()
+	require.NoError(t, err)
+
+	for locale, catalog := range catalogs {
+		for key := range catalogs[DefaultLocale] {
+			assert.Contains(t, catalog, key, "locale %s lacks %s", locale, key)
+		}
+	}
+}
+
+func TestRenderer_Localized(t *testing.T) {
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	data := OrderConfirmation{
+		Recipient:  Recipient{Email: "fan@example.com", Username: "fan"},
+		OrderID:    uuid.NewString(),
+		EventTitle: "<Jazz> Night",
+		EventDate:  time.Date(2026, 11, 20, 20, 0, 0, 0, time.UTC),
+		Quantity:   2,
+		Total:      50,
+	}
+
+	msg, err := renderer.RenderLocalized("order_confirmation", "de-AT", "fan@example.com", data)
+	require.NoError(t, err)
+	assert.Equal(t, "Bestellung bestätigt: <Jazz> Night", msg.Subject)
+	assert.True(t, strings.HasPrefix(msg.Text, "Hallo fan,"))
+	assert.Contains(t, msg.HTML, `lang="de"`)
+	assert.Contains(t, msg.HTML, "&lt;Jazz&gt; Night", "event titles must be escaped in HTML")
+
+	msg, err = renderer.RenderLocalized("order_confirmation", "xx", "fan@example.com", data)
+	require.NoError(t, err)
+	assert.Equal(t, "Order confirmed: <Jazz> Night", msg.Subject, "unknown locales fall back to the default")
+}
+
+func TestRenderer_EventUpdate(t *testing.T) {
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	msg, err := renderer.Render("event_update", "fan@example.com", EventUpdate{
+		Recipient:  Recipient{Email: "fan@example.com", Username: "fan"},
+		EventTitle: "Jazz Night",
+		EventDate:  time.Date(2026, 11, 20, 20, 0, 0, 0, time.UTC),
+		Changes:    []string{ChangeDate, ChangeVenue},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, msg.Text, "It has moved to a different venue.")
+	assert.NotContains(t, msg.Text, "event_update.")
+}
+
+func TestNewRenderer_UnknownLocale(t *testing.T) {
+	_, err := NewRenderer("xx")
+	assert.Error(t, err)
+}
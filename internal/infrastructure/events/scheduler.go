@@ -0,0 +1,77 @@
+package events
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+)
+
+// OnSaleScheduler periodically refreshes the on-sale flag of events as their sale windows open and close
+// Orders check the sale window themselves, so the flag only has to be current enough for listings.
+type OnSaleScheduler struct {
+	eventService event.Service
+	interval     time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewOnSaleScheduler creates a scheduler refreshing on-sale flags every interval
+func NewOnSaleScheduler(eventService event.Service, interval time.Duration) *OnSaleScheduler {
+	return &OnSaleScheduler{
+		eventService: eventService,
+		interval:     interval,
+	}
+}
+
+// Start begins refreshing on-sale flags in the background
+func (s *OnSaleScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go s.run(ctx)
+
+	log.Printf("On-sale scheduler started, refreshing every %s", s.interval)
+}
+
+// Stop halts the scheduler and waits for the current refresh to finish
+func (s *OnSaleScheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+	log.Println("On-sale scheduler stopped")
+}
+
+func (s *OnSaleScheduler) run(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick refreshes the flags of events whose sale window opened or closed by now
+func (s *OnSaleScheduler) tick(ctx context.Context, now time.Time) {
+	changed, err := s.eventService.RefreshOnSale(ctx, now)
+	if err != nil {
+		log.Printf("Warning: Failed to refresh on-sale flags: %v", err)
+	}
+	if changed > 0 {
+		log.Printf("Refreshed the on-sale flag of %d events", changed)
+	}
+}
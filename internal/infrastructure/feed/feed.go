@@ -0,0 +1,31 @@
+// Package feed encodes public event listings as iCalendar, RSS and sitemap documents
+package feed
+
+import "time"
+
+// Content types of the encoded documents
+const (
+	ContentTypeICal    = "text/calendar; charset=utf-8"
+	ContentTypeRSS     = "application/rss+xml; charset=utf-8"
+	ContentTypeSitemap = "application/xml; charset=utf-8"
+)
+
+// Item is a single event in a feed
+type Item struct {
+	ID          string
+	Title       string
+	Description string
+	Location    string
+	URL         string
+	Start       time.Time
+	Updated     time.Time
+}
+
+// Channel describes a feed and its items
+type Channel struct {
+	Title       string
+	Description string
+	Link        string
+	Updated     time.Time
+	Items       []Item
+}
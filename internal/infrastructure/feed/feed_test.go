@@ -0,0 +1,74 @@
+package feed
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testChannel() *Channel {
+	start := time.Date(2026, 11, 20, 19, 30, 0, 0, time.UTC)
+	updated := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	return &Channel{
+		Title:       "Upcoming events",
+		Description: "Upcoming events open for ticket sales",
+		Link:        "https://tickets.example.com/events",
+		Updated:     updated,
+		Items: []Item{{
+			ID:          "3f1c4a52-8e3b-4f7e-9a43-0d3d8c2b6f10",
+			Title:       "Jazz, Blues; & More",
+			Description: "Line one\nLine two with a much longer sentence that forces the iCalendar writer to fold the content line — twice, ideally.",
+			Location:    "Main Hall, 1 Music Road",
+			URL:         "https://tickets.example.com/events/3f1c4a52-8e3b-4f7e-9a43-0d3d8c2b6f10",
+			Start:       start,
+			Updated:     updated,
+		}},
+	}
+}
+
+func TestICal(t *testing.T) {
+	doc := string(ICal(testChannel(), "tickets.example.com"))
+
+	assert.True(t, strings.HasPrefix(doc, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(doc, "END:VCALENDAR\r\n"))
+	assert.Contains(t, doc, "UID:3f1c4a52-8e3b-4f7e-9a43-0d3d8c2b6f10@tickets.example.com\r\n")
+	assert.Contains(t, doc, "DTSTART:20261120T193000Z\r\n")
+	assert.Contains(t, doc, `SUMMARY:Jazz\, Blues\; & More`)
+
+	for _, line := range strings.Split(strings.TrimSuffix(doc, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), icalLineLimit, "line must be folded: %q", line)
+	}
+
+	// Unfolding restores the escaped description
+	unfolded := strings.ReplaceAll(doc, "\r\n ", "")
+	assert.Contains(t, unfolded, `DESCRIPTION:Line one\nLine two with a much longer sentence`)
+	assert.Contains(t, unfolded, `— twice\, ideally.`)
+}
+
+func TestRSS(t *testing.T) {
+	doc, err := RSS(testChannel())
+	require.NoError(t, err)
+
+	var parsed rssDocument
+	require.NoError(t, xml.Unmarshal(doc, &parsed))
+	assert.Equal(t, "2.0", parsed.Version)
+	require.Len(t, parsed.Channel.Items, 1)
+	assert.Equal(t, "Jazz, Blues; & More", parsed.Channel.Items[0].Title)
+	assert.Equal(t, "Fri, 20 Nov 2026 19:30:00 +0000", parsed.Channel.Items[0].PubDate)
+	assert.Contains(t, parsed.Channel.Items[0].Description, "Main Hall")
+}
+
+func TestSitemap(t *testing.T) {
+	doc, err := Sitemap(testChannel())
+	require.NoError(t, err)
+
+	var parsed sitemapURLSet
+	require.NoError(t, xml.Unmarshal(doc, &parsed))
+	require.Len(t, parsed.URLs, 2)
+	assert.Equal(t, "https://tickets.example.com/events", parsed.URLs[0].Loc)
+	assert.Equal(t, "2026-10-01", parsed.URLs[1].LastMod)
+}
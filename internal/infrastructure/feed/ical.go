@@ -0,0 +1,79 @@
+package feed
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+)
+
+// icalTimeFormat is the UTC DATE-TIME format from RFC 5545
+const icalTimeFormat = "20060102T150405Z"
+
+// icalLineLimit is the maximum line length in octets before folding
+const icalLineLimit = 75
+
+// icalEscaper escapes TEXT values as required by RFC 5545 section 3.3.11
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// ICal encodes a channel as an iCalendar (RFC 5545) document
+// uidDomain makes event UIDs globally unique, e.g. "events.example.com".
+func ICal(ch *Channel, uidDomain string) []byte {
+	var buf bytes.Buffer
+	w := icalWriter{buf: &buf}
+
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:-//enterprise-crud//events feed//EN")
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	w.line("X-WR-CALNAME:" + icalEscaper.Replace(ch.Title))
+	if ch.Description != "" {
+		w.line("X-WR-CALDESC:" + icalEscaper.Replace(ch.Description))
+	}
+
+	for _, item := range ch.Items {
+		w.line("BEGIN:VEVENT")
+		w.line("UID:" + item.ID + "@" + uidDomain)
+		w.line("DTSTAMP:" + item.Updated.UTC().Format(icalTimeFormat))
+		w.line("LAST-MODIFIED:" + item.Updated.UTC().Format(icalTimeFormat))
+		w.line("DTSTART:" + item.Start.UTC().Format(icalTimeFormat))
+		w.line("SUMMARY:" + icalEscaper.Replace(item.Title))
+		if item.Description != "" {
+			w.line("DESCRIPTION:" + icalEscaper.Replace(item.Description))
+		}
+		if item.Location != "" {
+			w.line("LOCATION:" + icalEscaper.Replace(item.Location))
+		}
+		if item.URL != "" {
+			w.line("URL:" + item.URL)
+		}
+		w.line("STATUS:CONFIRMED")
+		w.line("END:VEVENT")
+	}
+
+	w.line("END:VCALENDAR")
+	return buf.Bytes()
+}
+
+// icalWriter writes CRLF-terminated content lines, folding long ones
+type icalWriter struct {
+	buf *bytes.Buffer
+}
+
+func (w icalWriter) line(s string) {
+	limit := icalLineLimit
+	for len(s) > limit {
+		// Never split a multi-byte UTF-8 sequence
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.buf.WriteString(s[:cut])
+		w.buf.WriteString("\r\n ")
+		s = s[cut:]
+		// Continuation lines start with a space, which counts towards the limit
+		limit = icalLineLimit - 1
+	}
+	w.buf.WriteString(s)
+	w.buf.WriteString("\r\n")
+}
@@ -0,0 +1,43 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+)
+
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// Sitemap encodes the item URLs of a channel, plus the channel link, as a sitemaps.org urlset
+func Sitemap(ch *Channel) ([]byte, error) {
+	set := sitemapURLSet{XMLNS: "http://www.sitemaps.org/schemas/sitemap/0.9"}
+	if ch.Link != "" {
+		set.URLs = append(set.URLs, sitemapURL{Loc: ch.Link})
+	}
+	for _, item := range ch.Items {
+		if item.URL == "" {
+			continue
+		}
+		set.URLs = append(set.URLs, sitemapURL{
+			Loc:     item.URL,
+			LastMod: item.Updated.UTC().Format("2006-01-02"),
+		})
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&buf)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(set); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,85 @@
+// Package invoices renders order invoices through the job queue
+package invoices
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+
+	"enterprise-crud/internal/domain/invoice"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/infrastructure/jobs"
+)
+
+//go:embed templates/invoice.html.tmpl
+var templateFS embed.FS
+
+// templateFuncs are available in the invoice template
+var templateFuncs = template.FuncMap{
+	"money":   func(amount float64) string { return fmt.Sprintf("%.2f", amount) },
+	"percent": func(rate float64) string { return strconv.FormatFloat(rate*100, 'f', -1, 64) + "%" },
+	"logo":    logoURL,
+}
+
+// logoURL embeds an organizer's logo as a data URL, so the stored document needs nothing else to print
+// Logos are checked to be images on upload; anything else is left out.
+func logoURL(b *invoice.Branding) template.URL {
+	if !strings.HasPrefix(b.LogoContentType, "image/") || len(b.Logo) == 0 {
+		return ""
+	}
+	return template.URL("data:" + b.LogoContentType + ";base64," + base64.StdEncoding.EncodeToString(b.Logo))
+}
+
+// Renderer renders invoices as print-ready HTML
+type Renderer struct {
+	tmpl *template.Template
+}
+
+// NewRenderer parses the embedded invoice template
+func NewRenderer() (*Renderer, error) {
+	tmpl, err := template.New("invoice").Funcs(templateFuncs).ParseFS(templateFS, "templates/invoice.html.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse invoice template: %w", err)
+	}
+	return &Renderer{tmpl: tmpl}, nil
+}
+
+// Render builds the HTML document of an invoice
+func (r *Renderer) Render(inv *invoice.Invoice) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := r.tmpl.ExecuteTemplate(&buf, "invoice.html.tmpl", inv); err != nil {
+		return nil, fmt.Errorf("failed to render invoice %s: %w", inv.Number, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// GenerateHandler returns the job handler that renders an order's invoice into storage
+// Rendering is deterministic for a numbered order, so duplicate jobs store the same document.
+func GenerateHandler(invoiceService invoice.Service, renderer *Renderer) jobs.Handler {
+	return func(ctx context.Context, j *job.Job) error {
+		var payload invoice.GeneratePayload
+		if err := json.Unmarshal(j.Payload, &payload); err != nil {
+			return fmt.Errorf("%w: invalid payload: %v", job.ErrPermanent, err)
+		}
+
+		inv, err := invoiceService.PrepareInvoice(ctx, payload.OrderID)
+		if err != nil {
+			if invoice.IsOrderNotFoundError(err) || invoice.IsOrderNotCompletedError(err) {
+				return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+			}
+			return err
+		}
+
+		body, err := renderer.Render(inv)
+		if err != nil {
+			return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+		}
+		return invoiceService.SaveDocument(ctx, payload.OrderID, body)
+	}
+}
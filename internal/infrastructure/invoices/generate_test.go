@@ -0,0 +1,133 @@
+package invoices
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/invoice"
+	"enterprise-crud/internal/domain/job"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockInvoiceService is a mock implementation of invoice.Service
+// Only PrepareInvoice and SaveDocument are used by the job handler.
+type mockInvoiceService struct {
+	invoice.Service
+	mock.Mock
+}
+
+func (m *mockInvoiceService) PrepareInvoice(ctx context.Context, orderID uuid.UUID) (*invoice.Invoice, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*invoice.Invoice), args.Error(1)
+}
+
+func (m *mockInvoiceService) SaveDocument(ctx context.Context, orderID uuid.UUID, body []byte) error {
+	args := m.Called(ctx, orderID, body)
+	return args.Error(0)
+}
+
+func generateJob(t *testing.T, orderID uuid.UUID) *job.Job {
+	data, err := json.Marshal(invoice.GeneratePayload{OrderID: orderID})
+	require.NoError(t, err)
+	return &job.Job{ID: uuid.New(), Type: invoice.JobTypeGenerate, Payload: data}
+}
+
+func testInvoice(orderID uuid.UUID) *invoice.Invoice {
+	return &invoice.Invoice{
+		Number:     "INV-000042",
+		IssuedAt:   time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC),
+		OrderID:    orderID,
+		OrderedAt:  time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC),
+		BuyerName:  "buyer",
+		BuyerEmail: "buyer@example.com",
+		Seller:     invoice.Settings{SellerName: "Tickets Ltd", TaxLabel: "VAT", TaxRate: 0.2, Currency: "EUR"},
+		Lines:      []invoice.Line{{Description: "Ticket: <Jazz Night>", Quantity: 2, UnitPrice: 60, Amount: 120}},
+		Subtotal:   100,
+		TaxAmount:  20,
+		Total:      120,
+	}
+}
+
+func TestRenderer_Render(t *testing.T) {
+	renderer, err := NewRenderer()
+	require.NoError(t, err)
+
+	body, err := renderer.Render(testInvoice(uuid.New()))
+	require.NoError(t, err)
+
+	html := string(body)
+	assert.Contains(t, html, "Invoice INV-000042")
+	assert.Contains(t, html, "Tickets Ltd")
+	assert.Contains(t, html, "buyer@example.com")
+	assert.Contains(t, html, "Ticket: &lt;Jazz Night&gt;")
+	assert.Contains(t, html, "VAT (20%)")
+	assert.Contains(t, html, "20.00 EUR")
+	assert.Contains(t, html, "120.00 EUR")
+	assert.Contains(t, html, "h1, h3 { color: #222; }")
+	assert.NotContains(t, html, "<img")
+}
+
+func TestRenderer_RenderBranded(t *testing.T) {
+	renderer, err := NewRenderer()
+	require.NoError(t, err)
+
+	inv := testInvoice(uuid.New())
+	inv.Branding = &invoice.Branding{PrimaryColor: "#1a2b3c", AccentColor: "#ff8800", LogoContentType: "image/png", Logo: []byte("png")}
+	body, err := renderer.Render(inv)
+	require.NoError(t, err)
+
+	html := string(body)
+	assert.Contains(t, html, "h1, h3 { color: #1a2b3c; }")
+	assert.Contains(t, html, "border-bottom: 2px solid #ff8800;")
+	assert.Contains(t, html, `<img class="logo" src="data:image/png;base64,cG5n"`)
+}
+
+func TestGenerateHandler(t *testing.T) {
+	renderer, err := NewRenderer()
+	require.NoError(t, err)
+	orderID := uuid.New()
+
+	t.Run("stores the rendered invoice", func(t *testing.T) {
+		service := new(mockInvoiceService)
+		service.On("PrepareInvoice", mock.Anything, orderID).Return(testInvoice(orderID), nil)
+		service.On("SaveDocument", mock.Anything, orderID, mock.MatchedBy(func(body []byte) bool {
+			return assert.Contains(t, string(body), "INV-000042")
+		})).Return(nil)
+
+		err := GenerateHandler(service, renderer)(context.Background(), generateJob(t, orderID))
+
+		assert.NoError(t, err)
+		service.AssertExpectations(t)
+	})
+
+	t.Run("unknown orders are not retried", func(t *testing.T) {
+		service := new(mockInvoiceService)
+		service.On("PrepareInvoice", mock.Anything, orderID).Return(nil, invoice.NewOrderNotFoundError(orderID))
+
+		err := GenerateHandler(service, renderer)(context.Background(), generateJob(t, orderID))
+
+		assert.True(t, errors.Is(err, job.ErrPermanent))
+	})
+
+	t.Run("storage failures are retried", func(t *testing.T) {
+		service := new(mockInvoiceService)
+		service.On("PrepareInvoice", mock.Anything, orderID).Return(testInvoice(orderID), nil)
+		service.On("SaveDocument", mock.Anything, orderID, mock.Anything).
+			Return(invoice.NewInvoiceError(invoice.ErrInvoiceStorageFailed, errors.New("disk full")))
+
+		err := GenerateHandler(service, renderer)(context.Background(), generateJob(t, orderID))
+
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, job.ErrPermanent))
+	})
+}
@@ -0,0 +1,184 @@
+// Package jobs runs background jobs from the persistent job queue
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/job"
+)
+
+// Handler executes a single job
+// Returning an error wrapping job.ErrPermanent dead-letters the job immediately;
+// any other error retries it with backoff until its attempts run out.
+type Handler func(ctx context.Context, j *job.Job) error
+
+// Runner polls the job queue with a fixed pool of workers
+type Runner struct {
+	repo     job.Repository
+	cfg      config.JobsConfig
+	handlers map[string]Handler
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRunner creates a runner for the given queue
+// Handlers must be registered before Start
+func NewRunner(repo job.Repository, cfg config.JobsConfig) *Runner {
+	return &Runner{
+		repo:     repo,
+		cfg:      cfg,
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Register sets the handler for a job type
+func (r *Runner) Register(jobType string, handler Handler) {
+	r.handlers[jobType] = handler
+}
+
+// Start launches the workers and the stale job reaper
+func (r *Runner) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	workers := r.cfg.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	r.wg.Add(workers + 1)
+	for i := 0; i < workers; i++ {
+		go r.work(ctx)
+	}
+	go r.reap(ctx)
+
+	log.Printf("Job runner started with %d workers", workers)
+}
+
+// Stop signals workers to exit and waits for running jobs to finish
+func (r *Runner) Stop() {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	r.wg.Wait()
+	log.Println("Job runner stopped")
+}
+
+// work claims and runs jobs until ctx is cancelled, sleeping when the queue is empty
+func (r *Runner) work(ctx context.Context) {
+	defer r.wg.Done()
+
+	for {
+		processed := r.RunNext(ctx)
+		if processed {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.cfg.PollInterval):
+		}
+	}
+}
+
+// RunNext claims and runs a single due job, reporting whether one was found
+func (r *Runner) RunNext(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	j, err := r.repo.ClaimNext(ctx)
+	if err != nil {
+		log.Printf("Warning: Failed to claim job: %v", err)
+		return false
+	}
+	if j == nil {
+		return false
+	}
+
+	// Jobs get their own context so shutdown lets the current run finish
+	runCtx, cancel := context.WithTimeout(context.Background(), r.cfg.JobTimeout)
+	defer cancel()
+
+	r.finish(runCtx, j, r.execute(runCtx, j))
+	return true
+}
+
+// execute runs the job handler, turning panics into errors
+func (r *Runner) execute(ctx context.Context, j *job.Job) (err error) {
+	handler, ok := r.handlers[j.Type]
+	if !ok {
+		return fmt.Errorf("%w: no handler registered for job type %q", job.ErrPermanent, j.Type)
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job panicked: %v", recovered)
+		}
+	}()
+
+	return handler(ctx, j)
+}
+
+// finish records the outcome of a run
+func (r *Runner) finish(ctx context.Context, j *job.Job, runErr error) {
+	var err error
+	switch {
+	case runErr == nil:
+		err = r.repo.MarkSucceeded(ctx, j.ID)
+	case errors.Is(runErr, job.ErrPermanent) || !j.HasAttemptsLeft():
+		log.Printf("Job %s (%s) failed permanently after %d attempts: %v", j.ID, j.Type, j.Attempts, runErr)
+		err = r.repo.MarkFailed(ctx, j.ID, runErr.Error())
+	default:
+		delay := r.backoff(j.Attempts)
+		log.Printf("Job %s (%s) attempt %d failed, retrying in %s: %v", j.ID, j.Type, j.Attempts, delay, runErr)
+		err = r.repo.MarkRetry(ctx, j.ID, time.Now().Add(delay), runErr.Error())
+	}
+
+	if err != nil {
+		log.Printf("Warning: Failed to record result of job %s: %v", j.ID, err)
+	}
+}
+
+// backoff returns the exponential delay before retrying after attempt
+func (r *Runner) backoff(attempt int) time.Duration {
+	delay := r.cfg.RetryBaseDelay
+	for i := 1; i < attempt && delay < r.cfg.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > r.cfg.RetryMaxDelay {
+		delay = r.cfg.RetryMaxDelay
+	}
+	return delay
+}
+
+// reap periodically returns jobs whose worker died mid-run to the queue
+func (r *Runner) reap(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.cfg.LockTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			released, err := r.repo.ReleaseStale(ctx, time.Now().Add(-r.cfg.LockTimeout))
+			if err != nil {
+				log.Printf("Warning: Failed to release stale jobs: %v", err)
+			} else if released > 0 {
+				log.Printf("Released %d stale jobs back to the queue", released)
+			}
+		}
+	}
+}
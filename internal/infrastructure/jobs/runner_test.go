@@ -0,0 +1,227 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/job"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRepository is an in-memory job.Repository for runner tests
+type memoryRepository struct {
+	mu   sync.Mutex
+	jobs map[uuid.UUID]*job.Job
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{jobs: make(map[uuid.UUID]*job.Job)}
+}
+
+func (r *memoryRepository) Create(ctx context.Context, j *job.Job) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs[j.ID] = j
+	return nil
+}
+
+func (r *memoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	j, ok := r.jobs[id]
+	if !ok {
+		return nil, job.NewJobNotFoundError(id)
+	}
+	copied := *j
+	return &copied, nil
+}
+
+func (r *memoryRepository) GetByStatus(ctx context.Context, status string, limit int) ([]*job.Job, error) {
+	return nil, nil
+}
+
+func (r *memoryRepository) ClaimNext(ctx context.Context) (*job.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, j := range r.jobs {
+		if j.Status == job.StatusPending && !j.RunAt.After(time.Now()) {
+			now := time.Now()
+			j.Status = job.StatusRunning
+			j.Attempts++
+			j.LockedAt = &now
+			copied := *j
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryRepository) set(id uuid.UUID, fn func(j *job.Job)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	j, ok := r.jobs[id]
+	if !ok {
+		return job.NewJobNotFoundError(id)
+	}
+	fn(j)
+	return nil
+}
+
+func (r *memoryRepository) MarkSucceeded(ctx context.Context, id uuid.UUID) error {
+	return r.set(id, func(j *job.Job) { j.Status = job.StatusSucceeded })
+}
+
+func (r *memoryRepository) MarkRetry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error {
+	return r.set(id, func(j *job.Job) {
+		j.Status = job.StatusPending
+		j.RunAt = runAt
+		j.LastError = lastError
+	})
+}
+
+func (r *memoryRepository) MarkFailed(ctx context.Context, id uuid.UUID, lastError string) error {
+	return r.set(id, func(j *job.Job) {
+		j.Status = job.StatusFailed
+		j.LastError = lastError
+	})
+}
+
+func (r *memoryRepository) Requeue(ctx context.Context, id uuid.UUID) error {
+	return r.set(id, func(j *job.Job) {
+		j.Status = job.StatusPending
+		j.Attempts = 0
+	})
+}
+
+func (r *memoryRepository) ReleaseStale(ctx context.Context, cutoff time.Time) (int64, error) {
+	return 0, nil
+}
+
+func testJobsConfig() config.JobsConfig {
+	return config.JobsConfig{
+		Workers:        1,
+		PollInterval:   time.Millisecond,
+		JobTimeout:     time.Second,
+		LockTimeout:    time.Minute,
+		RetryBaseDelay: 0,
+		RetryMaxDelay:  0,
+	}
+}
+
+func enqueue(t *testing.T, repo *memoryRepository, jobType string, maxAttempts int) uuid.UUID {
+	j := &job.Job{ID: uuid.New(), Type: jobType, Status: job.StatusPending, MaxAttempts: maxAttempts, RunAt: time.Now()}
+	require.NoError(t, repo.Create(context.Background(), j))
+	return j.ID
+}
+
+func TestRunner_RunsJob(t *testing.T) {
+	repo := newMemoryRepository()
+	runner := NewRunner(repo, testJobsConfig())
+	called := 0
+	runner.Register("test", func(ctx context.Context, j *job.Job) error {
+		called++
+		return nil
+	})
+
+	id := enqueue(t, repo, "test", 3)
+
+	assert.True(t, runner.RunNext(context.Background()))
+	assert.False(t, runner.RunNext(context.Background()), "queue should be empty")
+
+	j, _ := repo.GetByID(context.Background(), id)
+	assert.Equal(t, job.StatusSucceeded, j.Status)
+	assert.Equal(t, 1, called)
+}
+
+func TestRunner_RetriesThenDeadLetters(t *testing.T) {
+	repo := newMemoryRepository()
+	runner := NewRunner(repo, testJobsConfig())
+	runner.Register("flaky", func(ctx context.Context, j *job.Job) error {
+		return errors.New("smtp unavailable")
+	})
+
+	id := enqueue(t, repo, "flaky", 2)
+
+	runner.RunNext(context.Background())
+	j, _ := repo.GetByID(context.Background(), id)
+	assert.Equal(t, job.StatusPending, j.Status, "first failure should be retried")
+	assert.Equal(t, "smtp unavailable", j.LastError)
+
+	runner.RunNext(context.Background())
+	j, _ = repo.GetByID(context.Background(), id)
+	assert.Equal(t, job.StatusFailed, j.Status, "job should be dead-lettered after its last attempt")
+	assert.Equal(t, 2, j.Attempts)
+}
+
+func TestRunner_PermanentErrorsAreNotRetried(t *testing.T) {
+	repo := newMemoryRepository()
+	runner := NewRunner(repo, testJobsConfig())
+	runner.Register("doomed", func(ctx context.Context, j *job.Job) error {
+		return fmt.Errorf("%w: event deleted", job.ErrPermanent)
+	})
+
+	id := enqueue(t, repo, "doomed", 5)
+	runner.RunNext(context.Background())
+
+	j, _ := repo.GetByID(context.Background(), id)
+	assert.Equal(t, job.StatusFailed, j.Status)
+	assert.Equal(t, 1, j.Attempts)
+}
+
+func TestRunner_UnknownTypeAndPanicsFail(t *testing.T) {
+	repo := newMemoryRepository()
+	runner := NewRunner(repo, testJobsConfig())
+	runner.Register("panics", func(ctx context.Context, j *job.Job) error {
+		panic("boom")
+	})
+
+	unknownID := enqueue(t, repo, "unknown", 5)
+	panicID := enqueue(t, repo, "panics", 1)
+
+	runner.RunNext(context.Background())
+	runner.RunNext(context.Background())
+
+	unknown, _ := repo.GetByID(context.Background(), unknownID)
+	assert.Equal(t, job.StatusFailed, unknown.Status)
+	assert.Contains(t, unknown.LastError, "no handler registered")
+
+	panicked, _ := repo.GetByID(context.Background(), panicID)
+	assert.Equal(t, job.StatusFailed, panicked.Status)
+	assert.Contains(t, panicked.LastError, "boom")
+}
+
+func TestRunner_StartStop(t *testing.T) {
+	repo := newMemoryRepository()
+	runner := NewRunner(repo, testJobsConfig())
+	done := make(chan struct{})
+	runner.Register("test", func(ctx context.Context, j *job.Job) error {
+		close(done)
+		return nil
+	})
+	enqueue(t, repo, "test", 1)
+
+	runner.Start()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not run the job")
+	}
+	runner.Stop()
+}
+
+func TestRunner_Backoff(t *testing.T) {
+	runner := NewRunner(newMemoryRepository(), config.JobsConfig{RetryBaseDelay: time.Second, RetryMaxDelay: 5 * time.Second})
+
+	assert.Equal(t, time.Second, runner.backoff(1))
+	assert.Equal(t, 2*time.Second, runner.backoff(2))
+	assert.Equal(t, 4*time.Second, runner.backoff(3))
+	assert.Equal(t, 5*time.Second, runner.backoff(4))
+}
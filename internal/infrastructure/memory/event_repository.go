@@ -0,0 +1,201 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+
+	"github.com/google/uuid"
+)
+
+// eventRepository implements event.Repository on a Store
+type eventRepository struct {
+	store *Store
+}
+
+// NewEventRepository creates an event repository on store
+func NewEventRepository(store *Store) event.Repository {
+	return &eventRepository{store: store}
+}
+
+// Create adds an event
+func (r *eventRepository) Create(ctx context.Context, e *event.Event) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	if err := r.claimSlug(e); err != nil {
+		return err
+	}
+	if e.Status == "" {
+		e.Status = event.StatusActive
+	}
+	if e.ModerationStatus == "" {
+		e.ModerationStatus = event.ModerationApproved
+	}
+	if e.AttendeeQuestions == nil {
+		e.AttendeeQuestions = event.Questions{}
+	}
+	e.CreatedAt = now()
+	e.UpdatedAt = e.CreatedAt
+	r.store.events[e.ID] = cloneEvent(*e)
+	return nil
+}
+
+// GetByID retrieves an event by its ID
+func (r *eventRepository) GetByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	e, ok := r.store.events[id]
+	if !ok {
+		return nil, event.NewEventNotFoundError(id)
+	}
+	e = cloneEvent(e)
+	return &e, nil
+}
+
+// GetBySlug retrieves the event that has or once had slug
+func (r *eventRepository) GetBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	e, ok := r.store.events[r.store.eventSlugs[slug]]
+	if !ok {
+		return nil, event.NewEventSlugNotFoundError(slug)
+	}
+	e = cloneEvent(e)
+	return &e, nil
+}
+
+// GetAll retrieves all events by date
+func (r *eventRepository) GetAll(ctx context.Context) ([]*event.Event, error) {
+	return r.filter(func(event.Event) bool { return true }), nil
+}
+
+// GetByOrganizer retrieves an organizer's events by date
+func (r *eventRepository) GetByOrganizer(ctx context.Context, organizerID uuid.UUID) ([]*event.Event, error) {
+	return r.filter(func(e event.Event) bool { return e.OrganizerID == organizerID }), nil
+}
+
+// GetByVenue retrieves the events at a venue by date
+func (r *eventRepository) GetByVenue(ctx context.Context, venueID uuid.UUID) ([]*event.Event, error) {
+	return r.filter(func(e event.Event) bool { return e.VenueID == venueID }), nil
+}
+
+func (r *eventRepository) filter(match func(event.Event) bool) []*event.Event {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	events := []*event.Event{}
+	for _, e := range r.store.events {
+		if match(e) {
+			e = cloneEvent(e)
+			events = append(events, &e)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].EventDate.Equal(events[j].EventDate) {
+			return events[i].EventDate.Before(events[j].EventDate)
+		}
+		return events[i].ID.String() < events[j].ID.String()
+	})
+	return events
+}
+
+// Update replaces an event
+func (r *eventRepository) Update(ctx context.Context, e *event.Event) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if err := r.claimSlug(e); err != nil {
+		return err
+	}
+	e.UpdatedAt = now()
+	r.store.events[e.ID] = cloneEvent(*e)
+	return nil
+}
+
+// claimSlug records the event's slug, unless another event has or had it
+// The caller holds the store's write lock.
+func (r *eventRepository) claimSlug(e *event.Event) error {
+	if id, ok := r.store.eventSlugs[e.Slug]; ok && id != e.ID {
+		return event.ErrSlugTaken
+	}
+	r.store.eventSlugs[e.Slug] = e.ID
+	return nil
+}
+
+// Delete deletes an event by its ID
+func (r *eventRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.events, id)
+	for slug, eventID := range r.store.eventSlugs {
+		if eventID == id {
+			delete(r.store.eventSlugs, slug)
+		}
+	}
+	return nil
+}
+
+// RefreshOnSale sets each event's OnSale flag from its sale window at now
+func (r *eventRepository) RefreshOnSale(ctx context.Context, now time.Time) ([]*event.Event, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	changed := []*event.Event{}
+	for id, e := range r.store.events {
+		if onSale := e.IsOnSale(now); onSale != e.OnSale {
+			e.OnSale = onSale
+			r.store.events[id] = e
+			e = cloneEvent(e)
+			changed = append(changed, &e)
+		}
+	}
+	return changed, nil
+}
+
+// AdjustInventory applies an adjustment to the event's ticket counts and records it
+func (r *eventRepository) AdjustInventory(ctx context.Context, adjustment *event.InventoryAdjustment) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	e, ok := r.store.events[adjustment.EventID]
+	if !ok {
+		return event.NewEventNotFoundError(adjustment.EventID)
+	}
+	if e.AvailableTickets+adjustment.Delta < 0 {
+		return event.ErrInvalidTicketReduction
+	}
+	e.TotalTickets += adjustment.Delta
+	e.AvailableTickets += adjustment.Delta
+	e.UpdatedAt = now()
+	r.store.events[e.ID] = e
+
+	adjustment.ID = uuid.New()
+	adjustment.TotalTickets = e.TotalTickets
+	adjustment.AvailableTickets = e.AvailableTickets
+	adjustment.CreatedAt = e.UpdatedAt
+	r.store.adjustments = append(r.store.adjustments, *adjustment)
+	return nil
+}
+
+// ListInventoryAdjustments retrieves the inventory adjustments of an event, newest first
+func (r *eventRepository) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID) ([]*event.InventoryAdjustment, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	adjustments := []*event.InventoryAdjustment{}
+	for i := len(r.store.adjustments) - 1; i >= 0; i-- {
+		if a := r.store.adjustments[i]; a.EventID == eventID {
+			adjustments = append(adjustments, &a)
+		}
+	}
+	return adjustments, nil
+}
@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/venue"
+	"enterprise-crud/internal/fixtures"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventRepository_GetByOrganizer_OrdersByDate(t *testing.T) {
+	repo := NewEventRepository(NewStore(fixtures.Default(time.Now())))
+
+	events, err := repo.GetByOrganizer(context.Background(), fixtures.OrganizerID)
+
+	require.NoError(t, err)
+	var ids []uuid.UUID
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+	assert.Equal(t, []uuid.UUID{fixtures.PastID, fixtures.SoldOutID, fixtures.WorkshopID, fixtures.ConcertID}, ids)
+}
+
+func TestEventRepository_ReturnsCopies(t *testing.T) {
+	repo := NewEventRepository(NewStore(fixtures.Default(time.Now())))
+	ctx := context.Background()
+
+	e, err := repo.GetByID(ctx, fixtures.WorkshopID)
+	require.NoError(t, err)
+	e.AttendeeQuestions[0].Label = "changed"
+	e.Title = "changed"
+
+	stored, err := repo.GetByID(ctx, fixtures.WorkshopID)
+	require.NoError(t, err)
+	assert.Equal(t, "Go Workshop", stored.Title)
+	assert.NotEqual(t, "changed", stored.AttendeeQuestions[0].Label)
+}
+
+func TestEventRepository_Create(t *testing.T) {
+	repo := NewEventRepository(NewStore(nil))
+	ctx := context.Background()
+
+	e := &event.Event{VenueID: fixtures.ArenaID, Title: "New", TotalTickets: 10, AvailableTickets: 10}
+	require.NoError(t, repo.Create(ctx, e))
+
+	assert.NotEqual(t, uuid.Nil, e.ID)
+	assert.Equal(t, event.StatusActive, e.Status)
+	assert.False(t, e.CreatedAt.IsZero())
+	_, err := repo.GetByID(ctx, e.ID)
+	assert.NoError(t, err)
+}
+
+func TestRepositories_ReportMissingRecords(t *testing.T) {
+	store := NewStore(fixtures.Default(time.Now()))
+	ctx := context.Background()
+	missing := uuid.New()
+
+	_, err := NewEventRepository(store).GetByID(ctx, missing)
+	assert.True(t, event.IsEventNotFoundError(err))
+
+	_, err = NewVenueRepository(store).GetByID(ctx, missing)
+	assert.True(t, venue.IsVenueNotFoundError(err))
+	assert.True(t, venue.IsVenueNotFoundError(NewVenueRepository(store).Delete(ctx, missing)))
+
+	p, err := NewPlanRepository(store).GetByUserID(ctx, fixtures.UserID)
+	require.NoError(t, err)
+	assert.Nil(t, p, "users without a plan have none assigned")
+}
+
+func TestEventRepository_Slugs(t *testing.T) {
+	repo := NewEventRepository(NewStore(nil))
+	ctx := context.Background()
+
+	e := &event.Event{Title: "Summer Concert", Slug: "summer-concert-abcdef"}
+	require.NoError(t, repo.Create(ctx, e))
+
+	e.Title, e.Slug = "Winter Concert", "winter-concert-ghijkl"
+	require.NoError(t, repo.Update(ctx, e))
+
+	for _, slug := range []string{"summer-concert-abcdef", "winter-concert-ghijkl"} {
+		found, err := repo.GetBySlug(ctx, slug)
+		require.NoError(t, err)
+		assert.Equal(t, "winter-concert-ghijkl", found.Slug)
+	}
+
+	// The old slug stays with its event
+	err := repo.Create(ctx, &event.Event{Title: "Summer Concert", Slug: "summer-concert-abcdef"})
+	assert.True(t, event.IsSlugTakenError(err))
+
+	_, err = repo.GetBySlug(ctx, "missing")
+	assert.True(t, event.IsEventNotFoundError(err))
+}
+
+func TestEventRepository_AdjustInventory(t *testing.T) {
+	repo := NewEventRepository(NewStore(fixtures.Default(time.Now())))
+	ctx := context.Background()
+
+	hold := &event.InventoryAdjustment{EventID: fixtures.ConcertID, Delta: -96, Reason: "Production hold"}
+	require.NoError(t, repo.AdjustInventory(ctx, hold))
+	assert.Equal(t, 404, hold.TotalTickets)
+	assert.Equal(t, 400, hold.AvailableTickets)
+
+	err := repo.AdjustInventory(ctx, &event.InventoryAdjustment{EventID: fixtures.ConcertID, Delta: -401, Reason: "Too much"})
+	assert.Equal(t, event.ErrInvalidTicketReduction, err, "sold tickets are never taken back")
+
+	release := &event.InventoryAdjustment{EventID: fixtures.ConcertID, Delta: 10, Reason: "Released part of the hold"}
+	require.NoError(t, repo.AdjustInventory(ctx, release))
+
+	stored, err := repo.GetByID(ctx, fixtures.ConcertID)
+	require.NoError(t, err)
+	assert.Equal(t, 414, stored.TotalTickets)
+	assert.Equal(t, 410, stored.AvailableTickets)
+
+	adjustments, err := repo.ListInventoryAdjustments(ctx, fixtures.ConcertID)
+	require.NoError(t, err)
+	require.Len(t, adjustments, 2)
+	assert.Equal(t, release.ID, adjustments[0].ID, "newest first")
+	assert.Equal(t, hold.ID, adjustments[1].ID)
+}
@@ -0,0 +1,34 @@
+package memory_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/venue"
+	"enterprise-crud/internal/fixtures"
+	"enterprise-crud/internal/infrastructure/memory"
+)
+
+// Services run on the in-memory repositories as they do on the database ones
+func ExampleNewStore() {
+	store := memory.NewStore(fixtures.Default(time.Now()))
+	venues := memory.NewVenueRepository(store)
+	events := event.NewService(memory.NewEventRepository(store), venues, nil, nil)
+
+	upcoming, err := events.GetUpcomingEvents(context.Background(), event.UpcomingFilter{VenueID: &fixtures.ClubID})
+	if err != nil {
+		panic(err)
+	}
+	for _, e := range upcoming {
+		fmt.Println(e.Title)
+	}
+
+	_, err = venue.NewVenueService(venues, nil).GetVenueByID(context.Background(), fixtures.ConcertID)
+	fmt.Println(venue.IsVenueNotFoundError(err))
+	// Output:
+	// Sold Out Meetup
+	// Go Workshop
+	// true
+}
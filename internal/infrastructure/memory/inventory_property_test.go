@@ -0,0 +1,156 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/fixtures"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// quantityScorer holds large orders for review so the review paths get exercised
+type quantityScorer struct{}
+
+func (quantityScorer) Score(ctx context.Context, input order.RiskInput) (order.RiskAssessment, error) {
+	if input.Quantity >= 6 {
+		return order.RiskAssessment{Score: 50, Reasons: []string{"large_order"}}, nil
+	}
+	return order.RiskAssessment{}, nil
+}
+
+// inventoryModel runs random order and event operations against one store
+type inventoryModel struct {
+	store  *Store
+	orders order.Service
+	events event.Service
+	rng    *rand.Rand
+	ids    []uuid.UUID // Events orders are placed for
+}
+
+func newInventoryModel(t *testing.T, seed int64, opts ...order.ServiceOption) *inventoryModel {
+	store := NewStore(fixtures.Default(time.Now()))
+	db, err := NewTxDB()
+	require.NoError(t, err)
+
+	opts = append(opts, order.WithRiskScorer(quantityScorer{}, order.RiskThresholds{Review: 50}))
+	return &inventoryModel{
+		store:  store,
+		orders: order.NewOrderService(NewOrderRepository(store), db, nil, opts...),
+		events: event.NewService(NewEventRepository(store), NewVenueRepository(store), nil, nil),
+		rng:    rand.New(rand.NewSource(seed)),
+		ids:    []uuid.UUID{fixtures.ConcertID, fixtures.WorkshopID, fixtures.SoldOutID, fixtures.PastID},
+	}
+}
+
+// step applies one random operation; its error only says the operation was refused
+func (m *inventoryModel) step(ctx context.Context) (string, error) {
+	eventID := m.ids[m.rng.Intn(len(m.ids))]
+
+	switch n := m.rng.Intn(10); {
+	case n < 4:
+		quantity := 1 + m.rng.Intn(8)
+		var answers map[string]interface{}
+		if eventID == fixtures.WorkshopID {
+			answers = map[string]interface{}{"experience": "Never"}
+		}
+		_, err := m.orders.CreateOrder(ctx, fixtures.UserID, eventID, quantity, order.Details{Answers: answers})
+		return fmt.Sprintf("create %d for %s", quantity, eventID), err
+	case n < 6:
+		o := m.pick(order.StatusPending)
+		if o == nil {
+			return "complete nothing", nil
+		}
+		return "complete " + o.ID.String(), m.orders.UpdateOrderStatus(ctx, o.ID, order.StatusCompleted)
+	case n < 8:
+		o := m.pick(order.StatusReview)
+		if o == nil {
+			return "review nothing", nil
+		}
+		approve := m.rng.Intn(2) == 0
+		_, err := m.orders.ReviewOrder(ctx, o.ID, approve)
+		return fmt.Sprintf("review %s approve=%v", o.ID, approve), err
+	case n < 9:
+		_, err := m.orders.ExpirePendingOrders(ctx, time.Nanosecond)
+		return "expire pending", err
+	default:
+		e, err := m.events.GetEventByID(ctx, eventID)
+		if err != nil {
+			return "update missing event", err
+		}
+		e.TotalTickets += m.rng.Intn(41) - 20
+		return fmt.Sprintf("update %s to %d tickets", eventID, e.TotalTickets), m.events.UpdateEvent(ctx, e)
+	}
+}
+
+// pick returns a random order with status, or nil when there is none
+func (m *inventoryModel) pick(status order.Status) *order.Order {
+	orders, err := NewOrderRepository(m.store).ListByStatus(context.Background(), status, 0)
+	if err != nil || len(orders) == 0 {
+		return nil
+	}
+	return orders[m.rng.Intn(len(orders))]
+}
+
+// check verifies the ticket counters of every event against its orders
+func (m *inventoryModel) check() error {
+	m.store.mu.RLock()
+	defer m.store.mu.RUnlock()
+
+	for _, e := range m.store.events {
+		if e.AvailableTickets < 0 {
+			return fmt.Errorf("event %s has %d available tickets", e.ID, e.AvailableTickets)
+		}
+		if e.AvailableTickets > e.TotalTickets {
+			return fmt.Errorf("event %s has %d available of %d tickets", e.ID, e.AvailableTickets, e.TotalTickets)
+		}
+
+		held, completed := 0, 0
+		for _, o := range m.store.orders {
+			if o.EventID != e.ID || o.Status == order.StatusFailed {
+				continue
+			}
+			held += o.Quantity
+			if o.Status == order.StatusCompleted {
+				completed += o.Quantity
+			}
+		}
+		// Pending and held orders keep their tickets too, so completed orders are a part of what is sold
+		if sold := e.TotalTickets - e.AvailableTickets; sold != held || completed > sold {
+			return fmt.Errorf("event %s sold %d tickets but its orders hold %d (%d completed)", e.ID, sold, held, completed)
+		}
+	}
+	return nil
+}
+
+func testInventoryInvariants(t *testing.T, opts ...order.ServiceOption) {
+	ctx := context.Background()
+	property := func(seed int64) bool {
+		m := newInventoryModel(t, seed, opts...)
+		for i := 0; i < 60; i++ {
+			op, _ := m.step(ctx)
+			if err := m.check(); err != nil {
+				t.Logf("seed %d, step %d (%s): %v", seed, i, op, err)
+				return false
+			}
+		}
+		return true
+	}
+
+	require.NoError(t, quick.Check(property, &quick.Config{MaxCount: 200}))
+}
+
+func TestInventoryInvariants(t *testing.T) {
+	testInventoryInvariants(t)
+}
+
+func TestInventoryInvariants_LegacyTicketUpdate(t *testing.T) {
+	testInventoryInvariants(t, order.WithLegacyTicketUpdate())
+}
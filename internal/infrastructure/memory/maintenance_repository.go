@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"enterprise-crud/internal/domain/maintenance"
+)
+
+// maintenanceRepository implements maintenance.Repository in process memory
+// Like the trending repository it keeps its own state rather than the store's.
+// It serves single instances running without Redis.
+type maintenanceRepository struct {
+	mu    sync.Mutex
+	state maintenance.State
+}
+
+// NewMaintenanceRepository creates a maintenance repository with maintenance mode off
+func NewMaintenanceRepository() maintenance.Repository {
+	return &maintenanceRepository{}
+}
+
+// Get retrieves a copy of the maintenance state
+func (r *maintenanceRepository) Get(ctx context.Context) (*maintenance.State, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return cloneMaintenanceState(r.state), nil
+}
+
+// Save replaces the maintenance state
+func (r *maintenanceRepository) Save(ctx context.Context, state *maintenance.State) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.state = *cloneMaintenanceState(*state)
+	return nil
+}
+
+func cloneMaintenanceState(state maintenance.State) *maintenance.State {
+	if state.Window != nil {
+		window := *state.Window
+		state.Window = &window
+	}
+	if state.UpdatedBy != nil {
+		updatedBy := *state.UpdatedBy
+		state.UpdatedBy = &updatedBy
+	}
+	return &state
+}
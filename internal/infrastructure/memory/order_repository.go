@@ -0,0 +1,452 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// orderRepository implements order.Repository on a Store
+// The transaction methods ignore tx; each call is atomic on its own, see NewTxDB.
+type orderRepository struct {
+	store *Store
+}
+
+// NewOrderRepository creates an order repository on store
+func NewOrderRepository(store *Store) order.Repository {
+	return &orderRepository{store: store}
+}
+
+// Create adds an order
+func (r *orderRepository) Create(ctx context.Context, o *order.Order) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.insert(o)
+	return nil
+}
+
+// CreateWithTx adds an order
+func (r *orderRepository) CreateWithTx(ctx context.Context, tx *gorm.DB, o *order.Order) error {
+	return r.Create(ctx, o)
+}
+
+func (r *orderRepository) insert(o *order.Order) {
+	if o.ID == uuid.Nil {
+		o.ID = uuid.New()
+	}
+	if o.Status == "" {
+		o.Status = order.StatusPending
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = now()
+	}
+	o.UpdatedAt = o.CreatedAt
+	r.store.orders[o.ID] = *o
+}
+
+// GetByID retrieves an order by its ID
+func (r *orderRepository) GetByID(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	o, ok := r.store.orders[id]
+	if !ok {
+		return nil, order.NewOrderNotFoundError(id)
+	}
+	return &o, nil
+}
+
+// GetStatus reads the status and last change of an order
+func (r *orderRepository) GetStatus(ctx context.Context, id uuid.UUID) (*order.StatusSnapshot, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	o, ok := r.store.orders[id]
+	if !ok {
+		return nil, order.NewOrderNotFoundError(id)
+	}
+	return &order.StatusSnapshot{ID: o.ID, UserID: o.UserID, Status: o.Status, UpdatedAt: o.UpdatedAt}, nil
+}
+
+// GetByUserID retrieves a user's orders and the gifts they bought that were claimed, newest first
+func (r *orderRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*order.Order, error) {
+	orders := r.filter(func(o order.Order) bool { return o.IsVisibleTo(userID) })
+	sort.SliceStable(orders, func(i, j int) bool { return orders[i].CreatedAt.After(orders[j].CreatedAt) })
+	return orders, nil
+}
+
+// GetByEventID retrieves an event's orders, oldest first
+func (r *orderRepository) GetByEventID(ctx context.Context, eventID uuid.UUID) ([]*order.Order, error) {
+	return r.filter(func(o order.Order) bool { return o.EventID == eventID }), nil
+}
+
+// GetAttendees retrieves the completed orders of an event with their buyers, oldest first
+func (r *orderRepository) GetAttendees(ctx context.Context, eventID uuid.UUID) ([]*order.Attendee, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	orders := r.match(func(o order.Order) bool { return o.EventID == eventID && o.Status == order.StatusCompleted })
+	attendees := make([]*order.Attendee, 0, len(orders))
+	for _, o := range orders {
+		buyer, ok := r.store.users[o.UserID]
+		if !ok {
+			continue // The join drops orders of deleted users too
+		}
+		attendees = append(attendees, &order.Attendee{Order: *o, Email: buyer.Email, Username: buyer.Username})
+	}
+	return attendees, nil
+}
+
+// ListByStatus returns up to limit orders with the given status, oldest first
+func (r *orderRepository) ListByStatus(ctx context.Context, status order.Status, limit int) ([]*order.Order, error) {
+	orders := r.filter(func(o order.Order) bool { return o.Status == status })
+	if limit > 0 && len(orders) > limit {
+		orders = orders[:limit]
+	}
+	return orders, nil
+}
+
+// filter returns copies of the matching orders, oldest first
+func (r *orderRepository) filter(match func(order.Order) bool) []*order.Order {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.match(match)
+}
+
+// match is filter for callers that hold the store's lock
+func (r *orderRepository) match(match func(order.Order) bool) []*order.Order {
+	orders := []*order.Order{}
+	for _, o := range r.store.orders {
+		if match(o) {
+			o := o
+			orders = append(orders, &o)
+		}
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		if !orders[i].CreatedAt.Equal(orders[j].CreatedAt) {
+			return orders[i].CreatedAt.Before(orders[j].CreatedAt)
+		}
+		return orders[i].ID.String() < orders[j].ID.String()
+	})
+	return orders
+}
+
+// Update replaces an order
+func (r *orderRepository) Update(ctx context.Context, o *order.Order) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	o.UpdatedAt = now()
+	r.store.orders[o.ID] = *o
+	return nil
+}
+
+// Delete deletes an order by its ID
+func (r *orderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.orders[id]; !ok {
+		return order.NewOrderNotFoundError(id)
+	}
+	delete(r.store.orders, id)
+	return nil
+}
+
+// MarkCheckedIn records a check-in unless the order was already checked in
+func (r *orderRepository) MarkCheckedIn(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	o, ok := r.store.orders[id]
+	if !ok || o.CheckedInAt != nil {
+		return false, nil
+	}
+	o.CheckedInAt = &at
+	o.UpdatedAt = now()
+	r.store.orders[id] = o
+	return true, nil
+}
+
+// SetGiftToken stores the hash of a new claim token on an unclaimed gift
+func (r *orderRepository) SetGiftToken(ctx context.Context, id uuid.UUID, tokenHash string) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	o, ok := r.store.orders[id]
+	if !ok || !o.IsGift() || o.IsGiftClaimed() {
+		return false, nil
+	}
+	o.GiftTokenHash = tokenHash
+	o.UpdatedAt = now()
+	r.store.orders[id] = o
+	return true, nil
+}
+
+// GetByGiftToken retrieves the gift whose current claim token hashes to tokenHash
+func (r *orderRepository) GetByGiftToken(ctx context.Context, tokenHash string) (*order.Order, error) {
+	orders := r.filter(func(o order.Order) bool { return o.GiftTokenHash != "" && o.GiftTokenHash == tokenHash })
+	if len(orders) == 0 {
+		return nil, order.NewGiftNotFoundError()
+	}
+	return orders[0], nil
+}
+
+// ClaimGift moves an unclaimed gift from its buyer to the recipient
+func (r *orderRepository) ClaimGift(ctx context.Context, id, purchaserID, recipientID uuid.UUID, at time.Time) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	o, ok := r.store.orders[id]
+	if !ok || o.UserID != purchaserID || o.IsGiftClaimed() {
+		return false, nil
+	}
+	o.UserID = recipientID
+	o.GiftedBy = &purchaserID
+	o.GiftClaimedAt = &at
+	o.GiftTokenHash = ""
+	o.UpdatedAt = now()
+	r.store.orders[id] = o
+	return true, nil
+}
+
+// CreateGroupWithTx adds a group reservation
+func (r *orderRepository) CreateGroupWithTx(ctx context.Context, tx *gorm.DB, g *order.Group) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.orderGroups[g.ID] = *g
+	return nil
+}
+
+// GetGroup retrieves a group reservation by its ID
+func (r *orderRepository) GetGroup(ctx context.Context, id uuid.UUID) (*order.Group, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	g, ok := r.store.orderGroups[id]
+	if !ok {
+		return nil, order.NewGroupNotFoundError(id)
+	}
+	g.Shares = nil
+	return &g, nil
+}
+
+// GetByGroupID retrieves the shares of a group reservation
+func (r *orderRepository) GetByGroupID(ctx context.Context, groupID uuid.UUID) ([]*order.Order, error) {
+	return r.filter(func(o order.Order) bool { return o.GroupID != nil && *o.GroupID == groupID }), nil
+}
+
+// GetByShareToken retrieves the group share whose payment link token hashes to tokenHash
+func (r *orderRepository) GetByShareToken(ctx context.Context, tokenHash string) (*order.Order, error) {
+	orders := r.filter(func(o order.Order) bool { return o.ShareTokenHash != "" && o.ShareTokenHash == tokenHash })
+	if len(orders) == 0 {
+		return nil, order.NewGroupShareNotFoundError()
+	}
+	return orders[0], nil
+}
+
+// TakeShare moves a held group share to userID, clearing its payment link
+func (r *orderRepository) TakeShare(ctx context.Context, id uuid.UUID, tokenHash string, userID uuid.UUID, at time.Time) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	o, ok := r.store.orders[id]
+	if !ok || o.ShareTokenHash == "" || o.ShareTokenHash != tokenHash || !o.IsPending() || o.IsHoldExpired(at) {
+		return false, nil
+	}
+	o.UserID = userID
+	o.ShareTokenHash = ""
+	o.UpdatedAt = now()
+	r.store.orders[id] = o
+	return true, nil
+}
+
+// ExpirePending marks pending orders created before cutoff, and group shares held until before at, as failed
+// and returns their tickets to their events
+func (r *orderRepository) ExpirePending(ctx context.Context, cutoff, at time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var expired int64
+	for id, o := range r.store.orders {
+		due := o.CreatedAt.Before(cutoff)
+		if o.HoldUntil != nil {
+			due = o.HoldUntil.Before(at)
+		}
+		if o.Status != order.StatusPending || !due {
+			continue
+		}
+		o.Status = order.StatusFailed
+		o.UpdatedAt = now()
+		r.store.orders[id] = o
+		r.releaseTickets(o.EventID, o.Quantity)
+		expired++
+	}
+	return expired, nil
+}
+
+// ResolveReview moves an order out of REVIEW to status, returning its tickets when status is FAILED
+func (r *orderRepository) ResolveReview(ctx context.Context, id uuid.UUID, status order.Status) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	o, ok := r.store.orders[id]
+	if !ok || o.Status != order.StatusReview {
+		return false, nil
+	}
+	o.Status = status
+	o.UpdatedAt = now()
+	r.store.orders[id] = o
+	if status == order.StatusFailed {
+		r.releaseTickets(o.EventID, o.Quantity)
+	}
+	return true, nil
+}
+
+func (r *orderRepository) releaseTickets(eventID uuid.UUID, quantity int) {
+	if e, ok := r.store.events[eventID]; ok {
+		e.AvailableTickets += quantity
+		r.store.events[eventID] = e
+	}
+}
+
+// GetPurchaseHistory summarises a user's earlier orders for risk scoring
+func (r *orderRepository) GetPurchaseHistory(ctx context.Context, userID, eventID uuid.UUID, since time.Time) (*order.PurchaseHistory, error) {
+	history := &order.PurchaseHistory{}
+	seen := make(map[string]bool)
+	for _, o := range r.filter(func(o order.Order) bool { return o.UserID == userID }) {
+		if o.EventID == eventID && o.Status != order.StatusFailed {
+			history.EventOrders++
+		}
+		if !o.CreatedAt.Before(since) {
+			history.RecentOrders++
+		}
+		if o.Country != "" && !seen[o.Country] {
+			seen[o.Country] = true
+			history.Countries = append(history.Countries, o.Country)
+		}
+	}
+	return history, nil
+}
+
+// FindTicketDrift returns the events whose available tickets differ from their total minus reserved tickets
+func (r *orderRepository) FindTicketDrift(ctx context.Context) ([]*order.TicketCount, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	counts := []*order.TicketCount{}
+	for _, e := range r.store.events {
+		count := &order.TicketCount{
+			EventID:          e.ID,
+			TotalTickets:     e.TotalTickets,
+			AvailableTickets: e.AvailableTickets,
+			ReservedTickets:  r.reservedTickets(e.ID),
+		}
+		if count.AvailableTickets != count.ExpectedAvailable() {
+			counts = append(counts, count)
+		}
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i].EventID.String() < counts[j].EventID.String() })
+	return counts, nil
+}
+
+// CorrectAvailableTickets recomputes an event's available tickets from its orders if the counter still reads observed
+func (r *orderRepository) CorrectAvailableTickets(ctx context.Context, eventID uuid.UUID, observed int) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	e, ok := r.store.events[eventID]
+	if !ok || e.AvailableTickets != observed {
+		return false, nil
+	}
+	expected := e.TotalTickets - r.reservedTickets(eventID)
+	if expected < 0 {
+		return false, nil
+	}
+	e.AvailableTickets = expected
+	r.store.events[eventID] = e
+	return true, nil
+}
+
+// reservedTickets sums the tickets held by an event's orders; the caller holds the store's lock
+func (r *orderRepository) reservedTickets(eventID uuid.UUID) int {
+	reserved := 0
+	for _, o := range r.store.orders {
+		if o.EventID == eventID && o.Status != order.StatusFailed {
+			reserved += o.Quantity
+		}
+	}
+	return reserved
+}
+
+// ArchiveEvents archives nothing: the store has no archive, and it is gone on restart anyway
+func (r *orderRepository) ArchiveEvents(ctx context.Context, cutoff time.Time, limit int) (*order.Archived, error) {
+	return &order.Archived{}, nil
+}
+
+// LockEventWithTx reads what order creation needs of an event
+func (r *orderRepository) LockEventWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID) (*order.EventInfo, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	e, ok := r.store.events[eventID]
+	if !ok {
+		return nil, order.NewEventNotFoundError(eventID)
+	}
+	return eventInfo(e), nil
+}
+
+// ReserveTicketsWithTx takes quantity tickets of an active, approved event
+// It returns nil when the event is missing, not active, held by moderation or short of tickets.
+func (r *orderRepository) ReserveTicketsWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID, quantity int) (*order.EventInfo, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	e, ok := r.store.events[eventID]
+	if !ok || e.Status != event.StatusActive || e.IsFlagged() || e.AvailableTickets < quantity {
+		return nil, nil
+	}
+	e.AvailableTickets -= quantity
+	r.store.events[eventID] = e
+	return eventInfo(e), nil
+}
+
+// UpdateEventTicketsWithTx sets an event's available tickets
+func (r *orderRepository) UpdateEventTicketsWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID, newAvailableTickets int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	e, ok := r.store.events[eventID]
+	if !ok {
+		return order.NewEventNotFoundError(eventID)
+	}
+	e.AvailableTickets = newAvailableTickets
+	r.store.events[eventID] = e
+	return nil
+}
+
+func eventInfo(e event.Event) *order.EventInfo {
+	return &order.EventInfo{
+		ID:               e.ID,
+		TicketPrice:      e.TicketPrice,
+		PriceSchedule:    cloneEvent(e).PriceSchedule,
+		AvailableTickets: e.AvailableTickets,
+		Status:           e.Status,
+		Flagged:          e.IsFlagged(),
+		Questions:        cloneEvent(e).AttendeeQuestions,
+
+		AccessCodeRequired: e.AccessCodeRequired,
+		SaleStart:          e.SaleStart,
+		SaleEnd:            e.SaleEnd,
+		MinimumAge:         e.MinimumAge,
+	}
+}
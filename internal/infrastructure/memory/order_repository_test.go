@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/fixtures"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderRepository_ReserveTicketsWithTx(t *testing.T) {
+	store := NewStore(fixtures.Default(time.Now()))
+	repo := NewOrderRepository(store)
+	ctx := context.Background()
+
+	info, err := repo.ReserveTicketsWithTx(ctx, nil, fixtures.ConcertID, 6)
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.Equal(t, 490, info.AvailableTickets)
+
+	// Sold out, completed and missing events reserve nothing
+	for _, id := range []uuid.UUID{fixtures.SoldOutID, fixtures.PastID, fixtures.ArenaID} {
+		info, err := repo.ReserveTicketsWithTx(ctx, nil, id, 1)
+		require.NoError(t, err, id)
+		assert.Nil(t, info, id)
+	}
+
+	// Neither do events held by moderation
+	held := store.events[fixtures.ConcertID]
+	held.ModerationStatus = event.ModerationFlagged
+	store.events[fixtures.ConcertID] = held
+	info, err = repo.ReserveTicketsWithTx(ctx, nil, fixtures.ConcertID, 1)
+	require.NoError(t, err)
+	assert.Nil(t, info)
+}
+
+func TestOrderRepository_FixturesHaveNoTicketDrift(t *testing.T) {
+	repo := NewOrderRepository(NewStore(fixtures.Default(time.Now())))
+
+	drift, err := repo.FindTicketDrift(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, drift)
+}
+
+func TestOrderRepository_ReturnsCopies(t *testing.T) {
+	repo := NewOrderRepository(NewStore(fixtures.Default(time.Now())))
+	ctx := context.Background()
+
+	o, err := repo.GetByID(ctx, fixtures.ConcertOrderID)
+	require.NoError(t, err)
+	o.Status = order.StatusFailed
+
+	stored, err := repo.GetByID(ctx, fixtures.ConcertOrderID)
+	require.NoError(t, err)
+	assert.Equal(t, order.StatusCompleted, stored.Status)
+}
+
+func TestOrderRepository_GetStatus_FollowsChanges(t *testing.T) {
+	repo := NewOrderRepository(NewStore(fixtures.Default(time.Now())))
+	ctx := context.Background()
+
+	placed := &order.Order{UserID: fixtures.UserID, EventID: fixtures.ConcertID, Quantity: 1, Status: order.StatusReview}
+	require.NoError(t, repo.Create(ctx, placed))
+	before, err := repo.GetStatus(ctx, placed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, order.StatusReview, before.Status)
+	assert.Equal(t, fixtures.UserID, before.UserID)
+	assert.Equal(t, placed.CreatedAt, before.UpdatedAt)
+
+	resolved, err := repo.ResolveReview(ctx, placed.ID, order.StatusPending)
+	require.NoError(t, err)
+	require.True(t, resolved)
+	after, err := repo.GetStatus(ctx, placed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, order.StatusPending, after.Status)
+	assert.False(t, after.UpdatedAt.Before(before.UpdatedAt))
+
+	_, err = repo.GetStatus(ctx, uuid.New())
+	assert.True(t, order.IsOrderNotFoundError(err))
+}
+
+func TestOrderRepository_ReserveTicketsWithTx_NeverOversells(t *testing.T) {
+	repo := NewOrderRepository(NewStore(fixtures.Default(time.Now())))
+
+	var wg sync.WaitGroup
+	var reserved atomic.Int32
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			info, err := repo.ReserveTicketsWithTx(context.Background(), nil, fixtures.WorkshopID, 10)
+			if err == nil && info != nil {
+				reserved.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(4), reserved.Load(), "40 tickets in reservations of 10")
+}
+
+func TestOrderRepository_ExpirePending_GroupSharesExpireAtTheirHold(t *testing.T) {
+	store := NewStore(fixtures.Default(time.Now()))
+	repo := NewOrderRepository(store)
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := repo.ReserveTicketsWithTx(ctx, nil, fixtures.ConcertID, 4)
+	require.NoError(t, err)
+	groupID := uuid.New()
+	heldUntil, ended := now.Add(time.Hour), now.Add(-time.Minute)
+	held := &order.Order{UserID: fixtures.UserID, EventID: fixtures.ConcertID, Quantity: 2, GroupID: &groupID, HoldUntil: &heldUntil, CreatedAt: now.Add(-2 * time.Hour)}
+	lapsed := &order.Order{UserID: fixtures.UserID, EventID: fixtures.ConcertID, Quantity: 2, GroupID: &groupID, HoldUntil: &ended, CreatedAt: now.Add(-2 * time.Minute)}
+	require.NoError(t, repo.Create(ctx, held))
+	require.NoError(t, repo.Create(ctx, lapsed))
+
+	// A cutoff both shares are older than only expires the one whose hold ended
+	expired, err := repo.ExpirePending(ctx, now, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), expired)
+	stored, err := repo.GetByID(ctx, lapsed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, order.StatusFailed, stored.Status)
+	stored, err = repo.GetByID(ctx, held.ID)
+	require.NoError(t, err)
+	assert.Equal(t, order.StatusPending, stored.Status)
+
+	drift, err := repo.FindTicketDrift(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, drift, "the lapsed share's tickets went back to the event")
+}
@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"enterprise-crud/internal/domain/plan"
+
+	"github.com/google/uuid"
+)
+
+// planRepository implements plan.Repository on a Store
+type planRepository struct {
+	store *Store
+}
+
+// NewPlanRepository creates a plan repository on store
+func NewPlanRepository(store *Store) plan.Repository {
+	return &planRepository{store: store}
+}
+
+// GetAll retrieves all plans by name
+func (r *planRepository) GetAll(ctx context.Context) ([]*plan.Plan, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	plans := make([]*plan.Plan, 0, len(r.store.plans))
+	for _, p := range r.store.plans {
+		p := p
+		plans = append(plans, &p)
+	}
+	sort.Slice(plans, func(i, j int) bool { return plans[i].Name < plans[j].Name })
+	return plans, nil
+}
+
+// GetByName retrieves a plan by its name
+func (r *planRepository) GetByName(ctx context.Context, name string) (*plan.Plan, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, p := range r.store.plans {
+		if p.Name == name {
+			return &p, nil
+		}
+	}
+	return nil, plan.NewPlanNotFoundError(name)
+}
+
+// GetByUserID retrieves the plan assigned to a user, or nil if none is assigned
+func (r *planRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*plan.Plan, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	u, ok := r.store.users[userID]
+	if !ok || u.PlanID == nil {
+		return nil, nil
+	}
+	p, ok := r.store.plans[*u.PlanID]
+	if !ok {
+		return nil, nil
+	}
+	return &p, nil
+}
+
+// AssignToUser sets the plan of a user
+func (r *planRepository) AssignToUser(ctx context.Context, userID, planID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	u, ok := r.store.users[userID]
+	if !ok {
+		return plan.NewUserNotFoundError(userID)
+	}
+	u = cloneUser(u)
+	u.PlanID = &planID
+	r.store.users[userID] = u
+	return nil
+}
@@ -0,0 +1,141 @@
+// Package memory implements the user, role, plan, venue, event, order and trending repositories on in-process maps
+// It backs the mock server, which serves the API to frontend developers without Postgres or Redis,
+// and unit tests that want real repository behaviour without a database.
+// Nothing is persisted: every start begins from the fixture data again.
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/plan"
+	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/domain/venue"
+	"enterprise-crud/internal/fixtures"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Store holds the records of the in-memory repositories
+// Repositories built on the same store share its records, as the database repositories share tables.
+type Store struct {
+	mu            sync.RWMutex
+	roles         map[role.Name]role.Role
+	plans         map[uuid.UUID]plan.Plan
+	users         map[uuid.UUID]user.User
+	statusChanges []user.StatusChange
+	venues        map[uuid.UUID]venue.Venue
+	events        map[uuid.UUID]event.Event
+	eventSlugs    map[string]uuid.UUID
+	adjustments   []event.InventoryAdjustment
+	orders        map[uuid.UUID]order.Order
+	orderGroups   map[uuid.UUID]order.Group
+}
+
+// NewStore creates a store holding a copy of data; nil data starts it empty
+func NewStore(data *fixtures.Data) *Store {
+	s := &Store{
+		roles:       make(map[role.Name]role.Role),
+		plans:       make(map[uuid.UUID]plan.Plan),
+		users:       make(map[uuid.UUID]user.User),
+		venues:      make(map[uuid.UUID]venue.Venue),
+		events:      make(map[uuid.UUID]event.Event),
+		eventSlugs:  make(map[string]uuid.UUID),
+		orders:      make(map[uuid.UUID]order.Order),
+		orderGroups: make(map[uuid.UUID]order.Group),
+	}
+	if data == nil {
+		return s
+	}
+	for _, r := range data.Roles {
+		s.roles[r.Name] = r
+	}
+	for _, p := range data.Plans {
+		s.plans[p.ID] = p
+	}
+	for _, u := range data.Users {
+		s.users[u.ID] = cloneUser(u)
+	}
+	for _, v := range data.Venues {
+		s.venues[v.ID] = cloneVenue(v)
+	}
+	for _, e := range data.Events {
+		s.events[e.ID] = cloneEvent(e)
+		s.eventSlugs[e.Slug] = e.ID
+	}
+	for _, o := range data.Orders {
+		s.orders[o.ID] = o
+	}
+	return s
+}
+
+// NewTxDB returns a *gorm.DB whose transactions begin and commit without a database
+// Services that group repository calls in db.Transaction can run on the in-memory repositories
+// with it. Nothing is rolled back: writes made before a failure in the transaction are kept.
+func NewTxDB() (*gorm.DB, error) {
+	return gorm.Open(postgres.New(postgres.Config{Conn: txPool{}}), &gorm.Config{DisableAutomaticPing: true})
+}
+
+// txPool hands out transactions that run no SQL
+type txPool struct{ gorm.ConnPool }
+
+func (txPool) BeginTx(context.Context, *sql.TxOptions) (gorm.ConnPool, error) {
+	return &tx{}, nil
+}
+
+type tx struct{ gorm.ConnPool }
+
+func (*tx) Commit() error   { return nil }
+func (*tx) Rollback() error { return nil }
+
+// now is when records are created or updated, as the database's timestamps would be
+func now() time.Time {
+	return time.Now().UTC()
+}
+
+// Records are stored and returned as copies, so callers can't change the store by mutating them
+
+func cloneUser(u user.User) user.User {
+	u.Roles = slices.Clone(u.Roles)
+	if u.Roles == nil {
+		u.Roles = []role.Role{}
+	}
+	return u
+}
+
+func cloneVenue(v venue.Venue) venue.Venue {
+	v.Layouts = slices.Clone(v.Layouts)
+	if v.Layouts == nil {
+		v.Layouts = venue.Layouts{}
+	}
+	if v.OwnerID != nil {
+		ownerID := *v.OwnerID
+		v.OwnerID = &ownerID
+	}
+	return v
+}
+
+func cloneEvent(e event.Event) event.Event {
+	e.AttendeeQuestions = slices.Clone(e.AttendeeQuestions)
+	if e.AttendeeQuestions == nil {
+		e.AttendeeQuestions = event.Questions{}
+	}
+	e.ContentWarnings = slices.Clone(e.ContentWarnings)
+	if e.ContentWarnings == nil {
+		e.ContentWarnings = event.ContentWarnings{}
+	}
+	e.PriceSchedule = slices.Clone(e.PriceSchedule)
+	if e.PriceSchedule == nil {
+		e.PriceSchedule = event.PriceSchedule{}
+	}
+	e.RefundPolicy.Tiers = slices.Clone(e.RefundPolicy.Tiers)
+	return e
+}
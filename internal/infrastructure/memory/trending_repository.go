@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/domain/trending"
+
+	"github.com/google/uuid"
+)
+
+// trendingRepository implements trending.Repository in process memory
+// Unlike the other repositories it keeps its own records: scores are not part of the fixture data.
+// It serves single instances running without Redis.
+type trendingRepository struct {
+	mu        sync.Mutex
+	scores    map[uuid.UUID]*trending.Score
+	decayedAt time.Time
+}
+
+// NewTrendingRepository creates an empty trending repository
+func NewTrendingRepository() trending.Repository {
+	return &trendingRepository{scores: make(map[uuid.UUID]*trending.Score)}
+}
+
+// Increment adds 1 to an event's count of signal and weight to its score
+func (r *trendingRepository) Increment(ctx context.Context, eventID uuid.UUID, signal trending.Signal, weight float64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	score, ok := r.scores[eventID]
+	if !ok {
+		score = &trending.Score{EventID: eventID}
+		r.scores[eventID] = score
+	}
+	score.Score += weight
+	switch signal {
+	case trending.SignalView:
+		score.Views++
+	case trending.SignalPurchase:
+		score.Purchases++
+	}
+	return nil
+}
+
+// Top returns up to limit events with the highest scores, highest first
+func (r *trendingRepository) Top(ctx context.Context, limit int) ([]*trending.Score, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	scores := make([]*trending.Score, 0, len(r.scores))
+	for _, score := range r.scores {
+		score := *score
+		scores = append(scores, &score)
+	}
+	// Ties go to the larger ID, as in a Redis sorted set read in reverse
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].EventID.String() > scores[j].EventID.String()
+	})
+	if len(scores) > limit {
+		scores = scores[:limit]
+	}
+	return scores, nil
+}
+
+// Decay halves scores and counts for every halfLife passed since the previous decay
+func (r *trendingRepository) Decay(ctx context.Context, now time.Time, halfLife time.Duration, floor float64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := r.decayedAt
+	if !now.After(last) {
+		return nil
+	}
+	r.decayedAt = now
+	if last.IsZero() {
+		return nil
+	}
+
+	factor := math.Pow(0.5, float64(now.Sub(last))/float64(halfLife))
+	for eventID, score := range r.scores {
+		score.Score *= factor
+		score.Views *= factor
+		score.Purchases *= factor
+		if score.Score < floor {
+			delete(r.scores, eventID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/domain/user"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// userRepository implements user.Repository on a Store
+// Lookups that find nothing return gorm.ErrRecordNotFound, as the database repository does.
+type userRepository struct {
+	store *Store
+}
+
+// NewUserRepository creates a user repository on store
+func NewUserRepository(store *Store) user.Repository {
+	return &userRepository{store: store}
+}
+
+// Create adds a user, rejecting a taken email (ignoring case) or username
+func (r *userRepository) Create(ctx context.Context, u *user.User) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, existing := range r.store.users {
+		if strings.EqualFold(existing.Email, u.Email) {
+			return user.NewUserExistsError(u.Email)
+		}
+		if existing.Username == u.Username {
+			return user.NewUsernameExistsError(u.Username)
+		}
+	}
+
+	if u.ID == uuid.Nil {
+		u.ID = uuid.New()
+	}
+	if u.Status == "" {
+		u.Status = user.StatusActive
+	}
+	u.CreatedAt = now()
+	u.UpdatedAt = u.CreatedAt
+	r.store.users[u.ID] = cloneUser(*u)
+	return nil
+}
+
+// GetByEmail retrieves a user by email address, ignoring case
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*user.User, error) {
+	return r.find(func(u user.User) bool { return strings.EqualFold(u.Email, email) })
+}
+
+// GetByUsername retrieves a user by username
+func (r *userRepository) GetByUsername(ctx context.Context, username string) (*user.User, error) {
+	return r.find(func(u user.User) bool { return u.Username == username })
+}
+
+// GetByID retrieves a user by ID
+func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return r.find(func(u user.User) bool { return u.ID == id })
+}
+
+// GetStatus retrieves a user; the in-memory copy is as cheap as its status fields
+func (r *userRepository) GetStatus(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return r.GetByID(ctx, id)
+}
+
+func (r *userRepository) find(match func(user.User) bool) (*user.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, u := range r.store.users {
+		if match(u) {
+			found := cloneUser(u)
+			return &found, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// AddRole grants a role to a user; granting a role the user has is a no-op
+func (r *userRepository) AddRole(ctx context.Context, userID uuid.UUID, roleEntity *role.Role) error {
+	return r.update(userID, func(u *user.User) {
+		if !u.HasRole(roleEntity.Name) {
+			u.Roles = append(u.Roles, *roleEntity)
+		}
+	})
+}
+
+// RemoveRole revokes a role from a user
+func (r *userRepository) RemoveRole(ctx context.Context, userID uuid.UUID, roleEntity *role.Role) error {
+	return r.update(userID, func(u *user.User) {
+		u.Roles = slices.DeleteFunc(u.Roles, func(granted role.Role) bool { return granted.Name == roleEntity.Name })
+	})
+}
+
+// UpdateStatus sets a user's status and records the change
+func (r *userRepository) UpdateStatus(ctx context.Context, change *user.StatusChange) error {
+	return r.update(change.UserID, func(u *user.User) {
+		u.Status = change.Status
+		u.StatusReason = change.Reason
+		u.StatusChangedAt = &change.CreatedAt
+		u.UpdatedAt = change.CreatedAt
+
+		if change.ID == uuid.Nil {
+			change.ID = uuid.New()
+		}
+		r.store.statusChanges = append(r.store.statusChanges, *change)
+	})
+}
+
+// ListStatusChanges retrieves a user's status changes, newest first
+func (r *userRepository) ListStatusChanges(ctx context.Context, userID uuid.UUID) ([]*user.StatusChange, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	changes := []*user.StatusChange{}
+	for _, c := range r.store.statusChanges {
+		if c.UserID == userID {
+			change := c
+			changes = append(changes, &change)
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].CreatedAt.After(changes[j].CreatedAt) })
+	return changes, nil
+}
+
+// SetDateOfBirth sets or removes a user's date of birth
+func (r *userRepository) SetDateOfBirth(ctx context.Context, id uuid.UUID, dateOfBirth *time.Time) error {
+	return r.update(id, func(u *user.User) {
+		u.DateOfBirth = dateOfBirth
+		u.UpdatedAt = now()
+	})
+}
+
+// UpdatePassword replaces a user's password hash and its algorithm
+func (r *userRepository) UpdatePassword(ctx context.Context, id uuid.UUID, hash, algorithm string) error {
+	return r.update(id, func(u *user.User) {
+		u.Password = hash
+		u.PasswordAlgorithm = algorithm
+		u.UpdatedAt = now()
+	})
+}
+
+// update applies a change to a copy of a user and stores it, holding the store's lock throughout
+func (r *userRepository) update(id uuid.UUID, apply func(*user.User)) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	u, ok := r.store.users[id]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	u = cloneUser(u)
+	apply(&u)
+	r.store.users[id] = u
+	return nil
+}
+
+// roleRepository implements role.Repository on a Store
+type roleRepository struct {
+	store *Store
+}
+
+// NewRoleRepository creates a role repository on store
+func NewRoleRepository(store *Store) role.Repository {
+	return &roleRepository{store: store}
+}
+
+// GetByName retrieves a role by its name
+func (r *roleRepository) GetByName(ctx context.Context, name role.Name) (*role.Role, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	found, ok := r.store.roles[name]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &found, nil
+}
@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/fixtures"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestUserRepository_Create_RejectsTakenEmailAndUsername(t *testing.T) {
+	repo := NewUserRepository(NewStore(fixtures.Default(time.Now())))
+	ctx := context.Background()
+
+	err := repo.Create(ctx, &user.User{Email: "USER@example.com", Username: "someone"})
+	var userErr *user.UserError
+	require.ErrorAs(t, err, &userErr)
+	assert.Equal(t, "USER_EXISTS", userErr.Code)
+
+	err = repo.Create(ctx, &user.User{Email: "someone@example.com", Username: "user"})
+	require.ErrorAs(t, err, &userErr)
+	assert.Equal(t, "USERNAME_EXISTS", userErr.Code)
+}
+
+func TestUserRepository_GetByEmail(t *testing.T) {
+	repo := NewUserRepository(NewStore(fixtures.Default(time.Now())))
+	ctx := context.Background()
+
+	found, err := repo.GetByEmail(ctx, "Organizer@Example.com")
+	require.NoError(t, err)
+	assert.Equal(t, fixtures.OrganizerID, found.ID)
+	assert.True(t, found.HasRole(role.RoleOrganizer))
+
+	_, err = repo.GetByEmail(ctx, "nobody@example.com")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestUserRepository_Roles(t *testing.T) {
+	store := NewStore(fixtures.Default(time.Now()))
+	users := NewUserRepository(store)
+	ctx := context.Background()
+	organizer, err := NewRoleRepository(store).GetByName(ctx, role.RoleOrganizer)
+	require.NoError(t, err)
+
+	require.NoError(t, users.AddRole(ctx, fixtures.UserID, organizer))
+	require.NoError(t, users.AddRole(ctx, fixtures.UserID, organizer))
+	u, err := users.GetByID(ctx, fixtures.UserID)
+	require.NoError(t, err)
+	assert.Len(t, u.Roles, 2, "granting a role twice keeps one")
+
+	require.NoError(t, users.RemoveRole(ctx, fixtures.UserID, organizer))
+	u, err = users.GetByID(ctx, fixtures.UserID)
+	require.NoError(t, err)
+	assert.False(t, u.HasRole(role.RoleOrganizer))
+
+	assert.ErrorIs(t, users.AddRole(ctx, fixtures.ConcertID, organizer), gorm.ErrRecordNotFound)
+}
+
+func TestUserRepository_UpdateStatus(t *testing.T) {
+	repo := NewUserRepository(NewStore(fixtures.Default(time.Now())))
+	ctx := context.Background()
+	start := time.Now()
+
+	require.NoError(t, repo.UpdateStatus(ctx, &user.StatusChange{UserID: fixtures.UserID, Status: user.StatusSuspended, Reason: "chargeback", CreatedAt: start}))
+	require.NoError(t, repo.UpdateStatus(ctx, &user.StatusChange{UserID: fixtures.UserID, Status: user.StatusActive, CreatedAt: start.Add(time.Minute)}))
+
+	u, err := repo.GetStatus(ctx, fixtures.UserID)
+	require.NoError(t, err)
+	assert.True(t, u.IsActive())
+
+	changes, err := repo.ListStatusChanges(ctx, fixtures.UserID)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, user.StatusActive, changes[0].Status, "newest first")
+
+	err = repo.UpdateStatus(ctx, &user.StatusChange{UserID: fixtures.ConcertID, Status: user.StatusBanned})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
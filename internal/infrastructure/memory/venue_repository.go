@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"enterprise-crud/internal/domain/venue"
+
+	"github.com/google/uuid"
+)
+
+// venueRepository implements venue.Repository on a Store
+type venueRepository struct {
+	store *Store
+}
+
+// NewVenueRepository creates a venue repository on store
+func NewVenueRepository(store *Store) venue.Repository {
+	return &venueRepository{store: store}
+}
+
+// Create adds a venue
+func (r *venueRepository) Create(ctx context.Context, v *venue.Venue) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if v.ID == uuid.Nil {
+		v.ID = uuid.New()
+	}
+	v.CreatedAt = now()
+	v.UpdatedAt = v.CreatedAt
+	r.store.venues[v.ID] = cloneVenue(*v)
+	return nil
+}
+
+// GetByID retrieves a venue by its ID
+func (r *venueRepository) GetByID(ctx context.Context, id uuid.UUID) (*venue.Venue, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	v, ok := r.store.venues[id]
+	if !ok {
+		return nil, venue.NewVenueNotFoundError(id)
+	}
+	v = cloneVenue(v)
+	return &v, nil
+}
+
+// GetAll retrieves all venues, oldest first
+func (r *venueRepository) GetAll(ctx context.Context) ([]*venue.Venue, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	venues := make([]*venue.Venue, 0, len(r.store.venues))
+	for _, v := range r.store.venues {
+		v = cloneVenue(v)
+		venues = append(venues, &v)
+	}
+	sort.Slice(venues, func(i, j int) bool {
+		if !venues[i].CreatedAt.Equal(venues[j].CreatedAt) {
+			return venues[i].CreatedAt.Before(venues[j].CreatedAt)
+		}
+		return venues[i].ID.String() < venues[j].ID.String()
+	})
+	return venues, nil
+}
+
+// Update replaces a venue
+func (r *venueRepository) Update(ctx context.Context, v *venue.Venue) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	v.UpdatedAt = now()
+	r.store.venues[v.ID] = cloneVenue(*v)
+	return nil
+}
+
+// Delete deletes a venue by its ID
+func (r *venueRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.venues[id]; !ok {
+		return venue.NewVenueNotFoundError(id)
+	}
+	delete(r.store.venues, id)
+	return nil
+}
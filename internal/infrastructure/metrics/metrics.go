@@ -0,0 +1,226 @@
+// Package metrics defines the Prometheus collectors exported by the application.
+// Collectors are registered on the default registry and served at GET /metrics.
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes every application metric
+const namespace = "enterprise_crud"
+
+var (
+	// DBUp reports whether the last database health check succeeded (1) or failed (0)
+	DBUp = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "up",
+		Help:      "Whether the last database health check succeeded.",
+	})
+
+	// DBHealthCheckFailures counts failed database health checks by reason
+	DBHealthCheckFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "health_check_failures_total",
+		Help:      "Number of failed database health checks.",
+	}, []string{"reason"})
+
+	// DBSlowQueries counts queries slower than database.slow_query_threshold by operation and table
+	DBSlowQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "slow_queries_total",
+		Help:      "Number of database queries slower than the configured threshold.",
+	}, []string{"operation", "table"})
+
+	// BreakerStateChanges counts circuit breaker transitions
+	BreakerStateChanges = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "circuit_breaker",
+		Name:      "state_changes_total",
+		Help:      "Number of circuit breaker state transitions.",
+	}, []string{"name", "from", "to"})
+
+	// BreakerOpen reports whether a circuit breaker is currently open (1) or not (0)
+	BreakerOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "circuit_breaker",
+		Name:      "open",
+		Help:      "Whether the circuit breaker is currently open.",
+	}, []string{"name"})
+
+	// CacheRequests counts event repository reads by key family and outcome (hit, miss or error)
+	// The hit ratio per family is what TTLs are tuned against.
+	CacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "Number of cached event reads by key family and outcome.",
+	}, []string{"family", "result"})
+
+	// CacheReadDuration measures event repository reads by key family and where they were served from
+	CacheReadDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "read_duration_seconds",
+		Help:      "Duration of cached event reads by key family and source (cache or database).",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"family", "source"})
+
+	// CacheLookups counts lookups in each cache layer (local or redis) by key family and result
+	// Results are hit, miss, error, or bypassed while the Redis circuit breaker is open.
+	CacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "lookups_total",
+		Help:      "Number of event cache lookups by key family, layer and result.",
+	}, []string{"family", "layer", "result"})
+
+	// CacheRedisDuration measures Redis round trips of the event cache by key family and operation
+	CacheRedisDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "redis_duration_seconds",
+		Help:      "Duration of event cache Redis calls by key family and operation.",
+		Buckets:   []float64{.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25},
+	}, []string{"family", "operation"})
+
+	// CacheRedisErrors counts failed Redis calls of the event cache by key family and operation
+	CacheRedisErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "redis_errors_total",
+		Help:      "Number of failed event cache Redis calls by key family and operation.",
+	}, []string{"family", "operation"})
+
+	// TicketDiscrepancies reports how many events had a ticket counter disagreeing with their orders in the last reconciliation
+	TicketDiscrepancies = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "tickets",
+		Name:      "reconciliation_discrepancies",
+		Help:      "Events whose available ticket counter disagreed with their orders in the last reconciliation.",
+	})
+
+	// TicketCorrections counts ticket counters reset by reconciliation
+	TicketCorrections = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tickets",
+		Name:      "reconciliation_corrections_total",
+		Help:      "Number of available ticket counters corrected by reconciliation.",
+	})
+
+	// TicketReconciliationLastSuccess is the Unix time of the last reconciliation that completed
+	// Alert on it going stale rather than on discrepancies alone, which a broken job would never report.
+	TicketReconciliationLastSuccess = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "tickets",
+		Name:      "reconciliation_last_success_timestamp_seconds",
+		Help:      "Unix time of the last completed ticket reconciliation.",
+	})
+
+	// ConcurrencyInFlight reports the requests of a concurrency-limited route holding a slot on this instance
+	ConcurrencyInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "concurrency",
+		Name:      "in_flight",
+		Help:      "Requests of a concurrency-limited route holding a slot on this instance.",
+	}, []string{"route"})
+
+	// ConcurrencyQueued reports the requests of a route waiting for a slot on this instance
+	ConcurrencyQueued = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "concurrency",
+		Name:      "queued",
+		Help:      "Requests of a concurrency-limited route waiting for a slot on this instance.",
+	}, []string{"route"})
+
+	// ConcurrencyWaitDuration measures how long requests waited for a slot by route and result (acquired or rejected)
+	ConcurrencyWaitDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "concurrency",
+		Name:      "wait_duration_seconds",
+		Help:      "Time requests waited for a slot of a concurrency-limited route, by result.",
+		Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"route", "result"})
+
+	// ConcurrencyRejections counts requests answered 429 by route and the limit they hit (user or total)
+	ConcurrencyRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "concurrency",
+		Name:      "rejections_total",
+		Help:      "Requests of a concurrency-limited route rejected for want of a slot, by the limit they hit.",
+	}, []string{"route", "limit"})
+
+	// AuditExportEntries counts audit entries a sink acknowledged, by source
+	AuditExportEntries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "audit_export",
+		Name:      "entries_total",
+		Help:      "Audit entries acknowledged by the export sink, by source; replays after failures count again.",
+	}, []string{"source"})
+
+	// AuditExportFailures counts batches the sink failed to acknowledge, by source
+	AuditExportFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "audit_export",
+		Name:      "failures_total",
+		Help:      "Audit entry batches the export sink failed to acknowledge, by source.",
+	}, []string{"source"})
+
+	// AuditExportLag reports the age of the last exported entry of each source when it was acknowledged
+	AuditExportLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "audit_export",
+		Name:      "lag_seconds",
+		Help:      "Age of the last audit entry of a source acknowledged by the export sink, at the time it was acknowledged.",
+	}, []string{"source"})
+
+	// BuildInfo is always 1; its labels describe the running build so dashboards can join on them
+	BuildInfo = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "build_info",
+		Help:      "Build the running binary was made from.",
+	}, []string{"version", "commit", "build_time", "go_version"})
+)
+
+// SetBuildInfo publishes the running build on BuildInfo
+func SetBuildInfo(version, commit, buildTime, goVersion string) {
+	BuildInfo.Reset()
+	BuildInfo.WithLabelValues(version, commit, buildTime, goVersion).Set(1)
+}
+
+// ObserveBreakerStateChange records a circuit breaker transition
+func ObserveBreakerStateChange(name, from, to string) {
+	BreakerStateChanges.WithLabelValues(name, from, to).Inc()
+
+	open := 0.0
+	if to == "open" {
+		open = 1
+	}
+	BreakerOpen.WithLabelValues(name).Set(open)
+}
+
+// RegisterDBStats exposes connection pool statistics (open, in use, idle, wait count...) for db
+// Registering the same pool twice is a no-op
+func RegisterDBStats(db *sql.DB, dbName string) error {
+	err := prometheus.Register(collectors.NewDBStatsCollector(db, dbName))
+
+	var alreadyRegistered prometheus.AlreadyRegisteredError
+	if errors.As(err, &alreadyRegistered) {
+		return nil
+	}
+	return err
+}
+
+// Handler returns the HTTP handler serving all registered metrics
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
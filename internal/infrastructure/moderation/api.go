@@ -0,0 +1,78 @@
+package moderation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+)
+
+// APIModerator asks an external moderation service about event text
+// The service receives {"title", "description"} and answers {"action", "reason"}, where
+// action is allow, flag or reject.
+type APIModerator struct {
+	client   *http.Client
+	endpoint string
+	apiKey   string
+}
+
+// NewAPIModerator creates a moderator for the service at endpoint
+func NewAPIModerator(endpoint, apiKey string, timeout time.Duration) (*APIModerator, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("moderation api_url %q must be an http or https URL", endpoint)
+	}
+	return &APIModerator{
+		client:   &http.Client{Timeout: timeout},
+		endpoint: endpoint,
+		apiKey:   apiKey,
+	}, nil
+}
+
+// Moderate sends the title and description to the service
+func (m *APIModerator) Moderate(ctx context.Context, title, description string) (event.ModerationVerdict, error) {
+	body, err := json.Marshal(map[string]string{"title": title, "description": description})
+	if err != nil {
+		return event.ModerationVerdict{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return event.ModerationVerdict{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if m.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+m.apiKey)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return event.ModerationVerdict{}, fmt.Errorf("moderation request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return event.ModerationVerdict{}, fmt.Errorf("moderation service unavailable: %s", resp.Status)
+	}
+
+	var result struct {
+		Action string `json:"action"`
+		Reason string `json:"reason"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&result); err != nil {
+		return event.ModerationVerdict{}, fmt.Errorf("invalid moderation response: %w", err)
+	}
+
+	action := strings.ToUpper(result.Action)
+	switch action {
+	case event.ModerationAllow, event.ModerationFlag, event.ModerationReject:
+	default:
+		return event.ModerationVerdict{}, fmt.Errorf("invalid moderation action %q", result.Action)
+	}
+	return event.ModerationVerdict{Action: action, Reason: result.Reason}, nil
+}
@@ -0,0 +1,68 @@
+package moderation
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"enterprise-crud/internal/domain/event"
+)
+
+// Blocklist moderates by configured words and phrases
+// Terms match whole words regardless of case and punctuation, so "ass" doesn't match "class".
+type Blocklist struct {
+	blocked []string
+	review  []string
+}
+
+// NewBlocklist creates a blocklist rejecting text with a blocked term and flagging text with a review term
+func NewBlocklist(blocked, review []string) *Blocklist {
+	return &Blocklist{blocked: normalizeTerms(blocked), review: normalizeTerms(review)}
+}
+
+// Moderate checks the title and description against the term lists; it never fails
+func (b *Blocklist) Moderate(ctx context.Context, title, description string) (event.ModerationVerdict, error) {
+	text := normalize(title + " " + description)
+	if term, ok := findTerm(text, b.blocked); ok {
+		return event.ModerationVerdict{Action: event.ModerationReject, Reason: "contains blocked term " + quote(term)}, nil
+	}
+	if term, ok := findTerm(text, b.review); ok {
+		return event.ModerationVerdict{Action: event.ModerationFlag, Reason: "contains review term " + quote(term)}, nil
+	}
+	return event.ModerationVerdict{Action: event.ModerationAllow}, nil
+}
+
+// findTerm returns the first term found in normalized text
+func findTerm(text string, terms []string) (string, bool) {
+	for _, term := range terms {
+		if strings.Contains(text, term) {
+			return strings.TrimSpace(term), true
+		}
+	}
+	return "", false
+}
+
+// normalizeTerms prepares terms for findTerm, dropping empty ones
+func normalizeTerms(terms []string) []string {
+	normalized := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if n := normalize(term); strings.TrimSpace(n) != "" {
+			normalized = append(normalized, n)
+		}
+	}
+	return normalized
+}
+
+// normalize lowercases s and reduces it to words separated by single spaces,
+// with a space at both ends so substring matches only find whole words
+func normalize(s string) string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return " " + strings.Join(words, " ") + " "
+}
+
+// quote wraps a term in double quotes for a verdict reason
+func quote(term string) string {
+	return `"` + term + `"`
+}
@@ -0,0 +1,59 @@
+// Package moderation checks event titles and descriptions before they are published
+// A blocklist rejects or flags events by configured terms; an optional external API
+// is asked about whatever the blocklist lets through.
+package moderation
+
+import (
+	"context"
+	"fmt"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/event"
+)
+
+// New creates the moderator described by cfg
+// It fails for an endpoint that isn't a valid URL, so a typo doesn't silently switch the API off.
+func New(cfg *config.ModerationConfig) (event.Moderator, error) {
+	moderators := []event.Moderator{NewBlocklist(cfg.BlockedTerms, cfg.ReviewTerms)}
+	if cfg.APIURL != "" {
+		api, err := NewAPIModerator(cfg.APIURL, cfg.APIKey, cfg.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		moderators = append(moderators, api)
+	}
+	return Chain(moderators...), nil
+}
+
+// chain asks its moderators in turn
+type chain []event.Moderator
+
+// Chain combines moderators into one that takes the strictest verdict
+// The first rejection ends the check, so later moderators aren't asked about text that
+// is refused anyway. A flag is kept while the remaining moderators are asked.
+func Chain(moderators ...event.Moderator) event.Moderator {
+	return chain(moderators)
+}
+
+// Moderate asks every moderator until one rejects the text
+func (c chain) Moderate(ctx context.Context, title, description string) (event.ModerationVerdict, error) {
+	verdict := event.ModerationVerdict{Action: event.ModerationAllow}
+	for _, m := range c {
+		v, err := m.Moderate(ctx, title, description)
+		if err != nil {
+			return event.ModerationVerdict{}, err
+		}
+		switch v.Action {
+		case event.ModerationReject:
+			return v, nil
+		case event.ModerationFlag:
+			if verdict.Action != event.ModerationFlag {
+				verdict = v
+			}
+		case event.ModerationAllow:
+		default:
+			return event.ModerationVerdict{}, fmt.Errorf("unknown moderation action %q", v.Action)
+		}
+	}
+	return verdict, nil
+}
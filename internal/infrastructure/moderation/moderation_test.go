@@ -0,0 +1,91 @@
+package moderation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/event"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlocklist(t *testing.T) {
+	ctx := context.Background()
+	blocklist := NewBlocklist([]string{"Fake Tickets", ""}, []string{"rave"})
+
+	verdict, err := blocklist.Moderate(ctx, "FAKE tickets!", "")
+	require.NoError(t, err)
+	assert.Equal(t, event.ModerationReject, verdict.Action)
+	assert.Equal(t, `contains blocked term "fake tickets"`, verdict.Reason)
+
+	verdict, err = blocklist.Moderate(ctx, "Summer party", "An all-night rave.")
+	require.NoError(t, err)
+	assert.Equal(t, event.ModerationFlag, verdict.Action)
+
+	verdict, err = blocklist.Moderate(ctx, "Bravery awards", "Ticket fakery is not a phrase we block")
+	require.NoError(t, err)
+	assert.Equal(t, event.ModerationAllow, verdict.Action, "terms only match whole words")
+}
+
+// stubModerator returns a fixed verdict and counts its calls
+type stubModerator struct {
+	verdict event.ModerationVerdict
+	err     error
+	calls   int
+}
+
+func (m *stubModerator) Moderate(ctx context.Context, title, description string) (event.ModerationVerdict, error) {
+	m.calls++
+	return m.verdict, m.err
+}
+
+func TestChain(t *testing.T) {
+	ctx := context.Background()
+	flag := &stubModerator{verdict: event.ModerationVerdict{Action: event.ModerationFlag, Reason: "first"}}
+	reject := &stubModerator{verdict: event.ModerationVerdict{Action: event.ModerationReject, Reason: "second"}}
+	allow := &stubModerator{verdict: event.ModerationVerdict{Action: event.ModerationAllow}}
+
+	verdict, err := Chain(flag, allow).Moderate(ctx, "title", "")
+	require.NoError(t, err)
+	assert.Equal(t, event.ModerationVerdict{Action: event.ModerationFlag, Reason: "first"}, verdict)
+
+	later := &stubModerator{verdict: event.ModerationVerdict{Action: event.ModerationAllow}}
+	verdict, err = Chain(flag, reject, later).Moderate(ctx, "title", "")
+	require.NoError(t, err)
+	assert.Equal(t, "second", verdict.Reason, "a rejection wins over a flag")
+	assert.Zero(t, later.calls, "moderators after a rejection aren't asked")
+}
+
+func TestAPIModerator(t *testing.T) {
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		if received["title"] == "broken" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"action": "flag", "reason": "possible spam"}`))
+	}))
+	defer server.Close()
+
+	moderator, err := New(&config.ModerationConfig{APIURL: server.URL, APIKey: "key", Timeout: time.Second})
+	require.NoError(t, err)
+
+	verdict, err := moderator.Moderate(context.Background(), "Concert", "Live music")
+	require.NoError(t, err)
+	assert.Equal(t, event.ModerationVerdict{Action: event.ModerationFlag, Reason: "possible spam"}, verdict)
+	assert.Equal(t, map[string]string{"title": "Concert", "description": "Live music"}, received)
+
+	_, err = moderator.Moderate(context.Background(), "broken", "")
+	assert.Error(t, err)
+
+	_, err = New(&config.ModerationConfig{APIURL: "moderation.example/check"})
+	assert.Error(t, err, "the endpoint needs a scheme")
+}
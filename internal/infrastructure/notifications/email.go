@@ -0,0 +1,87 @@
+// Package notifications delivers user notifications through the job queue
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/notification"
+	"enterprise-crud/internal/infrastructure/email"
+	"enterprise-crud/internal/infrastructure/jobs"
+)
+
+// emailTemplate is the email template used for notifications without a dedicated layout
+const emailTemplate = "notification"
+
+// EmailHandler returns the job handler that emails a notification to its recipient
+func EmailHandler(notificationService notification.Service, renderer *email.Renderer, sender email.Sender) jobs.Handler {
+	return func(ctx context.Context, j *job.Job) error {
+		var payload notification.EmailPayload
+		if err := json.Unmarshal(j.Payload, &payload); err != nil {
+			return fmt.Errorf("%w: invalid payload: %v", job.ErrPermanent, err)
+		}
+
+		mail, err := notificationService.PrepareEmail(ctx, payload)
+		if err != nil {
+			if notification.IsUserNotFoundError(err) {
+				return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+			}
+			return err
+		}
+		if mail == nil {
+			// User turned off email for this type after the job was queued
+			return nil
+		}
+
+		name, data := emailData(mail)
+		msg, err := renderer.Render(name, mail.Recipient.Email, data)
+		if err != nil {
+			return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+		}
+		return sender.Send(ctx, msg)
+	}
+}
+
+// emailData picks the template for a notification and the data it is rendered with
+// Notifications queued before their type carried details fall back to the generic template.
+func emailData(mail *notification.Email) (string, interface{}) {
+	recipient := email.Recipient{Email: mail.Recipient.Email, Username: mail.Recipient.Username}
+	eventDate, err := time.Parse(time.RFC3339, mail.Data[notification.DataEventDate])
+	if err != nil {
+		return emailTemplate, mail
+	}
+
+	switch mail.Type {
+	case notification.TypeOrderConfirmed:
+		quantity, qErr := strconv.Atoi(mail.Data[notification.DataQuantity])
+		total, tErr := strconv.ParseFloat(mail.Data[notification.DataTotal], 64)
+		if qErr != nil || tErr != nil || mail.OrderID == nil {
+			return emailTemplate, mail
+		}
+		return "order_confirmation", email.OrderConfirmation{
+			Recipient:  recipient,
+			OrderID:    mail.OrderID.String(),
+			EventTitle: mail.Data[notification.DataEventTitle],
+			EventDate:  eventDate,
+			Quantity:   quantity,
+			Total:      total,
+		}
+	case notification.TypeEventChanged:
+		update := email.EventUpdate{
+			Recipient:  recipient,
+			EventTitle: mail.Data[notification.DataEventTitle],
+			EventDate:  eventDate,
+			Cancelled:  mail.Data[notification.DataCancelled] == "true",
+		}
+		if changes := mail.Data[notification.DataChanges]; changes != "" {
+			update.Changes = strings.Split(changes, ",")
+		}
+		return "event_update", update
+	}
+	return emailTemplate, mail
+}
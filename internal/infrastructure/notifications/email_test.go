@@ -0,0 +1,143 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/notification"
+	"enterprise-crud/internal/infrastructure/email"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockNotificationService is a mock implementation of notification.Service
+// Only PrepareEmail and RemoveDeviceToken are used by the job handlers.
+type mockNotificationService struct {
+	notification.Service
+	mock.Mock
+}
+
+func (m *mockNotificationService) PrepareEmail(ctx context.Context, payload notification.EmailPayload) (*notification.Email, error) {
+	args := m.Called(ctx, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notification.Email), args.Error(1)
+}
+
+func (m *mockNotificationService) RemoveDeviceToken(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+// recordingSender records messages instead of sending them
+type recordingSender struct {
+	sent []*email.Message
+	err  error
+}
+
+func (s *recordingSender) Send(ctx context.Context, msg *email.Message) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func emailJob(t *testing.T, payload notification.EmailPayload) *job.Job {
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return &job.Job{ID: uuid.New(), Type: notification.JobTypeEmail, Payload: data}
+}
+
+func TestEmailHandler(t *testing.T) {
+	renderer, err := email.NewRenderer("")
+	require.NoError(t, err)
+
+	payload := notification.EmailPayload{
+		UserID: uuid.New(),
+		Type:   notification.TypeOrderConfirmed,
+		Title:  "Order confirmed: Jazz Night",
+		Body:   "Your order of 2 tickets for Jazz Night is confirmed.",
+	}
+	mail := &notification.Email{
+		Recipient: &notification.Recipient{UserID: payload.UserID, Email: "fan@example.com", Username: "fan"},
+		Title:     payload.Title,
+		Body:      payload.Body,
+	}
+
+	t.Run("sends the notification", func(t *testing.T) {
+		service := new(mockNotificationService)
+		service.On("PrepareEmail", mock.Anything, payload).Return(mail, nil)
+		sender := &recordingSender{}
+
+		err := EmailHandler(service, renderer, sender)(context.Background(), emailJob(t, payload))
+
+		assert.NoError(t, err)
+		require.Len(t, sender.sent, 1)
+		assert.Equal(t, "fan@example.com", sender.sent[0].To)
+		assert.Equal(t, "Order confirmed: Jazz Night", sender.sent[0].Subject)
+	})
+
+	t.Run("uses the order confirmation layout when details are present", func(t *testing.T) {
+		orderID := uuid.New()
+		detailed := payload
+		detailed.OrderID = &orderID
+		detailed.Data = map[string]string{
+			notification.DataEventTitle: "Jazz Night",
+			notification.DataEventDate:  "2026-11-20T20:00:00Z",
+			notification.DataQuantity:   "2",
+			notification.DataTotal:      "50.00",
+		}
+		detailedMail := *mail
+		detailedMail.Type = detailed.Type
+		detailedMail.OrderID = detailed.OrderID
+		detailedMail.Data = detailed.Data
+		service := new(mockNotificationService)
+		service.On("PrepareEmail", mock.Anything, detailed).Return(&detailedMail, nil)
+		sender := &recordingSender{}
+
+		err := EmailHandler(service, renderer, sender)(context.Background(), emailJob(t, detailed))
+
+		assert.NoError(t, err)
+		require.Len(t, sender.sent, 1)
+		assert.Contains(t, sender.sent[0].Text, orderID.String())
+		assert.Contains(t, sender.sent[0].Text, "Total paid: 50.00")
+	})
+
+	t.Run("skips users who opted out", func(t *testing.T) {
+		service := new(mockNotificationService)
+		service.On("PrepareEmail", mock.Anything, payload).Return(nil, nil)
+		sender := &recordingSender{}
+
+		err := EmailHandler(service, renderer, sender)(context.Background(), emailJob(t, payload))
+
+		assert.NoError(t, err)
+		assert.Empty(t, sender.sent)
+	})
+
+	t.Run("send failures are retried", func(t *testing.T) {
+		service := new(mockNotificationService)
+		service.On("PrepareEmail", mock.Anything, payload).Return(mail, nil)
+
+		err := EmailHandler(service, renderer, &recordingSender{err: errors.New("connection refused")})(context.Background(), emailJob(t, payload))
+
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, job.ErrPermanent))
+	})
+
+	t.Run("deleted users are not retried", func(t *testing.T) {
+		service := new(mockNotificationService)
+		service.On("PrepareEmail", mock.Anything, payload).Return(nil, notification.NewUserNotFoundError(payload.UserID))
+
+		err := EmailHandler(service, renderer, &recordingSender{})(context.Background(), emailJob(t, payload))
+
+		assert.True(t, errors.Is(err, job.ErrPermanent))
+	})
+}
@@ -0,0 +1,72 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/infrastructure/email"
+	"enterprise-crud/internal/infrastructure/jobs"
+)
+
+// giftClaimTemplate is the email template used for gift claim links
+const giftClaimTemplate = "gift_claim"
+
+// GiftClaimHandler returns the job handler that emails the recipient of a gift its claim link
+// Links are built on publicURL; jobs whose token was replaced by a newer link, or whose gift was
+// claimed in the meantime, send nothing.
+func GiftClaimHandler(orderService order.Service, eventService event.Service, userService user.Service, renderer *email.Renderer, sender email.Sender, publicURL string) jobs.Handler {
+	return func(ctx context.Context, j *job.Job) error {
+		var payload order.GiftClaimPayload
+		if err := json.Unmarshal(j.Payload, &payload); err != nil {
+			return fmt.Errorf("%w: invalid payload: %v", job.ErrPermanent, err)
+		}
+
+		gift, err := orderService.GetOrderByID(ctx, payload.OrderID)
+		if err != nil {
+			if order.IsOrderNotFoundError(err) {
+				return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+			}
+			return err
+		}
+		if gift.IsGiftClaimed() || !gift.GiftTokenMatches(payload.Token) {
+			return nil
+		}
+
+		ev, err := eventService.GetEventByID(ctx, gift.EventID)
+		if err != nil {
+			if event.IsEventNotFoundError(err) {
+				return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+			}
+			return err
+		}
+		giver, err := userService.GetUserByID(ctx, gift.UserID)
+		if err != nil {
+			if errors.Is(err, user.ErrUserNotFound) {
+				return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+			}
+			return err
+		}
+
+		localPart, _, _ := strings.Cut(gift.GiftRecipient, "@")
+		msg, err := renderer.Render(giftClaimTemplate, gift.GiftRecipient, email.GiftClaim{
+			Recipient:  email.Recipient{Email: gift.GiftRecipient, Username: localPart},
+			GiverName:  giver.Username,
+			EventTitle: ev.Title,
+			EventDate:  ev.EventDate,
+			Quantity:   gift.Quantity,
+			ClaimURL:   strings.TrimRight(publicURL, "/") + "/gifts/claim?token=" + url.QueryEscape(payload.Token),
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+		}
+		return sender.Send(ctx, msg)
+	}
+}
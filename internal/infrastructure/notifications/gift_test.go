@@ -0,0 +1,111 @@
+package notifications
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/infrastructure/email"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockOrderService is a mock implementation of order.Service; only GetOrderByID is used
+type mockOrderService struct {
+	order.Service
+	mock.Mock
+}
+
+func (m *mockOrderService) GetOrderByID(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+// stubEventService returns one event; only GetEventByID is used
+type stubEventService struct {
+	event.Service
+	ev *event.Event
+}
+
+func (s stubEventService) GetEventByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
+	return s.ev, nil
+}
+
+// stubUserService returns one user; only GetUserByID is used
+type stubUserService struct {
+	user.Service
+	u *user.User
+}
+
+func (s stubUserService) GetUserByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return s.u, nil
+}
+
+func giftClaimJob(t *testing.T, payload order.GiftClaimPayload) *job.Job {
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return &job.Job{ID: uuid.New(), Type: order.JobTypeGiftClaim, Payload: data}
+}
+
+func TestGiftClaimHandler(t *testing.T) {
+	renderer, err := email.NewRenderer("")
+	require.NoError(t, err)
+
+	sum := sha256.Sum256([]byte("current-token"))
+	gift := &order.Order{
+		ID: uuid.New(), UserID: uuid.New(), EventID: uuid.New(), Quantity: 2, Status: order.StatusCompleted,
+		GiftRecipient: "friend@example.com", GiftTokenHash: hex.EncodeToString(sum[:]),
+	}
+	events := stubEventService{ev: &event.Event{ID: gift.EventID, Title: "Jazz Night", EventDate: time.Date(2026, 11, 20, 20, 0, 0, 0, time.UTC)}}
+	users := stubUserService{u: &user.User{ID: gift.UserID, Username: "alex"}}
+
+	t.Run("emails the claim link to the recipient", func(t *testing.T) {
+		orders := new(mockOrderService)
+		orders.On("GetOrderByID", mock.Anything, gift.ID).Return(gift, nil)
+		sender := &recordingSender{}
+
+		handler := GiftClaimHandler(orders, events, users, renderer, sender, "https://tickets.example.com/")
+		err := handler(context.Background(), giftClaimJob(t, order.GiftClaimPayload{OrderID: gift.ID, Token: "current-token"}))
+
+		require.NoError(t, err)
+		require.Len(t, sender.sent, 1)
+		assert.Equal(t, "friend@example.com", sender.sent[0].To)
+		assert.Equal(t, "alex sent you tickets for Jazz Night", sender.sent[0].Subject)
+		assert.Contains(t, sender.sent[0].Text, "https://tickets.example.com/gifts/claim?token=current-token")
+	})
+
+	t.Run("replaced links are not sent", func(t *testing.T) {
+		orders := new(mockOrderService)
+		orders.On("GetOrderByID", mock.Anything, gift.ID).Return(gift, nil)
+		sender := &recordingSender{}
+
+		handler := GiftClaimHandler(orders, events, users, renderer, sender, "https://tickets.example.com")
+		err := handler(context.Background(), giftClaimJob(t, order.GiftClaimPayload{OrderID: gift.ID, Token: "older-token"}))
+
+		require.NoError(t, err)
+		assert.Empty(t, sender.sent)
+	})
+
+	t.Run("deleted orders fail permanently", func(t *testing.T) {
+		orders := new(mockOrderService)
+		orders.On("GetOrderByID", mock.Anything, gift.ID).Return(nil, order.NewOrderNotFoundError(gift.ID))
+
+		handler := GiftClaimHandler(orders, events, users, renderer, &recordingSender{}, "https://tickets.example.com")
+		err := handler(context.Background(), giftClaimJob(t, order.GiftClaimPayload{OrderID: gift.ID, Token: "current-token"}))
+
+		assert.ErrorIs(t, err, job.ErrPermanent)
+	})
+}
@@ -0,0 +1,44 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/notification"
+	"enterprise-crud/internal/infrastructure/jobs"
+	"enterprise-crud/internal/infrastructure/push"
+)
+
+// PushHandler returns the job handler that pushes a notification to one device
+// Tokens the provider reports as invalid are removed instead of retried.
+func PushHandler(notificationService notification.Service, provider push.Provider) jobs.Handler {
+	return func(ctx context.Context, j *job.Job) error {
+		var payload notification.PushPayload
+		if err := json.Unmarshal(j.Payload, &payload); err != nil {
+			return fmt.Errorf("%w: invalid payload: %v", job.ErrPermanent, err)
+		}
+
+		err := provider.Send(ctx, &push.Message{
+			Platform: payload.Platform,
+			Token:    payload.Token,
+			Title:    payload.Title,
+			Body:     payload.Body,
+			Data:     payload.Data,
+		})
+		switch {
+		case err == nil:
+			return nil
+		case errors.Is(err, push.ErrInvalidToken):
+			log.Printf("Removing invalid %s device token of user %s", payload.Platform, payload.UserID)
+			return notificationService.RemoveDeviceToken(ctx, payload.Token)
+		case errors.Is(err, push.ErrRejected):
+			return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+		default:
+			return err
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/notification"
+	"enterprise-crud/internal/infrastructure/push"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubProvider returns a fixed error and records the messages it was given
+type stubProvider struct {
+	sent []*push.Message
+	err  error
+}
+
+func (p *stubProvider) Send(ctx context.Context, msg *push.Message) error {
+	p.sent = append(p.sent, msg)
+	return p.err
+}
+
+func TestPushHandler(t *testing.T) {
+	payload := notification.PushPayload{
+		UserID:   uuid.New(),
+		Platform: notification.PlatformIOS,
+		Token:    "apns-token",
+		Type:     notification.TypeEventChanged,
+		Title:    "Jazz Night has been cancelled",
+		Body:     "Jazz Night has been cancelled.",
+		Data:     map[string]string{"type": notification.TypeEventChanged},
+	}
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	pushJob := &job.Job{ID: uuid.New(), Type: notification.JobTypePush, Payload: data}
+
+	t.Run("sends the message", func(t *testing.T) {
+		provider := &stubProvider{}
+
+		err := PushHandler(new(mockNotificationService), provider)(context.Background(), pushJob)
+
+		require.NoError(t, err)
+		require.Len(t, provider.sent, 1)
+		assert.Equal(t, &push.Message{
+			Platform: payload.Platform, Token: payload.Token, Title: payload.Title, Body: payload.Body, Data: payload.Data,
+		}, provider.sent[0])
+	})
+
+	t.Run("invalid tokens are removed", func(t *testing.T) {
+		service := new(mockNotificationService)
+		service.On("RemoveDeviceToken", context.Background(), "apns-token").Return(nil)
+
+		err := PushHandler(service, &stubProvider{err: push.ErrInvalidToken})(context.Background(), pushJob)
+
+		require.NoError(t, err)
+		service.AssertExpectations(t)
+	})
+
+	t.Run("rejected messages are not retried", func(t *testing.T) {
+		err := PushHandler(new(mockNotificationService), &stubProvider{err: fmt.Errorf("%w: bad payload", push.ErrRejected)})(context.Background(), pushJob)
+
+		assert.ErrorIs(t, err, job.ErrPermanent)
+	})
+
+	t.Run("provider outages are retried", func(t *testing.T) {
+		err := PushHandler(new(mockNotificationService), &stubProvider{err: errors.New("apns unavailable")})(context.Background(), pushJob)
+
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, job.ErrPermanent)
+	})
+}
@@ -0,0 +1,29 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/notification"
+	"enterprise-crud/internal/infrastructure/jobs"
+	"enterprise-crud/internal/infrastructure/sms"
+)
+
+// SMSHandler returns the job handler that texts a verification code or alert to a phone number
+func SMSHandler(sender sms.Sender) jobs.Handler {
+	return func(ctx context.Context, j *job.Job) error {
+		var payload notification.SMSPayload
+		if err := json.Unmarshal(j.Payload, &payload); err != nil {
+			return fmt.Errorf("%w: invalid payload: %v", job.ErrPermanent, err)
+		}
+
+		err := sender.Send(ctx, &sms.Message{To: payload.Phone, Body: payload.Body})
+		if errors.Is(err, sms.ErrRejected) {
+			return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+		}
+		return err
+	}
+}
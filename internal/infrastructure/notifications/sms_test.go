@@ -0,0 +1,33 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/notification"
+	"enterprise-crud/internal/infrastructure/sms"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMSHandler(t *testing.T) {
+	payload := notification.SMSPayload{UserID: uuid.New(), Phone: "+14155550123", Body: "Your verification code is 123456."}
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	sender := &sms.MockSender{}
+
+	err = SMSHandler(sender)(context.Background(), &job.Job{ID: uuid.New(), Type: notification.JobTypeSMS, Payload: data})
+
+	require.NoError(t, err)
+	assert.Equal(t, []*sms.Message{{To: payload.Phone, Body: payload.Body}}, sender.Sent())
+}
+
+func TestSMSHandler_InvalidPayload(t *testing.T) {
+	err := SMSHandler(&sms.MockSender{})(context.Background(), &job.Job{ID: uuid.New(), Payload: []byte("{")})
+
+	assert.ErrorIs(t, err, job.ErrPermanent)
+}
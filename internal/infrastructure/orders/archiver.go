@@ -0,0 +1,79 @@
+package orders
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/domain/order"
+)
+
+// ArchiveScheduler periodically moves events that took place more than retentionMonths ago, with their orders, to the archive tables
+// Several instances may run it: each batch locks its events and skips those another instance holds.
+type ArchiveScheduler struct {
+	orderService    order.Service
+	interval        time.Duration
+	retentionMonths int
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewArchiveScheduler creates a scheduler archiving past events every interval
+func NewArchiveScheduler(orderService order.Service, interval time.Duration, retentionMonths int) *ArchiveScheduler {
+	return &ArchiveScheduler{
+		orderService:    orderService,
+		interval:        interval,
+		retentionMonths: retentionMonths,
+	}
+}
+
+// Start begins archiving past events in the background
+func (s *ArchiveScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go s.run(ctx)
+
+	log.Printf("Event archive scheduler started, archiving events older than %d months every %s", s.retentionMonths, s.interval)
+}
+
+// Stop halts the scheduler and waits for the current run to finish
+func (s *ArchiveScheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+	log.Println("Event archive scheduler stopped")
+}
+
+func (s *ArchiveScheduler) run(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick archives every event that passed the retention period
+func (s *ArchiveScheduler) tick(ctx context.Context, now time.Time) {
+	archived, err := s.orderService.ArchiveEvents(ctx, now.AddDate(0, -s.retentionMonths, 0))
+	if archived != nil && archived.Events > 0 {
+		log.Printf("Archived %d events with %d orders", archived.Events, archived.Orders)
+	}
+	if err != nil {
+		log.Printf("Warning: Failed to archive past events: %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package orders
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/infrastructure/metrics"
+)
+
+// ReconciliationScheduler periodically compares event ticket counters with the orders holding their tickets
+// Discrepancies are logged and exported as metrics; with auto-correct on, drifted counters are reset.
+// Several instances may run it: a counter is only reset if it has not moved since it was read.
+type ReconciliationScheduler struct {
+	orderService order.Service
+	interval     time.Duration
+	autoCorrect  bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewReconciliationScheduler creates a scheduler reconciling ticket counters every interval
+func NewReconciliationScheduler(orderService order.Service, interval time.Duration, autoCorrect bool) *ReconciliationScheduler {
+	return &ReconciliationScheduler{
+		orderService: orderService,
+		interval:     interval,
+		autoCorrect:  autoCorrect,
+	}
+}
+
+// Start begins reconciling ticket counters in the background
+func (s *ReconciliationScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go s.run(ctx)
+
+	log.Printf("Ticket reconciliation scheduler started, running every %s (auto-correct: %t)", s.interval, s.autoCorrect)
+}
+
+// Stop halts the scheduler and waits for the current run to finish
+func (s *ReconciliationScheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+	log.Println("Ticket reconciliation scheduler stopped")
+}
+
+func (s *ReconciliationScheduler) run(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick reconciles every ticket counter once
+func (s *ReconciliationScheduler) tick(ctx context.Context, now time.Time) {
+	result, err := s.orderService.ReconcileTickets(ctx, !s.autoCorrect)
+	if err != nil {
+		log.Printf("Warning: Failed to reconcile ticket counters: %v", err)
+		return
+	}
+	report(result)
+	metrics.TicketReconciliationLastSuccess.Set(float64(now.Unix()))
+}
+
+// report logs every discrepancy of a reconciliation and records it in the metrics
+func report(result *order.Reconciliation) {
+	for _, d := range result.Discrepancies {
+		action := "reported"
+		switch {
+		case d.Corrected:
+			action = "corrected"
+		case d.IsOversold():
+			action = "oversold, not corrected"
+		case !result.DryRun:
+			action = "changed during reconciliation, not corrected"
+		}
+		log.Printf("Ticket reconciliation: event %s has %d available tickets, orders imply %d (total %d, reserved %d): %s",
+			d.EventID, d.AvailableTickets, d.ExpectedAvailable(), d.TotalTickets, d.ReservedTickets, action)
+	}
+
+	metrics.TicketDiscrepancies.Set(float64(len(result.Discrepancies)))
+	metrics.TicketCorrections.Add(float64(result.Corrected))
+
+	if len(result.Discrepancies) > 0 {
+		log.Printf("Ticket reconciliation found %d discrepancies, corrected %d", len(result.Discrepancies), result.Corrected)
+	}
+}
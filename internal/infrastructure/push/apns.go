@@ -0,0 +1,164 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	apnsProductionEndpoint = "https://api.push.apple.com"
+	apnsSandboxEndpoint    = "https://api.sandbox.push.apple.com"
+
+	// apnsTokenLifetime is how long a provider token is reused
+	// APNs rejects tokens older than an hour and throttles refreshes more often than every 20 minutes.
+	apnsTokenLifetime = 50 * time.Minute
+)
+
+// APNsProvider sends messages with the token-based APNs HTTP/2 API
+type APNsProvider struct {
+	client   *http.Client
+	endpoint string
+	topic    string
+	keyID    string
+	teamID   string
+	signer   interface{} // *ecdsa.PrivateKey parsed from the .p8 key file
+
+	mu        sync.Mutex
+	jwt       string
+	refreshAt time.Time
+}
+
+// NewAPNsProvider creates a provider from the configured signing key
+func NewAPNsProvider(cfg *config.PushConfig, client *http.Client) (*APNsProvider, error) {
+	if cfg.APNsKeyID == "" || cfg.APNsTeamID == "" || cfg.APNsTopic == "" {
+		return nil, fmt.Errorf("invalid APNs configuration: apns_key_id, apns_team_id and apns_topic are required")
+	}
+	raw, err := os.ReadFile(cfg.APNsKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read APNs key: %w", err)
+	}
+	key, err := jwt.ParseECPrivateKeyFromPEM(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid APNs key: %w", err)
+	}
+
+	endpoint := apnsSandboxEndpoint
+	if cfg.APNsProduction {
+		endpoint = apnsProductionEndpoint
+	}
+	return &APNsProvider{
+		client:   client,
+		endpoint: endpoint,
+		topic:    cfg.APNsTopic,
+		keyID:    cfg.APNsKeyID,
+		teamID:   cfg.APNsTeamID,
+		signer:   key,
+	}, nil
+}
+
+// Send delivers a message to an iOS device
+func (p *APNsProvider) Send(ctx context.Context, msg *Message) error {
+	providerToken, err := p.token()
+	if err != nil {
+		return err
+	}
+
+	// Custom data sits next to "aps" in the payload
+	payload := map[string]interface{}{
+		"aps": map[string]interface{}{
+			"alert": map[string]string{"title": msg.Title, "body": msg.Body},
+			"sound": "default",
+		},
+	}
+	for key, value := range msg.Data {
+		if key != "aps" {
+			payload[key] = value
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrRejected, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/3/device/"+url.PathEscape(msg.Token), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "bearer "+providerToken)
+	req.Header.Set("apns-topic", p.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-priority", "10")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("apns request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var apnsErr struct {
+		Reason string `json:"reason"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&apnsErr)
+
+	switch {
+	case resp.StatusCode == http.StatusGone,
+		apnsErr.Reason == "BadDeviceToken",
+		apnsErr.Reason == "DeviceTokenNotForTopic",
+		apnsErr.Reason == "Unregistered":
+		return ErrInvalidToken
+	case apnsErr.Reason == "ExpiredProviderToken", apnsErr.Reason == "InvalidProviderToken":
+		p.resetToken()
+		return fmt.Errorf("apns rejected the provider token: %s", apnsErr.Reason)
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("apns unavailable: %s %s", resp.Status, apnsErr.Reason)
+	default:
+		return fmt.Errorf("%w: apns %s %s", ErrRejected, resp.Status, apnsErr.Reason)
+	}
+}
+
+// token returns the cached provider token, signing a new one when it is due
+func (p *APNsProvider) token() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	if p.jwt != "" && now.Before(p.refreshAt) {
+		return p.jwt, nil
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": p.teamID,
+		"iat": now.Unix(),
+	})
+	token.Header["kid"] = p.keyID
+	signed, err := token.SignedString(p.signer)
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to sign APNs token: %v", ErrRejected, err)
+	}
+
+	p.jwt = signed
+	p.refreshAt = now.Add(apnsTokenLifetime)
+	return p.jwt, nil
+}
+
+// resetToken makes the next send sign a new provider token
+func (p *APNsProvider) resetToken() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.jwt = ""
+}
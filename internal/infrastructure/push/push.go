@@ -0,0 +1,82 @@
+// Package push delivers push notifications through Firebase Cloud Messaging and the Apple Push Notification service
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/notification"
+)
+
+var (
+	// ErrInvalidToken is returned when the provider reports that a device token is no longer valid
+	// The token should be removed; retrying never succeeds.
+	ErrInvalidToken = errors.New("push: device token is no longer valid")
+
+	// ErrRejected is returned when the provider refuses a message for a reason retrying doesn't fix
+	ErrRejected = errors.New("push: message rejected")
+)
+
+// Message is a push notification addressed to one device
+type Message struct {
+	Platform string            // notification.PlatformAndroid or notification.PlatformIOS
+	Token    string            // Device token issued by the platform
+	Title    string            // Alert title
+	Body     string            // Alert text
+	Data     map[string]string // Extra fields for the app, e.g. the event to open
+}
+
+// Provider delivers push notifications
+// Errors other than ErrInvalidToken and ErrRejected are transient and worth retrying.
+type Provider interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewProvider creates a provider that sends to FCM and APNs as configured
+// Platforms without credentials only log their messages, which suits local development.
+func NewProvider(cfg *config.PushConfig) (Provider, error) {
+	client := &http.Client{Timeout: cfg.Timeout}
+	r := &router{providers: make(map[string]Provider, 2)}
+
+	if cfg.FCMCredentialsFile != "" {
+		fcm, err := NewFCMProvider(cfg.FCMCredentialsFile, client)
+		if err != nil {
+			return nil, err
+		}
+		r.providers[notification.PlatformAndroid] = fcm
+	} else {
+		log.Println("FCM push delivery disabled: no credentials file configured, messages will be logged")
+	}
+
+	if cfg.APNsKeyFile != "" {
+		apns, err := NewAPNsProvider(cfg, client)
+		if err != nil {
+			return nil, err
+		}
+		r.providers[notification.PlatformIOS] = apns
+	} else {
+		log.Println("APNs push delivery disabled: no key file configured, messages will be logged")
+	}
+
+	return r, nil
+}
+
+// router hands each message to the provider of its platform
+type router struct {
+	providers map[string]Provider
+}
+
+func (r *router) Send(ctx context.Context, msg *Message) error {
+	if provider, ok := r.providers[msg.Platform]; ok {
+		return provider.Send(ctx, msg)
+	}
+	if msg.Platform != notification.PlatformAndroid && msg.Platform != notification.PlatformIOS {
+		return fmt.Errorf("%w: unsupported platform %q", ErrRejected, msg.Platform)
+	}
+	log.Printf("Push to %s device not sent (provider not configured): %s", msg.Platform, msg.Title)
+	return nil
+}
@@ -0,0 +1,227 @@
+package push
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/notification"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMessage = Message{
+	Token: "device-token",
+	Title: "Jazz Night has changed",
+	Body:  "It now takes place on Fri Nov 20, 2026 21:00 UTC.",
+	Data:  map[string]string{"type": notification.TypeEventChanged, "event_id": "42"},
+}
+
+// newFCMTestProvider returns an FCM provider whose token exchange and send calls go to server
+func newFCMTestProvider(t *testing.T, server *httptest.Server) *FCMProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	credentials, err := json.Marshal(serviceAccount{
+		ProjectID:   "tickets-app",
+		ClientEmail: "push@tickets-app.iam.gserviceaccount.com",
+		PrivateKey:  string(keyPEM),
+		TokenURI:    server.URL + "/token",
+	})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "fcm.json")
+	require.NoError(t, os.WriteFile(path, credentials, 0o600))
+
+	provider, err := NewFCMProvider(path, server.Client())
+	require.NoError(t, err)
+	provider.endpoint = server.URL
+	return provider
+}
+
+func TestFCMProvider_Send(t *testing.T) {
+	var tokenRequests atomic.Int32
+	var sendStatus atomic.Int32
+	var sendBody atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			tokenRequests.Add(1)
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "oauth-token", "expires_in": 3600})
+		case "/v1/projects/tickets-app/messages:send":
+			assert.Equal(t, "Bearer oauth-token", r.Header.Get("Authorization"))
+			var body fcmRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			sendBody.Store(body)
+			w.WriteHeader(int(sendStatus.Load()))
+			if sendStatus.Load() == http.StatusNotFound {
+				_, _ = w.Write([]byte(`{"error":{"status":"NOT_FOUND","details":[{"errorCode":"UNREGISTERED"}]}}`))
+			}
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	provider := newFCMTestProvider(t, server)
+
+	t.Run("delivers and reuses the access token", func(t *testing.T) {
+		sendStatus.Store(http.StatusOK)
+
+		require.NoError(t, provider.Send(context.Background(), &testMessage))
+		require.NoError(t, provider.Send(context.Background(), &testMessage))
+
+		assert.Equal(t, int32(1), tokenRequests.Load())
+		body := sendBody.Load().(fcmRequest)
+		assert.Equal(t, "device-token", body.Message.Token)
+		assert.Equal(t, testMessage.Title, body.Message.Notification.Title)
+		assert.Equal(t, testMessage.Data, body.Message.Data)
+	})
+
+	t.Run("unregistered token", func(t *testing.T) {
+		sendStatus.Store(http.StatusNotFound)
+
+		assert.ErrorIs(t, provider.Send(context.Background(), &testMessage), ErrInvalidToken)
+	})
+
+	t.Run("bad request is rejected", func(t *testing.T) {
+		sendStatus.Store(http.StatusBadRequest)
+
+		assert.ErrorIs(t, provider.Send(context.Background(), &testMessage), ErrRejected)
+	})
+
+	t.Run("server errors are transient", func(t *testing.T) {
+		sendStatus.Store(http.StatusServiceUnavailable)
+
+		err := provider.Send(context.Background(), &testMessage)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrRejected)
+		assert.NotErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+// newAPNsTestProvider returns an APNs provider that sends to server
+func newAPNsTestProvider(t *testing.T, server *httptest.Server) (*APNsProvider, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "apns.p8")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	provider, err := NewAPNsProvider(&config.PushConfig{
+		APNsKeyFile: path,
+		APNsKeyID:   "KEY123",
+		APNsTeamID:  "TEAM456",
+		APNsTopic:   "com.example.tickets",
+	}, server.Client())
+	require.NoError(t, err)
+	provider.endpoint = server.URL
+	return provider, key
+}
+
+// apnsRequest is a request received by the fake APNs server
+type apnsRequest struct {
+	header  http.Header
+	path    string
+	payload map[string]interface{}
+}
+
+func TestAPNsProvider_Send(t *testing.T) {
+	var status atomic.Int32
+	var reason atomic.Value
+	var lastRequest atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		lastRequest.Store(apnsRequest{header: r.Header.Clone(), path: r.URL.Path, payload: payload})
+
+		w.WriteHeader(int(status.Load()))
+		if r, _ := reason.Load().(string); r != "" {
+			_, _ = w.Write([]byte(`{"reason":"` + r + `"}`))
+		}
+	}))
+	defer server.Close()
+	provider, key := newAPNsTestProvider(t, server)
+
+	t.Run("delivers with a signed provider token", func(t *testing.T) {
+		status.Store(http.StatusOK)
+		reason.Store("")
+
+		require.NoError(t, provider.Send(context.Background(), &testMessage))
+
+		req := lastRequest.Load().(apnsRequest)
+		assert.Equal(t, "/3/device/device-token", req.path)
+		assert.Equal(t, "com.example.tickets", req.header.Get("apns-topic"))
+		assert.Equal(t, "alert", req.header.Get("apns-push-type"))
+		assert.Equal(t, "42", req.payload["event_id"])
+
+		providerToken := strings.TrimPrefix(req.header.Get("Authorization"), "bearer ")
+		parsed, err := jwt.Parse(providerToken, func(*jwt.Token) (interface{}, error) { return &key.PublicKey, nil },
+			jwt.WithValidMethods([]string{"ES256"}))
+		require.NoError(t, err)
+		assert.Equal(t, "KEY123", parsed.Header["kid"])
+		issuer, _ := parsed.Claims.GetIssuer()
+		assert.Equal(t, "TEAM456", issuer)
+	})
+
+	t.Run("unregistered device", func(t *testing.T) {
+		status.Store(http.StatusGone)
+		reason.Store("Unregistered")
+
+		assert.ErrorIs(t, provider.Send(context.Background(), &testMessage), ErrInvalidToken)
+	})
+
+	t.Run("bad device token", func(t *testing.T) {
+		status.Store(http.StatusBadRequest)
+		reason.Store("BadDeviceToken")
+
+		assert.ErrorIs(t, provider.Send(context.Background(), &testMessage), ErrInvalidToken)
+	})
+
+	t.Run("expired provider token is renewed and retried", func(t *testing.T) {
+		status.Store(http.StatusForbidden)
+		reason.Store("ExpiredProviderToken")
+		provider.jwt = "stale"
+		provider.refreshAt = time.Now().Add(time.Hour)
+
+		err := provider.Send(context.Background(), &testMessage)
+
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrRejected)
+		assert.Empty(t, provider.jwt)
+	})
+
+	t.Run("payload errors are rejected", func(t *testing.T) {
+		status.Store(http.StatusBadRequest)
+		reason.Store("PayloadTooLarge")
+
+		assert.ErrorIs(t, provider.Send(context.Background(), &testMessage), ErrRejected)
+	})
+}
+
+func TestNewProvider_LogsUnconfiguredPlatforms(t *testing.T) {
+	provider, err := NewProvider(&config.PushConfig{Timeout: time.Second})
+	require.NoError(t, err)
+
+	assert.NoError(t, provider.Send(context.Background(), &Message{Platform: notification.PlatformAndroid, Token: "t"}))
+	assert.NoError(t, provider.Send(context.Background(), &Message{Platform: notification.PlatformIOS, Token: "t"}))
+	assert.ErrorIs(t, provider.Send(context.Background(), &Message{Platform: "WINDOWS", Token: "t"}), ErrRejected)
+}
@@ -0,0 +1,53 @@
+// Package reports sends scheduled organizer reports through the job queue
+package reports
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/report"
+	"enterprise-crud/internal/infrastructure/email"
+	"enterprise-crud/internal/infrastructure/jobs"
+)
+
+// salesSummaryTemplate is the email template used for sales summaries
+const salesSummaryTemplate = "sales_summary"
+
+// SalesSummaryHandler returns the job handler that emails a sales summary to an organizer
+func SalesSummaryHandler(reportService report.Service, renderer *email.Renderer, sender email.Sender) jobs.Handler {
+	return func(ctx context.Context, j *job.Job) error {
+		var payload report.SalesSummaryPayload
+		if err := json.Unmarshal(j.Payload, &payload); err != nil {
+			return fmt.Errorf("%w: invalid payload: %v", job.ErrPermanent, err)
+		}
+
+		salesReport, err := reportService.PrepareSalesReport(ctx, payload)
+		if err != nil {
+			if report.IsUserNotFoundError(err) {
+				return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+			}
+			return err
+		}
+		if salesReport == nil {
+			// Organizer opted out after the job was queued
+			return nil
+		}
+
+		msg, err := renderer.Render(salesSummaryTemplate, salesReport.Recipient.Email, salesReport)
+		if err != nil {
+			return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+		}
+		if err := sender.Send(ctx, msg); err != nil {
+			return err
+		}
+
+		// The email is out; failing to record it must not trigger a resend
+		if err := reportService.MarkReportSent(ctx, payload.UserID, time.Now()); err != nil {
+			return fmt.Errorf("%w: report sent but not recorded: %v", job.ErrPermanent, err)
+		}
+		return nil
+	}
+}
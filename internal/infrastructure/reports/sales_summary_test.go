@@ -0,0 +1,174 @@
+package reports
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/report"
+	"enterprise-crud/internal/infrastructure/email"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockReportService is a mock implementation of report.Service
+type mockReportService struct {
+	mock.Mock
+}
+
+func (m *mockReportService) GetSchedule(ctx context.Context, userID uuid.UUID) (*report.Schedule, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(*report.Schedule), args.Error(1)
+}
+
+func (m *mockReportService) UpdateSchedule(ctx context.Context, userID uuid.UUID, frequency string, enabled bool) (*report.Schedule, error) {
+	args := m.Called(ctx, userID, frequency, enabled)
+	return args.Get(0).(*report.Schedule), args.Error(1)
+}
+
+func (m *mockReportService) GetSalesSummary(ctx context.Context, organizerID uuid.UUID, from, to time.Time) (*report.SalesSummary, error) {
+	args := m.Called(ctx, organizerID, from, to)
+	return args.Get(0).(*report.SalesSummary), args.Error(1)
+}
+
+func (m *mockReportService) GetForecast(ctx context.Context, eventID uuid.UUID) (*report.Forecast, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).(*report.Forecast), args.Error(1)
+}
+
+func (m *mockReportService) EnqueueDueReports(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockReportService) PrepareSalesReport(ctx context.Context, payload report.SalesSummaryPayload) (*report.SalesReport, error) {
+	args := m.Called(ctx, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*report.SalesReport), args.Error(1)
+}
+
+func (m *mockReportService) MarkReportSent(ctx context.Context, userID uuid.UUID, sentAt time.Time) error {
+	args := m.Called(ctx, userID, sentAt)
+	return args.Error(0)
+}
+
+// recordingSender records messages instead of sending them
+type recordingSender struct {
+	sent []*email.Message
+	err  error
+}
+
+func (s *recordingSender) Send(ctx context.Context, msg *email.Message) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func salesSummaryJob(t *testing.T, payload report.SalesSummaryPayload) *job.Job {
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return &job.Job{ID: uuid.New(), Type: report.JobTypeSalesSummary, Payload: data}
+}
+
+func TestSalesSummaryHandler(t *testing.T) {
+	renderer, err := email.NewRenderer("")
+	require.NoError(t, err)
+
+	payload := report.SalesSummaryPayload{
+		UserID: uuid.New(),
+		From:   time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC),
+	}
+	salesReport := &report.SalesReport{
+		Recipient: &report.Recipient{UserID: payload.UserID, Email: "organizer@example.com", Username: "organizer"},
+		Frequency: report.FrequencyDaily,
+		Summary:   &report.SalesSummary{OrganizerID: payload.UserID, From: payload.From, To: payload.To},
+	}
+
+	t.Run("sends report and records it", func(t *testing.T) {
+		service := new(mockReportService)
+		service.On("PrepareSalesReport", mock.Anything, payload).Return(salesReport, nil)
+		service.On("MarkReportSent", mock.Anything, payload.UserID, mock.AnythingOfType("time.Time")).Return(nil)
+		sender := &recordingSender{}
+
+		err := SalesSummaryHandler(service, renderer, sender)(context.Background(), salesSummaryJob(t, payload))
+
+		assert.NoError(t, err)
+		require.Len(t, sender.sent, 1)
+		assert.Equal(t, "organizer@example.com", sender.sent[0].To)
+		assert.Contains(t, sender.sent[0].Subject, "daily sales summary")
+		service.AssertExpectations(t)
+	})
+
+	t.Run("skips organizers who opted out", func(t *testing.T) {
+		service := new(mockReportService)
+		service.On("PrepareSalesReport", mock.Anything, payload).Return(nil, nil)
+		sender := &recordingSender{}
+
+		err := SalesSummaryHandler(service, renderer, sender)(context.Background(), salesSummaryJob(t, payload))
+
+		assert.NoError(t, err)
+		assert.Empty(t, sender.sent)
+	})
+
+	t.Run("send failures are retried", func(t *testing.T) {
+		service := new(mockReportService)
+		service.On("PrepareSalesReport", mock.Anything, payload).Return(salesReport, nil)
+		sender := &recordingSender{err: errors.New("connection refused")}
+
+		err := SalesSummaryHandler(service, renderer, sender)(context.Background(), salesSummaryJob(t, payload))
+
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, job.ErrPermanent))
+		service.AssertNotCalled(t, "MarkReportSent", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("deleted users are not retried", func(t *testing.T) {
+		service := new(mockReportService)
+		service.On("PrepareSalesReport", mock.Anything, payload).Return(nil, report.NewUserNotFoundError(payload.UserID))
+
+		err := SalesSummaryHandler(service, renderer, &recordingSender{})(context.Background(), salesSummaryJob(t, payload))
+
+		assert.True(t, errors.Is(err, job.ErrPermanent))
+	})
+
+	t.Run("invalid payload is not retried", func(t *testing.T) {
+		j := &job.Job{ID: uuid.New(), Type: report.JobTypeSalesSummary, Payload: json.RawMessage(`"nope"`)}
+
+		err := SalesSummaryHandler(new(mockReportService), renderer, &recordingSender{})(context.Background(), j)
+
+		assert.True(t, errors.Is(err, job.ErrPermanent))
+	})
+}
+
+func TestScheduler_StartStop(t *testing.T) {
+	service := new(mockReportService)
+	ticked := make(chan struct{}, 1)
+	service.On("EnqueueDueReports", mock.Anything, mock.AnythingOfType("time.Time")).
+		Run(func(mock.Arguments) {
+			select {
+			case ticked <- struct{}{}:
+			default:
+			}
+		}).
+		Return(0, nil)
+
+	scheduler := NewScheduler(service, time.Hour)
+	scheduler.Start()
+	select {
+	case <-ticked:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not check for due reports on start")
+	}
+	scheduler.Stop()
+}
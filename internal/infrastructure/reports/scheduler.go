@@ -0,0 +1,77 @@
+package reports
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/domain/report"
+)
+
+// Scheduler periodically enqueues sales summary jobs for due report schedules
+// Several instances may run it; schedules are claimed atomically so each report is queued once.
+type Scheduler struct {
+	reportService report.Service
+	interval      time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler creates a scheduler checking for due reports every interval
+func NewScheduler(reportService report.Service, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		reportService: reportService,
+		interval:      interval,
+	}
+}
+
+// Start begins checking for due reports in the background
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go s.run(ctx)
+
+	log.Printf("Report scheduler started, checking every %s", s.interval)
+}
+
+// Stop halts the scheduler and waits for the current check to finish
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+	log.Println("Report scheduler stopped")
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick enqueues every report due at now
+func (s *Scheduler) tick(ctx context.Context, now time.Time) {
+	enqueued, err := s.reportService.EnqueueDueReports(ctx, now)
+	if err != nil {
+		log.Printf("Warning: Failed to enqueue due reports: %v", err)
+	}
+	if enqueued > 0 {
+		log.Printf("Enqueued %d scheduled reports", enqueued)
+	}
+}
@@ -0,0 +1,54 @@
+package resilience
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// retryableSQLStates lists PostgreSQL error codes that indicate a transient condition
+// See https://www.postgresql.org/docs/current/errcodes-appendix.html
+var retryableSQLStates = map[string]bool{
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+	"53300": true, // too_many_connections
+	"57P01": true, // admin_shutdown
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+// IsTransient reports whether err is a temporary infrastructure failure worth retrying
+// Domain errors (not found, validation) and caller cancellations are never transient
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// The caller gave up - retrying or tripping the breaker would be wrong
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// Class 08 - connection exception
+		return strings.HasPrefix(pgErr.Code, "08") || retryableSQLStates[pgErr.Code]
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,208 @@
+// Package resilience provides circuit breaking and retry policies for calls to
+// external dependencies (PostgreSQL, Redis) so a slow or failing dependency
+// fails fast instead of cascading into request pile-ups.
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/config"
+
+	"github.com/sony/gobreaker"
+)
+
+// ErrCircuitOpen is returned when a call is rejected because the breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// Settings configures an Executor
+type Settings struct {
+	Name             string               // Dependency name used in logs and state snapshots
+	MaxRetries       int                  // Retries after the first attempt for transient errors (0 disables retrying)
+	RetryBaseDelay   time.Duration        // Base delay for exponential backoff
+	RetryMaxDelay    time.Duration        // Upper bound for a single backoff delay
+	FailureThreshold int                  // Consecutive failures before the breaker opens (0 disables the breaker)
+	OpenTimeout      time.Duration        // How long the breaker stays open before probing
+	HalfOpenRequests int                  // Requests allowed through while probing
+	IsTransient      func(err error) bool // Classifies errors that count as failures and may be retried
+}
+
+// SettingsFromConfig builds executor settings from the application resilience config
+func SettingsFromConfig(name string, cfg *config.ResilienceConfig) Settings {
+	return Settings{
+		Name:             name,
+		MaxRetries:       cfg.MaxRetries,
+		RetryBaseDelay:   cfg.RetryBaseDelay,
+		RetryMaxDelay:    cfg.RetryMaxDelay,
+		FailureThreshold: cfg.FailureThreshold,
+		OpenTimeout:      cfg.OpenTimeout,
+		HalfOpenRequests: cfg.HalfOpenRequests,
+		IsTransient:      IsTransient,
+	}
+}
+
+// Executor runs calls to a single dependency through a circuit breaker and retry policy
+type Executor struct {
+	settings Settings
+	breaker  *gobreaker.CircuitBreaker // Nil when the breaker is disabled
+}
+
+// NewExecutor creates an executor and registers it for state snapshots
+func NewExecutor(settings Settings) *Executor {
+	if settings.IsTransient == nil {
+		settings.IsTransient = IsTransient
+	}
+	if settings.HalfOpenRequests <= 0 {
+		settings.HalfOpenRequests = 1
+	}
+
+	e := &Executor{settings: settings}
+
+	if settings.FailureThreshold > 0 {
+		threshold := uint32(settings.FailureThreshold)
+		e.breaker = gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:        settings.Name,
+			MaxRequests: uint32(settings.HalfOpenRequests),
+			Timeout:     settings.OpenTimeout,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				return counts.ConsecutiveFailures >= threshold
+			},
+			// Only transient errors count against the breaker; "not found" and
+			// validation errors mean the dependency is healthy
+			IsSuccessful: func(err error) bool {
+				return err == nil || !settings.IsTransient(err)
+			},
+			OnStateChange: func(name string, from, to gobreaker.State) {
+				log.Printf("Circuit breaker %q changed state: %s -> %s", name, from, to)
+				notifyStateChange(name, from.String(), to.String())
+			},
+		})
+	}
+
+	register(e)
+	return e
+}
+
+// Name returns the dependency name of this executor
+func (e *Executor) Name() string {
+	return e.settings.Name
+}
+
+// State returns the breaker state ("closed", "half-open", "open" or "disabled")
+func (e *Executor) State() string {
+	if e.breaker == nil {
+		return "disabled"
+	}
+	return e.breaker.State().String()
+}
+
+// Do runs fn, retrying transient failures with jittered exponential backoff
+// Returns an error wrapping ErrCircuitOpen when the breaker rejects the call
+func (e *Executor) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	for attempt := 0; ; attempt++ {
+		err := e.execute(ctx, fn)
+		if err == nil {
+			return nil
+		}
+
+		if errors.Is(err, ErrCircuitOpen) || !e.settings.IsTransient(err) || attempt >= e.settings.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(e.backoff(attempt)):
+		}
+	}
+}
+
+// Once runs fn a single time through the breaker without retrying
+// Used for non-idempotent writes where a lost acknowledgement must not cause a duplicate
+func (e *Executor) Once(ctx context.Context, fn func(ctx context.Context) error) error {
+	return e.execute(ctx, fn)
+}
+
+// Call runs fn through the executor and returns its result
+func Call[T any](ctx context.Context, e *Executor, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := e.Do(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+	return result, err
+}
+
+// execute runs a single attempt through the breaker
+func (e *Executor) execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	if e.breaker == nil {
+		return fn(ctx)
+	}
+
+	_, err := e.breaker.Execute(func() (interface{}, error) {
+		return nil, fn(ctx)
+	})
+	if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+		return fmt.Errorf("%s: %w", e.settings.Name, ErrCircuitOpen)
+	}
+	return err
+}
+
+// backoff returns the delay before the given retry using "full jitter"
+func (e *Executor) backoff(attempt int) time.Duration {
+	delay := e.settings.RetryBaseDelay << attempt
+	if delay <= 0 || (e.settings.RetryMaxDelay > 0 && delay > e.settings.RetryMaxDelay) {
+		delay = e.settings.RetryMaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(delay)) + 1)
+}
+
+// Registry of executors so operators can inspect breaker states
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]*Executor{}
+	listeners  []func(name, from, to string)
+)
+
+func register(e *Executor) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[e.settings.Name] = e
+}
+
+// States returns the current breaker state of every registered executor keyed by name
+func States() map[string]string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	states := make(map[string]string, len(registry))
+	for name, e := range registry {
+		states[name] = e.State()
+	}
+	return states
+}
+
+// OnStateChange registers a listener invoked whenever any breaker changes state
+// Used to feed breaker transitions into metrics
+func OnStateChange(listener func(name, from, to string)) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	listeners = append(listeners, listener)
+}
+
+func notifyStateChange(name, from, to string) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	for _, listener := range listeners {
+		listener(name, from, to)
+	}
+}
@@ -0,0 +1,147 @@
+package resilience
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestExecutor(maxRetries, threshold int) *Executor {
+	return NewExecutor(Settings{
+		Name:             "test",
+		MaxRetries:       maxRetries,
+		RetryBaseDelay:   time.Millisecond,
+		RetryMaxDelay:    2 * time.Millisecond,
+		FailureThreshold: threshold,
+		OpenTimeout:      time.Hour,
+	})
+}
+
+func TestExecutor_RetriesTransientErrors(t *testing.T) {
+	exec := newTestExecutor(2, 0)
+	calls := 0
+
+	err := exec.Do(context.Background(), func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return driver.ErrBadConn
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestExecutor_DoesNotRetryDomainErrors(t *testing.T) {
+	exec := newTestExecutor(3, 0)
+	calls := 0
+	notFound := event.NewEventNotFoundError(uuid.New())
+
+	err := exec.Do(context.Background(), func(ctx context.Context) error {
+		calls++
+		return notFound
+	})
+
+	assert.Equal(t, notFound, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestExecutor_OnceDoesNotRetry(t *testing.T) {
+	exec := newTestExecutor(3, 0)
+	calls := 0
+
+	err := exec.Once(context.Background(), func(ctx context.Context) error {
+		calls++
+		return driver.ErrBadConn
+	})
+
+	assert.ErrorIs(t, err, driver.ErrBadConn)
+	assert.Equal(t, 1, calls)
+}
+
+func TestExecutor_OpensBreakerAfterThreshold(t *testing.T) {
+	exec := newTestExecutor(0, 2)
+	failing := func(ctx context.Context) error { return driver.ErrBadConn }
+
+	_ = exec.Do(context.Background(), failing)
+	_ = exec.Do(context.Background(), failing)
+	assert.Equal(t, "open", exec.State())
+
+	calls := 0
+	err := exec.Do(context.Background(), func(ctx context.Context) error {
+		calls++
+		return nil
+	})
+
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, 0, calls, "open breaker must not call the dependency")
+}
+
+func TestExecutor_DomainErrorsDoNotTripBreaker(t *testing.T) {
+	exec := newTestExecutor(0, 1)
+
+	_ = exec.Do(context.Background(), func(ctx context.Context) error {
+		return event.NewEventNotFoundError(uuid.New())
+	})
+
+	assert.Equal(t, "closed", exec.State())
+}
+
+func TestExecutor_StopsRetryingWhenContextCancelled(t *testing.T) {
+	exec := NewExecutor(Settings{Name: "test", MaxRetries: 5, RetryBaseDelay: time.Hour, RetryMaxDelay: time.Hour})
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+
+	err := exec.Do(ctx, func(ctx context.Context) error {
+		calls++
+		cancel()
+		return driver.ErrBadConn
+	})
+
+	assert.ErrorIs(t, err, driver.ErrBadConn)
+	assert.Equal(t, 1, calls)
+}
+
+func TestCall_ReturnsResult(t *testing.T) {
+	exec := newTestExecutor(1, 0)
+
+	result, err := Call(context.Background(), exec, func(ctx context.Context) (string, error) {
+		return "ok", nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", result)
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"bad connection", driver.ErrBadConn, true},
+		{"wrapped bad connection", event.NewEventError(event.ErrEventRetrievalFailed, driver.ErrBadConn), true},
+		{"serialization failure", &pgconn.PgError{Code: "40001"}, true},
+		{"connection exception", &pgconn.PgError{Code: "08006"}, true},
+		{"unique violation", &pgconn.PgError{Code: "23505"}, false},
+		{"context cancelled", context.Canceled, false},
+		{"domain error", event.ErrEventNotFound, false},
+		{"generic error", errors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsTransient(tt.err))
+		})
+	}
+}
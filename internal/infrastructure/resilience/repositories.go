@@ -0,0 +1,1066 @@
+package resilience
+
+import (
+	"context"
+	"time"
+
+	"enterprise-crud/internal/domain/accesscode"
+	"enterprise-crud/internal/domain/account"
+	"enterprise-crud/internal/domain/analytics"
+	"enterprise-crud/internal/domain/audit"
+	"enterprise-crud/internal/domain/branding"
+	"enterprise-crud/internal/domain/consent"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/invoice"
+	"enterprise-crud/internal/domain/ipaccess"
+	"enterprise-crud/internal/domain/messaging"
+	"enterprise-crud/internal/domain/notification"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/plan"
+	"enterprise-crud/internal/domain/refund"
+	"enterprise-crud/internal/domain/report"
+	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/domain/shorturl"
+	"enterprise-crud/internal/domain/staff"
+	"enterprise-crud/internal/domain/transfer"
+	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/domain/venue"
+	"enterprise-crud/internal/domain/webhook"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Repository decorators below retry reads on transient errors and send writes
+// through the breaker once, since a write whose acknowledgement was lost may
+// already have been applied.
+
+// eventRepository decorates an event.Repository with breaker and retry handling
+type eventRepository struct {
+	base event.Repository
+	exec *Executor
+}
+
+// NewEventRepository wraps an event repository with the given executor
+func NewEventRepository(base event.Repository, exec *Executor) event.Repository {
+	return &eventRepository{base: base, exec: exec}
+}
+
+func (r *eventRepository) Create(ctx context.Context, e *event.Event) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Create(ctx, e) })
+}
+
+func (r *eventRepository) GetByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*event.Event, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *eventRepository) GetBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*event.Event, error) { return r.base.GetBySlug(ctx, slug) })
+}
+
+func (r *eventRepository) GetAll(ctx context.Context) ([]*event.Event, error) {
+	return Call(ctx, r.exec, r.base.GetAll)
+}
+
+func (r *eventRepository) GetByOrganizer(ctx context.Context, organizerID uuid.UUID) ([]*event.Event, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*event.Event, error) {
+		return r.base.GetByOrganizer(ctx, organizerID)
+	})
+}
+
+func (r *eventRepository) GetByVenue(ctx context.Context, venueID uuid.UUID) ([]*event.Event, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*event.Event, error) {
+		return r.base.GetByVenue(ctx, venueID)
+	})
+}
+
+func (r *eventRepository) Update(ctx context.Context, e *event.Event) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Update(ctx, e) })
+}
+
+func (r *eventRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Delete(ctx, id) })
+}
+
+func (r *eventRepository) RefreshOnSale(ctx context.Context, now time.Time) ([]*event.Event, error) {
+	var changed []*event.Event
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		changed, err = r.base.RefreshOnSale(ctx, now)
+		return err
+	})
+	return changed, err
+}
+
+func (r *eventRepository) AdjustInventory(ctx context.Context, adjustment *event.InventoryAdjustment) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.AdjustInventory(ctx, adjustment) })
+}
+
+func (r *eventRepository) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID) ([]*event.InventoryAdjustment, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*event.InventoryAdjustment, error) {
+		return r.base.ListInventoryAdjustments(ctx, eventID)
+	})
+}
+
+// venueRepository decorates a venue.Repository with breaker and retry handling
+type venueRepository struct {
+	base venue.Repository
+	exec *Executor
+}
+
+// NewVenueRepository wraps a venue repository with the given executor
+func NewVenueRepository(base venue.Repository, exec *Executor) venue.Repository {
+	return &venueRepository{base: base, exec: exec}
+}
+
+func (r *venueRepository) Create(ctx context.Context, v *venue.Venue) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Create(ctx, v) })
+}
+
+func (r *venueRepository) GetByID(ctx context.Context, id uuid.UUID) (*venue.Venue, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*venue.Venue, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *venueRepository) GetAll(ctx context.Context) ([]*venue.Venue, error) {
+	return Call(ctx, r.exec, r.base.GetAll)
+}
+
+func (r *venueRepository) Update(ctx context.Context, v *venue.Venue) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Update(ctx, v) })
+}
+
+func (r *venueRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Delete(ctx, id) })
+}
+
+// userRepository decorates a user.Repository with breaker and retry handling
+type userRepository struct {
+	base user.Repository
+	exec *Executor
+}
+
+// NewUserRepository wraps a user repository with the given executor
+func NewUserRepository(base user.Repository, exec *Executor) user.Repository {
+	return &userRepository{base: base, exec: exec}
+}
+
+func (r *userRepository) Create(ctx context.Context, u *user.User) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Create(ctx, u) })
+}
+
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*user.User, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*user.User, error) { return r.base.GetByEmail(ctx, email) })
+}
+
+func (r *userRepository) GetByUsername(ctx context.Context, username string) (*user.User, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*user.User, error) { return r.base.GetByUsername(ctx, username) })
+}
+
+func (r *userRepository) AddRole(ctx context.Context, userID uuid.UUID, ro *role.Role) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.AddRole(ctx, userID, ro) })
+}
+
+func (r *userRepository) RemoveRole(ctx context.Context, userID uuid.UUID, ro *role.Role) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.RemoveRole(ctx, userID, ro) })
+}
+
+func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*user.User, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *userRepository) GetStatus(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*user.User, error) { return r.base.GetStatus(ctx, id) })
+}
+
+func (r *userRepository) UpdateStatus(ctx context.Context, change *user.StatusChange) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.UpdateStatus(ctx, change) })
+}
+
+func (r *userRepository) ListStatusChanges(ctx context.Context, userID uuid.UUID) ([]*user.StatusChange, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*user.StatusChange, error) {
+		return r.base.ListStatusChanges(ctx, userID)
+	})
+}
+
+func (r *userRepository) SetDateOfBirth(ctx context.Context, id uuid.UUID, dateOfBirth *time.Time) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.SetDateOfBirth(ctx, id, dateOfBirth) })
+}
+
+// UpdatePassword overwrites the hash, so retrying it is safe
+func (r *userRepository) UpdatePassword(ctx context.Context, id uuid.UUID, hash, algorithm string) error {
+	return r.exec.Do(ctx, func(ctx context.Context) error { return r.base.UpdatePassword(ctx, id, hash, algorithm) })
+}
+
+// roleRepository decorates a role.Repository with breaker and retry handling
+type roleRepository struct {
+	base role.Repository
+	exec *Executor
+}
+
+// NewRoleRepository wraps a role repository with the given executor
+func NewRoleRepository(base role.Repository, exec *Executor) role.Repository {
+	return &roleRepository{base: base, exec: exec}
+}
+
+func (r *roleRepository) GetByName(ctx context.Context, name role.Name) (*role.Role, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*role.Role, error) { return r.base.GetByName(ctx, name) })
+}
+
+// orderRepository decorates an order.Repository with breaker and retry handling
+// Methods taking a transaction are passed through untouched: a failed statement
+// aborts the whole transaction, so retrying it in isolation would be wrong.
+type orderRepository struct {
+	base order.Repository
+	exec *Executor
+}
+
+// NewOrderRepository wraps an order repository with the given executor
+func NewOrderRepository(base order.Repository, exec *Executor) order.Repository {
+	return &orderRepository{base: base, exec: exec}
+}
+
+func (r *orderRepository) Create(ctx context.Context, o *order.Order) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Create(ctx, o) })
+}
+
+func (r *orderRepository) GetByID(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*order.Order, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *orderRepository) GetStatus(ctx context.Context, id uuid.UUID) (*order.StatusSnapshot, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*order.StatusSnapshot, error) { return r.base.GetStatus(ctx, id) })
+}
+
+func (r *orderRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*order.Order, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*order.Order, error) { return r.base.GetByUserID(ctx, userID) })
+}
+
+func (r *orderRepository) Update(ctx context.Context, o *order.Order) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Update(ctx, o) })
+}
+
+func (r *orderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Delete(ctx, id) })
+}
+
+func (r *orderRepository) GetByEventID(ctx context.Context, eventID uuid.UUID) ([]*order.Order, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*order.Order, error) { return r.base.GetByEventID(ctx, eventID) })
+}
+
+func (r *orderRepository) GetAttendees(ctx context.Context, eventID uuid.UUID) ([]*order.Attendee, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*order.Attendee, error) { return r.base.GetAttendees(ctx, eventID) })
+}
+
+func (r *orderRepository) MarkCheckedIn(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	var checkedIn bool
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		checkedIn, err = r.base.MarkCheckedIn(ctx, id, at)
+		return err
+	})
+	return checkedIn, err
+}
+
+func (r *orderRepository) ExpirePending(ctx context.Context, cutoff, now time.Time) (int64, error) {
+	var expired int64
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		expired, err = r.base.ExpirePending(ctx, cutoff, now)
+		return err
+	})
+	return expired, err
+}
+
+func (r *orderRepository) GetPurchaseHistory(ctx context.Context, userID, eventID uuid.UUID, since time.Time) (*order.PurchaseHistory, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*order.PurchaseHistory, error) {
+		return r.base.GetPurchaseHistory(ctx, userID, eventID, since)
+	})
+}
+
+func (r *orderRepository) ListByStatus(ctx context.Context, status order.Status, limit int) ([]*order.Order, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*order.Order, error) { return r.base.ListByStatus(ctx, status, limit) })
+}
+
+func (r *orderRepository) ResolveReview(ctx context.Context, id uuid.UUID, status order.Status) (bool, error) {
+	var resolved bool
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		resolved, err = r.base.ResolveReview(ctx, id, status)
+		return err
+	})
+	return resolved, err
+}
+
+func (r *orderRepository) FindTicketDrift(ctx context.Context) ([]*order.TicketCount, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*order.TicketCount, error) { return r.base.FindTicketDrift(ctx) })
+}
+
+func (r *orderRepository) CorrectAvailableTickets(ctx context.Context, eventID uuid.UUID, observed int) (bool, error) {
+	var corrected bool
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		corrected, err = r.base.CorrectAvailableTickets(ctx, eventID, observed)
+		return err
+	})
+	return corrected, err
+}
+
+func (r *orderRepository) ArchiveEvents(ctx context.Context, cutoff time.Time, limit int) (*order.Archived, error) {
+	var archived *order.Archived
+	err := r.exec.Do(ctx, func(ctx context.Context) error {
+		var err error
+		archived, err = r.base.ArchiveEvents(ctx, cutoff, limit)
+		return err
+	})
+	return archived, err
+}
+
+func (r *orderRepository) SetGiftToken(ctx context.Context, id uuid.UUID, tokenHash string) (bool, error) {
+	var set bool
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		set, err = r.base.SetGiftToken(ctx, id, tokenHash)
+		return err
+	})
+	return set, err
+}
+
+func (r *orderRepository) GetByGiftToken(ctx context.Context, tokenHash string) (*order.Order, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*order.Order, error) { return r.base.GetByGiftToken(ctx, tokenHash) })
+}
+
+func (r *orderRepository) ClaimGift(ctx context.Context, id, purchaserID, recipientID uuid.UUID, at time.Time) (bool, error) {
+	var claimed bool
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		claimed, err = r.base.ClaimGift(ctx, id, purchaserID, recipientID, at)
+		return err
+	})
+	return claimed, err
+}
+
+func (r *orderRepository) GetGroup(ctx context.Context, id uuid.UUID) (*order.Group, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*order.Group, error) { return r.base.GetGroup(ctx, id) })
+}
+
+func (r *orderRepository) GetByGroupID(ctx context.Context, groupID uuid.UUID) ([]*order.Order, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*order.Order, error) { return r.base.GetByGroupID(ctx, groupID) })
+}
+
+func (r *orderRepository) GetByShareToken(ctx context.Context, tokenHash string) (*order.Order, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*order.Order, error) { return r.base.GetByShareToken(ctx, tokenHash) })
+}
+
+func (r *orderRepository) TakeShare(ctx context.Context, id uuid.UUID, tokenHash string, userID uuid.UUID, now time.Time) (bool, error) {
+	var taken bool
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		taken, err = r.base.TakeShare(ctx, id, tokenHash, userID, now)
+		return err
+	})
+	return taken, err
+}
+
+func (r *orderRepository) CreateWithTx(ctx context.Context, tx *gorm.DB, o *order.Order) error {
+	return r.base.CreateWithTx(ctx, tx, o)
+}
+
+func (r *orderRepository) CreateGroupWithTx(ctx context.Context, tx *gorm.DB, g *order.Group) error {
+	return r.base.CreateGroupWithTx(ctx, tx, g)
+}
+
+func (r *orderRepository) LockEventWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID) (*order.EventInfo, error) {
+	return r.base.LockEventWithTx(ctx, tx, eventID)
+}
+
+func (r *orderRepository) ReserveTicketsWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID, quantity int) (*order.EventInfo, error) {
+	return r.base.ReserveTicketsWithTx(ctx, tx, eventID, quantity)
+}
+
+func (r *orderRepository) UpdateEventTicketsWithTx(ctx context.Context, tx *gorm.DB, eventID uuid.UUID, newAvailableTickets int) error {
+	return r.base.UpdateEventTicketsWithTx(ctx, tx, eventID, newAvailableTickets)
+}
+
+// planRepository decorates a plan.Repository with breaker and retry handling
+type planRepository struct {
+	base plan.Repository
+	exec *Executor
+}
+
+// NewPlanRepository wraps a plan repository with the given executor
+func NewPlanRepository(base plan.Repository, exec *Executor) plan.Repository {
+	return &planRepository{base: base, exec: exec}
+}
+
+func (r *planRepository) GetAll(ctx context.Context) ([]*plan.Plan, error) {
+	return Call(ctx, r.exec, r.base.GetAll)
+}
+
+func (r *planRepository) GetByName(ctx context.Context, name string) (*plan.Plan, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*plan.Plan, error) { return r.base.GetByName(ctx, name) })
+}
+
+func (r *planRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*plan.Plan, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*plan.Plan, error) { return r.base.GetByUserID(ctx, userID) })
+}
+
+func (r *planRepository) AssignToUser(ctx context.Context, userID, planID uuid.UUID) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.AssignToUser(ctx, userID, planID) })
+}
+
+type reportRepository struct {
+	base report.Repository
+	exec *Executor
+}
+
+// NewReportRepository wraps a report repository with the given executor
+func NewReportRepository(base report.Repository, exec *Executor) report.Repository {
+	return &reportRepository{base: base, exec: exec}
+}
+
+func (r *reportRepository) GetSchedule(ctx context.Context, userID uuid.UUID) (*report.Schedule, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*report.Schedule, error) { return r.base.GetSchedule(ctx, userID) })
+}
+
+func (r *reportRepository) SaveSchedule(ctx context.Context, s *report.Schedule) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.SaveSchedule(ctx, s) })
+}
+
+func (r *reportRepository) GetDueSchedules(ctx context.Context, now time.Time, limit int) ([]*report.Schedule, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*report.Schedule, error) { return r.base.GetDueSchedules(ctx, now, limit) })
+}
+
+func (r *reportRepository) AdvanceSchedule(ctx context.Context, id uuid.UUID, expected, next time.Time) (bool, error) {
+	// A retry after a lost acknowledgement would report the period as claimed elsewhere, so run once
+	var advanced bool
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		advanced, err = r.base.AdvanceSchedule(ctx, id, expected, next)
+		return err
+	})
+	return advanced, err
+}
+
+func (r *reportRepository) MarkSent(ctx context.Context, userID uuid.UUID, sentAt time.Time) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.MarkSent(ctx, userID, sentAt) })
+}
+
+func (r *reportRepository) GetSalesSummary(ctx context.Context, organizerID uuid.UUID, from, to time.Time) (*report.SalesSummary, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*report.SalesSummary, error) {
+		return r.base.GetSalesSummary(ctx, organizerID, from, to)
+	})
+}
+
+func (r *reportRepository) GetSalesSeries(ctx context.Context, eventID uuid.UUID) (*report.SalesSeries, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*report.SalesSeries, error) { return r.base.GetSalesSeries(ctx, eventID) })
+}
+
+func (r *reportRepository) GetRecipient(ctx context.Context, userID uuid.UUID) (*report.Recipient, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*report.Recipient, error) { return r.base.GetRecipient(ctx, userID) })
+}
+
+type invoiceRepository struct {
+	base invoice.Repository
+	exec *Executor
+}
+
+// NewInvoiceRepository wraps an invoice repository with the given executor
+func NewInvoiceRepository(base invoice.Repository, exec *Executor) invoice.Repository {
+	return &invoiceRepository{base: base, exec: exec}
+}
+
+func (r *invoiceRepository) GetSource(ctx context.Context, orderID uuid.UUID) (*invoice.Source, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*invoice.Source, error) { return r.base.GetSource(ctx, orderID) })
+}
+
+func (r *invoiceRepository) AssignNumber(ctx context.Context, orderID uuid.UUID, issuedAt time.Time) (bool, error) {
+	// Only unnumbered orders are updated, so retrying can't draw a second number
+	return Call(ctx, r.exec, func(ctx context.Context) (bool, error) { return r.base.AssignNumber(ctx, orderID, issuedAt) })
+}
+
+type shortURLRepository struct {
+	base shorturl.Repository
+	exec *Executor
+}
+
+// NewShortURLRepository wraps a short URL repository with the given executor
+func NewShortURLRepository(base shorturl.Repository, exec *Executor) shorturl.Repository {
+	return &shortURLRepository{base: base, exec: exec}
+}
+
+func (r *shortURLRepository) Create(ctx context.Context, s *shorturl.ShortURL) (bool, error) {
+	var created bool
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = r.base.Create(ctx, s)
+		return err
+	})
+	return created, err
+}
+
+func (r *shortURLRepository) GetByCode(ctx context.Context, code string) (*shorturl.ShortURL, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*shorturl.ShortURL, error) { return r.base.GetByCode(ctx, code) })
+}
+
+func (r *shortURLRepository) GetByEventID(ctx context.Context, eventID uuid.UUID) (*shorturl.ShortURL, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*shorturl.ShortURL, error) { return r.base.GetByEventID(ctx, eventID) })
+}
+
+func (r *shortURLRepository) GetByEventIDs(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID]*shorturl.ShortURL, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (map[uuid.UUID]*shorturl.ShortURL, error) {
+		return r.base.GetByEventIDs(ctx, eventIDs)
+	})
+}
+
+func (r *shortURLRepository) IncrementClicks(ctx context.Context, code string) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.IncrementClicks(ctx, code) })
+}
+
+type staffRepository struct {
+	base staff.Repository
+	exec *Executor
+}
+
+// NewStaffRepository wraps an event staff repository with the given executor
+func NewStaffRepository(base staff.Repository, exec *Executor) staff.Repository {
+	return &staffRepository{base: base, exec: exec}
+}
+
+func (r *staffRepository) Create(ctx context.Context, a *staff.Assignment) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Create(ctx, a) })
+}
+
+func (r *staffRepository) GetByID(ctx context.Context, id uuid.UUID) (*staff.Assignment, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*staff.Assignment, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *staffRepository) Get(ctx context.Context, eventID, userID uuid.UUID) (*staff.Assignment, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*staff.Assignment, error) { return r.base.Get(ctx, eventID, userID) })
+}
+
+func (r *staffRepository) GetByEvent(ctx context.Context, eventID uuid.UUID) ([]*staff.Assignment, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*staff.Assignment, error) { return r.base.GetByEvent(ctx, eventID) })
+}
+
+func (r *staffRepository) GetPendingByUser(ctx context.Context, userID uuid.UUID) ([]*staff.Assignment, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*staff.Assignment, error) { return r.base.GetPendingByUser(ctx, userID) })
+}
+
+func (r *staffRepository) Accept(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Accept(ctx, id, at) })
+}
+
+func (r *staffRepository) Delete(ctx context.Context, eventID, userID uuid.UUID) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Delete(ctx, eventID, userID) })
+}
+
+func (r *staffRepository) IsCoOrganizer(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (bool, error) { return r.base.IsCoOrganizer(ctx, eventID, userID) })
+}
+
+func (r *staffRepository) FindUserIDByEmail(ctx context.Context, email string) (uuid.UUID, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (uuid.UUID, error) { return r.base.FindUserIDByEmail(ctx, email) })
+}
+
+type notificationRepository struct {
+	base notification.Repository
+	exec *Executor
+}
+
+// NewNotificationRepository wraps a notification repository with the given executor
+func NewNotificationRepository(base notification.Repository, exec *Executor) notification.Repository {
+	return &notificationRepository{base: base, exec: exec}
+}
+
+func (r *notificationRepository) Create(ctx context.Context, n *notification.Notification) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Create(ctx, n) })
+}
+
+func (r *notificationRepository) ListByUser(ctx context.Context, userID uuid.UUID, filter notification.ListFilter) ([]*notification.Notification, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*notification.Notification, error) {
+		return r.base.ListByUser(ctx, userID, filter)
+	})
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (int64, error) { return r.base.CountUnread(ctx, userID) })
+}
+
+func (r *notificationRepository) MarkRead(ctx context.Context, userID, id uuid.UUID, at time.Time) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.MarkRead(ctx, userID, id, at) })
+}
+
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int64, error) {
+	var marked int64
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		marked, err = r.base.MarkAllRead(ctx, userID, at)
+		return err
+	})
+	return marked, err
+}
+
+func (r *notificationRepository) GetPreferences(ctx context.Context, userID uuid.UUID) ([]*notification.Preference, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*notification.Preference, error) {
+		return r.base.GetPreferences(ctx, userID)
+	})
+}
+
+func (r *notificationRepository) SavePreferences(ctx context.Context, prefs []*notification.Preference) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.SavePreferences(ctx, prefs) })
+}
+
+func (r *notificationRepository) SaveDevice(ctx context.Context, device *notification.Device) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.SaveDevice(ctx, device) })
+}
+
+func (r *notificationRepository) GetDevices(ctx context.Context, userID uuid.UUID) ([]*notification.Device, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*notification.Device, error) { return r.base.GetDevices(ctx, userID) })
+}
+
+func (r *notificationRepository) DeleteDevice(ctx context.Context, userID, id uuid.UUID) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.DeleteDevice(ctx, userID, id) })
+}
+
+func (r *notificationRepository) DeleteDeviceToken(ctx context.Context, token string) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.DeleteDeviceToken(ctx, token) })
+}
+
+func (r *notificationRepository) SavePhoneVerification(ctx context.Context, v *notification.PhoneVerification) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.SavePhoneVerification(ctx, v) })
+}
+
+func (r *notificationRepository) GetPhoneVerification(ctx context.Context, userID uuid.UUID) (*notification.PhoneVerification, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*notification.PhoneVerification, error) {
+		return r.base.GetPhoneVerification(ctx, userID)
+	})
+}
+
+func (r *notificationRepository) IncrementVerificationAttempts(ctx context.Context, userID uuid.UUID) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.IncrementVerificationAttempts(ctx, userID) })
+}
+
+func (r *notificationRepository) DeletePhoneVerification(ctx context.Context, userID uuid.UUID) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.DeletePhoneVerification(ctx, userID) })
+}
+
+func (r *notificationRepository) GetPhone(ctx context.Context, userID uuid.UUID) (*notification.PhoneContact, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*notification.PhoneContact, error) { return r.base.GetPhone(ctx, userID) })
+}
+
+func (r *notificationRepository) SetPhone(ctx context.Context, userID uuid.UUID, phone string, verifiedAt *time.Time) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.SetPhone(ctx, userID, phone, verifiedAt) })
+}
+
+func (r *notificationRepository) GetTicketHolderPhones(ctx context.Context, eventID uuid.UUID) ([]notification.PhoneContact, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]notification.PhoneContact, error) {
+		return r.base.GetTicketHolderPhones(ctx, eventID)
+	})
+}
+
+func (r *notificationRepository) GetTicketHolderIDs(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]uuid.UUID, error) { return r.base.GetTicketHolderIDs(ctx, eventID) })
+}
+
+func (r *notificationRepository) GetRecipient(ctx context.Context, userID uuid.UUID) (*notification.Recipient, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*notification.Recipient, error) { return r.base.GetRecipient(ctx, userID) })
+}
+
+type accountRepository struct {
+	base account.Repository
+	exec *Executor
+}
+
+// NewAccountRepository wraps an account repository with the given executor
+func NewAccountRepository(base account.Repository, exec *Executor) account.Repository {
+	return &accountRepository{base: base, exec: exec}
+}
+
+func (r *accountRepository) CreateExport(ctx context.Context, export *account.Export) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.CreateExport(ctx, export) })
+}
+
+func (r *accountRepository) GetExport(ctx context.Context, id uuid.UUID) (*account.Export, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*account.Export, error) { return r.base.GetExport(ctx, id) })
+}
+
+func (r *accountRepository) GetPendingExport(ctx context.Context, userID uuid.UUID, since time.Time) (*account.Export, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*account.Export, error) {
+		return r.base.GetPendingExport(ctx, userID, since)
+	})
+}
+
+func (r *accountRepository) CompleteExport(ctx context.Context, id uuid.UUID, status string, completedAt time.Time) error {
+	// Setting the same status twice is harmless
+	return r.exec.Do(ctx, func(ctx context.Context) error { return r.base.CompleteExport(ctx, id, status, completedAt) })
+}
+
+func (r *accountRepository) GetPersonalData(ctx context.Context, userID uuid.UUID) (*account.PersonalData, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*account.PersonalData, error) { return r.base.GetPersonalData(ctx, userID) })
+}
+
+func (r *accountRepository) ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) (time.Time, error) {
+	// An existing schedule is kept, so retrying returns the date set by the first attempt
+	return Call(ctx, r.exec, func(ctx context.Context) (time.Time, error) { return r.base.ScheduleDeletion(ctx, userID, at) })
+}
+
+func (r *accountRepository) CancelDeletion(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var cancelled bool
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		cancelled, err = r.base.CancelDeletion(ctx, userID)
+		return err
+	})
+	return cancelled, err
+}
+
+func (r *accountRepository) GetDueDeletions(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]uuid.UUID, error) { return r.base.GetDueDeletions(ctx, now, limit) })
+}
+
+func (r *accountRepository) DeleteAccount(ctx context.Context, userID uuid.UUID, now time.Time) ([]string, bool, error) {
+	var storageKeys []string
+	var deleted bool
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		storageKeys, deleted, err = r.base.DeleteAccount(ctx, userID, now)
+		return err
+	})
+	return storageKeys, deleted, err
+}
+
+type consentRepository struct {
+	base consent.Repository
+	exec *Executor
+}
+
+// NewConsentRepository wraps a consent repository with the given executor
+func NewConsentRepository(base consent.Repository, exec *Executor) consent.Repository {
+	return &consentRepository{base: base, exec: exec}
+}
+
+func (r *consentRepository) CreateVersion(ctx context.Context, version *consent.Version) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.CreateVersion(ctx, version) })
+}
+
+func (r *consentRepository) GetVersions(ctx context.Context, ids []uuid.UUID) ([]*consent.Version, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*consent.Version, error) { return r.base.GetVersions(ctx, ids) })
+}
+
+func (r *consentRepository) ListVersions(ctx context.Context, policyType string) ([]*consent.Version, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*consent.Version, error) { return r.base.ListVersions(ctx, policyType) })
+}
+
+func (r *consentRepository) GetLatestVersions(ctx context.Context) ([]*consent.Version, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*consent.Version, error) { return r.base.GetLatestVersions(ctx) })
+}
+
+func (r *consentRepository) GetPendingVersions(ctx context.Context, userID uuid.UUID) ([]*consent.Version, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*consent.Version, error) { return r.base.GetPendingVersions(ctx, userID) })
+}
+
+func (r *consentRepository) ListAcceptances(ctx context.Context, userID uuid.UUID) ([]*consent.Acceptance, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*consent.Acceptance, error) { return r.base.ListAcceptances(ctx, userID) })
+}
+
+func (r *consentRepository) RecordConsents(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID, acceptedAt time.Time) error {
+	// Repeated acceptances are ignored, so retrying is safe
+	return r.exec.Do(ctx, func(ctx context.Context) error { return r.base.RecordConsents(ctx, userID, versionIDs, acceptedAt) })
+}
+
+type ipAccessRepository struct {
+	base ipaccess.Repository
+	exec *Executor
+}
+
+// NewIPAccessRepository wraps an admin IP rule repository with the given executor
+func NewIPAccessRepository(base ipaccess.Repository, exec *Executor) ipaccess.Repository {
+	return &ipAccessRepository{base: base, exec: exec}
+}
+
+func (r *ipAccessRepository) CreateRule(ctx context.Context, rule *ipaccess.Rule) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.CreateRule(ctx, rule) })
+}
+
+func (r *ipAccessRepository) ListRules(ctx context.Context) ([]*ipaccess.Rule, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*ipaccess.Rule, error) { return r.base.ListRules(ctx) })
+}
+
+func (r *ipAccessRepository) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.DeleteRule(ctx, id) })
+}
+
+type analyticsRepository struct {
+	base analytics.Repository
+	exec *Executor
+}
+
+// NewAnalyticsRepository wraps an analytics repository with the given executor
+func NewAnalyticsRepository(base analytics.Repository, exec *Executor) analytics.Repository {
+	return &analyticsRepository{base: base, exec: exec}
+}
+
+func (r *analyticsRepository) ComputeDay(ctx context.Context, day time.Time) (*analytics.DailySnapshot, []*analytics.EventDailySales, error) {
+	var snapshot *analytics.DailySnapshot
+	var sales []*analytics.EventDailySales
+	err := r.exec.Do(ctx, func(ctx context.Context) error {
+		var err error
+		snapshot, sales, err = r.base.ComputeDay(ctx, day)
+		return err
+	})
+	return snapshot, sales, err
+}
+
+func (r *analyticsRepository) SaveDay(ctx context.Context, snapshot *analytics.DailySnapshot, sales []*analytics.EventDailySales) error {
+	// The day's rows are replaced as a whole, so retrying is safe
+	return r.exec.Do(ctx, func(ctx context.Context) error { return r.base.SaveDay(ctx, snapshot, sales) })
+}
+
+func (r *analyticsRepository) SaveViews(ctx context.Context, day time.Time, views []*analytics.ViewCount) error {
+	// Counts replace earlier ones, so retrying is safe
+	return r.exec.Do(ctx, func(ctx context.Context) error { return r.base.SaveViews(ctx, day, views) })
+}
+
+func (r *analyticsRepository) LatestDay(ctx context.Context) (*time.Time, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*time.Time, error) { return r.base.LatestDay(ctx) })
+}
+
+func (r *analyticsRepository) GetSnapshots(ctx context.Context, from, to time.Time) ([]*analytics.DailySnapshot, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*analytics.DailySnapshot, error) {
+		return r.base.GetSnapshots(ctx, from, to)
+	})
+}
+
+func (r *analyticsRepository) GetOrganizerSales(ctx context.Context, organizerID uuid.UUID, from, to time.Time) ([]*analytics.SalesDay, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*analytics.SalesDay, error) {
+		return r.base.GetOrganizerSales(ctx, organizerID, from, to)
+	})
+}
+
+// auditRepository decorates an audit.Repository with breaker and retry handling
+type auditRepository struct {
+	base audit.Repository
+	exec *Executor
+}
+
+// NewAuditRepository wraps an audit trail repository with the given executor
+func NewAuditRepository(base audit.Repository, exec *Executor) audit.Repository {
+	return &auditRepository{base: base, exec: exec}
+}
+
+func (r *auditRepository) List(ctx context.Context, source string, after audit.Cursor, until time.Time, limit int) ([]*audit.Entry, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*audit.Entry, error) {
+		return r.base.List(ctx, source, after, until, limit)
+	})
+}
+
+func (r *auditRepository) GetCursor(ctx context.Context, sink, source string) (audit.Cursor, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (audit.Cursor, error) { return r.base.GetCursor(ctx, sink, source) })
+}
+
+func (r *auditRepository) SaveCursor(ctx context.Context, sink, source string, cursor audit.Cursor) error {
+	// Saving sets the cursor to the same entry, so retrying is safe
+	return r.exec.Do(ctx, func(ctx context.Context) error { return r.base.SaveCursor(ctx, sink, source, cursor) })
+}
+
+// refundRepository decorates a refund.Repository with breaker and retry handling
+type refundRepository struct {
+	base refund.Repository
+	exec *Executor
+}
+
+// NewRefundRepository wraps a refund repository with the given executor
+func NewRefundRepository(base refund.Repository, exec *Executor) refund.Repository {
+	return &refundRepository{base: base, exec: exec}
+}
+
+func (r *refundRepository) Create(ctx context.Context, req *refund.Request, entry *refund.AuditEntry) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Create(ctx, req, entry) })
+}
+
+func (r *refundRepository) GetByID(ctx context.Context, id uuid.UUID) (*refund.Request, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*refund.Request, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *refundRepository) List(ctx context.Context, filter refund.ListFilter) ([]*refund.Request, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*refund.Request, error) { return r.base.List(ctx, filter) })
+}
+
+func (r *refundRepository) Transition(ctx context.Context, req *refund.Request, from []string, entry *refund.AuditEntry) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Transition(ctx, req, from, entry) })
+}
+
+func (r *refundRepository) ListAudit(ctx context.Context, requestID uuid.UUID) ([]*refund.AuditEntry, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*refund.AuditEntry, error) { return r.base.ListAudit(ctx, requestID) })
+}
+
+// messageRepository decorates a messaging.Repository with breaker and retry handling
+type messageRepository struct {
+	base messaging.Repository
+	exec *Executor
+}
+
+// NewMessageRepository wraps an order message repository with the given executor
+func NewMessageRepository(base messaging.Repository, exec *Executor) messaging.Repository {
+	return &messageRepository{base: base, exec: exec}
+}
+
+func (r *messageRepository) Create(ctx context.Context, m *messaging.Message) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Create(ctx, m) })
+}
+
+func (r *messageRepository) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*messaging.Message, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*messaging.Message, error) { return r.base.ListByOrder(ctx, orderID) })
+}
+
+func (r *messageRepository) MarkRead(ctx context.Context, orderID, recipientID uuid.UUID, at time.Time) (int64, error) {
+	var marked int64
+	err := r.exec.Once(ctx, func(ctx context.Context) error {
+		var err error
+		marked, err = r.base.MarkRead(ctx, orderID, recipientID, at)
+		return err
+	})
+	return marked, err
+}
+
+func (r *messageRepository) CountUnread(ctx context.Context, recipientID uuid.UUID) ([]*messaging.ThreadUnread, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*messaging.ThreadUnread, error) {
+		return r.base.CountUnread(ctx, recipientID)
+	})
+}
+
+// webhookRepository decorates a webhook.Repository with breaker and retry handling
+type webhookRepository struct {
+	base webhook.Repository
+	exec *Executor
+}
+
+// NewWebhookRepository wraps a webhook subscription repository with the given executor
+func NewWebhookRepository(base webhook.Repository, exec *Executor) webhook.Repository {
+	return &webhookRepository{base: base, exec: exec}
+}
+
+func (r *webhookRepository) Create(ctx context.Context, s *webhook.Subscription) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Create(ctx, s) })
+}
+
+func (r *webhookRepository) GetByID(ctx context.Context, id uuid.UUID) (*webhook.Subscription, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*webhook.Subscription, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *webhookRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*webhook.Subscription, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*webhook.Subscription, error) { return r.base.ListByUser(ctx, userID) })
+}
+
+func (r *webhookRepository) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	// A retried delete would report the subscription it just removed as not found
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Delete(ctx, userID, id) })
+}
+
+// transferRepository decorates a transfer.Repository with breaker and retry handling
+type transferRepository struct {
+	base transfer.Repository
+	exec *Executor
+}
+
+// NewTransferRepository wraps an event transfer repository with the given executor
+func NewTransferRepository(base transfer.Repository, exec *Executor) transfer.Repository {
+	return &transferRepository{base: base, exec: exec}
+}
+
+func (r *transferRepository) Create(ctx context.Context, t *transfer.Transfer, entry *transfer.AuditEntry) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Create(ctx, t, entry) })
+}
+
+func (r *transferRepository) GetByID(ctx context.Context, id uuid.UUID) (*transfer.Transfer, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*transfer.Transfer, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *transferRepository) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]*transfer.Transfer, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*transfer.Transfer, error) { return r.base.ListByEvent(ctx, eventID) })
+}
+
+func (r *transferRepository) ListPendingForUser(ctx context.Context, userID uuid.UUID) ([]*transfer.Transfer, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*transfer.Transfer, error) {
+		return r.base.ListPendingForUser(ctx, userID)
+	})
+}
+
+func (r *transferRepository) Accept(ctx context.Context, t *transfer.Transfer, entry *transfer.AuditEntry) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Accept(ctx, t, entry) })
+}
+
+func (r *transferRepository) Close(ctx context.Context, t *transfer.Transfer, entry *transfer.AuditEntry) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Close(ctx, t, entry) })
+}
+
+func (r *transferRepository) ListAudit(ctx context.Context, transferID uuid.UUID) ([]*transfer.AuditEntry, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*transfer.AuditEntry, error) { return r.base.ListAudit(ctx, transferID) })
+}
+
+func (r *transferRepository) FindOrganizerByEmail(ctx context.Context, email string) (uuid.UUID, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (uuid.UUID, error) { return r.base.FindOrganizerByEmail(ctx, email) })
+}
+
+// accessCodeRepository decorates an accesscode.Repository with breaker and retry handling
+type accessCodeRepository struct {
+	base accesscode.Repository
+	exec *Executor
+}
+
+// NewAccessCodeRepository wraps an access code repository with the given executor
+func NewAccessCodeRepository(base accesscode.Repository, exec *Executor) accesscode.Repository {
+	return &accessCodeRepository{base: base, exec: exec}
+}
+
+func (r *accessCodeRepository) Create(ctx context.Context, code *accesscode.AccessCode) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Create(ctx, code) })
+}
+
+func (r *accessCodeRepository) GetByID(ctx context.Context, id uuid.UUID) (*accesscode.AccessCode, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*accesscode.AccessCode, error) { return r.base.GetByID(ctx, id) })
+}
+
+func (r *accessCodeRepository) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]*accesscode.AccessCode, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*accesscode.AccessCode, error) { return r.base.ListByEvent(ctx, eventID) })
+}
+
+func (r *accessCodeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.exec.Once(ctx, func(ctx context.Context) error { return r.base.Delete(ctx, id) })
+}
+
+func (r *accessCodeRepository) ListRedemptions(ctx context.Context, codeID uuid.UUID) ([]*accesscode.Redemption, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) ([]*accesscode.Redemption, error) {
+		return r.base.ListRedemptions(ctx, codeID)
+	})
+}
+
+func (r *accessCodeRepository) RedeemWithTx(ctx context.Context, tx *gorm.DB, redemption order.AccessCodeRedemption) (bool, error) {
+	return r.base.RedeemWithTx(ctx, tx, redemption)
+}
+
+// brandingRepository decorates a branding.Repository with breaker and retry handling
+type brandingRepository struct {
+	base branding.Repository
+	exec *Executor
+}
+
+// NewBrandingRepository wraps an organizer branding repository with the given executor
+func NewBrandingRepository(base branding.Repository, exec *Executor) branding.Repository {
+	return &brandingRepository{base: base, exec: exec}
+}
+
+func (r *brandingRepository) Get(ctx context.Context, organizerID uuid.UUID) (*branding.Branding, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (*branding.Branding, error) { return r.base.Get(ctx, organizerID) })
+}
+
+func (r *brandingRepository) GetMany(ctx context.Context, organizerIDs []uuid.UUID) (map[uuid.UUID]*branding.Branding, error) {
+	return Call(ctx, r.exec, func(ctx context.Context) (map[uuid.UUID]*branding.Branding, error) {
+		return r.base.GetMany(ctx, organizerIDs)
+	})
+}
+
+func (r *brandingRepository) Save(ctx context.Context, b *branding.Branding) error {
+	// Saving replaces the whole row, so a retried save stores the same branding
+	return r.exec.Do(ctx, func(ctx context.Context) error { return r.base.Save(ctx, b) })
+}
@@ -0,0 +1,67 @@
+// Package sms delivers text messages for phone verification and critical alerts
+package sms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+
+	"enterprise-crud/internal/config"
+)
+
+// ErrRejected is returned when the provider refuses a message for a reason retrying doesn't fix,
+// such as an invalid or unreachable number
+var ErrRejected = errors.New("sms: message rejected")
+
+// Message is a text message to one phone number
+type Message struct {
+	To   string // E.164 phone number
+	Body string
+}
+
+// Sender delivers text messages
+// Errors other than ErrRejected are transient and worth retrying.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewSender creates the sender selected by cfg.Provider
+// Any provider other than "twilio" logs messages instead of sending them, which suits development.
+func NewSender(cfg *config.SMSConfig) Sender {
+	if cfg.Provider == "twilio" {
+		return NewTwilioSender(cfg)
+	}
+	log.Printf("SMS delivery disabled: provider %q, messages will be logged", cfg.Provider)
+	return logSender{}
+}
+
+// logSender logs messages instead of sending them, for development
+// Message bodies may hold verification codes, so only the recipient is logged.
+type logSender struct{}
+
+func (logSender) Send(ctx context.Context, msg *Message) error {
+	log.Printf("SMS to %s not sent (no provider configured)", msg.To)
+	return nil
+}
+
+// MockSender records messages instead of sending them, for tests and staging environments
+type MockSender struct {
+	mu   sync.Mutex
+	sent []*Message
+}
+
+// Send records the message
+func (s *MockSender) Send(ctx context.Context, msg *Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+// Sent returns the messages recorded so far
+func (s *MockSender) Sent() []*Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Message(nil), s.sent...)
+}
@@ -0,0 +1,73 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"enterprise-crud/internal/config"
+)
+
+const twilioEndpoint = "https://api.twilio.com"
+
+// TwilioSender sends messages with the Twilio Programmable Messaging API
+type TwilioSender struct {
+	client     *http.Client
+	endpoint   string
+	accountSID string
+	authToken  string
+	from       string
+}
+
+// NewTwilioSender creates a sender for the configured Twilio account
+func NewTwilioSender(cfg *config.SMSConfig) *TwilioSender {
+	return &TwilioSender{
+		client:     &http.Client{Timeout: cfg.Timeout},
+		endpoint:   twilioEndpoint,
+		accountSID: cfg.TwilioAccountSID,
+		authToken:  cfg.TwilioAuthToken,
+		from:       cfg.From,
+	}
+}
+
+// Send delivers a message
+func (s *TwilioSender) Send(ctx context.Context, msg *Message) error {
+	form := url.Values{
+		"To":   {msg.To},
+		"From": {s.from},
+		"Body": {msg.Body},
+	}
+	sendURL := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", s.endpoint, url.PathEscape(s.accountSID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sendURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(s.accountSID, s.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("twilio request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusCreated || resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var twilioErr struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&twilioErr)
+
+	// 401 and 403 mean broken credentials, which an operator can fix while the job waits
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 ||
+		resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("twilio unavailable: %s %s", resp.Status, twilioErr.Message)
+	}
+	return fmt.Errorf("%w: twilio %s (code %d) %s", ErrRejected, resp.Status, twilioErr.Code, twilioErr.Message)
+}
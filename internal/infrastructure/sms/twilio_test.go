@@ -0,0 +1,72 @@
+package sms
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwilioSender_Send(t *testing.T) {
+	var status atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", r.URL.Path)
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "AC123", user)
+		assert.Equal(t, "secret", pass)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "+14155550123", r.PostForm.Get("To"))
+		assert.Equal(t, "+14155550100", r.PostForm.Get("From"))
+		assert.Equal(t, "Jazz Night has been cancelled", r.PostForm.Get("Body"))
+
+		w.WriteHeader(int(status.Load()))
+		if status.Load() == http.StatusBadRequest {
+			_, _ = w.Write([]byte(`{"code":21211,"message":"The 'To' number is not a valid phone number."}`))
+		}
+	}))
+	defer server.Close()
+
+	sender := NewTwilioSender(&config.SMSConfig{
+		TwilioAccountSID: "AC123",
+		TwilioAuthToken:  "secret",
+		From:             "+14155550100",
+		Timeout:          time.Second,
+	})
+	sender.endpoint = server.URL
+	msg := &Message{To: "+14155550123", Body: "Jazz Night has been cancelled"}
+
+	t.Run("sends the message", func(t *testing.T) {
+		status.Store(http.StatusCreated)
+
+		assert.NoError(t, sender.Send(context.Background(), msg))
+	})
+
+	t.Run("invalid number is rejected", func(t *testing.T) {
+		status.Store(http.StatusBadRequest)
+
+		err := sender.Send(context.Background(), msg)
+		assert.ErrorIs(t, err, ErrRejected)
+		assert.Contains(t, err.Error(), "21211")
+	})
+
+	t.Run("outages are transient", func(t *testing.T) {
+		status.Store(http.StatusServiceUnavailable)
+
+		err := sender.Send(context.Background(), msg)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrRejected)
+	})
+}
+
+func TestNewSender(t *testing.T) {
+	assert.IsType(t, &TwilioSender{}, NewSender(&config.SMSConfig{Provider: "twilio"}))
+	assert.IsType(t, logSender{}, NewSender(&config.SMSConfig{Provider: "log"}))
+}
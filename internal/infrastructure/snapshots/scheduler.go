@@ -0,0 +1,86 @@
+package snapshots
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/domain/analytics"
+)
+
+// Scheduler periodically takes the analytics snapshots of days that have ended and moves their
+// event page views to the database
+// Checking more often than daily means a missed midnight is caught up within one interval.
+type Scheduler struct {
+	analyticsService analytics.Service
+	interval         time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler creates a scheduler checking for due snapshots every interval
+func NewScheduler(analyticsService analytics.Service, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		analyticsService: analyticsService,
+		interval:         interval,
+	}
+}
+
+// Start begins checking for due snapshots in the background
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go s.run(ctx)
+
+	log.Printf("Analytics snapshot scheduler started, checking every %s", s.interval)
+}
+
+// Stop halts the scheduler and waits for the current check to finish
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+	log.Println("Analytics snapshot scheduler stopped")
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick snapshots every day that ended before now and has no snapshot yet, and moves its views
+func (s *Scheduler) tick(ctx context.Context, now time.Time) {
+	taken, err := s.analyticsService.TakeDueSnapshots(ctx, now)
+	if err != nil {
+		log.Printf("Warning: Failed to take analytics snapshots: %v", err)
+	}
+	if taken > 0 {
+		log.Printf("Took %d daily analytics snapshots", taken)
+	}
+
+	moved, err := s.analyticsService.MoveViews(ctx, now)
+	if err != nil {
+		log.Printf("Warning: Failed to move event page views: %v", err)
+	}
+	if moved > 0 {
+		log.Printf("Moved %d days of event page views to the database", moved)
+	}
+}
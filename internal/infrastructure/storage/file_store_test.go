@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore_PutGet(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	missing, err := store.Get(ctx, "invoices/missing.html")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	require.NoError(t, store.Put(ctx, "invoices/a.html", []byte("first")))
+	require.NoError(t, store.Put(ctx, "invoices/a.html", []byte("second")))
+
+	data, err := store.Get(ctx, "invoices/a.html")
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(data))
+}
+
+func TestFileStore_Delete(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "exports/a.zip", []byte("zip")))
+	require.NoError(t, store.Delete(ctx, "exports/a.zip"))
+	require.NoError(t, store.Delete(ctx, "exports/a.zip"), "deleting a missing object is not an error")
+
+	data, err := store.Get(ctx, "exports/a.zip")
+	require.NoError(t, err)
+	assert.Nil(t, data)
+}
+
+func TestFileStore_RejectsKeysOutsideRoot(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "../secret", "invoices/../../secret", "/etc/passwd"} {
+		assert.Error(t, store.Put(context.Background(), key, []byte("x")), key)
+	}
+}
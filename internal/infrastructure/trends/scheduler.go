@@ -0,0 +1,74 @@
+package trends
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/domain/trending"
+)
+
+// DecayScheduler periodically decays trending scores
+// Scores decay by the time passed since the previous decay, so several instances may run it on
+// shared scores; the interval only sets how smoothly scores fall.
+type DecayScheduler struct {
+	trendingService trending.Service
+	interval        time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDecayScheduler creates a scheduler decaying trending scores every interval
+func NewDecayScheduler(trendingService trending.Service, interval time.Duration) *DecayScheduler {
+	return &DecayScheduler{
+		trendingService: trendingService,
+		interval:        interval,
+	}
+}
+
+// Start begins decaying scores in the background
+func (s *DecayScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go s.run(ctx)
+
+	log.Printf("Trending decay scheduler started, decaying every %s", s.interval)
+}
+
+// Stop halts the scheduler and waits for the current decay to finish
+func (s *DecayScheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+	log.Println("Trending decay scheduler stopped")
+}
+
+func (s *DecayScheduler) run(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick decays the scores by the time passed since the previous decay
+func (s *DecayScheduler) tick(ctx context.Context, now time.Time) {
+	if err := s.trendingService.Decay(ctx, now); err != nil {
+		log.Printf("Warning: Failed to decay trending scores: %v", err)
+	}
+}
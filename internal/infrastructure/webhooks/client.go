@@ -0,0 +1,49 @@
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"enterprise-crud/internal/domain/webhook"
+)
+
+// ErrBlockedAddress is returned for endpoints resolving to loopback, private or other internal addresses
+var ErrBlockedAddress = errors.New("webhook endpoint resolves to an internal address")
+
+// NewClient creates the HTTP client deliveries are posted with
+// Buyers choose the endpoints, so the client refuses to connect to internal addresses unless
+// allowPrivate is set. The check runs on the address being dialled, after DNS resolution, so host
+// names resolving or rebinding to internal addresses are caught too. Redirects are not followed:
+// a 3xx answer is returned as is and refuses the delivery, as one could point anywhere.
+func NewClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: 5 * time.Second, KeepAlive: 30 * time.Second}
+	if !allowPrivate {
+		dialer.Control = refuseInternal
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // A proxy would dial the endpoint itself, bypassing the check
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// refuseInternal is a dialer control refusing connections to addresses that aren't public
+func refuseInternal(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, address)
+	}
+	if ip := net.ParseIP(host); ip == nil || !webhook.IsPublicIP(ip) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+	}
+	return nil
+}
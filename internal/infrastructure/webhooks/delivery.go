@@ -0,0 +1,73 @@
+// Package webhooks posts order status deliveries to the endpoints buyers subscribed
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/webhook"
+	"enterprise-crud/internal/infrastructure/jobs"
+)
+
+// userAgent identifies deliveries in the logs of receiving systems
+const userAgent = "enterprise-crud-webhooks/1.0"
+
+// DeliveryHandler returns the job handler that posts one delivery to a subscribed endpoint
+// Each attempt is signed anew, so its timestamp is recent enough for receivers to accept. Network
+// errors, timeouts, 408, 429 and 5xx answers are retried; other 4xx answers, redirects and endpoints
+// resolving to internal addresses dead-letter the job. Deliveries to deleted subscriptions are dropped.
+func DeliveryHandler(service webhook.Service, client *http.Client) jobs.Handler {
+	return func(ctx context.Context, j *job.Job) error {
+		var payload webhook.DeliveryPayload
+		if err := json.Unmarshal(j.Payload, &payload); err != nil {
+			return fmt.Errorf("%w: invalid payload: %v", job.ErrPermanent, err)
+		}
+
+		sub, err := service.GetSubscription(ctx, payload.SubscriptionID)
+		if webhook.IsNotFoundError(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(payload.Body))
+		if err != nil {
+			return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+		}
+		signedAt := time.Now()
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", userAgent)
+		req.Header.Set(webhook.HeaderID, payload.DeliveryID.String())
+		req.Header.Set(webhook.HeaderEvent, payload.Event)
+		req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(signedAt.Unix(), 10))
+		req.Header.Set(webhook.HeaderSignature, webhook.Sign(sub.Secret, signedAt, payload.Body))
+
+		resp, err := client.Do(req)
+		if errors.Is(err, ErrBlockedAddress) {
+			return fmt.Errorf("%w: %v", job.ErrPermanent, err)
+		}
+		if err != nil {
+			return fmt.Errorf("webhook request failed: %w", err)
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+		switch {
+		case resp.StatusCode >= 200 && resp.StatusCode < 300:
+			return nil
+		case resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+			return fmt.Errorf("webhook endpoint unavailable: %s", resp.Status)
+		default:
+			return fmt.Errorf("%w: webhook endpoint refused delivery: %s", job.ErrPermanent, resp.Status)
+		}
+	}
+}
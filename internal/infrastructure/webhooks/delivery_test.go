@@ -0,0 +1,137 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/job"
+	"enterprise-crud/internal/domain/webhook"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubService serves one subscription
+type stubService struct {
+	webhook.Service
+	sub *webhook.Subscription
+}
+
+func (s *stubService) GetSubscription(ctx context.Context, id uuid.UUID) (*webhook.Subscription, error) {
+	if s.sub == nil || s.sub.ID != id {
+		return nil, webhook.ErrSubscriptionNotFound
+	}
+	return s.sub, nil
+}
+
+func TestDeliveryHandler(t *testing.T) {
+	body := []byte(`{"id":"7d3c5f8e-1b2a-4c6d-9e0f-a1b2c3d4e5f6","type":"order.completed"}`)
+	var status atomic.Int32
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		got, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, body, got)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, webhook.EventOrderCompleted, r.Header.Get(webhook.HeaderEvent))
+		assert.Equal(t, "7d3c5f8e-1b2a-4c6d-9e0f-a1b2c3d4e5f6", r.Header.Get(webhook.HeaderID))
+		assert.True(t, webhook.Verify("whsec_test", r.Header.Get(webhook.HeaderSignature), r.Header.Get(webhook.HeaderTimestamp), got, time.Now()),
+			"signature verifies with the subscription secret")
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer server.Close()
+
+	sub := &webhook.Subscription{ID: uuid.New(), URL: server.URL + "/hooks", Secret: "whsec_test"}
+	handler := DeliveryHandler(&stubService{sub: sub}, server.Client())
+	deliver := func(subscriptionID uuid.UUID) error {
+		data, err := json.Marshal(webhook.DeliveryPayload{
+			SubscriptionID: subscriptionID,
+			DeliveryID:     uuid.MustParse("7d3c5f8e-1b2a-4c6d-9e0f-a1b2c3d4e5f6"),
+			Event:          webhook.EventOrderCompleted,
+			Body:           body,
+		})
+		require.NoError(t, err)
+		return handler(context.Background(), &job.Job{ID: uuid.New(), Type: webhook.JobTypeDeliver, Payload: data})
+	}
+
+	t.Run("posts the signed delivery", func(t *testing.T) {
+		status.Store(http.StatusNoContent)
+		assert.NoError(t, deliver(sub.ID))
+	})
+
+	t.Run("retries endpoints that are down", func(t *testing.T) {
+		for _, code := range []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusRequestTimeout} {
+			status.Store(int32(code))
+			err := deliver(sub.ID)
+			assert.Error(t, err)
+			assert.NotErrorIs(t, err, job.ErrPermanent)
+		}
+	})
+
+	t.Run("gives up on endpoints refusing the delivery", func(t *testing.T) {
+		status.Store(http.StatusNotFound)
+		assert.ErrorIs(t, deliver(sub.ID), job.ErrPermanent)
+	})
+
+	t.Run("drops deliveries to deleted subscriptions", func(t *testing.T) {
+		before := received.Load()
+		assert.NoError(t, deliver(uuid.New()))
+		assert.Equal(t, before, received.Load())
+	})
+}
+
+func TestDeliveryHandler_InvalidPayload(t *testing.T) {
+	err := DeliveryHandler(&stubService{}, http.DefaultClient)(context.Background(), &job.Job{ID: uuid.New(), Payload: []byte("{")})
+
+	assert.ErrorIs(t, err, job.ErrPermanent)
+}
+
+func TestDeliveryHandler_BlocksInternalEndpoints(t *testing.T) {
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+	}))
+	defer server.Close()
+	sub := &webhook.Subscription{ID: uuid.New(), URL: server.URL + "/hooks", Secret: "whsec_test"}
+	data, err := json.Marshal(webhook.DeliveryPayload{SubscriptionID: sub.ID, DeliveryID: uuid.New(), Event: webhook.EventOrderCompleted})
+	require.NoError(t, err)
+	deliver := func(client *http.Client) error {
+		return DeliveryHandler(&stubService{sub: sub}, client)(context.Background(), &job.Job{ID: uuid.New(), Payload: data})
+	}
+
+	err = deliver(NewClient(time.Second, false))
+	assert.ErrorIs(t, err, job.ErrPermanent)
+	assert.ErrorContains(t, err, ErrBlockedAddress.Error())
+	assert.Zero(t, received.Load(), "the loopback endpoint is never contacted")
+
+	assert.NoError(t, deliver(NewClient(time.Second, true)), "private networks may be allowed for local development")
+	assert.Equal(t, int32(1), received.Load())
+}
+
+func TestDeliveryHandler_RefusesRedirects(t *testing.T) {
+	var redirected atomic.Int32
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected.Add(1)
+	}))
+	defer internal.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL+"/admin", http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+	sub := &webhook.Subscription{ID: uuid.New(), URL: server.URL + "/hooks", Secret: "whsec_test"}
+	data, err := json.Marshal(webhook.DeliveryPayload{SubscriptionID: sub.ID, DeliveryID: uuid.New(), Event: webhook.EventOrderCompleted})
+	require.NoError(t, err)
+
+	err = DeliveryHandler(&stubService{sub: sub}, NewClient(time.Second, true))(context.Background(), &job.Job{ID: uuid.New(), Payload: data})
+
+	assert.ErrorIs(t, err, job.ErrPermanent)
+	assert.Zero(t, redirected.Load(), "the redirect is not followed")
+}
@@ -0,0 +1,122 @@
+// Package lifecycle tracks the goroutines an application starts so shutdown can wait for them.
+// Work started through a Registry gets contexts derived from one root context; shutdown first
+// asks it to finish, and only cancels that root context once the shutdown deadline has passed,
+// so a cache write or job in progress isn't cut off while there is still time to complete it.
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Registry tracks background goroutines from start to exit
+type Registry struct {
+	root   context.Context
+	cancel context.CancelFunc
+
+	stopping chan struct{}
+	stopOnce sync.Once
+
+	mu      sync.Mutex
+	running map[string]int // Goroutines still running, by name
+	wg      sync.WaitGroup
+}
+
+// New creates a registry whose root context is a child of parent
+func New(parent context.Context) *Registry {
+	root, cancel := context.WithCancel(parent)
+	return &Registry{
+		root:     root,
+		cancel:   cancel,
+		stopping: make(chan struct{}),
+		running:  make(map[string]int),
+	}
+}
+
+// Context returns the root context, cancelled when shutdown runs out of time
+// Derive the contexts of individual writes and requests from it.
+func (r *Registry) Context() context.Context {
+	return r.root
+}
+
+// Stopping is closed when shutdown begins
+// Long-running goroutines return once it is closed, after finishing or draining their current work.
+func (r *Registry) Stopping() <-chan struct{} {
+	return r.stopping
+}
+
+// Go runs fn in a tracked goroutine, passing it the root context
+// It returns false without running fn once shutdown has begun.
+func (r *Registry) Go(name string, fn func(ctx context.Context)) bool {
+	r.mu.Lock()
+	select {
+	case <-r.stopping:
+		r.mu.Unlock()
+		return false
+	default:
+	}
+	r.running[name]++
+	r.wg.Add(1)
+	r.mu.Unlock()
+
+	go func() {
+		defer r.done(name)
+		fn(r.root)
+	}()
+	return true
+}
+
+// Shutdown closes Stopping and waits for every tracked goroutine to return
+// When ctx ends first the root context is cancelled and an error naming the goroutines
+// still running is returned; they are not waited for any further.
+func (r *Registry) Shutdown(ctx context.Context) error {
+	r.mu.Lock()
+	r.stopOnce.Do(func() { close(r.stopping) })
+	r.mu.Unlock()
+
+	finished := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		r.cancel()
+		return nil
+	case <-ctx.Done():
+		r.cancel()
+		if len(r.Running()) == 0 {
+			return nil
+		}
+		return fmt.Errorf("background goroutines still running at shutdown deadline: %s", strings.Join(r.Running(), ", "))
+	}
+}
+
+// Running lists the goroutines still running by name, with a count when several share one
+func (r *Registry) Running() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.running))
+	for name, count := range r.running {
+		if count > 1 {
+			name = fmt.Sprintf("%s (%d)", name, count)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *Registry) done(name string) {
+	r.mu.Lock()
+	if r.running[name]--; r.running[name] == 0 {
+		delete(r.running, name)
+	}
+	r.mu.Unlock()
+	r.wg.Done()
+}
@@ -0,0 +1,65 @@
+package lifecycle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_ShutdownWaitsForGoroutines(t *testing.T) {
+	r := New(context.Background())
+
+	finished := make(chan struct{})
+	require.True(t, r.Go("writer", func(ctx context.Context) {
+		<-r.Stopping()
+		// Work after the stop signal still completes with a live context
+		time.Sleep(10 * time.Millisecond)
+		assert.NoError(t, ctx.Err())
+		close(finished)
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, r.Shutdown(ctx))
+
+	select {
+	case <-finished:
+	default:
+		t.Fatal("shutdown returned before the goroutine finished")
+	}
+	assert.Empty(t, r.Running())
+	assert.Error(t, r.Context().Err(), "root context is released after shutdown")
+}
+
+func TestRegistry_ShutdownDeadlineCancelsRootContext(t *testing.T) {
+	r := New(context.Background())
+
+	cancelled := make(chan struct{})
+	for i := 0; i < 2; i++ {
+		r.Go("stuck", func(ctx context.Context) {
+			<-ctx.Done()
+			cancelled <- struct{}{}
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := r.Shutdown(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "stuck (2)")
+
+	<-cancelled
+	<-cancelled
+}
+
+func TestRegistry_RejectsWorkAfterShutdown(t *testing.T) {
+	r := New(context.Background())
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	assert.False(t, r.Go("late", func(ctx context.Context) {
+		t.Error("should not run")
+	}))
+}
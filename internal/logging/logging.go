@@ -0,0 +1,31 @@
+// Package logging carries the request ID of an HTTP request through contexts
+// so services and repositories can tag their logs with it, and support can
+// find every line a request produced from the ID its client was given.
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+// requestIDKey is the context key of the request ID
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID carried by ctx, or "" outside a request
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// From returns the default structured logger, tagged with the request ID when ctx carries one
+func From(ctx context.Context) *slog.Logger {
+	if id := RequestID(ctx); id != "" {
+		return slog.Default().With(slog.String("request_id", id))
+	}
+	return slog.Default()
+}
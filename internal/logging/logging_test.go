@@ -0,0 +1,28 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	assert.Equal(t, "", RequestID(context.Background()))
+	assert.Equal(t, "req-1", RequestID(WithRequestID(context.Background(), "req-1")))
+}
+
+func TestFrom_TagsRequestID(t *testing.T) {
+	var out bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&out, nil)))
+	defer slog.SetDefault(previous)
+
+	From(WithRequestID(context.Background(), "req-1")).Info("tagged")
+	From(context.Background()).Info("untagged")
+
+	assert.Contains(t, out.String(), "msg=tagged request_id=req-1\n")
+	assert.Contains(t, out.String(), "msg=untagged\n")
+}
@@ -0,0 +1,297 @@
+package http
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/accesscode"
+	"enterprise-crud/internal/domain/event"
+	accessCodeDto "enterprise-crud/internal/dto/accesscode"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AccessCodeHandler handles HTTP requests for event access codes
+type AccessCodeHandler struct {
+	accessCodeService accesscode.Service
+	jwtService        *auth.JWTService
+}
+
+// NewAccessCodeHandler creates a new instance of AccessCodeHandler
+func NewAccessCodeHandler(accessCodeService accesscode.Service, jwtService *auth.JWTService) *AccessCodeHandler {
+	return &AccessCodeHandler{
+		accessCodeService: accessCodeService,
+		jwtService:        jwtService,
+	}
+}
+
+// CreateAccessCode adds an access code to an event
+// @Summary Create an access code
+// @Description Add a single-use or multi-use access code to an event; orders for events requiring a code must redeem one (organizer, co-organizer or admin)
+// @Tags access-codes
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param access_code body accessCodeDto.CreateAccessCodeRequest true "Access code"
+// @Success 201 {object} accessCodeDto.AccessCodeResponse
+// @Failure 400 {object} accessCodeDto.ErrorResponse
+// @Failure 401 {object} accessCodeDto.ErrorResponse
+// @Failure 403 {object} accessCodeDto.ErrorResponse
+// @Failure 404 {object} accessCodeDto.ErrorResponse
+// @Failure 409 {object} accessCodeDto.ErrorResponse
+// @Failure 500 {object} accessCodeDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/access-codes [post]
+func (h *AccessCodeHandler) CreateAccessCode(c *gin.Context) {
+	eventID, ok := parseAccessCodeID(c, "id", "event")
+	if !ok {
+		return
+	}
+
+	var req accessCodeDto.CreateAccessCodeRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, accessCodeDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	actor, ok := accessCodeActor(c)
+	if !ok {
+		return
+	}
+
+	code, err := h.accessCodeService.Create(c.Request.Context(), actor, eventID, req.Code, req.MaxUses)
+	if err != nil {
+		writeAccessCodeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapAccessCodeToResponse(code))
+}
+
+// ListAccessCodes lists an event's access codes
+// @Summary List access codes
+// @Description List the access codes of an event with their usage (organizer, co-organizer or admin)
+// @Tags access-codes
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} accessCodeDto.AccessCodeListResponse
+// @Failure 400 {object} accessCodeDto.ErrorResponse
+// @Failure 401 {object} accessCodeDto.ErrorResponse
+// @Failure 403 {object} accessCodeDto.ErrorResponse
+// @Failure 404 {object} accessCodeDto.ErrorResponse
+// @Failure 500 {object} accessCodeDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/access-codes [get]
+func (h *AccessCodeHandler) ListAccessCodes(c *gin.Context) {
+	eventID, ok := parseAccessCodeID(c, "id", "event")
+	if !ok {
+		return
+	}
+
+	actor, ok := accessCodeActor(c)
+	if !ok {
+		return
+	}
+
+	codes, err := h.accessCodeService.List(c.Request.Context(), actor, eventID)
+	if err != nil {
+		writeAccessCodeError(c, err)
+		return
+	}
+
+	response := accessCodeDto.AccessCodeListResponse{
+		AccessCodes: make([]accessCodeDto.AccessCodeResponse, len(codes)),
+		Count:       len(codes),
+	}
+	for i, code := range codes {
+		response.AccessCodes[i] = mapAccessCodeToResponse(code)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteAccessCode removes an access code from an event
+// @Summary Delete an access code
+// @Description Remove an access code; orders already placed with it are kept (organizer, co-organizer or admin)
+// @Tags access-codes
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param codeId path string true "Access code ID"
+// @Success 200 {object} accessCodeDto.SuccessResponse
+// @Failure 400 {object} accessCodeDto.ErrorResponse
+// @Failure 401 {object} accessCodeDto.ErrorResponse
+// @Failure 403 {object} accessCodeDto.ErrorResponse
+// @Failure 404 {object} accessCodeDto.ErrorResponse
+// @Failure 500 {object} accessCodeDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/access-codes/{codeId} [delete]
+func (h *AccessCodeHandler) DeleteAccessCode(c *gin.Context) {
+	eventID, ok := parseAccessCodeID(c, "id", "event")
+	if !ok {
+		return
+	}
+	codeID, ok := parseAccessCodeID(c, "codeId", "access code")
+	if !ok {
+		return
+	}
+
+	actor, ok := accessCodeActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.accessCodeService.Delete(c.Request.Context(), actor, eventID, codeID); err != nil {
+		writeAccessCodeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, accessCodeDto.SuccessResponse{
+		Message: "Access code deleted successfully",
+	})
+}
+
+// ListRedemptions lists the orders placed with an access code
+// @Summary List access code redemptions
+// @Description List the orders placed with an access code, oldest first (organizer, co-organizer or admin)
+// @Tags access-codes
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param codeId path string true "Access code ID"
+// @Success 200 {object} accessCodeDto.RedemptionListResponse
+// @Failure 400 {object} accessCodeDto.ErrorResponse
+// @Failure 401 {object} accessCodeDto.ErrorResponse
+// @Failure 403 {object} accessCodeDto.ErrorResponse
+// @Failure 404 {object} accessCodeDto.ErrorResponse
+// @Failure 500 {object} accessCodeDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/access-codes/{codeId}/redemptions [get]
+func (h *AccessCodeHandler) ListRedemptions(c *gin.Context) {
+	eventID, ok := parseAccessCodeID(c, "id", "event")
+	if !ok {
+		return
+	}
+	codeID, ok := parseAccessCodeID(c, "codeId", "access code")
+	if !ok {
+		return
+	}
+
+	actor, ok := accessCodeActor(c)
+	if !ok {
+		return
+	}
+
+	redemptions, err := h.accessCodeService.ListRedemptions(c.Request.Context(), actor, eventID, codeID)
+	if err != nil {
+		writeAccessCodeError(c, err)
+		return
+	}
+
+	response := accessCodeDto.RedemptionListResponse{
+		Redemptions: make([]accessCodeDto.RedemptionResponse, len(redemptions)),
+		Count:       len(redemptions),
+	}
+	for i, r := range redemptions {
+		response.Redemptions[i] = accessCodeDto.RedemptionResponse{
+			OrderID:   r.OrderID,
+			UserID:    r.UserID,
+			Email:     r.Email,
+			Quantity:  r.Quantity,
+			CreatedAt: r.CreatedAt,
+		}
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// RegisterRoutes registers all access code routes
+// Co-organizers may lack the ORGANIZER role, so access is checked per event instead of by role.
+func (h *AccessCodeHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	codeRoutes := router.Group("/events/:id/access-codes")
+	codeRoutes.Use(jwtMiddleware.AuthRequired())
+	{
+		codeRoutes.POST("", h.CreateAccessCode)
+		codeRoutes.GET("", h.ListAccessCodes)
+		codeRoutes.DELETE("/:codeId", h.DeleteAccessCode)
+		codeRoutes.GET("/:codeId/redemptions", h.ListRedemptions)
+	}
+}
+
+// parseAccessCodeID parses a UUID path parameter, writing a 400 when it is invalid
+func parseAccessCodeID(c *gin.Context, param, kind string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(param))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, accessCodeDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid " + kind + " ID format",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// accessCodeActor builds the acting user from the authenticated principal, writing a 401 when there is none
+func accessCodeActor(c *gin.Context) (accesscode.Actor, bool) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return accesscode.Actor{}, false
+	}
+	return accesscode.Actor{UserID: currentUser.UserID, IsAdmin: currentUser.IsAdmin()}, true
+}
+
+// writeAccessCodeError maps access code and event errors to HTTP responses
+func writeAccessCodeError(c *gin.Context, err error) {
+	switch {
+	case event.IsEventNotFoundError(err):
+		c.JSON(http.StatusNotFound, accessCodeDto.ErrorResponse{
+			Error:   event.GetEventErrorCode(err),
+			Message: err.Error(),
+		})
+	case accesscode.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, accessCodeDto.ErrorResponse{
+			Error:   accesscode.GetAccessCodeErrorCode(err),
+			Message: err.Error(),
+		})
+	case accesscode.IsForbiddenError(err):
+		c.JSON(http.StatusForbidden, accessCodeDto.ErrorResponse{
+			Error:   accesscode.GetAccessCodeErrorCode(err),
+			Message: err.Error(),
+		})
+	case accesscode.IsNotFoundError(err):
+		c.JSON(http.StatusNotFound, accessCodeDto.ErrorResponse{
+			Error:   accesscode.GetAccessCodeErrorCode(err),
+			Message: err.Error(),
+		})
+	case accesscode.IsConflictError(err):
+		c.JSON(http.StatusConflict, accessCodeDto.ErrorResponse{
+			Error:   accesscode.GetAccessCodeErrorCode(err),
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, accessCodeDto.ErrorResponse{
+			Error:   "access_code_error",
+			Message: err.Error(),
+		})
+	}
+}
+
+// mapAccessCodeToResponse converts an access code to response DTO
+func mapAccessCodeToResponse(code *accesscode.AccessCode) accessCodeDto.AccessCodeResponse {
+	response := accessCodeDto.AccessCodeResponse{
+		ID:        code.ID,
+		EventID:   code.EventID,
+		Code:      code.Code,
+		MaxUses:   code.MaxUses,
+		Uses:      code.Uses,
+		CreatedBy: code.CreatedBy,
+		CreatedAt: code.CreatedAt,
+	}
+	if code.MaxUses > 0 {
+		remaining := max(code.MaxUses-code.Uses, 0)
+		response.RemainingUses = &remaining
+	}
+	return response
+}
@@ -0,0 +1,206 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"enterprise-crud/internal/domain/account"
+	accountDto "enterprise-crud/internal/dto/account"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// exportRetryAfter is how long clients are asked to wait while an export is built
+const exportRetryAfter = 10
+
+// AccountHandler handles HTTP requests for personal data exports and account deletion
+type AccountHandler struct {
+	accountService account.Service
+	jwtService     *auth.JWTService
+}
+
+// NewAccountHandler creates a new instance of AccountHandler
+func NewAccountHandler(accountService account.Service, jwtService *auth.JWTService) *AccountHandler {
+	return &AccountHandler{
+		accountService: accountService,
+		jwtService:     jwtService,
+	}
+}
+
+// RequestExport queues an export of the current user's personal data
+// @Summary Export personal data
+// @Description Queue a ZIP archive of everything stored about the current user: profile, orders, tickets, notifications, devices, staff roles and organized events.
+// @Description Poll the returned download URL until it answers 200. Repeated requests within an hour return the pending export.
+// @Tags users
+// @Produce json
+// @Success 202 {object} accountDto.ExportResponse
+// @Failure 401 {object} accountDto.ErrorResponse
+// @Failure 500 {object} accountDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/me/export [post]
+func (h *AccountHandler) RequestExport(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	export, err := h.accountService.RequestExport(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.Header("Retry-After", strconv.Itoa(exportRetryAfter))
+	c.JSON(http.StatusAccepted, mapExportToResponse(export))
+}
+
+// GetExport downloads one of the current user's personal data exports
+// @Summary Download personal data export
+// @Description Download a personal data export as a ZIP archive. Answers 202 with Retry-After while the export is being built.
+// @Tags users
+// @Produce application/zip
+// @Param id path string true "Export ID"
+// @Success 200 {file} file "ZIP archive"
+// @Success 202 {object} accountDto.ExportResponse
+// @Failure 400 {object} accountDto.ErrorResponse
+// @Failure 401 {object} accountDto.ErrorResponse
+// @Failure 404 {object} accountDto.ErrorResponse
+// @Failure 410 {object} accountDto.ErrorResponse
+// @Failure 500 {object} accountDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/me/exports/{id} [get]
+func (h *AccountHandler) GetExport(c *gin.Context) {
+	exportID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, accountDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid export ID format",
+		})
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	export, archive, err := h.accountService.GetExport(c.Request.Context(), currentUser.UserID, exportID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	if archive == nil {
+		c.Header("Retry-After", strconv.Itoa(exportRetryAfter))
+		c.JSON(http.StatusAccepted, mapExportToResponse(export))
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="personal-data-%s.zip"`, export.CreatedAt.Format("2006-01-02")))
+	c.Header("Cache-Control", "private, no-store")
+	c.Data(http.StatusOK, account.ExportContentType, archive)
+}
+
+// RequestDeletion schedules the current user's account for deletion
+// @Summary Delete account
+// @Description Schedule the current user's account for deletion after a grace period, during which it can be cancelled.
+// @Description Deletion erases the profile, notes, answers, notifications, devices and preferences; orders are kept anonymized for accounting.
+// @Tags users
+// @Produce json
+// @Success 202 {object} accountDto.DeletionResponse
+// @Failure 401 {object} accountDto.ErrorResponse
+// @Failure 404 {object} accountDto.ErrorResponse
+// @Failure 500 {object} accountDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/me [delete]
+func (h *AccountHandler) RequestDeletion(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	scheduledFor, err := h.accountService.RequestDeletion(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, accountDto.DeletionResponse{
+		ScheduledFor: scheduledFor,
+		Message:      "Your account will be deleted on the scheduled date unless you cancel the request",
+	})
+}
+
+// CancelDeletion cancels the current user's scheduled account deletion
+// @Summary Cancel account deletion
+// @Description Cancel a scheduled account deletion during its grace period
+// @Tags users
+// @Success 204 "Deletion cancelled"
+// @Failure 401 {object} accountDto.ErrorResponse
+// @Failure 409 {object} accountDto.ErrorResponse
+// @Failure 500 {object} accountDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/me/deletion/cancel [post]
+func (h *AccountHandler) CancelDeletion(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.accountService.CancelDeletion(c.Request.Context(), currentUser.UserID); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RegisterRoutes registers account routes with the gin router
+func (h *AccountHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	// User routes (require any authenticated user)
+	meRoutes := router.Group("/users/me", jwtMiddleware.AuthRequired(), auth.RequireUser())
+	{
+		meRoutes.POST("/export", h.RequestExport)
+		meRoutes.GET("/exports/:id", h.GetExport)
+		meRoutes.DELETE("", h.RequestDeletion)
+		meRoutes.POST("/deletion/cancel", h.CancelDeletion)
+	}
+}
+
+// respondError maps account errors to HTTP responses
+func (h *AccountHandler) respondError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case account.IsExportNotFoundError(err), account.IsUserNotFoundError(err):
+		status = http.StatusNotFound
+	case account.IsExportFailedError(err):
+		status = http.StatusGone
+	case account.IsNoDeletionScheduledError(err):
+		status = http.StatusConflict
+	}
+
+	code := account.GetAccountErrorCode(err)
+	if code == "" {
+		code = "account_error"
+	}
+	c.JSON(status, accountDto.ErrorResponse{
+		Error:   code,
+		Message: err.Error(),
+	})
+}
+
+// mapExportToResponse converts an export to response DTO
+func mapExportToResponse(export *account.Export) accountDto.ExportResponse {
+	return accountDto.ExportResponse{
+		ID:          export.ID,
+		Status:      export.Status,
+		DownloadURL: "/api/v1/users/me/exports/" + export.ID.String(),
+		CompletedAt: export.CompletedAt,
+		CreatedAt:   export.CreatedAt,
+	}
+}
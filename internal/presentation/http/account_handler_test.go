@@ -0,0 +1,197 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/account"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAccountService is a mock implementation of account.Service interface
+type MockAccountService struct {
+	mock.Mock
+}
+
+func (m *MockAccountService) RequestExport(ctx context.Context, userID uuid.UUID) (*account.Export, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Export), args.Error(1)
+}
+
+func (m *MockAccountService) GetExport(ctx context.Context, userID, exportID uuid.UUID) (*account.Export, []byte, error) {
+	args := m.Called(ctx, userID, exportID)
+	export, _ := args.Get(0).(*account.Export)
+	archive, _ := args.Get(1).([]byte)
+	return export, archive, args.Error(2)
+}
+
+func (m *MockAccountService) PrepareExport(ctx context.Context, exportID uuid.UUID) (*account.Export, *account.PersonalData, error) {
+	args := m.Called(ctx, exportID)
+	export, _ := args.Get(0).(*account.Export)
+	data, _ := args.Get(1).(*account.PersonalData)
+	return export, data, args.Error(2)
+}
+
+func (m *MockAccountService) SaveExport(ctx context.Context, export *account.Export, archive []byte) error {
+	args := m.Called(ctx, export, archive)
+	return args.Error(0)
+}
+
+func (m *MockAccountService) FailExport(ctx context.Context, exportID uuid.UUID) error {
+	args := m.Called(ctx, exportID)
+	return args.Error(0)
+}
+
+func (m *MockAccountService) RequestDeletion(ctx context.Context, userID uuid.UUID) (time.Time, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockAccountService) CancelDeletion(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockAccountService) ProcessDueDeletions(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func newTestAccountHandler(service *MockAccountService) *AccountHandler {
+	return NewAccountHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+}
+
+func TestAccountHandler_RequestExport(t *testing.T) {
+	userID := uuid.New()
+	export := &account.Export{ID: uuid.New(), UserID: userID, Status: account.ExportPending}
+	service := new(MockAccountService)
+	service.On("RequestExport", mock.Anything, userID).Return(export, nil)
+
+	c, w := userTestContext(http.MethodPost, "/users/me/export", nil, userID, nil)
+	newTestAccountHandler(service).RequestExport(c)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Contains(t, w.Body.String(), "/api/v1/users/me/exports/"+export.ID.String())
+	assert.Equal(t, "10", w.Header().Get("Retry-After"))
+}
+
+func TestAccountHandler_GetExport(t *testing.T) {
+	userID := uuid.New()
+	exportID := uuid.New()
+	params := gin.Params{{Key: "id", Value: exportID.String()}}
+	createdAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*MockAccountService)
+		expectedStatus int
+		expectedBody   string
+		expectedHeader map[string]string
+	}{
+		{
+			name: "ready export",
+			setupMocks: func(m *MockAccountService) {
+				m.On("GetExport", mock.Anything, userID, exportID).
+					Return(&account.Export{ID: exportID, Status: account.ExportReady, CreatedAt: createdAt}, []byte("PK"), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "PK",
+			expectedHeader: map[string]string{
+				"Content-Type":        account.ExportContentType,
+				"Content-Disposition": `attachment; filename="personal-data-2026-05-01.zip"`,
+			},
+		},
+		{
+			name: "export pending",
+			setupMocks: func(m *MockAccountService) {
+				m.On("GetExport", mock.Anything, userID, exportID).
+					Return(&account.Export{ID: exportID, Status: account.ExportPending}, nil, nil)
+			},
+			expectedStatus: http.StatusAccepted,
+			expectedBody:   "PENDING",
+			expectedHeader: map[string]string{"Retry-After": "10"},
+		},
+		{
+			name: "export not found",
+			setupMocks: func(m *MockAccountService) {
+				m.On("GetExport", mock.Anything, userID, exportID).Return(nil, nil, account.ErrExportNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "EXPORT_NOT_FOUND",
+		},
+		{
+			name: "export failed",
+			setupMocks: func(m *MockAccountService) {
+				m.On("GetExport", mock.Anything, userID, exportID).Return(nil, nil, account.ErrExportFailed)
+			},
+			expectedStatus: http.StatusGone,
+			expectedBody:   "EXPORT_FAILED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockAccountService)
+			tt.setupMocks(service)
+
+			c, w := userTestContext(http.MethodGet, "/users/me/exports/"+exportID.String(), nil, userID, params)
+			newTestAccountHandler(service).GetExport(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			for header, value := range tt.expectedHeader {
+				assert.Equal(t, value, w.Header().Get(header))
+			}
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAccountHandler_RequestDeletion(t *testing.T) {
+	userID := uuid.New()
+	scheduledFor := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	service := new(MockAccountService)
+	service.On("RequestDeletion", mock.Anything, userID).Return(scheduledFor, nil)
+
+	c, w := userTestContext(http.MethodDelete, "/users/me", nil, userID, nil)
+	newTestAccountHandler(service).RequestDeletion(c)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Contains(t, w.Body.String(), `"scheduled_for":"2026-06-01T12:00:00Z"`)
+}
+
+func TestAccountHandler_CancelDeletion(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{name: "cancelled", expectedStatus: http.StatusNoContent},
+		{name: "nothing scheduled", err: account.ErrNoDeletionScheduled, expectedStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockAccountService)
+			service.On("CancelDeletion", mock.Anything, userID).Return(tt.err)
+
+			c, w := userTestContext(http.MethodPost, "/users/me/deletion/cancel", nil, userID, nil)
+			newTestAccountHandler(service).CancelDeletion(c)
+			c.Writer.WriteHeaderNow()
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
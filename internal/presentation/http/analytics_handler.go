@@ -0,0 +1,172 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"enterprise-crud/internal/domain/analytics"
+	"enterprise-crud/internal/domain/clock"
+	analyticsDto "enterprise-crud/internal/dto/analytics"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultTrendDays is the period covered by trend queries without a from parameter
+const defaultTrendDays = 30
+
+// AnalyticsHandler handles HTTP requests for trends read from the daily analytics snapshots
+type AnalyticsHandler struct {
+	analyticsService analytics.Service
+	jwtService       *auth.JWTService
+	clock            clock.Clock // Tells the default trend period
+}
+
+// NewAnalyticsHandler creates a new instance of AnalyticsHandler
+func NewAnalyticsHandler(analyticsService analytics.Service, jwtService *auth.JWTService, opts ...HandlerOption) *AnalyticsHandler {
+	options := applyHandlerOptions(opts)
+	return &AnalyticsHandler{
+		analyticsService: analyticsService,
+		jwtService:       jwtService,
+		clock:            options.clock,
+	}
+}
+
+// GetSalesTrend retrieves the current organizer's daily sales
+// @Summary Get sales trend
+// @Description Get the current organizer's completed orders and event page views per UTC day, read from the daily snapshots. Today is only included once its snapshot is taken.
+// @Tags analytics
+// @Produce json
+// @Param from query string false "Start of the period (RFC 3339 or YYYY-MM-DD, inclusive), defaults to 30 days ago"
+// @Param to query string false "End of the period (RFC 3339 or YYYY-MM-DD, exclusive), defaults to now"
+// @Success 200 {object} analyticsDto.SalesTrendResponse
+// @Failure 400 {object} analyticsDto.ErrorResponse
+// @Failure 401 {object} analyticsDto.ErrorResponse
+// @Failure 403 {object} analyticsDto.ErrorResponse
+// @Failure 500 {object} analyticsDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/analytics/sales [get]
+func (h *AnalyticsHandler) GetSalesTrend(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	from, to, ok := h.trendPeriod(c)
+	if !ok {
+		return
+	}
+
+	days, err := h.analyticsService.GetOrganizerSales(c.Request.Context(), currentUser.UserID, from, to)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := analyticsDto.SalesTrendResponse{Days: make([]analyticsDto.SalesDayResponse, len(days))}
+	for i, d := range days {
+		response.Days[i] = analyticsDto.SalesDayResponse{
+			Day:            d.Day.Format(time.DateOnly),
+			Orders:         d.Orders,
+			TicketsSold:    d.TicketsSold,
+			Revenue:        d.Revenue,
+			Views:          d.Views,
+			UniqueVisitors: d.UniqueVisitors,
+		}
+		response.TotalOrders += d.Orders
+		response.TotalTickets += d.TicketsSold
+		response.TotalRevenue += d.Revenue
+		response.TotalViews += d.Views
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetDailySnapshots retrieves the platform-wide daily snapshots
+// @Summary Get platform trends
+// @Description Get tickets sold, revenue, active events and new users per UTC day (requires ADMIN role)
+// @Tags admin
+// @Produce json
+// @Param from query string false "Start of the period (RFC 3339 or YYYY-MM-DD, inclusive), defaults to 30 days ago"
+// @Param to query string false "End of the period (RFC 3339 or YYYY-MM-DD, exclusive), defaults to now"
+// @Success 200 {object} analyticsDto.DailySnapshotsResponse
+// @Failure 400 {object} analyticsDto.ErrorResponse
+// @Failure 401 {object} analyticsDto.ErrorResponse
+// @Failure 403 {object} analyticsDto.ErrorResponse
+// @Failure 500 {object} analyticsDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/analytics/daily [get]
+func (h *AnalyticsHandler) GetDailySnapshots(c *gin.Context) {
+	from, to, ok := h.trendPeriod(c)
+	if !ok {
+		return
+	}
+
+	snapshots, err := h.analyticsService.GetDailySnapshots(c.Request.Context(), from, to)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := analyticsDto.DailySnapshotsResponse{Days: make([]analyticsDto.DailySnapshotResponse, len(snapshots))}
+	for i, s := range snapshots {
+		response.Days[i] = analyticsDto.DailySnapshotResponse{
+			Day:          s.Day.Format(time.DateOnly),
+			Orders:       s.Orders,
+			TicketsSold:  s.TicketsSold,
+			Revenue:      s.Revenue,
+			ActiveEvents: s.ActiveEvents,
+			NewUsers:     s.NewUsers,
+			TakenAt:      s.TakenAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RegisterRoutes registers analytics routes with the gin router
+func (h *AnalyticsHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	// Organizer routes (require ORGANIZER or ADMIN role)
+	analyticsRoutes := router.Group("/analytics", jwtMiddleware.AuthRequired(), auth.RequireOrganizer())
+	{
+		analyticsRoutes.GET("/sales", h.GetSalesTrend)
+	}
+
+	// Admin routes (require ADMIN role)
+	adminRoutes := router.Group("/admin/analytics", jwtMiddleware.AuthRequired(), auth.RequireAdmin())
+	{
+		adminRoutes.GET("/daily", h.GetDailySnapshots)
+	}
+}
+
+// trendPeriod reads the from and to query parameters, writing a 400 when either is malformed
+func (h *AnalyticsHandler) trendPeriod(c *gin.Context) (time.Time, time.Time, bool) {
+	now := h.clock.Now().UTC()
+	from, fromErr := parseReportTime(c.Query("from"), now.AddDate(0, 0, -defaultTrendDays))
+	to, toErr := parseReportTime(c.Query("to"), now)
+	if fromErr != nil || toErr != nil {
+		c.JSON(http.StatusBadRequest, analyticsDto.ErrorResponse{
+			Error:   "invalid_period",
+			Message: "from and to must be RFC 3339 timestamps or YYYY-MM-DD dates",
+		})
+		return time.Time{}, time.Time{}, false
+	}
+	return from, to, true
+}
+
+// respondError maps analytics errors to HTTP responses
+func (h *AnalyticsHandler) respondError(c *gin.Context, err error) {
+	if analytics.IsValidationError(err) {
+		c.JSON(http.StatusBadRequest, analyticsDto.ErrorResponse{
+			Error:   analytics.GetAnalyticsErrorCode(err),
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, analyticsDto.ErrorResponse{
+		Error:   "analytics_error",
+		Message: "Failed to retrieve analytics: " + err.Error(),
+	})
+}
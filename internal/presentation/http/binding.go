@@ -0,0 +1,241 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"enterprise-crud/internal/domain/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// maxJSONBodyBytes bounds the size of JSON request bodies
+const maxJSONBodyBytes = 1 << 20 // 1 MiB
+
+func init() {
+	// Report validation errors using JSON field names rather than Go struct field names
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(jsonFieldName)
+		for tag, rule := range valueRules {
+			check := rule
+			_ = v.RegisterValidation(tag, func(fl validator.FieldLevel) bool { return check(fl.Field()) == nil })
+		}
+	}
+}
+
+// valueRules back the money, quantity and capacity binding tags with the domain's value objects,
+// so requests are held to the same bounds as the services
+var valueRules = map[string]func(field reflect.Value) error{
+	"money": func(field reflect.Value) error {
+		_, err := values.NewMoney(field.Float())
+		return err
+	},
+	"quantity": func(field reflect.Value) error {
+		_, err := values.NewQuantity(int(field.Int()))
+		return err
+	},
+	"capacity": func(field reflect.Value) error {
+		_, err := values.NewCapacity(int(field.Int()))
+		return err
+	},
+}
+
+// BindError describes why a request body could not be bound
+// Messages are safe to return to clients: they never include Go type names.
+type BindError struct {
+	Status  int               // HTTP status to respond with (400, 413 or 415)
+	Message string            // Summary of the problem
+	Fields  map[string]string // Per-field problems keyed by JSON field name, if any
+}
+
+func (e *BindError) Error() string {
+	if len(e.Fields) == 0 {
+		return e.Message
+	}
+
+	names := make([]string, 0, len(e.Fields))
+	for name := range e.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	problems := make([]string, 0, len(names))
+	for _, name := range names {
+		problems = append(problems, e.Fields[name])
+	}
+	return strings.Join(problems, "; ")
+}
+
+// bindOptions configures BindJSON
+type bindOptions struct {
+	disallowUnknownFields bool
+}
+
+// BindOption customises BindJSON for a single endpoint
+type BindOption func(*bindOptions)
+
+// DisallowUnknownFields rejects bodies containing fields the request type doesn't declare
+func DisallowUnknownFields() BindOption {
+	return func(o *bindOptions) {
+		o.disallowUnknownFields = true
+	}
+}
+
+// BindJSON decodes and validates a JSON request body into dst
+// It replaces ShouldBindJSON: the Content-Type must be application/json, bodies are capped
+// at maxJSONBodyBytes, and decode and validation errors become field-level messages.
+func BindJSON(c *gin.Context, dst interface{}, opts ...BindOption) *BindError {
+	var options bindOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		return &BindError{Status: http.StatusUnsupportedMediaType, Message: "Content-Type must be application/json"}
+	}
+
+	decoder := json.NewDecoder(http.MaxBytesReader(c.Writer, c.Request.Body, maxJSONBodyBytes))
+	if options.disallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+
+	if err := decoder.Decode(dst); err != nil {
+		return decodeError(err)
+	}
+	if decoder.More() {
+		return &BindError{Status: http.StatusBadRequest, Message: "request body must contain a single JSON object"}
+	}
+
+	if err := binding.Validator.ValidateStruct(dst); err != nil {
+		return validationError(err)
+	}
+	return nil
+}
+
+// Code returns the error code reported to clients
+func (e *BindError) Code() string {
+	switch e.Status {
+	case http.StatusRequestEntityTooLarge:
+		return "payload_too_large"
+	case http.StatusUnsupportedMediaType:
+		return "unsupported_media_type"
+	default:
+		return "validation_error"
+	}
+}
+
+func decodeError(err error) *BindError {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	var maxBytesErr *http.MaxBytesError
+
+	switch {
+	case errors.As(err, &maxBytesErr):
+		return &BindError{
+			Status:  http.StatusRequestEntityTooLarge,
+			Message: fmt.Sprintf("request body must not exceed %d bytes", maxBytesErr.Limit),
+		}
+	case errors.Is(err, io.EOF):
+		return &BindError{Status: http.StatusBadRequest, Message: "request body must not be empty"}
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return &BindError{Status: http.StatusBadRequest, Message: "request body contains malformed JSON"}
+	case errors.As(err, &syntaxErr):
+		return &BindError{
+			Status:  http.StatusBadRequest,
+			Message: fmt.Sprintf("request body contains malformed JSON at position %d", syntaxErr.Offset),
+		}
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		return &BindError{
+			Status:  http.StatusBadRequest,
+			Message: "request body contains invalid values",
+			Fields:  map[string]string{typeErr.Field: fmt.Sprintf("%s must be %s", typeErr.Field, describeJSONType(typeErr.Type))},
+		}
+	case errors.As(err, &typeErr):
+		return &BindError{Status: http.StatusBadRequest, Message: "request body must be a JSON object"}
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		return &BindError{
+			Status:  http.StatusBadRequest,
+			Message: "request body contains unknown fields",
+			Fields:  map[string]string{field: fmt.Sprintf("%s is not a recognised field", field)},
+		}
+	default:
+		// Remaining errors come from custom UnmarshalJSON methods, e.g. an invalid UUID
+		return &BindError{Status: http.StatusBadRequest, Message: "request body contains invalid values"}
+	}
+}
+
+func validationError(err error) *BindError {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return &BindError{Status: http.StatusBadRequest, Message: "request body is invalid"}
+	}
+
+	fields := make(map[string]string, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		fields[fieldErr.Field()] = describeValidation(fieldErr)
+	}
+	return &BindError{Status: http.StatusBadRequest, Message: "request body failed validation", Fields: fields}
+}
+
+func describeValidation(fieldErr validator.FieldError) string {
+	field := fieldErr.Field()
+	switch fieldErr.Tag() {
+	case "required":
+		return field + " is required"
+	case "email":
+		return field + " must be a valid email address"
+	case "min":
+		if fieldErr.Kind() == reflect.String {
+			return fmt.Sprintf("%s must be at least %s characters long", field, fieldErr.Param())
+		}
+		return fmt.Sprintf("%s must be at least %s", field, fieldErr.Param())
+	case "max":
+		if fieldErr.Kind() == reflect.String {
+			return fmt.Sprintf("%s must be at most %s characters long", field, fieldErr.Param())
+		}
+		return fmt.Sprintf("%s must be at most %s", field, fieldErr.Param())
+	default:
+		var valueErr *values.ValueError
+		if rule, ok := valueRules[fieldErr.Tag()]; ok && errors.As(rule(reflect.ValueOf(fieldErr.Value())), &valueErr) {
+			return field + " " + valueErr.Rule
+		}
+		return field + " is invalid"
+	}
+}
+
+func describeJSONType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
@@ -0,0 +1,157 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type bindTestRequest struct {
+	Name     string `json:"name" binding:"required,min=3"`
+	Quantity int    `json:"quantity" binding:"required,min=1"`
+}
+
+type bindValueRequest struct {
+	Price    float64 `json:"price" binding:"money"`
+	Quantity int     `json:"quantity" binding:"required,quantity"`
+}
+
+func bindTestContext(body, contentType string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	if contentType != "" {
+		c.Request.Header.Set("Content-Type", contentType)
+	}
+	return c
+}
+
+func TestBindJSON(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		contentType    string
+		opts           []BindOption
+		expectedStatus int
+		expectedField  string
+		expectedMsg    string
+	}{
+		{
+			name:        "valid body",
+			body:        `{"name":"Concert","quantity":2}`,
+			contentType: "application/json; charset=utf-8",
+		},
+		{
+			name:           "wrong content type",
+			body:           `{"name":"Concert","quantity":2}`,
+			contentType:    "text/plain",
+			expectedStatus: http.StatusUnsupportedMediaType,
+			expectedMsg:    "Content-Type must be application/json",
+		},
+		{
+			name:           "malformed JSON",
+			body:           `{"name":`,
+			contentType:    "application/json",
+			expectedStatus: http.StatusBadRequest,
+			expectedMsg:    "request body contains malformed JSON",
+		},
+		{
+			name:           "empty body",
+			contentType:    "application/json",
+			expectedStatus: http.StatusBadRequest,
+			expectedMsg:    "request body must not be empty",
+		},
+		{
+			name:           "wrong type",
+			body:           `{"name":"Concert","quantity":"two"}`,
+			contentType:    "application/json",
+			expectedStatus: http.StatusBadRequest,
+			expectedField:  "quantity",
+			expectedMsg:    "quantity must be an integer",
+		},
+		{
+			name:           "validation failure uses JSON field names",
+			body:           `{"name":"ab","quantity":2}`,
+			contentType:    "application/json",
+			expectedStatus: http.StatusBadRequest,
+			expectedField:  "name",
+			expectedMsg:    "name must be at least 3 characters long",
+		},
+		{
+			name:        "unknown field allowed by default",
+			body:        `{"name":"Concert","quantity":2,"extra":true}`,
+			contentType: "application/json",
+		},
+		{
+			name:           "unknown field rejected when disallowed",
+			body:           `{"name":"Concert","quantity":2,"extra":true}`,
+			contentType:    "application/json",
+			opts:           []BindOption{DisallowUnknownFields()},
+			expectedStatus: http.StatusBadRequest,
+			expectedField:  "extra",
+			expectedMsg:    "extra is not a recognised field",
+		},
+		{
+			name:           "oversized body",
+			body:           `{"name":"` + strings.Repeat("a", maxJSONBodyBytes) + `","quantity":2}`,
+			contentType:    "application/json",
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedMsg:    "request body must not exceed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req bindTestRequest
+			err := BindJSON(bindTestContext(tt.body, tt.contentType), &req, tt.opts...)
+
+			if tt.expectedStatus == 0 {
+				assert.Nil(t, err)
+				return
+			}
+
+			require.NotNil(t, err)
+			assert.Equal(t, tt.expectedStatus, err.Status)
+			assert.Contains(t, err.Error(), tt.expectedMsg)
+			assert.NotContains(t, err.Error(), "bindTestRequest", "Go type names must not leak")
+			if tt.expectedField != "" {
+				assert.Contains(t, err.Fields, tt.expectedField)
+			}
+		})
+	}
+}
+
+func TestBindJSON_ValueRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expectedField string
+		expectedMsg   string
+	}{
+		{name: "within bounds", body: `{"price":19.99,"quantity":100}`},
+		{name: "free", body: `{"price":0,"quantity":1}`},
+		{name: "negative price", body: `{"price":-1,"quantity":1}`, expectedField: "price", expectedMsg: "price must be between 0 and 99999999.99"},
+		{name: "price too large", body: `{"price":100000000,"quantity":1}`, expectedField: "price", expectedMsg: "price must be between 0 and 99999999.99"},
+		{name: "too many tickets", body: `{"price":5,"quantity":101}`, expectedField: "quantity", expectedMsg: "quantity must be between 1 and 100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req bindValueRequest
+			err := BindJSON(bindTestContext(tt.body, "application/json"), &req)
+
+			if tt.expectedField == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Equal(t, http.StatusBadRequest, err.Status)
+			assert.Equal(t, tt.expectedMsg, err.Fields[tt.expectedField])
+		})
+	}
+}
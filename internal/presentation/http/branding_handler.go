@@ -0,0 +1,258 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"enterprise-crud/internal/domain/branding"
+	brandingDto "enterprise-crud/internal/dto/branding"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// logoMediaTypes are the Content-Types logos can be uploaded with
+var logoMediaTypes = map[string]bool{"image/png": true, "image/jpeg": true, "image/gif": true}
+
+// BrandingHandler handles HTTP requests for organizer branding
+type BrandingHandler struct {
+	brandingService branding.Service
+	jwtService      *auth.JWTService
+}
+
+// NewBrandingHandler creates a new instance of BrandingHandler
+func NewBrandingHandler(brandingService branding.Service, jwtService *auth.JWTService) *BrandingHandler {
+	return &BrandingHandler{
+		brandingService: brandingService,
+		jwtService:      jwtService,
+	}
+}
+
+// GetMyBranding returns the current organizer's branding
+// @Summary Get my branding
+// @Description Get the brand colors and logo shown on the current organizer's event pages and invoices (requires ORGANIZER or ADMIN role).
+// @Tags branding
+// @Produce json
+// @Success 200 {object} brandingDto.BrandingResponse
+// @Failure 401 {object} brandingDto.ErrorResponse
+// @Failure 403 {object} brandingDto.ErrorResponse
+// @Failure 500 {object} brandingDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/organizers/me/branding [get]
+func (h *BrandingHandler) GetMyBranding(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	b, err := h.brandingService.GetBranding(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, h.mapBrandingToResponse(b))
+}
+
+// SetColors sets the current organizer's brand colors
+// @Summary Set brand colors
+// @Description Replace the current organizer's brand colors (requires ORGANIZER or ADMIN role). Colors are #rrggbb or #rgb; an empty color restores the default. Invoices already issued keep the colors they were issued with.
+// @Tags branding
+// @Accept json
+// @Produce json
+// @Param colors body brandingDto.SetColorsRequest true "Brand colors"
+// @Success 200 {object} brandingDto.BrandingResponse
+// @Failure 400 {object} brandingDto.ErrorResponse
+// @Failure 401 {object} brandingDto.ErrorResponse
+// @Failure 403 {object} brandingDto.ErrorResponse
+// @Failure 500 {object} brandingDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/organizers/me/branding [put]
+func (h *BrandingHandler) SetColors(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req brandingDto.SetColorsRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, brandingDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	colors := branding.Colors{Primary: req.PrimaryColor, Accent: req.AccentColor}
+	b, err := h.brandingService.SetColors(c.Request.Context(), currentUser.UserID, colors)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, h.mapBrandingToResponse(b))
+}
+
+// UploadLogo replaces the current organizer's logo
+// @Summary Upload logo
+// @Description Replace the current organizer's logo with the PNG, JPEG or GIF image in the request body (requires ORGANIZER or ADMIN role). Logos are at most 512 KiB and between 32 and 1024 pixels wide and high.
+// @Tags branding
+// @Accept png
+// @Accept jpeg
+// @Accept gif
+// @Produce json
+// @Param logo body string true "Logo image"
+// @Success 200 {object} brandingDto.BrandingResponse
+// @Failure 400 {object} brandingDto.ErrorResponse
+// @Failure 401 {object} brandingDto.ErrorResponse
+// @Failure 403 {object} brandingDto.ErrorResponse
+// @Failure 413 {object} brandingDto.ErrorResponse
+// @Failure 415 {object} brandingDto.ErrorResponse
+// @Failure 500 {object} brandingDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/organizers/me/branding/logo [put]
+func (h *BrandingHandler) UploadLogo(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	if err != nil || !logoMediaTypes[mediaType] {
+		c.JSON(http.StatusUnsupportedMediaType, brandingDto.ErrorResponse{
+			Error:   "unsupported_media_type",
+			Message: "Content-Type must be image/png, image/jpeg or image/gif",
+		})
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, branding.MaxLogoBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.respondError(c, branding.ErrLogoTooLarge)
+			return
+		}
+		c.JSON(http.StatusBadRequest, brandingDto.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Failed to read logo: " + err.Error(),
+		})
+		return
+	}
+
+	b, err := h.brandingService.UploadLogo(c.Request.Context(), currentUser.UserID, data)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, h.mapBrandingToResponse(b))
+}
+
+// DeleteLogo removes the current organizer's logo
+// @Summary Delete logo
+// @Description Remove the current organizer's logo (requires ORGANIZER or ADMIN role). Invoices already issued keep the logo they were issued with.
+// @Tags branding
+// @Produce json
+// @Success 200 {object} brandingDto.BrandingResponse
+// @Failure 401 {object} brandingDto.ErrorResponse
+// @Failure 403 {object} brandingDto.ErrorResponse
+// @Failure 404 {object} brandingDto.ErrorResponse
+// @Failure 500 {object} brandingDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/organizers/me/branding/logo [delete]
+func (h *BrandingHandler) DeleteLogo(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	b, err := h.brandingService.DeleteLogo(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, h.mapBrandingToResponse(b))
+}
+
+// GetLogo serves an organizer's logo
+// @Summary Get organizer logo
+// @Description Serve an organizer's logo image. Link to it with the logo_url of event and branding responses, which changes with every upload.
+// @Tags branding
+// @Produce png
+// @Produce jpeg
+// @Produce gif
+// @Param id path string true "Organizer ID"
+// @Success 200 {file} binary "Logo"
+// @Failure 400 {object} brandingDto.ErrorResponse
+// @Failure 404 {object} brandingDto.ErrorResponse
+// @Failure 500 {object} brandingDto.ErrorResponse
+// @Router /api/v1/organizers/{id}/branding/logo [get]
+func (h *BrandingHandler) GetLogo(c *gin.Context) {
+	organizerID, _ := PathUUID(c, "id")
+
+	logo, err := h.brandingService.GetLogo(c.Request.Context(), organizerID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Header("Last-Modified", logo.UpdatedAt.UTC().Format(http.TimeFormat))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Data(http.StatusOK, logo.ContentType, logo.Body)
+}
+
+// RegisterRoutes registers branding routes with the gin router
+func (h *BrandingHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	// Public route
+	router.GET("/organizers/:id/branding/logo", UUIDParams("id"), h.GetLogo)
+
+	// Organizer routes (require ORGANIZER or ADMIN role)
+	brandingRoutes := router.Group("/organizers/me/branding", jwtMiddleware.AuthRequired(), auth.RequireOrganizer())
+	{
+		brandingRoutes.GET("", h.GetMyBranding)
+		brandingRoutes.PUT("", h.SetColors)
+		brandingRoutes.PUT("/logo", h.UploadLogo)
+		brandingRoutes.DELETE("/logo", h.DeleteLogo)
+	}
+}
+
+// respondError maps branding errors to HTTP responses
+func (h *BrandingHandler) respondError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case branding.IsValidationError(err):
+		status = http.StatusBadRequest
+	case branding.IsLogoTooLargeError(err):
+		status = http.StatusRequestEntityTooLarge
+	case branding.IsLogoNotFoundError(err):
+		status = http.StatusNotFound
+	}
+
+	code := branding.GetBrandingErrorCode(err)
+	if code == "" {
+		code = "branding_error"
+	}
+	c.JSON(status, brandingDto.ErrorResponse{
+		Error:   code,
+		Message: err.Error(),
+	})
+}
+
+// mapBrandingToResponse converts a branding to the response DTO
+func (h *BrandingHandler) mapBrandingToResponse(b *branding.Branding) brandingDto.BrandingResponse {
+	response := brandingDto.BrandingResponse{
+		OrganizerID:  b.OrganizerID,
+		PrimaryColor: b.PrimaryColor,
+		AccentColor:  b.AccentColor,
+		LogoURL:      h.brandingService.LogoURL(b),
+		LogoWidth:    b.LogoWidth,
+		LogoHeight:   b.LogoHeight,
+	}
+	if !b.UpdatedAt.IsZero() {
+		response.UpdatedAt = &b.UpdatedAt
+	}
+	return response
+}
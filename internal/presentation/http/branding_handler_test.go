@@ -0,0 +1,230 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/branding"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBrandingService is a mock implementation of branding.Service interface
+type MockBrandingService struct {
+	mock.Mock
+}
+
+func (m *MockBrandingService) GetBranding(ctx context.Context, organizerID uuid.UUID) (*branding.Branding, error) {
+	args := m.Called(ctx, organizerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*branding.Branding), args.Error(1)
+}
+
+func (m *MockBrandingService) GetBrandings(ctx context.Context, organizerIDs []uuid.UUID) (map[uuid.UUID]*branding.Branding, error) {
+	args := m.Called(ctx, organizerIDs)
+	return args.Get(0).(map[uuid.UUID]*branding.Branding), args.Error(1)
+}
+
+func (m *MockBrandingService) SetColors(ctx context.Context, organizerID uuid.UUID, colors branding.Colors) (*branding.Branding, error) {
+	args := m.Called(ctx, organizerID, colors)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*branding.Branding), args.Error(1)
+}
+
+func (m *MockBrandingService) UploadLogo(ctx context.Context, organizerID uuid.UUID, data []byte) (*branding.Branding, error) {
+	args := m.Called(ctx, organizerID, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*branding.Branding), args.Error(1)
+}
+
+func (m *MockBrandingService) DeleteLogo(ctx context.Context, organizerID uuid.UUID) (*branding.Branding, error) {
+	args := m.Called(ctx, organizerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*branding.Branding), args.Error(1)
+}
+
+func (m *MockBrandingService) GetLogo(ctx context.Context, organizerID uuid.UUID) (*branding.Logo, error) {
+	args := m.Called(ctx, organizerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*branding.Logo), args.Error(1)
+}
+
+func (m *MockBrandingService) LogoURL(b *branding.Branding) string {
+	return m.Called(b).String(0)
+}
+
+func TestBrandingHandler_SetColors(t *testing.T) {
+	organizerID := uuid.New()
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func(*MockBrandingService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "sets colors",
+			body: map[string]string{"primary_color": "#abc", "accent_color": ""},
+			setupMocks: func(m *MockBrandingService) {
+				b := &branding.Branding{OrganizerID: organizerID, PrimaryColor: "#aabbcc", UpdatedAt: time.Now()}
+				m.On("SetColors", mock.Anything, organizerID, branding.Colors{Primary: "#abc"}).Return(b, nil)
+				m.On("LogoURL", b).Return("")
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"primary_color":"#aabbcc"`,
+		},
+		{
+			name: "invalid color",
+			body: map[string]string{"primary_color": "red"},
+			setupMocks: func(m *MockBrandingService) {
+				m.On("SetColors", mock.Anything, organizerID, branding.Colors{Primary: "red"}).Return(nil, branding.ErrInvalidColor)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   branding.ErrInvalidColor.Code,
+		},
+		{
+			name:           "unknown field",
+			body:           map[string]string{"logo": "x"},
+			setupMocks:     func(m *MockBrandingService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockBrandingService)
+			tt.setupMocks(service)
+			handler := NewBrandingHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			c, w := userTestContext(http.MethodPut, "/organizers/me/branding", tt.body, organizerID, nil)
+			handler.SetColors(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBrandingHandler_UploadLogo(t *testing.T) {
+	organizerID := uuid.New()
+	logo := []byte("\x89PNG fake")
+
+	tests := []struct {
+		name           string
+		contentType    string
+		body           []byte
+		setupMocks     func(*MockBrandingService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "uploads logo",
+			contentType: "image/png",
+			body:        logo,
+			setupMocks: func(m *MockBrandingService) {
+				b := &branding.Branding{OrganizerID: organizerID, LogoKey: "branding/x/logo-1", LogoWidth: 64, LogoHeight: 32}
+				m.On("UploadLogo", mock.Anything, organizerID, logo).Return(b, nil)
+				m.On("LogoURL", b).Return("https://tickets.example.com/logo")
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"logo_url":"https://tickets.example.com/logo"`,
+		},
+		{
+			name:           "unsupported content type",
+			contentType:    "image/svg+xml",
+			body:           []byte("<svg/>"),
+			setupMocks:     func(m *MockBrandingService) {},
+			expectedStatus: http.StatusUnsupportedMediaType,
+			expectedBody:   "unsupported_media_type",
+		},
+		{
+			name:           "too large",
+			contentType:    "image/png",
+			body:           bytes.Repeat([]byte{0}, branding.MaxLogoBytes+1),
+			setupMocks:     func(m *MockBrandingService) {},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedBody:   branding.ErrLogoTooLarge.Code,
+		},
+		{
+			name:        "wrong dimensions",
+			contentType: "image/png",
+			body:        logo,
+			setupMocks: func(m *MockBrandingService) {
+				m.On("UploadLogo", mock.Anything, organizerID, logo).Return(nil, branding.ErrLogoDimensions)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   branding.ErrLogoDimensions.Code,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockBrandingService)
+			tt.setupMocks(service)
+			handler := NewBrandingHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			c, w := userTestContext(http.MethodPut, "/organizers/me/branding/logo", nil, organizerID, nil)
+			c.Request.Body = io.NopCloser(bytes.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", tt.contentType)
+			handler.UploadLogo(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBrandingHandler_GetLogo(t *testing.T) {
+	organizerID := uuid.New()
+	params := gin.Params{{Key: "id", Value: organizerID.String()}}
+
+	t.Run("serves the logo", func(t *testing.T) {
+		service := new(MockBrandingService)
+		updatedAt := time.Date(2026, 10, 17, 9, 30, 0, 0, time.UTC)
+		service.On("GetLogo", mock.Anything, organizerID).
+			Return(&branding.Logo{ContentType: "image/png", Body: []byte("png"), UpdatedAt: updatedAt}, nil)
+		handler := NewBrandingHandler(service, nil)
+
+		c, w := userTestContext(http.MethodGet, "/organizers/"+organizerID.String()+"/branding/logo", nil, uuid.Nil, params)
+		handler.GetLogo(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "png", w.Body.String())
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+		assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+		assert.Equal(t, "Sat, 17 Oct 2026 09:30:00 GMT", w.Header().Get("Last-Modified"))
+	})
+
+	t.Run("no logo", func(t *testing.T) {
+		service := new(MockBrandingService)
+		service.On("GetLogo", mock.Anything, organizerID).Return(nil, branding.ErrLogoNotFound)
+		handler := NewBrandingHandler(service, nil)
+
+		c, w := userTestContext(http.MethodGet, "/organizers/"+organizerID.String()+"/branding/logo", nil, uuid.Nil, params)
+		handler.GetLogo(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), branding.ErrLogoNotFound.Code)
+	})
+}
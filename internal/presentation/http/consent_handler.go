@@ -0,0 +1,248 @@
+package http
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/consent"
+	consentDto "enterprise-crud/internal/dto/consent"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ConsentHandler handles HTTP requests for policy versions and user consents
+type ConsentHandler struct {
+	consentService consent.Service
+	jwtService     *auth.JWTService
+}
+
+// NewConsentHandler creates a new instance of ConsentHandler
+func NewConsentHandler(consentService consent.Service, jwtService *auth.JWTService) *ConsentHandler {
+	return &ConsentHandler{
+		consentService: consentService,
+		jwtService:     jwtService,
+	}
+}
+
+// GetCurrentPolicies lists the current version of each policy
+// @Summary Get current policies
+// @Description Get the current terms of service and privacy policy versions, e.g. to show them at registration
+// @Tags consents
+// @Produce json
+// @Success 200 {object} consentDto.VersionListResponse
+// @Failure 500 {object} consentDto.ErrorResponse
+// @Router /api/v1/policies [get]
+func (h *ConsentHandler) GetCurrentPolicies(c *gin.Context) {
+	versions, err := h.consentService.GetLatestVersions(c.Request.Context())
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, consentDto.VersionListResponse{Versions: mapVersionsToResponse(versions)})
+}
+
+// ListPolicyVersions lists every published version of a policy
+// @Summary List policy versions
+// @Description List every published version of a policy, newest first (requires ADMIN role)
+// @Tags admin
+// @Produce json
+// @Param type query string true "Policy type" Enums(TERMS, PRIVACY)
+// @Success 200 {object} consentDto.VersionListResponse
+// @Failure 400 {object} consentDto.ErrorResponse
+// @Failure 401 {object} consentDto.ErrorResponse
+// @Failure 403 {object} consentDto.ErrorResponse
+// @Failure 500 {object} consentDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/policies [get]
+func (h *ConsentHandler) ListPolicyVersions(c *gin.Context) {
+	versions, err := h.consentService.ListVersions(c.Request.Context(), c.Query("type"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, consentDto.VersionListResponse{Versions: mapVersionsToResponse(versions)})
+}
+
+// PublishPolicyVersion publishes a new version of a policy
+// @Summary Publish policy version
+// @Description Publish a new terms of service or privacy policy version (requires ADMIN role).
+// @Description Users are asked to accept it on their next login; purchases are blocked until the current terms are accepted.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param version body consentDto.PublishVersionRequest true "Policy version"
+// @Success 201 {object} consentDto.VersionResponse
+// @Failure 400 {object} consentDto.ErrorResponse
+// @Failure 401 {object} consentDto.ErrorResponse
+// @Failure 403 {object} consentDto.ErrorResponse
+// @Failure 409 {object} consentDto.ErrorResponse
+// @Failure 500 {object} consentDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/policies [post]
+func (h *ConsentHandler) PublishPolicyVersion(c *gin.Context) {
+	var req consentDto.PublishVersionRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, consentDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	version, err := h.consentService.PublishVersion(c.Request.Context(), req.Type, req.Version, req.URL)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapVersionToResponse(version))
+}
+
+// GetMyConsents returns the current user's consent status
+// @Summary Get my consents
+// @Description List the policy versions the current user accepted and the current versions still to accept
+// @Tags consents
+// @Produce json
+// @Success 200 {object} consentDto.ConsentsResponse
+// @Failure 401 {object} consentDto.ErrorResponse
+// @Failure 500 {object} consentDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/me/consents [get]
+func (h *ConsentHandler) GetMyConsents(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	h.respondConsents(c, currentUser.UserID)
+}
+
+// AcceptPolicies records that the current user accepted policy versions
+// @Summary Accept policies
+// @Description Accept policy versions, e.g. the pending versions listed by GET /api/v1/users/me/consents
+// @Tags consents
+// @Accept json
+// @Produce json
+// @Param consents body consentDto.AcceptRequest true "Versions to accept"
+// @Success 200 {object} consentDto.ConsentsResponse
+// @Failure 400 {object} consentDto.ErrorResponse
+// @Failure 401 {object} consentDto.ErrorResponse
+// @Failure 404 {object} consentDto.ErrorResponse
+// @Failure 500 {object} consentDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/me/consents [post]
+func (h *ConsentHandler) AcceptPolicies(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req consentDto.AcceptRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, consentDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	if _, err := h.consentService.Accept(c.Request.Context(), currentUser.UserID, req.VersionIDs); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	h.respondConsents(c, currentUser.UserID)
+}
+
+// RegisterRoutes registers consent routes with the gin router
+func (h *ConsentHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	// Public routes
+	router.GET("/policies", h.GetCurrentPolicies)
+
+	// User routes (require any authenticated user)
+	consentRoutes := router.Group("/users/me/consents", jwtMiddleware.AuthRequired(), auth.RequireUser())
+	{
+		consentRoutes.GET("", h.GetMyConsents)
+		consentRoutes.POST("", h.AcceptPolicies)
+	}
+
+	// Admin routes (require ADMIN role)
+	adminRoutes := router.Group("/admin/policies", jwtMiddleware.AuthRequired(), auth.RequireAdmin())
+	{
+		adminRoutes.GET("", h.ListPolicyVersions)
+		adminRoutes.POST("", h.PublishPolicyVersion)
+	}
+}
+
+// respondConsents writes the user's accepted and pending policy versions
+func (h *ConsentHandler) respondConsents(c *gin.Context, userID uuid.UUID) {
+	accepted, err := h.consentService.ListAcceptances(c.Request.Context(), userID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	pending, err := h.consentService.GetPendingVersions(c.Request.Context(), userID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := consentDto.ConsentsResponse{
+		Accepted: make([]consentDto.AcceptanceResponse, len(accepted)),
+		Pending:  mapVersionsToResponse(pending),
+	}
+	for i, a := range accepted {
+		response.Accepted[i] = consentDto.AcceptanceResponse{
+			VersionResponse: mapVersionToResponse(&a.Version),
+			AcceptedAt:      a.AcceptedAt,
+		}
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps consent errors to HTTP responses
+func (h *ConsentHandler) respondError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case consent.IsValidationError(err):
+		status = http.StatusBadRequest
+	case consent.IsVersionNotFoundError(err):
+		status = http.StatusNotFound
+	case consent.IsVersionExistsError(err):
+		status = http.StatusConflict
+	}
+
+	code := consent.GetConsentErrorCode(err)
+	if code == "" {
+		code = "consent_error"
+	}
+	c.JSON(status, consentDto.ErrorResponse{
+		Error:   code,
+		Message: err.Error(),
+	})
+}
+
+// mapVersionToResponse converts a policy version to response DTO
+func mapVersionToResponse(v *consent.Version) consentDto.VersionResponse {
+	return consentDto.VersionResponse{
+		ID:          v.ID,
+		Type:        v.Type,
+		Version:     v.Version,
+		URL:         v.URL,
+		PublishedAt: v.PublishedAt,
+	}
+}
+
+// mapVersionsToResponse converts policy versions to response DTOs
+func mapVersionsToResponse(versions []*consent.Version) []consentDto.VersionResponse {
+	response := make([]consentDto.VersionResponse, len(versions))
+	for i, v := range versions {
+		response[i] = mapVersionToResponse(v)
+	}
+	return response
+}
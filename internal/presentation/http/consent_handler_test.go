@@ -0,0 +1,186 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/consent"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockConsentService is a mock implementation of consent.Service interface
+type MockConsentService struct {
+	mock.Mock
+}
+
+func (m *MockConsentService) PublishVersion(ctx context.Context, policyType, version, documentURL string) (*consent.Version, error) {
+	args := m.Called(ctx, policyType, version, documentURL)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*consent.Version), args.Error(1)
+}
+
+func (m *MockConsentService) ListVersions(ctx context.Context, policyType string) ([]*consent.Version, error) {
+	args := m.Called(ctx, policyType)
+	versions, _ := args.Get(0).([]*consent.Version)
+	return versions, args.Error(1)
+}
+
+func (m *MockConsentService) GetLatestVersions(ctx context.Context) ([]*consent.Version, error) {
+	args := m.Called(ctx)
+	versions, _ := args.Get(0).([]*consent.Version)
+	return versions, args.Error(1)
+}
+
+func (m *MockConsentService) GetPendingVersions(ctx context.Context, userID uuid.UUID) ([]*consent.Version, error) {
+	args := m.Called(ctx, userID)
+	versions, _ := args.Get(0).([]*consent.Version)
+	return versions, args.Error(1)
+}
+
+func (m *MockConsentService) ListAcceptances(ctx context.Context, userID uuid.UUID) ([]*consent.Acceptance, error) {
+	args := m.Called(ctx, userID)
+	acceptances, _ := args.Get(0).([]*consent.Acceptance)
+	return acceptances, args.Error(1)
+}
+
+func (m *MockConsentService) Accept(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID) ([]*consent.Version, error) {
+	args := m.Called(ctx, userID, versionIDs)
+	versions, _ := args.Get(0).([]*consent.Version)
+	return versions, args.Error(1)
+}
+
+func (m *MockConsentService) AcceptPending(ctx context.Context, userID uuid.UUID) ([]*consent.Version, error) {
+	args := m.Called(ctx, userID)
+	versions, _ := args.Get(0).([]*consent.Version)
+	return versions, args.Error(1)
+}
+
+func (m *MockConsentService) CheckTermsAccepted(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func newTestConsentHandler(service *MockConsentService) *ConsentHandler {
+	return NewConsentHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+}
+
+func TestConsentHandler_PublishPolicyVersion(t *testing.T) {
+	adminID := uuid.New()
+	url := "https://example.com/legal/terms-2026-05"
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func(*MockConsentService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "publishes version",
+			body: map[string]string{"type": consent.TypeTerms, "version": "2026-05", "url": url},
+			setupMocks: func(m *MockConsentService) {
+				m.On("PublishVersion", mock.Anything, consent.TypeTerms, "2026-05", url).
+					Return(&consent.Version{ID: uuid.New(), Type: consent.TypeTerms, Version: "2026-05", URL: url}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"version":"2026-05"`,
+		},
+		{
+			name:           "unknown policy type",
+			body:           map[string]string{"type": "COOKIES", "version": "2026-05", "url": url},
+			setupMocks:     func(m *MockConsentService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "version already published",
+			body: map[string]string{"type": consent.TypeTerms, "version": "2026-05", "url": url},
+			setupMocks: func(m *MockConsentService) {
+				m.On("PublishVersion", mock.Anything, consent.TypeTerms, "2026-05", url).
+					Return(nil, consent.ErrVersionExists)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "POLICY_VERSION_EXISTS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockConsentService)
+			tt.setupMocks(service)
+
+			c, w := userTestContext(http.MethodPost, "/admin/policies", tt.body, adminID, nil)
+			newTestConsentHandler(service).PublishPolicyVersion(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestConsentHandler_AcceptPolicies(t *testing.T) {
+	userID := uuid.New()
+	versionID := uuid.New()
+	body := map[string]interface{}{"version_ids": []uuid.UUID{versionID}}
+
+	t.Run("returns updated consents", func(t *testing.T) {
+		acceptedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+		version := consent.Version{ID: versionID, Type: consent.TypeTerms, Version: "2026-05"}
+		service := new(MockConsentService)
+		service.On("Accept", mock.Anything, userID, []uuid.UUID{versionID}).Return([]*consent.Version{&version}, nil)
+		service.On("ListAcceptances", mock.Anything, userID).
+			Return([]*consent.Acceptance{{Version: version, AcceptedAt: acceptedAt}}, nil)
+		service.On("GetPendingVersions", mock.Anything, userID).Return([]*consent.Version{}, nil)
+
+		c, w := userTestContext(http.MethodPost, "/users/me/consents", body, userID, nil)
+		newTestConsentHandler(service).AcceptPolicies(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), versionID.String())
+		assert.Contains(t, w.Body.String(), `"pending":[]`)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		service := new(MockConsentService)
+		service.On("Accept", mock.Anything, userID, []uuid.UUID{versionID}).Return(nil, consent.ErrVersionNotFound)
+
+		c, w := userTestContext(http.MethodPost, "/users/me/consents", body, userID, nil)
+		newTestConsentHandler(service).AcceptPolicies(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "POLICY_VERSION_NOT_FOUND")
+	})
+
+	t.Run("empty request", func(t *testing.T) {
+		service := new(MockConsentService)
+
+		c, w := userTestContext(http.MethodPost, "/users/me/consents", map[string]interface{}{"version_ids": []uuid.UUID{}}, userID, nil)
+		newTestConsentHandler(service).AcceptPolicies(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		service.AssertNotCalled(t, "Accept", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestConsentHandler_GetMyConsents_ListsPending(t *testing.T) {
+	userID := uuid.New()
+	pending := &consent.Version{ID: uuid.New(), Type: consent.TypePrivacy, Version: "v2", URL: "https://example.com/privacy"}
+	service := new(MockConsentService)
+	service.On("ListAcceptances", mock.Anything, userID).Return([]*consent.Acceptance{}, nil)
+	service.On("GetPendingVersions", mock.Anything, userID).Return([]*consent.Version{pending}, nil)
+
+	c, w := userTestContext(http.MethodGet, "/users/me/consents", nil, userID, nil)
+	newTestConsentHandler(service).GetMyConsents(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), pending.ID.String())
+	assert.Contains(t, w.Body.String(), `"accepted":[]`)
+}
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"os"
+
+	"enterprise-crud/internal/domain/clock"
+	diagnosticsDto "enterprise-crud/internal/dto/diagnostics"
+	"enterprise-crud/internal/infrastructure/auth"
+	"enterprise-crud/internal/infrastructure/diagnostics"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DiagnosticsHandler serves pprof profiles, expvar variables and on-demand profile dumps
+type DiagnosticsHandler struct {
+	dumpDir    string
+	jwtService *auth.JWTService
+	clock      clock.Clock // Names and dates the dumps
+}
+
+// NewDiagnosticsHandler creates a new instance of DiagnosticsHandler writing dumps to dumpDir
+func NewDiagnosticsHandler(dumpDir string, jwtService *auth.JWTService, opts ...HandlerOption) *DiagnosticsHandler {
+	options := applyHandlerOptions(opts)
+	return &DiagnosticsHandler{
+		dumpDir:    dumpDir,
+		jwtService: jwtService,
+		clock:      options.clock,
+	}
+}
+
+// Profile serves the pprof index and profiles
+// @Summary Runtime profiles
+// @Description pprof index and profiles, e.g. /debug/pprof/heap, /debug/pprof/goroutine?debug=2 or /debug/pprof/profile?seconds=10 for `go tool pprof` (requires ADMIN role on the API port). CPU profiles and traces can't outlast server.write_timeout there; serve diagnostics on diagnostics.addr for longer ones.
+// @Tags diagnostics
+// @Produce plain
+// @Success 200 {string} string "Profile"
+// @Failure 401 {object} diagnosticsDto.ErrorResponse
+// @Failure 403 {object} diagnosticsDto.ErrorResponse
+// @Security BearerAuth
+// @Router /debug/pprof/{profile} [get]
+func (h *DiagnosticsHandler) Profile(c *gin.Context) {
+	switch c.Param("profile") {
+	case "/cmdline":
+		pprof.Cmdline(c.Writer, c.Request)
+	case "/profile":
+		pprof.Profile(c.Writer, c.Request)
+	case "/symbol":
+		pprof.Symbol(c.Writer, c.Request)
+	case "/trace":
+		pprof.Trace(c.Writer, c.Request)
+	default:
+		// Index serves the named profiles too, taking the name from after /debug/pprof/
+		pprof.Index(c.Writer, c.Request)
+	}
+}
+
+// CaptureDumps writes goroutine and heap profiles to disk on the instance serving the request
+// @Summary Capture profile dumps
+// @Description Write profiles to diagnostics.dump_dir on the instance that serves the request, so they outlive it being restarted (requires ADMIN role on the API port). Goroutine dumps are text with every stack; the others are pprof files. Without a body goroutines and heap are captured.
+// @Tags diagnostics
+// @Accept json
+// @Produce json
+// @Param request body diagnosticsDto.CaptureRequest false "Profiles to capture"
+// @Success 201 {object} diagnosticsDto.CaptureResponse
+// @Failure 400 {object} diagnosticsDto.ErrorResponse
+// @Failure 401 {object} diagnosticsDto.ErrorResponse
+// @Failure 403 {object} diagnosticsDto.ErrorResponse
+// @Failure 500 {object} diagnosticsDto.ErrorResponse
+// @Security BearerAuth
+// @Router /debug/dumps [post]
+func (h *DiagnosticsHandler) CaptureDumps(c *gin.Context) {
+	var req diagnosticsDto.CaptureRequest
+	if c.Request.ContentLength != 0 {
+		if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+			c.JSON(bindErr.Status, diagnosticsDto.ErrorResponse{
+				Error:   bindErr.Code(),
+				Message: "Invalid input data: " + bindErr.Error(),
+			})
+			return
+		}
+	}
+
+	dumps, err := diagnostics.Capture(h.dumpDir, h.clock.Now(), req.Profiles...)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, diagnosticsDto.ErrorResponse{
+			Error:   "DUMP_FAILED",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	host, _ := os.Hostname()
+	response := diagnosticsDto.CaptureResponse{Host: host, Dumps: make([]diagnosticsDto.DumpResponse, 0, len(dumps))}
+	for _, dump := range dumps {
+		response.Dumps = append(response.Dumps, diagnosticsDto.DumpResponse{
+			Profile: dump.Profile,
+			Path:    dump.Path,
+			Bytes:   dump.Bytes,
+		})
+	}
+	c.JSON(http.StatusCreated, response)
+}
+
+// RegisterRoutes registers the diagnostics routes under /debug for admins
+func (h *DiagnosticsHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	h.register(router.Group("/debug", jwtMiddleware.AuthRequired(), auth.RequireAdmin()))
+}
+
+// RegisterInternalRoutes registers the diagnostics routes under /debug without authentication,
+// for a server only reachable from inside the network
+func (h *DiagnosticsHandler) RegisterInternalRoutes(router *gin.RouterGroup) {
+	h.register(router.Group("/debug"))
+}
+
+func (h *DiagnosticsHandler) register(debugRoutes *gin.RouterGroup) {
+	debugRoutes.GET("/pprof/*profile", h.Profile)
+	debugRoutes.POST("/pprof/symbol", gin.WrapF(pprof.Symbol))
+	debugRoutes.GET("/vars", gin.WrapH(expvar.Handler()))
+	debugRoutes.POST("/dumps", h.CaptureDumps)
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	diagnosticsDto "enterprise-crud/internal/dto/diagnostics"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnosticsHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	dumpDir := t.TempDir()
+	handler := NewDiagnosticsHandler(dumpDir, auth.NewJWTService("test-secret-key", "test-issuer", time.Hour))
+
+	router := gin.New()
+	handler.RegisterRoutes(&router.RouterGroup)
+
+	send := func(router *gin.Engine, method, path, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("requires a token", func(t *testing.T) {
+		w := send(router, http.MethodGet, "/debug/pprof/", "", "")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("requires the admin role", func(t *testing.T) {
+		w := send(router, http.MethodGet, "/debug/vars", generateTestJWT([]string{"USER"}), "")
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("serves profiles and variables to admins", func(t *testing.T) {
+		admin := generateTestJWT([]string{"ADMIN"})
+
+		w := send(router, http.MethodGet, "/debug/pprof/", admin, "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "goroutine")
+
+		w = send(router, http.MethodGet, "/debug/pprof/goroutine?debug=1", admin, "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "goroutine profile:")
+
+		w = send(router, http.MethodGet, "/debug/vars", admin, "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"memstats"`)
+	})
+
+	t.Run("captures dumps", func(t *testing.T) {
+		w := send(router, http.MethodPost, "/debug/dumps", generateTestJWT([]string{"ADMIN"}), `{"profiles":["goroutine"]}`)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response diagnosticsDto.CaptureResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Dumps, 1)
+		assert.Equal(t, "goroutine", response.Dumps[0].Profile)
+		assert.True(t, strings.HasPrefix(response.Dumps[0].Path, dumpDir))
+		_, err := os.Stat(response.Dumps[0].Path)
+		assert.NoError(t, err)
+	})
+
+	t.Run("captures goroutines and heap without a body", func(t *testing.T) {
+		w := send(router, http.MethodPost, "/debug/dumps", generateTestJWT([]string{"ADMIN"}), "")
+		require.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"profile":"heap"`)
+	})
+
+	t.Run("rejects unknown profiles", func(t *testing.T) {
+		w := send(router, http.MethodPost, "/debug/dumps", generateTestJWT([]string{"ADMIN"}), `{"profiles":["cpu"]}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("internal routes need no token", func(t *testing.T) {
+		internal := gin.New()
+		handler.RegisterInternalRoutes(&internal.RouterGroup)
+
+		w := send(internal, http.MethodGet, "/debug/pprof/heap?debug=1", "", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
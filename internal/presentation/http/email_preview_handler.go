@@ -0,0 +1,113 @@
+package http
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+
+	"enterprise-crud/internal/domain/clock"
+	emailPreviewDto "enterprise-crud/internal/dto/emailpreview"
+	"enterprise-crud/internal/infrastructure/email"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EmailPreviewHeaderSubject carries the subject of a previewed email
+const EmailPreviewHeaderSubject = "X-Email-Subject"
+
+// EmailPreviewHandler renders the email templates with sample data, for development
+type EmailPreviewHandler struct {
+	renderer *email.Renderer
+	clock    clock.Clock // Dates the sample emails
+}
+
+// NewEmailPreviewHandler creates a new instance of EmailPreviewHandler
+func NewEmailPreviewHandler(renderer *email.Renderer, opts ...HandlerOption) *EmailPreviewHandler {
+	options := applyHandlerOptions(opts)
+	return &EmailPreviewHandler{
+		renderer: renderer,
+		clock:    options.clock,
+	}
+}
+
+// ListTemplates lists the email templates and locales that can be previewed
+// @Summary List email templates
+// @Description List the email templates and the locales they can be rendered in (development only).
+// @Tags development
+// @Produce json
+// @Success 200 {object} emailPreviewDto.TemplatesResponse
+// @Router /api/v1/dev/emails [get]
+func (h *EmailPreviewHandler) ListTemplates(c *gin.Context) {
+	c.JSON(http.StatusOK, emailPreviewDto.TemplatesResponse{
+		Templates: h.renderer.Templates(),
+		Locales:   h.renderer.Locales(),
+	})
+}
+
+// Preview renders an email template with sample data
+// @Summary Preview an email
+// @Description Render an email template with made-up data, as HTML or plain text (development only). The subject is returned in the X-Email-Subject header, MIME-encoded when it isn't ASCII.
+// @Tags development
+// @Produce html
+// @Produce plain
+// @Param name path string true "Template name"
+// @Param locale query string false "Locale, e.g. de or de-AT (default: email.default_locale)"
+// @Param format query string false "html or text (default: html)"
+// @Success 200 {string} string "Rendered email"
+// @Failure 400 {object} emailPreviewDto.ErrorResponse
+// @Failure 404 {object} emailPreviewDto.ErrorResponse
+// @Failure 500 {object} emailPreviewDto.ErrorResponse
+// @Router /api/v1/dev/emails/{name} [get]
+func (h *EmailPreviewHandler) Preview(c *gin.Context) {
+	format := c.DefaultQuery("format", "html")
+	if format != "html" && format != "text" {
+		c.JSON(http.StatusBadRequest, emailPreviewDto.ErrorResponse{
+			Error:   "INVALID_FORMAT",
+			Message: "format must be html or text",
+		})
+		return
+	}
+
+	name := c.Param("name")
+	data, ok := email.Sample(name, h.clock.Now())
+	if !ok {
+		h.respondNotFound(c, name)
+		return
+	}
+	msg, err := h.renderer.RenderLocalized(name, c.Query("locale"), "preview@example.com", data)
+	if err != nil {
+		if errors.Is(err, email.ErrUnknownTemplate) {
+			h.respondNotFound(c, name)
+			return
+		}
+		c.JSON(http.StatusInternalServerError, emailPreviewDto.ErrorResponse{
+			Error:   "RENDER_FAILED",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.Header(EmailPreviewHeaderSubject, mime.QEncoding.Encode("utf-8", msg.Subject))
+	if format == "text" {
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(msg.Text))
+		return
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(msg.HTML))
+}
+
+func (h *EmailPreviewHandler) respondNotFound(c *gin.Context, name string) {
+	c.JSON(http.StatusNotFound, emailPreviewDto.ErrorResponse{
+		Error:   "TEMPLATE_NOT_FOUND",
+		Message: "No email template named " + name,
+	})
+}
+
+// RegisterRoutes registers the email preview routes under /dev/emails
+// Only mounted in development: the previews need no authentication.
+func (h *EmailPreviewHandler) RegisterRoutes(router *gin.RouterGroup) {
+	emails := router.Group("/dev/emails")
+	{
+		emails.GET("", h.ListTemplates)
+		emails.GET("/:name", h.Preview)
+	}
+}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	emailPreviewDto "enterprise-crud/internal/dto/emailpreview"
+	"enterprise-crud/internal/infrastructure/email"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailPreviewHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	renderer, err := email.NewRenderer("")
+	require.NoError(t, err)
+
+	router := gin.New()
+	NewEmailPreviewHandler(renderer).RegisterRoutes(&router.RouterGroup)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	t.Run("lists templates and locales", func(t *testing.T) {
+		w := get("/dev/emails")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response emailPreviewDto.TemplatesResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Contains(t, response.Templates, "order_confirmation")
+		assert.Contains(t, response.Locales, "de")
+	})
+
+	t.Run("renders HTML with the subject in a header", func(t *testing.T) {
+		w := get("/dev/emails/order_confirmation?locale=de")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), `lang="de"`)
+		subject, err := new(mime.WordDecoder).DecodeHeader(w.Header().Get(EmailPreviewHeaderSubject))
+		require.NoError(t, err)
+		assert.Contains(t, subject, "Bestellung bestätigt")
+	})
+
+	t.Run("renders plain text", func(t *testing.T) {
+		w := get("/dev/emails/password_reset?format=text")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), "Hi fan,")
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		w := get("/dev/emails/missing")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		w := get("/dev/emails/notification?format=pdf")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
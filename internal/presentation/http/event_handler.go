@@ -1,9 +1,22 @@
 package http
 
 import (
+	"context"
+	"fmt"
+	"log"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"enterprise-crud/internal/domain/analytics"
+	"enterprise-crud/internal/domain/branding"
+	"enterprise-crud/internal/domain/clock"
 	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/role"
+	"enterprise-crud/internal/domain/shorturl"
+	"enterprise-crud/internal/domain/trending"
+	"enterprise-crud/internal/dto/common"
 	eventDto "enterprise-crud/internal/dto/event"
 	"enterprise-crud/internal/infrastructure/auth"
 
@@ -13,21 +26,53 @@ import (
 
 // EventHandler handles HTTP requests for event operations
 type EventHandler struct {
-	eventService event.Service
-	jwtService   *auth.JWTService
+	eventService     event.Service
+	shortURLService  shorturl.Service  // Nil disables short URLs
+	trendingService  trending.Service  // Counts event page views towards trending; nil counts nothing
+	analyticsService analytics.Service // Counts event page views and visitors for organizers; nil counts nothing
+	brandingService  branding.Service  // Adds the organizer's branding to events; nil leaves it out
+	jwtService       *auth.JWTService
+	createChecks     []gin.HandlerFunc // Run after authentication and before an event is created, e.g. duplicate request checks
+	clock            clock.Clock       // Tells which price tier is current
 }
 
 // NewEventHandler creates a new instance of EventHandler
-func NewEventHandler(eventService event.Service, jwtService *auth.JWTService) *EventHandler {
+func NewEventHandler(eventService event.Service, shortURLService shorturl.Service, jwtService *auth.JWTService, opts ...HandlerOption) *EventHandler {
+	options := applyHandlerOptions(opts)
 	return &EventHandler{
-		eventService: eventService,
-		jwtService:   jwtService,
+		eventService:    eventService,
+		shortURLService: shortURLService,
+		jwtService:      jwtService,
+		clock:           options.clock,
 	}
 }
 
+// CountViewsWith counts every event page served as a view towards the trending events
+func (h *EventHandler) CountViewsWith(trendingService trending.Service) {
+	h.trendingService = trendingService
+}
+
+// TrackVisitorsWith counts every event page served, and who it was served to, in the organizer's analytics
+// Signed-in visitors are told apart by user ID, others by IP address.
+func (h *EventHandler) TrackVisitorsWith(analyticsService analytics.Service) {
+	h.analyticsService = analyticsService
+}
+
+// RequireBeforeCreate adds middleware that must pass before an event is created
+// It must be called before RegisterRoutes.
+func (h *EventHandler) RequireBeforeCreate(checks ...gin.HandlerFunc) {
+	h.createChecks = append(h.createChecks, checks...)
+}
+
+// BrandWith adds the organizer's colors and logo to every event served
+func (h *EventHandler) BrandWith(brandingService branding.Service) {
+	h.brandingService = brandingService
+}
+
 // CreateEvent creates a new event
 // @Summary Create a new event
 // @Description Create a new event (requires ORGANIZER or ADMIN role)
+// @Description A request identical to one the same user sent moments earlier answers 409 DUPLICATE_REQUEST.
 // @Tags events
 // @Accept json
 // @Produce json
@@ -36,47 +81,47 @@ func NewEventHandler(eventService event.Service, jwtService *auth.JWTService) *E
 // @Failure 400 {object} event.ErrorResponse
 // @Failure 401 {object} event.ErrorResponse
 // @Failure 403 {object} event.ErrorResponse
+// @Failure 409 {object} event.ErrorResponse
 // @Failure 500 {object} event.ErrorResponse
 // @Security BearerAuth
 // @Router /api/v1/events [post]
 func (h *EventHandler) CreateEvent(c *gin.Context) {
 	var req eventDto.CreateEventRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
-			Error:   "validation_error",
-			Message: "Invalid input data: " + err.Error(),
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, eventDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
 		})
 		return
 	}
 
 	// Get user ID from context
-	userClaims, exists := c.Get("user")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, eventDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "User not authenticated",
-		})
-		return
-	}
-
-	claims, ok := userClaims.(*auth.JWTClaims)
+	currentUser, ok := auth.RequireCurrentUser(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, eventDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "Invalid authentication credentials",
-		})
 		return
 	}
 
 	// Create event entity
 	newEvent := &event.Event{
 		VenueID:      req.VenueID,
-		OrganizerID:  claims.UserID,
+		OrganizerID:  currentUser.UserID,
 		Title:        req.Title,
 		Description:  req.Description,
 		EventDate:    req.EventDate,
 		TicketPrice:  req.TicketPrice,
 		TotalTickets: req.TotalTickets,
+		Layout:       req.Layout,
+
+		AttendeeQuestions:  mapQuestionsToDomain(req.AttendeeQuestions),
+		PriceSchedule:      mapPriceScheduleToDomain(req.PriceSchedule),
+		AccessCodeRequired: req.AccessCodeRequired,
+		SaleStart:          req.SaleStart,
+		SaleEnd:            req.SaleEnd,
+		MinimumAge:         req.MinimumAge,
+		ContentWarnings:    req.ContentWarnings,
+	}
+	if req.RefundPolicy != nil {
+		newEvent.RefundPolicy = mapRefundPolicyToDomain(*req.RefundPolicy)
 	}
 
 	// Create the event
@@ -92,6 +137,11 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 				Error:   event.GetEventErrorCode(err),
 				Message: err.Error(),
 			})
+		} else if event.IsQuotaExceededError(err) {
+			c.JSON(http.StatusForbidden, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
 		} else {
 			c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
 				Error:   "creation_error",
@@ -102,13 +152,15 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 	}
 
 	// Return created event
-	response := mapEventToResponse(newEvent)
+	response := mapEventToResponse(newEvent, h.clock.Now())
+	response.ShortURL = h.createShortURL(c.Request.Context(), newEvent.ID)
+	response.Branding = h.brandings(c.Request.Context(), newEvent)[newEvent.OrganizerID]
 	c.JSON(http.StatusCreated, response)
 }
 
 // GetEvent retrieves an event by ID
 // @Summary Get event by ID
-// @Description Get event details by ID
+// @Description Get event details by ID. Events flagged by moderation are only shown to their organizer and admins.
 // @Tags events
 // @Accept json
 // @Produce json
@@ -119,16 +171,61 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 // @Failure 500 {object} event.ErrorResponse
 // @Router /api/v1/events/{id} [get]
 func (h *EventHandler) GetEvent(c *gin.Context) {
-	eventID, err := uuid.Parse(c.Param("id"))
+	eventID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	foundEvent, err := h.eventService.GetEventByID(c.Request.Context(), eventID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
-			Error:   "invalid_id",
-			Message: "Invalid event ID format",
+		if event.IsEventNotFoundError(err) {
+			c.JSON(http.StatusNotFound, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
+				Error:   "retrieval_error",
+				Message: "Failed to retrieve event: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	// Events held by moderation aren't published yet
+	if foundEvent.IsFlagged() && !h.canSeeFlagged(c, foundEvent) {
+		notFound := event.NewEventNotFoundError(eventID)
+		c.JSON(http.StatusNotFound, eventDto.ErrorResponse{
+			Error:   event.GetEventErrorCode(notFound),
+			Message: notFound.Error(),
 		})
 		return
 	}
 
-	foundEvent, err := h.eventService.GetEventByID(c.Request.Context(), eventID)
+	h.countView(c, foundEvent.ID)
+
+	response := mapEventToResponse(foundEvent, h.clock.Now())
+	response.ShortURL = h.shortURLs(c.Request.Context(), foundEvent)[foundEvent.ID]
+	response.Branding = h.brandings(c.Request.Context(), foundEvent)[foundEvent.OrganizerID]
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEventBySlug retrieves an event by its slug
+// @Summary Get event by slug
+// @Description Get event details by slug. Slugs an event had before its title changed redirect to its current slug.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Param slug path string true "Event slug"
+// @Success 200 {object} event.EventResponse
+// @Success 301 "Moved to the event's current slug"
+// @Failure 404 {object} event.ErrorResponse
+// @Failure 500 {object} event.ErrorResponse
+// @Router /api/v1/events/slug/{slug} [get]
+func (h *EventHandler) GetEventBySlug(c *gin.Context) {
+	slug := c.Param("slug")
+
+	foundEvent, err := h.eventService.GetEventBySlug(c.Request.Context(), slug)
 	if err != nil {
 		if event.IsEventNotFoundError(err) {
 			c.JSON(http.StatusNotFound, eventDto.ErrorResponse{
@@ -144,73 +241,192 @@ func (h *EventHandler) GetEvent(c *gin.Context) {
 		return
 	}
 
-	response := mapEventToResponse(foundEvent)
+	// Events held by moderation aren't published yet
+	if foundEvent.IsFlagged() && !h.canSeeFlagged(c, foundEvent) {
+		notFound := event.NewEventSlugNotFoundError(slug)
+		c.JSON(http.StatusNotFound, eventDto.ErrorResponse{
+			Error:   event.GetEventErrorCode(notFound),
+			Message: notFound.Error(),
+		})
+		return
+	}
+
+	// Links with an old slug move to the current one, so the event keeps a single address
+	if foundEvent.Slug != slug {
+		c.Redirect(http.StatusMovedPermanently, "/api/v1/events/slug/"+foundEvent.Slug)
+		return
+	}
+
+	h.countView(c, foundEvent.ID)
+
+	response := mapEventToResponse(foundEvent, h.clock.Now())
+	response.ShortURL = h.shortURLs(c.Request.Context(), foundEvent)[foundEvent.ID]
+	response.Branding = h.brandings(c.Request.Context(), foundEvent)[foundEvent.OrganizerID]
 	c.JSON(http.StatusOK, response)
 }
 
-// GetAllEvents retrieves all events
-// @Summary Get all events
-// @Description Get list of all events
+// GetAllEvents lists events
+// @Summary List events
+// @Description List active upcoming events, soonest first.
+// @Description Organizers and admins may pass status and include_past; organizers then only see their own events.
 // @Tags events
 // @Accept json
 // @Produce json
+// @Param status query string false "Event status (ACTIVE, CANCELLED, COMPLETED); organizers and admins only"
+// @Param include_past query bool false "Include events that already took place; organizers and admins only"
+// @Param moderation query string false "Moderation status (APPROVED, FLAGGED); organizers and admins only"
+// @Param on_sale query bool false "Only events whose tickets are on sale"
 // @Success 200 {object} event.EventListResponse
+// @Failure 400 {object} event.ErrorResponse
+// @Failure 401 {object} event.ErrorResponse "Non-public listing requested without a valid token"
+// @Failure 403 {object} event.ErrorResponse "Non-public listing requested by a user who is neither organizer nor admin"
 // @Failure 500 {object} event.ErrorResponse
 // @Router /api/v1/events [get]
 func (h *EventHandler) GetAllEvents(c *gin.Context) {
-	events, err := h.eventService.GetAllEvents(c.Request.Context())
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
-			Error:   event.GetEventErrorCode(err),
-			Message: err.Error(),
-		})
-		return
+	filter := event.ListFilter{
+		Status:     event.Status(strings.ToUpper(c.Query("status"))),
+		Moderation: strings.ToUpper(c.Query("moderation")),
+	}
+	if raw := c.Query("include_past"); raw != "" {
+		includePast, err := strconv.ParseBool(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
+				Error:   "invalid_filter",
+				Message: "include_past must be true or false",
+			})
+			return
+		}
+		filter.IncludePast = includePast
+	}
+	if raw := c.Query("on_sale"); raw != "" {
+		onSale, err := strconv.ParseBool(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
+				Error:   "invalid_filter",
+				Message: "on_sale must be true or false",
+			})
+			return
+		}
+		filter.OnSale = onSale
 	}
 
-	response := eventDto.EventListResponse{
-		Events: make([]eventDto.EventResponse, len(events)),
-		Count:  len(events),
+	var viewer event.Viewer
+	currentUser, signedIn := auth.CurrentUser(c)
+	if signedIn {
+		viewer = event.Viewer{
+			UserID:    currentUser.UserID,
+			Organizer: currentUser.HasRole(role.RoleOrganizer),
+			Admin:     currentUser.IsAdmin(),
+		}
 	}
 
-	for i, e := range events {
-		response.Events[i] = mapEventToResponse(e)
+	events, err := h.eventService.GetAllEvents(c.Request.Context(), filter, viewer)
+	if err != nil {
+		if event.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		} else if event.IsListingNotAllowedError(err) && !signedIn {
+			auth.AbortUnauthenticated(c)
+		} else if event.IsListingNotAllowedError(err) {
+			c.JSON(http.StatusForbidden, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		}
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, eventDto.EventListResponse{ListResponse: newList(c, h.mapEvents(c, events))})
 }
 
 // GetMyEvents retrieves events created by the current organizer
 // @Summary Get my events
-// @Description Get events created by the current organizer
+// @Description Get a page of the current organizer's events, soonest first, optionally filtered by status and date.
+// @Description Follow links.next, or pass meta.next_cursor as cursor, for the next page.
 // @Tags events
 // @Accept json
 // @Produce json
+// @Param status query string false "Event status (ACTIVE, CANCELLED, COMPLETED)"
+// @Param from query string false "Only events at or after this time (RFC 3339 or YYYY-MM-DD)"
+// @Param to query string false "Only events before this time (RFC 3339 or YYYY-MM-DD)"
+// @Param limit query int false "Page size (default 50, max 100)"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param offset query int false "Deprecated, use cursor: number of events to skip; ignored with cursor"
 // @Success 200 {object} event.EventListResponse
+// @Failure 400 {object} event.ErrorResponse
 // @Failure 401 {object} event.ErrorResponse
 // @Failure 500 {object} event.ErrorResponse
 // @Security BearerAuth
 // @Router /api/v1/events/my-events [get]
 func (h *EventHandler) GetMyEvents(c *gin.Context) {
 	// Get user ID from context
-	userClaims, exists := c.Get("user")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, eventDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "User not authenticated",
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	filter, err := parseOrganizerFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
+			Error:   "invalid_filter",
+			Message: err.Error(),
 		})
 		return
 	}
 
-	claims, ok := userClaims.(*auth.JWTClaims)
-	if !ok {
-		c.JSON(http.StatusUnauthorized, eventDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "Invalid authentication credentials",
+	events, total, err := h.eventService.GetEventsByOrganizer(c.Request.Context(), currentUser.UserID, filter)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if event.IsValidationError(err) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, eventDto.ErrorResponse{
+			Error:   event.GetEventErrorCode(err),
+			Message: err.Error(),
 		})
 		return
 	}
 
-	events, err := h.eventService.GetEventsByOrganizer(c.Request.Context(), claims.UserID)
+	page := newList(c, h.mapEvents(c, events)).WithTotal(total)
+	if next := filter.Offset + len(events); len(events) > 0 && next < total {
+		page = page.WithNext(common.Cursor{Offset: next})
+	}
+	c.JSON(http.StatusOK, eventDto.EventListResponse{ListResponse: page})
+}
+
+// GetOrganizerEvents lists an organizer's upcoming events for their public page
+// @Summary List an organizer's upcoming events
+// @Description Get a page of an organizer's active events that have not taken place yet, soonest first. No authentication is needed;
+// @Description unknown organizers have no events. Follow links.next, or pass meta.next_cursor as cursor, for the next page.
+// @Tags events
+// @Produce json
+// @Param id path string true "Organizer ID"
+// @Param limit query int false "Page size (default 50, max 100)"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Success 200 {object} event.EventListResponse
+// @Failure 400 {object} event.ErrorResponse
+// @Failure 500 {object} event.ErrorResponse
+// @Router /api/v1/organizers/{id}/events [get]
+func (h *EventHandler) GetOrganizerEvents(c *gin.Context) {
+	organizerID, _ := PathUUID(c, "id")
+
+	filter, err := parsePublicOrganizerFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
+			Error:   "invalid_filter",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	events, total, err := h.eventService.GetEventsByOrganizer(c.Request.Context(), organizerID, filter)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
 			Error:   event.GetEventErrorCode(err),
@@ -219,21 +435,73 @@ func (h *EventHandler) GetMyEvents(c *gin.Context) {
 		return
 	}
 
-	response := eventDto.EventListResponse{
-		Events: make([]eventDto.EventResponse, len(events)),
-		Count:  len(events),
+	page := newList(c, h.mapEvents(c, events)).WithTotal(total)
+	if next := filter.Offset + len(events); len(events) > 0 && next < total {
+		page = page.WithNext(common.Cursor{Offset: next})
 	}
+	c.JSON(http.StatusOK, eventDto.EventListResponse{ListResponse: page})
+}
 
+// mapEvents converts events to responses with their short URLs and organizer branding
+func (h *EventHandler) mapEvents(c *gin.Context, events []*event.Event) []eventDto.EventResponse {
+	shortURLs := h.shortURLs(c.Request.Context(), events...)
+	brandings := h.brandings(c.Request.Context(), events...)
+	now := h.clock.Now()
+	responses := make([]eventDto.EventResponse, len(events))
 	for i, e := range events {
-		response.Events[i] = mapEventToResponse(e)
+		responses[i] = mapEventToResponse(e, now)
+		responses[i].ShortURL = shortURLs[e.ID]
+		responses[i].Branding = brandings[e.OrganizerID]
 	}
+	return responses
+}
 
-	c.JSON(http.StatusOK, response)
+// parseOrganizerFilter reads the filters and page of GetMyEvents from the query string
+func parseOrganizerFilter(c *gin.Context) (event.OrganizerFilter, error) {
+	filter := event.OrganizerFilter{Status: event.Status(strings.ToUpper(c.Query("status")))}
+
+	for _, bound := range []struct {
+		name   string
+		target **time.Time
+	}{{"from", &filter.From}, {"to", &filter.To}} {
+		raw := c.Query(bound.name)
+		if raw == "" {
+			continue
+		}
+		t, err := parseReportTime(raw, time.Time{})
+		if err != nil {
+			return filter, fmt.Errorf("%s must be an RFC 3339 timestamp or YYYY-MM-DD date", bound.name)
+		}
+		*bound.target = &t
+	}
+
+	var err error
+	if raw := c.Query("limit"); raw != "" {
+		if filter.Limit, err = strconv.Atoi(raw); err != nil || filter.Limit < 0 {
+			return filter, fmt.Errorf("limit must be a non-negative number")
+		}
+	}
+	filter.Offset, err = QueryOffset(c)
+	return filter, err
+}
+
+// parsePublicOrganizerFilter reads the page of GetOrganizerEvents from the query string
+func parsePublicOrganizerFilter(c *gin.Context) (event.OrganizerFilter, error) {
+	filter := event.OrganizerFilter{PublicOnly: true}
+
+	var err error
+	if raw := c.Query("limit"); raw != "" {
+		if filter.Limit, err = strconv.Atoi(raw); err != nil || filter.Limit < 0 {
+			return filter, fmt.Errorf("limit must be a non-negative number")
+		}
+	}
+	filter.Offset, err = QueryCursor(c)
+	return filter, err
 }
 
 // UpdateEvent updates an existing event
 // @Summary Update event
-// @Description Update an existing event (only by organizer)
+// @Description Update an existing event (only by its organizer or a co-organizer)
 // @Tags events
 // @Accept json
 // @Produce json
@@ -248,40 +516,23 @@ func (h *EventHandler) GetMyEvents(c *gin.Context) {
 // @Security BearerAuth
 // @Router /api/v1/events/{id} [put]
 func (h *EventHandler) UpdateEvent(c *gin.Context) {
-	eventID, err := uuid.Parse(c.Param("id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
-			Error:   "invalid_id",
-			Message: "Invalid event ID format",
-		})
+	eventID, ok := PathUUID(c, "id")
+	if !ok {
 		return
 	}
 
 	var req eventDto.UpdateEventRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
-			Error:   "validation_error",
-			Message: "Invalid input data: " + err.Error(),
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, eventDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
 		})
 		return
 	}
 
 	// Get user ID from context
-	userClaims, exists := c.Get("user")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, eventDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "User not authenticated",
-		})
-		return
-	}
-
-	claims, ok := userClaims.(*auth.JWTClaims)
+	currentUser, ok := auth.RequireCurrentUser(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, eventDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "Invalid authentication credentials",
-		})
 		return
 	}
 
@@ -302,13 +553,23 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 		return
 	}
 
-	// Check if user is the organizer (unless they're admin)
-	if existingEvent.OrganizerID != claims.UserID && !auth.HasRole(c, "ADMIN") {
-		c.JSON(http.StatusForbidden, eventDto.ErrorResponse{
-			Error:   "forbidden",
-			Message: "You can only update your own events",
-		})
-		return
+	// Check if user is the organizer or a co-organizer (unless they're admin)
+	if !currentUser.IsAdmin() {
+		canManage, err := h.eventService.CanManage(c.Request.Context(), existingEvent, currentUser.UserID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
+				Error:   "retrieval_error",
+				Message: "Failed to check event access: " + err.Error(),
+			})
+			return
+		}
+		if !canManage {
+			c.JSON(http.StatusForbidden, eventDto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "You can only update events you organize",
+			})
+			return
+		}
 	}
 
 	// Update event entity
@@ -321,8 +582,45 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 		EventDate:    req.EventDate,
 		TicketPrice:  req.TicketPrice,
 		TotalTickets: req.TotalTickets,
+		Layout:       existingEvent.Layout,
 		Status:       existingEvent.Status,
 		CreatedAt:    existingEvent.CreatedAt,
+
+		AttendeeQuestions:  existingEvent.AttendeeQuestions,
+		PriceSchedule:      existingEvent.PriceSchedule,
+		RefundPolicy:       existingEvent.RefundPolicy,
+		AccessCodeRequired: existingEvent.AccessCodeRequired,
+		SaleStart:          existingEvent.SaleStart,
+		SaleEnd:            existingEvent.SaleEnd,
+		MinimumAge:         existingEvent.MinimumAge,
+		ContentWarnings:    existingEvent.ContentWarnings,
+	}
+	if req.Layout != nil {
+		updatedEvent.Layout = *req.Layout
+	}
+	if req.AccessCodeRequired != nil {
+		updatedEvent.AccessCodeRequired = *req.AccessCodeRequired
+	}
+	if req.SaleStart != nil {
+		updatedEvent.SaleStart = req.SaleStart
+	}
+	if req.SaleEnd != nil {
+		updatedEvent.SaleEnd = req.SaleEnd
+	}
+	if req.MinimumAge != nil {
+		updatedEvent.MinimumAge = *req.MinimumAge
+	}
+	if req.ContentWarnings != nil {
+		updatedEvent.ContentWarnings = *req.ContentWarnings
+	}
+	if req.AttendeeQuestions != nil {
+		updatedEvent.AttendeeQuestions = mapQuestionsToDomain(*req.AttendeeQuestions)
+	}
+	if req.PriceSchedule != nil {
+		updatedEvent.PriceSchedule = mapPriceScheduleToDomain(*req.PriceSchedule)
+	}
+	if req.RefundPolicy != nil {
+		updatedEvent.RefundPolicy = mapRefundPolicyToDomain(*req.RefundPolicy)
 	}
 
 	// Update the event
@@ -338,6 +636,11 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 				Error:   event.GetEventErrorCode(err),
 				Message: err.Error(),
 			})
+		} else if event.IsQuotaExceededError(err) {
+			c.JSON(http.StatusForbidden, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
 		} else {
 			c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
 				Error:   "update_error",
@@ -348,13 +651,15 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 	}
 
 	// Return updated event
-	response := mapEventToResponse(updatedEvent)
+	response := mapEventToResponse(updatedEvent, h.clock.Now())
+	response.ShortURL = h.shortURLs(c.Request.Context(), updatedEvent)[updatedEvent.ID]
+	response.Branding = h.brandings(c.Request.Context(), updatedEvent)[updatedEvent.OrganizerID]
 	c.JSON(http.StatusOK, response)
 }
 
 // CancelEvent cancels an event
 // @Summary Cancel event
-// @Description Cancel an event (only by organizer)
+// @Description Cancel an event (only by its organizer or a co-organizer)
 // @Tags events
 // @Accept json
 // @Produce json
@@ -368,36 +673,19 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 // @Security BearerAuth
 // @Router /api/v1/events/{id}/cancel [patch]
 func (h *EventHandler) CancelEvent(c *gin.Context) {
-	eventID, err := uuid.Parse(c.Param("id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
-			Error:   "invalid_id",
-			Message: "Invalid event ID format",
-		})
+	eventID, ok := PathUUID(c, "id")
+	if !ok {
 		return
 	}
 
 	// Get user ID from context
-	userClaims, exists := c.Get("user")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, eventDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "User not authenticated",
-		})
-		return
-	}
-
-	claims, ok := userClaims.(*auth.JWTClaims)
+	currentUser, ok := auth.RequireCurrentUser(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, eventDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "Invalid authentication credentials",
-		})
 		return
 	}
 
 	// Cancel the event
-	if err := h.eventService.CancelEvent(c.Request.Context(), eventID, claims.UserID); err != nil {
+	if err := h.eventService.CancelEvent(c.Request.Context(), eventID, currentUser.UserID); err != nil {
 		// Handle different types of errors appropriately
 		if event.IsEventNotFoundError(err) {
 			c.JSON(http.StatusNotFound, eventDto.ErrorResponse{
@@ -444,36 +732,19 @@ func (h *EventHandler) CancelEvent(c *gin.Context) {
 // @Security BearerAuth
 // @Router /api/v1/events/{id} [delete]
 func (h *EventHandler) DeleteEvent(c *gin.Context) {
-	eventID, err := uuid.Parse(c.Param("id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
-			Error:   "invalid_id",
-			Message: "Invalid event ID format",
-		})
+	eventID, ok := PathUUID(c, "id")
+	if !ok {
 		return
 	}
 
 	// Get user ID from context
-	userClaims, exists := c.Get("user")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, eventDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "User not authenticated",
-		})
-		return
-	}
-
-	claims, ok := userClaims.(*auth.JWTClaims)
+	currentUser, ok := auth.RequireCurrentUser(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, eventDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "Invalid authentication credentials",
-		})
 		return
 	}
 
 	// Delete the event
-	if err := h.eventService.DeleteEvent(c.Request.Context(), eventID, claims.UserID); err != nil {
+	if err := h.eventService.DeleteEvent(c.Request.Context(), eventID, currentUser.UserID); err != nil {
 		// Handle different types of errors appropriately
 		if event.IsEventNotFoundError(err) {
 			c.JSON(http.StatusNotFound, eventDto.ErrorResponse{
@@ -504,6 +775,191 @@ func (h *EventHandler) DeleteEvent(c *gin.Context) {
 	})
 }
 
+// ApproveModeration publishes an event flagged by moderation
+// @Summary Approve flagged event
+// @Description Publish an event whose title or description moderation flagged (requires ADMIN role). Flagged events are listed at GET /api/v1/events?moderation=FLAGGED.
+// @Tags events
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} event.EventResponse
+// @Failure 400 {object} event.ErrorResponse
+// @Failure 401 {object} event.ErrorResponse
+// @Failure 403 {object} event.ErrorResponse
+// @Failure 404 {object} event.ErrorResponse
+// @Failure 500 {object} event.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/moderation/approve [post]
+func (h *EventHandler) ApproveModeration(c *gin.Context) {
+	eventID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	approved, err := h.eventService.ApproveModeration(c.Request.Context(), eventID, currentUser.UserID)
+	if err != nil {
+		if event.IsEventNotFoundError(err) {
+			c.JSON(http.StatusNotFound, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		} else if event.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
+				Error:   "moderation_error",
+				Message: "Failed to approve event: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	response := mapEventToResponse(approved, h.clock.Now())
+	response.ShortURL = h.shortURLs(c.Request.Context(), approved)[approved.ID]
+	response.Branding = h.brandings(c.Request.Context(), approved)[approved.OrganizerID]
+	c.JSON(http.StatusOK, response)
+}
+
+// AdjustInventory adds tickets to an event or holds some back without a full update
+// @Summary Adjust event inventory
+// @Description Add tickets to an event, or remove unsold ones with a negative delta (e.g. a production hold), and record why (only by its organizer, a co-organizer or an admin). The new total must fit the venue and the organizer's plan.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param adjustment body event.InventoryAdjustmentRequest true "Tickets added or removed, and why"
+// @Success 201 {object} event.InventoryAdjustmentResponse
+// @Failure 400 {object} event.ErrorResponse
+// @Failure 401 {object} event.ErrorResponse
+// @Failure 403 {object} event.ErrorResponse
+// @Failure 404 {object} event.ErrorResponse
+// @Failure 500 {object} event.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/inventory-adjustments [post]
+func (h *EventHandler) AdjustInventory(c *gin.Context) {
+	eventID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	var req eventDto.InventoryAdjustmentRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, eventDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	viewer := event.Viewer{UserID: currentUser.UserID, Admin: currentUser.IsAdmin()}
+	adjustment, err := h.eventService.AdjustInventory(c.Request.Context(), eventID, viewer, req.Delta, req.Reason)
+	if err != nil {
+		if event.IsEventNotFoundError(err) || event.IsVenueNotFoundError(err) {
+			c.JSON(http.StatusNotFound, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		} else if event.IsUnauthorizedError(err) || event.IsQuotaExceededError(err) {
+			c.JSON(http.StatusForbidden, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		} else if event.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
+				Error:   "update_error",
+				Message: "Failed to adjust event inventory: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapInventoryAdjustmentToResponse(adjustment))
+}
+
+// ListInventoryAdjustments returns the audit trail of an event's inventory adjustments
+// @Summary List event inventory adjustments
+// @Description List the inventory adjustments of an event, newest first (only for its organizer, a co-organizer or an admin)
+// @Tags events
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} event.InventoryAdjustmentListResponse
+// @Failure 400 {object} event.ErrorResponse
+// @Failure 401 {object} event.ErrorResponse
+// @Failure 403 {object} event.ErrorResponse
+// @Failure 404 {object} event.ErrorResponse
+// @Failure 500 {object} event.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/inventory-adjustments [get]
+func (h *EventHandler) ListInventoryAdjustments(c *gin.Context) {
+	eventID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	viewer := event.Viewer{UserID: currentUser.UserID, Admin: currentUser.IsAdmin()}
+	adjustments, err := h.eventService.ListInventoryAdjustments(c.Request.Context(), eventID, viewer)
+	if err != nil {
+		if event.IsEventNotFoundError(err) {
+			c.JSON(http.StatusNotFound, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		} else if event.IsUnauthorizedError(err) {
+			c.JSON(http.StatusForbidden, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
+				Error:   "retrieval_error",
+				Message: "Failed to retrieve inventory adjustments: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	items := make([]eventDto.InventoryAdjustmentResponse, len(adjustments))
+	for i, adjustment := range adjustments {
+		items[i] = mapInventoryAdjustmentToResponse(adjustment)
+	}
+	c.JSON(http.StatusOK, eventDto.InventoryAdjustmentListResponse{ListResponse: newList(c, items)})
+}
+
+// canSeeFlagged reports whether the current user may see an event held by moderation
+func (h *EventHandler) canSeeFlagged(c *gin.Context, e *event.Event) bool {
+	currentUser, ok := auth.CurrentUser(c)
+	if !ok {
+		return false
+	}
+	if currentUser.IsAdmin() {
+		return true
+	}
+	canManage, err := h.eventService.CanManage(c.Request.Context(), e, currentUser.UserID)
+	return err == nil && canManage
+}
+
 // RegisterRoutes registers event routes with the gin router
 func (h *EventHandler) RegisterRoutes(router *gin.RouterGroup) {
 	// Create JWT middleware
@@ -512,53 +968,263 @@ func (h *EventHandler) RegisterRoutes(router *gin.RouterGroup) {
 	// Event routes group
 	eventRoutes := router.Group("/events")
 	{
+		// Static paths are registered before /:id so they can never be taken for an event ID
+		eventRoutes.GET("/my-events",
+			jwtMiddleware.AuthRequired(),
+			auth.RequireOrganizer(),
+			h.GetMyEvents)
+
 		// Public routes
-		eventRoutes.GET("", h.GetAllEvents) // Get all events
-		eventRoutes.GET("/:id", h.GetEvent) // Get event by ID
+		eventRoutes.GET("", jwtMiddleware.AuthOptional(), h.GetAllEvents)                   // List events
+		eventRoutes.GET("/:id", jwtMiddleware.AuthOptional(), UUIDParams("id"), h.GetEvent) // Get event by ID
+		eventRoutes.GET("/slug/:slug", jwtMiddleware.AuthOptional(), h.GetEventBySlug)      // Get event by slug
 
 		// Organizer routes (require ORGANIZER or ADMIN role)
-		eventRoutes.POST("",
-			jwtMiddleware.AuthRequired(),
-			auth.RequireOrganizer(),
-			h.CreateEvent)
+		createHandlers := []gin.HandlerFunc{jwtMiddleware.AuthRequired(), auth.RequireOrganizer()}
+		createHandlers = append(createHandlers, h.createChecks...)
+		eventRoutes.POST("", append(createHandlers, h.CreateEvent)...)
 
+		// Co-organizers may be plain users, so updates, cancellations and inventory adjustments check access per event
 		eventRoutes.PUT("/:id",
 			jwtMiddleware.AuthRequired(),
-			auth.RequireOrganizer(),
+			UUIDParams("id"),
 			h.UpdateEvent)
 
 		eventRoutes.PATCH("/:id/cancel",
 			jwtMiddleware.AuthRequired(),
-			auth.RequireOrganizer(),
+			UUIDParams("id"),
 			h.CancelEvent)
 
 		eventRoutes.DELETE("/:id",
 			jwtMiddleware.AuthRequired(),
 			auth.RequireOrganizer(),
+			UUIDParams("id"),
 			h.DeleteEvent)
 
-		// My events route (require authentication)
-		eventRoutes.GET("/my-events",
+		eventRoutes.POST("/:id/inventory-adjustments",
 			jwtMiddleware.AuthRequired(),
-			auth.RequireOrganizer(),
-			h.GetMyEvents)
+			UUIDParams("id"),
+			h.AdjustInventory)
+
+		eventRoutes.GET("/:id/inventory-adjustments",
+			jwtMiddleware.AuthRequired(),
+			UUIDParams("id"),
+			h.ListInventoryAdjustments)
+
+		// Admin routes
+		eventRoutes.POST("/:id/moderation/approve",
+			jwtMiddleware.AuthRequired(),
+			auth.RequireAdmin(),
+			UUIDParams("id"),
+			h.ApproveModeration)
+	}
+
+	// An organizer's public page
+	router.GET("/organizers/:id/events", UUIDParams("id"), h.GetOrganizerEvents)
+}
+
+// createShortURL generates the short URL of a newly published event
+// Failures are logged and leave the URL out; the event itself was created.
+func (h *EventHandler) createShortURL(ctx context.Context, eventID uuid.UUID) string {
+	if h.shortURLService == nil {
+		return ""
 	}
+	created, err := h.shortURLService.CreateForEvent(ctx, eventID)
+	if err != nil {
+		log.Printf("Warning: Failed to create short URL for event %s: %v", eventID, err)
+		return ""
+	}
+	return h.shortURLService.URL(created.Code)
+}
+
+// countView counts a view of an event's page towards the trending events and the organizer's analytics
+// Failures are logged; the page is served either way.
+func (h *EventHandler) countView(c *gin.Context, eventID uuid.UUID) {
+	ctx := c.Request.Context()
+	if h.trendingService != nil {
+		if err := h.trendingService.RecordView(ctx, eventID); err != nil {
+			log.Printf("Warning: Failed to count view of event %s: %v", eventID, err)
+		}
+	}
+	if h.analyticsService != nil {
+		visitor := "ip:" + c.ClientIP()
+		if currentUser, ok := auth.CurrentUser(c); ok {
+			visitor = "user:" + currentUser.UserID.String()
+		}
+		if err := h.analyticsService.RecordView(ctx, eventID, visitor); err != nil {
+			log.Printf("Warning: Failed to record visitor of event %s: %v", eventID, err)
+		}
+	}
+}
+
+// shortURLs looks up the short URLs of events, keyed by event ID
+// Failures are logged and leave the URLs out of the responses.
+func (h *EventHandler) shortURLs(ctx context.Context, events ...*event.Event) map[uuid.UUID]string {
+	if h.shortURLService == nil || len(events) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, len(events))
+	for i, e := range events {
+		ids[i] = e.ID
+	}
+	urls, err := h.shortURLService.GetURLs(ctx, ids)
+	if err != nil {
+		log.Printf("Warning: Failed to look up short URLs: %v", err)
+		return nil
+	}
+	return urls
+}
+
+// brandings looks up the branding of the events' organizers, keyed by organizer ID
+// Organizers without branding are left out; failures are logged and leave the branding out of the responses.
+func (h *EventHandler) brandings(ctx context.Context, events ...*event.Event) map[uuid.UUID]*eventDto.OrganizerBranding {
+	if h.brandingService == nil || len(events) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(events))
+	seen := make(map[uuid.UUID]bool, len(events))
+	for _, e := range events {
+		if !seen[e.OrganizerID] {
+			seen[e.OrganizerID] = true
+			ids = append(ids, e.OrganizerID)
+		}
+	}
+	brandings, err := h.brandingService.GetBrandings(ctx, ids)
+	if err != nil {
+		log.Printf("Warning: Failed to look up organizer branding: %v", err)
+		return nil
+	}
+
+	responses := make(map[uuid.UUID]*eventDto.OrganizerBranding, len(brandings))
+	for organizerID, b := range brandings {
+		if b.IsEmpty() {
+			continue
+		}
+		responses[organizerID] = &eventDto.OrganizerBranding{
+			PrimaryColor: b.PrimaryColor,
+			AccentColor:  b.AccentColor,
+			LogoURL:      h.brandingService.LogoURL(b),
+		}
+	}
+	return responses
 }
 
 // mapEventToResponse converts event entity to response DTO
-func mapEventToResponse(e *event.Event) eventDto.EventResponse {
+// The current price is the one an order placed at now would pay.
+func mapEventToResponse(e *event.Event, now time.Time) eventDto.EventResponse {
+	currentTier, _ := e.PriceSchedule.TierAt(now)
 	return eventDto.EventResponse{
 		ID:               e.ID,
 		VenueID:          e.VenueID,
+		Layout:           e.Layout,
 		OrganizerID:      e.OrganizerID,
 		Title:            e.Title,
+		Slug:             e.Slug,
 		Description:      e.Description,
 		EventDate:        e.EventDate,
 		TicketPrice:      e.TicketPrice,
 		AvailableTickets: e.AvailableTickets,
 		TotalTickets:     e.TotalTickets,
-		Status:           e.Status,
+		Status:           string(e.Status),
+		ModerationStatus: e.ModerationStatus,
+		ModerationReason: e.ModerationReason,
 		CreatedAt:        e.CreatedAt,
 		UpdatedAt:        e.UpdatedAt,
+
+		AttendeeQuestions:  mapQuestionsToResponse(e.AttendeeQuestions),
+		PriceSchedule:      mapPriceScheduleToResponse(e.PriceSchedule),
+		CurrentPrice:       e.CurrentPrice(now),
+		CurrentTier:        currentTier.Name,
+		RefundPolicy:       mapRefundPolicyToResponse(e.RefundPolicy),
+		AccessCodeRequired: e.AccessCodeRequired,
+		SaleStart:          e.SaleStart,
+		SaleEnd:            e.SaleEnd,
+		OnSale:             e.OnSale,
+		MinimumAge:         e.MinimumAge,
+		ContentWarnings:    append([]string{}, e.ContentWarnings...),
+	}
+}
+
+// mapQuestionsToDomain converts attendee questions from a request to the domain form
+func mapQuestionsToDomain(questions []eventDto.AttendeeQuestion) event.Questions {
+	result := make(event.Questions, len(questions))
+	for i, q := range questions {
+		result[i] = event.Question{
+			Key:       q.Key,
+			Label:     q.Label,
+			Type:      q.Type,
+			Required:  q.Required,
+			Options:   q.Options,
+			MaxLength: q.MaxLength,
+		}
+	}
+	return result
+}
+
+// mapQuestionsToResponse converts an event's attendee questions to response DTOs
+func mapQuestionsToResponse(questions event.Questions) []eventDto.AttendeeQuestion {
+	result := make([]eventDto.AttendeeQuestion, len(questions))
+	for i, q := range questions {
+		result[i] = eventDto.AttendeeQuestion{
+			Key:       q.Key,
+			Label:     q.Label,
+			Type:      q.Type,
+			Required:  q.Required,
+			Options:   q.Options,
+			MaxLength: q.MaxLength,
+		}
+	}
+	return result
+}
+
+// mapPriceScheduleToDomain converts price tiers from a request to the domain form
+func mapPriceScheduleToDomain(tiers []eventDto.PriceTier) event.PriceSchedule {
+	result := make(event.PriceSchedule, len(tiers))
+	for i, t := range tiers {
+		result[i] = event.PriceTier{Name: t.Name, Price: t.Price, From: t.From, To: t.To}
+	}
+	return result
+}
+
+// mapPriceScheduleToResponse converts an event's price tiers to response DTOs
+func mapPriceScheduleToResponse(schedule event.PriceSchedule) []eventDto.PriceTier {
+	result := make([]eventDto.PriceTier, len(schedule))
+	for i, t := range schedule {
+		result[i] = eventDto.PriceTier{Name: t.Name, Price: t.Price, From: t.From, To: t.To}
+	}
+	return result
+}
+
+// mapInventoryAdjustmentToResponse converts an inventory adjustment to its response DTO
+func mapInventoryAdjustmentToResponse(a *event.InventoryAdjustment) eventDto.InventoryAdjustmentResponse {
+	return eventDto.InventoryAdjustmentResponse{
+		ID:               a.ID,
+		EventID:          a.EventID,
+		ActorID:          a.ActorID,
+		Delta:            a.Delta,
+		Reason:           a.Reason,
+		TotalTickets:     a.TotalTickets,
+		AvailableTickets: a.AvailableTickets,
+		CreatedAt:        a.CreatedAt,
+	}
+}
+
+// mapRefundPolicyToDomain converts a refund policy from a request to the domain form
+func mapRefundPolicyToDomain(p eventDto.RefundPolicy) event.RefundPolicy {
+	policy := event.RefundPolicy{Type: p.Type, DaysBefore: p.DaysBefore}
+	for _, t := range p.Tiers {
+		policy.Tiers = append(policy.Tiers, event.RefundTier{DaysBefore: t.DaysBefore, Percent: t.Percent})
+	}
+	return policy
+}
+
+// mapRefundPolicyToResponse converts an event's refund policy to its response DTO
+// Events saved before policies existed are reported as FLEXIBLE.
+func mapRefundPolicyToResponse(p event.RefundPolicy) eventDto.RefundPolicy {
+	p = p.Normalize()
+	response := eventDto.RefundPolicy{Type: p.Type, DaysBefore: p.DaysBefore}
+	for _, t := range p.Tiers {
+		response.Tiers = append(response.Tiers, eventDto.RefundTier{DaysBefore: t.DaysBefore, Percent: t.Percent})
 	}
+	return response
 }
@@ -6,17 +6,23 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"enterprise-crud/internal/domain/clock"
 	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/trending"
+	"enterprise-crud/internal/dto/common"
 	eventDto "enterprise-crud/internal/dto/event"
 	"enterprise-crud/internal/infrastructure/auth"
+	"enterprise-crud/internal/infrastructure/memory"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockEventService is a mock implementation of event.Service interface
@@ -37,16 +43,32 @@ func (m *MockEventService) GetEventByID(ctx context.Context, id uuid.UUID) (*eve
 	return args.Get(0).(*event.Event), args.Error(1)
 }
 
-func (m *MockEventService) GetAllEvents(ctx context.Context) ([]*event.Event, error) {
-	args := m.Called(ctx)
+func (m *MockEventService) GetEventBySlug(ctx context.Context, slug string) (*event.Event, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetAllEvents(ctx context.Context, filter event.ListFilter, viewer event.Viewer) ([]*event.Event, error) {
+	args := m.Called(ctx, filter, viewer)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*event.Event), args.Error(1)
 }
 
-func (m *MockEventService) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID) ([]*event.Event, error) {
-	args := m.Called(ctx, organizerID)
+func (m *MockEventService) GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, filter event.OrganizerFilter) ([]*event.Event, int, error) {
+	args := m.Called(ctx, organizerID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*event.Event), args.Int(1), args.Error(2)
+}
+
+func (m *MockEventService) GetUpcomingEvents(ctx context.Context, filter event.UpcomingFilter) ([]*event.Event, error) {
+	args := m.Called(ctx, filter)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -63,6 +85,40 @@ func (m *MockEventService) CancelEvent(ctx context.Context, eventID uuid.UUID, o
 	return args.Error(0)
 }
 
+func (m *MockEventService) ApproveModeration(ctx context.Context, eventID uuid.UUID, adminID uuid.UUID) (*event.Event, error) {
+	args := m.Called(ctx, eventID, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) CanManage(ctx context.Context, e *event.Event, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, e, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockEventService) RefreshOnSale(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockEventService) AdjustInventory(ctx context.Context, eventID uuid.UUID, viewer event.Viewer, delta int, reason string) (*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer, delta, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.InventoryAdjustment), args.Error(1)
+}
+
+func (m *MockEventService) ListInventoryAdjustments(ctx context.Context, eventID uuid.UUID, viewer event.Viewer) ([]*event.InventoryAdjustment, error) {
+	args := m.Called(ctx, eventID, viewer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*event.InventoryAdjustment), args.Error(1)
+}
+
 func (m *MockEventService) DeleteEvent(ctx context.Context, eventID uuid.UUID, organizerID uuid.UUID) error {
 	args := m.Called(ctx, eventID, organizerID)
 	return args.Error(0)
@@ -181,7 +237,7 @@ func TestEventHandler_CreateEvent(t *testing.T) {
 			mockService := new(MockEventService)
 			tt.setupMocks(mockService)
 
-			handler := NewEventHandler(mockService, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+			handler := NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
 
 			// Create request
 			body, _ := json.Marshal(tt.requestBody)
@@ -216,6 +272,111 @@ func TestEventHandler_CreateEvent(t *testing.T) {
 	}
 }
 
+func TestEventHandler_CreateEvent_PriceSchedule(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockEventService)
+	handler := NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+	earlyBirdEnd := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	mockService.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e *event.Event) bool {
+		return len(e.PriceSchedule) == 1 && e.PriceSchedule[0].Price == 35 && e.PriceSchedule[0].To.Equal(earlyBirdEnd)
+	})).Return(nil)
+
+	body, _ := json.Marshal(eventDto.CreateEventRequest{
+		VenueID:       uuid.New(),
+		Title:         "Test Event",
+		EventDate:     time.Now().Add(48 * time.Hour),
+		TicketPrice:   50,
+		TotalTickets:  100,
+		PriceSchedule: []eventDto.PriceTier{{Name: "Early bird", Price: 35, To: &earlyBirdEnd}},
+	})
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/events", bytes.NewBuffer(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("user", &auth.JWTClaims{UserID: uuid.New(), Roles: []string{"ORGANIZER"}})
+
+	handler.CreateEvent(c)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	var response eventDto.EventResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 50.0, response.TicketPrice)
+	assert.Equal(t, 35.0, response.CurrentPrice)
+	assert.Equal(t, "Early bird", response.CurrentTier)
+	require.Len(t, response.PriceSchedule, 1)
+	mockService.AssertExpectations(t)
+}
+
+func TestEventHandler_GetEvent_CurrentTierFollowsClock(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockEventService)
+	now := clock.NewFake(time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC))
+	handler := NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour), WithClock(now))
+	earlyBirdEnd := now.Now().Add(24 * time.Hour)
+	foundEvent := &event.Event{
+		ID:            uuid.New(),
+		Title:         "Test Event",
+		EventDate:     now.Now().Add(30 * 24 * time.Hour),
+		TicketPrice:   50,
+		PriceSchedule: event.PriceSchedule{{Name: "Early bird", Price: 35, To: &earlyBirdEnd}},
+	}
+	mockService.On("GetEventByID", mock.Anything, foundEvent.ID).Return(foundEvent, nil)
+	get := func() eventDto.EventResponse {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/events/"+foundEvent.ID.String(), nil)
+		c.Params = gin.Params{{Key: "id", Value: foundEvent.ID.String()}}
+		handler.GetEvent(c)
+		require.Equal(t, http.StatusOK, w.Code)
+		var response eventDto.EventResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response
+	}
+
+	response := get()
+	assert.Equal(t, 35.0, response.CurrentPrice)
+	assert.Equal(t, "Early bird", response.CurrentTier)
+
+	now.Advance(24 * time.Hour)
+	response = get()
+	assert.Equal(t, 50.0, response.CurrentPrice, "the tier ends exclusively at its end")
+	assert.Empty(t, response.CurrentTier)
+}
+
+func TestEventHandler_CreateEvent_RefundPolicy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockEventService)
+	handler := NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+	mockService.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e *event.Event) bool {
+		return e.RefundPolicy.Type == "sliding" && len(e.RefundPolicy.Tiers) == 2
+	})).Return(nil)
+
+	body, _ := json.Marshal(eventDto.CreateEventRequest{
+		VenueID:      uuid.New(),
+		Title:        "Test Event",
+		EventDate:    time.Now().Add(48 * time.Hour),
+		TicketPrice:  50,
+		TotalTickets: 100,
+		RefundPolicy: &eventDto.RefundPolicy{Type: "sliding", Tiers: []eventDto.RefundTier{{DaysBefore: 7, Percent: 50}, {DaysBefore: 30, Percent: 100}}},
+	})
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/events", bytes.NewBuffer(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("user", &auth.JWTClaims{UserID: uuid.New(), Roles: []string{"ORGANIZER"}})
+
+	handler.CreateEvent(c)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	var response eventDto.EventResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "SLIDING", response.RefundPolicy.Type)
+	assert.Equal(t, []eventDto.RefundTier{{DaysBefore: 30, Percent: 100}, {DaysBefore: 7, Percent: 50}}, response.RefundPolicy.Tiers)
+	mockService.AssertExpectations(t)
+}
+
 func TestEventHandler_GetEvent(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -262,7 +423,7 @@ func TestEventHandler_GetEvent(t *testing.T) {
 			mockService := new(MockEventService)
 			tt.setupMocks(mockService)
 
-			handler := NewEventHandler(mockService, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+			handler := NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
 
 			// Create request
 			req := httptest.NewRequest(http.MethodGet, "/events/"+tt.eventID, nil)
@@ -309,7 +470,7 @@ func TestEventHandler_GetAllEvents(t *testing.T) {
 					{ID: uuid.New(), Title: "Event 1"},
 					{ID: uuid.New(), Title: "Event 2"},
 				}
-				mockService.On("GetAllEvents", mock.Anything).Return(events, nil)
+				mockService.On("GetAllEvents", mock.Anything, event.ListFilter{}, event.Viewer{}).Return(events, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  2,
@@ -317,7 +478,7 @@ func TestEventHandler_GetAllEvents(t *testing.T) {
 		{
 			name: "empty events list",
 			setupMocks: func(mockService *MockEventService) {
-				mockService.On("GetAllEvents", mock.Anything).Return([]*event.Event{}, nil)
+				mockService.On("GetAllEvents", mock.Anything, event.ListFilter{}, event.Viewer{}).Return([]*event.Event{}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  0,
@@ -325,7 +486,7 @@ func TestEventHandler_GetAllEvents(t *testing.T) {
 		{
 			name: "service error",
 			setupMocks: func(mockService *MockEventService) {
-				mockService.On("GetAllEvents", mock.Anything).Return(nil, event.ErrEventRetrievalFailed)
+				mockService.On("GetAllEvents", mock.Anything, event.ListFilter{}, event.Viewer{}).Return(nil, event.ErrEventRetrievalFailed)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -336,7 +497,7 @@ func TestEventHandler_GetAllEvents(t *testing.T) {
 			mockService := new(MockEventService)
 			tt.setupMocks(mockService)
 
-			handler := NewEventHandler(mockService, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+			handler := NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
 
 			// Create request
 			req := httptest.NewRequest(http.MethodGet, "/events", nil)
@@ -358,8 +519,10 @@ func TestEventHandler_GetAllEvents(t *testing.T) {
 				var response eventDto.EventListResponse
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
-				assert.Equal(t, tt.expectedCount, response.Count)
-				assert.Len(t, response.Events, tt.expectedCount)
+				assert.Equal(t, tt.expectedCount, response.Meta.Count)
+				assert.Len(t, response.Data, tt.expectedCount)
+				assert.Equal(t, "/events", response.Links.Self)
+				assert.Empty(t, response.Meta.NextCursor)
 			}
 
 			mockService.AssertExpectations(t)
@@ -367,6 +530,218 @@ func TestEventHandler_GetAllEvents(t *testing.T) {
 	}
 }
 
+// TestEventHandler_GetAllEvents_Filters tests that listing filters are passed on with the caller's identity
+func TestEventHandler_GetAllEvents_Filters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtService := auth.NewJWTService("test-secret", "test-issuer", time.Hour)
+	organizerID := uuid.New()
+	token, _ := jwtService.GenerateToken(organizerID, "organizer@test.com", "organizer", []string{"ORGANIZER"}, 0)
+
+	t.Run("organizer asks for their past events", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("GetAllEvents", mock.Anything,
+			event.ListFilter{Status: event.StatusCompleted, IncludePast: true},
+			event.Viewer{UserID: organizerID, Organizer: true}).Return([]*event.Event{}, nil)
+		router := gin.New()
+		NewEventHandler(mockService, nil, jwtService).RegisterRoutes(router.Group("/api/v1"))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/events?status=completed&include_past=true", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("anonymous visitor is asked to sign in", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("GetAllEvents", mock.Anything, event.ListFilter{IncludePast: true}, event.Viewer{}).Return(nil, event.ErrListingNotAllowed)
+		router := gin.New()
+		NewEventHandler(mockService, nil, jwtService).RegisterRoutes(router.Group("/api/v1"))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/events?include_past=true", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("plain user is refused", func(t *testing.T) {
+		userID := uuid.New()
+		userToken, _ := jwtService.GenerateToken(userID, "user@test.com", "user", []string{"USER"}, 0)
+		mockService := new(MockEventService)
+		mockService.On("GetAllEvents", mock.Anything, event.ListFilter{IncludePast: true}, event.Viewer{UserID: userID}).Return(nil, event.ErrListingNotAllowed)
+		router := gin.New()
+		NewEventHandler(mockService, nil, jwtService).RegisterRoutes(router.Group("/api/v1"))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/events?include_past=true", nil)
+		req.Header.Set("Authorization", "Bearer "+userToken)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("malformed include_past", func(t *testing.T) {
+		mockService := new(MockEventService)
+		router := gin.New()
+		NewEventHandler(mockService, nil, jwtService).RegisterRoutes(router.Group("/api/v1"))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/events?include_past=maybe", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetAllEvents", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestEventHandler_GetMyEvents tests the organizer's own listing with its filters and page
+func TestEventHandler_GetMyEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtService := auth.NewJWTService("test-secret", "test-issuer", time.Hour)
+	organizerID := uuid.New()
+	token, _ := jwtService.GenerateToken(organizerID, "organizer@test.com", "organizer", []string{"ORGANIZER"}, 0)
+
+	get := func(mockService *MockEventService, path string) *httptest.ResponseRecorder {
+		router := gin.New()
+		NewEventHandler(mockService, nil, jwtService).RegisterRoutes(router.Group("/api/v1"))
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("passes filters and reports the page", func(t *testing.T) {
+		from := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+		mockService := new(MockEventService)
+		mockService.On("GetEventsByOrganizer", mock.Anything, organizerID, event.OrganizerFilter{
+			Status: event.StatusCancelled,
+			From:   &from,
+			Limit:  10,
+			Offset: 20,
+		}).Return([]*event.Event{{ID: uuid.New(), Title: "Event 1"}}, 21, nil)
+
+		w := get(mockService, "/api/v1/events/my-events?status=cancelled&from=2026-11-01&limit=10&offset=20")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response eventDto.EventListResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 1, response.Meta.Count)
+		assert.Equal(t, 21, *response.Meta.Total)
+		assert.Empty(t, response.Meta.NextCursor)
+		assert.Empty(t, response.Links.Next)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("pages with cursors", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("GetEventsByOrganizer", mock.Anything, organizerID, event.OrganizerFilter{Limit: 1, Offset: 1}).
+			Return([]*event.Event{{ID: uuid.New(), Title: "Event 2"}}, 3, nil)
+
+		w := get(mockService, "/api/v1/events/my-events?limit=1&cursor="+common.Cursor{Offset: 1}.Encode())
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response eventDto.EventListResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		next := common.Cursor{Offset: 2}.Encode()
+		assert.Equal(t, next, response.Meta.NextCursor)
+		assert.Equal(t, "/api/v1/events/my-events?cursor="+next+"&limit=1", response.Links.Next)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("rejects an invalid cursor", func(t *testing.T) {
+		mockService := new(MockEventService)
+
+		w := get(mockService, "/api/v1/events/my-events?cursor=%7Bnope")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetEventsByOrganizer", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("is not taken for an event ID", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("GetEventsByOrganizer", mock.Anything, organizerID, event.OrganizerFilter{}).Return([]*event.Event{}, 0, nil)
+
+		w := get(mockService, "/api/v1/events/my-events")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"data":[]`)
+		mockService.AssertNotCalled(t, "GetEventByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects a malformed date", func(t *testing.T) {
+		mockService := new(MockEventService)
+
+		w := get(mockService, "/api/v1/events/my-events?to=next-week")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetEventsByOrganizer", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestEventHandler_GetOrganizerEvents tests the public listing of an organizer's upcoming events
+func TestEventHandler_GetOrganizerEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	organizerID := uuid.New()
+
+	get := func(mockService *MockEventService, path string) *httptest.ResponseRecorder {
+		router := gin.New()
+		NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour)).RegisterRoutes(router.Group("/api/v1"))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	t.Run("lists public events without a token", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("GetEventsByOrganizer", mock.Anything, organizerID, event.OrganizerFilter{PublicOnly: true, Limit: 1}).
+			Return([]*event.Event{{ID: uuid.New(), OrganizerID: organizerID, Title: "Event 1"}}, 2, nil)
+
+		w := get(mockService, "/api/v1/organizers/"+organizerID.String()+"/events?limit=1")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response eventDto.EventListResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 1, response.Meta.Count)
+		assert.Equal(t, 2, *response.Meta.Total)
+		assert.Equal(t, common.Cursor{Offset: 1}.Encode(), response.Meta.NextCursor)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("pages with cursors only", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("GetEventsByOrganizer", mock.Anything, organizerID, event.OrganizerFilter{PublicOnly: true, Offset: 1}).
+			Return([]*event.Event{}, 1, nil)
+
+		w := get(mockService, "/api/v1/organizers/"+organizerID.String()+"/events?offset=5&cursor="+common.Cursor{Offset: 1}.Encode())
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("rejects an invalid cursor", func(t *testing.T) {
+		mockService := new(MockEventService)
+
+		w := get(mockService, "/api/v1/organizers/"+organizerID.String()+"/events?cursor=%7Bnope")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetEventsByOrganizer", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects a malformed organizer ID", func(t *testing.T) {
+		mockService := new(MockEventService)
+
+		w := get(mockService, "/api/v1/organizers/nope/events")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid_id")
+	})
+}
+
 func TestEventHandler_CancelEvent(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -447,7 +822,7 @@ func TestEventHandler_CancelEvent(t *testing.T) {
 			mockService := new(MockEventService)
 			tt.setupMocks(mockService)
 
-			handler := NewEventHandler(mockService, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+			handler := NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
 
 			// Create request
 			req := httptest.NewRequest(http.MethodPatch, "/events/"+tt.eventID+"/cancel", nil)
@@ -481,6 +856,82 @@ func TestEventHandler_CancelEvent(t *testing.T) {
 	}
 }
 
+func TestEventHandler_AdjustInventory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	eventID := uuid.New()
+	organizerID := uuid.New()
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMocks     func(*MockEventService)
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "holds back tickets",
+			body: `{"delta": -20, "reason": "Production hold"}`,
+			setupMocks: func(mockService *MockEventService) {
+				mockService.On("AdjustInventory", mock.Anything, eventID, event.Viewer{UserID: organizerID}, -20, "Production hold").
+					Return(&event.InventoryAdjustment{ID: uuid.New(), EventID: eventID, ActorID: &organizerID, Delta: -20, Reason: "Production hold", TotalTickets: 80, AvailableTickets: 30}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "zero delta",
+			body:           `{"delta": 0, "reason": "Production hold"}`,
+			setupMocks:     func(mockService *MockEventService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "removing sold tickets",
+			body: `{"delta": -80, "reason": "Production hold"}`,
+			setupMocks: func(mockService *MockEventService) {
+				mockService.On("AdjustInventory", mock.Anything, eventID, mock.Anything, -80, "Production hold").
+					Return(nil, event.NewInvalidTicketReductionError(20, 50))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_TICKET_REDUCTION",
+		},
+		{
+			name: "event of someone else",
+			body: `{"delta": 10, "reason": "Opened the balcony"}`,
+			setupMocks: func(mockService *MockEventService) {
+				mockService.On("AdjustInventory", mock.Anything, eventID, mock.Anything, 10, "Opened the balcony").
+					Return(nil, event.NewUnauthorizedAccessError("adjust tickets of this event"))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "UNAUTHORIZED_ACCESS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockEventService)
+			tt.setupMocks(mockService)
+			handler := NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/events/"+eventID.String()+"/inventory-adjustments", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{gin.Param{Key: "id", Value: eventID.String()}}
+			c.Set("user", &auth.JWTClaims{UserID: organizerID, Roles: []string{"USER"}})
+
+			handler.AdjustInventory(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedError != "" {
+				var errorResponse eventDto.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResponse))
+				assert.Equal(t, tt.expectedError, errorResponse.Error)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
 func TestEventHandler_DeleteEvent(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -546,7 +997,7 @@ func TestEventHandler_DeleteEvent(t *testing.T) {
 			mockService := new(MockEventService)
 			tt.setupMocks(mockService)
 
-			handler := NewEventHandler(mockService, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+			handler := NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
 
 			// Create request
 			req := httptest.NewRequest(http.MethodDelete, "/events/"+tt.eventID, nil)
@@ -583,9 +1034,111 @@ func TestEventHandler_DeleteEvent(t *testing.T) {
 func TestEventHandler_NewEventHandler(t *testing.T) {
 	mockService := new(MockEventService)
 	jwtService := auth.NewJWTService("test-secret", "test-issuer", time.Hour)
-	handler := NewEventHandler(mockService, jwtService)
+	handler := NewEventHandler(mockService, nil, jwtService)
 
 	assert.NotNil(t, handler)
 	assert.Equal(t, mockService, handler.eventService)
 	assert.Equal(t, jwtService, handler.jwtService)
 }
+
+func TestEventHandler_GetEvent_IncludesShortURL(t *testing.T) {
+	mockService := new(MockEventService)
+	mockShortURLService := new(MockShortURLService)
+	eventID := uuid.New()
+	mockService.On("GetEventByID", mock.Anything, eventID).Return(&event.Event{ID: eventID, Title: "Test Event"}, nil)
+	mockShortURLService.On("GetURLs", mock.Anything, []uuid.UUID{eventID}).Return(map[uuid.UUID]string{
+		eventID: "https://tickets.example.com/e/abc1234",
+	}, nil)
+
+	handler := NewEventHandler(mockService, mockShortURLService, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/events/"+eventID.String(), nil)
+	c.Params = gin.Params{gin.Param{Key: "id", Value: eventID.String()}}
+
+	handler.GetEvent(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response eventDto.EventResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "https://tickets.example.com/e/abc1234", response.ShortURL)
+}
+
+func TestEventHandler_GetEvent_CountsView(t *testing.T) {
+	mockService := new(MockEventService)
+	eventID := uuid.New()
+	mockService.On("GetEventByID", mock.Anything, eventID).
+		Return(&event.Event{ID: eventID, Status: event.StatusActive, EventDate: time.Now().Add(24 * time.Hour)}, nil)
+	trendingService := trending.NewService(memory.NewTrendingRepository(), mockService, trending.Config{ViewWeight: 1, PurchaseWeight: 10})
+
+	handler := NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+	handler.CountViewsWith(trendingService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/events/"+eventID.String(), nil)
+	c.Params = gin.Params{gin.Param{Key: "id", Value: eventID.String()}}
+
+	handler.GetEvent(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	trends, err := trendingService.GetTrending(context.Background(), 0)
+	assert.NoError(t, err)
+	if assert.Len(t, trends, 1) {
+		assert.Equal(t, 1.0, trends[0].Views)
+	}
+}
+
+func TestEventHandler_GetEventBySlug(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	current := &event.Event{ID: uuid.New(), Title: "Winter Concert", Slug: "winter-concert-ghijkl"}
+
+	tests := []struct {
+		name             string
+		slug             string
+		found            *event.Event
+		err              error
+		expectedStatus   int
+		expectedLocation string
+	}{
+		{name: "current slug", slug: "winter-concert-ghijkl", found: current, expectedStatus: http.StatusOK},
+		{
+			name:             "old slug redirects",
+			slug:             "summer-concert-abcdef",
+			found:            current,
+			expectedStatus:   http.StatusMovedPermanently,
+			expectedLocation: "/api/v1/events/slug/winter-concert-ghijkl",
+		},
+		{name: "unknown slug", slug: "missing", err: event.NewEventSlugNotFoundError("missing"), expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockEventService)
+			if tt.err != nil {
+				mockService.On("GetEventBySlug", mock.Anything, tt.slug).Return(nil, tt.err)
+			} else {
+				mockService.On("GetEventBySlug", mock.Anything, tt.slug).Return(tt.found, nil)
+			}
+			handler := NewEventHandler(mockService, nil, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/events/slug/"+tt.slug, nil)
+			c.Params = gin.Params{gin.Param{Key: "slug", Value: tt.slug}}
+
+			handler.GetEventBySlug(c)
+			c.Writer.WriteHeaderNow()
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedLocation, w.Header().Get("Location"))
+			if tt.expectedStatus == http.StatusOK {
+				var response eventDto.EventResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, current.Slug, response.Slug)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
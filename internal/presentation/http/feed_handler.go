@@ -0,0 +1,268 @@
+package http
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/venue"
+	eventDto "enterprise-crud/internal/dto/event"
+	"enterprise-crud/internal/infrastructure/feed"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxCachedFeeds bounds the number of distinct filtered feeds kept in memory
+const maxCachedFeeds = 1000
+
+// FeedHandler serves public event feeds for calendar apps, feed readers and crawlers
+type FeedHandler struct {
+	eventService event.Service
+	venueService venue.Service
+	publicURL    string
+	maxItems     int
+	cache        *feedCache
+}
+
+// NewFeedHandler creates a new instance of FeedHandler
+func NewFeedHandler(eventService event.Service, venueService venue.Service, appCfg *config.AppConfig, feedsCfg *config.FeedsConfig, opts ...HandlerOption) *FeedHandler {
+	options := applyHandlerOptions(opts)
+	return &FeedHandler{
+		eventService: eventService,
+		venueService: venueService,
+		publicURL:    strings.TrimRight(appCfg.PublicURL, "/"),
+		maxItems:     feedsCfg.MaxItems,
+		cache:        newFeedCache(feedsCfg.CacheTTL, options.clock),
+	}
+}
+
+// ICalFeed serves upcoming events as an iCalendar feed
+// @Summary Upcoming events calendar feed
+// @Description Subscribe to upcoming active events in a calendar app, optionally filtered by venue or organizer
+// @Tags events
+// @Produce text/calendar
+// @Param venue_id query string false "Only events at this venue"
+// @Param organizer_id query string false "Only events by this organizer"
+// @Success 200 {string} string "iCalendar document"
+// @Success 304 "Not modified"
+// @Failure 400 {object} event.ErrorResponse
+// @Failure 500 {object} event.ErrorResponse
+// @Router /api/v1/events/feed.ics [get]
+func (h *FeedHandler) ICalFeed(c *gin.Context) {
+	h.serve(c, feed.ContentTypeICal, func(ch *feed.Channel) ([]byte, error) {
+		return feed.ICal(ch, h.uidDomain()), nil
+	})
+}
+
+// RSSFeed serves upcoming events as an RSS feed
+// @Summary Upcoming events RSS feed
+// @Description Follow upcoming active events in a feed reader, optionally filtered by venue or organizer
+// @Tags events
+// @Produce application/rss+xml
+// @Param venue_id query string false "Only events at this venue"
+// @Param organizer_id query string false "Only events by this organizer"
+// @Success 200 {string} string "RSS 2.0 document"
+// @Success 304 "Not modified"
+// @Failure 400 {object} event.ErrorResponse
+// @Failure 500 {object} event.ErrorResponse
+// @Router /api/v1/events/feed.rss [get]
+func (h *FeedHandler) RSSFeed(c *gin.Context) {
+	h.serve(c, feed.ContentTypeRSS, feed.RSS)
+}
+
+// Sitemap serves the public event pages as a sitemap for search engines
+// @Summary Sitemap
+// @Description Sitemap listing the pages of upcoming active events
+// @Tags events
+// @Produce application/xml
+// @Success 200 {string} string "Sitemap document"
+// @Success 304 "Not modified"
+// @Failure 500 {object} event.ErrorResponse
+// @Router /sitemap.xml [get]
+func (h *FeedHandler) Sitemap(c *gin.Context) {
+	h.serve(c, feed.ContentTypeSitemap, feed.Sitemap)
+}
+
+// RegisterRoutes registers feed routes with the gin router
+// Feeds are public; they are registered next to the event routes.
+func (h *FeedHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/events/feed.ics", h.ICalFeed)
+	router.GET("/events/feed.rss", h.RSSFeed)
+}
+
+// serve renders a feed with encode, reusing cached output and honoring If-None-Match
+func (h *FeedHandler) serve(c *gin.Context, contentType string, encode func(*feed.Channel) ([]byte, error)) {
+	filter, err := h.parseFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, eventDto.ErrorResponse{
+			Error:   "invalid_filter",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	key := c.FullPath() + "?" + c.Request.URL.Query().Encode()
+	entry, ok := h.cache.get(key)
+	if !ok {
+		channel, err := h.buildChannel(c, filter)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: "Failed to build feed: " + err.Error(),
+			})
+			return
+		}
+
+		body, err := encode(channel)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
+				Error:   "feed_error",
+				Message: "Failed to encode feed: " + err.Error(),
+			})
+			return
+		}
+
+		sum := sha256.Sum256(body)
+		entry = feedEntry{body: body, etag: `"` + hex.EncodeToString(sum[:16]) + `"`}
+		h.cache.set(key, entry)
+	}
+
+	c.Header("ETag", entry.etag)
+	c.Header("Cache-Control", "public, max-age="+strconv.Itoa(int(h.cache.ttl.Seconds())))
+	if match := c.GetHeader("If-None-Match"); match != "" && strings.Contains(match, entry.etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, entry.body)
+}
+
+// parseFilter reads the optional venue and organizer filters
+func (h *FeedHandler) parseFilter(c *gin.Context) (event.UpcomingFilter, error) {
+	filter := event.UpcomingFilter{Limit: h.maxItems}
+	if raw := c.Query("venue_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return filter, errors.New("venue_id must be a valid UUID")
+		}
+		filter.VenueID = &id
+	}
+	if raw := c.Query("organizer_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return filter, errors.New("organizer_id must be a valid UUID")
+		}
+		filter.OrganizerID = &id
+	}
+	return filter, nil
+}
+
+// buildChannel loads upcoming events and their venues into a feed channel
+func (h *FeedHandler) buildChannel(c *gin.Context, filter event.UpcomingFilter) (*feed.Channel, error) {
+	events, err := h.eventService.GetUpcomingEvents(c.Request.Context(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	venues, err := h.venueService.GetAllVenues(c.Request.Context())
+	if err != nil {
+		return nil, err
+	}
+	locations := make(map[uuid.UUID]string, len(venues))
+	for _, v := range venues {
+		locations[v.ID] = v.Name + ", " + v.Address
+	}
+
+	channel := &feed.Channel{
+		Title:       "Upcoming events",
+		Description: "Upcoming events open for ticket sales",
+		Link:        h.publicURL + "/events",
+		Items:       make([]feed.Item, len(events)),
+	}
+	for i, e := range events {
+		channel.Items[i] = feed.Item{
+			ID:          e.ID.String(),
+			Title:       e.Title,
+			Description: e.Description,
+			Location:    locations[e.VenueID],
+			URL:         h.publicURL + "/events/" + e.ID.String(),
+			Start:       e.EventDate,
+			Updated:     e.UpdatedAt,
+		}
+		if e.UpdatedAt.After(channel.Updated) {
+			channel.Updated = e.UpdatedAt
+		}
+	}
+	return channel, nil
+}
+
+// uidDomain returns the host used to make iCalendar UIDs unique
+func (h *FeedHandler) uidDomain() string {
+	if u, err := url.Parse(h.publicURL); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return "enterprise-crud"
+}
+
+// feedEntry is a rendered feed
+type feedEntry struct {
+	body    []byte
+	etag    string
+	expires time.Time
+}
+
+// feedCache keeps rendered feeds in memory for a short time
+type feedCache struct {
+	ttl     time.Duration
+	clock   clock.Clock
+	mu      sync.Mutex
+	entries map[string]feedEntry
+}
+
+func newFeedCache(ttl time.Duration, c clock.Clock) *feedCache {
+	return &feedCache{ttl: ttl, clock: c, entries: make(map[string]feedEntry)}
+}
+
+func (fc *feedCache) get(key string) (feedEntry, bool) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	entry, ok := fc.entries[key]
+	if !ok || fc.clock.Now().After(entry.expires) {
+		return feedEntry{}, false
+	}
+	return entry, true
+}
+
+func (fc *feedCache) set(key string, entry feedEntry) {
+	if fc.ttl <= 0 {
+		return
+	}
+
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	now := fc.clock.Now()
+	if len(fc.entries) >= maxCachedFeeds {
+		for k, e := range fc.entries {
+			if now.After(e.expires) {
+				delete(fc.entries, k)
+			}
+		}
+		if len(fc.entries) >= maxCachedFeeds {
+			return
+		}
+	}
+
+	entry.expires = now.Add(fc.ttl)
+	fc.entries[key] = entry
+}
@@ -0,0 +1,189 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/venue"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockVenueService is a mock implementation of venue.Service interface
+type MockVenueService struct {
+	mock.Mock
+}
+
+func (m *MockVenueService) CreateVenue(ctx context.Context, v *venue.Venue) error {
+	args := m.Called(ctx, v)
+	return args.Error(0)
+}
+
+func (m *MockVenueService) GetVenueByID(ctx context.Context, id uuid.UUID) (*venue.Venue, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*venue.Venue), args.Error(1)
+}
+
+func (m *MockVenueService) GetAllVenues(ctx context.Context) ([]*venue.Venue, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*venue.Venue), args.Error(1)
+}
+
+func (m *MockVenueService) UpdateVenue(ctx context.Context, actor venue.Actor, v *venue.Venue) error {
+	args := m.Called(ctx, actor, v)
+	return args.Error(0)
+}
+
+func (m *MockVenueService) DeleteVenue(ctx context.Context, actor venue.Actor, id uuid.UUID) error {
+	args := m.Called(ctx, actor, id)
+	return args.Error(0)
+}
+
+func (m *MockVenueService) TransferOwnership(ctx context.Context, actor venue.Actor, id, newOwnerID uuid.UUID) (*venue.Venue, error) {
+	args := m.Called(ctx, actor, id, newOwnerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*venue.Venue), args.Error(1)
+}
+
+func setupFeedRouter(eventService *MockEventService, venueService *MockVenueService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewFeedHandler(eventService, venueService,
+		&config.AppConfig{PublicURL: "https://tickets.example.com/"},
+		&config.FeedsConfig{CacheTTL: time.Minute, MaxItems: 50})
+
+	router := gin.New()
+	router.GET("/sitemap.xml", handler.Sitemap)
+	handler.RegisterRoutes(router.Group("/api/v1"))
+	return router
+}
+
+func TestFeedHandler_Feeds(t *testing.T) {
+	venueID := uuid.New()
+	upcoming := []*event.Event{{
+		ID:        uuid.New(),
+		VenueID:   venueID,
+		Title:     "Jazz Night",
+		EventDate: time.Now().Add(24 * time.Hour),
+		UpdatedAt: time.Now(),
+	}}
+
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		contains    string
+	}{
+		{"iCal feed", "/api/v1/events/feed.ics", "text/calendar; charset=utf-8", "SUMMARY:Jazz Night"},
+		{"RSS feed", "/api/v1/events/feed.rss", "application/rss+xml; charset=utf-8", "<title>Jazz Night</title>"},
+		{"sitemap", "/sitemap.xml", "application/xml; charset=utf-8", "<loc>https://tickets.example.com/events/" + upcoming[0].ID.String() + "</loc>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventService := new(MockEventService)
+			venueService := new(MockVenueService)
+			eventService.On("GetUpcomingEvents", mock.Anything, event.UpcomingFilter{Limit: 50}).Return(upcoming, nil)
+			venueService.On("GetAllVenues", mock.Anything).Return([]*venue.Venue{{ID: venueID, Name: "Main Hall", Address: "1 Music Road"}}, nil)
+
+			router := setupFeedRouter(eventService, venueService)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.contentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, "public, max-age=60", w.Header().Get("Cache-Control"))
+			assert.Contains(t, w.Body.String(), tt.contains)
+		})
+	}
+}
+
+func TestFeedHandler_CachingAndConditionalRequests(t *testing.T) {
+	eventService := new(MockEventService)
+	venueService := new(MockVenueService)
+	eventService.On("GetUpcomingEvents", mock.Anything, mock.Anything).Return([]*event.Event{}, nil).Once()
+	venueService.On("GetAllVenues", mock.Anything).Return([]*venue.Venue{}, nil).Once()
+
+	router := setupFeedRouter(eventService, venueService)
+
+	first := httptest.NewRecorder()
+	router.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/api/v1/events/feed.ics", nil))
+	etag := first.Header().Get("ETag")
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.NotEmpty(t, etag)
+
+	// A second request is served from the cache and honors If-None-Match
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/events/feed.ics", nil)
+	req.Header.Set("If-None-Match", etag)
+	second := httptest.NewRecorder()
+	router.ServeHTTP(second, req)
+
+	assert.Equal(t, http.StatusNotModified, second.Code)
+	assert.Empty(t, second.Body.String())
+	eventService.AssertExpectations(t)
+}
+
+func TestFeedHandler_CacheExpires(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	eventService := new(MockEventService)
+	venueService := new(MockVenueService)
+	eventService.On("GetUpcomingEvents", mock.Anything, mock.Anything).Return([]*event.Event{}, nil).Twice()
+	venueService.On("GetAllVenues", mock.Anything).Return([]*venue.Venue{}, nil).Twice()
+	now := clock.NewFake(time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC))
+	handler := NewFeedHandler(eventService, venueService,
+		&config.AppConfig{PublicURL: "https://tickets.example.com"},
+		&config.FeedsConfig{CacheTTL: time.Minute, MaxItems: 50}, WithClock(now))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+	get := func() {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/events/feed.ics", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+
+	get()
+	now.Advance(59 * time.Second)
+	get() // Served from the cache
+	now.Advance(2 * time.Second)
+	get() // Rendered again once the cached feed expired
+
+	eventService.AssertExpectations(t)
+	venueService.AssertExpectations(t)
+}
+
+func TestFeedHandler_Filters(t *testing.T) {
+	organizerID := uuid.New()
+	eventService := new(MockEventService)
+	venueService := new(MockVenueService)
+	eventService.On("GetUpcomingEvents", mock.Anything, event.UpcomingFilter{OrganizerID: &organizerID, Limit: 50}).Return([]*event.Event{}, nil)
+	venueService.On("GetAllVenues", mock.Anything).Return([]*venue.Venue{}, nil)
+
+	router := setupFeedRouter(eventService, venueService)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/events/feed.rss?organizer_id="+organizerID.String(), nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	eventService.AssertExpectations(t)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/events/feed.rss?venue_id=nope", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "venue_id must be a valid UUID")
+}
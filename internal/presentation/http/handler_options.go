@@ -0,0 +1,29 @@
+package http
+
+import (
+	"enterprise-crud/internal/domain/clock"
+)
+
+// handlerOptions holds the optional dependencies handlers share
+type handlerOptions struct {
+	clock clock.Clock // Tells handlers the current time, e.g. for price tiers, default periods and token expiry
+}
+
+// HandlerOption customises a handler when it is created
+type HandlerOption func(*handlerOptions)
+
+// WithClock reads the current time from c instead of the system clock
+func WithClock(c clock.Clock) HandlerOption {
+	return func(o *handlerOptions) {
+		o.clock = c
+	}
+}
+
+// applyHandlerOptions returns the defaults overridden by opts
+func applyHandlerOptions(opts []HandlerOption) handlerOptions {
+	options := handlerOptions{clock: clock.System{}}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
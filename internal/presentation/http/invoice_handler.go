@@ -0,0 +1,115 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"enterprise-crud/internal/domain/invoice"
+	invoiceDto "enterprise-crud/internal/dto/invoice"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// invoiceRetryAfter is how long clients are asked to wait while an invoice is generated
+const invoiceRetryAfter = 5
+
+// InvoiceHandler handles HTTP requests for order invoices
+type InvoiceHandler struct {
+	invoiceService invoice.Service
+	jwtService     *auth.JWTService
+}
+
+// NewInvoiceHandler creates a new instance of InvoiceHandler
+func NewInvoiceHandler(invoiceService invoice.Service, jwtService *auth.JWTService) *InvoiceHandler {
+	return &InvoiceHandler{
+		invoiceService: invoiceService,
+		jwtService:     jwtService,
+	}
+}
+
+// GetInvoice returns the invoice of an order
+// @Summary Get order invoice
+// @Description Download the invoice of a completed order as print-ready HTML (own orders only, unless admin).
+// @Description The first request assigns a sequential invoice number and queues generation, answering 202 with Retry-After; poll until 200.
+// @Tags orders
+// @Produce html
+// @Param id path string true "Order ID"
+// @Success 200 {string} string "Invoice document"
+// @Success 202 {object} invoiceDto.PendingResponse
+// @Failure 400 {object} invoiceDto.ErrorResponse
+// @Failure 401 {object} invoiceDto.ErrorResponse
+// @Failure 403 {object} invoiceDto.ErrorResponse
+// @Failure 404 {object} invoiceDto.ErrorResponse
+// @Failure 409 {object} invoiceDto.ErrorResponse
+// @Failure 500 {object} invoiceDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/orders/{id}/invoice [get]
+func (h *InvoiceHandler) GetInvoice(c *gin.Context) {
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, invoiceDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid order ID format",
+		})
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	doc, err := h.invoiceService.GetInvoice(c.Request.Context(), currentUser.UserID, currentUser.IsAdmin(), orderID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	if doc == nil {
+		c.Header("Retry-After", strconv.Itoa(invoiceRetryAfter))
+		c.JSON(http.StatusAccepted, invoiceDto.PendingResponse{
+			Status:  "PENDING",
+			Message: "Invoice is being generated, retry shortly",
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="%s.html"`, doc.Number))
+	c.Header("Cache-Control", "private, max-age=3600")
+	c.Data(http.StatusOK, doc.ContentType, doc.Body)
+}
+
+// RegisterRoutes registers invoice routes with the gin router
+func (h *InvoiceHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	router.GET("/orders/:id/invoice",
+		jwtMiddleware.AuthRequired(),
+		auth.RequireUser(),
+		h.GetInvoice)
+}
+
+// respondError maps invoice errors to HTTP responses
+func (h *InvoiceHandler) respondError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case invoice.IsOrderNotFoundError(err):
+		status = http.StatusNotFound
+	case invoice.IsForbiddenError(err):
+		status = http.StatusForbidden
+	case invoice.IsOrderNotCompletedError(err):
+		status = http.StatusConflict
+	}
+
+	code := invoice.GetInvoiceErrorCode(err)
+	if code == "" {
+		code = "invoice_error"
+	}
+	c.JSON(status, invoiceDto.ErrorResponse{
+		Error:   code,
+		Message: err.Error(),
+	})
+}
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/invoice"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockInvoiceService is a mock implementation of invoice.Service interface
+type MockInvoiceService struct {
+	mock.Mock
+}
+
+func (m *MockInvoiceService) GetInvoice(ctx context.Context, requesterID uuid.UUID, isAdmin bool, orderID uuid.UUID) (*invoice.Document, error) {
+	args := m.Called(ctx, requesterID, isAdmin, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*invoice.Document), args.Error(1)
+}
+
+func (m *MockInvoiceService) PrepareInvoice(ctx context.Context, orderID uuid.UUID) (*invoice.Invoice, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*invoice.Invoice), args.Error(1)
+}
+
+func (m *MockInvoiceService) SaveDocument(ctx context.Context, orderID uuid.UUID, body []byte) error {
+	args := m.Called(ctx, orderID, body)
+	return args.Error(0)
+}
+
+func TestInvoiceHandler_GetInvoice(t *testing.T) {
+	userID := uuid.New()
+	orderID := uuid.New()
+	params := gin.Params{{Key: "id", Value: orderID.String()}}
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*MockInvoiceService)
+		expectedStatus int
+		expectedBody   string
+		expectedHeader map[string]string
+	}{
+		{
+			name: "stored invoice",
+			setupMocks: func(m *MockInvoiceService) {
+				m.On("GetInvoice", mock.Anything, userID, false, orderID).
+					Return(&invoice.Document{Number: "INV-000042", ContentType: invoice.ContentType, Body: []byte("<html>invoice</html>")}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "<html>invoice</html>",
+			expectedHeader: map[string]string{
+				"Content-Type":        invoice.ContentType,
+				"Content-Disposition": `inline; filename="INV-000042.html"`,
+			},
+		},
+		{
+			name: "generation pending",
+			setupMocks: func(m *MockInvoiceService) {
+				m.On("GetInvoice", mock.Anything, userID, false, orderID).Return(nil, nil)
+			},
+			expectedStatus: http.StatusAccepted,
+			expectedBody:   "PENDING",
+			expectedHeader: map[string]string{"Retry-After": "5"},
+		},
+		{
+			name: "someone else's order",
+			setupMocks: func(m *MockInvoiceService) {
+				m.On("GetInvoice", mock.Anything, userID, false, orderID).Return(nil, invoice.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "FORBIDDEN",
+		},
+		{
+			name: "order not completed",
+			setupMocks: func(m *MockInvoiceService) {
+				m.On("GetInvoice", mock.Anything, userID, false, orderID).Return(nil, invoice.ErrOrderNotCompleted)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "ORDER_NOT_COMPLETED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockInvoiceService)
+			tt.setupMocks(service)
+			handler := NewInvoiceHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			c, w := userTestContext(http.MethodGet, "/orders/"+orderID.String()+"/invoice", nil, userID, params)
+			handler.GetInvoice(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			for header, value := range tt.expectedHeader {
+				assert.Equal(t, value, w.Header().Get(header))
+			}
+			service.AssertExpectations(t)
+		})
+	}
+}
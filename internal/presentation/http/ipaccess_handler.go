@@ -0,0 +1,170 @@
+package http
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/ipaccess"
+	ipAccessDto "enterprise-crud/internal/dto/ipaccess"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// IPAccessHandler handles HTTP requests for admin IP rules
+type IPAccessHandler struct {
+	ipAccessService ipaccess.Service
+	jwtService      *auth.JWTService
+}
+
+// NewIPAccessHandler creates a new instance of IPAccessHandler
+func NewIPAccessHandler(ipAccessService ipaccess.Service, jwtService *auth.JWTService) *IPAccessHandler {
+	return &IPAccessHandler{
+		ipAccessService: ipAccessService,
+		jwtService:      jwtService,
+	}
+}
+
+// ListRules lists the admin IP rules managed through the API
+// @Summary List admin IP rules
+// @Description List the address ranges allowed or denied access to admin routes (requires ADMIN role). Ranges from configuration are not listed.
+// @Tags admin
+// @Produce json
+// @Success 200 {object} ipAccessDto.RuleListResponse
+// @Failure 401 {object} ipAccessDto.ErrorResponse
+// @Failure 403 {object} ipAccessDto.ErrorResponse
+// @Failure 500 {object} ipAccessDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/ip-rules [get]
+func (h *IPAccessHandler) ListRules(c *gin.Context) {
+	rules, err := h.ipAccessService.ListRules(c.Request.Context())
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := ipAccessDto.RuleListResponse{Rules: make([]ipAccessDto.RuleResponse, len(rules))}
+	for i, rule := range rules {
+		response.Rules[i] = mapRuleToResponse(rule)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateRule adds an admin IP rule
+// @Summary Add admin IP rule
+// @Description Allow or deny a CIDR range or single address access to admin routes (requires ADMIN role).
+// @Description Deny rules win; once any allow rule exists only matching addresses get through. Changes that would block your own address are rejected.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param rule body ipAccessDto.CreateRuleRequest true "Rule"
+// @Success 201 {object} ipAccessDto.RuleResponse
+// @Failure 400 {object} ipAccessDto.ErrorResponse
+// @Failure 401 {object} ipAccessDto.ErrorResponse
+// @Failure 403 {object} ipAccessDto.ErrorResponse
+// @Failure 409 {object} ipAccessDto.ErrorResponse
+// @Failure 500 {object} ipAccessDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/ip-rules [post]
+func (h *IPAccessHandler) CreateRule(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req ipAccessDto.CreateRuleRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, ipAccessDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	rule, err := h.ipAccessService.AddRule(c.Request.Context(), req.CIDR, req.Action, req.Description, currentUser.UserID, c.ClientIP())
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapRuleToResponse(rule))
+}
+
+// DeleteRule removes an admin IP rule
+// @Summary Delete admin IP rule
+// @Description Remove an admin IP rule (requires ADMIN role). Changes that would block your own address are rejected.
+// @Tags admin
+// @Param id path string true "Rule ID"
+// @Success 204
+// @Failure 400 {object} ipAccessDto.ErrorResponse
+// @Failure 401 {object} ipAccessDto.ErrorResponse
+// @Failure 403 {object} ipAccessDto.ErrorResponse
+// @Failure 404 {object} ipAccessDto.ErrorResponse
+// @Failure 409 {object} ipAccessDto.ErrorResponse
+// @Failure 500 {object} ipAccessDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/ip-rules/{id} [delete]
+func (h *IPAccessHandler) DeleteRule(c *gin.Context) {
+	ruleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ipAccessDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid rule ID format",
+		})
+		return
+	}
+
+	if err := h.ipAccessService.DeleteRule(c.Request.Context(), ruleID, c.ClientIP()); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RegisterRoutes registers admin IP rule routes with the gin router
+func (h *IPAccessHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	// Admin routes (require ADMIN role)
+	adminRoutes := router.Group("/admin/ip-rules", jwtMiddleware.AuthRequired(), auth.RequireAdmin())
+	{
+		adminRoutes.GET("", h.ListRules)
+		adminRoutes.POST("", h.CreateRule)
+		adminRoutes.DELETE("/:id", h.DeleteRule)
+	}
+}
+
+// respondError maps IP access errors to HTTP responses
+func (h *IPAccessHandler) respondError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case ipaccess.IsValidationError(err):
+		status = http.StatusBadRequest
+	case ipaccess.IsRuleNotFoundError(err):
+		status = http.StatusNotFound
+	case ipaccess.IsRuleExistsError(err), ipaccess.IsSelfLockoutError(err):
+		status = http.StatusConflict
+	}
+
+	code := ipaccess.GetIPAccessErrorCode(err)
+	if code == "" {
+		code = "ip_rule_error"
+	}
+	c.JSON(status, ipAccessDto.ErrorResponse{
+		Error:   code,
+		Message: err.Error(),
+	})
+}
+
+// mapRuleToResponse converts an admin IP rule to response DTO
+func mapRuleToResponse(rule *ipaccess.Rule) ipAccessDto.RuleResponse {
+	return ipAccessDto.RuleResponse{
+		ID:          rule.ID,
+		CIDR:        rule.CIDR,
+		Action:      rule.Action,
+		Description: rule.Description,
+		CreatedBy:   rule.CreatedBy,
+		CreatedAt:   rule.CreatedAt,
+	}
+}
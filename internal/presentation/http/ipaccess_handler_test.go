@@ -0,0 +1,141 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/ipaccess"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockIPAccessService is a mock implementation of ipaccess.Service interface
+type MockIPAccessService struct {
+	mock.Mock
+}
+
+func (m *MockIPAccessService) ListRules(ctx context.Context) ([]*ipaccess.Rule, error) {
+	args := m.Called(ctx)
+	rules, _ := args.Get(0).([]*ipaccess.Rule)
+	return rules, args.Error(1)
+}
+
+func (m *MockIPAccessService) AddRule(ctx context.Context, cidr, action, description string, createdBy uuid.UUID, callerIP string) (*ipaccess.Rule, error) {
+	args := m.Called(ctx, cidr, action, description, createdBy, callerIP)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ipaccess.Rule), args.Error(1)
+}
+
+func (m *MockIPAccessService) DeleteRule(ctx context.Context, id uuid.UUID, callerIP string) error {
+	args := m.Called(ctx, id, callerIP)
+	return args.Error(0)
+}
+
+func (m *MockIPAccessService) IsAllowed(ctx context.Context, ip string) (bool, error) {
+	args := m.Called(ctx, ip)
+	return args.Bool(0), args.Error(1)
+}
+
+func newTestIPAccessHandler(service *MockIPAccessService) *IPAccessHandler {
+	return NewIPAccessHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+}
+
+// testClientIP is the address httptest.NewRequest gives every request
+const testClientIP = "192.0.2.1"
+
+func TestIPAccessHandler_CreateRule(t *testing.T) {
+	adminID := uuid.New()
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func(*MockIPAccessService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "adds rule",
+			body: map[string]string{"cidr": "203.0.113.0/24", "action": ipaccess.ActionAllow, "description": "Office"},
+			setupMocks: func(m *MockIPAccessService) {
+				m.On("AddRule", mock.Anything, "203.0.113.0/24", ipaccess.ActionAllow, "Office", adminID, testClientIP).
+					Return(&ipaccess.Rule{ID: uuid.New(), CIDR: "203.0.113.0/24", Action: ipaccess.ActionAllow, Description: "Office", CreatedBy: &adminID}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"cidr":"203.0.113.0/24"`,
+		},
+		{
+			name:           "unknown action",
+			body:           map[string]string{"cidr": "203.0.113.0/24", "action": "MAYBE"},
+			setupMocks:     func(m *MockIPAccessService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid range",
+			body: map[string]string{"cidr": "203.0.113.0/33", "action": ipaccess.ActionDeny},
+			setupMocks: func(m *MockIPAccessService) {
+				m.On("AddRule", mock.Anything, "203.0.113.0/33", ipaccess.ActionDeny, "", adminID, testClientIP).
+					Return(nil, ipaccess.ErrInvalidCIDR)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_CIDR",
+		},
+		{
+			name: "rule would lock the caller out",
+			body: map[string]string{"cidr": testClientIP, "action": ipaccess.ActionDeny},
+			setupMocks: func(m *MockIPAccessService) {
+				m.On("AddRule", mock.Anything, testClientIP, ipaccess.ActionDeny, "", adminID, testClientIP).
+					Return(nil, ipaccess.ErrSelfLockout)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "IP_RULE_LOCKOUT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockIPAccessService)
+			tt.setupMocks(service)
+
+			c, w := userTestContext(http.MethodPost, "/admin/ip-rules", tt.body, adminID, nil)
+			newTestIPAccessHandler(service).CreateRule(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestIPAccessHandler_DeleteRule(t *testing.T) {
+	ruleID := uuid.New()
+	params := gin.Params{{Key: "id", Value: ruleID.String()}}
+
+	t.Run("deletes rule", func(t *testing.T) {
+		service := new(MockIPAccessService)
+		service.On("DeleteRule", mock.Anything, ruleID, testClientIP).Return(nil)
+
+		c, w := userTestContext(http.MethodDelete, "/admin/ip-rules/"+ruleID.String(), nil, uuid.New(), params)
+		newTestIPAccessHandler(service).DeleteRule(c)
+		c.Writer.WriteHeaderNow()
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("unknown rule", func(t *testing.T) {
+		service := new(MockIPAccessService)
+		service.On("DeleteRule", mock.Anything, ruleID, testClientIP).Return(ipaccess.ErrRuleNotFound)
+
+		c, w := userTestContext(http.MethodDelete, "/admin/ip-rules/"+ruleID.String(), nil, uuid.New(), params)
+		newTestIPAccessHandler(service).DeleteRule(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
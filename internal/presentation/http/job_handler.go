@@ -0,0 +1,179 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"enterprise-crud/internal/domain/job"
+	jobDto "enterprise-crud/internal/dto/job"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// JobHandler handles HTTP requests for inspecting and retrying background jobs
+type JobHandler struct {
+	jobService job.Service
+	jwtService *auth.JWTService
+}
+
+// NewJobHandler creates a new instance of JobHandler
+func NewJobHandler(jobService job.Service, jwtService *auth.JWTService) *JobHandler {
+	return &JobHandler{
+		jobService: jobService,
+		jwtService: jwtService,
+	}
+}
+
+// ListJobs lists background jobs by status
+// @Summary List jobs
+// @Description List background jobs with the given status, most recently updated first (requires ADMIN role)
+// @Tags admin
+// @Produce json
+// @Param status query string false "Job status (PENDING, RUNNING, SUCCEEDED, FAILED)" default(FAILED)
+// @Param limit query int false "Maximum number of jobs (1-500)" default(100)
+// @Success 200 {object} jobDto.JobListResponse
+// @Failure 400 {object} jobDto.ErrorResponse
+// @Failure 401 {object} jobDto.ErrorResponse
+// @Failure 403 {object} jobDto.ErrorResponse
+// @Failure 500 {object} jobDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/jobs [get]
+func (h *JobHandler) ListJobs(c *gin.Context) {
+	status := strings.ToUpper(c.DefaultQuery("status", job.StatusFailed))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "100"))
+
+	jobs, err := h.jobService.ListJobs(c.Request.Context(), status, limit)
+	if err != nil {
+		h.respondError(c, err, "Failed to list jobs: ")
+		return
+	}
+
+	response := jobDto.JobListResponse{
+		Jobs:  make([]jobDto.JobResponse, len(jobs)),
+		Count: len(jobs),
+	}
+	for i, j := range jobs {
+		response.Jobs[i] = mapJobToResponse(j)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetJob retrieves a background job by ID
+// @Summary Get job by ID
+// @Description Get background job details including the last error (requires ADMIN role)
+// @Tags admin
+// @Produce json
+// @Param id path string true "Job ID"
+// @Success 200 {object} jobDto.JobResponse
+// @Failure 400 {object} jobDto.ErrorResponse
+// @Failure 401 {object} jobDto.ErrorResponse
+// @Failure 403 {object} jobDto.ErrorResponse
+// @Failure 404 {object} jobDto.ErrorResponse
+// @Failure 500 {object} jobDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/jobs/{id} [get]
+func (h *JobHandler) GetJob(c *gin.Context) {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, jobDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid job ID format",
+		})
+		return
+	}
+
+	j, err := h.jobService.GetJob(c.Request.Context(), jobID)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve job: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapJobToResponse(j))
+}
+
+// RetryJob puts a failed job back in the queue
+// @Summary Retry failed job
+// @Description Move a failed (dead-lettered) job back to the queue with a fresh set of attempts (requires ADMIN role)
+// @Tags admin
+// @Produce json
+// @Param id path string true "Job ID"
+// @Success 200 {object} jobDto.JobResponse
+// @Failure 400 {object} jobDto.ErrorResponse
+// @Failure 401 {object} jobDto.ErrorResponse
+// @Failure 403 {object} jobDto.ErrorResponse
+// @Failure 404 {object} jobDto.ErrorResponse
+// @Failure 500 {object} jobDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/jobs/{id}/retry [post]
+func (h *JobHandler) RetryJob(c *gin.Context) {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, jobDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid job ID format",
+		})
+		return
+	}
+
+	j, err := h.jobService.RetryJob(c.Request.Context(), jobID)
+	if err != nil {
+		h.respondError(c, err, "Failed to retry job: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapJobToResponse(j))
+}
+
+// RegisterRoutes registers job administration routes with the gin router
+func (h *JobHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	// Admin routes (require ADMIN role)
+	adminRoutes := router.Group("/admin/jobs", jwtMiddleware.AuthRequired(), auth.RequireAdmin())
+	{
+		adminRoutes.GET("", h.ListJobs)
+		adminRoutes.GET("/:id", h.GetJob)
+		adminRoutes.POST("/:id/retry", h.RetryJob)
+	}
+}
+
+// respondError maps job errors to HTTP responses
+func (h *JobHandler) respondError(c *gin.Context, err error, prefix string) {
+	switch {
+	case job.IsJobNotFoundError(err):
+		c.JSON(http.StatusNotFound, jobDto.ErrorResponse{
+			Error:   job.GetJobErrorCode(err),
+			Message: err.Error(),
+		})
+	case job.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, jobDto.ErrorResponse{
+			Error:   job.GetJobErrorCode(err),
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, jobDto.ErrorResponse{
+			Error:   "job_error",
+			Message: prefix + err.Error(),
+		})
+	}
+}
+
+// mapJobToResponse converts a job domain entity to response DTO
+func mapJobToResponse(j *job.Job) jobDto.JobResponse {
+	return jobDto.JobResponse{
+		ID:          j.ID,
+		Type:        j.Type,
+		Payload:     j.Payload,
+		Status:      j.Status,
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		RunAt:       j.RunAt,
+		LastError:   j.LastError,
+		CreatedAt:   j.CreatedAt,
+		UpdatedAt:   j.UpdatedAt,
+	}
+}
@@ -0,0 +1,14 @@
+package http
+
+import (
+	"enterprise-crud/internal/deprecation"
+	"enterprise-crud/internal/dto/common"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newList wraps the items of a list in the list envelope
+// The envelope links the request's URL and warns about the deprecated features the request used.
+func newList[T any](c *gin.Context, data []T) common.ListResponse[T] {
+	return common.NewListResponse(data, c.Request.URL).WithWarnings(deprecation.Warnings(c.Request.Context()))
+}
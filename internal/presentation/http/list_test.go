@@ -0,0 +1,24 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"enterprise-crud/internal/deprecation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewList_RepeatsDeprecationWarnings(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/events/my-events?offset=10", nil)
+	c.Request = c.Request.WithContext(deprecation.WithWarnings(c.Request.Context(), []string{"offset is deprecated"}))
+
+	list := newList(c, []string{"a"})
+
+	assert.Equal(t, []string{"offset is deprecated"}, list.Meta.Warnings)
+	assert.Equal(t, "/api/v1/events/my-events?offset=10", list.Links.Self)
+}
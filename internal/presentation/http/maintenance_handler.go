@@ -0,0 +1,234 @@
+package http
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/maintenance"
+	maintenanceDto "enterprise-crud/internal/dto/maintenance"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MaintenanceHandler handles HTTP requests for maintenance mode
+type MaintenanceHandler struct {
+	maintenanceService maintenance.Service
+	jwtService         *auth.JWTService
+}
+
+// NewMaintenanceHandler creates a new instance of MaintenanceHandler
+func NewMaintenanceHandler(maintenanceService maintenance.Service, jwtService *auth.JWTService) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		maintenanceService: maintenanceService,
+		jwtService:         jwtService,
+	}
+}
+
+// GetStatus reports whether writes are currently rejected for maintenance
+// @Summary Get maintenance status
+// @Description Report whether maintenance mode is in force, and the current or upcoming maintenance window. While it is, writes answer 503 with Retry-After; reads keep working.
+// @Tags maintenance
+// @Produce json
+// @Success 200 {object} maintenanceDto.StatusResponse
+// @Failure 500 {object} maintenanceDto.ErrorResponse
+// @Router /api/v1/maintenance [get]
+func (h *MaintenanceHandler) GetStatus(c *gin.Context) {
+	status, err := h.maintenanceService.Status(c.Request.Context())
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, mapStatusToResponse(status))
+}
+
+// GetAdminStatus reports maintenance mode with the switch and who changed it last
+// @Summary Get maintenance mode
+// @Description Report maintenance mode, what put it in force, the admin switch and the scheduled window (requires ADMIN role).
+// @Tags admin
+// @Produce json
+// @Success 200 {object} maintenanceDto.AdminStatusResponse
+// @Failure 401 {object} maintenanceDto.ErrorResponse
+// @Failure 403 {object} maintenanceDto.ErrorResponse
+// @Failure 500 {object} maintenanceDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/maintenance [get]
+func (h *MaintenanceHandler) GetAdminStatus(c *gin.Context) {
+	status, err := h.maintenanceService.Status(c.Request.Context())
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, mapStatusToAdminResponse(status))
+}
+
+// SetMode turns the maintenance switch on or off
+// @Summary Switch maintenance mode
+// @Description Turn maintenance mode on or off for every instance (requires ADMIN role). Maintenance forced on in configuration stays on.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param mode body maintenanceDto.SetModeRequest true "Switch"
+// @Success 200 {object} maintenanceDto.AdminStatusResponse
+// @Failure 400 {object} maintenanceDto.ErrorResponse
+// @Failure 401 {object} maintenanceDto.ErrorResponse
+// @Failure 403 {object} maintenanceDto.ErrorResponse
+// @Failure 500 {object} maintenanceDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/maintenance [put]
+func (h *MaintenanceHandler) SetMode(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req maintenanceDto.SetModeRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, maintenanceDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	status, err := h.maintenanceService.SetEnabled(c.Request.Context(), *req.Enabled, req.Message, currentUser.UserID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, mapStatusToAdminResponse(status))
+}
+
+// ScheduleWindow schedules a maintenance window
+// @Summary Schedule maintenance window
+// @Description Schedule a period during which writes answer 503, replacing the window scheduled before (requires ADMIN role). It is listed by GET /api/v1/maintenance until it ends.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param window body maintenanceDto.ScheduleWindowRequest true "Window"
+// @Success 200 {object} maintenanceDto.AdminStatusResponse
+// @Failure 400 {object} maintenanceDto.ErrorResponse
+// @Failure 401 {object} maintenanceDto.ErrorResponse
+// @Failure 403 {object} maintenanceDto.ErrorResponse
+// @Failure 500 {object} maintenanceDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/maintenance/window [put]
+func (h *MaintenanceHandler) ScheduleWindow(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req maintenanceDto.ScheduleWindowRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, maintenanceDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	window := maintenance.Window{StartsAt: req.StartsAt, EndsAt: req.EndsAt, Message: req.Message}
+	status, err := h.maintenanceService.ScheduleWindow(c.Request.Context(), window, currentUser.UserID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, mapStatusToAdminResponse(status))
+}
+
+// CancelWindow removes the scheduled maintenance window
+// @Summary Cancel maintenance window
+// @Description Remove the scheduled maintenance window, ending it early if it is in progress (requires ADMIN role).
+// @Tags admin
+// @Produce json
+// @Success 200 {object} maintenanceDto.AdminStatusResponse
+// @Failure 401 {object} maintenanceDto.ErrorResponse
+// @Failure 403 {object} maintenanceDto.ErrorResponse
+// @Failure 404 {object} maintenanceDto.ErrorResponse
+// @Failure 500 {object} maintenanceDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/maintenance/window [delete]
+func (h *MaintenanceHandler) CancelWindow(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	status, err := h.maintenanceService.CancelWindow(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, mapStatusToAdminResponse(status))
+}
+
+// RegisterRoutes registers maintenance routes with the gin router
+func (h *MaintenanceHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	// Public route
+	router.GET("/maintenance", h.GetStatus)
+
+	// Admin routes (require ADMIN role)
+	adminRoutes := router.Group("/admin/maintenance", jwtMiddleware.AuthRequired(), auth.RequireAdmin())
+	{
+		adminRoutes.GET("", h.GetAdminStatus)
+		adminRoutes.PUT("", h.SetMode)
+		adminRoutes.PUT("/window", h.ScheduleWindow)
+		adminRoutes.DELETE("/window", h.CancelWindow)
+	}
+}
+
+// respondError maps maintenance errors to HTTP responses
+func (h *MaintenanceHandler) respondError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case maintenance.IsValidationError(err):
+		status = http.StatusBadRequest
+	case maintenance.IsNoWindowError(err):
+		status = http.StatusNotFound
+	}
+
+	code := maintenance.GetMaintenanceErrorCode(err)
+	if code == "" {
+		code = "maintenance_error"
+	}
+	c.JSON(status, maintenanceDto.ErrorResponse{
+		Error:   code,
+		Message: err.Error(),
+	})
+}
+
+// mapStatusToResponse converts a maintenance status to the public response DTO
+func mapStatusToResponse(status *maintenance.Status) maintenanceDto.StatusResponse {
+	response := maintenanceDto.StatusResponse{
+		Active: status.Active,
+		EndsAt: status.EndsAt,
+	}
+	if status.Active {
+		response.Message = status.Message
+	}
+	if status.Window != nil {
+		response.Window = &maintenanceDto.WindowResponse{
+			StartsAt: status.Window.StartsAt,
+			EndsAt:   status.Window.EndsAt,
+			Message:  status.Window.Message,
+		}
+	}
+	return response
+}
+
+// mapStatusToAdminResponse converts a maintenance status to the admin response DTO
+func mapStatusToAdminResponse(status *maintenance.Status) maintenanceDto.AdminStatusResponse {
+	response := maintenanceDto.AdminStatusResponse{
+		StatusResponse: mapStatusToResponse(status),
+		Source:         status.Source,
+		SwitchEnabled:  status.State.Enabled,
+		UpdatedBy:      status.State.UpdatedBy,
+	}
+	if !status.State.UpdatedAt.IsZero() {
+		updatedAt := status.State.UpdatedAt
+		response.UpdatedAt = &updatedAt
+	}
+	return response
+}
@@ -0,0 +1,209 @@
+package http
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/messaging"
+	"enterprise-crud/internal/domain/order"
+	messagingDto "enterprise-crud/internal/dto/messaging"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MessageHandler handles HTTP requests for the message threads between buyers and organizers
+type MessageHandler struct {
+	messagingService messaging.Service
+	jwtService       *auth.JWTService
+}
+
+// NewMessageHandler creates a new instance of MessageHandler
+func NewMessageHandler(messagingService messaging.Service, jwtService *auth.JWTService) *MessageHandler {
+	return &MessageHandler{
+		messagingService: messagingService,
+		jwtService:       jwtService,
+	}
+}
+
+// PostMessage writes a message in an order's thread
+// @Summary Post an order message
+// @Description Write to the other side of an order: the buyer to the event organizer, or the organizer to the buyer. The recipient is notified.
+// @Tags messages
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param message body messagingDto.PostMessageRequest true "Message"
+// @Success 201 {object} messagingDto.MessageResponse
+// @Failure 400 {object} messagingDto.ErrorResponse
+// @Failure 401 {object} messagingDto.ErrorResponse
+// @Failure 404 {object} messagingDto.ErrorResponse
+// @Failure 409 {object} messagingDto.ErrorResponse
+// @Failure 500 {object} messagingDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/orders/{id}/messages [post]
+func (h *MessageHandler) PostMessage(c *gin.Context) {
+	orderID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	var req messagingDto.PostMessageRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, messagingDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	m, err := h.messagingService.Post(c.Request.Context(), currentUser.UserID, orderID, req.Body)
+	if err != nil {
+		writeMessagingError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapMessageToResponse(m))
+}
+
+// GetThread retrieves an order's thread and marks it read
+// @Summary Get order messages
+// @Description Get the messages between the buyer and the event organizer of an order, oldest first. Messages to the current user are marked read; the response still shows them unread.
+// @Tags messages
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} messagingDto.ThreadResponse
+// @Failure 400 {object} messagingDto.ErrorResponse
+// @Failure 401 {object} messagingDto.ErrorResponse
+// @Failure 404 {object} messagingDto.ErrorResponse
+// @Failure 500 {object} messagingDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/orders/{id}/messages [get]
+func (h *MessageHandler) GetThread(c *gin.Context) {
+	orderID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	messages, err := h.messagingService.ListThread(c.Request.Context(), currentUser.UserID, orderID)
+	if err != nil {
+		writeMessagingError(c, err)
+		return
+	}
+
+	response := messagingDto.ThreadResponse{
+		Messages: make([]messagingDto.MessageResponse, len(messages)),
+		Count:    len(messages),
+	}
+	for i, m := range messages {
+		response.Messages[i] = mapMessageToResponse(m)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetUnreadCounts counts the current user's unread order messages
+// @Summary Count unread order messages
+// @Description Count the messages the current user hasn't read, in total and per order thread
+// @Tags messages
+// @Produce json
+// @Success 200 {object} messagingDto.UnreadCountsResponse
+// @Failure 401 {object} messagingDto.ErrorResponse
+// @Failure 500 {object} messagingDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/messages/unread [get]
+func (h *MessageHandler) GetUnreadCounts(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	counts, err := h.messagingService.UnreadCounts(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		writeMessagingError(c, err)
+		return
+	}
+
+	response := messagingDto.UnreadCountsResponse{Threads: make([]messagingDto.UnreadThreadResponse, len(counts))}
+	for i, t := range counts {
+		response.Threads[i] = messagingDto.UnreadThreadResponse{OrderID: t.OrderID, Unread: t.Unread}
+		response.Total += t.Unread
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RegisterRoutes registers order message routes with the gin router
+// Organizers take part in threads of orders they didn't place, so access is checked per order.
+func (h *MessageHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	router.POST("/orders/:id/messages",
+		jwtMiddleware.AuthRequired(),
+		UUIDParams("id"),
+		h.PostMessage)
+
+	router.GET("/orders/:id/messages",
+		jwtMiddleware.AuthRequired(),
+		UUIDParams("id"),
+		h.GetThread)
+
+	router.GET("/messages/unread",
+		jwtMiddleware.AuthRequired(),
+		h.GetUnreadCounts)
+}
+
+// writeMessagingError maps messaging, order and event errors to HTTP responses
+func writeMessagingError(c *gin.Context, err error) {
+	switch {
+	case order.IsOrderNotFoundError(err):
+		c.JSON(http.StatusNotFound, messagingDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	case order.IsOrderArchivedError(err):
+		c.JSON(http.StatusConflict, messagingDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	case event.IsEventNotFoundError(err):
+		c.JSON(http.StatusNotFound, messagingDto.ErrorResponse{
+			Error:   event.GetEventErrorCode(err),
+			Message: err.Error(),
+		})
+	case messaging.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, messagingDto.ErrorResponse{
+			Error:   messaging.GetMessagingErrorCode(err),
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, messagingDto.ErrorResponse{
+			Error:   "messaging_error",
+			Message: err.Error(),
+		})
+	}
+}
+
+// mapMessageToResponse converts an order message to response DTO
+func mapMessageToResponse(m *messaging.Message) messagingDto.MessageResponse {
+	return messagingDto.MessageResponse{
+		ID:          m.ID,
+		OrderID:     m.OrderID,
+		SenderID:    m.SenderID,
+		RecipientID: m.RecipientID,
+		SenderRole:  m.SenderRole,
+		Body:        m.Body,
+		ReadAt:      m.ReadAt,
+		CreatedAt:   m.CreatedAt,
+	}
+}
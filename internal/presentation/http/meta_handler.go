@@ -0,0 +1,74 @@
+package http
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/errcode"
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/domain/venue"
+	metaDto "enterprise-crud/internal/dto/meta"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errorKindStatuses maps every kind of domain error to the HTTP status it is answered with
+var errorKindStatuses = map[errcode.Kind]int{
+	errcode.Invalid:         http.StatusBadRequest,
+	errcode.Unauthenticated: http.StatusUnauthorized,
+	errcode.Forbidden:       http.StatusForbidden,
+	errcode.NotFound:        http.StatusNotFound,
+	errcode.Conflict:        http.StatusConflict,
+	errcode.Gone:            http.StatusGone,
+	errcode.Internal:        http.StatusInternalServerError,
+}
+
+// MetaHandler handles HTTP requests describing the API itself
+type MetaHandler struct {
+	errorCodes []metaDto.ErrorCodeResponse
+}
+
+// NewMetaHandler creates a new instance of MetaHandler
+func NewMetaHandler() *MetaHandler {
+	domains := []struct {
+		name  string
+		codes []errcode.Code
+	}{
+		{"event", event.ErrorCodes()},
+		{"order", order.ErrorCodes()},
+		{"user", user.ErrorCodes()},
+		{"venue", venue.ErrorCodes()},
+	}
+
+	var errorCodes []metaDto.ErrorCodeResponse
+	for _, domain := range domains {
+		for _, code := range domain.codes {
+			errorCodes = append(errorCodes, metaDto.ErrorCodeResponse{
+				Code:        code.Code,
+				Domain:      domain.name,
+				Kind:        string(code.Kind),
+				HTTPStatus:  errorKindStatuses[code.Kind],
+				Description: code.Description,
+			})
+		}
+	}
+	return &MetaHandler{errorCodes: errorCodes}
+}
+
+// ListErrorCodes lists the error codes of the domain packages
+// @Summary List error codes
+// @Description List every error code the event, order, user and venue endpoints can answer with in the error (or code) field,
+// @Description with the HTTP status it usually comes with and what it means. Codes are stable; messages are not, so program against codes.
+// @Tags meta
+// @Produce json
+// @Success 200 {object} metaDto.ErrorCodeListResponse
+// @Router /api/v1/meta/error-codes [get]
+func (h *MetaHandler) ListErrorCodes(c *gin.Context) {
+	c.JSON(http.StatusOK, metaDto.ErrorCodeListResponse{ListResponse: newList(c, h.errorCodes)})
+}
+
+// RegisterRoutes registers meta routes with the gin router
+func (h *MetaHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/meta/error-codes", h.ListErrorCodes)
+}
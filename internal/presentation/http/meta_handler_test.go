@@ -0,0 +1,43 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metaDto "enterprise-crud/internal/dto/meta"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetaHandler_ListErrorCodes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewMetaHandler().RegisterRoutes(router.Group("/api/v1"))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/meta/error-codes", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response metaDto.ErrorCodeListResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.NotEmpty(t, response.Data)
+
+	domains := make(map[string]bool)
+	for _, code := range response.Data {
+		domains[code.Domain] = true
+		assert.NotZero(t, code.HTTPStatus, "%s has a kind without a status", code.Code)
+
+		// The user handler maps each code explicitly, so the catalogue must agree with it
+		if code.Domain == "user" {
+			mapped, ok := userErrorResponses[code.Code]
+			if assert.True(t, ok, "%s is not mapped by the user handler", code.Code) {
+				assert.Equal(t, mapped.status, code.HTTPStatus, code.Code)
+			}
+		}
+	}
+	assert.Equal(t, map[string]bool{"event": true, "order": true, "user": true, "venue": true}, domains)
+}
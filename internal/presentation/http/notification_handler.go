@@ -0,0 +1,510 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"enterprise-crud/internal/domain/notification"
+	"enterprise-crud/internal/dto/common"
+	notificationDto "enterprise-crud/internal/dto/notification"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// NotificationHandler handles HTTP requests for the notification center and preferences
+type NotificationHandler struct {
+	notificationService notification.Service
+	jwtService          *auth.JWTService
+}
+
+// NewNotificationHandler creates a new instance of NotificationHandler
+func NewNotificationHandler(notificationService notification.Service, jwtService *auth.JWTService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+		jwtService:          jwtService,
+	}
+}
+
+// ListNotifications lists the current user's notifications
+// @Summary List notifications
+// @Description List a page of the current user's in-app notifications, newest first, with the unread count
+// @Tags notifications
+// @Produce json
+// @Param unread query bool false "Only unread notifications"
+// @Param limit query int false "Page size (1-100)" default(50)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Success 200 {object} notificationDto.NotificationListResponse
+// @Failure 400 {object} notificationDto.ErrorResponse
+// @Failure 401 {object} notificationDto.ErrorResponse
+// @Failure 500 {object} notificationDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/notifications [get]
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	filter := notification.ListFilter{}
+	filter.UnreadOnly, _ = strconv.ParseBool(c.Query("unread"))
+	filter.Limit, _ = strconv.Atoi(c.DefaultQuery("limit", "50"))
+	offset, err := QueryOffset(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, notificationDto.ErrorResponse{
+			Error:   "invalid_cursor",
+			Message: err.Error(),
+		})
+		return
+	}
+	filter.Offset = offset
+
+	notifications, unread, err := h.notificationService.List(c.Request.Context(), currentUser.UserID, filter)
+	if err != nil {
+		h.respondError(c, err, "Failed to list notifications: ")
+		return
+	}
+
+	items := make([]notificationDto.NotificationResponse, len(notifications))
+	for i, n := range notifications {
+		items[i] = mapNotificationToResponse(n)
+	}
+
+	// Without a total, a full page is assumed to have more after it; the page after the last one is empty
+	page := newList(c, items)
+	if len(notifications) == filter.PageSize() {
+		page = page.WithNext(common.Cursor{Offset: filter.Offset + len(notifications)})
+	}
+	c.JSON(http.StatusOK, notificationDto.NotificationListResponse{ListResponse: page, UnreadCount: unread})
+}
+
+// MarkRead marks one of the current user's notifications as read
+// @Summary Mark notification read
+// @Description Mark one of the current user's notifications as read
+// @Tags notifications
+// @Produce json
+// @Param id path string true "Notification ID"
+// @Success 204 "Notification marked as read"
+// @Failure 400 {object} notificationDto.ErrorResponse
+// @Failure 401 {object} notificationDto.ErrorResponse
+// @Failure 404 {object} notificationDto.ErrorResponse
+// @Failure 500 {object} notificationDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/notifications/{id}/read [post]
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	notificationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, notificationDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid notification ID format",
+		})
+		return
+	}
+
+	if err := h.notificationService.MarkRead(c.Request.Context(), currentUser.UserID, notificationID); err != nil {
+		h.respondError(c, err, "Failed to mark notification as read: ")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// MarkAllRead marks all of the current user's notifications as read
+// @Summary Mark all notifications read
+// @Description Mark every unread notification of the current user as read
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} notificationDto.MarkAllReadResponse
+// @Failure 401 {object} notificationDto.ErrorResponse
+// @Failure 500 {object} notificationDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/notifications/read-all [post]
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	marked, err := h.notificationService.MarkAllRead(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		h.respondError(c, err, "Failed to mark notifications as read: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, notificationDto.MarkAllReadResponse{Marked: marked})
+}
+
+// GetPreferences retrieves the current user's notification preferences
+// @Summary Get notification preferences
+// @Description Get whether each notification type is delivered by email, in-app and push for the current user
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} notificationDto.PreferencesResponse
+// @Failure 401 {object} notificationDto.ErrorResponse
+// @Failure 500 {object} notificationDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/notifications/preferences [get]
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	prefs, err := h.notificationService.GetPreferences(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve notification preferences: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapPreferencesToResponse(prefs))
+}
+
+// UpdatePreferences changes the current user's notification preferences
+// @Summary Update notification preferences
+// @Description Choose email, in-app and push delivery per notification type (ORDER_CONFIRMED, EVENT_CHANGED, WAITLIST_PROMOTED, ORDER_MESSAGE, GIFT); types and channels left out are unchanged
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param preferences body notificationDto.UpdatePreferencesRequest true "Notification preferences"
+// @Success 200 {object} notificationDto.PreferencesResponse
+// @Failure 400 {object} notificationDto.ErrorResponse
+// @Failure 401 {object} notificationDto.ErrorResponse
+// @Failure 500 {object} notificationDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/notifications/preferences [put]
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req notificationDto.UpdatePreferencesRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, notificationDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	updates := make([]notification.PreferenceUpdate, len(req.Preferences))
+	for i, p := range req.Preferences {
+		updates[i] = notification.PreferenceUpdate{
+			Type:  p.Type,
+			Email: p.Email,
+			InApp: p.InApp,
+			Push:  p.Push,
+		}
+	}
+
+	updated, err := h.notificationService.UpdatePreferences(c.Request.Context(), currentUser.UserID, updates)
+	if err != nil {
+		h.respondError(c, err, "Failed to update notification preferences: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapPreferencesToResponse(updated))
+}
+
+// RegisterDevice registers a device of the current user for push notifications
+// @Summary Register device
+// @Description Register an FCM (ANDROID) or APNs (IOS) device token so the current user receives push notifications on it; registering a token again moves it to the current user
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param device body notificationDto.RegisterDeviceRequest true "Device"
+// @Success 201 {object} notificationDto.DeviceResponse
+// @Failure 400 {object} notificationDto.ErrorResponse
+// @Failure 401 {object} notificationDto.ErrorResponse
+// @Failure 500 {object} notificationDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/devices [post]
+func (h *NotificationHandler) RegisterDevice(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req notificationDto.RegisterDeviceRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, notificationDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	device, err := h.notificationService.RegisterDevice(c.Request.Context(), currentUser.UserID, req.Platform, req.Token)
+	if err != nil {
+		h.respondError(c, err, "Failed to register device: ")
+		return
+	}
+
+	c.JSON(http.StatusCreated, notificationDto.DeviceResponse{
+		ID:        device.ID,
+		Platform:  device.Platform,
+		CreatedAt: device.CreatedAt,
+	})
+}
+
+// UnregisterDevice stops push notifications to one of the current user's devices
+// @Summary Unregister device
+// @Description Stop sending push notifications to a device of the current user, e.g. on logout
+// @Tags notifications
+// @Produce json
+// @Param id path string true "Device ID"
+// @Success 204 "Device unregistered"
+// @Failure 400 {object} notificationDto.ErrorResponse
+// @Failure 401 {object} notificationDto.ErrorResponse
+// @Failure 404 {object} notificationDto.ErrorResponse
+// @Failure 500 {object} notificationDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/devices/{id} [delete]
+func (h *NotificationHandler) UnregisterDevice(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	deviceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, notificationDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid device ID format",
+		})
+		return
+	}
+
+	if err := h.notificationService.UnregisterDevice(c.Request.Context(), currentUser.UserID, deviceID); err != nil {
+		h.respondError(c, err, "Failed to unregister device: ")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetPhone returns the verified phone number of the current user
+// @Summary Get phone number
+// @Description Get the verified phone number that receives critical alerts by SMS; empty when none is set
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} notificationDto.PhoneResponse
+// @Failure 401 {object} notificationDto.ErrorResponse
+// @Failure 500 {object} notificationDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/phone [get]
+func (h *NotificationHandler) GetPhone(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	contact, err := h.notificationService.GetPhone(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve phone number: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, notificationDto.PhoneResponse{
+		Phone:      contact.Phone,
+		VerifiedAt: contact.VerifiedAt,
+	})
+}
+
+// StartPhoneVerification texts a verification code to a phone number
+// @Summary Add phone number
+// @Description Text a six-digit code to a phone number in international format; the number is saved once the code is confirmed. A new code can be requested once a minute.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param phone body notificationDto.StartPhoneVerificationRequest true "Phone number"
+// @Success 202 {object} notificationDto.PhoneVerificationResponse
+// @Failure 400 {object} notificationDto.ErrorResponse
+// @Failure 401 {object} notificationDto.ErrorResponse
+// @Failure 429 {object} notificationDto.ErrorResponse
+// @Failure 500 {object} notificationDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/phone [put]
+func (h *NotificationHandler) StartPhoneVerification(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req notificationDto.StartPhoneVerificationRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, notificationDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	verification, err := h.notificationService.StartPhoneVerification(c.Request.Context(), currentUser.UserID, req.Phone)
+	if err != nil {
+		h.respondError(c, err, "Failed to send verification code: ")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, notificationDto.PhoneVerificationResponse{
+		Phone:     verification.Phone,
+		ExpiresAt: verification.ExpiresAt,
+	})
+}
+
+// VerifyPhone confirms a phone number with the code texted to it
+// @Summary Verify phone number
+// @Description Confirm the pending phone number with the code texted to it. After five wrong codes a new one must be requested.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param code body notificationDto.VerifyPhoneRequest true "Verification code"
+// @Success 200 {object} notificationDto.PhoneResponse
+// @Failure 400 {object} notificationDto.ErrorResponse
+// @Failure 401 {object} notificationDto.ErrorResponse
+// @Failure 404 {object} notificationDto.ErrorResponse
+// @Failure 429 {object} notificationDto.ErrorResponse
+// @Failure 500 {object} notificationDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/phone/verify [post]
+func (h *NotificationHandler) VerifyPhone(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req notificationDto.VerifyPhoneRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, notificationDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	contact, err := h.notificationService.VerifyPhone(c.Request.Context(), currentUser.UserID, req.Code)
+	if err != nil {
+		h.respondError(c, err, "Failed to verify phone number: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, notificationDto.PhoneResponse{
+		Phone:      contact.Phone,
+		VerifiedAt: contact.VerifiedAt,
+	})
+}
+
+// RemovePhone removes the phone number of the current user
+// @Summary Remove phone number
+// @Description Remove the current user's phone number, which stops SMS alerts
+// @Tags notifications
+// @Success 204 "Phone number removed"
+// @Failure 401 {object} notificationDto.ErrorResponse
+// @Failure 500 {object} notificationDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/phone [delete]
+func (h *NotificationHandler) RemovePhone(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.notificationService.RemovePhone(c.Request.Context(), currentUser.UserID); err != nil {
+		h.respondError(c, err, "Failed to remove phone number: ")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RegisterRoutes registers notification routes with the gin router
+func (h *NotificationHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	// User routes (require any authenticated user)
+	notificationRoutes := router.Group("/users/notifications", jwtMiddleware.AuthRequired(), auth.RequireUser())
+	{
+		notificationRoutes.GET("", h.ListNotifications)
+		notificationRoutes.POST("/:id/read", h.MarkRead)
+		notificationRoutes.POST("/read-all", h.MarkAllRead)
+		notificationRoutes.GET("/preferences", h.GetPreferences)
+		notificationRoutes.PUT("/preferences", h.UpdatePreferences)
+	}
+
+	deviceRoutes := router.Group("/users/devices", jwtMiddleware.AuthRequired(), auth.RequireUser())
+	{
+		deviceRoutes.POST("", h.RegisterDevice)
+		deviceRoutes.DELETE("/:id", h.UnregisterDevice)
+	}
+
+	phoneRoutes := router.Group("/users/phone", jwtMiddleware.AuthRequired(), auth.RequireUser())
+	{
+		phoneRoutes.GET("", h.GetPhone)
+		phoneRoutes.PUT("", h.StartPhoneVerification)
+		phoneRoutes.POST("/verify", h.VerifyPhone)
+		phoneRoutes.DELETE("", h.RemovePhone)
+	}
+}
+
+// respondError maps notification errors to HTTP responses
+func (h *NotificationHandler) respondError(c *gin.Context, err error, prefix string) {
+	switch {
+	case notification.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, notificationDto.ErrorResponse{
+			Error:   notification.GetNotificationErrorCode(err),
+			Message: err.Error(),
+		})
+	case notification.IsNotFoundError(err):
+		c.JSON(http.StatusNotFound, notificationDto.ErrorResponse{
+			Error:   notification.GetNotificationErrorCode(err),
+			Message: err.Error(),
+		})
+	case notification.IsRateLimitError(err):
+		c.JSON(http.StatusTooManyRequests, notificationDto.ErrorResponse{
+			Error:   notification.GetNotificationErrorCode(err),
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, notificationDto.ErrorResponse{
+			Error:   "notification_error",
+			Message: prefix + err.Error(),
+		})
+	}
+}
+
+// mapNotificationToResponse converts a notification to response DTO
+func mapNotificationToResponse(n *notification.Notification) notificationDto.NotificationResponse {
+	return notificationDto.NotificationResponse{
+		ID:        n.ID,
+		Type:      n.Type,
+		Title:     n.Title,
+		Body:      n.Body,
+		EventID:   n.EventID,
+		OrderID:   n.OrderID,
+		Read:      n.IsRead(),
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+// mapPreferencesToResponse converts notification preferences to response DTO
+func mapPreferencesToResponse(prefs []*notification.Preference) notificationDto.PreferencesResponse {
+	response := notificationDto.PreferencesResponse{
+		Preferences: make([]notificationDto.PreferenceResponse, len(prefs)),
+	}
+	for i, p := range prefs {
+		response.Preferences[i] = notificationDto.PreferenceResponse{
+			Type:  p.Type,
+			Email: p.Email,
+			InApp: p.InApp,
+			Push:  p.Push,
+		}
+	}
+	return response
+}
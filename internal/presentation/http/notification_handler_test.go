@@ -0,0 +1,410 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/notification"
+	"enterprise-crud/internal/dto/common"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockNotificationService is a mock implementation of notification.Service interface
+type MockNotificationService struct {
+	mock.Mock
+}
+
+func (m *MockNotificationService) List(ctx context.Context, userID uuid.UUID, filter notification.ListFilter) ([]*notification.Notification, int64, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*notification.Notification), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockNotificationService) MarkRead(ctx context.Context, userID, id uuid.UUID) error {
+	args := m.Called(ctx, userID, id)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationService) GetPreferences(ctx context.Context, userID uuid.UUID) ([]*notification.Preference, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notification.Preference), args.Error(1)
+}
+
+func (m *MockNotificationService) UpdatePreferences(ctx context.Context, userID uuid.UUID, updates []notification.PreferenceUpdate) ([]*notification.Preference, error) {
+	args := m.Called(ctx, userID, updates)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notification.Preference), args.Error(1)
+}
+
+func (m *MockNotificationService) Notify(ctx context.Context, userID uuid.UUID, msg notification.Message) error {
+	args := m.Called(ctx, userID, msg)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) NotifyTicketHolders(ctx context.Context, eventID uuid.UUID, msg notification.Message) error {
+	args := m.Called(ctx, eventID, msg)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) PrepareEmail(ctx context.Context, payload notification.EmailPayload) (*notification.Email, error) {
+	args := m.Called(ctx, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notification.Email), args.Error(1)
+}
+
+func (m *MockNotificationService) RegisterDevice(ctx context.Context, userID uuid.UUID, platform, token string) (*notification.Device, error) {
+	args := m.Called(ctx, userID, platform, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notification.Device), args.Error(1)
+}
+
+func (m *MockNotificationService) UnregisterDevice(ctx context.Context, userID, deviceID uuid.UUID) error {
+	args := m.Called(ctx, userID, deviceID)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) RemoveDeviceToken(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) GetPhone(ctx context.Context, userID uuid.UUID) (*notification.PhoneContact, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notification.PhoneContact), args.Error(1)
+}
+
+func (m *MockNotificationService) StartPhoneVerification(ctx context.Context, userID uuid.UUID, phone string) (*notification.PhoneVerification, error) {
+	args := m.Called(ctx, userID, phone)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notification.PhoneVerification), args.Error(1)
+}
+
+func (m *MockNotificationService) VerifyPhone(ctx context.Context, userID uuid.UUID, code string) (*notification.PhoneContact, error) {
+	args := m.Called(ctx, userID, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notification.PhoneContact), args.Error(1)
+}
+
+func (m *MockNotificationService) RemovePhone(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) AlertTicketHoldersBySMS(ctx context.Context, eventID uuid.UUID, body string) error {
+	args := m.Called(ctx, eventID, body)
+	return args.Error(0)
+}
+
+func TestNotificationHandler_ListNotifications(t *testing.T) {
+	userID := uuid.New()
+	readAt := time.Now()
+	notifications := []*notification.Notification{
+		{ID: uuid.New(), UserID: userID, Type: notification.TypeOrderConfirmed, Title: "Order confirmed: Jazz Night", CreatedAt: time.Now()},
+		{ID: uuid.New(), UserID: userID, Type: notification.TypeEventChanged, Title: "Jazz Night has changed", ReadAt: &readAt, CreatedAt: time.Now()},
+	}
+
+	t.Run("last page", func(t *testing.T) {
+		service := new(MockNotificationService)
+		service.On("List", mock.Anything, userID, notification.ListFilter{UnreadOnly: true, Limit: 10}).Return(notifications, int64(1), nil)
+		handler := NewNotificationHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+		c, w := userTestContext(http.MethodGet, "/users/notifications?unread=true&limit=10", nil, userID, nil)
+		handler.ListNotifications(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"unread_count":1`)
+		assert.Contains(t, w.Body.String(), `"title":"Order confirmed: Jazz Night"`)
+		assert.Contains(t, w.Body.String(), `"read":true`)
+		assert.Contains(t, w.Body.String(), `"meta":{"count":2}`)
+		assert.NotContains(t, w.Body.String(), "next_cursor")
+		service.AssertExpectations(t)
+	})
+
+	t.Run("full page links the next one", func(t *testing.T) {
+		cursor := common.Cursor{Offset: 4}.Encode()
+		service := new(MockNotificationService)
+		service.On("List", mock.Anything, userID, notification.ListFilter{Limit: 2, Offset: 4}).Return(notifications, int64(1), nil)
+		handler := NewNotificationHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+		c, w := userTestContext(http.MethodGet, "/users/notifications?limit=2&cursor="+cursor, nil, userID, nil)
+		handler.ListNotifications(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		next := common.Cursor{Offset: 6}.Encode()
+		assert.Contains(t, w.Body.String(), `"next_cursor":"`+next+`"`)
+		assert.Contains(t, w.Body.String(), `"next":"/users/notifications?cursor=`+next+`\u0026limit=2"`)
+		service.AssertExpectations(t)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		service := new(MockNotificationService)
+		handler := NewNotificationHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+		c, w := userTestContext(http.MethodGet, "/users/notifications?cursor=not-a-cursor", nil, userID, nil)
+		handler.ListNotifications(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid_cursor")
+		service.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestNotificationHandler_MarkRead(t *testing.T) {
+	userID := uuid.New()
+	notificationID := uuid.New()
+
+	tests := []struct {
+		name           string
+		id             string
+		setupMocks     func(*MockNotificationService)
+		expectedStatus int
+	}{
+		{
+			name: "success",
+			id:   notificationID.String(),
+			setupMocks: func(m *MockNotificationService) {
+				m.On("MarkRead", mock.Anything, userID, notificationID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "invalid ID",
+			id:             "not-a-uuid",
+			setupMocks:     func(m *MockNotificationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "another user's notification",
+			id:   notificationID.String(),
+			setupMocks: func(m *MockNotificationService) {
+				m.On("MarkRead", mock.Anything, userID, notificationID).Return(notification.ErrNotificationNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockNotificationService)
+			tt.setupMocks(service)
+			handler := NewNotificationHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			c, w := userTestContext(http.MethodPost, "/users/notifications/"+tt.id+"/read", nil, userID, gin.Params{{Key: "id", Value: tt.id}})
+			handler.MarkRead(c)
+
+			// Status() alone is not flushed to the recorder until the response is written
+			c.Writer.WriteHeaderNow()
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationHandler_UpdatePreferences(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func(*MockNotificationService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "success",
+			body: map[string]interface{}{"preferences": []map[string]interface{}{
+				{"type": "EVENT_CHANGED", "email": false},
+			}},
+			setupMocks: func(m *MockNotificationService) {
+				m.On("UpdatePreferences", mock.Anything, userID, mock.MatchedBy(func(updates []notification.PreferenceUpdate) bool {
+					return len(updates) == 1 && updates[0].Type == "EVENT_CHANGED" && !*updates[0].Email &&
+						updates[0].InApp == nil && updates[0].Push == nil
+				})).
+					Return([]*notification.Preference{{UserID: userID, Type: notification.TypeEventChanged, InApp: true}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"in_app":true`,
+		},
+		{
+			name: "missing type",
+			body: map[string]interface{}{"preferences": []map[string]interface{}{
+				{"email": false},
+			}},
+			setupMocks:     func(m *MockNotificationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unknown type",
+			body: map[string]interface{}{"preferences": []map[string]interface{}{
+				{"type": "NEWSLETTER", "email": false, "in_app": false},
+			}},
+			setupMocks: func(m *MockNotificationService) {
+				m.On("UpdatePreferences", mock.Anything, userID, mock.Anything).Return(nil, notification.ErrInvalidType)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_NOTIFICATION_TYPE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockNotificationService)
+			tt.setupMocks(service)
+			handler := NewNotificationHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			c, w := userTestContext(http.MethodPut, "/users/notifications/preferences", tt.body, userID, nil)
+			handler.UpdatePreferences(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationHandler_RegisterDevice(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func(*MockNotificationService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "success",
+			body: map[string]string{"platform": "IOS", "token": "apns-token"},
+			setupMocks: func(m *MockNotificationService) {
+				m.On("RegisterDevice", mock.Anything, userID, "IOS", "apns-token").
+					Return(&notification.Device{ID: uuid.New(), UserID: userID, Platform: notification.PlatformIOS, Token: "apns-token"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"platform":"IOS"`,
+		},
+		{
+			name:           "missing token",
+			body:           map[string]string{"platform": "IOS"},
+			setupMocks:     func(m *MockNotificationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unsupported platform",
+			body: map[string]string{"platform": "WINDOWS", "token": "token"},
+			setupMocks: func(m *MockNotificationService) {
+				m.On("RegisterDevice", mock.Anything, userID, "WINDOWS", "token").Return(nil, notification.ErrInvalidPlatform)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_PLATFORM",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockNotificationService)
+			tt.setupMocks(service)
+			handler := NewNotificationHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			c, w := userTestContext(http.MethodPost, "/users/devices", tt.body, userID, nil)
+			handler.RegisterDevice(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			assert.NotContains(t, w.Body.String(), "apns-token")
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationHandler_VerifyPhone(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func(*MockNotificationService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "success",
+			body: map[string]string{"code": "123456"},
+			setupMocks: func(m *MockNotificationService) {
+				now := time.Now()
+				m.On("VerifyPhone", mock.Anything, userID, "123456").
+					Return(&notification.PhoneContact{UserID: userID, Phone: "+14155550123", VerifiedAt: &now}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"phone":"+14155550123"`,
+		},
+		{
+			name:           "malformed code",
+			body:           map[string]string{"code": "12ab"},
+			setupMocks:     func(m *MockNotificationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "wrong code",
+			body: map[string]string{"code": "654321"},
+			setupMocks: func(m *MockNotificationService) {
+				m.On("VerifyPhone", mock.Anything, userID, "654321").Return(nil, notification.ErrInvalidCode)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_VERIFICATION_CODE",
+		},
+		{
+			name: "too many attempts",
+			body: map[string]string{"code": "654321"},
+			setupMocks: func(m *MockNotificationService) {
+				m.On("VerifyPhone", mock.Anything, userID, "654321").Return(nil, notification.ErrTooManyAttempts)
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedBody:   "TOO_MANY_ATTEMPTS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockNotificationService)
+			tt.setupMocks(service)
+			handler := NewNotificationHandler(service, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			c, w := userTestContext(http.MethodPost, "/users/phone/verify", tt.body, userID, nil)
+			handler.VerifyPhone(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -1,108 +1,119 @@
 package http
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	"enterprise-crud/internal/domain/invoice"
 	"enterprise-crud/internal/domain/order"
 	orderDto "enterprise-crud/internal/dto/order"
 	"enterprise-crud/internal/infrastructure/auth"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 )
 
 // OrderHandler handles HTTP requests for order operations
 type OrderHandler struct {
-	orderService order.Service
-	jwtService   *auth.JWTService
+	orderService   order.Service
+	jwtService     *auth.JWTService
+	purchaseChecks []gin.HandlerFunc // Run after authentication and before an order is created
+	countryHeader  string            // Header the edge proxy reports the client country in; empty ignores it
+	statusMaxAge   time.Duration     // Cache lifetime of order status responses
+	publicURL      string            // Base of the group payment links handed to leaders
 }
 
+// defaultStatusMaxAge is how long clients may reuse an order status response unless configured otherwise
+const defaultStatusMaxAge = 2 * time.Second
+
 // NewOrderHandler creates a new instance of OrderHandler
 func NewOrderHandler(orderService order.Service, jwtService *auth.JWTService) *OrderHandler {
 	return &OrderHandler{
 		orderService: orderService,
 		jwtService:   jwtService,
+		statusMaxAge: defaultStatusMaxAge,
 	}
 }
 
+// RequireBeforePurchase adds middleware that must pass before an order is created, e.g. a terms of service gate or anti-bot challenge
+// It must be called before RegisterRoutes.
+func (h *OrderHandler) RequireBeforePurchase(checks ...gin.HandlerFunc) {
+	h.purchaseChecks = append(h.purchaseChecks, checks...)
+}
+
+// ReadCountryFrom takes the buyer's country for fraud checks from header, as set by the edge proxy
+func (h *OrderHandler) ReadCountryFrom(header string) {
+	h.countryHeader = header
+}
+
+// LinkGroupSharesTo builds the payment links of group shares on publicURL, the public site members open them on
+func (h *OrderHandler) LinkGroupSharesTo(publicURL string) {
+	h.publicURL = strings.TrimRight(publicURL, "/")
+}
+
+// CacheStatusFor sets how long clients may reuse an order status response before polling again
+func (h *OrderHandler) CacheStatusFor(maxAge time.Duration) {
+	h.statusMaxAge = maxAge
+}
+
 // CreateOrder creates a new order
 // @Summary Create a new order
-// @Description Create a new order (requires USER role)
+// @Description Create a new order (requires USER role). Answers must match the event's attendee questions.
+// @Description Answers 403 CONSENT_REQUIRED until the user has accepted the current terms of service.
+// @Description Risky checkouts answer 403 CAPTCHA_REQUIRED; repeat them with the CAPTCHA token in the X-Captcha-Token header.
+// @Description Orders before an event's sale_start answer 400 SALES_NOT_STARTED, from its sale_end on 400 SALES_ENDED.
+// @Description Events that require an access code answer 403 ACCESS_CODE_REQUIRED without one and 403 ACCESS_CODE_INVALID for unknown or used-up codes.
+// @Description Age-restricted events answer 403 DATE_OF_BIRTH_REQUIRED until the buyer adds a date of birth to their profile and 403 AGE_RESTRICTED for buyers under the minimum age.
+// @Description Orders flagged by the fraud checks are created with status REVIEW until an admin decides, or refused with 403 ORDER_REJECTED.
+// @Description A user may only have a few checkouts running at once; a request finding no free slot within a short wait answers 429 TOO_MANY_CONCURRENT_REQUESTS with Retry-After.
 // @Tags orders
 // @Accept json
 // @Produce json
 // @Param order body orderDto.CreateOrderRequest true "Order data"
+// @Param X-Captcha-Token header string false "CAPTCHA token, when challenged"
 // @Success 201 {object} orderDto.OrderResponse
 // @Failure 400 {object} orderDto.ErrorResponse
 // @Failure 401 {object} orderDto.ErrorResponse
 // @Failure 403 {object} orderDto.ErrorResponse
+// @Failure 429 {object} orderDto.ErrorResponse
 // @Failure 500 {object} orderDto.ErrorResponse
 // @Security BearerAuth
 // @Router /api/v1/orders [post]
 func (h *OrderHandler) CreateOrder(c *gin.Context) {
 	var req orderDto.CreateOrderRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, orderDto.ErrorResponse{
-			Error:   "validation_error",
-			Message: "Invalid input data: " + err.Error(),
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, orderDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
 		})
 		return
 	}
 
 	// Get user ID from context
-	userClaims, exists := c.Get("user")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, orderDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "User not authenticated",
-		})
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
 		return
 	}
 
-	claims, ok := userClaims.(*auth.JWTClaims)
-	if !ok {
-		c.JSON(http.StatusUnauthorized, orderDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "Invalid authentication credentials",
-		})
-		return
+	details := order.Details{
+		Notes:         req.Notes,
+		Answers:       req.Answers,
+		AccessCode:    req.AccessCode,
+		GiftRecipient: req.GiftRecipient,
+		ClientIP:      c.ClientIP(),
+	}
+	if h.countryHeader != "" {
+		details.Country = c.GetHeader(h.countryHeader)
 	}
 
 	// Create the order
-	createdOrder, err := h.orderService.CreateOrder(c.Request.Context(), claims.UserID, req.EventID, req.Quantity)
+	createdOrder, err := h.orderService.CreateOrder(c.Request.Context(), currentUser.UserID, req.EventID, req.Quantity, details)
 	if err != nil {
-		// Handle different types of errors appropriately
-		if order.IsInvalidQuantityError(err) {
-			c.JSON(http.StatusBadRequest, orderDto.ErrorResponse{
-				Error:   order.GetOrderErrorCode(err),
-				Message: err.Error(),
-			})
-		} else if order.IsEventNotFoundError(err) {
-			c.JSON(http.StatusNotFound, orderDto.ErrorResponse{
-				Error:   order.GetOrderErrorCode(err),
-				Message: err.Error(),
-			})
-		} else if order.IsEventNotActiveError(err) {
-			c.JSON(http.StatusBadRequest, orderDto.ErrorResponse{
-				Error:   order.GetOrderErrorCode(err),
-				Message: err.Error(),
-			})
-		} else if order.IsInsufficientTicketsError(err) {
-			c.JSON(http.StatusBadRequest, orderDto.ErrorResponse{
-				Error:   order.GetOrderErrorCode(err),
-				Message: err.Error(),
-			})
-		} else if order.IsOrderCreationError(err) {
-			c.JSON(http.StatusInternalServerError, orderDto.ErrorResponse{
-				Error:   order.GetOrderErrorCode(err),
-				Message: err.Error(),
-			})
-		} else {
-			c.JSON(http.StatusInternalServerError, orderDto.ErrorResponse{
-				Error:   "creation_error",
-				Message: "Failed to create order: " + err.Error(),
-			})
-		}
+		writeOrderCreationError(c, err, "Failed to create order: ")
 		return
 	}
 
@@ -111,6 +122,52 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 	c.JSON(http.StatusCreated, response)
 }
 
+// writeOrderCreationError maps errors of placing orders and group reservations to responses
+func writeOrderCreationError(c *gin.Context, err error, prefix string) {
+	if order.IsInvalidQuantityError(err) || order.IsValidationError(err) || order.IsInvalidAnswersError(err) {
+		c.JSON(http.StatusBadRequest, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	} else if order.IsEventNotFoundError(err) {
+		c.JSON(http.StatusNotFound, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	} else if order.IsEventNotActiveError(err) || order.IsSalesNotStartedError(err) || order.IsSalesEndedError(err) {
+		c.JSON(http.StatusBadRequest, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	} else if order.IsInsufficientTicketsError(err) {
+		c.JSON(http.StatusBadRequest, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	} else if order.IsAccessCodeRequiredError(err) || order.IsAccessCodeInvalidError(err) ||
+		order.IsAgeRestrictedError(err) || order.IsDateOfBirthRequiredError(err) {
+		c.JSON(http.StatusForbidden, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	} else if order.IsOrderRejectedError(err) {
+		c.JSON(http.StatusForbidden, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	} else if order.IsOrderCreationError(err) {
+		c.JSON(http.StatusInternalServerError, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	} else {
+		c.JSON(http.StatusInternalServerError, orderDto.ErrorResponse{
+			Error:   "creation_error",
+			Message: prefix + err.Error(),
+		})
+	}
+}
+
 // GetOrder retrieves an order by ID
 // @Summary Get order by ID
 // @Description Get order details by ID (user can only see their own orders)
@@ -127,31 +184,14 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 // @Security BearerAuth
 // @Router /api/v1/orders/{id} [get]
 func (h *OrderHandler) GetOrder(c *gin.Context) {
-	orderID, err := uuid.Parse(c.Param("id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, orderDto.ErrorResponse{
-			Error:   "invalid_id",
-			Message: "Invalid order ID format",
-		})
+	orderID, ok := PathUUID(c, "id")
+	if !ok {
 		return
 	}
 
 	// Get user ID from context
-	userClaims, exists := c.Get("user")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, orderDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "User not authenticated",
-		})
-		return
-	}
-
-	claims, ok := userClaims.(*auth.JWTClaims)
+	currentUser, ok := auth.RequireCurrentUser(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, orderDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "Invalid authentication credentials",
-		})
 		return
 	}
 
@@ -171,8 +211,8 @@ func (h *OrderHandler) GetOrder(c *gin.Context) {
 		return
 	}
 
-	// Check if user can access this order (only own orders unless admin)
-	if foundOrder.UserID != claims.UserID && !auth.HasRole(c, "ADMIN") {
+	// Check if user can access this order (only own orders and claimed gifts they bought, unless admin)
+	if !foundOrder.IsVisibleTo(currentUser.UserID) && !currentUser.IsAdmin() {
 		c.JSON(http.StatusForbidden, orderDto.ErrorResponse{
 			Error:   "forbidden",
 			Message: "You can only view your own orders",
@@ -184,9 +224,83 @@ func (h *OrderHandler) GetOrder(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// GetOrderStatus returns only the status of an order, for clients polling it after payment
+// @Summary Poll order status
+// @Description Get the status of an order and when it last changed (user can only see their own orders).
+// @Description Responses carry an ETag; send it back in If-None-Match to get 304 Not Modified while the order is unchanged.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param If-None-Match header string false "ETag of the last response"
+// @Success 200 {object} orderDto.OrderStatusResponse
+// @Success 304 "Order unchanged"
+// @Failure 400 {object} orderDto.ErrorResponse
+// @Failure 401 {object} orderDto.ErrorResponse
+// @Failure 403 {object} orderDto.ErrorResponse
+// @Failure 404 {object} orderDto.ErrorResponse
+// @Failure 500 {object} orderDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/orders/{id}/status [get]
+func (h *OrderHandler) GetOrderStatus(c *gin.Context) {
+	orderID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	snapshot, err := h.orderService.GetOrderStatus(c.Request.Context(), orderID)
+	if err != nil {
+		if order.IsOrderNotFoundError(err) {
+			c.JSON(http.StatusNotFound, orderDto.ErrorResponse{
+				Error:   order.GetOrderErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, orderDto.ErrorResponse{
+				Error:   "retrieval_error",
+				Message: "Failed to retrieve order status: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	// Checked before the ETag, so a 304 never confirms someone else's order exists
+	if snapshot.UserID != currentUser.UserID && !currentUser.IsAdmin() {
+		c.JSON(http.StatusForbidden, orderDto.ErrorResponse{
+			Error:   "forbidden",
+			Message: "You can only view your own orders",
+		})
+		return
+	}
+
+	etag := statusETag(snapshot)
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "private, max-age="+strconv.Itoa(int(h.statusMaxAge.Seconds())))
+	if match := c.GetHeader("If-None-Match"); match == "*" || (match != "" && strings.Contains(match, etag)) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.JSON(http.StatusOK, orderDto.OrderStatusResponse{
+		Status:    string(snapshot.Status),
+		UpdatedAt: snapshot.UpdatedAt,
+	})
+}
+
+// statusETag identifies a status response; it changes whenever the order does
+func statusETag(snapshot *order.StatusSnapshot) string {
+	sum := sha256.Sum256([]byte(string(snapshot.Status) + "|" + snapshot.UpdatedAt.UTC().Format(time.RFC3339Nano)))
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
 // GetMyOrders retrieves all orders for the current user
 // @Summary Get my orders
-// @Description Get all orders for the current user
+// @Description Get all orders for the current user, newest first, including read-only orders of archived events
 // @Tags orders
 // @Accept json
 // @Produce json
@@ -197,25 +311,312 @@ func (h *OrderHandler) GetOrder(c *gin.Context) {
 // @Router /api/v1/orders/my-orders [get]
 func (h *OrderHandler) GetMyOrders(c *gin.Context) {
 	// Get user ID from context
-	userClaims, exists := c.Get("user")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, orderDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "User not authenticated",
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	orders, err := h.orderService.GetOrdersByUserID(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, orderDto.ErrorResponse{
+			Error:   "retrieval_error",
+			Message: "Failed to retrieve orders: " + err.Error(),
 		})
 		return
 	}
 
-	claims, ok := userClaims.(*auth.JWTClaims)
+	items := make([]orderDto.OrderResponse, len(orders))
+	for i, o := range orders {
+		items[i] = mapOrderToResponse(o)
+	}
+
+	c.JSON(http.StatusOK, orderDto.OrderListResponse{ListResponse: newList(c, items)})
+}
+
+// ClaimGift moves the tickets of a gift to the current user
+// @Summary Claim a gift
+// @Description Claim the tickets of a gift with the token from its claim link; the order moves to the current user's account and both sides are notified.
+// @Description Unknown tokens and tokens replaced by a resent link answer 404 GIFT_NOT_FOUND, gifts claimed before 409 GIFT_ALREADY_CLAIMED,
+// @Description and buyers claiming their own gift 400 CANNOT_CLAIM_OWN_GIFT.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param claim body orderDto.ClaimGiftRequest true "Claim token"
+// @Success 200 {object} orderDto.OrderResponse
+// @Failure 400 {object} orderDto.ErrorResponse
+// @Failure 401 {object} orderDto.ErrorResponse
+// @Failure 404 {object} orderDto.ErrorResponse
+// @Failure 409 {object} orderDto.ErrorResponse
+// @Failure 500 {object} orderDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/orders/gifts/claim [post]
+func (h *OrderHandler) ClaimGift(c *gin.Context) {
+	var req orderDto.ClaimGiftRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, orderDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, orderDto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "Invalid authentication credentials",
+		return
+	}
+
+	claimed, err := h.orderService.ClaimGift(c.Request.Context(), currentUser.UserID, req.Token)
+	if err != nil {
+		writeGiftError(c, err, "Failed to claim gift: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapOrderToResponse(claimed))
+}
+
+// ResendGift emails the recipient of a gift a new claim link
+// @Summary Resend a gift claim link
+// @Description Email the recipient of a paid, unclaimed gift a new claim link; earlier links stop working (buyer only).
+// @Tags orders
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 202 {object} orderDto.SuccessResponse
+// @Failure 400 {object} orderDto.ErrorResponse
+// @Failure 401 {object} orderDto.ErrorResponse
+// @Failure 404 {object} orderDto.ErrorResponse
+// @Failure 409 {object} orderDto.ErrorResponse
+// @Failure 500 {object} orderDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/orders/{id}/gift/resend [post]
+func (h *OrderHandler) ResendGift(c *gin.Context) {
+	orderID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.orderService.ResendGift(c.Request.Context(), currentUser.UserID, orderID); err != nil {
+		writeGiftError(c, err, "Failed to resend gift: ")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, orderDto.SuccessResponse{Message: "A new claim link is on its way to the recipient"})
+}
+
+// writeGiftError maps errors of the gift endpoints to responses
+func writeGiftError(c *gin.Context, err error, prefix string) {
+	switch {
+	case order.IsGiftNotFoundError(err) || order.IsOrderNotFoundError(err):
+		c.JSON(http.StatusNotFound, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
 		})
+	case order.IsGiftAlreadyClaimedError(err) || order.IsOrderArchivedError(err):
+		c.JSON(http.StatusConflict, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	case order.IsOwnGiftError(err) || order.IsNotAGiftError(err) || order.IsOrderNotCompletedError(err) || order.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, orderDto.ErrorResponse{
+			Error:   "gift_error",
+			Message: prefix + err.Error(),
+		})
+	}
+}
+
+// CreateGroup reserves tickets for a group, one pending order per member's share
+// @Summary Reserve tickets for a group
+// @Description Reserve tickets for several members at once (requires USER role). Each share becomes a pending order with its own payment link,
+// @Description returned only in this response for the leader to pass on; shares not paid by hold_until are released back to the event.
+// @Description The checks of creating an order apply to the reservation as a whole, with the same error codes.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param group body orderDto.CreateGroupRequest true "Group reservation"
+// @Param X-Captcha-Token header string false "CAPTCHA token, when challenged"
+// @Success 201 {object} orderDto.GroupResponse
+// @Failure 400 {object} orderDto.ErrorResponse
+// @Failure 401 {object} orderDto.ErrorResponse
+// @Failure 403 {object} orderDto.ErrorResponse
+// @Failure 404 {object} orderDto.ErrorResponse
+// @Failure 429 {object} orderDto.ErrorResponse
+// @Failure 500 {object} orderDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/orders/groups [post]
+func (h *OrderHandler) CreateGroup(c *gin.Context) {
+	var req orderDto.CreateGroupRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, orderDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
 		return
 	}
 
-	orders, err := h.orderService.GetOrdersByUserID(c.Request.Context(), claims.UserID)
+	shares := make([]int, len(req.Shares))
+	for i, share := range req.Shares {
+		shares[i] = share.Quantity
+	}
+	details := order.Details{
+		Notes:      req.Notes,
+		Answers:    req.Answers,
+		AccessCode: req.AccessCode,
+		ClientIP:   c.ClientIP(),
+	}
+	if h.countryHeader != "" {
+		details.Country = c.GetHeader(h.countryHeader)
+	}
+
+	reservation, err := h.orderService.CreateGroup(c.Request.Context(), currentUser.UserID, req.EventID, shares, details)
+	if err != nil {
+		writeOrderCreationError(c, err, "Failed to reserve tickets for the group: ")
+		return
+	}
+
+	response := mapGroupToResponse(reservation.Group)
+	for i, token := range reservation.Tokens {
+		response.Shares[i].PaymentURL = h.publicURL + "/groups/pay?token=" + url.QueryEscape(token)
+	}
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetGroup retrieves a group reservation with its shares
+// @Summary Get a group reservation
+// @Description Get a group reservation with the status of each share and who holds it (leader only). Payment links are not shown again.
+// @Tags orders
+// @Produce json
+// @Param id path string true "Group ID"
+// @Success 200 {object} orderDto.GroupResponse
+// @Failure 400 {object} orderDto.ErrorResponse
+// @Failure 401 {object} orderDto.ErrorResponse
+// @Failure 404 {object} orderDto.ErrorResponse
+// @Failure 500 {object} orderDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/orders/groups/{id} [get]
+func (h *OrderHandler) GetGroup(c *gin.Context) {
+	groupID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	group, err := h.orderService.GetGroup(c.Request.Context(), currentUser.UserID, groupID)
+	if err != nil {
+		writeGroupError(c, err, "Failed to retrieve group: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapGroupToResponse(group))
+}
+
+// TakeGroupShare moves a group share to the current user, who then pays it like any pending order
+// @Summary Take a group share
+// @Description Take a share of a group reservation with the token from its payment link; the pending order moves to the current user,
+// @Description who pays it before hold_until to get the tickets. Each link works once.
+// @Description Unknown or used links answer 404 GROUP_SHARE_NOT_FOUND, shares another member took or paid 409 GROUP_SHARE_TAKEN,
+// @Description and shares whose hold ended 410 GROUP_HOLD_EXPIRED.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param share body orderDto.TakeGroupShareRequest true "Payment link token"
+// @Success 200 {object} orderDto.OrderResponse
+// @Failure 400 {object} orderDto.ErrorResponse
+// @Failure 401 {object} orderDto.ErrorResponse
+// @Failure 404 {object} orderDto.ErrorResponse
+// @Failure 409 {object} orderDto.ErrorResponse
+// @Failure 410 {object} orderDto.ErrorResponse
+// @Failure 500 {object} orderDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/orders/groups/shares/take [post]
+func (h *OrderHandler) TakeGroupShare(c *gin.Context) {
+	var req orderDto.TakeGroupShareRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, orderDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	share, err := h.orderService.TakeGroupShare(c.Request.Context(), currentUser.UserID, req.Token)
+	if err != nil {
+		writeGroupError(c, err, "Failed to take group share: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapOrderToResponse(share))
+}
+
+// writeGroupError maps errors of reading groups and taking their shares to responses
+func writeGroupError(c *gin.Context, err error, prefix string) {
+	switch {
+	case order.IsGroupNotFoundError(err) || order.IsGroupShareNotFoundError(err):
+		c.JSON(http.StatusNotFound, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	case order.IsGroupShareTakenError(err):
+		c.JSON(http.StatusConflict, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	case order.IsGroupHoldExpiredError(err):
+		c.JSON(http.StatusGone, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	case order.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, orderDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, orderDto.ErrorResponse{
+			Error:   "group_error",
+			Message: prefix + err.Error(),
+		})
+	}
+}
+
+// ListReviewQueue lists orders held by the fraud checks
+// @Summary List orders awaiting review
+// @Description List orders held for manual review, oldest first, with the reasons they were held (requires ADMIN role)
+// @Tags admin
+// @Produce json
+// @Param limit query int false "Maximum number of orders (default 100, max 100)"
+// @Success 200 {object} orderDto.ReviewQueueResponse
+// @Failure 401 {object} orderDto.ErrorResponse
+// @Failure 403 {object} orderDto.ErrorResponse
+// @Failure 500 {object} orderDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/orders/review [get]
+func (h *OrderHandler) ListReviewQueue(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "100"))
+
+	orders, err := h.orderService.ListOrdersForReview(c.Request.Context(), limit)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, orderDto.ErrorResponse{
 			Error:   "retrieval_error",
@@ -224,16 +625,68 @@ func (h *OrderHandler) GetMyOrders(c *gin.Context) {
 		return
 	}
 
-	response := orderDto.OrderListResponse{
-		Orders: make([]orderDto.OrderResponse, len(orders)),
-		Count:  len(orders),
+	items := make([]orderDto.ReviewOrderResponse, len(orders))
+	for i, o := range orders {
+		items[i] = mapOrderToReviewResponse(o)
 	}
 
-	for i, o := range orders {
-		response.Orders[i] = mapOrderToResponse(o)
+	c.JSON(http.StatusOK, orderDto.ReviewQueueResponse{ListResponse: newList(c, items)})
+}
+
+// ReviewOrder approves or rejects an order held by the fraud checks
+// @Summary Decide on an order awaiting review
+// @Description Approve a held order, releasing it as PENDING, or reject it, failing it and returning its tickets (requires ADMIN role)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param decision body orderDto.ReviewOrderRequest true "Decision"
+// @Success 200 {object} orderDto.ReviewOrderResponse
+// @Failure 400 {object} orderDto.ErrorResponse
+// @Failure 401 {object} orderDto.ErrorResponse
+// @Failure 403 {object} orderDto.ErrorResponse
+// @Failure 404 {object} orderDto.ErrorResponse
+// @Failure 409 {object} orderDto.ErrorResponse
+// @Failure 500 {object} orderDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/orders/{id}/review [post]
+func (h *OrderHandler) ReviewOrder(c *gin.Context) {
+	orderID, ok := PathUUID(c, "id")
+	if !ok {
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	var req orderDto.ReviewOrderRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, orderDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	reviewed, err := h.orderService.ReviewOrder(c.Request.Context(), orderID, *req.Approve)
+	if err != nil {
+		if order.IsOrderNotFoundError(err) {
+			c.JSON(http.StatusNotFound, orderDto.ErrorResponse{
+				Error:   order.GetOrderErrorCode(err),
+				Message: err.Error(),
+			})
+		} else if order.IsOrderNotInReviewError(err) {
+			c.JSON(http.StatusConflict, orderDto.ErrorResponse{
+				Error:   order.GetOrderErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, orderDto.ErrorResponse{
+				Error:   "review_error",
+				Message: "Failed to review order: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, mapOrderToReviewResponse(reviewed))
 }
 
 // RegisterRoutes registers order routes with the gin router
@@ -245,32 +698,130 @@ func (h *OrderHandler) RegisterRoutes(router *gin.RouterGroup) {
 	orderRoutes := router.Group("/orders")
 	{
 		// User routes (require USER role)
-		orderRoutes.POST("",
-			jwtMiddleware.AuthRequired(),
-			auth.RequireUser(),
-			h.CreateOrder)
+		createHandlers := []gin.HandlerFunc{jwtMiddleware.AuthRequired(), auth.RequireUser()}
+		createHandlers = append(createHandlers, h.purchaseChecks...)
+		orderRoutes.POST("", append(createHandlers, h.CreateOrder)...)
 
 		orderRoutes.GET("/:id",
 			jwtMiddleware.AuthRequired(),
 			auth.RequireUser(),
+			UUIDParams("id"),
 			h.GetOrder)
 
+		orderRoutes.GET("/:id/status",
+			jwtMiddleware.AuthRequired(),
+			auth.RequireUser(),
+			UUIDParams("id"),
+			h.GetOrderStatus)
+
 		orderRoutes.GET("/my-orders",
 			jwtMiddleware.AuthRequired(),
 			auth.RequireUser(),
 			h.GetMyOrders)
+
+		orderRoutes.POST("/gifts/claim",
+			jwtMiddleware.AuthRequired(),
+			auth.RequireUser(),
+			h.ClaimGift)
+
+		orderRoutes.POST("/:id/gift/resend",
+			jwtMiddleware.AuthRequired(),
+			auth.RequireUser(),
+			UUIDParams("id"),
+			h.ResendGift)
+
+		// Group reservations take tickets like an order, so they pass the same checks
+		orderRoutes.POST("/groups", append(createHandlers, h.CreateGroup)...)
+
+		orderRoutes.GET("/groups/:id",
+			jwtMiddleware.AuthRequired(),
+			auth.RequireUser(),
+			UUIDParams("id"),
+			h.GetGroup)
+
+		orderRoutes.POST("/groups/shares/take",
+			jwtMiddleware.AuthRequired(),
+			auth.RequireUser(),
+			h.TakeGroupShare)
+	}
+
+	// Admin routes (require ADMIN role)
+	adminRoutes := router.Group("/admin/orders", jwtMiddleware.AuthRequired(), auth.RequireAdmin())
+	{
+		adminRoutes.GET("/review", h.ListReviewQueue)
+		adminRoutes.POST("/:id/review", UUIDParams("id"), h.ReviewOrder)
 	}
 }
 
 // mapOrderToResponse converts order entity to response DTO
 func mapOrderToResponse(o *order.Order) orderDto.OrderResponse {
-	return orderDto.OrderResponse{
+	response := orderDto.OrderResponse{
 		ID:          o.ID,
 		UserID:      o.UserID,
 		EventID:     o.EventID,
 		Quantity:    o.Quantity,
 		TotalAmount: o.TotalAmount,
-		Status:      o.Status,
+		Status:      string(o.Status),
+		CheckedInAt: o.CheckedInAt,
+		Notes:       o.Notes,
+		Answers:     o.Answers,
 		CreatedAt:   o.CreatedAt,
+		Archived:    o.IsArchived(),
+	}
+	if o.InvoiceNumber != nil {
+		response.InvoiceNumber = invoice.FormatNumber(*o.InvoiceNumber)
+	}
+	if o.IsGroupShare() {
+		response.GroupID = o.GroupID
+		response.HoldUntil = o.HoldUntil
+	}
+	if o.IsGift() {
+		response.Gift = &orderDto.GiftResponse{
+			Recipient: o.GiftRecipient,
+			Status:    orderDto.GiftStatusUnclaimed,
+			GiftedBy:  o.GiftedBy,
+			ClaimedAt: o.GiftClaimedAt,
+		}
+		if o.IsGiftClaimed() {
+			response.Gift.Status = orderDto.GiftStatusClaimed
+		}
+	}
+	return response
+}
+
+// mapGroupToResponse converts a group reservation to response DTO, without payment links
+func mapGroupToResponse(g *order.Group) orderDto.GroupResponse {
+	response := orderDto.GroupResponse{
+		ID:        g.ID,
+		EventID:   g.EventID,
+		HoldUntil: g.HoldUntil,
+		Shares:    make([]orderDto.GroupShareResponse, len(g.Shares)),
+		CreatedAt: g.CreatedAt,
+	}
+	for i, share := range g.Shares {
+		response.TotalAmount += share.TotalAmount
+		response.Shares[i] = orderDto.GroupShareResponse{
+			OrderID:     share.ID,
+			Quantity:    share.Quantity,
+			TotalAmount: share.TotalAmount,
+			Status:      string(share.Status),
+			Taken:       share.UserID != g.LeaderID,
+			HolderID:    share.UserID,
+		}
+	}
+	return response
+}
+
+// mapOrderToReviewResponse converts a held order to response DTO, including its risk assessment
+func mapOrderToReviewResponse(o *order.Order) orderDto.ReviewOrderResponse {
+	reasons := o.RiskReasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+	return orderDto.ReviewOrderResponse{
+		OrderResponse: mapOrderToResponse(o),
+		RiskScore:     o.RiskScore,
+		RiskReasons:   reasons,
+		Country:       o.Country,
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"enterprise-crud/internal/domain/event"
 	"enterprise-crud/internal/domain/order"
 	orderDto "enterprise-crud/internal/dto/order"
 	"enterprise-crud/internal/infrastructure/auth"
@@ -18,6 +19,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockOrderService is a mock implementation of order.Service
@@ -25,8 +27,8 @@ type MockOrderService struct {
 	mock.Mock
 }
 
-func (m *MockOrderService) CreateOrder(ctx context.Context, userID uuid.UUID, eventID uuid.UUID, quantity int) (*order.Order, error) {
-	args := m.Called(ctx, userID, eventID, quantity)
+func (m *MockOrderService) CreateOrder(ctx context.Context, userID uuid.UUID, eventID uuid.UUID, quantity int, details order.Details) (*order.Order, error) {
+	args := m.Called(ctx, userID, eventID, quantity, details)
 	return args.Get(0).(*order.Order), args.Error(1)
 }
 
@@ -35,6 +37,14 @@ func (m *MockOrderService) GetOrderByID(ctx context.Context, id uuid.UUID) (*ord
 	return args.Get(0).(*order.Order), args.Error(1)
 }
 
+func (m *MockOrderService) GetOrderStatus(ctx context.Context, id uuid.UUID) (*order.StatusSnapshot, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.StatusSnapshot), args.Error(1)
+}
+
 func (m *MockOrderService) GetOrdersByUserID(ctx context.Context, userID uuid.UUID) ([]*order.Order, error) {
 	args := m.Called(ctx, userID)
 	return args.Get(0).([]*order.Order), args.Error(1)
@@ -45,7 +55,15 @@ func (m *MockOrderService) GetOrdersByEventID(ctx context.Context, eventID uuid.
 	return args.Get(0).([]*order.Order), args.Error(1)
 }
 
-func (m *MockOrderService) UpdateOrderStatus(ctx context.Context, id uuid.UUID, status string) error {
+func (m *MockOrderService) GetAttendees(ctx context.Context, eventID uuid.UUID) ([]*order.Attendee, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Attendee), args.Error(1)
+}
+
+func (m *MockOrderService) UpdateOrderStatus(ctx context.Context, id uuid.UUID, status order.Status) error {
 	args := m.Called(ctx, id, status)
 	return args.Error(0)
 }
@@ -55,6 +73,88 @@ func (m *MockOrderService) DeleteOrder(ctx context.Context, id uuid.UUID) error
 	return args.Error(0)
 }
 
+func (m *MockOrderService) CheckIn(ctx context.Context, eventID uuid.UUID, orderID uuid.UUID) (*order.Order, error) {
+	args := m.Called(ctx, eventID, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockOrderService) ExpirePendingOrders(ctx context.Context, olderThan time.Duration) (int64, error) {
+	args := m.Called(ctx, olderThan)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockOrderService) ListOrdersForReview(ctx context.Context, limit int) ([]*order.Order, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
+func (m *MockOrderService) ReviewOrder(ctx context.Context, id uuid.UUID, approve bool) (*order.Order, error) {
+	args := m.Called(ctx, id, approve)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockOrderService) ReconcileTickets(ctx context.Context, dryRun bool) (*order.Reconciliation, error) {
+	args := m.Called(ctx, dryRun)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Reconciliation), args.Error(1)
+}
+
+func (m *MockOrderService) ArchiveEvents(ctx context.Context, cutoff time.Time) (*order.Archived, error) {
+	args := m.Called(ctx, cutoff)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Archived), args.Error(1)
+}
+
+func (m *MockOrderService) ResendGift(ctx context.Context, userID, orderID uuid.UUID) error {
+	args := m.Called(ctx, userID, orderID)
+	return args.Error(0)
+}
+
+func (m *MockOrderService) ClaimGift(ctx context.Context, userID uuid.UUID, token string) (*order.Order, error) {
+	args := m.Called(ctx, userID, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockOrderService) CreateGroup(ctx context.Context, leaderID, eventID uuid.UUID, shares []int, details order.Details) (*order.GroupReservation, error) {
+	args := m.Called(ctx, leaderID, eventID, shares, details)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.GroupReservation), args.Error(1)
+}
+
+func (m *MockOrderService) GetGroup(ctx context.Context, leaderID, groupID uuid.UUID) (*order.Group, error) {
+	args := m.Called(ctx, leaderID, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Group), args.Error(1)
+}
+
+func (m *MockOrderService) TakeGroupShare(ctx context.Context, userID uuid.UUID, token string) (*order.Order, error) {
+	args := m.Called(ctx, userID, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
 func setupOrderHandlerTest() (*gin.Engine, *MockOrderService) {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
@@ -120,7 +220,7 @@ func TestOrderHandler_CreateOrder_Success(t *testing.T) {
 		CreatedAt:   time.Now(),
 	}
 
-	mockService.On("CreateOrder", mock.Anything, mock.AnythingOfType("uuid.UUID"), eventID, 2).Return(expectedOrder, nil)
+	mockService.On("CreateOrder", mock.Anything, mock.AnythingOfType("uuid.UUID"), eventID, 2, order.Details{ClientIP: "192.0.2.1"}).Return(expectedOrder, nil)
 
 	body, _ := json.Marshal(requestBody)
 	req := httptest.NewRequest(http.MethodPost, "/orders", bytes.NewReader(body))
@@ -140,7 +240,7 @@ func TestOrderHandler_CreateOrder_Success(t *testing.T) {
 	assert.Equal(t, expectedOrder.EventID, response.EventID)
 	assert.Equal(t, expectedOrder.Quantity, response.Quantity)
 	assert.Equal(t, expectedOrder.TotalAmount, response.TotalAmount)
-	assert.Equal(t, expectedOrder.Status, response.Status)
+	assert.Equal(t, string(expectedOrder.Status), response.Status)
 
 	mockService.AssertExpectations(t)
 }
@@ -182,7 +282,7 @@ func TestOrderHandler_CreateOrder_InvalidQuantity(t *testing.T) {
 		Quantity: 1, // Valid quantity for JSON binding
 	}
 
-	mockService.On("CreateOrder", mock.Anything, mock.AnythingOfType("uuid.UUID"), eventID, 1).Return((*order.Order)(nil), order.NewInvalidQuantityError(1))
+	mockService.On("CreateOrder", mock.Anything, mock.AnythingOfType("uuid.UUID"), eventID, 1, order.Details{ClientIP: "192.0.2.1"}).Return((*order.Order)(nil), order.NewInvalidQuantityError(1))
 
 	body, _ := json.Marshal(requestBody)
 	req := httptest.NewRequest(http.MethodPost, "/orders", bytes.NewReader(body))
@@ -214,7 +314,7 @@ func TestOrderHandler_CreateOrder_EventNotFound(t *testing.T) {
 		Quantity: 2,
 	}
 
-	mockService.On("CreateOrder", mock.Anything, mock.AnythingOfType("uuid.UUID"), eventID, 2).Return((*order.Order)(nil), order.NewEventNotFoundError(eventID))
+	mockService.On("CreateOrder", mock.Anything, mock.AnythingOfType("uuid.UUID"), eventID, 2, order.Details{ClientIP: "192.0.2.1"}).Return((*order.Order)(nil), order.NewEventNotFoundError(eventID))
 
 	body, _ := json.Marshal(requestBody)
 	req := httptest.NewRequest(http.MethodPost, "/orders", bytes.NewReader(body))
@@ -246,7 +346,7 @@ func TestOrderHandler_CreateOrder_InsufficientTickets(t *testing.T) {
 		Quantity: 10,
 	}
 
-	mockService.On("CreateOrder", mock.Anything, mock.AnythingOfType("uuid.UUID"), eventID, 10).Return((*order.Order)(nil), order.NewInsufficientTicketsError(10, 5))
+	mockService.On("CreateOrder", mock.Anything, mock.AnythingOfType("uuid.UUID"), eventID, 10, order.Details{ClientIP: "192.0.2.1"}).Return((*order.Order)(nil), order.NewInsufficientTicketsError(10, 5))
 
 	body, _ := json.Marshal(requestBody)
 	req := httptest.NewRequest(http.MethodPost, "/orders", bytes.NewReader(body))
@@ -267,6 +367,43 @@ func TestOrderHandler_CreateOrder_InsufficientTickets(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestOrderHandler_CreateOrder_InvalidAnswers(t *testing.T) {
+	// Arrange
+	router, mockService := setupOrderHandlerTest()
+
+	eventID := uuid.New()
+
+	requestBody := orderDto.CreateOrderRequest{
+		EventID:  eventID,
+		Quantity: 1,
+		Notes:    "Arriving late",
+		Answers:  map[string]interface{}{"tshirt_size": "XXL"},
+	}
+
+	details := order.Details{Notes: "Arriving late", Answers: map[string]interface{}{"tshirt_size": "XXL"}, ClientIP: "192.0.2.1"}
+	answersErr := event.NewInvalidAnswersError("tshirt_size must be one of: S, M, L")
+	mockService.On("CreateOrder", mock.Anything, mock.AnythingOfType("uuid.UUID"), eventID, 1, details).Return((*order.Order)(nil), order.NewInvalidAnswersError(answersErr))
+
+	body, _ := json.Marshal(requestBody)
+	req := httptest.NewRequest(http.MethodPost, "/orders", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response orderDto.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, order.InvalidAnswersErrorCode, response.Error)
+	assert.Contains(t, response.Message, "tshirt_size must be one of")
+
+	mockService.AssertExpectations(t)
+}
+
 func TestOrderHandler_GetOrder_Success(t *testing.T) {
 	// Arrange
 	router, mockService := setupOrderHandlerTest()
@@ -430,10 +567,10 @@ func TestOrderHandler_GetMyOrders_Success(t *testing.T) {
 	var response orderDto.OrderListResponse
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	assert.NoError(t, err)
-	assert.Len(t, response.Orders, 2)
-	assert.Equal(t, 2, response.Count)
-	assert.Equal(t, expectedOrders[0].ID, response.Orders[0].ID)
-	assert.Equal(t, expectedOrders[1].ID, response.Orders[1].ID)
+	assert.Len(t, response.Data, 2)
+	assert.Equal(t, 2, response.Meta.Count)
+	assert.Equal(t, expectedOrders[0].ID, response.Data[0].ID)
+	assert.Equal(t, expectedOrders[1].ID, response.Data[1].ID)
 
 	mockService.AssertExpectations(t)
 }
@@ -457,3 +594,424 @@ func TestOrderHandler_GetOrder_InvalidID(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "invalid_id", response.Error)
 }
+
+func TestOrderHandler_CreateOrder_Rejected(t *testing.T) {
+	router, mockService := setupOrderHandlerTest()
+	eventID := uuid.New()
+
+	mockService.On("CreateOrder", mock.Anything, mock.AnythingOfType("uuid.UUID"), eventID, 2, order.Details{ClientIP: "192.0.2.1"}).Return((*order.Order)(nil), order.NewOrderRejectedError())
+
+	body, _ := json.Marshal(orderDto.CreateOrderRequest{EventID: eventID, Quantity: 2})
+	req := httptest.NewRequest(http.MethodPost, "/orders", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), order.OrderRejectedErrorCode)
+}
+
+func TestOrderHandler_CreateOrder_ReadsCountryHeader(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockOrderService)
+	handler := httpHandlers.NewOrderHandler(mockService, &auth.JWTService{})
+	handler.ReadCountryFrom("CF-IPCountry")
+
+	router := gin.New()
+	router.POST("/orders", func(c *gin.Context) {
+		c.Set("user", &auth.JWTClaims{UserID: uuid.New(), Roles: []string{"USER"}})
+	}, handler.CreateOrder)
+
+	eventID := uuid.New()
+	created := &order.Order{ID: uuid.New(), EventID: eventID, Quantity: 1, Status: order.StatusReview}
+	mockService.On("CreateOrder", mock.Anything, mock.AnythingOfType("uuid.UUID"), eventID, 1, order.Details{ClientIP: "192.0.2.1", Country: "NL"}).Return(created, nil)
+
+	body, _ := json.Marshal(orderDto.CreateOrderRequest{EventID: eventID, Quantity: 1})
+	req := httptest.NewRequest(http.MethodPost, "/orders", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("CF-IPCountry", "NL")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), order.StatusReview)
+	mockService.AssertExpectations(t)
+}
+
+func setupOrderStatusTest(userID uuid.UUID) (*gin.Engine, *MockOrderService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockOrderService)
+	handler := httpHandlers.NewOrderHandler(mockService, &auth.JWTService{})
+	handler.CacheStatusFor(5 * time.Second)
+
+	router := gin.New()
+	router.GET("/orders/:id/status", func(c *gin.Context) {
+		c.Set("user", &auth.JWTClaims{UserID: userID, Roles: []string{"USER"}})
+	}, handler.GetOrderStatus)
+	return router, mockService
+}
+
+func TestOrderHandler_GetOrderStatus(t *testing.T) {
+	userID := uuid.New()
+	router, mockService := setupOrderStatusTest(userID)
+	orderID := uuid.New()
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockService.On("GetOrderStatus", mock.Anything, orderID).Return(&order.StatusSnapshot{
+		ID: orderID, UserID: userID, Status: order.StatusCompleted, UpdatedAt: updatedAt,
+	}, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/orders/"+orderID.String()+"/status", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"COMPLETED","updated_at":"2026-03-01T12:00:00Z"}`, w.Body.String())
+	assert.Equal(t, "private, max-age=5", w.Header().Get("Cache-Control"))
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	// The same status is not sent again
+	req := httptest.NewRequest(http.MethodGet, "/orders/"+orderID.String()+"/status", nil)
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+}
+
+func TestOrderHandler_GetOrderStatus_ChangedOrderGetsNewETag(t *testing.T) {
+	userID := uuid.New()
+	router, mockService := setupOrderStatusTest(userID)
+	orderID := uuid.New()
+	updatedAt := time.Now()
+	mockService.On("GetOrderStatus", mock.Anything, orderID).Return(&order.StatusSnapshot{
+		ID: orderID, UserID: userID, Status: order.StatusPending, UpdatedAt: updatedAt,
+	}, nil).Once()
+	mockService.On("GetOrderStatus", mock.Anything, orderID).Return(&order.StatusSnapshot{
+		ID: orderID, UserID: userID, Status: order.StatusCompleted, UpdatedAt: updatedAt.Add(time.Second),
+	}, nil).Once()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/orders/"+orderID.String()+"/status", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/orders/"+orderID.String()+"/status", nil)
+	req.Header.Set("If-None-Match", w.Header().Get("ETag"))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), order.StatusCompleted)
+	assert.NotEqual(t, req.Header.Get("If-None-Match"), w.Header().Get("ETag"))
+}
+
+func TestOrderHandler_GetOrderStatus_Forbidden(t *testing.T) {
+	router, mockService := setupOrderStatusTest(uuid.New())
+	orderID := uuid.New()
+	snapshot := &order.StatusSnapshot{ID: orderID, UserID: uuid.New(), Status: order.StatusPending, UpdatedAt: time.Now()}
+	mockService.On("GetOrderStatus", mock.Anything, orderID).Return(snapshot, nil)
+
+	// A matching ETag doesn't get around the ownership check
+	req := httptest.NewRequest(http.MethodGet, "/orders/"+orderID.String()+"/status", nil)
+	req.Header.Set("If-None-Match", "*")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Empty(t, w.Header().Get("ETag"))
+}
+
+func TestOrderHandler_GetOrderStatus_NotFound(t *testing.T) {
+	router, mockService := setupOrderStatusTest(uuid.New())
+	orderID := uuid.New()
+	mockService.On("GetOrderStatus", mock.Anything, orderID).Return(nil, order.NewOrderNotFoundError(orderID))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/orders/"+orderID.String()+"/status", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), order.OrderNotFoundErrorCode)
+}
+
+func setupOrderReviewTest() (*gin.Engine, *MockOrderService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockOrderService)
+	handler := httpHandlers.NewOrderHandler(mockService, &auth.JWTService{})
+
+	router := gin.New()
+	router.GET("/admin/orders/review", handler.ListReviewQueue)
+	router.POST("/admin/orders/:id/review", handler.ReviewOrder)
+	return router, mockService
+}
+
+func TestOrderHandler_ListReviewQueue(t *testing.T) {
+	router, mockService := setupOrderReviewTest()
+	held := &order.Order{
+		ID:          uuid.New(),
+		Status:      order.StatusReview,
+		RiskScore:   50,
+		RiskReasons: []string{order.ReasonRapidPurchases},
+		Country:     "NL",
+	}
+	mockService.On("ListOrdersForReview", mock.Anything, 20).Return([]*order.Order{held}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/orders/review?limit=20", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response orderDto.ReviewQueueResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Meta.Count)
+	assert.Equal(t, held.ID, response.Data[0].ID)
+	assert.Equal(t, 50, response.Data[0].RiskScore)
+	assert.Equal(t, []string{order.ReasonRapidPurchases}, response.Data[0].RiskReasons)
+	assert.Equal(t, "NL", response.Data[0].Country)
+}
+
+func TestOrderHandler_ReviewOrder(t *testing.T) {
+	orderID := uuid.New()
+
+	t.Run("approves the order", func(t *testing.T) {
+		router, mockService := setupOrderReviewTest()
+		mockService.On("ReviewOrder", mock.Anything, orderID, true).Return(&order.Order{ID: orderID, Status: order.StatusPending}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/orders/"+orderID.String()+"/review", bytes.NewBufferString(`{"approve":true}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), order.StatusPending)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("requires a decision", func(t *testing.T) {
+		router, mockService := setupOrderReviewTest()
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/orders/"+orderID.String()+"/review", bytes.NewBufferString(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "ReviewOrder", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("conflicts when the order is not in review", func(t *testing.T) {
+		router, mockService := setupOrderReviewTest()
+		mockService.On("ReviewOrder", mock.Anything, orderID, false).Return(nil, order.NewOrderNotInReviewError(orderID, order.StatusCompleted))
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/orders/"+orderID.String()+"/review", bytes.NewBufferString(`{"approve":false}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), order.OrderNotInReviewErrorCode)
+	})
+}
+
+func setupOrderGiftTest(userID uuid.UUID) (*gin.Engine, *MockOrderService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockOrderService)
+	handler := httpHandlers.NewOrderHandler(mockService, &auth.JWTService{})
+
+	router := gin.New()
+	setUser := func(c *gin.Context) {
+		c.Set("user", &auth.JWTClaims{UserID: userID, Roles: []string{"USER"}})
+	}
+	router.POST("/orders/gifts/claim", setUser, handler.ClaimGift)
+	router.POST("/orders/:id/gift/resend", setUser, handler.ResendGift)
+	router.GET("/orders/:id", setUser, handler.GetOrder)
+	return router, mockService
+}
+
+func TestOrderHandler_ClaimGift(t *testing.T) {
+	userID := uuid.New()
+	buyerID := uuid.New()
+	orderID := uuid.New()
+
+	t.Run("claimed gift is returned", func(t *testing.T) {
+		router, mockService := setupOrderGiftTest(userID)
+		claimedAt := time.Now()
+		mockService.On("ClaimGift", mock.Anything, userID, "abc").Return(&order.Order{
+			ID: orderID, UserID: userID, Status: order.StatusCompleted,
+			GiftRecipient: "friend@example.com", GiftedBy: &buyerID, GiftClaimedAt: &claimedAt,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/orders/gifts/claim", bytes.NewBufferString(`{"token":"abc"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response orderDto.OrderResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.NotNil(t, response.Gift)
+		assert.Equal(t, orderDto.GiftStatusClaimed, response.Gift.Status)
+		assert.Equal(t, &buyerID, response.Gift.GiftedBy)
+	})
+
+	t.Run("claimed twice conflicts", func(t *testing.T) {
+		router, mockService := setupOrderGiftTest(userID)
+		mockService.On("ClaimGift", mock.Anything, userID, "abc").Return(nil, order.NewGiftAlreadyClaimedError(orderID))
+
+		req := httptest.NewRequest(http.MethodPost, "/orders/gifts/claim", bytes.NewBufferString(`{"token":"abc"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), order.GiftAlreadyClaimedErrorCode)
+	})
+
+	t.Run("unknown token is not found", func(t *testing.T) {
+		router, mockService := setupOrderGiftTest(userID)
+		mockService.On("ClaimGift", mock.Anything, userID, "abc").Return(nil, order.NewGiftNotFoundError())
+
+		req := httptest.NewRequest(http.MethodPost, "/orders/gifts/claim", bytes.NewBufferString(`{"token":"abc"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("resend is accepted", func(t *testing.T) {
+		router, mockService := setupOrderGiftTest(buyerID)
+		mockService.On("ResendGift", mock.Anything, buyerID, orderID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/orders/"+orderID.String()+"/gift/resend", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("buyer still sees a claimed gift", func(t *testing.T) {
+		router, mockService := setupOrderGiftTest(buyerID)
+		claimedAt := time.Now()
+		mockService.On("GetOrderByID", mock.Anything, orderID).Return(&order.Order{
+			ID: orderID, UserID: userID, Status: order.StatusCompleted,
+			GiftRecipient: "friend@example.com", GiftedBy: &buyerID, GiftClaimedAt: &claimedAt,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/orders/"+orderID.String(), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func setupOrderGroupTest(userID uuid.UUID) (*gin.Engine, *MockOrderService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockOrderService)
+	handler := httpHandlers.NewOrderHandler(mockService, &auth.JWTService{})
+	handler.LinkGroupSharesTo("https://tickets.example.com/")
+
+	router := gin.New()
+	setUser := func(c *gin.Context) {
+		c.Set("user", &auth.JWTClaims{UserID: userID, Roles: []string{"USER"}})
+	}
+	router.POST("/orders/groups", setUser, handler.CreateGroup)
+	router.POST("/orders/groups/shares/take", setUser, handler.TakeGroupShare)
+	return router, mockService
+}
+
+func TestOrderHandler_CreateGroup(t *testing.T) {
+	leaderID := uuid.New()
+	eventID := uuid.New()
+
+	t.Run("payment links are returned once per share", func(t *testing.T) {
+		router, mockService := setupOrderGroupTest(leaderID)
+		memberID := uuid.New()
+		holdUntil := time.Now().Add(48 * time.Hour)
+		group := &order.Group{ID: uuid.New(), EventID: eventID, LeaderID: leaderID, HoldUntil: holdUntil, Shares: []*order.Order{
+			{ID: uuid.New(), UserID: leaderID, Quantity: 2, TotalAmount: 40, Status: order.StatusPending},
+			{ID: uuid.New(), UserID: memberID, Quantity: 1, TotalAmount: 20, Status: order.StatusPending},
+		}}
+		mockService.On("CreateGroup", mock.Anything, leaderID, eventID, []int{2, 1}, mock.AnythingOfType("order.Details")).
+			Return(&order.GroupReservation{Group: group, Tokens: []string{"first", "second"}}, nil)
+
+		body := `{"event_id":"` + eventID.String() + `","shares":[{"quantity":2},{"quantity":1}]}`
+		req := httptest.NewRequest(http.MethodPost, "/orders/groups", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		var response orderDto.GroupResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 60.0, response.TotalAmount)
+		require.Len(t, response.Shares, 2)
+		assert.Equal(t, "https://tickets.example.com/groups/pay?token=first", response.Shares[0].PaymentURL)
+		assert.False(t, response.Shares[0].Taken)
+		assert.True(t, response.Shares[1].Taken)
+	})
+
+	t.Run("one share is not a group", func(t *testing.T) {
+		router, mockService := setupOrderGroupTest(leaderID)
+
+		body := `{"event_id":"` + eventID.String() + `","shares":[{"quantity":2}]}`
+		req := httptest.NewRequest(http.MethodPost, "/orders/groups", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestOrderHandler_TakeGroupShare(t *testing.T) {
+	userID := uuid.New()
+	shareID := uuid.New()
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"unknown link", order.NewGroupShareNotFoundError(), http.StatusNotFound},
+		{"taken by someone else", order.NewGroupShareTakenError(shareID), http.StatusConflict},
+		{"hold ended", order.NewGroupHoldExpiredError(shareID, time.Now()), http.StatusGone},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService := setupOrderGroupTest(userID)
+			mockService.On("TakeGroupShare", mock.Anything, userID, "abc").Return(nil, tc.err)
+
+			req := httptest.NewRequest(http.MethodPost, "/orders/groups/shares/take", bytes.NewBufferString(`{"token":"abc"}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.status, w.Code)
+			assert.Contains(t, w.Body.String(), order.GetOrderErrorCode(tc.err))
+		})
+	}
+
+	t.Run("taken share is returned pending with its hold", func(t *testing.T) {
+		router, mockService := setupOrderGroupTest(userID)
+		groupID := uuid.New()
+		holdUntil := time.Now().Add(time.Hour)
+		mockService.On("TakeGroupShare", mock.Anything, userID, "abc").Return(&order.Order{
+			ID: shareID, UserID: userID, Status: order.StatusPending, GroupID: &groupID, HoldUntil: &holdUntil,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/orders/groups/shares/take", bytes.NewBufferString(`{"token":"abc"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response orderDto.OrderResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, &groupID, response.GroupID)
+		assert.NotNil(t, response.HoldUntil)
+	})
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"enterprise-crud/internal/dto/common"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// uuidParamKey is the context key a validated UUID path parameter is stored under
+func uuidParamKey(name string) string {
+	return "uuid_param:" + name
+}
+
+// UUIDParams validates the named path parameters as UUIDs before the handler runs
+// Parsed values are stored in the context for PathUUID; a malformed one aborts with 400 invalid_id.
+func UUIDParams(names ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, name := range names {
+			if _, ok := PathUUID(c, name); !ok {
+				c.Abort()
+				return
+			}
+		}
+		c.Next()
+	}
+}
+
+// PathUUID returns the named path parameter as a UUID
+// Values validated by UUIDParams are read from the context. Otherwise the parameter is parsed here,
+// and if it is malformed the 400 invalid_id response is written and ok is false.
+func PathUUID(c *gin.Context, name string) (id uuid.UUID, ok bool) {
+	if value, exists := c.Get(uuidParamKey(name)); exists {
+		return value.(uuid.UUID), true
+	}
+
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_id",
+			"message": name + " must be a valid UUID",
+		})
+		return uuid.Nil, false
+	}
+
+	c.Set(uuidParamKey(name), id)
+	return id, true
+}
+
+// QueryOffset returns how many items to skip before the requested page of a list
+// It reads the cursor query parameter, or offset without one, so lists paged by offset keep accepting it.
+func QueryOffset(c *gin.Context) (int, error) {
+	if c.Query("cursor") != "" {
+		return QueryCursor(c)
+	}
+
+	raw := c.Query("offset")
+	if raw == "" {
+		return 0, nil
+	}
+	offset, err := strconv.Atoi(raw)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("offset must be a non-negative number")
+	}
+	return offset, nil
+}
+
+// QueryCursor returns how many items to skip before the page the cursor query parameter asks for
+// Lists that never took offset only read the cursor.
+func QueryCursor(c *gin.Context) (int, error) {
+	token := c.Query("cursor")
+	if token == "" {
+		return 0, nil
+	}
+	cursor, err := common.ParseCursor(token)
+	return cursor.Offset, err
+}
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUIDParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/things/:id", UUIDParams("id"), func(c *gin.Context) {
+		id, ok := PathUUID(c, "id")
+		if !ok {
+			return
+		}
+		c.String(http.StatusOK, id.String())
+	})
+
+	t.Run("valid id reaches the handler", func(t *testing.T) {
+		id := uuid.New()
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/things/"+id.String(), nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, id.String(), w.Body.String())
+	})
+
+	t.Run("malformed id is rejected before the handler", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/things/not-a-uuid", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var body map[string]string
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "invalid_id", body["error"])
+		assert.Equal(t, "id must be a valid UUID", body["message"])
+	})
+}
+
+func TestPathUUID_WithoutMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "id", Value: "nope"}}
+
+	_, ok := PathUUID(c, "id")
+
+	assert.False(t, ok)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func FuzzPathUUID(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		"not-a-uuid",
+		uuid.NewString(),
+		"{" + uuid.NewString() + "}",
+		"urn:uuid:" + uuid.NewString(),
+		"00000000000000000000000000000000",
+		"00000000-0000-0000-0000-00000000000g",
+		"../00000000-0000-0000-0000-000000000000",
+		"\x00",
+	} {
+		f.Add(seed)
+	}
+	gin.SetMode(gin.TestMode)
+
+	f.Fuzz(func(t *testing.T, value string) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: value}}
+
+		id, ok := PathUUID(c, "id")
+
+		want, err := uuid.Parse(value)
+		if ok != (err == nil) {
+			t.Fatalf("PathUUID(%q) ok = %v, uuid.Parse error = %v", value, ok, err)
+		}
+		if !ok {
+			if id != uuid.Nil || w.Code != http.StatusBadRequest {
+				t.Fatalf("PathUUID(%q) rejected with id %v and status %d", value, id, w.Code)
+			}
+			return
+		}
+		if id != want {
+			t.Fatalf("PathUUID(%q) = %v, want %v", value, id, want)
+		}
+		// A second lookup is served from the context and must agree
+		if again, ok := PathUUID(c, "id"); !ok || again != id {
+			t.Fatalf("PathUUID(%q) changed on second call: %v, %v", value, again, ok)
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package http
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/plan"
+	planDto "enterprise-crud/internal/dto/plan"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// PlanHandler handles HTTP requests for subscription plan administration
+type PlanHandler struct {
+	planService plan.Service
+	jwtService  *auth.JWTService
+}
+
+// NewPlanHandler creates a new instance of PlanHandler
+func NewPlanHandler(planService plan.Service, jwtService *auth.JWTService) *PlanHandler {
+	return &PlanHandler{
+		planService: planService,
+		jwtService:  jwtService,
+	}
+}
+
+// GetAllPlans retrieves all subscription plans
+// @Summary Get all plans
+// @Description Get all subscription plans and their limits (requires ADMIN role)
+// @Tags admin
+// @Produce json
+// @Success 200 {object} planDto.PlanListResponse
+// @Failure 401 {object} planDto.ErrorResponse
+// @Failure 403 {object} planDto.ErrorResponse
+// @Failure 500 {object} planDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/plans [get]
+func (h *PlanHandler) GetAllPlans(c *gin.Context) {
+	plans, err := h.planService.GetAllPlans(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, planDto.ErrorResponse{
+			Error:   "retrieval_error",
+			Message: "Failed to retrieve plans: " + err.Error(),
+		})
+		return
+	}
+
+	response := planDto.PlanListResponse{
+		Plans: make([]planDto.PlanResponse, len(plans)),
+		Count: len(plans),
+	}
+	for i, p := range plans {
+		response.Plans[i] = mapPlanToResponse(p)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// AssignPlan assigns a subscription plan to a user
+// @Summary Assign plan to user
+// @Description Assign a subscription plan to a user (requires ADMIN role)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param plan body planDto.AssignPlanRequest true "Plan to assign"
+// @Success 200 {object} planDto.UserPlanResponse
+// @Failure 400 {object} planDto.ErrorResponse
+// @Failure 401 {object} planDto.ErrorResponse
+// @Failure 403 {object} planDto.ErrorResponse
+// @Failure 404 {object} planDto.ErrorResponse
+// @Failure 500 {object} planDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/plan [put]
+func (h *PlanHandler) AssignPlan(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, planDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid user ID format",
+		})
+		return
+	}
+
+	var req planDto.AssignPlanRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, planDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	assigned, err := h.planService.AssignPlan(c.Request.Context(), userID, req.Plan)
+	if err != nil {
+		if plan.IsNotFoundError(err) {
+			c.JSON(http.StatusNotFound, planDto.ErrorResponse{
+				Error:   plan.GetPlanErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, planDto.ErrorResponse{
+				Error:   "assign_error",
+				Message: "Failed to assign plan: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, planDto.UserPlanResponse{
+		UserID: userID,
+		Plan:   mapPlanToResponse(assigned),
+	})
+}
+
+// RegisterRoutes registers plan administration routes with the gin router
+func (h *PlanHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	// Admin routes (require ADMIN role)
+	adminRoutes := router.Group("/admin", jwtMiddleware.AuthRequired(), auth.RequireAdmin())
+	{
+		adminRoutes.GET("/plans", h.GetAllPlans)
+		adminRoutes.PUT("/users/:id/plan", h.AssignPlan)
+	}
+}
+
+// mapPlanToResponse converts a plan domain entity to response DTO
+func mapPlanToResponse(p *plan.Plan) planDto.PlanResponse {
+	return planDto.PlanResponse{
+		ID:                 p.ID,
+		Name:               p.Name,
+		Description:        p.Description,
+		MaxActiveEvents:    p.MaxActiveEvents,
+		MaxTicketsPerEvent: p.MaxTicketsPerEvent,
+	}
+}
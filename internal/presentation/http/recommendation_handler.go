@@ -0,0 +1,80 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/recommendation"
+	eventDto "enterprise-crud/internal/dto/event"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RecommendationHandler handles HTTP requests for personalised event recommendations
+type RecommendationHandler struct {
+	recommendationService recommendation.Service
+	jwtService            *auth.JWTService
+	clock                 clock.Clock
+}
+
+// NewRecommendationHandler creates a new instance of RecommendationHandler
+func NewRecommendationHandler(recommendationService recommendation.Service, jwtService *auth.JWTService, opts ...HandlerOption) *RecommendationHandler {
+	options := applyHandlerOptions(opts)
+	return &RecommendationHandler{
+		recommendationService: recommendationService,
+		jwtService:            jwtService,
+		clock:                 options.clock,
+	}
+}
+
+// GetRecommendedEvents retrieves upcoming events picked for the current user
+// @Summary Get recommended events
+// @Description Get upcoming events at venues and by organizers the current user bought tickets for before, best match first.
+// @Description Events the user already ordered and sold out events are left out; when too few events match, the soonest upcoming events fill the list.
+// @Tags events
+// @Produce json
+// @Param limit query int false "Maximum number of events (default 10, at most 50)"
+// @Success 200 {object} eventDto.RecommendedEventsResponse
+// @Failure 401 {object} eventDto.ErrorResponse
+// @Failure 500 {object} eventDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/recommended [get]
+func (h *RecommendationHandler) GetRecommendedEvents(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	recommendations, err := h.recommendationService.Recommend(c.Request.Context(), currentUser.UserID, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
+			Error:   "recommendation_error",
+			Message: "Failed to recommend events: " + err.Error(),
+		})
+		return
+	}
+
+	now := h.clock.Now()
+	events := make([]eventDto.RecommendedEventResponse, len(recommendations))
+	for i, r := range recommendations {
+		events[i] = eventDto.RecommendedEventResponse{
+			EventResponse: mapEventToResponse(r.Event, now),
+			Score:         r.Score,
+			Reasons:       r.Reasons,
+		}
+	}
+
+	c.JSON(http.StatusOK, eventDto.RecommendedEventsResponse{ListResponse: newList(c, events)})
+}
+
+// RegisterRoutes registers recommendation routes with the gin router
+func (h *RecommendationHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	router.GET("/events/recommended",
+		jwtMiddleware.AuthRequired(),
+		h.GetRecommendedEvents)
+}
@@ -0,0 +1,401 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/refund"
+	refundDto "enterprise-crud/internal/dto/refund"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RefundHandler handles HTTP requests for refund requests, disputes and the organizer refund queue
+type RefundHandler struct {
+	refundService refund.Service
+	jwtService    *auth.JWTService
+}
+
+// NewRefundHandler creates a new instance of RefundHandler
+func NewRefundHandler(refundService refund.Service, jwtService *auth.JWTService) *RefundHandler {
+	return &RefundHandler{
+		refundService: refundService,
+		jwtService:    jwtService,
+	}
+}
+
+// OpenRefundRequest opens a refund request or dispute on one of the current user's orders
+// @Summary Request a refund
+// @Description Ask the organizer for a refund (REFUND) or contest the charge (DISPUTE) on a completed order.
+// @Description Requests can be opened until refunds.request_window after the event, or any time if it was cancelled.
+// @Description Refunds follow the event's refund_policy: NONE fails with 409 REFUNDS_NOT_OFFERED and past its deadline with 409 REFUND_DEADLINE_PASSED.
+// @Description An order has at most one request that wasn't denied.
+// @Tags refunds
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body refundDto.OpenRefundRequest true "Kind and reason"
+// @Success 201 {object} refundDto.RefundResponse
+// @Failure 400 {object} refundDto.ErrorResponse
+// @Failure 401 {object} refundDto.ErrorResponse
+// @Failure 404 {object} refundDto.ErrorResponse
+// @Failure 409 {object} refundDto.ErrorResponse
+// @Failure 500 {object} refundDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/orders/{id}/refund-requests [post]
+func (h *RefundHandler) OpenRefundRequest(c *gin.Context) {
+	orderID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	var req refundDto.OpenRefundRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, refundDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	actor, ok := refundActor(c)
+	if !ok {
+		return
+	}
+
+	opened, err := h.refundService.Open(c.Request.Context(), actor.UserID, orderID, req.Kind, req.Reason)
+	if err != nil {
+		writeRefundError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapRefundToResponse(opened))
+}
+
+// ListRefundRequests lists the refund queue of the current organizer
+// @Summary List refund requests
+// @Description List the refund requests and disputes on the current organizer's events, oldest first (admins see every event)
+// @Tags refunds
+// @Produce json
+// @Param status query string false "Request status (OPEN, APPROVED, REFUNDED, REFUND_FAILED, DENIED)"
+// @Param event_id query string false "Only requests on this event"
+// @Param limit query int false "Page size (default 50, max 100)"
+// @Param offset query int false "Number of requests to skip"
+// @Success 200 {object} refundDto.RefundListResponse
+// @Failure 400 {object} refundDto.ErrorResponse
+// @Failure 401 {object} refundDto.ErrorResponse
+// @Failure 403 {object} refundDto.ErrorResponse
+// @Failure 500 {object} refundDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/refund-requests [get]
+func (h *RefundHandler) ListRefundRequests(c *gin.Context) {
+	filter, ok := parseRefundFilter(c)
+	if !ok {
+		return
+	}
+
+	actor, ok := refundActor(c)
+	if !ok {
+		return
+	}
+
+	requests, err := h.refundService.List(c.Request.Context(), actor, filter)
+	if err != nil {
+		writeRefundError(c, err)
+		return
+	}
+
+	response := refundDto.RefundListResponse{
+		Requests: make([]refundDto.RefundResponse, len(requests)),
+		Count:    len(requests),
+		Limit:    filter.PageSize(),
+		Offset:   filter.Offset,
+	}
+	for i, r := range requests {
+		response.Requests[i] = mapRefundToResponse(r)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetRefundRequest retrieves a refund request with its audit trail
+// @Summary Get a refund request
+// @Description Get a refund request or dispute with every change made to it (buyer, event organizer or admin)
+// @Tags refunds
+// @Produce json
+// @Param id path string true "Refund request ID"
+// @Success 200 {object} refundDto.RefundDetailResponse
+// @Failure 400 {object} refundDto.ErrorResponse
+// @Failure 401 {object} refundDto.ErrorResponse
+// @Failure 404 {object} refundDto.ErrorResponse
+// @Failure 500 {object} refundDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/refund-requests/{id} [get]
+func (h *RefundHandler) GetRefundRequest(c *gin.Context) {
+	id, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	actor, ok := refundActor(c)
+	if !ok {
+		return
+	}
+
+	req, entries, err := h.refundService.Get(c.Request.Context(), actor, id)
+	if err != nil {
+		writeRefundError(c, err)
+		return
+	}
+
+	response := refundDto.RefundDetailResponse{
+		RefundResponse: mapRefundToResponse(req),
+		History:        make([]refundDto.AuditEntryResponse, len(entries)),
+	}
+	for i, e := range entries {
+		response.History[i] = refundDto.AuditEntryResponse{
+			Action:    e.Action,
+			ActorID:   e.ActorID,
+			Amount:    e.Amount,
+			Note:      e.Note,
+			CreatedAt: e.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ApproveRefundRequest approves a refund request and refunds the buyer
+// @Summary Approve a refund request
+// @Description Approve a refund request or dispute with a reason and refund the buyer through the payment provider (only by organizer).
+// @Description The amount defaults to the refundable amount the event's refund policy allowed when the request was opened, and only admins may refund more.
+// @Description Cancelled events must be refunded in full. Approving a REFUND_FAILED request retries the refund.
+// @Tags refunds
+// @Accept json
+// @Produce json
+// @Param id path string true "Refund request ID"
+// @Param decision body refundDto.ApproveRefundRequest true "Amount and reason"
+// @Success 200 {object} refundDto.RefundResponse
+// @Failure 400 {object} refundDto.ErrorResponse
+// @Failure 401 {object} refundDto.ErrorResponse
+// @Failure 403 {object} refundDto.ErrorResponse
+// @Failure 404 {object} refundDto.ErrorResponse
+// @Failure 409 {object} refundDto.ErrorResponse
+// @Failure 500 {object} refundDto.ErrorResponse
+// @Failure 502 {object} refundDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/refund-requests/{id}/approve [post]
+func (h *RefundHandler) ApproveRefundRequest(c *gin.Context) {
+	id, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	var req refundDto.ApproveRefundRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, refundDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	actor, ok := refundActor(c)
+	if !ok {
+		return
+	}
+
+	approved, err := h.refundService.Approve(c.Request.Context(), actor, id, req.Amount, req.Reason)
+	if err != nil {
+		writeRefundError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapRefundToResponse(approved))
+}
+
+// DenyRefundRequest denies a refund request
+// @Summary Deny a refund request
+// @Description Deny an open refund request or dispute with a reason (only by organizer). Requests on cancelled events can't be denied.
+// @Tags refunds
+// @Accept json
+// @Produce json
+// @Param id path string true "Refund request ID"
+// @Param decision body refundDto.DenyRefundRequest true "Reason"
+// @Success 200 {object} refundDto.RefundResponse
+// @Failure 400 {object} refundDto.ErrorResponse
+// @Failure 401 {object} refundDto.ErrorResponse
+// @Failure 403 {object} refundDto.ErrorResponse
+// @Failure 404 {object} refundDto.ErrorResponse
+// @Failure 409 {object} refundDto.ErrorResponse
+// @Failure 500 {object} refundDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/refund-requests/{id}/deny [post]
+func (h *RefundHandler) DenyRefundRequest(c *gin.Context) {
+	id, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	var req refundDto.DenyRefundRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, refundDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	actor, ok := refundActor(c)
+	if !ok {
+		return
+	}
+
+	denied, err := h.refundService.Deny(c.Request.Context(), actor, id, req.Reason)
+	if err != nil {
+		writeRefundError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapRefundToResponse(denied))
+}
+
+// RegisterRoutes registers refund routes with the gin router
+func (h *RefundHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	router.POST("/orders/:id/refund-requests",
+		jwtMiddleware.AuthRequired(),
+		auth.RequireUser(),
+		UUIDParams("id"),
+		h.OpenRefundRequest)
+
+	refundRoutes := router.Group("/refund-requests", jwtMiddleware.AuthRequired())
+	{
+		refundRoutes.GET("", auth.RequireOrganizer(), h.ListRefundRequests)
+		refundRoutes.GET("/:id", UUIDParams("id"), h.GetRefundRequest)
+		refundRoutes.POST("/:id/approve", auth.RequireOrganizer(), UUIDParams("id"), h.ApproveRefundRequest)
+		refundRoutes.POST("/:id/deny", auth.RequireOrganizer(), UUIDParams("id"), h.DenyRefundRequest)
+	}
+}
+
+// parseRefundFilter reads the filters and page of ListRefundRequests, writing a 400 when one is invalid
+func parseRefundFilter(c *gin.Context) (refund.ListFilter, bool) {
+	filter := refund.ListFilter{Status: c.Query("status")}
+	invalid := func(message string) (refund.ListFilter, bool) {
+		c.JSON(http.StatusBadRequest, refundDto.ErrorResponse{
+			Error:   "invalid_filter",
+			Message: message,
+		})
+		return filter, false
+	}
+
+	if raw := c.Query("event_id"); raw != "" {
+		eventID, err := uuid.Parse(raw)
+		if err != nil {
+			return invalid("event_id must be a valid UUID")
+		}
+		filter.EventID = &eventID
+	}
+
+	var err error
+	if raw := c.Query("limit"); raw != "" {
+		if filter.Limit, err = strconv.Atoi(raw); err != nil || filter.Limit < 0 {
+			return invalid("limit must be a non-negative number")
+		}
+	}
+	if raw := c.Query("offset"); raw != "" {
+		if filter.Offset, err = strconv.Atoi(raw); err != nil || filter.Offset < 0 {
+			return invalid("offset must be a non-negative number")
+		}
+	}
+	return filter, true
+}
+
+// refundActor builds the acting user from the authenticated principal, writing a 401 when there is none
+func refundActor(c *gin.Context) (refund.Actor, bool) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return refund.Actor{}, false
+	}
+	return refund.Actor{UserID: currentUser.UserID, IsAdmin: currentUser.IsAdmin()}, true
+}
+
+// writeRefundError maps refund, order and event errors to HTTP responses
+func writeRefundError(c *gin.Context, err error) {
+	switch {
+	case order.IsOrderNotFoundError(err):
+		c.JSON(http.StatusNotFound, refundDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	case order.IsOrderNotCompletedError(err):
+		c.JSON(http.StatusConflict, refundDto.ErrorResponse{
+			Error:   order.GetOrderErrorCode(err),
+			Message: err.Error(),
+		})
+	case event.IsEventNotFoundError(err):
+		c.JSON(http.StatusNotFound, refundDto.ErrorResponse{
+			Error:   event.GetEventErrorCode(err),
+			Message: err.Error(),
+		})
+	case refund.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, refundDto.ErrorResponse{
+			Error:   refund.GetRefundErrorCode(err),
+			Message: err.Error(),
+		})
+	case refund.IsForbiddenError(err):
+		c.JSON(http.StatusForbidden, refundDto.ErrorResponse{
+			Error:   refund.GetRefundErrorCode(err),
+			Message: err.Error(),
+		})
+	case refund.IsNotFoundError(err):
+		c.JSON(http.StatusNotFound, refundDto.ErrorResponse{
+			Error:   refund.GetRefundErrorCode(err),
+			Message: err.Error(),
+		})
+	case refund.IsConflictError(err):
+		c.JSON(http.StatusConflict, refundDto.ErrorResponse{
+			Error:   refund.GetRefundErrorCode(err),
+			Message: err.Error(),
+		})
+	case refund.IsProviderError(err):
+		c.JSON(http.StatusBadGateway, refundDto.ErrorResponse{
+			Error:   refund.GetRefundErrorCode(err),
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, refundDto.ErrorResponse{
+			Error:   "refund_error",
+			Message: err.Error(),
+		})
+	}
+}
+
+// mapRefundToResponse converts a refund request to response DTO
+func mapRefundToResponse(r *refund.Request) refundDto.RefundResponse {
+	return refundDto.RefundResponse{
+		ID:                r.ID,
+		OrderID:           r.OrderID,
+		EventID:           r.EventID,
+		EventTitle:        r.EventTitle,
+		UserID:            r.UserID,
+		Email:             r.Email,
+		Kind:              r.Kind,
+		Status:            r.Status,
+		Reason:            r.Reason,
+		OrderAmount:       r.OrderAmount,
+		RefundableAmount:  r.RefundableAmount,
+		Amount:            r.Amount,
+		Decision:          r.Decision,
+		DecidedBy:         r.DecidedBy,
+		DecidedAt:         r.DecidedAt,
+		ProviderReference: r.ProviderReference,
+		CreatedAt:         r.CreatedAt,
+		UpdatedAt:         r.UpdatedAt,
+	}
+}
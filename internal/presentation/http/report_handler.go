@@ -0,0 +1,273 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/report"
+	reportDto "enterprise-crud/internal/dto/report"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReportHandler handles HTTP requests for organizer sales reports
+type ReportHandler struct {
+	reportService report.Service
+	jwtService    *auth.JWTService
+	clock         clock.Clock // Tells the default reporting period
+}
+
+// NewReportHandler creates a new instance of ReportHandler
+func NewReportHandler(reportService report.Service, jwtService *auth.JWTService, opts ...HandlerOption) *ReportHandler {
+	options := applyHandlerOptions(opts)
+	return &ReportHandler{
+		reportService: reportService,
+		jwtService:    jwtService,
+		clock:         options.clock,
+	}
+}
+
+// GetSchedule retrieves the current organizer's report email schedule
+// @Summary Get report schedule
+// @Description Get the sales summary email schedule of the current organizer
+// @Tags reports
+// @Produce json
+// @Success 200 {object} reportDto.ScheduleResponse
+// @Failure 401 {object} reportDto.ErrorResponse
+// @Failure 403 {object} reportDto.ErrorResponse
+// @Failure 500 {object} reportDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/reports/schedule [get]
+func (h *ReportHandler) GetSchedule(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.reportService.GetSchedule(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve report schedule: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapScheduleToResponse(schedule))
+}
+
+// UpdateSchedule opts the current organizer in or out of sales summary emails
+// @Summary Update report schedule
+// @Description Enable or disable DAILY or WEEKLY sales summary emails for the current organizer
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param schedule body reportDto.UpdateScheduleRequest true "Report schedule"
+// @Success 200 {object} reportDto.ScheduleResponse
+// @Failure 400 {object} reportDto.ErrorResponse
+// @Failure 401 {object} reportDto.ErrorResponse
+// @Failure 403 {object} reportDto.ErrorResponse
+// @Failure 500 {object} reportDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/reports/schedule [put]
+func (h *ReportHandler) UpdateSchedule(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req reportDto.UpdateScheduleRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, reportDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	schedule, err := h.reportService.UpdateSchedule(c.Request.Context(), currentUser.UserID, req.Frequency, *req.Enabled)
+	if err != nil {
+		h.respondError(c, err, "Failed to update report schedule: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapScheduleToResponse(schedule))
+}
+
+// GetSalesSummary retrieves the current organizer's sales over a period
+// @Summary Get sales summary
+// @Description Get completed order totals per event for the current organizer, the same data sent in report emails
+// @Tags reports
+// @Produce json
+// @Param from query string false "Start of the period (RFC 3339 or YYYY-MM-DD, inclusive), defaults to 7 days ago"
+// @Param to query string false "End of the period (RFC 3339 or YYYY-MM-DD, exclusive), defaults to now"
+// @Success 200 {object} reportDto.SalesSummaryResponse
+// @Failure 400 {object} reportDto.ErrorResponse
+// @Failure 401 {object} reportDto.ErrorResponse
+// @Failure 403 {object} reportDto.ErrorResponse
+// @Failure 500 {object} reportDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/reports/sales [get]
+func (h *ReportHandler) GetSalesSummary(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	now := h.clock.Now().UTC()
+	from, fromErr := parseReportTime(c.Query("from"), now.AddDate(0, 0, -7))
+	to, toErr := parseReportTime(c.Query("to"), now)
+	if fromErr != nil || toErr != nil {
+		c.JSON(http.StatusBadRequest, reportDto.ErrorResponse{
+			Error:   "invalid_period",
+			Message: "from and to must be RFC 3339 timestamps or YYYY-MM-DD dates",
+		})
+		return
+	}
+
+	summary, err := h.reportService.GetSalesSummary(c.Request.Context(), currentUser.UserID, from, to)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve sales summary: ")
+		return
+	}
+
+	response := reportDto.SalesSummaryResponse{
+		From:         summary.From,
+		To:           summary.To,
+		TotalOrders:  summary.TotalOrders,
+		TotalTickets: summary.TotalTickets,
+		TotalRevenue: summary.TotalRevenue,
+		Events:       make([]reportDto.EventSalesResponse, len(summary.Events)),
+	}
+	for i, e := range summary.Events {
+		response.Events[i] = reportDto.EventSalesResponse{
+			EventID:     e.EventID,
+			Title:       e.Title,
+			Orders:      e.Orders,
+			TicketsSold: e.TicketsSold,
+			Revenue:     e.Revenue,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetForecast estimates when an event sells out
+// @Summary Get sellout forecast
+// @Description Project when an event sells out by fitting a line through its tickets sold per hour (event organizer or ADMIN only).
+// @Description No sellout is projected with fewer than three hours of sales or when the event comes first. Forecasts are recomputed from cached sales figures, refreshed every reports.forecast_cache_ttl.
+// @Tags reports
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} reportDto.ForecastResponse
+// @Failure 400 {object} reportDto.ErrorResponse
+// @Failure 401 {object} reportDto.ErrorResponse
+// @Failure 403 {object} reportDto.ErrorResponse
+// @Failure 404 {object} reportDto.ErrorResponse
+// @Failure 500 {object} reportDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/forecast [get]
+func (h *ReportHandler) GetForecast(c *gin.Context) {
+	eventID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	forecast, err := h.reportService.GetForecast(c.Request.Context(), eventID)
+	if err != nil {
+		h.respondError(c, err, "Failed to forecast sales: ")
+		return
+	}
+
+	// Only the event's organizer or an admin may see its sales
+	if forecast.OrganizerID != currentUser.UserID && !currentUser.IsAdmin() {
+		c.JSON(http.StatusForbidden, reportDto.ErrorResponse{
+			Error:   "forbidden",
+			Message: "You can only view forecasts of your own events",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, reportDto.ForecastResponse{
+		EventID:       forecast.EventID,
+		Title:         forecast.Title,
+		EventDate:     forecast.EventDate,
+		TotalTickets:  forecast.TotalTickets,
+		TicketsSold:   forecast.TicketsSold,
+		TicketsPerDay: forecast.TicketsPerDay,
+		Fit:           forecast.Fit,
+		SoldOut:       forecast.SoldOut,
+		SelloutAt:     forecast.SelloutAt,
+		ProjectedSold: forecast.ProjectedSold,
+		GeneratedAt:   forecast.GeneratedAt,
+	})
+}
+
+// RegisterRoutes registers report routes with the gin router
+func (h *ReportHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	// Organizer routes (require ORGANIZER or ADMIN role)
+	reportRoutes := router.Group("/reports", jwtMiddleware.AuthRequired(), auth.RequireOrganizer())
+	{
+		reportRoutes.GET("/schedule", h.GetSchedule)
+		reportRoutes.PUT("/schedule", h.UpdateSchedule)
+		reportRoutes.GET("/sales", h.GetSalesSummary)
+	}
+
+	router.GET("/events/:id/forecast",
+		jwtMiddleware.AuthRequired(),
+		auth.RequireOrganizer(),
+		UUIDParams("id"),
+		h.GetForecast)
+}
+
+// respondError maps report errors to HTTP responses
+func (h *ReportHandler) respondError(c *gin.Context, err error, prefix string) {
+	switch {
+	case report.IsEventNotFoundError(err):
+		c.JSON(http.StatusNotFound, reportDto.ErrorResponse{
+			Error:   report.GetReportErrorCode(err),
+			Message: err.Error(),
+		})
+	case report.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, reportDto.ErrorResponse{
+			Error:   report.GetReportErrorCode(err),
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, reportDto.ErrorResponse{
+			Error:   "report_error",
+			Message: prefix + err.Error(),
+		})
+	}
+}
+
+// parseReportTime parses an RFC 3339 timestamp or a YYYY-MM-DD date (midnight UTC)
+func parseReportTime(value string, fallback time.Time) (time.Time, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
+
+// mapScheduleToResponse converts a report schedule to response DTO
+func mapScheduleToResponse(s *report.Schedule) reportDto.ScheduleResponse {
+	response := reportDto.ScheduleResponse{
+		Frequency:  s.Frequency,
+		Enabled:    s.Enabled,
+		LastSentAt: s.LastSentAt,
+	}
+	if s.Enabled {
+		nextRunAt := s.NextRunAt
+		response.NextRunAt = &nextRunAt
+	}
+	return response
+}
@@ -0,0 +1,118 @@
+package http
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/share"
+	shareDto "enterprise-crud/internal/dto/share"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ShareHandler handles HTTP requests for event share metadata and short links
+type ShareHandler struct {
+	shareService share.Service
+	jwtService   *auth.JWTService
+}
+
+// NewShareHandler creates a new instance of ShareHandler
+func NewShareHandler(shareService share.Service, jwtService *auth.JWTService) *ShareHandler {
+	return &ShareHandler{
+		shareService: shareService,
+		jwtService:   jwtService,
+	}
+}
+
+// GetShareMetadata retrieves share-ready metadata for an event
+// @Summary Get event share metadata
+// @Description Get Open Graph style metadata and a short link for sharing an event
+// @Tags events
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} shareDto.ShareResponse
+// @Failure 400 {object} shareDto.ErrorResponse
+// @Failure 404 {object} shareDto.ErrorResponse
+// @Failure 500 {object} shareDto.ErrorResponse
+// @Router /api/v1/events/{id}/share [get]
+func (h *ShareHandler) GetShareMetadata(c *gin.Context) {
+	eventID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, shareDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid event ID format",
+		})
+		return
+	}
+
+	var viewer event.Viewer
+	if currentUser, ok := auth.CurrentUser(c); ok {
+		viewer = event.Viewer{UserID: currentUser.UserID, Admin: currentUser.IsAdmin()}
+	}
+
+	metadata, err := h.shareService.GetEventMetadata(c.Request.Context(), eventID, viewer)
+	if err != nil {
+		if event.IsEventNotFoundError(err) {
+			c.JSON(http.StatusNotFound, shareDto.ErrorResponse{
+				Error:   event.GetEventErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, shareDto.ErrorResponse{
+				Error:   "retrieval_error",
+				Message: "Failed to retrieve share metadata: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, shareDto.ShareResponse{
+		EventID:      metadata.EventID,
+		Title:        metadata.Title,
+		Description:  metadata.Description,
+		ImageURL:     metadata.ImageURL,
+		CanonicalURL: metadata.CanonicalURL,
+		ShortURL:     metadata.ShortURL,
+		StartTime:    metadata.StartTime,
+		VenueName:    metadata.VenueName,
+		Status:       string(metadata.Status),
+	})
+}
+
+// Redirect sends a short link visitor to the event page
+// @Summary Follow a short link
+// @Description Redirect to the event page a short link was created for
+// @Tags events
+// @Param code path string true "Short link code"
+// @Success 302 "Redirect to the event page"
+// @Failure 404 {object} shareDto.ErrorResponse
+// @Failure 500 {object} shareDto.ErrorResponse
+// @Router /s/{code} [get]
+func (h *ShareHandler) Redirect(c *gin.Context) {
+	link, err := h.shareService.ResolveShortLink(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		if share.IsLinkNotFoundError(err) {
+			c.JSON(http.StatusNotFound, shareDto.ErrorResponse{
+				Error:   share.GetShareErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, shareDto.ErrorResponse{
+				Error:   "retrieval_error",
+				Message: "Failed to resolve short link: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	c.Redirect(http.StatusFound, link.TargetURL)
+}
+
+// RegisterRoutes registers the share metadata route
+// Short link redirects live at the site root and are registered by the router.
+func (h *ShareHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+	router.GET("/events/:id/share", jwtMiddleware.AuthOptional(), h.GetShareMetadata)
+}
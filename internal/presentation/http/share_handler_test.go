@@ -0,0 +1,137 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/share"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockShareService is a mock implementation of share.Service interface
+type MockShareService struct {
+	mock.Mock
+}
+
+func (m *MockShareService) GetEventMetadata(ctx context.Context, eventID uuid.UUID, viewer event.Viewer) (*share.Metadata, error) {
+	args := m.Called(ctx, eventID, viewer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*share.Metadata), args.Error(1)
+}
+
+func (m *MockShareService) ResolveShortLink(ctx context.Context, code string) (*share.ShortLink, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*share.ShortLink), args.Error(1)
+}
+
+func setupShareRouter(shareService *MockShareService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewShareHandler(shareService, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+	router.GET("/s/:code", handler.Redirect)
+	return router
+}
+
+func TestShareHandler_GetShareMetadata(t *testing.T) {
+	eventID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		shareService := new(MockShareService)
+		shareService.On("GetEventMetadata", mock.Anything, eventID, event.Viewer{}).Return(&share.Metadata{
+			EventID:      eventID,
+			Title:        "Spring Concert",
+			CanonicalURL: "https://tickets.example.com/events/" + eventID.String(),
+			ShortURL:     "https://tickets.example.com/s/abc1234",
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/events/"+eventID.String()+"/share", nil)
+		setupShareRouter(shareService).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"title":"Spring Concert"`)
+		assert.Contains(t, w.Body.String(), `"short_url":"https://tickets.example.com/s/abc1234"`)
+		assert.NotContains(t, w.Body.String(), `"image_url"`)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/events/not-a-uuid/share", nil)
+		setupShareRouter(new(MockShareService)).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("event not found", func(t *testing.T) {
+		shareService := new(MockShareService)
+		shareService.On("GetEventMetadata", mock.Anything, eventID, event.Viewer{}).Return(nil, event.ErrEventNotFound)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/events/"+eventID.String()+"/share", nil)
+		setupShareRouter(shareService).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "EVENT_NOT_FOUND")
+	})
+
+	t.Run("passes on who is signed in", func(t *testing.T) {
+		adminID := uuid.New()
+		shareService := new(MockShareService)
+		shareService.On("GetEventMetadata", mock.Anything, eventID, event.Viewer{UserID: adminID, Admin: true}).
+			Return(&share.Metadata{EventID: eventID, Title: "Spring Concert"}, nil)
+		token, _ := auth.NewJWTService("test-secret", "test-issuer", time.Hour).
+			GenerateToken(adminID, "admin@test.com", "admin", []string{"ADMIN"}, 0)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/events/"+eventID.String()+"/share", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		setupShareRouter(shareService).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		shareService.AssertExpectations(t)
+	})
+}
+
+func TestShareHandler_Redirect(t *testing.T) {
+	t.Run("redirects to target", func(t *testing.T) {
+		shareService := new(MockShareService)
+		shareService.On("ResolveShortLink", mock.Anything, "abc1234").Return(&share.ShortLink{
+			Code:      "abc1234",
+			TargetURL: "https://tickets.example.com/events/1",
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/s/abc1234", nil)
+		setupShareRouter(shareService).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "https://tickets.example.com/events/1", w.Header().Get("Location"))
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		shareService := new(MockShareService)
+		shareService.On("ResolveShortLink", mock.Anything, "missing").Return(nil, share.ErrLinkNotFound)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/s/missing", nil)
+		setupShareRouter(shareService).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "LINK_NOT_FOUND")
+	})
+}
@@ -0,0 +1,122 @@
+package http
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/shorturl"
+	"enterprise-crud/internal/domain/staff"
+	shortURLDto "enterprise-crud/internal/dto/shorturl"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ShortURLHandler handles event short URL redirects and click statistics
+type ShortURLHandler struct {
+	shortURLService shorturl.Service
+	staffService    staff.Service
+	jwtService      *auth.JWTService
+}
+
+// NewShortURLHandler creates a new instance of ShortURLHandler
+func NewShortURLHandler(shortURLService shorturl.Service, staffService staff.Service, jwtService *auth.JWTService) *ShortURLHandler {
+	return &ShortURLHandler{
+		shortURLService: shortURLService,
+		staffService:    staffService,
+		jwtService:      jwtService,
+	}
+}
+
+// Redirect sends a short URL visitor to the event page and counts the click
+// @Summary Follow an event short URL
+// @Description Redirect to the page of the event the short code belongs to
+// @Tags events
+// @Param code path string true "Short code"
+// @Success 302 "Redirect to the event page"
+// @Failure 404 {object} shortURLDto.ErrorResponse
+// @Failure 500 {object} shortURLDto.ErrorResponse
+// @Router /e/{code} [get]
+func (h *ShortURLHandler) Redirect(c *gin.Context) {
+	shortURL, err := h.shortURLService.Resolve(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		if shorturl.IsShortURLNotFoundError(err) {
+			c.JSON(http.StatusNotFound, shortURLDto.ErrorResponse{
+				Error:   shorturl.GetShortURLErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, shortURLDto.ErrorResponse{
+				Error:   "retrieval_error",
+				Message: "Failed to resolve short URL: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	c.Redirect(http.StatusFound, h.shortURLService.TargetURL(shortURL))
+}
+
+// GetEventShortURL retrieves an event's short URL and its click count
+// @Summary Get event short URL statistics
+// @Description Get the short URL of an event and how often it was followed (by the organizer or assigned staff).
+// @Description Events published before short URLs existed get one on first request.
+// @Tags events
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} shortURLDto.ShortURLResponse
+// @Failure 400 {object} shortURLDto.ErrorResponse
+// @Failure 401 {object} shortURLDto.ErrorResponse
+// @Failure 403 {object} shortURLDto.ErrorResponse
+// @Failure 404 {object} shortURLDto.ErrorResponse
+// @Failure 500 {object} shortURLDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/short-url [get]
+func (h *ShortURLHandler) GetEventShortURL(c *gin.Context) {
+	eventID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, shortURLDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid event ID format",
+		})
+		return
+	}
+
+	actor, ok := staffActor(c)
+	if !ok {
+		return
+	}
+
+	if _, err := h.staffService.Authorize(c.Request.Context(), actor, eventID, staff.PermissionView); err != nil {
+		writeStaffError(c, err)
+		return
+	}
+
+	shortURL, err := h.shortURLService.CreateForEvent(c.Request.Context(), eventID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, shortURLDto.ErrorResponse{
+			Error:   shorturl.GetShortURLErrorCode(err),
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, shortURLDto.ShortURLResponse{
+		EventID:   shortURL.EventID,
+		Code:      shortURL.Code,
+		ShortURL:  h.shortURLService.URL(shortURL.Code),
+		Clicks:    shortURL.Clicks,
+		CreatedAt: shortURL.CreatedAt,
+	})
+}
+
+// RegisterRoutes registers the short URL statistics route
+// Redirects live at the site root and are registered by the router. Staff may be
+// plain users, so access is checked per event rather than by role.
+func (h *ShortURLHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	router.GET("/events/:id/short-url",
+		jwtMiddleware.AuthRequired(),
+		h.GetEventShortURL)
+}
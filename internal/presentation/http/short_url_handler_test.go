@@ -0,0 +1,159 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/shorturl"
+	"enterprise-crud/internal/domain/staff"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockShortURLService is a mock implementation of shorturl.Service interface
+type MockShortURLService struct {
+	mock.Mock
+}
+
+func (m *MockShortURLService) CreateForEvent(ctx context.Context, eventID uuid.UUID) (*shorturl.ShortURL, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*shorturl.ShortURL), args.Error(1)
+}
+
+func (m *MockShortURLService) GetForEvent(ctx context.Context, eventID uuid.UUID) (*shorturl.ShortURL, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*shorturl.ShortURL), args.Error(1)
+}
+
+func (m *MockShortURLService) GetURLs(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	args := m.Called(ctx, eventIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]string), args.Error(1)
+}
+
+func (m *MockShortURLService) Resolve(ctx context.Context, code string) (*shorturl.ShortURL, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*shorturl.ShortURL), args.Error(1)
+}
+
+func (m *MockShortURLService) URL(code string) string {
+	return "https://tickets.example.com/e/" + code
+}
+
+func (m *MockShortURLService) TargetURL(shortURL *shorturl.ShortURL) string {
+	return "https://tickets.example.com/events/" + shortURL.EventID.String()
+}
+
+func TestShortURLHandler_Redirect(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("redirects to event page", func(t *testing.T) {
+		shortURLService := new(MockShortURLService)
+		eventID := uuid.New()
+		shortURLService.On("Resolve", mock.Anything, "abc1234").Return(&shorturl.ShortURL{Code: "abc1234", EventID: eventID}, nil)
+
+		router := gin.New()
+		router.GET("/e/:code", NewShortURLHandler(shortURLService, nil, nil).Redirect)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/e/abc1234", nil))
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "https://tickets.example.com/events/"+eventID.String(), w.Header().Get("Location"))
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		shortURLService := new(MockShortURLService)
+		shortURLService.On("Resolve", mock.Anything, "missing").Return(nil, shorturl.ErrShortURLNotFound)
+
+		router := gin.New()
+		router.GET("/e/:code", NewShortURLHandler(shortURLService, nil, nil).Redirect)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/e/missing", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "SHORT_URL_NOT_FOUND")
+	})
+}
+
+func TestShortURLHandler_GetEventShortURL(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	organizerID := uuid.New()
+	eventID := uuid.New()
+
+	tests := []struct {
+		name           string
+		userID         uuid.UUID
+		setupMocks     func(*MockStaffService, *MockShortURLService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "organizer gets click count",
+			userID: organizerID,
+			setupMocks: func(st *MockStaffService, ss *MockShortURLService) {
+				st.On("Authorize", mock.Anything, staff.Actor{UserID: organizerID}, eventID, staff.PermissionView).Return(&event.Event{ID: eventID, OrganizerID: organizerID}, nil)
+				ss.On("CreateForEvent", mock.Anything, eventID).Return(&shorturl.ShortURL{Code: "abc1234", EventID: eventID, Clicks: 7}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"clicks":7`,
+		},
+		{
+			name:   "user without access is forbidden",
+			userID: uuid.New(),
+			setupMocks: func(st *MockStaffService, ss *MockShortURLService) {
+				st.On("Authorize", mock.Anything, mock.Anything, eventID, staff.PermissionView).Return(nil, staff.ErrAccessDenied)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "ACCESS_DENIED",
+		},
+		{
+			name:   "event not found",
+			userID: organizerID,
+			setupMocks: func(st *MockStaffService, ss *MockShortURLService) {
+				st.On("Authorize", mock.Anything, mock.Anything, eventID, staff.PermissionView).Return(nil, event.ErrEventNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "EVENT_NOT_FOUND",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			staffService := new(MockStaffService)
+			shortURLService := new(MockShortURLService)
+			tt.setupMocks(staffService, shortURLService)
+			handler := NewShortURLHandler(shortURLService, staffService, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/short-url", nil)
+			c.Params = gin.Params{{Key: "id", Value: eventID.String()}}
+			c.Set("user", &auth.JWTClaims{UserID: tt.userID, Roles: []string{"USER"}})
+
+			handler.GetEventShortURL(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			shortURLService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,510 @@
+package http
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/staff"
+	orderDto "enterprise-crud/internal/dto/order"
+	staffDto "enterprise-crud/internal/dto/staff"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// StaffHandler handles HTTP requests for event staff and ticket check-in
+type StaffHandler struct {
+	staffService staff.Service
+	orderService order.Service
+	jwtService   *auth.JWTService
+}
+
+// NewStaffHandler creates a new instance of StaffHandler
+func NewStaffHandler(staffService staff.Service, orderService order.Service, jwtService *auth.JWTService) *StaffHandler {
+	return &StaffHandler{
+		staffService: staffService,
+		orderService: orderService,
+		jwtService:   jwtService,
+	}
+}
+
+// InviteStaff invites a user to an event's staff
+// @Summary Invite event staff
+// @Description Invite a user by email to check in attendees (CHECK_IN), view statistics (VIEWER) or help run the event (CO_ORGANIZER) (only by organizer)
+// @Tags staff
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param invitation body staffDto.InviteStaffRequest true "Invitation"
+// @Success 201 {object} staffDto.StaffResponse
+// @Failure 400 {object} staffDto.ErrorResponse
+// @Failure 401 {object} staffDto.ErrorResponse
+// @Failure 403 {object} staffDto.ErrorResponse
+// @Failure 404 {object} staffDto.ErrorResponse
+// @Failure 409 {object} staffDto.ErrorResponse
+// @Failure 500 {object} staffDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/staff [post]
+func (h *StaffHandler) InviteStaff(c *gin.Context) {
+	eventID, ok := parseStaffEventID(c)
+	if !ok {
+		return
+	}
+
+	var req staffDto.InviteStaffRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, staffDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	actor, ok := staffActor(c)
+	if !ok {
+		return
+	}
+
+	assignment, err := h.staffService.Invite(c.Request.Context(), actor, eventID, req.Email, req.Role)
+	if err != nil {
+		writeStaffError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapAssignmentToResponse(assignment))
+}
+
+// ListStaff lists an event's staff and pending invitations
+// @Summary List event staff
+// @Description List the staff and pending invitations of an event (only by organizer)
+// @Tags staff
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} staffDto.StaffListResponse
+// @Failure 400 {object} staffDto.ErrorResponse
+// @Failure 401 {object} staffDto.ErrorResponse
+// @Failure 403 {object} staffDto.ErrorResponse
+// @Failure 404 {object} staffDto.ErrorResponse
+// @Failure 500 {object} staffDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/staff [get]
+func (h *StaffHandler) ListStaff(c *gin.Context) {
+	eventID, ok := parseStaffEventID(c)
+	if !ok {
+		return
+	}
+
+	actor, ok := staffActor(c)
+	if !ok {
+		return
+	}
+
+	assignments, err := h.staffService.ListStaff(c.Request.Context(), actor, eventID)
+	if err != nil {
+		writeStaffError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapAssignmentsToResponse(assignments))
+}
+
+// RemoveStaff revokes a user's staff role or invitation
+// @Summary Remove event staff
+// @Description Revoke a user's staff role or pending invitation on an event (only by organizer)
+// @Tags staff
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param userId path string true "Staff user ID"
+// @Success 200 {object} staffDto.SuccessResponse
+// @Failure 400 {object} staffDto.ErrorResponse
+// @Failure 401 {object} staffDto.ErrorResponse
+// @Failure 403 {object} staffDto.ErrorResponse
+// @Failure 404 {object} staffDto.ErrorResponse
+// @Failure 500 {object} staffDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/staff/{userId} [delete]
+func (h *StaffHandler) RemoveStaff(c *gin.Context) {
+	eventID, ok := parseStaffEventID(c)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, staffDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid user ID format",
+		})
+		return
+	}
+
+	actor, ok := staffActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.staffService.RemoveStaff(c.Request.Context(), actor, eventID, userID); err != nil {
+		writeStaffError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, staffDto.SuccessResponse{
+		Message: "Staff member removed successfully",
+	})
+}
+
+// ListMyInvitations lists the current user's pending staff invitations
+// @Summary List my staff invitations
+// @Description List the staff invitations the current user has not accepted yet
+// @Tags staff
+// @Produce json
+// @Success 200 {object} staffDto.StaffListResponse
+// @Failure 401 {object} staffDto.ErrorResponse
+// @Failure 500 {object} staffDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/staff/invitations [get]
+func (h *StaffHandler) ListMyInvitations(c *gin.Context) {
+	actor, ok := staffActor(c)
+	if !ok {
+		return
+	}
+
+	assignments, err := h.staffService.ListInvitations(c.Request.Context(), actor.UserID)
+	if err != nil {
+		writeStaffError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapAssignmentsToResponse(assignments))
+}
+
+// AcceptInvitation accepts one of the current user's staff invitations
+// @Summary Accept a staff invitation
+// @Description Accept a staff invitation, granting its role on the event
+// @Tags staff
+// @Produce json
+// @Param id path string true "Invitation ID"
+// @Success 200 {object} staffDto.StaffResponse
+// @Failure 400 {object} staffDto.ErrorResponse
+// @Failure 401 {object} staffDto.ErrorResponse
+// @Failure 404 {object} staffDto.ErrorResponse
+// @Failure 500 {object} staffDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/staff/invitations/{id}/accept [post]
+func (h *StaffHandler) AcceptInvitation(c *gin.Context) {
+	invitationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, staffDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid invitation ID format",
+		})
+		return
+	}
+
+	actor, ok := staffActor(c)
+	if !ok {
+		return
+	}
+
+	assignment, err := h.staffService.AcceptInvitation(c.Request.Context(), actor.UserID, invitationID)
+	if err != nil {
+		writeStaffError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapAssignmentToResponse(assignment))
+}
+
+// CheckIn admits the holder of an order to the event
+// @Summary Check in a ticket holder
+// @Description Mark a completed order as checked in (by the organizer, a co-organizer or CHECK_IN staff)
+// @Tags staff
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param check_in body orderDto.CheckInRequest true "Order to check in"
+// @Success 200 {object} orderDto.OrderResponse
+// @Failure 400 {object} staffDto.ErrorResponse
+// @Failure 401 {object} staffDto.ErrorResponse
+// @Failure 403 {object} staffDto.ErrorResponse
+// @Failure 404 {object} staffDto.ErrorResponse
+// @Failure 409 {object} staffDto.ErrorResponse
+// @Failure 500 {object} staffDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/check-ins [post]
+func (h *StaffHandler) CheckIn(c *gin.Context) {
+	eventID, ok := parseStaffEventID(c)
+	if !ok {
+		return
+	}
+
+	var req orderDto.CheckInRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, staffDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	actor, ok := staffActor(c)
+	if !ok {
+		return
+	}
+
+	if _, err := h.staffService.Authorize(c.Request.Context(), actor, eventID, staff.PermissionCheckIn); err != nil {
+		writeStaffError(c, err)
+		return
+	}
+
+	checkedIn, err := h.orderService.CheckIn(c.Request.Context(), eventID, req.OrderID)
+	if err != nil {
+		switch {
+		case order.IsOrderNotFoundError(err):
+			c.JSON(http.StatusNotFound, staffDto.ErrorResponse{
+				Error:   order.GetOrderErrorCode(err),
+				Message: err.Error(),
+			})
+		case order.IsOrderNotCompletedError(err), order.IsAlreadyCheckedInError(err), order.IsOrderArchivedError(err):
+			c.JSON(http.StatusConflict, staffDto.ErrorResponse{
+				Error:   order.GetOrderErrorCode(err),
+				Message: err.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, staffDto.ErrorResponse{
+				Error:   "check_in_error",
+				Message: "Failed to check in order: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, mapOrderToResponse(checkedIn))
+}
+
+// ExportAttendees exports an event's attendees with their answers as CSV
+// @Summary Export event attendees
+// @Description Download the completed orders of an event as CSV, with buyer, notes and one column per attendee question (organizer, admin or staff)
+// @Tags staff
+// @Produce text/csv
+// @Param id path string true "Event ID"
+// @Success 200 {string} string "CSV file"
+// @Failure 400 {object} staffDto.ErrorResponse
+// @Failure 401 {object} staffDto.ErrorResponse
+// @Failure 403 {object} staffDto.ErrorResponse
+// @Failure 404 {object} staffDto.ErrorResponse
+// @Failure 500 {object} staffDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/attendees/export [get]
+func (h *StaffHandler) ExportAttendees(c *gin.Context) {
+	eventID, ok := parseStaffEventID(c)
+	if !ok {
+		return
+	}
+
+	actor, ok := staffActor(c)
+	if !ok {
+		return
+	}
+
+	evt, err := h.staffService.Authorize(c.Request.Context(), actor, eventID, staff.PermissionView)
+	if err != nil {
+		writeStaffError(c, err)
+		return
+	}
+
+	attendees, err := h.orderService.GetAttendees(c.Request.Context(), eventID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, staffDto.ErrorResponse{
+			Error:   "export_error",
+			Message: "Failed to retrieve attendees: " + err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="attendees-%s.csv"`, eventID))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	header := []string{"order_id", "email", "username", "quantity", "total_amount", "ordered_at", "checked_in_at", "notes"}
+	for _, question := range evt.AttendeeQuestions {
+		header = append(header, question.Key)
+	}
+	_ = w.Write(header)
+
+	for _, a := range attendees {
+		checkedInAt := ""
+		if a.CheckedInAt != nil {
+			checkedInAt = a.CheckedInAt.UTC().Format(time.RFC3339)
+		}
+		record := []string{
+			a.ID.String(),
+			csvSafe(a.Email),
+			csvSafe(a.Username),
+			strconv.Itoa(a.Quantity),
+			strconv.FormatFloat(a.TotalAmount, 'f', 2, 64),
+			a.CreatedAt.UTC().Format(time.RFC3339),
+			checkedInAt,
+			csvSafe(a.Notes),
+		}
+		for _, question := range evt.AttendeeQuestions {
+			record = append(record, csvSafe(formatAnswer(a.Answers[question.Key])))
+		}
+		_ = w.Write(record)
+	}
+	w.Flush()
+}
+
+// RegisterRoutes registers all staff and check-in routes
+// Staff are often plain users, so event routes check access per event instead of by role.
+func (h *StaffHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	router.POST("/events/:id/staff",
+		jwtMiddleware.AuthRequired(),
+		auth.RequireOrganizer(),
+		h.InviteStaff)
+
+	router.GET("/events/:id/staff",
+		jwtMiddleware.AuthRequired(),
+		auth.RequireOrganizer(),
+		h.ListStaff)
+
+	router.DELETE("/events/:id/staff/:userId",
+		jwtMiddleware.AuthRequired(),
+		auth.RequireOrganizer(),
+		h.RemoveStaff)
+
+	router.POST("/events/:id/check-ins",
+		jwtMiddleware.AuthRequired(),
+		h.CheckIn)
+
+	router.GET("/events/:id/attendees/export",
+		jwtMiddleware.AuthRequired(),
+		h.ExportAttendees)
+
+	invitationRoutes := router.Group("/staff/invitations")
+	invitationRoutes.Use(jwtMiddleware.AuthRequired())
+	{
+		invitationRoutes.GET("", h.ListMyInvitations)
+		invitationRoutes.POST("/:id/accept", h.AcceptInvitation)
+	}
+}
+
+// parseStaffEventID parses the event ID path parameter, writing a 400 when it is invalid
+func parseStaffEventID(c *gin.Context) (uuid.UUID, bool) {
+	eventID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, staffDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid event ID format",
+		})
+		return uuid.Nil, false
+	}
+	return eventID, true
+}
+
+// staffActor builds the acting user from the authenticated principal, writing a 401 when there is none
+func staffActor(c *gin.Context) (staff.Actor, bool) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return staff.Actor{}, false
+	}
+	return staff.Actor{UserID: currentUser.UserID, IsAdmin: currentUser.IsAdmin()}, true
+}
+
+// writeStaffError maps staff and event errors to HTTP responses
+func writeStaffError(c *gin.Context, err error) {
+	switch {
+	case event.IsEventNotFoundError(err):
+		c.JSON(http.StatusNotFound, staffDto.ErrorResponse{
+			Error:   event.GetEventErrorCode(err),
+			Message: err.Error(),
+		})
+	case staff.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, staffDto.ErrorResponse{
+			Error:   staff.GetStaffErrorCode(err),
+			Message: err.Error(),
+		})
+	case staff.IsForbiddenError(err):
+		c.JSON(http.StatusForbidden, staffDto.ErrorResponse{
+			Error:   staff.GetStaffErrorCode(err),
+			Message: err.Error(),
+		})
+	case staff.IsNotFoundError(err):
+		c.JSON(http.StatusNotFound, staffDto.ErrorResponse{
+			Error:   staff.GetStaffErrorCode(err),
+			Message: err.Error(),
+		})
+	case staff.IsAlreadyAssignedError(err):
+		c.JSON(http.StatusConflict, staffDto.ErrorResponse{
+			Error:   staff.GetStaffErrorCode(err),
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, staffDto.ErrorResponse{
+			Error:   "staff_error",
+			Message: err.Error(),
+		})
+	}
+}
+
+// formatAnswer renders an attendee answer as a CSV cell
+func formatAnswer(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// csvSafe neutralizes buyer-provided text that spreadsheets would evaluate as a formula
+func csvSafe(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// mapAssignmentToResponse converts a staff assignment to response DTO
+func mapAssignmentToResponse(a *staff.Assignment) staffDto.StaffResponse {
+	return staffDto.StaffResponse{
+		ID:         a.ID,
+		EventID:    a.EventID,
+		UserID:     a.UserID,
+		Email:      a.Email,
+		Username:   a.Username,
+		Role:       a.Role,
+		Status:     a.Status,
+		InvitedBy:  a.InvitedBy,
+		AcceptedAt: a.AcceptedAt,
+		CreatedAt:  a.CreatedAt,
+	}
+}
+
+// mapAssignmentsToResponse converts staff assignments to a list response DTO
+func mapAssignmentsToResponse(assignments []*staff.Assignment) staffDto.StaffListResponse {
+	response := staffDto.StaffListResponse{
+		Staff: make([]staffDto.StaffResponse, len(assignments)),
+		Count: len(assignments),
+	}
+	for i, a := range assignments {
+		response.Staff[i] = mapAssignmentToResponse(a)
+	}
+	return response
+}
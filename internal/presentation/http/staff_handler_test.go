@@ -0,0 +1,411 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/staff"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockStaffService is a mock implementation of staff.Service interface
+type MockStaffService struct {
+	mock.Mock
+}
+
+func (m *MockStaffService) Invite(ctx context.Context, actor staff.Actor, eventID uuid.UUID, email, role string) (*staff.Assignment, error) {
+	args := m.Called(ctx, actor, eventID, email, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*staff.Assignment), args.Error(1)
+}
+
+func (m *MockStaffService) ListStaff(ctx context.Context, actor staff.Actor, eventID uuid.UUID) ([]*staff.Assignment, error) {
+	args := m.Called(ctx, actor, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*staff.Assignment), args.Error(1)
+}
+
+func (m *MockStaffService) RemoveStaff(ctx context.Context, actor staff.Actor, eventID, userID uuid.UUID) error {
+	args := m.Called(ctx, actor, eventID, userID)
+	return args.Error(0)
+}
+
+func (m *MockStaffService) ListInvitations(ctx context.Context, userID uuid.UUID) ([]*staff.Assignment, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*staff.Assignment), args.Error(1)
+}
+
+func (m *MockStaffService) AcceptInvitation(ctx context.Context, userID, invitationID uuid.UUID) (*staff.Assignment, error) {
+	args := m.Called(ctx, userID, invitationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*staff.Assignment), args.Error(1)
+}
+
+func (m *MockStaffService) Authorize(ctx context.Context, actor staff.Actor, eventID uuid.UUID, permission staff.Permission) (*event.Event, error) {
+	args := m.Called(ctx, actor, eventID, permission)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+// MockStaffOrderService is a mock implementation of order.Service interface
+type MockStaffOrderService struct {
+	mock.Mock
+}
+
+func (m *MockStaffOrderService) CreateOrder(ctx context.Context, userID uuid.UUID, eventID uuid.UUID, quantity int, details order.Details) (*order.Order, error) {
+	args := m.Called(ctx, userID, eventID, quantity, details)
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockStaffOrderService) GetOrderByID(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockStaffOrderService) GetOrderStatus(ctx context.Context, id uuid.UUID) (*order.StatusSnapshot, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.StatusSnapshot), args.Error(1)
+}
+
+func (m *MockStaffOrderService) GetOrdersByUserID(ctx context.Context, userID uuid.UUID) ([]*order.Order, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
+func (m *MockStaffOrderService) GetOrdersByEventID(ctx context.Context, eventID uuid.UUID) ([]*order.Order, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
+func (m *MockStaffOrderService) GetAttendees(ctx context.Context, eventID uuid.UUID) ([]*order.Attendee, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Attendee), args.Error(1)
+}
+
+func (m *MockStaffOrderService) UpdateOrderStatus(ctx context.Context, id uuid.UUID, status order.Status) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockStaffOrderService) DeleteOrder(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockStaffOrderService) CheckIn(ctx context.Context, eventID uuid.UUID, orderID uuid.UUID) (*order.Order, error) {
+	args := m.Called(ctx, eventID, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockStaffOrderService) ExpirePendingOrders(ctx context.Context, olderThan time.Duration) (int64, error) {
+	args := m.Called(ctx, olderThan)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockStaffOrderService) ListOrdersForReview(ctx context.Context, limit int) ([]*order.Order, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
+func (m *MockStaffOrderService) ReviewOrder(ctx context.Context, id uuid.UUID, approve bool) (*order.Order, error) {
+	args := m.Called(ctx, id, approve)
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockStaffOrderService) ReconcileTickets(ctx context.Context, dryRun bool) (*order.Reconciliation, error) {
+	args := m.Called(ctx, dryRun)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Reconciliation), args.Error(1)
+}
+
+func (m *MockStaffOrderService) ArchiveEvents(ctx context.Context, cutoff time.Time) (*order.Archived, error) {
+	args := m.Called(ctx, cutoff)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Archived), args.Error(1)
+}
+
+func (m *MockStaffOrderService) ResendGift(ctx context.Context, userID, orderID uuid.UUID) error {
+	args := m.Called(ctx, userID, orderID)
+	return args.Error(0)
+}
+
+func (m *MockStaffOrderService) ClaimGift(ctx context.Context, userID uuid.UUID, token string) (*order.Order, error) {
+	args := m.Called(ctx, userID, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockStaffOrderService) CreateGroup(ctx context.Context, leaderID, eventID uuid.UUID, shares []int, details order.Details) (*order.GroupReservation, error) {
+	args := m.Called(ctx, leaderID, eventID, shares, details)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.GroupReservation), args.Error(1)
+}
+
+func (m *MockStaffOrderService) GetGroup(ctx context.Context, leaderID, groupID uuid.UUID) (*order.Group, error) {
+	args := m.Called(ctx, leaderID, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Group), args.Error(1)
+}
+
+func (m *MockStaffOrderService) TakeGroupShare(ctx context.Context, userID uuid.UUID, token string) (*order.Order, error) {
+	args := m.Called(ctx, userID, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func userTestContext(method, path string, body interface{}, userID uuid.UUID, params gin.Params) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	var buf bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&buf).Encode(body)
+	}
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, path, &buf)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = params
+	c.Set("user", &auth.JWTClaims{UserID: userID, Roles: []string{"USER"}})
+	return c, w
+}
+
+func TestStaffHandler_InviteStaff(t *testing.T) {
+	organizerID := uuid.New()
+	eventID := uuid.New()
+	params := gin.Params{{Key: "id", Value: eventID.String()}}
+
+	tests := []struct {
+		name           string
+		body           interface{}
+		setupMocks     func(*MockStaffService)
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "success",
+			body: map[string]string{"email": "door@example.com", "role": "CHECK_IN"},
+			setupMocks: func(m *MockStaffService) {
+				m.On("Invite", mock.Anything, staff.Actor{UserID: organizerID}, eventID, "door@example.com", "CHECK_IN").
+					Return(&staff.Assignment{ID: uuid.New(), EventID: eventID, Role: staff.RoleCheckIn, Status: staff.StatusPending}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "invalid email",
+			body:           map[string]string{"email": "not-an-email", "role": "CHECK_IN"},
+			setupMocks:     func(m *MockStaffService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "not the organizer",
+			body: map[string]string{"email": "door@example.com", "role": "VIEWER"},
+			setupMocks: func(m *MockStaffService) {
+				m.On("Invite", mock.Anything, mock.Anything, eventID, "door@example.com", "VIEWER").Return(nil, staff.ErrNotOrganizer)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "NOT_EVENT_ORGANIZER",
+		},
+		{
+			name: "already invited",
+			body: map[string]string{"email": "door@example.com", "role": "VIEWER"},
+			setupMocks: func(m *MockStaffService) {
+				m.On("Invite", mock.Anything, mock.Anything, eventID, "door@example.com", "VIEWER").Return(nil, staff.ErrAlreadyAssigned)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  "ALREADY_ASSIGNED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			staffService := new(MockStaffService)
+			tt.setupMocks(staffService)
+			handler := NewStaffHandler(staffService, new(MockStaffOrderService), auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			c, w := userTestContext(http.MethodPost, "/events/"+eventID.String()+"/staff", tt.body, organizerID, params)
+			handler.InviteStaff(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+			staffService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStaffHandler_CheckIn(t *testing.T) {
+	staffID := uuid.New()
+	eventID := uuid.New()
+	orderID := uuid.New()
+	params := gin.Params{{Key: "id", Value: eventID.String()}}
+	body := map[string]string{"order_id": orderID.String()}
+	actor := staff.Actor{UserID: staffID}
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*MockStaffService, *MockStaffOrderService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "check-in staff admits ticket holder",
+			setupMocks: func(s *MockStaffService, o *MockStaffOrderService) {
+				now := time.Now()
+				s.On("Authorize", mock.Anything, actor, eventID, staff.PermissionCheckIn).Return(&event.Event{ID: eventID}, nil)
+				o.On("CheckIn", mock.Anything, eventID, orderID).Return(&order.Order{ID: orderID, EventID: eventID, Status: order.StatusCompleted, CheckedInAt: &now}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "checked_in_at",
+		},
+		{
+			name: "viewer staff is denied",
+			setupMocks: func(s *MockStaffService, o *MockStaffOrderService) {
+				s.On("Authorize", mock.Anything, actor, eventID, staff.PermissionCheckIn).Return(nil, staff.ErrAccessDenied)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "ACCESS_DENIED",
+		},
+		{
+			name: "already checked in",
+			setupMocks: func(s *MockStaffService, o *MockStaffOrderService) {
+				s.On("Authorize", mock.Anything, actor, eventID, staff.PermissionCheckIn).Return(&event.Event{ID: eventID}, nil)
+				o.On("CheckIn", mock.Anything, eventID, orderID).Return(nil, order.NewAlreadyCheckedInError(orderID))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "ALREADY_CHECKED_IN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			staffService := new(MockStaffService)
+			orderService := new(MockStaffOrderService)
+			tt.setupMocks(staffService, orderService)
+			handler := NewStaffHandler(staffService, orderService, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+			c, w := userTestContext(http.MethodPost, "/events/"+eventID.String()+"/check-ins", body, staffID, params)
+			handler.CheckIn(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			staffService.AssertExpectations(t)
+			orderService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStaffHandler_AcceptInvitation(t *testing.T) {
+	userID := uuid.New()
+	invitationID := uuid.New()
+	staffService := new(MockStaffService)
+	staffService.On("AcceptInvitation", mock.Anything, userID, invitationID).Return(nil, staff.ErrInvitationNotFound)
+	handler := NewStaffHandler(staffService, new(MockStaffOrderService), auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+	c, w := userTestContext(http.MethodPost, "/staff/invitations/"+invitationID.String()+"/accept", nil, userID,
+		gin.Params{{Key: "id", Value: invitationID.String()}})
+	handler.AcceptInvitation(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "INVITATION_NOT_FOUND")
+}
+
+func TestStaffHandler_ExportAttendees(t *testing.T) {
+	viewerID := uuid.New()
+	eventID := uuid.New()
+	params := gin.Params{{Key: "id", Value: eventID.String()}}
+	actor := staff.Actor{UserID: viewerID}
+
+	t.Run("exports attendees with answers", func(t *testing.T) {
+		staffService := new(MockStaffService)
+		orderService := new(MockStaffOrderService)
+		evt := &event.Event{ID: eventID, AttendeeQuestions: event.Questions{
+			{Key: "tshirt_size", Label: "T-shirt size", Type: event.QuestionChoice, Options: []string{"S", "M"}},
+			{Key: "newsletter", Label: "Newsletter", Type: event.QuestionBoolean},
+		}}
+		orderID := uuid.New()
+		attendee := &order.Attendee{
+			Order: order.Order{
+				ID:          orderID,
+				EventID:     eventID,
+				Quantity:    2,
+				TotalAmount: 50,
+				Status:      order.StatusCompleted,
+				Notes:       "=HYPERLINK(\"http://evil\")",
+				Answers:     event.Answers{"tshirt_size": "M", "newsletter": true},
+				CreatedAt:   time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC),
+			},
+			Email:    "buyer@example.com",
+			Username: "buyer",
+		}
+		staffService.On("Authorize", mock.Anything, actor, eventID, staff.PermissionView).Return(evt, nil)
+		orderService.On("GetAttendees", mock.Anything, eventID).Return([]*order.Attendee{attendee}, nil)
+		handler := NewStaffHandler(staffService, orderService, auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+		c, w := userTestContext(http.MethodGet, "/events/"+eventID.String()+"/attendees/export", nil, viewerID, params)
+		handler.ExportAttendees(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "attendees-"+eventID.String()+".csv")
+		assert.Equal(t,
+			"order_id,email,username,quantity,total_amount,ordered_at,checked_in_at,notes,tshirt_size,newsletter\n"+
+				orderID.String()+",buyer@example.com,buyer,2,50.00,2026-05-01T12:00:00Z,,\"'=HYPERLINK(\"\"http://evil\"\")\",M,true\n",
+			w.Body.String())
+	})
+
+	t.Run("denied without view permission", func(t *testing.T) {
+		staffService := new(MockStaffService)
+		staffService.On("Authorize", mock.Anything, actor, eventID, staff.PermissionView).Return(nil, staff.ErrAccessDenied)
+		handler := NewStaffHandler(staffService, new(MockStaffOrderService), auth.NewJWTService("test-secret", "test-issuer", time.Hour))
+
+		c, w := userTestContext(http.MethodGet, "/events/"+eventID.String()+"/attendees/export", nil, viewerID, params)
+		handler.ExportAttendees(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "ACCESS_DENIED")
+	})
+}
@@ -0,0 +1,374 @@
+package http
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/event"
+	"enterprise-crud/internal/domain/transfer"
+	transferDto "enterprise-crud/internal/dto/transfer"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// TransferHandler handles HTTP requests for event ownership transfers
+type TransferHandler struct {
+	transferService transfer.Service
+	jwtService      *auth.JWTService
+}
+
+// NewTransferHandler creates a new instance of TransferHandler
+func NewTransferHandler(transferService transfer.Service, jwtService *auth.JWTService) *TransferHandler {
+	return &TransferHandler{
+		transferService: transferService,
+		jwtService:      jwtService,
+	}
+}
+
+// RequestTransfer offers an event to another organizer
+// @Summary Transfer an event
+// @Description Offer an event to another organizer by email; the event changes hands once they accept (only by organizer or admin)
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param transfer body transferDto.TransferEventRequest true "New organizer"
+// @Success 201 {object} transferDto.TransferResponse
+// @Failure 400 {object} transferDto.ErrorResponse
+// @Failure 401 {object} transferDto.ErrorResponse
+// @Failure 403 {object} transferDto.ErrorResponse
+// @Failure 404 {object} transferDto.ErrorResponse
+// @Failure 409 {object} transferDto.ErrorResponse
+// @Failure 500 {object} transferDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/transfer [post]
+func (h *TransferHandler) RequestTransfer(c *gin.Context) {
+	eventID, ok := parseTransferID(c, "event")
+	if !ok {
+		return
+	}
+
+	var req transferDto.TransferEventRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, transferDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	actor, ok := transferActor(c)
+	if !ok {
+		return
+	}
+
+	t, err := h.transferService.Request(c.Request.Context(), actor, eventID, req.Email)
+	if err != nil {
+		writeTransferError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapTransferToResponse(t))
+}
+
+// ListEventTransfers lists the transfer history of an event
+// @Summary List event transfers
+// @Description List the transfers of an event, newest first (only by organizer or admin)
+// @Tags transfers
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} transferDto.TransferListResponse
+// @Failure 400 {object} transferDto.ErrorResponse
+// @Failure 401 {object} transferDto.ErrorResponse
+// @Failure 403 {object} transferDto.ErrorResponse
+// @Failure 404 {object} transferDto.ErrorResponse
+// @Failure 500 {object} transferDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/events/{id}/transfers [get]
+func (h *TransferHandler) ListEventTransfers(c *gin.Context) {
+	eventID, ok := parseTransferID(c, "event")
+	if !ok {
+		return
+	}
+
+	actor, ok := transferActor(c)
+	if !ok {
+		return
+	}
+
+	transfers, err := h.transferService.ListForEvent(c.Request.Context(), actor, eventID)
+	if err != nil {
+		writeTransferError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapTransfersToResponse(transfers))
+}
+
+// ListMyTransfers lists the transfers waiting for the current user to accept
+// @Summary List my pending event transfers
+// @Description List the events other organizers offered to the current user
+// @Tags transfers
+// @Produce json
+// @Success 200 {object} transferDto.TransferListResponse
+// @Failure 401 {object} transferDto.ErrorResponse
+// @Failure 403 {object} transferDto.ErrorResponse
+// @Failure 500 {object} transferDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/event-transfers [get]
+func (h *TransferHandler) ListMyTransfers(c *gin.Context) {
+	actor, ok := transferActor(c)
+	if !ok {
+		return
+	}
+
+	transfers, err := h.transferService.ListPending(c.Request.Context(), actor.UserID)
+	if err != nil {
+		writeTransferError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapTransfersToResponse(transfers))
+}
+
+// GetTransfer retrieves a transfer with its audit trail
+// @Summary Get an event transfer
+// @Description Get a transfer with its history (by either organizer or an admin)
+// @Tags transfers
+// @Produce json
+// @Param id path string true "Transfer ID"
+// @Success 200 {object} transferDto.TransferDetailResponse
+// @Failure 400 {object} transferDto.ErrorResponse
+// @Failure 401 {object} transferDto.ErrorResponse
+// @Failure 403 {object} transferDto.ErrorResponse
+// @Failure 404 {object} transferDto.ErrorResponse
+// @Failure 500 {object} transferDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/event-transfers/{id} [get]
+func (h *TransferHandler) GetTransfer(c *gin.Context) {
+	id, ok := parseTransferID(c, "transfer")
+	if !ok {
+		return
+	}
+
+	actor, ok := transferActor(c)
+	if !ok {
+		return
+	}
+
+	t, audit, err := h.transferService.Get(c.Request.Context(), actor, id)
+	if err != nil {
+		writeTransferError(c, err)
+		return
+	}
+
+	response := transferDto.TransferDetailResponse{
+		TransferResponse: mapTransferToResponse(t),
+		History:          make([]transferDto.AuditEntryResponse, len(audit)),
+	}
+	for i, entry := range audit {
+		response.History[i] = transferDto.AuditEntryResponse{
+			Action:    entry.Action,
+			ActorID:   entry.ActorID,
+			CreatedAt: entry.CreatedAt,
+		}
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// AcceptTransfer accepts a transfer, making the current user the event's organizer
+// @Summary Accept an event transfer
+// @Description Take over an event another organizer offered to the current user
+// @Tags transfers
+// @Produce json
+// @Param id path string true "Transfer ID"
+// @Success 200 {object} transferDto.TransferResponse
+// @Failure 400 {object} transferDto.ErrorResponse
+// @Failure 401 {object} transferDto.ErrorResponse
+// @Failure 403 {object} transferDto.ErrorResponse
+// @Failure 404 {object} transferDto.ErrorResponse
+// @Failure 409 {object} transferDto.ErrorResponse
+// @Failure 500 {object} transferDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/event-transfers/{id}/accept [post]
+func (h *TransferHandler) AcceptTransfer(c *gin.Context) {
+	h.respond(c, func(actor transfer.Actor, id uuid.UUID) (*transfer.Transfer, error) {
+		return h.transferService.Accept(c.Request.Context(), actor.UserID, id)
+	})
+}
+
+// DeclineTransfer declines a transfer offered to the current user
+// @Summary Decline an event transfer
+// @Description Decline an event another organizer offered to the current user
+// @Tags transfers
+// @Produce json
+// @Param id path string true "Transfer ID"
+// @Success 200 {object} transferDto.TransferResponse
+// @Failure 400 {object} transferDto.ErrorResponse
+// @Failure 401 {object} transferDto.ErrorResponse
+// @Failure 403 {object} transferDto.ErrorResponse
+// @Failure 404 {object} transferDto.ErrorResponse
+// @Failure 409 {object} transferDto.ErrorResponse
+// @Failure 500 {object} transferDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/event-transfers/{id}/decline [post]
+func (h *TransferHandler) DeclineTransfer(c *gin.Context) {
+	h.respond(c, func(actor transfer.Actor, id uuid.UUID) (*transfer.Transfer, error) {
+		return h.transferService.Decline(c.Request.Context(), actor.UserID, id)
+	})
+}
+
+// CancelTransfer withdraws a pending transfer
+// @Summary Cancel an event transfer
+// @Description Withdraw a pending transfer (only by the organizer handing the event over or an admin)
+// @Tags transfers
+// @Produce json
+// @Param id path string true "Transfer ID"
+// @Success 200 {object} transferDto.TransferResponse
+// @Failure 400 {object} transferDto.ErrorResponse
+// @Failure 401 {object} transferDto.ErrorResponse
+// @Failure 403 {object} transferDto.ErrorResponse
+// @Failure 404 {object} transferDto.ErrorResponse
+// @Failure 409 {object} transferDto.ErrorResponse
+// @Failure 500 {object} transferDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/event-transfers/{id}/cancel [post]
+func (h *TransferHandler) CancelTransfer(c *gin.Context) {
+	h.respond(c, func(actor transfer.Actor, id uuid.UUID) (*transfer.Transfer, error) {
+		return h.transferService.Cancel(c.Request.Context(), actor, id)
+	})
+}
+
+// RegisterRoutes registers all event transfer routes
+// Both sides of a transfer are organizers, so every route requires the ORGANIZER or ADMIN role.
+func (h *TransferHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	router.POST("/events/:id/transfer",
+		jwtMiddleware.AuthRequired(),
+		auth.RequireOrganizer(),
+		h.RequestTransfer)
+
+	router.GET("/events/:id/transfers",
+		jwtMiddleware.AuthRequired(),
+		auth.RequireOrganizer(),
+		h.ListEventTransfers)
+
+	transferRoutes := router.Group("/event-transfers")
+	transferRoutes.Use(jwtMiddleware.AuthRequired(), auth.RequireOrganizer())
+	{
+		transferRoutes.GET("", h.ListMyTransfers)
+		transferRoutes.GET("/:id", h.GetTransfer)
+		transferRoutes.POST("/:id/accept", h.AcceptTransfer)
+		transferRoutes.POST("/:id/decline", h.DeclineTransfer)
+		transferRoutes.POST("/:id/cancel", h.CancelTransfer)
+	}
+}
+
+// respond runs a decision on the transfer in the path and writes the updated transfer
+func (h *TransferHandler) respond(c *gin.Context, decide func(transfer.Actor, uuid.UUID) (*transfer.Transfer, error)) {
+	id, ok := parseTransferID(c, "transfer")
+	if !ok {
+		return
+	}
+
+	actor, ok := transferActor(c)
+	if !ok {
+		return
+	}
+
+	t, err := decide(actor, id)
+	if err != nil {
+		writeTransferError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapTransferToResponse(t))
+}
+
+// parseTransferID parses the ID path parameter, writing a 400 when it is invalid
+func parseTransferID(c *gin.Context, kind string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, transferDto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid " + kind + " ID format",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// transferActor builds the acting user from the authenticated principal, writing a 401 when there is none
+func transferActor(c *gin.Context) (transfer.Actor, bool) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return transfer.Actor{}, false
+	}
+	return transfer.Actor{UserID: currentUser.UserID, IsAdmin: currentUser.IsAdmin()}, true
+}
+
+// writeTransferError maps transfer and event errors to HTTP responses
+func writeTransferError(c *gin.Context, err error) {
+	switch {
+	case event.IsEventNotFoundError(err):
+		c.JSON(http.StatusNotFound, transferDto.ErrorResponse{
+			Error:   event.GetEventErrorCode(err),
+			Message: err.Error(),
+		})
+	case transfer.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, transferDto.ErrorResponse{
+			Error:   transfer.GetTransferErrorCode(err),
+			Message: err.Error(),
+		})
+	case transfer.IsForbiddenError(err):
+		c.JSON(http.StatusForbidden, transferDto.ErrorResponse{
+			Error:   transfer.GetTransferErrorCode(err),
+			Message: err.Error(),
+		})
+	case transfer.IsNotFoundError(err):
+		c.JSON(http.StatusNotFound, transferDto.ErrorResponse{
+			Error:   transfer.GetTransferErrorCode(err),
+			Message: err.Error(),
+		})
+	case transfer.IsConflictError(err):
+		c.JSON(http.StatusConflict, transferDto.ErrorResponse{
+			Error:   transfer.GetTransferErrorCode(err),
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, transferDto.ErrorResponse{
+			Error:   "transfer_error",
+			Message: err.Error(),
+		})
+	}
+}
+
+// mapTransferToResponse converts a transfer to response DTO
+func mapTransferToResponse(t *transfer.Transfer) transferDto.TransferResponse {
+	return transferDto.TransferResponse{
+		ID:              t.ID,
+		EventID:         t.EventID,
+		EventTitle:      t.EventTitle,
+		FromOrganizerID: t.FromOrganizerID,
+		ToOrganizerID:   t.ToOrganizerID,
+		Status:          t.Status,
+		RequestedBy:     t.RequestedBy,
+		RespondedAt:     t.RespondedAt,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+	}
+}
+
+// mapTransfersToResponse converts transfers to a list response DTO
+func mapTransfersToResponse(transfers []*transfer.Transfer) transferDto.TransferListResponse {
+	response := transferDto.TransferListResponse{
+		Transfers: make([]transferDto.TransferResponse, len(transfers)),
+		Count:     len(transfers),
+	}
+	for i, t := range transfers {
+		response.Transfers[i] = mapTransferToResponse(t)
+	}
+	return response
+}
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/trending"
+	eventDto "enterprise-crud/internal/dto/event"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TrendingHandler handles HTTP requests for trending events
+type TrendingHandler struct {
+	trendingService trending.Service
+	clock           clock.Clock
+}
+
+// NewTrendingHandler creates a new instance of TrendingHandler
+func NewTrendingHandler(trendingService trending.Service, opts ...HandlerOption) *TrendingHandler {
+	options := applyHandlerOptions(opts)
+	return &TrendingHandler{trendingService: trendingService, clock: options.clock}
+}
+
+// GetTrendingEvents lists the events with the most recent views and purchases
+// @Summary Get trending events
+// @Description List active upcoming events by their recent page views and placed orders, highest score first.
+// @Description Each view and order counts half as much after every trending.half_life.
+// @Tags events
+// @Produce json
+// @Param limit query int false "Maximum number of events (default 10, at most 50)"
+// @Success 200 {object} eventDto.TrendingEventsResponse
+// @Failure 500 {object} eventDto.ErrorResponse
+// @Router /api/v1/events/trending [get]
+func (h *TrendingHandler) GetTrendingEvents(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	trends, err := h.trendingService.GetTrending(c.Request.Context(), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, eventDto.ErrorResponse{
+			Error:   "retrieval_error",
+			Message: "Failed to retrieve trending events: " + err.Error(),
+		})
+		return
+	}
+
+	now := h.clock.Now()
+	events := make([]eventDto.TrendingEventResponse, len(trends))
+	for i, t := range trends {
+		events[i] = eventDto.TrendingEventResponse{
+			EventResponse: mapEventToResponse(t.Event, now),
+			Score:         t.Score.Score,
+			Views:         t.Views,
+			Purchases:     t.Purchases,
+		}
+	}
+
+	c.JSON(http.StatusOK, eventDto.TrendingEventsResponse{ListResponse: newList(c, events)})
+}
+
+// RegisterRoutes registers trending routes with the gin router
+func (h *TrendingHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/events/trending", h.GetTrendingEvents)
+}
@@ -5,14 +5,20 @@ package http
 
 import (
 	"errors"
+	"log"
 	"net/http"
 	"time"
+	"unicode/utf8"
 
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/consent"
+	"enterprise-crud/internal/domain/order"
 	"enterprise-crud/internal/domain/user"
 	userDTO "enterprise-crud/internal/dto/user"
 	"enterprise-crud/internal/infrastructure/auth"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 // UserHandler handles HTTP requests for user operations
@@ -25,8 +31,12 @@ import (
 // - Makes testing easy (can inject mock services)
 // - Makes the code flexible (can swap service implementations)
 type UserHandler struct {
-	userService user.Service     // Service layer for user business logic (INTERFACE, not concrete type)
-	jwtService  *auth.JWTService // JWT service for token generation and validation
+	userService        user.Service      // Service layer for user business logic (INTERFACE, not concrete type)
+	consentService     consent.Service   // Records policy acceptance at registration and login; nil disables consent tracking
+	jwtService         *auth.JWTService  // JWT service for token generation and validation
+	registrationChecks []gin.HandlerFunc // Run before a user is created, e.g. anti-bot challenges
+	giftClaimer        order.Service     // Claims the gift a new user registered through; nil ignores gift tokens
+	clock              clock.Clock       // Tells when issued tokens expire
 }
 
 // NewUserHandler creates a new instance of UserHandler
@@ -48,37 +58,77 @@ type UserHandler struct {
 // - Testing: NewUserHandler(mockUserService)
 //
 // Returns a handler for user HTTP operations
-func NewUserHandler(userService user.Service, jwtService *auth.JWTService) *UserHandler {
+func NewUserHandler(userService user.Service, consentService consent.Service, jwtService *auth.JWTService, opts ...HandlerOption) *UserHandler {
+	options := applyHandlerOptions(opts)
 	return &UserHandler{
-		userService: userService,
-		jwtService:  jwtService,
+		userService:    userService,
+		consentService: consentService,
+		jwtService:     jwtService,
+		clock:          options.clock,
 	}
 }
 
+// RequireBeforeRegistration adds middleware that must pass before a user is created
+// It must be called before RegisterRoutes.
+func (h *UserHandler) RequireBeforeRegistration(checks ...gin.HandlerFunc) {
+	h.registrationChecks = append(h.registrationChecks, checks...)
+}
+
+// ClaimGiftsWith lets users claim a gift while registering, by sending the token from its claim link
+func (h *UserHandler) ClaimGiftsWith(orderService order.Service) {
+	h.giftClaimer = orderService
+}
+
 // CreateUser handles POST requests to create a new user
 // @Summary Create a new user
-// @Description Create a new user with email, username and password
+// @Description Create a new user with email, username and password.
+// @Description Once policies are published, accept_terms must be set to accept the current versions.
+// @Description A gift_token from a gift claim link moves the gift to the new account; a gift that can't be claimed doesn't fail the registration.
+// @Description Risky registrations answer 403 CAPTCHA_REQUIRED; repeat them with the CAPTCHA token in the X-Captcha-Token header.
 // @Tags users
 // @Accept json
 // @Produce json
 // @Param user body userDTO.CreateUserRequest true "User creation request"
+// @Param X-Captcha-Token header string false "CAPTCHA token, when challenged"
 // @Success 201 {object} userDTO.UserResponse "User created successfully"
-// @Failure 400 {object} userDTO.ErrorResponse "Invalid request data"
-// @Failure 409 {object} userDTO.ErrorResponse "User already exists"
+// @Failure 400 {object} userDTO.ErrorResponse "Invalid request data or terms not accepted"
+// @Failure 403 {object} userDTO.ErrorResponse "CAPTCHA required or failed"
+// @Failure 409 {object} userDTO.ErrorResponse "Email or username already taken"
 // @Failure 500 {object} userDTO.ErrorResponse "Internal server error"
 // @Router /api/v1/users [post]
 func (h *UserHandler) CreateUser(c *gin.Context) {
 	var req userDTO.CreateUserRequest
 
 	// Bind and validate request JSON
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, userDTO.ErrorResponse{
+	if bindErr := BindJSON(c, &req); bindErr != nil {
+		c.JSON(bindErr.Status, userDTO.ErrorResponse{
 			Error:   "Invalid request",
-			Message: err.Error(),
+			Message: bindErr.Error(),
 		})
 		return
 	}
 
+	// Users accept the policies current at registration, which they were shown
+	var policies []*consent.Version
+	if h.consentService != nil {
+		var err error
+		policies, err = h.consentService.GetLatestVersions(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, userDTO.ErrorResponse{
+				Error:   "Internal server error",
+				Message: "An error occurred while processing your request",
+			})
+			return
+		}
+		if len(policies) > 0 && !req.AcceptTerms {
+			c.JSON(http.StatusBadRequest, userDTO.ErrorResponse{
+				Error:   "Consent required",
+				Message: "Accept the terms of service and privacy policy by setting accept_terms",
+			})
+			return
+		}
+	}
+
 	// Call service to create user
 	createdUser, err := h.userService.CreateUser(c.Request.Context(), req.Email, req.Username, req.Password)
 	if err != nil {
@@ -86,10 +136,22 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 		return
 	}
 
-	// Extract role names for response
-	roleNames := make([]string, len(createdUser.Roles))
-	for i, role := range createdUser.Roles {
-		roleNames[i] = role.Name
+	// A failed recording is not fatal: the policies are offered again at login
+	if len(policies) > 0 {
+		ids := make([]uuid.UUID, len(policies))
+		for i, p := range policies {
+			ids[i] = p.ID
+		}
+		if _, err := h.consentService.Accept(c.Request.Context(), createdUser.ID, ids); err != nil {
+			log.Printf("Warning: Failed to record consents of user %s: %v", createdUser.ID, err)
+		}
+	}
+
+	// The account exists either way; the gift can still be claimed from the link after signing in
+	if h.giftClaimer != nil && req.GiftToken != "" {
+		if _, err := h.giftClaimer.ClaimGift(c.Request.Context(), createdUser.ID, req.GiftToken); err != nil {
+			log.Printf("Warning: Failed to claim gift for user %s: %v", createdUser.ID, err)
+		}
 	}
 
 	// Return successful response with roles
@@ -97,7 +159,7 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 		ID:       createdUser.ID,
 		Email:    createdUser.Email,
 		Username: createdUser.Username,
-		Roles:    roleNames,
+		Roles:    createdUser.RoleNames(),
 	}
 
 	c.JSON(http.StatusCreated, response)
@@ -135,18 +197,12 @@ func (h *UserHandler) GetUserByEmail(c *gin.Context) {
 		return
 	}
 
-	// Extract role names for response
-	roleNames := make([]string, len(foundUser.Roles))
-	for i, role := range foundUser.Roles {
-		roleNames[i] = role.Name
-	}
-
 	// Return successful response with roles
 	response := userDTO.UserResponse{
 		ID:       foundUser.ID,
 		Email:    foundUser.Email,
 		Username: foundUser.Username,
-		Roles:    roleNames,
+		Roles:    foundUser.RoleNames(),
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -154,7 +210,8 @@ func (h *UserHandler) GetUserByEmail(c *gin.Context) {
 
 // Login handles POST requests to authenticate a user
 // @Summary User login
-// @Description Authenticate user with email and password, returns JWT token
+// @Description Authenticate user with email and password, returns JWT token.
+// @Description pending_policies lists policy versions published since the user last accepted them; log in with accept_terms to accept them.
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -162,16 +219,17 @@ func (h *UserHandler) GetUserByEmail(c *gin.Context) {
 // @Success 200 {object} userDTO.LoginResponse "Login successful"
 // @Failure 400 {object} userDTO.ErrorResponse "Invalid request data"
 // @Failure 401 {object} userDTO.ErrorResponse "Invalid credentials"
+// @Failure 403 {object} userDTO.ErrorResponse "Account suspended or banned"
 // @Failure 500 {object} userDTO.ErrorResponse "Internal server error"
 // @Router /api/v1/auth/login [post]
 func (h *UserHandler) Login(c *gin.Context) {
 	var req userDTO.LoginRequest
 
 	// Bind and validate request JSON
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, userDTO.ErrorResponse{
+	if bindErr := BindJSON(c, &req); bindErr != nil {
+		c.JSON(bindErr.Status, userDTO.ErrorResponse{
 			Error:   "Invalid request",
-			Message: err.Error(),
+			Message: bindErr.Error(),
 		})
 		return
 	}
@@ -183,15 +241,22 @@ func (h *UserHandler) Login(c *gin.Context) {
 		return
 	}
 
-	// Extract role names from user roles for JWT token
-	// Convert the Role objects to just their names (strings)
-	roleNames := make([]string, len(authenticatedUser.Roles))
-	for i, role := range authenticatedUser.Roles {
-		roleNames[i] = role.Name
+	// The roles loaded with the credentials predate the version read now, so a role change landing in
+	// between would have the token carry old roles as current; they are reloaded after the version
+	// unless the user's roles never changed
+	rolesVersion := h.jwtService.RolesVersion(c.Request.Context(), authenticatedUser.ID)
+	if rolesVersion != 0 {
+		current, err := h.userService.GetUserByID(c.Request.Context(), authenticatedUser.ID)
+		if err != nil {
+			h.handleUserError(c, err)
+			return
+		}
+		authenticatedUser = current
 	}
 
 	// Generate JWT token with user roles included
-	token, err := h.jwtService.GenerateToken(authenticatedUser.ID, authenticatedUser.Email, authenticatedUser.Username, roleNames)
+	roleNames := authenticatedUser.RoleNames()
+	token, err := h.jwtService.GenerateToken(authenticatedUser.ID, authenticatedUser.Email, authenticatedUser.Username, roleNames, rolesVersion)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, userDTO.ErrorResponse{
 			Error:   "Failed to generate token",
@@ -201,7 +266,7 @@ func (h *UserHandler) Login(c *gin.Context) {
 	}
 
 	// Calculate expiration time (matching JWT service expiration)
-	expiresAt := time.Now().Add(24 * 30 * time.Hour).Unix() // 30 days (long-lived token)
+	expiresAt := h.clock.Now().Add(24 * 30 * time.Hour).Unix() // 30 days (long-lived token)
 
 	// Return successful response with roles
 	response := userDTO.LoginResponse{
@@ -214,10 +279,43 @@ func (h *UserHandler) Login(c *gin.Context) {
 		Token:     token,
 		ExpiresAt: expiresAt,
 	}
+	response.PendingPolicies = h.pendingPolicies(c, authenticatedUser.ID, req.AcceptTerms)
 
 	c.JSON(http.StatusOK, response)
 }
 
+// CheckUsername handles GET requests to check whether a username is still free
+// @Summary Check username availability
+// @Description Report whether a username can still be registered, for validating sign-up forms as the user types
+// @Tags users
+// @Produce json
+// @Param username query string true "Username to check (at least 3 characters)"
+// @Success 200 {object} userDTO.UsernameAvailabilityResponse "Availability of the username"
+// @Failure 400 {object} userDTO.ErrorResponse "Missing or too short username"
+// @Failure 500 {object} userDTO.ErrorResponse "Internal server error"
+// @Router /api/v1/users/check-username [get]
+func (h *UserHandler) CheckUsername(c *gin.Context) {
+	username := c.Query("username")
+	if utf8.RuneCountInString(username) < 3 {
+		c.JSON(http.StatusBadRequest, userDTO.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "username must be at least 3 characters",
+		})
+		return
+	}
+
+	available, err := h.userService.IsUsernameAvailable(c.Request.Context(), username)
+	if err != nil {
+		h.handleUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, userDTO.UsernameAvailabilityResponse{
+		Username:  username,
+		Available: available,
+	})
+}
+
 // GetProfile handles GET requests to retrieve the current user's profile
 // @Summary Get current user profile
 // @Description Get the profile of the currently authenticated user with their roles
@@ -230,68 +328,362 @@ func (h *UserHandler) Login(c *gin.Context) {
 // @Router /api/v1/users/profile [get]
 func (h *UserHandler) GetProfile(c *gin.Context) {
 	// Get user information from JWT token (set by middleware)
-	userID, userEmail, userUsername, exists := auth.GetUserFromContext(c)
-	if !exists {
-		c.JSON(http.StatusUnauthorized, userDTO.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User information not found in token",
-		})
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
 		return
 	}
 
-	// Get user roles from JWT token
-	userRoles, _ := auth.GetUserRoles(c) // Empty slice if no roles found
-
 	// Return user profile with roles
 	response := userDTO.UserResponse{
-		ID:       userID,
-		Email:    userEmail,
-		Username: userUsername,
-		Roles:    userRoles,
+		ID:       currentUser.UserID,
+		Email:    currentUser.Email,
+		Username: currentUser.Username,
+		Roles:    currentUser.Roles,
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
+// dateOfBirthLayout is how dates of birth are written in requests and responses
+const dateOfBirthLayout = "2006-01-02"
+
+// GetDateOfBirth handles GET requests for the current user's date of birth
+// @Summary Get date of birth
+// @Description Get the date of birth on the current user's profile, null when not given
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} userDTO.DateOfBirthResponse
+// @Failure 401 {object} userDTO.ErrorResponse "Unauthorized - invalid or missing token"
+// @Failure 500 {object} userDTO.ErrorResponse "Internal server error"
+// @Router /api/v1/users/profile/date-of-birth [get]
+func (h *UserHandler) GetDateOfBirth(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	dateOfBirth, err := h.userService.DateOfBirth(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		h.handleUserError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dateOfBirthResponse(dateOfBirth))
+}
+
+// SetDateOfBirth handles PUT requests to set or remove the current user's date of birth
+// @Summary Set date of birth
+// @Description Set the date of birth on the current user's profile, or remove it with null.
+// @Description It is optional, but needed to order tickets for events with a minimum age.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body userDTO.DateOfBirthRequest true "Date of birth"
+// @Security BearerAuth
+// @Success 200 {object} userDTO.DateOfBirthResponse
+// @Failure 400 {object} userDTO.ErrorResponse "Invalid request or date of birth"
+// @Failure 401 {object} userDTO.ErrorResponse "Unauthorized - invalid or missing token"
+// @Failure 500 {object} userDTO.ErrorResponse "Internal server error"
+// @Router /api/v1/users/profile/date-of-birth [put]
+func (h *UserHandler) SetDateOfBirth(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req userDTO.DateOfBirthRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, userDTO.ErrorResponse{
+			Error:   "Invalid request",
+			Message: bindErr.Error(),
+		})
+		return
+	}
+
+	var dateOfBirth *time.Time
+	if req.DateOfBirth != nil {
+		parsed, err := time.Parse(dateOfBirthLayout, *req.DateOfBirth)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, userDTO.ErrorResponse{
+				Error:   "Invalid request",
+				Code:    user.ErrInvalidDateOfBirth.Code,
+				Message: "date_of_birth must be a date formatted as YYYY-MM-DD",
+			})
+			return
+		}
+		dateOfBirth = &parsed
+	}
+
+	updated, err := h.userService.SetDateOfBirth(c.Request.Context(), currentUser.UserID, dateOfBirth)
+	if err != nil {
+		h.handleUserError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dateOfBirthResponse(updated.DateOfBirth))
+}
+
+// dateOfBirthResponse formats a date of birth for clients
+func dateOfBirthResponse(dateOfBirth *time.Time) userDTO.DateOfBirthResponse {
+	if dateOfBirth == nil {
+		return userDTO.DateOfBirthResponse{}
+	}
+	formatted := dateOfBirth.Format(dateOfBirthLayout)
+	return userDTO.DateOfBirthResponse{DateOfBirth: &formatted}
+}
+
+// pendingPolicies accepts new policy versions if asked to and returns those still pending
+// Consent problems never block login; purchases are gated separately.
+func (h *UserHandler) pendingPolicies(c *gin.Context, userID uuid.UUID, accept bool) []userDTO.PolicyResponse {
+	if h.consentService == nil {
+		return nil
+	}
+
+	if accept {
+		if _, err := h.consentService.AcceptPending(c.Request.Context(), userID); err != nil {
+			log.Printf("Warning: Failed to record consents of user %s: %v", userID, err)
+		}
+	}
+
+	pending, err := h.consentService.GetPendingVersions(c.Request.Context(), userID)
+	if err != nil {
+		log.Printf("Warning: Failed to check pending consents of user %s: %v", userID, err)
+		return nil
+	}
+
+	policies := make([]userDTO.PolicyResponse, len(pending))
+	for i, v := range pending {
+		policies[i] = userDTO.PolicyResponse{ID: v.ID, Type: v.Type, Version: v.Version, URL: v.URL}
+	}
+	return policies
+}
+
+// userErrorResponse is how a user error code is reported to clients
+type userErrorResponse struct {
+	status int    // HTTP status
+	title  string // Value of the error field
+	hide   bool   // Replace the message, which may carry database details, with a generic one
+}
+
+// userErrorResponses maps every user error code to its HTTP response
+// Codes missing here are unexpected and answer 500.
+var userErrorResponses = map[string]userErrorResponse{
+	"USER_NOT_FOUND":        {status: http.StatusNotFound, title: "User not found"},
+	"ROLE_NOT_FOUND":        {status: http.StatusNotFound, title: "Role not found"},
+	"USER_EXISTS":           {status: http.StatusConflict, title: "User already exists"},
+	"USERNAME_EXISTS":       {status: http.StatusConflict, title: "Username already taken"},
+	"ACCOUNT_SUSPENDED":     {status: http.StatusForbidden, title: "Account blocked"},
+	"ACCOUNT_BANNED":        {status: http.StatusForbidden, title: "Account blocked"},
+	"INVALID_STATUS":        {status: http.StatusBadRequest, title: "Invalid request"},
+	"REASON_REQUIRED":       {status: http.StatusBadRequest, title: "Invalid request"},
+	"OWN_STATUS_CHANGE":     {status: http.StatusBadRequest, title: "Invalid request"},
+	"INVALID_CREDENTIALS":   {status: http.StatusUnauthorized, title: "Authentication failed"},
+	"PASSWORD_HASH_FAILED":  {status: http.StatusInternalServerError, title: "Internal server error", hide: true},
+	"USER_CREATION_FAILED":  {status: http.StatusInternalServerError, title: "Internal server error", hide: true},
+	"USER_RETRIEVAL_FAILED": {status: http.StatusInternalServerError, title: "Internal server error", hide: true},
+	"ROLE_RETRIEVAL_FAILED": {status: http.StatusInternalServerError, title: "Internal server error", hide: true},
+	"ROLE_UPDATE_FAILED":    {status: http.StatusInternalServerError, title: "Internal server error", hide: true},
+	"INVALID_DATE_OF_BIRTH": {status: http.StatusBadRequest, title: "Invalid request"},
+	"PROFILE_UPDATE_FAILED": {status: http.StatusInternalServerError, title: "Internal server error", hide: true},
+	"STATUS_UPDATE_FAILED":  {status: http.StatusInternalServerError, title: "Internal server error", hide: true},
+}
+
 // handleUserError maps user domain errors to appropriate HTTP responses
+// Internal failures are logged with their cause and answered with a generic message.
 func (h *UserHandler) handleUserError(c *gin.Context, err error) {
 	var userErr *user.UserError
-	if errors.As(err, &userErr) {
-		switch userErr.Code {
-		case "USER_NOT_FOUND":
-			c.JSON(http.StatusNotFound, userDTO.ErrorResponse{
-				Error:   "User not found",
-				Message: userErr.Message,
-			})
-		case "USER_EXISTS":
-			c.JSON(http.StatusConflict, userDTO.ErrorResponse{
-				Error:   "User already exists",
-				Message: userErr.Message,
-			})
-		case "INVALID_CREDENTIALS":
-			c.JSON(http.StatusUnauthorized, userDTO.ErrorResponse{
-				Error:   "Authentication failed",
-				Message: userErr.Message,
-			})
-		case "PASSWORD_HASH_FAILED", "USER_CREATION_FAILED", "USER_RETRIEVAL_FAILED", "ROLE_RETRIEVAL_FAILED":
-			c.JSON(http.StatusInternalServerError, userDTO.ErrorResponse{
-				Error:   "Internal server error",
-				Message: "An error occurred while processing your request",
-			})
-		default:
-			c.JSON(http.StatusInternalServerError, userDTO.ErrorResponse{
-				Error:   "Internal server error",
-				Message: "An unexpected error occurred",
+	if !errors.As(err, &userErr) {
+		log.Printf("Error: unexpected error from user service: %v", err)
+		c.JSON(http.StatusInternalServerError, userDTO.ErrorResponse{
+			Error:   "Internal server error",
+			Message: "An unexpected error occurred",
+		})
+		return
+	}
+
+	response, known := userErrorResponses[userErr.Code]
+	if !known {
+		log.Printf("Error: unmapped user error %s: %v", userErr.Code, err)
+		c.JSON(http.StatusInternalServerError, userDTO.ErrorResponse{
+			Error:   "Internal server error",
+			Message: "An unexpected error occurred",
+		})
+		return
+	}
+
+	message := userErr.Message
+	if response.hide {
+		log.Printf("Error: %v", err)
+		message = "An error occurred while processing your request"
+	}
+	c.JSON(response.status, userDTO.ErrorResponse{
+		Error:   response.title,
+		Code:    userErr.Code,
+		Message: message,
+	})
+}
+
+// GetAccountStatus handles GET requests for a user's account status and its history
+// @Summary Get account status
+// @Description Get a user's account status with every change and its reason, newest first (requires ADMIN role)
+// @Tags admin
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} userDTO.AccountStatusResponse
+// @Failure 400 {object} userDTO.ErrorResponse "Invalid user ID"
+// @Failure 401 {object} userDTO.ErrorResponse "Unauthorized"
+// @Failure 403 {object} userDTO.ErrorResponse "Forbidden"
+// @Failure 404 {object} userDTO.ErrorResponse "User not found"
+// @Failure 500 {object} userDTO.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/status [get]
+func (h *UserHandler) GetAccountStatus(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	foundUser, err := h.userService.GetUserByID(c.Request.Context(), userID)
+	if err != nil {
+		h.handleUserError(c, err)
+		return
+	}
+	h.respondAccountStatus(c, foundUser)
+}
+
+// SuspendUser handles POST requests to suspend a user
+// @Summary Suspend user
+// @Description Block a user until reactivated (requires ADMIN role). They get 403 with the reason at login and on every authenticated request.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param request body userDTO.AccountStatusRequest true "Reason"
+// @Success 200 {object} userDTO.AccountStatusResponse
+// @Failure 400 {object} userDTO.ErrorResponse "Invalid request or missing reason"
+// @Failure 401 {object} userDTO.ErrorResponse "Unauthorized"
+// @Failure 403 {object} userDTO.ErrorResponse "Forbidden"
+// @Failure 404 {object} userDTO.ErrorResponse "User not found"
+// @Failure 500 {object} userDTO.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/suspend [post]
+func (h *UserHandler) SuspendUser(c *gin.Context) {
+	h.setAccountStatus(c, user.StatusSuspended)
+}
+
+// BanUser handles POST requests to ban a user
+// @Summary Ban user
+// @Description Block a user permanently (requires ADMIN role). They get 403 with the reason at login and on every authenticated request.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param request body userDTO.AccountStatusRequest true "Reason"
+// @Success 200 {object} userDTO.AccountStatusResponse
+// @Failure 400 {object} userDTO.ErrorResponse "Invalid request or missing reason"
+// @Failure 401 {object} userDTO.ErrorResponse "Unauthorized"
+// @Failure 403 {object} userDTO.ErrorResponse "Forbidden"
+// @Failure 404 {object} userDTO.ErrorResponse "User not found"
+// @Failure 500 {object} userDTO.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/ban [post]
+func (h *UserHandler) BanUser(c *gin.Context) {
+	h.setAccountStatus(c, user.StatusBanned)
+}
+
+// ReactivateUser handles POST requests to reactivate a suspended or banned user
+// @Summary Reactivate user
+// @Description Lift a suspension or ban (requires ADMIN role). The reason is optional.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param request body userDTO.AccountStatusRequest false "Reason"
+// @Success 200 {object} userDTO.AccountStatusResponse
+// @Failure 400 {object} userDTO.ErrorResponse "Invalid request"
+// @Failure 401 {object} userDTO.ErrorResponse "Unauthorized"
+// @Failure 403 {object} userDTO.ErrorResponse "Forbidden"
+// @Failure 404 {object} userDTO.ErrorResponse "User not found"
+// @Failure 500 {object} userDTO.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/reactivate [post]
+func (h *UserHandler) ReactivateUser(c *gin.Context) {
+	h.setAccountStatus(c, user.StatusActive)
+}
+
+// setAccountStatus applies an admin's status change to the user in the path
+func (h *UserHandler) setAccountStatus(c *gin.Context, status string) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	// The reason is optional when reactivating, so an empty body is fine
+	var req userDTO.AccountStatusRequest
+	if c.Request.ContentLength != 0 {
+		if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+			c.JSON(bindErr.Status, userDTO.ErrorResponse{
+				Error:   "Invalid request",
+				Message: bindErr.Error(),
 			})
+			return
 		}
+	}
+
+	updated, err := h.userService.SetAccountStatus(c.Request.Context(), userID, status, req.Reason, &currentUser.UserID)
+	if err != nil {
+		h.handleUserError(c, err)
 		return
 	}
+	h.respondAccountStatus(c, updated)
+}
 
-	// Handle non-user errors
-	c.JSON(http.StatusInternalServerError, userDTO.ErrorResponse{
-		Error:   "Internal server error",
-		Message: "An unexpected error occurred",
-	})
+// respondAccountStatus writes a user's account status together with its history
+func (h *UserHandler) respondAccountStatus(c *gin.Context, u *user.User) {
+	changes, err := h.userService.GetStatusHistory(c.Request.Context(), u.ID)
+	if err != nil {
+		h.handleUserError(c, err)
+		return
+	}
+
+	status := u.Status
+	if status == "" {
+		status = user.StatusActive
+	}
+	response := userDTO.AccountStatusResponse{
+		UserID:    u.ID,
+		Email:     u.Email,
+		Status:    status,
+		Reason:    u.StatusReason,
+		ChangedAt: u.StatusChangedAt,
+		History:   make([]userDTO.StatusChangeResponse, len(changes)),
+	}
+	for i, change := range changes {
+		response.History[i] = userDTO.StatusChangeResponse{
+			Status:    change.Status,
+			Reason:    change.Reason,
+			ChangedBy: change.ChangedBy,
+			CreatedAt: change.CreatedAt,
+		}
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// parseUserID reads the user ID from the path, responding with 400 if it is malformed
+func parseUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, userDTO.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Invalid user ID format",
+		})
+		return uuid.Nil, false
+	}
+	return userID, true
 }
 
 // RegisterRoutes registers user routes with the gin router
@@ -304,7 +696,9 @@ func (h *UserHandler) RegisterRoutes(router *gin.RouterGroup) {
 	userRoutes := router.Group("/users")
 	{
 		// Public routes (no authentication required)
-		userRoutes.POST("", h.CreateUser) // Create new user (public registration)
+		registerHandlers := append([]gin.HandlerFunc{}, h.registrationChecks...)
+		userRoutes.POST("", append(registerHandlers, h.CreateUser)...) // Create new user (public registration)
+		userRoutes.GET("/check-username", h.CheckUsername)             // Username availability for sign-up forms
 
 		// Admin-only routes (require ADMIN role)
 		userRoutes.GET("/:email",
@@ -317,6 +711,23 @@ func (h *UserHandler) RegisterRoutes(router *gin.RouterGroup) {
 			jwtMiddleware.AuthRequired(), // Check authentication
 			auth.RequireUser(),           // Require USER or ADMIN role
 			h.GetProfile)                 // Get current user profile
+		userRoutes.GET("/profile/date-of-birth",
+			jwtMiddleware.AuthRequired(),
+			auth.RequireUser(),
+			h.GetDateOfBirth) // Date of birth checked for age-restricted events
+		userRoutes.PUT("/profile/date-of-birth",
+			jwtMiddleware.AuthRequired(),
+			auth.RequireUser(),
+			h.SetDateOfBirth)
+	}
+
+	// Account status management (require ADMIN role)
+	adminRoutes := router.Group("/admin/users", jwtMiddleware.AuthRequired(), auth.RequireAdmin())
+	{
+		adminRoutes.GET("/:id/status", h.GetAccountStatus)
+		adminRoutes.POST("/:id/suspend", h.SuspendUser)
+		adminRoutes.POST("/:id/ban", h.BanUser)
+		adminRoutes.POST("/:id/reactivate", h.ReactivateUser)
 	}
 }
 
@@ -10,6 +10,9 @@ import (
 	"testing"
 	"time"
 
+	"enterprise-crud/internal/domain/clock"
+	"enterprise-crud/internal/domain/consent"
+	"enterprise-crud/internal/domain/role"
 	"enterprise-crud/internal/domain/user"
 	userDTO "enterprise-crud/internal/dto/user"
 	"enterprise-crud/internal/infrastructure/auth"
@@ -18,6 +21,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockUserService is a mock implementation of user.Service interface
@@ -48,6 +52,56 @@ func (m *MockUserService) GetUserByEmail(ctx context.Context, email string) (*us
 
 // AuthenticateUser mocks the AuthenticateUser method of Service interface
 // Returns user and error based on test scenario configuration
+func (m *MockUserService) IsUsernameAvailable(ctx context.Context, username string) (bool, error) {
+	args := m.Called(ctx, username)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserService) GetUserByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) SetAccountStatus(ctx context.Context, userID uuid.UUID, status, reason string, actorID *uuid.UUID) (*user.User, error) {
+	args := m.Called(ctx, userID, status, reason, actorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) CheckAccountStatus(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserService) GetStatusHistory(ctx context.Context, userID uuid.UUID) ([]*user.StatusChange, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*user.StatusChange), args.Error(1)
+}
+
+func (m *MockUserService) SetDateOfBirth(ctx context.Context, userID uuid.UUID, dateOfBirth *time.Time) (*user.User, error) {
+	args := m.Called(ctx, userID, dateOfBirth)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) DateOfBirth(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
 func (m *MockUserService) AuthenticateUser(ctx context.Context, email, password string) (*user.User, error) {
 	args := m.Called(ctx, email, password)
 	if args.Get(0) == nil {
@@ -56,6 +110,27 @@ func (m *MockUserService) AuthenticateUser(ctx context.Context, email, password
 	return args.Get(0).(*user.User), args.Error(1)
 }
 
+func (m *MockUserService) GrantRole(ctx context.Context, email, roleName string) (*user.User, error) {
+	args := m.Called(ctx, email, roleName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) RevokeRole(ctx context.Context, email, roleName string) (*user.User, error) {
+	args := m.Called(ctx, email, roleName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) RolesVersion(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // setupTestRouter creates a test Gin router with user routes
 // Returns configured router for testing HTTP endpoints
 func setupTestRouter(userService user.Service) *gin.Engine {
@@ -66,7 +141,7 @@ func setupTestRouter(userService user.Service) *gin.Engine {
 	jwtService := auth.NewJWTService("test-secret-key", "test-issuer", time.Hour)
 
 	// Create handler and register routes
-	userHandler := NewUserHandler(userService, jwtService)
+	userHandler := NewUserHandler(userService, nil, jwtService)
 	v1 := router.Group("/api/v1")
 	userHandler.RegisterRoutes(v1)
 
@@ -78,7 +153,7 @@ func setupTestRouter(userService user.Service) *gin.Engine {
 func generateTestJWT(roles []string) string {
 	jwtService := auth.NewJWTService("test-secret-key", "test-issuer", time.Hour)
 	userID := uuid.New()
-	token, _ := jwtService.GenerateToken(userID, "admin@test.com", "admin", roles)
+	token, _ := jwtService.GenerateToken(userID, "admin@test.com", "admin", roles, 0)
 	return token
 }
 
@@ -176,6 +251,19 @@ func TestUserHandler_CreateUser(t *testing.T) {
 			expectedStatus: http.StatusConflict,
 			expectedBody:   `"error":"User already exists"`,
 		},
+		{
+			name: "username already taken",
+			requestBody: userDTO.CreateUserRequest{
+				Email:    "new@example.com",
+				Username: "existinguser",
+				Password: "password123",
+			},
+			mockFunc: func(m *MockUserService) {
+				m.On("CreateUser", mock.Anything, "new@example.com", "existinguser", "password123").Return((*user.User)(nil), user.NewUsernameExistsError("existinguser"))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `"error":"Username already taken"`,
+		},
 		{
 			name: "internal server error",
 			requestBody: userDTO.CreateUserRequest{
@@ -224,6 +312,38 @@ func TestUserHandler_CreateUser(t *testing.T) {
 	}
 }
 
+// TestUserHandler_CheckUsername tests the username availability endpoint
+func TestUserHandler_CheckUsername(t *testing.T) {
+	t.Run("reports availability", func(t *testing.T) {
+		mockService := new(MockUserService)
+		mockService.On("IsUsernameAvailable", mock.Anything, "john_doe").Return(false, nil)
+		router := setupTestRouter(mockService)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/check-username?username=john_doe", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response userDTO.UsernameAvailabilityResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "john_doe", response.Username)
+		assert.False(t, response.Available)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("rejects a too short username", func(t *testing.T) {
+		mockService := new(MockUserService)
+		router := setupTestRouter(mockService)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/check-username?username=ab", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "IsUsernameAvailable", mock.Anything, mock.Anything)
+	})
+}
+
 // TestUserHandler_GetUserByEmail tests the GetUserByEmail HTTP handler
 // Covers successful retrieval, missing parameter, and not found scenarios
 func TestUserHandler_GetUserByEmail(t *testing.T) {
@@ -312,3 +432,236 @@ func TestUserHandler_GetUserByEmail_EmptyEmail(t *testing.T) {
 	// Verify response - should return 404 for route not found
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
+
+// setupConsentTestRouter creates a test Gin router with user and auth routes that track consent
+func setupConsentTestRouter(userService user.Service, consentService consent.Service) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	userHandler := NewUserHandler(userService, consentService, auth.NewJWTService("test-secret-key", "test-issuer", time.Hour))
+	v1 := router.Group("/api/v1")
+	userHandler.RegisterRoutes(v1)
+	userHandler.RegisterAuthRoutes(v1)
+
+	return router
+}
+
+// postJSON sends a JSON request to the router and returns the recorded response
+func postJSON(router *gin.Engine, path string, body interface{}) *httptest.ResponseRecorder {
+	jsonBody, _ := json.Marshal(body)
+	req, _ := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// TestUserHandler_CreateUser_Consent tests that registration records acceptance of the current policies
+func TestUserHandler_CreateUser_Consent(t *testing.T) {
+	terms := &consent.Version{ID: uuid.New(), Type: consent.TypeTerms, Version: "2026-05"}
+	request := userDTO.CreateUserRequest{Email: "test@example.com", Username: "testuser", Password: "password123"}
+
+	t.Run("rejects registration without accept_terms", func(t *testing.T) {
+		userService := new(MockUserService)
+		consentService := new(MockConsentService)
+		consentService.On("GetLatestVersions", mock.Anything).Return([]*consent.Version{terms}, nil)
+
+		w := postJSON(setupConsentTestRouter(userService, consentService), "/api/v1/users", request)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "accept_terms")
+		userService.AssertNotCalled(t, "CreateUser", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("records the versions shown", func(t *testing.T) {
+		createdUser := &user.User{ID: uuid.New(), Email: request.Email, Username: request.Username}
+		userService := new(MockUserService)
+		userService.On("CreateUser", mock.Anything, request.Email, request.Username, request.Password).Return(createdUser, nil)
+		consentService := new(MockConsentService)
+		consentService.On("GetLatestVersions", mock.Anything).Return([]*consent.Version{terms}, nil)
+		consentService.On("Accept", mock.Anything, createdUser.ID, []uuid.UUID{terms.ID}).Return([]*consent.Version{terms}, nil)
+
+		accepted := request
+		accepted.AcceptTerms = true
+		w := postJSON(setupConsentTestRouter(userService, consentService), "/api/v1/users", accepted)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		consentService.AssertExpectations(t)
+	})
+}
+
+// TestUserHandler_Login_PendingPolicies tests that login lists policy versions the user has not accepted
+func TestUserHandler_Login_PendingPolicies(t *testing.T) {
+	authenticated := &user.User{ID: uuid.New(), Email: "test@example.com", Username: "testuser"}
+	privacy := &consent.Version{ID: uuid.New(), Type: consent.TypePrivacy, Version: "v2", URL: "https://example.com/privacy"}
+	userService := new(MockUserService)
+	userService.On("AuthenticateUser", mock.Anything, "test@example.com", "password123").Return(authenticated, nil)
+	consentService := new(MockConsentService)
+	consentService.On("GetPendingVersions", mock.Anything, authenticated.ID).Return([]*consent.Version{privacy}, nil)
+
+	w := postJSON(setupConsentTestRouter(userService, consentService), "/api/v1/auth/login", userDTO.LoginRequest{Email: "test@example.com", Password: "password123"})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"pending_policies"`)
+	assert.Contains(t, w.Body.String(), privacy.ID.String())
+	consentService.AssertNotCalled(t, "AcceptPending", mock.Anything, mock.Anything)
+}
+
+// TestUserHandler_AccountStatus tests the admin endpoints that suspend, ban and reactivate users
+func TestUserHandler_AccountStatus(t *testing.T) {
+	userID := uuid.New()
+	adminToken := generateTestJWT([]string{"ADMIN"})
+
+	send := func(router *gin.Engine, method, path, token string, body interface{}) *httptest.ResponseRecorder {
+		var reader *bytes.Buffer
+		if body != nil {
+			jsonBody, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(jsonBody)
+		} else {
+			reader = &bytes.Buffer{}
+		}
+		req, _ := http.NewRequest(method, path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("suspends with a reason", func(t *testing.T) {
+		suspended := &user.User{ID: userID, Email: "user@example.com", Status: user.StatusSuspended, StatusReason: "Chargebacks"}
+		mockService := new(MockUserService)
+		mockService.On("SetAccountStatus", mock.Anything, userID, user.StatusSuspended, "Chargebacks", mock.AnythingOfType("*uuid.UUID")).Return(suspended, nil)
+		mockService.On("GetStatusHistory", mock.Anything, userID).Return([]*user.StatusChange{{UserID: userID, Status: user.StatusSuspended, Reason: "Chargebacks"}}, nil)
+
+		w := send(setupTestRouter(mockService), http.MethodPost, "/api/v1/admin/users/"+userID.String()+"/suspend", adminToken, userDTO.AccountStatusRequest{Reason: "Chargebacks"})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response userDTO.AccountStatusResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, user.StatusSuspended, response.Status)
+		assert.Equal(t, "Chargebacks", response.Reason)
+		assert.Len(t, response.History, 1)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("ban without a reason is rejected", func(t *testing.T) {
+		mockService := new(MockUserService)
+		mockService.On("SetAccountStatus", mock.Anything, userID, user.StatusBanned, "", mock.AnythingOfType("*uuid.UUID")).Return(nil, user.ErrReasonRequired)
+
+		w := send(setupTestRouter(mockService), http.MethodPost, "/api/v1/admin/users/"+userID.String()+"/ban", adminToken, nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("reactivates without a body", func(t *testing.T) {
+		mockService := new(MockUserService)
+		mockService.On("SetAccountStatus", mock.Anything, userID, user.StatusActive, "", mock.AnythingOfType("*uuid.UUID")).Return(&user.User{ID: userID, Status: user.StatusActive}, nil)
+		mockService.On("GetStatusHistory", mock.Anything, userID).Return([]*user.StatusChange{}, nil)
+
+		w := send(setupTestRouter(mockService), http.MethodPost, "/api/v1/admin/users/"+userID.String()+"/reactivate", adminToken, nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"status":"ACTIVE"`)
+	})
+
+	t.Run("requires the admin role", func(t *testing.T) {
+		mockService := new(MockUserService)
+
+		w := send(setupTestRouter(mockService), http.MethodPost, "/api/v1/admin/users/"+userID.String()+"/suspend", generateTestJWT([]string{"USER"}), userDTO.AccountStatusRequest{Reason: "Chargebacks"})
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		mockService.AssertNotCalled(t, "SetAccountStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestUserHandler_Login_RolesChangedDuringLogin tests that a role change landing between loading the
+// user and reading the roles version doesn't stamp the old roles with the new version
+func TestUserHandler_Login_RolesChangedDuringLogin(t *testing.T) {
+	userID := uuid.New()
+	organizer := []role.Role{{Name: role.RoleUser}, {Name: role.RoleOrganizer}}
+	mockService := new(MockUserService)
+	mockService.On("AuthenticateUser", mock.Anything, "test@example.com", "password123").
+		Return(&user.User{ID: userID, Email: "test@example.com", Username: "testuser", Roles: organizer}, nil)
+	// ORGANIZER is revoked after the credentials were checked, bumping the version to 2
+	mockService.On("RolesVersion", mock.Anything, userID).Return(int64(2), nil)
+	mockService.On("GetUserByID", mock.Anything, userID).
+		Return(&user.User{ID: userID, Email: "test@example.com", Username: "testuser", Roles: []role.Role{{Name: role.RoleUser}}}, nil)
+	jwtService := auth.NewJWTService("test-secret-key", "test-issuer", time.Hour)
+	jwtService.CheckRolesWith(mockService)
+	router := gin.New()
+	NewUserHandler(mockService, nil, jwtService).RegisterAuthRoutes(router.Group("/api/v1"))
+
+	w := postJSON(router, "/api/v1/auth/login", userDTO.LoginRequest{Email: "test@example.com", Password: "password123"})
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response userDTO.LoginResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	claims, err := jwtService.ValidateToken(response.Token)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"USER"}, claims.Roles)
+	assert.Equal(t, int64(2), claims.RolesVersion)
+	assert.Equal(t, []string{"USER"}, response.User.Roles)
+}
+
+func TestUserHandler_Login_ExpiresByClock(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockService := new(MockUserService)
+	mockService.On("AuthenticateUser", mock.Anything, "test@example.com", "password123").
+		Return(&user.User{ID: uuid.New(), Email: "test@example.com", Username: "testuser"}, nil)
+	router := gin.New()
+	NewUserHandler(mockService, nil, auth.NewJWTService("test-secret-key", "test-issuer", time.Hour), WithClock(clock.NewFake(now))).
+		RegisterAuthRoutes(router.Group("/api/v1"))
+
+	w := postJSON(router, "/api/v1/auth/login", userDTO.LoginRequest{Email: "test@example.com", Password: "password123"})
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response userDTO.LoginResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, now.Add(30*24*time.Hour).Unix(), response.ExpiresAt)
+}
+
+// TestUserHandler_Login_BlockedAccount tests that blocked users are told why they cannot log in
+func TestUserHandler_Login_BlockedAccount(t *testing.T) {
+	mockService := new(MockUserService)
+	mockService.On("AuthenticateUser", mock.Anything, "test@example.com", "password123").Return(nil, user.NewAccountBlockedError(user.StatusSuspended, "Chargebacks"))
+
+	w := postJSON(setupConsentTestRouter(mockService, nil), "/api/v1/auth/login", userDTO.LoginRequest{Email: "test@example.com", Password: "password123"})
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "Chargebacks")
+}
+
+// TestUserHandler_ErrorMapping tests that user errors are reported with their code and internal details stay hidden
+func TestUserHandler_ErrorMapping(t *testing.T) {
+	request := userDTO.CreateUserRequest{Email: "test@example.com", Username: "testuser", Password: "password123"}
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   string
+		hiddenDetail   string
+	}{
+		{"taken username", user.NewUsernameExistsError("testuser"), http.StatusConflict, "USERNAME_EXISTS", ""},
+		{"database failure", user.NewUserError(user.ErrUserCreationFailed, errors.New("pq: connection refused")), http.StatusInternalServerError, "USER_CREATION_FAILED", "connection refused"},
+		{"untyped error", errors.New("boom"), http.StatusInternalServerError, "", "boom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockUserService)
+			mockService.On("CreateUser", mock.Anything, request.Email, request.Username, request.Password).Return(nil, tt.err)
+
+			w := postJSON(setupTestRouter(mockService), "/api/v1/users", request)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			var response userDTO.ErrorResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedCode, response.Code)
+			if tt.hiddenDetail != "" {
+				assert.NotContains(t, w.Body.String(), tt.hiddenDetail)
+			}
+		})
+	}
+}
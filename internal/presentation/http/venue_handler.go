@@ -2,20 +2,19 @@ package http
 
 import (
 	"net/http"
-	"time"
 
 	"enterprise-crud/internal/domain/venue"
 	venueDto "enterprise-crud/internal/dto/venue"
 	"enterprise-crud/internal/infrastructure/auth"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 )
 
 // VenueHandler handles HTTP requests for venue operations
 type VenueHandler struct {
 	venueService venue.Service
 	jwtService   *auth.JWTService
+	createChecks []gin.HandlerFunc // Run after authentication and before a venue is created, e.g. duplicate request checks
 }
 
 // NewVenueHandler creates a new instance of VenueHandler
@@ -26,9 +25,16 @@ func NewVenueHandler(venueService venue.Service, jwtService *auth.JWTService) *V
 	}
 }
 
+// RequireBeforeCreate adds middleware that must pass before a venue is created
+// It must be called before RegisterRoutes.
+func (h *VenueHandler) RequireBeforeCreate(checks ...gin.HandlerFunc) {
+	h.createChecks = append(h.createChecks, checks...)
+}
+
 // CreateVenue creates a new venue
 // @Summary Create a new venue
 // @Description Create a new venue (requires ORGANIZER or ADMIN role)
+// @Description A request identical to one the same user sent moments earlier answers 409 DUPLICATE_REQUEST.
 // @Tags venues
 // @Accept json
 // @Produce json
@@ -37,28 +43,33 @@ func NewVenueHandler(venueService venue.Service, jwtService *auth.JWTService) *V
 // @Failure 400 {object} venueDto.ErrorResponse
 // @Failure 401 {object} venueDto.ErrorResponse
 // @Failure 403 {object} venueDto.ErrorResponse
+// @Failure 409 {object} venueDto.ErrorResponse
 // @Failure 500 {object} venueDto.ErrorResponse
 // @Security BearerAuth
 // @Router /api/v1/venues [post]
 func (h *VenueHandler) CreateVenue(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
 	var req venueDto.CreateVenueRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, venueDto.ErrorResponse{
-			Error:   "validation_error",
-			Message: "Invalid input data: " + err.Error(),
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, venueDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
 		})
 		return
 	}
 
 	// Create venue entity
 	newVenue := &venue.Venue{
-		ID:          uuid.New(),
 		Name:        req.Name,
 		Address:     req.Address,
 		Capacity:    req.Capacity,
 		Description: req.Description,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		Layouts:     mapLayoutsFromRequest(req.Layouts),
+		OwnerID:     &currentUser.UserID,
 	}
 
 	// Create the venue
@@ -95,12 +106,8 @@ func (h *VenueHandler) CreateVenue(c *gin.Context) {
 // @Failure 500 {object} venueDto.ErrorResponse
 // @Router /api/v1/venues/{id} [get]
 func (h *VenueHandler) GetVenue(c *gin.Context) {
-	venueID, err := uuid.Parse(c.Param("id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, venueDto.ErrorResponse{
-			Error:   "invalid_id",
-			Message: "Invalid venue ID format",
-		})
+	venueID, ok := PathUUID(c, "id")
+	if !ok {
 		return
 	}
 
@@ -143,21 +150,17 @@ func (h *VenueHandler) GetAllVenues(c *gin.Context) {
 		return
 	}
 
-	response := venueDto.VenueListResponse{
-		Venues: make([]venueDto.VenueResponse, len(venues)),
-		Count:  len(venues),
-	}
-
+	items := make([]venueDto.VenueResponse, len(venues))
 	for i, v := range venues {
-		response.Venues[i] = mapVenueToResponse(v)
+		items[i] = mapVenueToResponse(v)
 	}
 
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, venueDto.VenueListResponse{ListResponse: newList(c, items)})
 }
 
 // UpdateVenue updates an existing venue
 // @Summary Update venue
-// @Description Update an existing venue (requires ORGANIZER or ADMIN role)
+// @Description Update an existing venue (requires the venue owner or ADMIN role)
 // @Tags venues
 // @Accept json
 // @Produce json
@@ -172,20 +175,20 @@ func (h *VenueHandler) GetAllVenues(c *gin.Context) {
 // @Security BearerAuth
 // @Router /api/v1/venues/{id} [put]
 func (h *VenueHandler) UpdateVenue(c *gin.Context) {
-	venueID, err := uuid.Parse(c.Param("id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, venueDto.ErrorResponse{
-			Error:   "invalid_id",
-			Message: "Invalid venue ID format",
-		})
+	venueID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+	actor, ok := venueActor(c)
+	if !ok {
 		return
 	}
 
 	var req venueDto.UpdateVenueRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, venueDto.ErrorResponse{
-			Error:   "validation_error",
-			Message: "Invalid input data: " + err.Error(),
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, venueDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
 		})
 		return
 	}
@@ -197,16 +200,23 @@ func (h *VenueHandler) UpdateVenue(c *gin.Context) {
 		Address:     req.Address,
 		Capacity:    req.Capacity,
 		Description: req.Description,
-		UpdatedAt:   time.Now(),
+	}
+	if req.Layouts != nil {
+		updatedVenue.Layouts = mapLayoutsFromRequest(*req.Layouts)
 	}
 
 	// Update the venue
-	if err := h.venueService.UpdateVenue(c.Request.Context(), updatedVenue); err != nil {
+	if err := h.venueService.UpdateVenue(c.Request.Context(), actor, updatedVenue); err != nil {
 		if venue.IsVenueNotFoundError(err) {
 			c.JSON(http.StatusNotFound, venueDto.ErrorResponse{
 				Error:   venue.GetVenueErrorCode(err),
 				Message: err.Error(),
 			})
+		} else if venue.IsNotVenueOwnerError(err) {
+			c.JSON(http.StatusForbidden, venueDto.ErrorResponse{
+				Error:   venue.GetVenueErrorCode(err),
+				Message: err.Error(),
+			})
 		} else if venue.IsVenueError(err) {
 			c.JSON(http.StatusBadRequest, venueDto.ErrorResponse{
 				Error:   venue.GetVenueErrorCode(err),
@@ -228,7 +238,7 @@ func (h *VenueHandler) UpdateVenue(c *gin.Context) {
 
 // DeleteVenue deletes a venue
 // @Summary Delete venue
-// @Description Delete a venue (requires ADMIN role)
+// @Description Delete a venue (requires the venue owner or ADMIN role)
 // @Tags venues
 // @Accept json
 // @Produce json
@@ -242,22 +252,27 @@ func (h *VenueHandler) UpdateVenue(c *gin.Context) {
 // @Security BearerAuth
 // @Router /api/v1/venues/{id} [delete]
 func (h *VenueHandler) DeleteVenue(c *gin.Context) {
-	venueID, err := uuid.Parse(c.Param("id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, venueDto.ErrorResponse{
-			Error:   "invalid_id",
-			Message: "Invalid venue ID format",
-		})
+	venueID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+	actor, ok := venueActor(c)
+	if !ok {
 		return
 	}
 
 	// Delete the venue
-	if err := h.venueService.DeleteVenue(c.Request.Context(), venueID); err != nil {
+	if err := h.venueService.DeleteVenue(c.Request.Context(), actor, venueID); err != nil {
 		if venue.IsVenueNotFoundError(err) {
 			c.JSON(http.StatusNotFound, venueDto.ErrorResponse{
 				Error:   venue.GetVenueErrorCode(err),
 				Message: err.Error(),
 			})
+		} else if venue.IsNotVenueOwnerError(err) {
+			c.JSON(http.StatusForbidden, venueDto.ErrorResponse{
+				Error:   venue.GetVenueErrorCode(err),
+				Message: err.Error(),
+			})
 		} else {
 			c.JSON(http.StatusInternalServerError, venueDto.ErrorResponse{
 				Error:   "deletion_error",
@@ -272,6 +287,70 @@ func (h *VenueHandler) DeleteVenue(c *gin.Context) {
 	})
 }
 
+// TransferVenue hands a venue to another organizer
+// @Summary Transfer venue ownership
+// @Description Hand a venue to another active organizer or admin (requires the venue owner or ADMIN role)
+// @Tags venues
+// @Accept json
+// @Produce json
+// @Param id path string true "Venue ID"
+// @Param transfer body venueDto.TransferVenueRequest true "New owner"
+// @Success 200 {object} venueDto.VenueResponse
+// @Failure 400 {object} venueDto.ErrorResponse
+// @Failure 401 {object} venueDto.ErrorResponse
+// @Failure 403 {object} venueDto.ErrorResponse
+// @Failure 404 {object} venueDto.ErrorResponse
+// @Failure 500 {object} venueDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/venues/{id}/transfer [post]
+func (h *VenueHandler) TransferVenue(c *gin.Context) {
+	venueID, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+	actor, ok := venueActor(c)
+	if !ok {
+		return
+	}
+
+	var req venueDto.TransferVenueRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, venueDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	transferred, err := h.venueService.TransferOwnership(c.Request.Context(), actor, venueID, req.OwnerID)
+	if err != nil {
+		if venue.IsVenueNotFoundError(err) {
+			c.JSON(http.StatusNotFound, venueDto.ErrorResponse{
+				Error:   venue.GetVenueErrorCode(err),
+				Message: err.Error(),
+			})
+		} else if venue.IsNotVenueOwnerError(err) {
+			c.JSON(http.StatusForbidden, venueDto.ErrorResponse{
+				Error:   venue.GetVenueErrorCode(err),
+				Message: err.Error(),
+			})
+		} else if venue.IsVenueError(err) {
+			c.JSON(http.StatusBadRequest, venueDto.ErrorResponse{
+				Error:   venue.GetVenueErrorCode(err),
+				Message: err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, venueDto.ErrorResponse{
+				Error:   "transfer_error",
+				Message: "Failed to transfer venue: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, mapVenueToResponse(transferred))
+}
+
 // RegisterRoutes registers venue routes with the gin router
 func (h *VenueHandler) RegisterRoutes(router *gin.RouterGroup) {
 	// Create JWT middleware
@@ -281,28 +360,44 @@ func (h *VenueHandler) RegisterRoutes(router *gin.RouterGroup) {
 	venueRoutes := router.Group("/venues")
 	{
 		// Public routes
-		venueRoutes.GET("", h.GetAllVenues) // Get all venues
-		venueRoutes.GET("/:id", h.GetVenue) // Get venue by ID
+		venueRoutes.GET("", h.GetAllVenues)                   // Get all venues
+		venueRoutes.GET("/:id", UUIDParams("id"), h.GetVenue) // Get venue by ID
 
 		// Organizer routes (require ORGANIZER or ADMIN role)
-		venueRoutes.POST("",
-			jwtMiddleware.AuthRequired(),
-			auth.RequireOrganizer(),
-			h.CreateVenue)
+		createHandlers := []gin.HandlerFunc{jwtMiddleware.AuthRequired(), auth.RequireOrganizer()}
+		createHandlers = append(createHandlers, h.createChecks...)
+		venueRoutes.POST("", append(createHandlers, h.CreateVenue)...)
 
 		venueRoutes.PUT("/:id",
 			jwtMiddleware.AuthRequired(),
 			auth.RequireOrganizer(),
+			UUIDParams("id"),
 			h.UpdateVenue)
 
-		// Admin routes (require ADMIN role)
+		// The service limits these to the venue owner and admins
 		venueRoutes.DELETE("/:id",
 			jwtMiddleware.AuthRequired(),
-			auth.RequireAdmin(),
+			auth.RequireOrganizer(),
+			UUIDParams("id"),
 			h.DeleteVenue)
+
+		venueRoutes.POST("/:id/transfer",
+			jwtMiddleware.AuthRequired(),
+			auth.RequireOrganizer(),
+			UUIDParams("id"),
+			h.TransferVenue)
 	}
 }
 
+// venueActor builds the acting user from the authenticated principal, writing a 401 when there is none
+func venueActor(c *gin.Context) (venue.Actor, bool) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return venue.Actor{}, false
+	}
+	return venue.Actor{UserID: currentUser.UserID, IsAdmin: currentUser.IsAdmin()}, true
+}
+
 // mapVenueToResponse converts venue entity to response DTO
 func mapVenueToResponse(v *venue.Venue) venueDto.VenueResponse {
 	return venueDto.VenueResponse{
@@ -311,7 +406,28 @@ func mapVenueToResponse(v *venue.Venue) venueDto.VenueResponse {
 		Address:     v.Address,
 		Capacity:    v.Capacity,
 		Description: v.Description,
+		OwnerID:     v.OwnerID,
+		Layouts:     mapLayoutsToResponse(v.Layouts),
 		CreatedAt:   v.CreatedAt,
 		UpdatedAt:   v.UpdatedAt,
 	}
 }
+
+// mapLayoutsFromRequest converts request layouts to domain layouts, never returning nil
+// so an update with an empty list clears the venue's layouts
+func mapLayoutsFromRequest(layouts []venueDto.VenueLayout) venue.Layouts {
+	result := make(venue.Layouts, 0, len(layouts))
+	for _, l := range layouts {
+		result = append(result, venue.Layout{Name: l.Name, Capacity: l.Capacity})
+	}
+	return result
+}
+
+// mapLayoutsToResponse converts domain layouts to response DTOs
+func mapLayoutsToResponse(layouts venue.Layouts) []venueDto.VenueLayout {
+	result := make([]venueDto.VenueLayout, 0, len(layouts))
+	for _, l := range layouts {
+		result = append(result, venueDto.VenueLayout{Name: l.Name, Capacity: l.Capacity})
+	}
+	return result
+}
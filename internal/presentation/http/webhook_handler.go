@@ -0,0 +1,181 @@
+package http
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/webhook"
+	webhookDto "enterprise-crud/internal/dto/webhook"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookHandler handles HTTP requests for the order status webhooks of buyers
+type WebhookHandler struct {
+	webhookService webhook.Service
+	jwtService     *auth.JWTService
+}
+
+// NewWebhookHandler creates a new instance of WebhookHandler
+func NewWebhookHandler(webhookService webhook.Service, jwtService *auth.JWTService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		jwtService:     jwtService,
+	}
+}
+
+// CreateSubscription subscribes an endpoint of the current user to their orders' status changes
+// @Summary Subscribe a webhook
+// @Description Have order.completed and order.refunded events of the current user's own orders POSTed as JSON to an https endpoint of their system; leave out events to receive both. The response carries the secret deliveries are signed with, which is not shown again. Every delivery has the headers X-Webhook-Id (the same on retries, to drop duplicates), X-Webhook-Event, X-Webhook-Timestamp (Unix seconds) and X-Webhook-Signature: "sha256=" and the hex HMAC-SHA256, keyed with the secret, of the timestamp, a dot and the raw body. To verify a delivery, compute that HMAC over the body exactly as received, compare it to the signature in constant time and refuse timestamps more than 5 minutes from your clock. Answer 2xx to acknowledge; timeouts, 408, 429 and 5xx are retried with backoff, other answers drop the delivery.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param subscription body webhookDto.CreateSubscriptionRequest true "Endpoint and events"
+// @Success 201 {object} webhookDto.CreatedSubscriptionResponse
+// @Failure 400 {object} webhookDto.ErrorResponse
+// @Failure 401 {object} webhookDto.ErrorResponse
+// @Failure 403 {object} webhookDto.ErrorResponse
+// @Failure 409 {object} webhookDto.ErrorResponse
+// @Failure 500 {object} webhookDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/webhooks [post]
+func (h *WebhookHandler) CreateSubscription(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req webhookDto.CreateSubscriptionRequest
+	if bindErr := BindJSON(c, &req, DisallowUnknownFields()); bindErr != nil {
+		c.JSON(bindErr.Status, webhookDto.ErrorResponse{
+			Error:   bindErr.Code(),
+			Message: "Invalid input data: " + bindErr.Error(),
+		})
+		return
+	}
+
+	sub, err := h.webhookService.Create(c.Request.Context(), currentUser.UserID, req.URL, req.Events)
+	if err != nil {
+		writeWebhookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhookDto.CreatedSubscriptionResponse{
+		ID:        sub.ID,
+		URL:       sub.URL,
+		Events:    sub.Events,
+		Secret:    sub.Secret,
+		CreatedAt: sub.CreatedAt,
+	})
+}
+
+// ListSubscriptions lists the current user's webhook subscriptions
+// @Summary List webhooks
+// @Description List the endpoints the current user subscribed to their orders' status changes, oldest first. Secrets are not included.
+// @Tags webhooks
+// @Produce json
+// @Success 200 {object} webhookDto.SubscriptionListResponse
+// @Failure 401 {object} webhookDto.ErrorResponse
+// @Failure 403 {object} webhookDto.ErrorResponse
+// @Failure 500 {object} webhookDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/webhooks [get]
+func (h *WebhookHandler) ListSubscriptions(c *gin.Context) {
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	subs, err := h.webhookService.List(c.Request.Context(), currentUser.UserID)
+	if err != nil {
+		writeWebhookError(c, err)
+		return
+	}
+
+	response := webhookDto.SubscriptionListResponse{
+		Subscriptions: make([]webhookDto.SubscriptionResponse, len(subs)),
+		Count:         len(subs),
+	}
+	for i, sub := range subs {
+		response.Subscriptions[i] = webhookDto.SubscriptionResponse{
+			ID:        sub.ID,
+			URL:       sub.URL,
+			Events:    sub.Events,
+			CreatedAt: sub.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteSubscription removes one of the current user's webhook subscriptions
+// @Summary Delete a webhook
+// @Description Stop delivering to an endpoint of the current user; deliveries still being retried are dropped
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 204 "Webhook deleted"
+// @Failure 400 {object} webhookDto.ErrorResponse
+// @Failure 401 {object} webhookDto.ErrorResponse
+// @Failure 403 {object} webhookDto.ErrorResponse
+// @Failure 404 {object} webhookDto.ErrorResponse
+// @Failure 500 {object} webhookDto.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteSubscription(c *gin.Context) {
+	id, ok := PathUUID(c, "id")
+	if !ok {
+		return
+	}
+
+	currentUser, ok := auth.RequireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.webhookService.Delete(c.Request.Context(), currentUser.UserID, id); err != nil {
+		writeWebhookError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RegisterRoutes registers webhook routes with the gin router
+// Any signed-in buyer can subscribe; deliveries only ever cover orders they placed themselves.
+func (h *WebhookHandler) RegisterRoutes(router *gin.RouterGroup) {
+	jwtMiddleware := auth.NewJWTMiddleware(h.jwtService)
+
+	webhookRoutes := router.Group("/users/webhooks", jwtMiddleware.AuthRequired(), auth.RequireUser())
+	{
+		webhookRoutes.POST("", h.CreateSubscription)
+		webhookRoutes.GET("", h.ListSubscriptions)
+		webhookRoutes.DELETE("/:id", UUIDParams("id"), h.DeleteSubscription)
+	}
+}
+
+// writeWebhookError maps webhook errors to HTTP responses
+func writeWebhookError(c *gin.Context, err error) {
+	switch {
+	case webhook.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, webhookDto.ErrorResponse{
+			Error:   webhook.GetWebhookErrorCode(err),
+			Message: err.Error(),
+		})
+	case webhook.IsNotFoundError(err):
+		c.JSON(http.StatusNotFound, webhookDto.ErrorResponse{
+			Error:   webhook.GetWebhookErrorCode(err),
+			Message: err.Error(),
+		})
+	case webhook.IsLimitError(err):
+		c.JSON(http.StatusConflict, webhookDto.ErrorResponse{
+			Error:   webhook.GetWebhookErrorCode(err),
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, webhookDto.ErrorResponse{
+			Error:   "webhook_error",
+			Message: err.Error(),
+		})
+	}
+}
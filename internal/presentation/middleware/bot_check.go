@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"enterprise-crud/internal/infrastructure/antibot"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CaptchaTokenHeader carries the CAPTCHA token of a challenged request
+const CaptchaTokenHeader = "X-Captcha-Token"
+
+// RequireHumanCheck challenges risky requests with a CAPTCHA before they reach the handler
+// The email checked is the authenticated user's, or the "email" field of a JSON body.
+// Challenged requests get 403 CAPTCHA_REQUIRED with the provider and site key; clients
+// render the widget and repeat the request with the token in the X-Captcha-Token header.
+func RequireHumanCheck(guard *antibot.Guard, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		attempt := antibot.Attempt{
+			Action: action,
+			IP:     c.ClientIP(),
+			Email:  requestEmail(c),
+			Token:  c.GetHeader(CaptchaTokenHeader),
+		}
+
+		err := guard.Check(c.Request.Context(), attempt)
+		switch {
+		case err == nil:
+			c.Next()
+		case errors.Is(err, antibot.ErrChallengeRequired), errors.Is(err, antibot.ErrChallengeFailed):
+			code, message := "CAPTCHA_REQUIRED", "Complete the CAPTCHA and send its token in the "+CaptchaTokenHeader+" header"
+			if errors.Is(err, antibot.ErrChallengeFailed) {
+				code, message = "CAPTCHA_FAILED", "The CAPTCHA could not be verified, please try again"
+			}
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error":   code,
+				"message": message,
+				"captcha": gin.H{
+					"provider": guard.Provider(),
+					"site_key": guard.SiteKey(),
+				},
+			})
+		default:
+			log.Printf("CAPTCHA verification failed for %s from %s: %v", action, attempt.IP, err)
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+				"error":   "captcha_unavailable",
+				"message": "Could not verify the CAPTCHA, please try again later",
+			})
+		}
+	}
+}
+
+// requestEmail returns the authenticated user's email, or the email in the JSON body
+// The body is restored so the handler can still bind it.
+func requestEmail(c *gin.Context) string {
+	if currentUser, ok := auth.CurrentUser(c); ok {
+		return currentUser.Email
+	}
+	if c.Request.Body == nil {
+		return ""
+	}
+
+	var body struct {
+		Email string `json:"email"`
+	}
+	_ = json.Unmarshal(captureBody(c), &body)
+	return body.Email
+}
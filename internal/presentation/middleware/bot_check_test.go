@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/infrastructure/antibot"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBotCheckRouter(t *testing.T, claims *auth.JWTClaims) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	guard, err := antibot.NewGuard(&config.AntiBotConfig{
+		Provider:       "turnstile",
+		SiteKey:        "site-key",
+		SecretKey:      "secret",
+		VelocityLimit:  10,
+		VelocityWindow: time.Hour,
+	}, antibot.NewMemoryCounter())
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.POST("/users", func(c *gin.Context) {
+		if claims != nil {
+			c.Set("user", claims)
+		}
+	}, RequireHumanCheck(guard, "register"), func(c *gin.Context) {
+		var body struct {
+			Email string `json:"email"`
+		}
+		require.NoError(t, c.ShouldBindJSON(&body))
+		c.String(http.StatusCreated, body.Email)
+	})
+	return router
+}
+
+func TestRequireHumanCheck(t *testing.T) {
+	tests := []struct {
+		name           string
+		claims         *auth.JWTClaims
+		body           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{name: "low-risk request reaches the handler with its body", body: `{"email":"jane@example.com"}`, expectedStatus: http.StatusCreated, expectedBody: "jane@example.com"},
+		{name: "disposable email in body is challenged", body: `{"email":"bot@mailinator.com"}`, expectedStatus: http.StatusForbidden, expectedBody: `"site_key":"site-key"`},
+		{
+			name:   "authenticated user's email is checked",
+			claims: &auth.JWTClaims{UserID: uuid.New(), Email: "bot@yopmail.com"}, body: `{}`,
+			expectedStatus: http.StatusForbidden, expectedBody: "CAPTCHA_REQUIRED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			newBotCheckRouter(t, tt.claims).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"enterprise-crud/internal/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// noStore keeps responses out of every cache
+const noStore = "no-store"
+
+// cachePolicy is the Cache-Control value of one public route group
+type cachePolicy struct {
+	prefix string
+	value  string
+}
+
+// CacheControl sets Cache-Control on every response
+// Anonymous GET and HEAD requests under a public route group may be cached for the group's
+// max-age and served stale while revalidating; authenticated requests, writes, errors and
+// every other route get no-store. Handlers that set Cache-Control themselves keep theirs.
+func CacheControl(cfg *config.CacheControlConfig) gin.HandlerFunc {
+	policies := make([]cachePolicy, 0, len(cfg.Public))
+	for _, p := range cfg.Public {
+		value := "public, max-age=" + strconv.Itoa(int(p.MaxAge.Seconds()))
+		if p.StaleWhileRevalidate > 0 {
+			value += ", stale-while-revalidate=" + strconv.Itoa(int(p.StaleWhileRevalidate.Seconds()))
+		}
+		policies = append(policies, cachePolicy{prefix: strings.TrimSuffix(p.Prefix, "/"), value: value})
+	}
+	// Longest prefix first, so nested groups override their parents
+	sort.SliceStable(policies, func(i, j int) bool { return len(policies[i].prefix) > len(policies[j].prefix) })
+
+	return func(c *gin.Context) {
+		if strings.HasPrefix(c.Request.URL.Path, swaggerPathPrefix) {
+			c.Next()
+			return
+		}
+
+		// The same URL answers differently once authenticated, so shared caches must key on it
+		c.Writer.Header().Add("Vary", "Authorization")
+		value := noStore
+		if isAnonymousRead(c.Request) {
+			value = publicPolicy(policies, c.Request.URL.Path)
+		}
+		c.Header("Cache-Control", value)
+
+		if value != noStore {
+			c.Writer = &cacheControlWriter{ResponseWriter: c.Writer, public: value}
+		}
+		c.Next()
+	}
+}
+
+// isAnonymousRead reports whether a request only reads and carries no credentials
+func isAnonymousRead(r *http.Request) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	return r.Header.Get("Authorization") == ""
+}
+
+// publicPolicy returns the Cache-Control value of the route group path belongs to, no-store outside them
+func publicPolicy(policies []cachePolicy, path string) string {
+	for _, p := range policies {
+		if path == p.prefix || strings.HasPrefix(path, p.prefix+"/") {
+			return p.value
+		}
+	}
+	return noStore
+}
+
+// cacheControlWriter stops error responses of public routes from being cached
+type cacheControlWriter struct {
+	gin.ResponseWriter
+	public string
+}
+
+func (w *cacheControlWriter) WriteHeader(code int) {
+	if code >= http.StatusBadRequest && w.Header().Get("Cache-Control") == w.public {
+		w.Header().Set("Cache-Control", noStore)
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCacheControlRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(CacheControl(&config.CacheControlConfig{
+		Public: []config.CachePolicyConfig{
+			{Prefix: "/api/v1/events", MaxAge: 30 * time.Second, StaleWhileRevalidate: time.Minute},
+			{Prefix: "/api/v1/events/trending", MaxAge: 5 * time.Minute},
+		},
+	}))
+	ok := func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{}) }
+	router.GET("/api/v1/events", ok)
+	router.POST("/api/v1/events", ok)
+	router.GET("/api/v1/events/trending", ok)
+	router.GET("/api/v1/events-archive", ok)
+	router.GET("/api/v1/orders", ok)
+	router.GET("/api/v1/events/:id", func(c *gin.Context) { c.JSON(http.StatusNotFound, gin.H{}) })
+	router.GET("/api/v1/events/:id/feed.ics", func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=600")
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func TestCacheControl(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		token    string
+		expected string
+	}{
+		{name: "public listing", method: http.MethodGet, path: "/api/v1/events", expected: "public, max-age=30, stale-while-revalidate=60"},
+		{name: "longest prefix wins", method: http.MethodGet, path: "/api/v1/events/trending", expected: "public, max-age=300"},
+		{name: "authenticated read", method: http.MethodGet, path: "/api/v1/events", token: "Bearer token", expected: "no-store"},
+		{name: "write", method: http.MethodPost, path: "/api/v1/events", expected: "no-store"},
+		{name: "outside public groups", method: http.MethodGet, path: "/api/v1/orders", expected: "no-store"},
+		{name: "prefix matches whole segments", method: http.MethodGet, path: "/api/v1/events-archive", expected: "no-store"},
+		{name: "errors are not cached", method: http.MethodGet, path: "/api/v1/events/missing", expected: "no-store"},
+		{name: "handler policy is kept", method: http.MethodGet, path: "/api/v1/events/42/feed.ics", expected: "public, max-age=600"},
+	}
+
+	router := newCacheControlRouter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Header().Get("Cache-Control"))
+			assert.Contains(t, w.Header().Values("Vary"), "Authorization")
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+
+	"enterprise-crud/internal/infrastructure/auth"
+	"enterprise-crud/internal/infrastructure/concurrency"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// LimitConcurrency lets only as many requests of a route run at once as the limiter allows, per user and in total
+// Requests finding no free slot wait for one up to the limiter's wait time, then answer 429
+// TOO_MANY_CONCURRENT_REQUESTS with a Retry-After header. Requests are let through when the store cannot
+// be reached: an outage of Redis should not take checkout down with it.
+// It must run after authentication; anonymous requests only count towards the total.
+func LimitConcurrency(limiter *concurrency.Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !limiter.Enabled() {
+			c.Next()
+			return
+		}
+
+		userID := uuid.Nil
+		if currentUser, ok := auth.CurrentUser(c); ok {
+			userID = currentUser.UserID
+		}
+
+		route := c.Request.Method + " " + c.FullPath()
+		release, err := limiter.Acquire(c.Request.Context(), route, userID)
+		if concurrency.IsLimitError(err) {
+			c.Header("Retry-After", "1")
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error":   "TOO_MANY_CONCURRENT_REQUESTS",
+				"message": "Too many requests are in progress; try again in a moment",
+			})
+			return
+		}
+		if err != nil {
+			log.Printf("Warning: Failed to check concurrency limits, allowing %s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+			c.Next()
+			return
+		}
+
+		defer release()
+		c.Next()
+	}
+}
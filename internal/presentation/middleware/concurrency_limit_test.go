@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/infrastructure/auth"
+	"enterprise-crud/internal/infrastructure/concurrency"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingSlots is a concurrency store that cannot be reached
+type failingSlots struct{}
+
+func (failingSlots) TryAcquire(ctx context.Context, key, holder string, limit int, lease time.Duration) (bool, error) {
+	return false, errors.New("connection refused")
+}
+
+func (failingSlots) Release(ctx context.Context, key, holder string) error {
+	return nil
+}
+
+func TestLimitConcurrency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := concurrency.NewLimiter(concurrency.NewMemoryStore(), concurrency.Limits{PerUser: 1}, 20*time.Millisecond)
+
+	entered := make(chan struct{})
+	proceed := make(chan struct{})
+	router := gin.New()
+	router.POST("/orders/:id", func(c *gin.Context) {
+		c.Set("user", &auth.JWTClaims{UserID: uuid.MustParse(c.Query("user"))})
+	}, LimitConcurrency(limiter), func(c *gin.Context) {
+		if c.Query("block") != "" {
+			entered <- struct{}{}
+			<-proceed
+		}
+		c.Status(http.StatusCreated)
+	})
+	send := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, nil))
+		return w
+	}
+
+	alice, bob := uuid.NewString(), uuid.NewString()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.Equal(t, http.StatusCreated, send("/orders/1?block=1&user="+alice).Code)
+	}()
+	<-entered
+
+	busy := send("/orders/2?user=" + alice)
+	assert.Equal(t, http.StatusTooManyRequests, busy.Code, "the route is limited, whatever its parameters")
+	assert.Contains(t, busy.Body.String(), "TOO_MANY_CONCURRENT_REQUESTS")
+	assert.Equal(t, "1", busy.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusCreated, send("/orders/2?user="+bob).Code, "other users have their own slots")
+
+	close(proceed)
+	wg.Wait()
+	assert.Equal(t, http.StatusCreated, send("/orders/2?user="+alice).Code, "the slot is freed when the request ends")
+}
+
+func TestLimitConcurrency_AllowsRequestsWhenStoreFails(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := concurrency.NewLimiter(failingSlots{}, concurrency.Limits{PerUser: 1, Total: 1}, time.Second)
+
+	router := gin.New()
+	router.POST("/orders", LimitConcurrency(limiter), func(c *gin.Context) { c.Status(http.StatusCreated) })
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/orders", nil))
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
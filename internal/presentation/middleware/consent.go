@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+
+	"enterprise-crud/internal/domain/consent"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireTermsAccepted blocks requests from users who have not accepted the current terms of service
+// It must run after JWT authentication. Blocked requests get 403 CONSENT_REQUIRED; clients
+// should show the pending versions from GET /api/v1/users/me/consents and accept them.
+func RequireTermsAccepted(consentService consent.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		currentUser, ok := auth.RequireCurrentUser(c)
+		if !ok {
+			c.Abort()
+			return
+		}
+
+		err := consentService.CheckTermsAccepted(c.Request.Context(), currentUser.UserID)
+		switch {
+		case err == nil:
+			c.Next()
+		case consent.IsConsentRequiredError(err):
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error":   consent.GetConsentErrorCode(err),
+				"message": "Accept the latest terms of service to continue",
+			})
+		default:
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error":   "consent_error",
+				"message": "Failed to check terms of service acceptance",
+			})
+		}
+	}
+}
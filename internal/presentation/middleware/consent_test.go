@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"enterprise-crud/internal/domain/consent"
+	"enterprise-crud/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// termsChecker is a consent.Service stub; only CheckTermsAccepted is used by the middleware
+type termsChecker struct {
+	consent.Service
+	err error
+}
+
+func (s termsChecker) CheckTermsAccepted(ctx context.Context, userID uuid.UUID) error {
+	return s.err
+}
+
+func newConsentRouter(err error, authenticated bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.POST("/orders", func(c *gin.Context) {
+		if authenticated {
+			c.Set("user", &auth.JWTClaims{UserID: uuid.New(), Roles: []string{"USER"}})
+		}
+	}, RequireTermsAccepted(termsChecker{err: err}), func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+	return router
+}
+
+func TestRequireTermsAccepted(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		authenticated  bool
+		expectedStatus int
+		expectedBody   string
+	}{
+		{name: "accepted", authenticated: true, expectedStatus: http.StatusCreated},
+		{name: "not accepted", err: consent.ErrConsentRequired, authenticated: true, expectedStatus: http.StatusForbidden, expectedBody: "CONSENT_REQUIRED"},
+		{name: "check failed", err: errors.New("db down"), authenticated: true, expectedStatus: http.StatusInternalServerError, expectedBody: "consent_error"},
+		{name: "unauthenticated", expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			newConsentRouter(tt.err, tt.authenticated).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/orders", nil))
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
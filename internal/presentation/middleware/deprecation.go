@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"enterprise-crud/internal/deprecation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Deprecations announces deprecated endpoints, parameters and response fields a request touches
+// The response gets Deprecation (RFC 9745) with the earliest announcement and Sunset (RFC 8594)
+// with the earliest removal date, and the warnings are put in the request context for list
+// responses to repeat in meta.warnings.
+func Deprecations(registry *deprecation.Registry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		applicable := registry.Applicable(c.Request.Method, c.FullPath(), c.Request.URL.Query())
+		if len(applicable) == 0 {
+			c.Next()
+			return
+		}
+
+		since := applicable[0].Since
+		warnings := make([]string, len(applicable))
+		for i, d := range applicable {
+			if d.Since.Before(since) {
+				since = d.Since
+			}
+			warnings[i] = d.Warning()
+		}
+		c.Header("Deprecation", "@"+strconv.FormatInt(since.Unix(), 10))
+		c.Header("Sunset", applicable[0].Sunset.UTC().Format(http.TimeFormat))
+
+		c.Request = c.Request.WithContext(deprecation.WithWarnings(c.Request.Context(), warnings))
+		c.Next()
+	}
+}
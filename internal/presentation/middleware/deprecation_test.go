@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/deprecation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDeprecationRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Deprecations(deprecation.NewRegistry(
+		deprecation.Deprecation{
+			Method: http.MethodGet, Path: "/api/v1/events/:id", Param: "offset",
+			Since:  time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+			Sunset: time.Date(2027, 4, 17, 0, 0, 0, 0, time.UTC),
+		},
+		deprecation.Deprecation{
+			Method: http.MethodGet, Path: "/api/v1/events/:id",
+			Since:  time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+			Sunset: time.Date(2027, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+	)))
+	router.GET("/api/v1/events/:id", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"warnings": deprecation.Warnings(c.Request.Context())})
+	})
+	router.GET("/api/v1/venues", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return router
+}
+
+func TestDeprecations(t *testing.T) {
+	w := httptest.NewRecorder()
+	newDeprecationRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/events/42?offset=10", nil))
+
+	assert.Equal(t, "@1788220800", w.Header().Get("Deprecation"), "earliest announcement")
+	assert.Equal(t, "Sat, 17 Apr 2027 00:00:00 GMT", w.Header().Get("Sunset"), "earliest sunset")
+	assert.Contains(t, w.Body.String(), "the offset parameter of GET /api/v1/events/:id is deprecated and will be removed after 2027-04-17")
+	assert.Contains(t, w.Body.String(), "GET /api/v1/events/:id is deprecated and will be removed after 2027-09-01")
+}
+
+func TestDeprecations_ParamNotSent(t *testing.T) {
+	w := httptest.NewRecorder()
+	newDeprecationRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/events/42", nil))
+
+	assert.Equal(t, "Wed, 01 Sep 2027 00:00:00 GMT", w.Header().Get("Sunset"))
+	assert.NotContains(t, w.Body.String(), "offset")
+}
+
+func TestDeprecations_NothingDeprecated(t *testing.T) {
+	w := httptest.NewRecorder()
+	newDeprecationRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/venues", nil))
+
+	assert.Empty(t, w.Header().Get("Deprecation"))
+	assert.Empty(t, w.Header().Get("Sunset"))
+}
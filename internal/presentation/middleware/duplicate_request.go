@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"enterprise-crud/internal/infrastructure/auth"
+	"enterprise-crud/internal/infrastructure/dedupe"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxDedupedBodyBytes bounds the bodies compared for duplicates; larger requests are not checked
+const maxDedupedBodyBytes = 1 << 20 // 1 MiB
+
+// RejectDuplicates answers 409 DUPLICATE_REQUEST to a request identical to one the same user sent within window
+// Requests are identical when they have the same method, path and body. A request that fails releases
+// its claim, so a retry after an error is let through. Requests are also let through when the store
+// cannot be reached: an outage of Redis should not take creation down with it.
+// It must run after authentication; anonymous requests are not checked.
+func RejectDuplicates(store dedupe.Store, window time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		currentUser, ok := auth.CurrentUser(c)
+		if !ok || window <= 0 || c.Request.Body == nil {
+			c.Next()
+			return
+		}
+		body := peekBody(c, maxDedupedBodyBytes+1)
+		if len(body) > maxDedupedBodyBytes {
+			c.Next()
+			return
+		}
+
+		hash := sha256.New()
+		for _, part := range []string{currentUser.UserID.String(), c.Request.Method, c.Request.URL.Path} {
+			hash.Write([]byte(part))
+			hash.Write([]byte{0})
+		}
+		hash.Write(body)
+		key := hex.EncodeToString(hash.Sum(nil))
+
+		claimed, err := store.Claim(c.Request.Context(), key, window)
+		if err != nil {
+			log.Printf("Warning: Failed to check for duplicate requests, allowing %s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+			c.Next()
+			return
+		}
+		if !claimed {
+			seconds := int(math.Max(1, math.Ceil(window.Seconds())))
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+				"error":   "DUPLICATE_REQUEST",
+				"message": "An identical request was just submitted; check its result before sending it again",
+			})
+			return
+		}
+
+		c.Next()
+
+		if c.Writer.Status() >= http.StatusBadRequest {
+			if err := store.Release(c.Request.Context(), key); err != nil {
+				log.Printf("Warning: Failed to release duplicate request claim for %s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/infrastructure/auth"
+	"enterprise-crud/internal/infrastructure/dedupe"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDuplicateRouter(t *testing.T, claims *auth.JWTClaims) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.POST("/events", func(c *gin.Context) {
+		c.Set("user", claims)
+	}, RejectDuplicates(dedupe.NewMemoryStore(), time.Minute), func(c *gin.Context) {
+		var body struct {
+			Title string `json:"title"`
+		}
+		require.NoError(t, c.ShouldBindJSON(&body))
+		if body.Title == "" {
+			c.Status(http.StatusBadRequest)
+			return
+		}
+		c.String(http.StatusCreated, body.Title)
+	})
+	return router
+}
+
+func TestRejectDuplicates(t *testing.T) {
+	claims := &auth.JWTClaims{UserID: uuid.New()}
+	router := newDuplicateRouter(t, claims)
+
+	send := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(body)))
+		return w
+	}
+
+	first := send(`{"title":"Summer Concert"}`)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, "Summer Concert", first.Body.String(), "the handler still reads the body")
+
+	second := send(`{"title":"Summer Concert"}`)
+	assert.Equal(t, http.StatusConflict, second.Code)
+	assert.Contains(t, second.Body.String(), "DUPLICATE_REQUEST")
+	assert.Equal(t, "60", second.Header().Get("Retry-After"))
+
+	assert.Equal(t, http.StatusCreated, send(`{"title":"Winter Concert"}`).Code, "other bodies pass")
+
+	assert.Equal(t, http.StatusBadRequest, send(`{"title":""}`).Code)
+	assert.Equal(t, http.StatusBadRequest, send(`{"title":""}`).Code, "failed requests release their claim")
+
+	claims.UserID = uuid.New()
+	assert.Equal(t, http.StatusCreated, send(`{"title":"Summer Concert"}`).Code, "other users pass")
+}
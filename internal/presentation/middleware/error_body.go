@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errorBodyWriter holds back JSON error bodies so they can be rewritten before they are sent
+// Successful responses and non-JSON bodies are written straight through.
+type errorBodyWriter struct {
+	gin.ResponseWriter
+	rewrite func(body []byte) []byte
+	held    *bytes.Buffer // Error body written so far; nil until one is written
+}
+
+func (w *errorBodyWriter) Write(data []byte) (int, error) {
+	if !w.holds() {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.held.Write(data)
+}
+
+func (w *errorBodyWriter) WriteString(s string) (int, error) {
+	if !w.holds() {
+		return w.ResponseWriter.WriteString(s)
+	}
+	return w.held.WriteString(s)
+}
+
+// holds reports whether writes are being held back, starting to once an error body is written
+func (w *errorBodyWriter) holds() bool {
+	if w.held != nil {
+		return true
+	}
+	if w.Status() < 400 || w.ResponseWriter.Written() {
+		return false
+	}
+	contentType := w.Header().Get("Content-Type")
+	if !strings.HasPrefix(contentType, "application/json") && !strings.HasPrefix(contentType, problemContentType) {
+		return false
+	}
+	w.held = &bytes.Buffer{}
+	return true
+}
+
+// flush writes the held error body after rewriting it
+func (w *errorBodyWriter) flush() {
+	if w.held == nil {
+		return
+	}
+	body := w.held.Bytes()
+	w.held = nil
+	_, _ = w.ResponseWriter.Write(w.rewrite(body))
+}
+
+// WithRequestID adds a request_id field to a JSON object
+// Bodies that aren't JSON objects, or already have a request_id, are returned unchanged.
+// The field is added last so the rest of the body keeps its order.
+func WithRequestID(body []byte, requestID string) []byte {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || fields == nil {
+		return body
+	}
+	if _, exists := fields["request_id"]; exists {
+		return body
+	}
+
+	// Insert after the last value rather than before the closing brace, so indented bodies keep their layout
+	end := len(bytes.TrimRight(body[:bytes.LastIndexByte(body, '}')], " \t\r\n"))
+	id, _ := json.Marshal(requestID)
+	out := make([]byte, 0, len(body)+len(id)+16)
+	out = append(out, body[:end]...)
+	if len(fields) > 0 {
+		out = append(out, ',')
+	}
+	out = append(out, `"request_id":`...)
+	out = append(out, id...)
+	return append(out, body[end:]...)
+}
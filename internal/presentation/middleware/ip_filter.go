@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"enterprise-crud/internal/domain/ipaccess"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RestrictIPs blocks requests under the given path prefixes from addresses the IP rules do not allow
+// The client address comes from gin's ClientIP, so X-Forwarded-For only counts from trusted proxies.
+// Other paths pass through untouched, which lets the middleware be installed on the whole router.
+func RestrictIPs(ipService ipaccess.Service, pathPrefixes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !hasPathPrefix(c.Request.URL.Path, pathPrefixes) {
+			c.Next()
+			return
+		}
+
+		clientIP := c.ClientIP()
+		allowed, err := ipService.IsAllowed(c.Request.Context(), clientIP)
+		switch {
+		case err != nil:
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error":   "ip_check_error",
+				"message": "Failed to check access rules",
+			})
+		case !allowed:
+			log.Printf("Blocked %s %s from %s by admin IP rules", c.Request.Method, c.Request.URL.Path, clientIP)
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error":   "forbidden",
+				"message": "Access from this address is not allowed",
+			})
+		default:
+			c.Next()
+		}
+	}
+}
+
+// hasPathPrefix matches whole path segments, so "/api/v1/admin" covers "/api/v1/admin/jobs" but not "/api/v1/administrators"
+func hasPathPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
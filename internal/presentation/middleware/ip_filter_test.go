@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"enterprise-crud/internal/domain/ipaccess"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ipChecker is an ipaccess.Service stub; only IsAllowed is used by the middleware
+type ipChecker struct {
+	ipaccess.Service
+	policy *ipaccess.Policy
+	err    error
+}
+
+func (s ipChecker) IsAllowed(ctx context.Context, ip string) (bool, error) {
+	if s.err != nil {
+		return false, s.err
+	}
+	return s.policy.Allows(ip), nil
+}
+
+func newIPFilterRouter(t *testing.T, checker ipChecker, trustedProxies []string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	require.NoError(t, router.SetTrustedProxies(trustedProxies))
+	router.Use(RestrictIPs(checker, "/api/v1/admin", "/swagger/"))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/api/v1/admin/jobs", ok)
+	router.GET("/api/v1/administrators", ok)
+	router.GET("/api/v1/events", ok)
+	router.GET("/swagger/*any", ok)
+	return router
+}
+
+func TestRestrictIPs(t *testing.T) {
+	policy, err := ipaccess.NewPolicy([]string{"10.0.0.0/8"}, []string{"10.0.0.13"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		checker        ipChecker
+		path           string
+		remoteAddr     string
+		forwardedFor   string
+		trustedProxies []string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{name: "allowed range", checker: ipChecker{policy: policy}, path: "/api/v1/admin/jobs", remoteAddr: "10.1.2.3:4000", expectedStatus: http.StatusOK},
+		{name: "outside allowed range", checker: ipChecker{policy: policy}, path: "/api/v1/admin/jobs", remoteAddr: "198.51.100.1:4000", expectedStatus: http.StatusForbidden, expectedBody: "forbidden"},
+		{name: "denied address", checker: ipChecker{policy: policy}, path: "/api/v1/admin/jobs", remoteAddr: "10.0.0.13:4000", expectedStatus: http.StatusForbidden},
+		{name: "swagger is restricted", checker: ipChecker{policy: policy}, path: "/swagger/index.html", remoteAddr: "198.51.100.1:4000", expectedStatus: http.StatusForbidden},
+		{name: "other routes pass", checker: ipChecker{policy: policy}, path: "/api/v1/events", remoteAddr: "198.51.100.1:4000", expectedStatus: http.StatusOK},
+		{name: "prefix matches whole segments", checker: ipChecker{policy: policy}, path: "/api/v1/administrators", remoteAddr: "198.51.100.1:4000", expectedStatus: http.StatusOK},
+		{
+			name: "forwarded address from untrusted peer is ignored", checker: ipChecker{policy: policy}, path: "/api/v1/admin/jobs",
+			remoteAddr: "198.51.100.1:4000", forwardedFor: "10.1.2.3", expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "forwarded address from trusted proxy is used", checker: ipChecker{policy: policy}, path: "/api/v1/admin/jobs",
+			remoteAddr: "192.168.1.10:4000", forwardedFor: "10.1.2.3", trustedProxies: []string{"192.168.1.0/24"}, expectedStatus: http.StatusOK,
+		},
+		{name: "check failed", checker: ipChecker{err: errors.New("db down")}, path: "/api/v1/admin/jobs", remoteAddr: "10.1.2.3:4000", expectedStatus: http.StatusInternalServerError, expectedBody: "ip_check_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			w := httptest.NewRecorder()
+			newIPFilterRouter(t, tt.checker, tt.trustedProxies).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
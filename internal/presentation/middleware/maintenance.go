@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"enterprise-crud/internal/domain/maintenance"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Maintenance answers writes with 503 while maintenance mode is in force; reads keep working
+// Requests under the exempt path prefixes always pass, so admins can log in and switch maintenance off.
+// If the maintenance state cannot be loaded writes are let through: an outage of the state store
+// should not take writes down with it.
+func Maintenance(maintenanceService maintenance.Service, retryAfter time.Duration, exemptPrefixes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+		if hasPathPrefix(c.Request.URL.Path, exemptPrefixes) {
+			c.Next()
+			return
+		}
+
+		status, err := maintenanceService.Status(c.Request.Context())
+		if err != nil {
+			log.Printf("Warning: Failed to check maintenance mode, allowing %s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+			c.Next()
+			return
+		}
+		if !status.Active {
+			c.Next()
+			return
+		}
+
+		wait := retryAfter
+		if status.EndsAt != nil {
+			wait = time.Until(*status.EndsAt)
+		}
+		seconds := int(math.Max(1, math.Ceil(wait.Seconds())))
+
+		body := gin.H{
+			"error":       "maintenance",
+			"message":     status.Message,
+			"retry_after": seconds,
+		}
+		if status.EndsAt != nil {
+			body["ends_at"] = status.EndsAt.UTC()
+		}
+		c.Header("Retry-After", strconv.Itoa(seconds))
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, body)
+	}
+}
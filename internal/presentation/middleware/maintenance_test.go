@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/domain/maintenance"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// maintenanceChecker is a maintenance.Service stub; only Status is used by the middleware
+type maintenanceChecker struct {
+	maintenance.Service
+	status *maintenance.Status
+	err    error
+}
+
+func (s maintenanceChecker) Status(ctx context.Context) (*maintenance.Status, error) {
+	return s.status, s.err
+}
+
+func newMaintenanceRouter(checker maintenanceChecker) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Maintenance(checker, 5*time.Minute, "/api/v1/auth/login", "/api/v1/admin/maintenance"))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/api/v1/events", ok)
+	router.POST("/api/v1/orders", ok)
+	router.POST("/api/v1/auth/login", ok)
+	router.PUT("/api/v1/admin/maintenance", ok)
+	return router
+}
+
+func TestMaintenance(t *testing.T) {
+	endsAt := time.Now().Add(90 * time.Second)
+	active := &maintenance.Status{Active: true, Source: maintenance.SourceSwitch, Message: "Back soon"}
+	window := &maintenance.Status{Active: true, Source: maintenance.SourceWindow, Message: "Upgrading", EndsAt: &endsAt}
+
+	tests := []struct {
+		name             string
+		checker          maintenanceChecker
+		method           string
+		path             string
+		expectedStatus   int
+		expectRetryAfter string
+	}{
+		{name: "writes pass when inactive", checker: maintenanceChecker{status: &maintenance.Status{}}, method: http.MethodPost, path: "/api/v1/orders", expectedStatus: http.StatusOK},
+		{name: "reads pass during maintenance", checker: maintenanceChecker{status: active}, method: http.MethodGet, path: "/api/v1/events", expectedStatus: http.StatusOK},
+		{name: "writes are rejected", checker: maintenanceChecker{status: active}, method: http.MethodPost, path: "/api/v1/orders", expectedStatus: http.StatusServiceUnavailable, expectRetryAfter: "300"},
+		{name: "window end sets retry", checker: maintenanceChecker{status: window}, method: http.MethodPost, path: "/api/v1/orders", expectedStatus: http.StatusServiceUnavailable, expectRetryAfter: "90"},
+		{name: "login is exempt", checker: maintenanceChecker{status: active}, method: http.MethodPost, path: "/api/v1/auth/login", expectedStatus: http.StatusOK},
+		{name: "switch is exempt", checker: maintenanceChecker{status: active}, method: http.MethodPut, path: "/api/v1/admin/maintenance", expectedStatus: http.StatusOK},
+		{name: "store errors let writes through", checker: maintenanceChecker{err: errors.New("redis down")}, method: http.MethodPost, path: "/api/v1/orders", expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			newMaintenanceRouter(tt.checker).ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectRetryAfter, w.Header().Get("Retry-After"))
+		})
+	}
+
+	t.Run("payload", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newMaintenanceRouter(maintenanceChecker{status: window}).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/orders", nil))
+
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "maintenance", body["error"])
+		assert.Equal(t, "Upgrading", body["message"])
+		assert.Equal(t, float64(90), body["retry_after"])
+		assert.Equal(t, endsAt.UTC().Format(time.RFC3339Nano), body["ends_at"])
+	})
+}
@@ -0,0 +1,154 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+)
+
+// problemContentType is the media type of RFC 7807 problem details
+const problemContentType = "application/problem+json"
+
+// problemFields are the fields of an error body that ToProblem replaces rather than carries over
+var problemFields = map[string]bool{
+	"error": true, "code": true, "message": true,
+	"type": true, "title": true, "status": true, "detail": true, "instance": true,
+}
+
+// ProblemDetails answers errors as RFC 7807 problem details to clients whose Accept header
+// asks for application/problem+json; everyone else keeps the {error, message} shape.
+// The type of a problem is typeBase followed by its error code in kebab case, e.g.
+// INSUFFICIENT_TICKETS becomes <typeBase>insufficient-tickets. The code is kept as the
+// "code" extension member, and other fields of the error body are carried over unchanged.
+func ProblemDetails(typeBase string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", "Accept")
+		if !AcceptsProblemJSON(c.GetHeader("Accept")) {
+			c.Next()
+			return
+		}
+
+		writer := &errorBodyWriter{ResponseWriter: c.Writer}
+		writer.rewrite = func(body []byte) []byte {
+			if strings.HasPrefix(writer.Header().Get("Content-Type"), problemContentType) {
+				return body
+			}
+			problem, ok := ToProblem(body, writer.Status(), c.Request.URL.Path, typeBase)
+			if !ok {
+				return body
+			}
+			writer.Header().Set("Content-Type", problemContentType)
+			return problem
+		}
+		c.Writer = writer
+		c.Next()
+		writer.flush()
+	}
+}
+
+// AcceptsProblemJSON reports whether an Accept header lists application/problem+json
+// A wildcard is not enough, so clients only get problem details when they ask for them.
+func AcceptsProblemJSON(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || mediaType != problemContentType {
+			continue
+		}
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil && q <= 0 {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// ToProblem turns an {error, message} body into problem details for status
+// It reports false when the body isn't a JSON object.
+func ToProblem(body []byte, status int, instance, typeBase string) ([]byte, bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || fields == nil {
+		return nil, false
+	}
+	text := func(key string) string {
+		var s string
+		_ = json.Unmarshal(fields[key], &s)
+		return s
+	}
+
+	// Handlers answer either {error: CODE, message} or {error: text, code: CODE}
+	code, detail := text("code"), text("message")
+	if code == "" {
+		code = text("error")
+	} else if detail == "" {
+		detail = text("error")
+	}
+
+	problemType := "about:blank"
+	if slug := problemSlug(code); slug != "" {
+		problemType = typeBase + slug
+	}
+
+	var out bytes.Buffer
+	member := func(key string, value interface{}) {
+		if out.Len() > 0 {
+			out.WriteByte(',')
+		}
+		k, _ := json.Marshal(key)
+		out.Write(k)
+		out.WriteByte(':')
+		if raw, ok := value.(json.RawMessage); ok {
+			out.Write(raw)
+			return
+		}
+		v, _ := json.Marshal(value)
+		out.Write(v)
+	}
+	member("type", problemType)
+	member("title", http.StatusText(status))
+	member("status", status)
+	if detail != "" {
+		member("detail", detail)
+	}
+	member("instance", instance)
+	if code != "" {
+		member("code", code)
+	}
+
+	extensions := make([]string, 0, len(fields))
+	for key := range fields {
+		if !problemFields[key] {
+			extensions = append(extensions, key)
+		}
+	}
+	sort.Strings(extensions)
+	for _, key := range extensions {
+		member(key, fields[key])
+	}
+
+	return append(append([]byte{'{'}, out.Bytes()...), '}'), true
+}
+
+// problemSlug turns an error code such as INSUFFICIENT_TICKETS or "Invalid credentials" into kebab case
+func problemSlug(code string) string {
+	var slug strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(code) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			if dash && slug.Len() > 0 {
+				slug.WriteByte('-')
+			}
+			slug.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return slug.String()
+}
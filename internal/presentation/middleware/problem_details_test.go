@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProblemDetails(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(RequestLogger(RequestLoggerConfig{Logger: slog.New(slog.NewJSONHandler(io.Discard, nil))}))
+	router.Use(ProblemDetails("https://example.com/problems/"))
+	router.POST("/orders", func(c *gin.Context) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "INSUFFICIENT_TICKETS", "message": "Insufficient tickets", "available": 0})
+	})
+	router.POST("/users", func(c *gin.Context) {
+		c.JSON(http.StatusConflict, gin.H{"error": "Username taken", "code": "USERNAME_EXISTS"})
+	})
+	router.GET("/ok", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "fine"})
+	})
+
+	serve := func(method, path, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set(RequestIDHeader, "req-1")
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("clients asking for problem details get them", func(t *testing.T) {
+		w := serve(http.MethodPost, "/orders", "application/problem+json, application/json;q=0.9")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+		assert.Equal(t, "Accept", w.Header().Get("Vary"))
+		assert.Equal(t, `{"type":"https://example.com/problems/insufficient-tickets","title":"Bad Request","status":400,`+
+			`"detail":"Insufficient tickets","instance":"/orders","code":"INSUFFICIENT_TICKETS","available":0,"request_id":"req-1"}`,
+			w.Body.String())
+	})
+
+	t.Run("a code field wins over the error text", func(t *testing.T) {
+		w := serve(http.MethodPost, "/users", "application/problem+json")
+
+		assert.JSONEq(t, `{"type":"https://example.com/problems/username-exists","title":"Conflict","status":409,`+
+			`"detail":"Username taken","instance":"/users","code":"USERNAME_EXISTS","request_id":"req-1"}`, w.Body.String())
+	})
+
+	t.Run("other clients keep the legacy shape", func(t *testing.T) {
+		for _, accept := range []string{"", "application/json", "*/*", "application/problem+json;q=0"} {
+			w := serve(http.MethodPost, "/orders", accept)
+
+			assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"), accept)
+			assert.JSONEq(t, `{"error":"INSUFFICIENT_TICKETS","message":"Insufficient tickets","available":0,"request_id":"req-1"}`, w.Body.String(), accept)
+		}
+	})
+
+	t.Run("successful responses are untouched", func(t *testing.T) {
+		w := serve(http.MethodGet, "/ok", "application/problem+json")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"message":"fine"}`, w.Body.String())
+	})
+}
+
+func TestProblemSlug(t *testing.T) {
+	for code, want := range map[string]string{
+		"INSUFFICIENT_TICKETS": "insufficient-tickets",
+		"validation_error":     "validation-error",
+		"Invalid credentials":  "invalid-credentials",
+		"  --weird__Code!! ":   "weird-code",
+		"":                     "",
+	} {
+		assert.Equal(t, want, problemSlug(code), code)
+	}
+}
@@ -0,0 +1,190 @@
+// Package middleware contains HTTP middleware shared by all routes
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"enterprise-crud/internal/logging"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader carries the request ID in both directions
+const RequestIDHeader = "X-Request-ID"
+
+// RequestIDKey is the Gin context key holding the request ID
+const RequestIDKey = "request_id"
+
+// maxLoggedBodyBytes bounds how much of a request body is captured for logging
+const maxLoggedBodyBytes = 4096
+
+// redacted replaces sensitive values in logged bodies
+const redacted = "[REDACTED]"
+
+// sensitiveFields are JSON keys whose values are never logged (matched case-insensitively)
+var sensitiveFields = []string{"password", "token", "secret", "authorization", "api_key", "apikey"}
+
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// requestIDPattern is what an incoming request ID may look like: up to 128 visible ASCII characters,
+// so a client can't inject line breaks or control characters into logs and headers
+var requestIDPattern = regexp.MustCompile(`^[\x21-\x7e]{1,128}$`)
+
+// RequestLoggerConfig controls the access log
+type RequestLoggerConfig struct {
+	Logger   *slog.Logger // Destination for access logs (default: JSON to stdout)
+	LogBody  bool         // Include redacted request bodies; only enable in development
+	SkipPath []string     // Paths not logged, e.g. health probes
+}
+
+// RequestLogger writes one structured log line per request with method, path, status,
+// latency, user ID and request ID. The request ID is taken from X-Request-ID when the
+// client sends a well-formed one and generated otherwise, then echoed back in the response.
+// It is also put on the request context for logging.From, and added as request_id to
+// JSON error bodies so users can quote it to support.
+func RequestLogger(cfg RequestLoggerConfig) gin.HandlerFunc {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	}
+
+	skip := make(map[string]bool, len(cfg.SkipPath))
+	for _, path := range cfg.SkipPath {
+		skip[path] = true
+	}
+
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if !requestIDPattern.MatchString(requestID) {
+			requestID = uuid.NewString()
+		}
+		c.Set(RequestIDKey, requestID)
+		c.Header(RequestIDHeader, requestID)
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), requestID))
+
+		writer := &errorBodyWriter{ResponseWriter: c.Writer, rewrite: func(body []byte) []byte {
+			return WithRequestID(body, requestID)
+		}}
+		c.Writer = writer
+
+		var body []byte
+		if cfg.LogBody && c.Request.Body != nil {
+			body = captureBody(c)
+		}
+
+		start := time.Now()
+		c.Next()
+		writer.flush()
+
+		if skip[c.Request.URL.Path] {
+			return
+		}
+
+		attrs := []any{
+			slog.String("request_id", requestID),
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status", c.Writer.Status()),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("client_ip", c.ClientIP()),
+		}
+		if userID, exists := c.Get("user_id"); exists {
+			attrs = append(attrs, slog.Any("user_id", userID))
+		}
+		if len(body) > 0 {
+			attrs = append(attrs, slog.String("body", RedactBody(body)))
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("errors", c.Errors.String()))
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case c.Writer.Status() >= 500:
+			level = slog.LevelError
+		case c.Writer.Status() >= 400:
+			level = slog.LevelWarn
+		}
+		logger.Log(c.Request.Context(), level, "request", attrs...)
+	}
+}
+
+// GetRequestID returns the request ID assigned by RequestLogger, or "" if none
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(RequestIDKey)
+}
+
+// captureBody reads up to maxLoggedBodyBytes of the body and restores it for handlers
+func captureBody(c *gin.Context) []byte {
+	return peekBody(c, maxLoggedBodyBytes)
+}
+
+// peekBody reads up to limit bytes of the body and restores it for handlers
+func peekBody(c *gin.Context, limit int64) []byte {
+	head, err := io.ReadAll(io.LimitReader(c.Request.Body, limit))
+	if err != nil {
+		return nil
+	}
+	c.Request.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), c.Request.Body), c.Request.Body}
+	return head
+}
+
+// RedactBody masks sensitive fields and email addresses in a JSON request body
+// Bodies that aren't valid JSON (including ones truncated at maxLoggedBodyBytes) are
+// omitted entirely, since sensitive values can't be located in them reliably
+func RedactBody(body []byte) string {
+	var payload interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "[OMITTED: not valid JSON]"
+	}
+
+	out, err := json.Marshal(redactValue(payload))
+	if err != nil {
+		return ""
+	}
+	return string(out)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range v {
+			if isSensitiveField(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(inner)
+		}
+		return v
+	case []interface{}:
+		for i, inner := range v {
+			v[i] = redactValue(inner)
+		}
+		return v
+	case string:
+		return emailPattern.ReplaceAllString(v, redacted)
+	default:
+		return v
+	}
+}
+
+func isSensitiveField(key string) bool {
+	key = strings.ToLower(key)
+	for _, field := range sensitiveFields {
+		if strings.Contains(key, field) {
+			return true
+		}
+	}
+	return false
+}
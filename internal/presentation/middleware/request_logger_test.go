@@ -0,0 +1,161 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"enterprise-crud/internal/logging"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactBody(t *testing.T) {
+	body := `{"email":"jane@example.com","password":"hunter2","username":"jane","nested":{"accessToken":"abc"},"notes":["call bob@example.org"]}`
+
+	result := RedactBody([]byte(body))
+
+	assert.NotContains(t, result, "hunter2")
+	assert.NotContains(t, result, "jane@example.com")
+	assert.NotContains(t, result, "bob@example.org")
+	assert.NotContains(t, result, "abc")
+	assert.Contains(t, result, `"username":"jane"`)
+}
+
+func TestRedactBody_NonJSONOmitted(t *testing.T) {
+	result := RedactBody([]byte("password=hunter2"))
+
+	assert.NotContains(t, result, "hunter2")
+}
+
+func TestRequestLogger(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var logs bytes.Buffer
+	router := gin.New()
+	router.Use(RequestLogger(RequestLoggerConfig{
+		Logger:  slog.New(slog.NewJSONHandler(&logs, nil)),
+		LogBody: true,
+	}))
+
+	var handlerBody string
+	router.POST("/login", func(c *gin.Context) {
+		raw, _ := io.ReadAll(c.Request.Body)
+		handlerBody = string(raw)
+		c.Status(http.StatusUnauthorized)
+	})
+
+	payload := `{"email":"jane@example.com","password":"hunter2"}`
+	req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(payload))
+	req.Header.Set(RequestIDHeader, "req-123")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, payload, handlerBody, "handler must still see the full body")
+	assert.Equal(t, "req-123", w.Header().Get(RequestIDHeader))
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Equal(t, "req-123", entry["request_id"])
+	assert.Equal(t, "POST", entry["method"])
+	assert.Equal(t, "/login", entry["path"])
+	assert.Equal(t, float64(http.StatusUnauthorized), entry["status"])
+	assert.Equal(t, "WARN", entry["level"])
+	assert.NotContains(t, logs.String(), "hunter2")
+	assert.NotContains(t, logs.String(), "jane@example.com")
+}
+
+func TestRequestLogger_GeneratesRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(RequestLogger(RequestLoggerConfig{
+		Logger:   slog.New(slog.NewJSONHandler(io.Discard, nil)),
+		SkipPath: []string{"/health"},
+	}))
+	router.GET("/health", func(c *gin.Context) {
+		c.String(http.StatusOK, GetRequestID(c))
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	assert.NotEmpty(t, w.Header().Get(RequestIDHeader))
+	assert.Equal(t, w.Header().Get(RequestIDHeader), w.Body.String())
+}
+
+func TestRequestLogger_RejectsMalformedRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(RequestLogger(RequestLoggerConfig{Logger: slog.New(slog.NewJSONHandler(io.Discard, nil))}))
+	router.GET("/ping", func(c *gin.Context) {
+		c.String(http.StatusOK, logging.RequestID(c.Request.Context()))
+	})
+
+	for _, id := range []string{"has space", "line\nbreak", strings.Repeat("a", 129)} {
+		req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+		req.Header.Set(RequestIDHeader, id)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.NotEqual(t, id, w.Header().Get(RequestIDHeader))
+		assert.Equal(t, w.Header().Get(RequestIDHeader), w.Body.String(), "the context carries the ID that was sent back")
+	}
+}
+
+func TestRequestLogger_AddsRequestIDToErrorBodies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(RequestLogger(RequestLoggerConfig{Logger: slog.New(slog.NewJSONHandler(io.Discard, nil))}))
+	router.GET("/missing", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not_found", "message": "Nothing here"})
+	})
+	router.GET("/ok", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "fine"})
+	})
+	router.GET("/text", func(c *gin.Context) {
+		c.String(http.StatusBadRequest, "plain")
+	})
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(RequestIDHeader, "req-456")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := serve("/missing")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"error":"not_found","message":"Nothing here","request_id":"req-456"}`, w.Body.String())
+
+	assert.JSONEq(t, `{"message":"fine"}`, serve("/ok").Body.String())
+	assert.Equal(t, "plain", serve("/text").Body.String())
+}
+
+func TestWithRequestID(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{`{"error":"x"}`, `{"error":"x","request_id":"r\"1"}`},
+		{`{}`, `{"request_id":"r\"1"}`},
+		{"{\n  \"error\": \"x\"\n}\n", "{\n  \"error\": \"x\",\"request_id\":\"r\\\"1\"\n}\n"},
+		{`{"request_id":"other"}`, `{"request_id":"other"}`},
+		{`["not","an","object"]`, `["not","an","object"]`},
+		{`null`, `null`},
+		{`not json`, `not json`},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, string(WithRequestID([]byte(tt.body), `r"1`)), tt.body)
+	}
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"enterprise-crud/internal/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSecurityRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(SecurityHeaders(&config.SecurityConfig{
+		FrameOptions:    "DENY",
+		ReferrerPolicy:  "no-referrer",
+		HSTSMaxAge:      time.Hour,
+		ContentSecurity: "default-src 'none'",
+		SwaggerSecurity: "default-src 'self'",
+	}))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/api/v1/events", ok)
+	router.GET("/swagger/*any", ok)
+	return router
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	w := httptest.NewRecorder()
+	newSecurityRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/events", nil))
+
+	assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "DENY", w.Header().Get("X-Frame-Options"))
+	assert.Equal(t, "no-referrer", w.Header().Get("Referrer-Policy"))
+	assert.Equal(t, "default-src 'none'", w.Header().Get("Content-Security-Policy"))
+	assert.Empty(t, w.Header().Get("Strict-Transport-Security"), "HSTS must not be sent over plain HTTP")
+}
+
+func TestSecurityHeaders_HSTSBehindTLSProxy(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/events", nil)
+	req.Header.Set("X-Forwarded-Proto", "https")
+	w := httptest.NewRecorder()
+	newSecurityRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, "max-age=3600; includeSubDomains", w.Header().Get("Strict-Transport-Security"))
+}
+
+func TestSecurityHeaders_SwaggerPolicy(t *testing.T) {
+	w := httptest.NewRecorder()
+	newSecurityRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/swagger/index.html", nil))
+
+	assert.Equal(t, "default-src 'self'", w.Header().Get("Content-Security-Policy"))
+}
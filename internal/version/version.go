@@ -0,0 +1,87 @@
+// Package version describes the running build. Version, Commit and BuildTime are
+// stamped in at link time:
+//
+//	go build -ldflags "-X enterprise-crud/internal/version.Version=1.4.0 \
+//	  -X enterprise-crud/internal/version.Commit=$(git rev-parse HEAD) \
+//	  -X enterprise-crud/internal/version.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Binaries built without ldflags fall back to the VCS details Go records in the
+// binary, so `go build` from a checkout still reports its commit.
+package version
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// unknown is reported for values neither ldflags nor the build info provide
+const unknown = "unknown"
+
+// Set with -ldflags "-X enterprise-crud/internal/version.<Name>=<value>"
+var (
+	// Version is the release the binary was built from
+	Version = "dev"
+	// Commit is the git commit the binary was built from
+	Commit = ""
+	// BuildTime is when the binary was built, in RFC 3339
+	BuildTime = ""
+)
+
+// Info is the build description served at GET /version
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+	Modified  bool   `json:"modified,omitempty"` // Built from a checkout with uncommitted changes
+}
+
+// Get returns the build description of the running binary
+func Get() Info {
+	return resolve(Version, Commit, BuildTime, readBuildInfo)
+}
+
+// resolve fills whatever ldflags left empty from the build info
+func resolve(version, commit, buildTime string, read func() (*debug.BuildInfo, bool)) Info {
+	info := Info{Version: version, Commit: commit, BuildTime: buildTime, GoVersion: runtime.Version()}
+
+	if bi, ok := read(); ok {
+		for _, setting := range bi.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.Commit == "" {
+					info.Commit = setting.Value
+				}
+			case "vcs.time":
+				if info.BuildTime == "" {
+					info.BuildTime = setting.Value
+				}
+			case "vcs.modified":
+				info.Modified = setting.Value == "true"
+			}
+		}
+	}
+
+	if info.Version == "" {
+		info.Version = "dev"
+	}
+	if info.Commit == "" {
+		info.Commit = unknown
+	}
+	if info.BuildTime == "" {
+		info.BuildTime = unknown
+	}
+	return info
+}
+
+// ShortCommit returns the first 12 characters of the commit, for log lines
+func (i Info) ShortCommit() string {
+	if len(i.Commit) > 12 {
+		return i.Commit[:12]
+	}
+	return i.Commit
+}
+
+func readBuildInfo() (*debug.BuildInfo, bool) {
+	return debug.ReadBuildInfo()
+}
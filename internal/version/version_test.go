@@ -0,0 +1,41 @@
+package version
+
+import (
+	"runtime"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolve(t *testing.T) {
+	vcs := func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Settings: []debug.BuildSetting{
+			{Key: "vcs.revision", Value: "0123456789abcdef0123456789abcdef01234567"},
+			{Key: "vcs.time", Value: "2026-01-02T03:04:05Z"},
+			{Key: "vcs.modified", Value: "true"},
+		}}, true
+	}
+	none := func() (*debug.BuildInfo, bool) { return nil, false }
+
+	t.Run("ldflags win over build info", func(t *testing.T) {
+		info := resolve("1.4.0", "abc123", "2026-10-01T00:00:00Z", vcs)
+		assert.Equal(t, "1.4.0", info.Version)
+		assert.Equal(t, "abc123", info.Commit)
+		assert.Equal(t, "2026-10-01T00:00:00Z", info.BuildTime)
+		assert.Equal(t, runtime.Version(), info.GoVersion)
+		assert.True(t, info.Modified)
+	})
+
+	t.Run("falls back to build info", func(t *testing.T) {
+		info := resolve("dev", "", "", vcs)
+		assert.Equal(t, "0123456789abcdef0123456789abcdef01234567", info.Commit)
+		assert.Equal(t, "2026-01-02T03:04:05Z", info.BuildTime)
+		assert.Equal(t, "0123456789ab", info.ShortCommit())
+	})
+
+	t.Run("unknown without either", func(t *testing.T) {
+		info := resolve("", "", "", none)
+		assert.Equal(t, Info{Version: "dev", Commit: "unknown", BuildTime: "unknown", GoVersion: runtime.Version()}, info)
+	})
+}
@@ -11,19 +11,34 @@
 package main
 
 import (
+	"flag"
 	"log"
+	"log/slog"
 
 	"enterprise-crud/internal/app"
 	"enterprise-crud/internal/config"
+	"enterprise-crud/internal/version"
 )
 
 func main() {
+	mock := flag.Bool("mock", false, "Serve seeded in-memory data without Postgres or Redis, for frontend development")
+	flag.Parse()
+
+	// Tag every structured log line with the build that wrote it
+	build := version.Get()
+	slog.SetDefault(slog.Default().With("version", build.Version, "commit", build.ShortCommit()))
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
+	if *mock {
+		runMock(cfg)
+		return
+	}
+
 	// Method 1: Using manual dependency injection
 	deps, err := app.NewDependencies(cfg)
 	if err != nil {
@@ -31,10 +46,84 @@ func main() {
 	}
 
 	// Create application with dependencies
-	application := app.NewWireApp(cfg, deps.DBConn, deps.RedisClient, deps.UserHandler, deps.EventHandler, deps.OrderHandler, deps.VenueHandler)
+	application := app.NewWireApp(cfg, deps.DBConn, deps.RedisClient, deps.UserHandler, deps.EventHandler, deps.OrderHandler, deps.VenueHandler, deps.PlanHandler, deps.JobHandler, deps.ReportHandler, deps.FeedHandler, deps.ShareHandler, deps.ShortURLHandler, deps.StaffHandler, deps.NotificationHandler, deps.InvoiceHandler, deps.AccountHandler, deps.ConsentHandler, deps.IPAccessHandler, deps.AnalyticsHandler, deps.RecommendationHandler, deps.TrendingHandler, deps.RefundHandler, deps.MessageHandler, deps.WebhookHandler, deps.TransferHandler, deps.AccessCodeHandler, deps.MaintenanceHandler, deps.DiagnosticsHandler, deps.EmailPreviewHandler, deps.BrandingHandler, deps.MetaHandler)
+
+	// Process background jobs in this instance
+	application.Use(deps.AdminIPFilter, deps.MaintenanceGuard)
+
+	if cfg.Jobs.Enabled {
+		application.RunInBackground(deps.JobRunner)
+	}
+
+	// Enqueue scheduled report emails
+	if deps.ReportScheduler != nil {
+		application.RunInBackground(deps.ReportScheduler)
+	}
+
+	// Take daily analytics snapshots
+	if deps.SnapshotScheduler != nil {
+		application.RunInBackground(deps.SnapshotScheduler)
+	}
+
+	// Let trending scores fade
+	if deps.TrendingDecay != nil {
+		application.RunInBackground(deps.TrendingDecay)
+	}
+
+	// Keep on-sale flags in step with sale windows
+	if deps.OnSaleScheduler != nil {
+		application.RunInBackground(deps.OnSaleScheduler)
+	}
+
+	// Send queued CDN purges
+	if deps.CDNPurges != nil {
+		application.RunInBackground(deps.CDNPurges)
+	}
+
+	// Ship audit trails to the configured SIEM sink
+	if deps.AuditExporter != nil {
+		application.RunInBackground(deps.AuditExporter)
+	}
+
+	// Delete accounts whose deletion grace period has ended
+	if deps.DeletionScheduler != nil {
+		application.RunInBackground(deps.DeletionScheduler)
+	}
+
+	// Reconcile event ticket counters with orders
+	if deps.TicketReconciler != nil {
+		application.RunInBackground(deps.TicketReconciler)
+	}
+	if deps.EventArchiver != nil {
+		application.RunInBackground(deps.EventArchiver)
+	}
+	if deps.OrderPartitions != nil {
+		application.RunInBackground(deps.OrderPartitions)
+	}
+
+	// Serve diagnostics on their own address
+	if deps.DiagnosticsServer != nil {
+		application.RunInBackground(deps.DiagnosticsServer)
+	}
+
+	// Let background cache writes finish before Redis is closed
+	application.Track(deps.Lifecycle)
 
 	// Run application (handles startup and graceful shutdown)
 	if err := application.Run(); err != nil {
 		log.Fatalf("Application failed: %v", err)
 	}
 }
+
+// runMock serves the API from the fixtures package until interrupted
+func runMock(cfg *config.Config) {
+	application, err := app.NewMockApp(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize mock server: %v", err)
+	}
+
+	log.Println("Mock mode: serving fixture data from memory; changes are lost on restart")
+	if err := application.Run(); err != nil {
+		log.Fatalf("Application failed: %v", err)
+	}
+}
@@ -0,0 +1,4 @@
+-- Drop plans table
+DROP INDEX IF EXISTS idx_users_plan_id;
+ALTER TABLE users DROP COLUMN IF EXISTS plan_id;
+DROP TABLE IF EXISTS plans CASCADE;
@@ -0,0 +1,23 @@
+-- Create plans table
+-- Subscription plans limit how many active events an organizer may run and how
+-- many tickets a single event may offer. A limit of 0 means unlimited.
+CREATE TABLE IF NOT EXISTS plans (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(50) UNIQUE NOT NULL,
+    description TEXT,
+    max_active_events INTEGER NOT NULL DEFAULT 0 CHECK (max_active_events >= 0),
+    max_tickets_per_event INTEGER NOT NULL DEFAULT 0 CHECK (max_tickets_per_event >= 0),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Seed built-in plans
+INSERT INTO plans (name, description, max_active_events, max_tickets_per_event) VALUES
+('FREE', 'Default plan for new organizers', 3, 500),
+('PRO', 'Paid plan for regular organizers', 25, 10000),
+('ENTERPRISE', 'Unlimited events and tickets', 0, 0)
+ON CONFLICT (name) DO NOTHING;
+
+-- Users without a plan fall back to FREE
+ALTER TABLE users ADD COLUMN IF NOT EXISTS plan_id UUID REFERENCES plans(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_users_plan_id ON users(plan_id);
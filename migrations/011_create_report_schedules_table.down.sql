@@ -0,0 +1,3 @@
+-- Drop report_schedules table
+DROP INDEX IF EXISTS idx_orders_status_created_at;
+DROP TABLE IF EXISTS report_schedules CASCADE;
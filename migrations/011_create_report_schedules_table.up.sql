@@ -0,0 +1,19 @@
+-- Create report_schedules table
+-- Organizers opt in to DAILY or WEEKLY sales summary emails. The scheduler
+-- enqueues a report job when next_run_at passes and advances it atomically.
+CREATE TABLE IF NOT EXISTS report_schedules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID UNIQUE NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    frequency VARCHAR(10) NOT NULL CHECK (frequency IN ('DAILY', 'WEEKLY')),
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    next_run_at TIMESTAMP NOT NULL,
+    last_sent_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_report_schedules_due ON report_schedules(next_run_at) WHERE enabled;
+
+-- Sales summaries filter orders by status and creation time
+CREATE INDEX IF NOT EXISTS idx_orders_status_created_at ON orders(status, created_at);
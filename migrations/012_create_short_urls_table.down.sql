@@ -0,0 +1,2 @@
+-- Drop short_urls table
+DROP TABLE IF EXISTS short_urls CASCADE;
@@ -0,0 +1,11 @@
+-- Create short_urls table
+-- Each event gets one short code when it is published; /e/{code} redirects to
+-- the event page and counts the click.
+CREATE TABLE IF NOT EXISTS short_urls (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(16) UNIQUE NOT NULL,
+    event_id UUID UNIQUE NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    clicks BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
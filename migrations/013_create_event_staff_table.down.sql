@@ -0,0 +1,3 @@
+-- Drop event_staff table
+ALTER TABLE orders DROP COLUMN IF EXISTS checked_in_at;
+DROP TABLE IF EXISTS event_staff CASCADE;
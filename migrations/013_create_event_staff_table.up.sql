@@ -0,0 +1,21 @@
+-- Create event_staff table
+-- Organizers invite staff to single events with check-in or view-only access.
+-- Invitations stay PENDING until the invited user accepts them.
+CREATE TABLE IF NOT EXISTS event_staff (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('CHECK_IN', 'VIEWER')),
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'ACCEPTED')),
+    invited_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    accepted_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (event_id, user_id)
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_event_staff_user_id ON event_staff(user_id);
+
+-- Record when ticket holders are admitted
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMP;
@@ -0,0 +1,3 @@
+-- Drop notifications and notification_preferences tables
+DROP TABLE IF EXISTS notification_preferences CASCADE;
+DROP TABLE IF EXISTS notifications CASCADE;
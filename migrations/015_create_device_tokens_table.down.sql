@@ -0,0 +1,3 @@
+-- Drop device_tokens table and the push preference column
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS push;
+DROP TABLE IF EXISTS device_tokens CASCADE;
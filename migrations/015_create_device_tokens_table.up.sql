@@ -0,0 +1,15 @@
+-- Create device_tokens table and add the push channel to notification preferences
+-- A token identifies one app installation, so it is unique across users.
+CREATE TABLE IF NOT EXISTS device_tokens (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    platform VARCHAR(10) NOT NULL CHECK (platform IN ('ANDROID', 'IOS')),
+    token VARCHAR(512) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS push BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_device_tokens_user_id ON device_tokens(user_id);
@@ -0,0 +1,4 @@
+-- Drop phone_verifications table and user phone columns
+DROP TABLE IF EXISTS phone_verifications CASCADE;
+ALTER TABLE users DROP COLUMN IF EXISTS phone_verified_at;
+ALTER TABLE users DROP COLUMN IF EXISTS phone;
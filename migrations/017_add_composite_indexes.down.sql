@@ -0,0 +1,12 @@
+-- Restore single-column foreign key indexes and drop composite indexes
+CREATE INDEX IF NOT EXISTS idx_orders_event ON orders(event_id);
+DROP INDEX IF EXISTS idx_orders_event_status;
+
+CREATE INDEX IF NOT EXISTS idx_orders_user ON orders(user_id);
+DROP INDEX IF EXISTS idx_orders_user_created_at;
+
+CREATE INDEX IF NOT EXISTS idx_events_venue ON events(venue_id);
+DROP INDEX IF EXISTS idx_events_venue_status;
+
+CREATE INDEX IF NOT EXISTS idx_events_organizer ON events(organizer_id);
+DROP INDEX IF EXISTS idx_events_organizer_date;
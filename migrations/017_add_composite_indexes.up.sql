@@ -0,0 +1,18 @@
+-- Replace single-column foreign key indexes with composite indexes matching repository queries
+-- Each composite index starts with the old column, so lookups by that column alone still use it.
+
+-- Organizer event lists, ordered by date
+CREATE INDEX IF NOT EXISTS idx_events_organizer_date ON events(organizer_id, event_date, id);
+DROP INDEX IF EXISTS idx_events_organizer;
+
+-- Venue event lists, optionally filtered by status
+CREATE INDEX IF NOT EXISTS idx_events_venue_status ON events(venue_id, status);
+DROP INDEX IF EXISTS idx_events_venue;
+
+-- Order history of a user, newest first
+CREATE INDEX IF NOT EXISTS idx_orders_user_created_at ON orders(user_id, created_at, id);
+DROP INDEX IF EXISTS idx_orders_user;
+
+-- Orders of an event, and its ticket holders (completed orders)
+CREATE INDEX IF NOT EXISTS idx_orders_event_status ON orders(event_id, status);
+DROP INDEX IF EXISTS idx_orders_event;
@@ -0,0 +1,5 @@
+-- Drop attendee questions, order notes and answers
+ALTER TABLE orders DROP COLUMN IF EXISTS answers;
+ALTER TABLE orders DROP COLUMN IF EXISTS notes;
+
+ALTER TABLE events DROP COLUMN IF EXISTS attendee_questions;
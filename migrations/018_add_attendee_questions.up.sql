@@ -0,0 +1,7 @@
+-- Add custom attendee questions to events, and notes and answers to orders
+-- Questions are a JSON array of {key, label, type, required, options, max_length};
+-- answers are a JSON object keyed by question key.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS attendee_questions JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS notes TEXT;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS answers JSONB;
@@ -0,0 +1,5 @@
+-- Drop invoice numbers
+ALTER TABLE orders DROP COLUMN IF EXISTS invoice_issued_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS invoice_number;
+
+DROP SEQUENCE IF EXISTS invoice_number_seq;
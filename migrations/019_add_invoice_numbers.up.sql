@@ -0,0 +1,6 @@
+-- Sequential invoice numbers for orders
+-- Numbers are drawn from a sequence the first time an order's invoice is requested.
+CREATE SEQUENCE IF NOT EXISTS invoice_number_seq START WITH 1;
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS invoice_number BIGINT UNIQUE;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS invoice_issued_at TIMESTAMP;
@@ -0,0 +1,7 @@
+-- Drop data exports and scheduled deletion
+DROP INDEX IF EXISTS idx_users_deletion_due;
+
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE users DROP COLUMN IF EXISTS deletion_scheduled_at;
+
+DROP TABLE IF EXISTS data_exports;
@@ -0,0 +1,18 @@
+-- Create data_exports table and add scheduled deletion to users
+-- Users download a ZIP of their personal data once its export is READY. A
+-- requested deletion runs when deletion_scheduled_at passes unless cancelled;
+-- the user row is then kept as an anonymous tombstone marked by deleted_at.
+CREATE TABLE IF NOT EXISTS data_exports (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'READY', 'FAILED')),
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deletion_scheduled_at TIMESTAMP;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_data_exports_user_created_at ON data_exports(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_users_deletion_due ON users(deletion_scheduled_at) WHERE deletion_scheduled_at IS NOT NULL AND deleted_at IS NULL;
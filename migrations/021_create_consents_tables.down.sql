@@ -0,0 +1,3 @@
+-- Drop user_consents and policy_versions tables
+DROP TABLE IF EXISTS user_consents;
+DROP TABLE IF EXISTS policy_versions;
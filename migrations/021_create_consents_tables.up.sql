@@ -0,0 +1,23 @@
+-- Create policy_versions and user_consents tables
+-- Admins publish versions of the terms of service and privacy policy; the
+-- newest of each type is current. Users accept versions at registration,
+-- on login or through the API, and purchases require the current terms.
+CREATE TABLE IF NOT EXISTS policy_versions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    type VARCHAR(20) NOT NULL CHECK (type IN ('TERMS', 'PRIVACY')),
+    version VARCHAR(50) NOT NULL,
+    url VARCHAR(500) NOT NULL,
+    published_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (type, version)
+);
+
+CREATE TABLE IF NOT EXISTS user_consents (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    version_id UUID NOT NULL REFERENCES policy_versions(id) ON DELETE CASCADE,
+    accepted_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, version_id)
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_policy_versions_type_published_at ON policy_versions(type, published_at DESC);
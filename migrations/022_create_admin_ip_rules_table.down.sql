@@ -0,0 +1,2 @@
+-- Drop admin_ip_rules table
+DROP TABLE IF EXISTS admin_ip_rules;
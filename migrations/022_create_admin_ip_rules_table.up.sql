@@ -0,0 +1,12 @@
+-- Create admin_ip_rules table
+-- Address ranges allowed or denied access to /api/v1/admin routes, managed by
+-- admins through the API. Ranges from configuration apply as well; deny rules
+-- win, and once any allow rule exists only matching addresses are let through.
+CREATE TABLE IF NOT EXISTS admin_ip_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    cidr VARCHAR(50) NOT NULL UNIQUE,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('ALLOW', 'DENY')),
+    description VARCHAR(255),
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
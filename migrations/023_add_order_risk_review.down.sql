@@ -0,0 +1,13 @@
+-- Drop order risk columns and the REVIEW status
+ALTER TABLE orders DROP COLUMN IF EXISTS country;
+ALTER TABLE orders DROP COLUMN IF EXISTS risk_reasons;
+ALTER TABLE orders DROP COLUMN IF EXISTS risk_score;
+
+-- Held orders can't be represented any more; fail them and return their tickets
+UPDATE events SET available_tickets = available_tickets + held.quantity
+FROM (SELECT event_id, SUM(quantity) AS quantity FROM orders WHERE status = 'REVIEW' GROUP BY event_id) held
+WHERE events.id = held.event_id;
+UPDATE orders SET status = 'FAILED' WHERE status = 'REVIEW';
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check CHECK (status IN ('PENDING', 'COMPLETED', 'FAILED'));
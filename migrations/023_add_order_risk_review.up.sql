@@ -0,0 +1,9 @@
+-- Add risk scoring to orders and a REVIEW status for orders held by fraud checks
+-- Orders in review keep their tickets reserved until an admin approves them
+-- (back to PENDING) or rejects them (FAILED, tickets returned).
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check CHECK (status IN ('PENDING', 'COMPLETED', 'FAILED', 'REVIEW'));
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS risk_score INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS risk_reasons JSONB;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS country VARCHAR(2);
@@ -0,0 +1,4 @@
+-- Restore case-sensitive email uniqueness
+-- Emails stay lowercased and merged accounts stay retired.
+DROP INDEX IF EXISTS users_email_lower_key;
+ALTER TABLE users ADD CONSTRAINT users_email_key UNIQUE (email);
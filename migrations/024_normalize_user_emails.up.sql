@@ -0,0 +1,36 @@
+-- Compare user emails case-insensitively
+-- Emails are stored trimmed and lowercased and are unique regardless of case.
+-- Accounts whose emails only differ in case or spacing are merged into the
+-- oldest one: it takes over their orders, tickets, organized events and roles.
+-- The newer accounts are retired as tombstones, like deleted accounts, so they
+-- can no longer sign in; their notifications, devices and consents stay there.
+CREATE TEMPORARY TABLE user_email_merges AS
+SELECT id AS duplicate_id, keep_id
+FROM (
+    SELECT id, FIRST_VALUE(id) OVER (PARTITION BY LOWER(TRIM(email)) ORDER BY created_at, id) AS keep_id
+    FROM users
+    WHERE deleted_at IS NULL
+) ranked
+WHERE id <> keep_id;
+
+UPDATE orders SET user_id = m.keep_id FROM user_email_merges m WHERE orders.user_id = m.duplicate_id;
+UPDATE tickets SET user_id = m.keep_id FROM user_email_merges m WHERE tickets.user_id = m.duplicate_id;
+UPDATE events SET organizer_id = m.keep_id FROM user_email_merges m WHERE events.organizer_id = m.duplicate_id;
+
+INSERT INTO user_roles (user_id, role_id)
+SELECT m.keep_id, ur.role_id
+FROM user_roles ur
+JOIN user_email_merges m ON ur.user_id = m.duplicate_id
+ON CONFLICT DO NOTHING;
+
+UPDATE users SET email = 'merged-' || users.id || '@merged.invalid', username = 'merged-' || users.id,
+    password = '!', phone = NULL, phone_verified_at = NULL, deleted_at = NOW(), updated_at = NOW()
+FROM user_email_merges m
+WHERE users.id = m.duplicate_id;
+
+DROP TABLE user_email_merges;
+
+UPDATE users SET email = LOWER(TRIM(email)) WHERE email <> LOWER(TRIM(email));
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_email_key;
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_lower_key ON users(LOWER(email));
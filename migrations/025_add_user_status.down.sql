@@ -0,0 +1,6 @@
+-- Drop user_status_changes table and the account status of users
+DROP TABLE IF EXISTS user_status_changes;
+
+ALTER TABLE users DROP COLUMN IF EXISTS status_changed_at;
+ALTER TABLE users DROP COLUMN IF EXISTS status_reason;
+ALTER TABLE users DROP COLUMN IF EXISTS status;
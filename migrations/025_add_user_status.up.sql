@@ -0,0 +1,20 @@
+-- Add an account status to users and create user_status_changes table
+-- Suspended and banned users are refused at login and on every authenticated
+-- request. Each change is recorded with its reason; changed_by is NULL for
+-- automatic suspensions by the fraud checks.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE'
+    CHECK (status IN ('ACTIVE', 'SUSPENDED', 'BANNED'));
+ALTER TABLE users ADD COLUMN IF NOT EXISTS status_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS user_status_changes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('ACTIVE', 'SUSPENDED', 'BANNED')),
+    reason TEXT NOT NULL DEFAULT '',
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_user_status_changes_user_created_at ON user_status_changes(user_id, created_at DESC);
@@ -0,0 +1,6 @@
+-- Drop analytics snapshot tables and their indexes
+DROP INDEX IF EXISTS idx_users_created_at;
+DROP INDEX IF EXISTS idx_orders_created_at;
+
+DROP TABLE IF EXISTS event_daily_sales;
+DROP TABLE IF EXISTS daily_snapshots;
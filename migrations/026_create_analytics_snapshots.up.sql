@@ -0,0 +1,29 @@
+-- Create daily_snapshots and event_daily_sales tables
+-- A snapshot job aggregates each finished UTC day once so trend queries read
+-- a row per day instead of scanning orders. organizer_id is copied from the
+-- event so organizer trends don't need to join events.
+CREATE TABLE IF NOT EXISTS daily_snapshots (
+    day DATE PRIMARY KEY,
+    orders INTEGER NOT NULL DEFAULT 0,
+    tickets_sold INTEGER NOT NULL DEFAULT 0,
+    revenue DECIMAL(12,2) NOT NULL DEFAULT 0,
+    active_events INTEGER NOT NULL DEFAULT 0,
+    new_users INTEGER NOT NULL DEFAULT 0,
+    taken_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS event_daily_sales (
+    day DATE NOT NULL,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    organizer_id UUID NOT NULL,
+    orders INTEGER NOT NULL DEFAULT 0,
+    tickets_sold INTEGER NOT NULL DEFAULT 0,
+    revenue DECIMAL(12,2) NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, event_id)
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_event_daily_sales_organizer_day ON event_daily_sales(organizer_id, day);
+-- Snapshots and backfills read one day of orders and users at a time
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON orders(created_at);
+CREATE INDEX IF NOT EXISTS idx_users_created_at ON users(created_at);
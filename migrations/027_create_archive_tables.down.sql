@@ -0,0 +1,14 @@
+-- Move archived events and orders back and drop the archive tables
+-- Without archived_at the archive tables match events and orders column for column.
+ALTER TABLE archived_events DROP COLUMN archived_at;
+ALTER TABLE archived_orders DROP COLUMN archived_at;
+
+INSERT INTO events SELECT * FROM archived_events;
+INSERT INTO orders SELECT * FROM archived_orders;
+
+DROP TABLE IF EXISTS archived_orders;
+DROP TABLE IF EXISTS archived_events;
+
+-- Sales of events deleted in the meantime may be left, so existing rows aren't checked
+ALTER TABLE event_daily_sales ADD CONSTRAINT event_daily_sales_event_id_fkey
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE NOT VALID;
@@ -0,0 +1,28 @@
+-- Create archived_events and archived_orders tables
+-- Events that took place long ago are moved here with their orders, unchanged, so the
+-- live tables stay small. The tables copy the columns of events and orders in order and
+-- append archived_at; rows are moved with SELECT *, so a migration adding a column to
+-- events or orders must add it to the archive table too.
+CREATE TABLE IF NOT EXISTS archived_events (
+    LIKE events INCLUDING DEFAULTS INCLUDING CONSTRAINTS,
+    archived_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    FOREIGN KEY (organizer_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS archived_orders (
+    LIKE orders INCLUDING DEFAULTS INCLUDING CONSTRAINTS,
+    archived_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (event_id) REFERENCES archived_events(id) ON DELETE CASCADE
+);
+
+-- Create indexes for better query performance
+-- Order history reads both tables by buyer, newest first
+CREATE INDEX IF NOT EXISTS idx_archived_orders_user_created_at ON archived_orders(user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_archived_orders_event ON archived_orders(event_id);
+CREATE INDEX IF NOT EXISTS idx_archived_events_organizer ON archived_events(organizer_id);
+
+-- Sales snapshots outlive the events they describe
+ALTER TABLE event_daily_sales DROP CONSTRAINT IF EXISTS event_daily_sales_event_id_fkey;
@@ -0,0 +1,24 @@
+-- Turn orders back into a plain table
+CREATE TABLE orders_unpartitioned (LIKE orders INCLUDING DEFAULTS INCLUDING CONSTRAINTS);
+INSERT INTO orders_unpartitioned SELECT * FROM orders;
+
+DROP TABLE orders CASCADE;
+ALTER TABLE orders_unpartitioned RENAME TO orders;
+
+ALTER TABLE orders ALTER COLUMN created_at DROP NOT NULL;
+ALTER TABLE orders ADD CONSTRAINT orders_pkey PRIMARY KEY (id);
+ALTER TABLE orders ADD CONSTRAINT orders_invoice_number_key UNIQUE (invoice_number);
+ALTER TABLE orders ADD CONSTRAINT orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE orders ADD CONSTRAINT orders_event_id_fkey FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+-- Tickets and notifications of orders deleted in the meantime may be left, so existing rows aren't checked
+ALTER TABLE tickets ADD CONSTRAINT tickets_order_id_fkey
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE NOT VALID;
+ALTER TABLE notifications ADD CONSTRAINT notifications_order_id_fkey
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE SET NULL NOT VALID;
+
+CREATE INDEX IF NOT EXISTS idx_orders_user_created_at ON orders(user_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_orders_event_status ON orders(event_id, status);
+CREATE INDEX IF NOT EXISTS idx_orders_status_created_at ON orders(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_orders_status ON orders(status);
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON orders(created_at);
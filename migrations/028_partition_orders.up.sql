@@ -0,0 +1,47 @@
+-- Partition orders by month of created_at
+-- Time-bounded queries (reports, analytics snapshots, order expiry) then only read the
+-- partitions of their period. Monthly partitions are named orders_pYYYYMM; the partition
+-- maintainer creates them a few months ahead, and orders_pdefault catches anything outside
+-- them so inserts never fail. The default partition should stay empty: a new partition
+-- can't be created over a range the default already holds rows for.
+--
+-- A primary key or unique constraint on a partitioned table must include the partition
+-- key, so the primary key becomes (id, created_at) and invoice numbers are no longer
+-- enforced unique; invoice_number_seq hands out every number once. Foreign keys must
+-- point at a unique constraint, so tickets and notifications no longer reference orders.
+-- Orders without a creation time can't be placed in a partition
+UPDATE orders SET created_at = NOW() WHERE created_at IS NULL;
+
+CREATE TABLE orders_partitioned (LIKE orders INCLUDING DEFAULTS INCLUDING CONSTRAINTS)
+    PARTITION BY RANGE (created_at);
+ALTER TABLE orders_partitioned ALTER COLUMN created_at SET NOT NULL;
+
+CREATE TABLE orders_pdefault PARTITION OF orders_partitioned DEFAULT;
+
+DO $$
+DECLARE
+    month_start DATE := date_trunc('month', COALESCE((SELECT MIN(created_at) FROM orders), NOW()));
+    last_month DATE := date_trunc('month', NOW()) + INTERVAL '3 months';
+BEGIN
+    WHILE month_start <= last_month LOOP
+        EXECUTE format('CREATE TABLE %I PARTITION OF orders_partitioned FOR VALUES FROM (%L) TO (%L)',
+            'orders_p' || to_char(month_start, 'YYYYMM'), month_start, month_start + INTERVAL '1 month');
+        month_start := month_start + INTERVAL '1 month';
+    END LOOP;
+END $$;
+
+INSERT INTO orders_partitioned SELECT * FROM orders;
+
+DROP TABLE orders CASCADE;
+ALTER TABLE orders_partitioned RENAME TO orders;
+
+ALTER TABLE orders ADD CONSTRAINT orders_pkey PRIMARY KEY (id, created_at);
+ALTER TABLE orders ADD CONSTRAINT orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE orders ADD CONSTRAINT orders_event_id_fkey FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+-- Recreate indexes; each is created on every partition
+CREATE INDEX IF NOT EXISTS idx_orders_user_created_at ON orders(user_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_orders_event_status ON orders(event_id, status);
+CREATE INDEX IF NOT EXISTS idx_orders_status_created_at ON orders(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON orders(created_at);
+CREATE INDEX IF NOT EXISTS idx_orders_invoice_number ON orders(invoice_number);
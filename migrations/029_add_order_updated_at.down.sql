@@ -0,0 +1,4 @@
+-- Remove updated_at from orders
+-- archived_at is already the last column of archived_orders, so dropping updated_at keeps the tables aligned
+ALTER TABLE archived_orders DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS updated_at;
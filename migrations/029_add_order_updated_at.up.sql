@@ -0,0 +1,19 @@
+-- Add updated_at to orders
+-- Status polling answers {status, updated_at} and derives its ETag from them, so every
+-- change to an order must move updated_at. Existing orders start at their creation time.
+ALTER TABLE orders ADD COLUMN updated_at TIMESTAMP;
+UPDATE orders SET updated_at = created_at;
+ALTER TABLE orders ALTER COLUMN updated_at SET DEFAULT NOW();
+ALTER TABLE orders ALTER COLUMN updated_at SET NOT NULL;
+
+-- Orders are archived with SELECT orders.*, so archived_orders must keep the columns of
+-- orders in order followed by archived_at: add updated_at, then move archived_at behind it
+ALTER TABLE archived_orders ADD COLUMN updated_at TIMESTAMP;
+UPDATE archived_orders SET updated_at = COALESCE(created_at, archived_at);
+ALTER TABLE archived_orders ALTER COLUMN updated_at SET DEFAULT NOW();
+ALTER TABLE archived_orders ALTER COLUMN updated_at SET NOT NULL;
+
+ALTER TABLE archived_orders RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE archived_orders ADD COLUMN archived_at TIMESTAMP NOT NULL DEFAULT NOW();
+UPDATE archived_orders SET archived_at = archived_at_old;
+ALTER TABLE archived_orders DROP COLUMN archived_at_old;
@@ -0,0 +1,2 @@
+-- Drop event_daily_views table
+DROP TABLE IF EXISTS event_daily_views;
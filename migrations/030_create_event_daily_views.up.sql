@@ -0,0 +1,15 @@
+-- Create event_daily_views table
+-- Event page views are counted in Redis during the day and moved here once
+-- the day has ended. Only totals are stored: unique visitors are estimated
+-- from salted hashes and no user ID or IP address reaches this table.
+CREATE TABLE IF NOT EXISTS event_daily_views (
+    day DATE NOT NULL,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    organizer_id UUID NOT NULL,
+    views INTEGER NOT NULL DEFAULT 0,
+    unique_visitors INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, event_id)
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_event_daily_views_organizer_day ON event_daily_views(organizer_id, day);
@@ -0,0 +1,3 @@
+-- Drop refund_audit_log and refund_requests tables
+DROP TABLE IF EXISTS refund_audit_log;
+DROP TABLE IF EXISTS refund_requests;
@@ -0,0 +1,41 @@
+-- Create refund_requests and refund_audit_log tables
+-- Buyers open a refund request or dispute on a completed order, and the event
+-- organizer approves or denies it with a reason. Approved refunds are sent to the
+-- payment provider. An order has at most one request that wasn't denied, so it
+-- can't be refunded twice. order_id has no foreign key: orders are partitioned
+-- and their primary key includes created_at.
+CREATE TABLE IF NOT EXISTS refund_requests (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('REFUND', 'DISPUTE')),
+    status VARCHAR(20) NOT NULL DEFAULT 'OPEN'
+        CHECK (status IN ('OPEN', 'APPROVED', 'REFUNDED', 'REFUND_FAILED', 'DENIED')),
+    reason TEXT NOT NULL,
+    order_amount DECIMAL(10,2) NOT NULL,
+    amount DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (amount >= 0 AND amount <= order_amount),
+    decision TEXT NOT NULL DEFAULT '',
+    decided_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    decided_at TIMESTAMP,
+    provider_reference VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Every change of a request, with who made it and why; actor_id is NULL for
+-- the payment provider's outcome
+CREATE TABLE IF NOT EXISTS refund_audit_log (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    request_id UUID NOT NULL REFERENCES refund_requests(id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Create indexes for better query performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refund_requests_order_live ON refund_requests(order_id) WHERE status <> 'DENIED';
+CREATE INDEX IF NOT EXISTS idx_refund_requests_event_status ON refund_requests(event_id, status, created_at);
+CREATE INDEX IF NOT EXISTS idx_refund_audit_log_request_created_at ON refund_audit_log(request_id, created_at);
@@ -0,0 +1,2 @@
+-- Drop order_messages table
+DROP TABLE IF EXISTS order_messages;
@@ -0,0 +1,21 @@
+-- Create order_messages table
+-- Each order has a message thread between its buyer and the event organizer,
+-- so support questions stay on the platform. Every message has one recipient,
+-- the other participant, and read_at is set when they open the thread.
+-- order_id has no foreign key: orders are partitioned and their primary key
+-- includes created_at.
+CREATE TABLE IF NOT EXISTS order_messages (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    sender_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    recipient_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    sender_role VARCHAR(20) NOT NULL CHECK (sender_role IN ('BUYER', 'ORGANIZER')),
+    body TEXT NOT NULL,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_order_messages_order_created_at ON order_messages(order_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_order_messages_unread ON order_messages(recipient_id, order_id) WHERE read_at IS NULL;
@@ -0,0 +1,7 @@
+-- Remove moderation status from events
+-- archived_at is already the last column of archived_events, so dropping the columns keeps the tables aligned
+ALTER TABLE archived_events DROP COLUMN IF EXISTS moderation_reason;
+ALTER TABLE archived_events DROP COLUMN IF EXISTS moderation_status;
+DROP INDEX IF EXISTS idx_events_flagged;
+ALTER TABLE events DROP COLUMN IF EXISTS moderation_reason;
+ALTER TABLE events DROP COLUMN IF EXISTS moderation_status;
@@ -0,0 +1,20 @@
+-- Add moderation status to events
+-- Events whose title or description a moderator flagged stay out of public listings
+-- until an admin approves them. Existing events were published before moderation.
+ALTER TABLE events ADD COLUMN moderation_status VARCHAR(20) NOT NULL DEFAULT 'APPROVED'
+    CHECK (moderation_status IN ('APPROVED', 'FLAGGED'));
+ALTER TABLE events ADD COLUMN moderation_reason VARCHAR(255) NOT NULL DEFAULT '';
+
+-- Admins review the flagged events
+CREATE INDEX IF NOT EXISTS idx_events_flagged ON events(created_at) WHERE moderation_status = 'FLAGGED';
+
+-- Events are archived with SELECT events.*, so archived_events must keep the columns of
+-- events in order followed by archived_at: add the new columns, then move archived_at behind them
+ALTER TABLE archived_events ADD COLUMN moderation_status VARCHAR(20) NOT NULL DEFAULT 'APPROVED'
+    CHECK (moderation_status IN ('APPROVED', 'FLAGGED'));
+ALTER TABLE archived_events ADD COLUMN moderation_reason VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE archived_events RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE archived_events ADD COLUMN archived_at TIMESTAMP NOT NULL DEFAULT NOW();
+UPDATE archived_events SET archived_at = archived_at_old;
+ALTER TABLE archived_events DROP COLUMN archived_at_old;
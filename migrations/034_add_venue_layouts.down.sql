@@ -0,0 +1,5 @@
+-- Remove capacity layouts
+-- archived_at is already the last column of archived_events, so dropping layout keeps the tables aligned
+ALTER TABLE archived_events DROP COLUMN IF EXISTS layout;
+ALTER TABLE events DROP COLUMN IF EXISTS layout;
+ALTER TABLE venues DROP COLUMN IF EXISTS layouts;
@@ -0,0 +1,15 @@
+-- Add capacity layouts to venues and the chosen layout to events
+-- A venue can be set up in several ways, e.g. 1500 standing for concerts or 800 seated
+-- for shows. Events with a layout are checked against its capacity instead of the venue's;
+-- events without one, including every existing event, use the whole venue.
+ALTER TABLE venues ADD COLUMN layouts JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE events ADD COLUMN layout VARCHAR(50) NOT NULL DEFAULT '';
+
+-- Events are archived with SELECT events.*, so archived_events must keep the columns of
+-- events in order followed by archived_at: add layout, then move archived_at behind it
+ALTER TABLE archived_events ADD COLUMN layout VARCHAR(50) NOT NULL DEFAULT '';
+
+ALTER TABLE archived_events RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE archived_events ADD COLUMN archived_at TIMESTAMP NOT NULL DEFAULT NOW();
+UPDATE archived_events SET archived_at = archived_at_old;
+ALTER TABLE archived_events DROP COLUMN archived_at_old;
@@ -0,0 +1,3 @@
+-- Remove venue owners
+DROP INDEX IF EXISTS idx_venues_owner_id;
+ALTER TABLE venues DROP COLUMN IF EXISTS owner_id;
@@ -0,0 +1,6 @@
+-- Add owners to venues
+-- Only the owner or an admin may update, delete or transfer a venue. Existing venues have no
+-- owner and stay admin-only until an admin transfers them to an organizer.
+ALTER TABLE venues ADD COLUMN owner_id UUID REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_venues_owner_id ON venues(owner_id);
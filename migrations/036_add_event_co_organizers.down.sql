@@ -0,0 +1,4 @@
+-- Remove co-organizers
+DELETE FROM event_staff WHERE role = 'CO_ORGANIZER';
+ALTER TABLE event_staff DROP CONSTRAINT IF EXISTS event_staff_role_check;
+ALTER TABLE event_staff ADD CONSTRAINT event_staff_role_check CHECK (role IN ('CHECK_IN', 'VIEWER'));
@@ -0,0 +1,5 @@
+-- Allow co-organizers on the event staff
+-- Co-organizers are invited and accept like other staff. Accepted co-organizers may update and
+-- cancel the event, check in and view its statistics; only the organizer deletes it.
+ALTER TABLE event_staff DROP CONSTRAINT IF EXISTS event_staff_role_check;
+ALTER TABLE event_staff ADD CONSTRAINT event_staff_role_check CHECK (role IN ('CHECK_IN', 'VIEWER', 'CO_ORGANIZER'));
@@ -0,0 +1,3 @@
+-- Drop event_transfer_audit_log and event_transfers tables
+DROP TABLE IF EXISTS event_transfer_audit_log;
+DROP TABLE IF EXISTS event_transfers;
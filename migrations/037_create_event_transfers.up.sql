@@ -0,0 +1,34 @@
+-- Create event_transfers and event_transfer_audit_log tables
+-- An organizer offers an event to another organizer, who takes it over by
+-- accepting. An event has at most one pending transfer. event_id has no foreign
+-- key so the ownership history outlives archiving, which moves events to
+-- archived_events.
+CREATE TABLE IF NOT EXISTS event_transfers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    event_id UUID NOT NULL,
+    from_organizer_id UUID NOT NULL,
+    to_organizer_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'ACCEPTED', 'DECLINED', 'CANCELLED')),
+    requested_by UUID NOT NULL,
+    responded_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    CHECK (from_organizer_id <> to_organizer_id)
+);
+
+-- Every change of a transfer, with who made it
+CREATE TABLE IF NOT EXISTS event_transfer_audit_log (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    transfer_id UUID NOT NULL REFERENCES event_transfers(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    actor_id UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Create indexes for better query performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_event_transfers_event_pending ON event_transfers(event_id) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_event_transfers_event_created_at ON event_transfers(event_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_event_transfers_to_status ON event_transfers(to_organizer_id, status);
+CREATE INDEX IF NOT EXISTS idx_event_transfer_audit_log_transfer_created_at ON event_transfer_audit_log(transfer_id, created_at);
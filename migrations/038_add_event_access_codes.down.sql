@@ -0,0 +1,6 @@
+-- Remove access codes
+DROP TABLE IF EXISTS event_access_code_redemptions;
+DROP TABLE IF EXISTS event_access_codes;
+-- archived_at is already the last column of archived_events, so dropping access_code_required keeps the tables aligned
+ALTER TABLE archived_events DROP COLUMN IF EXISTS access_code_required;
+ALTER TABLE events DROP COLUMN IF EXISTS access_code_required;
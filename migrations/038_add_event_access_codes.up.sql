@@ -0,0 +1,42 @@
+-- Add invitation-only ticket sales with access codes
+-- Orders for an event with access_code_required must carry one of the event's codes.
+-- A code may be limited to a number of uses; each order redeeming it counts as one use.
+ALTER TABLE events ADD COLUMN access_code_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Events are archived with SELECT events.*, so archived_events must keep the columns of
+-- events in order followed by archived_at: add access_code_required, then move archived_at behind it
+ALTER TABLE archived_events ADD COLUMN access_code_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE archived_events RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE archived_events ADD COLUMN archived_at TIMESTAMP NOT NULL DEFAULT NOW();
+UPDATE archived_events SET archived_at = archived_at_old;
+ALTER TABLE archived_events DROP COLUMN archived_at_old;
+
+-- Codes are stored upper-case and are unique per event
+CREATE TABLE IF NOT EXISTS event_access_codes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    code VARCHAR(32) NOT NULL,
+    max_uses INTEGER NOT NULL DEFAULT 0 CHECK (max_uses >= 0),
+    uses INTEGER NOT NULL DEFAULT 0 CHECK (uses >= 0),
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (event_id, code),
+    CHECK (max_uses = 0 OR uses <= max_uses)
+);
+
+-- Who redeemed a code and for which order. order_id has no foreign key: orders are
+-- partitioned and their primary key includes created_at.
+CREATE TABLE IF NOT EXISTS event_access_code_redemptions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    access_code_id UUID NOT NULL REFERENCES event_access_codes(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    order_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_event_access_code_redemptions_code_created_at ON event_access_code_redemptions(access_code_id, created_at);
@@ -0,0 +1,9 @@
+-- Remove sale windows
+-- archived_at is already the last column of archived_events, so dropping the sale window keeps the tables aligned
+ALTER TABLE archived_events DROP COLUMN IF EXISTS on_sale;
+ALTER TABLE archived_events DROP COLUMN IF EXISTS sale_end;
+ALTER TABLE archived_events DROP COLUMN IF EXISTS sale_start;
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_sale_window_check;
+ALTER TABLE events DROP COLUMN IF EXISTS on_sale;
+ALTER TABLE events DROP COLUMN IF EXISTS sale_end;
+ALTER TABLE events DROP COLUMN IF EXISTS sale_start;
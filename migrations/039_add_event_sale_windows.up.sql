@@ -0,0 +1,19 @@
+-- Add sale windows to events
+-- Orders are refused before sale_start and from sale_end on; either may be left open.
+-- on_sale caches whether the window is open for listings and is refreshed by the
+-- on-sale scheduler. Existing events have no window, so they are on sale.
+ALTER TABLE events ADD COLUMN sale_start TIMESTAMP;
+ALTER TABLE events ADD COLUMN sale_end TIMESTAMP;
+ALTER TABLE events ADD COLUMN on_sale BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE events ADD CONSTRAINT events_sale_window_check CHECK (sale_end > sale_start);
+
+-- Events are archived with SELECT events.*, so archived_events must keep the columns of
+-- events in order followed by archived_at: add the sale window, then move archived_at behind it
+ALTER TABLE archived_events ADD COLUMN sale_start TIMESTAMP;
+ALTER TABLE archived_events ADD COLUMN sale_end TIMESTAMP;
+ALTER TABLE archived_events ADD COLUMN on_sale BOOLEAN NOT NULL DEFAULT TRUE;
+
+ALTER TABLE archived_events RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE archived_events ADD COLUMN archived_at TIMESTAMP NOT NULL DEFAULT NOW();
+UPDATE archived_events SET archived_at = archived_at_old;
+ALTER TABLE archived_events DROP COLUMN archived_at_old;
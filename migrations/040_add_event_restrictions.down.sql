@@ -0,0 +1,8 @@
+-- Remove event restrictions and dates of birth
+-- archived_at is already the last column of archived_events, so dropping the restrictions keeps the tables aligned
+ALTER TABLE users DROP COLUMN IF EXISTS date_of_birth;
+ALTER TABLE archived_events DROP COLUMN IF EXISTS content_warnings;
+ALTER TABLE archived_events DROP COLUMN IF EXISTS minimum_age;
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_minimum_age_check;
+ALTER TABLE events DROP COLUMN IF EXISTS content_warnings;
+ALTER TABLE events DROP COLUMN IF EXISTS minimum_age;
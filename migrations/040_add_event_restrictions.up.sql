@@ -0,0 +1,18 @@
+-- Add age limits and content warnings to events, and dates of birth to users
+-- Orders for events with a minimum_age need the buyer's date of birth to show they are old enough.
+ALTER TABLE events ADD COLUMN minimum_age INT NOT NULL DEFAULT 0;
+ALTER TABLE events ADD COLUMN content_warnings JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE events ADD CONSTRAINT events_minimum_age_check CHECK (minimum_age >= 0);
+
+-- Events are archived with SELECT events.*, so archived_events must keep the columns of
+-- events in order followed by archived_at: add the restrictions, then move archived_at behind them
+ALTER TABLE archived_events ADD COLUMN minimum_age INT NOT NULL DEFAULT 0;
+ALTER TABLE archived_events ADD COLUMN content_warnings JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE archived_events RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE archived_events ADD COLUMN archived_at TIMESTAMP NOT NULL DEFAULT NOW();
+UPDATE archived_events SET archived_at = archived_at_old;
+ALTER TABLE archived_events DROP COLUMN archived_at_old;
+
+-- Optional; only needed to order tickets for age-restricted events
+ALTER TABLE users ADD COLUMN date_of_birth DATE;
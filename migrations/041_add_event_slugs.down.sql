@@ -0,0 +1,6 @@
+-- Remove event slugs
+-- archived_at is already the last column of archived_events, so dropping the slug keeps the tables aligned
+ALTER TABLE archived_events DROP COLUMN IF EXISTS slug;
+DROP TABLE IF EXISTS event_slugs;
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_slug_key;
+ALTER TABLE events DROP COLUMN IF EXISTS slug;
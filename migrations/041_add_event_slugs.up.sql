@@ -0,0 +1,25 @@
+-- Add readable slugs to events, and keep every slug an event has had so old links still find it
+-- Existing events get a slug from their title and ID; new slugs are made by the application.
+ALTER TABLE events ADD COLUMN slug VARCHAR(100);
+UPDATE events SET slug = COALESCE(NULLIF(LEFT(TRIM(BOTH '-' FROM LOWER(REGEXP_REPLACE(title, '[^a-zA-Z0-9]+', '-', 'g'))), 80), ''), 'event')
+    || '-' || SUBSTR(MD5(id::text), 1, 6);
+ALTER TABLE events ALTER COLUMN slug SET NOT NULL;
+ALTER TABLE events ADD CONSTRAINT events_slug_key UNIQUE (slug);
+
+-- A slug stays with its event after the title changes, so it is never handed to another event
+CREATE TABLE event_slugs (
+    slug VARCHAR(100) PRIMARY KEY,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_event_slugs_event_id ON event_slugs(event_id);
+INSERT INTO event_slugs (slug, event_id) SELECT slug, id FROM events;
+
+-- Events are archived with SELECT events.*, so archived_events must keep the columns of
+-- events in order followed by archived_at: add the slug, then move archived_at behind it
+ALTER TABLE archived_events ADD COLUMN slug VARCHAR(100) NOT NULL DEFAULT '';
+
+ALTER TABLE archived_events RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE archived_events ADD COLUMN archived_at TIMESTAMP NOT NULL DEFAULT NOW();
+UPDATE archived_events SET archived_at = archived_at_old;
+ALTER TABLE archived_events DROP COLUMN archived_at_old;
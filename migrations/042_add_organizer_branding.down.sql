@@ -0,0 +1,2 @@
+-- Remove organizer branding; uploaded logos stay in object storage under branding/
+DROP TABLE IF EXISTS organizer_brandings;
@@ -0,0 +1,15 @@
+-- Organizer branding shown on event pages and invoices
+-- Logos live in object storage under logo_key; an empty key means no logo.
+CREATE TABLE organizer_brandings (
+    organizer_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    primary_color VARCHAR(7) NOT NULL DEFAULT '',
+    accent_color VARCHAR(7) NOT NULL DEFAULT '',
+    logo_key VARCHAR(255) NOT NULL DEFAULT '',
+    logo_content_type VARCHAR(32) NOT NULL DEFAULT '',
+    logo_width INTEGER NOT NULL DEFAULT 0,
+    logo_height INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT organizer_brandings_primary_color_check CHECK (primary_color = '' OR primary_color ~ '^#[0-9a-f]{6}$'),
+    CONSTRAINT organizer_brandings_accent_color_check CHECK (accent_color = '' OR accent_color ~ '^#[0-9a-f]{6}$')
+);
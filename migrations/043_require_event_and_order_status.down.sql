@@ -0,0 +1,5 @@
+-- Allow missing event and order statuses again; the check constraints predate this migration and stay
+ALTER TABLE archived_orders ALTER COLUMN status DROP NOT NULL;
+ALTER TABLE archived_events ALTER COLUMN status DROP NOT NULL;
+ALTER TABLE orders ALTER COLUMN status DROP NOT NULL;
+ALTER TABLE events ALTER COLUMN status DROP NOT NULL;
@@ -0,0 +1,25 @@
+-- Require event and order statuses
+-- Statuses are typed in the application (event.Status, order.Status) and these check
+-- constraints list the same values; a change to either list needs a migration here too.
+-- The status columns were nullable and a NULL passes a CHECK, so fill them first: events
+-- with the column default, orders with FAILED since a NULL order never held tickets.
+UPDATE events SET status = 'ACTIVE' WHERE status IS NULL;
+ALTER TABLE events ALTER COLUMN status SET NOT NULL;
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_status_check;
+ALTER TABLE events ADD CONSTRAINT events_status_check CHECK (status IN ('ACTIVE', 'CANCELLED', 'COMPLETED'));
+
+UPDATE orders SET status = 'FAILED' WHERE status IS NULL;
+ALTER TABLE orders ALTER COLUMN status SET NOT NULL;
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check CHECK (status IN ('PENDING', 'COMPLETED', 'FAILED', 'REVIEW'));
+
+-- The archive tables copied the constraints of the live tables when they were created
+UPDATE archived_events SET status = 'ACTIVE' WHERE status IS NULL;
+ALTER TABLE archived_events ALTER COLUMN status SET NOT NULL;
+ALTER TABLE archived_events DROP CONSTRAINT IF EXISTS events_status_check;
+ALTER TABLE archived_events ADD CONSTRAINT events_status_check CHECK (status IN ('ACTIVE', 'CANCELLED', 'COMPLETED'));
+
+UPDATE archived_orders SET status = 'FAILED' WHERE status IS NULL;
+ALTER TABLE archived_orders ALTER COLUMN status SET NOT NULL;
+ALTER TABLE archived_orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE archived_orders ADD CONSTRAINT orders_status_check CHECK (status IN ('PENDING', 'COMPLETED', 'FAILED', 'REVIEW'));
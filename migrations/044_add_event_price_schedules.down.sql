@@ -0,0 +1,4 @@
+-- Remove price schedules
+-- archived_at is already the last column of archived_events, so dropping the schedule keeps the tables aligned
+ALTER TABLE archived_events DROP COLUMN IF EXISTS price_schedule;
+ALTER TABLE events DROP COLUMN IF EXISTS price_schedule;
@@ -0,0 +1,13 @@
+-- Add price schedules to events
+-- Each tier of the schedule sets the ticket price for orders placed between its from and to
+-- timestamps; outside every tier ticket_price applies. Existing events have no tiers.
+ALTER TABLE events ADD COLUMN price_schedule JSONB NOT NULL DEFAULT '[]';
+
+-- Events are archived with SELECT events.*, so archived_events must keep the columns of
+-- events in order followed by archived_at: add the schedule, then move archived_at behind it
+ALTER TABLE archived_events ADD COLUMN price_schedule JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE archived_events RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE archived_events ADD COLUMN archived_at TIMESTAMP NOT NULL DEFAULT NOW();
+UPDATE archived_events SET archived_at = archived_at_old;
+ALTER TABLE archived_events DROP COLUMN archived_at_old;
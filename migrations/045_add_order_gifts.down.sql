@@ -0,0 +1,15 @@
+-- Remove gifts from orders
+-- Claimed gifts stay with their recipients; only the record of who bought them is lost.
+-- archived_at is already the last column of archived_orders, so dropping the gift columns keeps the tables aligned
+DROP INDEX IF EXISTS idx_archived_orders_gifted_by;
+ALTER TABLE archived_orders DROP COLUMN IF EXISTS gift_claimed_at;
+ALTER TABLE archived_orders DROP COLUMN IF EXISTS gifted_by;
+ALTER TABLE archived_orders DROP COLUMN IF EXISTS gift_token_hash;
+ALTER TABLE archived_orders DROP COLUMN IF EXISTS gift_recipient;
+
+DROP INDEX IF EXISTS idx_orders_gifted_by;
+DROP INDEX IF EXISTS idx_orders_gift_token_hash;
+ALTER TABLE orders DROP COLUMN IF EXISTS gift_claimed_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS gifted_by;
+ALTER TABLE orders DROP COLUMN IF EXISTS gift_token_hash;
+ALTER TABLE orders DROP COLUMN IF EXISTS gift_recipient;
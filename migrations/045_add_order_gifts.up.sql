@@ -0,0 +1,27 @@
+-- Add gifts to orders
+-- A gift names the recipient's email; its claim link carries a token stored here only as a SHA-256
+-- hash. Claiming moves the order to the recipient and records the buyer in gifted_by.
+ALTER TABLE orders ADD COLUMN gift_recipient VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN gift_token_hash VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN gifted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE orders ADD COLUMN gift_claimed_at TIMESTAMP;
+
+-- Claim links look gifts up by token, and order history lists the claimed gifts a user bought.
+-- A unique index on partitioned orders would have to include created_at, so the token index is
+-- a plain one; tokens carry 256 random bits and don't collide.
+CREATE INDEX IF NOT EXISTS idx_orders_gift_token_hash ON orders(gift_token_hash) WHERE gift_token_hash <> '';
+CREATE INDEX IF NOT EXISTS idx_orders_gifted_by ON orders(gifted_by) WHERE gifted_by IS NOT NULL;
+
+-- Orders are archived with SELECT orders.*, so archived_orders must keep the columns of
+-- orders in order followed by archived_at: add the gift columns, then move archived_at behind them
+ALTER TABLE archived_orders ADD COLUMN gift_recipient VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE archived_orders ADD COLUMN gift_token_hash VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE archived_orders ADD COLUMN gifted_by UUID;
+ALTER TABLE archived_orders ADD COLUMN gift_claimed_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_archived_orders_gifted_by ON archived_orders(gifted_by) WHERE gifted_by IS NOT NULL;
+
+ALTER TABLE archived_orders RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE archived_orders ADD COLUMN archived_at TIMESTAMP NOT NULL DEFAULT NOW();
+UPDATE archived_orders SET archived_at = archived_at_old;
+ALTER TABLE archived_orders DROP COLUMN archived_at_old;
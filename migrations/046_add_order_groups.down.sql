@@ -0,0 +1,15 @@
+-- Remove group reservations
+-- Shares already taken stay with their members as ordinary orders; unpaid ones no longer expire at their hold.
+-- archived_at is already the last column of archived_orders, so dropping the group columns keeps the tables aligned
+ALTER TABLE archived_orders DROP COLUMN IF EXISTS share_token_hash;
+ALTER TABLE archived_orders DROP COLUMN IF EXISTS hold_until;
+ALTER TABLE archived_orders DROP COLUMN IF EXISTS group_id;
+
+DROP INDEX IF EXISTS idx_orders_status_hold_until;
+DROP INDEX IF EXISTS idx_orders_share_token_hash;
+DROP INDEX IF EXISTS idx_orders_group_id;
+ALTER TABLE orders DROP COLUMN IF EXISTS share_token_hash;
+ALTER TABLE orders DROP COLUMN IF EXISTS hold_until;
+ALTER TABLE orders DROP COLUMN IF EXISTS group_id;
+
+DROP TABLE IF EXISTS order_groups;
@@ -0,0 +1,35 @@
+-- Add group reservations
+-- A group's leader reserves tickets for several members at once. Each member's share is a pending
+-- order, the leader's until a member takes it with its payment link, whose token is stored here only
+-- as a SHA-256 hash. Shares not paid by hold_until are expired like any pending order.
+CREATE TABLE order_groups (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    leader_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    hold_until TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_order_groups_leader_id ON order_groups(leader_id);
+
+ALTER TABLE orders ADD COLUMN group_id UUID REFERENCES order_groups(id) ON DELETE CASCADE;
+ALTER TABLE orders ADD COLUMN hold_until TIMESTAMP;
+ALTER TABLE orders ADD COLUMN share_token_hash VARCHAR(64) NOT NULL DEFAULT '';
+
+-- Leaders list the shares of their group, payment links look shares up by token, and expiry
+-- finds pending shares by the end of their hold. Like gift tokens, share tokens can't be
+-- enforced unique on partitioned orders; they carry 256 random bits.
+CREATE INDEX IF NOT EXISTS idx_orders_group_id ON orders(group_id) WHERE group_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_orders_share_token_hash ON orders(share_token_hash) WHERE share_token_hash <> '';
+CREATE INDEX IF NOT EXISTS idx_orders_status_hold_until ON orders(status, hold_until) WHERE hold_until IS NOT NULL;
+
+-- Orders are archived with SELECT orders.*, so archived_orders must keep the columns of
+-- orders in order followed by archived_at: add the group columns, then move archived_at behind them.
+-- Archiving an event drops its groups, so archived shares keep their group_id without a reference.
+ALTER TABLE archived_orders ADD COLUMN group_id UUID;
+ALTER TABLE archived_orders ADD COLUMN hold_until TIMESTAMP;
+ALTER TABLE archived_orders ADD COLUMN share_token_hash VARCHAR(64) NOT NULL DEFAULT '';
+
+ALTER TABLE archived_orders RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE archived_orders ADD COLUMN archived_at TIMESTAMP NOT NULL DEFAULT NOW();
+UPDATE archived_orders SET archived_at = archived_at_old;
+ALTER TABLE archived_orders DROP COLUMN archived_at_old;
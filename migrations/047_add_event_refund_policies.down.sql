@@ -0,0 +1,5 @@
+-- Remove refund policies
+-- archived_at is already the last column of archived_events, so dropping the policy keeps the tables aligned
+ALTER TABLE refund_requests DROP COLUMN IF EXISTS refundable_amount;
+ALTER TABLE archived_events DROP COLUMN IF EXISTS refund_policy;
+ALTER TABLE events DROP COLUMN IF EXISTS refund_policy;
@@ -0,0 +1,21 @@
+-- Add refund policies to events
+-- Organizers choose how buyers may ask for refunds: FLEXIBLE leaves every request to them as
+-- before, NONE offers no refunds, DAYS_BEFORE refunds in full until a number of days before
+-- the event and SLIDING refunds a shrinking percentage. Existing events stay FLEXIBLE.
+ALTER TABLE events ADD COLUMN refund_policy JSONB NOT NULL DEFAULT '{"type": "FLEXIBLE"}';
+
+-- Refund requests record the share of the order the policy allowed when they were opened, the
+-- most organizers may refund. Requests opened before policies existed could claim the whole order.
+ALTER TABLE refund_requests ADD COLUMN refundable_amount DECIMAL(10,2) NOT NULL DEFAULT 0;
+UPDATE refund_requests SET refundable_amount = order_amount;
+ALTER TABLE refund_requests ADD CONSTRAINT refund_requests_refundable_amount_check
+    CHECK (refundable_amount >= 0 AND refundable_amount <= order_amount);
+
+-- Events are archived with SELECT events.*, so archived_events must keep the columns of
+-- events in order followed by archived_at: add the policy, then move archived_at behind it
+ALTER TABLE archived_events ADD COLUMN refund_policy JSONB NOT NULL DEFAULT '{"type": "FLEXIBLE"}';
+
+ALTER TABLE archived_events RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE archived_events ADD COLUMN archived_at TIMESTAMP NOT NULL DEFAULT NOW();
+UPDATE archived_events SET archived_at = archived_at_old;
+ALTER TABLE archived_events DROP COLUMN archived_at_old;
@@ -0,0 +1,2 @@
+-- Drop event_inventory_adjustments table
+DROP TABLE IF EXISTS event_inventory_adjustments;
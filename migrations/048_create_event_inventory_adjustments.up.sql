@@ -0,0 +1,16 @@
+-- Create event_inventory_adjustments table
+-- Organizers add or remove tickets without a full event update, e.g. to hold seats back for
+-- production. Each adjustment records who made it, why, and the ticket counts it left behind;
+-- actor_id is NULL once that user is deleted.
+CREATE TABLE IF NOT EXISTS event_inventory_adjustments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    delta INTEGER NOT NULL CHECK (delta <> 0),
+    reason TEXT NOT NULL,
+    total_tickets INTEGER NOT NULL,
+    available_tickets INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_inventory_adjustments_event_created_at ON event_inventory_adjustments(event_id, created_at);
@@ -0,0 +1,3 @@
+-- Remove password algorithms
+-- Users whose password was rehashed with argon2id cannot sign in until their password is set again
+ALTER TABLE users DROP COLUMN IF EXISTS password_algorithm;
@@ -0,0 +1,4 @@
+-- Record the algorithm each password was hashed with
+-- New hashes use the configured algorithm, bcrypt or argon2id. Existing hashes are bcrypt and are
+-- replaced with the configured algorithm when their user next signs in.
+ALTER TABLE users ADD COLUMN password_algorithm VARCHAR(20) NOT NULL DEFAULT 'bcrypt';
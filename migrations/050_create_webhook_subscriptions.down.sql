@@ -0,0 +1,2 @@
+-- Drop webhook_subscriptions table
+DROP TABLE IF EXISTS webhook_subscriptions CASCADE;
@@ -0,0 +1,5 @@
+-- Drop audit_export_cursors table and the export order indexes
+DROP INDEX IF EXISTS idx_event_inventory_adjustments_created_at_id;
+DROP INDEX IF EXISTS idx_event_transfer_audit_log_created_at_id;
+DROP INDEX IF EXISTS idx_refund_audit_log_created_at_id;
+DROP TABLE IF EXISTS audit_export_cursors;
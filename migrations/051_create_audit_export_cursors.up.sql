@@ -0,0 +1,17 @@
+-- Create audit_export_cursors table
+-- The audit exporter ships refund, transfer and inventory audit entries to an external sink
+-- in (created_at, id) order. Each row is the last entry of one source the sink acknowledged;
+-- entries after it are sent again after a failure or restart, so delivery is at least once.
+CREATE TABLE IF NOT EXISTS audit_export_cursors (
+    sink VARCHAR(20) NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    entry_id UUID NOT NULL,
+    updated_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (sink, source)
+);
+
+-- Indexes to page through the audit trails in export order
+CREATE INDEX IF NOT EXISTS idx_refund_audit_log_created_at_id ON refund_audit_log(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_event_transfer_audit_log_created_at_id ON event_transfer_audit_log(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_event_inventory_adjustments_created_at_id ON event_inventory_adjustments(created_at, id);
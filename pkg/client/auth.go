@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+// User is a user account as the API returns it
+type User struct {
+	ID       uuid.UUID `json:"id"`
+	Email    string    `json:"email"`
+	Username string    `json:"username"`
+	Roles    []string  `json:"roles"`
+}
+
+// Policy is a legal policy the user hasn't accepted yet
+type Policy struct {
+	ID      uuid.UUID `json:"id"`
+	Type    string    `json:"type"`
+	Version string    `json:"version"`
+	URL     string    `json:"url"`
+}
+
+// LoginResponse is the answer to a successful sign-in
+type LoginResponse struct {
+	User      User   `json:"user"`
+	Token     string `json:"token"`
+	ExpiresAt int64  `json:"expires_at"` // Unix seconds
+
+	PendingPolicies []Policy `json:"pending_policies,omitempty"`
+}
+
+// RegisterRequest creates a user account
+type RegisterRequest struct {
+	Email       string `json:"email"`
+	Username    string `json:"username"`
+	Password    string `json:"password"`
+	AcceptTerms bool   `json:"accept_terms"`
+	GiftToken   string `json:"gift_token,omitempty"` // Claims the gift whose link led here
+
+	CaptchaToken string `json:"-"` // Sent as X-Captcha-Token when the API asked for a CAPTCHA
+}
+
+// Login signs in and makes the client authenticate with the returned token
+func (c *Client) Login(ctx context.Context, email, password string) (*LoginResponse, error) {
+	var resp LoginResponse
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/auth/login",
+		body:   map[string]string{"email": email, "password": password},
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	c.setToken(resp.Token, resp.ExpiresAt)
+	return &resp, nil
+}
+
+// Register creates a user account; it doesn't sign in
+func (c *Client) Register(ctx context.Context, req RegisterRequest) (*User, error) {
+	var created User
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/users",
+		body:   req,
+		header: captchaHeader(req.CaptchaToken),
+	}, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// Profile retrieves the signed-in user
+func (c *Client) Profile(ctx context.Context) (*User, error) {
+	var profile User
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/users/profile", auth: true}, &profile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// UsernameAvailable reports whether username is still free
+func (c *Client) UsernameAvailable(ctx context.Context, username string) (bool, error) {
+	var resp struct {
+		Available bool `json:"available"`
+	}
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/users/check-username",
+		query:  url.Values{"username": {username}},
+	}, &resp)
+	return resp.Available, err
+}
+
+// captchaHeader carries a CAPTCHA token, if there is one
+func captchaHeader(token string) http.Header {
+	if token == "" {
+		return nil
+	}
+	return http.Header{"X-Captcha-Token": {token}}
+}
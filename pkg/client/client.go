@@ -0,0 +1,378 @@
+// Package client is a Go client for the enterprise-crud API
+//
+// It covers authentication, events, venues and orders:
+//
+//	c := client.New("http://localhost:8080", client.WithCredentials("user@example.com", "password123"))
+//	events, err := c.ListEvents(ctx, client.EventFilter{})
+//
+// With credentials the client signs in on its first authenticated call and again shortly before
+// its token expires or when the API rejects it. Tokens the API refreshes after a role change are
+// adopted, with or without credentials. Idempotent requests (GET, PUT, DELETE) are retried
+// on network errors and on 429, 502, 503 and 504 answers; POST requests are sent once.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// apiPrefix is the path every endpoint lives under
+const apiPrefix = "/api/v1"
+
+// headerRefreshedToken carries a new token when the user's roles changed since theirs was issued
+const headerRefreshedToken = "X-Refreshed-Token"
+
+// tokenRefreshMargin is how long before its expiry a token is replaced
+const tokenRefreshMargin = time.Minute
+
+// Client calls the API; it is safe for concurrent use
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	userAgent  string
+
+	maxRetries int           // Retries of idempotent requests after the first attempt
+	backoff    time.Duration // Wait before the first retry, doubled for every further one
+
+	email    string // Credentials used to sign in again; empty with a fixed token
+	password string
+
+	loginMu   sync.Mutex // Serializes sign-ins so concurrent calls don't all replace the same stale token
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time // Zero when the expiry is unknown
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sends requests through hc instead of a client with a 30s timeout
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithCredentials signs in with email and password whenever a token is needed
+func WithCredentials(email, password string) Option {
+	return func(c *Client) {
+		c.email = email
+		c.password = password
+	}
+}
+
+// WithToken authenticates with a token obtained elsewhere
+// Without credentials the token is never refreshed.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithRetries retries idempotent requests up to maxRetries times, waiting backoff and then twice as long each time
+func WithRetries(maxRetries int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.backoff = backoff
+	}
+}
+
+// WithUserAgent sets the User-Agent header of every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// New creates a client for the API at baseURL, e.g. "http://localhost:8080"
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		userAgent:  "enterprise-crud-client",
+		maxRetries: 2,
+		backoff:    200 * time.Millisecond,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Token returns the token the client currently authenticates with, or "" before it signed in
+func (c *Client) Token() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.token
+}
+
+// request describes one API call
+type request struct {
+	method string
+	path   string // Below apiPrefix, e.g. "/events"
+	query  url.Values
+	body   interface{} // Encoded as JSON; nil sends no body
+	header http.Header
+	auth   bool // Send the bearer token, signing in first if needed
+}
+
+// do sends req and decodes a successful response into out, which may be nil
+func (c *Client) do(ctx context.Context, req request, out interface{}) error {
+	var body []byte
+	if req.body != nil {
+		var err error
+		if body, err = json.Marshal(req.body); err != nil {
+			return fmt.Errorf("encoding request body: %w", err)
+		}
+	}
+
+	var token string
+	if req.auth {
+		var err error
+		if token, err = c.validToken(ctx); err != nil {
+			return err
+		}
+	}
+
+	resp, err := c.send(ctx, req, body, token)
+	if err != nil {
+		return err
+	}
+
+	// A rejected token is replaced once, in case it was revoked or expired early
+	if resp.StatusCode == http.StatusUnauthorized && req.auth && c.email != "" {
+		resp.Body.Close()
+		if token, err = c.refreshToken(ctx, token); err != nil {
+			return err
+		}
+		if resp, err = c.send(ctx, req, body, token); err != nil {
+			return err
+		}
+	}
+	defer resp.Body.Close()
+	if refreshed := resp.Header.Get(headerRefreshedToken); refreshed != "" && req.auth {
+		c.adoptToken(token, refreshed)
+	}
+
+	if resp.StatusCode >= 300 {
+		return decodeError(resp)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding %s %s response: %w", req.method, req.path, err)
+	}
+	return nil
+}
+
+// send performs req, retrying idempotent requests on network errors and temporary failures
+func (c *Client) send(ctx context.Context, req request, body []byte, token string) (*http.Response, error) {
+	target := c.baseURL + apiPrefix + req.path
+	if len(req.query) > 0 {
+		target += "?" + req.query.Encode()
+	}
+
+	retries := 0
+	if idempotent(req.method) {
+		retries = c.maxRetries
+	}
+
+	wait := c.backoff
+	for attempt := 0; ; attempt++ {
+		httpReq, err := http.NewRequestWithContext(ctx, req.method, target, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		for key, values := range req.header {
+			httpReq.Header[key] = values
+		}
+		httpReq.Header.Set("Accept", "application/json")
+		httpReq.Header.Set("User-Agent", c.userAgent)
+		if body != nil {
+			httpReq.Header.Set("Content-Type", "application/json")
+		}
+		if token != "" {
+			httpReq.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp, err := c.httpClient.Do(httpReq)
+		if attempt >= retries || ctx.Err() != nil || (err == nil && !retryable(resp.StatusCode)) {
+			return resp, err
+		}
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok && after > wait {
+				wait = after
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+// validToken returns a token that isn't about to expire, signing in when there is none
+func (c *Client) validToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	token, expiresAt := c.token, c.expiresAt
+	c.mu.Unlock()
+
+	fresh := token != "" && (expiresAt.IsZero() || time.Until(expiresAt) > tokenRefreshMargin)
+	if fresh || c.email == "" {
+		return token, nil
+	}
+	return c.refreshToken(ctx, token)
+}
+
+// refreshToken signs in again unless another call already replaced stale
+func (c *Client) refreshToken(ctx context.Context, stale string) (string, error) {
+	c.loginMu.Lock()
+	defer c.loginMu.Unlock()
+
+	c.mu.Lock()
+	current := c.token
+	c.mu.Unlock()
+	if current != stale {
+		return current, nil
+	}
+
+	resp, err := c.Login(ctx, c.email, c.password)
+	if err != nil {
+		return "", fmt.Errorf("signing in as %s: %w", c.email, err)
+	}
+	return resp.Token, nil
+}
+
+// setToken stores the token of a successful sign-in
+func (c *Client) setToken(token string, expiresAt int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = token
+	c.expiresAt = time.Time{}
+	if expiresAt > 0 {
+		c.expiresAt = time.Unix(expiresAt, 0)
+	}
+}
+
+// adoptToken replaces sent with the refreshed token the API answered with, keeping its expiry
+// A token replaced by another call in the meantime is left alone.
+func (c *Client) adoptToken(sent, refreshed string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token == sent {
+		c.token = refreshed
+	}
+}
+
+// idempotent reports whether a request with method may be sent again
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryable reports whether a response with status is worth retrying
+func retryable(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter reads a Retry-After header given in seconds
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// APIError is an error answer of the API
+type APIError struct {
+	StatusCode int
+	Code       string // Error code such as "INSUFFICIENT_TICKETS" or "validation_error"; empty when the body had none
+	Message    string
+	RequestID  string // ID of the failed request, for quoting to support; from the body or X-Request-ID
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("api error %d %s: %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("api error %d %s", e.StatusCode, e.Code)
+}
+
+// IsNotFound reports whether err is a 404 answer of the API
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is a 401 answer of the API
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is a 403 answer of the API
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsConflict reports whether err is a 409 answer of the API
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// decodeError turns an error response into an APIError
+// Handlers answer {"error", "message"} and some add "code"; the most specific code wins.
+func decodeError(resp *http.Response) error {
+	apiErr := &APIError{StatusCode: resp.StatusCode, RequestID: resp.Header.Get("X-Request-ID")}
+	var body struct {
+		Error     string `json:"error"`
+		Code      string `json:"code"`
+		Message   string `json:"message"`
+		RequestID string `json:"request_id"`
+	}
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if json.Unmarshal(raw, &body) != nil {
+		apiErr.Message = strings.TrimSpace(string(raw))
+		return apiErr
+	}
+
+	if body.RequestID != "" {
+		apiErr.RequestID = body.RequestID
+	}
+	apiErr.Code = body.Code
+	if apiErr.Code == "" {
+		apiErr.Code = body.Error
+	}
+	apiErr.Message = body.Message
+	if apiErr.Message == "" && body.Code != "" {
+		apiErr.Message = body.Error
+	}
+	return apiErr
+}
@@ -0,0 +1,270 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAPI answers /api/v1/auth/login with a new token each time and routes everything else to handler
+type fakeAPI struct {
+	logins    atomic.Int32
+	expiresIn time.Duration
+	handler   http.HandlerFunc
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/v1/auth/login" {
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["password"] != "secret123" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "Invalid credentials", "message": "wrong password"})
+			return
+		}
+		n := f.logins.Add(1)
+		json.NewEncoder(w).Encode(LoginResponse{
+			User:      User{Email: body["email"]},
+			Token:     fmt.Sprintf("token-%d", n),
+			ExpiresAt: time.Now().Add(f.expiresIn).Unix(),
+		})
+		return
+	}
+	f.handler(w, r)
+}
+
+func newTestClient(t *testing.T, api *fakeAPI, opts ...Option) *Client {
+	if api.expiresIn == 0 {
+		api.expiresIn = time.Hour
+	}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	opts = append([]Option{WithCredentials("user@example.com", "secret123"), WithRetries(2, time.Millisecond)}, opts...)
+	return New(server.URL, opts...)
+}
+
+func TestClient_SignsInOnFirstAuthenticatedCall(t *testing.T) {
+	var authorization string
+	api := &fakeAPI{handler: func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		json.NewEncoder(w).Encode(User{Username: "john_doe"})
+	}}
+	c := newTestClient(t, api)
+
+	profile, err := c.Profile(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "john_doe", profile.Username)
+	assert.Equal(t, "Bearer token-1", authorization)
+	assert.Equal(t, int32(1), api.logins.Load())
+}
+
+func TestClient_RefreshesTokenBeforeItExpires(t *testing.T) {
+	api := &fakeAPI{expiresIn: 30 * time.Second, handler: func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": []Order{}})
+	}}
+	c := newTestClient(t, api)
+
+	_, err := c.MyOrders(context.Background())
+	require.NoError(t, err)
+	_, err = c.MyOrders(context.Background())
+	require.NoError(t, err)
+
+	// Each token expires within the refresh margin, so every call signs in again
+	assert.Equal(t, int32(2), api.logins.Load())
+	assert.Equal(t, "token-2", c.Token())
+}
+
+func TestClient_RefreshesRejectedToken(t *testing.T) {
+	api := &fakeAPI{handler: func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(User{Username: "john_doe"})
+	}}
+	c := newTestClient(t, api, WithToken("revoked"))
+
+	_, err := c.Profile(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), api.logins.Load())
+}
+
+func TestClient_AdoptsRefreshedToken(t *testing.T) {
+	var authorization string
+	api := &fakeAPI{handler: func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		if authorization == "Bearer token-1" {
+			w.Header().Set("X-Refreshed-Token", "token-1-refreshed")
+		}
+		json.NewEncoder(w).Encode(User{Username: "john_doe"})
+	}}
+	c := newTestClient(t, api)
+
+	_, err := c.Profile(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1-refreshed", c.Token())
+
+	_, err = c.Profile(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-1-refreshed", authorization)
+	assert.Equal(t, int32(1), api.logins.Load())
+}
+
+func TestClient_RetriesIdempotentRequests(t *testing.T) {
+	var calls atomic.Int32
+	api := &fakeAPI{handler: func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": []Venue{{Name: "Arena"}}})
+	}}
+	c := newTestClient(t, api)
+
+	venues, err := c.ListVenues(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, venues, 1)
+	assert.Equal(t, "Arena", venues[0].Name)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_SendsOrdersOnce(t *testing.T) {
+	var calls atomic.Int32
+	var captcha string
+	api := &fakeAPI{handler: func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		captcha = r.Header.Get("X-Captcha-Token")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}}
+	c := newTestClient(t, api)
+
+	_, err := c.CreateOrder(context.Background(), OrderRequest{EventID: uuid.New(), Quantity: 1, CaptchaToken: "captcha"})
+
+	require.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, "captcha", captcha)
+}
+
+func TestClient_DecodesErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		code      string
+		msg       string
+		requestID string
+	}{
+		{"error and message", `{"error":"INSUFFICIENT_TICKETS","message":"Insufficient tickets","request_id":"req-1"}`, "INSUFFICIENT_TICKETS", "Insufficient tickets", "req-1"},
+		{"code", `{"error":"Username taken","code":"USERNAME_EXISTS"}`, "USERNAME_EXISTS", "Username taken", "req-header"},
+		{"plain text", "upstream timed out\n", "", "upstream timed out", "req-header"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeAPI{handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-ID", "req-header")
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(tt.body))
+			}}
+			c := newTestClient(t, api)
+
+			_, err := c.GetEvent(context.Background(), uuid.New())
+
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.True(t, IsConflict(err))
+			assert.Equal(t, tt.code, apiErr.Code)
+			assert.Equal(t, tt.msg, apiErr.Message)
+			assert.Equal(t, tt.requestID, apiErr.RequestID)
+		})
+	}
+}
+
+func TestClient_ReportsFailedSignIn(t *testing.T) {
+	api := &fakeAPI{handler: func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent without a token")
+	}}
+	c := newTestClient(t, api, WithCredentials("user@example.com", "wrong"))
+
+	_, err := c.MyOrders(context.Background())
+
+	assert.True(t, IsUnauthorized(err))
+}
+
+func TestClient_StopsRetryingWhenContextEnds(t *testing.T) {
+	api := &fakeAPI{handler: func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}}
+	c := newTestClient(t, api, WithRetries(5, time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.GetVenue(ctx, uuid.New())
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClient_ListEventsSendsFilter(t *testing.T) {
+	var query string
+	api := &fakeAPI{handler: func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": []Event{}, "meta": map[string]int{"count": 0}})
+	}}
+	c := newTestClient(t, api)
+
+	events, err := c.ListEvents(context.Background(), EventFilter{Status: "CANCELLED", IncludePast: true})
+
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.Equal(t, "include_past=true&status=CANCELLED", query)
+}
+
+func TestClient_MyEventsPagesWithCursor(t *testing.T) {
+	var query string
+	api := &fakeAPI{handler: func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []Event{{Title: "Event 2"}},
+			"meta": map[string]interface{}{"count": 1, "total": 3, "next_cursor": "eyJvIjoyfQ"},
+		})
+	}}
+	c := newTestClient(t, api)
+
+	page, err := c.MyEvents(context.Background(), OrganizerEventFilter{Limit: 1, Cursor: "eyJvIjoxfQ"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "cursor=eyJvIjoxfQ&limit=1", query)
+	require.Len(t, page.Events, 1)
+	assert.Equal(t, 3, page.Total)
+	assert.Equal(t, "eyJvIjoyfQ", page.NextCursor)
+}
+
+func TestClient_OrganizerEventsNeedsNoSignIn(t *testing.T) {
+	var path, auth string
+	api := &fakeAPI{handler: func(w http.ResponseWriter, r *http.Request) {
+		path, auth = r.URL.Path+"?"+r.URL.RawQuery, r.Header.Get("Authorization")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []Event{{Title: "Event 1"}},
+			"meta": map[string]interface{}{"count": 1, "total": 1},
+		})
+	}}
+	c := newTestClient(t, api)
+	organizerID := uuid.New()
+
+	page, err := c.OrganizerEvents(context.Background(), organizerID, 10, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/organizers/"+organizerID.String()+"/events?limit=10", path)
+	assert.Empty(t, auth)
+	require.Len(t, page.Events, 1)
+	assert.Empty(t, page.NextCursor)
+}
@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttendeeQuestion is a question ticket buyers answer when ordering
+type AttendeeQuestion struct {
+	Key       string   `json:"key"`
+	Label     string   `json:"label"`
+	Type      string   `json:"type"` // text, number, choice or boolean
+	Required  bool     `json:"required"`
+	Options   []string `json:"options,omitempty"`
+	MaxLength int      `json:"max_length,omitempty"`
+}
+
+// PriceTier prices tickets ordered between From and To, e.g. an early-bird price
+type PriceTier struct {
+	Name  string     `json:"name"`
+	Price float64    `json:"price"`
+	From  *time.Time `json:"from,omitempty"` // Nil starts the tier right away
+	To    *time.Time `json:"to,omitempty"`   // Exclusive; nil runs the tier until the event
+}
+
+// RefundPolicy sets the refunds buyers of an event may ask for
+type RefundPolicy struct {
+	Type       string       `json:"type"`                  // FLEXIBLE, NONE, DAYS_BEFORE or SLIDING
+	DaysBefore int          `json:"days_before,omitempty"` // Full refunds until this many days before the event; DAYS_BEFORE only
+	Tiers      []RefundTier `json:"tiers,omitempty"`       // SLIDING only
+}
+
+// RefundTier refunds Percent of an order asked for at least DaysBefore days before the event
+type RefundTier struct {
+	DaysBefore int `json:"days_before"`
+	Percent    int `json:"percent"`
+}
+
+// Event is an event as the API returns it
+type Event struct {
+	ID                uuid.UUID          `json:"id"`
+	VenueID           uuid.UUID          `json:"venue_id"`
+	OrganizerID       uuid.UUID          `json:"organizer_id"`
+	Title             string             `json:"title"`
+	Description       string             `json:"description"`
+	EventDate         time.Time          `json:"event_date"`
+	TicketPrice       float64            `json:"ticket_price"`
+	PriceSchedule     []PriceTier        `json:"price_schedule"`
+	CurrentPrice      float64            `json:"current_price"` // What an order placed now pays per ticket
+	RefundPolicy      RefundPolicy       `json:"refund_policy"`
+	AvailableTickets  int                `json:"available_tickets"`
+	TotalTickets      int                `json:"total_tickets"`
+	Status            string             `json:"status"`
+	ShortURL          string             `json:"short_url,omitempty"`
+	AttendeeQuestions []AttendeeQuestion `json:"attendee_questions"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
+}
+
+// EventRequest creates or updates an event
+type EventRequest struct {
+	VenueID      uuid.UUID `json:"venue_id"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	EventDate    time.Time `json:"event_date"`
+	TicketPrice  float64   `json:"ticket_price"`
+	TotalTickets int       `json:"total_tickets"`
+
+	// AttendeeQuestions replaces the event's questions; nil keeps them on update
+	AttendeeQuestions *[]AttendeeQuestion `json:"attendee_questions,omitempty"`
+
+	// PriceSchedule replaces the event's price tiers; nil keeps them on update
+	PriceSchedule *[]PriceTier `json:"price_schedule,omitempty"`
+
+	// RefundPolicy replaces the event's refund policy; nil keeps it on update and means FLEXIBLE on create
+	RefundPolicy *RefundPolicy `json:"refund_policy,omitempty"`
+}
+
+// EventFilter narrows ListEvents; organizers and admins only
+type EventFilter struct {
+	Status      string // ACTIVE, CANCELLED or COMPLETED
+	IncludePast bool
+}
+
+// OrganizerEventFilter narrows and pages MyEvents
+type OrganizerEventFilter struct {
+	Status string
+	From   time.Time // Zero for no lower bound
+	To     time.Time // Zero for no upper bound
+	Limit  int       // 0 for the API default
+	Cursor string    // NextCursor of the previous page; empty for the first page
+}
+
+// EventPage is one page of an organizer's events
+type EventPage struct {
+	Events     []Event
+	Total      int    // Events matching the filter
+	NextCursor string // Pass as OrganizerEventFilter.Cursor for the next page; empty on the last page
+}
+
+// ListEvents lists upcoming active events, or those matching filter
+// The client's token is sent when it has one, so organizers and admins may filter.
+func (c *Client) ListEvents(ctx context.Context, filter EventFilter) ([]Event, error) {
+	query := url.Values{}
+	if filter.Status != "" {
+		query.Set("status", filter.Status)
+	}
+	if filter.IncludePast {
+		query.Set("include_past", "true")
+	}
+
+	var resp list[Event]
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/events",
+		query:  query,
+		auth:   c.email != "" || c.Token() != "",
+	}, &resp)
+	return resp.Data, err
+}
+
+// GetEvent retrieves an event by ID
+func (c *Client) GetEvent(ctx context.Context, id uuid.UUID) (*Event, error) {
+	var e Event
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/events/" + id.String()}, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// MyEvents retrieves a page of the signed-in organizer's events
+func (c *Client) MyEvents(ctx context.Context, filter OrganizerEventFilter) (*EventPage, error) {
+	query := url.Values{}
+	if filter.Status != "" {
+		query.Set("status", filter.Status)
+	}
+	if !filter.From.IsZero() {
+		query.Set("from", filter.From.Format(time.RFC3339))
+	}
+	if !filter.To.IsZero() {
+		query.Set("to", filter.To.Format(time.RFC3339))
+	}
+	if filter.Limit > 0 {
+		query.Set("limit", strconv.Itoa(filter.Limit))
+	}
+	if filter.Cursor != "" {
+		query.Set("cursor", filter.Cursor)
+	}
+
+	var resp list[Event]
+	err := c.do(ctx, request{method: http.MethodGet, path: "/events/my-events", query: query, auth: true}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	page := &EventPage{Events: resp.Data, NextCursor: resp.Meta.NextCursor}
+	if resp.Meta.Total != nil {
+		page.Total = *resp.Meta.Total
+	}
+	return page, nil
+}
+
+// OrganizerEvents retrieves a page of an organizer's upcoming public events
+// It needs no sign-in; pass the previous page's NextCursor as cursor and 0 as limit for the API default.
+func (c *Client) OrganizerEvents(ctx context.Context, organizerID uuid.UUID, limit int, cursor string) (*EventPage, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+
+	var resp list[Event]
+	err := c.do(ctx, request{method: http.MethodGet, path: "/organizers/" + organizerID.String() + "/events", query: query}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	page := &EventPage{Events: resp.Data, NextCursor: resp.Meta.NextCursor}
+	if resp.Meta.Total != nil {
+		page.Total = *resp.Meta.Total
+	}
+	return page, nil
+}
+
+// CreateEvent creates an event as the signed-in organizer
+func (c *Client) CreateEvent(ctx context.Context, req EventRequest) (*Event, error) {
+	var created Event
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/events", body: req, auth: true}, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateEvent replaces an event's details
+func (c *Client) UpdateEvent(ctx context.Context, id uuid.UUID, req EventRequest) (*Event, error) {
+	var updated Event
+	err := c.do(ctx, request{method: http.MethodPut, path: "/events/" + id.String(), body: req, auth: true}, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// CancelEvent cancels an event
+func (c *Client) CancelEvent(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, request{method: http.MethodPatch, path: "/events/" + id.String() + "/cancel", auth: true}, nil)
+}
+
+// DeleteEvent deletes an event
+func (c *Client) DeleteEvent(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/events/" + id.String(), auth: true}, nil)
+}
+
+// InventoryAdjustment is a change to an event's tickets made outside a full update
+type InventoryAdjustment struct {
+	ID               uuid.UUID  `json:"id"`
+	EventID          uuid.UUID  `json:"event_id"`
+	ActorID          *uuid.UUID `json:"actor_id,omitempty"` // nil once the user who made it is deleted
+	Delta            int        `json:"delta"`              // Tickets added, or removed when negative
+	Reason           string     `json:"reason"`
+	TotalTickets     int        `json:"total_tickets"`     // After the adjustment
+	AvailableTickets int        `json:"available_tickets"` // After the adjustment
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// AdjustInventory adds delta tickets to an event, or holds back unsold ones when negative, recording why
+func (c *Client) AdjustInventory(ctx context.Context, id uuid.UUID, delta int, reason string) (*InventoryAdjustment, error) {
+	body := map[string]interface{}{"delta": delta, "reason": reason}
+	var adjustment InventoryAdjustment
+	err := c.do(ctx, request{method: http.MethodPost, path: "/events/" + id.String() + "/inventory-adjustments", body: body, auth: true}, &adjustment)
+	if err != nil {
+		return nil, err
+	}
+	return &adjustment, nil
+}
+
+// InventoryAdjustments lists the inventory adjustments of an event, newest first
+func (c *Client) InventoryAdjustments(ctx context.Context, id uuid.UUID) ([]InventoryAdjustment, error) {
+	var resp list[InventoryAdjustment]
+	err := c.do(ctx, request{method: http.MethodGet, path: "/events/" + id.String() + "/inventory-adjustments", auth: true}, &resp)
+	return resp.Data, err
+}
@@ -0,0 +1,14 @@
+package client
+
+// ListMeta describes the page of a list the API answered with
+type ListMeta struct {
+	Count      int    `json:"count"`
+	Total      *int   `json:"total,omitempty"`       // Set by lists that count their matches
+	NextCursor string `json:"next_cursor,omitempty"` // Empty on the last page
+}
+
+// list is the envelope the API wraps every list in
+type list[T any] struct {
+	Data []T      `json:"data"`
+	Meta ListMeta `json:"meta"`
+}
@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// ErrorCode describes an error code the API answers with, as APIError.Code
+type ErrorCode struct {
+	Code        string `json:"code"`
+	Domain      string `json:"domain"` // event, order, user or venue
+	Kind        string `json:"kind"`
+	HTTPStatus  int    `json:"http_status"`
+	Description string `json:"description"`
+}
+
+// ErrorCodes lists the error codes of the event, order, user and venue endpoints
+func (c *Client) ErrorCodes(ctx context.Context) ([]ErrorCode, error) {
+	var resp list[ErrorCode]
+	err := c.do(ctx, request{method: http.MethodGet, path: "/meta/error-codes"}, &resp)
+	return resp.Data, err
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Order is an order as the API returns it
+type Order struct {
+	ID            uuid.UUID              `json:"id"`
+	UserID        uuid.UUID              `json:"user_id"`
+	EventID       uuid.UUID              `json:"event_id"`
+	Quantity      int                    `json:"quantity"`
+	TotalAmount   float64                `json:"total_amount"`
+	Status        string                 `json:"status"`
+	CheckedInAt   *time.Time             `json:"checked_in_at,omitempty"`
+	Notes         string                 `json:"notes,omitempty"`
+	Answers       map[string]interface{} `json:"answers,omitempty"`
+	InvoiceNumber string                 `json:"invoice_number,omitempty"`
+	CreatedAt     time.Time              `json:"created_at"`
+	Archived      bool                   `json:"archived,omitempty"` // Read-only history of an archived event
+	Gift          *Gift                  `json:"gift,omitempty"`     // Set on orders bought for someone else
+
+	// Set on the shares of a group reservation; unpaid shares are released at HoldUntil
+	GroupID   *uuid.UUID `json:"group_id,omitempty"`
+	HoldUntil *time.Time `json:"hold_until,omitempty"`
+}
+
+// Gift describes an order bought for someone else
+// Once claimed the order belongs to the recipient; the buyer, in GiftedBy, still sees it in MyOrders.
+type Gift struct {
+	Recipient string     `json:"recipient"`
+	Status    string     `json:"status"` // UNCLAIMED or CLAIMED
+	GiftedBy  *uuid.UUID `json:"gifted_by,omitempty"`
+	ClaimedAt *time.Time `json:"claimed_at,omitempty"`
+}
+
+// OrderRequest buys tickets for an event
+type OrderRequest struct {
+	EventID  uuid.UUID              `json:"event_id"`
+	Quantity int                    `json:"quantity"`
+	Notes    string                 `json:"notes,omitempty"`
+	Answers  map[string]interface{} `json:"answers,omitempty"` // Answers to the event's attendee questions by key
+
+	AccessCode    string `json:"access_code,omitempty"`    // Needed for events that require an access code
+	GiftRecipient string `json:"gift_recipient,omitempty"` // Buys the tickets for this email; a claim link is sent once paid
+
+	CaptchaToken string `json:"-"` // Sent as X-Captcha-Token when the API asked for a CAPTCHA
+}
+
+// CreateOrder buys tickets as the signed-in user
+// It is never retried: a lost response may still have placed the order, so check MyOrders before trying again.
+func (c *Client) CreateOrder(ctx context.Context, req OrderRequest) (*Order, error) {
+	var created Order
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/orders",
+		body:   req,
+		header: captchaHeader(req.CaptchaToken),
+		auth:   true,
+	}, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetOrder retrieves one of the signed-in user's orders
+func (c *Client) GetOrder(ctx context.Context, id uuid.UUID) (*Order, error) {
+	var o Order
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/orders/" + id.String(), auth: true}, &o); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// MyOrders lists the signed-in user's orders, newest first
+func (c *Client) MyOrders(ctx context.Context) ([]Order, error) {
+	var resp list[Order]
+	err := c.do(ctx, request{method: http.MethodGet, path: "/orders/my-orders", auth: true}, &resp)
+	return resp.Data, err
+}
+
+// ClaimGift moves the tickets of a gift to the signed-in user, with the token from its claim link
+func (c *Client) ClaimGift(ctx context.Context, token string) (*Order, error) {
+	var claimed Order
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/orders/gifts/claim",
+		body:   map[string]string{"token": token},
+		auth:   true,
+	}, &claimed)
+	if err != nil {
+		return nil, err
+	}
+	return &claimed, nil
+}
+
+// ResendGift emails the recipient of one of the signed-in user's gifts a new claim link
+func (c *Client) ResendGift(ctx context.Context, orderID uuid.UUID) error {
+	return c.do(ctx, request{method: http.MethodPost, path: "/orders/" + orderID.String() + "/gift/resend", auth: true}, nil)
+}
+
+// Group is a reservation of tickets for several members, one pending order per share
+type Group struct {
+	ID          uuid.UUID    `json:"id"`
+	EventID     uuid.UUID    `json:"event_id"`
+	HoldUntil   time.Time    `json:"hold_until"` // Shares not paid by then are released
+	TotalAmount float64      `json:"total_amount"`
+	Shares      []GroupShare `json:"shares"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+// GroupShare is one member's share of a group reservation
+type GroupShare struct {
+	OrderID     uuid.UUID `json:"order_id"`
+	Quantity    int       `json:"quantity"`
+	TotalAmount float64   `json:"total_amount"`
+	Status      string    `json:"status"`
+	Taken       bool      `json:"taken"`                 // A member took the share with its payment link
+	HolderID    uuid.UUID `json:"holder_id"`             // The leader until the share is taken
+	PaymentURL  string    `json:"payment_url,omitempty"` // Only set in the response to CreateGroup
+}
+
+// GroupRequest reserves tickets for a group, one share per member
+type GroupRequest struct {
+	EventID    uuid.UUID              `json:"event_id"`
+	Shares     []GroupShareRequest    `json:"shares"`
+	Notes      string                 `json:"notes,omitempty"`
+	Answers    map[string]interface{} `json:"answers,omitempty"`
+	AccessCode string                 `json:"access_code,omitempty"`
+
+	CaptchaToken string `json:"-"` // Sent as X-Captcha-Token when the API asked for a CAPTCHA
+}
+
+// GroupShareRequest is one member's share of a group reservation
+type GroupShareRequest struct {
+	Quantity int `json:"quantity"`
+}
+
+// CreateGroup reserves tickets for a group as the signed-in user, who becomes its leader
+// The payment links in the response are never shown again. Like CreateOrder it is never retried.
+func (c *Client) CreateGroup(ctx context.Context, req GroupRequest) (*Group, error) {
+	var created Group
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/orders/groups",
+		body:   req,
+		header: captchaHeader(req.CaptchaToken),
+		auth:   true,
+	}, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetGroup retrieves one of the signed-in user's group reservations with its shares
+func (c *Client) GetGroup(ctx context.Context, id uuid.UUID) (*Group, error) {
+	var g Group
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/orders/groups/" + id.String(), auth: true}, &g); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// TakeGroupShare moves a group share to the signed-in user, with the token from its payment link
+// The returned order is pending until paid.
+func (c *Client) TakeGroupShare(ctx context.Context, token string) (*Order, error) {
+	var taken Order
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/orders/groups/shares/take",
+		body:   map[string]string{"token": token},
+		auth:   true,
+	}, &taken)
+	if err != nil {
+		return nil, err
+	}
+	return &taken, nil
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Venue is a venue as the API returns it
+type Venue struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Address     string     `json:"address"`
+	Capacity    int        `json:"capacity"`
+	Description string     `json:"description"`
+	OwnerID     *uuid.UUID `json:"owner_id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// VenueRequest creates or updates a venue
+type VenueRequest struct {
+	Name        string `json:"name"`
+	Address     string `json:"address"`
+	Capacity    int    `json:"capacity"`
+	Description string `json:"description,omitempty"`
+}
+
+// ListVenues lists every venue
+func (c *Client) ListVenues(ctx context.Context) ([]Venue, error) {
+	var resp list[Venue]
+	err := c.do(ctx, request{method: http.MethodGet, path: "/venues"}, &resp)
+	return resp.Data, err
+}
+
+// GetVenue retrieves a venue by ID
+func (c *Client) GetVenue(ctx context.Context, id uuid.UUID) (*Venue, error) {
+	var v Venue
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/venues/" + id.String()}, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateVenue creates a venue
+func (c *Client) CreateVenue(ctx context.Context, req VenueRequest) (*Venue, error) {
+	var created Venue
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/venues", body: req, auth: true}, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateVenue replaces a venue's details
+func (c *Client) UpdateVenue(ctx context.Context, id uuid.UUID, req VenueRequest) (*Venue, error) {
+	var updated Venue
+	err := c.do(ctx, request{method: http.MethodPut, path: "/venues/" + id.String(), body: req, auth: true}, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteVenue deletes a venue
+func (c *Client) DeleteVenue(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/venues/" + id.String(), auth: true}, nil)
+}
+
+// TransferVenue hands a venue to another organizer or admin
+func (c *Client) TransferVenue(ctx context.Context, id, ownerID uuid.UUID) (*Venue, error) {
+	var transferred Venue
+	body := map[string]uuid.UUID{"owner_id": ownerID}
+	err := c.do(ctx, request{method: http.MethodPost, path: "/venues/" + id.String() + "/transfer", body: body, auth: true}, &transferred)
+	if err != nil {
+		return nil, err
+	}
+	return &transferred, nil
+}
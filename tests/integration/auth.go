@@ -0,0 +1,58 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	userDto "enterprise-crud/internal/dto/user"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+// LoginAs signs in through POST /api/v1/auth/login and returns the issued Bearer token
+// The token goes through the same JWT middleware as a real client's, so protected routes
+// see the user's roles as they are stored.
+func LoginAs(t *testing.T, router *gin.Engine, email, password string) string {
+	t.Helper()
+
+	w := DoRequest(t, router, http.MethodPost, "/api/v1/auth/login", "", userDto.LoginRequest{
+		Email:    email,
+		Password: password,
+	})
+	require.Equal(t, http.StatusOK, w.Code, "login as %s failed: %s", email, w.Body.String())
+
+	var response userDto.LoginResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.NotEmpty(t, response.Token, "login as %s returned no token", email)
+	return response.Token
+}
+
+// DoRequest sends a request to router and records the response
+// body is encoded as JSON unless nil; token is sent as a Bearer token unless empty.
+func DoRequest(t *testing.T, router *gin.Engine, method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var payload bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&payload).Encode(body))
+	}
+	req, err := http.NewRequest(method, path, &payload)
+	require.NoError(t, err)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
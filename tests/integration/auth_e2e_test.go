@@ -0,0 +1,137 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	eventDto "enterprise-crud/internal/dto/event"
+	orderDto "enterprise-crud/internal/dto/order"
+	"enterprise-crud/internal/infrastructure/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticatedFlows(t *testing.T) {
+	testDB := SetupTestDatabase(t)
+	defer testDB.Close()
+	defer testDB.Cleanup(t)
+
+	fixtures := NewTestFixtures(testDB)
+	userRole, organizerRole, _ := fixtures.StandardRoles(t)
+
+	cfg := CreateTestConfig()
+	deps, err := CreateTestDependencies(cfg, &database.Connection{DB: testDB.DB})
+	require.NoError(t, err, "Failed to create dependencies")
+	router := NewTestRouter(cfg, deps)
+
+	organizer := fixtures.CreateUser(t, "e2e-organizer@test.com", "e2e_organizer", "password123", organizerRole, userRole)
+	otherOrganizer := fixtures.CreateUser(t, "e2e-other@test.com", "e2e_other", "password123", organizerRole)
+	buyer := fixtures.CreateUser(t, "e2e-buyer@test.com", "e2e_buyer", "password123", userRole)
+	venue := fixtures.CreateVenue(t, "E2E Venue", 200)
+
+	organizerToken := LoginAs(t, router, organizer.Email, "password123")
+	otherToken := LoginAs(t, router, otherOrganizer.Email, "password123")
+	buyerToken := LoginAs(t, router, buyer.Email, "password123")
+
+	t.Run("protected routes reject missing and invalid tokens", func(t *testing.T) {
+		w := DoRequest(t, router, http.MethodGet, "/api/v1/orders/my-orders", "", nil)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		w = DoRequest(t, router, http.MethodGet, "/api/v1/orders/my-orders", "not-a-token", nil)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("buyers cannot create events", func(t *testing.T) {
+		w := DoRequest(t, router, http.MethodPost, "/api/v1/events", buyerToken, eventDto.CreateEventRequest{
+			VenueID:      venue.ID,
+			Title:        "Not Allowed",
+			EventDate:    time.Now().Add(48 * time.Hour),
+			TicketPrice:  10,
+			TotalTickets: 10,
+		})
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("organizer creates, updates, cancels and deletes an event", func(t *testing.T) {
+		w := DoRequest(t, router, http.MethodPost, "/api/v1/events", organizerToken, eventDto.CreateEventRequest{
+			VenueID:      venue.ID,
+			Title:        "E2E Concert",
+			Description:  "Created through the API",
+			EventDate:    time.Now().Add(48 * time.Hour),
+			TicketPrice:  40,
+			TotalTickets: 100,
+		})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var created eventDto.EventResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+		assert.Equal(t, organizer.ID, created.OrganizerID)
+		eventPath := fmt.Sprintf("/api/v1/events/%s", created.ID)
+
+		w = DoRequest(t, router, http.MethodPut, eventPath, organizerToken, eventDto.UpdateEventRequest{
+			VenueID:      venue.ID,
+			Title:        "E2E Concert (moved)",
+			EventDate:    time.Now().Add(72 * time.Hour),
+			TicketPrice:  45,
+			TotalTickets: 120,
+		})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var updated eventDto.EventResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+		assert.Equal(t, "E2E Concert (moved)", updated.Title)
+		assert.Equal(t, 120, updated.TotalTickets)
+
+		// Only the event's own organizer may change it
+		w = DoRequest(t, router, http.MethodPatch, eventPath+"/cancel", otherToken, nil)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		w = DoRequest(t, router, http.MethodPatch, eventPath+"/cancel", organizerToken, nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = DoRequest(t, router, http.MethodGet, eventPath, "", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		var cancelled eventDto.EventResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &cancelled))
+		assert.Equal(t, "CANCELLED", cancelled.Status)
+
+		w = DoRequest(t, router, http.MethodDelete, eventPath, organizerToken, nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = DoRequest(t, router, http.MethodGet, eventPath, "", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("user orders tickets", func(t *testing.T) {
+		concert := fixtures.CreateEvent(t, venue, organizer, "E2E Order Event", 25, 50)
+
+		w := DoRequest(t, router, http.MethodPost, "/api/v1/orders", buyerToken, orderDto.CreateOrderRequest{
+			EventID:  concert.ID,
+			Quantity: 3,
+		})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var placed orderDto.OrderResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &placed))
+		assert.Equal(t, buyer.ID, placed.UserID)
+		assert.Equal(t, 75.0, placed.TotalAmount)
+
+		w = DoRequest(t, router, http.MethodGet, fmt.Sprintf("/api/v1/events/%s", concert.ID), "", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		var after eventDto.EventResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &after))
+		assert.Equal(t, 47, after.AvailableTickets)
+
+		orderPath := fmt.Sprintf("/api/v1/orders/%s", placed.ID)
+		w = DoRequest(t, router, http.MethodGet, orderPath, buyerToken, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// Organizers without the USER role can't read other people's orders
+		w = DoRequest(t, router, http.MethodGet, orderPath, otherToken, nil)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -12,6 +12,7 @@ import (
 
 	"enterprise-crud/internal/domain/event"
 	"enterprise-crud/internal/domain/order"
+	"enterprise-crud/internal/domain/plan"
 	"enterprise-crud/internal/domain/role"
 	"enterprise-crud/internal/domain/user"
 	"enterprise-crud/internal/domain/venue"
@@ -130,9 +131,11 @@ func runAutoMigrations(t *testing.T, db *gorm.DB) {
 	// Auto-migrate all tables in correct order
 	err = db.AutoMigrate(
 		&role.Role{},
+		&plan.Plan{},
 		&user.User{},
 		&venue.Venue{},
 		&event.Event{},
+		&database.EventSlug{},
 		&order.Order{},
 	)
 	require.NoError(t, err, "Failed to run auto-migrations")
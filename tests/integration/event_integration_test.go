@@ -12,7 +12,6 @@ import (
 	"testing"
 	"time"
 
-	"enterprise-crud/internal/app"
 	"enterprise-crud/internal/dto/event"
 	"enterprise-crud/internal/infrastructure/database"
 
@@ -41,16 +40,13 @@ func TestEventIntegration(t *testing.T) {
 	deps, err := CreateTestDependencies(cfg, dbConn)
 	require.NoError(t, err, "Failed to create dependencies")
 
-	// Create the application instance
-	application := app.NewWireApp(cfg, dbConn, deps.UserHandler, deps.EventHandler, deps.OrderHandler)
-
 	// Create HTTP handler
-	router := application.SetupRouter()
+	router := NewTestRouter(cfg, deps)
 
 	t.Run("POST /events - Create Event", func(t *testing.T) {
 		// Create test data
 		organizer := fixtures.CreateUser(t, "organizer@test.com", "organizer", "password123", organizerRole)
-		_ = organizer // Used in request
+		token := LoginAs(t, router, organizer.Email, "password123")
 		venue := fixtures.CreateVenue(t, "Test Venue", 100)
 
 		// Prepare request payload
@@ -70,6 +66,7 @@ func TestEventIntegration(t *testing.T) {
 		req, err := http.NewRequest("POST", "/api/v1/events", bytes.NewBuffer(payload))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
 
 		// Execute request
 		w := httptest.NewRecorder()
@@ -119,6 +116,7 @@ func TestEventIntegration(t *testing.T) {
 	t.Run("PUT /events/{id} - Update Event", func(t *testing.T) {
 		// Create test data
 		organizer := fixtures.CreateUser(t, "organizer3@test.com", "organizer3", "password123", organizerRole)
+		token := LoginAs(t, router, organizer.Email, "password123")
 		venue := fixtures.CreateVenue(t, "Test Venue 3", 300)
 		testEvent := fixtures.CreateEvent(t, venue, organizer, "Original Event", 100.0, 200)
 
@@ -139,6 +137,7 @@ func TestEventIntegration(t *testing.T) {
 		req, err := http.NewRequest("PUT", fmt.Sprintf("/api/v1/events/%s", testEvent.ID.String()), bytes.NewBuffer(payload))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
 
 		// Execute request
 		w := httptest.NewRecorder()
@@ -160,19 +159,21 @@ func TestEventIntegration(t *testing.T) {
 	t.Run("DELETE /events/{id} - Delete Event", func(t *testing.T) {
 		// Create test data
 		organizer := fixtures.CreateUser(t, "organizer4@test.com", "organizer4", "password123", organizerRole)
+		token := LoginAs(t, router, organizer.Email, "password123")
 		venue := fixtures.CreateVenue(t, "Test Venue 4", 400)
 		testEvent := fixtures.CreateEvent(t, venue, organizer, "Delete Event", 50.0, 100)
 
 		// Create HTTP request
 		req, err := http.NewRequest("DELETE", fmt.Sprintf("/api/v1/events/%s", testEvent.ID.String()), nil)
 		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
 
 		// Execute request
 		w := httptest.NewRecorder()
 		router.ServeHTTP(w, req)
 
 		// Verify response
-		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, http.StatusOK, w.Code)
 
 		// Verify event is deleted - try to get it
 		req, err = http.NewRequest("GET", fmt.Sprintf("/api/v1/events/%s", testEvent.ID.String()), nil)
@@ -202,15 +203,16 @@ func TestEventIntegration(t *testing.T) {
 		// Verify response
 		assert.Equal(t, http.StatusOK, w.Code)
 
-		var response []event.EventResponse
+		var response event.EventListResponse
 		err = json.Unmarshal(w.Body.Bytes(), &response)
 		require.NoError(t, err)
 
-		assert.GreaterOrEqual(t, len(response), 2)
+		assert.GreaterOrEqual(t, len(response.Data), 2)
+		assert.Equal(t, len(response.Data), response.Meta.Count)
 
 		// Check if our test events are in the response
-		eventTitles := make([]string, len(response))
-		for i, e := range response {
+		eventTitles := make([]string, len(response.Data))
+		for i, e := range response.Data {
 			eventTitles[i] = e.Title
 		}
 		assert.Contains(t, eventTitles, event1.Title)
@@ -218,6 +220,9 @@ func TestEventIntegration(t *testing.T) {
 	})
 
 	t.Run("POST /events - Create Event with Invalid Data", func(t *testing.T) {
+		organizer := fixtures.CreateUser(t, "organizer7@test.com", "organizer7", "password123", organizerRole)
+		token := LoginAs(t, router, organizer.Email, "password123")
+
 		// Test with invalid data
 		createEventReq := event.CreateEventRequest{
 			VenueID:      uuid.UUID{}, // Invalid UUID
@@ -234,6 +239,7 @@ func TestEventIntegration(t *testing.T) {
 		req, err := http.NewRequest("POST", "/api/v1/events", bytes.NewBuffer(payload))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
 
 		w := httptest.NewRecorder()
 		router.ServeHTTP(w, req)
@@ -273,18 +279,18 @@ func TestEventIntegration(t *testing.T) {
 		// Verify response
 		assert.Equal(t, http.StatusOK, w.Code)
 
-		var response []event.EventResponse
+		var response event.EventListResponse
 		err = json.Unmarshal(w.Body.Bytes(), &response)
 		require.NoError(t, err)
 
 		// All events should be active
-		for _, e := range response {
-			assert.Equal(t, "active", e.Status)
+		for _, e := range response.Data {
+			assert.Equal(t, "ACTIVE", e.Status)
 		}
 
 		// Our test event should be in the response
-		eventTitles := make([]string, len(response))
-		for i, e := range response {
+		eventTitles := make([]string, len(response.Data))
+		for i, e := range response.Data {
 			eventTitles[i] = e.Title
 		}
 		assert.Contains(t, eventTitles, activeEvent.Title)
@@ -29,7 +29,7 @@ func NewTestFixtures(db *TestDatabase) *TestFixtures {
 }
 
 // CreateRole creates a test role
-func (f *TestFixtures) CreateRole(t *testing.T, name, description string) *role.Role {
+func (f *TestFixtures) CreateRole(t *testing.T, name role.Name, description string) *role.Role {
 	roleEntity := &role.Role{
 		ID:          uuid.New(),
 		Name:        name,
@@ -88,6 +88,7 @@ func (f *TestFixtures) CreateEvent(t *testing.T, venue *venue.Venue, organizer *
 		VenueID:          venue.ID,
 		OrganizerID:      organizer.ID,
 		Title:            title,
+		Slug:             event.NewSlug(title),
 		Description:      "Test event description",
 		EventDate:        time.Now().Add(24 * time.Hour), // Tomorrow
 		TicketPrice:      ticketPrice,
@@ -125,8 +126,8 @@ func (f *TestFixtures) CreateOrder(t *testing.T, user *user.User, event *event.E
 // CreateCompleteTestData creates a complete set of test data (roles, user, venue, event, order)
 func (f *TestFixtures) CreateCompleteTestData(t *testing.T) (*user.User, *event.Event, *order.Order) {
 	// Create roles
-	userRole := f.CreateRole(t, "USER", "Regular user")
-	organizerRole := f.CreateRole(t, "ORGANIZER", "Event organizer")
+	userRole := f.CreateRole(t, role.RoleUser, "Regular user")
+	organizerRole := f.CreateRole(t, role.RoleOrganizer, "Event organizer")
 
 	// Create users
 	regularUser := f.CreateUser(t, "user@test.com", "testuser", "password123", userRole)
@@ -146,9 +147,9 @@ func (f *TestFixtures) CreateCompleteTestData(t *testing.T) (*user.User, *event.
 
 // StandardRoles creates the standard application roles
 func (f *TestFixtures) StandardRoles(t *testing.T) (*role.Role, *role.Role, *role.Role) {
-	userRole := f.CreateRole(t, "USER", "Regular user role")
-	organizerRole := f.CreateRole(t, "ORGANIZER", "Event organizer role")
-	adminRole := f.CreateRole(t, "ADMIN", "Administrator role")
+	userRole := f.CreateRole(t, role.RoleUser, "Regular user role")
+	organizerRole := f.CreateRole(t, role.RoleOrganizer, "Event organizer role")
+	adminRole := f.CreateRole(t, role.RoleAdmin, "Administrator role")
 
 	return userRole, organizerRole, adminRole
 }
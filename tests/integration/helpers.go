@@ -13,9 +13,12 @@ import (
 	"enterprise-crud/internal/domain/event"
 	"enterprise-crud/internal/domain/order"
 	"enterprise-crud/internal/domain/user"
+	"enterprise-crud/internal/domain/venue"
 	"enterprise-crud/internal/infrastructure/auth"
 	"enterprise-crud/internal/infrastructure/database"
 	httpHandlers "enterprise-crud/internal/presentation/http"
+
+	"github.com/gin-gonic/gin"
 )
 
 // CreateTestDependencies creates test dependencies with test database
@@ -29,8 +32,8 @@ func CreateTestDependencies(cfg *config.Config, dbConn *database.Connection) (*a
 
 	// Create services
 	userService := user.NewUserService(userRepo, roleRepo)
-	eventService := event.NewService(eventRepo, venueRepo)
-	orderService := order.NewOrderService(orderRepo, dbConn.DB)
+	eventService := event.NewService(eventRepo, venueRepo, nil, nil)
+	orderService := order.NewOrderService(orderRepo, dbConn.DB, nil)
 
 	// JWT Service
 	jwtSecret := os.Getenv("JWT_SECRET")
@@ -53,8 +56,8 @@ func CreateTestDependencies(cfg *config.Config, dbConn *database.Connection) (*a
 	jwtService := auth.NewJWTService(jwtSecret, jwtIssuer, time.Duration(jwtExpirationHours)*time.Hour)
 
 	// Create handlers
-	userHandler := httpHandlers.NewUserHandler(userService, jwtService)
-	eventHandler := httpHandlers.NewEventHandler(eventService, jwtService)
+	userHandler := httpHandlers.NewUserHandler(userService, nil, jwtService)
+	eventHandler := httpHandlers.NewEventHandler(eventService, nil, jwtService)
 	orderHandler := httpHandlers.NewOrderHandler(orderService, jwtService)
 
 	return &app.Dependencies{
@@ -72,6 +75,44 @@ func CreateTestDependencies(cfg *config.Config, dbConn *database.Connection) (*a
 	}, nil
 }
 
+// NewTestRouter sets up the API router on the handlers of deps
+// Handlers the tests don't create get no services; their routes are registered but must not be called.
+func NewTestRouter(cfg *config.Config, deps *app.Dependencies) *gin.Engine {
+	jwtService := deps.JWTService
+	application := app.NewWireApp(cfg, deps.DBConn, nil,
+		deps.UserHandler,
+		deps.EventHandler,
+		deps.OrderHandler,
+		httpHandlers.NewVenueHandler(venue.NewVenueService(database.NewVenueRepository(deps.DBConn.DB), app.VenueOwnerCheck(deps.UserService)), jwtService),
+		httpHandlers.NewPlanHandler(nil, jwtService),
+		httpHandlers.NewJobHandler(nil, jwtService),
+		httpHandlers.NewReportHandler(nil, jwtService),
+		httpHandlers.NewFeedHandler(nil, nil, &cfg.App, &cfg.Feeds),
+		httpHandlers.NewShareHandler(nil, jwtService),
+		httpHandlers.NewShortURLHandler(nil, nil, jwtService),
+		httpHandlers.NewStaffHandler(nil, nil, jwtService),
+		httpHandlers.NewNotificationHandler(nil, jwtService),
+		httpHandlers.NewInvoiceHandler(nil, jwtService),
+		httpHandlers.NewAccountHandler(nil, jwtService),
+		httpHandlers.NewConsentHandler(nil, jwtService),
+		httpHandlers.NewIPAccessHandler(nil, jwtService),
+		httpHandlers.NewAnalyticsHandler(nil, jwtService),
+		httpHandlers.NewRecommendationHandler(nil, jwtService),
+		httpHandlers.NewTrendingHandler(nil),
+		httpHandlers.NewRefundHandler(nil, jwtService),
+		httpHandlers.NewMessageHandler(nil, jwtService),
+		httpHandlers.NewWebhookHandler(nil, jwtService),
+		httpHandlers.NewTransferHandler(nil, jwtService),
+		httpHandlers.NewAccessCodeHandler(nil, jwtService),
+		httpHandlers.NewMaintenanceHandler(nil, jwtService),
+		httpHandlers.NewDiagnosticsHandler(cfg.Diagnostics.DumpDir, jwtService),
+		httpHandlers.NewEmailPreviewHandler(nil),
+		httpHandlers.NewBrandingHandler(nil, jwtService),
+		httpHandlers.NewMetaHandler(),
+	)
+	return application.SetupRouter()
+}
+
 // CreateTestConfig creates a test configuration
 func CreateTestConfig() *config.Config {
 	return &config.Config{
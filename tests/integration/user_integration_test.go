@@ -11,7 +11,6 @@ import (
 	"net/http/httptest"
 	"testing"
 
-	"enterprise-crud/internal/app"
 	"enterprise-crud/internal/dto/user"
 	"enterprise-crud/internal/infrastructure/database"
 
@@ -39,11 +38,8 @@ func TestUserIntegration(t *testing.T) {
 	deps, err := CreateTestDependencies(cfg, dbConn)
 	require.NoError(t, err, "Failed to create dependencies")
 
-	// Create the application instance
-	application := app.NewWireApp(cfg, dbConn, deps.UserHandler, deps.EventHandler, deps.OrderHandler)
-
 	// Create HTTP handler
-	router := application.SetupRouter()
+	router := NewTestRouter(cfg, deps)
 
 	t.Run("POST /users - Create User", func(t *testing.T) {
 		// Prepare request payload
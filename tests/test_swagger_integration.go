@@ -16,6 +16,7 @@ import (
 	httpHandlers "enterprise-crud/internal/presentation/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -36,11 +37,82 @@ func (m *MockUserService) GetUserByEmail(ctx context.Context, email string) (*us
 	return args.Get(0).(*user.User), args.Error(1)
 }
 
+func (m *MockUserService) IsUsernameAvailable(ctx context.Context, username string) (bool, error) {
+	args := m.Called(ctx, username)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserService) GetUserByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) SetAccountStatus(ctx context.Context, userID uuid.UUID, status, reason string, actorID *uuid.UUID) (*user.User, error) {
+	args := m.Called(ctx, userID, status, reason, actorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) CheckAccountStatus(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserService) GetStatusHistory(ctx context.Context, userID uuid.UUID) ([]*user.StatusChange, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*user.StatusChange), args.Error(1)
+}
+
+func (m *MockUserService) SetDateOfBirth(ctx context.Context, userID uuid.UUID, dateOfBirth *time.Time) (*user.User, error) {
+	args := m.Called(ctx, userID, dateOfBirth)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) DateOfBirth(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
 func (m *MockUserService) AuthenticateUser(ctx context.Context, email, password string) (*user.User, error) {
 	args := m.Called(ctx, email, password)
 	return args.Get(0).(*user.User), args.Error(1)
 }
 
+func (m *MockUserService) GrantRole(ctx context.Context, email, roleName string) (*user.User, error) {
+	args := m.Called(ctx, email, roleName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) RevokeRole(ctx context.Context, email, roleName string) (*user.User, error) {
+	args := m.Called(ctx, email, roleName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserService) RolesVersion(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func setupTestServer() *httptest.Server {
 	gin.SetMode(gin.TestMode)
 
@@ -55,7 +127,7 @@ func setupTestServer() *httptest.Server {
 	// Create mock user service
 	mockUserService := new(MockUserService)
 	jwtService := auth.NewJWTService("test-secret-key", "test-issuer", time.Hour)
-	userHandler := httpHandlers.NewUserHandler(mockUserService, jwtService)
+	userHandler := httpHandlers.NewUserHandler(mockUserService, nil, jwtService)
 
 	// Setup router
 	router := gin.New()